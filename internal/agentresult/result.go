@@ -0,0 +1,280 @@
+// Package agentresult is the structured channel from agents back to gt.
+//
+// An agent returns a result in one of two ways:
+//
+//   - a fenced block in its output, tagged gt:result:
+//
+//     ```gt:result
+//     {"kind": "verdict", "bead": "gt-abc12", "verdict": "approve", "summary": "LGTM"}
+//     ```
+//
+//   - a JSON file dropped in its worktree under .runtime/results/, holding
+//     one result object or an array of them.
+//
+// The file drop is the reliable path: TUIs may re-wrap or restyle fenced
+// blocks, so pane parsing is best-effort. Either way, every result is
+// validated against its kind's schema before gt routes it (see Router).
+package agentresult
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// FenceTag is the info string that marks a result block in agent output.
+const FenceTag = "gt:result"
+
+// DropDir is the directory, relative to an agent's worktree, where it drops
+// result files. It lives under .runtime/ so it is never committed.
+const DropDir = ".runtime/results"
+
+// routedFile records digests of pane results already routed, so a block
+// still on screen isn't routed again by the next collect.
+const routedFile = "routed"
+
+// Result kinds.
+const (
+	KindVerdict   = "verdict"   // A review or check outcome for a bead
+	KindArtifacts = "artifacts" // Files or links produced for a bead
+	KindFollowUps = "followups" // New work discovered while on a bead
+)
+
+// Verdicts a verdict result may carry.
+const (
+	VerdictApprove      = "approve"
+	VerdictReject       = "reject"
+	VerdictNeedsChanges = "needs_changes"
+)
+
+var validVerdicts = []string{VerdictApprove, VerdictReject, VerdictNeedsChanges}
+
+// validFollowUpTypes are the bead types a follow-up may be filed as.
+var validFollowUpTypes = []string{"task", "bug", "feature", "chore"}
+
+// Result is one structured result returned by an agent.
+type Result struct {
+	Kind      string     `json:"kind"`
+	Bead      string     `json:"bead,omitempty"`    // Bead the result is about
+	Verdict   string     `json:"verdict,omitempty"` // verdict only
+	Summary   string     `json:"summary,omitempty"`
+	Artifacts []Artifact `json:"artifacts,omitempty"` // artifacts only
+	FollowUps []FollowUp `json:"followups,omitempty"` // followups only
+
+	Source string `json:"-"` // "pane" or the dropped file's path
+}
+
+// Artifact is a file or link an agent produced.
+type Artifact struct {
+	Path        string `json:"path"` // Worktree-relative path or URL
+	Description string `json:"description,omitempty"`
+}
+
+// FollowUp is a piece of work to file as a new bead.
+type FollowUp struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`     // task (default), bug, feature, chore
+	Priority    *int   `json:"priority,omitempty"` // 0-4, default 2
+}
+
+// Validate checks a result against its kind's schema.
+func (r *Result) Validate() error {
+	switch r.Kind {
+	case KindVerdict:
+		if !slices.Contains(validVerdicts, r.Verdict) {
+			return fmt.Errorf("verdict %q must be one of %s", r.Verdict, strings.Join(validVerdicts, ", "))
+		}
+		if r.Verdict != VerdictApprove && strings.TrimSpace(r.Summary) == "" {
+			return fmt.Errorf("a %s verdict needs a summary", r.Verdict)
+		}
+		if len(r.Artifacts) > 0 || len(r.FollowUps) > 0 {
+			return errors.New("a verdict result carries no artifacts or followups")
+		}
+	case KindArtifacts:
+		if len(r.Artifacts) == 0 {
+			return errors.New("an artifacts result needs at least one artifact")
+		}
+		for i, a := range r.Artifacts {
+			if strings.TrimSpace(a.Path) == "" {
+				return fmt.Errorf("artifacts[%d]: path is required", i)
+			}
+		}
+		if r.Verdict != "" || len(r.FollowUps) > 0 {
+			return errors.New("an artifacts result carries no verdict or followups")
+		}
+	case KindFollowUps:
+		if len(r.FollowUps) == 0 {
+			return errors.New("a followups result needs at least one followup")
+		}
+		for i, f := range r.FollowUps {
+			if strings.TrimSpace(f.Title) == "" {
+				return fmt.Errorf("followups[%d]: title is required", i)
+			}
+			if f.Type != "" && !slices.Contains(validFollowUpTypes, f.Type) {
+				return fmt.Errorf("followups[%d]: type %q must be one of %s", i, f.Type, strings.Join(validFollowUpTypes, ", "))
+			}
+			if f.Priority != nil && (*f.Priority < 0 || *f.Priority > 4) {
+				return fmt.Errorf("followups[%d]: priority %d is outside 0-4", i, *f.Priority)
+			}
+		}
+		if r.Verdict != "" || len(r.Artifacts) > 0 {
+			return errors.New("a followups result carries no verdict or artifacts")
+		}
+	case "":
+		return errors.New("kind is required")
+	default:
+		return fmt.Errorf("unknown kind %q (want %s, %s, or %s)", r.Kind, KindVerdict, KindArtifacts, KindFollowUps)
+	}
+	return nil
+}
+
+// Digest identifies a result by content, independent of its source.
+func (r *Result) Digest() string {
+	data, _ := json.Marshal(r)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Decode parses and validates result JSON: one object or an array of them.
+// Unknown fields are rejected so typos don't silently drop data.
+func Decode(data []byte, source string) ([]Result, error) {
+	data = bytes.TrimSpace(data)
+	var results []Result
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if len(data) > 0 && data[0] == '[' {
+		if err := dec.Decode(&results); err != nil {
+			return nil, fmt.Errorf("parsing result JSON: %w", err)
+		}
+	} else {
+		var r Result
+		if err := dec.Decode(&r); err != nil {
+			return nil, fmt.Errorf("parsing result JSON: %w", err)
+		}
+		results = []Result{r}
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("parsing result JSON: trailing data after result")
+	}
+	for i := range results {
+		if err := results[i].Validate(); err != nil {
+			return nil, err
+		}
+		results[i].Source = source
+	}
+	return results, nil
+}
+
+// ParseOutput extracts the gt:result blocks from agent output (typically a
+// pane capture). Valid results are returned in order, with duplicates of
+// the same block (say, scrolled into view twice) dropped; each invalid
+// block yields an error.
+func ParseOutput(text string) ([]Result, []error) {
+	var (
+		results []Result
+		errs    []error
+		seen    = map[string]bool{}
+		block   []string
+		inBlock bool
+	)
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := cleanLine(line)
+		if !inBlock {
+			if trimmed == "```"+FenceTag {
+				inBlock, block = true, nil
+			}
+			continue
+		}
+		if trimmed != "```" {
+			block = append(block, trimmed)
+			continue
+		}
+		inBlock = false
+		decoded, err := Decode([]byte(strings.Join(block, "\n")), "pane")
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, r := range decoded {
+			if d := r.Digest(); !seen[d] {
+				seen[d] = true
+				results = append(results, r)
+			}
+		}
+	}
+	return results, errs
+}
+
+// cleanLine strips the indentation and response bullet agent TUIs draw
+// around output lines.
+func cleanLine(line string) string {
+	trimmed := strings.TrimSpace(line)
+	return strings.TrimSpace(strings.TrimPrefix(trimmed, "⏺"))
+}
+
+// DropFiles returns the result files waiting in a worktree, oldest name first.
+func DropFiles(workDir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(workDir, DropDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// ReadDrop reads and validates one dropped result file.
+func ReadDrop(path string) ([]Result, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path comes from DropFiles
+	if err != nil {
+		return nil, err
+	}
+	results, err := Decode(data, path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return results, nil
+}
+
+// LoadRouted returns the digests of pane results already routed from a
+// worktree.
+func LoadRouted(workDir string) map[string]bool {
+	routed := map[string]bool{}
+	data, err := os.ReadFile(filepath.Join(workDir, DropDir, routedFile)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return routed
+	}
+	for _, d := range strings.Fields(string(data)) {
+		routed[d] = true
+	}
+	return routed
+}
+
+// MarkRouted records pane result digests as routed.
+func MarkRouted(workDir string, digests []string) error {
+	if len(digests) == 0 {
+		return nil
+	}
+	dir := filepath.Join(workDir, DropDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, routedFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: digests only
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(strings.Join(digests, "\n") + "\n"); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,169 @@
+package agentresult
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestDecodeValidates(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"approve", `{"kind":"verdict","verdict":"approve"}`, ""},
+		{"reject needs summary", `{"kind":"verdict","verdict":"reject"}`, "needs a summary"},
+		{"bad verdict", `{"kind":"verdict","verdict":"maybe"}`, "must be one of"},
+		{"artifacts", `{"kind":"artifacts","artifacts":[{"path":"out/report.md"}]}`, ""},
+		{"artifact without path", `{"kind":"artifacts","artifacts":[{"description":"x"}]}`, "path is required"},
+		{"followups array", `[{"kind":"followups","followups":[{"title":"Fix flake","type":"bug","priority":1}]}]`, ""},
+		{"followup bad priority", `{"kind":"followups","followups":[{"title":"x","priority":7}]}`, "outside 0-4"},
+		{"followup bad type", `{"kind":"followups","followups":[{"title":"x","type":"epic"}]}`, "type \"epic\""},
+		{"mixed kinds", `{"kind":"verdict","verdict":"approve","artifacts":[{"path":"a"}]}`, "carries no"},
+		{"unknown field", `{"kind":"verdict","verdict":"approve","verdik":"x"}`, "unknown field"},
+		{"missing kind", `{"verdict":"approve"}`, "kind is required"},
+		{"unknown kind", `{"kind":"vibes"}`, "unknown kind"},
+		{"trailing data", `{"kind":"verdict","verdict":"approve"} {}`, "trailing data"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode([]byte(tt.input), "test")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Decode() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Decode() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseOutput(t *testing.T) {
+	pane := strings.Join([]string{
+		"⏺ Review done.",
+		"  ```gt:result",
+		`  {"kind": "verdict", "bead": "gt-1",`,
+		`   "verdict": "needs_changes", "summary": "Missing tests"}`,
+		"  ```",
+		"",
+		"```json",
+		`{"kind": "verdict", "verdict": "approve"}`,
+		"```",
+		"```gt:result",
+		`{"kind": "bogus"}`,
+		"```",
+		"⏺ ```gt:result",
+		`{"kind": "verdict", "bead": "gt-1", "verdict": "needs_changes", "summary": "Missing tests"}`,
+		"```",
+	}, "\n")
+
+	results, errs := ParseOutput(pane)
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1 (duplicate and untagged blocks dropped): %+v", len(results), results)
+	}
+	if r := results[0]; r.Verdict != VerdictNeedsChanges || r.Bead != "gt-1" || r.Source != "pane" {
+		t.Errorf("result = %+v", r)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "unknown kind") {
+		t.Errorf("errs = %v, want one unknown-kind error", errs)
+	}
+}
+
+func TestDropFilesAndRouted(t *testing.T) {
+	dir := t.TempDir()
+	if files, err := DropFiles(dir); err != nil || len(files) != 0 {
+		t.Fatalf("DropFiles(empty) = %v, %v", files, err)
+	}
+	drop := filepath.Join(dir, DropDir)
+	if err := os.MkdirAll(drop, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(drop, "b.json"), []byte(`{"kind":"verdict","verdict":"approve"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(drop, "a.json"), []byte(`{"kind":"verdict"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	files, err := DropFiles(dir)
+	if err != nil || len(files) != 2 || filepath.Base(files[0]) != "a.json" {
+		t.Fatalf("DropFiles() = %v, %v", files, err)
+	}
+	if _, err := ReadDrop(files[0]); err == nil || !strings.Contains(err.Error(), "a.json") {
+		t.Errorf("ReadDrop(invalid) error = %v, want one naming the file", err)
+	}
+	results, err := ReadDrop(files[1])
+	if err != nil || len(results) != 1 || results[0].Source != files[1] {
+		t.Fatalf("ReadDrop() = %+v, %v", results, err)
+	}
+
+	if err := MarkRouted(dir, []string{results[0].Digest()}); err != nil {
+		t.Fatal(err)
+	}
+	if !LoadRouted(dir)[results[0].Digest()] {
+		t.Error("LoadRouted() missing the marked digest")
+	}
+}
+
+type fakeBeads struct {
+	comments map[string][]*beads.Comment
+	created  []beads.CreateOptions
+}
+
+func (f *fakeBeads) AddComment(id string, c *beads.Comment) error {
+	if f.comments == nil {
+		f.comments = map[string][]*beads.Comment{}
+	}
+	f.comments[id] = append(f.comments[id], c)
+	return nil
+}
+
+func (f *fakeBeads) Create(opts beads.CreateOptions) (*beads.Issue, error) {
+	f.created = append(f.created, opts)
+	return &beads.Issue{ID: fmt.Sprintf("gt-new%d", len(f.created))}, nil
+}
+
+func TestRouterRoute(t *testing.T) {
+	fb := &fakeBeads{}
+	rt := &Router{Beads: fb, Actor: "gastown/polecats/Toast", Role: "polecat"}
+
+	if _, err := rt.Route(Result{Kind: KindVerdict, Verdict: VerdictApprove}, ""); err == nil {
+		t.Error("Route() without a bead should fail for a verdict")
+	}
+
+	if _, err := rt.Route(Result{Kind: KindVerdict, Verdict: VerdictReject, Summary: "Breaks sync"}, "gt-1"); err != nil {
+		t.Fatal(err)
+	}
+	if c := fb.comments["gt-1"][0]; c.Kind != beads.CommentKindVerdict || c.Body != "reject: Breaks sync" || c.Author != rt.Actor {
+		t.Errorf("verdict comment = %+v", c)
+	}
+
+	if _, err := rt.Route(Result{Kind: KindArtifacts, Bead: "gt-2", Artifacts: []Artifact{{Path: "out/a.md"}, {Path: "https://ci/run/1", Description: "CI run"}}}, "gt-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := fb.comments["gt-2"]; len(got) != 2 || got[0].Artifact != "out/a.md" || got[1].Body != "CI run" {
+		t.Errorf("artifact comments = %+v", got)
+	}
+
+	p := 1
+	out, err := rt.Route(Result{Kind: KindFollowUps, FollowUps: []FollowUp{{Title: "Fix flake", Type: "bug", Priority: &p}, {Title: "Docs"}}}, "gt-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Created) != 2 || fb.created[0].Priority != 1 || fb.created[1].Type != "task" || fb.created[1].Priority != 2 {
+		t.Errorf("follow-ups = %+v, created = %+v", out, fb.created)
+	}
+	if !strings.Contains(fb.created[1].Description, "gt-1") {
+		t.Errorf("follow-up description = %q, want it to name the source bead", fb.created[1].Description)
+	}
+	if last := fb.comments["gt-1"][1]; !strings.Contains(last.Body, "gt-new1, gt-new2") {
+		t.Errorf("source bead note = %q", last.Body)
+	}
+}
@@ -0,0 +1,121 @@
+package agentresult
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// BeadWriter is the subset of *beads.Beads the router writes through.
+type BeadWriter interface {
+	AddComment(id string, c *beads.Comment) error
+	Create(opts beads.CreateOptions) (*beads.Issue, error)
+}
+
+// Router delivers validated results to beads:
+//
+//   - verdict: a comment on the bead (kind=verdict) stating the verdict
+//   - artifacts: one comment per artifact (kind=artifact) linking it
+//   - followups: a new bead per follow-up, noted on the source bead
+type Router struct {
+	Beads BeadWriter
+	Actor string // Agent address the results came from
+	Role  string // Agent role, recorded on comments
+}
+
+// Routed describes what routing one result did.
+type Routed struct {
+	Kind    string   `json:"kind"`
+	Bead    string   `json:"bead,omitempty"`
+	Created []string `json:"created,omitempty"` // Follow-up bead IDs
+	Source  string   `json:"source"`
+}
+
+// Route delivers one result. bead is the fallback target (typically the
+// agent's hooked bead) for results that don't name one.
+func (rt *Router) Route(r Result, bead string) (*Routed, error) {
+	if r.Bead != "" {
+		bead = r.Bead
+	}
+	out := &Routed{Kind: r.Kind, Bead: bead, Source: r.Source}
+	if bead == "" && r.Kind != KindFollowUps {
+		return nil, errors.New("result names no bead and the agent has none hooked")
+	}
+
+	switch r.Kind {
+	case KindVerdict:
+		body := r.Verdict
+		if r.Summary != "" {
+			body += ": " + r.Summary
+		}
+		return out, rt.comment(bead, body, "", beads.CommentKindVerdict)
+	case KindArtifacts:
+		for _, a := range r.Artifacts {
+			body := a.Description
+			if body == "" {
+				body = a.Path
+			}
+			if err := rt.comment(bead, body, a.Path, beads.CommentKindArtifact); err != nil {
+				return out, err
+			}
+		}
+		return out, nil
+	case KindFollowUps:
+		for _, f := range r.FollowUps {
+			issue, err := rt.Beads.Create(followUpOptions(f, bead, rt.Actor))
+			if err != nil {
+				return out, fmt.Errorf("filing follow-up %q: %w", f.Title, err)
+			}
+			out.Created = append(out.Created, issue.ID)
+		}
+		if bead != "" {
+			body := "Follow-ups filed: " + strings.Join(out.Created, ", ")
+			if r.Summary != "" {
+				body = r.Summary + "\n" + body
+			}
+			if err := rt.comment(bead, body, "", ""); err != nil {
+				return out, err
+			}
+		}
+		return out, nil
+	}
+	return nil, r.Validate()
+}
+
+func (rt *Router) comment(bead, body, artifact, kind string) error {
+	return rt.Beads.AddComment(bead, &beads.Comment{
+		Author:   rt.Actor,
+		Role:     rt.Role,
+		Body:     body,
+		Artifact: artifact,
+		Kind:     kind,
+	})
+}
+
+// followUpOptions builds the bead for a follow-up, defaulting its type to
+// task and priority to 2 and noting where it was discovered.
+func followUpOptions(f FollowUp, bead, actor string) beads.CreateOptions {
+	opts := beads.CreateOptions{
+		Title:       f.Title,
+		Type:        f.Type,
+		Priority:    2,
+		Description: f.Description,
+		Actor:       actor,
+	}
+	if opts.Type == "" {
+		opts.Type = "task"
+	}
+	if f.Priority != nil {
+		opts.Priority = *f.Priority
+	}
+	if bead != "" {
+		note := fmt.Sprintf("Discovered while working on %s.", bead)
+		if opts.Description != "" {
+			note = opts.Description + "\n\n" + note
+		}
+		opts.Description = note
+	}
+	return opts
+}
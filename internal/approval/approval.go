@@ -0,0 +1,259 @@
+// Package approval implements human approval gates for designated actions.
+//
+// An agent (or command) about to perform a gated action files a Request. The
+// overseer approves or denies it with gt approval; until then the caller
+// either blocks in Wait or parks its work and retries later. Requests expire
+// after a timeout, and an approval is consumed by the first action it allows.
+// Requests are stored one file per request under .runtime/approvals/, which
+// doubles as the audit record.
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Actions gt knows how to gate. None is gated until the town lists it in
+// its approval policy.
+const (
+	ActionForcePush = "force-push" // git push --force by an agent (tap guard)
+	ActionDeploy    = "deploy"     // deploy scripts, via gt approval request
+	ActionRigRemove = "rig-remove" // gt rig remove
+)
+
+// DefaultTimeout is how long a request stays pending before it expires.
+const DefaultTimeout = 24 * time.Hour
+
+// Status is the state of an approval request.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusDenied   Status = "denied"
+	StatusExpired  Status = "expired"
+)
+
+var (
+	// ErrNotFound is returned when a request does not exist.
+	ErrNotFound = errors.New("approval request not found")
+	// ErrDecided is returned when deciding a request that is no longer pending.
+	ErrDecided = errors.New("approval request already decided")
+)
+
+// Request is an approval request for one action on one target.
+type Request struct {
+	ID          string     `json:"id"`
+	Action      string     `json:"action"`
+	Target      string     `json:"target"`
+	Reason      string     `json:"reason,omitempty"`
+	Bead        string     `json:"bead,omitempty"`        // Wisp parked on this request, if any
+	BeadStatus  string     `json:"bead_status,omitempty"` // Wisp's status before it was parked
+	RequestedBy string     `json:"requested_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	Status      Status     `json:"status"`
+	DecidedBy   string     `json:"decided_by,omitempty"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+	Note        string     `json:"note,omitempty"`
+	ConsumedAt  *time.Time `json:"consumed_at,omitempty"`
+}
+
+// IsPending reports whether the request still awaits a decision.
+func (r *Request) IsPending() bool { return r.Status == StatusPending }
+
+// refresh marks an overdue pending request as expired. Returns true if changed.
+func (r *Request) refresh(now time.Time) bool {
+	if r.Status == StatusPending && !r.ExpiresAt.IsZero() && now.After(r.ExpiresAt) {
+		r.Status = StatusExpired
+		return true
+	}
+	return false
+}
+
+// Policy decides which actions need approval and for how long requests wait.
+type Policy struct {
+	Actions []string
+	Timeout time.Duration
+}
+
+// NewPolicy builds a policy from town settings; nil gates nothing.
+func NewPolicy(cfg *config.ApprovalsConfig) *Policy {
+	p := &Policy{Timeout: DefaultTimeout}
+	if cfg == nil {
+		return p
+	}
+	p.Actions = cfg.Actions
+	p.Timeout = config.ParseDurationOrDefault(cfg.Timeout, DefaultTimeout)
+	return p
+}
+
+// LoadPolicy reads the approval policy from town settings.
+func LoadPolicy(townRoot string) (*Policy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return NewPolicy(settings.Approvals), nil
+}
+
+// Requires reports whether action is gated.
+func (p *Policy) Requires(action string) bool {
+	for _, a := range p.Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// Dir returns the directory holding approval requests.
+func Dir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "approvals")
+}
+
+func requestPath(townRoot, id string) string {
+	return filepath.Join(Dir(townRoot), id+".json")
+}
+
+// New creates and saves a pending request.
+func New(townRoot, action, target, reason, requestedBy string, timeout time.Duration) (*Request, error) {
+	now := time.Now().UTC()
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	r := &Request{
+		ID:          "ap-" + strconv.FormatInt(now.UnixNano(), 36),
+		Action:      action,
+		Target:      target,
+		Reason:      reason,
+		RequestedBy: requestedBy,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(timeout),
+		Status:      StatusPending,
+	}
+	return r, Save(townRoot, r)
+}
+
+// Save writes a request.
+func Save(townRoot string, r *Request) error {
+	return util.EnsureDirAndWriteJSON(requestPath(townRoot, r.ID), r)
+}
+
+// Load reads a request, expiring it if overdue.
+func Load(townRoot, id string) (*Request, error) {
+	data, err := os.ReadFile(requestPath(townRoot, id)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+		}
+		return nil, fmt.Errorf("reading approval %s: %w", id, err)
+	}
+	var r Request
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("parsing approval %s: %w", id, err)
+	}
+	if r.refresh(time.Now()) {
+		_ = Save(townRoot, &r)
+	}
+	return &r, nil
+}
+
+// List returns all requests, newest first.
+func List(townRoot string) ([]*Request, error) {
+	entries, err := os.ReadDir(Dir(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading approvals: %w", err)
+	}
+	var out []*Request
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		r, err := Load(townRoot, strings.TrimSuffix(name, ".json"))
+		if err != nil {
+			continue
+		}
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	return out, nil
+}
+
+// Find returns the newest unconsumed request for action on target, or nil.
+func Find(townRoot, action, target string) (*Request, error) {
+	all, err := List(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range all {
+		if r.Action == action && r.Target == target && r.ConsumedAt == nil {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+// Decide approves or denies a pending request.
+func Decide(townRoot, id string, approve bool, by, note string) (*Request, error) {
+	r, err := Load(townRoot, id)
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsPending() {
+		return r, fmt.Errorf("%w: %s is %s", ErrDecided, id, r.Status)
+	}
+	now := time.Now().UTC()
+	r.Status = StatusDenied
+	if approve {
+		r.Status = StatusApproved
+	}
+	r.DecidedBy = by
+	r.DecidedAt = &now
+	r.Note = note
+	return r, Save(townRoot, r)
+}
+
+// Consume marks a decided request as used, so an approval allows only one
+// action and a denial is reported only once.
+func Consume(townRoot string, r *Request) error {
+	now := time.Now().UTC()
+	r.ConsumedAt = &now
+	return Save(townRoot, r)
+}
+
+// Wait polls until the request is decided or expires, or ctx is done.
+func Wait(ctx context.Context, townRoot, id string, poll time.Duration) (*Request, error) {
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+	for {
+		r, err := Load(townRoot, id)
+		if err != nil {
+			return nil, err
+		}
+		if !r.IsPending() {
+			return r, nil
+		}
+		select {
+		case <-ctx.Done():
+			return r, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestPolicy(t *testing.T) {
+	p := NewPolicy(nil)
+	if p.Requires(ActionRigRemove) || p.Requires(ActionForcePush) || p.Timeout != DefaultTimeout {
+		t.Errorf("default policy = %+v", p)
+	}
+	p = NewPolicy(&config.ApprovalsConfig{Actions: []string{"deploy"}, Timeout: "2h"})
+	if !p.Requires("deploy") || p.Requires(ActionRigRemove) || p.Timeout != 2*time.Hour {
+		t.Errorf("configured policy = %+v", p)
+	}
+	if p := NewPolicy(&config.ApprovalsConfig{Actions: []string{}}); p.Requires(ActionForcePush) {
+		t.Error("empty action list should disable gates")
+	}
+	if data, _ := json.Marshal(&config.ApprovalsConfig{Actions: []string{}}); !strings.Contains(string(data), `"actions":[]`) {
+		t.Errorf("explicit empty action list not kept: %s", data)
+	}
+}
+
+func TestRequestLifecycle(t *testing.T) {
+	townRoot := t.TempDir()
+	r, err := New(townRoot, ActionDeploy, "prod", "release", "gastown/witness", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found, err := Find(townRoot, ActionDeploy, "prod"); err != nil || found == nil || found.ID != r.ID {
+		t.Fatalf("Find() = %+v, %v", found, err)
+	}
+
+	decided, err := Decide(townRoot, r.ID, true, "overseer", "ship it")
+	if err != nil || decided.Status != StatusApproved || decided.DecidedBy != "overseer" {
+		t.Fatalf("Decide() = %+v, %v", decided, err)
+	}
+	if _, err := Decide(townRoot, r.ID, false, "overseer", ""); !errors.Is(err, ErrDecided) {
+		t.Errorf("second Decide() = %v, want ErrDecided", err)
+	}
+
+	if err := Consume(townRoot, decided); err != nil {
+		t.Fatal(err)
+	}
+	if found, _ := Find(townRoot, ActionDeploy, "prod"); found != nil {
+		t.Errorf("consumed approval should not be found, got %+v", found)
+	}
+	if _, err := Load(townRoot, "ap-missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Load(missing) = %v, want ErrNotFound", err)
+	}
+}
+
+func TestRequestExpires(t *testing.T) {
+	townRoot := t.TempDir()
+	r, err := New(townRoot, ActionForcePush, "polecat/Toast", "", "gastown/polecats/Toast", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.ExpiresAt = time.Now().Add(-time.Minute)
+	if err := Save(townRoot, r); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(townRoot, r.ID)
+	if err != nil || loaded.Status != StatusExpired {
+		t.Fatalf("Load() = %+v, %v; want expired", loaded, err)
+	}
+	if _, err := Decide(townRoot, r.ID, true, "overseer", ""); !errors.Is(err, ErrDecided) {
+		t.Errorf("Decide(expired) = %v, want ErrDecided", err)
+	}
+}
+
+func TestWait(t *testing.T) {
+	townRoot := t.TempDir()
+	r, err := New(townRoot, ActionDeploy, "prod", "", "mayor", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := Wait(ctx, townRoot, r.ID, 5*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait(pending) = %v, want deadline exceeded", err)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_, _ = Decide(townRoot, r.ID, false, "overseer", "not today")
+	}()
+	got, err := Wait(context.Background(), townRoot, r.ID, 5*time.Millisecond)
+	if err != nil || got.Status != StatusDenied || got.Note != "not today" {
+		t.Errorf("Wait() = %+v, %v; want denied", got, err)
+	}
+}
@@ -0,0 +1,459 @@
+// Package archive moves old artifacts out of hot storage into compressed
+// cold storage, keeping a searchable index so they can be found and
+// restored on demand.
+//
+// Three kinds of artifact are archived: closed wisps (their bd show JSON),
+// agent session logs under <rig>/.runtime/session-logs, and rotated patrol
+// logs under logs/ and daemon/. Each run writes one gzipped tarball to the
+// archive directory and appends an entry per artifact to index.jsonl.
+package archive
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/lock"
+)
+
+// Artifact kinds.
+const (
+	KindWisp    = "wisp"    // Closed wisp bead
+	KindSession = "session" // Agent session log
+	KindLog     = "log"     // Rotated patrol log
+)
+
+// Default ages before an artifact is archived. DefaultWispAge is well
+// under the wisp reaper's 7-day delete age, so closed wisps reach the
+// archive before the reaper deletes them.
+const (
+	DefaultWispAge    = 3 * 24 * time.Hour
+	DefaultSessionAge = 14 * 24 * time.Hour
+	DefaultLogAge     = 30 * 24 * time.Hour
+)
+
+const (
+	archiveExt = ".tar.gz"
+	indexName  = "index.jsonl"
+)
+
+// ErrNotFound is returned when no archived artifact matches an ID.
+var ErrNotFound = errors.New("not in archive")
+
+// ErrExists is returned when restoring over an artifact that is still present.
+var ErrExists = errors.New("already exists")
+
+// Entry is one archived artifact in the index.
+type Entry struct {
+	Kind       string    `json:"kind"`
+	ID         string    `json:"id"` // Bead ID, or file path relative to the town root
+	Title      string    `json:"title,omitempty"`
+	Rig        string    `json:"rig,omitempty"`
+	Time       time.Time `json:"time"` // When the artifact was closed or last written
+	Bytes      int64     `json:"bytes"`
+	Archive    string    `json:"archive"` // Archive file name in the archive dir
+	Member     string    `json:"member"`  // Tar member holding the content
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// Item is an artifact to archive. Content comes from Data, or from the file
+// at Path when Data is nil.
+type Item struct {
+	Entry
+	Path string `json:"-"`
+	Data []byte `json:"-"`
+}
+
+// Policy decides which artifacts are old enough to archive. A zero age
+// turns archival off for that kind.
+type Policy struct {
+	Dir        string
+	WispAge    time.Duration
+	SessionAge time.Duration
+	LogAge     time.Duration
+}
+
+// NewPolicy builds a policy from town settings; nil uses the defaults.
+func NewPolicy(townRoot string, cfg *config.ArchiveConfig) (*Policy, error) {
+	p := &Policy{
+		Dir:        DefaultDir(townRoot),
+		WispAge:    DefaultWispAge,
+		SessionAge: DefaultSessionAge,
+		LogAge:     DefaultLogAge,
+	}
+	if cfg == nil {
+		return p, nil
+	}
+	if cfg.Dir != "" {
+		p.Dir = cfg.Dir
+		if !filepath.IsAbs(p.Dir) {
+			p.Dir = filepath.Join(townRoot, p.Dir)
+		}
+	}
+	for _, f := range []struct {
+		name string
+		val  string
+		dst  *time.Duration
+	}{
+		{"wisps", cfg.Wisps, &p.WispAge},
+		{"sessions", cfg.Sessions, &p.SessionAge},
+		{"logs", cfg.Logs, &p.LogAge},
+	} {
+		if f.val == "" {
+			continue
+		}
+		d, err := ParseAge(f.val)
+		if err != nil {
+			return nil, fmt.Errorf("archive.%s: %w", f.name, err)
+		}
+		*f.dst = d
+	}
+	return p, nil
+}
+
+// LoadPolicy reads the archival policy from town settings.
+func LoadPolicy(townRoot string) (*Policy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return NewPolicy(townRoot, settings.Archive)
+}
+
+// ParseAge parses a duration, also accepting whole days ("30d").
+func ParseAge(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid age %q", s)
+	}
+	return d, nil
+}
+
+// DefaultDir returns the default archive directory.
+func DefaultDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "archive")
+}
+
+// Due reports whether an artifact of kind last touched at t is old enough
+// to archive at now.
+func (p *Policy) Due(kind string, t, now time.Time) bool {
+	var age time.Duration
+	switch kind {
+	case KindWisp:
+		age = p.WispAge
+	case KindSession:
+		age = p.SessionAge
+	case KindLog:
+		age = p.LogAge
+	}
+	return age > 0 && now.Sub(t) > age
+}
+
+// FileCandidates returns the session and patrol logs due for archival.
+// Active logs (the ones still being appended to) are never candidates;
+// only rotated copies are.
+func (p *Policy) FileCandidates(townRoot string, now time.Time) ([]Item, error) {
+	var items []Item
+	add := func(kind, rig, path string) {
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() || !p.Due(kind, info.ModTime(), now) {
+			return
+		}
+		rel, err := filepath.Rel(townRoot, path)
+		if err != nil {
+			return
+		}
+		items = append(items, Item{
+			Entry: Entry{
+				Kind:  kind,
+				ID:    filepath.ToSlash(rel),
+				Title: filepath.Base(path),
+				Rig:   rig,
+				Time:  info.ModTime().UTC(),
+				Bytes: info.Size(),
+			},
+			Path: path,
+		})
+	}
+
+	entries, err := os.ReadDir(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("reading town root: %w", err)
+	}
+	for _, e := range entries {
+		if !e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		logs, _ := filepath.Glob(filepath.Join(townRoot, e.Name(), constants.DirRuntime, "session-logs", "*.log"))
+		for _, path := range logs {
+			add(KindSession, e.Name(), path)
+		}
+	}
+	for _, dir := range []string{"logs", "daemon"} {
+		files, _ := os.ReadDir(filepath.Join(townRoot, dir))
+		for _, f := range files {
+			if isRotatedLog(f.Name()) {
+				add(KindLog, "", filepath.Join(townRoot, dir, f.Name()))
+			}
+		}
+	}
+	return items, nil
+}
+
+// isRotatedLog matches rotated log copies (town.log.1, dolt-2026-03-01T...log.gz)
+// but not the live *.log files.
+func isRotatedLog(name string) bool {
+	if strings.HasSuffix(name, ".log") || !strings.Contains(name, ".log") {
+		return false
+	}
+	return !strings.HasSuffix(name, ".lock")
+}
+
+// Write stores items in a new archive in dir and appends them to the index.
+// It does not remove the originals; callers do that once Write succeeds.
+func Write(dir string, items []Item, now time.Time) ([]Entry, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("creating archive dir: %w", err)
+	}
+	unlock, err := lock.FlockAcquire(filepath.Join(dir, indexName+".lock"))
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	name := archiveName(dir, now)
+	path := filepath.Join(dir, name)
+	entries, err := writeArchive(path, name, items, now)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, err
+	}
+	if err := appendIndex(dir, entries); err != nil {
+		_ = os.Remove(path)
+		return nil, err
+	}
+	return entries, nil
+}
+
+// archiveName picks an unused archive file name for now.
+func archiveName(dir string, now time.Time) string {
+	base := now.UTC().Format("20060102-150405")
+	name := base + archiveExt
+	for i := 2; ; i++ {
+		if _, err := os.Stat(filepath.Join(dir, name)); os.IsNotExist(err) {
+			return name
+		}
+		name = fmt.Sprintf("%s-%d%s", base, i, archiveExt)
+	}
+}
+
+func writeArchive(path, name string, items []Item, now time.Time) ([]Entry, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil, fmt.Errorf("creating archive: %w", err)
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	entries := make([]Entry, 0, len(items))
+	for _, it := range items {
+		data := it.Data
+		if data == nil {
+			if data, err = os.ReadFile(it.Path); err != nil {
+				return nil, fmt.Errorf("reading %s: %w", it.ID, err)
+			}
+		}
+		e := it.Entry
+		e.Archive = name
+		e.Member = memberName(e)
+		e.Bytes = int64(len(data))
+		e.ArchivedAt = now.UTC()
+		hdr := &tar.Header{Name: e.Member, Mode: 0644, Size: e.Bytes, ModTime: e.Time}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, fmt.Errorf("writing %s: %w", e.Member, err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return nil, fmt.Errorf("writing %s: %w", e.Member, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("finishing archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("finishing archive: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		return nil, fmt.Errorf("syncing archive: %w", err)
+	}
+	return entries, nil
+}
+
+func memberName(e Entry) string {
+	if e.Kind == KindWisp {
+		return e.Kind + "/" + e.ID + ".json"
+	}
+	return e.Kind + "/" + e.ID
+}
+
+func appendIndex(dir string, entries []Entry) error {
+	f, err := os.OpenFile(filepath.Join(dir, indexName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return fmt.Errorf("opening archive index: %w", err)
+	}
+	defer f.Close()
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			return fmt.Errorf("encoding index entry: %w", err)
+		}
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("writing archive index: %w", err)
+	}
+	return nil
+}
+
+// Index returns every archived artifact, oldest archival first.
+func Index(dir string) ([]Entry, error) {
+	f, err := os.Open(filepath.Join(dir, indexName)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading archive index: %w", err)
+	}
+	defer f.Close()
+	var entries []Entry
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		var e Entry
+		if json.Unmarshal(sc.Bytes(), &e) == nil && e.ID != "" {
+			entries = append(entries, e)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("reading archive index: %w", err)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].ArchivedAt.Before(entries[j].ArchivedAt) })
+	return entries, nil
+}
+
+// Find returns the archived artifacts matching every whitespace-separated
+// term of query (case-insensitive, against kind, ID, title, and rig).
+func Find(dir, query string) ([]Entry, error) {
+	entries, err := Index(dir)
+	if err != nil {
+		return nil, err
+	}
+	terms := strings.Fields(strings.ToLower(query))
+	var matches []Entry
+	for _, e := range entries {
+		hay := strings.ToLower(strings.Join([]string{e.Kind, e.ID, e.Title, e.Rig}, " "))
+		ok := true
+		for _, t := range terms {
+			if !strings.Contains(hay, t) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matches = append(matches, e)
+		}
+	}
+	return matches, nil
+}
+
+// Lookup returns the most recently archived copy of the artifact with id.
+func Lookup(dir, id string) (*Entry, error) {
+	entries, err := Index(dir)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].ID == id {
+			return &entries[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s: %w", id, ErrNotFound)
+}
+
+// Read returns an archived artifact's content.
+func Read(dir string, e *Entry) ([]byte, error) {
+	f, err := os.Open(filepath.Join(dir, e.Archive)) //nolint:gosec // G304: archive name comes from the index
+	if err != nil {
+		return nil, fmt.Errorf("opening archive: %w", err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading archive %s: %w", e.Archive, err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%s missing from %s: %w", e.Member, e.Archive, ErrNotFound)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading archive %s: %w", e.Archive, err)
+		}
+		if hdr.Name == e.Member {
+			return io.ReadAll(tr)
+		}
+	}
+}
+
+// RestoreFile puts an archived session or patrol log back at its original
+// path under townRoot. It refuses to overwrite an existing file unless force.
+func RestoreFile(townRoot, dir string, e *Entry, force bool) (string, error) {
+	if e.Kind == KindWisp {
+		return "", fmt.Errorf("%s is a wisp; restore it through beads", e.ID)
+	}
+	dst := filepath.Join(townRoot, filepath.FromSlash(e.ID))
+	if rel, err := filepath.Rel(townRoot, dst); err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("refusing to restore %s outside the town", e.ID)
+	}
+	if _, err := os.Stat(dst); err == nil && !force {
+		return "", fmt.Errorf("%s: %w", dst, ErrExists)
+	}
+	data, err := Read(dir, e)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return "", fmt.Errorf("creating %s: %w", filepath.Dir(dst), err)
+	}
+	if err := os.WriteFile(dst, data, 0644); err != nil { //nolint:gosec // G306: logs are not sensitive
+		return "", fmt.Errorf("writing %s: %w", dst, err)
+	}
+	_ = os.Chtimes(dst, e.Time, e.Time)
+	return dst, nil
+}
@@ -0,0 +1,128 @@
+package archive
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func writeAged(t *testing.T, path, content string, mtime time.Time) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileCandidates(t *testing.T) {
+	town := t.TempDir()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-60 * 24 * time.Hour)
+
+	writeAged(t, filepath.Join(town, "gastown", ".runtime", "session-logs", "gt-toast.log"), "old session", old)
+	writeAged(t, filepath.Join(town, "gastown", ".runtime", "session-logs", "gt-nux.log"), "fresh", now)
+	writeAged(t, filepath.Join(town, "logs", "town.log"), "live", old)
+	writeAged(t, filepath.Join(town, "logs", "town.log.1"), "rotated", old)
+	writeAged(t, filepath.Join(town, "daemon", "dolt-2026-01-01T00-00-00.log.gz"), "gz", old)
+
+	p, err := NewPolicy(town, &config.ArchiveConfig{Sessions: "30d"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err := p.FileCandidates(town, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	for _, it := range items {
+		got[it.ID] = it.Kind
+	}
+	want := map[string]string{
+		"gastown/.runtime/session-logs/gt-toast.log": KindSession,
+		"logs/town.log.1":                        KindLog,
+		"daemon/dolt-2026-01-01T00-00-00.log.gz": KindLog,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("candidates = %v, want %v", got, want)
+	}
+	for id, kind := range want {
+		if got[id] != kind {
+			t.Errorf("candidate %s = %q, want %q", id, got[id], kind)
+		}
+	}
+
+	off, _ := NewPolicy(town, &config.ArchiveConfig{Sessions: "0", Logs: "0"})
+	if items, _ := off.FileCandidates(town, now); len(items) != 0 {
+		t.Errorf("disabled policy returned %d candidates", len(items))
+	}
+	if _, err := NewPolicy(town, &config.ArchiveConfig{Wisps: "soon"}); err == nil {
+		t.Error("invalid age accepted")
+	}
+}
+
+func TestWriteFindAndRestore(t *testing.T) {
+	town := t.TempDir()
+	dir := DefaultDir(town)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	logPath := filepath.Join(town, "gastown", ".runtime", "session-logs", "gt-toast.log")
+	writeAged(t, logPath, "session transcript", now.Add(-time.Hour))
+
+	items := []Item{
+		{Entry: Entry{Kind: KindWisp, ID: "gt-wisp-abc", Title: "Patrol: refinery sweep", Rig: "gastown"}, Data: []byte(`{"id":"gt-wisp-abc"}`)},
+		{Entry: Entry{Kind: KindSession, ID: "gastown/.runtime/session-logs/gt-toast.log", Title: "gt-toast.log"}, Path: logPath},
+	}
+	entries, err := Write(dir, items, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Archive == "" || entries[1].Bytes != int64(len("session transcript")) {
+		t.Fatalf("entries = %+v", entries)
+	}
+	// A second run in the same second gets its own archive.
+	more, err := Write(dir, items[:1], now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if more[0].Archive == entries[0].Archive {
+		t.Error("second archive reused the first archive's name")
+	}
+
+	found, err := Find(dir, "PATROL sweep")
+	if err != nil || len(found) != 2 {
+		t.Fatalf("Find = %+v, %v", found, err)
+	}
+	e, err := Lookup(dir, "gt-wisp-abc")
+	if err != nil || e.Archive != more[0].Archive {
+		t.Fatalf("Lookup = %+v, %v (want the latest copy)", e, err)
+	}
+	if data, err := Read(dir, e); err != nil || string(data) != `{"id":"gt-wisp-abc"}` {
+		t.Errorf("Read = %q, %v", data, err)
+	}
+	if _, err := Lookup(dir, "gt-nope"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Lookup(missing) = %v", err)
+	}
+
+	session, _ := Lookup(dir, items[1].ID)
+	if _, err := RestoreFile(town, dir, session, false); !errors.Is(err, ErrExists) {
+		t.Errorf("restore over live file = %v, want ErrExists", err)
+	}
+	if err := os.Remove(logPath); err != nil {
+		t.Fatal(err)
+	}
+	dst, err := RestoreFile(town, dir, session, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(dst); string(data) != "session transcript" || dst != logPath {
+		t.Errorf("restored %s = %q", dst, data)
+	}
+}
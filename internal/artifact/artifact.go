@@ -0,0 +1,524 @@
+// Package artifact is the registry of what agents produce for a wisp: the
+// branch holding its work, patch files, reports, and docs.
+//
+// Agents register artifacts against their wisp (gt artifact add, or
+// automatically by gt done and gt mq submit). Each artifact gets a stable ID
+// derived from its wisp, kind, and reference, so registering the same branch
+// twice updates one record. Review gates and the merge queue look artifacts
+// up here instead of guessing from branch names.
+//
+// Records live in one JSON file per wisp under .runtime/artifacts/. Local
+// files (patches, reports, docs) are copied beside them so they outlive the
+// worktree that produced them. Once a wisp closes, its artifacts are kept for
+// a per-kind retention period and then pruned.
+package artifact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/archive"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/lock"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Artifact kinds.
+const (
+	KindBranch = "branch" // Git branch holding the wisp's work
+	KindPatch  = "patch"  // Patch or diff file
+	KindReport = "report" // Test, review, or analysis report
+	KindDoc    = "doc"    // Document written for the wisp
+)
+
+// Kinds lists the artifact kinds in display order.
+var Kinds = []string{KindBranch, KindPatch, KindReport, KindDoc}
+
+// Default retention after a wisp closes.
+const (
+	DefaultBranchRetention = 30 * 24 * time.Hour
+	DefaultFileRetention   = 90 * 24 * time.Hour
+)
+
+// ErrNotFound is returned when no artifact has the given ID.
+var ErrNotFound = errors.New("artifact not found")
+
+// wispIDPattern is the bead ID grammar: a prefix, a dash, and a hash or
+// name, with optional numeric child suffixes (gt-abc12, hq-wisp-x9.2).
+var wispIDPattern = regexp.MustCompile(`^[A-Za-z0-9_]+-[A-Za-z0-9-]+(\.[0-9]+)*$`)
+
+// ValidWispID reports whether wisp is a well-formed bead ID. Wisp IDs name
+// files in the registry, so anything else is rejected.
+func ValidWispID(wisp string) bool {
+	return wispIDPattern.MatchString(wisp)
+}
+
+// wispPath joins name onto the registry directory for wisp, refusing
+// malformed wisp IDs and any result outside the registry.
+func wispPath(townRoot, wisp, name string) (string, error) {
+	if !ValidWispID(wisp) {
+		return "", fmt.Errorf("invalid wisp ID %q", wisp)
+	}
+	root := Dir(townRoot)
+	path := filepath.Join(root, name)
+	if rel, err := filepath.Rel(root, path); err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("artifact path for wisp %q leaves the registry", wisp)
+	}
+	return path, nil
+}
+
+// Artifact is one registered output of a wisp.
+type Artifact struct {
+	ID          string    `json:"id"`
+	Wisp        string    `json:"wisp"`
+	Kind        string    `json:"kind"`
+	Ref         string    `json:"ref"` // Branch name, file path, or URL
+	Description string    `json:"description,omitempty"`
+	Rig         string    `json:"rig,omitempty"`
+	Stored      string    `json:"stored,omitempty"` // Copy of a file artifact, relative to the town root
+	Bytes       int64     `json:"bytes,omitempty"`
+	CreatedBy   string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Dir returns the directory holding the registry.
+func Dir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "artifacts")
+}
+
+// ID returns the stable ID of the artifact of kind with ref on wisp.
+func ID(wisp, kind, ref string) string {
+	sum := sha256.Sum256([]byte(wisp + "\x00" + kind + "\x00" + ref))
+	return "art-" + hex.EncodeToString(sum[:])[:10]
+}
+
+// ValidKind reports whether kind is a known artifact kind.
+func ValidKind(kind string) bool {
+	for _, k := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// IsURL reports whether ref points outside the town (http, https, file URLs)
+// rather than at a local file.
+func IsURL(ref string) bool {
+	return strings.Contains(ref, "://")
+}
+
+// Register records a on its wisp, filling ID and times, and returns the
+// stored record. Registering an existing artifact again updates its
+// description and stored copy and keeps its ID and creation time; created
+// reports whether the artifact is new. A file artifact's Ref must be an
+// absolute path to an existing file or a URL.
+func Register(townRoot string, a Artifact) (stored *Artifact, created bool, err error) {
+	if a.Wisp == "" {
+		return nil, false, errors.New("artifact has no wisp")
+	}
+	if !ValidWispID(a.Wisp) {
+		return nil, false, fmt.Errorf("invalid wisp ID %q", a.Wisp)
+	}
+	if !ValidKind(a.Kind) {
+		return nil, false, fmt.Errorf("unknown artifact kind %q (want %s)", a.Kind, strings.Join(Kinds, ", "))
+	}
+	if a.Ref == "" {
+		return nil, false, errors.New("artifact has no ref")
+	}
+	a.ID = ID(a.Wisp, a.Kind, a.Ref)
+
+	if a.Kind != KindBranch && !IsURL(a.Ref) {
+		if !filepath.IsAbs(a.Ref) {
+			return nil, false, fmt.Errorf("artifact path %q is not absolute", a.Ref)
+		}
+		rel, n, err := storeCopy(townRoot, a)
+		if err != nil {
+			return nil, false, err
+		}
+		a.Stored, a.Bytes = rel, n
+	}
+
+	err = update(townRoot, a.Wisp, func(list []Artifact) ([]Artifact, error) {
+		now := time.Now().UTC()
+		for i := range list {
+			if list[i].ID != a.ID {
+				continue
+			}
+			if a.Description != "" {
+				list[i].Description = a.Description
+			}
+			if a.Rig != "" {
+				list[i].Rig = a.Rig
+			}
+			if a.Stored != "" {
+				list[i].Stored, list[i].Bytes = a.Stored, a.Bytes
+			}
+			list[i].UpdatedAt = now
+			stored = &list[i]
+			return list, nil
+		}
+		a.CreatedAt, a.UpdatedAt = now, now
+		created = true
+		list = append(list, a)
+		stored = &list[len(list)-1]
+		return list, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	cp := *stored
+	return &cp, created, nil
+}
+
+// storeCopy copies a file artifact into the registry and returns its path
+// relative to the town root and its size.
+func storeCopy(townRoot string, a Artifact) (string, int64, error) {
+	src, err := os.Open(a.Ref)
+	if err != nil {
+		return "", 0, fmt.Errorf("reading artifact: %w", err)
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return "", 0, fmt.Errorf("reading artifact: %w", err)
+	}
+	if !info.Mode().IsRegular() {
+		return "", 0, fmt.Errorf("artifact %s is not a regular file", a.Ref)
+	}
+
+	dir, err := wispPath(townRoot, a.Wisp, a.Wisp)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", 0, fmt.Errorf("creating artifact dir: %w", err)
+	}
+	dst := filepath.Join(dir, a.ID+filepath.Ext(a.Ref))
+	tmp := dst + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return "", 0, fmt.Errorf("storing artifact: %w", err)
+	}
+	n, err := io.Copy(out, src)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, dst)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return "", 0, fmt.Errorf("storing artifact: %w", err)
+	}
+	rel, err := filepath.Rel(townRoot, dst)
+	if err != nil {
+		return "", 0, err
+	}
+	return rel, n, nil
+}
+
+// List returns the artifacts registered on wisp, oldest first.
+func List(townRoot, wisp string) ([]Artifact, error) {
+	path, err := recordPath(townRoot, wisp)
+	if err != nil {
+		return nil, err
+	}
+	list, err := read(path)
+	if err != nil {
+		return nil, err
+	}
+	sortByCreation(list)
+	return list, nil
+}
+
+// ListAll returns every registered artifact, grouped by wisp in wisp order
+// and oldest first within a wisp.
+func ListAll(townRoot string) ([]Artifact, error) {
+	wisps, err := Wisps(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	var all []Artifact
+	for _, w := range wisps {
+		list, err := List(townRoot, w)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list...)
+	}
+	return all, nil
+}
+
+// Wisps returns the wisps that have registered artifacts, sorted.
+func Wisps(townRoot string) ([]string, error) {
+	entries, err := os.ReadDir(Dir(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading artifact registry: %w", err)
+	}
+	var wisps []string
+	for _, e := range entries {
+		if name, ok := strings.CutSuffix(e.Name(), ".json"); ok && !e.IsDir() && ValidWispID(name) {
+			wisps = append(wisps, name)
+		}
+	}
+	sort.Strings(wisps)
+	return wisps, nil
+}
+
+// Get returns the artifact with id.
+func Get(townRoot, id string) (*Artifact, error) {
+	all, err := ListAll(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		if all[i].ID == id {
+			return &all[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s: %w", id, ErrNotFound)
+}
+
+// Latest returns the most recently registered artifact of kind on wisp, or
+// nil if there is none.
+func Latest(townRoot, wisp, kind string) (*Artifact, error) {
+	list, err := List(townRoot, wisp)
+	if err != nil {
+		return nil, err
+	}
+	var latest *Artifact
+	for i := range list {
+		if list[i].Kind == kind && (latest == nil || !list[i].UpdatedAt.Before(latest.UpdatedAt)) {
+			latest = &list[i]
+		}
+	}
+	return latest, nil
+}
+
+// FindByRef returns the most recently registered artifact of kind with ref
+// on any wisp of rigName, or nil if there is none. It answers "which wisp
+// is this branch for?". Branch names are only unique within a rig, so
+// artifacts registered for another rig never match; an empty rigName
+// matches every rig.
+func FindByRef(townRoot, rigName, kind, ref string) (*Artifact, error) {
+	all, err := ListAll(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	var found *Artifact
+	for i := range all {
+		a := &all[i]
+		if rigName != "" && a.Rig != rigName {
+			continue
+		}
+		if a.Kind == kind && a.Ref == ref && (found == nil || a.UpdatedAt.After(found.UpdatedAt)) {
+			found = a
+		}
+	}
+	return found, nil
+}
+
+// Remove deletes the artifact with id and its stored copy.
+func Remove(townRoot, id string) (*Artifact, error) {
+	a, err := Get(townRoot, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := removeFrom(townRoot, a.Wisp, map[string]bool{id: true}); err != nil {
+		return nil, err
+	}
+	if a.Stored != "" {
+		_ = os.Remove(filepath.Join(townRoot, a.Stored))
+	}
+	return a, nil
+}
+
+// removeFrom drops the artifacts with the given IDs from wisp's record.
+func removeFrom(townRoot, wisp string, ids map[string]bool) error {
+	return update(townRoot, wisp, func(list []Artifact) ([]Artifact, error) {
+		kept := list[:0]
+		for _, x := range list {
+			if !ids[x.ID] {
+				kept = append(kept, x)
+			}
+		}
+		return kept, nil
+	})
+}
+
+// Policy is how long each kind of artifact is kept after its wisp closes.
+// A zero duration keeps that kind forever.
+type Policy map[string]time.Duration
+
+// NewPolicy builds a retention policy from town settings; nil uses the
+// defaults.
+func NewPolicy(cfg *config.ArtifactsConfig) (Policy, error) {
+	p := Policy{
+		KindBranch: DefaultBranchRetention,
+		KindPatch:  DefaultBranchRetention,
+		KindReport: DefaultFileRetention,
+		KindDoc:    DefaultFileRetention,
+	}
+	if cfg == nil {
+		return p, nil
+	}
+	for kind, age := range cfg.Retention {
+		if !ValidKind(kind) {
+			return nil, fmt.Errorf("artifacts.retention: unknown kind %q (want %s)", kind, strings.Join(Kinds, ", "))
+		}
+		d, err := archive.ParseAge(age)
+		if err != nil {
+			return nil, fmt.Errorf("artifacts.retention.%s: %w", kind, err)
+		}
+		p[kind] = d
+	}
+	return p, nil
+}
+
+// LoadPolicy reads the retention policy from town settings.
+func LoadPolicy(townRoot string) (Policy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return NewPolicy(settings.Artifacts)
+}
+
+// Expired reports whether a, whose wisp closed at closedAt, is past its
+// retention at now.
+func (p Policy) Expired(a Artifact, closedAt, now time.Time) bool {
+	keep := p[a.Kind]
+	if keep <= 0 {
+		return false
+	}
+	return now.Sub(closedAt) >= keep
+}
+
+// ClosedFunc reports when wisp closed; ok is false while it is open or
+// when its state is unknown.
+type ClosedFunc func(wisp string) (closedAt time.Time, ok bool)
+
+// Prune removes artifacts whose wisp closed longer ago than their
+// retention, and returns them. Artifacts of open wisps are never pruned.
+// With dryRun nothing is removed.
+func Prune(townRoot string, p Policy, closed ClosedFunc, now time.Time, dryRun bool) ([]Artifact, error) {
+	wisps, err := Wisps(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	var pruned []Artifact
+	for _, w := range wisps {
+		closedAt, ok := closed(w)
+		if !ok {
+			continue
+		}
+		list, err := List(townRoot, w)
+		if err != nil {
+			return pruned, err
+		}
+		expired := map[string]bool{}
+		for _, a := range list {
+			if p.Expired(a, closedAt, now) {
+				expired[a.ID] = true
+				pruned = append(pruned, a)
+			}
+		}
+		if dryRun || len(expired) == 0 {
+			continue
+		}
+		// One rewrite per wisp, not one registry scan per artifact.
+		if err := removeFrom(townRoot, w, expired); err != nil {
+			return pruned, err
+		}
+		for _, a := range list {
+			if expired[a.ID] && a.Stored != "" {
+				_ = os.Remove(filepath.Join(townRoot, a.Stored))
+			}
+		}
+		removeIfEmpty(townRoot, w)
+	}
+	return pruned, nil
+}
+
+// removeIfEmpty deletes a wisp's record file and copy directory once it has
+// no artifacts left.
+func removeIfEmpty(townRoot, wisp string) {
+	list, err := List(townRoot, wisp)
+	if err != nil || len(list) > 0 {
+		return
+	}
+	if path, err := recordPath(townRoot, wisp); err == nil {
+		_ = os.Remove(path)
+	}
+	if dir, err := wispPath(townRoot, wisp, wisp); err == nil {
+		_ = os.Remove(dir) // Only succeeds when empty
+	}
+}
+
+func recordPath(townRoot, wisp string) (string, error) {
+	return wispPath(townRoot, wisp, wisp+".json")
+}
+
+func read(path string) ([]Artifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", filepath.Base(path), err)
+	}
+	var list []Artifact
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filepath.Base(path), err)
+	}
+	return list, nil
+}
+
+// update rewrites wisp's record under its lock.
+func update(townRoot, wisp string, fn func([]Artifact) ([]Artifact, error)) error {
+	path, err := recordPath(townRoot, wisp)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(Dir(townRoot), 0755); err != nil {
+		return fmt.Errorf("creating artifact registry: %w", err)
+	}
+	unlock, err := lock.FlockAcquire(path + ".lock")
+	if err != nil {
+		return fmt.Errorf("locking artifact registry: %w", err)
+	}
+	defer unlock()
+
+	list, err := read(path)
+	if err != nil {
+		return err
+	}
+	if list, err = fn(list); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	return util.AtomicWriteFile(path, data, 0644)
+}
+
+func sortByCreation(list []Artifact) {
+	sort.SliceStable(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+}
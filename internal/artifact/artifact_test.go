@@ -0,0 +1,196 @@
+package artifact
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestRegisterIsIdempotent(t *testing.T) {
+	town := t.TempDir()
+	a, created, err := Register(town, Artifact{Wisp: "gt-abc", Kind: KindBranch, Ref: "polecat/Toast", CreatedBy: "gastown/polecats/Toast"})
+	if err != nil || !created {
+		t.Fatalf("Register = %v, created=%v", err, created)
+	}
+	if a.ID != ID("gt-abc", KindBranch, "polecat/Toast") {
+		t.Errorf("ID = %q, want the stable ID", a.ID)
+	}
+
+	again, created, err := Register(town, Artifact{Wisp: "gt-abc", Kind: KindBranch, Ref: "polecat/Toast", Description: "rebased"})
+	if err != nil || created {
+		t.Fatalf("second Register = %v, created=%v", err, created)
+	}
+	if again.ID != a.ID || !again.CreatedAt.Equal(a.CreatedAt) || again.Description != "rebased" || again.CreatedBy != a.CreatedBy {
+		t.Errorf("second Register = %+v, want update of %+v", again, a)
+	}
+
+	list, err := List(town, "gt-abc")
+	if err != nil || len(list) != 1 {
+		t.Fatalf("List = %+v, %v; want one artifact", list, err)
+	}
+}
+
+func TestRegisterValidates(t *testing.T) {
+	town := t.TempDir()
+	for _, a := range []Artifact{
+		{Kind: KindBranch, Ref: "b"},
+		{Wisp: "gt-abc", Kind: "tarball", Ref: "x"},
+		{Wisp: "gt-abc", Kind: KindBranch},
+		{Wisp: "gt-abc", Kind: KindPatch, Ref: "relative.patch"},
+		{Wisp: "gt-abc", Kind: KindPatch, Ref: filepath.Join(town, "missing.patch")},
+		{Wisp: "../escape", Kind: KindBranch, Ref: "b"},
+	} {
+		if _, _, err := Register(town, a); err == nil {
+			t.Errorf("Register(%+v) succeeded, want error", a)
+		}
+	}
+}
+
+func TestRegisterRejectsTraversal(t *testing.T) {
+	town := t.TempDir()
+	src := filepath.Join(t.TempDir(), "fix.patch")
+	if err := os.WriteFile(src, []byte("diff\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, wisp := range []string{"../../x", "gt-abc/../../x", "..", "gt-abc/.."} {
+		if _, _, err := Register(town, Artifact{Wisp: wisp, Kind: KindPatch, Ref: src}); err == nil {
+			t.Errorf("Register(wisp=%q) succeeded, want error", wisp)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(town, "x")); !os.IsNotExist(err) {
+		t.Errorf("artifact written outside the registry: %v", err)
+	}
+	if _, err := List(town, "../../x"); err == nil {
+		t.Error("List accepted a traversing wisp ID")
+	}
+}
+
+func TestFileArtifactIsCopied(t *testing.T) {
+	town := t.TempDir()
+	src := filepath.Join(t.TempDir(), "fix.patch")
+	if err := os.WriteFile(src, []byte("diff --git a b\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	a, _, err := Register(town, Artifact{Wisp: "gt-abc", Kind: KindPatch, Ref: src})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Stored == "" || a.Bytes != 15 {
+		t.Fatalf("Stored = %q, Bytes = %d", a.Stored, a.Bytes)
+	}
+	if err := os.Remove(src); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(town, a.Stored))
+	if err != nil || string(data) != "diff --git a b\n" {
+		t.Fatalf("stored copy = %q, %v", data, err)
+	}
+
+	if _, err := Remove(town, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(town, a.Stored)); !os.IsNotExist(err) {
+		t.Errorf("stored copy still present after Remove: %v", err)
+	}
+	if _, err := Get(town, a.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Remove = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLookups(t *testing.T) {
+	town := t.TempDir()
+	mustRegister := func(a Artifact) {
+		t.Helper()
+		if _, _, err := Register(town, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustRegister(Artifact{Wisp: "gt-abc", Kind: KindBranch, Ref: "polecat/Toast-1"})
+	mustRegister(Artifact{Wisp: "gt-abc", Kind: KindReport, Ref: "https://ci.example/run/1"})
+	mustRegister(Artifact{Wisp: "gt-abc", Kind: KindBranch, Ref: "polecat/Toast-2"})
+	mustRegister(Artifact{Wisp: "gt-def", Kind: KindBranch, Ref: "polecat/Nux-1", Rig: "gastown"})
+
+	if a, err := Latest(town, "gt-abc", KindBranch); err != nil || a == nil || a.Ref != "polecat/Toast-2" {
+		t.Errorf("Latest = %+v, %v; want polecat/Toast-2", a, err)
+	}
+	if a, err := Latest(town, "gt-def", KindPatch); err != nil || a != nil {
+		t.Errorf("Latest patch = %+v, %v; want none", a, err)
+	}
+	if a, err := FindByRef(town, "gastown", KindBranch, "polecat/Nux-1"); err != nil || a == nil || a.Wisp != "gt-def" {
+		t.Errorf("FindByRef = %+v, %v; want gt-def", a, err)
+	}
+	if a, err := FindByRef(town, "beads", KindBranch, "polecat/Nux-1"); err != nil || a != nil {
+		t.Errorf("FindByRef in another rig = %+v, %v; want none", a, err)
+	}
+	if a, err := FindByRef(town, "", KindBranch, "polecat/unknown"); err != nil || a != nil {
+		t.Errorf("FindByRef unknown = %+v, %v; want none", a, err)
+	}
+	all, err := ListAll(town)
+	if err != nil || len(all) != 4 || all[0].Wisp != "gt-abc" || all[3].Wisp != "gt-def" {
+		t.Errorf("ListAll = %+v, %v", all, err)
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	p, err := NewPolicy(&config.ArtifactsConfig{Retention: map[string]string{"report": "7d", "doc": "0"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p[KindBranch] != DefaultBranchRetention || p[KindReport] != 7*24*time.Hour || p[KindDoc] != 0 {
+		t.Errorf("policy = %v", p)
+	}
+	for _, bad := range []map[string]string{{"tarball": "1d"}, {"report": "soon"}} {
+		if _, err := NewPolicy(&config.ArtifactsConfig{Retention: bad}); err == nil {
+			t.Errorf("NewPolicy(%v) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	town := t.TempDir()
+	for _, a := range []Artifact{
+		{Wisp: "gt-open", Kind: KindBranch, Ref: "polecat/a"},
+		{Wisp: "gt-closed", Kind: KindBranch, Ref: "polecat/b"},
+		{Wisp: "gt-closed", Kind: KindReport, Ref: "https://ci.example/run/2"},
+	} {
+		if _, _, err := Register(town, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now().UTC()
+	closed := func(wisp string) (time.Time, bool) {
+		if wisp == "gt-closed" {
+			return now.Add(-40 * 24 * time.Hour), true
+		}
+		return time.Time{}, false
+	}
+	p, _ := NewPolicy(nil)
+
+	pruned, err := Prune(town, p, closed, now, true)
+	if err != nil || len(pruned) != 1 || pruned[0].Kind != KindBranch || pruned[0].Wisp != "gt-closed" {
+		t.Fatalf("dry-run Prune = %+v, %v; want the closed wisp's branch", pruned, err)
+	}
+	if all, _ := ListAll(town); len(all) != 3 {
+		t.Fatalf("dry run removed artifacts: %+v", all)
+	}
+
+	if _, err := Prune(town, p, closed, now, false); err != nil {
+		t.Fatal(err)
+	}
+	all, _ := ListAll(town)
+	if len(all) != 2 {
+		t.Fatalf("after Prune = %+v, want open branch and closed report", all)
+	}
+
+	p[KindReport] = 24 * time.Hour
+	if _, err := Prune(town, p, closed, now, false); err != nil {
+		t.Fatal(err)
+	}
+	if wisps, _ := Wisps(town); len(wisps) != 1 || wisps[0] != "gt-open" {
+		t.Errorf("Wisps after pruning everything closed = %v, want [gt-open]", wisps)
+	}
+}
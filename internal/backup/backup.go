@@ -0,0 +1,499 @@
+// Package backup creates and restores point-in-time snapshots of a town.
+//
+// A snapshot is a gzipped tarball holding town and rig configuration, the
+// event and feed history, legacy JSONL mailboxes, and a copy of every Dolt
+// beads database (which also holds bead-backed mail). Each snapshot carries a
+// manifest, also written next to the archive so listing is cheap.
+//
+// Files guarded by a sibling ".lock" (the gt convention for append-only
+// ledgers) are copied under that lock so a snapshot never captures a torn
+// write. Databases are copied with `dolt backup sync-url`, which is safe
+// against a running server.
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/doltserver"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/feed"
+)
+
+// Snapshot components.
+const (
+	ComponentConfig  = "config"  // Town and rig configuration
+	ComponentHistory = "history" // Event and feed logs, approvals, experiments
+	ComponentMail    = "mail"    // Legacy JSONL mailboxes
+	ComponentBeads   = "beads"   // Dolt beads databases
+)
+
+// AllComponents is the default component set.
+var AllComponents = []string{ComponentConfig, ComponentHistory, ComponentMail, ComponentBeads}
+
+// Triggers recorded in the manifest.
+const (
+	TriggerManual     = "manual"
+	TriggerScheduled  = "scheduled"
+	TriggerPreRestore = "pre-restore"
+)
+
+// DefaultRetain is how many snapshots Prune keeps when not configured.
+const DefaultRetain = 14
+
+const (
+	archiveExt   = ".tar.gz"
+	manifestName = "manifest.json"
+	filesPrefix  = "files/"
+	dbPrefix     = "dolt/"
+)
+
+// ErrNotFound is returned when a snapshot does not exist.
+var ErrNotFound = errors.New("backup not found")
+
+// Manifest describes one snapshot.
+type Manifest struct {
+	ID         string    `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	Trigger    string    `json:"trigger"`
+	Components []string  `json:"components"`
+	Files      int       `json:"files"`
+	Databases  []string  `json:"databases,omitempty"`
+	Bytes      int64     `json:"bytes,omitempty"` // Archive size, filled in after writing
+	Errors     []string  `json:"errors,omitempty"`
+}
+
+// OK reports whether the snapshot completed without errors.
+func (m *Manifest) OK() bool { return len(m.Errors) == 0 }
+
+// Has reports whether the snapshot includes component.
+func (m *Manifest) Has(component string) bool {
+	for _, c := range m.Components {
+		if c == component {
+			return true
+		}
+	}
+	return false
+}
+
+// Options control Create.
+type Options struct {
+	Dir        string   // Snapshot directory (default: DefaultDir)
+	Components []string // Components to include (default: AllComponents)
+	Trigger    string   // Recorded in the manifest (default: TriggerManual)
+}
+
+// DefaultDir returns the default snapshot directory for a town.
+func DefaultDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "backups")
+}
+
+// ArchivePath returns the archive path for a snapshot ID.
+func ArchivePath(dir, id string) string {
+	return filepath.Join(dir, id+archiveExt)
+}
+
+func manifestPath(dir, id string) string {
+	return filepath.Join(dir, id+".json")
+}
+
+// syncDatabase copies the Dolt database in dbDir to the file:// URL dest.
+// A variable so tests can run without dolt.
+var syncDatabase = func(dbDir, dest string) error {
+	cmd := exec.Command("dolt", "backup", "sync-url", "file://"+dest) //nolint:gosec // G204: dest is constructed internally
+	cmd.Dir = dbDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// Create writes a new snapshot. Problems with individual files or databases
+// are recorded in the manifest rather than failing the whole snapshot; the
+// returned error covers only failures to produce an archive at all.
+func Create(townRoot string, opts Options) (*Manifest, error) {
+	if opts.Dir == "" {
+		opts.Dir = DefaultDir(townRoot)
+	}
+	if len(opts.Components) == 0 {
+		opts.Components = AllComponents
+	}
+	if opts.Trigger == "" {
+		opts.Trigger = TriggerManual
+	}
+	if err := os.MkdirAll(opts.Dir, 0700); err != nil {
+		return nil, fmt.Errorf("creating backup directory: %w", err)
+	}
+
+	// One snapshot at a time per directory.
+	fl := flock.New(filepath.Join(opts.Dir, ".lock"))
+	if err := fl.Lock(); err != nil {
+		return nil, fmt.Errorf("acquiring backup lock: %w", err)
+	}
+	defer fl.Unlock() //nolint:errcheck // best-effort unlock
+
+	now := time.Now().UTC()
+	m := &Manifest{
+		ID:         now.Format("20060102-150405"),
+		CreatedAt:  now,
+		Trigger:    opts.Trigger,
+		Components: opts.Components,
+	}
+	if _, err := os.Stat(ArchivePath(opts.Dir, m.ID)); err == nil {
+		m.ID += fmt.Sprintf("-%03d", now.Nanosecond()/int(time.Millisecond))
+	}
+
+	archive := ArchivePath(opts.Dir, m.ID)
+	tmp := archive + ".partial"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil, fmt.Errorf("creating archive: %w", err)
+	}
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	err = writeSnapshot(townRoot, tw, m)
+	if cerr := tw.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := gz.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return nil, fmt.Errorf("writing archive: %w", err)
+	}
+	if err := os.Rename(tmp, archive); err != nil {
+		_ = os.Remove(tmp)
+		return nil, fmt.Errorf("finalizing archive: %w", err)
+	}
+	if info, err := os.Stat(archive); err == nil {
+		m.Bytes = info.Size()
+	}
+	if err := writeManifest(opts.Dir, m); err != nil {
+		return m, err
+	}
+	return m, nil
+}
+
+func writeSnapshot(townRoot string, tw *tar.Writer, m *Manifest) error {
+	for _, component := range m.Components {
+		for _, rel := range collectFiles(townRoot, component) {
+			if err := addFile(tw, townRoot, component, rel); err != nil {
+				m.Errors = append(m.Errors, fmt.Sprintf("%s: %v", rel, err))
+				continue
+			}
+			m.Files++
+		}
+	}
+
+	if m.Has(ComponentBeads) {
+		if err := addDatabases(townRoot, tw, m); err != nil {
+			return err
+		}
+	}
+
+	// Manifest goes last so it reflects everything above.
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeTarEntry(tw, manifestName, data, 0644, m.CreatedAt)
+}
+
+// ConfigFiles returns the town-relative paths of the town and rig
+// configuration files a snapshot's config component covers.
+func ConfigFiles(townRoot string) []string {
+	return collectFiles(townRoot, ComponentConfig)
+}
+
+// collectFiles returns the town-relative paths a component covers, sorted.
+func collectFiles(townRoot, component string) []string {
+	seen := map[string]bool{}
+	var out []string
+	add := func(rel string) {
+		rel = filepath.ToSlash(rel)
+		if !seen[rel] {
+			seen[rel] = true
+			out = append(out, rel)
+		}
+	}
+	glob := func(pattern string) {
+		matches, _ := filepath.Glob(filepath.Join(townRoot, filepath.FromSlash(pattern)))
+		for _, p := range matches {
+			if info, err := os.Stat(p); err == nil && info.Mode().IsRegular() {
+				if rel, err := filepath.Rel(townRoot, p); err == nil {
+					add(rel)
+				}
+			}
+		}
+	}
+	tree := func(relDir string) {
+		root := filepath.Join(townRoot, filepath.FromSlash(relDir))
+		_ = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return nil //nolint:nilerr // missing trees are simply skipped
+			}
+			if d.Type().IsRegular() && !strings.HasSuffix(p, ".lock") {
+				if rel, err := filepath.Rel(townRoot, p); err == nil {
+					add(rel)
+				}
+			}
+			return nil
+		})
+	}
+
+	rigs := registeredRigs(townRoot)
+	switch component {
+	case ComponentConfig:
+		glob(constants.DirMayor + "/*.json")
+		glob(constants.DirMayor + "/*.md")
+		glob("*.md")
+		tree(constants.DirSettings)
+		tree("config")
+		glob(constants.DirBeads + "/routes.jsonl")
+		glob(constants.DirBeads + "/config.yaml")
+		for _, rig := range rigs {
+			glob(rig + "/" + constants.FileConfigJSON)
+			tree(rig + "/" + constants.DirSettings)
+		}
+	case ComponentHistory:
+		glob(events.EventsFile)
+		glob(feed.FeedFile)
+		glob("logs/*.log")
+		tree(constants.DirRuntime + "/approvals")
+		tree(constants.DirRuntime + "/experiments")
+	case ComponentMail:
+		glob(constants.DirBeads + "/*/inbox.jsonl")
+		glob(constants.DirBeads + "/archive.jsonl")
+		for _, rig := range rigs {
+			glob(rig + "/" + constants.DirMayor + "/" + constants.DirRig + "/" + constants.DirBeads + "/archive.jsonl")
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func registeredRigs(townRoot string) []string {
+	cfg, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(cfg.Rigs))
+	for name := range cfg.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// addFile copies one file into the archive under its component, holding its
+// ledger lock if any.
+func addFile(tw *tar.Writer, townRoot, component, rel string) error {
+	path := filepath.Join(townRoot, filepath.FromSlash(rel))
+	if _, err := os.Stat(path + ".lock"); err == nil {
+		fl := flock.New(path + ".lock")
+		if err := fl.RLock(); err == nil {
+			defer fl.Unlock() //nolint:errcheck // best-effort unlock
+		}
+	}
+	return copyTarEntry(tw, filesPrefix+component+"/"+rel, path)
+}
+
+// addDatabases syncs each Dolt database to a staging directory and archives it.
+func addDatabases(townRoot string, tw *tar.Writer, m *Manifest) error {
+	dataDir := doltserver.DefaultConfig(townRoot).DataDir
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			m.Errors = append(m.Errors, fmt.Sprintf("dolt data dir: %v", err))
+		}
+		return nil
+	}
+
+	staging, err := os.MkdirTemp("", "gt-backup-")
+	if err != nil {
+		return fmt.Errorf("creating staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	for _, e := range entries {
+		name := e.Name()
+		if !e.IsDir() || doltserver.IsSystemDatabase(name) {
+			continue
+		}
+		dbDir := filepath.Join(dataDir, name)
+		if _, err := os.Stat(filepath.Join(dbDir, ".dolt")); err != nil {
+			continue
+		}
+		dest := filepath.Join(staging, name)
+		if err := syncDatabase(dbDir, dest); err != nil {
+			m.Errors = append(m.Errors, fmt.Sprintf("database %s: %v", name, err))
+			continue
+		}
+		if err := addTree(tw, dest, dbPrefix+name); err != nil {
+			return err
+		}
+		m.Databases = append(m.Databases, name)
+	}
+	return nil
+}
+
+func addTree(tw *tar.Writer, root, prefix string) error {
+	return filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		return copyTarEntry(tw, prefix+"/"+filepath.ToSlash(rel), p)
+	})
+}
+
+// copyTarEntry streams a file into the archive, so large Dolt table files
+// are never held in memory whole.
+func copyTarEntry(tw *tar.Writer, name, path string) error {
+	f, err := os.Open(path) //nolint:gosec // G304: path is a town location or inside our staging directory
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	hdr := &tar.Header{Name: name, Mode: int64(info.Mode().Perm()), Size: info.Size(), ModTime: info.ModTime(), Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(tw, f, info.Size()); err != nil {
+		return fmt.Errorf("archiving %s: %w", path, err)
+	}
+	return nil
+}
+
+func writeTarEntry(tw *tar.Writer, name string, data []byte, mode int64, mtime time.Time) error {
+	hdr := &tar.Header{Name: name, Mode: mode, Size: int64(len(data)), ModTime: mtime, Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+func writeManifest(dir string, m *Manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding manifest: %w", err)
+	}
+	if err := os.WriteFile(manifestPath(dir, m.ID), data, 0600); err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+	return nil
+}
+
+// List returns the snapshots in dir, newest first.
+func List(dir string) ([]*Manifest, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading backup directory: %w", err)
+	}
+	var out []*Manifest
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		m, err := Load(dir, strings.TrimSuffix(name, ".json"))
+		if err != nil {
+			continue
+		}
+		out = append(out, m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	return out, nil
+}
+
+// Load reads a snapshot's manifest. A manifest whose archive is gone is
+// reported as not found.
+func Load(dir, id string) (*Manifest, error) {
+	data, err := os.ReadFile(manifestPath(dir, id)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+		}
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("parsing manifest %s: %w", id, err)
+	}
+	if _, err := os.Stat(ArchivePath(dir, id)); err != nil {
+		return nil, fmt.Errorf("%w: %s (archive missing)", ErrNotFound, id)
+	}
+	return &m, nil
+}
+
+// LastGood returns the newest snapshot that completed without errors, or nil.
+func LastGood(dir string) (*Manifest, error) {
+	all, err := List(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range all {
+		if m.OK() {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+// Prune deletes the oldest snapshots beyond retain, always keeping the newest
+// good one. Returns the IDs removed.
+func Prune(dir string, retain int) ([]string, error) {
+	if retain <= 0 {
+		retain = DefaultRetain
+	}
+	all, err := List(dir)
+	if err != nil {
+		return nil, err
+	}
+	good, _ := LastGood(dir)
+	var removed []string
+	for i, m := range all {
+		if i < retain || (good != nil && m.ID == good.ID) {
+			continue
+		}
+		if err := os.Remove(ArchivePath(dir, m.ID)); err != nil && !os.IsNotExist(err) {
+			return removed, fmt.Errorf("removing %s: %w", m.ID, err)
+		}
+		_ = os.Remove(manifestPath(dir, m.ID))
+		removed = append(removed, m.ID)
+	}
+	return removed, nil
+}
@@ -0,0 +1,194 @@
+package backup
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTownFile(t *testing.T, root, rel, content string) {
+	t.Helper()
+	path := filepath.Join(root, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTownFile(t *testing.T, root, rel string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+	if err != nil {
+		t.Fatalf("reading %s: %v", rel, err)
+	}
+	return string(data)
+}
+
+func newTown(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	writeTownFile(t, root, "mayor/town.json", `{"type":"town","name":"test"}`)
+	writeTownFile(t, root, "mayor/rigs.json", `{"version":1,"rigs":{"gastown":{}}}`)
+	writeTownFile(t, root, "settings/config.json", `{"type":"town-settings"}`)
+	writeTownFile(t, root, "gastown/config.json", `{"type":"rig","name":"gastown"}`)
+	writeTownFile(t, root, "gastown/settings/config.json", `{"type":"rig-settings"}`)
+	writeTownFile(t, root, "unregistered/config.json", `{"type":"rig"}`)
+	writeTownFile(t, root, ".events.jsonl", `{"type":"sling"}`+"\n")
+	writeTownFile(t, root, ".events.jsonl.lock", "")
+	writeTownFile(t, root, ".beads/archive.jsonl", `{"id":"m1"}`+"\n")
+	return root
+}
+
+func TestCreateAndList(t *testing.T) {
+	root := newTown(t)
+	dir := t.TempDir()
+
+	m, err := Create(root, Options{Dir: dir, Components: []string{ComponentConfig, ComponentHistory, ComponentMail}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.OK() || m.Files != 7 || m.Trigger != TriggerManual || m.Bytes == 0 {
+		t.Errorf("manifest = %+v, want 7 files and no errors", m)
+	}
+
+	all, err := List(dir)
+	if err != nil || len(all) != 1 || all[0].ID != m.ID {
+		t.Fatalf("List() = %+v, %v", all, err)
+	}
+	if _, err := Load(dir, "nope"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Load(missing) = %v, want ErrNotFound", err)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	root := newTown(t)
+	dir := t.TempDir()
+	m, err := Create(root, Options{Dir: dir, Components: []string{ComponentConfig, ComponentHistory}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Lose one file, change another.
+	if err := os.Remove(filepath.Join(root, "gastown", "settings", "config.json")); err != nil {
+		t.Fatal(err)
+	}
+	writeTownFile(t, root, "settings/config.json", `{"changed":true}`)
+
+	res, err := Restore(root, m.ID, RestoreOptions{Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Restored != 1 || res.SafetyID != "" {
+		t.Errorf("restore = %+v, want only the missing file restored", res)
+	}
+	if got := readTownFile(t, root, "gastown/settings/config.json"); got != `{"type":"rig-settings"}` {
+		t.Errorf("restored rig settings = %q", got)
+	}
+	if got := readTownFile(t, root, "settings/config.json"); got != `{"changed":true}` {
+		t.Errorf("existing file overwritten without force: %q", got)
+	}
+
+	res, err = Restore(root, m.ID, RestoreOptions{Dir: dir, Force: true, Components: []string{ComponentConfig}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.SafetyID == "" {
+		t.Error("forced restore should take a pre-restore snapshot")
+	}
+	if got := readTownFile(t, root, "settings/config.json"); got != `{"type":"town-settings"}` {
+		t.Errorf("forced restore left %q", got)
+	}
+	safety, err := Load(dir, res.SafetyID)
+	if err != nil || safety.Trigger != TriggerPreRestore || safety.Has(ComponentHistory) {
+		t.Errorf("safety snapshot = %+v, %v", safety, err)
+	}
+
+	if _, err := Restore(root, m.ID, RestoreOptions{Dir: dir, Components: []string{ComponentMail}}); err == nil {
+		t.Error("restoring a component the snapshot lacks should fail")
+	}
+}
+
+func TestBeadsDatabases(t *testing.T) {
+	root := newTown(t)
+	dir := t.TempDir()
+	writeTownFile(t, root, ".dolt-data/hq/.dolt/manifest", "v1")
+
+	origSync, origRestore, origRunning := syncDatabase, restoreDatabase, doltRunning
+	t.Cleanup(func() { syncDatabase, restoreDatabase, doltRunning = origSync, origRestore, origRunning })
+	syncDatabase = func(dbDir, dest string) error {
+		writeTownFile(t, dest, "backup.dat", readTownFile(t, dbDir, ".dolt/manifest"))
+		return nil
+	}
+	var restored []string
+	restoreDatabase = func(src, dataDir, name string) error {
+		restored = append(restored, name+"="+readTownFile(t, src, "backup.dat"))
+		return nil
+	}
+	running := true
+	doltRunning = func(string) bool { return running }
+
+	m, err := Create(root, Options{Dir: dir, Components: []string{ComponentBeads}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Databases) != 1 || m.Databases[0] != "hq" {
+		t.Fatalf("databases = %v", m.Databases)
+	}
+
+	if _, err := Restore(root, m.ID, RestoreOptions{Dir: dir}); !errors.Is(err, ErrDoltRunning) {
+		t.Errorf("restore with server running = %v, want ErrDoltRunning", err)
+	}
+	running = false
+
+	res, err := Restore(root, m.ID, RestoreOptions{Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restored) != 0 || len(res.Skipped) != 1 {
+		t.Errorf("existing database should be skipped without force: restored %v, result %+v", restored, res)
+	}
+
+	if _, err := Restore(root, m.ID, RestoreOptions{Dir: dir, Force: true}); err != nil {
+		t.Fatal(err)
+	}
+	if len(restored) != 1 || restored[0] != "hq=v1" {
+		t.Errorf("restored = %v", restored)
+	}
+	matches, _ := filepath.Glob(filepath.Join(root, ".dolt-data", "hq.pre-restore-*"))
+	if len(matches) != 1 {
+		t.Errorf("existing database should be moved aside, found %v", matches)
+	}
+}
+
+func TestPruneKeepsNewestGood(t *testing.T) {
+	dir := t.TempDir()
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i, errs := range [][]string{nil, {"boom"}, {"boom"}} {
+		m := &Manifest{ID: base.Add(time.Duration(i) * time.Hour).Format("20060102-150405"), CreatedAt: base.Add(time.Duration(i) * time.Hour), Errors: errs}
+		if err := writeManifest(dir, m); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(ArchivePath(dir, m.ID), []byte("x"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	good, err := LastGood(dir)
+	if err != nil || good == nil || good.ID != "20260301-000000" {
+		t.Fatalf("LastGood() = %+v, %v", good, err)
+	}
+	removed, err := Prune(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(removed) != 1 || removed[0] != "20260301-010000" {
+		t.Errorf("removed = %v, want only the middle failed snapshot", removed)
+	}
+	if all, _ := List(dir); len(all) != 2 {
+		t.Errorf("remaining = %d, want 2", len(all))
+	}
+}
@@ -0,0 +1,322 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/doltserver"
+)
+
+// SandboxMarker is the file identifying a town as a disposable clone.
+const SandboxMarker = "sandbox.json"
+
+// ErrDestNotEmpty is returned when a clone destination already has content.
+var ErrDestNotEmpty = errors.New("destination exists and is not empty")
+
+// CloneOptions control Clone.
+type CloneOptions struct {
+	Dest  string   // Sandbox town root (default: a new temp directory)
+	Beads bool     // Also copy the beads databases
+	Rigs  []string // Rigs to clone (default: all registered rigs)
+}
+
+// Sandbox describes a cloned town. It is also written to the clone's
+// mayor/sandbox.json.
+type Sandbox struct {
+	Root      string       `json:"root"`
+	Source    string       `json:"source"`
+	CreatedAt time.Time    `json:"created_at"`
+	Files     int          `json:"files"`
+	Rigs      []SandboxRig `json:"rigs,omitempty"`
+	Databases []string     `json:"databases,omitempty"`
+	DoltPort  int          `json:"dolt_port,omitempty"`
+	Errors    []string     `json:"errors,omitempty"`
+}
+
+// SandboxRig records where a rig's throwaway repository came from.
+type SandboxRig struct {
+	Name     string `json:"name"`
+	Source   string `json:"source"`   // Production repository the clone was taken from
+	Upstream string `json:"upstream"` // Bare repository standing in for the remote
+}
+
+// cloneRepo makes a bare, object-sharing clone of src at dst.
+// A variable so tests can run without network remotes.
+var cloneRepo = func(src, dst string) error {
+	cmd := exec.Command("git", "clone", "--quiet", "--bare", "--shared", src, dst) //nolint:gosec // G204: args are constructed internally
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// checkoutRepo clones the sandbox upstream into a rig's working clone.
+var checkoutRepo = func(upstream, dst string) error {
+	cmd := exec.Command("git", "clone", "--quiet", upstream, dst) //nolint:gosec // G204: args are constructed internally
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// freePort asks the kernel for an unused TCP port for the sandbox's Dolt
+// server, so it can never talk to production's.
+var freePort = func() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+// Clone copies a town's configuration (and optionally its beads) into a
+// disposable sandbox town, so patrol and config changes can be trialed
+// without touching production agents.
+//
+// Each rig gets a throwaway bare "upstream" repository sharing objects with
+// the production clone, and the sandbox's rig registry points at it: pushes
+// from the sandbox land there and nowhere else. The sandbox gets its own
+// Dolt port and a town name suffixed with "-sandbox". Problems with single
+// files, rigs, or databases are recorded rather than failing the clone.
+func Clone(townRoot string, opts CloneOptions) (*Sandbox, error) {
+	dest := opts.Dest
+	if dest == "" {
+		dir, err := os.MkdirTemp("", "gt-sandbox-")
+		if err != nil {
+			return nil, fmt.Errorf("creating sandbox directory: %w", err)
+		}
+		dest = dir
+	} else if entries, err := os.ReadDir(dest); err == nil && len(entries) > 0 {
+		return nil, fmt.Errorf("%s: %w", dest, ErrDestNotEmpty)
+	}
+	dest, err := filepath.Abs(dest)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return nil, fmt.Errorf("creating sandbox directory: %w", err)
+	}
+
+	sb := &Sandbox{Root: dest, Source: townRoot, CreatedAt: time.Now().UTC()}
+	for _, rel := range collectFiles(townRoot, ComponentConfig) {
+		if err := copyFile(filepath.Join(townRoot, filepath.FromSlash(rel)), filepath.Join(dest, filepath.FromSlash(rel))); err != nil {
+			sb.Errors = append(sb.Errors, fmt.Sprintf("%s: %v", rel, err))
+			continue
+		}
+		sb.Files++
+	}
+
+	if err := renameSandboxTown(dest); err != nil {
+		sb.Errors = append(sb.Errors, fmt.Sprintf("town.json: %v", err))
+	}
+	if port, err := freePort(); err != nil {
+		sb.Errors = append(sb.Errors, fmt.Sprintf("dolt port: %v", err))
+	} else if err := setSandboxDoltPort(dest, port); err != nil {
+		sb.Errors = append(sb.Errors, fmt.Sprintf("daemon.json: %v", err))
+	} else {
+		sb.DoltPort = port
+	}
+	cloneRigs(townRoot, dest, opts.Rigs, sb)
+
+	if opts.Beads {
+		cloneDatabases(townRoot, dest, sb)
+	}
+
+	data, err := json.MarshalIndent(sb, "", "  ")
+	if err != nil {
+		return sb, err
+	}
+	if err := os.MkdirAll(filepath.Join(dest, constants.DirMayor), 0755); err != nil {
+		return sb, err
+	}
+	if err := os.WriteFile(filepath.Join(dest, constants.DirMayor, SandboxMarker), data, 0644); err != nil { //nolint:gosec // G306: not sensitive
+		return sb, fmt.Errorf("writing sandbox marker: %w", err)
+	}
+	return sb, nil
+}
+
+// cloneRigs gives each selected rig a throwaway upstream and working clone,
+// and rewrites the sandbox registry to match. Unselected rigs are dropped
+// from the sandbox registry.
+func cloneRigs(townRoot, dest string, only []string, sb *Sandbox) {
+	rigsPath := constants.MayorRigsPath(dest)
+	rigs, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		return // No registry, no rigs.
+	}
+	want := map[string]bool{}
+	for _, name := range only {
+		want[name] = true
+	}
+	for name, entry := range rigs.Rigs {
+		if len(want) > 0 && !want[name] {
+			delete(rigs.Rigs, name)
+			continue
+		}
+		src := constants.RigMayorPath(filepath.Join(townRoot, name))
+		if _, err := os.Stat(src); err != nil {
+			src = entry.GitURL
+		}
+		upstream := filepath.Join(dest, ".upstream", name+".git")
+		if err := os.MkdirAll(filepath.Dir(upstream), 0755); err != nil {
+			sb.Errors = append(sb.Errors, fmt.Sprintf("rig %s: %v", name, err))
+			continue
+		}
+		if err := cloneRepo(src, upstream); err != nil {
+			sb.Errors = append(sb.Errors, fmt.Sprintf("rig %s: %v", name, err))
+			delete(rigs.Rigs, name)
+			continue
+		}
+		if err := checkoutRepo(upstream, constants.RigMayorPath(filepath.Join(dest, name))); err != nil {
+			sb.Errors = append(sb.Errors, fmt.Sprintf("rig %s checkout: %v", name, err))
+		}
+		entry.GitURL = upstream
+		entry.PushURL = ""
+		entry.UpstreamURL = ""
+		entry.LocalRepo = ""
+		rigs.Rigs[name] = entry
+		sb.Rigs = append(sb.Rigs, SandboxRig{Name: name, Source: src, Upstream: upstream})
+	}
+	for name := range want {
+		if _, ok := rigs.Rigs[name]; !ok {
+			sb.Errors = append(sb.Errors, fmt.Sprintf("rig %s: not registered", name))
+		}
+	}
+	if err := config.SaveRigsConfig(rigsPath, rigs); err != nil {
+		sb.Errors = append(sb.Errors, fmt.Sprintf("rigs.json: %v", err))
+	}
+}
+
+// cloneDatabases copies each beads database into the sandbox's data dir.
+func cloneDatabases(townRoot, dest string, sb *Sandbox) {
+	dataDir := doltserver.DefaultConfig(townRoot).DataDir
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			sb.Errors = append(sb.Errors, fmt.Sprintf("dolt data dir: %v", err))
+		}
+		return
+	}
+	staging, err := os.MkdirTemp("", "gt-sandbox-dolt-")
+	if err != nil {
+		sb.Errors = append(sb.Errors, fmt.Sprintf("staging: %v", err))
+		return
+	}
+	defer os.RemoveAll(staging)
+
+	destData := filepath.Join(dest, filepath.Base(dataDir))
+	if err := os.MkdirAll(destData, 0755); err != nil {
+		sb.Errors = append(sb.Errors, fmt.Sprintf("dolt data dir: %v", err))
+		return
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if !e.IsDir() || doltserver.IsSystemDatabase(name) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dataDir, name, ".dolt")); err != nil {
+			continue
+		}
+		src := filepath.Join(staging, name)
+		if err := syncDatabase(filepath.Join(dataDir, name), src); err != nil {
+			sb.Errors = append(sb.Errors, fmt.Sprintf("database %s: %v", name, err))
+			continue
+		}
+		if err := restoreDatabase(src, destData, name); err != nil {
+			sb.Errors = append(sb.Errors, fmt.Sprintf("database %s: %v", name, err))
+			continue
+		}
+		sb.Databases = append(sb.Databases, name)
+	}
+}
+
+// renameSandboxTown suffixes the clone's town name so it is never mistaken
+// for production.
+func renameSandboxTown(dest string) error {
+	path := constants.MayorTownPath(dest)
+	cfg, err := config.LoadTownConfig(path)
+	if err != nil {
+		if errors.Is(err, config.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !strings.HasSuffix(cfg.Name, "-sandbox") {
+		cfg.Name += "-sandbox"
+	}
+	return config.SaveTownConfig(path, cfg)
+}
+
+// setSandboxDoltPort points the sandbox's Dolt server at port via the
+// daemon.json env block, preserving the rest of the file.
+func setSandboxDoltPort(dest string, port int) error {
+	path := filepath.Join(dest, constants.DirMayor, "daemon.json")
+	raw := map[string]json.RawMessage{}
+	if data, err := os.ReadFile(path); err == nil { //nolint:gosec // G304: path is constructed internally
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	env := map[string]string{}
+	if e, ok := raw["env"]; ok {
+		if err := json.Unmarshal(e, &env); err != nil {
+			return err
+		}
+	}
+	env["GT_DOLT_PORT"] = strconv.Itoa(port)
+	encoded, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+	raw["env"] = encoded
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644) //nolint:gosec // G306: not sensitive
+}
+
+// LoadSandbox returns the sandbox marker for townRoot, or nil if the town
+// is not a sandbox.
+func LoadSandbox(townRoot string) *Sandbox {
+	data, err := os.ReadFile(filepath.Join(townRoot, constants.DirMayor, SandboxMarker)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil
+	}
+	var sb Sandbox
+	if json.Unmarshal(data, &sb) != nil {
+		return nil
+	}
+	return &sb
+}
+
+func copyFile(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(src) //nolint:gosec // G304: path comes from a fixed set of town locations
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, info.Mode().Perm())
+}
@@ -0,0 +1,135 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func stubClone(t *testing.T) *[]string {
+	t.Helper()
+	origClone, origCheckout, origPort, origSync, origRestore := cloneRepo, checkoutRepo, freePort, syncDatabase, restoreDatabase
+	t.Cleanup(func() {
+		cloneRepo, checkoutRepo, freePort, syncDatabase, restoreDatabase = origClone, origCheckout, origPort, origSync, origRestore
+	})
+	var calls []string
+	cloneRepo = func(src, dst string) error {
+		calls = append(calls, "bare "+filepath.Base(dst))
+		writeTownFile(t, dst, "HEAD", "ref: refs/heads/main")
+		return nil
+	}
+	checkoutRepo = func(upstream, dst string) error {
+		calls = append(calls, "checkout "+filepath.Base(upstream))
+		writeTownFile(t, dst, "README", "clone")
+		return nil
+	}
+	freePort = func() (int, error) { return 45123, nil }
+	syncDatabase = func(dbDir, dest string) error {
+		writeTownFile(t, dest, "backup.dat", readTownFile(t, dbDir, ".dolt/manifest"))
+		return nil
+	}
+	restoreDatabase = func(src, dataDir, name string) error {
+		writeTownFile(t, dataDir, name+"/.dolt/manifest", readTownFile(t, src, "backup.dat"))
+		return nil
+	}
+	return &calls
+}
+
+func TestClone(t *testing.T) {
+	root := newTown(t)
+	writeTownFile(t, root, "mayor/rigs.json", `{"version":1,"rigs":{"gastown":{"git_url":"git@github.com:x/gastown.git","push_url":"git@github.com:me/gastown.git"}}}`)
+	writeTownFile(t, root, "mayor/daemon.json", `{"type":"daemon-patrol-config","env":{"GT_DOLT_PORT":"3307","OTHER":"1"}}`)
+	writeTownFile(t, root, "gastown/mayor/rig/README", "prod")
+	writeTownFile(t, root, ".dolt-data/hq/.dolt/manifest", "v1")
+	calls := stubClone(t)
+
+	dest := filepath.Join(t.TempDir(), "sandbox")
+	sb, err := Clone(root, CloneOptions{Dest: dest, Beads: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sb.Errors) != 0 {
+		t.Fatalf("errors = %v", sb.Errors)
+	}
+	if strings.Join(*calls, ",") != "bare gastown.git,checkout gastown.git" {
+		t.Errorf("git calls = %v", *calls)
+	}
+
+	var town struct{ Name string }
+	if err := json.Unmarshal([]byte(readTownFile(t, dest, "mayor/town.json")), &town); err != nil || town.Name != "test-sandbox" {
+		t.Errorf("town name = %q, %v; want test-sandbox", town.Name, err)
+	}
+
+	var rigs struct {
+		Rigs map[string]struct {
+			GitURL  string `json:"git_url"`
+			PushURL string `json:"push_url"`
+		}
+	}
+	if err := json.Unmarshal([]byte(readTownFile(t, dest, "mayor/rigs.json")), &rigs); err != nil {
+		t.Fatal(err)
+	}
+	if r := rigs.Rigs["gastown"]; r.GitURL != filepath.Join(dest, ".upstream", "gastown.git") || r.PushURL != "" {
+		t.Errorf("sandbox rig entry = %+v, want it pointed at the sandbox upstream", r)
+	}
+	if readTownFile(t, dest, "gastown/mayor/rig/README") != "clone" {
+		t.Error("rig clone not checked out from the sandbox upstream")
+	}
+
+	var daemon struct{ Env map[string]string }
+	if err := json.Unmarshal([]byte(readTownFile(t, dest, "mayor/daemon.json")), &daemon); err != nil {
+		t.Fatal(err)
+	}
+	if daemon.Env["GT_DOLT_PORT"] != "45123" || daemon.Env["OTHER"] != "1" {
+		t.Errorf("daemon env = %v", daemon.Env)
+	}
+
+	if len(sb.Databases) != 1 || readTownFile(t, dest, ".dolt-data/hq/.dolt/manifest") != "v1" {
+		t.Errorf("databases = %v", sb.Databases)
+	}
+	if got := LoadSandbox(dest); got == nil || got.Source != root {
+		t.Errorf("LoadSandbox = %+v", got)
+	}
+	if LoadSandbox(root) != nil {
+		t.Error("production town reported as a sandbox")
+	}
+	if readTownFile(t, root, "mayor/daemon.json") != `{"type":"daemon-patrol-config","env":{"GT_DOLT_PORT":"3307","OTHER":"1"}}` {
+		t.Error("production daemon.json was modified")
+	}
+}
+
+func TestCloneSelectsRigs(t *testing.T) {
+	root := newTown(t)
+	writeTownFile(t, root, "mayor/rigs.json", `{"version":1,"rigs":{"gastown":{},"beads":{}}}`)
+	stubClone(t)
+
+	dest := t.TempDir()
+	sb, err := Clone(root, CloneOptions{Dest: dest, Rigs: []string{"beads", "nope"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sb.Rigs) != 1 || sb.Rigs[0].Name != "beads" {
+		t.Errorf("rigs = %+v", sb.Rigs)
+	}
+	if len(sb.Errors) != 1 || !strings.Contains(sb.Errors[0], "nope") {
+		t.Errorf("errors = %v, want unknown rig reported", sb.Errors)
+	}
+	if strings.Contains(readTownFile(t, dest, "mayor/rigs.json"), "gastown") {
+		t.Error("unselected rig left in sandbox registry")
+	}
+	if sb.Databases != nil {
+		t.Errorf("beads copied without --beads: %v", sb.Databases)
+	}
+}
+
+func TestCloneRefusesNonEmptyDest(t *testing.T) {
+	root := newTown(t)
+	stubClone(t)
+	dest := t.TempDir()
+	writeTownFile(t, dest, "keep", "x")
+	if _, err := Clone(root, CloneOptions{Dest: dest}); !errors.Is(err, ErrDestNotEmpty) {
+		t.Errorf("err = %v, want ErrDestNotEmpty", err)
+	}
+}
@@ -0,0 +1,236 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/doltserver"
+)
+
+// ErrDoltRunning is returned when restoring databases while the Dolt server
+// is running.
+var ErrDoltRunning = errors.New("dolt server is running; stop it before restoring databases (gt dolt stop)")
+
+// RestoreOptions control Restore.
+type RestoreOptions struct {
+	Components []string // Components to restore (default: all in the snapshot)
+	Force      bool     // Overwrite existing files and databases
+	Dir        string   // Snapshot directory (default: DefaultDir)
+}
+
+// RestoreResult reports what Restore did.
+type RestoreResult struct {
+	Restored  int      `json:"restored"`
+	Skipped   []string `json:"skipped,omitempty"` // Existing files left alone (no --force)
+	Databases []string `json:"databases,omitempty"`
+	SafetyID  string   `json:"safety_id,omitempty"` // Snapshot taken before overwriting
+}
+
+// restoreDatabase restores the Dolt backup at src as database name in dataDir.
+// A variable so tests can run without dolt.
+var restoreDatabase = func(src, dataDir, name string) error {
+	cmd := exec.Command("dolt", "backup", "restore", "file://"+src, name) //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = dataDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// doltRunning reports whether the town's Dolt server is up. A variable so
+// tests do not depend on the host.
+var doltRunning = func(townRoot string) bool {
+	running, _, _ := doltserver.IsRunning(townRoot)
+	return running
+}
+
+// Restore unpacks a snapshot into the town. Without Force, files and
+// databases that already exist are skipped, so a restore only fills in what
+// is missing. With Force, a pre-restore snapshot of the affected components
+// is taken first and replaced databases are moved aside rather than deleted.
+func Restore(townRoot, id string, opts RestoreOptions) (*RestoreResult, error) {
+	if opts.Dir == "" {
+		opts.Dir = DefaultDir(townRoot)
+	}
+	m, err := Load(opts.Dir, id)
+	if err != nil {
+		return nil, err
+	}
+	want := map[string]bool{}
+	for _, c := range opts.Components {
+		if !m.Has(c) {
+			return nil, fmt.Errorf("backup %s does not include %s", id, c)
+		}
+		want[c] = true
+	}
+	if len(want) == 0 {
+		for _, c := range m.Components {
+			want[c] = true
+		}
+	}
+	if want[ComponentBeads] && len(m.Databases) > 0 && doltRunning(townRoot) {
+		return nil, ErrDoltRunning
+	}
+
+	res := &RestoreResult{}
+	if opts.Force {
+		var comps []string
+		for _, c := range AllComponents {
+			if want[c] {
+				comps = append(comps, c)
+			}
+		}
+		safety, err := Create(townRoot, Options{Dir: opts.Dir, Components: comps, Trigger: TriggerPreRestore})
+		if err != nil {
+			return nil, fmt.Errorf("taking pre-restore snapshot: %w", err)
+		}
+		res.SafetyID = safety.ID
+	}
+
+	staging, err := os.MkdirTemp("", "gt-restore-")
+	if err != nil {
+		return nil, fmt.Errorf("creating staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	dbs, err := extract(townRoot, ArchivePath(opts.Dir, id), staging, want, opts.Force, res)
+	if err != nil {
+		return res, err
+	}
+
+	if want[ComponentBeads] {
+		dataDir := doltserver.DefaultConfig(townRoot).DataDir
+		for _, name := range dbs {
+			if err := restoreOneDatabase(filepath.Join(staging, name), dataDir, name, opts.Force, res); err != nil {
+				return res, fmt.Errorf("restoring database %s: %w", name, err)
+			}
+		}
+	}
+	return res, nil
+}
+
+// extract writes wanted files into the town and stages database backups.
+// Returns the staged database names.
+func extract(townRoot, archive, staging string, want map[string]bool, force bool, res *RestoreResult) ([]string, error) {
+	f, err := os.Open(archive) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil, fmt.Errorf("opening archive: %w", err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	dbs := map[string]bool{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(hdr.Name)
+		if strings.HasPrefix(name, "../") || path.IsAbs(name) {
+			return nil, fmt.Errorf("unsafe path in archive: %s", hdr.Name)
+		}
+
+		switch {
+		case strings.HasPrefix(name, filesPrefix):
+			component, rel, ok := strings.Cut(strings.TrimPrefix(name, filesPrefix), "/")
+			if !ok || !want[component] {
+				continue
+			}
+			if err := restoreFile(townRoot, rel, tr, os.FileMode(hdr.Mode).Perm(), force, res); err != nil { //nolint:gosec // G115: tar modes are small
+				return nil, fmt.Errorf("restoring %s: %w", rel, err)
+			}
+		case strings.HasPrefix(name, dbPrefix) && want[ComponentBeads]:
+			db, _, _ := strings.Cut(strings.TrimPrefix(name, dbPrefix), "/")
+			dbs[db] = true
+			if err := writeFileFrom(filepath.Join(staging, filepath.FromSlash(strings.TrimPrefix(name, dbPrefix))), tr, 0644); err != nil {
+				return nil, fmt.Errorf("staging %s: %w", name, err)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(dbs))
+	for db := range dbs {
+		names = append(names, db)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func restoreFile(townRoot, rel string, r io.Reader, mode os.FileMode, force bool, res *RestoreResult) error {
+	dst := filepath.Join(townRoot, filepath.FromSlash(rel))
+	if _, err := os.Stat(dst); err == nil && !force {
+		res.Skipped = append(res.Skipped, rel)
+		return nil
+	}
+	tmp := dst + ".restore"
+	if err := writeFileFrom(tmp, r, mode); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	res.Restored++
+	return nil
+}
+
+func writeFileFrom(dst string, r io.Reader, mode os.FileMode) error {
+	if mode == 0 {
+		mode = 0644
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode) //nolint:gosec // G304: path is validated against the town root
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil { //nolint:gosec // G110: archives are our own snapshots
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func restoreOneDatabase(src, dataDir, name string, force bool, res *RestoreResult) error {
+	target := filepath.Join(dataDir, name)
+	if _, err := os.Stat(target); err == nil {
+		if !force {
+			res.Skipped = append(res.Skipped, "database "+name)
+			return nil
+		}
+		aside := fmt.Sprintf("%s.pre-restore-%s", target, time.Now().UTC().Format("20060102-150405"))
+		if err := os.Rename(target, aside); err != nil {
+			return fmt.Errorf("moving existing database aside: %w", err)
+		}
+	}
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		return err
+	}
+	if err := restoreDatabase(src, dataDir, name); err != nil {
+		return err
+	}
+	res.Databases = append(res.Databases, name)
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/telemetry"
 )
@@ -25,6 +26,9 @@ var (
 	ErrNotInstalled = errors.New("bd not installed: run 'pip install beads-cli' or see https://github.com/anthropics/beads")
 	ErrNotFound     = errors.New("issue not found")
 	ErrFlagTitle    = errors.New("title looks like a CLI flag (starts with '-'); use --title=\"...\" to set flag-like titles intentionally")
+	// ErrUnregisteredPrefix is returned when creating a bead whose ID prefix
+	// is missing from (or retired in) the town prefix registry.
+	ErrUnregisteredPrefix = errors.New("bead prefix not registered")
 )
 
 // ExtractIssueID strips the external:prefix:id wrapper from bead IDs.
@@ -165,6 +169,8 @@ type CreateOptions struct {
 	Parent      string
 	Actor       string // Who is creating this issue (populates created_by)
 	Ephemeral   bool   // Create as ephemeral (wisp) - not synced to git
+
+	AcceptanceCriteria string // Markdown checklist ("- [ ] ...") of the definition of done
 }
 
 // UpdateOptions specifies options for updating an issue.
@@ -305,7 +311,7 @@ func (b *Beads) run(args ...string) (_ []byte, retErr error) {
 	cmd := exec.Command("bd", fullArgs...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Dir = b.workDir
 
-	cmd.Env = append(b.buildRunEnv(), "BEADS_DIR="+beadsDir)
+	cmd.Env = append(b.buildRunEnv(beadsDir), "BEADS_DIR="+beadsDir)
 	cmd.Env = append(cmd.Env, telemetry.OTELEnvForSubprocess()...)
 
 	cmd.Stdout = &stdout
@@ -413,7 +419,8 @@ func isSubprocessCrash(err error) bool {
 // Without this, getenv() returns the first occurrence, so an inherited BEADS_DIR
 // (e.g., from a parent process or shell context) would shadow the explicit value
 // appended by run(). This was the root cause of gt-uygpe / GH #803.
-func (b *Beads) buildRunEnv() []string {
+// The Dolt port is set as described at doltPortEnv.
+func (b *Beads) buildRunEnv(beadsDir string) []string {
 	if b.isolated {
 		env := filterBeadsEnv(os.Environ())
 		if b.serverPort > 0 {
@@ -422,8 +429,7 @@ func (b *Beads) buildRunEnv() []string {
 		}
 		return env
 	}
-	env := stripEnvPrefixes(os.Environ(), "BEADS_DIR=")
-	return translateDoltPort(env)
+	return doltPortEnv(stripEnvPrefixes(os.Environ(), "BEADS_DIR="), beadsDir)
 }
 
 // buildRoutingEnv builds the environment for runWithRouting() calls.
@@ -438,8 +444,7 @@ func (b *Beads) buildRoutingEnv() []string {
 		}
 		return env
 	}
-	env := stripEnvPrefixes(os.Environ(), "BEADS_DIR=")
-	return translateDoltPort(env)
+	return doltPortEnv(stripEnvPrefixes(os.Environ(), "BEADS_DIR="), ResolveBeadsDir(b.workDir))
 }
 
 // filterBeadsEnv removes beads-related environment variables from the given
@@ -494,6 +499,31 @@ func translateDoltPort(env []string) []string {
 	return env
 }
 
+// doltPortEnv sets the Dolt port for bd in env. A rig moved to its own
+// server by the daemon's rig_dolt_servers patrol has that server's address
+// in metadata.json, marked dedicated; GT_DOLT_PORT names the town server
+// and would override it, so both port variables are dropped for such rigs.
+// Otherwise GT_DOLT_PORT is translated as described at translateDoltPort.
+func doltPortEnv(env []string, beadsDir string) []string {
+	if hasDedicatedDoltServer(beadsDir) {
+		return stripEnvPrefixes(env, "GT_DOLT_PORT=", "BEADS_DOLT_PORT=")
+	}
+	return translateDoltPort(env)
+}
+
+// hasDedicatedDoltServer reports whether beadsDir's metadata.json points at
+// a dedicated Dolt server (see doltserver.DedicatedServerKey).
+func hasDedicatedDoltServer(beadsDir string) bool {
+	var metadata struct {
+		Dedicated bool `json:"dolt_server_dedicated"`
+	}
+	data, err := os.ReadFile(filepath.Join(beadsDir, "metadata.json"))
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, &metadata) == nil && metadata.Dedicated
+}
+
 // stripEnvPrefixes removes entries matching any of the given prefixes from an
 // environment variable slice. Used by runWithRouting to strip BEADS_DIR.
 func stripEnvPrefixes(environ []string, prefixes ...string) []string {
@@ -644,6 +674,8 @@ func (b *Beads) ReadyWithType(issueType string) ([]*Issue, error) {
 
 // Show returns detailed information about an issue.
 func (b *Beads) Show(id string) (*Issue, error) {
+	id = b.forwardID(id)
+
 	// Route cross-rig queries via routes.jsonl so that rig-level bead IDs
 	// (e.g., "gt-abc123") resolve to the correct rig database.
 	targetDir := ResolveRoutingTarget(b.getTownRoot(), id, b.getResolvedBeadsDir())
@@ -677,8 +709,19 @@ func (b *Beads) ShowMultiple(ids []string) (map[string]*Issue, error) {
 		return make(map[string]*Issue), nil
 	}
 
+	// Requested IDs under retired prefixes are looked up by their forwarded
+	// IDs and returned under the ID the caller asked for.
+	forwarded := make(map[string]string)
+	lookup := make([]string, len(ids))
+	for i, id := range ids {
+		lookup[i] = b.forwardID(id)
+		if lookup[i] != id {
+			forwarded[id] = lookup[i]
+		}
+	}
+
 	// bd show supports multiple IDs
-	args := append([]string{"show", "--json"}, ids...)
+	args := append([]string{"show", "--json"}, lookup...)
 	out, err := b.run(args...)
 	if err != nil {
 		return nil, fmt.Errorf("bd show: %w", err)
@@ -693,6 +736,11 @@ func (b *Beads) ShowMultiple(ids []string) (map[string]*Issue, error) {
 	for _, issue := range issues {
 		result[issue.ID] = issue
 	}
+	for id, fwd := range forwarded {
+		if issue, ok := result[fwd]; ok {
+			result[id] = issue
+		}
+	}
 
 	return result, nil
 }
@@ -739,6 +787,9 @@ func (b *Beads) Create(opts CreateOptions) (*Issue, error) {
 	if opts.Parent != "" {
 		args = append(args, "--parent="+opts.Parent)
 	}
+	if opts.AcceptanceCriteria != "" {
+		args = append(args, "--acceptance="+opts.AcceptanceCriteria)
+	}
 	if opts.Ephemeral {
 		args = append(args, "--ephemeral")
 	}
@@ -774,6 +825,10 @@ func (b *Beads) CreateWithID(id string, opts CreateOptions) (*Issue, error) {
 		return nil, fmt.Errorf("refusing to create bead: %w (got %q)", ErrFlagTitle, opts.Title)
 	}
 
+	if err := b.checkRegisteredPrefix(id); err != nil {
+		return nil, err
+	}
+
 	args := []string{"create", "--json", "--id=" + id}
 	if NeedsForceForID(id) {
 		args = append(args, "--force")
@@ -818,6 +873,36 @@ func (b *Beads) CreateWithID(id string, opts CreateOptions) (*Issue, error) {
 	return &issue, nil
 }
 
+// checkRegisteredPrefix refuses IDs whose prefix the town prefix registry
+// does not know or has retired. Towns without a registry accept any prefix.
+func (b *Beads) checkRegisteredPrefix(id string) error {
+	townRoot := b.getTownRoot()
+	if b.isolated || townRoot == "" {
+		return nil
+	}
+	cfg := config.LoadTownPrefixes(townRoot)
+	if cfg == nil {
+		return nil
+	}
+	e, prefix, retired := cfg.RegisteredPrefixFor(id)
+	switch {
+	case e == nil:
+		return fmt.Errorf("%w: %s (register it with gt rig add, or see gt doctor prefix-registry)", ErrUnregisteredPrefix, id)
+	case retired:
+		return fmt.Errorf("%w: %s uses %s-, which was retired in favor of %s-", ErrUnregisteredPrefix, id, prefix, e.Prefix)
+	}
+	return nil
+}
+
+// forwardID rewrites an ID under a retired prefix to its current prefix,
+// so references recorded before gt rig reprefix still resolve.
+func (b *Beads) forwardID(id string) string {
+	if b.isolated {
+		return id
+	}
+	return config.ForwardBeadID(b.getTownRoot(), id)
+}
+
 // SearchOptions specifies options for searching issues.
 type SearchOptions struct {
 	Query        string // Text query to search titles and descriptions
@@ -699,6 +699,13 @@ func (b *Beads) GetAgentBead(id string) (*Issue, *AgentFields, error) {
 	return issue, fields, nil
 }
 
+// DeleteAgentBead permanently deletes an agent bead. Use it only for agents
+// that no longer exist, such as those of a removed rig.
+func (b *Beads) DeleteAgentBead(id string) error {
+	_, err := b.run("delete", id, "--hard", "--force")
+	return err
+}
+
 // ListAgentBeads returns all agent beads in a single query.
 // Returns a map of agent bead ID to Issue.
 //
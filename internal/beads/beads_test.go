@@ -2755,6 +2755,30 @@ func TestBdBranch_SystemScenario_FilterBeadsEnvIsolation(t *testing.T) {
 	}
 }
 
+// TestDoltPortEnv verifies that a rig on a dedicated Dolt server keeps the
+// address in its metadata.json instead of GT_DOLT_PORT's town server port.
+func TestDoltPortEnv(t *testing.T) {
+	env := []string{"GT_DOLT_PORT=3307", "BEADS_DOLT_PORT=3307", "PATH=/usr/bin"}
+
+	townRig := t.TempDir()
+	if err := os.WriteFile(filepath.Join(townRig, "metadata.json"), []byte(`{"dolt_server_port":3307}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got := doltPortEnv(env, townRig); len(got) != len(env) {
+		t.Errorf("doltPortEnv() on the town server = %v, want %v", got, env)
+	}
+
+	dedicated := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dedicated, "metadata.json"),
+		[]byte(`{"dolt_server_port":3400,"dolt_server_dedicated":true}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	got := doltPortEnv(env, dedicated)
+	if len(got) != 1 || got[0] != "PATH=/usr/bin" {
+		t.Errorf("doltPortEnv() on a dedicated server = %v, want only PATH", got)
+	}
+}
+
 // TestBuildRunEnv verifies buildRunEnv() returns the correct environment
 // for each mode: default (passthrough) and isolated (strip all beads vars).
 func TestBuildRunEnv(t *testing.T) {
@@ -2788,7 +2812,7 @@ func TestBuildRunEnv(t *testing.T) {
 				t.Setenv(k, v)
 			}
 			b := &Beads{workDir: "/tmp", isolated: tt.isolated}
-			env := b.buildRunEnv()
+			env := b.buildRunEnv(t.TempDir())
 
 			for _, prefix := range tt.mustContain {
 				found := false
@@ -0,0 +1,140 @@
+// Package beads provides structured wisp comment operations.
+package beads
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// commentHeaderPrefix marks a comment written by gt with structured metadata.
+// The header is the first line of the bd comment text, for example:
+//
+//	[gt role=witness artifact=https://ci.example.com/run/42]
+//	Tests are flaky on the sandbox runner; retry before escalating.
+const commentHeaderPrefix = "[gt "
+
+// Comment is a structured comment on a wisp's bead.
+type Comment struct {
+	ID        int64     `json:"id,omitempty"`
+	Author    string    `json:"author"`
+	Role      string    `json:"role,omitempty"`
+	Body      string    `json:"body"`
+	Artifact  string    `json:"artifact,omitempty"` // Optional link (URL or path)
+	Kind      string    `json:"kind,omitempty"`     // "" for free-form notes, CommentKindHandoff
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Comment kinds written by gt.
+const (
+	CommentKindHandoff  = "handoff"  // A HandoffNote
+	CommentKindVerdict  = "verdict"  // An agent's verdict on the bead (see agentresult)
+	CommentKindArtifact = "artifact" // An artifact an agent produced for the bead
+	CommentKindAbandon  = "abandon"  // Why the wisp was abandoned (gt wisp abandon)
+)
+
+// bdComment is the bd comments --json wire format.
+type bdComment struct {
+	ID        int64     `json:"id"`
+	Author    string    `json:"author"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FormatCommentText encodes a comment's role, artifact, and kind into bd
+// comment text. Comments with none of them are stored as plain text.
+func FormatCommentText(c *Comment) string {
+	var attrs []string
+	if c.Role != "" {
+		attrs = append(attrs, "role="+strings.ReplaceAll(c.Role, " ", "_"))
+	}
+	if c.Artifact != "" {
+		attrs = append(attrs, "artifact="+strings.ReplaceAll(c.Artifact, " ", "%20"))
+	}
+	if c.Kind != "" {
+		attrs = append(attrs, "kind="+c.Kind)
+	}
+	if len(attrs) == 0 {
+		return c.Body
+	}
+	return commentHeaderPrefix + strings.Join(attrs, " ") + "]\n" + c.Body
+}
+
+// ParseCommentText decodes bd comment text written by FormatCommentText.
+// Plain comments (from bd or humans) are returned with only Body set.
+func ParseCommentText(text string) (role, artifact, body string) {
+	c := parseCommentText(text)
+	return c.Role, c.Artifact, c.Body
+}
+
+// parseCommentText decodes bd comment text into a Comment with Role,
+// Artifact, Kind, and Body set.
+func parseCommentText(text string) Comment {
+	header, rest, _ := strings.Cut(text, "\n")
+	if !strings.HasPrefix(header, commentHeaderPrefix) || !strings.HasSuffix(header, "]") {
+		return Comment{Body: text}
+	}
+	c := Comment{Body: rest}
+	for _, field := range strings.Fields(strings.TrimSuffix(strings.TrimPrefix(header, commentHeaderPrefix), "]")) {
+		key, value, _ := strings.Cut(field, "=")
+		switch key {
+		case "role":
+			c.Role = value
+		case "artifact":
+			c.Artifact = strings.ReplaceAll(value, "%20", " ")
+		case "kind":
+			c.Kind = value
+		}
+	}
+	return c
+}
+
+// AddComment appends a structured comment to a bead.
+func (b *Beads) AddComment(id string, c *Comment) error {
+	if strings.TrimSpace(c.Body) == "" {
+		return fmt.Errorf("comment body is empty")
+	}
+	targetDir := ResolveRoutingTarget(b.getTownRoot(), id, b.getResolvedBeadsDir())
+	if targetDir != b.getResolvedBeadsDir() {
+		return NewWithBeadsDir(filepath.Dir(targetDir), targetDir).AddComment(id, c)
+	}
+
+	args := []string{"comments", "add", id, FormatCommentText(c)}
+	if c.Author != "" {
+		args = append(args, "--author", c.Author)
+	}
+	_, err := b.run(args...)
+	return err
+}
+
+// Comments returns a bead's comments, oldest first.
+func (b *Beads) Comments(id string) ([]*Comment, error) {
+	targetDir := ResolveRoutingTarget(b.getTownRoot(), id, b.getResolvedBeadsDir())
+	if targetDir != b.getResolvedBeadsDir() {
+		return NewWithBeadsDir(filepath.Dir(targetDir), targetDir).Comments(id)
+	}
+
+	out, err := b.run("comments", id, "--json")
+	if err != nil {
+		return nil, err
+	}
+	return parseComments(out)
+}
+
+func parseComments(out []byte) ([]*Comment, error) {
+	var raw []bdComment
+	if err := json.Unmarshal(out, &raw); err != nil {
+		return nil, fmt.Errorf("parsing bd comments output: %w", err)
+	}
+	comments := make([]*Comment, 0, len(raw))
+	for _, r := range raw {
+		c := parseCommentText(r.Text)
+		c.ID = r.ID
+		c.Author = r.Author
+		c.CreatedAt = r.CreatedAt
+		comments = append(comments, &c)
+	}
+	return comments, nil
+}
@@ -0,0 +1,51 @@
+package beads
+
+import (
+	"testing"
+)
+
+func TestCommentTextRoundTrip(t *testing.T) {
+	tests := []Comment{
+		{Role: "witness", Artifact: "https://ci.example.com/run/42", Body: "Tests are flaky.\nRetry first."},
+		{Role: "overseer", Body: "Prefer the smaller refactor."},
+		{Artifact: "/tmp/my report.txt", Body: "See report"},
+		{Body: "plain"},
+	}
+	for _, c := range tests {
+		text := FormatCommentText(&c)
+		role, artifact, body := ParseCommentText(text)
+		if role != c.Role || artifact != c.Artifact || body != c.Body {
+			t.Errorf("round trip of %+v = %q, %q, %q (text %q)", c, role, artifact, body, text)
+		}
+	}
+	if text := FormatCommentText(&Comment{Body: "plain"}); text != "plain" {
+		t.Errorf("plain comment text = %q, want unchanged body", text)
+	}
+}
+
+func TestParseCommentTextIgnoresLookalikes(t *testing.T) {
+	text := "[gt is great\nsecond line"
+	if role, artifact, body := ParseCommentText(text); role != "" || artifact != "" || body != text {
+		t.Errorf("ParseCommentText(%q) = %q, %q, %q", text, role, artifact, body)
+	}
+}
+
+func TestParseComments(t *testing.T) {
+	out := []byte(`[
+		{"id": 1, "issue_id": "gt-1", "author": "steve", "text": "plain note", "created_at": "2026-03-02T09:00:00Z"},
+		{"id": 2, "issue_id": "gt-1", "author": "gastown/witness", "text": "[gt role=witness]\nstuck on lint", "created_at": "2026-03-02T10:00:00Z"}
+	]`)
+	comments, err := parseComments(out)
+	if err != nil {
+		t.Fatalf("parseComments: %v", err)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("got %d comments, want 2", len(comments))
+	}
+	if c := comments[0]; c.Author != "steve" || c.Role != "" || c.Body != "plain note" {
+		t.Errorf("comment 0 = %+v", c)
+	}
+	if c := comments[1]; c.Role != "witness" || c.Body != "stuck on lint" || c.CreatedAt.Hour() != 10 {
+		t.Errorf("comment 1 = %+v", c)
+	}
+}
@@ -0,0 +1,165 @@
+package beads
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HandoffNote is what an agent leaves for the agent it hands its work to:
+// where the work stands, what is left, and what to watch out for. It is
+// stored as a CommentKindHandoff comment on the transferred bead.
+type HandoffNote struct {
+	From      string   `json:"from"`
+	To        string   `json:"to"`
+	State     string   `json:"state"`
+	Remaining []string `json:"remaining,omitempty"`
+	Gotchas   []string `json:"gotchas,omitempty"`
+}
+
+const (
+	handoffNoteState     = "State:"
+	handoffNoteRemaining = "Remaining:"
+	handoffNoteGotchas   = "Gotchas:"
+)
+
+// FormatHandoffNote renders a note as readable comment text:
+//
+//	Handoff from gastown/polecats/Toast to gastown/crew/max
+//
+//	State: tests pass locally, PR not opened
+//
+//	Remaining:
+//	- open the PR
+//
+//	Gotchas:
+//	- lint is flaky on the sandbox runner
+func FormatHandoffNote(n *HandoffNote) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Handoff from %s to %s\n\n", n.From, n.To)
+	fmt.Fprintf(&sb, "%s %s\n", handoffNoteState, strings.TrimSpace(n.State))
+	writeList := func(heading string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(&sb, "\n%s\n", heading)
+		for _, item := range items {
+			fmt.Fprintf(&sb, "- %s\n", strings.TrimSpace(item))
+		}
+	}
+	writeList(handoffNoteRemaining, n.Remaining)
+	writeList(handoffNoteGotchas, n.Gotchas)
+	return sb.String()
+}
+
+// ParseHandoffNote decodes text written by FormatHandoffNote. Multi-line
+// state text is preserved.
+func ParseHandoffNote(text string) (*HandoffNote, error) {
+	first, rest, _ := strings.Cut(text, "\n")
+	from, to, ok := strings.Cut(strings.TrimPrefix(first, "Handoff from "), " to ")
+	if !strings.HasPrefix(first, "Handoff from ") || !ok {
+		return nil, fmt.Errorf("not a handoff note")
+	}
+	n := &HandoffNote{From: strings.TrimSpace(from), To: strings.TrimSpace(to)}
+
+	var list *[]string
+	var state []string
+	inState := false
+	for _, line := range strings.Split(rest, "\n") {
+		switch {
+		case strings.HasPrefix(line, handoffNoteState):
+			inState, list = true, nil
+			state = append(state, strings.TrimSpace(strings.TrimPrefix(line, handoffNoteState)))
+		case line == handoffNoteRemaining:
+			inState, list = false, &n.Remaining
+		case line == handoffNoteGotchas:
+			inState, list = false, &n.Gotchas
+		case list != nil && strings.HasPrefix(line, "- "):
+			*list = append(*list, strings.TrimPrefix(line, "- "))
+		case inState:
+			state = append(state, line)
+		}
+	}
+	n.State = strings.TrimSpace(strings.Join(state, "\n"))
+	return n, nil
+}
+
+// TransferBead hands a bead from note.From to note.To: it reassigns the bead
+// to the receiver's hook and then records the note as a handoff comment. If
+// the comment cannot be recorded, the bead goes back to note.From. The
+// bead lock is held throughout, and the transfer is refused unless note.From
+// still owns the bead, so two concurrent handoffs (or a handoff racing a
+// sling) cannot both succeed.
+func (b *Beads) TransferBead(id string, note *HandoffNote) error {
+	if strings.TrimSpace(note.State) == "" {
+		return fmt.Errorf("handoff note has no state")
+	}
+	if note.To == "" || note.From == note.To {
+		return fmt.Errorf("invalid handoff target %q", note.To)
+	}
+
+	unlock, err := b.lockBead(id)
+	if err != nil {
+		return fmt.Errorf("acquiring bead lock: %w", err)
+	}
+	defer unlock()
+
+	issue, err := b.Show(id)
+	if err != nil {
+		return fmt.Errorf("fetching %s: %w", id, err)
+	}
+	if issue.Status == "closed" {
+		return fmt.Errorf("%s is closed", id)
+	}
+	if strings.TrimSuffix(issue.Assignee, "/") != strings.TrimSuffix(note.From, "/") {
+		return fmt.Errorf("%s is assigned to %q, not %s", id, issue.Assignee, note.From)
+	}
+
+	// Reassign first and comment only once that succeeded, so a failed
+	// update never leaves a note claiming a transfer that did not happen.
+	status := StatusHooked
+	assignee := note.To
+	if err := b.Update(id, UpdateOptions{Status: &status, Assignee: &assignee}); err != nil {
+		return fmt.Errorf("reassigning %s: %w", id, err)
+	}
+
+	if err := b.AddComment(id, &Comment{
+		Author: note.From,
+		Kind:   CommentKindHandoff,
+		Body:   FormatHandoffNote(note),
+	}); err != nil {
+		// Without the note the receiver has nothing to resume from; give
+		// the bead back to the sender rather than hand it over blind.
+		if rerr := b.Update(id, UpdateOptions{Status: &issue.Status, Assignee: &issue.Assignee}); rerr != nil {
+			return fmt.Errorf("recording handoff note: %w (and restoring %s to %s failed: %v)", err, id, note.From, rerr)
+		}
+		return fmt.Errorf("recording handoff note: %w", err)
+	}
+	return nil
+}
+
+// LatestHandoffNote returns the most recent handoff note on a bead addressed
+// to agent, or nil if there is none.
+func (b *Beads) LatestHandoffNote(id, agent string) (*HandoffNote, *Comment, error) {
+	comments, err := b.Comments(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return latestHandoffNote(comments, agent)
+}
+
+func latestHandoffNote(comments []*Comment, agent string) (*HandoffNote, *Comment, error) {
+	for i := len(comments) - 1; i >= 0; i-- {
+		c := comments[i]
+		if c.Kind != CommentKindHandoff {
+			continue
+		}
+		n, err := ParseHandoffNote(c.Body)
+		if err != nil {
+			continue
+		}
+		if strings.TrimSuffix(n.To, "/") == strings.TrimSuffix(agent, "/") {
+			return n, c, nil
+		}
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,54 @@
+package beads
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHandoffNoteRoundTrip(t *testing.T) {
+	note := &HandoffNote{
+		From:      "gastown/polecats/Toast",
+		To:        "gastown/crew/max",
+		State:     "Tests pass locally.\nPR not opened yet.",
+		Remaining: []string{"open the PR", "ping the refinery"},
+		Gotchas:   []string{"lint is flaky on the sandbox runner"},
+	}
+	got, err := ParseHandoffNote(FormatHandoffNote(note))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, note) {
+		t.Errorf("round trip = %+v, want %+v", got, note)
+	}
+
+	if _, err := ParseHandoffNote("just a comment"); err == nil {
+		t.Error("ParseHandoffNote accepted a plain comment")
+	}
+}
+
+func TestLatestHandoffNote(t *testing.T) {
+	mk := func(from, to, state string) *Comment {
+		text := FormatCommentText(&Comment{
+			Kind: CommentKindHandoff,
+			Body: FormatHandoffNote(&HandoffNote{From: from, To: to, State: state}),
+		})
+		c := parseCommentText(text)
+		return &c
+	}
+	comments := []*Comment{
+		mk("a/polecats/x", "mayor/", "first"),
+		{Body: "Handoff from a to mayor/\n\nState: not a real note"},
+		mk("mayor/", "a/crew/y", "second"),
+	}
+
+	n, _, err := latestHandoffNote(comments, "mayor")
+	if err != nil || n == nil || n.State != "first" {
+		t.Errorf("note for mayor = %+v, %v", n, err)
+	}
+	if n, _, _ := latestHandoffNote(comments, "a/crew/y"); n == nil || n.From != "mayor/" {
+		t.Errorf("note for a/crew/y = %+v", n)
+	}
+	if n, _, _ := latestHandoffNote(comments, "deacon/"); n != nil {
+		t.Errorf("note for deacon = %+v, want none", n)
+	}
+}
@@ -0,0 +1,225 @@
+package beads
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ImportItem is one bead parsed from an import file (see ParseImportCSV and
+// ParseImportChecklist).
+type ImportItem struct {
+	Line        int // 1-based line in the source file, for error messages
+	ID          string
+	Title       string
+	Description string
+	Type        string
+	Priority    int // 0-4, or -1 when the file doesn't say
+	Labels      []string
+	Done        bool // A checked checklist item, or status "closed" in CSV
+}
+
+// importPriorityWords maps priority names to beads priorities.
+var importPriorityWords = map[string]int{
+	"critical": 0, "urgent": 0,
+	"high":   1,
+	"normal": 2, "medium": 2,
+	"low":     3,
+	"backlog": 4,
+}
+
+// ParseImportPriority parses a priority as a number ("1"), a P-number
+// ("P1"), or a word ("high"). An empty string is -1 (unset).
+func ParseImportPriority(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return -1, nil
+	}
+	if p, ok := importPriorityWords[s]; ok {
+		return p, nil
+	}
+	p, err := strconv.Atoi(strings.TrimPrefix(s, "p"))
+	if err != nil || p < 0 || p > 4 {
+		return 0, fmt.Errorf("invalid priority %q: want 0-4, P0-P4, or critical/high/normal/low/backlog", s)
+	}
+	return p, nil
+}
+
+// splitImportLabels splits a label list on commas or semicolons.
+func splitImportLabels(s string) []string {
+	var labels []string
+	for _, l := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' }) {
+		if l = strings.TrimSpace(l); l != "" {
+			labels = append(labels, l)
+		}
+	}
+	return labels
+}
+
+// ParseImportCSV parses a CSV file with a header row. The title column is
+// required; id, description, type, priority, labels (comma or semicolon
+// separated), and status are optional and other columns are ignored.
+// Header names are case-insensitive.
+func ParseImportCSV(r io.Reader) ([]ImportItem, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("empty CSV: a header row with a title column is required")
+		}
+		return nil, err
+	}
+	cols := map[string]int{}
+	for i, h := range header {
+		cols[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+	if _, ok := cols["title"]; !ok {
+		return nil, fmt.Errorf("CSV header has no title column (got %s)", strings.Join(header, ", "))
+	}
+
+	var items []ImportItem
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(rec) {
+				return strings.TrimSpace(rec[i])
+			}
+			return ""
+		}
+
+		item := ImportItem{
+			Line:        line,
+			ID:          field("id"),
+			Title:       field("title"),
+			Description: field("description"),
+			Type:        strings.ToLower(field("type")),
+			Labels:      splitImportLabels(field("labels")),
+		}
+		if item.Title == "" && item.ID == "" && item.Description == "" {
+			continue // blank row
+		}
+		if item.Title == "" {
+			return nil, fmt.Errorf("line %d: title is required", line)
+		}
+		if item.Priority, err = ParseImportPriority(field("priority")); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		switch strings.ToLower(field("status")) {
+		case "closed", "done":
+			item.Done = true
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+var (
+	checklistItemRe = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s+(.+)$`)
+	checklistHeadRe = regexp.MustCompile(`^#{1,6}\s+(.+)$`)
+	checklistTagRe  = regexp.MustCompile(`^#[A-Za-z0-9][\w:.-]*$`)
+	checklistPriRe  = regexp.MustCompile(`^[pP][0-4]$`)
+	labelSlugRe     = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// ParseImportChecklist parses a markdown checklist. Each "- [ ] title" line
+// is an item ("- [x]" marks it done). Trailing #tags become labels and a
+// trailing P0-P4 sets the priority. Lines indented under an item become its
+// description, and the nearest heading becomes a label ("## Backend Work"
+// labels items backend-work).
+func ParseImportChecklist(r io.Reader) ([]ImportItem, error) {
+	var items []ImportItem
+	var section string
+	var cur *ImportItem
+	curIndent := 0
+	var desc []string
+
+	flush := func() {
+		if cur != nil {
+			cur.Description = strings.TrimSpace(strings.Join(desc, "\n"))
+			items = append(items, *cur)
+		}
+		cur, desc = nil, nil
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimRight(sc.Text(), " \t\r")
+		if m := checklistItemRe.FindStringSubmatch(line); m != nil {
+			flush()
+			item := parseChecklistTitle(m[3])
+			item.Line = n
+			item.Done = m[2] != " "
+			if section != "" {
+				item.Labels = append([]string{section}, item.Labels...)
+			}
+			cur, curIndent = &item, len(m[1])
+			continue
+		}
+		if m := checklistHeadRe.FindStringSubmatch(strings.TrimSpace(line)); m != nil && !strings.HasPrefix(line, " ") {
+			flush()
+			section = strings.Trim(labelSlugRe.ReplaceAllString(strings.ToLower(m[1]), "-"), "-")
+			continue
+		}
+		if cur == nil {
+			continue
+		}
+		trimmed := strings.TrimLeft(line, " \t")
+		switch {
+		case trimmed == "":
+			desc = append(desc, "")
+		case len(line)-len(trimmed) > curIndent:
+			desc = append(desc, trimmed)
+		default:
+			flush() // Unindented prose ends the item.
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return items, nil
+}
+
+// parseChecklistTitle splits trailing #tags and a P0-P4 priority off a
+// checklist item's text.
+func parseChecklistTitle(text string) ImportItem {
+	item := ImportItem{Priority: -1}
+	fields := strings.Fields(text)
+	for len(fields) > 1 {
+		last := fields[len(fields)-1]
+		switch {
+		case checklistTagRe.MatchString(last):
+			item.Labels = append([]string{last[1:]}, item.Labels...)
+		case checklistPriRe.MatchString(last) && item.Priority < 0:
+			item.Priority = int(last[1] - '0')
+		default:
+			item.Title = strings.Join(fields, " ")
+			return item
+		}
+		fields = fields[:len(fields)-1]
+	}
+	item.Title = strings.Join(fields, " ")
+	return item
+}
+
+// NormalizeImportTitle returns the key import dedups titles on: lowercase
+// with whitespace collapsed and trailing punctuation dropped.
+func NormalizeImportTitle(title string) string {
+	return strings.TrimRight(strings.Join(strings.Fields(strings.ToLower(title)), " "), ".!?:;")
+}
@@ -0,0 +1,94 @@
+package beads
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseImportCSV(t *testing.T) {
+	in := `Title,Description,Priority,Labels,Status,Owner
+Fix login race,"Fails 1 in 5 runs, see CI",P1,"auth; flaky",,max
+Write docs,,low,,done,
+
+Upgrade Go,,,tooling,,
+`
+	items, err := ParseImportCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("got %d items, want 3 (blank row skipped): %+v", len(items), items)
+	}
+	want := ImportItem{Line: 2, Title: "Fix login race", Description: "Fails 1 in 5 runs, see CI", Priority: 1, Labels: []string{"auth", "flaky"}}
+	if !reflect.DeepEqual(items[0], want) {
+		t.Errorf("items[0] = %+v, want %+v", items[0], want)
+	}
+	if items[1].Priority != 3 || !items[1].Done {
+		t.Errorf("items[1] = %+v, want priority 3 and done", items[1])
+	}
+	if items[2].Priority != -1 || items[2].Line != 5 {
+		t.Errorf("items[2] = %+v, want unset priority on line 5", items[2])
+	}
+}
+
+func TestParseImportCSVErrors(t *testing.T) {
+	for name, in := range map[string]string{
+		"no title column": "name,priority\nx,1\n",
+		"bad priority":    "title,priority\nx,urgentish\n",
+		"missing title":   "title,description\n,only a description\n",
+		"empty":           "",
+	} {
+		if _, err := ParseImportCSV(strings.NewReader(in)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestParseImportChecklist(t *testing.T) {
+	in := `# Legacy TODO
+
+Some intro prose.
+
+## Backend Work
+- [ ] Fix login race P1 #auth #flaky
+  Fails about 1 in 5 runs.
+  See the CI dashboard.
+- [x] Remove old cron job
+
+## Docs
+* [ ] Write the setup guide
+Unrelated paragraph.
+- [ ] Use #hashtags in the middle
+`
+	items, err := ParseImportChecklist(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 4 {
+		t.Fatalf("got %d items, want 4: %+v", len(items), items)
+	}
+	first := ImportItem{
+		Line: 6, Title: "Fix login race", Priority: 1,
+		Description: "Fails about 1 in 5 runs.\nSee the CI dashboard.",
+		Labels:      []string{"backend-work", "auth", "flaky"},
+	}
+	if !reflect.DeepEqual(items[0], first) {
+		t.Errorf("items[0] = %+v, want %+v", items[0], first)
+	}
+	if !items[1].Done || items[1].Title != "Remove old cron job" {
+		t.Errorf("items[1] = %+v, want done", items[1])
+	}
+	if items[2].Description != "" || !reflect.DeepEqual(items[2].Labels, []string{"docs"}) {
+		t.Errorf("items[2] = %+v, want no description and the docs label", items[2])
+	}
+	if items[3].Title != "Use #hashtags in the middle" {
+		t.Errorf("items[3].Title = %q", items[3].Title)
+	}
+}
+
+func TestNormalizeImportTitle(t *testing.T) {
+	if NormalizeImportTitle("  Fix   Login race. ") != NormalizeImportTitle("fix login race") {
+		t.Error("titles differing only in case, spacing, and trailing punctuation should match")
+	}
+}
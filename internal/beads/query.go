@@ -0,0 +1,700 @@
+// Package beads provides a small query language over bead fields.
+package beads
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// A bead query is a boolean expression over bead fields:
+//
+//	status = in_progress AND updated_at < now()-1h AND rig = "gastown"
+//	priority <= 1 AND (label = gt:bug OR type IN (bug, incident))
+//	NOT assignee = ""
+//
+// Operators are =, !=, <, <=, >, >=, and IN (...), combined with AND, OR,
+// NOT, and parentheses (keywords are case-insensitive). Values are bare
+// words, quoted strings, numbers, true/false, timestamps, or now() with an
+// optional offset such as now()-90m or now()-2d.
+//
+// Fields:
+//
+//	id, title, status, type, assignee, created_by   strings (= != IN)
+//	priority                                        number
+//	created_at, updated_at, closed_at               times
+//	label                                           has-label (= != IN)
+//	ephemeral                                       bool
+//	rig                                             the rig the bead lives in
+//
+// A compiled query is evaluated in Go over decoded issues (Match, Filter,
+// FilterJSONL) or rendered as a SQL WHERE clause for bd sql against Dolt
+// (SQL). Both paths share semantics: a missing string equals "", and a
+// missing time never satisfies a comparison.
+
+// QueryEnv supplies the context a query is evaluated in.
+type QueryEnv struct {
+	Now time.Time // Anchor for now(); zero means time.Now()
+	Rig string    // Rig the beads belong to (for rig predicates)
+}
+
+func (e QueryEnv) now() time.Time {
+	if e.Now.IsZero() {
+		return time.Now()
+	}
+	return e.Now
+}
+
+// Predicate is a compiled bead query.
+type Predicate struct {
+	src  string
+	root queryNode // nil matches everything
+}
+
+// Query compiles a bead query. An empty query matches every bead.
+func Query(src string) (*Predicate, error) {
+	toks, err := lexQuery(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{toks: toks}
+	if p.peek().kind == tokEOF {
+		return &Predicate{src: src}, nil
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("query %q: %w", src, err)
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("query %q: unexpected %q", src, t.text)
+	}
+	return &Predicate{src: src, root: root}, nil
+}
+
+// MustQuery is like Query but panics on error. Use it for constant queries.
+func MustQuery(src string) *Predicate {
+	p, err := Query(src)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the query source.
+func (p *Predicate) String() string { return p.src }
+
+// Match reports whether issue satisfies the query.
+func (p *Predicate) Match(issue *Issue, env QueryEnv) bool {
+	if p.root == nil {
+		return true
+	}
+	return p.root.match(issue, env)
+}
+
+// Filter returns the issues that satisfy the query, in order.
+func (p *Predicate) Filter(issues []*Issue, env QueryEnv) []*Issue {
+	var out []*Issue
+	for _, issue := range issues {
+		if p.Match(issue, env) {
+			out = append(out, issue)
+		}
+	}
+	return out
+}
+
+// FilterJSONL reads an issues.jsonl export and returns the issues that
+// satisfy the query. Malformed lines are skipped; a missing file yields none.
+func (p *Predicate) FilterJSONL(path string, env QueryEnv) ([]*Issue, error) {
+	f, err := os.Open(path) //nolint:gosec // G304: caller-provided export path
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var out []*Issue
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var issue Issue
+		if err := json.Unmarshal(scanner.Bytes(), &issue); err != nil || issue.ID == "" {
+			continue
+		}
+		if p.Match(&issue, env) {
+			out = append(out, &issue)
+		}
+	}
+	return out, scanner.Err()
+}
+
+// SQL renders the query as a WHERE clause for the beads issues table. Values
+// are inlined as escaped literals because bd sql takes a single statement.
+// Rig predicates fold to TRUE or FALSE against env.Rig.
+func (p *Predicate) SQL(env QueryEnv) (string, error) {
+	if p.root == nil {
+		return "TRUE", nil
+	}
+	var b strings.Builder
+	if err := p.root.sql(&b, env); err != nil {
+		return "", fmt.Errorf("query %q: %w", p.src, err)
+	}
+	return b.String(), nil
+}
+
+// Field kinds.
+type queryKind int
+
+const (
+	kindString queryKind = iota
+	kindNumber
+	kindTime
+	kindLabel
+	kindBool
+	kindRig
+)
+
+type queryField struct {
+	name   string
+	column string
+	kind   queryKind
+	get    func(*Issue) string
+}
+
+var queryFields = map[string]*queryField{
+	"id":         {column: "id", kind: kindString, get: func(i *Issue) string { return i.ID }},
+	"title":      {column: "title", kind: kindString, get: func(i *Issue) string { return i.Title }},
+	"status":     {column: "status", kind: kindString, get: func(i *Issue) string { return i.Status }},
+	"type":       {column: "issue_type", kind: kindString, get: func(i *Issue) string { return i.Type }},
+	"assignee":   {column: "assignee", kind: kindString, get: func(i *Issue) string { return i.Assignee }},
+	"created_by": {column: "created_by", kind: kindString, get: func(i *Issue) string { return i.CreatedBy }},
+	"priority":   {column: "priority", kind: kindNumber, get: func(i *Issue) string { return strconv.Itoa(i.Priority) }},
+	"created_at": {column: "created_at", kind: kindTime, get: func(i *Issue) string { return i.CreatedAt }},
+	"updated_at": {column: "updated_at", kind: kindTime, get: func(i *Issue) string { return i.UpdatedAt }},
+	"closed_at":  {column: "closed_at", kind: kindTime, get: func(i *Issue) string { return i.ClosedAt }},
+	"label":      {kind: kindLabel},
+	"ephemeral":  {column: "ephemeral", kind: kindBool, get: func(i *Issue) string { return strconv.FormatBool(i.Ephemeral) }},
+	"rig":        {kind: kindRig},
+}
+
+func init() {
+	for name, f := range queryFields {
+		f.name = name
+	}
+	// Aliases, added after naming so errors use the canonical name.
+	queryFields["issue_type"] = queryFields["type"]
+	queryFields["labels"] = queryFields["label"]
+}
+
+// queryValue is a literal. Times are either absolute or relative to now().
+type queryValue struct {
+	str      string
+	num      float64
+	at       time.Time
+	relative bool          // now() + offset
+	offset   time.Duration // For relative times
+}
+
+func (v queryValue) time(env QueryEnv) time.Time {
+	if v.relative {
+		return env.now().Add(v.offset)
+	}
+	return v.at
+}
+
+type queryNode interface {
+	match(issue *Issue, env QueryEnv) bool
+	sql(b *strings.Builder, env QueryEnv) error
+}
+
+type andNode struct{ l, r queryNode }
+type orNode struct{ l, r queryNode }
+type notNode struct{ x queryNode }
+
+func (n andNode) match(i *Issue, env QueryEnv) bool { return n.l.match(i, env) && n.r.match(i, env) }
+func (n orNode) match(i *Issue, env QueryEnv) bool  { return n.l.match(i, env) || n.r.match(i, env) }
+func (n notNode) match(i *Issue, env QueryEnv) bool { return !n.x.match(i, env) }
+
+func (n andNode) sql(b *strings.Builder, env QueryEnv) error {
+	return sqlBinary(b, env, n.l, "AND", n.r)
+}
+func (n orNode) sql(b *strings.Builder, env QueryEnv) error { return sqlBinary(b, env, n.l, "OR", n.r) }
+func (n notNode) sql(b *strings.Builder, env QueryEnv) error {
+	b.WriteString("NOT ")
+	return sqlGroup(b, env, n.x)
+}
+
+func sqlBinary(b *strings.Builder, env QueryEnv, l queryNode, op string, r queryNode) error {
+	if err := sqlGroup(b, env, l); err != nil {
+		return err
+	}
+	b.WriteString(" " + op + " ")
+	return sqlGroup(b, env, r)
+}
+
+func sqlGroup(b *strings.Builder, env QueryEnv, n queryNode) error {
+	b.WriteByte('(')
+	if err := n.sql(b, env); err != nil {
+		return err
+	}
+	b.WriteByte(')')
+	return nil
+}
+
+// cmpNode compares a field with one value, or with a list for IN.
+type cmpNode struct {
+	field *queryField
+	op    string // =, !=, <, <=, >, >=, in
+	vals  []queryValue
+}
+
+func (n cmpNode) match(issue *Issue, env QueryEnv) bool {
+	switch n.field.kind {
+	case kindLabel:
+		has := slices.ContainsFunc(n.vals, func(v queryValue) bool { return HasLabel(issue, v.str) })
+		return has == (n.op != "!=")
+	case kindRig:
+		in := slices.ContainsFunc(n.vals, func(v queryValue) bool { return v.str == env.Rig })
+		return in == (n.op != "!=")
+	case kindNumber:
+		got, err := strconv.ParseFloat(n.field.get(issue), 64)
+		return err == nil && compareOrdered(got, n.op, n.vals[0].num)
+	case kindTime:
+		got, ok := parseIssueTime(n.field.get(issue))
+		if !ok {
+			return false
+		}
+		want := n.vals[0].time(env)
+		return compareOrdered(got.UnixNano(), n.op, want.UnixNano())
+	default: // kindString, kindBool
+		got := n.field.get(issue)
+		in := slices.ContainsFunc(n.vals, func(v queryValue) bool { return v.str == got })
+		return in == (n.op != "!=")
+	}
+}
+
+func compareOrdered[T int64 | float64](a T, op string, b T) bool {
+	switch op {
+	case "=":
+		return a == b
+	case "!=":
+		return a != b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	}
+	return false
+}
+
+func (n cmpNode) sql(b *strings.Builder, env QueryEnv) error {
+	col := n.field.column
+	switch n.field.kind {
+	case kindRig:
+		if env.Rig == "" {
+			return fmt.Errorf("rig predicate needs a rig to evaluate against")
+		}
+		if n.match(nil, env) {
+			b.WriteString("TRUE")
+		} else {
+			b.WriteString("FALSE")
+		}
+	case kindLabel:
+		if n.op == "!=" {
+			b.WriteString("NOT ")
+		}
+		b.WriteString("id IN (SELECT issue_id FROM labels WHERE label IN (" + sqlList(n.vals) + "))")
+	case kindNumber:
+		fmt.Fprintf(b, "%s %s %s", col, sqlOp(n.op), strconv.FormatFloat(n.vals[0].num, 'f', -1, 64))
+	case kindTime:
+		fmt.Fprintf(b, "%s %s %s", col, sqlOp(n.op), sqlString(n.vals[0].time(env).UTC().Format("2006-01-02 15:04:05")))
+	case kindBool:
+		truth := (n.vals[0].str == "true") == (n.op == "=")
+		if truth {
+			fmt.Fprintf(b, "%s = 1", col)
+		} else {
+			fmt.Fprintf(b, "(%s = 0 OR %s IS NULL)", col, col)
+		}
+	default:
+		// A NULL column reads as "" so SQL agrees with Match.
+		hasEmpty := slices.ContainsFunc(n.vals, func(v queryValue) bool { return v.str == "" })
+		list := col + " IN (" + sqlList(n.vals) + ")"
+		if len(n.vals) == 1 && n.op != "in" {
+			list = col + " = " + sqlString(n.vals[0].str)
+		}
+		switch {
+		case n.op == "!=" && hasEmpty:
+			fmt.Fprintf(b, "(%s IS NOT NULL AND NOT %s)", col, list)
+		case n.op == "!=":
+			fmt.Fprintf(b, "(%s IS NULL OR NOT %s)", col, list)
+		case hasEmpty:
+			fmt.Fprintf(b, "(%s IS NULL OR %s)", col, list)
+		default:
+			b.WriteString(list)
+		}
+	}
+	return nil
+}
+
+func sqlOp(op string) string {
+	if op == "!=" {
+		return "<>"
+	}
+	return op
+}
+
+func sqlList(vals []queryValue) string {
+	parts := make([]string, len(vals))
+	for i, v := range vals {
+		parts[i] = sqlString(v.str)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// sqlString quotes s as a MySQL string literal.
+func sqlString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `''`)
+	return "'" + s + "'"
+}
+
+// parseIssueTime parses the timestamp formats bd emits.
+func parseIssueTime(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Lexer.
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokWord
+	tokString
+	tokOp
+	tokLParen
+	tokRParen
+	tokComma
+	tokNow
+)
+
+type queryToken struct {
+	kind   tokKind
+	text   string
+	offset time.Duration // For tokNow
+}
+
+func isQueryWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_-./:+@", r)
+}
+
+func lexQuery(src string) ([]queryToken, error) {
+	var toks []queryToken
+	rs := []rune(src)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			toks = append(toks, queryToken{kind: tokLParen, text: "("})
+			i++
+		case r == ')':
+			toks = append(toks, queryToken{kind: tokRParen, text: ")"})
+			i++
+		case r == ',':
+			toks = append(toks, queryToken{kind: tokComma, text: ","})
+			i++
+		case r == '"' || r == '\'':
+			j := i + 1
+			var sb strings.Builder
+			for ; j < len(rs) && rs[j] != r; j++ {
+				if rs[j] == '\\' && j+1 < len(rs) {
+					j++
+				}
+				sb.WriteRune(rs[j])
+			}
+			if j >= len(rs) {
+				return nil, fmt.Errorf("unterminated string in query %q", src)
+			}
+			toks = append(toks, queryToken{kind: tokString, text: sb.String()})
+			i = j + 1
+		case strings.ContainsRune("=!<>", r):
+			op := string(r)
+			if i+1 < len(rs) && rs[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("unexpected '!' in query %q", src)
+			}
+			if op == "==" {
+				op = "="
+			}
+			toks = append(toks, queryToken{kind: tokOp, text: op})
+			i += len(op)
+			if op == "=" && i < len(rs) && rs[i] == '=' {
+				i++
+			}
+		case isQueryWordRune(r):
+			j := i
+			for j < len(rs) && isQueryWordRune(rs[j]) {
+				j++
+			}
+			word := string(rs[i:j])
+			i = j
+			if strings.EqualFold(word, "now") && i+1 < len(rs) && rs[i] == '(' && rs[i+1] == ')' {
+				i += 2
+				tok := queryToken{kind: tokNow, text: "now()"}
+				k := i
+				for k < len(rs) && unicode.IsSpace(rs[k]) {
+					k++
+				}
+				if k < len(rs) && (rs[k] == '-' || rs[k] == '+') {
+					sign := rs[k]
+					k++
+					for k < len(rs) && unicode.IsSpace(rs[k]) {
+						k++
+					}
+					e := k
+					for e < len(rs) && isQueryWordRune(rs[e]) {
+						e++
+					}
+					d, err := parseQueryDuration(string(rs[k:e]))
+					if err != nil {
+						return nil, fmt.Errorf("query %q: %w", src, err)
+					}
+					if sign == '-' {
+						d = -d
+					}
+					tok.offset, tok.text, i = d, string(rs[i-5:e]), e
+				}
+				toks = append(toks, tok)
+				continue
+			}
+			toks = append(toks, queryToken{kind: tokWord, text: word})
+		default:
+			return nil, fmt.Errorf("unexpected %q in query %q", r, src)
+		}
+	}
+	return append(toks, queryToken{kind: tokEOF}), nil
+}
+
+// parseQueryDuration parses Go durations plus a "d" (day) suffix.
+func parseQueryDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseFloat(days, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n * float64(24*time.Hour)), nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return d, nil
+}
+
+// Parser.
+
+type queryParser struct {
+	toks []queryToken
+	pos  int
+}
+
+func (p *queryParser) peek() queryToken { return p.toks[p.pos] }
+
+func (p *queryParser) next() queryToken {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *queryParser) keyword(kw string) bool {
+	t := p.peek()
+	if t.kind == tokWord && strings.EqualFold(t.text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("OR") {
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = orNode{l, r}
+	}
+	return l, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	l, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("AND") {
+		r, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l = andNode{l, r}
+	}
+	return l, nil
+}
+
+func (p *queryParser) parseNot() (queryNode, error) {
+	if p.keyword("NOT") {
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{x}, nil
+	}
+	if p.peek().kind == tokLParen {
+		p.next()
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokRParen {
+			return nil, fmt.Errorf("expected ')', got %q", t.text)
+		}
+		return x, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *queryParser) parseComparison() (queryNode, error) {
+	t := p.next()
+	if t.kind != tokWord {
+		return nil, fmt.Errorf("expected a field, got %q", t.text)
+	}
+	field, ok := queryFields[strings.ToLower(t.text)]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q", t.text)
+	}
+
+	n := cmpNode{field: field}
+	if p.keyword("IN") {
+		n.op = "in"
+		if t := p.next(); t.kind != tokLParen {
+			return nil, fmt.Errorf("expected '(' after IN, got %q", t.text)
+		}
+		for {
+			v, err := p.parseValue(field)
+			if err != nil {
+				return nil, err
+			}
+			n.vals = append(n.vals, v)
+			t := p.next()
+			if t.kind == tokRParen {
+				break
+			}
+			if t.kind != tokComma {
+				return nil, fmt.Errorf("expected ',' or ')' in IN list, got %q", t.text)
+			}
+		}
+	} else {
+		op := p.next()
+		if op.kind != tokOp {
+			return nil, fmt.Errorf("expected an operator after %s, got %q", field.name, op.text)
+		}
+		n.op = op.text
+		v, err := p.parseValue(field)
+		if err != nil {
+			return nil, err
+		}
+		n.vals = []queryValue{v}
+	}
+
+	ordered := n.op != "=" && n.op != "!=" && n.op != "in"
+	switch field.kind {
+	case kindNumber, kindTime:
+		if n.op == "in" && field.kind == kindTime {
+			return nil, fmt.Errorf("IN is not supported for %s", field.name)
+		}
+		if n.op == "in" {
+			// priority IN (0, 1) is sugar for an OR of equalities.
+			var node queryNode = cmpNode{field: field, op: "=", vals: n.vals[:1]}
+			for _, v := range n.vals[1:] {
+				node = orNode{node, cmpNode{field: field, op: "=", vals: []queryValue{v}}}
+			}
+			return node, nil
+		}
+	case kindBool:
+		if ordered || n.op == "in" {
+			return nil, fmt.Errorf("%s supports only = and !=", field.name)
+		}
+	default:
+		if ordered {
+			return nil, fmt.Errorf("%s supports only =, !=, and IN", field.name)
+		}
+	}
+	return n, nil
+}
+
+func (p *queryParser) parseValue(field *queryField) (queryValue, error) {
+	t := p.next()
+	switch t.kind {
+	case tokWord, tokString, tokNow:
+	default:
+		return queryValue{}, fmt.Errorf("expected a value for %s, got %q", field.name, t.text)
+	}
+
+	switch field.kind {
+	case kindNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil || t.kind == tokNow {
+			return queryValue{}, fmt.Errorf("%s needs a number, got %q", field.name, t.text)
+		}
+		return queryValue{num: n}, nil
+	case kindTime:
+		if t.kind == tokNow {
+			return queryValue{relative: true, offset: t.offset}, nil
+		}
+		at, ok := parseIssueTime(t.text)
+		if !ok {
+			return queryValue{}, fmt.Errorf("%s needs now() or a timestamp, got %q", field.name, t.text)
+		}
+		return queryValue{at: at}, nil
+	case kindBool:
+		b, err := strconv.ParseBool(t.text)
+		if err != nil || t.kind == tokNow {
+			return queryValue{}, fmt.Errorf("%s needs true or false, got %q", field.name, t.text)
+		}
+		return queryValue{str: strconv.FormatBool(b)}, nil
+	default:
+		if t.kind == tokNow {
+			return queryValue{}, fmt.Errorf("%s cannot compare with now()", field.name)
+		}
+		return queryValue{str: t.text}, nil
+	}
+}
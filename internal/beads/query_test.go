@@ -0,0 +1,129 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQueryMatch(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	env := QueryEnv{Now: now, Rig: "gastown"}
+	stale := &Issue{ID: "gt-1", Status: "in_progress", Priority: 1, Type: "bug", Assignee: "gastown/polecats/Toast",
+		UpdatedAt: now.Add(-2 * time.Hour).Format(time.RFC3339), Labels: []string{"gt:bug"}}
+	fresh := &Issue{ID: "gt-2", Status: "in_progress", Priority: 3, Type: "task",
+		UpdatedAt: now.Add(-10 * time.Minute).Format(time.RFC3339), Ephemeral: true}
+	closed := &Issue{ID: "gt-3", Status: "closed", Priority: 2, Type: "task", ClosedAt: "2026-03-01 09:00:00"}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{``, []string{"gt-1", "gt-2", "gt-3"}},
+		{`status=in_progress AND updated_at < now()-1h AND rig="gastown"`, []string{"gt-1"}},
+		{`status = in_progress and updated_at >= now() - 1h`, []string{"gt-2"}},
+		{`rig = beads`, nil},
+		{`priority <= 1 OR type IN (task)`, []string{"gt-1", "gt-2", "gt-3"}},
+		{`priority IN (1, 2)`, []string{"gt-1", "gt-3"}},
+		{`label = gt:bug`, []string{"gt-1"}},
+		{`label != gt:bug`, []string{"gt-2", "gt-3"}},
+		{`assignee = ""`, []string{"gt-2", "gt-3"}},
+		{`NOT (assignee = "" OR status = closed)`, []string{"gt-1"}},
+		{`ephemeral = true`, []string{"gt-2"}},
+		{`closed_at < "2026-03-02"`, []string{"gt-3"}},
+		{`closed_at > "2020-01-01" OR updated_at > now()-1d`, []string{"gt-1", "gt-2", "gt-3"}},
+		{`status != closed AND updated_at < now()-30m`, []string{"gt-1"}},
+	}
+	for _, tt := range tests {
+		p, err := Query(tt.query)
+		if err != nil {
+			t.Fatalf("Query(%q): %v", tt.query, err)
+		}
+		var got []string
+		for _, issue := range p.Filter([]*Issue{stale, fresh, closed}, env) {
+			got = append(got, issue.ID)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("%s: got %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestQueryErrors(t *testing.T) {
+	for _, q := range []string{
+		`bogus = 1`,
+		`status`,
+		`status < open`,
+		`priority = high`,
+		`updated_at < yesterday`,
+		`updated_at < now()-soon`,
+		`ephemeral IN (true)`,
+		`status = "open`,
+		`(status = open`,
+		`status = open extra`,
+		`status ! open`,
+	} {
+		if _, err := Query(q); err == nil {
+			t.Errorf("Query(%q) succeeded, want error", q)
+		}
+	}
+}
+
+func TestQuerySQL(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		query string
+		rig   string
+		want  string
+	}{
+		{`status = in_progress AND updated_at < now()-1h`, "",
+			`(status = 'in_progress') AND (updated_at < '2026-03-02 11:00:00')`},
+		{`status = in_progress AND assignee = ""`, "",
+			`(status = 'in_progress') AND ((assignee IS NULL OR assignee = ''))`},
+		{`assignee != "gastown/witness"`, "",
+			`(assignee IS NULL OR NOT assignee = 'gastown/witness')`},
+		{`type IN (bug, task) AND priority < 2`, "",
+			`(issue_type IN ('bug', 'task')) AND (priority < 2)`},
+		{`label = gt:bug OR NOT ephemeral = true`, "",
+			`(id IN (SELECT issue_id FROM labels WHERE label IN ('gt:bug'))) OR (NOT (ephemeral = 1))`},
+		{`rig = gastown AND title = "it's"`, "gastown",
+			`(TRUE) AND (title = 'it''s')`},
+		{``, "", `TRUE`},
+	}
+	for _, tt := range tests {
+		got, err := MustQuery(tt.query).SQL(QueryEnv{Now: now, Rig: tt.rig})
+		if err != nil {
+			t.Fatalf("SQL(%q): %v", tt.query, err)
+		}
+		if got != tt.want {
+			t.Errorf("SQL(%q)\n got  %s\n want %s", tt.query, got, tt.want)
+		}
+	}
+
+	if _, err := MustQuery(`rig = gastown`).SQL(QueryEnv{}); err == nil {
+		t.Error("rig predicate without env.Rig should fail to render")
+	}
+}
+
+func TestQueryFilterJSONL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	data := `{"id":"gt-1","status":"open","priority":1}
+not json
+{"id":"gt-2","status":"closed","priority":1}
+`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := MustQuery(`status != closed`).FilterJSONL(path, QueryEnv{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != "gt-1" {
+		t.Errorf("FilterJSONL = %+v, want gt-1", got)
+	}
+	if got, err := MustQuery(``).FilterJSONL(filepath.Join(t.TempDir(), "missing.jsonl"), QueryEnv{}); err != nil || got != nil {
+		t.Errorf("missing file: %v, %v", got, err)
+	}
+}
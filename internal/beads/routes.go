@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
 )
 
 // Route represents a prefix-to-path routing rule.
@@ -60,11 +61,56 @@ func LoadRoutes(beadsDir string) ([]Route, error) {
 
 // AppendRoute appends a route to routes.jsonl in the town's beads directory.
 // If the prefix already exists, it updates the path.
+// The prefix is validated against, and recorded in, the town prefix registry.
 func AppendRoute(townRoot string, route Route) error {
 	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := registerRoutePrefix(townRoot, beadsDir, route); err != nil {
+		return err
+	}
 	return AppendRouteToDir(beadsDir, route)
 }
 
+// RouteOwner returns the prefix registry owner of a route path: the rig it
+// points into, or config.TownPrefixOwner for town-level beads (".").
+func RouteOwner(path string) string {
+	if path == "." || path == "" {
+		return config.TownPrefixOwner
+	}
+	return strings.SplitN(filepath.ToSlash(path), "/", 2)[0]
+}
+
+// registerRoutePrefix records a route's prefix in the town prefix registry,
+// refusing a prefix another owner holds or that was retired. The first
+// registration seeds the registry from the existing routes, so towns that
+// predate the registry start with it complete. Towns without town.json
+// (partial installs, tests) have no registry and skip this.
+func registerRoutePrefix(townRoot, beadsDir string, route Route) error {
+	path := constants.MayorTownPath(townRoot)
+	cfg, err := config.LoadTownConfig(path)
+	if err != nil {
+		return nil //nolint:nilerr // no town.json: nothing to register in
+	}
+
+	changed := false
+	if len(cfg.Prefixes) == 0 {
+		existing, _ := LoadRoutes(beadsDir)
+		for _, r := range existing {
+			// Best effort: conflicting legacy routes are reported by gt doctor.
+			if c, err := cfg.RegisterPrefix(strings.TrimSuffix(r.Prefix, "-"), RouteOwner(r.Path)); err == nil && c {
+				changed = true
+			}
+		}
+	}
+	c, err := cfg.RegisterPrefix(strings.TrimSuffix(route.Prefix, "-"), RouteOwner(route.Path))
+	if err != nil {
+		return fmt.Errorf("registering route prefix: %w", err)
+	}
+	if !changed && !c {
+		return nil
+	}
+	return config.SaveTownConfig(path, cfg)
+}
+
 // AppendRouteToDir appends a route to routes.jsonl in the given beads directory.
 // If the prefix already exists, it updates the path.
 func AppendRouteToDir(beadsDir string, route Route) error {
@@ -288,6 +334,7 @@ func GetRigNameForPrefix(townRoot, prefix string) string {
 // actual rig directory from the bead's prefix. hookWorkDir is only used as
 // a fallback if prefix resolution fails.
 func ResolveHookDir(townRoot, beadID, hookWorkDir string) string {
+	beadID = config.ForwardBeadID(townRoot, beadID)
 	// Always try prefix resolution first - bd update needs the actual rig dir
 	prefix := ExtractPrefix(beadID)
 	if rigPath := GetRigPathForPrefix(townRoot, prefix); rigPath != "" {
@@ -0,0 +1,69 @@
+package beads
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+func TestAppendRoute_RegistersPrefixes(t *testing.T) {
+	townRoot := t.TempDir()
+	townPath := constants.MayorTownPath(townRoot)
+	if err := config.SaveTownConfig(townPath, &config.TownConfig{Type: "town", Name: "town"}); err != nil {
+		t.Fatal(err)
+	}
+	// A town that predates the registry: routes exist, registry is empty.
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteRoutes(filepath.Join(townRoot, ".beads"), []Route{{Prefix: "hq-", Path: "."}, {Prefix: "gt-", Path: "gastown/mayor/rig"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := AppendRoute(townRoot, Route{Prefix: "bd-", Path: "beads/mayor/rig"}); err != nil {
+		t.Fatalf("AppendRoute: %v", err)
+	}
+	cfg, err := config.LoadTownConfig(townPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for prefix, owner := range map[string]string{"hq": config.TownPrefixOwner, "gt": "gastown", "bd": "beads"} {
+		if e, _ := cfg.LookupPrefix(prefix); e == nil || e.Owner != owner {
+			t.Errorf("registry entry for %s = %+v, want owner %s", prefix, e, owner)
+		}
+	}
+
+	// Re-pointing a prefix into another rig is refused.
+	err = AppendRoute(townRoot, Route{Prefix: "gt-", Path: "beads/mayor/rig"})
+	if !errors.Is(err, config.ErrPrefixConflict) {
+		t.Errorf("AppendRoute into another rig err = %v, want ErrPrefixConflict", err)
+	}
+	// Moving within the owning rig is fine.
+	if err := AppendRoute(townRoot, Route{Prefix: "gt-", Path: "gastown"}); err != nil {
+		t.Errorf("AppendRoute within the rig: %v", err)
+	}
+}
+
+func TestResolveHookDir_ForwardsRetiredPrefix(t *testing.T) {
+	townRoot := t.TempDir()
+	cfg := &config.TownConfig{Type: "town", Name: "town", Prefixes: []config.PrefixEntry{
+		{Prefix: "gas", Owner: "gastown", Retired: []string{"gt"}},
+	}}
+	if err := config.SaveTownConfig(constants.MayorTownPath(townRoot), cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteRoutes(filepath.Join(townRoot, ".beads"), []Route{{Prefix: "gas-", Path: "gastown"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := ResolveHookDir(townRoot, "gt-abc", ""), filepath.Join(townRoot, "gastown"); got != want {
+		t.Errorf("ResolveHookDir(old ID) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,231 @@
+package beads
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Cross-rig references let a bead in one rig depend on, or point at, a bead
+// in another. The fully-qualified form is "<rig>:<bead-id>" (e.g.
+// "beads:bd-abc12"); bd's own "external:<prefix>:<id>" dependency form and
+// bare IDs are accepted too, and resolved through routes.jsonl.
+
+// ErrXRefMismatch is returned when a qualified reference names a rig that
+// does not own the bead's prefix.
+var ErrXRefMismatch = errors.New("bead is not in the named rig")
+
+// XRef is a resolved cross-rig bead reference.
+type XRef struct {
+	Rig string `json:"rig"` // Owning rig, or config.TownPrefixOwner for town beads
+	ID  string `json:"id"`
+}
+
+// String returns the fully-qualified "<rig>:<id>" form.
+func (x XRef) String() string {
+	if x.Rig == "" {
+		return x.ID
+	}
+	return x.Rig + ":" + x.ID
+}
+
+// ParseXRef splits a reference into its rig qualifier and bead ID without
+// resolving it. bd's "external:<prefix>:<id>" form yields no rig (the prefix
+// is implied by the ID); a bare ID yields no rig.
+func ParseXRef(ref string) XRef {
+	ref = strings.TrimSpace(ref)
+	if strings.HasPrefix(ref, "external:") {
+		return XRef{ID: ExtractIssueID(ref)}
+	}
+	if rig, id, ok := strings.Cut(ref, ":"); ok && rig != "" && id != "" {
+		return XRef{Rig: rig, ID: id}
+	}
+	return XRef{ID: ref}
+}
+
+// IsQualifiedRef reports whether ref carries a rig qualifier or bd's external
+// wrapper, i.e. is more than a bare bead ID.
+func IsQualifiedRef(ref string) bool {
+	return strings.Contains(ref, ":")
+}
+
+// XRefResolver resolves references against a town's routes and prefix
+// registry. Routes are read once; use a new resolver per command.
+type XRefResolver struct {
+	townRoot string
+	routes   []Route
+	registry *config.TownConfig
+	cache    map[string]*Issue
+}
+
+// NewXRefResolver loads the town's routes and prefix registry.
+func NewXRefResolver(townRoot string) (*XRefResolver, error) {
+	routes, err := LoadRoutes(GetTownBeadsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading routes: %w", err)
+	}
+	// Longest prefix first, so hq-cv- wins over hq-.
+	sort.SliceStable(routes, func(i, j int) bool { return len(routes[i].Prefix) > len(routes[j].Prefix) })
+	return &XRefResolver{
+		townRoot: townRoot,
+		routes:   routes,
+		registry: config.LoadTownPrefixes(townRoot),
+		cache:    make(map[string]*Issue),
+	}, nil
+}
+
+// route returns the route serving id, if any.
+func (r *XRefResolver) route(id string) (Route, bool) {
+	for _, rt := range r.routes {
+		if strings.HasPrefix(id, rt.Prefix) {
+			return rt, true
+		}
+	}
+	return Route{}, false
+}
+
+// Resolve parses ref, forwards IDs under retired prefixes, and fills in the
+// owning rig. A qualifier that disagrees with the routes is ErrXRefMismatch.
+func (r *XRefResolver) Resolve(ref string) (XRef, error) {
+	x := ParseXRef(ref)
+	if x.ID == "" {
+		return XRef{}, fmt.Errorf("empty bead reference %q", ref)
+	}
+	if r.registry != nil {
+		x.ID = r.registry.ForwardID(x.ID)
+	}
+	rt, ok := r.route(x.ID)
+	if !ok {
+		return XRef{}, fmt.Errorf("no route for %s (prefix not in routes.jsonl)", x.ID)
+	}
+	owner := RouteOwner(rt.Path)
+	if x.Rig != "" && x.Rig != owner {
+		return XRef{}, fmt.Errorf("%w: %s belongs to %s, not %s", ErrXRefMismatch, x.ID, owner, x.Rig)
+	}
+	x.Rig = owner
+	return x, nil
+}
+
+// WorkDir returns the directory to run bd in for x.
+func (r *XRefResolver) WorkDir(x XRef) string {
+	if rt, ok := r.route(x.ID); ok && rt.Path != "." {
+		return filepath.Join(r.townRoot, rt.Path)
+	}
+	return r.townRoot
+}
+
+// Show resolves ref and reads the bead from its owning rig's database.
+func (r *XRefResolver) Show(ref string) (*Issue, XRef, error) {
+	x, err := r.Resolve(ref)
+	if err != nil {
+		return nil, XRef{}, err
+	}
+	if issue, ok := r.cache[x.ID]; ok {
+		return issue, x, nil
+	}
+	issue, err := New(r.WorkDir(x)).Show(x.ID)
+	if err != nil {
+		return nil, x, err
+	}
+	r.cache[x.ID] = issue
+	return issue, x, nil
+}
+
+// XBlocker is an open blocking dependency of a bead that lives in another
+// rig. Err is set when the dependency could not be read; such blockers are
+// reported rather than assumed closed.
+type XBlocker struct {
+	Ref    XRef   `json:"ref"`
+	Title  string `json:"title,omitempty"`
+	Status string `json:"status,omitempty"`
+	Err    string `json:"error,omitempty"`
+}
+
+// isBlockingDep reports whether a dependency type gates ready work. An empty
+// type is bd's default, "blocks".
+func isBlockingDep(depType string) bool {
+	switch depType {
+	case "", "blocks", "conditional-blocks", "waits-for":
+		return true
+	}
+	return false
+}
+
+// CrossRigBlockers returns issue's blocking dependencies that live outside
+// its own rig and are not closed, with their live status. bd only sees
+// status within one database, so these are the blockers bd ready misses.
+func (r *XRefResolver) CrossRigBlockers(issue *Issue) []XBlocker {
+	home := ""
+	if rt, ok := r.route(issue.ID); ok {
+		home = RouteOwner(rt.Path)
+	}
+
+	var refs []string
+	for _, dep := range issue.Dependencies {
+		if isBlockingDep(dep.DependencyType) {
+			refs = append(refs, dep.ID)
+		}
+	}
+	if len(issue.Dependencies) == 0 {
+		refs = append(refs, issue.DependsOn...)
+	}
+
+	var blockers []XBlocker
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		x, err := r.Resolve(ref)
+		if err != nil {
+			// Unroutable local IDs are bd's concern; only report external ones.
+			if strings.HasPrefix(ref, "external:") {
+				blockers = append(blockers, XBlocker{Ref: ParseXRef(ref), Err: err.Error()})
+			}
+			continue
+		}
+		if x.Rig == home || seen[x.ID] {
+			continue
+		}
+		seen[x.ID] = true
+		dep, _, err := r.Show(x.ID)
+		if err != nil {
+			blockers = append(blockers, XBlocker{Ref: x, Err: err.Error()})
+			continue
+		}
+		if IssueStatus(dep.Status).IsTerminal() {
+			continue
+		}
+		blockers = append(blockers, XBlocker{Ref: x, Title: dep.Title, Status: dep.Status})
+	}
+	return blockers
+}
+
+// AddCrossRigDependency records that issue depends on dependsOn, either of
+// which may be a qualified reference in any rig. The dependency is written
+// to issue's rig; bd stores out-of-database targets in its external form.
+func (r *XRefResolver) AddCrossRigDependency(issue, dependsOn, depType string) (XRef, XRef, error) {
+	from, err := r.Resolve(issue)
+	if err != nil {
+		return XRef{}, XRef{}, err
+	}
+	to, err := r.Resolve(dependsOn)
+	if err != nil {
+		return XRef{}, XRef{}, err
+	}
+	if from.ID == to.ID {
+		return XRef{}, XRef{}, fmt.Errorf("%s cannot depend on itself", from)
+	}
+	if _, _, err := r.Show(to.ID); err != nil {
+		return XRef{}, XRef{}, fmt.Errorf("dependency %s: %w", to, err)
+	}
+	args := []string{"dep", "add", from.ID, to.ID}
+	if depType != "" {
+		args = append(args, "--type="+depType)
+	}
+	if _, err := New(r.WorkDir(from)).run(args...); err != nil {
+		return XRef{}, XRef{}, err
+	}
+	return from, to, nil
+}
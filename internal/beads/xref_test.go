@@ -0,0 +1,119 @@
+package beads
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+func TestParseXRef(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want XRef
+	}{
+		{"beads:bd-abc12", XRef{Rig: "beads", ID: "bd-abc12"}},
+		{"external:bd:bd-abc12", XRef{ID: "bd-abc12"}},
+		{"gt-abc12", XRef{ID: "gt-abc12"}},
+		{" gt-abc12 ", XRef{ID: "gt-abc12"}},
+	}
+	for _, tt := range tests {
+		if got := ParseXRef(tt.ref); got != tt.want {
+			t.Errorf("ParseXRef(%q) = %+v, want %+v", tt.ref, got, tt.want)
+		}
+	}
+	if got := (XRef{Rig: "beads", ID: "bd-1"}).String(); got != "beads:bd-1" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestXRefResolver_Resolve(t *testing.T) {
+	townRoot := t.TempDir()
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	routes := []Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "hq-cv-", Path: "."},
+		{Prefix: "gas-", Path: "gastown/mayor/rig"},
+		{Prefix: "bd-", Path: "beads/mayor/rig"},
+	}
+	if err := WriteRoutes(beadsDir, routes); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &config.TownConfig{Type: "town", Name: "town", Prefixes: []config.PrefixEntry{
+		{Prefix: "gas", Owner: "gastown", Retired: []string{"gt"}},
+	}}
+	if err := config.SaveTownConfig(constants.MayorTownPath(townRoot), cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	xr, err := NewXRefResolver(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		ref     string
+		want    XRef
+		workDir string
+	}{
+		{"beads:bd-abc", XRef{Rig: "beads", ID: "bd-abc"}, "beads/mayor/rig"},
+		{"bd-abc", XRef{Rig: "beads", ID: "bd-abc"}, "beads/mayor/rig"},
+		{"external:bd:bd-abc", XRef{Rig: "beads", ID: "bd-abc"}, "beads/mayor/rig"},
+		{"gastown:gt-old", XRef{Rig: "gastown", ID: "gas-old"}, "gastown/mayor/rig"},
+		{"hq-cv-xyz", XRef{Rig: config.TownPrefixOwner, ID: "hq-cv-xyz"}, "."},
+	}
+	for _, tt := range tests {
+		got, err := xr.Resolve(tt.ref)
+		if err != nil {
+			t.Errorf("Resolve(%q): %v", tt.ref, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Resolve(%q) = %+v, want %+v", tt.ref, got, tt.want)
+		}
+		if dir := xr.WorkDir(got); dir != filepath.Join(townRoot, tt.workDir) {
+			t.Errorf("WorkDir(%s) = %q, want %q", got, dir, filepath.Join(townRoot, tt.workDir))
+		}
+	}
+
+	if _, err := xr.Resolve("gastown:bd-abc"); !errors.Is(err, ErrXRefMismatch) {
+		t.Errorf("wrong rig qualifier err = %v, want ErrXRefMismatch", err)
+	}
+	if _, err := xr.Resolve("zz-abc"); err == nil {
+		t.Error("unrouted prefix should fail")
+	}
+}
+
+func TestXRefResolver_CrossRigBlockersSkipsHomeRig(t *testing.T) {
+	townRoot := t.TempDir()
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteRoutes(beadsDir, []Route{{Prefix: "gt-", Path: "gastown/mayor/rig"}, {Prefix: "bd-", Path: "beads/mayor/rig"}}); err != nil {
+		t.Fatal(err)
+	}
+	xr, err := NewXRefResolver(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Same-rig and non-blocking dependencies never reach bd.
+	issue := &Issue{ID: "gt-a", Dependencies: []IssueDep{
+		{ID: "gt-b", DependencyType: "blocks"},
+		{ID: "bd-c", DependencyType: "related"},
+		{ID: "zz-d", DependencyType: "blocks"},
+	}}
+	if got := xr.CrossRigBlockers(issue); len(got) != 0 {
+		t.Errorf("CrossRigBlockers = %+v, want none", got)
+	}
+	// An external dependency that cannot be routed is reported, not dropped.
+	issue.Dependencies = []IssueDep{{ID: "external:zz:zz-d", DependencyType: "blocks"}}
+	if got := xr.CrossRigBlockers(issue); len(got) != 1 || got[0].Err == "" {
+		t.Errorf("CrossRigBlockers = %+v, want one unresolved blocker", got)
+	}
+}
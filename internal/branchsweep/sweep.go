@@ -0,0 +1,265 @@
+// Package branchsweep deletes wisp branches left behind by finished work.
+//
+// Every polecat run gets its own branch, and most are never cleaned up:
+// the refinery deletes merged branches on origin, but the shared repo
+// keeps its local copies, and abandoned work leaves branches nobody
+// merges. The sweeper finds branches whose wisp closed, or was abandoned,
+// more than MaxAge ago and deletes them, after checking that their changes
+// reached the default branch or saving them as a patch.
+package branchsweep
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// DefaultMaxAge is how long a finished wisp's branch is kept by default.
+const DefaultMaxAge = 7 * 24 * time.Hour
+
+// Reasons a branch was swept.
+const (
+	ReasonMerged  = "merged"  // Its changes are on the default branch
+	ReasonPatched = "patched" // Unmerged; saved as a patch first
+)
+
+// Options configures a sweep.
+type Options struct {
+	MaxAge       time.Duration // Keep branches whose wisp finished more recently
+	DeleteRemote bool          // Also delete merged branches on origin
+	DryRun       bool          // Report without deleting
+}
+
+// Swept is one branch the sweeper deleted (or would delete, in a dry run).
+type Swept struct {
+	Rig    string `json:"rig"`
+	Branch string `json:"branch"`
+	Bead   string `json:"bead,omitempty"`
+	Status string `json:"status"` // Wisp status: closed, abandoned, missing
+	Reason string `json:"reason"` // ReasonMerged or ReasonPatched
+	Patch  string `json:"patch,omitempty"`
+	Remote bool   `json:"remote,omitempty"` // Deleted on origin too
+}
+
+// Sweeper sweeps the stale wisp branches of a town's rigs.
+type Sweeper struct {
+	TownRoot string
+	Options
+
+	// Show looks up a bead in a rig. Defaults to the rig's beads database.
+	Show func(rigPath, id string) (*beads.Issue, error)
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// New creates a sweeper for a town.
+func New(townRoot string, opts Options) *Sweeper {
+	if opts.MaxAge <= 0 {
+		opts.MaxAge = DefaultMaxAge
+	}
+	return &Sweeper{
+		TownRoot: townRoot,
+		Options:  opts,
+		Show: func(rigPath, id string) (*beads.Issue, error) {
+			return beads.New(rigPath).Show(id)
+		},
+		Now: time.Now,
+	}
+}
+
+// PatchDir is where the sweeper saves unmerged branches of a rig.
+func PatchDir(townRoot, rigName string) string {
+	return filepath.Join(townRoot, ".runtime", "branch-patches", rigName)
+}
+
+// SweepRig sweeps one rig's shared repo. Branches that can't be checked
+// safely (checked out in a worktree, unknown wisp, git errors) are kept.
+func (s *Sweeper) SweepRig(rigName string) ([]Swept, error) {
+	rigPath := filepath.Join(s.TownRoot, rigName)
+	g, err := repoBase(rigPath)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := rig.LoadRigConfig(rigPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading %s config: %w", rigName, err)
+	}
+	if cfg.Beads == nil || cfg.Beads.Prefix == "" {
+		return nil, fmt.Errorf("%s has no beads prefix; can't match branches to wisps", rigName)
+	}
+	defaultBranch := cfg.DefaultBranch
+	if defaultBranch == "" {
+		defaultBranch = "main"
+	}
+	base := "origin/" + defaultBranch
+	if err := g.FetchPrune("origin"); err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", rigName, err)
+	}
+
+	inUse := map[string]bool{defaultBranch: true}
+	if worktrees, err := g.WorktreeList(); err == nil {
+		for _, wt := range worktrees {
+			inUse[wt.Branch] = true
+		}
+	} else {
+		return nil, fmt.Errorf("listing worktrees: %w", err)
+	}
+
+	beadID := beadPattern(cfg.Beads.Prefix)
+	var swept []Swept
+	for _, branch := range candidates(g, cfg.BranchPolicy) {
+		if inUse[branch] {
+			continue
+		}
+		id := beadID.FindString(branch)
+		if id == "" {
+			continue
+		}
+		status, finished, ok := s.wispFinished(g, rigPath, id, branch)
+		if !ok || s.Now().Sub(finished) < s.MaxAge {
+			continue
+		}
+		result, err := s.sweepBranch(g, rigName, branch, base)
+		if err != nil {
+			continue
+		}
+		result.Bead, result.Status = id, status
+		swept = append(swept, *result)
+	}
+	return swept, nil
+}
+
+// wispFinished reports whether a branch's wisp is done with it, how (closed,
+// abandoned, or missing), and since when. Wisps still hooked or in progress
+// aren't finished; neither is one that can't be looked up for another reason.
+func (s *Sweeper) wispFinished(g *git.Git, rigPath, id, branch string) (string, time.Time, bool) {
+	issue, err := s.Show(rigPath, id)
+	if err != nil {
+		if !errors.Is(err, beads.ErrNotFound) {
+			return "", time.Time{}, false
+		}
+		// The wisp was deleted: age the branch by its last commit.
+		tip, err := g.CommitTime(branch)
+		return "missing", tip, err == nil
+	}
+	var status string
+	var since time.Time
+	switch {
+	case issue.Status == "closed":
+		status, since = "closed", parseTime(issue.ClosedAt, issue.UpdatedAt)
+	case issue.Status == "open" && issue.Assignee == "":
+		// Back in the pool: whoever held this branch gave the work up.
+		status, since = "abandoned", parseTime(issue.UpdatedAt, "")
+	}
+	// Without a timestamp there's no telling how long ago it finished.
+	return status, since, status != "" && !since.IsZero()
+}
+
+// sweepBranch verifies a branch is merged or saves its patch, then deletes
+// it locally and, when merged and configured, on origin.
+func (s *Sweeper) sweepBranch(g *git.Git, rigName, branch, base string) (*Swept, error) {
+	result := &Swept{Rig: rigName, Branch: branch, Reason: ReasonMerged}
+	merged, err := g.ContentMerged(branch, base)
+	if err != nil {
+		return nil, err
+	}
+	if !merged {
+		patch, err := g.FormatPatch(base, branch)
+		if err != nil {
+			return nil, err
+		}
+		if patch != "" {
+			result.Reason = ReasonPatched
+			result.Patch = filepath.Join(PatchDir(s.TownRoot, rigName), strings.ReplaceAll(branch, "/", "_")+".patch")
+			if !s.DryRun {
+				if err := os.MkdirAll(filepath.Dir(result.Patch), 0755); err != nil {
+					return nil, err
+				}
+				if err := os.WriteFile(result.Patch, []byte(patch), 0644); err != nil { //nolint:gosec // G306: patches of repo content
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if s.DeleteRemote && result.Reason == ReasonMerged {
+		if exists, err := g.RemoteTrackingBranchExists("origin", branch); err == nil && exists {
+			result.Remote = true
+			if !s.DryRun {
+				if err := g.DeleteRemoteBranch("origin", branch); err != nil {
+					result.Remote = false
+				}
+			}
+		}
+	}
+	if !s.DryRun {
+		if err := g.DeleteBranch(branch, true); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// candidates lists the local branches that may belong to wisps: the
+// default polecat/ branches plus any the rig's branch policy allows.
+func candidates(g *git.Git, policy *rig.BranchPolicy) []string {
+	patterns := []string{"polecat/*"}
+	if policy != nil {
+		for _, prefix := range policy.AllowedPrefixes {
+			patterns = append(patterns, prefix+"*")
+		}
+	}
+	seen := map[string]bool{}
+	var out []string
+	for _, pattern := range patterns {
+		branches, err := g.ListBranches(pattern)
+		if err != nil {
+			continue
+		}
+		for _, b := range branches {
+			if b = strings.TrimSpace(b); b != "" && !seen[b] {
+				seen[b] = true
+				out = append(out, b)
+			}
+		}
+	}
+	return out
+}
+
+// beadPattern matches a bead ID with the rig's prefix inside a branch name,
+// e.g. gt-abc12 in polecat/Toast/gt-abc12@mkb0vq9f.
+func beadPattern(prefix string) *regexp.Regexp {
+	return regexp.MustCompile(regexp.QuoteMeta(prefix) + `-[0-9a-z]+(?:\.[0-9]+)*`)
+}
+
+// repoBase returns the rig's shared repo: .repo.git, or mayor/rig for
+// rigs set up before it existed.
+func repoBase(rigPath string) (*git.Git, error) {
+	bare := filepath.Join(rigPath, ".repo.git")
+	if info, err := os.Stat(bare); err == nil && info.IsDir() {
+		return git.NewGitWithDir(bare, ""), nil
+	}
+	mayor := filepath.Join(rigPath, "mayor", "rig")
+	if _, err := os.Stat(mayor); err != nil {
+		return nil, fmt.Errorf("no repo base in %s (neither .repo.git nor mayor/rig)", rigPath)
+	}
+	return git.NewGit(mayor), nil
+}
+
+// parseTime parses a bead timestamp, falling back to a second one.
+func parseTime(primary, fallback string) time.Time {
+	for _, v := range []string{primary, fallback} {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
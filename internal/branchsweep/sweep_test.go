@@ -0,0 +1,182 @@
+package branchsweep
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Test",
+		"GIT_AUTHOR_EMAIL=test@test.com",
+		"GIT_COMMITTER_NAME=Test",
+		"GIT_COMMITTER_EMAIL=test@test.com",
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v (dir=%s) failed: %v\n%s", args, dir, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// commitFile writes a file in dir and commits it.
+func commitFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, dir, "add", name)
+	runGit(t, dir, "commit", "-m", "add "+name)
+}
+
+// setupTown creates a town with one rig ("gastown", prefix gt) whose
+// .repo.git tracks an origin with these local branches:
+//
+//	polecat/Toast/gt-aaa  merged into main
+//	polecat/Nux/gt-bbb    unmerged
+//	polecat/Rust/gt-ccc   unmerged
+//	polecat/Slit/gt-ddd   squash-merged into main
+//	polecat/Max/gt-eee    merged, checked out in a worktree
+//	polecat/scratch       no wisp in its name
+func setupTown(t *testing.T) (townRoot, repo string) {
+	t.Helper()
+	tmp := t.TempDir()
+	origin := filepath.Join(tmp, "origin.git")
+	runGit(t, tmp, "init", "--bare", "-b", "main", origin)
+
+	work := filepath.Join(tmp, "work")
+	runGit(t, tmp, "clone", origin, work)
+	runGit(t, work, "checkout", "-b", "main")
+	commitFile(t, work, "README", "hello\n")
+	runGit(t, work, "push", "origin", "main")
+
+	branch := func(name, file string) {
+		runGit(t, work, "checkout", "-b", name, "main")
+		commitFile(t, work, file, name+"\n")
+		runGit(t, work, "push", "origin", name)
+		runGit(t, work, "checkout", "main")
+	}
+	branch("polecat/Toast/gt-aaa", "a.txt")
+	branch("polecat/Nux/gt-bbb", "b.txt")
+	branch("polecat/Rust/gt-ccc", "c.txt")
+	branch("polecat/Slit/gt-ddd", "d.txt")
+	branch("polecat/Max/gt-eee", "e.txt")
+	branch("polecat/scratch", "s.txt")
+	runGit(t, work, "merge", "--ff-only", "polecat/Toast/gt-aaa")
+	runGit(t, work, "merge", "--no-edit", "polecat/Max/gt-eee")
+	runGit(t, work, "merge", "--squash", "polecat/Slit/gt-ddd")
+	runGit(t, work, "commit", "-m", "squash gt-ddd")
+	runGit(t, work, "push", "origin", "main")
+
+	townRoot = filepath.Join(tmp, "town")
+	rigPath := filepath.Join(townRoot, "gastown")
+	repo = filepath.Join(rigPath, ".repo.git")
+	runGit(t, tmp, "clone", "--bare", origin, repo)
+	runGit(t, repo, "config", "remote.origin.fetch", "+refs/heads/*:refs/remotes/origin/*")
+	runGit(t, repo, "fetch", "origin")
+	runGit(t, repo, "worktree", "add", filepath.Join(rigPath, "polecats", "Max", "gastown"), "polecat/Max/gt-eee")
+
+	cfg := `{"name":"gastown","default_branch":"main","beads":{"prefix":"gt"}}`
+	if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return townRoot, repo
+}
+
+func TestSweepRig(t *testing.T) {
+	townRoot, repo := setupTown(t)
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-10 * 24 * time.Hour).Format(time.RFC3339)
+	recent := now.Add(-24 * time.Hour).Format(time.RFC3339)
+	issues := map[string]*beads.Issue{
+		"gt-aaa": {ID: "gt-aaa", Status: "closed", ClosedAt: old},
+		"gt-bbb": {ID: "gt-bbb", Status: "open", UpdatedAt: old},
+		"gt-ccc": {ID: "gt-ccc", Status: "in_progress", Assignee: "gastown/polecats/Rust", UpdatedAt: old},
+		"gt-ddd": {ID: "gt-ddd", Status: "closed", ClosedAt: recent},
+		"gt-eee": {ID: "gt-eee", Status: "closed", ClosedAt: old},
+	}
+
+	s := New(townRoot, Options{DeleteRemote: true})
+	s.Now = func() time.Time { return now }
+	s.Show = func(_, id string) (*beads.Issue, error) {
+		if issue, ok := issues[id]; ok {
+			return issue, nil
+		}
+		return nil, beads.ErrNotFound
+	}
+
+	swept, err := s.SweepRig("gastown")
+	if err != nil {
+		t.Fatalf("SweepRig() error = %v", err)
+	}
+	got := map[string]Swept{}
+	for _, sw := range swept {
+		got[sw.Branch] = sw
+	}
+	if len(got) != 2 {
+		t.Fatalf("swept %+v, want polecat/Toast/gt-aaa and polecat/Nux/gt-bbb", swept)
+	}
+	if sw := got["polecat/Toast/gt-aaa"]; sw.Reason != ReasonMerged || sw.Status != "closed" || !sw.Remote || sw.Patch != "" {
+		t.Errorf("merged branch = %+v", sw)
+	}
+	nux := got["polecat/Nux/gt-bbb"]
+	if nux.Reason != ReasonPatched || nux.Status != "abandoned" || nux.Remote {
+		t.Errorf("abandoned branch = %+v", nux)
+	}
+	if patch, err := os.ReadFile(nux.Patch); err != nil || !strings.Contains(string(patch), "b.txt") {
+		t.Errorf("patch %s = %q, %v", nux.Patch, patch, err)
+	}
+
+	left := runGit(t, repo, "branch", "--list", "--format=%(refname:short)", "polecat/*")
+	for _, want := range []string{"polecat/Rust/gt-ccc", "polecat/Slit/gt-ddd", "polecat/Max/gt-eee", "polecat/scratch"} {
+		if !strings.Contains(left, want) {
+			t.Errorf("%s was deleted; remaining branches:\n%s", want, left)
+		}
+	}
+	for _, gone := range []string{"polecat/Toast/gt-aaa", "polecat/Nux/gt-bbb"} {
+		if strings.Contains(left, gone) {
+			t.Errorf("%s was not deleted", gone)
+		}
+	}
+	remote := runGit(t, repo, "ls-remote", "--heads", "origin")
+	if strings.Contains(remote, "polecat/Toast/gt-aaa") {
+		t.Error("merged branch still on origin")
+	}
+	if !strings.Contains(remote, "polecat/Nux/gt-bbb") {
+		t.Error("unmerged branch deleted from origin")
+	}
+
+	// The recently closed squash-merge is swept once it ages, without a patch.
+	s.Now = func() time.Time { return now.Add(7 * 24 * time.Hour) }
+	s.DryRun = true
+	swept, err = s.SweepRig("gastown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(swept) != 1 || swept[0].Branch != "polecat/Slit/gt-ddd" || swept[0].Reason != ReasonMerged {
+		t.Errorf("aged sweep = %+v, want squash-merged polecat/Slit/gt-ddd", swept)
+	}
+	if !strings.Contains(runGit(t, repo, "branch", "--list", "polecat/Slit/gt-ddd"), "gt-ddd") {
+		t.Error("dry run deleted a branch")
+	}
+}
+
+func TestSweepRigRequiresPrefix(t *testing.T) {
+	townRoot, _ := setupTown(t)
+	cfg := filepath.Join(townRoot, "gastown", "config.json")
+	if err := os.WriteFile(cfg, []byte(`{"name":"gastown"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(townRoot, Options{}).SweepRig("gastown"); err == nil || !strings.Contains(err.Error(), "beads prefix") {
+		t.Errorf("SweepRig() error = %v, want missing prefix", err)
+	}
+}
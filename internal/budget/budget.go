@@ -0,0 +1,275 @@
+// Package budget tracks monthly cost budgets for the town and its rigs.
+//
+// Spend is month-to-date; the projection extends the current run rate to
+// the end of the month. Thresholds of spend, and a projected overrun, each
+// alert once per month. When a budget is nearly spent its scope is
+// downgraded to a cheaper cost tier, and the previous model assignments are
+// restored when the month rolls over. Alert and downgrade state lives in
+// .runtime/budget/state.json.
+package budget
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// TownScope is the scope of the town-wide budget; other scopes are rig names.
+const TownScope = "town"
+
+// AlertOverrun is the alert key for a projected overrun.
+const AlertOverrun = "overrun"
+
+// DefaultWarnAt are the spent fractions that alert by default.
+var DefaultWarnAt = []float64{0.5, 0.8, 1.0}
+
+// DefaultNotify are the addresses told about alerts and downgrades.
+var DefaultNotify = []string{"overseer", "mayor/"}
+
+// Policy holds the budget settings.
+type Policy struct {
+	Limits        map[string]float64 // Monthly USD by scope
+	WarnAt        []float64          // Ascending
+	DowngradeAt   float64            // 0 disables downgrades
+	DowngradeTier config.CostTier
+	Notify        []string
+}
+
+// NewPolicy builds a policy from town settings; nil configures no budgets.
+func NewPolicy(cfg *config.BudgetConfig) *Policy {
+	p := &Policy{
+		Limits:        map[string]float64{},
+		WarnAt:        DefaultWarnAt,
+		DowngradeTier: config.TierBudget,
+		Notify:        DefaultNotify,
+	}
+	if cfg == nil {
+		return p
+	}
+	if cfg.MonthlyUSD > 0 {
+		p.Limits[TownScope] = cfg.MonthlyUSD
+	}
+	for rig, limit := range cfg.Rigs {
+		if limit > 0 {
+			p.Limits[rig] = limit
+		}
+	}
+	if len(cfg.WarnAt) > 0 {
+		p.WarnAt = append([]float64(nil), cfg.WarnAt...)
+		sort.Float64s(p.WarnAt)
+	}
+	p.DowngradeAt = cfg.DowngradeAt
+	if cfg.DowngradeTier != "" {
+		p.DowngradeTier = config.CostTier(cfg.DowngradeTier)
+	}
+	if len(cfg.Notify) > 0 {
+		p.Notify = cfg.Notify
+	}
+	return p
+}
+
+// LoadPolicy reads the budget policy from town settings.
+func LoadPolicy(townRoot string) (*Policy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	p := NewPolicy(settings.Budget)
+	if p.DowngradeAt > 0 && !config.IsValidTier(string(p.DowngradeTier)) {
+		return nil, fmt.Errorf("budget downgrade_tier %q is not a cost tier", p.DowngradeTier)
+	}
+	return p, nil
+}
+
+// Spend is month-to-date cost in USD.
+type Spend struct {
+	Total float64
+	ByRig map[string]float64
+}
+
+// Status is one budget's standing.
+type Status struct {
+	Scope     string  `json:"scope"`
+	Limit     float64 `json:"limit_usd"`
+	Spent     float64 `json:"spent_usd"`
+	Projected float64 `json:"projected_usd"`
+}
+
+// SpentFraction is the share of the budget already spent.
+func (s Status) SpentFraction() float64 { return s.Spent / s.Limit }
+
+// ProjectedFraction is the share of the budget the month is on track to spend.
+func (s Status) ProjectedFraction() float64 { return s.Projected / s.Limit }
+
+// Evaluate returns the status of every budget, town first, then rigs by name.
+func (p *Policy) Evaluate(spend Spend, now time.Time) []Status {
+	var out []Status
+	for scope, limit := range p.Limits {
+		spent := spend.ByRig[scope]
+		if scope == TownScope {
+			spent = spend.Total
+		}
+		out = append(out, Status{Scope: scope, Limit: limit, Spent: spent, Projected: Project(spent, now)})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if (out[i].Scope == TownScope) != (out[j].Scope == TownScope) {
+			return out[i].Scope == TownScope
+		}
+		return out[i].Scope < out[j].Scope
+	})
+	return out
+}
+
+// Project extends month-to-date spend to the whole month at the current run
+// rate. The first day counts as a full day so early spikes don't explode.
+func Project(spent float64, now time.Time) float64 {
+	start := MonthStart(now)
+	month := start.AddDate(0, 1, 0).Sub(start)
+	elapsed := now.Sub(start)
+	if elapsed < 24*time.Hour {
+		elapsed = 24 * time.Hour
+	}
+	return spent * float64(month) / float64(elapsed)
+}
+
+// MonthStart is midnight on the first of now's month, in now's location.
+func MonthStart(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+}
+
+// Alert is a budget threshold newly crossed.
+type Alert struct {
+	Status
+	Key       string  `json:"key"`                 // Threshold ("0.8") or AlertOverrun
+	Threshold float64 `json:"threshold,omitempty"` // Spent fraction crossed
+}
+
+// Alerts returns the alerts for statuses not yet sent this month. When a
+// budget crosses several thresholds at once only the highest alerts, and
+// the lower ones count as sent.
+func (p *Policy) Alerts(statuses []Status, st *State) []Alert {
+	var out []Alert
+	for _, s := range statuses {
+		sent := st.Alerted[s.Scope]
+		var crossed *Alert
+		for _, t := range p.WarnAt {
+			key := strconv.FormatFloat(t, 'f', -1, 64)
+			if s.SpentFraction() >= t && !slices.Contains(sent, key) {
+				crossed = &Alert{Status: s, Key: key, Threshold: t}
+			}
+		}
+		if crossed != nil {
+			out = append(out, *crossed)
+		}
+		if s.ProjectedFraction() > 1 && s.SpentFraction() < 1 && !slices.Contains(sent, AlertOverrun) {
+			out = append(out, Alert{Status: s, Key: AlertOverrun})
+		}
+	}
+	return out
+}
+
+// MarkAlerted records alerts as sent, including any lower thresholds they
+// subsume.
+func (p *Policy) MarkAlerted(st *State, alerts []Alert) {
+	for _, a := range alerts {
+		keys := []string{a.Key}
+		if a.Key != AlertOverrun {
+			for _, t := range p.WarnAt {
+				if t <= a.Threshold {
+					keys = append(keys, strconv.FormatFloat(t, 'f', -1, 64))
+				}
+			}
+		}
+		for _, k := range keys {
+			if !slices.Contains(st.Alerted[a.Scope], k) {
+				st.Alerted[a.Scope] = append(st.Alerted[a.Scope], k)
+			}
+		}
+	}
+}
+
+// Downgrades returns the statuses due for a downgrade: spent at least
+// DowngradeAt of their budget and not downgraded yet this month.
+func (p *Policy) Downgrades(statuses []Status, st *State) []Status {
+	if p.DowngradeAt <= 0 {
+		return nil
+	}
+	var out []Status
+	for _, s := range statuses {
+		if s.SpentFraction() >= p.DowngradeAt && st.Downgrades[s.Scope] == nil {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Downgrade records a scope moved to a cheaper tier and what it had before.
+type Downgrade struct {
+	Tier string    `json:"tier"`
+	At   time.Time `json:"at"`
+	// Previous holds the tier-managed role assignments before the
+	// downgrade; "" means the role was unset.
+	Previous     map[string]string `json:"previous"`
+	PreviousTier string            `json:"previous_tier,omitempty"` // Town only
+}
+
+// State tracks the current month's alerts and downgrades by scope.
+type State struct {
+	Month      string                `json:"month"` // "2006-01"
+	Alerted    map[string][]string   `json:"alerted"`
+	Downgrades map[string]*Downgrade `json:"downgrades"`
+}
+
+// Rollover starts a new month if now is past the state's month, returning
+// the previous month's downgrades, which should be restored.
+func (st *State) Rollover(now time.Time) map[string]*Downgrade {
+	month := now.Format("2006-01")
+	if st.Month == month {
+		return nil
+	}
+	expired := st.Downgrades
+	st.Month = month
+	st.Alerted = map[string][]string{}
+	st.Downgrades = map[string]*Downgrade{}
+	return expired
+}
+
+// StatePath returns the budget state file.
+func StatePath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "budget", "state.json")
+}
+
+// LoadState reads the budget state; a missing file is an empty state.
+func LoadState(townRoot string) (*State, error) {
+	st := &State{}
+	data, err := os.ReadFile(StatePath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading budget state: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, st); err != nil {
+			return nil, fmt.Errorf("parsing budget state: %w", err)
+		}
+	}
+	if st.Alerted == nil {
+		st.Alerted = map[string][]string{}
+	}
+	if st.Downgrades == nil {
+		st.Downgrades = map[string]*Downgrade{}
+	}
+	return st, nil
+}
+
+// SaveState writes the budget state.
+func SaveState(townRoot string, st *State) error {
+	return util.EnsureDirAndWriteJSON(StatePath(townRoot), st)
+}
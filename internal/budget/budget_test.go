@@ -0,0 +1,172 @@
+package budget
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestProject(t *testing.T) {
+	// Day 10 of a 30-day month at $100 spent: on track for $300.
+	now := time.Date(2026, 4, 11, 0, 0, 0, 0, time.UTC)
+	if got := Project(100, now); math.Abs(got-300) > 0.001 {
+		t.Errorf("Project() = %v, want 300", got)
+	}
+	// The first day counts as a full day.
+	early := time.Date(2026, 4, 1, 2, 0, 0, 0, time.UTC)
+	if got := Project(10, early); math.Abs(got-300) > 0.001 {
+		t.Errorf("Project() on day one = %v, want 300", got)
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	p := NewPolicy(nil)
+	if len(p.Limits) != 0 || p.DowngradeAt != 0 || p.DowngradeTier != config.TierBudget {
+		t.Errorf("NewPolicy(nil) = %+v", p)
+	}
+
+	p = NewPolicy(&config.BudgetConfig{
+		MonthlyUSD:  500,
+		Rigs:        map[string]float64{"gastown": 200, "beads": 0},
+		WarnAt:      []float64{0.9, 0.5},
+		DowngradeAt: 0.9,
+	})
+	if p.Limits[TownScope] != 500 || p.Limits["gastown"] != 200 || len(p.Limits) != 2 {
+		t.Errorf("Limits = %v", p.Limits)
+	}
+	if p.WarnAt[0] != 0.5 || p.WarnAt[1] != 0.9 {
+		t.Errorf("WarnAt = %v, want sorted", p.WarnAt)
+	}
+}
+
+func TestAlertsAndDowngrades(t *testing.T) {
+	p := NewPolicy(&config.BudgetConfig{
+		MonthlyUSD:  1000,
+		Rigs:        map[string]float64{"gastown": 100, "beads": 100},
+		DowngradeAt: 0.9,
+	})
+	now := time.Date(2026, 4, 11, 0, 0, 0, 0, time.UTC) // A third of the month
+	spend := Spend{Total: 200, ByRig: map[string]float64{"gastown": 85, "beads": 40}}
+	statuses := p.Evaluate(spend, now)
+	if len(statuses) != 3 || statuses[0].Scope != TownScope || statuses[1].Scope != "beads" {
+		t.Fatalf("Evaluate() = %+v, want town first then rigs by name", statuses)
+	}
+
+	st := &State{}
+	st.Rollover(now)
+	alerts := p.Alerts(statuses, st)
+	got := map[string]string{}
+	for _, a := range alerts {
+		got[a.Scope+"/"+a.Key] = a.Key
+	}
+	// town: $200 of $1000, projected $600 — nothing.
+	// beads: 40% spent, projected 120% — overrun only.
+	// gastown: 85% spent crosses 0.5 and 0.8 (one alert), projected 255%.
+	want := []string{"beads/overrun", "gastown/0.8", "gastown/overrun"}
+	if len(alerts) != len(want) {
+		t.Fatalf("Alerts() = %+v, want %v", alerts, want)
+	}
+	for _, w := range want {
+		if _, ok := got[w]; !ok {
+			t.Errorf("missing alert %s in %v", w, got)
+		}
+	}
+
+	p.MarkAlerted(st, alerts)
+	if again := p.Alerts(statuses, st); len(again) != 0 {
+		t.Errorf("Alerts() after MarkAlerted = %+v, want none", again)
+	}
+	if len(st.Alerted["gastown"]) != 3 {
+		t.Errorf("gastown alerted = %v, want 0.8, 0.5 and overrun", st.Alerted["gastown"])
+	}
+
+	if d := p.Downgrades(statuses, st); len(d) != 0 {
+		t.Errorf("Downgrades() at 85%% = %+v, want none", d)
+	}
+	spend.ByRig["gastown"] = 95
+	statuses = p.Evaluate(spend, now)
+	due := p.Downgrades(statuses, st)
+	if len(due) != 1 || due[0].Scope != "gastown" {
+		t.Fatalf("Downgrades() = %+v, want gastown", due)
+	}
+	st.Downgrades["gastown"] = &Downgrade{Tier: "budget"}
+	if d := p.Downgrades(statuses, st); len(d) != 0 {
+		t.Errorf("Downgrades() after downgrade = %+v, want none", d)
+	}
+
+	if expired := st.Rollover(now.AddDate(0, 0, 5)); expired != nil {
+		t.Errorf("Rollover() within the month = %v", expired)
+	}
+	expired := st.Rollover(now.AddDate(0, 1, 0))
+	if expired["gastown"] == nil || len(st.Alerted) != 0 || len(st.Downgrades) != 0 {
+		t.Errorf("Rollover() = %v, state %+v", expired, st)
+	}
+}
+
+func TestApplyAndRestoreDowngrade(t *testing.T) {
+	town := t.TempDir()
+	settings := config.NewTownSettings()
+	settings.RoleAgents["polecat"] = "claude-opus"
+	if err := config.SaveTownSettings(config.TownSettingsPath(town), settings); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(town, "gastown"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	d, err := ApplyDowngrade(town, TownScope, config.TierBudget, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := config.LoadOrCreateTownSettings(config.TownSettingsPath(town))
+	if got.RoleAgents["polecat"] != "claude-sonnet" || got.CostTier != "budget" {
+		t.Errorf("town after downgrade: role_agents %v, tier %q", got.RoleAgents, got.CostTier)
+	}
+	if err := RestoreDowngrade(town, TownScope, d); err != nil {
+		t.Fatal(err)
+	}
+	got, _ = config.LoadOrCreateTownSettings(config.TownSettingsPath(town))
+	if got.RoleAgents["polecat"] != "claude-opus" || got.RoleAgents["witness"] != "" || got.CostTier != "" {
+		t.Errorf("town after restore: role_agents %v, tier %q", got.RoleAgents, got.CostTier)
+	}
+
+	// A rig without settings gets new ones, and loses the override on restore.
+	d, err = ApplyDowngrade(town, "gastown", config.TierBudget, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rigPath := config.RigSettingsPath(filepath.Join(town, "gastown"))
+	rs, err := config.LoadRigSettings(rigPath)
+	if err != nil || rs.RoleAgents["witness"] != "claude-haiku" {
+		t.Fatalf("rig after downgrade: %+v, %v", rs, err)
+	}
+	if err := RestoreDowngrade(town, "gastown", d); err != nil {
+		t.Fatal(err)
+	}
+	rs, _ = config.LoadRigSettings(rigPath)
+	if len(rs.RoleAgents) != 0 {
+		t.Errorf("rig role_agents after restore = %v, want none", rs.RoleAgents)
+	}
+}
+
+func TestStateRoundTrip(t *testing.T) {
+	town := t.TempDir()
+	st, err := LoadState(town)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st.Rollover(time.Date(2026, 4, 11, 0, 0, 0, 0, time.UTC))
+	st.Alerted["gastown"] = []string{"0.5"}
+	if err := SaveState(town, st); err != nil {
+		t.Fatal(err)
+	}
+	st, err = LoadState(town)
+	if err != nil || st.Month != "2026-04" || st.Alerted["gastown"][0] != "0.5" {
+		t.Errorf("LoadState() = %+v, %v", st, err)
+	}
+}
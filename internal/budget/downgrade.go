@@ -0,0 +1,112 @@
+package budget
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// ApplyDowngrade moves a scope to tier: the town's settings for TownScope,
+// otherwise the rig's settings, which then override the town for that rig.
+// New sessions pick up the cheaper models; running ones are not touched.
+func ApplyDowngrade(townRoot, scope string, tier config.CostTier, now time.Time) (*Downgrade, error) {
+	d := &Downgrade{Tier: string(tier), At: now.UTC(), Previous: map[string]string{}}
+	if scope == TownScope {
+		path := config.TownSettingsPath(townRoot)
+		settings, err := config.LoadOrCreateTownSettings(path)
+		if err != nil {
+			return nil, fmt.Errorf("loading town settings: %w", err)
+		}
+		d.Previous = tierRoles(settings.RoleAgents)
+		d.PreviousTier = settings.CostTier
+		if err := config.ApplyCostTier(settings, tier); err != nil {
+			return nil, err
+		}
+		if err := config.SaveTownSettings(path, settings); err != nil {
+			return nil, fmt.Errorf("saving town settings: %w", err)
+		}
+		return d, nil
+	}
+
+	path, settings, err := loadRigSettings(townRoot, scope)
+	if err != nil {
+		return nil, err
+	}
+	d.Previous = tierRoles(settings.RoleAgents)
+	if err := config.ApplyCostTierToRig(settings, tier); err != nil {
+		return nil, err
+	}
+	if err := config.SaveRigSettings(path, settings); err != nil {
+		return nil, fmt.Errorf("saving %s settings: %w", scope, err)
+	}
+	return d, nil
+}
+
+// RestoreDowngrade puts back the role assignments a downgrade replaced.
+// Agent presets the downgrade added are left in place; they're unused once
+// no role refers to them.
+func RestoreDowngrade(townRoot, scope string, d *Downgrade) error {
+	if scope == TownScope {
+		path := config.TownSettingsPath(townRoot)
+		settings, err := config.LoadOrCreateTownSettings(path)
+		if err != nil {
+			return fmt.Errorf("loading town settings: %w", err)
+		}
+		settings.RoleAgents = restoreRoles(settings.RoleAgents, d.Previous)
+		settings.CostTier = d.PreviousTier
+		if err := config.SaveTownSettings(path, settings); err != nil {
+			return fmt.Errorf("saving town settings: %w", err)
+		}
+		return nil
+	}
+
+	path, settings, err := loadRigSettings(townRoot, scope)
+	if err != nil {
+		return err
+	}
+	settings.RoleAgents = restoreRoles(settings.RoleAgents, d.Previous)
+	if err := config.SaveRigSettings(path, settings); err != nil {
+		return fmt.Errorf("saving %s settings: %w", scope, err)
+	}
+	return nil
+}
+
+// loadRigSettings loads a rig's settings, or new ones if it has none yet.
+func loadRigSettings(townRoot, rigName string) (string, *config.RigSettings, error) {
+	path := config.RigSettingsPath(filepath.Join(townRoot, rigName))
+	settings, err := config.LoadRigSettings(path)
+	if errors.Is(err, config.ErrNotFound) {
+		return path, config.NewRigSettings(), nil
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("loading %s settings: %w", rigName, err)
+	}
+	return path, settings, nil
+}
+
+// tierRoles copies the tier-managed roles' assignments ("" when unset).
+func tierRoles(roleAgents map[string]string) map[string]string {
+	out := map[string]string{}
+	for _, role := range config.TierManagedRoles {
+		out[role] = roleAgents[role]
+	}
+	return out
+}
+
+// restoreRoles writes previous assignments back into roleAgents.
+func restoreRoles(roleAgents, previous map[string]string) map[string]string {
+	if roleAgents == nil {
+		roleAgents = map[string]string{}
+	}
+	for role, agent := range previous {
+		if agent == "" {
+			delete(roleAgents, role)
+		} else {
+			roleAgents[role] = agent
+		}
+	}
+	return roleAgents
+}
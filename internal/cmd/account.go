@@ -11,6 +11,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -459,7 +460,7 @@ func runAccountSwitch(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Switched to account '%s'\n", targetHandle)
 	fmt.Printf("~/.claude -> %s\n", targetAcct.ConfigDir)
 	fmt.Println()
-	fmt.Println(style.Warning.Render("⚠️  Restart Claude Code for the change to take effect"))
+	fmt.Println(style.Warning.Render(ui.Glyph("⚠️") + "  Restart Claude Code for the change to take effect"))
 
 	return nil
 }
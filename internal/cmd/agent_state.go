@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 var (
@@ -231,7 +232,7 @@ func modifyAgentState(agentBead, beadsDir string, hasIncr bool) error {
 		return fmt.Errorf("updating agent state: %w", err)
 	}
 
-	fmt.Printf("%s Updated agent state for %s\n", style.Bold.Render("✓"), agentBead)
+	fmt.Printf("%s Updated agent state for %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), agentBead)
 
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -617,12 +618,12 @@ func runAgentsCheck(cmd *cobra.Command, args []string) error {
 
 	// Text output
 	if len(report.Issues) == 0 {
-		fmt.Printf("%s All agents healthy\n", style.Bold.Render("✓"))
+		fmt.Printf("%s All agents healthy\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		fmt.Printf("  Sessions: %d, Locks: %d\n", report.TotalSessions, report.TotalLocks)
 		return nil
 	}
 
-	fmt.Printf("%s\n\n", style.Bold.Render("⚠️  Issues Detected"))
+	fmt.Printf("%s\n\n", style.Bold.Render(ui.Glyph("⚠️")+"  Issues Detected"))
 	fmt.Printf("Collisions: %d, Stale locks: %d\n\n", report.Collisions, report.StaleLocks)
 
 	for _, issue := range report.Issues {
@@ -652,9 +653,9 @@ func runAgentsFix(cmd *cobra.Command, args []string) error {
 	}
 
 	if cleaned > 0 {
-		fmt.Printf("%s Cleaned %d stale lock(s)\n", style.Bold.Render("✓"), cleaned)
+		fmt.Printf("%s Cleaned %d stale lock(s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), cleaned)
 	} else {
-		fmt.Printf("%s No stale locks found\n", style.Dim.Render("○"))
+		fmt.Printf("%s No stale locks found\n", style.Dim.Render(ui.Glyph("○")))
 	}
 
 	// Check for remaining issues
@@ -666,7 +667,7 @@ func runAgentsFix(cmd *cobra.Command, args []string) error {
 	if report.Collisions > 0 {
 		fmt.Println()
 		fmt.Printf("%s %d collision(s) require manual intervention:\n\n",
-			style.Bold.Render("⚠"), report.Collisions)
+			style.Bold.Render(ui.Glyph(ui.IconWarn)), report.Collisions)
 
 		for _, issue := range report.Issues {
 			if issue.Type == "collision" {
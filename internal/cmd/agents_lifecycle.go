@@ -0,0 +1,444 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/checkpoint"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/experiment"
+	"github.com/steveyegge/gastown/internal/mayor"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/primecache"
+	"github.com/steveyegge/gastown/internal/refinery"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/telemetry"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/witness"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Agent lifecycle states recorded by gt agents stop/start/restart.
+const (
+	agentStateRunning    = "running"
+	agentStateStopping   = "stopping"
+	agentStateStopped    = "stopped"
+	agentStateStarting   = "starting"
+	agentStateRestarting = "restarting"
+)
+
+// shutdownHandshakeMsg asks a single agent to wrap up before it is stopped.
+const shutdownHandshakeMsg = "[SHUTDOWN] You are being stopped. Save your state (gt checkpoint write) and update your handoff bead, then type /exit or wait to be terminated."
+
+var (
+	agentsLifecycleTimeout time.Duration
+	agentsLifecycleForce   bool
+)
+
+var agentsStopCmd = &cobra.Command{
+	Use:   "stop <agent>",
+	Short: "Gracefully stop a single agent",
+	Long: `Gracefully stop one agent session using the shutdown handshake.
+
+The agent is interrupted and asked to save its state and update its handoff
+bead. gt waits up to --timeout for the agent to exit on its own, then stops
+the session. Hooked work stays on the hook.
+
+Agents are addressed like mail: mayor, deacon, <rig>/witness, <rig>/refinery,
+<rig>/crew/<name>, or <rig>/polecats/<name>.
+
+Examples:
+  gt agents stop gastown/witness
+  gt agents stop gastown/polecats/Toast --timeout 2m
+  gt agents stop mayor --force        # Skip the handshake`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAgentsStop,
+}
+
+var agentsStartCmd = &cobra.Command{
+	Use:   "start <agent>",
+	Short: "Cold-start a single agent with a fresh prime",
+	Long: `Cold-start one agent session.
+
+A cold start discards the agent's checkpoint (polecats and crew) and its
+prime cache entries, so the new session primes from scratch; other agents'
+cached packs are kept. Hooked work is picked up by the new session as usual.
+
+Examples:
+  gt agents start deacon
+  gt agents start gastown/crew/max`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAgentsStart,
+}
+
+var agentsRestartCmd = &cobra.Command{
+	Use:   "restart <agent>",
+	Short: "Restart a single agent, keeping its hooked wisp and checkpoint",
+	Long: `Restart one agent session without losing its place.
+
+The agent is stopped with the shutdown handshake (see 'gt agents stop'),
+then started again. Its hooked wisp stays assigned, and for polecats and
+crew a checkpoint is written (unless the agent just wrote one) so the new
+session resumes where the old one left off.
+
+Examples:
+  gt agents restart gastown/polecats/Toast
+  gt agents restart gastown/refinery --timeout 30s`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAgentsRestart,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{agentsStopCmd, agentsRestartCmd} {
+		c.Flags().DurationVar(&agentsLifecycleTimeout, "timeout", 60*time.Second,
+			"How long to wait for the agent to exit after the shutdown handshake")
+		c.Flags().BoolVarP(&agentsLifecycleForce, "force", "f", false,
+			"Skip the shutdown handshake and stop immediately")
+	}
+
+	agentsCmd.AddCommand(agentsStopCmd)
+	agentsCmd.AddCommand(agentsStartCmd)
+	agentsCmd.AddCommand(agentsRestartCmd)
+}
+
+// agentLifecycle is a single agent resolved for stop/start/restart.
+type agentLifecycle struct {
+	id       *session.AgentIdentity
+	townRoot string
+	workDir  string // checkpoint directory (polecats and crew only)
+	stop     func() error
+	start    func(topic string) error
+}
+
+// address returns the agent's mail-style address.
+func (a *agentLifecycle) address() string {
+	return a.id.Address()
+}
+
+// resolveAgentLifecycle maps an agent address to the role manager that owns
+// its session.
+func resolveAgentLifecycle(address string) (*agentLifecycle, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return nil, fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	id, err := session.ParseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	a := &agentLifecycle{id: id, townRoot: townRoot}
+
+	switch id.Role {
+	case session.RoleMayor:
+		mgr := mayor.NewManager(townRoot)
+		a.stop = ignoreErr(mgr.Stop, mayor.ErrNotRunning)
+		a.start = func(string) error {
+			return ignoreErr(func() error { return mgr.Start("") }, mayor.ErrAlreadyRunning)()
+		}
+	case session.RoleDeacon:
+		if id.Name == "boot" {
+			return nil, fmt.Errorf("boot is managed by the daemon; use gt deacon to control the deacon")
+		}
+		mgr := deacon.NewManager(townRoot)
+		a.stop = ignoreErr(mgr.Stop, deacon.ErrNotRunning)
+		a.start = func(string) error {
+			t := tmux.NewTmux()
+			if running, _ := t.HasSession(mgr.SessionName()); running {
+				return nil
+			}
+			return startDeaconSession(t, mgr.SessionName(), "")
+		}
+	case session.RoleWitness:
+		if err := checkRigNotParkedOrDocked(id.Rig); err != nil {
+			return nil, err
+		}
+		mgr, err := getWitnessManager(id.Rig)
+		if err != nil {
+			return nil, err
+		}
+		a.stop = ignoreErr(mgr.Stop, witness.ErrNotRunning)
+		a.start = func(string) error {
+			return ignoreErr(func() error { return mgr.Start(false, "", nil) }, witness.ErrAlreadyRunning)()
+		}
+	case session.RoleRefinery:
+		if err := checkRigNotParkedOrDocked(id.Rig); err != nil {
+			return nil, err
+		}
+		mgr, _, _, err := getRefineryManager(id.Rig)
+		if err != nil {
+			return nil, err
+		}
+		a.stop = ignoreErr(mgr.Stop, refinery.ErrNotRunning)
+		a.start = func(string) error {
+			return ignoreErr(func() error { return mgr.Start(false, "") }, refinery.ErrAlreadyRunning)()
+		}
+	case session.RoleCrew:
+		mgr, _, err := getCrewManager(id.Rig)
+		if err != nil {
+			return nil, err
+		}
+		worker, err := mgr.Get(id.Name)
+		if err != nil {
+			return nil, err
+		}
+		a.workDir = worker.ClonePath
+		a.stop = ignoreErr(func() error { return mgr.Stop(id.Name) }, crew.ErrSessionNotFound)
+		a.start = func(topic string) error {
+			return ignoreErr(func() error { return mgr.Start(id.Name, crew.StartOptions{Topic: topic}) }, crew.ErrSessionRunning)()
+		}
+	case session.RolePolecat:
+		mgr, r, err := getSessionManager(id.Rig)
+		if err != nil {
+			return nil, err
+		}
+		a.workDir = mgr.ClonePath(id.Name)
+		// Read the running session's agent and experiment before a restart
+		// stops it, so the new session comes back the same way.
+		opts := polecatStartOptions(townRoot, r, id)
+		// The handshake already gave the agent its chance; stop hard.
+		a.stop = ignoreErr(func() error { return mgr.Stop(id.Name, true) }, polecat.ErrSessionNotFound)
+		a.start = func(string) error {
+			opts.Issue = a.hookedBead()
+			return ignoreErr(func() error { return mgr.Start(id.Name, opts) }, polecat.ErrSessionRunning)()
+		}
+	default:
+		return nil, fmt.Errorf("%s has no session to manage", address)
+	}
+	return a, nil
+}
+
+// polecatStartOptions returns the session options for starting a polecat
+// the way gt sling would: the default account's config dir, plus the agent
+// override and experiment variant of its current session, if one is running.
+func polecatStartOptions(townRoot string, r *rig.Rig, id *session.AgentIdentity) polecat.SessionStartOptions {
+	var opts polecat.SessionStartOptions
+	if dir, _, err := config.ResolveAccountConfigDir(constants.MayorAccountsPath(townRoot), ""); err == nil {
+		opts.RuntimeConfigDir = dir
+	}
+	t := tmux.NewTmux()
+	if running, _ := t.HasSession(id.SessionName()); !running {
+		return opts
+	}
+	opts.Agent, _ = t.GetEnvironment(id.SessionName(), "GT_AGENT")
+	opts.Experiment, _ = t.GetEnvironment(id.SessionName(), experiment.EnvVar)
+	if opts.Agent != "" {
+		cmd, err := config.BuildStartupCommandFromConfig(config.AgentEnvConfig{
+			Role:       constants.RolePolecat,
+			Rig:        id.Rig,
+			AgentName:  id.Name,
+			TownRoot:   townRoot,
+			Experiment: opts.Experiment,
+		}, r.Path, "", opts.Agent)
+		if err == nil {
+			opts.Command = cmd
+		}
+	}
+	return opts
+}
+
+// ignoreErr wraps fn so that the given sentinel (e.g., "already running")
+// counts as success.
+func ignoreErr(fn func() error, sentinel error) func() error {
+	return func() error {
+		if err := fn(); err != nil && !errors.Is(err, sentinel) {
+			return err
+		}
+		return nil
+	}
+}
+
+// hookedBead returns the bead hooked to the agent, if any.
+func (a *agentLifecycle) hookedBead() string {
+	dir := a.townRoot
+	if a.id.Rig != "" {
+		dir = filepath.Join(a.townRoot, a.id.Rig)
+	}
+	hooked, err := beads.New(dir).List(beads.ListOptions{
+		Status:   beads.StatusHooked,
+		Assignee: a.address(),
+		Priority: -1,
+	})
+	if err != nil || len(hooked) == 0 {
+		return ""
+	}
+	return hooked[0].ID
+}
+
+// transition records a lifecycle state change in the event feed and telemetry.
+func (a *agentLifecycle) transition(from, to, bead, reason string, err error) {
+	_ = events.LogFeed(events.TypeAgentState, a.address(), events.AgentStatePayload(a.address(), from, to, bead, reason))
+	var hook *string
+	if bead != "" {
+		hook = &bead
+	}
+	telemetry.RecordAgentStateChange(context.Background(), a.address(), to, hook, err)
+}
+
+// gracefulStop runs the shutdown handshake, waits for the agent to exit, then
+// stops the session. Returns whether the agent exited on its own.
+func (a *agentLifecycle) gracefulStop(timeout time.Duration, force bool) (bool, error) {
+	t := tmux.NewTmux()
+	sessionID := a.id.SessionName()
+	exited := false
+	if running, _ := t.HasSession(sessionID); running && !force && t.IsAgentAlive(sessionID) {
+		_ = t.SendKeysRaw(sessionID, "Escape") // best-effort interrupt
+		_ = t.WaitForIdle(sessionID, constants.InterruptSettleTimeout)
+		_ = t.SendKeys(sessionID, shutdownHandshakeMsg)
+		exited = waitForAgentExit(t, sessionID, timeout)
+	}
+	return exited, a.stop()
+}
+
+// waitForAgentExit polls until the agent process in sessionID has exited (or
+// the session is gone) or timeout elapses.
+func waitForAgentExit(t *tmux.Tmux, sessionID string, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if running, _ := t.HasSession(sessionID); !running || !t.IsAgentAlive(sessionID) {
+			return true
+		}
+		time.Sleep(time.Second)
+	}
+	return false
+}
+
+func runAgentsStop(cmd *cobra.Command, args []string) error {
+	a, err := resolveAgentLifecycle(args[0])
+	if err != nil {
+		return err
+	}
+	if running, _ := tmux.NewTmux().HasSession(a.id.SessionName()); !running {
+		fmt.Printf("%s %s is not running\n", style.Dim.Render(ui.Glyph("○")), a.address())
+		return nil
+	}
+
+	bead := a.hookedBead()
+	a.transition(agentStateRunning, agentStateStopping, bead, "gt agents stop", nil)
+	if agentsLifecycleForce {
+		fmt.Printf("Stopping %s...\n", a.address())
+	} else {
+		fmt.Printf("Stopping %s (handshake, waiting up to %s)...\n", a.address(), agentsLifecycleTimeout)
+	}
+
+	exited, err := a.gracefulStop(agentsLifecycleTimeout, agentsLifecycleForce)
+	a.transition(agentStateStopping, agentStateStopped, bead, "gt agents stop", err)
+	if err != nil {
+		return fmt.Errorf("stopping %s: %w", a.address(), err)
+	}
+
+	if exited {
+		fmt.Printf("%s %s exited after handshake\n", style.Bold.Render(ui.Glyph(ui.IconPass)), a.address())
+	} else {
+		fmt.Printf("%s %s stopped\n", style.Bold.Render(ui.Glyph(ui.IconPass)), a.address())
+	}
+	if bead != "" {
+		fmt.Printf("  Hook kept: %s\n", bead)
+	}
+	return nil
+}
+
+func runAgentsStart(cmd *cobra.Command, args []string) error {
+	a, err := resolveAgentLifecycle(args[0])
+	if err != nil {
+		return err
+	}
+	if running, _ := tmux.NewTmux().HasSession(a.id.SessionName()); running {
+		return fmt.Errorf("%s is already running (use 'gt agents restart %s')", a.address(), a.address())
+	}
+
+	// Cold start: nothing carried over from the previous session's prime.
+	if a.workDir != "" {
+		if err := checkpoint.Remove(a.workDir); err != nil {
+			style.PrintWarning("could not remove checkpoint: %v", err)
+		}
+	}
+	if _, err := primecache.New(a.townRoot).ClearAgent(a.id.Rig, string(a.id.Role), a.id.Name); err != nil {
+		style.PrintWarning("could not clear prime cache: %v", err)
+	}
+
+	bead := a.hookedBead()
+	a.transition(agentStateStopped, agentStateStarting, bead, "gt agents start", nil)
+	fmt.Printf("Starting %s...\n", a.address())
+	err = a.start("start")
+	to := agentStateRunning
+	if err != nil {
+		to = agentStateStopped
+	}
+	a.transition(agentStateStarting, to, bead, "gt agents start", err)
+	if err != nil {
+		return fmt.Errorf("starting %s: %w", a.address(), err)
+	}
+
+	fmt.Printf("%s %s started\n", style.Bold.Render(ui.Glyph(ui.IconPass)), a.address())
+	return nil
+}
+
+func runAgentsRestart(cmd *cobra.Command, args []string) error {
+	a, err := resolveAgentLifecycle(args[0])
+	if err != nil {
+		return err
+	}
+	return a.restart(agentsLifecycleTimeout, agentsLifecycleForce, "gt agents restart")
+}
+
+// restart stops the agent with the shutdown handshake (if running) and starts
+// it again, keeping its hooked wisp and checkpoint. reason labels the state
+// transitions.
+func (a *agentLifecycle) restart(timeout time.Duration, force bool, reason string) error {
+	bead := a.hookedBead()
+	running, _ := tmux.NewTmux().HasSession(a.id.SessionName())
+	from := agentStateStopped
+	if running {
+		from = agentStateRunning
+	}
+	a.transition(from, agentStateRestarting, bead, reason, nil)
+	fmt.Printf("Restarting %s...\n", a.address())
+
+	if running {
+		if _, err := a.gracefulStop(timeout, force); err != nil {
+			a.transition(agentStateRestarting, from, bead, reason, err)
+			return fmt.Errorf("stopping %s: %w", a.address(), err)
+		}
+	}
+
+	// Keep the agent's place: the handshake asks it to checkpoint, but a
+	// forced or unresponsive stop may not have, so capture one if needed.
+	if a.workDir != "" {
+		if cp, _ := checkpoint.Read(a.workDir); cp == nil || cp.IsStale(timeout+time.Minute) {
+			if cp, err := checkpoint.Capture(a.workDir); err == nil {
+				cp.WithHookedBead(bead).WithNotes("captured by " + reason)
+				if err := checkpoint.Write(a.workDir, cp); err != nil {
+					style.PrintWarning("could not write checkpoint: %v", err)
+				}
+			}
+		}
+	}
+
+	err := a.start("restart")
+	to := agentStateRunning
+	if err != nil {
+		to = agentStateStopped
+	}
+	a.transition(agentStateRestarting, to, bead, reason, err)
+	if err != nil {
+		return fmt.Errorf("starting %s: %w", a.address(), err)
+	}
+
+	fmt.Printf("%s %s restarted\n", style.Bold.Render(ui.Glyph(ui.IconPass)), a.address())
+	if bead != "" {
+		fmt.Printf("  Hook kept: %s\n", bead)
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/roster"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	agentsApplyDryRun  bool
+	agentsApplyNoStop  bool
+	agentsApplyTimeout time.Duration
+	agentsApplyJSON    bool
+	agentsRosterInit   bool
+	agentsRosterForce  bool
+	agentsRosterJSON   bool
+)
+
+var agentsApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Start and stop agents to match the roster",
+	Long: `Reconcile running agents with the town roster (mayor/roster.json).
+
+Declared agents that aren't running are started; witnesses, refineries, and
+crew running in a rostered rig without being declared are stopped with the
+shutdown handshake (see 'gt agents stop'). Rigs the roster doesn't list,
+town-level agents, and polecats are left alone. Agents in parked or docked
+rigs are not started.
+
+The daemon runs the same reconciliation when its roster patrol is enabled
+in mayor/daemon.json:
+
+  "patrols": {
+    "roster": {"enabled": true, "interval": "10m"}
+  }
+
+While the roster patrol is enabled, the daemon heartbeat also stops
+restarting the witnesses and refineries the roster leaves out.
+
+Examples:
+  gt agents apply --dry-run     # Show drift without changing anything
+  gt agents apply
+  gt agents apply --no-stop     # Only start missing agents`,
+	Args: cobra.NoArgs,
+	RunE: runAgentsApply,
+}
+
+var agentsRosterCmd = &cobra.Command{
+	Use:   "roster",
+	Short: "Show the agent roster and how running agents differ from it",
+	Long: `Show the town roster: which witness, refinery, and crew agents each rig
+should run, and any drift from the running sessions.
+
+The roster lives in mayor/roster.json:
+
+  {
+    "type": "roster",
+    "version": 1,
+    "rigs": {
+      "gastown": {"witness": true, "refinery": true, "crew": ["max"]},
+      "beads":   {"witness": true}
+    }
+  }
+
+Use --init to write a roster of the defaults 'gt up' starts: every rig's
+witness and refinery, plus the crew named by its crew.startup setting.
+
+Examples:
+  gt agents roster
+  gt agents roster --init
+  gt agents roster --json`,
+	Args: cobra.NoArgs,
+	RunE: runAgentsRoster,
+}
+
+func init() {
+	agentsApplyCmd.Flags().BoolVarP(&agentsApplyDryRun, "dry-run", "n", false, "Show what would change without starting or stopping agents")
+	agentsApplyCmd.Flags().BoolVar(&agentsApplyNoStop, "no-stop", false, "Only start missing agents; leave undeclared ones running")
+	agentsApplyCmd.Flags().DurationVar(&agentsApplyTimeout, "timeout", 60*time.Second,
+		"How long to wait for each stopped agent to exit after the shutdown handshake")
+	agentsApplyCmd.Flags().BoolVar(&agentsApplyJSON, "json", false, "Output as JSON")
+
+	agentsRosterCmd.Flags().BoolVar(&agentsRosterInit, "init", false, "Write a roster declaring the agents 'gt up' starts in every rig")
+	agentsRosterCmd.Flags().BoolVarP(&agentsRosterForce, "force", "f", false, "With --init, overwrite an existing roster")
+	agentsRosterCmd.Flags().BoolVar(&agentsRosterJSON, "json", false, "Output as JSON")
+
+	agentsCmd.AddCommand(agentsApplyCmd)
+	agentsCmd.AddCommand(agentsRosterCmd)
+}
+
+// AgentsApplyAction is one change gt agents apply made or would make.
+type AgentsApplyAction struct {
+	Agent  string `json:"agent"`
+	Action string `json:"action"` // "start" or "stop"
+	Status string `json:"status"` // "planned", "done", "skipped", or "failed"
+	Detail string `json:"detail,omitempty"`
+}
+
+// loadRosterDrift loads the town roster and compares it with the running
+// sessions. Returns a nil roster if the town has none.
+func loadRosterDrift(townRoot string) (*roster.Roster, roster.Drift, error) {
+	r, err := roster.Load(townRoot)
+	if err != nil || r == nil {
+		return r, roster.Drift{}, err
+	}
+	names, err := tmux.NewTmux().ListSessions()
+	if err != nil {
+		return nil, roster.Drift{}, fmt.Errorf("listing sessions: %w", err)
+	}
+	return r, r.Diff(roster.Agents(names)), nil
+}
+
+func runAgentsApply(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	r, drift, err := loadRosterDrift(townRoot)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		return fmt.Errorf("no roster at %s (create one with 'gt agents roster --init')", roster.Path(townRoot))
+	}
+
+	var actions []AgentsApplyAction
+	for _, id := range drift.Missing {
+		actions = append(actions, applyRosterChange(id, "start"))
+	}
+	for _, id := range drift.Extra {
+		if agentsApplyNoStop {
+			actions = append(actions, AgentsApplyAction{Agent: id.Address(), Action: "stop", Status: "skipped", Detail: "--no-stop"})
+			continue
+		}
+		actions = append(actions, applyRosterChange(id, "stop"))
+	}
+
+	failed := 0
+	for _, a := range actions {
+		if a.Status == "failed" {
+			failed++
+		}
+	}
+
+	if agentsApplyJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(actions); err != nil {
+			return err
+		}
+	} else if len(actions) == 0 {
+		fmt.Printf("%s Agents match the roster\n", style.SuccessPrefix)
+	} else {
+		for _, a := range actions {
+			printApplyAction(a)
+		}
+	}
+	if failed > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// applyRosterChange starts or stops one agent (or plans to, with --dry-run).
+func applyRosterChange(id *session.AgentIdentity, action string) AgentsApplyAction {
+	res := AgentsApplyAction{Agent: id.Address(), Action: action}
+	if action == "start" {
+		if err := checkRigNotParkedOrDocked(id.Rig); err != nil {
+			res.Status, res.Detail = "skipped", err.Error()
+			return res
+		}
+	}
+	a, err := resolveAgentLifecycle(id.Address())
+	if err != nil {
+		res.Status, res.Detail = "failed", err.Error()
+		return res
+	}
+	if agentsApplyDryRun {
+		res.Status = "planned"
+		return res
+	}
+
+	const reason = "gt agents apply"
+	bead := a.hookedBead()
+	if action == "start" {
+		a.transition(agentStateStopped, agentStateStarting, bead, reason, nil)
+		err = a.start("start")
+		to := agentStateRunning
+		if err != nil {
+			to = agentStateStopped
+		}
+		a.transition(agentStateStarting, to, bead, reason, err)
+	} else {
+		a.transition(agentStateRunning, agentStateStopping, bead, reason, nil)
+		_, err = a.gracefulStop(agentsApplyTimeout, false)
+		a.transition(agentStateStopping, agentStateStopped, bead, reason, err)
+	}
+	if err != nil {
+		res.Status, res.Detail = "failed", err.Error()
+		return res
+	}
+	res.Status = "done"
+	return res
+}
+
+func printApplyAction(a AgentsApplyAction) {
+	verb := map[string]string{"start": "started", "stop": "stopped"}[a.Action]
+	switch a.Status {
+	case "planned":
+		fmt.Printf("  %s would %s %s\n", style.Warning.Render(ui.Glyph("→")), a.Action, a.Agent)
+	case "done":
+		fmt.Printf("  %s %s %s\n", style.SuccessPrefix, verb, a.Agent)
+	case "skipped":
+		fmt.Printf("  %s not %s %s: %s\n", style.Dim.Render(ui.Glyph("○")), verb, a.Agent, a.Detail)
+	default:
+		fmt.Printf("  %s failed to %s %s: %s\n", style.Error.Render(ui.Glyph("✗")), a.Action, a.Agent, a.Detail)
+	}
+}
+
+// AgentsRosterStatus is the roster and its drift, for gt agents roster --json.
+type AgentsRosterStatus struct {
+	Path    string         `json:"path"`
+	Roster  *roster.Roster `json:"roster"` // null if the town has no roster
+	Missing []string       `json:"missing"`
+	Extra   []string       `json:"extra"`
+}
+
+func runAgentsRoster(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if agentsRosterInit {
+		return initAgentsRoster(townRoot)
+	}
+
+	r, drift, err := loadRosterDrift(townRoot)
+	if err != nil {
+		return err
+	}
+	if r == nil && !agentsRosterJSON {
+		fmt.Printf("%s No roster at %s\n", style.Dim.Render(ui.Glyph("○")), roster.Path(townRoot))
+		fmt.Printf("\nCreate one from the running agents with: %s\n", style.Dim.Render("gt agents roster --init"))
+		return nil
+	}
+
+	if agentsRosterJSON {
+		status := AgentsRosterStatus{Path: roster.Path(townRoot), Roster: r, Missing: []string{}, Extra: []string{}}
+		for _, id := range drift.Missing {
+			status.Missing = append(status.Missing, id.Address())
+		}
+		for _, id := range drift.Extra {
+			status.Extra = append(status.Extra, id.Address())
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(status)
+	}
+
+	missing := map[string]bool{}
+	for _, id := range drift.Missing {
+		missing[id.Address()] = true
+	}
+	fmt.Printf("%s\n", style.Bold.Render("Roster"))
+	for _, id := range r.Declared() {
+		if missing[id.Address()] {
+			fmt.Printf("  %s %-32s %s\n", style.Warning.Render(ui.Glyph("○")), id.Address(), style.Warning.Render("not running"))
+		} else {
+			fmt.Printf("  %s %s\n", style.Success.Render(ui.Glyph("●")), id.Address())
+		}
+	}
+	for _, id := range drift.Extra {
+		fmt.Printf("  %s %-32s %s\n", style.Warning.Render("+"), id.Address(), style.Warning.Render("running, not declared"))
+	}
+	if drift.Clean() {
+		fmt.Printf("\n%s Agents match the roster\n", style.SuccessPrefix)
+	} else {
+		fmt.Printf("\nReconcile with: %s\n", style.Dim.Render("gt agents apply"))
+	}
+	return nil
+}
+
+// initAgentsRoster writes a roster declaring what 'gt up' starts in every
+// rig: its witness and refinery, and the crew its crew.startup setting names.
+func initAgentsRoster(townRoot string) error {
+	if !agentsRosterForce {
+		if _, err := os.Stat(roster.Path(townRoot)); err == nil {
+			return fmt.Errorf("roster already exists at %s (use --force to overwrite)", roster.Path(townRoot))
+		}
+	}
+	rigs, _, err := getAllRigs()
+	if err != nil {
+		return err
+	}
+	r := &roster.Roster{Rigs: map[string]roster.RigRoster{}}
+	for _, rg := range rigs {
+		crewNames := startupCrew(townRoot, rg.Name)
+		sort.Strings(crewNames)
+		r.Rigs[rg.Name] = roster.RigRoster{Witness: true, Refinery: true, Crew: crewNames}
+	}
+	if err := roster.Save(townRoot, r); err != nil {
+		return fmt.Errorf("writing roster: %w", err)
+	}
+	fmt.Printf("%s Wrote %s declaring %d agent(s) across %d rig(s)\n",
+		style.SuccessPrefix, roster.Path(townRoot), len(r.Declared()), len(r.Rigs))
+	return nil
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	agentsStatsRig  string
+	agentsStatsJSON bool
+)
+
+var agentsStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show agent performance scorecards",
+	Long: `Show per-agent performance scorecards.
+
+Scorecards aggregate each polecat's track record from the event log, the
+wisp stats ledger, and the session cost log:
+  DONE      wisps completed
+  PASS      refinery review pass rate (merged / merged + failed)
+  GATES     merges rejected by tests or quality gates
+  $/WISP    session cost per completed wisp
+  NUDGES    nudges received per completed wisp
+  SCORE     composite score (0-1) used by sling to prefer idle polecats
+            with better track records
+
+Agents with no history score 0.5.
+
+Examples:
+  gt agents stats
+  gt agents stats --rig gastown
+  gt agents stats --json`,
+	RunE: runAgentsStats,
+}
+
+func init() {
+	agentsStatsCmd.Flags().StringVar(&agentsStatsRig, "rig", "", "Only show agents in this rig")
+	agentsStatsCmd.Flags().BoolVar(&agentsStatsJSON, "json", false, "Output as JSON")
+	agentsCmd.AddCommand(agentsStatsCmd)
+}
+
+func runAgentsStats(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	cards, err := loadAgentScorecards(townRoot)
+	if err != nil {
+		return err
+	}
+	if agentsStatsRig != "" {
+		filtered := cards[:0]
+		for _, c := range cards {
+			if stats.RigFromAgent(c.Agent) == agentsStatsRig {
+				filtered = append(filtered, c)
+			}
+		}
+		cards = filtered
+	}
+
+	if agentsStatsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(cards)
+	}
+
+	if len(cards) == 0 {
+		fmt.Println("No agent activity recorded.")
+		return nil
+	}
+
+	fmt.Printf("%s\n\n", style.Bold.Render("Agent scorecards"))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "AGENT\tDONE\tMERGED\tFAILED\tPASS\tGATES\t$/WISP\tNUDGES\tSCORE")
+	for _, c := range cards {
+		pass := "-"
+		if c.Merged+c.MergeFailed > 0 {
+			pass = fmt.Sprintf("%.0f%%", c.ReviewPassRate*100)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\t%d\t$%.2f\t%.1f\t%.2f\n",
+			c.Agent, c.Completed, c.Merged, c.MergeFailed, pass, c.GateFailures,
+			c.CostPerWisp, c.NudgesPerWisp, c.Score)
+	}
+	return w.Flush()
+}
+
+// loadAgentScorecards builds scorecards from the wisp timelines, the event
+// log, and polecat session costs.
+func loadAgentScorecards(townRoot string) ([]*stats.Scorecard, error) {
+	timelines, err := stats.Load(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("loading wisp timelines: %w", err)
+	}
+	evs, err := events.ReadAll(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("reading events: %w", err)
+	}
+	return stats.BuildScorecards(timelines, evs, polecatCostSamples()), nil
+}
+
+// polecatCostSamples returns polecat session costs from the local cost log.
+func polecatCostSamples() []stats.CostSample {
+	var samples []stats.CostSample
+	for _, entry := range readCostLogEntries() {
+		if entry.Role != constants.RolePolecat || entry.Rig == "" || entry.Worker == "" {
+			continue
+		}
+		samples = append(samples, stats.CostSample{
+			Agent:   entry.Rig + "/polecats/" + entry.Worker,
+			CostUSD: entry.CostUSD,
+		})
+	}
+	return samples
+}
+
+// idlePolecatScorer returns a scoring function for idle polecats in rigName.
+// Scorecards are best-effort: if they cannot be loaded every polecat scores
+// the same and the first idle polecat is reused, as before.
+func idlePolecatScorer(townRoot, rigName string) func(name string) float64 {
+	cards, _ := loadAgentScorecards(townRoot)
+	return func(name string) float64 {
+		return stats.ScoreOf(cards, rigName+"/polecats/"+name)
+	}
+}
@@ -0,0 +1,439 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/approval"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+const (
+	// approvalPendingExitCode is gt approval request's exit code while pending.
+	approvalPendingExitCode = 2
+	// approvalPollInterval is how often a blocking request checks for a decision.
+	approvalPollInterval = 5 * time.Second
+)
+
+// errApprovalPending is returned when a gated action is parked awaiting approval.
+var errApprovalPending = errors.New("awaiting approval")
+
+// errNotOverseer is returned when an agent tries to decide an approval.
+var errNotOverseer = errors.New("only the overseer can approve or deny requests")
+
+var (
+	approvalListAll bool
+	approvalJSON    bool
+	approvalNote    string
+	approvalReason  string
+	approvalBead    string
+	approvalWait    bool
+	approvalWaitFor time.Duration
+)
+
+var approvalCmd = &cobra.Command{
+	Use:     "approval",
+	Aliases: []string{"approvals"},
+	GroupID: GroupWork,
+	Short:   "Human approval gates for designated actions",
+	Long: `Manage human approval gates.
+
+Actions the town designates require the overseer's approval before they
+run. Requesting an action mails the overseer; the requester either blocks
+until a decision or parks its wisp (status blocked, label approval:<id>)
+and retries later. Requests expire after a timeout (default 24h), and each
+approval allows the action once.
+
+Gateable actions:
+  force-push   An agent's git push --force (checked by the dangerous-command guard)
+  deploy       Deploy scripts call 'gt approval request deploy <target> --wait'
+  rig-remove   gt rig remove
+
+No action is gated until it is listed in settings/config.json:
+  "approvals": {"actions": ["force-push", "deploy"], "timeout": "4h"}
+
+Only the overseer can approve or deny a request: gt approval approve/deny
+refuse to run in an agent session (GT_ROLE set or an agent directory).
+
+Requests are kept in .runtime/approvals/ as an audit record.`,
+	RunE: requireSubcommand,
+}
+
+var approvalListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List approval requests (pending by default)",
+	RunE:  runApprovalList,
+}
+
+var approvalShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show an approval request",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runApprovalShow,
+}
+
+var approvalApproveCmd = &cobra.Command{
+	Use:   "approve <id>",
+	Short: "Approve a pending request",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runApprovalDecide(args[0], true)
+	},
+}
+
+var approvalDenyCmd = &cobra.Command{
+	Use:   "deny <id>",
+	Short: "Deny a pending request",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runApprovalDecide(args[0], false)
+	},
+}
+
+var approvalRequestCmd = &cobra.Command{
+	Use:   "request <action> <target>",
+	Short: "Request approval for an action (for patrols and formulas)",
+	Long: `Request approval for an action on a target.
+
+If the action is not gated, or an unused approval already exists, exits 0.
+Otherwise a request is filed (or the pending one reused) and the overseer is
+mailed. Without --wait the command parks: it exits 2 while the request is
+pending. With --wait it blocks until a decision. A denial exits 1.
+
+Examples:
+  gt approval request deploy prod --reason "release v1.4" --wait
+  gt approval request force-push polecat/Toast --bead gt-abc   # park the wisp`,
+	Args: cobra.ExactArgs(2),
+	RunE: runApprovalRequest,
+}
+
+func init() {
+	approvalListCmd.Flags().BoolVarP(&approvalListAll, "all", "a", false, "Include decided and expired requests")
+	approvalListCmd.Flags().BoolVar(&approvalJSON, "json", false, "Output as JSON")
+	approvalShowCmd.Flags().BoolVar(&approvalJSON, "json", false, "Output as JSON")
+	approvalApproveCmd.Flags().StringVar(&approvalNote, "note", "", "Note to record with the decision")
+	approvalDenyCmd.Flags().StringVar(&approvalNote, "note", "", "Note to record with the decision")
+	approvalRequestCmd.Flags().StringVar(&approvalReason, "reason", "", "Why the action is needed")
+	approvalRequestCmd.Flags().StringVar(&approvalBead, "bead", "", "Wisp to park on this request")
+	approvalRequestCmd.Flags().BoolVar(&approvalWait, "wait", false, "Block until the request is decided")
+	approvalRequestCmd.Flags().DurationVar(&approvalWaitFor, "timeout", 0, "Give up waiting after this long (default: until the request expires)")
+
+	approvalCmd.AddCommand(approvalListCmd)
+	approvalCmd.AddCommand(approvalShowCmd)
+	approvalCmd.AddCommand(approvalApproveCmd)
+	approvalCmd.AddCommand(approvalDenyCmd)
+	approvalCmd.AddCommand(approvalRequestCmd)
+	rootCmd.AddCommand(approvalCmd)
+}
+
+func runApprovalList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	all, err := approval.List(townRoot)
+	if err != nil {
+		return err
+	}
+	var reqs []*approval.Request
+	for _, r := range all {
+		if approvalListAll || r.IsPending() {
+			reqs = append(reqs, r)
+		}
+	}
+
+	if approvalJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(reqs)
+	}
+	if len(reqs) == 0 {
+		fmt.Println("No pending approval requests.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tACTION\tTARGET\tSTATUS\tREQUESTED BY\tAGE")
+	for _, r := range reqs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.ID, r.Action, r.Target, r.Status, r.RequestedBy, formatDuration(time.Since(r.CreatedAt)))
+	}
+	return w.Flush()
+}
+
+func runApprovalShow(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	r, err := approval.Load(townRoot, args[0])
+	if err != nil {
+		return err
+	}
+	if approvalJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	}
+
+	fmt.Printf("%s %s\n", style.Bold.Render(r.ID), r.Status)
+	fmt.Printf("  Action:    %s %s\n", r.Action, r.Target)
+	if r.Reason != "" {
+		fmt.Printf("  Reason:    %s\n", r.Reason)
+	}
+	if r.Bead != "" {
+		fmt.Printf("  Wisp:      %s\n", r.Bead)
+	}
+	fmt.Printf("  Requested: %s by %s\n", r.CreatedAt.Local().Format(time.RFC3339), r.RequestedBy)
+	fmt.Printf("  Expires:   %s\n", r.ExpiresAt.Local().Format(time.RFC3339))
+	if r.DecidedAt != nil {
+		fmt.Printf("  Decided:   %s by %s\n", r.DecidedAt.Local().Format(time.RFC3339), r.DecidedBy)
+	}
+	if r.Note != "" {
+		fmt.Printf("  Note:      %s\n", r.Note)
+	}
+	if r.ConsumedAt != nil {
+		fmt.Printf("  Used:      %s\n", r.ConsumedAt.Local().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func runApprovalDecide(id string, approve bool) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if err := checkOverseer(); err != nil {
+		return err
+	}
+	by, _ := commentIdentity()
+	r, err := approval.Decide(townRoot, id, approve, by, approvalNote)
+	if err != nil {
+		return err
+	}
+	_ = events.LogFeed(events.TypeApprovalDecided, by, events.ApprovalPayload(r.ID, r.Action, r.Target, string(r.Status)))
+
+	// Let the requester know so parked work can resume.
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	body := fmt.Sprintf("Request: %s\nAction: %s %s\nDecision: %s by %s", r.ID, r.Action, r.Target, r.Status, by)
+	if r.Note != "" {
+		body += "\nNote: " + r.Note
+	}
+	if r.Bead != "" {
+		body += "\nWisp: " + r.Bead
+		unparkApprovalWisp(r)
+	}
+	if err := router.Send(&mail.Message{
+		From:    by,
+		To:      r.RequestedBy,
+		Subject: fmt.Sprintf("APPROVAL %s: %s %s", r.Status, r.Action, r.Target),
+		Body:    body,
+	}); err != nil {
+		style.PrintWarning("could not notify %s: %v", r.RequestedBy, err)
+	}
+
+	fmt.Printf("%s %s %s (%s %s)\n", style.SuccessPrefix, r.Status, r.ID, r.Action, r.Target)
+	return nil
+}
+
+// checkOverseer refuses approval decisions from agent sessions, so an agent
+// cannot approve its own gated action.
+func checkOverseer() error {
+	if role := os.Getenv("GT_ROLE"); role != "" {
+		return fmt.Errorf("%w (running as agent %s)", errNotOverseer, role)
+	}
+	if sender := detectSender(); sender != "overseer" {
+		return fmt.Errorf("%w (running as agent %s)", errNotOverseer, sender)
+	}
+	return nil
+}
+
+func runApprovalRequest(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	ctx := context.Background()
+	if approvalWait {
+		var cancel context.CancelFunc
+		ctx, cancel = signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+		if approvalWaitFor > 0 {
+			ctx, cancel = context.WithTimeout(ctx, approvalWaitFor)
+			defer cancel()
+		}
+	}
+
+	err = requireApproval(ctx, townRoot, approvalGate{
+		Action: args[0],
+		Target: args[1],
+		Reason: approvalReason,
+		Bead:   approvalBead,
+		Wait:   approvalWait,
+	})
+	if errors.Is(err, errApprovalPending) {
+		fmt.Fprintln(os.Stderr, err)
+		return NewSilentExit(approvalPendingExitCode)
+	}
+	return err
+}
+
+// approvalGate describes a gated action.
+type approvalGate struct {
+	Action string
+	Target string
+	Reason string
+	Bead   string // Wisp to park, if any
+	Wait   bool   // Block until decided instead of parking
+}
+
+// requireApproval returns nil when the action may proceed: it is not gated,
+// or an unused approval exists (which is consumed). Otherwise it files or
+// reuses a request and either waits for a decision or returns an error
+// wrapping errApprovalPending so the caller can park.
+func requireApproval(ctx context.Context, townRoot string, g approvalGate) error {
+	policy, err := approval.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	if !policy.Requires(g.Action) {
+		return nil
+	}
+
+	r, err := approval.Find(townRoot, g.Action, g.Target)
+	if err != nil {
+		return err
+	}
+	if r != nil && r.Status == approval.StatusExpired {
+		// Retire the stale request, releasing any wisp it parked.
+		unparkApprovalWisp(r)
+		_ = approval.Consume(townRoot, r)
+		r = nil
+	}
+	if r == nil {
+		if r, err = fileApprovalRequest(townRoot, g, policy); err != nil {
+			return err
+		}
+	}
+
+	if r.IsPending() && g.Wait {
+		id := r.ID
+		fmt.Printf("Waiting for approval of %s %s (%s)...\n", g.Action, g.Target, id)
+		if r, err = approval.Wait(ctx, townRoot, id, approvalPollInterval); err != nil {
+			return fmt.Errorf("waiting for approval %s: %w", id, err)
+		}
+	}
+
+	switch r.Status {
+	case approval.StatusApproved:
+		if err := approval.Consume(townRoot, r); err != nil {
+			return fmt.Errorf("recording approval use: %w", err)
+		}
+		fmt.Printf("%s %s %s approved by %s (%s)\n", style.SuccessPrefix, g.Action, g.Target, r.DecidedBy, r.ID)
+		return nil
+	case approval.StatusDenied:
+		_ = approval.Consume(townRoot, r)
+		msg := fmt.Sprintf("%s %s denied by %s (%s)", g.Action, g.Target, r.DecidedBy, r.ID)
+		if r.Note != "" {
+			msg += ": " + r.Note
+		}
+		return errors.New(msg)
+	case approval.StatusExpired:
+		unparkApprovalWisp(r)
+		return fmt.Errorf("approval request %s for %s %s expired", r.ID, g.Action, g.Target)
+	default:
+		return fmt.Errorf("%w: %s %s needs overseer approval (%s); the overseer has been mailed and must approve it before this is re-run",
+			errApprovalPending, g.Action, g.Target, r.ID)
+	}
+}
+
+// fileApprovalRequest creates a request and notifies the overseer.
+func fileApprovalRequest(townRoot string, g approvalGate, policy *approval.Policy) (*approval.Request, error) {
+	requester := detectActor()
+	r, err := approval.New(townRoot, g.Action, g.Target, g.Reason, requester, policy.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("filing approval request: %w", err)
+	}
+	if g.Bead != "" {
+		parkApprovalWisp(r, g.Bead)
+		if err := approval.Save(townRoot, r); err != nil {
+			return nil, err
+		}
+	}
+	_ = events.LogFeed(events.TypeApprovalRequested, requester, events.ApprovalPayload(r.ID, r.Action, r.Target, ""))
+
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	body := fmt.Sprintf("%s requests approval to %s %s.\n", requester, g.Action, g.Target)
+	if g.Reason != "" {
+		body += "\nReason: " + g.Reason + "\n"
+	}
+	if g.Bead != "" {
+		body += "\nWisp " + g.Bead + " is parked until a decision.\n"
+	}
+	body += fmt.Sprintf("\nApprove: gt approval approve %s\nDeny:    gt approval deny %s\nExpires: %s\n",
+		r.ID, r.ID, r.ExpiresAt.Local().Format(time.RFC3339))
+	if err := router.Send(&mail.Message{
+		From:     requester,
+		To:       "overseer",
+		Subject:  fmt.Sprintf("APPROVAL NEEDED: %s %s", g.Action, g.Target),
+		Body:     body,
+		Priority: mail.PriorityHigh,
+	}); err != nil {
+		style.PrintWarning("could not mail overseer: %v", err)
+	}
+	fmt.Printf("%s Requested approval for %s %s (%s)\n", style.Bold.Render("⏸"), g.Action, g.Target, r.ID)
+	return r, nil
+}
+
+// approvalLabel marks a wisp parked on approval request id.
+func approvalLabel(id string) string {
+	return "approval:" + id
+}
+
+// parkApprovalWisp blocks bead until r is decided, remembering its status
+// so unparkApprovalWisp can restore it. Parking is best-effort: the request
+// stands even if the wisp cannot be updated.
+func parkApprovalWisp(r *approval.Request, bead string) {
+	r.Bead = bead
+	bd := beads.New(resolveBeadDir(bead))
+	issue, err := bd.Show(bead)
+	if err != nil {
+		style.PrintWarning("could not park %s: %v", bead, err)
+		return
+	}
+	if issue.Status == string(beads.StatusBlocked) {
+		return
+	}
+	blocked := string(beads.StatusBlocked)
+	if err := bd.Update(bead, beads.UpdateOptions{Status: &blocked, AddLabels: []string{approvalLabel(r.ID)}}); err != nil {
+		style.PrintWarning("could not park %s: %v", bead, err)
+		return
+	}
+	r.BeadStatus = issue.Status
+}
+
+// unparkApprovalWisp restores the wisp parked on r to its earlier status.
+func unparkApprovalWisp(r *approval.Request) {
+	if r.Bead == "" || r.BeadStatus == "" {
+		return
+	}
+	status := r.BeadStatus
+	bd := beads.New(resolveBeadDir(r.Bead))
+	if err := bd.Update(r.Bead, beads.UpdateOptions{Status: &status, RemoveLabels: []string{approvalLabel(r.ID)}}); err != nil {
+		style.PrintWarning("could not unpark %s: %v", r.Bead, err)
+	}
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckOverseer(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	t.Setenv("GT_ROLE", "")
+	if err := checkOverseer(); err != nil {
+		t.Errorf("checkOverseer() outside an agent session = %v, want nil", err)
+	}
+
+	t.Setenv("GT_ROLE", "gastown/polecats/toast")
+	if err := checkOverseer(); !errors.Is(err, errNotOverseer) {
+		t.Errorf("checkOverseer() in an agent session = %v, want errNotOverseer", err)
+	}
+}
@@ -0,0 +1,487 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/archive"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	archiveDryRun bool
+	archiveJSON   bool
+	archiveKind   string
+	archiveForce  bool
+)
+
+var archiveCmd = &cobra.Command{
+	Use:     "archive",
+	GroupID: GroupWork,
+	Short:   "Move old wisps and logs into compressed cold storage",
+	Long: `Move old artifacts out of hot storage into compressed archives.
+
+Archived:
+  wisp     Closed wisps in the current beads database (full bd show JSON);
+           wisps labeled keep are never archived
+  session  Agent session logs in <rig>/.runtime/session-logs
+  log      Rotated patrol logs in logs/ and daemon/ (never the live log)
+
+Each run writes one .tar.gz to the archive directory and adds its contents
+to a searchable index; originals are removed only after the archive is
+written. Use "gt archive find" to search and "gt archive restore" to bring
+an artifact back.
+
+Configure in settings/config.json (ages are durations or days; "0" turns
+archival off for that kind):
+  "archive": {"dir": ".runtime/archive", "wisps": "3d", "sessions": "14d", "logs": "30d"}
+
+Examples:
+  gt archive                    # Archive everything past its age
+  gt archive --dry-run          # Show what would be archived
+  gt archive find refinery      # Search the archive index
+  gt archive show gt-wisp-abc   # Print an archived artifact
+  gt archive restore gt-wisp-abc`,
+	Args: cobra.NoArgs,
+	RunE: runArchive,
+}
+
+var archiveListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List archived artifacts",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runArchiveFind("")
+	},
+}
+
+var archiveFindCmd = &cobra.Command{
+	Use:   "find <query>...",
+	Short: "Search archived artifacts by ID, title, rig, or kind",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runArchiveFind(strings.Join(args, " "))
+	},
+}
+
+var archiveShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Print an archived artifact",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runArchiveShow,
+}
+
+var archiveRestoreCmd = &cobra.Command{
+	Use:   "restore <id>",
+	Short: "Restore an archived wisp or log to hot storage",
+	Long: `Restore an archived artifact.
+
+Logs go back to their original path. Wisps are imported into the current
+beads database as archived: ID, fields, labels, assignee, comments,
+dependencies, status, and timestamps. If bd cannot import, the wisp is
+re-created field by field and only its timestamps are lost. The archived
+copy is kept.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runArchiveRestore,
+}
+
+func init() {
+	archiveCmd.Flags().BoolVar(&archiveDryRun, "dry-run", false, "Show what would be archived without changing anything")
+	archiveCmd.Flags().BoolVar(&archiveJSON, "json", false, "Output as JSON")
+	for _, c := range []*cobra.Command{archiveListCmd, archiveFindCmd} {
+		c.Flags().BoolVar(&archiveJSON, "json", false, "Output as JSON")
+		c.Flags().StringVar(&archiveKind, "kind", "", "Only show this kind (wisp, session, log)")
+	}
+	archiveRestoreCmd.Flags().BoolVar(&archiveForce, "force", false, "Overwrite a log that still exists")
+
+	archiveCmd.AddCommand(archiveListCmd, archiveFindCmd, archiveShowCmd, archiveRestoreCmd)
+	rootCmd.AddCommand(archiveCmd)
+}
+
+func runArchive(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := archive.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+
+	items, err := policy.FileCandidates(townRoot, now)
+	if err != nil {
+		return err
+	}
+	workDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working dir: %w", err)
+	}
+	bd := beads.New(workDir)
+	var warnings []string
+	if policy.WispAge > 0 {
+		wisps, err := archiveWispCandidates(bd, policy, now)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("wisps skipped: %v", err))
+		}
+		items = append(items, wisps...)
+	}
+
+	if archiveDryRun {
+		return printArchiveItems(items, warnings)
+	}
+
+	// Wisps are archived from their bd show JSON so comments and
+	// dependencies survive the round trip.
+	var ready []archive.Item
+	for _, it := range items {
+		if it.Kind == archive.KindWisp {
+			out, err := bd.Run("show", it.ID, "--json")
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s: %v", it.ID, err))
+				continue
+			}
+			it.Data = out
+		}
+		ready = append(ready, it)
+	}
+	entries, err := archive.Write(policy.Dir, ready, now)
+	if err != nil {
+		return fmt.Errorf("writing archive: %w", err)
+	}
+
+	// Only now that the archive is on disk do the originals go.
+	for i, e := range entries {
+		switch e.Kind {
+		case archive.KindWisp:
+			if _, err := bd.Run("delete", e.ID, "--force"); err != nil {
+				warnings = append(warnings, fmt.Sprintf("archived %s but could not delete it: %v", e.ID, err))
+			}
+		default:
+			if err := os.Remove(ready[i].Path); err != nil && !os.IsNotExist(err) {
+				warnings = append(warnings, fmt.Sprintf("archived %s but could not remove it: %v", e.ID, err))
+			}
+		}
+	}
+
+	if archiveJSON {
+		if entries == nil {
+			entries = []archive.Entry{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(map[string]interface{}{"archived": entries, "warnings": warnings})
+	}
+	for _, w := range warnings {
+		style.PrintWarning("%s", w)
+	}
+	if len(entries) == 0 {
+		fmt.Println("Nothing to archive.")
+		return nil
+	}
+	counts := map[string]int{}
+	var bytes int64
+	for _, e := range entries {
+		counts[e.Kind]++
+		bytes += e.Bytes
+	}
+	fmt.Printf("%s Archived %d wisp(s), %d session log(s), %d patrol log(s) (%s) to %s\n",
+		style.SuccessPrefix, counts[archive.KindWisp], counts[archive.KindSession], counts[archive.KindLog],
+		formatBytes(bytes), entries[0].Archive)
+	return nil
+}
+
+// archiveWispCandidates returns the closed, unkept wisps old enough to archive.
+func archiveWispCandidates(bd *beads.Beads, policy *archive.Policy, now time.Time) ([]archive.Item, error) {
+	wisps, err := listWisps(bd)
+	if err != nil {
+		return nil, err
+	}
+	rig := os.Getenv("GT_RIG")
+	var items []archive.Item
+	for _, w := range wisps {
+		if w.Status != "closed" || hasKeepLabel(w) {
+			continue
+		}
+		ts := w.ClosedAt
+		if ts == "" {
+			ts = w.UpdatedAt
+		}
+		closed, err := time.Parse(time.RFC3339, ts)
+		if err != nil || !policy.Due(archive.KindWisp, closed, now) {
+			continue
+		}
+		items = append(items, archive.Item{Entry: archive.Entry{
+			Kind:  archive.KindWisp,
+			ID:    w.ID,
+			Title: w.Title,
+			Rig:   rig,
+			Time:  closed.UTC(),
+		}})
+	}
+	return items, nil
+}
+
+func printArchiveItems(items []archive.Item, warnings []string) error {
+	if archiveJSON {
+		entries := make([]archive.Entry, 0, len(items))
+		for _, it := range items {
+			entries = append(entries, it.Entry)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(map[string]interface{}{"would_archive": entries, "warnings": warnings})
+	}
+	for _, w := range warnings {
+		style.PrintWarning("%s", w)
+	}
+	if len(items) == 0 {
+		fmt.Println("Nothing to archive.")
+		return nil
+	}
+	fmt.Printf("%s Would archive %d artifact(s):\n", style.Dim.Render("[dry-run]"), len(items))
+	for _, it := range items {
+		fmt.Printf("  %-7s %s  %s\n", it.Kind, it.Time.Local().Format("2006-01-02"), it.ID)
+	}
+	return nil
+}
+
+func runArchiveFind(query string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := archive.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	found, err := archive.Find(policy.Dir, query)
+	if err != nil {
+		return err
+	}
+	if archiveKind != "" {
+		var kept []archive.Entry
+		for _, e := range found {
+			if e.Kind == archiveKind {
+				kept = append(kept, e)
+			}
+		}
+		found = kept
+	}
+
+	if archiveJSON {
+		if found == nil {
+			found = []archive.Entry{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(found)
+	}
+	if len(found) == 0 {
+		fmt.Println("No archived artifacts found.")
+		return nil
+	}
+	for _, e := range found {
+		title := e.Title
+		if title == e.ID || title == "" {
+			title = ""
+		} else {
+			title = "  " + style.Dim.Render(title)
+		}
+		fmt.Printf("  %-7s %s  %s%s\n", e.Kind, e.Time.Local().Format("2006-01-02"), e.ID, title)
+	}
+	fmt.Printf("\n%d archived artifact(s)\n", len(found))
+	return nil
+}
+
+func runArchiveShow(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := archive.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	e, err := archive.Lookup(policy.Dir, args[0])
+	if err != nil {
+		return err
+	}
+	data, err := archive.Read(policy.Dir, e)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+func runArchiveRestore(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := archive.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	e, err := archive.Lookup(policy.Dir, args[0])
+	if err != nil {
+		return err
+	}
+
+	if e.Kind != archive.KindWisp {
+		dst, err := archive.RestoreFile(townRoot, policy.Dir, e, archiveForce)
+		if err != nil {
+			if errors.Is(err, archive.ErrExists) {
+				return fmt.Errorf("%w (use --force to overwrite)", err)
+			}
+			return err
+		}
+		fmt.Printf("%s Restored %s\n", style.SuccessPrefix, dst)
+		return nil
+	}
+
+	data, err := archive.Read(policy.Dir, e)
+	if err != nil {
+		return err
+	}
+	w, record, err := parseArchivedWisp(data)
+	if err != nil {
+		return fmt.Errorf("parsing archived %s: %w", e.ID, err)
+	}
+	workDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working dir: %w", err)
+	}
+	bd := beads.New(workDir)
+	if _, err := bd.Show(w.ID); err == nil {
+		return fmt.Errorf("%s: %w", w.ID, archive.ErrExists)
+	}
+
+	// bd import brings back the whole record: timestamps, assignee,
+	// comments, and dependencies. Older bd without import gets the wisp
+	// rebuilt field by field, which loses only the timestamps.
+	if err := importArchivedWisp(bd, record); err != nil {
+		style.PrintWarning("bd import failed (%v); re-creating %s field by field", err, w.ID)
+		if err := recreateArchivedWisp(bd, w); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("%s Restored wisp %s: %s\n", style.SuccessPrefix, w.ID, w.Title)
+	return nil
+}
+
+// archivedWisp is a wisp as archived: bd show --json output, including
+// its comments.
+type archivedWisp struct {
+	beads.Issue
+	Comments []struct {
+		Author    string    `json:"author"`
+		Text      string    `json:"text"`
+		CreatedAt time.Time `json:"created_at"`
+	} `json:"comments,omitempty"`
+}
+
+// parseArchivedWisp decodes bd show --json output, which is an array for
+// some bd versions and a single object for others. It also returns the
+// wisp's JSON object as one line, ready for bd import.
+func parseArchivedWisp(data []byte) (*archivedWisp, []byte, error) {
+	raw := json.RawMessage(data)
+	var list []json.RawMessage
+	if err := json.Unmarshal(data, &list); err == nil {
+		if len(list) == 0 {
+			return nil, nil, fmt.Errorf("empty archived wisp")
+		}
+		raw = list[0]
+	}
+	var w archivedWisp
+	if err := json.Unmarshal(raw, &w); err != nil {
+		return nil, nil, err
+	}
+	var line bytes.Buffer
+	if err := json.Compact(&line, raw); err != nil {
+		return nil, nil, err
+	}
+	line.WriteByte('\n')
+	return &w, line.Bytes(), nil
+}
+
+// importArchivedWisp restores record with bd import.
+func importArchivedWisp(bd *beads.Beads, record []byte) error {
+	f, err := os.CreateTemp("", "gt-archive-restore-*.jsonl")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(record); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	_, err = bd.Run("import", "-i", f.Name())
+	return err
+}
+
+// recreateArchivedWisp rebuilds w with bd create and updates: fields,
+// labels, assignee, dependencies, comments, and status.
+func recreateArchivedWisp(bd *beads.Beads, w *archivedWisp) error {
+	if _, err := bd.CreateWithID(w.ID, beads.CreateOptions{
+		Title:              w.Title,
+		Type:               w.Type,
+		Priority:           w.Priority,
+		Description:        w.Description,
+		Parent:             w.Parent,
+		Actor:              w.CreatedBy,
+		Ephemeral:          true,
+		AcceptanceCriteria: w.AcceptanceCriteria,
+	}); err != nil {
+		return fmt.Errorf("re-creating %s: %w", w.ID, err)
+	}
+	update := beads.UpdateOptions{SetLabels: w.Labels}
+	if w.Assignee != "" {
+		update.Assignee = &w.Assignee
+	}
+	if len(update.SetLabels) > 0 || update.Assignee != nil {
+		if err := bd.Update(w.ID, update); err != nil {
+			style.PrintWarning("could not restore labels and assignee on %s: %v", w.ID, err)
+		}
+	}
+	for _, dep := range w.Dependencies {
+		if dep.DependencyType == "parent-child" {
+			continue // Restored by Parent
+		}
+		if err := bd.AddDependency(w.ID, dep.ID); err != nil {
+			style.PrintWarning("could not restore dependency %s → %s: %v", w.ID, dep.ID, err)
+		}
+	}
+	for _, c := range w.Comments {
+		body := c.Text
+		if !c.CreatedAt.IsZero() {
+			body = fmt.Sprintf("%s\n\n(originally %s)", body, c.CreatedAt.Format(time.RFC3339))
+		}
+		if err := bd.AddComment(w.ID, &beads.Comment{Author: c.Author, Body: body}); err != nil {
+			style.PrintWarning("could not restore a comment on %s: %v", w.ID, err)
+		}
+	}
+	if w.Status == "closed" {
+		if err := bd.Close(w.ID); err != nil {
+			style.PrintWarning("could not close restored %s: %v", w.ID, err)
+		}
+	} else if w.Status != "" && w.Status != "open" {
+		status := w.Status
+		if err := bd.Update(w.ID, beads.UpdateOptions{Status: &status}); err != nil {
+			style.PrintWarning("could not restore status on %s: %v", w.ID, err)
+		}
+	}
+	return nil
+}
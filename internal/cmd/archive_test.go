@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseArchivedWisp(t *testing.T) {
+	data := []byte(`[
+  {
+    "id": "gt-wisp-abc",
+    "title": "patrol",
+    "status": "closed",
+    "assignee": "gastown/witness",
+    "created_at": "2026-10-01T10:00:00Z",
+    "comments": [{"author": "gastown/witness", "text": "done", "created_at": "2026-10-01T11:00:00Z"}]
+  }
+]`)
+	w, record, err := parseArchivedWisp(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.ID != "gt-wisp-abc" || w.Assignee != "gastown/witness" || len(w.Comments) != 1 || w.Comments[0].Text != "done" {
+		t.Errorf("parsed = %+v", w)
+	}
+	line := string(record)
+	if strings.Count(line, "\n") != 1 || !strings.HasSuffix(line, "\n") || strings.HasPrefix(line, "[") {
+		t.Errorf("record should be one JSON object line, got %q", line)
+	}
+	if !strings.Contains(line, `"created_at":"2026-10-01T10:00:00Z"`) || !strings.Contains(line, `"comments":[`) {
+		t.Errorf("record lost fields: %s", line)
+	}
+
+	if _, _, err := parseArchivedWisp([]byte(`[]`)); err == nil {
+		t.Error("empty array should fail")
+	}
+}
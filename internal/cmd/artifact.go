@@ -0,0 +1,358 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/artifact"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	artifactWisp   string
+	artifactDesc   string
+	artifactKind   string
+	artifactJSON   bool
+	artifactDryRun bool
+)
+
+var artifactCmd = &cobra.Command{
+	Use:     "artifact",
+	GroupID: GroupWork,
+	Short:   "Register and inspect what agents produce for a wisp",
+	Long: `Manage the artifacts registered against wisps.
+
+Kinds:
+  branch   Git branch holding the wisp's work
+  patch    Patch or diff file
+  report   Test, review, or analysis report
+  doc      Document written for the wisp
+
+Each artifact gets a stable ID (art-...) from its wisp, kind, and reference,
+so registering the same thing twice updates one record. Local files are
+copied into .runtime/artifacts/ so they outlive the worktree; URLs are
+recorded as-is. gt done and gt mq submit register the branch they submit.
+
+The merge queue looks up a branch's wisp here before guessing from the
+branch name, and refinery gates get the wisp's artifacts as JSON in
+GT_WISP_ARTIFACTS. Artifacts appear in gt trace and gt status.
+
+Once a wisp closes its artifacts are kept for a per-kind retention and then
+removed by gt artifact prune. Configure in settings/config.json (durations
+or days; "0" keeps that kind forever):
+  "artifacts": {"retention": {"branch": "30d", "patch": "30d", "report": "90d", "doc": "90d"}}
+
+Examples:
+  gt artifact add branch polecat/furiosa-mkb0vq9f
+  gt artifact add report ./coverage.html -m "coverage after fix"
+  gt artifact list gt-abc
+  gt artifact show art-1a2b3c4d5e`,
+	RunE: requireSubcommand,
+}
+
+var artifactAddCmd = &cobra.Command{
+	Use:   "add <kind> <ref>",
+	Short: "Register an artifact against a wisp",
+	Long: `Register an artifact against a wisp (default: your hooked bead).
+
+ref is a branch name for branch artifacts, and a file path or URL for the
+other kinds. Registering an existing artifact again refreshes its stored
+copy and description.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runArtifactAdd,
+}
+
+var artifactListCmd = &cobra.Command{
+	Use:   "list [wisp]",
+	Short: "List artifacts of one wisp, or of every wisp",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runArtifactList,
+}
+
+var artifactShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show an artifact",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runArtifactShow,
+}
+
+var artifactRmCmd = &cobra.Command{
+	Use:   "rm <id>",
+	Short: "Remove an artifact and its stored copy",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runArtifactRm,
+}
+
+var artifactPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove artifacts of closed wisps past their retention",
+	Args:  cobra.NoArgs,
+	RunE:  runArtifactPrune,
+}
+
+func init() {
+	artifactAddCmd.Flags().StringVar(&artifactWisp, "wisp", "", "Wisp to register against (default: hooked bead)")
+	artifactAddCmd.Flags().StringVarP(&artifactDesc, "message", "m", "", "Description of the artifact")
+	artifactAddCmd.Flags().BoolVar(&artifactJSON, "json", false, "Output as JSON")
+	artifactListCmd.Flags().StringVar(&artifactKind, "kind", "", "Only show this kind (branch, patch, report, doc)")
+	artifactListCmd.Flags().BoolVar(&artifactJSON, "json", false, "Output as JSON")
+	artifactShowCmd.Flags().BoolVar(&artifactJSON, "json", false, "Output as JSON")
+	artifactPruneCmd.Flags().BoolVar(&artifactDryRun, "dry-run", false, "Show what would be removed without changing anything")
+	artifactPruneCmd.Flags().BoolVar(&artifactJSON, "json", false, "Output as JSON")
+
+	artifactCmd.AddCommand(artifactAddCmd, artifactListCmd, artifactShowCmd, artifactRmCmd, artifactPruneCmd)
+	rootCmd.AddCommand(artifactCmd)
+}
+
+func runArtifactAdd(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	kind, ref := args[0], args[1]
+	if !artifact.ValidKind(kind) {
+		return fmt.Errorf("unknown artifact kind %q (want %s)", kind, strings.Join(artifact.Kinds, ", "))
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working dir: %w", err)
+	}
+
+	wisp := artifactWisp
+	rigName := ""
+	if info, err := GetRole(); err == nil {
+		rigName = info.Rig
+		if wisp == "" {
+			wisp = detectHookedBead(cwd, info)
+		}
+	}
+	if wisp == "" {
+		return errors.New("no hooked bead; use --wisp to name the wisp")
+	}
+	if kind != artifact.KindBranch && !artifact.IsURL(ref) && !filepath.IsAbs(ref) {
+		ref = filepath.Join(cwd, ref)
+	}
+
+	a, created, err := artifact.Register(townRoot, artifact.Artifact{
+		Wisp:        wisp,
+		Kind:        kind,
+		Ref:         ref,
+		Description: artifactDesc,
+		Rig:         rigName,
+		CreatedBy:   detectActor(),
+	})
+	if err != nil {
+		return err
+	}
+	if artifactJSON {
+		return printArtifactJSON(a)
+	}
+	verb := "Registered"
+	if !created {
+		verb = "Updated"
+	}
+	fmt.Printf("%s %s %s %s on %s\n", style.SuccessPrefix, verb, a.Kind, style.Bold.Render(a.ID), a.Wisp)
+	return nil
+}
+
+func runArtifactList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if artifactKind != "" && !artifact.ValidKind(artifactKind) {
+		return fmt.Errorf("unknown artifact kind %q (want %s)", artifactKind, strings.Join(artifact.Kinds, ", "))
+	}
+	var list []artifact.Artifact
+	if len(args) == 1 {
+		list, err = artifact.List(townRoot, args[0])
+	} else {
+		list, err = artifact.ListAll(townRoot)
+	}
+	if err != nil {
+		return err
+	}
+	shown := []artifact.Artifact{}
+	for _, a := range list {
+		if artifactKind == "" || a.Kind == artifactKind {
+			shown = append(shown, a)
+		}
+	}
+	if artifactJSON {
+		return printArtifactJSON(shown)
+	}
+	if len(shown) == 0 {
+		fmt.Println(style.Dim.Render("No artifacts registered."))
+		return nil
+	}
+	for _, a := range shown {
+		line := fmt.Sprintf("  %s  %-12s %-6s %s", style.Bold.Render(a.ID), a.Wisp, a.Kind, a.Ref)
+		if a.Description != "" {
+			line += " " + style.Dim.Render("— "+a.Description)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+func runArtifactShow(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	a, err := artifact.Get(townRoot, args[0])
+	if err != nil {
+		return err
+	}
+	if artifactJSON {
+		return printArtifactJSON(a)
+	}
+	fmt.Printf("%s %s\n", style.Bold.Render(a.ID), a.Kind)
+	for _, kv := range [][2]string{
+		{"wisp", a.Wisp},
+		{"ref", a.Ref},
+		{"description", a.Description},
+		{"rig", a.Rig},
+		{"created by", a.CreatedBy},
+	} {
+		if kv[1] != "" {
+			fmt.Printf("  %-12s %s\n", kv[0]+":", kv[1])
+		}
+	}
+	if a.Stored != "" {
+		fmt.Printf("  %-12s %s (%d bytes)\n", "stored:", filepath.Join(townRoot, a.Stored), a.Bytes)
+	}
+	fmt.Printf("  %-12s %s\n", "created:", a.CreatedAt.Local().Format(time.RFC3339))
+	if !a.UpdatedAt.Equal(a.CreatedAt) {
+		fmt.Printf("  %-12s %s\n", "updated:", a.UpdatedAt.Local().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func runArtifactRm(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	a, err := artifact.Remove(townRoot, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s Removed %s %s from %s\n", style.SuccessPrefix, a.Kind, style.Bold.Render(a.ID), a.Wisp)
+	return nil
+}
+
+func runArtifactPrune(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := artifact.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	pruned, err := artifact.Prune(townRoot, policy, wispClosedAt(townRoot), time.Now().UTC(), artifactDryRun)
+	if artifactJSON {
+		if pruned == nil {
+			pruned = []artifact.Artifact{}
+		}
+		if jerr := printArtifactJSON(pruned); jerr != nil {
+			return jerr
+		}
+		return err
+	}
+	verb := "Removed"
+	if artifactDryRun {
+		verb = "Would remove"
+	}
+	for _, a := range pruned {
+		fmt.Printf("  %s %s %-6s %s %s\n", verb, style.Bold.Render(a.ID), a.Kind, a.Ref, style.Dim.Render("("+a.Wisp+")"))
+	}
+	if len(pruned) == 0 {
+		fmt.Println(style.Dim.Render("No artifacts past their retention."))
+	}
+	return err
+}
+
+// wispClosedAt reports when a wisp closed, from its bead. A wisp whose bead
+// is gone (archived or deleted) counts as closed when its artifacts were
+// last updated.
+func wispClosedAt(townRoot string) artifact.ClosedFunc {
+	bd := beads.New(townRoot)
+	return func(wisp string) (time.Time, bool) {
+		issue, err := bd.Show(wisp)
+		if errors.Is(err, beads.ErrNotFound) {
+			list, lerr := artifact.List(townRoot, wisp)
+			if lerr != nil {
+				return time.Time{}, false
+			}
+			var last time.Time
+			for _, a := range list {
+				if a.UpdatedAt.After(last) {
+					last = a.UpdatedAt
+				}
+			}
+			return last, !last.IsZero()
+		}
+		if err != nil || issue.Status != string(beads.StatusClosed) || issue.ClosedAt == "" {
+			return time.Time{}, false
+		}
+		t, err := time.Parse(time.RFC3339, issue.ClosedAt)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+}
+
+func printArtifactJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// registeredBranch returns the branch most recently registered for wisp,
+// or "" if none is.
+func registeredBranch(townRoot, wisp string) string {
+	a, err := artifact.Latest(townRoot, wisp, artifact.KindBranch)
+	if err != nil || a == nil {
+		return ""
+	}
+	return a.Ref
+}
+
+// wispForBranch returns the wisp branch is registered to in rigName, or ""
+// if it is not registered there.
+func wispForBranch(townRoot, rigName, branch string) string {
+	a, err := artifact.FindByRef(townRoot, rigName, artifact.KindBranch, branch)
+	if err != nil || a == nil {
+		return ""
+	}
+	return a.Wisp
+}
+
+// registerBranchArtifact records branch as wisp's branch artifact. Failures
+// are warnings: the registry must not block a submit.
+func registerBranchArtifact(townRoot, wisp, branch, rigName string) {
+	if wisp == "" || branch == "" {
+		return
+	}
+	_, _, err := artifact.Register(townRoot, artifact.Artifact{
+		Wisp:      wisp,
+		Kind:      artifact.KindBranch,
+		Ref:       branch,
+		Rig:       rigName,
+		CreatedBy: detectActor(),
+	})
+	if err != nil {
+		style.PrintWarning("could not register branch artifact: %v", err)
+	}
+}
@@ -0,0 +1,441 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/locks"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+var (
+	askAgent   string
+	askBorrow  string
+	askTimeout time.Duration
+	askKeep    bool
+)
+
+// askBusyGrace is how long gt ask waits to see the agent start working on
+// the question before accepting an idle pane as its answer. Without it, the
+// prompt still on screen right after sending would read as "done".
+const askBusyGrace = 10 * time.Second
+
+var askCmd = &cobra.Command{
+	Use:     "ask <rig> <question>",
+	GroupID: GroupComm,
+	Short:   "Ask a rig-scoped agent one question and print its answer",
+	Long: `Ask a one-off question about a rig and print the answer.
+
+gt ask starts a short-lived agent primed with the rig's context, sends it
+the question, waits for it to finish responding, prints the answer, and
+shuts the agent down. The agent runs in a scratch worktree of the rig's
+default branch that is removed with it, so nothing it writes reaches the
+rig. It is told to answer only: it does not sling work or send mail.
+
+Ask agents left running with --keep form a warm pool: later questions to
+the rig go to an idle kept agent instead of starting a new one. Stop one
+with 'gt agents stop <rig>/crew/ask-<id> --force' when done; its worktree
+is cleaned up by the next gt ask.
+
+Use --borrow to ask a specific running agent (for example a crew member
+with the context you need). A borrowed agent must be idle; it keeps
+running afterwards.
+
+Examples:
+  gt ask gastown "Where is the refinery merge queue implemented?"
+  gt ask beads "Which tests cover the sync path?" --timeout 10m
+  gt ask gastown "What are you working on?" --borrow gastown/crew/max
+  gt ask gastown "Summarize the open MRs" --keep   # Keep the agent warm`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runAsk,
+}
+
+func init() {
+	askCmd.Flags().StringVar(&askAgent, "agent", "", "Agent alias to run the question with (overrides the rig default)")
+	askCmd.Flags().StringVar(&askBorrow, "borrow", "", "Ask this running agent (address, e.g. gastown/crew/max) instead of starting one")
+	askCmd.Flags().DurationVar(&askTimeout, "timeout", 5*time.Minute, "How long to wait for the answer")
+	askCmd.Flags().BoolVar(&askKeep, "keep", false, "Leave the started agent running to answer later questions")
+	rootCmd.AddCommand(askCmd)
+}
+
+func runAsk(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+	question := strings.TrimSpace(strings.Join(args[1:], " "))
+	if question == "" {
+		return errors.New("question is empty")
+	}
+	townRoot, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+	t := tmux.NewTmux()
+
+	var agent *askHelper
+	if askBorrow != "" {
+		agent, err = borrowAskAgent(t, townRoot, rigName)
+	} else if agent = borrowWarmAskAgent(t, townRoot, rigName); agent == nil {
+		agent, err = newAskAgent(t, townRoot, r)
+	}
+	if err != nil {
+		return err
+	}
+	defer agent.release(t, askKeep)
+
+	// The waits below cannot be interrupted, so shut a started agent down
+	// here on Ctrl-C rather than leaking its session and worktree.
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	go func() {
+		<-sigCh
+		agent.release(t, false)
+		os.Exit(130)
+	}()
+
+	if agent.started {
+		if err := agent.start(t, townRoot, r); err != nil {
+			return err
+		}
+	}
+
+	answer, err := askQuestion(t, agent.sessionID, question, askTimeout)
+	if err != nil {
+		return err
+	}
+	fmt.Println(answer)
+	if askKeep && agent.started {
+		fmt.Fprintf(os.Stderr, "%s Agent left running in %s for the next question\n", style.Dim.Render(ui.Glyph("○")), agent.sessionID)
+	}
+	return nil
+}
+
+// askNamePrefix starts the crew name of every gt ask agent, so agents kept
+// with --keep can be found again: sessions are <prefix>-crew-ask-<hex>.
+const askNamePrefix = "ask-"
+
+// askHelper is the agent answering a question: one started for it, one kept
+// from an earlier gt ask --keep, or one named with --borrow. The lease keeps
+// two gt ask runs from talking to the same agent at once.
+type askHelper struct {
+	sessionID string
+	name      string
+	worktree  string // Scratch worktree the agent runs in; "" if not ours
+	repo      *git.Git
+	lease     *locks.Handle
+	started   bool // Started for this question, so ours to shut down
+	once      sync.Once
+}
+
+// askLockName is the lease an ask agent is held under while in use.
+func askLockName(sessionID string) string {
+	return "ask/" + sessionID
+}
+
+// askWorktreeDir holds the scratch worktrees of a rig's ask agents.
+func askWorktreeDir(rigPath string) string {
+	return filepath.Join(rigPath, constants.DirRuntime, "ask")
+}
+
+// holdAskAgent takes the lease on an agent, failing at once if another gt
+// ask holds it.
+func holdAskAgent(townRoot, sessionID string) (*locks.Handle, error) {
+	return locks.Acquire(townRoot, askLockName(sessionID), locks.Options{
+		Purpose: "gt ask",
+		TTL:     2 * askTimeout, // Priming, then answering
+	})
+}
+
+// release ends this question's use of the agent. A started agent is shut
+// down and its worktree removed unless keep is set; other agents keep
+// running.
+func (a *askHelper) release(t *tmux.Tmux, keep bool) {
+	a.once.Do(func() {
+		if a.started && !keep {
+			_ = t.KillSessionWithProcesses(a.sessionID)
+			removeAskWorktree(a.repo, a.worktree)
+		}
+		if a.lease != nil {
+			a.lease.Release()
+		}
+	})
+}
+
+// borrowAskAgent resolves --borrow to a running, idle agent in the rig.
+func borrowAskAgent(t *tmux.Tmux, townRoot, rigName string) (*askHelper, error) {
+	id, err := session.ParseAddress(askBorrow)
+	if err != nil {
+		return nil, err
+	}
+	if id.Rig != rigName {
+		return nil, fmt.Errorf("%s is not in rig %s", askBorrow, rigName)
+	}
+	sessionID := id.SessionName()
+	if running, _ := t.HasSession(sessionID); !running || !t.IsAgentAlive(sessionID) {
+		return nil, fmt.Errorf("%s is not running", askBorrow)
+	}
+	lease, err := holdAskAgent(townRoot, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s is answering another question: %w", askBorrow, err)
+	}
+	if err := t.WaitForIdle(sessionID, constants.InterruptSettleTimeout); err != nil {
+		lease.Release()
+		return nil, fmt.Errorf("%s is busy; ask again later or without --borrow", askBorrow)
+	}
+	return &askHelper{sessionID: sessionID, lease: lease}, nil
+}
+
+// borrowWarmAskAgent returns an idle ask agent left running in the rig by
+// gt ask --keep, or nil if none is free. Borrowed agents stay running.
+func borrowWarmAskAgent(t *tmux.Tmux, townRoot, rigName string) *askHelper {
+	sessions, err := t.ListSessions()
+	if err != nil {
+		return nil
+	}
+	prefix := session.CrewSessionName(session.PrefixFor(rigName), askNamePrefix)
+	for _, sessionID := range sessions {
+		if !strings.HasPrefix(sessionID, prefix) || !t.IsAgentAlive(sessionID) {
+			continue
+		}
+		lease, err := holdAskAgent(townRoot, sessionID)
+		if err != nil {
+			continue // In use by another gt ask
+		}
+		if t.WaitForIdle(sessionID, constants.InterruptSettleTimeout) != nil {
+			lease.Release()
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "%s Asking warm agent %s\n", style.Dim.Render(ui.Glyph("○")), sessionID)
+		return &askHelper{sessionID: sessionID, lease: lease}
+	}
+	return nil
+}
+
+// newAskAgent names and leases a new ask agent for the rig; start runs it.
+func newAskAgent(t *tmux.Tmux, townRoot string, r *rig.Rig) (*askHelper, error) {
+	repo, err := askRepo(r.Path)
+	if err != nil {
+		return nil, err
+	}
+	pruneAskWorktrees(t, townRoot, r, repo)
+
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, err
+	}
+	name := askNamePrefix + hex.EncodeToString(suffix)
+	sessionID := session.CrewSessionName(session.PrefixFor(r.Name), name)
+	lease, err := holdAskAgent(townRoot, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &askHelper{
+		sessionID: sessionID,
+		name:      name,
+		worktree:  filepath.Join(askWorktreeDir(r.Path), name),
+		repo:      repo,
+		lease:     lease,
+		started:   true,
+	}, nil
+}
+
+// start checks out a scratch worktree of the rig's default branch, starts
+// the agent in it, and waits for it to finish priming. Anything the agent
+// writes is thrown away with the worktree.
+func (a *askHelper) start(t *tmux.Tmux, townRoot string, r *rig.Rig) error {
+	if err := os.MkdirAll(filepath.Dir(a.worktree), 0755); err != nil {
+		return fmt.Errorf("creating ask worktree dir: %w", err)
+	}
+	ref := "origin/" + r.DefaultBranch()
+	if ok, _ := a.repo.RefExists(ref); !ok {
+		ref = "HEAD"
+	}
+	if err := a.repo.WorktreeAddDetached(a.worktree, ref); err != nil {
+		return fmt.Errorf("creating ask worktree: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "%s Starting agent for %s...\n", style.Dim.Render(ui.Glyph("○")), r.Name)
+	_, err := session.StartSession(t, session.SessionConfig{
+		SessionID: a.sessionID,
+		WorkDir:   a.worktree,
+		Role:      constants.RoleCrew,
+		TownRoot:  townRoot,
+		RigPath:   r.Path,
+		RigName:   r.Name,
+		AgentName: a.name,
+		Beacon: session.BeaconConfig{
+			Recipient: session.BeaconRecipient("ask agent", "", r.Name),
+			Sender:    "human",
+			Topic:     "ask",
+		},
+		Instructions: "You were started to answer questions about this rig, in a scratch checkout that is discarded afterwards. " +
+			"Answer from the code and beads; do not sling work, send mail, or run gt done. " +
+			"Wait for the question.",
+		AgentOverride: askAgent,
+		WaitForAgent:  true,
+		WaitFatal:     true,
+		AcceptBypass:  true,
+		ReadyDelay:    true,
+	})
+	if err != nil {
+		return fmt.Errorf("starting agent: %w", err)
+	}
+	// Let the agent finish priming from the startup beacon before asking.
+	if err := t.WaitForResponse(a.sessionID, newBusyFirst(t.ResponseDetectorFor(a.sessionID)), askTimeout); err != nil {
+		return fmt.Errorf("agent did not finish starting: %w", err)
+	}
+	return nil
+}
+
+// askRepo returns the repository ask worktrees are checked out from: the
+// rig's shared bare repo, or mayor/rig in rigs without one.
+func askRepo(rigPath string) (*git.Git, error) {
+	bareRepoPath := filepath.Join(rigPath, ".repo.git")
+	if info, err := os.Stat(bareRepoPath); err == nil && info.IsDir() {
+		return git.NewGitWithDir(bareRepoPath, ""), nil
+	}
+	mayorPath := filepath.Join(rigPath, "mayor", "rig")
+	if _, err := os.Stat(mayorPath); err != nil {
+		return nil, fmt.Errorf("no repo to check out (neither .repo.git nor mayor/rig exists)")
+	}
+	return git.NewGit(mayorPath), nil
+}
+
+// pruneAskWorktrees removes the worktrees of ask agents that are gone: kept
+// agents that were since killed, or a gt ask that died before cleaning up.
+func pruneAskWorktrees(t *tmux.Tmux, townRoot string, r *rig.Rig, repo *git.Git) {
+	dir := askWorktreeDir(r.Path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		sessionID := session.CrewSessionName(session.PrefixFor(r.Name), e.Name())
+		if running, _ := t.HasSession(sessionID); running {
+			continue
+		}
+		lease, err := holdAskAgent(townRoot, sessionID)
+		if err != nil {
+			continue // Still starting
+		}
+		removeAskWorktree(repo, filepath.Join(dir, e.Name()))
+		lease.Release()
+	}
+}
+
+// removeAskWorktree discards an ask agent's scratch worktree.
+func removeAskWorktree(repo *git.Git, path string) {
+	if repo == nil || path == "" {
+		return
+	}
+	if err := repo.WorktreeRemove(path, true); err != nil {
+		_ = os.RemoveAll(path)
+		_ = repo.WorktreePrune()
+	}
+}
+
+// askQuestion sends the question, waits for the agent to finish responding,
+// and returns the answer cut from its pane.
+func askQuestion(t *tmux.Tmux, sessionID, question string, timeout time.Duration) (string, error) {
+	if err := t.NudgeSession(sessionID, question); err != nil {
+		return "", fmt.Errorf("sending question: %w", err)
+	}
+	if err := t.WaitForResponse(sessionID, newBusyFirst(t.ResponseDetectorFor(sessionID)), timeout); err != nil {
+		if errors.Is(err, tmux.ErrIdleTimeout) {
+			return "", fmt.Errorf("no answer within %s (see the session with 'gt peek', or raise --timeout)", timeout)
+		}
+		return "", err
+	}
+	pane, err := t.CapturePaneAll(sessionID)
+	if err != nil {
+		return "", fmt.Errorf("capturing answer: %w", err)
+	}
+	answer := extractAskAnswer(pane, question)
+	if answer == "" {
+		return "", errors.New("agent finished without an answer")
+	}
+	return answer, nil
+}
+
+// busyFirst wraps a response detector so the agent only counts as finished
+// once it has been seen working, or after askBusyGrace if it answered too
+// quickly to catch.
+type busyFirst struct {
+	inner tmux.ResponseDetector
+	start time.Time
+	busy  bool
+}
+
+func newBusyFirst(inner tmux.ResponseDetector) *busyFirst {
+	return &busyFirst{inner: inner, start: time.Now()}
+}
+
+// Finished implements tmux.ResponseDetector.
+func (b *busyFirst) Finished(lines []string, unchanged time.Duration) bool {
+	if !b.inner.Finished(lines, unchanged) {
+		b.busy = true
+		return false
+	}
+	return b.busy || time.Since(b.start) >= askBusyGrace
+}
+
+// extractAskAnswer cuts the answer out of a pane capture: the lines after
+// the last echo of the question, up to the agent's input prompt, without
+// the TUI's box-drawing separators.
+func extractAskAnswer(pane, question string) string {
+	lines := strings.Split(pane, "\n")
+	echo := strings.TrimSpace(strings.SplitN(question, "\n", 2)[0])
+	if len(echo) > 60 {
+		echo = echo[:60]
+	}
+	start := 0
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.Contains(lines[i], echo) {
+			start = i + 1
+			break
+		}
+	}
+	end := len(lines)
+	prompt := strings.TrimSpace(tmux.DefaultReadyPromptPrefix)
+	for i := len(lines) - 1; i >= start; i-- {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, prompt) || trimmed == ">" || strings.HasPrefix(trimmed, "> ") {
+			end = i
+			break
+		}
+	}
+
+	var out []string
+	for _, line := range lines[start:end] {
+		if isBoxRule(line) {
+			continue
+		}
+		out = append(out, strings.TrimRight(line, " "))
+	}
+	answer := strings.TrimSpace(strings.Join(out, "\n"))
+	return strings.TrimSpace(strings.TrimPrefix(answer, "⏺"))
+}
+
+// isBoxRule reports whether a line is a TUI separator (box-drawing only).
+func isBoxRule(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		return false
+	}
+	return strings.Trim(trimmed, "─━═│╭╮╰╯┌┐└┘") == ""
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func TestExtractAskAnswer(t *testing.T) {
+	pane := `⏺ Primed for gastown.
+
+> Where is the merge queue implemented?
+
+⏺ The merge queue lives in internal/refinery/engineer.go.
+  MRs are claimed in ProcessQueue.
+
+────────────────────────────────
+❯ 
+────────────────────────────────
+  ⏵⏵ bypass permissions on (shift+tab to cycle)`
+
+	got := extractAskAnswer(pane, "Where is the merge queue implemented?")
+	want := "The merge queue lives in internal/refinery/engineer.go.\n  MRs are claimed in ProcessQueue."
+	if got != want {
+		t.Errorf("extractAskAnswer =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestExtractAskAnswer_NoAnswer(t *testing.T) {
+	pane := "> What?\n\n❯ \n"
+	if got := extractAskAnswer(pane, "What?"); got != "" {
+		t.Errorf("extractAskAnswer = %q, want empty", got)
+	}
+}
+
+func TestBusyFirst(t *testing.T) {
+	idle := []string{"❯ "}
+	busy := []string{"❯ ", "esc to interrupt"}
+	d := newBusyFirst(tmux.PromptDetector{Prefix: tmux.DefaultReadyPromptPrefix})
+
+	if d.Finished(idle, 0) {
+		t.Error("idle before the agent was seen working should not count as finished")
+	}
+	if d.Finished(busy, 0) {
+		t.Error("busy pane reported finished")
+	}
+	if !d.Finished(idle, 0) {
+		t.Error("idle after working should count as finished")
+	}
+
+	late := newBusyFirst(tmux.PromptDetector{Prefix: tmux.DefaultReadyPromptPrefix})
+	late.start = time.Now().Add(-askBusyGrace)
+	if !late.Finished(idle, 0) {
+		t.Error("idle after the grace period should count as finished")
+	}
+}
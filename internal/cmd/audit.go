@@ -16,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/townlog"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -130,9 +131,9 @@ func runAudit(cmd *cobra.Command, args []string) error {
 
 	if len(allEntries) == 0 {
 		if auditActor != "" {
-			fmt.Printf("%s No activity found for actor %q\n", style.Dim.Render("○"), auditActor)
+			fmt.Printf("%s No activity found for actor %q\n", style.Dim.Render(ui.Glyph("○")), auditActor)
 		} else {
-			fmt.Printf("%s No activity found\n", style.Dim.Render("○"))
+			fmt.Printf("%s No activity found\n", style.Dim.Render(ui.Glyph("○")))
 		}
 		return nil
 	}
@@ -475,6 +476,17 @@ func formatFeedSummary(e events.Event) string {
 			return fmt.Sprintf("Sent mail to %s", to)
 		}
 		return "Sent mail"
+	case events.TypePatrolDisabled:
+		patrol, _ := e.Payload["patrol"].(string)
+		reason, _ := e.Payload["reason"].(string)
+		until, _ := e.Payload["until"].(string)
+		return fmt.Sprintf("Disabled patrol %s until %s: %s", patrol, until, reason)
+	case events.TypePatrolEnabled:
+		patrol, _ := e.Payload["patrol"].(string)
+		if reason, ok := e.Payload["reason"].(string); ok {
+			return fmt.Sprintf("Re-enabled patrol %s (%s)", patrol, reason)
+		}
+		return fmt.Sprintf("Re-enabled patrol %s", patrol)
 	default:
 		return e.Type
 	}
@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/backup"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	backupDir    string
+	backupOnly   []string
+	backupJSON   bool
+	backupForce  bool
+	backupRetain int
+)
+
+var backupCmd = &cobra.Command{
+	Use:     "backup",
+	GroupID: GroupServices,
+	Short:   "Create, list, and restore town snapshots",
+	Long: `Create, list, and restore point-in-time snapshots of the town.
+
+A snapshot is a tarball holding:
+  config   Town and rig configuration (mayor/, settings/, rig config.json)
+  history  Event and feed logs, approvals, experiment ledgers
+  mail     Legacy JSONL mailboxes
+  beads    Every Dolt beads database (includes bead-backed mail)
+
+Append-only ledgers are copied under their locks, and databases are copied
+with 'dolt backup', so snapshots are consistent while the town is running.
+
+Snapshots go to .runtime/backups/ unless patrols.town_backup.dir is set in
+mayor/daemon.json. Enable that patrol to take snapshots on a schedule:
+
+  "town_backup": {"enabled": true, "interval": "24h", "retain": 14}
+
+'gt doctor' warns when the last good snapshot is too old.`,
+	RunE: requireSubcommand,
+}
+
+var backupCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Take a snapshot now",
+	Long: `Take a snapshot of the town.
+
+Examples:
+  gt backup create
+  gt backup create --only config,history`,
+	Args: cobra.NoArgs,
+	RunE: runBackupCreate,
+}
+
+var backupListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List snapshots, newest first",
+	Args:    cobra.NoArgs,
+	RunE:    runBackupList,
+}
+
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore <id>",
+	Short: "Restore a snapshot",
+	Long: `Restore a snapshot into the town.
+
+By default only missing files and databases are restored; anything that
+already exists is left alone and listed. With --force, existing files are
+overwritten and existing databases are moved aside (<db>.pre-restore-<time>),
+after a pre-restore snapshot of the affected components is taken.
+
+Restoring databases requires the Dolt server to be stopped (gt dolt stop).
+
+Examples:
+  gt backup restore 20260301-030000 --only config
+  gt backup restore 20260301-030000 --force`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBackupRestore,
+}
+
+var backupPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete old snapshots beyond the retention count",
+	Long: `Delete the oldest snapshots, keeping the newest --retain (default from
+patrols.town_backup.retain, else 14). The newest good snapshot is always kept.`,
+	Args: cobra.NoArgs,
+	RunE: runBackupPrune,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{backupCreateCmd, backupListCmd, backupRestoreCmd, backupPruneCmd} {
+		c.Flags().StringVar(&backupDir, "dir", "", "Snapshot directory (default: configured or .runtime/backups)")
+	}
+	backupCreateCmd.Flags().StringSliceVar(&backupOnly, "only", nil, "Components to include: config,history,mail,beads (default: all)")
+	backupCreateCmd.Flags().BoolVar(&backupJSON, "json", false, "Output the manifest as JSON")
+	backupListCmd.Flags().BoolVar(&backupJSON, "json", false, "Output as JSON")
+	backupRestoreCmd.Flags().StringSliceVar(&backupOnly, "only", nil, "Components to restore (default: all in the snapshot)")
+	backupRestoreCmd.Flags().BoolVarP(&backupForce, "force", "f", false, "Overwrite existing files and databases")
+	backupRestoreCmd.Flags().BoolVar(&backupJSON, "json", false, "Output the result as JSON")
+	backupPruneCmd.Flags().IntVar(&backupRetain, "retain", 0, "Snapshots to keep")
+
+	backupCmd.AddCommand(backupCreateCmd)
+	backupCmd.AddCommand(backupListCmd)
+	backupCmd.AddCommand(backupRestoreCmd)
+	backupCmd.AddCommand(backupPruneCmd)
+	rootCmd.AddCommand(backupCmd)
+}
+
+// resolveBackupDir returns the town root and snapshot directory.
+func resolveBackupDir() (string, string, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return "", "", fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if backupDir != "" {
+		return townRoot, backupDir, nil
+	}
+	return townRoot, daemon.TownBackupDir(daemon.LoadPatrolConfig(townRoot), townRoot), nil
+}
+
+func validateBackupComponents(components []string) error {
+	for _, c := range components {
+		valid := false
+		for _, known := range backup.AllComponents {
+			if c == known {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("unknown component %q (valid: %s)", c, strings.Join(backup.AllComponents, ", "))
+		}
+	}
+	return nil
+}
+
+func runBackupCreate(cmd *cobra.Command, args []string) error {
+	if err := validateBackupComponents(backupOnly); err != nil {
+		return err
+	}
+	townRoot, dir, err := resolveBackupDir()
+	if err != nil {
+		return err
+	}
+
+	m, err := backup.Create(townRoot, backup.Options{Dir: dir, Components: backupOnly})
+	if err != nil {
+		return err
+	}
+	if backupJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(m)
+	}
+
+	fmt.Printf("%s Snapshot %s: %d files, %d database(s), %s\n", style.SuccessPrefix,
+		style.Bold.Render(m.ID), m.Files, len(m.Databases), formatBytes(m.Bytes))
+	fmt.Printf("  %s\n", style.Dim.Render(backup.ArchivePath(dir, m.ID)))
+	if !m.OK() {
+		style.PrintWarning("snapshot completed with %d error(s):", len(m.Errors))
+		for _, e := range m.Errors {
+			fmt.Printf("  - %s\n", e)
+		}
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+func runBackupList(cmd *cobra.Command, args []string) error {
+	_, dir, err := resolveBackupDir()
+	if err != nil {
+		return err
+	}
+	all, err := backup.List(dir)
+	if err != nil {
+		return err
+	}
+
+	if backupJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if all == nil {
+			all = []*backup.Manifest{}
+		}
+		return enc.Encode(all)
+	}
+	if len(all) == 0 {
+		fmt.Printf("No snapshots in %s.\n", dir)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tAGE\tTRIGGER\tCOMPONENTS\tFILES\tDBS\tSIZE\tSTATUS")
+	for _, m := range all {
+		status := style.Success.Render("ok")
+		if !m.OK() {
+			status = style.Warning.Render(fmt.Sprintf("%d error(s)", len(m.Errors)))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n", m.ID, formatDuration(time.Since(m.CreatedAt)),
+			m.Trigger, strings.Join(m.Components, ","), m.Files, len(m.Databases), formatBytes(m.Bytes), status)
+	}
+	return w.Flush()
+}
+
+func runBackupRestore(cmd *cobra.Command, args []string) error {
+	if err := validateBackupComponents(backupOnly); err != nil {
+		return err
+	}
+	townRoot, dir, err := resolveBackupDir()
+	if err != nil {
+		return err
+	}
+
+	res, err := backup.Restore(townRoot, args[0], backup.RestoreOptions{Dir: dir, Components: backupOnly, Force: backupForce})
+	if backupJSON && res != nil {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if encErr := enc.Encode(res); encErr != nil {
+			return encErr
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if res.SafetyID != "" {
+		fmt.Printf("%s Pre-restore snapshot %s\n", style.Dim.Render(ui.Glyph("•")), res.SafetyID)
+	}
+	fmt.Printf("%s Restored %d file(s) and %d database(s) from %s\n", style.SuccessPrefix,
+		res.Restored, len(res.Databases), style.Bold.Render(args[0]))
+	if len(res.Skipped) > 0 {
+		fmt.Printf("%s %d existing item(s) left alone (use --force to overwrite):\n", style.WarningPrefix, len(res.Skipped))
+		for _, s := range res.Skipped {
+			fmt.Printf("  %s\n", style.Dim.Render(s))
+		}
+	}
+	return nil
+}
+
+func runBackupPrune(cmd *cobra.Command, args []string) error {
+	townRoot, dir, err := resolveBackupDir()
+	if err != nil {
+		return err
+	}
+	retain := backupRetain
+	if retain <= 0 {
+		if cfg := daemon.LoadPatrolConfig(townRoot); cfg != nil && cfg.Patrols != nil && cfg.Patrols.TownBackup != nil {
+			retain = cfg.Patrols.TownBackup.Retain
+		}
+	}
+
+	removed, err := backup.Prune(dir, retain)
+	if err != nil {
+		return err
+	}
+	if len(removed) == 0 {
+		fmt.Println("Nothing to prune.")
+		return nil
+	}
+	for _, id := range removed {
+		fmt.Printf("%s Removed %s\n", style.SuccessPrefix, id)
+	}
+	return nil
+}
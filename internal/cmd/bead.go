@@ -10,11 +10,12 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 var beadCmd = &cobra.Command{
 	Use:     "bead",
-	Aliases: []string{"bd"},
+	Aliases: []string{"bd", "beads"},
 	GroupID: GroupWork,
 	Short:   "Bead management utilities",
 	Long: `Utilities for managing beads across repositories.
@@ -24,6 +25,9 @@ moving beads between repos and viewing beads by ID with automatic
 prefix-based routing.
 
 Subcommands:
+  dep     Add and list dependencies across rigs
+  export  Export beads and wisp history as CSV or Parquet
+  import  Import beads from a CSV file or markdown checklist
   move    Move a bead from one repository to another
   show    Show details of a bead (routes by prefix)
   read    Alias for show`,
@@ -137,7 +141,7 @@ func runBeadMove(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("cannot move closed bead %s", sourceID)
 	}
 
-	fmt.Printf("%s Moving %s to %s...\n", style.Bold.Render("→"), sourceID, targetPrefix)
+	fmt.Printf("%s Moving %s to %s...\n", style.Bold.Render(ui.Glyph("→")), sourceID, targetPrefix)
 	fmt.Printf("  Title: %s\n", source.Title)
 	fmt.Printf("  Type: %s\n", source.Type)
 
@@ -185,7 +189,7 @@ func runBeadMove(cmd *cobra.Command, args []string) error {
 	}
 	newID := strings.TrimSpace(string(newIDBytes))
 
-	fmt.Printf("%s Created %s\n", style.Bold.Render("✓"), newID)
+	fmt.Printf("%s Created %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), newID)
 
 	// Close the source bead with reference
 	closeReason := fmt.Sprintf("Moved to %s", newID)
@@ -204,7 +208,7 @@ func runBeadMove(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("%s Closed %s (moved to %s)\n", style.Bold.Render("✓"), sourceID, newID)
+	fmt.Printf("%s Closed %s (moved to %s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), sourceID, newID)
 	fmt.Printf("\nBead moved: %s → %s\n", sourceID, newID)
 
 	return nil
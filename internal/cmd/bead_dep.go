@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	beadDepType     string
+	beadDepListJSON bool
+)
+
+var beadDepCmd = &cobra.Command{
+	Use:   "dep",
+	Short: "Manage dependencies across rigs",
+	Long: `Add and inspect bead dependencies that cross rig boundaries.
+
+Beads are named by fully-qualified references, <rig>:<bead-id>, or by bare
+IDs routed by prefix. A qualified reference is checked against routes.jsonl,
+so a typo in the rig or a bead that has moved is caught up front.
+
+bd only sees statuses inside one rig's database; these commands read each
+dependency from the rig that owns it.`,
+	RunE: requireSubcommand,
+}
+
+var beadDepAddCmd = &cobra.Command{
+	Use:   "add <issue> <depends-on>",
+	Short: "Make a bead depend on a bead in any rig",
+	Long: `Record that <issue> depends on <depends-on>. The dependency is stored in
+<issue>'s rig; either reference may name another rig.
+
+Use --type=related to reference a bead without blocking on it.
+
+Examples:
+  gt bead dep add gastown:gt-abc12 beads:bd-xyz9
+  gt bead dep add gt-abc12 bd-xyz9
+  gt bead dep add gt-abc12 beads:bd-xyz9 --type=related`,
+	Args: cobra.ExactArgs(2),
+	RunE: runBeadDepAdd,
+}
+
+var beadDepListCmd = &cobra.Command{
+	Use:   "list <issue>",
+	Short: "List a bead's dependencies with live cross-rig status",
+	Long: `List a bead's dependencies, resolving each one in the rig that owns it.
+
+Examples:
+  gt bead dep list gt-abc12
+  gt bead dep list gastown:gt-abc12 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBeadDepList,
+}
+
+func init() {
+	beadDepAddCmd.Flags().StringVar(&beadDepType, "type", "", "Dependency type (default: blocks)")
+	beadDepListCmd.Flags().BoolVar(&beadDepListJSON, "json", false, "Output as JSON")
+	beadDepCmd.AddCommand(beadDepAddCmd)
+	beadDepCmd.AddCommand(beadDepListCmd)
+	beadCmd.AddCommand(beadDepCmd)
+}
+
+// BeadDepEntry is one dependency in gt bead dep list output.
+type BeadDepEntry struct {
+	Ref    string `json:"ref"`
+	Type   string `json:"type"`
+	Title  string `json:"title,omitempty"`
+	Status string `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func newTownXRefResolver() (*beads.XRefResolver, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return nil, fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	return beads.NewXRefResolver(townRoot)
+}
+
+func runBeadDepAdd(cmd *cobra.Command, args []string) error {
+	xr, err := newTownXRefResolver()
+	if err != nil {
+		return err
+	}
+	from, to, err := xr.AddCrossRigDependency(args[0], args[1], beadDepType)
+	if err != nil {
+		return err
+	}
+	depType := beadDepType
+	if depType == "" {
+		depType = "blocks"
+	}
+	fmt.Printf("%s %s depends on %s (%s)\n", style.SuccessPrefix, from, to, depType)
+	return nil
+}
+
+func runBeadDepList(cmd *cobra.Command, args []string) error {
+	xr, err := newTownXRefResolver()
+	if err != nil {
+		return err
+	}
+	issue, self, err := xr.Show(args[0])
+	if err != nil {
+		return err
+	}
+
+	entries := make([]BeadDepEntry, 0, len(issue.Dependencies))
+	for _, dep := range issue.Dependencies {
+		e := BeadDepEntry{Ref: dep.ID, Type: dep.DependencyType, Title: dep.Title, Status: dep.Status}
+		if e.Type == "" {
+			e.Type = "blocks"
+		}
+		// Re-read every dependency from its own rig: bd's joined status is
+		// missing or stale for beads outside this database.
+		if depIssue, x, err := xr.Show(dep.ID); err != nil {
+			e.Error = err.Error()
+		} else {
+			e.Ref, e.Title, e.Status = x.String(), depIssue.Title, depIssue.Status
+		}
+		entries = append(entries, e)
+	}
+
+	if beadDepListJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+
+	fmt.Printf("%s %s\n", style.Bold.Render(self.String()), issue.Title)
+	if len(entries) == 0 {
+		fmt.Println(style.Dim.Render("  (no dependencies)"))
+		return nil
+	}
+	for _, e := range entries {
+		switch {
+		case e.Error != "":
+			fmt.Printf("  %s %s [%s] %s\n", style.Warning.Render("?"), e.Ref, e.Type, style.Dim.Render(e.Error))
+		case beads.IssueStatus(e.Status).IsTerminal():
+			fmt.Printf("  %s %s [%s] %s\n", style.SuccessPrefix, e.Ref, e.Type, e.Title)
+		default:
+			fmt.Printf("  %s %s [%s] %s %s\n", style.Dim.Render(ui.Glyph("○")), e.Ref, e.Type, e.Title, style.Dim.Render("("+e.Status+")"))
+		}
+	}
+	return nil
+}
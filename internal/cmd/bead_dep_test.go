@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestResolveSlingBeadRefs(t *testing.T) {
+	townRoot := t.TempDir()
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := beads.WriteRoutes(beadsDir, []beads.Route{{Prefix: "bd-", Path: "beads/mayor/rig"}}); err != nil {
+		t.Fatal(err)
+	}
+	prevOn := slingOnTarget
+	t.Cleanup(func() { slingOnTarget = prevOn })
+
+	slingOnTarget = "beads:bd-on"
+	args := []string{"beads:bd-abc", "gt-plain", "gastown"}
+	if err := resolveSlingBeadRefs(townRoot, args); err != nil {
+		t.Fatal(err)
+	}
+	if args[0] != "bd-abc" || args[1] != "gt-plain" || args[2] != "gastown" {
+		t.Errorf("args = %v", args)
+	}
+	if slingOnTarget != "bd-on" {
+		t.Errorf("slingOnTarget = %q", slingOnTarget)
+	}
+
+	slingOnTarget = ""
+	if err := resolveSlingBeadRefs(townRoot, []string{"gastown:bd-abc"}); err == nil {
+		t.Error("expected rig mismatch error")
+	}
+}
+
+func TestResolveExternalDepWithoutTown(t *testing.T) {
+	if ref, open := resolveExternalDep(nil, beads.IssueDep{ID: "bd-x", Status: "closed"}); ref != "bd-x" || open {
+		t.Errorf("closed dep = %q, %v", ref, open)
+	}
+	if _, open := resolveExternalDep(nil, beads.IssueDep{ID: "external:bd:bd-x"}); !open {
+		t.Error("unknown status should count as open")
+	}
+}
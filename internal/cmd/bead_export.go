@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/export"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	beadExportFormat      string
+	beadExportSince       string
+	beadExportOut         string
+	beadExportRigs        []string
+	beadExportTables      []string
+	beadExportIncremental bool
+	beadExportJSON        bool
+)
+
+var beadExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export beads and wisp history as CSV or Parquet",
+	Long: `Export beads, their state transitions, and wisp timing as flat tables for
+offline analysis in pandas, DuckDB, or a spreadsheet.
+
+Tables:
+  issues       One row per bead: status, priority, type, labels, timestamps
+  transitions  One row per lifecycle event: queued, claimed, completed, ...
+  wisps        One row per wisp: stage times and queue/startup/cycle/lead seconds
+
+Column schemas are stable: columns are only appended, and a change to an
+existing column bumps the table's schema version (recorded in Parquet
+footer metadata as gastown.export.schema).
+
+--since keeps rows at or after a date (2024-01-01), a time (RFC 3339), or
+a duration ago (7d, 12h). For issues that is the last update; for wisps the
+latest stage reached.
+
+--incremental exports only rows newer than the previous incremental export
+to the same directory, as timestamped part files (issues-20260310T090000.csv)
+next to earlier ones, so "issues*.parquet" reads the lot. Progress is kept
+in .gt-export-state.json in the output directory.
+
+Issues come from town beads and every rig unless --rig narrows it.
+
+Examples:
+  gt bead export --format parquet --since 2024-01-01
+  gt beads export --out ~/analysis/gt --incremental
+  gt bead export --table wisps --since 30d
+  gt bead export --rig gastown --format csv --out /tmp/gastown`,
+	Args: cobra.NoArgs,
+	RunE: runBeadExport,
+}
+
+func init() {
+	beadExportCmd.Flags().StringVar(&beadExportFormat, "format", "csv", "Output format: "+strings.Join(export.Formats, " or "))
+	beadExportCmd.Flags().StringVar(&beadExportSince, "since", "", "Only rows at or after a date, time, or duration ago (e.g., 2024-01-01, 7d)")
+	beadExportCmd.Flags().StringVarP(&beadExportOut, "out", "o", "gt-export", "Output directory")
+	beadExportCmd.Flags().StringSliceVar(&beadExportRigs, "rig", nil, "Only export issues from these rigs (\"town\" for town beads; repeatable)")
+	beadExportCmd.Flags().StringSliceVar(&beadExportTables, "table", nil, "Only export these tables: issues, transitions, wisps (repeatable)")
+	beadExportCmd.Flags().BoolVar(&beadExportIncremental, "incremental", false, "Only export rows newer than the last incremental export to --out")
+	beadExportCmd.Flags().BoolVar(&beadExportJSON, "json", false, "Output a summary as JSON")
+	beadCmd.AddCommand(beadExportCmd)
+}
+
+// BeadExportFile is one file written by gt bead export.
+type BeadExportFile struct {
+	Table  string `json:"table"`
+	Schema string `json:"schema"`
+	Path   string `json:"path"`
+	Rows   int    `json:"rows"`
+}
+
+func runBeadExport(cmd *cobra.Command, args []string) error {
+	if !slices.Contains(export.Formats, beadExportFormat) {
+		return fmt.Errorf("unknown format %q: want %s", beadExportFormat, strings.Join(export.Formats, " or "))
+	}
+	var schemas []export.Schema
+	for _, s := range export.Schemas {
+		if len(beadExportTables) == 0 || slices.Contains(beadExportTables, s.Name) {
+			schemas = append(schemas, s)
+		}
+	}
+	for _, name := range beadExportTables {
+		if !slices.ContainsFunc(export.Schemas, func(s export.Schema) bool { return s.Name == name }) {
+			return fmt.Errorf("unknown table %q: want issues, transitions, or wisps", name)
+		}
+	}
+	since, err := parseExportSince(beadExportSince, time.Now())
+	if err != nil {
+		return err
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if err := os.MkdirAll(beadExportOut, 0755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+	state, err := export.LoadState(beadExportOut)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	stamp := now.UTC().Format("20060102T150405")
+	var files []BeadExportFile
+	for _, schema := range schemas {
+		f := export.Filter{Since: since}
+		if beadExportIncremental {
+			f.After = state.After(schema)
+		}
+		table, mark, err := buildExportTable(townRoot, schema.Name, f)
+		if err != nil {
+			return fmt.Errorf("%s: %w", schema.Name, err)
+		}
+
+		name := schema.Name
+		if beadExportIncremental {
+			if len(table.Rows) == 0 {
+				files = append(files, BeadExportFile{Table: schema.Name, Schema: schema.SchemaID()})
+				state.Advance(schema, mark, now)
+				continue
+			}
+			name += "-" + stamp
+		}
+		path := filepath.Join(beadExportOut, name+"."+beadExportFormat)
+		if err := writeExportFile(path, table); err != nil {
+			return err
+		}
+		files = append(files, BeadExportFile{Table: schema.Name, Schema: schema.SchemaID(), Path: path, Rows: len(table.Rows)})
+		if beadExportIncremental {
+			state.Advance(schema, mark, now)
+		}
+	}
+	if beadExportIncremental {
+		if err := state.Save(beadExportOut); err != nil {
+			return fmt.Errorf("saving export state: %w", err)
+		}
+	}
+
+	if beadExportJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(files)
+	}
+	for _, f := range files {
+		if f.Path == "" {
+			fmt.Printf("  %s %-12s %s\n", style.Dim.Render(ui.Glyph("○")), f.Table, style.Dim.Render("nothing new since the last export"))
+			continue
+		}
+		fmt.Printf("  %s %-12s %6d rows  %s\n", style.SuccessPrefix, f.Table, f.Rows, f.Path)
+	}
+	return nil
+}
+
+// buildExportTable loads the data behind one table.
+func buildExportTable(townRoot, name string, f export.Filter) (*export.Table, time.Time, error) {
+	switch name {
+	case export.IssuesSchema.Name:
+		sets, err := loadExportIssues(townRoot, beadExportRigs)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		table, mark := export.IssuesTable(sets, f)
+		return table, mark, nil
+	case export.TransitionsSchema.Name:
+		evs, err := events.ReadAll(townRoot)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		table, mark := export.TransitionsTable(evs, f)
+		return table, mark, nil
+	default:
+		timelines, err := stats.Load(townRoot)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		table, mark := export.WispsTable(timelines, f)
+		return table, mark, nil
+	}
+}
+
+// loadExportIssues lists every issue in town beads and each rig's beads,
+// or only in the named rigs ("town" for town beads).
+func loadExportIssues(townRoot string, only []string) ([]export.IssueSet, error) {
+	want := func(name string) bool { return len(only) == 0 || slices.Contains(only, name) }
+
+	var sets []export.IssueSet
+	if want("town") {
+		issues, err := beads.New(townRoot).List(beads.ListOptions{Status: "all", Priority: -1})
+		if err != nil {
+			return nil, fmt.Errorf("listing town beads: %w", err)
+		}
+		sets = append(sets, export.IssueSet{Issues: issues})
+	}
+	rigs, _, err := getAllRigs()
+	if err != nil {
+		return nil, err
+	}
+	found := map[string]bool{}
+	for _, r := range rigs {
+		if !want(r.Name) {
+			continue
+		}
+		found[r.Name] = true
+		issues, err := beads.New(r.Path).List(beads.ListOptions{Status: "all", Priority: -1})
+		if err != nil {
+			style.PrintWarning("skipping rig %s: %v", r.Name, err)
+			continue
+		}
+		sets = append(sets, export.IssueSet{Rig: r.Name, Issues: issues})
+	}
+	for _, name := range only {
+		if name != "town" && !found[name] {
+			return nil, fmt.Errorf("rig %q not found", name)
+		}
+	}
+	return sets, nil
+}
+
+func writeExportFile(path string, table *export.Table) error {
+	f, err := os.Create(path) //nolint:gosec // G304: user-specified output directory
+	if err != nil {
+		return err
+	}
+	if err := export.Write(f, table, beadExportFormat); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return f.Close()
+}
+
+// parseExportSince parses --since as a date (2024-01-01, local time), an
+// RFC 3339 time, or a duration before now (7d, 12h). Empty means no bound.
+func parseExportSince(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if d, err := parseDuration(s); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since %q: use a date (2024-01-01), an RFC 3339 time, or a duration (7d)", s)
+}
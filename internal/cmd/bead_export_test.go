@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseExportSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"", time.Time{}},
+		{"2024-01-01", time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)},
+		{"2026-03-01T08:00:00Z", time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)},
+		{"7d", now.Add(-7 * 24 * time.Hour)},
+		{"12h", now.Add(-12 * time.Hour)},
+	}
+	for _, tt := range tests {
+		got, err := parseExportSince(tt.in, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseExportSince(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := parseExportSince("last tuesday", now); err == nil {
+		t.Error("expected an error for an unparseable --since")
+	}
+}
@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	beadImportFormat      string
+	beadImportRig         string
+	beadImportType        string
+	beadImportLabels      []string
+	beadImportIncludeDone bool
+	beadImportDryRun      bool
+	beadImportJSON        bool
+)
+
+var beadImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import beads from a CSV file or markdown checklist",
+	Long: `Create beads in bulk from a CSV file or a markdown checklist, so a legacy
+TODO list moves into the town in one command.
+
+CSV files need a header row with a title column. Optional columns: id,
+description, type, priority (0-4, P0-P4, or critical/high/normal/low/
+backlog), labels (comma or semicolon separated), and status (closed or
+done marks the row done). Other columns are ignored.
+
+Markdown checklists take each "- [ ] title" line as a bead. Trailing #tags
+become labels and a trailing P0-P4 sets the priority. Lines indented under
+an item become its description, and the nearest heading becomes a label.
+
+The whole file is validated before anything is created: explicit IDs must
+use the target's prefix, and that prefix must be registered. Items whose ID
+or title (ignoring case and spacing) matches an existing bead, or an
+earlier item in the file, are skipped. Done items are skipped unless
+--include-done imports them closed.
+
+Beads go in town beads by default, or in a rig's beads with --rig.
+
+Examples:
+  gt bead import todo.md --dry-run
+  gt bead import backlog.csv --rig gastown
+  gt beads import legacy.md --label migrated --include-done
+  gt bead import issues.csv --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBeadImport,
+}
+
+func init() {
+	beadImportCmd.Flags().StringVar(&beadImportFormat, "format", "", "File format: csv or md (default: from the file extension)")
+	beadImportCmd.Flags().StringVar(&beadImportRig, "rig", "", "Import into this rig's beads (default: town beads)")
+	beadImportCmd.Flags().StringVar(&beadImportType, "type", "task", "Type for items that don't set one")
+	beadImportCmd.Flags().StringSliceVar(&beadImportLabels, "label", nil, "Label to add to every imported bead (repeatable)")
+	beadImportCmd.Flags().BoolVar(&beadImportIncludeDone, "include-done", false, "Import done items and close them")
+	beadImportCmd.Flags().BoolVarP(&beadImportDryRun, "dry-run", "n", false, "Validate and show what would be imported")
+	beadImportCmd.Flags().BoolVar(&beadImportJSON, "json", false, "Output as JSON")
+	beadCmd.AddCommand(beadImportCmd)
+}
+
+// BeadImportResult is the outcome of one item in gt bead import.
+type BeadImportResult struct {
+	Line   int    `json:"line"`
+	Title  string `json:"title"`
+	ID     string `json:"id,omitempty"`     // Created bead, or the existing one for duplicates
+	Action string `json:"action"`           // created, closed, duplicate, done, would-create, failed
+	Reason string `json:"reason,omitempty"` // Why it was skipped or failed
+}
+
+func runBeadImport(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	items, err := parseBeadImportFile(args[0], beadImportFormat)
+	if err != nil {
+		return err
+	}
+
+	beadDir, prefix := townRoot, beads.TownBeadsPrefix
+	if beadImportRig != "" {
+		_, r, err := getRig(beadImportRig)
+		if err != nil {
+			return err
+		}
+		beadDir, prefix = r.Path, beads.GetPrefixForRig(townRoot, beadImportRig)
+	}
+	if err := validateBeadImport(items, prefix, config.LoadTownPrefixes(townRoot)); err != nil {
+		return err
+	}
+
+	bd := beads.New(beadDir)
+	existing, err := bd.List(beads.ListOptions{Status: "all", Priority: -1, Limit: 0})
+	if err != nil {
+		return fmt.Errorf("listing existing beads for dedup: %w", err)
+	}
+	results := planBeadImport(items, existing, beadImportIncludeDone)
+
+	actor := detectActor()
+	var created, failed int
+	for i, item := range items {
+		res := &results[i]
+		if res.Action != "would-create" || beadImportDryRun {
+			continue
+		}
+		opts := beads.CreateOptions{
+			Title:       item.Title,
+			Type:        item.Type,
+			Priority:    item.Priority,
+			Description: item.Description,
+			Actor:       actor,
+		}
+		if opts.Type == "" {
+			opts.Type = beadImportType
+		}
+		var issue *beads.Issue
+		if item.ID != "" {
+			issue, err = bd.CreateWithID(item.ID, opts)
+		} else {
+			issue, err = bd.Create(opts)
+		}
+		if err != nil {
+			res.Action, res.Reason = "failed", err.Error()
+			failed++
+			continue
+		}
+		res.ID, res.Action = issue.ID, "created"
+		created++
+		if labels := append(append([]string(nil), item.Labels...), beadImportLabels...); len(labels) > 0 {
+			if err := bd.Update(issue.ID, beads.UpdateOptions{AddLabels: labels}); err != nil {
+				res.Reason = "labels not added: " + err.Error()
+			}
+		}
+		if item.Done {
+			if err := bd.CloseWithReason("imported as done", issue.ID); err != nil {
+				res.Reason = "not closed: " + err.Error()
+			} else {
+				res.Action = "closed"
+			}
+		}
+	}
+
+	if beadImportJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+	} else {
+		printBeadImport(results, args[0], prefix)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d bead(s) failed to import", failed, failed+created)
+	}
+	return nil
+}
+
+// parseBeadImportFile parses path as format ("csv" or "md"), inferring the
+// format from the extension when it is empty.
+func parseBeadImportFile(path, format string) ([]beads.ImportItem, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			format = "csv"
+		case ".md", ".markdown", ".txt":
+			format = "md"
+		default:
+			return nil, fmt.Errorf("cannot tell the format of %s: use --format csv or --format md", path)
+		}
+	}
+	f, err := os.Open(path) //nolint:gosec // G304: user-specified import file
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var items []beads.ImportItem
+	switch format {
+	case "csv":
+		items, err = beads.ParseImportCSV(f)
+	case "md", "markdown":
+		items, err = beads.ParseImportChecklist(f)
+	default:
+		return nil, fmt.Errorf("unknown format %q: want csv or md", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no items found in %s", path)
+	}
+	return items, nil
+}
+
+// validateBeadImport rejects the whole file if any item can't be created:
+// explicit IDs must carry the target's prefix, which must be registered
+// and current in the town prefix registry (when the town has one), and
+// titles must not look like flags.
+func validateBeadImport(items []beads.ImportItem, prefix string, registry *config.TownConfig) error {
+	var problems []string
+	for _, item := range items {
+		if item.ID != "" {
+			if beads.ExtractPrefix(item.ID) != prefix+"-" {
+				problems = append(problems, fmt.Sprintf("line %d: ID %s does not use the target prefix %s-", item.Line, item.ID, prefix))
+			} else if registry != nil {
+				switch e, matched, retired := registry.RegisteredPrefixFor(item.ID); {
+				case e == nil:
+					problems = append(problems, fmt.Sprintf("line %d: prefix of %s is not registered", item.Line, item.ID))
+				case retired:
+					problems = append(problems, fmt.Sprintf("line %d: %s uses %s-, which was retired in favor of %s-", item.Line, item.ID, matched, e.Prefix))
+				case matched != prefix:
+					problems = append(problems, fmt.Sprintf("line %d: %s belongs to prefix %s-, not %s-", item.Line, item.ID, matched, prefix))
+				}
+			}
+		}
+		if beads.IsFlagLikeTitle(item.Title) {
+			problems = append(problems, fmt.Sprintf("line %d: title %q looks like a flag", item.Line, item.Title))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("nothing imported; fix these first:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// planBeadImport decides what happens to each item: duplicates of an
+// existing bead (by ID or normalized title) or of an earlier item are
+// skipped, as are done items unless includeDone.
+func planBeadImport(items []beads.ImportItem, existing []*beads.Issue, includeDone bool) []BeadImportResult {
+	byID := map[string]bool{}
+	byTitle := map[string]string{}
+	for _, issue := range existing {
+		byID[issue.ID] = true
+		byTitle[beads.NormalizeImportTitle(issue.Title)] = issue.ID
+	}
+	seen := map[string]int{}
+
+	results := make([]BeadImportResult, len(items))
+	for i, item := range items {
+		res := BeadImportResult{Line: item.Line, Title: item.Title, Action: "would-create"}
+		key := beads.NormalizeImportTitle(item.Title)
+		switch {
+		case item.ID != "" && byID[item.ID]:
+			res.ID, res.Action, res.Reason = item.ID, "duplicate", "ID already exists"
+		case byTitle[key] != "":
+			res.ID, res.Action, res.Reason = byTitle[key], "duplicate", "same title as an existing bead"
+		case seen[key] > 0:
+			res.Action, res.Reason = "duplicate", fmt.Sprintf("same title as line %d", seen[key])
+		case item.Done && !includeDone:
+			res.Action, res.Reason = "done", "checked off (use --include-done to import it closed)"
+		}
+		if seen[key] == 0 {
+			seen[key] = item.Line
+		}
+		results[i] = res
+	}
+	return results
+}
+
+func printBeadImport(results []BeadImportResult, path, prefix string) {
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.Action]++
+		switch r.Action {
+		case "created", "closed":
+			fmt.Printf("  %s %s %s\n", style.SuccessPrefix, style.Bold.Render(r.ID), r.Title)
+			if r.Reason != "" {
+				fmt.Printf("    %s\n", style.Warning.Render(r.Reason))
+			}
+		case "would-create":
+			fmt.Printf("  %s %s\n", style.Dim.Render("+"), r.Title)
+		case "failed":
+			fmt.Printf("  %s line %d %s: %s\n", style.ErrorPrefix, r.Line, r.Title, r.Reason)
+		default:
+			detail := r.Reason
+			if r.ID != "" {
+				detail += " (" + r.ID + ")"
+			}
+			fmt.Printf("  %s %s %s\n", style.Dim.Render(ui.Glyph("○")), r.Title, style.Dim.Render(detail))
+		}
+	}
+
+	skipped := counts["duplicate"] + counts["done"]
+	if beadImportDryRun {
+		fmt.Printf("\n%s Would import %d bead(s) from %s into %s-, skipping %d\n",
+			style.Dim.Render("Dry run:"), counts["would-create"], path, prefix, skipped)
+		return
+	}
+	fmt.Printf("\n%s Imported %d bead(s) from %s into %s-", style.SuccessPrefix,
+		counts["created"]+counts["closed"], path, prefix)
+	if skipped > 0 {
+		fmt.Printf(", skipped %d", skipped)
+	}
+	if counts["failed"] > 0 {
+		fmt.Printf(", %s", style.Error.Render(fmt.Sprintf("%d failed", counts["failed"])))
+	}
+	fmt.Println()
+}
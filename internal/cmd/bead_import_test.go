@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestPlanBeadImport(t *testing.T) {
+	existing := []*beads.Issue{
+		{ID: "gt-abc", Title: "Fix login race"},
+		{ID: "gt-def", Title: "Other work"},
+	}
+	items := []beads.ImportItem{
+		{Line: 1, Title: "fix  LOGIN race."},
+		{Line: 2, ID: "gt-def", Title: "Renamed"},
+		{Line: 3, Title: "New thing"},
+		{Line: 4, Title: "new thing"},
+		{Line: 5, Title: "Old thing", Done: true},
+	}
+
+	results := planBeadImport(items, existing, false)
+	want := []struct{ action, id string }{
+		{"duplicate", "gt-abc"},
+		{"duplicate", "gt-def"},
+		{"would-create", ""},
+		{"duplicate", ""},
+		{"done", ""},
+	}
+	for i, w := range want {
+		if results[i].Action != w.action || results[i].ID != w.id {
+			t.Errorf("line %d: got %s %s, want %s %s", i+1, results[i].Action, results[i].ID, w.action, w.id)
+		}
+	}
+	if !strings.Contains(results[3].Reason, "line 3") {
+		t.Errorf("in-file duplicate reason = %q, want it to name line 3", results[3].Reason)
+	}
+
+	if r := planBeadImport(items[4:], nil, true); r[0].Action != "would-create" {
+		t.Errorf("--include-done: action = %s, want would-create", r[0].Action)
+	}
+}
+
+func TestValidateBeadImport(t *testing.T) {
+	registry := &config.TownConfig{Prefixes: []config.PrefixEntry{
+		{Prefix: "gt", Retired: []string{"gs"}},
+		{Prefix: "gt-cv"},
+	}}
+	ok := []beads.ImportItem{{Line: 1, Title: "A"}, {Line: 2, ID: "gt-x1", Title: "B"}}
+	if err := validateBeadImport(ok, "gt", registry); err != nil {
+		t.Errorf("valid items rejected: %v", err)
+	}
+
+	bad := []beads.ImportItem{
+		{Line: 1, ID: "bd-x1", Title: "wrong prefix"},
+		{Line: 2, ID: "gt-cv-x2", Title: "another entry's prefix"},
+		{Line: 3, Title: "--help"},
+	}
+	err := validateBeadImport(bad, "gt", registry)
+	if err == nil {
+		t.Fatal("expected validation to fail")
+	}
+	for _, want := range []string{"line 1", "line 2: gt-cv-x2 belongs to prefix gt-cv-", "line 3"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q missing %q", err, want)
+		}
+	}
+}
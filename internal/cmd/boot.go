@@ -15,6 +15,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -176,7 +177,7 @@ func runBootStatus(cmd *cobra.Command, args []string) error {
 		if status.LastAction != "" {
 			fmt.Printf("  Action:  %s", status.LastAction)
 			if status.Target != "" {
-				fmt.Printf(" → %s", status.Target)
+				fmt.Printf(" %s %s", ui.Glyph("→"), status.Target)
 			}
 			fmt.Println()
 		}
@@ -267,7 +268,7 @@ func runBootTriage(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Triage complete: %s", action)
 	if target != "" {
-		fmt.Printf(" → %s", target)
+		fmt.Printf(" %s %s", ui.Glyph("→"), target)
 	}
 	fmt.Println()
 
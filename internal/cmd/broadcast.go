@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -116,7 +117,7 @@ func runBroadcast(cmd *cobra.Command, args []string) error {
 		if townRoot != "" {
 			if shouldSend, level, _ := shouldNudgeTarget(townRoot, agentName, false); !shouldSend {
 				skipped++
-				fmt.Printf("  %s %s %s (DND: %s)\n", style.Dim.Render("○"), AgentTypeIcons[agent.Type], agentName, level)
+				fmt.Printf("  %s %s %s (DND: %s)\n", style.Dim.Render(ui.Glyph("○")), AgentTypeIcons[agent.Type], agentName, level)
 				continue
 			}
 		}
@@ -11,6 +11,7 @@ import (
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/townlog"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -136,11 +137,11 @@ func runCallbacksProcess(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(messages) == 0 {
-		fmt.Printf("%s No pending callbacks\n", style.Dim.Render("○"))
+		fmt.Printf("%s No pending callbacks\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
-	fmt.Printf("%s Processing %d callback(s)\n", style.Bold.Render("●"), len(messages))
+	fmt.Printf("%s Processing %d callback(s)\n", style.Bold.Render(ui.Glyph("●")), len(messages))
 
 	var results []CallbackResult
 	for _, msg := range messages {
@@ -150,17 +151,17 @@ func runCallbacksProcess(cmd *cobra.Command, args []string) error {
 		// Print result
 		if result.Error != nil {
 			fmt.Printf("  %s %s: %v\n",
-				style.Error.Render("✗"),
+				style.Error.Render(ui.Glyph("✗")),
 				msg.Subject,
 				result.Error)
 		} else if result.Handled {
 			fmt.Printf("  %s [%s] %s\n",
-				style.Bold.Render("✓"),
+				style.Bold.Render(ui.Glyph(ui.IconPass)),
 				result.CallbackType,
 				result.Action)
 		} else {
 			fmt.Printf("  %s [%s] %s\n",
-				style.Dim.Render("○"),
+				style.Dim.Render(ui.Glyph("○")),
 				result.CallbackType,
 				result.Action)
 		}
@@ -186,10 +187,10 @@ func runCallbacksProcess(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 	if callbacksDryRun {
 		fmt.Printf("%s Dry run: would process %d/%d callbacks\n",
-			style.Dim.Render("○"), handled, len(results))
+			style.Dim.Render(ui.Glyph("○")), handled, len(results))
 	} else {
 		fmt.Printf("%s Processed %d/%d callbacks",
-			style.Bold.Render("✓"), handled, len(results))
+			style.Bold.Render(ui.Glyph(ui.IconPass)), handled, len(results))
 		if errors > 0 {
 			fmt.Printf(" (%d errors)", errors)
 		}
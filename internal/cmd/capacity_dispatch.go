@@ -8,13 +8,16 @@ import (
 	"os/exec"
 	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/gofrs/flock"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/decision"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // maxDispatchFailures is the maximum number of consecutive dispatch failures
@@ -70,7 +73,7 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 			staleBeads, _ := getReadySlingContexts(townRoot)
 			if len(staleBeads) > 0 {
 				fmt.Printf("%s %d context bead(s) still open from a previous deferred mode\n",
-					style.Warning.Render("⚠"), len(staleBeads))
+					style.WarningPrefix, len(staleBeads))
 				fmt.Printf("  Use: gt scheduler clear  (close all sling context beads)\n")
 				fmt.Printf("  Or:  gt config set scheduler.max_polecats N  (re-enable deferred dispatch)\n")
 			}
@@ -97,6 +100,14 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 	successfulRigs := make(map[string]bool)
 	// Track polecat names from dispatch results, keyed by context bead ID.
 	polecatNames := make(map[string]string)
+	// Record why each pending bead was or wasn't dispatched, for `gt why sling`.
+	var pending []capacity.PendingBead
+	var decisions []decision.Decision
+	decide := func(b capacity.PendingBead, outcome, reason, detail string) {
+		decisions = append(decisions, decision.Decision{
+			Kind: decision.KindSling, Subject: b.WorkBeadID, Outcome: outcome, Reason: reason, Detail: detail,
+		})
+	}
 	cycle := &capacity.DispatchCycle{
 		AvailableCapacity: func() (int, error) {
 			active := countActivePolecats()
@@ -107,7 +118,9 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 			return cap, nil
 		},
 		QueryPending: func() ([]capacity.PendingBead, error) {
-			return getReadySlingContexts(townRoot)
+			var err error
+			pending, err = getReadySlingContexts(townRoot)
+			return pending, err
 		},
 		Execute: func(b capacity.PendingBead) error {
 			result, err := dispatchSingleBead(b, townRoot, actor)
@@ -123,6 +136,7 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 			}
 			_ = events.LogFeed(events.TypeSchedulerDispatch, actor,
 				events.SchedulerDispatchPayload(b.WorkBeadID, b.TargetRig, polecatNames[b.ID]))
+			decide(b, decision.OutcomeDispatched, "", strings.TrimSpace(b.TargetRig+" "+polecatNames[b.ID]))
 			return nil
 		},
 		OnSuccess: func(b capacity.PendingBead) error {
@@ -135,12 +149,12 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 				// Polecat launched but context close failed — not a true dispatch failure.
 				// Log a distinct warning so operators can distinguish from "polecat never launched".
 				fmt.Fprintf(os.Stderr, "%s Dispatch of %s succeeded but context close failed: %v\n",
-					style.Warning.Render("⚠"), b.WorkBeadID, err)
+					style.WarningPrefix, b.WorkBeadID, err)
 				// Last-resort close attempt to prevent double-dispatch on next cycle.
 				// OnSuccess already retried 2x; this is a final attempt before circuit-breaking.
 				if closeErr := townBeads.CloseSlingContext(b.ID, "dispatch-close-failed"); closeErr != nil {
 					fmt.Fprintf(os.Stderr, "%s CRITICAL: last-resort close of %s failed — risk of double-dispatch for %s: %v\n",
-						style.Warning.Render("⚠"), b.ID, b.WorkBeadID, closeErr)
+						style.WarningPrefix, b.ID, b.WorkBeadID, closeErr)
 				} else {
 					// Last-resort close succeeded — context is now closed.
 					// Log feed event so dashboards can detect bead DB degradation.
@@ -154,6 +168,15 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 					events.SchedulerDispatchFailedPayload(b.WorkBeadID, b.TargetRig, err.Error()))
 			}
 			recordDispatchFailure(townBeads, b, err)
+			if onSuccessErr != nil {
+				return // Already recorded as dispatched
+			}
+			if b.Context != nil && b.Context.DispatchFailures >= maxDispatchFailures {
+				decide(b, decision.OutcomeFailed, decision.ReasonBreaker,
+					fmt.Sprintf("failed %d times, context circuit-broken: %v", b.Context.DispatchFailures, err))
+			} else {
+				decide(b, decision.OutcomeFailed, "", err.Error())
+			}
 		},
 		BatchSize:  batchSize,
 		SpawnDelay: spawnDelay,
@@ -172,6 +195,7 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 	if err != nil {
 		return 0, fmt.Errorf("dispatch cycle failed: %w", err)
 	}
+	recordDeferredDispatches(townRoot, pending, decisions, report.Reason, maxPolecats, batchSize)
 
 	// Wake rig agents for each unique rig that had successful dispatches.
 	for rig := range successfulRigs {
@@ -193,12 +217,34 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 
 	if report.Dispatched > 0 || report.Failed > 0 {
 		fmt.Printf("\n%s Dispatched %d, failed %d (reason: %s)\n",
-			style.Bold.Render("✓"), report.Dispatched, report.Failed, report.Reason)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), report.Dispatched, report.Failed, report.Reason)
 	}
 
 	return report.Dispatched, nil
 }
 
+// recordDeferredDispatches writes the cycle's dispatch decisions to the
+// decision log, adding a deferral for each ready bead the cycle left waiting.
+func recordDeferredDispatches(townRoot string, pending []capacity.PendingBead, decisions []decision.Decision, reason string, maxPolecats, batchSize int) {
+	handled := make(map[string]bool, len(decisions))
+	for _, d := range decisions {
+		handled[d.Subject] = true
+	}
+	detail := fmt.Sprintf("batch limit of %d reached", batchSize)
+	if reason == "capacity" {
+		detail = fmt.Sprintf("no free polecat slots (%d of %d in use)", countActivePolecats(), maxPolecats)
+	}
+	for _, b := range pending {
+		if !handled[b.WorkBeadID] {
+			decisions = append(decisions, decision.Decision{
+				Kind: decision.KindSling, Subject: b.WorkBeadID,
+				Outcome: decision.OutcomeDeferred, Reason: decision.ReasonBudget, Detail: detail,
+			})
+		}
+	}
+	_ = decision.Record(townRoot, decisions...)
+}
+
 // printDryRunPlan displays a dry-run dispatch plan.
 func printDryRunPlan(plan capacity.DispatchPlan, maxPolecats, batchSize int) {
 	if plan.Reason == "none" {
@@ -458,16 +504,16 @@ func recordDispatchFailure(townBeads *beads.Beads, b capacity.PendingBead, dispa
 
 	if err := townBeads.UpdateSlingContextFields(b.ID, b.Context); err != nil {
 		fmt.Printf("  %s Failed to record dispatch failure for %s: %v\n",
-			style.Warning.Render("⚠"), b.ID, err)
+			style.WarningPrefix, b.ID, err)
 	}
 
 	if b.Context.DispatchFailures >= maxDispatchFailures {
 		if err := townBeads.CloseSlingContext(b.ID, "circuit-broken"); err != nil {
 			fmt.Printf("  %s Failed to close circuit-broken context %s: %v\n",
-				style.Warning.Render("⚠"), b.ID, err)
+				style.WarningPrefix, b.ID, err)
 		}
 		fmt.Printf("  %s Context %s (work: %s) failed %d times, circuit-broken\n",
-			style.Warning.Render("⚠"), b.ID, b.WorkBeadID, b.Context.DispatchFailures)
+			style.WarningPrefix, b.ID, b.WorkBeadID, b.Context.DispatchFailures)
 	}
 }
 
@@ -497,7 +543,7 @@ func listReadyWorkBeadIDsWithError(townRoot string) (map[string]bool, error) {
 			failCount++
 			lastErr = err
 			fmt.Fprintf(os.Stderr, "%s Warning: bd ready failed for %s: %v\n",
-				style.Dim.Render("⚠"), dir, err)
+				style.Dim.Render(ui.Glyph(ui.IconWarn)), dir, err)
 			continue
 		}
 		var readyBeads []struct {
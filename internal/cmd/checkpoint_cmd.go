@@ -9,6 +9,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/checkpoint"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -100,7 +101,7 @@ func runCheckpointWrite(cmd *cobra.Command, args []string) error {
 	// Only polecats and crew workers use checkpoints
 	if roleInfo.Role != RolePolecat && roleInfo.Role != RoleCrew {
 		fmt.Printf("%s Checkpoints only apply to polecats and crew workers\n",
-			style.Dim.Render("○"))
+			style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -145,7 +146,7 @@ func runCheckpointWrite(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("writing checkpoint: %w", err)
 	}
 
-	fmt.Printf("%s Checkpoint written\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Checkpoint written\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Printf("  %s\n", cp.Summary())
 
 	return nil
@@ -163,7 +164,7 @@ func runCheckpointRead(cmd *cobra.Command, args []string) error {
 	}
 
 	if cp == nil {
-		fmt.Printf("%s No checkpoint exists\n", style.Dim.Render("○"))
+		fmt.Printf("%s No checkpoint exists\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -214,7 +215,7 @@ func runCheckpointClear(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("removing checkpoint: %w", err)
 	}
 
-	fmt.Printf("%s Checkpoint cleared\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Checkpoint cleared\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	return nil
 }
 
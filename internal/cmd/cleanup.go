@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/util"
 )
 
@@ -50,12 +51,12 @@ func runCleanup(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(zombies) == 0 {
-		fmt.Printf("%s No orphaned Claude processes found\n", style.Bold.Render("✓"))
+		fmt.Printf("%s No orphaned Claude processes found\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		return nil
 	}
 
 	// Show what we found
-	fmt.Printf("%s Found %d orphaned Claude process(es):\n\n", style.Warning.Render("⚠"), len(zombies))
+	fmt.Printf("%s Found %d orphaned Claude process(es):\n\n", style.WarningPrefix, len(zombies))
 	for _, z := range zombies {
 		ageStr := formatProcessAgeCleanup(z.Age)
 		fmt.Printf("  %s %s (age: %s, tty: %s)\n",
@@ -67,7 +68,7 @@ func runCleanup(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 
 	if cleanupDryRun {
-		fmt.Printf("%s Dry run - no processes killed\n", style.Dim.Render("ℹ"))
+		fmt.Printf("%s Dry run - no processes killed\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
 		return nil
 	}
 
@@ -93,18 +94,18 @@ func runCleanup(cmd *cobra.Command, args []string) error {
 	for _, r := range results {
 		switch r.Signal {
 		case "SIGTERM":
-			fmt.Printf("  %s PID %d sent SIGTERM\n", style.Success.Render("✓"), r.Process.PID)
+			fmt.Printf("  %s PID %d sent SIGTERM\n", style.SuccessPrefix, r.Process.PID)
 			killed++
 		case "SIGKILL":
-			fmt.Printf("  %s PID %d sent SIGKILL (didn't respond to SIGTERM)\n", style.Warning.Render("⚠"), r.Process.PID)
+			fmt.Printf("  %s PID %d sent SIGKILL (didn't respond to SIGTERM)\n", style.WarningPrefix, r.Process.PID)
 			killed++
 		case "UNKILLABLE":
-			fmt.Printf("  %s PID %d survived SIGKILL\n", style.Error.Render("✗"), r.Process.PID)
+			fmt.Printf("  %s PID %d survived SIGKILL\n", style.Error.Render(ui.Glyph("✗")), r.Process.PID)
 			escalated++
 		}
 	}
 
-	fmt.Printf("\n%s Cleaned up %d process(es)", style.Bold.Render("✓"), killed)
+	fmt.Printf("\n%s Cleaned up %d process(es)", style.Bold.Render(ui.Glyph(ui.IconPass)), killed)
 	if escalated > 0 {
 		fmt.Printf(", %d unkillable", escalated)
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/wisp"
 )
 
@@ -356,9 +357,9 @@ func printCompactSummary(result *compactResult) {
 
 	if compactDryRun {
 		fmt.Printf("\n%s Dry run complete: %d wisps scanned\n",
-			style.Dim.Render("ℹ"), total)
+			style.Dim.Render(ui.Glyph(ui.IconInfo)), total)
 	} else {
-		fmt.Printf("\n%s Compaction complete\n", style.Success.Render("✓"))
+		fmt.Printf("\n%s Compaction complete\n", style.SuccessPrefix)
 	}
 
 	fmt.Printf("  Promoted: %d\n", promoted)
@@ -366,7 +367,7 @@ func printCompactSummary(result *compactResult) {
 	fmt.Printf("  Skipped:  %d (within TTL)\n", result.Skipped)
 
 	if len(result.Errors) > 0 {
-		fmt.Printf("\n%s %d errors:\n", style.Warning.Render("⚠"), len(result.Errors))
+		fmt.Printf("\n%s %d errors:\n", style.WarningPrefix, len(result.Errors))
 		for _, e := range result.Errors {
 			fmt.Printf("  - %s\n", e)
 		}
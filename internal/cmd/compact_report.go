@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 var (
@@ -118,7 +119,7 @@ func runDailyDigest() error {
 		}
 	} else if existingID != "" {
 		fmt.Printf("%s Compaction digest already sent for %s (bead: %s)\n",
-			style.Dim.Render("○"), dateStr, existingID)
+			style.Dim.Render(ui.Glyph("○")), dateStr, existingID)
 		return nil
 	}
 
@@ -176,7 +177,7 @@ func runDailyDigest() error {
 		return fmt.Errorf("sending digest: %w", err)
 	}
 
-	fmt.Printf("%s Compaction digest sent for %s\n", style.Success.Render("✓"), dateStr)
+	fmt.Printf("%s Compaction digest sent for %s\n", style.SuccessPrefix, dateStr)
 	if beadID != "" {
 		fmt.Printf("  Audit bead: %s\n", beadID)
 	}
@@ -370,7 +371,7 @@ func runWeeklyRollup() error {
 		}
 	} else if existingID != "" {
 		fmt.Printf("%s Weekly rollup already sent for %s to %s (bead: %s)\n",
-			style.Dim.Render("○"), weekStart, weekEnd, existingID)
+			style.Dim.Render(ui.Glyph("○")), weekStart, weekEnd, existingID)
 		return nil
 	}
 
@@ -440,7 +441,7 @@ func runWeeklyRollup() error {
 	}
 
 	fmt.Printf("%s Weekly compaction rollup sent to mayor/ (%s to %s)\n",
-		style.Success.Render("✓"), weekStart, weekEnd)
+		style.SuccessPrefix, weekStart, weekEnd)
 	if beadID != "" {
 		fmt.Printf("  Audit bead: %s\n", beadID)
 	}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/conventions"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var conventionsCmd = &cobra.Command{
+	Use:     "conventions",
+	GroupID: GroupWorkspace,
+	Short:   "Compile and show rig conventions summaries",
+	Long: `Compile a short summary of how each rig's repository expects work to be
+done, for agents to read at prime time.
+
+gt conventions build scans the rig's checkout (mayor/rig) for:
+  - build manifests and entry points (go.mod, package.json, Cargo.toml,
+    pyproject.toml, Makefile targets)
+  - lint and format configs (golangci-lint, ESLint, Prettier, Ruff, ...)
+  - CI workflows and the commands they run
+  - contributing guides (CONTRIBUTING.md, AGENTS.md), bullet points only
+  - the test layout
+
+and writes <rig>/.runtime/conventions.md. gt prime includes it for every
+agent working in the rig.
+
+To keep summaries fresh, enable the conventions patrol in
+mayor/daemon.json; it rebuilds a rig's summary when any source changes:
+  "conventions": {"enabled": true, "interval": "1h"}
+
+Examples:
+  gt conventions build            # Build every rig
+  gt conventions build gastown    # Build one rig
+  gt conventions show gastown`,
+	RunE: requireSubcommand,
+}
+
+var conventionsBuildCmd = &cobra.Command{
+	Use:   "build [rig...]",
+	Short: "Compile conventions summaries (default: all rigs)",
+	RunE:  runConventionsBuild,
+}
+
+var conventionsShowCmd = &cobra.Command{
+	Use:   "show [rig]",
+	Short: "Print a rig's conventions summary (default: current rig)",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runConventionsShow,
+}
+
+func init() {
+	conventionsCmd.AddCommand(conventionsBuildCmd, conventionsShowCmd)
+	rootCmd.AddCommand(conventionsCmd)
+}
+
+func runConventionsBuild(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigs := args
+	if len(rigs) == 0 {
+		rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+		if err != nil {
+			return fmt.Errorf("loading rigs: %w", err)
+		}
+		for name := range rigsConfig.Rigs {
+			rigs = append(rigs, name)
+		}
+		sort.Strings(rigs)
+	}
+	if len(rigs) == 0 {
+		fmt.Println("No rigs registered.")
+		return nil
+	}
+
+	var failed int
+	for _, rigName := range rigs {
+		state, err := conventions.Build(rigName, filepath.Join(townRoot, rigName))
+		if err != nil {
+			fmt.Printf("%s %s: %v\n", style.WarningPrefix, rigName, err)
+			failed++
+			continue
+		}
+		fmt.Printf("%s %s: compiled from %d source file(s)\n", style.SuccessPrefix, rigName, len(state.Sources))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d rig(s) failed", failed)
+	}
+	return nil
+}
+
+func runConventionsShow(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	var rigName string
+	if len(args) > 0 {
+		rigName = args[0]
+	} else if rigName, err = inferRigFromCwd(townRoot); err != nil {
+		return fmt.Errorf("specify a rig: %w", err)
+	}
+
+	summary := conventions.Load(filepath.Join(townRoot, rigName))
+	if summary == "" {
+		fmt.Printf("No conventions summary for %s. Run 'gt conventions build %s'.\n", rigName, rigName)
+		return nil
+	}
+	fmt.Print(summary)
+	return nil
+}
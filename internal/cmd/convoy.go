@@ -23,6 +23,7 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/tui/convoy"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -522,7 +523,7 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Output
-	fmt.Printf("%s Created convoy 🚚 %s\n\n", style.Bold.Render("✓"), convoyID)
+	fmt.Printf("%s Created convoy 🚚 %s\n\n", style.Bold.Render(ui.Glyph(ui.IconPass)), convoyID)
 	fmt.Printf("  Name:     %s\n", name)
 	fmt.Printf("  Tracking: %d issues\n", trackedCount)
 	if len(trackedIssues) > 0 {
@@ -633,7 +634,7 @@ func runConvoyAdd(cmd *cobra.Command, args []string) error {
 	if reopened {
 		fmt.Println()
 	}
-	fmt.Printf("%s Added %d issue(s) to convoy 🚚 %s\n", style.Bold.Render("✓"), addedCount, convoyID)
+	fmt.Printf("%s Added %d issue(s) to convoy 🚚 %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), addedCount, convoyID)
 	if addedCount > 0 {
 		fmt.Printf("  Issues: %s\n", strings.Join(issuesToAdd[:addedCount], ", "))
 	}
@@ -663,9 +664,9 @@ func runConvoyCheck(cmd *cobra.Command, args []string) error {
 		fmt.Println("No convoys ready to close.")
 	} else {
 		if convoyCheckDryRun {
-			fmt.Printf("%s Would auto-close %d convoy(s):\n", style.Warning.Render("⚠"), len(closed))
+			fmt.Printf("%s Would auto-close %d convoy(s):\n", style.WarningPrefix, len(closed))
 		} else {
-			fmt.Printf("%s Auto-closed %d convoy(s):\n", style.Bold.Render("✓"), len(closed))
+			fmt.Printf("%s Auto-closed %d convoy(s):\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(closed))
 		}
 		for _, c := range closed {
 			fmt.Printf("  🚚 %s: %s\n", c.ID, c.Title)
@@ -715,7 +716,7 @@ func checkSingleConvoy(townBeads, convoyID string, dryRun bool) error {
 
 	// Check if convoy is already closed
 	if normalizeConvoyStatus(convoy.Status) == convoyStatusClosed {
-		fmt.Printf("%s Convoy %s is already closed\n", style.Dim.Render("○"), convoyID)
+		fmt.Printf("%s Convoy %s is already closed\n", style.Dim.Render(ui.Glyph("○")), convoyID)
 		return nil
 	}
 
@@ -736,13 +737,13 @@ func checkSingleConvoy(townBeads, convoyID string, dryRun bool) error {
 	}
 
 	if !allClosed {
-		fmt.Printf("%s Convoy %s has %d open issue(s) remaining\n", style.Dim.Render("○"), convoyID, openCount)
+		fmt.Printf("%s Convoy %s has %d open issue(s) remaining\n", style.Dim.Render(ui.Glyph("○")), convoyID, openCount)
 		return nil
 	}
 
 	// All tracked issues are complete (or convoy is empty) - close the convoy
 	if dryRun {
-		fmt.Printf("%s Would auto-close convoy 🚚 %s: %s\n", style.Warning.Render("⚠"), convoyID, convoy.Title)
+		fmt.Printf("%s Would auto-close convoy 🚚 %s: %s\n", style.WarningPrefix, convoyID, convoy.Title)
 		return nil
 	}
 
@@ -759,7 +760,7 @@ func checkSingleConvoy(townBeads, convoyID string, dryRun bool) error {
 		return fmt.Errorf("closing convoy: %w", err)
 	}
 
-	fmt.Printf("%s Auto-closed convoy 🚚 %s: %s\n", style.Bold.Render("✓"), convoyID, convoy.Title)
+	fmt.Printf("%s Auto-closed convoy 🚚 %s: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), convoyID, convoy.Title)
 
 	// Send completion notification
 	notifyConvoyCompletion(townBeads, convoyID, convoy.Title)
@@ -813,7 +814,7 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 
 	// Idempotent: if already closed, just report it
 	if normalizeConvoyStatus(convoy.Status) == convoyStatusClosed {
-		fmt.Printf("%s Convoy %s is already closed\n", style.Dim.Render("○"), convoyID)
+		fmt.Printf("%s Convoy %s is already closed\n", style.Dim.Render(ui.Glyph("○")), convoyID)
 		return nil
 	}
 	if err := validateConvoyStatusTransition(convoy.Status, convoyStatusClosed); err != nil {
@@ -839,11 +840,11 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 		}
 
 		if len(openIssues) > 0 {
-			fmt.Printf("%s Convoy %s has %d open issue(s):\n\n", style.Warning.Render("⚠"), convoyID, len(openIssues))
+			fmt.Printf("%s Convoy %s has %d open issue(s):\n\n", style.WarningPrefix, convoyID, len(openIssues))
 			for _, t := range openIssues {
-				status := "○"
+				status := ui.Glyph("○")
 				if t.Status == "in_progress" || t.Status == "hooked" {
-					status = "▶"
+					status = ui.Glyph("▶")
 				}
 				fmt.Printf("    %s %s: %s [%s]\n", status, t.ID, t.Title, t.Status)
 			}
@@ -871,7 +872,7 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("closing convoy: %w", err)
 	}
 
-	fmt.Printf("%s Closed convoy 🚚 %s: %s\n", style.Bold.Render("✓"), convoyID, convoy.Title)
+	fmt.Printf("%s Closed convoy 🚚 %s: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), convoyID, convoy.Title)
 	if convoyCloseReason != "" {
 		fmt.Printf("  Reason: %s\n", convoyCloseReason)
 	}
@@ -978,7 +979,7 @@ func runConvoyLand(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("convoy '%s' has invalid lifecycle state: %w", convoyID, err)
 	}
 	if normalizeConvoyStatus(convoy.Status) == convoyStatusClosed {
-		fmt.Printf("%s Convoy %s is already closed\n", style.Dim.Render("○"), convoyID)
+		fmt.Printf("%s Convoy %s is already closed\n", style.Dim.Render(ui.Glyph("○")), convoyID)
 		return nil
 	}
 
@@ -1000,11 +1001,11 @@ func runConvoyLand(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(openIssues) > 0 && !convoyLandForce {
-		fmt.Printf("%s Convoy %s has %d open issue(s):\n\n", style.Warning.Render("⚠"), convoyID, len(openIssues))
+		fmt.Printf("%s Convoy %s has %d open issue(s):\n\n", style.WarningPrefix, convoyID, len(openIssues))
 		for _, t := range openIssues {
-			status := "○"
+			status := ui.Glyph("○")
 			if t.Status == "in_progress" || t.Status == "hooked" {
-				status = "▶"
+				status = ui.Glyph("▶")
 			}
 			fmt.Printf("    %s %s: %s [%s]\n", status, t.ID, t.Title, t.Status)
 		}
@@ -1013,13 +1014,13 @@ func runConvoyLand(cmd *cobra.Command, args []string) error {
 	}
 
 	if convoyLandDryRun {
-		fmt.Printf("%s Dry run — would land convoy 🚚 %s: %s\n\n", style.Warning.Render("⚠"), convoyID, convoy.Title)
+		fmt.Printf("%s Dry run — would land convoy 🚚 %s: %s\n\n", style.WarningPrefix, convoyID, convoy.Title)
 		fmt.Printf("  Tracked: %d issue(s) (%d closed, %d open)\n", len(tracked), len(tracked)-len(openIssues), len(openIssues))
 		if !convoyLandKeep {
 			worktrees := findConvoyWorktrees(tracked)
 			fmt.Printf("  Worktrees to clean: %d\n", len(worktrees))
 			for _, wt := range worktrees {
-				fmt.Printf("    • %s (%s)\n", wt.polecatName, wt.rigName)
+				fmt.Printf("    %s %s (%s)\n", ui.Glyph("•"), wt.polecatName, wt.rigName)
 			}
 		} else {
 			fmt.Printf("  Worktrees: skipped (--keep-worktrees)\n")
@@ -1037,7 +1038,7 @@ func runConvoyLand(cmd *cobra.Command, args []string) error {
 				if err := removePolecatWorktree(wt); err != nil {
 					style.PrintWarning("couldn't remove worktree %s/%s: %v", wt.rigName, wt.polecatName, err)
 				} else {
-					fmt.Printf("    %s %s/%s\n", style.Dim.Render("✓"), wt.rigName, wt.polecatName)
+					fmt.Printf("    %s %s/%s\n", style.Dim.Render(ui.Glyph(ui.IconPass)), wt.rigName, wt.polecatName)
 				}
 			}
 		}
@@ -1053,7 +1054,7 @@ func runConvoyLand(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("closing convoy: %w", err)
 	}
 
-	fmt.Printf("\n%s Landed convoy 🚚 %s: %s\n", style.Bold.Render("✓"), convoyID, convoy.Title)
+	fmt.Printf("\n%s Landed convoy 🚚 %s: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), convoyID, convoy.Title)
 	fmt.Printf("  Reason: %s\n", reason)
 	if len(tracked) > 0 {
 		closedCount := len(tracked) - len(openIssues)
@@ -1191,7 +1192,7 @@ func runConvoyStranded(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	fmt.Printf("%s Found %d stranded convoy(s):\n\n", style.Warning.Render("⚠"), len(stranded))
+	fmt.Printf("%s Found %d stranded convoy(s):\n\n", style.WarningPrefix, len(stranded))
 	for _, s := range stranded {
 		fmt.Printf("  🚚 %s: %s\n", s.ID, s.Title)
 		if s.ReadyCount == 0 && s.TrackedCount == 0 {
@@ -1201,7 +1202,7 @@ func runConvoyStranded(cmd *cobra.Command, args []string) error {
 		} else {
 			fmt.Printf("     Ready issues: %d (of %d tracked)\n", s.ReadyCount, s.TrackedCount)
 			for _, issueID := range s.ReadyIssues {
-				fmt.Printf("       • %s\n", issueID)
+				fmt.Printf("       %s %s\n", ui.Glyph("•"), issueID)
 			}
 		}
 		fmt.Println()
@@ -1279,7 +1280,7 @@ func findStrandedConvoys(townBeads string) ([]strandedConvoyInfo, error) {
 		if err != nil {
 			// Write to stderr explicitly — stdout may be consumed as JSON
 			// by the daemon's JSON parser (fixes #2142).
-			fmt.Fprintf(os.Stderr, "⚠ Warning: skipping convoy %s: %v\n", convoy.ID, err)
+			fmt.Fprintf(os.Stderr, "%s Warning: skipping convoy %s: %v\n", ui.Glyph(ui.IconWarn), convoy.ID, err)
 			continue
 		}
 		// Empty convoys (0 tracked issues) are stranded — they need
@@ -1670,12 +1671,12 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\n  %s\n", style.Bold.Render("Tracked Issues:"))
 		for _, t := range tracked {
 			// Status symbol: ✓ closed, ▶ in_progress/hooked, ○ other
-			status := "○"
+			status := ui.Glyph("○")
 			switch t.Status {
 			case "closed":
-				status = "✓"
+				status = ui.Glyph(ui.IconPass)
 			case "in_progress", "hooked":
-				status = "▶"
+				status = ui.Glyph("▶")
 			}
 
 			// Show assignee in brackets (extract short name from path like gastown/polecats/goose -> goose)
@@ -1902,12 +1903,12 @@ func printConvoyTree(townBeads string, convoys []struct {
 			}
 
 			// Status symbol: ✓ closed, ▶ in_progress/hooked, ○ other
-			status := "○"
+			status := ui.Glyph("○")
 			switch t.Status {
 			case "closed":
-				status = "✓"
+				status = ui.Glyph(ui.IconPass)
 			case "in_progress", "hooked":
-				status = "▶"
+				status = ui.Glyph("▶")
 			}
 
 			fmt.Printf("%s %s %s: %s\n", connector, status, t.ID, t.Title)
@@ -1952,11 +1953,11 @@ func formatYesNo(b bool) string {
 func formatConvoyStatus(status string) string {
 	switch status {
 	case "open":
-		return style.Warning.Render("●")
+		return style.Warning.Render(ui.Glyph("●"))
 	case "closed":
-		return style.Success.Render("✓")
+		return style.SuccessPrefix
 	case "in_progress":
-		return style.Info.Render("→")
+		return style.Info.Render(ui.Glyph("→"))
 	default:
 		return status
 	}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -229,9 +230,9 @@ func renderLaunchOutput(convoyID string, waves []Wave, results []DispatchResult,
 	})
 
 	for _, r := range sorted {
-		marker := "✓"
+		marker := ui.Glyph(ui.IconPass)
 		if !r.Success {
-			marker = "✗"
+			marker = ui.Glyph("✗")
 		}
 
 		title := ""
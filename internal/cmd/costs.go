@@ -12,14 +12,17 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/prices"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -64,7 +67,8 @@ Examples:
 
 Subcommands:
   gt costs record       # Record session cost to local log file (Stop hook)
-  gt costs digest       # Aggregate log entries into daily digest bead (Deacon patrol)`,
+  gt costs digest       # Aggregate log entries into daily digest bead (Deacon patrol)
+  gt costs budget       # Monthly budgets, projected spend, and downgrades`,
 	RunE: runCosts,
 }
 
@@ -192,6 +196,7 @@ type TranscriptMessage struct {
 	Type      string                 `json:"type"`
 	SessionID string                 `json:"sessionId"`
 	CWD       string                 `json:"cwd"`
+	Timestamp string                 `json:"timestamp,omitempty"`
 	Message   *TranscriptMessageBody `json:"message,omitempty"`
 }
 
@@ -217,24 +222,31 @@ type TokenUsage struct {
 	CacheCreationInputTokens int
 	CacheReadInputTokens     int
 	OutputTokens             int
+	// At is when the usage happened (the last message), which picks the
+	// prices in effect. Zero means now.
+	At time.Time
 }
 
-// Model pricing per million tokens (as of Jan 2025).
-// See: https://www.anthropic.com/pricing
-var modelPricing = map[string]struct {
-	InputPerMillion       float64
-	OutputPerMillion      float64
-	CacheReadPerMillion   float64 // 90% discount on input price
-	CacheCreatePerMillion float64 // 25% premium on input price
-}{
-	// Claude Opus 4.5
-	"claude-opus-4-5-20251101": {15.0, 75.0, 1.5, 18.75},
-	// Claude Sonnet 4
-	"claude-sonnet-4-20250514": {3.0, 15.0, 0.3, 3.75},
-	// Claude Haiku 3.5
-	"claude-3-5-haiku-20241022": {1.0, 5.0, 0.1, 1.25},
-	// Fallback for unknown models (use Sonnet pricing)
-	"default": {3.0, 15.0, 0.3, 3.75},
+var (
+	costPricesOnce sync.Once
+	costPricesSet  *prices.Set
+)
+
+// costPrices returns the price table for costing usage: the current town's
+// (overrides, published, built-in), or the built-in table outside a town or
+// when the town's tables don't load.
+func costPrices() *prices.Set {
+	costPricesOnce.Do(func() {
+		costPricesSet = prices.BuiltinSet()
+		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
+			if set, err := prices.Load(townRoot); err == nil {
+				costPricesSet = set
+			} else if costsVerbose {
+				fmt.Fprintf(os.Stderr, "[costs] using built-in prices: %v\n", err)
+			}
+		}
+	})
+	return costPricesSet
 }
 
 func runCosts(cmd *cobra.Command, args []string) error {
@@ -564,6 +576,47 @@ func querySessionEventsFromLocation(location string) ([]CostEntry, error) {
 
 // queryDigestBeads queries costs.digest events from the past N days and extracts session entries.
 func queryDigestBeads(days int) ([]CostEntry, error) {
+	digests, err := queryCostDigests()
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate date range
+	now := time.Now()
+	cutoff := now.AddDate(0, 0, -days)
+
+	var entries []CostEntry
+	for _, digest := range digests {
+		// Check date is within range
+		digestDate, err := time.Parse("2006-01-02", digest.Date)
+		if err != nil {
+			continue
+		}
+		if digestDate.Before(cutoff) {
+			continue
+		}
+
+		// If the digest has per-session data (old format), use it directly.
+		// Otherwise, synthesize entries from the aggregate ByRole data.
+		if len(digest.Sessions) > 0 {
+			entries = append(entries, digest.Sessions...)
+		} else {
+			for role, cost := range digest.ByRole {
+				entries = append(entries, CostEntry{
+					SessionID: fmt.Sprintf("digest-%s-%s", digest.Date, role),
+					Role:      role,
+					CostUSD:   cost,
+					EndedAt:   digestDate,
+				})
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// queryCostDigests returns the payloads of all costs.digest events.
+func queryCostDigests() ([]CostDigest, error) {
 	// Get list of event IDs
 	listArgs := []string{
 		"list",
@@ -605,11 +658,7 @@ func queryDigestBeads(days int) ([]CostEntry, error) {
 		return nil, fmt.Errorf("parsing event details: %w", err)
 	}
 
-	// Calculate date range
-	now := time.Now()
-	cutoff := now.AddDate(0, 0, -days)
-
-	var entries []CostEntry
+	var digests []CostDigest
 	for _, event := range events {
 		// Filter for costs.digest events only
 		if event.EventKind != "costs.digest" {
@@ -623,33 +672,10 @@ func queryDigestBeads(days int) ([]CostEntry, error) {
 				continue
 			}
 		}
-
-		// Check date is within range
-		digestDate, err := time.Parse("2006-01-02", digest.Date)
-		if err != nil {
-			continue
-		}
-		if digestDate.Before(cutoff) {
-			continue
-		}
-
-		// If the digest has per-session data (old format), use it directly.
-		// Otherwise, synthesize entries from the aggregate ByRole data.
-		if len(digest.Sessions) > 0 {
-			entries = append(entries, digest.Sessions...)
-		} else {
-			for role, cost := range digest.ByRole {
-				entries = append(entries, CostEntry{
-					SessionID: fmt.Sprintf("digest-%s-%s", digest.Date, role),
-					Role:      role,
-					CostUSD:   cost,
-					EndedAt:   digestDate,
-				})
-			}
-		}
+		digests = append(digests, digest)
 	}
 
-	return entries, nil
+	return digests, nil
 }
 
 // parseSessionName extracts role, rig, and worker from a session name.
@@ -781,6 +807,9 @@ func parseTranscriptUsage(transcriptPath string) (*TokenUsage, error) {
 		if usage.Model == "" && msg.Message.Model != "" {
 			usage.Model = msg.Message.Model
 		}
+		if at, err := time.Parse(time.RFC3339Nano, msg.Timestamp); err == nil && at.After(usage.At) {
+			usage.At = at
+		}
 
 		// Sum token usage
 		u := msg.Message.Usage
@@ -803,19 +832,16 @@ func calculateCost(usage *TokenUsage) float64 {
 		return 0.0
 	}
 
-	// Look up pricing for the model
-	pricing, ok := modelPricing[usage.Model]
-	if !ok {
-		pricing = modelPricing["default"]
+	at := usage.At
+	if at.IsZero() {
+		at = time.Now()
 	}
-
-	// Calculate cost (prices are per million tokens)
-	inputCost := float64(usage.InputTokens) / 1_000_000 * pricing.InputPerMillion
-	cacheReadCost := float64(usage.CacheReadInputTokens) / 1_000_000 * pricing.CacheReadPerMillion
-	cacheCreateCost := float64(usage.CacheCreationInputTokens) / 1_000_000 * pricing.CacheCreatePerMillion
-	outputCost := float64(usage.OutputTokens) / 1_000_000 * pricing.OutputPerMillion
-
-	return inputCost + cacheReadCost + cacheCreateCost + outputCost
+	return costPrices().Cost(usage.Model, at, prices.Usage{
+		Input:      usage.InputTokens,
+		Output:     usage.OutputTokens,
+		CacheRead:  usage.CacheReadInputTokens,
+		CacheWrite: usage.CacheCreationInputTokens,
+	})
 }
 
 // extractCostFromWorkDir extracts cost from Claude Code transcript for a working directory.
@@ -870,9 +896,9 @@ func outputCostsHuman(costs []SessionCost, total float64) error {
 
 	// Print each session
 	for _, c := range costs {
-		statusIcon := style.Success.Render("●")
+		statusIcon := style.Success.Render(ui.Glyph("●"))
 		if !c.Running {
-			statusIcon = style.Dim.Render("○")
+			statusIcon = style.Dim.Render(ui.Glyph("○"))
 		}
 
 		rigWorker := c.Rig
@@ -1047,7 +1073,7 @@ func runCostsRecord(cmd *cobra.Command, args []string) error {
 
 	// Output confirmation (silent if cost is zero and no work item)
 	if cost > 0 || recordWorkItem != "" {
-		fmt.Printf("%s Recorded $%.2f for %s", style.Success.Render("✓"), cost, session)
+		fmt.Printf("%s Recorded $%.2f for %s", style.SuccessPrefix, cost, session)
 		if recordWorkItem != "" {
 			fmt.Printf(" (work: %s)", recordWorkItem)
 		}
@@ -1177,7 +1203,7 @@ func runCostsDigest(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(costEntries) == 0 {
-		fmt.Printf("%s No session cost entries found for %s\n", style.Dim.Render("○"), dateStr)
+		fmt.Printf("%s No session cost entries found for %s\n", style.Dim.Render(ui.Glyph("○")), dateStr)
 		return nil
 	}
 
@@ -1227,7 +1253,7 @@ func runCostsDigest(cmd *cobra.Command, args []string) error {
 		fmt.Fprintf(os.Stderr, "warning: failed to delete some source entries: %v\n", deleteErr)
 	}
 
-	fmt.Printf("%s Created Cost Report %s (bead: %s)\n", style.Success.Render("✓"), dateStr, digestID)
+	fmt.Printf("%s Created Cost Report %s (bead: %s)\n", style.SuccessPrefix, dateStr, digestID)
 	fmt.Printf("  Total: $%.2f from %d sessions\n", digest.TotalUSD, digest.SessionCount)
 	if deletedCount > 0 {
 		fmt.Printf("  Removed %d entries from costs log\n", deletedCount)
@@ -1236,6 +1262,29 @@ func runCostsDigest(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// readCostLogEntries returns every parseable entry in the local cost log.
+// A missing or unreadable log yields no entries; callers treat cost as
+// informational.
+func readCostLogEntries() []CostLogEntry {
+	data, err := os.ReadFile(getCostsLogPath())
+	if err != nil {
+		return nil
+	}
+	var entries []CostLogEntry
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var entry CostLogEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
 // querySessionCostEntries reads session cost entries from the local log file for a target date.
 func querySessionCostEntries(targetDate time.Time) ([]CostEntry, error) {
 	logPath := getCostsLogPath()
@@ -1485,7 +1534,7 @@ func runCostsMigrate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("  Open:   %d (will be closed)\n", len(openEvents))
 
 	if len(openEvents) == 0 {
-		fmt.Println(style.Success.Render("\n✓ No migration needed - all session.ended events are already closed"))
+		fmt.Println(style.Success.Render("\n" + ui.Glyph(ui.IconPass) + " No migration needed - all session.ended events are already closed"))
 		return nil
 	}
 
@@ -1508,7 +1557,7 @@ func runCostsMigrate(cmd *cobra.Command, args []string) error {
 		closedMigrated++
 	}
 
-	fmt.Printf("\n%s Migrated %d session.ended events (closed)\n", style.Success.Render("✓"), closedMigrated)
+	fmt.Printf("\n%s Migrated %d session.ended events (closed)\n", style.SuccessPrefix, closedMigrated)
 	fmt.Println(style.Dim.Render("Legacy beads preserved for historical queries."))
 	fmt.Println(style.Dim.Render("New session costs will use ~/.gt/costs.jsonl + daily digests."))
 
@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/budget"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	budgetJSON   bool
+	budgetDryRun bool
+)
+
+var costsBudgetCmd = &cobra.Command{
+	Use:   "budget",
+	Short: "Show monthly cost budgets and projected spend",
+	Long: `Show each monthly budget's month-to-date spend and the projected total
+at the current run rate.
+
+Budgets are set in settings/config.json:
+
+  "budget": {
+    "monthly_usd": 500,
+    "rigs": {"gastown": 200},
+    "warn_at": [0.5, 0.8, 1.0],
+    "downgrade_at": 0.9,
+    "downgrade_tier": "budget",
+    "notify": ["overseer", "mayor/"]
+  }
+
+Spend comes from the daily cost digests plus today's cost log. The daemon
+runs "gt costs budget check" hourly: it mails the notify list the first
+time a budget's spend crosses a warn_at fraction or its projection
+overruns it, and once downgrade_at is spent it switches the town (or rig)
+to downgrade_tier for new sessions. Downgrades are undone when the month
+rolls over.
+
+Examples:
+  gt costs budget              # Budget standing this month
+  gt costs budget --json       # Output as JSON
+  gt costs budget check --dry-run  # Preview alerts and downgrades`,
+	RunE: runCostsBudget,
+}
+
+var costsBudgetCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Send budget alerts and apply downgrades (run by the daemon)",
+	Long: `Check every budget: mail the notify list about newly crossed thresholds
+and projected overruns, downgrade nearly exhausted scopes to the cheaper
+tier, and restore last month's downgrades once a new month starts.
+
+Each alert and downgrade happens once per month.
+
+Examples:
+  gt costs budget check
+  gt costs budget check --dry-run`,
+	RunE: runCostsBudgetCheck,
+}
+
+func init() {
+	costsBudgetCmd.Flags().BoolVar(&budgetJSON, "json", false, "Output as JSON")
+	costsBudgetCheckCmd.Flags().BoolVar(&budgetDryRun, "dry-run", false, "Show what would be sent and changed")
+	costsBudgetCmd.AddCommand(costsBudgetCheckCmd)
+	costsCmd.AddCommand(costsBudgetCmd)
+}
+
+// BudgetStatusJSON is one budget in gt costs budget --json.
+type BudgetStatusJSON struct {
+	budget.Status
+	SpentFraction     float64 `json:"spent_fraction"`
+	ProjectedFraction float64 `json:"projected_fraction"`
+	DowngradedTo      string  `json:"downgraded_to,omitempty"`
+}
+
+func runCostsBudget(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := budget.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	st, err := budget.LoadState(townRoot)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if st.Month != now.Format("2006-01") {
+		st.Downgrades = nil // Last month's; the next check restores them
+	}
+	statuses := policy.Evaluate(monthToDateSpend(now), now)
+
+	if budgetJSON {
+		out := []BudgetStatusJSON{}
+		for _, s := range statuses {
+			j := BudgetStatusJSON{Status: s, SpentFraction: s.SpentFraction(), ProjectedFraction: s.ProjectedFraction()}
+			if d := st.Downgrades[s.Scope]; d != nil {
+				j.DowngradedTo = d.Tier
+			}
+			out = append(out, j)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+
+	if len(statuses) == 0 {
+		fmt.Println(style.Dim.Render(`No budgets configured. Set "budget" in settings/config.json (see gt costs budget --help).`))
+		return nil
+	}
+	start := budget.MonthStart(now)
+	fmt.Printf("%s %s (day %d of %d)\n\n", style.Bold.Render("Budgets for"), now.Format("January 2006"),
+		now.Day(), start.AddDate(0, 1, -1).Day())
+	for _, s := range statuses {
+		fmt.Printf("  %-12s $%.2f of $%.2f (%.0f%%)  projected $%.2f (%.0f%%)%s\n",
+			s.Scope, s.Spent, s.Limit, 100*s.SpentFraction(), s.Projected, 100*s.ProjectedFraction(),
+			budgetFlag(s, st.Downgrades[s.Scope]))
+	}
+	return nil
+}
+
+// budgetFlag marks an over-budget or downgraded scope in the status listing.
+func budgetFlag(s budget.Status, d *budget.Downgrade) string {
+	var flags []string
+	switch {
+	case s.SpentFraction() >= 1:
+		flags = append(flags, style.Error.Render(ui.Glyph("✗")+" over budget"))
+	case s.ProjectedFraction() > 1:
+		flags = append(flags, style.Warning.Render(ui.Glyph(ui.IconWarn)+" projected overrun"))
+	}
+	if d != nil {
+		flags = append(flags, style.Dim.Render(fmt.Sprintf("downgraded to %s since %s", d.Tier, d.At.Local().Format("Jan 2"))))
+	}
+	if len(flags) == 0 {
+		return ""
+	}
+	return "  " + strings.Join(flags, "  ")
+}
+
+func runCostsBudgetCheck(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := budget.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	st, err := budget.LoadState(townRoot)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	for scope, d := range st.Rollover(now) {
+		if budgetDryRun {
+			fmt.Printf("Would restore %s from the %s tier\n", scope, d.Tier)
+			continue
+		}
+		if err := budget.RestoreDowngrade(townRoot, scope, d); err != nil {
+			return fmt.Errorf("restoring %s after budget downgrade: %w", scope, err)
+		}
+		fmt.Printf("Restored %s from the %s tier for the new month\n", scope, d.Tier)
+	}
+
+	statuses := policy.Evaluate(monthToDateSpend(now), now)
+	alerts := policy.Alerts(statuses, st)
+	var downgraded []string
+	for _, s := range policy.Downgrades(statuses, st) {
+		if budgetDryRun {
+			downgraded = append(downgraded, s.Scope)
+			continue
+		}
+		d, err := budget.ApplyDowngrade(townRoot, s.Scope, policy.DowngradeTier, now)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s downgrading %s: %v\n", style.WarningPrefix, s.Scope, err)
+			continue
+		}
+		st.Downgrades[s.Scope] = d
+		downgraded = append(downgraded, s.Scope)
+	}
+
+	if len(alerts) > 0 || len(downgraded) > 0 {
+		subject, body, urgent := budgetAlertMail(alerts, downgraded, policy, now)
+		if budgetDryRun {
+			fmt.Printf("Would mail %s: %s\n\n%s\n", strings.Join(policy.Notify, ", "), subject, body)
+			return nil
+		}
+		priority := mail.PriorityNormal
+		if urgent {
+			priority = mail.PriorityUrgent
+		}
+		router := mail.NewRouter(townRoot)
+		defer router.WaitPendingNotifications()
+		var sendErrs []error
+		for _, to := range policy.Notify {
+			if _, err := sendOrHoldMail(townRoot, router, &mail.Message{
+				From:     "costs",
+				To:       to,
+				Subject:  subject,
+				Body:     body,
+				Priority: priority,
+			}, false); err != nil {
+				sendErrs = append(sendErrs, fmt.Errorf("%s: %w", to, err))
+			}
+		}
+		if len(sendErrs) == len(policy.Notify) {
+			// Keep the downgrades already applied; alerts retry next check.
+			_ = budget.SaveState(townRoot, st)
+			return fmt.Errorf("sending budget alerts: %w", errors.Join(sendErrs...))
+		}
+		policy.MarkAlerted(st, alerts)
+		fmt.Printf("Sent %d budget alert(s), downgraded %d scope(s)\n", len(alerts), len(downgraded))
+	}
+	if budgetDryRun {
+		return nil
+	}
+	return budget.SaveState(townRoot, st)
+}
+
+// budgetAlertMail builds the alert mail. It's urgent once a budget is spent
+// or a scope was downgraded.
+func budgetAlertMail(alerts []budget.Alert, downgraded []string, policy *budget.Policy, now time.Time) (subject, body string, urgent bool) {
+	var b strings.Builder
+	var scopes []string
+	for _, a := range alerts {
+		if !slices.Contains(scopes, a.Scope) {
+			scopes = append(scopes, a.Scope)
+		}
+		if a.Key == budget.AlertOverrun {
+			fmt.Fprintf(&b, "%s is projected to spend $%.2f of its $%.2f budget this month (%.0f%%); $%.2f spent so far.\n",
+				a.Scope, a.Projected, a.Limit, 100*a.ProjectedFraction(), a.Spent)
+			continue
+		}
+		if a.Threshold >= 1 {
+			urgent = true
+		}
+		fmt.Fprintf(&b, "%s has spent $%.2f of its $%.2f budget (%.0f%%), projected $%.2f by month end.\n",
+			a.Scope, a.Spent, a.Limit, 100*a.SpentFraction(), a.Projected)
+	}
+	for _, scope := range downgraded {
+		urgent = true
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+		fmt.Fprintf(&b, "%s is now on the %s cost tier for new sessions until %s.\n",
+			scope, policy.DowngradeTier, budget.MonthStart(now).AddDate(0, 1, 0).Format("Jan 2"))
+	}
+	b.WriteString("\nDetails: gt costs budget")
+
+	subject = fmt.Sprintf("BUDGET: %s", strings.Join(scopes, ", "))
+	if len(downgraded) > 0 {
+		subject += " downgraded"
+	}
+	return subject, b.String(), urgent
+}
+
+// monthToDateSpend sums this month's cost digests plus log entries from days
+// not digested yet. Missing cost data counts as no spend.
+func monthToDateSpend(now time.Time) budget.Spend {
+	spend := budget.Spend{ByRig: map[string]float64{}}
+	month := now.Format("2006-01")
+	digests, _ := queryCostDigests()
+	digested := map[string]bool{}
+	for _, d := range digests {
+		if !strings.HasPrefix(d.Date, month) || digested[d.Date] {
+			continue
+		}
+		digested[d.Date] = true
+		spend.Total += d.TotalUSD
+		for rig, cost := range d.ByRig {
+			spend.ByRig[rig] += cost
+		}
+	}
+	for _, e := range readCostLogEntries() {
+		day := e.EndedAt.Local().Format("2006-01-02")
+		if !strings.HasPrefix(day, month) || digested[day] {
+			continue
+		}
+		spend.Total += e.CostUSD
+		if e.Rig != "" {
+			spend.ByRig[e.Rig] += e.CostUSD
+		}
+	}
+	return spend
+}
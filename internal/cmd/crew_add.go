@@ -11,6 +11,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -132,7 +133,7 @@ func runCrewAdd(cmd *cobra.Command, args []string) error {
 		}
 
 		fmt.Printf("%s Created crew workspace: %s/%s\n",
-			style.Bold.Render("✓"), rigName, name)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), rigName, name)
 		fmt.Printf("  Path: %s\n", worker.ClonePath)
 		fmt.Printf("  Branch: %s\n", worker.Branch)
 
@@ -152,7 +153,7 @@ func runCrewAdd(cmd *cobra.Command, args []string) error {
 	// Summary
 	if len(created) > 0 {
 		fmt.Printf("%s Created %d crew workspace(s): %v\n",
-			style.Bold.Render("✓"), len(created), created)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), len(created), created)
 		if lastWorker != nil && len(created) == 1 {
 			fmt.Printf("\n%s\n", style.Dim.Render("Start working with: cd "+lastWorker.ClonePath))
 		}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -155,7 +157,7 @@ func runCrewAt(cmd *cobra.Command, args []string) error {
 			// Found an existing session with runtime running in this directory
 			existingSession := existingSessions[0]
 			fmt.Printf("%s Found existing runtime session '%s' in crew directory\n",
-				style.Warning.Render("⚠"),
+				style.WarningPrefix,
 				existingSession)
 			fmt.Printf("  Attaching to existing session instead of creating a new one\n")
 
@@ -253,7 +255,7 @@ func runCrewAt(cmd *cobra.Command, args []string) error {
 		}
 
 		fmt.Printf("%s Created session for %s/%s\n",
-			style.Bold.Render("✓"), r.Name, name)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, name)
 	} else {
 		// Session exists - check if agent is still alive
 		// Uses descendant process check instead of pane command check,
@@ -309,7 +311,7 @@ func runCrewAt(cmd *cobra.Command, args []string) error {
 						return fmt.Errorf("stale session persists after cleanup: %w", err)
 					}
 					fmt.Printf("Stale session detected, recreating...\n")
-					if killErr := t.KillSession(sessionID); killErr != nil && killErr != tmux.ErrSessionNotFound {
+					if killErr := t.KillSession(sessionID); killErr != nil && !errors.Is(killErr, tmux.ErrSessionNotFound) {
 						return fmt.Errorf("failed to kill stale session: %w", killErr)
 					}
 					crewAtRetried = true
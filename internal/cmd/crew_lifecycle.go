@@ -20,6 +20,7 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/townlog"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -98,7 +99,7 @@ func runCrewRemove(cmd *cobra.Command, args []string) error {
 				continue
 			}
 			fmt.Printf("%s Removed crew worktree: %s/%s\n",
-				style.Bold.Render("✓"), r.Name, name)
+				style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, name)
 		} else {
 			// For regular clones, use the crew manager
 			if err := crewMgr.Remove(name, forceRemove); err != nil {
@@ -113,7 +114,7 @@ func runCrewRemove(cmd *cobra.Command, args []string) error {
 				continue
 			}
 			fmt.Printf("%s Removed crew workspace: %s/%s\n",
-				style.Bold.Render("✓"), r.Name, name)
+				style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, name)
 		}
 
 		// Handle agent bead
@@ -250,7 +251,7 @@ func runCrewRefresh(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Refreshed crew workspace: %s/%s\n",
-		style.Bold.Render("✓"), r.Name, name)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, name)
 	fmt.Printf("Attach with: %s\n", style.Dim.Render(fmt.Sprintf("gt crew at %s", name)))
 
 	return nil
@@ -385,7 +386,7 @@ func runCrewStart(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  %s %s/%s: %v\n", style.ErrorPrefix, rigName, res.name, res.err)
 			lastErr = res.err
 		} else if res.skipped {
-			fmt.Printf("  %s %s/%s: already running\n", style.Dim.Render("○"), rigName, res.name)
+			fmt.Printf("  %s %s/%s: already running\n", style.Dim.Render(ui.Glyph("○")), rigName, res.name)
 			skippedCount++
 		} else {
 			fmt.Printf("  %s %s/%s: started\n", style.SuccessPrefix, rigName, res.name)
@@ -397,7 +398,7 @@ func runCrewStart(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 	if startedCount > 0 || skippedCount > 0 {
 		fmt.Printf("%s Started %d, skipped %d (already running) in %s\n",
-			style.Bold.Render("✓"), startedCount, skippedCount, r.Name)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), startedCount, skippedCount, r.Name)
 	}
 
 	return lastErr
@@ -444,7 +445,7 @@ func runCrewRestart(cmd *cobra.Command, args []string) error {
 		}
 
 		fmt.Printf("%s Restarted crew workspace: %s/%s\n",
-			style.Bold.Render("✓"), r.Name, name)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, name)
 		fmt.Printf("Attach with: %s\n", style.Dim.Render(fmt.Sprintf("gt crew at %s", name)))
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // CrewListItem represents a crew worker in list output.
@@ -100,9 +101,9 @@ func runCrewList(cmd *cobra.Command, args []string) error {
 	// Text output
 	fmt.Printf("%s\n\n", style.Bold.Render("Crew Workspaces"))
 	for _, item := range items {
-		status := style.Dim.Render("○")
+		status := style.Dim.Render(ui.Glyph("○"))
 		if item.HasSession {
-			status = style.Bold.Render("●")
+			status = style.Bold.Render(ui.Glyph("●"))
 		}
 
 		gitStatus := style.Dim.Render("clean")
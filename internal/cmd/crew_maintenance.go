@@ -10,6 +10,7 @@ import (
 	"github.com/steveyegge/gastown/internal/crew"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 func runCrewRename(cmd *cobra.Command, args []string) error {
@@ -55,7 +56,7 @@ func runCrewRename(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Renamed crew workspace: %s/%s → %s/%s\n",
-		style.Bold.Render("✓"), r.Name, oldName, r.Name, newName)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, oldName, r.Name, newName)
 	fmt.Printf("New session will be: %s\n", style.Dim.Render(crewSessionName(r.Name, newName)))
 
 	return nil
@@ -115,16 +116,16 @@ func runCrewPristine(cmd *cobra.Command, args []string) error {
 
 	// Text output
 	for _, result := range results {
-		fmt.Printf("%s %s/%s\n", style.Bold.Render("→"), r.Name, result.Name)
+		fmt.Printf("%s %s/%s\n", style.Bold.Render(ui.Glyph("→")), r.Name, result.Name)
 
 		if result.HadChanges {
-			fmt.Printf("  %s\n", style.Bold.Render("⚠ Has uncommitted changes"))
+			fmt.Printf("  %s\n", style.Bold.Render(ui.Glyph(ui.IconWarn)+" Has uncommitted changes"))
 		}
 
 		if result.Pulled {
-			fmt.Printf("  %s git pull\n", style.Dim.Render("✓"))
+			fmt.Printf("  %s git pull\n", style.Dim.Render(ui.Glyph(ui.IconPass)))
 		} else if result.PullError != "" {
-			fmt.Printf("  %s git pull: %s\n", style.Bold.Render("✗"), result.PullError)
+			fmt.Printf("  %s git pull: %s\n", style.Bold.Render(ui.Glyph("✗")), result.PullError)
 		}
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // CrewStatusItem represents detailed status for a crew worker.
@@ -140,9 +141,9 @@ func runCrewStatus(cmd *cobra.Command, args []string) error {
 			fmt.Println()
 		}
 
-		sessionStatus := style.Dim.Render("○ stopped")
+		sessionStatus := style.Dim.Render(ui.Glyph("○") + " stopped")
 		if item.HasSession {
-			sessionStatus = style.Bold.Render("● running")
+			sessionStatus = style.Bold.Render(ui.Glyph("●") + " running")
 		}
 
 		fmt.Printf("%s %s/%s\n", sessionStatus, item.Rig, item.Name)
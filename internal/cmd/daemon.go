@@ -12,6 +12,7 @@ import (
 	"github.com/steveyegge/gastown/internal/daemon"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/templates"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -178,11 +179,28 @@ func runDaemonStart(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("daemon already running (PID %d)", pid)
 	}
 
-	// Start daemon in background
+	pid, won, err := spawnDaemon(townRoot)
+	if err != nil {
+		return err
+	}
+	if !won {
+		// Another daemon won the race - that's fine, report it
+		fmt.Printf("%s Daemon already running (PID %d)\n", style.Bold.Render(ui.Glyph("●")), pid)
+		return nil
+	}
+
+	fmt.Printf("%s Daemon started (PID %d)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), pid)
+	return nil
+}
+
+// spawnDaemon starts 'gt daemon run' in the background from the current
+// executable and returns the running daemon's PID. won is false when a
+// concurrent start got there first and the PID is that daemon's.
+func spawnDaemon(townRoot string) (pid int, won bool, err error) {
 	// We use 'gt daemon run' as the actual daemon process
 	gtPath, err := os.Executable()
 	if err != nil {
-		return fmt.Errorf("finding executable: %w", err)
+		return 0, false, fmt.Errorf("finding executable: %w", err)
 	}
 
 	daemonCmd := exec.Command(gtPath, "daemon", "run")
@@ -194,32 +212,25 @@ func runDaemonStart(cmd *cobra.Command, args []string) error {
 	daemonCmd.Stderr = nil
 
 	if err := daemonCmd.Start(); err != nil {
-		return fmt.Errorf("starting daemon: %w", err)
+		return 0, false, fmt.Errorf("starting daemon: %w", err)
 	}
 
 	// Wait a moment for the daemon to initialize and acquire the lock
 	time.Sleep(200 * time.Millisecond)
 
 	// Verify it started
-	running, pid, err = daemon.IsRunning(townRoot)
+	running, pid, err := daemon.IsRunning(townRoot)
 	if err != nil {
-		return fmt.Errorf("checking daemon status: %w", err)
+		return 0, false, fmt.Errorf("checking daemon status: %w", err)
 	}
 	if !running {
-		return fmt.Errorf("daemon failed to start (check logs with 'gt daemon logs')")
+		return 0, false, fmt.Errorf("daemon failed to start (check logs with 'gt daemon logs')")
 	}
 
 	// Check if our spawned process is the one that won the race.
 	// If another concurrent start won, our process would have exited after
 	// failing to acquire the lock, and the PID file would have a different PID.
-	if pid != daemonCmd.Process.Pid {
-		// Another daemon won the race - that's fine, report it
-		fmt.Printf("%s Daemon already running (PID %d)\n", style.Bold.Render("●"), pid)
-		return nil
-	}
-
-	fmt.Printf("%s Daemon started (PID %d)\n", style.Bold.Render("✓"), pid)
-	return nil
+	return pid, pid == daemonCmd.Process.Pid, nil
 }
 
 func runDaemonStop(cmd *cobra.Command, args []string) error {
@@ -240,7 +251,7 @@ func runDaemonStop(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("stopping daemon: %w", err)
 	}
 
-	fmt.Printf("%s Daemon stopped (was PID %d)\n", style.Bold.Render("✓"), pid)
+	fmt.Printf("%s Daemon stopped (was PID %d)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), pid)
 	return nil
 }
 
@@ -257,7 +268,7 @@ func runDaemonStatus(cmd *cobra.Command, args []string) error {
 
 	if running {
 		fmt.Printf("%s Daemon is %s (PID %d)\n",
-			style.Bold.Render("●"),
+			style.Bold.Render(ui.Glyph("●")),
 			style.Bold.Render("running"),
 			pid)
 		fmt.Printf("  Town: %s\n", townRoot)
@@ -277,14 +288,14 @@ func runDaemonStatus(cmd *cobra.Command, args []string) error {
 				fmt.Printf("  Binary: %s\n", binaryModTime.Format("2006-01-02 15:04:05"))
 				if binaryModTime.After(state.StartedAt) {
 					fmt.Printf("  %s Binary is newer than process - consider '%s'\n",
-						style.Bold.Render("⚠"),
+						style.Bold.Render(ui.Glyph(ui.IconWarn)),
 						style.Dim.Render("gt daemon stop && gt daemon start"))
 				}
 			}
 		}
 	} else {
 		fmt.Printf("%s Daemon is %s\n",
-			style.Dim.Render("○"),
+			style.Dim.Render(ui.Glyph("○")),
 			"not running")
 		fmt.Printf("\nStart with: %s\n", style.Dim.Render("gt daemon start"))
 	}
@@ -358,7 +369,7 @@ func runDaemonEnableSupervisor(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("configuring supervisor: %w", err)
 	}
 
-	fmt.Printf("%s %s\n", style.Bold.Render("✓"), msg)
+	fmt.Printf("%s %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), msg)
 	fmt.Println("\nThe daemon will now:")
 	fmt.Println("  - Auto-restart if it crashes")
 	fmt.Println("  - Start automatically on login/boot")
@@ -398,10 +409,10 @@ func runDaemonClearBackoff(cmd *cobra.Command, args []string) error {
 		if err := signalDaemonReload(process); err != nil {
 			return fmt.Errorf("signaling daemon to reload: %w", err)
 		}
-		fmt.Printf("%s Cleared backoff for %s (daemon reloaded)\n", style.Bold.Render("✓"), agentID)
+		fmt.Printf("%s Cleared backoff for %s (daemon reloaded)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), agentID)
 	} else {
 		fmt.Printf("%s Cleared backoff for %s (daemon not running, will take effect on next start)\n",
-			style.Bold.Render("✓"), agentID)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), agentID)
 	}
 
 	return nil
@@ -421,17 +432,17 @@ func runDaemonRotateLogs(cmd *cobra.Command, args []string) error {
 	}
 
 	for _, path := range result.Rotated {
-		fmt.Printf("%s Rotated %s\n", style.Bold.Render("✓"), path)
+		fmt.Printf("%s Rotated %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), path)
 	}
 	for _, path := range result.Skipped {
 		fmt.Printf("  %s %s (below threshold)\n", style.Dim.Render("·"), path)
 	}
 	for _, err := range result.Errors {
-		fmt.Printf("  %s %v\n", style.Warning.Render("⚠"), err)
+		fmt.Printf("  %s %v\n", style.WarningPrefix, err)
 	}
 
 	if len(result.Rotated) == 0 && len(result.Errors) == 0 {
-		fmt.Printf("%s No logs needed rotation\n", style.Bold.Render("✓"))
+		fmt.Printf("%s No logs needed rotation\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	return nil
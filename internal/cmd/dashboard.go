@@ -12,14 +12,17 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/observer"
 	"github.com/steveyegge/gastown/internal/web"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 var (
-	dashboardPort int
-	dashboardBind string
-	dashboardOpen bool
+	dashboardPort     int
+	dashboardBind     string
+	dashboardOpen     bool
+	dashboardObserver bool
 )
 
 var dashboardCmd = &cobra.Command{
@@ -34,18 +37,25 @@ The dashboard shows real-time convoy status with:
 - Last activity indicator (green/yellow/red)
 - Auto-refresh every 30 seconds via htmx
 
+With --observer the dashboard is read-only and admits only holders of an
+observer token (see gt observer add): open it once as /?token=<token>.
+Mutating API calls are refused. Running with GT_OBSERVER set also serves
+a read-only dashboard, without requiring a token.
+
 Example:
   gt dashboard                    # Start on default port 8080
   gt dashboard --port 3000        # Start on port 3000
   gt dashboard --bind 0.0.0.0     # Listen on all interfaces
-  gt dashboard --open             # Start and open browser`,
+  gt dashboard --open             # Start and open browser
+  gt dashboard --observer --bind 0.0.0.0   # Read-only, token-gated`,
 	RunE: runDashboard,
 }
 
 func init() {
-	dashboardCmd.Flags().IntVar(&dashboardPort, "port", 8080, "HTTP port to listen on")
+	dashboardCmd.Flags().IntVar(&dashboardPort, "port", constants.DashboardPort, "HTTP port to listen on")
 	dashboardCmd.Flags().StringVar(&dashboardBind, "bind", "127.0.0.1", "Address to bind to (use 0.0.0.0 for all interfaces)")
 	dashboardCmd.Flags().BoolVar(&dashboardOpen, "open", false, "Open browser automatically")
+	dashboardCmd.Flags().BoolVar(&dashboardObserver, "observer", false, "Serve a read-only dashboard to observer token holders")
 	rootCmd.AddCommand(dashboardCmd)
 }
 
@@ -55,6 +65,10 @@ func runDashboard(cmd *cobra.Command, args []string) error {
 	var err error
 
 	townRoot, wsErr := workspace.FindFromCwdOrError()
+	readOnly := dashboardObserver || observer.Enabled()
+	if wsErr != nil && readOnly {
+		return fmt.Errorf("not in a Gas Town workspace: %w", wsErr)
+	}
 	if wsErr != nil {
 		// No workspace - run in setup mode
 		handler, err = web.NewSetupMux()
@@ -76,7 +90,30 @@ func runDashboard(cmd *cobra.Command, args []string) error {
 			fmt.Fprintf(cmd.ErrOrStderr(), "warning: loading town settings: %v (using defaults)\n", loadErr)
 		}
 
-		handler, err = web.NewDashboardMux(fetcher, webCfg)
+		switch {
+		case dashboardObserver:
+			reg, loadErr := observer.Load(townRoot)
+			if loadErr != nil {
+				return loadErr
+			}
+			if len(reg.Observers) == 0 {
+				return fmt.Errorf("no observer tokens: add one with gt observer add <name>")
+			}
+			// Reload per request so revoked tokens stop working at once
+			verify := func(token string) bool {
+				reg, err := observer.Load(townRoot)
+				if err != nil {
+					return false
+				}
+				_, ok := reg.Verify(token)
+				return ok
+			}
+			handler, err = web.NewObserverDashboardMux(fetcher, webCfg, verify)
+		case readOnly:
+			handler, err = web.NewObserverDashboardMux(fetcher, webCfg, nil)
+		default:
+			handler, err = web.NewDashboardMux(fetcher, webCfg)
+		}
 		if err != nil {
 			return fmt.Errorf("creating dashboard handler: %w", err)
 		}
@@ -20,6 +20,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -484,7 +485,7 @@ func runDeaconStart(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Deacon session started. Attach with: %s\n",
-		style.Bold.Render("✓"),
+		style.Bold.Render(ui.Glyph(ui.IconPass)),
 		style.Dim.Render("gt deacon attach"))
 
 	return nil
@@ -594,7 +595,7 @@ func runDeaconStop(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("killing session: %w", err)
 	}
 
-	fmt.Printf("%s Deacon session stopped.\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Deacon session stopped.\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	return nil
 }
 
@@ -693,7 +694,7 @@ func runDeaconStatus(cmd *cobra.Command, args []string) error {
 
 	// Human-readable output
 	if paused && pauseState != nil {
-		fmt.Printf("%s DEACON PAUSED\n", style.Bold.Render("⏸️"))
+		fmt.Printf("%s DEACON PAUSED\n", style.Bold.Render(ui.Glyph("⏸️")))
 		if pauseState.Reason != "" {
 			fmt.Printf("  Reason: %s\n", pauseState.Reason)
 		}
@@ -713,18 +714,18 @@ func runDeaconStatus(cmd *cobra.Command, args []string) error {
 				status = "attached"
 			}
 			fmt.Printf("%s Deacon session is %s\n",
-				style.Bold.Render("●"),
+				style.Bold.Render(ui.Glyph("●")),
 				style.Bold.Render("running"))
 			fmt.Printf("  Status: %s\n", status)
 			fmt.Printf("  Created: %s\n", info.Created)
 		} else {
 			fmt.Printf("%s Deacon session is %s\n",
-				style.Bold.Render("●"),
+				style.Bold.Render(ui.Glyph("●")),
 				style.Bold.Render("running"))
 		}
 	} else {
 		fmt.Printf("%s Deacon session is %s\n",
-			style.Dim.Render("○"),
+			style.Dim.Render(ui.Glyph("○")),
 			"not running")
 		fmt.Printf("\nStart with: %s\n", style.Dim.Render("gt deacon start"))
 	}
@@ -782,7 +783,7 @@ func runDeaconRestart(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("%s Deacon restarted\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Deacon restarted\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt deacon attach' to connect"))
 	return nil
 }
@@ -799,7 +800,7 @@ func runDeaconHeartbeat(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("checking pause state: %w", err)
 	}
 	if paused {
-		fmt.Printf("%s Deacon is paused. Use 'gt deacon resume' to unpause.\n", style.Bold.Render("⏸️"))
+		fmt.Printf("%s Deacon is paused. Use 'gt deacon resume' to unpause.\n", style.Bold.Render(ui.Glyph("⏸️")))
 		if state.Reason != "" {
 			fmt.Printf("  Reason: %s\n", state.Reason)
 		}
@@ -815,12 +816,12 @@ func runDeaconHeartbeat(cmd *cobra.Command, args []string) error {
 		if err := deacon.TouchWithAction(townRoot, action, 0, 0); err != nil {
 			return fmt.Errorf("updating heartbeat: %w", err)
 		}
-		fmt.Printf("%s Heartbeat updated: %s\n", style.Bold.Render("✓"), action)
+		fmt.Printf("%s Heartbeat updated: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), action)
 	} else {
 		if err := deacon.Touch(townRoot); err != nil {
 			return fmt.Errorf("updating heartbeat: %w", err)
 		}
-		fmt.Printf("%s Heartbeat updated\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Heartbeat updated\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	return nil
@@ -847,7 +848,7 @@ func runDeaconHealthCheck(cmd *cobra.Command, args []string) error {
 	if agentState.IsInCooldown(healthCheckCooldown) {
 		remaining := agentState.CooldownRemaining(healthCheckCooldown)
 		fmt.Printf("%s Agent %s is in cooldown (remaining: %s)\n",
-			style.Dim.Render("○"), agent, remaining.Round(time.Second))
+			style.Dim.Render(ui.Glyph("○")), agent, remaining.Round(time.Second))
 		return nil
 	}
 
@@ -865,7 +866,7 @@ func runDeaconHealthCheck(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("checking session: %w", err)
 	}
 	if !exists {
-		fmt.Printf("%s Agent %s session not running\n", style.Dim.Render("○"), agent)
+		fmt.Printf("%s Agent %s session not running\n", style.Dim.Render(ui.Glyph("○")), agent)
 		return nil
 	}
 
@@ -894,7 +895,7 @@ func runDeaconHealthCheck(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Sent HEALTH_CHECK to %s, waiting %s...\n",
-		style.Bold.Render("→"), agent, healthCheckTimeout)
+		style.Bold.Render(ui.Glyph("→")), agent, healthCheckTimeout)
 
 	// Wait for response using context and ticker for reliability
 	// This prevents loop hangs if system clock changes
@@ -932,7 +933,7 @@ Done:
 			style.PrintWarning("failed to save health check state: %v", err)
 		}
 		fmt.Printf("%s Agent %s responded (failures reset to 0)\n",
-			style.Bold.Render("✓"), agent)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), agent)
 		return nil
 	}
 
@@ -943,11 +944,11 @@ Done:
 	}
 
 	fmt.Printf("%s Agent %s did not respond (consecutive failures: %d/%d)\n",
-		style.Dim.Render("⚠"), agent, agentState.ConsecutiveFailures, healthCheckFailures)
+		style.Dim.Render(ui.Glyph(ui.IconWarn)), agent, agentState.ConsecutiveFailures, healthCheckFailures)
 
 	// Check if force-kill threshold reached
 	if agentState.ShouldForceKill(healthCheckFailures) {
-		fmt.Printf("%s Agent %s should be force-killed\n", style.Bold.Render("✗"), agent)
+		fmt.Printf("%s Agent %s should be force-killed\n", style.Bold.Render(ui.Glyph("✗")), agent)
 		return NewSilentExit(2) // Exit code 2 = should force-kill
 	}
 
@@ -992,7 +993,7 @@ func runDeaconForceKill(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("checking session: %w", err)
 	}
 	if !exists {
-		fmt.Printf("%s Agent %s session not running\n", style.Dim.Render("○"), agent)
+		fmt.Printf("%s Agent %s session not running\n", style.Dim.Render(ui.Glyph("○")), agent)
 		return nil
 	}
 
@@ -1033,7 +1034,7 @@ func runDeaconForceKill(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Force-killed agent %s (total kills: %d)\n",
-		style.Bold.Render("✓"), agent, agentState.ForceKillCount)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), agent, agentState.ForceKillCount)
 	fmt.Printf("  %s\n", style.Dim.Render("Agent is now 'asleep'. Use 'gt rig boot' to restart."))
 
 	return nil
@@ -1052,12 +1053,12 @@ func runDeaconHealthState(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(state.Agents) == 0 {
-		fmt.Printf("%s No health check state recorded yet\n", style.Dim.Render("○"))
+		fmt.Printf("%s No health check state recorded yet\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
 	fmt.Printf("%s Health Check State (updated %s)\n\n",
-		style.Bold.Render("●"),
+		style.Bold.Render(ui.Glyph("●")),
 		state.LastUpdated.Format(time.RFC3339))
 
 	for agentID, agentState := range state.Agents {
@@ -1189,21 +1190,21 @@ func runDeaconStaleHooks(cmd *cobra.Command, args []string) error {
 
 	// Print summary
 	if result.TotalHooked == 0 {
-		fmt.Printf("%s No hooked beads found\n", style.Dim.Render("○"))
+		fmt.Printf("%s No hooked beads found\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
 	fmt.Printf("%s Found %d hooked bead(s), %d stale (older than %s)\n",
-		style.Bold.Render("●"), result.TotalHooked, result.StaleCount, staleHooksMaxAge)
+		style.Bold.Render(ui.Glyph("●")), result.TotalHooked, result.StaleCount, staleHooksMaxAge)
 
 	if result.StaleCount == 0 {
-		fmt.Printf("%s No stale hooked beads\n", style.Dim.Render("○"))
+		fmt.Printf("%s No stale hooked beads\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
 	// Print details for each stale bead
 	for _, r := range result.Results {
-		status := style.Dim.Render("○")
+		status := style.Dim.Render(ui.Glyph("○"))
 		action := "skipped (agent alive)"
 
 		if !r.AgentAlive {
@@ -1211,10 +1212,10 @@ func runDeaconStaleHooks(cmd *cobra.Command, args []string) error {
 				status = style.Bold.Render("?")
 				action = "would unhook (agent dead)"
 			} else if r.Unhooked {
-				status = style.Bold.Render("✓")
+				status = style.Bold.Render(ui.Glyph(ui.IconPass))
 				action = "unhooked (agent dead)"
 			} else if r.Error != "" {
-				status = style.Dim.Render("✗")
+				status = style.Dim.Render(ui.Glyph("✗"))
 				action = fmt.Sprintf("error: %s", r.Error)
 			}
 		}
@@ -1232,11 +1233,11 @@ func runDeaconStaleHooks(cmd *cobra.Command, args []string) error {
 				details = append(details, fmt.Sprintf("%d unpushed commit(s)", r.UnpushedCount))
 			}
 			fmt.Printf("    %s partial work detected: %s\n",
-				style.Bold.Render("⚠"), strings.Join(details, ", "))
+				style.Bold.Render(ui.Glyph(ui.IconWarn)), strings.Join(details, ", "))
 		}
 		if r.WorktreeError != "" {
 			fmt.Printf("    %s worktree check failed: %s\n",
-				style.Dim.Render("⚠"), r.WorktreeError)
+				style.Dim.Render(ui.Glyph(ui.IconWarn)), r.WorktreeError)
 		}
 	}
 
@@ -1251,14 +1252,14 @@ func runDeaconStaleHooks(cmd *cobra.Command, args []string) error {
 	// Summary
 	if staleHooksDryRun {
 		fmt.Printf("\n%s Dry run - no changes made. Run without --dry-run to unhook.\n",
-			style.Dim.Render("ℹ"))
+			style.Dim.Render(ui.Glyph(ui.IconInfo)))
 	} else if result.Unhooked > 0 {
 		fmt.Printf("\n%s Unhooked %d stale bead(s)\n",
-			style.Bold.Render("✓"), result.Unhooked)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), result.Unhooked)
 	}
 	if partialWorkCount > 0 {
 		fmt.Printf("%s %d bead(s) had partial work in worktree\n",
-			style.Bold.Render("⚠"), partialWorkCount)
+			style.Bold.Render(ui.Glyph(ui.IconWarn)), partialWorkCount)
 	}
 
 	return nil
@@ -1277,7 +1278,7 @@ func runDeaconPause(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("checking pause state: %w", err)
 	}
 	if paused {
-		fmt.Printf("%s Deacon is already paused\n", style.Dim.Render("○"))
+		fmt.Printf("%s Deacon is already paused\n", style.Dim.Render(ui.Glyph("○")))
 		fmt.Printf("  Reason: %s\n", state.Reason)
 		fmt.Printf("  Paused at: %s\n", state.PausedAt.Format(time.RFC3339))
 		fmt.Printf("  Paused by: %s\n", state.PausedBy)
@@ -1289,7 +1290,7 @@ func runDeaconPause(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("pausing Deacon: %w", err)
 	}
 
-	fmt.Printf("%s Deacon paused\n", style.Bold.Render("⏸️"))
+	fmt.Printf("%s Deacon paused\n", style.Bold.Render(ui.Glyph("⏸️")))
 	if pauseReason != "" {
 		fmt.Printf("  Reason: %s\n", pauseReason)
 	}
@@ -1314,7 +1315,7 @@ func runDeaconResume(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("checking pause state: %w", err)
 	}
 	if !paused {
-		fmt.Printf("%s Deacon is not paused\n", style.Dim.Render("○"))
+		fmt.Printf("%s Deacon is not paused\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -1338,11 +1339,11 @@ func runDeaconCleanupOrphans(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(orphans) == 0 {
-		fmt.Printf("%s No orphaned claude processes found\n", style.Dim.Render("○"))
+		fmt.Printf("%s No orphaned claude processes found\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
-	fmt.Printf("%s Found %d orphaned claude process(es)\n", style.Bold.Render("●"), len(orphans))
+	fmt.Printf("%s Found %d orphaned claude process(es)\n", style.Bold.Render(ui.Glyph("●")), len(orphans))
 
 	// Process them with signal escalation
 	results, err := util.CleanupOrphanedClaudeProcesses()
@@ -1355,13 +1356,13 @@ func runDeaconCleanupOrphans(cmd *cobra.Command, args []string) error {
 	for _, r := range results {
 		switch r.Signal {
 		case "SIGTERM":
-			fmt.Printf("  %s Sent SIGTERM to PID %d (%s)\n", style.Bold.Render("→"), r.Process.PID, r.Process.Cmd)
+			fmt.Printf("  %s Sent SIGTERM to PID %d (%s)\n", style.Bold.Render(ui.Glyph("→")), r.Process.PID, r.Process.Cmd)
 			terminated++
 		case "SIGKILL":
 			fmt.Printf("  %s Escalated to SIGKILL for PID %d (%s)\n", style.Bold.Render("!"), r.Process.PID, r.Process.Cmd)
 			escalated++
 		case "UNKILLABLE":
-			fmt.Printf("  %s WARNING: PID %d (%s) survived SIGKILL\n", style.Bold.Render("⚠"), r.Process.PID, r.Process.Cmd)
+			fmt.Printf("  %s WARNING: PID %d (%s) survived SIGKILL\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), r.Process.PID, r.Process.Cmd)
 			unkillable++
 		}
 	}
@@ -1374,7 +1375,7 @@ func runDeaconCleanupOrphans(cmd *cobra.Command, args []string) error {
 		if unkillable > 0 {
 			summary += fmt.Sprintf(" (%d unkillable)", unkillable)
 		}
-		fmt.Printf("%s %s\n", style.Bold.Render("✓"), summary)
+		fmt.Printf("%s %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), summary)
 	}
 
 	return nil
@@ -1389,20 +1390,20 @@ func runDeaconZombieScan(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(zombies) == 0 {
-		fmt.Printf("%s No zombie claude processes found\n", style.Dim.Render("○"))
+		fmt.Printf("%s No zombie claude processes found\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
-	fmt.Printf("%s Found %d zombie claude process(es)\n", style.Bold.Render("●"), len(zombies))
+	fmt.Printf("%s Found %d zombie claude process(es)\n", style.Bold.Render(ui.Glyph("●")), len(zombies))
 
 	// In dry-run mode, just list them
 	if zombieScanDryRun {
 		for _, z := range zombies {
 			ageStr := fmt.Sprintf("%dm", z.Age/60)
 			fmt.Printf("  %s PID %d (%s) TTY=%s age=%s\n",
-				style.Dim.Render("→"), z.PID, z.Cmd, z.TTY, ageStr)
+				style.Dim.Render(ui.Glyph("→")), z.PID, z.Cmd, z.TTY, ageStr)
 		}
-		fmt.Printf("%s Dry run - no processes killed\n", style.Dim.Render("○"))
+		fmt.Printf("%s Dry run - no processes killed\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -1418,7 +1419,7 @@ func runDeaconZombieScan(cmd *cobra.Command, args []string) error {
 		switch r.Signal {
 		case "SIGTERM":
 			fmt.Printf("  %s Sent SIGTERM to PID %d (%s) TTY=%s\n",
-				style.Bold.Render("→"), r.Process.PID, r.Process.Cmd, r.Process.TTY)
+				style.Bold.Render(ui.Glyph("→")), r.Process.PID, r.Process.Cmd, r.Process.TTY)
 			terminated++
 		case "SIGKILL":
 			fmt.Printf("  %s Escalated to SIGKILL for PID %d (%s)\n",
@@ -1426,7 +1427,7 @@ func runDeaconZombieScan(cmd *cobra.Command, args []string) error {
 			escalated++
 		case "UNKILLABLE":
 			fmt.Printf("  %s WARNING: PID %d (%s) survived SIGKILL\n",
-				style.Bold.Render("⚠"), r.Process.PID, r.Process.Cmd)
+				style.Bold.Render(ui.Glyph(ui.IconWarn)), r.Process.PID, r.Process.Cmd)
 			unkillable++
 		}
 	}
@@ -1439,7 +1440,7 @@ func runDeaconZombieScan(cmd *cobra.Command, args []string) error {
 		if unkillable > 0 {
 			summary += fmt.Sprintf(" (%d unkillable)", unkillable)
 		}
-		fmt.Printf("%s %s\n", style.Bold.Render("✓"), summary)
+		fmt.Printf("%s %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), summary)
 	}
 
 	return nil
@@ -1458,26 +1459,26 @@ func runDeaconRedispatch(cmd *cobra.Command, args []string) error {
 
 	switch result.Action {
 	case "redispatched":
-		fmt.Printf("%s %s\n", style.Bold.Render("✓"), result.Message)
+		fmt.Printf("%s %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), result.Message)
 		return nil
 
 	case "escalated":
-		fmt.Printf("%s %s\n", style.Bold.Render("⚠"), result.Message)
+		fmt.Printf("%s %s\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), result.Message)
 		if result.Error != nil {
 			return result.Error
 		}
 		return nil
 
 	case "already-escalated":
-		fmt.Printf("%s %s\n", style.Dim.Render("○"), result.Message)
+		fmt.Printf("%s %s\n", style.Dim.Render(ui.Glyph("○")), result.Message)
 		return nil
 
 	case "cooldown":
-		fmt.Printf("%s %s\n", style.Dim.Render("○"), result.Message)
+		fmt.Printf("%s %s\n", style.Dim.Render(ui.Glyph("○")), result.Message)
 		return NewSilentExit(2)
 
 	case "skipped":
-		fmt.Printf("%s %s\n", style.Dim.Render("○"), result.Message)
+		fmt.Printf("%s %s\n", style.Dim.Render(ui.Glyph("○")), result.Message)
 		return NewSilentExit(3)
 
 	case "error":
@@ -1504,12 +1505,12 @@ func runDeaconRedispatchState(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(state.Beads) == 0 {
-		fmt.Printf("%s No re-dispatch state recorded\n", style.Dim.Render("○"))
+		fmt.Printf("%s No re-dispatch state recorded\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
 	fmt.Printf("%s Re-dispatch State (updated %s)\n\n",
-		style.Bold.Render("●"),
+		style.Bold.Render(ui.Glyph("●")),
 		state.LastUpdated.Format(time.RFC3339))
 
 	for beadID, beadState := range state.Beads {
@@ -1555,32 +1556,32 @@ func runDeaconFeedStranded(cmd *cobra.Command, args []string) error {
 
 	// Human-readable output
 	if len(result.Details) == 0 {
-		fmt.Printf("%s No stranded convoys found\n", style.Dim.Render("○"))
+		fmt.Printf("%s No stranded convoys found\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
 	for _, d := range result.Details {
 		switch d.Action {
 		case "fed":
-			fmt.Printf("  %s %s: %s\n", style.Bold.Render("✓"), d.ConvoyID, d.Message)
+			fmt.Printf("  %s %s: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), d.ConvoyID, d.Message)
 		case "closed":
-			fmt.Printf("  %s %s: %s\n", style.Bold.Render("✓"), d.ConvoyID, d.Message)
+			fmt.Printf("  %s %s: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), d.ConvoyID, d.Message)
 		case "cooldown":
-			fmt.Printf("  %s %s: %s\n", style.Dim.Render("○"), d.ConvoyID, d.Message)
+			fmt.Printf("  %s %s: %s\n", style.Dim.Render(ui.Glyph("○")), d.ConvoyID, d.Message)
 		case "limit":
-			fmt.Printf("  %s %s: %s\n", style.Dim.Render("○"), d.ConvoyID, d.Message)
+			fmt.Printf("  %s %s: %s\n", style.Dim.Render(ui.Glyph("○")), d.ConvoyID, d.Message)
 		case "error":
 			id := d.ConvoyID
 			if id == "" {
 				id = "(general)"
 			}
-			fmt.Printf("  %s %s: %s\n", style.Dim.Render("✗"), id, d.Message)
+			fmt.Printf("  %s %s: %s\n", style.Dim.Render(ui.Glyph("✗")), id, d.Message)
 		}
 	}
 
 	// Summary
 	fmt.Printf("\n%s Fed: %d, Closed: %d, Skipped: %d, Errors: %d\n",
-		style.Bold.Render("●"), result.Fed, result.Closed, result.Skipped, result.Errors)
+		style.Bold.Render(ui.Glyph("●")), result.Fed, result.Closed, result.Skipped, result.Errors)
 
 	return nil
 }
@@ -1598,12 +1599,12 @@ func runDeaconFeedStrandedState(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(state.Convoys) == 0 {
-		fmt.Printf("%s No feed-stranded state recorded yet\n", style.Dim.Render("○"))
+		fmt.Printf("%s No feed-stranded state recorded yet\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
 	fmt.Printf("%s Feed-Stranded State (updated %s)\n\n",
-		style.Bold.Render("●"),
+		style.Bold.Render(ui.Glyph("●")),
 		state.LastUpdated.Format(time.RFC3339))
 
 	for convoyID, convoyState := range state.Convoys {
@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	diffConfigAgainst string
+	diffConfigRig     string
+	diffConfigTop     int
+	diffConfigJSON    bool
+)
+
+var diffConfigCmd = &cobra.Command{
+	Use:     "diff-config",
+	GroupID: GroupConfig,
+	Short:   "Diff effective config against defaults and the last known-good",
+	Long: `Show how the town's effective configuration differs from the built-in
+defaults and from the last known-good snapshot.
+
+The effective configuration is every config file layered over its
+compiled-in defaults (town settings, mayor/daemon.json, and each rig's
+settings), with rig env profiles interpolated. Secret-looking values are
+shown as hashes.
+
+The known-good snapshot is saved automatically whenever a full gt doctor
+run comes back green. When a town turns sick, the settings changed since
+then are the first suspects; they are ranked by how likely each is to be
+responsible (changed since known-good, off its default, and in an area
+that commonly breaks towns: agents, timeouts, patrols, env, ...).
+
+Examples:
+  gt diff-config                       # Both diffs plus ranked suspects
+  gt diff-config --against=known-good  # Only changes since the town was green
+  gt diff-config --against=defaults --rig gastown
+  gt diff-config --json`,
+	Args: cobra.NoArgs,
+	RunE: runDiffConfig,
+}
+
+func init() {
+	diffConfigCmd.Flags().StringVar(&diffConfigAgainst, "against", "both", "Compare against: defaults, known-good, or both")
+	diffConfigCmd.Flags().StringVar(&diffConfigRig, "rig", "", "Only show this rig's settings (town-wide settings are always shown)")
+	diffConfigCmd.Flags().IntVar(&diffConfigTop, "top", 5, "Number of likely suspects to highlight")
+	diffConfigCmd.Flags().BoolVar(&diffConfigJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(diffConfigCmd)
+}
+
+// DiffConfigReport is the gt diff-config output.
+type DiffConfigReport struct {
+	VsDefaults     []config.SettingChange `json:"vs_defaults,omitempty"`
+	KnownGoodAt    *time.Time             `json:"known_good_at,omitempty"`
+	SinceKnownGood []config.SettingChange `json:"since_known_good,omitempty"`
+	Suspects       []ConfigSuspect        `json:"suspects,omitempty"`
+}
+
+// ConfigSuspect is a changed setting ranked by how likely it is to have
+// made the town sick.
+type ConfigSuspect struct {
+	config.SettingChange
+	Score   int      `json:"score"`
+	Reasons []string `json:"reasons"`
+}
+
+// configImpactAreas are setting areas whose changes most often break a
+// town, matched against any segment of a setting key.
+var configImpactAreas = map[string]string{
+	"agents":        "agent commands",
+	"role_agents":   "role agent assignment",
+	"default_agent": "default agent",
+	"runtime":       "agent runtime",
+	"operational":   "timeouts and retries",
+	"scheduler":     "dispatch capacity",
+	"patrols":       "patrol schedule",
+	"heartbeat":     "daemon heartbeat",
+	"env":           "session environment",
+	"merge_queue":   "merge queue",
+}
+
+func runDiffConfig(cmd *cobra.Command, args []string) error {
+	switch diffConfigAgainst {
+	case "defaults", "known-good", "both":
+	default:
+		return fmt.Errorf("invalid --against %q: must be defaults, known-good, or both", diffConfigAgainst)
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigs := config.TownRigNames(townRoot)
+	if diffConfigRig != "" {
+		found := false
+		for _, r := range rigs {
+			found = found || r == diffConfigRig
+		}
+		if !found {
+			return fmt.Errorf("rig %q not found", diffConfigRig)
+		}
+	}
+	defaults := config.DefaultSettings(rigs)
+	current, err := config.EffectiveSettings(townRoot, rigs)
+	if err != nil {
+		return err
+	}
+	snapshot, err := doctor.LoadConfigSnapshot(townRoot)
+	if err != nil {
+		return err
+	}
+
+	var report DiffConfigReport
+	vsDefaults := filterRigChanges(config.DiffSettings(defaults, current), diffConfigRig)
+	if diffConfigAgainst != "known-good" {
+		report.VsDefaults = vsDefaults
+	}
+	var sinceGood []config.SettingChange
+	if snapshot != nil {
+		report.KnownGoodAt = &snapshot.SavedAt
+		sinceGood = filterRigChanges(config.DiffSettings(snapshot.Settings, current), diffConfigRig)
+		if diffConfigAgainst != "defaults" {
+			report.SinceKnownGood = sinceGood
+		}
+	}
+	report.Suspects = rankConfigSuspects(vsDefaults, sinceGood, snapshot != nil, diffConfigTop)
+
+	if diffConfigJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	if diffConfigAgainst != "known-good" {
+		fmt.Printf("%s (%d)\n", style.Bold.Render("Effective config vs defaults"), len(report.VsDefaults))
+		printSettingChanges(report.VsDefaults, "  (all defaults)")
+		fmt.Println()
+	}
+	if diffConfigAgainst != "defaults" {
+		if snapshot == nil {
+			fmt.Printf("%s\n", style.Bold.Render("Changes since last known-good"))
+			fmt.Println(style.Dim.Render("  No known-good snapshot yet; one is saved when a full gt doctor run is green."))
+		} else {
+			fmt.Printf("%s (%d, doctor green %s)\n", style.Bold.Render("Changes since last known-good"),
+				len(report.SinceKnownGood), snapshot.SavedAt.Local().Format("2006-01-02 15:04"))
+			printSettingChanges(report.SinceKnownGood, "  (no changes)")
+		}
+		fmt.Println()
+	}
+	if len(report.Suspects) > 0 {
+		fmt.Println(style.Bold.Render("Most likely responsible"))
+		for i, s := range report.Suspects {
+			fmt.Printf("  %d. %s  %s\n", i+1, style.Warning.Render(s.Key), style.Dim.Render(strings.Join(s.Reasons, "; ")))
+		}
+	}
+	return nil
+}
+
+// filterRigChanges drops settings of rigs other than rig (if set).
+func filterRigChanges(changes []config.SettingChange, rig string) []config.SettingChange {
+	if rig == "" {
+		return changes
+	}
+	out := changes[:0:0]
+	for _, c := range changes {
+		if !strings.HasPrefix(c.Key, "rig.") || strings.HasPrefix(c.Key, "rig."+rig+".") {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func printSettingChanges(changes []config.SettingChange, empty string) {
+	if len(changes) == 0 {
+		fmt.Println(style.Dim.Render(empty))
+		return
+	}
+	for _, c := range changes {
+		switch {
+		case c.From == "":
+			fmt.Printf("  %s %s: %s\n", style.Success.Render("+"), c.Key, c.To)
+		case c.To == "":
+			fmt.Printf("  %s %s: %s\n", style.Error.Render("-"), c.Key, style.Dim.Render(c.From))
+		default:
+			fmt.Printf("  %s %s: %s → %s\n", style.Warning.Render("~"), c.Key, style.Dim.Render(c.From), c.To)
+		}
+	}
+}
+
+// rankConfigSuspects scores changed settings by how likely each is to
+// have made the town sick. With a known-good snapshot only settings
+// changed since then are suspects; without one, every non-default
+// setting is, with less confidence.
+func rankConfigSuspects(vsDefaults, sinceGood []config.SettingChange, haveSnapshot bool, top int) []ConfigSuspect {
+	nonDefault := make(map[string]bool, len(vsDefaults))
+	for _, c := range vsDefaults {
+		nonDefault[c.Key] = true
+	}
+	candidates := vsDefaults
+	if haveSnapshot {
+		candidates = sinceGood
+	}
+
+	suspects := make([]ConfigSuspect, 0, len(candidates))
+	for _, c := range candidates {
+		s := ConfigSuspect{SettingChange: c}
+		if haveSnapshot {
+			s.Score += 3
+			s.Reasons = append(s.Reasons, "changed since known-good")
+		}
+		if nonDefault[c.Key] {
+			s.Score++
+			s.Reasons = append(s.Reasons, "differs from default")
+		}
+		for _, seg := range strings.Split(c.Key, ".") {
+			if area, ok := configImpactAreas[seg]; ok {
+				s.Score++
+				s.Reasons = append(s.Reasons, "affects "+area)
+				break
+			}
+		}
+		suspects = append(suspects, s)
+	}
+	sort.SliceStable(suspects, func(i, j int) bool { return suspects[i].Score > suspects[j].Score })
+	if top >= 0 && len(suspects) > top {
+		suspects = suspects[:top]
+	}
+	return suspects
+}
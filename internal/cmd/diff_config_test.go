@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestRankConfigSuspects(t *testing.T) {
+	vsDefaults := []config.SettingChange{
+		{Key: "town.default_agent", From: "claude", To: "codex"},
+		{Key: "town.cli_theme", To: "dark"},
+	}
+	sinceGood := []config.SettingChange{
+		{Key: "town.cli_theme", From: "light", To: "dark"},
+		{Key: "rig.gastown.merge_queue.enabled", From: "true", To: "false"},
+		{Key: "town.agent_email_domain", From: "x.local"},
+	}
+
+	got := rankConfigSuspects(vsDefaults, sinceGood, true, 5)
+	if len(got) != 3 {
+		t.Fatalf("got %d suspects, want only the 3 changed since known-good", len(got))
+	}
+	// cli_theme (since good + non-default) and merge_queue (since good +
+	// impact area) tie ahead of the plain removal.
+	if got[2].Key != "town.agent_email_domain" || got[0].Score != 4 || got[2].Score != 3 {
+		t.Errorf("unexpected ranking: %+v", got)
+	}
+
+	noSnap := rankConfigSuspects(vsDefaults, nil, false, 1)
+	if len(noSnap) != 1 || noSnap[0].Key != "town.default_agent" {
+		t.Errorf("without a snapshot, the non-default impact setting should lead: %+v", noSnap)
+	}
+}
+
+func TestFilterRigChanges(t *testing.T) {
+	changes := []config.SettingChange{{Key: "town.x"}, {Key: "rig.a.y"}, {Key: "rig.ab.z"}}
+	got := filterRigChanges(changes, "a")
+	if len(got) != 2 || got[1].Key != "rig.a.y" {
+		t.Errorf("filterRigChanges = %+v", got)
+	}
+}
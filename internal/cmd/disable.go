@@ -55,7 +55,7 @@ func runDisable(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Printf("%s Gas Town disabled\n", style.Success.Render("✓"))
+	fmt.Printf("%s Gas Town disabled\n", style.SuccessPrefix)
 	fmt.Println()
 	fmt.Println("All agentic coding tools now work vanilla.")
 	if !disableClean {
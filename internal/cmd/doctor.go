@@ -2,11 +2,18 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/inbox"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -17,6 +24,15 @@ var (
 	doctorRestartSessions bool
 	doctorNoStart         bool
 	doctorSlow            string
+	doctorWatch           bool
+	doctorInterval        time.Duration
+	doctorOnly            []string
+	doctorSkip            []string
+	doctorNoCache         bool
+	doctorGroups          bool
+	doctorJobs            int
+	doctorTimeout         time.Duration
+	doctorJSON            bool
 )
 
 var doctorCmd = &cobra.Command{
@@ -34,6 +50,7 @@ Workspace checks:
   - rigs-registry-exists     Check mayor/rigs.json exists (fixable)
   - rigs-registry-valid      Check registered rigs exist (fixable)
   - mayor-exists             Check mayor/ directory structure
+  - layout-version           Check the town layout matches this binary (see gt migrate)
 
 Town root protection:
   - town-git                 Verify town root is under version control
@@ -44,8 +61,16 @@ Infrastructure checks:
   - stale-binary             Check if gt binary is up to date with repo
   - beads-binary             Check that beads (bd) is installed and meets minimum version
   - daemon                   Check if daemon is running (fixable)
+  - daemon-heartbeat         Warn when the last daemon heartbeat is over 2x the interval old
   - boot-health              Check Boot watchdog health (vet mode)
   - town-beads-config        Verify town .beads/config.yaml exists (fixable)
+  - backup-freshness         Warn when the last good town backup is too old
+  - daemon-log-errors        Scan the last 24h of daemon log for panics and repeated failures
+  - telemetry-cardinality    Report metric attributes stripped by the cardinality guard
+  - mail-retention           Warn when a mailbox nears its mail retention cap
+  - env-drift                Report changes since 'gt doctor baseline save'
+  - config-secrets           Detect plaintext credentials in config and prime templates (fixable)
+  - doctor-settings          Check the doctor policy and cache TTLs in settings/config.json are valid
 
 Cleanup checks (fixable):
   - orphan-sessions          Detect orphaned tmux sessions
@@ -55,11 +80,16 @@ Cleanup checks (fixable):
   - misclassified-wisps      Detect issues that should be wisps (purges to wisps table, fixable)
   - jsonl-bloat              Detect stale/bloated issues.jsonl vs live database
   - stale-beads-redirect     Detect stale files in .beads directories with redirects
+  - cache-health             Prune corrupt, stale, or oversized prime/pre-warm caches
+  - stale-locks              Detect expired or orphaned town lock leases (fixable)
+  - roster-drift             Compare running agents with mayor/roster.json (fixable)
 
 Clone divergence checks:
   - persistent-role-branches Detect witness/refinery not on main (excludes crew)
   - clone-divergence         Detect clones significantly behind origin/main
   - default-branch-all-rigs  Verify default_branch exists on remote for all rigs
+  - branch-policy            Check existing branches against each rig's branch_policy
+  - repo-bloat               Detect large files and build artifacts on wisp branches
   - worktree-gitdir-valid    Verify worktree .git files reference existing paths (fixable)
 
 Crew workspace checks:
@@ -78,10 +108,12 @@ Rig checks (with --rig flag):
   - mayor-clone-exists       Verify mayor/rig/ clone exists (fixable)
   - polecat-clones-valid     Verify polecat directories are valid clones
   - beads-config-valid       Verify beads configuration (fixable)
+  - rig-env-profile          Validate per-rig session env profiles
 
 Routing checks (fixable):
   - routes-config            Check beads routing configuration
   - prefix-mismatch          Detect rigs.json vs routes.jsonl prefix mismatches (fixable)
+  - prefix-registry          Check route prefixes are in the town prefix registry (fixable)
   - database-prefix          Detect database vs routes.jsonl prefix mismatches (fixable)
 
 Lifecycle checks (fixable):
@@ -100,6 +132,8 @@ Dolt checks:
   - dolt-binary              Check that dolt is installed and meets minimum version
   - dolt-metadata            Check dolt metadata tables exist
   - dolt-server-reachable    Check dolt sql-server is reachable
+  - rig-dolt-servers         Check health of dedicated per-rig Dolt servers
+  - port-conflicts           Check service ports are free or held by the right process
   - dolt-orphaned-databases  Detect orphaned dolt databases
 
 Patrol checks:
@@ -107,11 +141,73 @@ Patrol checks:
   - patrol-hooks-wired       Verify daemon triggers patrols
   - patrol-not-stuck         Detect stale wisps (>1h)
   - patrol-plugins-accessible Verify plugin directories
+  - patrol-disabled          Report patrols paused with gt patrol disable
 
 Use --fix to attempt automatic fixes for issues that support it.
+Each fix's file writes and removals are staged and applied together: if
+one change fails, the changes already applied are rolled back and the
+output lists what was rolled back, what was not applied, and anything that
+could not be restored. Directory creation, cache pruning, formula
+provisioning and external commands (git, bd, tmux, stopping processes)
+take effect immediately and are not rolled back.
 Use --no-start with --fix to suppress starting the daemon and agents.
+"gt doctor autofix" applies only fixes graded safe, inside the change
+windows set in the doctor policy; the daemon runs it on a schedule.
+Fixes are serialized across concurrent doctor runs by a town-wide lock
+(.runtime/doctor-fix.lock); a fix that cannot get the lock within a few
+seconds is reported as "skipped: another doctor holds the lock".
+Fixes denied by a town policy rule (gt policy, decision point "fix") are
+skipped and the rule is named in the check's details.
 Use --rig to check a specific rig instead of the entire workspace.
-Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).`,
+Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).
+Use --only and --skip to run a subset of checks. Each takes a name glob
+(patrol-*), category=<name> (category=cleanup), group=<name>, or
+fixable=true|false, and may be repeated. "gt doctor checks list" shows every check with its
+category, fix capability, and typical duration.
+Related checks roll up into groups (composite checks) such as beads-health,
+git-health, patrol-health, and session-health. A group's status is the worst
+of its unacknowledged members. Use --groups to print the report one line per
+group, with failing members beneath, and --only group=<name> to run one
+group. Define custom groups with "groups" in the doctor policy (group name →
+check name globs), and mail the mayor when a group reaches a severity with
+"notify_groups" (e.g. {"beads-health": "error"}). "gt doctor checks groups"
+lists every group and its members.
+Slow checks (remote probes, Dolt queries across rigs) reuse a recent result
+for a few minutes; cached results are marked "(cached Xs ago)". Override a
+check's TTL with "cache_ttls" in the doctor policy ("0" disables caching;
+an invalid entry is ignored and fails doctor-settings), or use --no-cache to
+force every check to run fresh.
+Exit codes follow the town's severity policy (settings/config.json "doctor"):
+per-check severity overrides (info/warning/error/critical), a severity→exit
+code map, and which severities notify the mayor by mail. By default any
+error exits 1. An invalid policy does not stop the run: it falls back to
+the defaults and the doctor-settings check fails.
+
+Use "gt doctor ack <check>" to acknowledge a known finding so it is listed
+as acknowledged instead of failing (optionally until a date).
+
+Every run is recorded; use "gt doctor diff" to compare two runs and see
+which checks regressed and when.
+
+Checks run one at a time unless --jobs is set; checks that depend on
+another check start after it. With --timeout, a check that overruns it is
+reported as a warning and never fixed. --fix runs checks one at a time.
+
+Site checks: executables in mayor/doctor.d run as checks (category Custom),
+named after the file without its extension. Each prints a JSON object:
+  {"status": "ok|warning|error", "message": "...", "details": ["..."],
+   "fix_hint": "...", "fix_command": "...", "fixable": true}
+With "fixable": true, --fix runs the script with --fix and re-checks.
+Scripts get GT_TOWN_ROOT and GT_RIG; one that prints nothing passes if it
+exits 0.
+
+Use --json for machine-readable output (for CI and other tools): one JSON
+document with every check's name, category, status, severity, message,
+details, fix hint, duration, and whether it is fixable. The "schema" field
+versions the layout. The exit code is the same as for text output.
+
+Use --watch to rerun checks continuously (every --interval, or sooner when
+town config changes). Watch mode exits non-zero when a check transitions to error.`,
 	RunE: runDoctor,
 }
 
@@ -124,6 +220,17 @@ func init() {
 	doctorCmd.Flags().StringVar(&doctorSlow, "slow", "", "Highlight slow checks (optional threshold, default 1s)")
 	// Allow --slow without a value (uses default 1s)
 	doctorCmd.Flags().Lookup("slow").NoOptDefVal = "1s"
+	doctorCmd.Flags().BoolVarP(&doctorWatch, "watch", "w", false, "Watch mode: rerun checks continuously")
+	doctorCmd.Flags().DurationVarP(&doctorInterval, "interval", "n", 30*time.Second, "Refresh interval for --watch")
+	doctorCmd.Flags().StringSliceVar(&doctorOnly, "only", nil, "Run only matching checks (name glob, category=X, group=X, fixable=true)")
+	doctorCmd.Flags().StringSliceVar(&doctorSkip, "skip", nil, "Skip matching checks (name glob, category=X, group=X, fixable=true)")
+	doctorCmd.Flags().BoolVar(&doctorNoCache, "no-cache", false, "Ignore cached results and re-run every check")
+	doctorCmd.Flags().BoolVar(&doctorGroups, "groups", false, "Summarize the report by check group")
+	doctorCmd.Flags().IntVarP(&doctorJobs, "jobs", "j", 1, "Number of checks to run in parallel (1 = sequential)")
+	doctorCmd.Flags().DurationVar(&doctorTimeout, "timeout", 0, "Per-check time limit (0 = none)")
+	doctorCmd.Flags().BoolVar(&doctorJSON, "json", false, "Output results as JSON")
+	doctorCmd.MarkFlagsMutuallyExclusive("json", "watch")
+	doctorCmd.MarkFlagsMutuallyExclusive("json", "groups")
 	rootCmd.AddCommand(doctorCmd)
 }
 
@@ -143,11 +250,229 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 		NoStart:         doctorNoStart,
 	}
 
-	// Create doctor and register checks
+	// Invalid doctor settings must not stop the run that would report
+	// them: fall back to the defaults and let doctor-settings fail.
+	policy, err := doctor.LoadPolicy(townRoot)
+	if err != nil {
+		policy = doctor.DefaultPolicy()
+	}
+
+	acks, err := doctor.LoadAcks(townRoot)
+	if err != nil {
+		return err
+	}
+
+	d := newTownDoctor()
+	d.SetJobs(doctorJobs)
+	d.SetCheckTimeout(doctorTimeout)
+	if err := applyDoctorSelection(d, policy.Groups()); err != nil {
+		return err
+	}
+
+	cache := loadDoctorCache(townRoot)
+	d.SetCache(cache)
+
+	if doctorWatch {
+		return runDoctorWatch(ctx, d, policy, acks, cache)
+	}
+
+	// Parse slow threshold (0 = disabled)
+	var slowThreshold time.Duration
+	if doctorSlow != "" {
+		var err error
+		slowThreshold, err = time.ParseDuration(doctorSlow)
+		if err != nil {
+			return fmt.Errorf("invalid --slow duration %q: %w", doctorSlow, err)
+		}
+	}
+
+	// Run checks with streaming output; --groups prints the rollup and
+	// --json the full report instead
+	var out io.Writer = os.Stdout
+	if doctorGroups || doctorJSON {
+		out = io.Discard
+	}
+	if !doctorJSON {
+		fmt.Println() // Initial blank line
+	}
+	var report *doctor.Report
+	if doctorFix {
+		d.SetFixGate(doctorFixGate(townRoot, false))
+		report = d.FixStreaming(ctx, out, slowThreshold)
+	} else {
+		report = d.RunStreaming(ctx, out, slowThreshold)
+	}
+
+	// Remember check timings for "gt doctor checks list" (best-effort)
+	if durations, err := doctor.LoadDurations(townRoot); err == nil {
+		durations.Record(report)
+		_ = doctor.SaveDurations(townRoot, durations)
+	}
+	_ = doctor.SaveResultCache(townRoot, cache)
+
+	// Suppress acknowledged findings, then grade the rest by the town's
+	// severity policy before summarizing
+	acks.Apply(report, time.Now())
+	policy.Apply(report)
+
+	// Record the run for gt doctor diff (best-effort)
+	_ = doctor.AppendHistory(townRoot, doctor.NewHistoryRun(report, doctorRig))
+
+	if doctorJSON {
+		if err := report.PrintJSON(os.Stdout); err != nil {
+			return fmt.Errorf("writing JSON: %w", err)
+		}
+	} else {
+		if doctorGroups {
+			doctor.PrintGroups(os.Stdout, report, policy.Groups(), doctorVerbose)
+		}
+
+		// Print summary (checks were already printed during streaming)
+		report.PrintSummaryOnly(os.Stdout, doctorVerbose, slowThreshold)
+	}
+
+	notifyDoctorFindings(townRoot, policy.ToNotify(report))
+	syncDoctorInbox(townRoot, report)
+
+	// A fully green run of every check makes the current config the
+	// known-good reference for gt diff-config (best-effort)
+	if report.IsHealthy() && doctorRig == "" && len(doctorOnly) == 0 && len(doctorSkip) == 0 {
+		_ = doctor.SaveConfigSnapshot(townRoot)
+	}
+
+	// Exit code follows the most severe finding
+	if code := policy.ExitCode(report); code != 0 {
+		if !doctorJSON {
+			fmt.Fprintf(os.Stderr, "Error: doctor found %s-severity issue(s)\n", doctor.MaxSeverity(report))
+		}
+		return NewSilentExit(code)
+	}
+
+	return nil
+}
+
+// applyDoctorSelection narrows d to the checks selected by --only/--skip.
+func applyDoctorSelection(d *doctor.Doctor, groups map[string]doctor.Group) error {
+	if len(doctorOnly) == 0 && len(doctorSkip) == 0 {
+		return nil
+	}
+	only, skip, err := parseDoctorSelectors(doctorOnly, doctorSkip, groups)
+	if err != nil {
+		return err
+	}
+	d.Select(only, skip)
+	if len(d.Checks()) == 0 {
+		return fmt.Errorf("--only/--skip matched no checks (see gt doctor checks list)")
+	}
+	return nil
+}
+
+// parseDoctorSelectors parses --only/--skip expressions, resolving group
+// selectors against groups.
+func parseDoctorSelectors(onlyExprs, skipExprs []string, groups map[string]doctor.Group) (only, skip []doctor.Selector, err error) {
+	only, err = doctor.ParseSelectors(onlyExprs)
+	if err == nil {
+		err = doctor.ResolveGroups(only, groups)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid --only: %w", err)
+	}
+	skip, err = doctor.ParseSelectors(skipExprs)
+	if err == nil {
+		err = doctor.ResolveGroups(skip, groups)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid --skip: %w", err)
+	}
+	return only, skip, nil
+}
+
+// loadDoctorCache loads the town's check result cache with the policy's TTL
+// overrides. --no-cache starts from an empty cache, so every check runs fresh
+// but the results still refresh the cache for later runs.
+func loadDoctorCache(townRoot string) *doctor.ResultCache {
+	// Unreadable settings and bad TTLs are reported by doctor-settings; use
+	// the checks' own TTLs meanwhile.
+	var cfg *config.DoctorPolicyConfig
+	if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil {
+		cfg = settings.Doctor
+	}
+	cache := doctor.LoadResultCache(townRoot, cfg)
+	if doctorNoCache {
+		cache.Clear()
+	}
+	return cache
+}
+
+// syncDoctorInbox puts critical findings in the overseer inbox and clears
+// entries for checks that are no longer critical. Best-effort.
+func syncDoctorInbox(townRoot string, report *doctor.Report) {
+	for _, r := range report.Checks {
+		id := "dr-" + r.Name
+		if r.Severity != doctor.SeverityCritical {
+			_ = inbox.Resolve(townRoot, id)
+			continue
+		}
+		detail := r.FixHint
+		if detail != "" {
+			detail = "fix: " + detail
+		}
+		_ = inbox.Add(townRoot, &inbox.Item{
+			ID:     id,
+			Kind:   inbox.KindDoctor,
+			Title:  fmt.Sprintf("%s: %s", r.Name, r.Message),
+			Detail: detail,
+			From:   "doctor",
+			Ref:    r.Name,
+		})
+	}
+}
+
+// notifyDoctorFindings mails the mayor about findings the policy notifies on.
+// Delivery failures are reported but do not change the doctor result.
+func notifyDoctorFindings(townRoot string, findings []*doctor.CheckResult) {
+	if len(findings) == 0 {
+		return
+	}
+
+	var body strings.Builder
+	for _, f := range findings {
+		fmt.Fprintf(&body, "[%s] %s: %s\n", f.Severity, f.Name, f.Message)
+		if f.FixHint != "" {
+			fmt.Fprintf(&body, "    fix: %s\n", f.FixHint)
+		}
+	}
+
+	priority := mail.PriorityHigh
+	if findings[0].Severity == doctor.SeverityCritical {
+		priority = mail.PriorityUrgent
+	}
+
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	msg := &mail.Message{
+		From:     "doctor",
+		To:       "mayor/",
+		Subject:  fmt.Sprintf("DOCTOR: %d %s finding(s)", len(findings), findings[0].Severity),
+		Body:     body.String(),
+		Priority: priority,
+	}
+	held, err := sendOrHoldMail(townRoot, router, msg, findings[0].Severity == doctor.SeverityCritical)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s could not notify mayor: %v\n", style.WarningPrefix, err)
+	} else if held {
+		fmt.Fprintf(os.Stderr, "%s quiet hours: mayor notification held for the digest\n", style.Dim.Render(ui.Glyph("○")))
+	}
+}
+
+// newTownDoctor creates a doctor with the full check suite registered.
+// Rig-specific checks are included when --rig is set.
+func newTownDoctor() *doctor.Doctor {
 	d := doctor.NewDoctor()
 
 	// Register workspace-level checks first (fundamental)
 	d.RegisterAll(doctor.WorkspaceChecks()...)
+	d.Register(doctor.NewLayoutVersionCheck())
 
 	d.Register(doctor.NewGlobalStateCheck())
 
@@ -161,6 +486,8 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewBeadsBinaryCheck())
 	d.Register(doctor.NewDoltBinaryCheck())
 	d.Register(doctor.NewDoltServerReachableCheck())
+	d.Register(doctor.NewRigDoltServerCheck())
+	d.Register(doctor.NewPortConflictCheck())
 
 	d.Register(doctor.NewTownGitCheck())
 	d.Register(doctor.NewTownRootBranchCheck())
@@ -171,6 +498,7 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	// start with missing PATH exports. See gt-99u.
 	d.Register(doctor.NewClaudeSettingsCheck())
 	d.Register(doctor.NewDaemonCheck())
+	d.Register(doctor.NewDaemonHeartbeatCheck())
 	d.Register(doctor.NewTmuxGlobalEnvCheck())
 	d.Register(doctor.NewBootHealthCheck())
 	d.Register(doctor.NewTownBeadsConfigCheck())
@@ -180,6 +508,7 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewPrefixConflictCheck())
 	d.Register(doctor.NewRigNameMismatchCheck())
 	d.Register(doctor.NewPrefixMismatchCheck())
+	d.Register(doctor.NewPrefixRegistryCheck())
 	d.Register(doctor.NewDatabasePrefixCheck())
 	d.Register(doctor.NewRoutesCheck())
 	d.Register(doctor.NewRigRoutesJSONLCheck())
@@ -192,21 +521,33 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewCheckMisclassifiedWisps())
 	d.Register(doctor.NewCheckJSONLBloat())
 	d.Register(doctor.NewStaleBeadsRedirectCheck())
+	d.Register(doctor.NewCacheHealthCheck())
+	d.Register(doctor.NewStaleLocksCheck())
 	d.Register(doctor.NewBeadsRedirectTargetCheck())
 	d.Register(doctor.NewBranchCheck())
 	d.Register(doctor.NewCloneDivergenceCheck())
 	d.Register(doctor.NewDefaultBranchAllRigsCheck())
+	d.Register(doctor.NewBranchPolicyCheck())
+	d.Register(doctor.NewRepoBloatCheck())
 	d.Register(doctor.NewIdentityCollisionCheck())
+	d.Register(doctor.NewRosterDriftCheck())
 	d.Register(doctor.NewLinkedPaneCheck())
 	d.Register(doctor.NewThemeCheck())
 	d.Register(doctor.NewCrashReportCheck())
+	d.Register(doctor.NewBackupFreshnessCheck())
+	d.Register(doctor.NewDaemonLogCheck())
+	d.Register(doctor.NewTelemetryCardinalityCheck())
+	d.Register(doctor.NewMailRetentionCheck())
 	d.Register(doctor.NewEnvVarsCheck())
+	d.Register(doctor.NewDoctorSettingsCheck())
+	d.Register(doctor.NewDriftCheck())
 
 	// Patrol system checks
 	d.Register(doctor.NewPatrolMoleculesExistCheck())
 	d.Register(doctor.NewPatrolHooksWiredCheck())
 	d.Register(doctor.NewPatrolNotStuckCheck())
 	d.Register(doctor.NewPatrolPluginsAccessibleCheck())
+	d.Register(doctor.NewPatrolDisabledCheck())
 	d.Register(doctor.NewAgentBeadsCheck())
 	d.Register(doctor.NewStaleAgentBeadsCheck())
 	d.Register(doctor.NewRigBeadsCheck())
@@ -223,6 +564,8 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewDeprecatedMergeQueueKeysCheck())
 	d.Register(doctor.NewLandWorktreeGitignoreCheck())
 	d.Register(doctor.NewHooksPathAllRigsCheck())
+	d.Register(doctor.NewRigEnvProfileCheck())
+	d.Register(doctor.NewConfigSecretsCheck())
 
 	// Sparse checkout migration (runs across all rigs, not just --rig mode)
 	d.Register(doctor.NewSparseCheckoutCheck())
@@ -265,32 +608,32 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 		d.RegisterAll(doctor.RigChecks()...)
 	}
 
-	// Parse slow threshold (0 = disabled)
-	var slowThreshold time.Duration
-	if doctorSlow != "" {
-		var err error
-		slowThreshold, err = time.ParseDuration(doctorSlow)
-		if err != nil {
-			return fmt.Errorf("invalid --slow duration %q: %w", doctorSlow, err)
-		}
+	// Site checks from mayor/doctor.d run last
+	if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
+		registerExternalChecks(d, townRoot)
 	}
 
-	// Run checks with streaming output
-	fmt.Println() // Initial blank line
-	var report *doctor.Report
-	if doctorFix {
-		report = d.FixStreaming(ctx, os.Stdout, slowThreshold)
-	} else {
-		report = d.RunStreaming(ctx, os.Stdout, slowThreshold)
-	}
-
-	// Print summary (checks were already printed during streaming)
-	report.PrintSummaryOnly(os.Stdout, doctorVerbose, slowThreshold)
+	return d
+}
 
-	// Exit with error code if there are errors
-	if report.HasErrors() {
-		return fmt.Errorf("doctor found %d error(s)", report.Summary.Errors)
+// registerExternalChecks adds the executables in mayor/doctor.d as checks.
+// A script whose check name is already taken is skipped with a warning.
+func registerExternalChecks(d *doctor.Doctor, townRoot string) {
+	external, err := doctor.DiscoverExternalChecks(townRoot)
+	if err != nil {
+		style.PrintWarning("skipping site checks: %v", err)
+		return
+	}
+	taken := make(map[string]bool)
+	for _, c := range d.Checks() {
+		taken[c.Name()] = true
+	}
+	for _, c := range external {
+		if taken[c.Name()] {
+			style.PrintWarning("skipping %s: another check is named %q", c.Path(), c.Name())
+			continue
+		}
+		taken[c.Name()] = true
+		d.Register(c)
 	}
-
-	return nil
 }
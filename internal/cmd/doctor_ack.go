@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	doctorAckUntil  string
+	doctorAckReason string
+	doctorAckList   bool
+	doctorAckRemove bool
+)
+
+var doctorAckCmd = &cobra.Command{
+	Use:   "ack [check]",
+	Short: "Acknowledge a known doctor finding",
+	Long: `Acknowledge a known-broken doctor check so it shows as "acknowledged"
+instead of failing. Acknowledged checks are listed separately in the doctor
+summary and do not affect the exit code.
+
+Acknowledgements expire automatically when --until is set. --until accepts a
+date (2006-01-02), an RFC3339 timestamp, or a duration from now (48h, 7d).
+
+Examples:
+  gt doctor ack stale-binary --until 7d --reason "rebuilding after release"
+  gt doctor ack patrol-hooks-wired --reason "tracked in gt-abc12"
+  gt doctor ack --list
+  gt doctor ack --remove stale-binary`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runDoctorAck,
+}
+
+func init() {
+	doctorAckCmd.Flags().StringVar(&doctorAckUntil, "until", "", "Expiry (date, RFC3339 timestamp, or duration like 7d)")
+	doctorAckCmd.Flags().StringVar(&doctorAckReason, "reason", "", "Why the finding is acknowledged")
+	doctorAckCmd.Flags().BoolVar(&doctorAckList, "list", false, "List acknowledgements")
+	doctorAckCmd.Flags().BoolVar(&doctorAckRemove, "remove", false, "Remove the acknowledgement for a check")
+	doctorCmd.AddCommand(doctorAckCmd)
+}
+
+func runDoctorAck(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	store, err := doctor.LoadAcks(townRoot)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	if doctorAckList {
+		return printDoctorAcks(store, now)
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("check name required (see gt doctor --verbose for names)")
+	}
+	check := args[0]
+
+	if doctorAckRemove {
+		if !store.Remove(check) {
+			return fmt.Errorf("no acknowledgement for %q", check)
+		}
+		if err := doctor.SaveAcks(townRoot, store); err != nil {
+			return fmt.Errorf("saving acks: %w", err)
+		}
+		fmt.Printf("%s Removed acknowledgement for %s\n", style.SuccessPrefix, check)
+		return nil
+	}
+
+	ack := doctor.Ack{
+		Check:  check,
+		Reason: doctorAckReason,
+		By:     detectActor(),
+		At:     now.UTC(),
+	}
+	if doctorAckUntil != "" {
+		until, err := parseAckUntil(doctorAckUntil, now)
+		if err != nil {
+			return err
+		}
+		ack.Until = until.UTC()
+	}
+
+	pruned := store.Prune(now)
+	store.Add(ack)
+	if err := doctor.SaveAcks(townRoot, store); err != nil {
+		return fmt.Errorf("saving acks: %w", err)
+	}
+
+	fmt.Printf("%s Acknowledged %s", style.SuccessPrefix, style.Bold.Render(check))
+	if !ack.Until.IsZero() {
+		fmt.Printf(" until %s", ack.Until.Local().Format("2006-01-02 15:04"))
+	}
+	fmt.Println()
+	if pruned > 0 {
+		fmt.Printf("%s\n", style.Dim.Render(fmt.Sprintf("(pruned %d expired acknowledgement(s))", pruned)))
+	}
+	return nil
+}
+
+// parseAckUntil parses --until as a date, RFC3339 timestamp, or duration.
+func parseAckUntil(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, nil
+	}
+	d, err := parseDuration(s)
+	if err != nil || d <= 0 {
+		return time.Time{}, fmt.Errorf("invalid --until %q (want 2006-01-02, RFC3339, or a duration like 7d)", s)
+	}
+	return now.Add(d), nil
+}
+
+// printDoctorAcks lists active and expired acknowledgements.
+func printDoctorAcks(store *doctor.AckStore, now time.Time) error {
+	if len(store.Acks) == 0 {
+		fmt.Println("No acknowledgements.")
+		return nil
+	}
+	for _, a := range store.Acks {
+		status := style.Success.Render("active ")
+		if a.Expired(now) {
+			status = style.Dim.Render("expired")
+		}
+		expiry := "no expiry"
+		if !a.Until.IsZero() {
+			expiry = "until " + a.Until.Local().Format("2006-01-02 15:04")
+		}
+		fmt.Printf("  %s  %s %s\n", status, style.Bold.Render(a.Check), style.Dim.Render("("+expiry+")"))
+		if a.Reason != "" {
+			fmt.Printf("           %s\n", a.Reason)
+		}
+		if a.By != "" {
+			fmt.Printf("           %s\n", style.Dim.Render("by "+a.By+" at "+a.At.Local().Format("2006-01-02 15:04")))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	doctorAutofixDryRun bool
+	doctorAutofixForce  bool
+)
+
+var doctorAutofixCmd = &cobra.Command{
+	Use:   "autofix",
+	Short: "Apply safe doctor fixes inside the town's change windows",
+	Long: `Run the doctor checks whose fixes are graded safe and fix what they find,
+but only inside a configured change window. The daemon runs this on a
+schedule when autofix is enabled.
+
+Safe fixes only add missing local configuration or clear caches and
+orphaned state: they never restart agents, rewrite git history, or delete
+work. "gt doctor checks list" marks them; every other fix still needs
+gt doctor --fix.
+
+Every applied fix is recorded in the audit log (.events.jsonl, type
+doctor_autofix), and the notify list (default: overseer) is mailed a
+summary of what changed.
+
+Configure it in settings/config.json:
+
+  "doctor": {
+    "autofix": {
+      "enabled": true,
+      "interval": "1h",
+      "timezone": "America/New_York",
+      "windows": [{"days": ["sat", "sun"], "start": "02:00", "end": "05:00"}],
+      "checks": ["stale-locks", "cache-health"],
+      "notify": ["overseer"]
+    }
+  }
+
+Examples:
+  gt doctor autofix --dry-run   # What would be fixed now
+  gt doctor autofix --force     # Fix now, ignoring windows and enabled`,
+	Args: cobra.NoArgs,
+	RunE: runDoctorAutofix,
+}
+
+func init() {
+	doctorAutofixCmd.Flags().BoolVar(&doctorAutofixDryRun, "dry-run", false, "Show what would be fixed without changing anything")
+	doctorAutofixCmd.Flags().BoolVar(&doctorAutofixForce, "force", false, "Run even when autofix is disabled or outside a change window")
+	doctorCmd.AddCommand(doctorAutofixCmd)
+}
+
+// autofixResult is a check result from an autofix run, with its rig.
+type autofixResult struct {
+	Rig string
+	*doctor.CheckResult
+}
+
+func (r autofixResult) label() string {
+	if r.Rig == "" {
+		return r.Name
+	}
+	return r.Name + " (" + r.Rig + ")"
+}
+
+func runDoctorAutofix(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := doctor.LoadAutofixPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	if !doctorAutofixForce {
+		if !policy.Enabled() {
+			fmt.Println("Doctor autofix is not enabled (settings/config.json doctor.autofix).")
+			return nil
+		}
+		if !policy.InWindow(time.Now()) {
+			fmt.Println("Outside the autofix change windows; nothing applied.")
+			return nil
+		}
+	}
+
+	results, err := runAutofixChecks(townRoot, policy, doctorAutofixDryRun)
+	if err != nil {
+		return err
+	}
+	var applied, remaining []autofixResult
+	for _, r := range results {
+		switch {
+		case r.Fixed:
+			applied = append(applied, r)
+		case r.Status != doctor.StatusOK:
+			remaining = append(remaining, r)
+		}
+	}
+
+	if doctorAutofixDryRun {
+		if len(remaining) == 0 {
+			fmt.Println("Nothing to fix.")
+		}
+		for _, r := range remaining {
+			fmt.Printf("Would fix %s: %s\n", r.label(), r.Message)
+		}
+		return nil
+	}
+
+	for _, r := range applied {
+		_ = events.LogAudit(events.TypeDoctorAutofix, "doctor", map[string]interface{}{
+			"check":   r.Name,
+			"rig":     r.Rig,
+			"message": r.Message,
+			"details": r.Details,
+		})
+		fmt.Printf("%s fixed %s: %s\n", style.SuccessPrefix, r.label(), r.Message)
+	}
+	for _, r := range remaining {
+		fmt.Printf("%s %s still failing: %s\n", style.WarningPrefix, r.label(), r.Message)
+	}
+	if len(applied) == 0 {
+		fmt.Println("Nothing needed fixing.")
+		return nil
+	}
+	return notifyAutofix(townRoot, policy.Notify(), applied, remaining)
+}
+
+// runAutofixChecks runs the town checks and each rig's checks that the
+// policy allows to autofix, fixing what they find unless dryRun.
+func runAutofixChecks(townRoot string, policy *doctor.AutofixPolicy, dryRun bool) ([]autofixResult, error) {
+	run := func(d *doctor.Doctor, rig string) []autofixResult {
+		d.SelectAutofix(policy)
+		if len(d.Checks()) == 0 {
+			return nil
+		}
+		ctx := &doctor.CheckContext{TownRoot: townRoot, RigName: rig, NoStart: true}
+		var report *doctor.Report
+		if dryRun {
+			report = d.Run(ctx)
+		} else {
+			d.SetFixGate(doctorFixGate(townRoot, true))
+			report = d.Fix(ctx)
+		}
+		var out []autofixResult
+		for _, r := range report.Checks {
+			out = append(out, autofixResult{Rig: rig, CheckResult: r})
+		}
+		return out
+	}
+
+	results := run(newTownDoctor(), "")
+
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("loading rigs config: %w", err)
+	}
+	if rigsConfig != nil {
+		rigs := make([]string, 0, len(rigsConfig.Rigs))
+		for name := range rigsConfig.Rigs {
+			rigs = append(rigs, name)
+		}
+		sort.Strings(rigs)
+		for _, rig := range rigs {
+			d := doctor.NewDoctor()
+			d.RegisterAll(doctor.RigChecks()...)
+			results = append(results, run(d, rig)...)
+		}
+	}
+	return results, nil
+}
+
+// notifyAutofix mails the notify list a summary of the applied fixes.
+func notifyAutofix(townRoot string, notify []string, applied, remaining []autofixResult) error {
+	var body strings.Builder
+	fmt.Fprintf(&body, "Doctor autofix applied %d fix(es) during a change window:\n\n", len(applied))
+	for _, r := range applied {
+		fmt.Fprintf(&body, "- %s: %s\n", r.label(), r.Message)
+		for _, d := range r.Details {
+			fmt.Fprintf(&body, "    %s\n", d)
+		}
+	}
+	if len(remaining) > 0 {
+		fmt.Fprintf(&body, "\nStill failing after autofix:\n\n")
+		for _, r := range remaining {
+			fmt.Fprintf(&body, "- %s: %s\n", r.label(), r.Message)
+		}
+	}
+	fmt.Fprintf(&body, "\nEach fix is in the audit log as a doctor_autofix event.\n")
+
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	var sendErrs []error
+	for _, to := range notify {
+		if _, err := sendOrHoldMail(townRoot, router, &mail.Message{
+			From:     "doctor",
+			To:       to,
+			Subject:  fmt.Sprintf("DOCTOR AUTOFIX: %d fix(es) applied", len(applied)),
+			Body:     body.String(),
+			Priority: mail.PriorityNormal,
+		}, false); err != nil {
+			sendErrs = append(sendErrs, fmt.Errorf("%s: %w", to, err))
+		}
+	}
+	if len(sendErrs) > 0 {
+		return fmt.Errorf("sending autofix summary: %w", errors.Join(sendErrs...))
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var doctorBaselineCmd = &cobra.Command{
+	Use:   "baseline",
+	Short: "Record or show the known-good environment baseline",
+	Long: `Record the current environment as known-good so the env-drift doctor
+check can report what changed since: binary versions (gt, bd, dolt, git,
+tmux), hashes of the town and rig config files, and hashes of the hook
+settings files.
+
+Save a baseline while the town is healthy. When something later breaks,
+gt doctor lists every difference, answering "what changed since it worked?"
+
+Examples:
+  gt doctor baseline save
+  gt doctor baseline show`,
+	RunE: requireSubcommand,
+}
+
+var doctorBaselineSaveCmd = &cobra.Command{
+	Use:   "save",
+	Short: "Record the current environment as the baseline",
+	Args:  cobra.NoArgs,
+	RunE:  runDoctorBaselineSave,
+}
+
+var doctorBaselineShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the saved baseline",
+	Args:  cobra.NoArgs,
+	RunE:  runDoctorBaselineShow,
+}
+
+func init() {
+	doctorBaselineCmd.AddCommand(doctorBaselineSaveCmd)
+	doctorBaselineCmd.AddCommand(doctorBaselineShowCmd)
+	doctorCmd.AddCommand(doctorBaselineCmd)
+}
+
+func runDoctorBaselineSave(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	b := doctor.CaptureBaseline(townRoot)
+	b.SavedBy = detectActor()
+	if err := doctor.SaveBaseline(townRoot, b); err != nil {
+		return fmt.Errorf("saving baseline: %w", err)
+	}
+	fmt.Printf("%s Saved baseline: %d binaries, %d config files, %d hook targets\n",
+		style.SuccessPrefix, len(b.Binaries), len(b.Configs), len(b.Hooks))
+	return nil
+}
+
+func runDoctorBaselineShow(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	b, err := doctor.LoadBaseline(townRoot)
+	if err != nil {
+		return err
+	}
+	if b == nil {
+		fmt.Println("No baseline saved. Run 'gt doctor baseline save' while the town is healthy.")
+		return nil
+	}
+
+	fmt.Printf("Baseline saved %s", b.SavedAt.Local().Format("2006-01-02 15:04"))
+	if b.SavedBy != "" {
+		fmt.Printf(" by %s", b.SavedBy)
+	}
+	fmt.Println()
+	for _, section := range []struct {
+		title  string
+		values map[string]string
+	}{
+		{"Binaries", b.Binaries},
+		{"Config files", b.Configs},
+		{"Hook targets", b.Hooks},
+	} {
+		fmt.Printf("\n%s\n", style.Bold.Render(section.title))
+		names := make([]string, 0, len(section.values))
+		for name := range section.values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("  %-40s %s\n", name, style.Dim.Render(section.values[name]))
+		}
+	}
+	return nil
+}
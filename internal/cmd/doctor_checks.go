@@ -0,0 +1,295 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	doctorChecksOnly []string
+	doctorChecksSkip []string
+	doctorChecksJSON bool
+)
+
+var doctorChecksCmd = &cobra.Command{
+	Use:   "checks",
+	Short: "List and describe registered doctor checks",
+	RunE:  requireSubcommand,
+}
+
+var doctorChecksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List every doctor check",
+	Long: `List every registered doctor check with its category, whether it can
+fix what it finds ("safe" when gt doctor autofix may apply the fix
+unattended), and its typical duration (a moving average of recent
+gt doctor runs; "-" until the check has run).
+
+Rig checks only run with gt doctor --rig and are marked RIG.
+
+--only and --skip use the same selectors as gt doctor, so a filter can be
+previewed before it is run.
+
+Examples:
+  gt doctor checks list
+  gt doctor checks list --only category=cleanup
+  gt doctor checks list --only fixable=true --skip 'dolt-*'
+  gt doctor checks list --only group=beads-health
+  gt doctor checks list --json`,
+	Args: cobra.NoArgs,
+	RunE: runDoctorChecksList,
+}
+
+var doctorChecksGroupsCmd = &cobra.Command{
+	Use:   "groups",
+	Short: "List check groups and their members",
+	Long: `List the check groups (composite checks) that gt doctor --groups rolls
+results up into, with the registered checks each one contains.
+
+Built-in groups can be replaced, and custom groups added, with "groups" in
+the doctor policy in settings/config.json:
+
+  "doctor": {
+    "groups": {"merge-health": ["refinery-*", "branch-policy"]},
+    "notify_groups": {"beads-health": "error"}
+  }
+
+Examples:
+  gt doctor checks groups
+  gt doctor checks groups --json`,
+	Args: cobra.NoArgs,
+	RunE: runDoctorChecksGroups,
+}
+
+var doctorChecksDescribeCmd = &cobra.Command{
+	Use:   "describe <check>",
+	Short: "Describe one doctor check",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runDoctorChecksDescribe,
+}
+
+func init() {
+	doctorChecksListCmd.Flags().StringSliceVar(&doctorChecksOnly, "only", nil, "Only matching checks (name glob, category=X, fixable=true)")
+	doctorChecksListCmd.Flags().StringSliceVar(&doctorChecksSkip, "skip", nil, "Omit matching checks")
+	doctorChecksListCmd.Flags().BoolVar(&doctorChecksJSON, "json", false, "Output as JSON")
+	doctorChecksDescribeCmd.Flags().BoolVar(&doctorChecksJSON, "json", false, "Output as JSON")
+	doctorChecksGroupsCmd.Flags().BoolVar(&doctorChecksJSON, "json", false, "Output as JSON")
+
+	doctorChecksCmd.AddCommand(doctorChecksListCmd)
+	doctorChecksCmd.AddCommand(doctorChecksDescribeCmd)
+	doctorChecksCmd.AddCommand(doctorChecksGroupsCmd)
+	doctorCmd.AddCommand(doctorChecksCmd)
+}
+
+// DoctorCheckInfo describes a registered doctor check.
+type DoctorCheckInfo struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Category    string        `json:"category,omitempty"`
+	Fixable     bool          `json:"fixable"`
+	FixSafety   string        `json:"fix_safety,omitempty"`
+	RigOnly     bool          `json:"rig_only,omitempty"`
+	Typical     time.Duration `json:"typical_ns,omitempty"`
+}
+
+// doctorCheckCatalog returns every town and rig check in registration
+// order, with the checks themselves for selector matching.
+func doctorCheckCatalog(townRoot string) ([]DoctorCheckInfo, []doctor.Check) {
+	durations, err := doctor.LoadDurations(townRoot)
+	if err != nil {
+		durations = &doctor.Durations{}
+	}
+
+	// Build the town suite without --rig so rig checks are listed once,
+	// explicitly marked.
+	savedRig := doctorRig
+	doctorRig = ""
+	townChecks := newTownDoctor().Checks()
+	doctorRig = savedRig
+
+	var infos []DoctorCheckInfo
+	var checks []doctor.Check
+	add := func(cs []doctor.Check, rigOnly bool) {
+		for _, c := range cs {
+			infos = append(infos, DoctorCheckInfo{
+				Name:        c.Name(),
+				Description: c.Description(),
+				Category:    doctor.CheckCategory(c),
+				Fixable:     c.CanFix(),
+				FixSafety:   string(doctor.CheckFixSafety(c)),
+				RigOnly:     rigOnly,
+				Typical:     durations.Typical(c.Name()),
+			})
+			checks = append(checks, c)
+		}
+	}
+	add(townChecks, false)
+	add(doctor.RigChecks(), true)
+	return infos, checks
+}
+
+func runDoctorChecksList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := doctor.LoadPolicy(townRoot)
+	if err != nil {
+		return fmt.Errorf("loading doctor policy: %w", err)
+	}
+	only, skip, err := parseDoctorSelectors(doctorChecksOnly, doctorChecksSkip, policy.Groups())
+	if err != nil {
+		return err
+	}
+
+	all, checks := doctorCheckCatalog(townRoot)
+	var infos []DoctorCheckInfo
+	for i, info := range all {
+		if doctor.Selected(checks[i], only, skip) {
+			infos = append(infos, info)
+		}
+	}
+
+	if doctorChecksJSON {
+		if infos == nil {
+			infos = []DoctorCheckInfo{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(infos)
+	}
+	if len(infos) == 0 {
+		fmt.Println("No checks match.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tCATEGORY\tFIX\tTYPICAL\tDESCRIPTION")
+	for _, info := range infos {
+		fix := ""
+		if info.Fixable {
+			fix = "yes"
+		}
+		if info.FixSafety == string(doctor.FixSafe) {
+			fix = "safe"
+		}
+		category := info.Category
+		if info.RigOnly {
+			category += " (RIG)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", info.Name, category, fix, doctorTypical(info.Typical), info.Description)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\n%s\n", style.Dim.Render(fmt.Sprintf("%d checks. Run a subset with gt doctor --only/--skip.", len(infos))))
+	return nil
+}
+
+func runDoctorChecksDescribe(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	infos, _ := doctorCheckCatalog(townRoot)
+	for _, info := range infos {
+		if info.Name != args[0] {
+			continue
+		}
+		if doctorChecksJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(info)
+		}
+		fmt.Printf("%s\n", style.Bold.Render(info.Name))
+		fmt.Printf("  %s\n\n", info.Description)
+		fmt.Printf("  Category:  %s\n", info.Category)
+		if info.FixSafety == string(doctor.FixSafe) {
+			fmt.Printf("  Fixable:   yes, safe for gt doctor autofix (gt doctor --fix --only %s)\n", info.Name)
+		} else if info.Fixable {
+			fmt.Printf("  Fixable:   yes (gt doctor --fix --only %s)\n", info.Name)
+		} else {
+			fmt.Printf("  Fixable:   no\n")
+		}
+		fmt.Printf("  Typical:   %s\n", doctorTypical(info.Typical))
+		if info.RigOnly {
+			fmt.Printf("  Scope:     rig (gt doctor --rig <rig> --only %s)\n", info.Name)
+		} else {
+			fmt.Printf("  Scope:     town (gt doctor --only %s)\n", info.Name)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown check %q (see gt doctor checks list)", args[0])
+}
+
+// DoctorGroupInfo describes a check group and the registered checks in it.
+type DoctorGroupInfo struct {
+	doctor.Group
+	Checks []string `json:"checks"`
+}
+
+func runDoctorChecksGroups(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := doctor.LoadPolicy(townRoot)
+	if err != nil {
+		return fmt.Errorf("loading doctor policy: %w", err)
+	}
+	groups := policy.Groups()
+	catalog, _ := doctorCheckCatalog(townRoot)
+
+	var infos []DoctorGroupInfo
+	for _, name := range doctor.GroupNames(groups) {
+		info := DoctorGroupInfo{Group: groups[name], Checks: []string{}}
+		for _, c := range catalog {
+			if info.Contains(c.Name) {
+				info.Checks = append(info.Checks, c.Name)
+			}
+		}
+		infos = append(infos, info)
+	}
+
+	if doctorChecksJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(infos)
+	}
+	for _, info := range infos {
+		fmt.Printf("%s\n", style.Bold.Render(info.Name))
+		if info.Description != "" {
+			fmt.Printf("  %s\n", style.Dim.Render(info.Description))
+		}
+		if len(info.Checks) == 0 {
+			fmt.Printf("  %s\n", style.Dim.Render("(no registered checks match: "+strings.Join(info.Members, ", ")+")"))
+		} else {
+			fmt.Printf("  %s\n", strings.Join(info.Checks, ", "))
+		}
+	}
+	return nil
+}
+
+// doctorTypical formats a typical check duration.
+func doctorTypical(d time.Duration) string {
+	switch {
+	case d == 0:
+		return "-"
+	case d < time.Millisecond:
+		return "<1ms"
+	case d < time.Second:
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	default:
+		return fmt.Sprintf("%.1fs", d.Seconds())
+	}
+}
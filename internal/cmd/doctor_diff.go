@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	doctorDiffList bool
+	doctorDiffJSON bool
+)
+
+var doctorDiffCmd = &cobra.Command{
+	Use:   "diff [run-a] [run-b]",
+	Short: "Compare two recorded doctor runs",
+	Long: `Compare two doctor runs from the run history and show what changed:
+checks whose status changed, checks whose message or details changed, and
+checks that started or stopped running. Each regression shows the run it
+first appeared in, so "what broke between yesterday and today" is one
+command.
+
+Every gt doctor run is recorded in .runtime/doctor-history.jsonl (the last
+200 runs). A run is named by:
+  latest, latest~N   the newest run, or the Nth run before it
+  <id>               a run ID or unique prefix (see --list)
+  2006-01-02         the last run on that day
+  24h, 7d            the last run at least that long ago
+
+run-a defaults to latest~1 and run-b to latest.
+
+Examples:
+  gt doctor diff                       # Last run vs the one before
+  gt doctor diff 1d latest             # Since a day ago
+  gt doctor diff 2026-03-01 2026-03-02
+  gt doctor diff --list`,
+	Args: cobra.MaximumNArgs(2),
+	RunE: runDoctorDiff,
+}
+
+func init() {
+	doctorDiffCmd.Flags().BoolVar(&doctorDiffList, "list", false, "List recorded runs")
+	doctorDiffCmd.Flags().BoolVar(&doctorDiffJSON, "json", false, "Output as JSON")
+	doctorCmd.AddCommand(doctorDiffCmd)
+}
+
+func runDoctorDiff(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	runs, err := doctor.LoadHistory(townRoot)
+	if err != nil {
+		return err
+	}
+	if doctorDiffList {
+		return printDoctorHistory(runs)
+	}
+
+	refA, refB := "latest~1", "latest"
+	if len(args) > 0 {
+		refA = args[0]
+	}
+	if len(args) > 1 {
+		refB = args[1]
+	}
+	now := time.Now()
+	a, err := resolveDoctorRun(runs, refA, now)
+	if err != nil {
+		return err
+	}
+	b, err := resolveDoctorRun(runs, refB, now)
+	if err != nil {
+		return err
+	}
+	if b.Timestamp.Before(a.Timestamp) {
+		a, b = b, a
+	}
+	diffs := doctor.DiffHistoryRuns(runs, a, b)
+
+	if doctorDiffJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			From    string             `json:"from"`
+			To      string             `json:"to"`
+			Changes []doctor.CheckDiff `json:"changes"`
+		}{a.ID, b.ID, diffs})
+	}
+
+	fmt.Printf("%s %s → %s\n", style.Bold.Render("Doctor diff"), doctorRunLabel(a), doctorRunLabel(b))
+	if a == b {
+		fmt.Printf("  %s\n", style.Dim.Render("(same run)"))
+		return nil
+	}
+	if a.Rig != b.Rig {
+		style.PrintWarning("comparing runs with different scopes (--rig %q vs %q)", a.Rig, b.Rig)
+	}
+	if len(diffs) == 0 {
+		fmt.Printf("\n%s No changes\n", style.SuccessPrefix)
+		return nil
+	}
+
+	fmt.Println()
+	regressions := 0
+	for _, d := range diffs {
+		if d.Regressed {
+			regressions++
+		}
+		printCheckDiff(d)
+	}
+	fmt.Printf("\n%d changed, %d regressed\n", len(diffs), regressions)
+	return nil
+}
+
+// resolveDoctorRun resolves a run reference: a history reference, a date,
+// or a duration ago.
+func resolveDoctorRun(runs []*doctor.HistoryRun, ref string, now time.Time) (*doctor.HistoryRun, error) {
+	run, err := doctor.FindHistoryRun(runs, ref)
+	if err == nil || len(runs) == 0 {
+		return run, err
+	}
+	if day, perr := time.ParseInLocation("2006-01-02", ref, time.Local); perr == nil {
+		return doctor.HistoryRunAt(runs, day.AddDate(0, 0, 1).Add(-time.Nanosecond))
+	}
+	if d, perr := parseDuration(ref); perr == nil && d > 0 {
+		return doctor.HistoryRunAt(runs, now.Add(-d))
+	}
+	return nil, err
+}
+
+func doctorRunLabel(r *doctor.HistoryRun) string {
+	label := r.ID + " " + style.Dim.Render("("+r.Timestamp.Local().Format("2006-01-02 15:04")+")")
+	if r.Rig != "" {
+		label += " " + style.Dim.Render("[rig "+r.Rig+"]")
+	}
+	return label
+}
+
+func doctorStatusLabel(c *doctor.HistoryCheck) string {
+	if c == nil {
+		return style.Dim.Render("not run")
+	}
+	label := strings.ToLower(c.Status.String())
+	switch c.Status {
+	case doctor.StatusError:
+		label = style.Error.Render(label)
+	case doctor.StatusWarning:
+		label = style.Warning.Render(label)
+	default:
+		label = style.Success.Render(label)
+	}
+	if c.Acknowledged {
+		label += style.Dim.Render(" (acked)")
+	}
+	return label
+}
+
+func printCheckDiff(d doctor.CheckDiff) {
+	marker := " "
+	if d.Regressed {
+		marker = style.Error.Render("↓")
+	} else if d.StatusChanged && d.From != nil && d.To != nil && d.To.Status < d.From.Status {
+		marker = style.Success.Render("↑")
+	}
+	status := doctorStatusLabel(d.To)
+	if d.StatusChanged {
+		status = doctorStatusLabel(d.From) + " → " + doctorStatusLabel(d.To)
+	}
+	fmt.Printf("%s %s  %s\n", marker, style.Bold.Render(d.Name), status)
+	if d.Regressed && d.FirstSeen != "" {
+		fmt.Printf("    %s\n", style.Dim.Render("first seen in "+d.FirstSeen+" ("+d.FirstAt.Local().Format("2006-01-02 15:04")+")"))
+	}
+	if d.MessageChanged {
+		fmt.Printf("    - %s\n", d.From.Message)
+		fmt.Printf("    + %s\n", d.To.Message)
+	} else if d.StatusChanged && d.To != nil && d.To.Message != "" {
+		fmt.Printf("    %s\n", d.To.Message)
+	}
+	for _, s := range d.RemovedDetails {
+		fmt.Printf("    %s %s\n", style.Dim.Render("- detail:"), s)
+	}
+	for _, s := range d.AddedDetails {
+		fmt.Printf("    %s %s\n", style.Dim.Render("+ detail:"), s)
+	}
+}
+
+func printDoctorHistory(runs []*doctor.HistoryRun) error {
+	if doctorDiffJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(runs)
+	}
+	if len(runs) == 0 {
+		fmt.Println("No doctor runs recorded yet. Run 'gt doctor' to record one.")
+		return nil
+	}
+	for i := len(runs) - 1; i >= 0; i-- {
+		r := runs[i]
+		var warnings, errors int
+		for _, c := range r.Checks {
+			switch c.Status {
+			case doctor.StatusWarning:
+				warnings++
+			case doctor.StatusError:
+				errors++
+			}
+		}
+		fmt.Printf("  %s  %d checks, %d warnings, %d errors\n", doctorRunLabel(r), len(r.Checks), warnings, errors)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
+	"golang.org/x/term"
+)
+
+// doctorWatchDebounce coalesces bursts of config writes into one rerun.
+const doctorWatchDebounce = 500 * time.Millisecond
+
+// doctorWatchDirs are the town directories whose config files trigger an
+// early rerun in watch mode. Only direct children are watched.
+var doctorWatchDirs = []string{"mayor", "settings"}
+
+// runDoctorWatch reruns the check suite every interval (or sooner when town
+// config changes) and renders the non-OK checks with status changes since
+// the previous run. Returns an error as soon as a check transitions to error.
+// A config change drops cached results so the rerun reflects it.
+func runDoctorWatch(ctx *doctor.CheckContext, d *doctor.Doctor, policy *doctor.Policy, acks *doctor.AckStore, cache *doctor.ResultCache) error {
+	if doctorFix {
+		return fmt.Errorf("--fix and --watch cannot be used together")
+	}
+	if doctorInterval <= 0 {
+		return fmt.Errorf("--interval must be positive, got %s", doctorInterval)
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	ticker := time.NewTicker(doctorInterval)
+	defer ticker.Stop()
+
+	changes, stopWatcher := watchTownConfig(ctx.TownRoot)
+	defer stopWatcher()
+
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+
+	var prev *doctor.Report
+	for {
+		report := d.Run(ctx)
+		_ = doctor.SaveResultCache(ctx.TownRoot, cache)
+		acks.Apply(report, time.Now())
+		policy.Apply(report)
+		transitions := doctor.DiffReports(prev, report)
+
+		var buf bytes.Buffer
+		if isTTY {
+			buf.WriteString("\033[H\033[2J") // ANSI: cursor home + clear screen
+		}
+		header := fmt.Sprintf("[%s] gt doctor --watch (every %s, Ctrl+C to stop)",
+			time.Now().Format("15:04:05"), doctorInterval)
+		if isTTY {
+			fmt.Fprintf(&buf, "%s\n\n", style.Dim.Render(header))
+		} else {
+			fmt.Fprintf(&buf, "%s\n\n", header)
+		}
+		doctor.PrintWatchFrame(&buf, report, transitions)
+		_, _ = os.Stdout.Write(buf.Bytes())
+
+		if newErrors := doctor.NewErrors(transitions); len(newErrors) > 0 {
+			names := make([]string, len(newErrors))
+			for i, t := range newErrors {
+				names[i] = t.Name
+			}
+			return fmt.Errorf("check(s) transitioned to error: %s", strings.Join(names, ", "))
+		}
+		prev = report
+
+		select {
+		case <-sigChan:
+			if isTTY {
+				fmt.Println("\nStopped.")
+			}
+			return nil
+		case <-ticker.C:
+		case <-changes:
+			cache.Clear()
+			ticker.Reset(doctorInterval)
+		}
+	}
+}
+
+// watchTownConfig returns a channel that receives (debounced) when a JSON
+// config file in one of doctorWatchDirs changes. If fsnotify is unavailable
+// the channel never fires and watch mode falls back to the interval.
+func watchTownConfig(townRoot string) (<-chan struct{}, func()) {
+	out := make(chan struct{}, 1)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return out, func() {}
+	}
+	for _, dir := range doctorWatchDirs {
+		_ = watcher.Add(filepath.Join(townRoot, dir))
+	}
+
+	done := make(chan struct{})
+	go func() {
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-done:
+				return
+			case ev, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Ext(ev.Name) == ".json" {
+					debounce = time.After(doctorWatchDebounce)
+				}
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			case <-debounce:
+				debounce = nil
+				select {
+				case out <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	return out, func() {
+		close(done)
+		_ = watcher.Close()
+	}
+}
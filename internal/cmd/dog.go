@@ -17,6 +17,7 @@ import (
 	"github.com/steveyegge/gastown/internal/plugin"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -333,7 +334,7 @@ func runDogAdd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("adding dog %s: %w", name, err)
 	}
 
-	fmt.Printf("✓ Created dog %s in kennel\n", style.Bold.Render(name))
+	fmt.Printf("%s Created dog %s in kennel\n", ui.Glyph(ui.IconPass), style.Bold.Render(name))
 	fmt.Printf("  Path: %s\n", d.Path)
 	fmt.Printf("  Worktrees:\n")
 	for rigName, path := range d.Worktrees {
@@ -409,7 +410,7 @@ func runDogRemove(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
-		fmt.Printf("✓ Removed dog %s\n", name)
+		fmt.Printf("%s Removed dog %s\n", ui.Glyph(ui.IconPass), name)
 		removed++
 
 		// Reset agent bead for the dog (preserves persistent identity)
@@ -429,7 +430,7 @@ func runDogRemove(cmd *cobra.Command, args []string) error {
 	}
 
 	if removed > 0 {
-		fmt.Printf("\n✓ Removed %d dog(s).\n", removed)
+		fmt.Printf("\n%s Removed %d dog(s).\n", ui.Glyph(ui.IconPass), removed)
 	}
 
 	if len(removeErrors) > 0 {
@@ -498,10 +499,10 @@ func runDogList(cmd *cobra.Command, args []string) error {
 	workingCount := 0
 
 	for _, d := range dogs {
-		stateIcon := "○"
+		stateIcon := ui.Glyph("○")
 		stateStyle := style.Dim
 		if d.State == dog.StateWorking {
-			stateIcon = "●"
+			stateIcon = ui.Glyph("●")
 			stateStyle = style.Bold
 			workingCount++
 		} else {
@@ -510,7 +511,7 @@ func runDogList(cmd *cobra.Command, args []string) error {
 
 		line := fmt.Sprintf("  %s %s", stateIcon, stateStyle.Render(d.Name))
 		if d.Work != "" {
-			line += fmt.Sprintf(" → %s", style.Dim.Render(d.Work))
+			line += fmt.Sprintf(" %s %s", ui.Glyph("→"), style.Dim.Render(d.Work))
 		}
 		fmt.Println(line)
 	}
@@ -542,7 +543,7 @@ func runDogCall(cmd *cobra.Command, args []string) error {
 					continue
 				}
 				woken++
-				fmt.Printf("✓ Called %s\n", d.Name)
+				fmt.Printf("%s Called %s\n", ui.Glyph(ui.IconPass), d.Name)
 			}
 		}
 
@@ -571,7 +572,7 @@ func runDogCall(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("waking dog %s: %w", name, err)
 		}
 
-		fmt.Printf("✓ Called %s - ready for work\n", name)
+		fmt.Printf("%s Called %s - ready for work\n", ui.Glyph(ui.IconPass), name)
 		return nil
 	}
 
@@ -590,7 +591,7 @@ func runDogCall(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("waking dog %s: %w", d.Name, err)
 	}
 
-	fmt.Printf("✓ Called %s - ready for work\n", d.Name)
+	fmt.Printf("%s Called %s - ready for work\n", ui.Glyph(ui.IconPass), d.Name)
 	return nil
 }
 
@@ -627,7 +628,7 @@ func runDogClear(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("clearing work for dog %s: %w", name, err)
 	}
 
-	fmt.Printf("✓ Cleared dog %s (now idle)\n", name)
+	fmt.Printf("%s Cleared dog %s (now idle)\n", ui.Glyph(ui.IconPass), name)
 	if d.Work != "" {
 		fmt.Printf("  Previous work: %s\n", d.Work)
 	}
@@ -679,7 +680,7 @@ func runDogDone(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("clearing work for dog %s: %w", name, err)
 	}
 
-	fmt.Printf("✓ Dog %s returned to kennel (idle)\n", name)
+	fmt.Printf("%s Dog %s returned to kennel (idle)\n", ui.Glyph(ui.IconPass), name)
 
 	// Auto-terminate the tmux session after a short delay.
 	// Dogs run inside tmux sessions (hq-dog-<name>). Without this, the
@@ -757,9 +758,9 @@ func showDogStatus(mgr *dog.Manager, name string) error {
 		fmt.Println("\nWorktrees:")
 		for rigName, path := range d.Worktrees {
 			// Check if worktree exists
-			exists := "✓"
+			exists := ui.Glyph(ui.IconPass)
 			if _, err := os.Stat(path); os.IsNotExist(err) {
-				exists = "✗"
+				exists = ui.Glyph("✗")
 			}
 			fmt.Printf("  %s %s: %s\n", exists, rigName, path)
 		}
@@ -919,9 +920,9 @@ func runDogHealthCheck(cmd *cobra.Command, args []string) error {
 		fmt.Println()
 
 		for _, r := range results {
-			icon := "✓"
+			icon := ui.Glyph(ui.IconPass)
 			if r.NeedsAttention {
-				icon = "✗"
+				icon = ui.Glyph("✗")
 			}
 			line := fmt.Sprintf("  %s %s [%s] session=%s", icon, r.Name, r.State, r.SessionStatus)
 			if r.WorkDuration > 0 {
@@ -932,7 +933,7 @@ func runDogHealthCheck(cmd *cobra.Command, args []string) error {
 			}
 			fmt.Println(line)
 			if r.Recommendation != "" && r.NeedsAttention {
-				fmt.Printf("    → %s\n", r.Recommendation)
+				fmt.Printf("    %s %s\n", ui.Glyph("→"), r.Recommendation)
 			}
 		}
 
@@ -1117,17 +1118,17 @@ func runDogDispatch(cmd *cobra.Command, args []string) error {
 		return json.NewEncoder(os.Stdout).Encode(result)
 	}
 
-	fmt.Printf("%s Found plugin: %s\n", style.Bold.Render("✓"), p.Name)
+	fmt.Printf("%s Found plugin: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), p.Name)
 	if p.RigName != "" {
 		fmt.Printf("  Location: %s/plugins/%s\n", p.RigName, p.Name)
 	} else {
 		fmt.Printf("  Location: plugins/%s (town-level)\n", p.Name)
 	}
 	if dogCreated {
-		fmt.Printf("%s Created dog %s (pool was empty)\n", style.Bold.Render("✓"), targetDog.Name)
+		fmt.Printf("%s Created dog %s (pool was empty)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), targetDog.Name)
 	}
 	fmt.Printf("%s Dispatching to dog: %s\n", style.Bold.Render("🐕"), targetDog.Name)
-	fmt.Printf("%s Plugin dispatched (non-blocking)\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Plugin dispatched (non-blocking)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Printf("  Dog: %s\n", targetDog.Name)
 	fmt.Printf("  Work: %s\n", workDesc)
 
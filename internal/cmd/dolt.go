@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/daemon"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -388,7 +389,7 @@ func runDoltStart(cmd *cobra.Command, args []string) error {
 	state, _ := doltserver.LoadState(townRoot)
 
 	fmt.Printf("%s Dolt server started (PID %d, port %d)\n",
-		style.Bold.Render("✓"), state.PID, config.Port)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), state.PID, config.Port)
 	fmt.Printf("  Data dir: %s\n", state.DataDir)
 	fmt.Printf("  Databases: %s\n", style.Dim.Render(strings.Join(state.Databases, ", ")))
 	fmt.Printf("  Connection: %s\n", style.Dim.Render(doltserver.GetConnectionString(townRoot)))
@@ -397,9 +398,9 @@ func runDoltStart(cmd *cobra.Command, args []string) error {
 	// Use retry since Start() only waits 500ms — DBs may still be loading.
 	served, missing, verifyErr := doltserver.VerifyDatabasesWithRetry(townRoot, 5)
 	if verifyErr != nil {
-		fmt.Printf("  %s Could not verify databases: %v\n", style.Dim.Render("⚠"), verifyErr)
+		fmt.Printf("  %s Could not verify databases: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), verifyErr)
 	} else if len(missing) > 0 {
-		fmt.Printf("\n%s Some databases exist on disk but are NOT served:\n", style.Bold.Render("⚠"))
+		fmt.Printf("\n%s Some databases exist on disk but are NOT served:\n", style.Bold.Render(ui.Glyph(ui.IconWarn)))
 		for _, db := range missing {
 			fmt.Printf("  - %s\n", db)
 		}
@@ -407,7 +408,7 @@ func runDoltStart(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  This usually means the database has a stale manifest.\n")
 		fmt.Printf("  Try: %s\n", style.Dim.Render("cd ~/gt/.dolt-data/<db> && dolt fsck --repair"))
 	} else {
-		fmt.Printf("  %s All %d databases verified\n", style.Bold.Render("✓"), len(served))
+		fmt.Printf("  %s All %d databases verified\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(served))
 	}
 
 	return nil
@@ -426,7 +427,7 @@ func runDoltKillImposters(cmd *cobra.Command, args []string) error {
 
 	conflictPID, conflictDataDir := doltserver.CheckPortConflict(townRoot)
 	if conflictPID == 0 {
-		fmt.Printf("%s No imposters found on port %d\n", style.Bold.Render("✓"), config.Port)
+		fmt.Printf("%s No imposters found on port %d\n", style.Bold.Render(ui.Glyph(ui.IconPass)), config.Port)
 		return nil
 	}
 
@@ -443,7 +444,7 @@ func runDoltKillImposters(cmd *cobra.Command, args []string) error {
 	if err := doltserver.KillImposters(townRoot); err != nil {
 		return fmt.Errorf("killing imposter: %w", err)
 	}
-	fmt.Printf("%s Imposter killed (PID %d)\n", style.Bold.Render("✓"), conflictPID)
+	fmt.Printf("%s Imposter killed (PID %d)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), conflictPID)
 	return nil
 }
 
@@ -464,7 +465,7 @@ func runDoltStop(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("%s Dolt server stopped (was PID %d)\n", style.Bold.Render("✓"), pid)
+	fmt.Printf("%s Dolt server stopped (was PID %d)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), pid)
 	return nil
 }
 
@@ -486,7 +487,7 @@ func runDoltRestart(cmd *cobra.Command, args []string) error {
 		if err := doltserver.Stop(townRoot); err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: stop failed: %v (continuing with imposter kill)\n", err)
 		} else {
-			fmt.Printf("%s Stopped\n", style.Bold.Render("✓"))
+			fmt.Printf("%s Stopped\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		}
 	}
 
@@ -515,7 +516,7 @@ func runDoltRestart(cmd *cobra.Command, args []string) error {
 	state, _ := doltserver.LoadState(townRoot)
 
 	fmt.Printf("%s Dolt server restarted (PID %d, port %d)\n",
-		style.Bold.Render("✓"), state.PID, config.Port)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), state.PID, config.Port)
 	fmt.Printf("  Data dir: %s\n", state.DataDir)
 	fmt.Printf("  Databases: %s\n", style.Dim.Render(strings.Join(state.Databases, ", ")))
 	fmt.Printf("  Connection: %s\n", style.Dim.Render(doltserver.GetConnectionString(townRoot)))
@@ -523,15 +524,15 @@ func runDoltRestart(cmd *cobra.Command, args []string) error {
 	// Verify databases
 	served, missing, verifyErr := doltserver.VerifyDatabasesWithRetry(townRoot, 5)
 	if verifyErr != nil {
-		fmt.Printf("  %s Could not verify databases: %v\n", style.Dim.Render("⚠"), verifyErr)
+		fmt.Printf("  %s Could not verify databases: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), verifyErr)
 	} else if len(missing) > 0 {
-		fmt.Printf("\n%s Some databases exist on disk but are NOT served:\n", style.Bold.Render("⚠"))
+		fmt.Printf("\n%s Some databases exist on disk but are NOT served:\n", style.Bold.Render(ui.Glyph(ui.IconWarn)))
 		for _, db := range missing {
 			fmt.Printf("  - %s\n", db)
 		}
 		fmt.Printf("\n  Served: %v\n", served)
 	} else {
-		fmt.Printf("  %s All %d databases verified\n", style.Bold.Render("✓"), len(served))
+		fmt.Printf("  %s All %d databases verified\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(served))
 	}
 
 	return nil
@@ -553,12 +554,12 @@ func runDoltStatus(cmd *cobra.Command, args []string) error {
 	if config.IsRemote() {
 		if running {
 			fmt.Printf("%s Dolt server is %s (remote: %s)\n",
-				style.Bold.Render("●"),
+				style.Bold.Render(ui.Glyph("●")),
 				style.Bold.Render("reachable"),
 				config.HostPort())
 		} else {
 			fmt.Printf("%s Dolt server is %s (remote: %s)\n",
-				style.Dim.Render("○"),
+				style.Dim.Render(ui.Glyph("○")),
 				"not reachable",
 				config.HostPort())
 		}
@@ -580,7 +581,7 @@ func runDoltStatus(cmd *cobra.Command, args []string) error {
 
 	if running {
 		fmt.Printf("%s Dolt server is %s (PID %d)\n",
-			style.Bold.Render("●"),
+			style.Bold.Render(ui.Glyph("●")),
 			style.Bold.Render("running"),
 			pid)
 
@@ -644,7 +645,7 @@ func runDoltStatus(cmd *cobra.Command, args []string) error {
 		}
 	} else {
 		fmt.Printf("%s Dolt server is %s\n",
-			style.Dim.Render("○"),
+			style.Dim.Render(ui.Glyph("○")),
 			"not running")
 
 		// List available databases
@@ -803,12 +804,12 @@ func runDoltInitRig(cmd *cobra.Command, args []string) error {
 	rigDir := doltserver.RigDatabaseDir(townRoot, rigName)
 
 	if !created {
-		fmt.Printf("%s Rig database %q already exists (no-op)\n", style.Bold.Render("✓"), rigName)
+		fmt.Printf("%s Rig database %q already exists (no-op)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rigName)
 		fmt.Printf("  Location: %s\n", rigDir)
 		return nil
 	}
 
-	fmt.Printf("%s Initialized rig database %q\n", style.Bold.Render("✓"), rigName)
+	fmt.Printf("%s Initialized rig database %q\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rigName)
 	fmt.Printf("  Location: %s\n", rigDir)
 	fmt.Printf("  Data dir: %s\n", config.DataDir)
 
@@ -841,7 +842,7 @@ func runDoltInit(cmd *cobra.Command, args []string) error {
 			fmt.Printf("\nInitialize a rig database with: %s\n", style.Dim.Render("gt dolt init-rig <name>"))
 		} else {
 			fmt.Printf("%s All workspaces healthy (%d database(s) verified)\n",
-				style.Bold.Render("✓"), len(databases))
+				style.Bold.Render(ui.Glyph(ui.IconPass)), len(databases))
 		}
 
 		// Report orphans even when workspaces are healthy
@@ -869,16 +870,16 @@ func runDoltInit(cmd *cobra.Command, args []string) error {
 
 		action, err := doltserver.RepairWorkspace(townRoot, ws)
 		if err != nil {
-			fmt.Printf("    %s Repair failed: %v\n", style.Bold.Render("✗"), err)
+			fmt.Printf("    %s Repair failed: %v\n", style.Bold.Render(ui.Glyph("✗")), err)
 			continue
 		}
 
-		fmt.Printf("    %s Repaired: %s\n", style.Bold.Render("✓"), action)
+		fmt.Printf("    %s Repaired: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), action)
 		repaired++
 	}
 
 	if repaired > 0 {
-		fmt.Printf("\n%s Repaired %d/%d workspace(s)\n", style.Bold.Render("✓"), repaired, len(broken))
+		fmt.Printf("\n%s Repaired %d/%d workspace(s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), repaired, len(broken))
 	}
 
 	// Report orphans after repairs
@@ -906,7 +907,7 @@ func runDoltCleanup(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(orphans) == 0 {
-		fmt.Printf("%s No orphaned databases found in .dolt-data/\n", style.Bold.Render("✓"))
+		fmt.Printf("%s No orphaned databases found in .dolt-data/\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		return nil
 	}
 
@@ -946,33 +947,33 @@ func runDoltCleanup(cmd *cobra.Command, args []string) error {
 			if doltserver.IsReadOnlyError(err.Error()) {
 				fmt.Printf("  %s DROP put server into read-only mode — attempting recovery...\n", style.Bold.Render("!"))
 				if recoverErr := doltserver.RecoverReadOnly(townRoot); recoverErr != nil {
-					fmt.Printf("  %s Recovery failed: %v\n", style.Bold.Render("✗"), recoverErr)
+					fmt.Printf("  %s Recovery failed: %v\n", style.Bold.Render(ui.Glyph("✗")), recoverErr)
 					fmt.Printf("  Run: gt dolt stop && gt dolt start\n")
 				} else {
-					fmt.Printf("  %s Server recovered from read-only state\n", style.Bold.Render("✓"))
+					fmt.Printf("  %s Server recovered from read-only state\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 				}
 				break
 			}
-			fmt.Printf("  %s Failed to remove %s: %v\n", style.Bold.Render("✗"), o.Name, err)
+			fmt.Printf("  %s Failed to remove %s: %v\n", style.Bold.Render(ui.Glyph("✗")), o.Name, err)
 			continue
 		}
-		fmt.Printf("  %s Removed %s\n", style.Bold.Render("✓"), o.Name)
+		fmt.Printf("  %s Removed %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), o.Name)
 		removed++
 
 		// Health check after each DROP to catch read-only early (gt-r1cyd)
 		if readOnly, _ := doltserver.CheckReadOnly(townRoot); readOnly {
 			fmt.Printf("  %s Server went read-only after DROP — attempting recovery...\n", style.Bold.Render("!"))
 			if recoverErr := doltserver.RecoverReadOnly(townRoot); recoverErr != nil {
-				fmt.Printf("  %s Recovery failed: %v\n", style.Bold.Render("✗"), recoverErr)
+				fmt.Printf("  %s Recovery failed: %v\n", style.Bold.Render(ui.Glyph("✗")), recoverErr)
 				fmt.Printf("  Run: gt dolt stop && gt dolt start\n")
 				break
 			}
-			fmt.Printf("  %s Server recovered — continuing cleanup\n", style.Bold.Render("✓"))
+			fmt.Printf("  %s Server recovered — continuing cleanup\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		}
 	}
 
 	fmt.Printf("\n%s Removed %d/%d orphaned database(s)\n",
-		style.Bold.Render("✓"), removed, len(orphans))
+		style.Bold.Render(ui.Glyph(ui.IconPass)), removed, len(orphans))
 
 	return nil
 }
@@ -1041,7 +1042,7 @@ func runDoltMigrate(cmd *cobra.Command, args []string) error {
 	for _, m := range migrations {
 		sizeStr := dirSizeHuman(m.SourcePath)
 		fmt.Printf("  %s (%s)\n", m.SourcePath, sizeStr)
-		fmt.Printf("    → %s\n\n", m.TargetPath)
+		fmt.Printf("    %s %s\n\n", ui.Glyph("→"), m.TargetPath)
 	}
 
 	if doltMigrateDry {
@@ -1055,7 +1056,7 @@ func runDoltMigrate(cmd *cobra.Command, args []string) error {
 		if err := doltserver.MigrateRigFromBeads(townRoot, m.RigName, m.SourcePath); err != nil {
 			return fmt.Errorf("migrating %s: %w", m.RigName, err)
 		}
-		fmt.Printf("  %s Migrated to %s\n", style.Bold.Render("✓"), m.TargetPath)
+		fmt.Printf("  %s Migrated to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), m.TargetPath)
 	}
 
 	// Update metadata.json for all migrated rigs
@@ -1064,24 +1065,24 @@ func runDoltMigrate(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\nUpdated metadata.json for: %s\n", strings.Join(updated, ", "))
 	}
 	for _, err := range metaErrs {
-		fmt.Printf("  %s metadata.json update failed: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("  %s metadata.json update failed: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	}
 
-	fmt.Printf("\n%s Migration complete.\n", style.Bold.Render("✓"))
+	fmt.Printf("\n%s Migration complete.\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	// Auto-start the Dolt server to prevent split-brain risk.
 	// If bd commands are run before the server starts, they may silently create
 	// isolated local databases instead of connecting to the centralized server.
 	fmt.Printf("\nStarting Dolt server to prevent split-brain risk...\n")
 	if err := doltserver.Start(townRoot); err != nil {
-		fmt.Printf("\n%s Could not auto-start Dolt server: %v\n", style.Bold.Render("⚠"), err)
-		fmt.Printf("\n%s WARNING: Do NOT run bd commands until the server is started!\n", style.Bold.Render("⚠"))
+		fmt.Printf("\n%s Could not auto-start Dolt server: %v\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), err)
+		fmt.Printf("\n%s WARNING: Do NOT run bd commands until the server is started!\n", style.Bold.Render(ui.Glyph(ui.IconWarn)))
 		fmt.Printf("  Running bd before 'gt dolt start' risks split-brain: bd may create an\n")
 		fmt.Printf("  isolated local database instead of connecting to the centralized server.\n")
 		fmt.Printf("\n  Start manually with: %s\n", style.Dim.Render("gt dolt start"))
 	} else {
 		state, _ := doltserver.LoadState(townRoot)
-		fmt.Printf("%s Dolt server started (PID %d)\n", style.Bold.Render("✓"), state.PID)
+		fmt.Printf("%s Dolt server started (PID %d)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), state.PID)
 
 		// Verify the server is actually serving all databases that exist on disk.
 		// Dolt silently skips databases with stale manifests after migration,
@@ -1089,11 +1090,11 @@ func runDoltMigrate(cmd *cobra.Command, args []string) error {
 		// Use retry since the server may still be loading databases after Start().
 		served, missing, verifyErr := doltserver.VerifyDatabasesWithRetry(townRoot, 5)
 		if verifyErr != nil {
-			fmt.Printf("  %s Could not verify databases: %v\n", style.Dim.Render("⚠"), verifyErr)
+			fmt.Printf("  %s Could not verify databases: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), verifyErr)
 			fmt.Printf("  Migration may be incomplete. Verify manually with: %s\n", style.Dim.Render("gt dolt status"))
 			return fmt.Errorf("database verification failed after migration: %w", verifyErr)
 		} else if len(missing) > 0 {
-			fmt.Printf("\n%s Some databases exist on disk but are NOT served by Dolt:\n", style.Bold.Render("⚠"))
+			fmt.Printf("\n%s Some databases exist on disk but are NOT served by Dolt:\n", style.Bold.Render(ui.Glyph(ui.IconWarn)))
 			for _, db := range missing {
 				fmt.Printf("  - %s\n", db)
 			}
@@ -1105,7 +1106,7 @@ func runDoltMigrate(cmd *cobra.Command, args []string) error {
 			fmt.Printf("    3. Restart:           %s\n", style.Dim.Render("gt dolt start"))
 			return fmt.Errorf("migration incomplete: %d database(s) exist on disk but are not served: %v", len(missing), missing)
 		} else {
-			fmt.Printf("  %s All %d databases verified as served\n", style.Bold.Render("✓"), len(served))
+			fmt.Printf("  %s All %d databases verified as served\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(served))
 		}
 	}
 
@@ -1136,7 +1137,7 @@ func runDoltFixMetadata(cmd *cobra.Command, args []string) error {
 	updated, errs := doltserver.EnsureAllMetadata(townRoot)
 
 	if len(updated) > 0 {
-		fmt.Printf("%s Updated metadata.json for %d rig(s):\n", style.Bold.Render("✓"), len(updated))
+		fmt.Printf("%s Updated metadata.json for %d rig(s):\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(updated))
 		for _, name := range updated {
 			fmt.Printf("  - %s\n", name)
 		}
@@ -1145,7 +1146,7 @@ func runDoltFixMetadata(cmd *cobra.Command, args []string) error {
 	if len(errs) > 0 {
 		fmt.Println()
 		for _, err := range errs {
-			fmt.Printf("  %s %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("  %s %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		}
 	}
 
@@ -1178,7 +1179,7 @@ func runDoltRecover(cmd *cobra.Command, args []string) error {
 	}
 
 	if !readOnly {
-		fmt.Printf("%s Dolt server is writable (no recovery needed)\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Dolt server is writable (no recovery needed)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		return nil
 	}
 
@@ -1186,7 +1187,7 @@ func runDoltRecover(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("recovery failed: %w", err)
 	}
 
-	fmt.Printf("%s Dolt server recovered from read-only state\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Dolt server recovered from read-only state\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	return nil
 }
 
@@ -1267,7 +1268,7 @@ func runDoltRollback(cmd *cobra.Command, args []string) error {
 		if err := doltserver.Stop(townRoot); err != nil {
 			return fmt.Errorf("stopping Dolt server: %w", err)
 		}
-		fmt.Printf("%s Dolt server stopped\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Dolt server stopped\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	// Perform the rollback
@@ -1280,13 +1281,13 @@ func runDoltRollback(cmd *cobra.Command, args []string) error {
 	// Report results
 	fmt.Println()
 	if result.RestoredTown {
-		fmt.Printf("  %s Restored town-level .beads\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s Restored town-level .beads\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 	for _, rig := range result.RestoredRigs {
-		fmt.Printf("  %s Restored %s/.beads\n", style.Bold.Render("✓"), rig)
+		fmt.Printf("  %s Restored %s/.beads\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rig)
 	}
 	for _, rig := range result.SkippedRigs {
-		fmt.Printf("  %s Skipped %s (restore failed)\n", style.Dim.Render("⚠"), rig)
+		fmt.Printf("  %s Skipped %s (restore failed)\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), rig)
 	}
 
 	if len(result.MetadataReset) > 0 {
@@ -1300,12 +1301,12 @@ func runDoltRollback(cmd *cobra.Command, args []string) error {
 	output, validateErr := validateCmd.CombinedOutput()
 	if validateErr != nil {
 		fmt.Printf("  %s bd list returned an error: %v\n",
-			style.Dim.Render("⚠"), validateErr)
+			style.Dim.Render(ui.Glyph(ui.IconWarn)), validateErr)
 		if len(output) > 0 {
 			fmt.Printf("  %s\n", string(output))
 		}
 	} else {
-		fmt.Printf("  %s bd list succeeded\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s bd list succeeded\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		if len(output) > 0 {
 			// Show first few lines of output
 			lines := strings.Split(strings.TrimSpace(string(output)), "\n")
@@ -1315,7 +1316,7 @@ func runDoltRollback(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Printf("\n%s Rollback complete from %s\n", style.Bold.Render("✓"), backupPath)
+	fmt.Printf("\n%s Rollback complete from %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), backupPath)
 
 	return nil
 }
@@ -1459,19 +1460,19 @@ func runDoltSync(cmd *cobra.Command, args []string) error {
 				return fmt.Errorf("stopping Dolt server: %w", err)
 			}
 		} else {
-			fmt.Printf("%s Dolt server stopped\n", style.Bold.Render("✓"))
+			fmt.Printf("%s Dolt server stopped\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		}
 
 		// Guarantee restart even if push fails
 		defer func() {
 			fmt.Printf("\nRestarting Dolt server...\n")
 			if startErr := doltserver.Start(townRoot); startErr != nil {
-				fmt.Printf("%s Failed to restart Dolt server: %v\n", style.Bold.Render("✗"), startErr)
+				fmt.Printf("%s Failed to restart Dolt server: %v\n", style.Bold.Render(ui.Glyph("✗")), startErr)
 				fmt.Printf("  Start manually with: %s\n", style.Dim.Render("gt dolt start"))
 			} else {
 				// Start() now verifies the server is accepting connections,
 				// so if we get here it's genuinely ready.
-				fmt.Printf("%s Dolt server restarted (accepting connections)\n", style.Bold.Render("✓"))
+				fmt.Printf("%s Dolt server restarted (accepting connections)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 			}
 
 			// Unpark rigs that we parked
@@ -1511,14 +1512,14 @@ func runDoltSync(cmd *cobra.Command, args []string) error {
 					if doltSyncDry {
 						verb = "would purge"
 					}
-					fmt.Printf("  %s %s gc: %s %d closed ephemeral bead(s)\n", style.Bold.Render("✓"), r.Database, verb, pr.purged)
+					fmt.Printf("  %s %s gc: %s %d closed ephemeral bead(s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), r.Database, verb, pr.purged)
 					totalPurged += pr.purged
 				}
 			}
 		}
 		switch {
 		case r.Pushed:
-			fmt.Printf("  %s %s → origin main\n", style.Bold.Render("✓"), r.Database)
+			fmt.Printf("  %s %s → origin main\n", style.Bold.Render(ui.Glyph(ui.IconPass)), r.Database)
 			fmt.Printf("    %s\n", style.Dim.Render(r.Remote))
 			pushed++
 		case r.DryRun:
@@ -1526,10 +1527,10 @@ func runDoltSync(cmd *cobra.Command, args []string) error {
 			fmt.Printf("    %s\n", style.Dim.Render(r.Remote))
 			pushed++ // count as would-push for summary
 		case r.Skipped:
-			fmt.Printf("  %s %s — no remote configured\n", style.Dim.Render("○"), r.Database)
+			fmt.Printf("  %s %s — no remote configured\n", style.Dim.Render(ui.Glyph("○")), r.Database)
 			skipped++
 		case r.Error != nil:
-			fmt.Printf("  %s %s → origin main\n", style.Bold.Render("✗"), r.Database)
+			fmt.Printf("  %s %s → origin main\n", style.Bold.Render(ui.Glyph("✗")), r.Database)
 			fmt.Printf("    error: %v\n", r.Error)
 			failed++
 		}
@@ -1564,7 +1565,7 @@ func runDoltMigrateWisps(cmd *cobra.Command, args []string) error {
 		if _, err := os.Stat(rigDir); os.IsNotExist(err) {
 			return fmt.Errorf("rig directory not found: %s", rigDir)
 		}
-		fmt.Printf("%s Migrating: %s\n", style.Bold.Render("→"), doltMigrateWispsDB)
+		fmt.Printf("%s Migrating: %s\n", style.Bold.Render(ui.Glyph("→")), doltMigrateWispsDB)
 		result, err := doltserver.MigrateAgentBeadsToWisps(townRoot, rigDir, doltMigrateWispsDry)
 		if err != nil {
 			return err
@@ -1592,10 +1593,10 @@ func runDoltMigrateWisps(cmd *cobra.Command, args []string) error {
 		} else if _, err := os.Stat(rigDir); os.IsNotExist(err) {
 			continue // Not a rig directory
 		}
-		fmt.Printf("\n%s Migrating: %s\n", style.Bold.Render("→"), db)
+		fmt.Printf("\n%s Migrating: %s\n", style.Bold.Render(ui.Glyph("→")), db)
 		result, err := doltserver.MigrateAgentBeadsToWisps(townRoot, rigDir, doltMigrateWispsDry)
 		if err != nil {
-			fmt.Printf("  %s %s: %v\n", style.Bold.Render("✗"), db, err)
+			fmt.Printf("  %s %s: %v\n", style.Bold.Render(ui.Glyph("✗")), db, err)
 			continue
 		}
 		printMigrateWispsResult(result)
@@ -1605,30 +1606,30 @@ func runDoltMigrateWisps(cmd *cobra.Command, args []string) error {
 
 func printMigrateWispsResult(result *doltserver.MigrateWispsResult) {
 	if result.WispsTableCreated {
-		fmt.Printf("  %s Created wisps table\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s Created wisps table\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 	for _, t := range result.AuxTablesCreated {
-		fmt.Printf("  %s Created %s\n", style.Bold.Render("✓"), t)
+		fmt.Printf("  %s Created %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), t)
 	}
 	if result.AgentsCopied > 0 {
-		fmt.Printf("  %s Copied %d agent beads to wisps\n", style.Bold.Render("✓"), result.AgentsCopied)
+		fmt.Printf("  %s Copied %d agent beads to wisps\n", style.Bold.Render(ui.Glyph(ui.IconPass)), result.AgentsCopied)
 	}
 	if result.LabelsCopied > 0 {
-		fmt.Printf("  %s Copied %d labels\n", style.Bold.Render("✓"), result.LabelsCopied)
+		fmt.Printf("  %s Copied %d labels\n", style.Bold.Render(ui.Glyph(ui.IconPass)), result.LabelsCopied)
 	}
 	if result.CommentsCopied > 0 {
-		fmt.Printf("  %s Copied %d comments\n", style.Bold.Render("✓"), result.CommentsCopied)
+		fmt.Printf("  %s Copied %d comments\n", style.Bold.Render(ui.Glyph(ui.IconPass)), result.CommentsCopied)
 	}
 	if result.EventsCopied > 0 {
-		fmt.Printf("  %s Copied %d events\n", style.Bold.Render("✓"), result.EventsCopied)
+		fmt.Printf("  %s Copied %d events\n", style.Bold.Render(ui.Glyph(ui.IconPass)), result.EventsCopied)
 	}
 	if result.DepsCopied > 0 {
-		fmt.Printf("  %s Copied %d dependencies\n", style.Bold.Render("✓"), result.DepsCopied)
+		fmt.Printf("  %s Copied %d dependencies\n", style.Bold.Render(ui.Glyph(ui.IconPass)), result.DepsCopied)
 	}
 	if result.AgentsClosed > 0 {
-		fmt.Printf("  %s Closed %d original agent beads\n", style.Bold.Render("✓"), result.AgentsClosed)
+		fmt.Printf("  %s Closed %d original agent beads\n", style.Bold.Render(ui.Glyph(ui.IconPass)), result.AgentsClosed)
 	}
 	if result.AgentsCopied == 0 && len(result.AuxTablesCreated) == 0 && !result.WispsTableCreated {
-		fmt.Printf("  %s Already migrated (no changes needed)\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s Already migrated (no changes needed)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 }
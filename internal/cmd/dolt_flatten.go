@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -83,7 +84,7 @@ func runDoltFlatten(cmd *cobra.Command, args []string) error {
 	}
 
 	// Pre-flight: check backup freshness.
-	fmt.Printf("%s Pre-flight checks for %s\n", style.Bold.Render("●"), style.Bold.Render(dbName))
+	fmt.Printf("%s Pre-flight checks for %s\n", style.Bold.Render(ui.Glyph("●")), style.Bold.Render(dbName))
 
 	backupDir := filepath.Join(townRoot, ".dolt-backup")
 	if _, err := os.Stat(backupDir); err == nil {
@@ -94,7 +95,7 @@ func runDoltFlatten(cmd *cobra.Command, args []string) error {
 				fmt.Printf("  %s Backup is %v old — consider running backup first\n",
 					style.Bold.Render("!"), age.Round(time.Minute))
 			} else {
-				fmt.Printf("  %s Backup is %v old (OK)\n", style.Bold.Render("✓"), age.Round(time.Second))
+				fmt.Printf("  %s Backup is %v old (OK)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), age.Round(time.Second))
 			}
 		}
 	} else {
@@ -109,7 +110,7 @@ func runDoltFlatten(cmd *cobra.Command, args []string) error {
 	fmt.Printf("  Commits: %d\n", commitCount)
 
 	if commitCount <= 2 {
-		fmt.Printf("  %s Already minimal — nothing to flatten\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s Already minimal — nothing to flatten\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		return nil
 	}
 
@@ -130,7 +131,7 @@ func runDoltFlatten(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Printf("  Root: %s\n", rootHash[:12])
 
-	fmt.Printf("\n%s Flattening %s (direct SQL — no downtime)...\n", style.Bold.Render("●"), dbName)
+	fmt.Printf("\n%s Flattening %s (direct SQL — no downtime)...\n", style.Bold.Render(ui.Glyph("●")), dbName)
 
 	// USE database for session-scoped operations.
 	if _, err := db.ExecContext(ctx, fmt.Sprintf("USE `%s`", dbName)); err != nil {
@@ -166,7 +167,7 @@ func runDoltFlatten(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("integrity FAIL: %q pre=%d post=%d", table, preCount, postCount)
 		}
 	}
-	fmt.Printf("  %s Integrity verified (%d tables match)\n", style.Bold.Render("✓"), len(preCounts))
+	fmt.Printf("  %s Integrity verified (%d tables match)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(preCounts))
 
 	// Verify final state.
 	var finalCount int
@@ -175,7 +176,7 @@ func runDoltFlatten(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("\n%s Flatten complete: %d → %d commits\n",
-		style.Bold.Render("✓"), commitCount, finalCount)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), commitCount, finalCount)
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -101,7 +102,7 @@ func runDoltRebase(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("database %q not reachable: %w", dbName, err)
 	}
 
-	fmt.Printf("%s Pre-flight checks for %s (surgical rebase)\n", style.Bold.Render("●"), style.Bold.Render(dbName))
+	fmt.Printf("%s Pre-flight checks for %s (surgical rebase)\n", style.Bold.Render(ui.Glyph("●")), style.Bold.Render(dbName))
 
 	// Count commits.
 	var commitCount int
@@ -115,7 +116,7 @@ func runDoltRebase(cmd *cobra.Command, args []string) error {
 	minCommits := doltRebaseKeepRecent + 2
 	if commitCount < minCommits {
 		fmt.Printf("  %s Too few commits (%d) for surgical rebase with --keep-recent=%d (need at least %d)\n",
-			style.Bold.Render("✓"), commitCount, doltRebaseKeepRecent, minCommits)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), commitCount, doltRebaseKeepRecent, minCommits)
 		return nil
 	}
 
@@ -154,7 +155,7 @@ func runDoltRebase(cmd *cobra.Command, args []string) error {
 	// Clean up any leftover branches from a previous failed run.
 	rebaseCleanup(db, baseBranch, workBranch)
 
-	fmt.Printf("\n%s Starting surgical rebase...\n", style.Bold.Render("●"))
+	fmt.Printf("\n%s Starting surgical rebase...\n", style.Bold.Render(ui.Glyph("●")))
 
 	// Step 1: Create anchor branch at root commit.
 	if _, err := db.ExecContext(ctx, fmt.Sprintf("CALL DOLT_BRANCH('%s', '%s')", baseBranch, rootHash)); err != nil {
@@ -205,14 +206,14 @@ func runDoltRebase(cmd *cobra.Command, args []string) error {
 		toSquash, doltRebaseKeepRecent)
 
 	if toSquash == 0 {
-		fmt.Printf("  %s Nothing to squash — all commits are recent\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s Nothing to squash — all commits are recent\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		rebaseAbortAndCleanup(db, baseBranch, workBranch)
 		return nil
 	}
 
 	if doltRebaseDryRun {
 		// Show the plan.
-		fmt.Printf("\n%s Dry-run rebase plan:\n", style.Bold.Render("●"))
+		fmt.Printf("\n%s Dry-run rebase plan:\n", style.Bold.Render(ui.Glyph("●")))
 		rows, err := db.QueryContext(ctx, "SELECT rebase_order, action, commit_hash, commit_message FROM dolt_rebase ORDER BY rebase_order")
 		if err != nil {
 			rebaseAbortAndCleanup(db, baseBranch, workBranch)
@@ -265,7 +266,7 @@ func runDoltRebase(cmd *cobra.Command, args []string) error {
 		rebaseCleanupAll(db, baseBranch, workBranch)
 		return fmt.Errorf("rebase execution failed (possible conflicts — automatic abort): %w", err)
 	}
-	fmt.Printf("  %s Rebase executed successfully\n", style.Bold.Render("✓"))
+	fmt.Printf("  %s Rebase executed successfully\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	// Step 7: Verify integrity — row counts must match pre-flight.
 	postCounts, err := flattenGetRowCounts(db, dbName)
@@ -287,7 +288,7 @@ func runDoltRebase(cmd *cobra.Command, args []string) error {
 				return fmt.Errorf("integrity FAIL: %q pre=%d post=%d", table, preCount, postCount)
 			}
 		}
-		fmt.Printf("  %s Integrity verified (%d tables match)\n", style.Bold.Render("✓"), len(preCounts))
+		fmt.Printf("  %s Integrity verified (%d tables match)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(preCounts))
 	}
 
 	// Step 8: Concurrency check — verify main hasn't moved.
@@ -325,7 +326,7 @@ func runDoltRebase(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("\n%s Surgical rebase complete: %d → %d commits (kept %d recent)\n",
-		style.Bold.Render("✓"), commitCount, finalCount, doltRebaseKeepRecent)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), commitCount, finalCount, doltRebaseKeepRecent)
 	return nil
 }
 
@@ -19,10 +19,13 @@ import (
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/stats"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/telemetry"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/townlog"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/wisp"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -261,11 +264,15 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 	// Parse branch info
 	info := parseBranchName(branch)
 
-	// Override with explicit flags
+	// Explicit flags win, then the branch name; the rig's artifact registry
+	// covers branches whose names don't carry the issue
 	issueID := doneIssue
 	if issueID == "" {
 		issueID = info.Issue
 	}
+	if issueID == "" {
+		issueID = wispForBranch(townRoot, rigName, branch)
+	}
 	worker := info.Worker
 
 	// Determine polecat name from sender detection
@@ -315,7 +322,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		setDoneIntentLabel(bd, agentBeadID, exitType)
 		checkpoints = readDoneCheckpoints(bd, agentBeadID)
 		if len(checkpoints) > 0 {
-			fmt.Printf("%s Resuming gt done from checkpoint (previous run was interrupted)\n", style.Bold.Render("→"))
+			fmt.Printf("%s Resuming gt done from checkpoint (previous run was interrupted)\n", style.Bold.Render(ui.Glyph("→")))
 		}
 	}
 
@@ -325,6 +332,16 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		defaultBranch = rigCfg.DefaultBranch
 	}
 
+	// The rig's branch policy can forbid landing work without the merge
+	// queue, which overrides a convoy's "direct" merge strategy.
+	allowDirect := rig.LoadBranchPolicy(filepath.Join(townRoot, rigName)).DirectCommitsAllowed()
+	directAllowed := func() bool {
+		if !allowDirect {
+			style.PrintWarning("branch policy forbids direct commits to %s; submitting to the merge queue instead", defaultBranch)
+		}
+		return allowDirect
+	}
+
 	// For COMPLETED, we need an issue ID and branch must not be the default branch
 	var mrID string
 	var pushFailed bool
@@ -390,7 +407,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			// Non-polecat (crew/mayor) or polecat with --cleanup-status=clean
 			// (report-only tasks like audits/reviews where no code changes expected):
 			// zero commits is valid.
-			fmt.Printf("%s Branch has no commits ahead of %s\n", style.Bold.Render("→"), originDefault)
+			fmt.Printf("%s Branch has no commits ahead of %s\n", style.Bold.Render(ui.Glyph("→")), originDefault)
 			fmt.Printf("  Work was likely pushed directly to main or already merged.\n")
 			fmt.Printf("  Skipping MR creation - completing without merge request.\n\n")
 
@@ -423,7 +440,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 					for attempt := 1; attempt <= 3; attempt++ {
 						closeErr = bd.ForceCloseWithReason(closeReason, issueID)
 						if closeErr == nil {
-							fmt.Printf("%s Issue %s closed (no MR needed)\n", style.Bold.Render("✓"), issueID)
+							fmt.Printf("%s Issue %s closed (no MR needed)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), issueID)
 							break
 						}
 						if attempt < 3 {
@@ -459,7 +476,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 
 		// Handle "local" strategy: skip push and MR entirely
 		if convoyInfo != nil && convoyInfo.MergeStrategy == "local" {
-			fmt.Printf("%s Local merge strategy: skipping push and merge queue\n", style.Bold.Render("→"))
+			fmt.Printf("%s Local merge strategy: skipping push and merge queue\n", style.Bold.Render(ui.Glyph("→")))
 			fmt.Printf("  Branch: %s\n", branch)
 			if issueID != "" {
 				fmt.Printf("  Issue: %s\n", issueID)
@@ -470,8 +487,8 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		}
 
 		// Handle "direct" strategy: push to target branch, skip MR
-		if convoyInfo != nil && convoyInfo.MergeStrategy == "direct" {
-			fmt.Printf("%s Direct merge strategy: pushing to %s\n", style.Bold.Render("→"), defaultBranch)
+		if convoyInfo != nil && convoyInfo.MergeStrategy == "direct" && directAllowed() {
+			fmt.Printf("%s Direct merge strategy: pushing to %s\n", style.Bold.Render(ui.Glyph("→")), defaultBranch)
 			directRefspec := branch + ":" + defaultBranch
 			directPushErr := g.Push("origin", directRefspec, false)
 			if directPushErr != nil {
@@ -481,7 +498,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				style.PrintWarning("%s", errMsg)
 				goto notifyWitness
 			}
-			fmt.Printf("%s Branch pushed directly to %s\n", style.Bold.Render("✓"), defaultBranch)
+			fmt.Printf("%s Branch pushed directly to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), defaultBranch)
 
 			// Close the base issue — no MR/refinery will close it
 			if issueID != "" {
@@ -491,7 +508,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				for attempt := 1; attempt <= 3; attempt++ {
 					closeErr = directBd.ForceCloseWithReason(closeReason, issueID)
 					if closeErr == nil {
-						fmt.Printf("%s Issue %s closed (direct merge)\n", style.Bold.Render("✓"), issueID)
+						fmt.Printf("%s Issue %s closed (direct merge)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), issueID)
 						break
 					}
 					if attempt < 3 {
@@ -515,7 +532,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 
 		// Resume: skip push if already completed in a previous run (gt-aufru)
 		if checkpoints[CheckpointPushed] != "" {
-			fmt.Printf("%s Branch already pushed (resumed from checkpoint)\n", style.Bold.Render("✓"))
+			fmt.Printf("%s Branch already pushed (resumed from checkpoint)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 			goto afterPush
 		}
 
@@ -545,7 +562,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				if pushErr != nil {
 					style.PrintWarning("bare repo push also failed: %v", pushErr)
 				} else {
-					fmt.Printf("%s Branch pushed via bare repo fallback\n", style.Bold.Render("✓"))
+					fmt.Printf("%s Branch pushed via bare repo fallback\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 				}
 			} else {
 				// No bare repo — try mayor/rig as last resort
@@ -556,7 +573,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 					if pushErr != nil {
 						style.PrintWarning("mayor/rig push also failed: %v", pushErr)
 					} else {
-						fmt.Printf("%s Branch pushed via mayor/rig fallback\n", style.Bold.Render("✓"))
+						fmt.Printf("%s Branch pushed via mayor/rig fallback\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 					}
 				}
 			}
@@ -593,7 +610,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				goto notifyWitness
 			}
 		}
-		fmt.Printf("%s Branch pushed to origin\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Branch pushed to origin\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 		// Fix cleanup_status after successful push (gt-wcr).
 		// Status was detected before push, so "unpushed" is now stale.
@@ -621,7 +638,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		if err == nil {
 			attachmentFields := beads.ParseAttachmentFields(sourceIssueForNoMerge)
 			if attachmentFields != nil && attachmentFields.NoMerge {
-				fmt.Printf("%s No-merge mode: skipping merge queue\n", style.Bold.Render("→"))
+				fmt.Printf("%s No-merge mode: skipping merge queue\n", style.Bold.Render(ui.Glyph("→")))
 				fmt.Printf("  Branch: %s\n", branch)
 				fmt.Printf("  Issue: %s\n", issueID)
 				fmt.Println()
@@ -640,7 +657,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 					if err := townRouter.Send(reviewMsg); err != nil {
 						style.PrintWarning("could not notify dispatcher: %v", err)
 					} else {
-						fmt.Printf("%s Dispatcher notified: READY_FOR_REVIEW\n", style.Bold.Render("✓"))
+						fmt.Printf("%s Dispatcher notified: READY_FOR_REVIEW\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 					}
 				}
 
@@ -658,8 +675,8 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		if convoyInfo == nil {
 			convoyInfo = getConvoyInfoForIssue(issueID)
 		}
-		if convoyInfo != nil && convoyInfo.MergeStrategy == "direct" {
-			fmt.Printf("%s Late-detected direct merge strategy: pushing to %s\n", style.Bold.Render("→"), defaultBranch)
+		if convoyInfo != nil && convoyInfo.MergeStrategy == "direct" && allowDirect {
+			fmt.Printf("%s Late-detected direct merge strategy: pushing to %s\n", style.Bold.Render(ui.Glyph("→")), defaultBranch)
 			fmt.Printf("  Convoy: %s\n", convoyInfo.ID)
 
 			// Push branch directly to main (the earlier push went to origin/<branch>)
@@ -669,7 +686,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 				// Direct push failed — fall through to normal MR creation
 				style.PrintWarning("late direct push to %s failed: %v — falling through to MR", defaultBranch, directPushErr)
 			} else {
-				fmt.Printf("%s Branch pushed directly to %s\n", style.Bold.Render("✓"), defaultBranch)
+				fmt.Printf("%s Branch pushed directly to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), defaultBranch)
 
 				// Close the issue directly — refinery won't process it.
 				if issueID != "" {
@@ -678,7 +695,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 						closeErr = bd.ForceCloseWithReason(
 							fmt.Sprintf("Direct merge to %s (convoy strategy, late detection)", defaultBranch), issueID)
 						if closeErr == nil {
-							fmt.Printf("%s Issue %s closed (direct merge)\n", style.Bold.Render("✓"), issueID)
+							fmt.Printf("%s Issue %s closed (direct merge)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), issueID)
 							break
 						}
 						if attempt < 3 {
@@ -723,6 +740,8 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			}
 		}
 
+		registerBranchArtifact(townRoot, issueID, branch, rigName)
+
 		// Pre-declare for checkpoint goto (gt-aufru)
 		var existingMR *beads.Issue
 
@@ -731,7 +750,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		// re-attempts bd.Create which hits unique constraints or creates duplicates.
 		if checkpoints[CheckpointMRCreated] != "" {
 			mrID = checkpoints[CheckpointMRCreated]
-			fmt.Printf("%s MR already created (resumed from checkpoint: %s)\n", style.Bold.Render("✓"), mrID)
+			fmt.Printf("%s MR already created (resumed from checkpoint: %s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), mrID)
 			goto afterMR
 		}
 
@@ -745,7 +764,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		if existingMR != nil {
 			// MR already exists - use it instead of creating a new one
 			mrID = existingMR.ID
-			fmt.Printf("%s MR already exists (idempotent)\n", style.Bold.Render("✓"))
+			fmt.Printf("%s MR already exists (idempotent)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 			fmt.Printf("  MR ID: %s\n", style.Bold.Render(mrID))
 		} else {
 			// Build MR bead title and description
@@ -813,7 +832,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			}
 
 			// Success output
-			fmt.Printf("%s Work submitted to merge queue (verified)\n", style.Bold.Render("✓"))
+			fmt.Printf("%s Work submitted to merge queue (verified)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 			fmt.Printf("  MR ID: %s\n", style.Bold.Render(mrID))
 
 			// NOTE: Refinery nudge is deferred to AFTER the Dolt branch merge
@@ -840,7 +859,7 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		fmt.Printf("%s\n", style.Dim.Render("The Refinery will process your merge request."))
 	} else {
 		// For ESCALATED or DEFERRED, just print status
-		fmt.Printf("%s Signaling %s\n", style.Bold.Render("→"), exitType)
+		fmt.Printf("%s Signaling %s\n", style.Bold.Render(ui.Glyph("→")), exitType)
 		if issueID != "" {
 			fmt.Printf("  Issue: %s\n", issueID)
 		}
@@ -853,6 +872,12 @@ notifyWitness:
 		nudgeRefinery(rigName, "MERGE_READY received - check inbox for pending work")
 	}
 
+	// Route any structured results (verdicts, artifacts, follow-ups) the
+	// polecat left, before its pane and worktree are recycled.
+	if cwdAvailable {
+		collectDoneResults(townRoot, sender, cwd, issueID)
+	}
+
 	// Write completion metadata to agent bead for audit trail.
 	// Self-managed completion (gt-1qlg): metadata is retained for anomaly
 	// detection and crash recovery by witness patrol, but the witness no
@@ -878,7 +903,7 @@ notifyWitness:
 	// The nudge is kept for observability — witness logs the event but doesn't
 	// need to act on it. Nudges are free (no Dolt commit).
 	nudgeWitness(rigName, fmt.Sprintf("POLECAT_DONE %s exit=%s", polecatName, exitType))
-	fmt.Printf("%s Witness notified of %s (via nudge)\n", style.Bold.Render("✓"), exitType)
+	fmt.Printf("%s Witness notified of %s (via nudge)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), exitType)
 
 	// Write witness notification checkpoint for resume (gt-aufru)
 	if agentBeadID != "" {
@@ -892,6 +917,19 @@ notifyWitness:
 	}
 	if err := events.LogFeed(events.TypeDone, sender, events.DonePayload(issueID, branch)); err != nil {
 		style.PrintWarning("could not log feed event: %v", err)
+	} else if issueID != "" {
+		// Persist the wisp's cycle-time timeline for gt stats (non-fatal)
+		var labels []string
+		var wispType string
+		if issue, err := beads.New(townRoot).Show(issueID); err == nil {
+			labels = issue.Labels
+			if t, ok := wisp.TypeOf(wisp.Types(loadWispSettings(townRoot)), issue); ok {
+				wispType = t.Name
+			}
+		}
+		if err := stats.RecordCompletion(townRoot, issueID, labels, wispType); err != nil {
+			style.PrintWarning("could not record wisp timeline: %v", err)
+		}
 	}
 
 	// Update agent bead state (ZFC: self-report completion)
@@ -904,10 +942,10 @@ notifyWitness:
 	if roleInfo, err := GetRoleWithContext(cwd, townRoot); err == nil && roleInfo.Role == RolePolecat {
 		isPolecat = true
 
-		fmt.Printf("%s Sandbox preserved for reuse (persistent polecat)\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Sandbox preserved for reuse (persistent polecat)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 		if pushFailed || mrFailed {
-			fmt.Printf("%s Work needs recovery (push or MR failed) — session preserved\n", style.Bold.Render("⚠"))
+			fmt.Printf("%s Work needs recovery (push or MR failed) — session preserved\n", style.Bold.Render(ui.Glyph(ui.IconWarn)))
 		}
 
 		// Sync worktree to main so the polecat is ready for new assignments.
@@ -918,13 +956,13 @@ notifyWitness:
 			// Remember the old branch so we can delete it after switching
 			oldBranch := branch
 
-			fmt.Printf("%s Syncing worktree to %s...\n", style.Bold.Render("→"), defaultBranch)
+			fmt.Printf("%s Syncing worktree to %s...\n", style.Bold.Render(ui.Glyph("→")), defaultBranch)
 			if err := g.Checkout(defaultBranch); err != nil {
 				style.PrintWarning("could not checkout %s: %v (worktree stays on feature branch)", defaultBranch, err)
 			} else if err := g.Pull("origin", defaultBranch); err != nil {
 				style.PrintWarning("could not pull %s: %v (worktree on %s but may be stale)", defaultBranch, defaultBranch, err)
 			} else {
-				fmt.Printf("%s Worktree synced to %s\n", style.Bold.Render("✓"), defaultBranch)
+				fmt.Printf("%s Worktree synced to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), defaultBranch)
 			}
 
 			// Delete the old polecat branch (non-fatal: cleanup only).
@@ -933,22 +971,40 @@ notifyWitness:
 				if err := g.DeleteBranch(oldBranch, true); err != nil {
 					style.PrintWarning("could not delete old branch %s: %v", oldBranch, err)
 				} else {
-					fmt.Printf("%s Deleted old branch %s\n", style.Bold.Render("✓"), oldBranch)
+					fmt.Printf("%s Deleted old branch %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), oldBranch)
 				}
 			}
 		}
 
-		fmt.Printf("%s Polecat transitioned to IDLE — ready for new work\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Polecat transitioned to IDLE — ready for new work\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	fmt.Println()
 	if !isPolecat {
-		fmt.Printf("%s Session exiting\n", style.Bold.Render("→"))
+		fmt.Printf("%s Session exiting\n", style.Bold.Render(ui.Glyph("→")))
 		fmt.Printf("  Witness will handle cleanup.\n")
 	}
 	return nil
 }
 
+// collectDoneResults routes the results in a finishing polecat's pane and
+// worktree to its beads. Failures are reported but never block gt done.
+func collectDoneResults(townRoot, sender, workDir, issueID string) {
+	id, err := session.ParseAddress(sender)
+	if err != nil {
+		return
+	}
+	pane, _ := tmux.NewTmux().CapturePaneAll(id.SessionName())
+	c, err := collectAgentResults(townRoot, id, pane, workDir, issueID, false)
+	if err != nil {
+		style.PrintWarning("could not collect results: %v", err)
+		return
+	}
+	if len(c.Routed) > 0 || len(c.Invalid) > 0 || len(c.Failed) > 0 {
+		c.print(false)
+	}
+}
+
 // setDoneIntentLabel writes a done-intent:<type>:<unix-ts> label on the agent bead
 // EARLY in gt done, before push/MR. This allows the Witness to detect polecats that
 // crashed mid-gt-done: if the session is dead but done-intent exists, the polecat was
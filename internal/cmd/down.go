@@ -22,6 +22,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -279,9 +280,9 @@ func runDown(cmd *cobra.Command, args []string) error {
 		respawned := verifyShutdown(t, townRoot)
 		if len(respawned) > 0 {
 			fmt.Println()
-			fmt.Printf("%s Warning: Some processes may have respawned:\n", style.Bold.Render("⚠"))
+			fmt.Printf("%s Warning: Some processes may have respawned:\n", style.Bold.Render(ui.Glyph(ui.IconWarn)))
 			for _, r := range respawned {
-				fmt.Printf("  • %s\n", r)
+				fmt.Printf("  %s %s\n", ui.Glyph("•"), r)
 			}
 			fmt.Println()
 			fmt.Printf("This may indicate a process manager is respawning agents.\n")
@@ -307,7 +308,7 @@ func runDown(cmd *cobra.Command, args []string) error {
 		} else if os.Getenv("GT_NUKE_ACKNOWLEDGED") == "" {
 			fmt.Println()
 			fmt.Printf("%s The --nuke flag kills the shared tmux server (socket: %s).\n",
-				style.Bold.Render("⚠ BLOCKED:"), socketLabel)
+				style.Bold.Render(ui.Glyph(ui.IconWarn)+" BLOCKED:"), socketLabel)
 			fmt.Printf("All towns share this socket — this will destroy all tmux sessions, including any custom windows you opened.\n")
 			fmt.Println()
 			fmt.Printf("To proceed, run with: %s\n", style.Bold.Render("GT_NUKE_ACKNOWLEDGED=1 gt down --nuke"))
@@ -330,7 +331,7 @@ func runDown(cmd *cobra.Command, args []string) error {
 	}
 
 	if allOK {
-		fmt.Printf("%s All services stopped\n", style.Bold.Render("✓"))
+		fmt.Printf("%s All services stopped\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		stoppedServices := []string{"dolt", "daemon", "deacon", "boot", "mayor"}
 		for _, rigName := range rigs {
 			stoppedServices = append(stoppedServices, fmt.Sprintf("%s/refinery", rigName))
@@ -347,7 +348,7 @@ func runDown(cmd *cobra.Command, args []string) error {
 		}
 		_ = events.LogFeed(events.TypeHalt, "gt", events.HaltPayload(stoppedServices))
 	} else {
-		fmt.Printf("%s Some services failed to stop\n", style.Bold.Render("✗"))
+		fmt.Printf("%s Some services failed to stop\n", style.Bold.Render(ui.Glyph("✗")))
 		return fmt.Errorf("not all services stopped")
 	}
 
@@ -384,7 +385,7 @@ func stopAllPolecats(t *tmux.Tmux, townRoot string, rigNames []string, force boo
 		for _, info := range infos {
 			if dryRun {
 				stopped++
-				fmt.Printf("  %s [%s] %s would stop\n", style.Dim.Render("○"), rigName, info.Polecat)
+				fmt.Printf("  %s [%s] %s would stop\n", style.Dim.Render(ui.Glyph("○")), rigName, info.Polecat)
 				continue
 			}
 			err := polecatMgr.Stop(info.Polecat, force)
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/state"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 var enableCmd = &cobra.Command{
@@ -39,12 +40,12 @@ func runEnable(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("enabling Gas Town: %w", err)
 	}
 
-	fmt.Printf("%s Gas Town enabled\n", style.Success.Render("✓"))
+	fmt.Printf("%s Gas Town enabled\n", style.SuccessPrefix)
 	fmt.Println()
 	fmt.Println("Gas Town will now:")
-	fmt.Println("  • Inject context into Claude Code sessions")
-	fmt.Println("  • Set GT_TOWN_ROOT and GT_RIG environment variables")
-	fmt.Println("  • Auto-register git repos as rigs (if configured)")
+	fmt.Println("  " + ui.Glyph("•") + " Inject context into Claude Code sessions")
+	fmt.Println("  " + ui.Glyph("•") + " Set GT_TOWN_ROOT and GT_RIG environment variables")
+	fmt.Println("  " + ui.Glyph("•") + " Auto-register git repos as rigs (if configured)")
 	fmt.Println()
 	fmt.Printf("Use %s to disable, %s to check status\n",
 		style.Dim.Render("gt disable"),
@@ -13,7 +13,9 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/quiethours"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -249,7 +251,7 @@ func runEscalateAck(cmd *cobra.Command, args []string) error {
 		"acked_by":      ackedBy,
 	})
 
-	fmt.Printf("%s Escalation acknowledged: %s\n", style.Bold.Render("✓"), escalationID)
+	fmt.Printf("%s Escalation acknowledged: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), escalationID)
 	return nil
 }
 
@@ -279,7 +281,7 @@ func runEscalateClose(cmd *cobra.Command, args []string) error {
 		"reason":        escalateCloseReason,
 	})
 
-	fmt.Printf("%s Escalation closed: %s\n", style.Bold.Render("✓"), escalationID)
+	fmt.Printf("%s Escalation closed: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), escalationID)
 	fmt.Printf("  Reason: %s\n", escalateCloseReason)
 	return nil
 }
@@ -555,8 +557,13 @@ func extractMailTargetsFromActions(actions []string) []string {
 
 // executeExternalActions processes external notification actions (email:, sms:, slack).
 // For now, this logs warnings if contacts aren't configured - actual sending is future work.
-func executeExternalActions(actions []string, cfg *config.EscalationConfig, _, _, _ string) {
+// During a channel's quiet hours, non-critical pings are held for the digest.
+func executeExternalActions(actions []string, cfg *config.EscalationConfig, beadID, severity, description string) {
 	for _, action := range actions {
+		if channel := externalActionChannel(action); channel != "" && holdExternalPing(channel, beadID, severity, description) {
+			fmt.Printf("  🌙 %s held for the quiet-hours digest\n", channel)
+			continue
+		}
 		switch {
 		case strings.HasPrefix(action, "email:"):
 			if cfg.Contacts.HumanEmail == "" {
@@ -590,6 +597,41 @@ func executeExternalActions(actions []string, cfg *config.EscalationConfig, _, _
 	}
 }
 
+// externalActionChannel maps an external action to its quiet-hours channel.
+func externalActionChannel(action string) string {
+	switch {
+	case strings.HasPrefix(action, "email:"):
+		return quiethours.ChannelEmail
+	case strings.HasPrefix(action, "sms:"):
+		return quiethours.ChannelSMS
+	case action == "slack":
+		return quiethours.ChannelSlack
+	}
+	return ""
+}
+
+// holdExternalPing holds a non-critical escalation ping when channel is in
+// quiet hours. Returns true if held.
+func holdExternalPing(channel, beadID, severity, description string) bool {
+	if severity == config.SeverityCritical {
+		return false
+	}
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return false
+	}
+	policy, err := quiethours.LoadPolicy(townRoot)
+	if err != nil || !policy.Quiet(channel, time.Now()) {
+		return false
+	}
+	return quiethours.Hold(townRoot, quiethours.Held{
+		Channel: channel,
+		To:      "human",
+		Subject: fmt.Sprintf("[%s] %s", strings.ToUpper(severity), description),
+		Body:    "Escalation " + beadID,
+	}) == nil
+}
+
 func formatEscalationMailBody(beadID, severity, reason, from, related string) string {
 	var lines []string
 	lines = append(lines, fmt.Sprintf("Escalation ID: %s", beadID))
@@ -616,7 +658,7 @@ func severityEmoji(severity string) string {
 	case config.SeverityCritical:
 		return "🚨"
 	case config.SeverityHigh:
-		return "⚠️"
+		return ui.Glyph("⚠️")
 	case config.SeverityMedium:
 		return "📢"
 	case config.SeverityLow:
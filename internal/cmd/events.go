@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	eventsTailTypes   []string
+	eventsTailActor   string
+	eventsTailJSON    bool
+	eventsTailBacklog int
+)
+
+var eventsCmd = &cobra.Command{
+	Use:     "events",
+	GroupID: GroupDiag,
+	Short:   "Subscribe to Gas Town events",
+	Long: `Work with the town event stream (the same events gt feed shows).
+
+Use 'gt events tail' to build automations - desktop notifications, custom
+dashboards - on top of Gas Town without polling.`,
+	RunE: requireSubcommand,
+}
+
+var eventsTailCmd = &cobra.Command{
+	Use:   "tail",
+	Short: "Stream events as they happen",
+	Long: `Stream town events as they happen.
+
+Events come from the daemon's event socket (daemon/events.sock), which any
+script can also connect to directly: send one JSON line such as
+{"types":["merged"],"backlog":10} and read NDJSON events back. When the
+daemon isn't running, gt events tail follows .events.jsonl itself.
+
+--type may be repeated or comma-separated, and accepts glob patterns.
+
+Examples:
+  gt events tail                          # Everything, human-readable
+  gt events tail --type merged --json     # NDJSON for scripts
+  gt events tail --type 'merge_*' -n 20   # Last 20 merge events, then live
+  gt events tail --actor gastown/witness  # One agent's events
+
+  # Desktop notification on every merge
+  gt events tail --type merged --json | while read -r ev; do
+    notify-send "merged" "$(echo "$ev" | jq -r .payload.branch)"
+  done`,
+	Args: cobra.NoArgs,
+	RunE: runEventsTail,
+}
+
+func init() {
+	eventsTailCmd.Flags().StringSliceVar(&eventsTailTypes, "type", nil, "Event types to include (repeatable, globs allowed)")
+	eventsTailCmd.Flags().StringVar(&eventsTailActor, "actor", "", "Only events from this actor")
+	eventsTailCmd.Flags().BoolVar(&eventsTailJSON, "json", false, "Output NDJSON (one event per line)")
+	eventsTailCmd.Flags().IntVarP(&eventsTailBacklog, "backlog", "n", 0, "Print this many recent matching events first")
+
+	eventsCmd.AddCommand(eventsTailCmd)
+	rootCmd.AddCommand(eventsCmd)
+}
+
+func runEventsTail(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	enc := json.NewEncoder(os.Stdout)
+	emit := func(e events.Event) error {
+		if eventsTailJSON {
+			return enc.Encode(e)
+		}
+		_, err := fmt.Println(formatEventLine(e))
+		return err
+	}
+
+	req := events.SubscribeRequest{
+		Filter:  events.Filter{Types: eventsTailTypes, Actor: eventsTailActor},
+		Backlog: eventsTailBacklog,
+	}
+	err = events.Subscribe(ctx, townRoot, req, emit)
+	if !errors.Is(err, events.ErrNoStreamServer) {
+		return err
+	}
+
+	// No daemon: follow the log directly.
+	if !eventsTailJSON {
+		fmt.Fprintln(os.Stderr, style.Dim.Render("(daemon not running; following .events.jsonl directly)"))
+	}
+	backlog, err := events.Recent(townRoot, req.Filter, req.Backlog)
+	if err != nil {
+		return err
+	}
+	for _, e := range backlog {
+		if err := emit(e); err != nil {
+			return err
+		}
+	}
+	return events.Follow(ctx, townRoot, req.Filter, emit)
+}
+
+// formatEventLine renders an event as "15:04:05 type actor key=value ...".
+func formatEventLine(e events.Event) string {
+	var b strings.Builder
+	b.WriteString(style.Dim.Render(e.Time().Local().Format("15:04:05")))
+	b.WriteString(" ")
+	b.WriteString(style.Bold.Render(e.Type))
+	if e.Actor != "" {
+		b.WriteString(" ")
+		b.WriteString(e.Actor)
+	}
+	keys := make([]string, 0, len(e.Payload))
+	for k := range e.Payload {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, " %s=%v", k, e.Payload[k])
+	}
+	return b.String()
+}
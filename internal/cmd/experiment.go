@@ -0,0 +1,359 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/experiment"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	experimentKind  string
+	experimentA     string
+	experimentB     string
+	experimentSplit int
+	experimentRig   string
+	experimentJSON  bool
+)
+
+var experimentCmd = &cobra.Command{
+	Use:     "experiment",
+	Aliases: []string{"exp"},
+	GroupID: GroupWork,
+	Short:   "Run A/B experiments on polecat prompts and models",
+	Long: `Run A/B experiments comparing two prime-template or model variants.
+
+While an experiment is active, each wisp slung to a polecat in its scope is
+assigned a variant by a stable hash of the bead ID (re-slinging keeps the
+variant). The variant is applied to the polecat session:
+
+  prompt  the variant's template file replaces the polecat prime template
+  model   the variant's agent alias is used (unless sling --agent is given)
+
+Sessions carry GT_EXPERIMENT=<experiment>/<variant>, which tags all telemetry
+with gt.experiment. Assignments are recorded in .runtime/experiments/.
+
+Experiments are defined in settings/experiments.json.`,
+	RunE: requireSubcommand,
+}
+
+var experimentCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Define and start an experiment",
+	Long: `Define and start an experiment with two variants.
+
+Variants are given as name=value. For prompt experiments the value is a
+template path relative to the town root; for model experiments it is an
+agent alias.
+
+Examples:
+  gt experiment create terse --kind prompt \
+      --a control=templates/polecat.md.tmpl --b terse=templates/polecat-terse.md.tmpl
+  gt experiment create cheap --kind model --a control=claude --b haiku=claude-haiku --split 80
+  gt experiment create cheap --kind model --a control=claude --b haiku=claude-haiku --rig gastown`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExperimentCreate,
+}
+
+var experimentListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List experiments",
+	RunE:  runExperimentList,
+}
+
+var experimentStopCmd = &cobra.Command{
+	Use:   "stop <name>",
+	Short: "Stop assigning wisps to an experiment",
+	Long: `Stop an experiment. Wisps already assigned keep their variant and
+still count toward the report.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExperimentStop,
+}
+
+var experimentReportCmd = &cobra.Command{
+	Use:   "report <name>",
+	Short: "Compare outcomes between variants",
+	Long: `Compare outcomes between an experiment's variants.
+
+Columns:
+  ASSIGNED    wisps assigned to the variant
+  DONE        wisps completed (gt done)
+  COMPLETION  done / assigned
+  PASS        refinery review pass rate (merged / merged + failed)
+  COST        total session cost attributed to the variant's wisps
+  $/WISP      cost per completed wisp
+
+Examples:
+  gt experiment report cheap
+  gt experiment report cheap --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExperimentReport,
+}
+
+func init() {
+	experimentCreateCmd.Flags().StringVar(&experimentKind, "kind", experiment.KindModel, "Experiment kind: prompt or model")
+	experimentCreateCmd.Flags().StringVar(&experimentA, "a", "", "First variant as name=value (required)")
+	experimentCreateCmd.Flags().StringVar(&experimentB, "b", "", "Second variant as name=value (required)")
+	experimentCreateCmd.Flags().IntVar(&experimentSplit, "split", experiment.DefaultSplit, "Percent of wisps assigned to the first variant")
+	experimentCreateCmd.Flags().StringVar(&experimentRig, "rig", "", "Limit the experiment to one rig")
+	_ = experimentCreateCmd.MarkFlagRequired("a")
+	_ = experimentCreateCmd.MarkFlagRequired("b")
+
+	experimentListCmd.Flags().BoolVar(&experimentJSON, "json", false, "Output as JSON")
+	experimentReportCmd.Flags().BoolVar(&experimentJSON, "json", false, "Output as JSON")
+
+	experimentCmd.AddCommand(experimentCreateCmd)
+	experimentCmd.AddCommand(experimentListCmd)
+	experimentCmd.AddCommand(experimentStopCmd)
+	experimentCmd.AddCommand(experimentReportCmd)
+	rootCmd.AddCommand(experimentCmd)
+}
+
+func parseExperimentVariant(spec string) (experiment.Variant, error) {
+	name, value, ok := strings.Cut(spec, "=")
+	if !ok || name == "" || value == "" {
+		return experiment.Variant{}, fmt.Errorf("invalid variant %q (want name=value)", spec)
+	}
+	return experiment.Variant{Name: name, Value: value}, nil
+}
+
+func runExperimentCreate(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	a, err := parseExperimentVariant(experimentA)
+	if err != nil {
+		return err
+	}
+	b, err := parseExperimentVariant(experimentB)
+	if err != nil {
+		return err
+	}
+	e := &experiment.Experiment{
+		Name:     args[0],
+		Kind:     experimentKind,
+		Rig:      experimentRig,
+		Split:    experimentSplit,
+		Variants: [2]experiment.Variant{a, b},
+		Status:   experiment.StatusActive,
+		Created:  time.Now().UTC(),
+	}
+	if e.Kind == experiment.KindPrompt {
+		for _, v := range e.Variants {
+			if _, err := os.Stat(filepath.Join(townRoot, v.Value)); err != nil {
+				return fmt.Errorf("variant %s: template %s: %w", v.Name, v.Value, err)
+			}
+		}
+	}
+
+	store, err := experiment.LoadStore(townRoot)
+	if err != nil {
+		return err
+	}
+	if err := store.Add(e); err != nil {
+		return err
+	}
+	if err := experiment.SaveStore(townRoot, store); err != nil {
+		return err
+	}
+
+	scope := "all rigs"
+	if e.Rig != "" {
+		scope = "rig " + e.Rig
+	}
+	fmt.Printf("%s Started %s experiment %s on %s (%d%% %s / %d%% %s)\n",
+		style.SuccessPrefix, e.Kind, style.Bold.Render(e.Name), scope,
+		e.Split, a.Name, 100-e.Split, b.Name)
+	if other := store.ActiveFor(e.Rig); other != nil && other != e {
+		style.PrintWarning("experiment %s also covers this scope and takes precedence", other.Name)
+	}
+	return nil
+}
+
+func runExperimentList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	store, err := experiment.LoadStore(townRoot)
+	if err != nil {
+		return err
+	}
+
+	if experimentJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(store.Experiments)
+	}
+	if len(store.Experiments) == 0 {
+		fmt.Println("No experiments defined.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tKIND\tSTATUS\tRIG\tSPLIT\tVARIANTS")
+	for _, e := range store.Experiments {
+		rig := e.Rig
+		if rig == "" {
+			rig = "*"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d/%d\t%s=%s, %s=%s\n",
+			e.Name, e.Kind, e.Status, rig, e.Split, 100-e.Split,
+			e.Variants[0].Name, e.Variants[0].Value, e.Variants[1].Name, e.Variants[1].Value)
+	}
+	return w.Flush()
+}
+
+func runExperimentStop(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	store, err := experiment.LoadStore(townRoot)
+	if err != nil {
+		return err
+	}
+	e, err := store.Get(args[0])
+	if err != nil {
+		return err
+	}
+	if e.Status == experiment.StatusStopped {
+		fmt.Printf("Experiment %s is already stopped.\n", e.Name)
+		return nil
+	}
+	now := time.Now().UTC()
+	e.Status = experiment.StatusStopped
+	e.Stopped = &now
+	if err := experiment.SaveStore(townRoot, store); err != nil {
+		return err
+	}
+	fmt.Printf("%s Stopped experiment %s\n", style.SuccessPrefix, style.Bold.Render(e.Name))
+	return nil
+}
+
+func runExperimentReport(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	store, err := experiment.LoadStore(townRoot)
+	if err != nil {
+		return err
+	}
+	e, err := store.Get(args[0])
+	if err != nil {
+		return err
+	}
+
+	assignments, err := experiment.LoadAssignments(townRoot, e.Name)
+	if err != nil {
+		return fmt.Errorf("loading assignments: %w", err)
+	}
+	timelines, err := stats.Load(townRoot)
+	if err != nil {
+		return fmt.Errorf("loading wisp timelines: %w", err)
+	}
+	evs, err := events.ReadAll(townRoot)
+	if err != nil {
+		return fmt.Errorf("reading events: %w", err)
+	}
+	costByBead := make(map[string]float64)
+	for _, entry := range readCostLogEntries() {
+		if entry.WorkItem != "" {
+			costByBead[entry.WorkItem] += entry.CostUSD
+		}
+	}
+	report := experiment.BuildReport(e, assignments, timelines, evs, costByBead)
+
+	if experimentJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	fmt.Printf("%s %s\n\n", style.Bold.Render("Experiment "+e.Name),
+		style.Dim.Render(fmt.Sprintf("(%s, %s)", e.Kind, e.Status)))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VARIANT\tVALUE\tASSIGNED\tDONE\tCOMPLETION\tPASS\tCOST\t$/WISP")
+	for _, v := range report.Variants {
+		pass := "-"
+		if v.Merged+v.MergeFailed > 0 {
+			pass = fmt.Sprintf("%.0f%%", v.ReviewPassRate*100)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%.0f%%\t%s\t$%.2f\t$%.2f\n",
+			v.Variant, v.Value, v.Assigned, v.Completed, v.CompletionRate*100, pass, v.CostUSD, v.CostPerWisp)
+	}
+	return w.Flush()
+}
+
+// assignExperimentVariant returns the active experiment and variant for a
+// wisp slung into rig, or nil when no experiment applies. Model experiments
+// are skipped when the agent was chosen explicitly, since the variant would
+// not actually run.
+func assignExperimentVariant(townRoot, rig, bead, agentOverride string) (*experiment.Experiment, experiment.Variant) {
+	if bead == "" {
+		return nil, experiment.Variant{}
+	}
+	store, err := experiment.LoadStore(townRoot)
+	if err != nil {
+		style.PrintWarning("could not load experiments: %v", err)
+		return nil, experiment.Variant{}
+	}
+	e := store.ActiveFor(rig)
+	if e == nil || (e.Kind == experiment.KindModel && agentOverride != "") {
+		return nil, experiment.Variant{}
+	}
+	return e, e.Assign(bead)
+}
+
+// recordExperimentAssignment appends a wisp's variant to the assignment
+// ledger once its polecat session has started.
+func recordExperimentAssignment(townRoot, tag, bead, agent string) {
+	name, variant, ok := experiment.ParseTag(tag)
+	if !ok || bead == "" {
+		return
+	}
+	if err := experiment.Record(townRoot, experiment.Assignment{
+		Experiment: name,
+		Variant:    variant,
+		Bead:       bead,
+		Agent:      agent,
+		At:         time.Now().UTC(),
+	}); err != nil {
+		style.PrintWarning("could not record experiment assignment: %v", err)
+	}
+}
+
+// experimentPromptTemplate returns the prime template path for the prompt
+// experiment variant in GT_EXPERIMENT, or "" when none applies.
+func experimentPromptTemplate(townRoot string) string {
+	name, variant, ok := experiment.ParseTag(os.Getenv(experiment.EnvVar))
+	if !ok || townRoot == "" {
+		return ""
+	}
+	store, err := experiment.LoadStore(townRoot)
+	if err != nil {
+		return ""
+	}
+	e, err := store.Get(name)
+	if err != nil || e.Kind != experiment.KindPrompt {
+		return ""
+	}
+	v, ok := e.Variant(variant)
+	if !ok {
+		return ""
+	}
+	return filepath.Join(townRoot, v.Value)
+}
@@ -0,0 +1,406 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/failover"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	failoverJSON   bool
+	failoverDryRun bool
+	failoverAll    bool
+)
+
+var failoverCmd = &cobra.Command{
+	Use:     "failover",
+	GroupID: GroupServices,
+	Short:   "Fall back to another agent when a provider is down",
+	RunE:    requireSubcommand,
+	Long: `Move agents off a failing model provider and back when it recovers.
+
+Sessions are scanned for provider errors (server errors, overload,
+connection failures); only output new since the last scan counts. When an
+agent's sessions show new errors on several consecutive scans, that agent
+is failed over: new sessions for roles that resolve to it start on its
+fallback agent instead. Once no errors have been seen for a while and the
+agent shows it works again, the failover clears by itself. The health
+signal is new error-free output from a session still on the agent, or a
+passing health_check command (run with GT_AGENT set).
+
+Configure fallbacks in settings/config.json:
+
+  "failover": {
+    "fallbacks": {"claude-opus": "claude-sonnet", "claude": "gemini"},
+    "threshold": 3,
+    "recover_after": "15m",
+    "health_check": "curl -sf https://status.example.com/api/ok",
+    "resume_sessions": true
+  }
+
+With resume_sessions, running sessions that are failing are restarted on
+the fallback and pick their work back up from their checkpoint.
+
+The daemon runs gt failover check every two minutes while fallbacks are
+configured.
+
+Commands:
+  gt failover status         Show fallbacks and active failovers
+  gt failover check          Scan sessions and fail over or recover
+  gt failover clear          End a failover by hand`,
+}
+
+var failoverStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show fallbacks and active failovers",
+	Long: `Show each configured fallback, whether its agent is failed over, and
+the most recent provider error seen for it.
+
+Examples:
+  gt failover status
+  gt failover status --json`,
+	Args: cobra.NoArgs,
+	RunE: runFailoverStatus,
+}
+
+var failoverCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Scan sessions and fail over or recover",
+	Long: `Scan agent sessions for provider errors and update failover state.
+
+Agents that have failed for enough consecutive scans fail over to their
+fallback; failed-over agents with no recent errors and a health signal
+recover. Each change is
+logged to the feed and mailed to the notify list (default: overseer).
+
+Examples:
+  gt failover check             # What the daemon runs
+  gt failover check --dry-run   # Show errors and changes, save nothing`,
+	Args: cobra.NoArgs,
+	RunE: runFailoverCheck,
+}
+
+var failoverClearCmd = &cobra.Command{
+	Use:   "clear [agent...]",
+	Short: "End a failover by hand",
+	Long: `Send new sessions back to an agent now instead of waiting for the
+failover to clear by itself.
+
+Examples:
+  gt failover clear claude-opus
+  gt failover clear --all`,
+	RunE: runFailoverClear,
+}
+
+func init() {
+	failoverStatusCmd.Flags().BoolVar(&failoverJSON, "json", false, "Output as JSON")
+	failoverCheckCmd.Flags().BoolVar(&failoverDryRun, "dry-run", false, "Show what would change without saving or notifying")
+	failoverClearCmd.Flags().BoolVar(&failoverAll, "all", false, "Clear every active failover")
+
+	failoverCmd.AddCommand(failoverStatusCmd)
+	failoverCmd.AddCommand(failoverCheckCmd)
+	failoverCmd.AddCommand(failoverClearCmd)
+	rootCmd.AddCommand(failoverCmd)
+}
+
+// FailoverStatusItem is one agent in gt failover status output.
+type FailoverStatusItem struct {
+	Agent       string     `json:"agent"`
+	Fallback    string     `json:"fallback"`
+	Active      bool       `json:"active"`
+	Since       *time.Time `json:"since,omitempty"`
+	Streak      int        `json:"streak,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"`
+}
+
+// failoverStatusItems merges the configured fallbacks with the state,
+// including failovers whose fallback has since been removed from settings.
+func failoverStatusItems(policy *failover.Policy, st *config.FailoverState) []FailoverStatusItem {
+	agents := map[string]bool{}
+	for agent := range policy.Fallbacks {
+		agents[agent] = true
+	}
+	for agent := range st.Agents {
+		agents[agent] = true
+	}
+	items := make([]FailoverStatusItem, 0, len(agents))
+	for agent := range agents {
+		item := FailoverStatusItem{Agent: agent, Fallback: policy.Fallbacks[agent]}
+		if af := st.Agents[agent]; af != nil {
+			item.Streak = af.Streak
+			item.LastError = af.LastError
+			if !af.LastErrorAt.IsZero() {
+				item.LastErrorAt = &af.LastErrorAt
+			}
+			if af.Active() {
+				item.Active = true
+				item.Fallback = af.Fallback
+				item.Since = &af.Since
+			}
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Agent < items[j].Agent })
+	return items
+}
+
+func runFailoverStatus(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := failover.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	st, err := config.LoadFailoverState(townRoot)
+	if err != nil {
+		return err
+	}
+	items := failoverStatusItems(policy, st)
+
+	if failoverJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(items)
+	}
+	if len(items) == 0 {
+		fmt.Println("No fallbacks configured (settings/config.json failover.fallbacks).")
+		return nil
+	}
+	for _, item := range items {
+		if item.Active {
+			fmt.Printf("%s %s → %s %s\n", style.WarningPrefix, item.Agent, item.Fallback,
+				style.Dim.Render("failed over "+ui.FormatRelative(*item.Since)))
+		} else {
+			fmt.Printf("%s %s %s\n", style.SuccessPrefix, item.Agent,
+				style.Dim.Render("(fallback: "+item.Fallback+")"))
+		}
+		if item.LastError != "" {
+			fmt.Printf("    last error %s: %s\n", ui.FormatRelative(*item.LastErrorAt), item.LastError)
+		}
+	}
+	return nil
+}
+
+func runFailoverCheck(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := failover.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	st, err := config.LoadFailoverState(townRoot)
+	if err != nil {
+		return err
+	}
+
+	t := tmux.NewTmux()
+	scans, err := policy.Scan(t, st)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for agent, af := range st.Agents {
+		if af.Active() && policy.CheckHealth(townRoot, agent) {
+			failover.RecordHealth(st, agent, now)
+		}
+	}
+	for _, s := range scans {
+		if s.Error != "" {
+			fmt.Printf("%s %s (%s): %s\n", style.WarningPrefix, s.Session, s.Agent, s.Error)
+		}
+	}
+
+	transitions := policy.Update(st, scans, now)
+	for _, tr := range transitions {
+		if tr.Recovered {
+			fmt.Printf("%s %s recovered; new sessions use it again\n", style.SuccessPrefix, tr.Agent)
+		} else {
+			fmt.Printf("%s %s failed over to %s\n", style.WarningPrefix, tr.Agent, tr.Fallback)
+		}
+	}
+	if failoverDryRun {
+		return nil
+	}
+	if err := failover.SaveState(townRoot, st); err != nil {
+		return fmt.Errorf("saving failover state: %w", err)
+	}
+
+	var resumed []string
+	if policy.ResumeSessions {
+		for _, s := range failover.ToResume(st, scans) {
+			fallback := st.Agents[s.Agent].Fallback
+			if err := restartOnAgent(t, s.Session, fallback); err != nil {
+				style.PrintWarning("could not restart %s on %s: %v", s.Session, fallback, err)
+				continue
+			}
+			fmt.Printf("%s restarted %s on %s\n", style.SuccessPrefix, s.Session, fallback)
+			resumed = append(resumed, s.Session+" → "+fallback)
+		}
+	}
+
+	for _, tr := range transitions {
+		payload := map[string]interface{}{"agent": tr.Agent, "fallback": tr.Fallback}
+		if tr.Recovered {
+			_ = events.LogFeed(events.TypeProviderRecovered, "daemon", payload)
+			continue
+		}
+		payload["error"] = tr.Error
+		payload["sessions"] = tr.Sessions
+		_ = events.LogFeed(events.TypeProviderFailover, "daemon", payload)
+	}
+	if len(transitions) == 0 && len(resumed) == 0 {
+		return nil
+	}
+	return notifyFailover(townRoot, policy, transitions, resumed)
+}
+
+// restartOnAgent respawns a session's pane on agent. The new session primes
+// from its hook and checkpoint like any other restart.
+func restartOnAgent(t *tmux.Tmux, session, agent string) error {
+	restartCmd, err := buildRestartCommandWithOpts(session, buildRestartCommandOpts{Agent: agent})
+	if err != nil {
+		return fmt.Errorf("building restart command: %w", err)
+	}
+	pane, err := t.GetPaneID(session)
+	if err != nil {
+		return fmt.Errorf("getting pane: %w", err)
+	}
+	if err := t.SetRemainOnExit(pane, true); err != nil {
+		style.PrintWarning("could not set remain-on-exit for %s: %v", session, err)
+	}
+	if err := t.KillPaneProcesses(pane); err != nil {
+		style.PrintWarning("could not kill pane processes for %s: %v", session, err)
+	}
+	if err := t.ClearHistory(pane); err != nil {
+		style.PrintWarning("could not clear history for %s: %v", session, err)
+	}
+	if err := t.RespawnPane(pane, restartCmd); err != nil {
+		return fmt.Errorf("respawning pane: %w", err)
+	}
+	// The scanner and later restarts read the agent from the tmux environment.
+	if err := t.SetEnvironment(session, "GT_AGENT", agent); err != nil {
+		style.PrintWarning("could not set GT_AGENT for %s: %v", session, err)
+	}
+	return nil
+}
+
+// notifyFailover mails the notify list about failovers, recoveries, and
+// restarted sessions.
+func notifyFailover(townRoot string, policy *failover.Policy, transitions []failover.Transition, resumed []string) error {
+	var body strings.Builder
+	var agents []string
+	urgent := false
+	for _, tr := range transitions {
+		agents = append(agents, tr.Agent)
+		if tr.Recovered {
+			fmt.Fprintf(&body, "%s recovered: new sessions use it again instead of %s.\n", tr.Agent, tr.Fallback)
+			continue
+		}
+		urgent = true
+		fmt.Fprintf(&body, "%s failed over to %s after %d scans with provider errors (%d session(s) failing).\n", tr.Agent, tr.Fallback, policy.Threshold, tr.Sessions)
+		fmt.Fprintf(&body, "    %s\n", tr.Error)
+	}
+	if len(resumed) > 0 {
+		body.WriteString("\nRestarted on the fallback:\n")
+		for _, r := range resumed {
+			fmt.Fprintf(&body, "  %s\n", r)
+		}
+	}
+	body.WriteString("\nDetails: gt failover status")
+
+	subject := "FAILOVER: sessions restarted on fallback agents"
+	if len(agents) > 0 {
+		subject = "FAILOVER: " + strings.Join(agents, ", ")
+		if !urgent {
+			subject += " recovered"
+		}
+	}
+	priority := mail.PriorityNormal
+	if urgent {
+		priority = mail.PriorityUrgent
+	}
+
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	var sendErrs []error
+	for _, to := range policy.Notify {
+		if _, err := sendOrHoldMail(townRoot, router, &mail.Message{
+			From:     "daemon",
+			To:       to,
+			Subject:  subject,
+			Body:     body.String(),
+			Priority: priority,
+		}, urgent); err != nil {
+			sendErrs = append(sendErrs, fmt.Errorf("%s: %w", to, err))
+		}
+	}
+	if len(sendErrs) > 0 {
+		return fmt.Errorf("sending failover notice: %w", errors.Join(sendErrs...))
+	}
+	return nil
+}
+
+func runFailoverClear(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 && !failoverAll {
+		return fmt.Errorf("name an agent to clear, or use --all")
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	st, err := config.LoadFailoverState(townRoot)
+	if err != nil {
+		return err
+	}
+	if failoverAll {
+		args = args[:0]
+		for agent, af := range st.Agents {
+			if af.Active() {
+				args = append(args, agent)
+			}
+		}
+		sort.Strings(args)
+	}
+	cleared := 0
+	for _, agent := range args {
+		fallback := ""
+		if af := st.Agents[agent]; af != nil {
+			fallback = af.Fallback
+		}
+		if !failover.Clear(st, agent) {
+			fmt.Printf("%s %s is not failed over\n", style.Dim.Render("-"), agent)
+			continue
+		}
+		_ = events.LogFeed(events.TypeProviderRecovered, detectSender(), map[string]interface{}{
+			"agent": agent, "fallback": fallback, "manual": true,
+		})
+		fmt.Printf("%s %s cleared; new sessions use it again\n", style.SuccessPrefix, agent)
+		cleared++
+	}
+	if cleared == 0 {
+		return nil
+	}
+	if err := failover.SaveState(townRoot, st); err != nil {
+		return fmt.Errorf("saving failover state: %w", err)
+	}
+	return nil
+}
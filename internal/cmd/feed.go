@@ -25,6 +25,7 @@ var (
 	feedWindow   bool
 	feedPlain    bool
 	feedProblems bool
+	feedView     string
 )
 
 func init() {
@@ -40,6 +41,7 @@ func init() {
 	feedCmd.Flags().BoolVarP(&feedWindow, "window", "w", false, "Open in dedicated tmux window (creates 'feed' window)")
 	feedCmd.Flags().BoolVar(&feedPlain, "plain", false, "Use plain text output (bd activity) instead of TUI")
 	feedCmd.Flags().BoolVarP(&feedProblems, "problems", "p", false, "Start in problems view (shows stuck agents)")
+	feedCmd.Flags().StringVar(&feedView, "view", "", "Only show activity on wisps in this saved view (TUI)")
 }
 
 var feedCmd = &cobra.Command{
@@ -103,7 +105,8 @@ Examples:
   gt feed --plain               # Plain text output (bd activity)
   gt feed --window              # Open in dedicated tmux window
   gt feed --since 1h            # Events from last hour
-  gt feed --rig greenplace      # Use gastown rig's beads`,
+  gt feed --rig greenplace      # Use gastown rig's beads
+  gt feed --view urgent-frontend  # Only wisps in a saved view (see gt wisp list)`,
 	RunE: runFeed,
 }
 
@@ -277,6 +280,13 @@ func runFeedTUI(workDir string, problemsView bool) error {
 	}
 	m.SetEventChannel(multiSource.Events())
 	m.SetTownRoot(townRoot)
+	if feedView != "" {
+		ids, err := wispViewBeadIDs(townRoot, feedView)
+		if err != nil {
+			return err
+		}
+		m.SetWispView(feedView, ids)
+	}
 
 	// Run the TUI
 	p := tea.NewProgram(m, tea.WithAltScreen())
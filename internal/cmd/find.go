@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/memory"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var findJSON bool
+
+var findCmd = &cobra.Command{
+	Use:     "find <query>...",
+	GroupID: GroupWork,
+	Short:   "Search agent notes and town memories",
+	Long: `Search what the town has learned: every agent's own notes
+(gt remember --self) and the shared town memories (gt remember).
+
+Matching is case-insensitive substring. Agent notes are listed newest
+first, so "has anyone hit this before?" is one command.
+
+Examples:
+  gt find dolt
+  gt find "rebase conflict"
+  gt find worktree --json`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runFind,
+}
+
+func init() {
+	findCmd.Flags().BoolVar(&findJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(findCmd)
+}
+
+// findResult is gt find's JSON output.
+type findResult struct {
+	Notes    []memory.Match    `json:"notes"`
+	Memories map[string]string `json:"memories"`
+}
+
+func runFind(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	query := strings.Join(args, " ")
+
+	notes, err := memory.Search(townRoot, query)
+	if err != nil {
+		return fmt.Errorf("searching agent notes: %w", err)
+	}
+
+	// Town memories live in beads kv; search them when bd is available.
+	memories := map[string]string{}
+	if kvs, err := bdKvListJSON(); err == nil {
+		lower := strings.ToLower(query)
+		for k, v := range kvs {
+			if !strings.HasPrefix(k, memoryKeyPrefix) {
+				continue
+			}
+			key := strings.TrimPrefix(k, memoryKeyPrefix)
+			if strings.Contains(strings.ToLower(key), lower) || strings.Contains(strings.ToLower(v), lower) {
+				memories[key] = v
+			}
+		}
+	}
+
+	if findJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(findResult{Notes: notes, Memories: memories})
+	}
+
+	if len(notes) == 0 && len(memories) == 0 {
+		fmt.Printf("Nothing found for %q\n", query)
+		return nil
+	}
+	if len(notes) > 0 {
+		fmt.Printf("%s (%d)\n\n", style.Bold.Render("Agent notes"), len(notes))
+		for _, m := range notes {
+			fmt.Printf("  %s %s\n", style.Dim.Render(m.Time.Local().Format("2006-01-02")+" "+m.Agent), m.Text)
+		}
+	}
+	if len(memories) > 0 {
+		if len(notes) > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s (%d)\n\n", style.Bold.Render("Town memories"), len(memories))
+		keys := make([]string, 0, len(memories))
+		for k := range memories {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Printf("  %s %s\n", style.Bold.Render(k), memories[k])
+		}
+	}
+	return nil
+}
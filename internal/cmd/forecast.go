@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	forecastBy          string
+	forecastWeeks       int
+	forecastInflowWeeks int
+	forecastJSON        bool
+)
+
+var forecastCmd = &cobra.Command{
+	Use:     "forecast",
+	GroupID: GroupDiag,
+	Short:   "Forecast when the open wisp backlog will clear",
+	Long: `Estimate when each rig's (or agent's) open wisp backlog will clear.
+
+The backlog is each rig's ready beads (open work not yet slung) plus every
+slung wisp that is still open; wisps that were dropped or closed without
+completing no longer count. Throughput history comes from the same
+timelines as gt stats: completions per week over the last --weeks weeks.
+Completion dates are simulated by replaying randomly sampled historical
+weeks against the backlog, so busy and quiet weeks both show up in the
+range:
+
+  P50   half of the simulations finished by this date
+  P85   a reasonable commitment date
+  P95   a conservative date
+
+Rigs whose inflow (wisps slung) exceeded throughput in each of the last
+--inflow-weeks weeks are flagged as GROWING: at the current rate, their
+backlog will not clear on its own.
+
+Examples:
+  gt forecast                   # Per rig, 8 weeks of history
+  gt forecast --by agent
+  gt forecast --weeks 12 --inflow-weeks 4
+  gt forecast --json`,
+	Args: cobra.NoArgs,
+	RunE: runForecast,
+}
+
+func init() {
+	forecastCmd.Flags().StringVar(&forecastBy, "by", stats.ByRig, "Group by: rig or agent")
+	forecastCmd.Flags().IntVar(&forecastWeeks, "weeks", stats.DefaultForecastWeeks, "Weeks of throughput history to sample")
+	forecastCmd.Flags().IntVar(&forecastInflowWeeks, "inflow-weeks", stats.DefaultForecastInflowWeeks, "Flag groups whose inflow exceeded throughput for this many recent weeks")
+	forecastCmd.Flags().BoolVar(&forecastJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(forecastCmd)
+}
+
+func runForecast(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if forecastBy != stats.ByRig && forecastBy != stats.ByAgent {
+		return fmt.Errorf("unknown grouping %q (want %s or %s)", forecastBy, stats.ByRig, stats.ByAgent)
+	}
+	if forecastWeeks < 1 || forecastInflowWeeks < 1 || forecastInflowWeeks > forecastWeeks {
+		return fmt.Errorf("--weeks and --inflow-weeks must be positive, with --inflow-weeks <= --weeks")
+	}
+	key, err := stats.GroupKey(forecastBy)
+	if err != nil {
+		return err
+	}
+
+	timelines, err := stats.Load(townRoot)
+	if err != nil {
+		return fmt.Errorf("loading wisp timelines: %w", err)
+	}
+	now := time.Now()
+	ready, closed := forecastOpenWork(townRoot, timelines)
+	forecasts := stats.BuildForecasts(timelines, key, stats.ForecastOptions{
+		Weeks:       forecastWeeks,
+		InflowWeeks: forecastInflowWeeks,
+		Now:         now,
+		Ready:       ready,
+		Closed:      closed,
+	})
+
+	if forecastJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(forecasts)
+	}
+
+	if len(forecasts) == 0 {
+		fmt.Printf("No wisp activity in the last %d weeks.\n", forecastWeeks)
+		return nil
+	}
+
+	fmt.Printf("%s %s\n\n", style.Bold.Render("Backlog forecast by "+forecastBy),
+		style.Dim.Render(fmt.Sprintf("(%d weeks of history)", forecastWeeks)))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tOPEN\t/WEEK\tP50\tP85\tP95\t")
+	var growing []string
+	for _, f := range forecasts {
+		var p50, p85, p95 string
+		switch {
+		case f.Backlog == 0:
+			p50, p85, p95 = "clear", "-", "-"
+		case f.Stalled:
+			p50, p85, p95 = "never", "-", "-"
+		default:
+			p50, p85, p95 = forecastDate(f.P50, now), forecastDate(f.P85, now), forecastDate(f.P95, now)
+		}
+		flag := ""
+		if f.Growing {
+			flag = style.Warning.Render("GROWING")
+			growing = append(growing, f.Key)
+		} else if f.Stalled {
+			flag = style.Warning.Render("STALLED")
+		}
+		fmt.Fprintf(w, "%s\t%d\t%.1f\t%s\t%s\t%s\t%s\n", f.Key, f.Backlog, f.PerWeek, p50, p85, p95, flag)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(growing) > 0 {
+		fmt.Println()
+		style.PrintWarning("inflow exceeded throughput for %d straight weeks in: %s", forecastInflowWeeks, strings.Join(growing, ", "))
+	}
+	return nil
+}
+
+// forecastOpenWork looks up what the wisp timelines cannot show: each rig's
+// ready beads, and which open wisps have since been closed (or deleted)
+// without a completion event. A rig whose beads cannot be read contributes
+// neither, leaving its timelines as they are.
+func forecastOpenWork(townRoot string, timelines []*stats.Timeline) ([]*stats.Timeline, map[string]bool) {
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+	rigs, err := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot)).DiscoverRigs()
+	if err != nil {
+		return nil, nil
+	}
+
+	open := make(map[string][]string) // rig -> beads of open wisps
+	for _, t := range timelines {
+		if !t.IsComplete() && !t.IsDropped() {
+			open[t.Rig] = append(open[t.Rig], t.Bead)
+		}
+	}
+
+	var ready []*stats.Timeline
+	closed := make(map[string]bool)
+	for _, r := range rigs {
+		if issues, err := readyWork(r.BeadsPath()); err == nil {
+			for _, issue := range issues {
+				ready = append(ready, &stats.Timeline{Bead: issue.ID, Rig: r.Name})
+			}
+		}
+		if ids := open[r.Name]; len(ids) > 0 {
+			issues, err := beads.New(r.BeadsPath()).ShowMultiple(ids)
+			if err != nil {
+				continue
+			}
+			for _, id := range ids {
+				if issue, ok := issues[id]; !ok || issue.Status == "closed" {
+					closed[id] = true
+				}
+			}
+		}
+	}
+	return ready, closed
+}
+
+// forecastDate formats a forecast date, as a day for anything beyond a
+// day away and as a time-of-day otherwise.
+func forecastDate(t, now time.Time) string {
+	if t.Sub(now) < 24*time.Hour {
+		return t.Local().Format("15:04")
+	}
+	return t.Local().Format("Jan 2")
+}
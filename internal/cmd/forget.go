@@ -5,30 +5,42 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/memory"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
+var forgetSelf bool
+
 func init() {
+	forgetCmd.Flags().BoolVar(&forgetSelf, "self", false, "Remove your own agent notes containing the text")
 	forgetCmd.GroupID = GroupWork
 	rootCmd.AddCommand(forgetCmd)
 }
 
 var forgetCmd = &cobra.Command{
-	Use:   "forget <key>",
+	Use:   "forget <key|text>",
 	Short: "Remove a stored memory",
 	Long: `Remove a memory from the beads key-value store.
 
 The key should match the short name shown by 'gt memories'
 (without the memory. prefix).
 
+With --self, removes every note in your own agent memory that contains
+the given text (case-insensitive).
+
 Examples:
   gt forget refinery-worktree
-  gt forget hooks-package-structure`,
+  gt forget hooks-package-structure
+  gt forget --self DOLT_PORT`,
 	Args: cobra.ExactArgs(1),
 	RunE: runForget,
 }
 
 func runForget(cmd *cobra.Command, args []string) error {
+	if forgetSelf {
+		return forgetForSelf(args[0])
+	}
 	key := args[0]
 
 	// Strip memory. prefix if the user included it
@@ -46,6 +58,27 @@ func runForget(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("removing memory: %w", err)
 	}
 
-	fmt.Printf("%s Forgot memory: %s\n", style.Success.Render("✓"), style.Bold.Render(key))
+	fmt.Printf("%s Forgot memory: %s\n", style.SuccessPrefix, style.Bold.Render(key))
+	return nil
+}
+
+// forgetForSelf removes the current agent's notes containing text.
+func forgetForSelf(text string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	agent, err := selfAgent()
+	if err != nil {
+		return err
+	}
+	n, err := memory.Forget(townRoot, agent, text)
+	if err != nil {
+		return fmt.Errorf("removing notes: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("no notes for %s contain %q", agent, text)
+	}
+	fmt.Printf("%s Forgot %d note(s) for %s\n", style.SuccessPrefix, n, style.Bold.Render(agent))
 	return nil
 }
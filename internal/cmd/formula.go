@@ -18,6 +18,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/formula"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -349,18 +350,18 @@ func dryRunFormula(f *formula.Formula, formulaName, targetRig string) error {
 				}
 				legPattern := renderTemplateOrDefault(f.Output.LegPattern, legCtx, leg.ID+"-findings.md")
 				outputPath := filepath.Join(outputDir, legPattern)
-				fmt.Printf("    • %s: %s\n      → %s\n", leg.ID, leg.Title, outputPath)
+				fmt.Printf("    %s %s: %s\n      → %s\n", ui.Glyph("•"), leg.ID, leg.Title, outputPath)
 			} else {
-				fmt.Printf("    • %s: %s\n", leg.ID, leg.Title)
+				fmt.Printf("    %s %s: %s\n", ui.Glyph("•"), leg.ID, leg.Title)
 			}
 		}
 		if f.Synthesis != nil {
 			fmt.Printf("\n  Synthesis:\n")
 			if f.Output != nil && outputDir != "" {
 				synthPath := filepath.Join(outputDir, f.Output.Synthesis)
-				fmt.Printf("    • %s\n      → %s\n", f.Synthesis.Title, synthPath)
+				fmt.Printf("    %s %s\n      → %s\n", ui.Glyph("•"), f.Synthesis.Title, synthPath)
 			} else {
-				fmt.Printf("    • %s\n", f.Synthesis.Title)
+				fmt.Printf("    %s %s\n", ui.Glyph("•"), f.Synthesis.Title)
 			}
 		}
 	}
@@ -417,7 +418,7 @@ func executeConvoyFormula(f *formula.Formula, formulaName, targetRig string) err
 		return fmt.Errorf("creating convoy bead: %w", err)
 	}
 
-	fmt.Printf("%s Created convoy: %s\n", style.Bold.Render("✓"), convoyID)
+	fmt.Printf("%s Created convoy: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), convoyID)
 
 	// Generate a unique review ID for this convoy run
 	reviewID := generateFormulaShortID()
@@ -535,7 +536,7 @@ func executeConvoyFormula(f *formula.Formula, formulaName, targetRig string) err
 		}
 
 		legBeads[leg.ID] = legBeadID
-		fmt.Printf("  %s Created leg: %s (%s)\n", style.Dim.Render("○"), leg.ID, legBeadID)
+		fmt.Printf("  %s Created leg: %s (%s)\n", style.Dim.Render(ui.Glyph("○")), leg.ID, legBeadID)
 	}
 
 	// Step 3: Create synthesis bead if defined
@@ -581,12 +582,12 @@ func executeConvoyFormula(f *formula.Formula, formulaName, targetRig string) err
 					Run()
 			}
 
-			fmt.Printf("  %s Created synthesis: %s\n", style.Dim.Render("★"), synthesisBeadID)
+			fmt.Printf("  %s Created synthesis: %s\n", style.Dim.Render(ui.Glyph("★")), synthesisBeadID)
 		}
 	}
 
 	// Step 4: Sling each leg to a polecat
-	fmt.Printf("\n%s Dispatching legs to polecats...\n\n", style.Bold.Render("→"))
+	fmt.Printf("\n%s Dispatching legs to polecats...\n\n", style.Bold.Render(ui.Glyph("→")))
 
 	slingCount := 0
 	for _, leg := range f.Legs {
@@ -625,7 +626,7 @@ func executeConvoyFormula(f *formula.Formula, formulaName, targetRig string) err
 	}
 
 	// Summary
-	fmt.Printf("\n%s Convoy dispatched!\n", style.Bold.Render("✓"))
+	fmt.Printf("\n%s Convoy dispatched!\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Printf("  Convoy:  %s\n", convoyID)
 	fmt.Printf("  Legs:    %d dispatched\n", slingCount)
 	if synthesisBeadID != "" {
@@ -797,7 +798,7 @@ func runFormulaCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("writing formula file: %w", err)
 	}
 
-	fmt.Printf("%s Created formula: %s\n", style.Bold.Render("✓"), filename)
+	fmt.Printf("%s Created formula: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), filename)
 	fmt.Printf("\nNext steps:\n")
 	fmt.Printf("  1. Edit the formula: %s\n", filename)
 	fmt.Printf("  2. View it:          gt formula show %s\n", formulaName)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -175,12 +176,12 @@ func runGitInit(cmd *cobra.Command, args []string) error {
 			return err
 		}
 	} else {
-		fmt.Printf("   ✓ Git repository already exists\n")
+		fmt.Printf("   %s Git repository already exists\n", ui.Glyph(ui.IconPass))
 	}
 
 	// Install pre-checkout hook to prevent accidental branch switches
 	if err := InstallPreCheckoutHook(hqRoot); err != nil {
-		fmt.Printf("   %s Could not install pre-checkout hook: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not install pre-checkout hook: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	}
 
 	// Create GitHub repo if requested
@@ -190,7 +191,7 @@ func runGitInit(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Printf("\n%s Git initialization complete!\n", style.Bold.Render("✓"))
+	fmt.Printf("\n%s Git initialization complete!\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	// Show next steps if no GitHub was created
 	if gitInitGitHub == "" {
@@ -216,7 +217,7 @@ func createGitignore(path string) error {
 
 		// Check if it already has Gas Town section
 		if strings.Contains(string(content), "Gas Town HQ") {
-			fmt.Printf("   ✓ .gitignore already configured for Gas Town\n")
+			fmt.Printf("   %s .gitignore already configured for Gas Town\n", ui.Glyph(ui.IconPass))
 			return nil
 		}
 
@@ -225,7 +226,7 @@ func createGitignore(path string) error {
 		if err := os.WriteFile(path, []byte(combined), 0644); err != nil {
 			return fmt.Errorf("updating .gitignore: %w", err)
 		}
-		fmt.Printf("   ✓ Updated .gitignore with Gas Town patterns\n")
+		fmt.Printf("   %s Updated .gitignore with Gas Town patterns\n", ui.Glyph(ui.IconPass))
 		return nil
 	}
 
@@ -233,7 +234,7 @@ func createGitignore(path string) error {
 	if err := os.WriteFile(path, []byte(HQGitignore), 0644); err != nil {
 		return fmt.Errorf("creating .gitignore: %w", err)
 	}
-	fmt.Printf("   ✓ Created .gitignore\n")
+	fmt.Printf("   %s Created .gitignore\n", ui.Glyph(ui.IconPass))
 	return nil
 }
 
@@ -246,7 +247,7 @@ func initGitRepo(path string) error {
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("git init failed: %w", err)
 	}
-	fmt.Printf("   ✓ Initialized git repository\n")
+	fmt.Printf("   %s Initialized git repository\n", ui.Glyph(ui.IconPass))
 	return nil
 }
 
@@ -266,7 +267,7 @@ func createGitHubRepo(hqRoot, repo string, private bool) error {
 	if !private {
 		visibility = "public"
 	}
-	fmt.Printf("   → Creating %s GitHub repository %s...\n", visibility, repo)
+	fmt.Printf("   %s Creating %s GitHub repository %s...\n", ui.Glyph("→"), visibility, repo)
 
 	// Ensure there's at least one commit before pushing.
 	// gh repo create --push fails on empty repos with no commits.
@@ -291,9 +292,9 @@ func createGitHubRepo(hqRoot, repo string, private bool) error {
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("gh repo create failed: %w", err)
 	}
-	fmt.Printf("   ✓ Created and pushed to GitHub: %s (%s)\n", repo, visibility)
+	fmt.Printf("   %s Created and pushed to GitHub: %s (%s)\n", ui.Glyph(ui.IconPass), repo, visibility)
 	if private {
-		fmt.Printf("   ℹ To make this repo public: %s\n", style.Dim.Render("gh repo edit "+repo+" --visibility public"))
+		fmt.Printf("   %s To make this repo public: %s\n", ui.Glyph(ui.IconInfo), style.Dim.Render("gh repo edit "+repo+" --visibility public"))
 	}
 	return nil
 }
@@ -321,7 +322,7 @@ func ensureInitialCommit(hqRoot string) error {
 		return fmt.Errorf("git commit failed: %s", strings.TrimSpace(string(output)))
 	}
 
-	fmt.Printf("   ✓ Created initial commit\n")
+	fmt.Printf("   %s Created initial commit\n", ui.Glyph(ui.IconPass))
 	return nil
 }
 
@@ -342,12 +343,12 @@ func InitGitForHarness(hqRoot string, github string, private bool) error {
 			return err
 		}
 	} else {
-		fmt.Printf("   ✓ Git repository already exists\n")
+		fmt.Printf("   %s Git repository already exists\n", ui.Glyph(ui.IconPass))
 	}
 
 	// Install pre-checkout hook to prevent accidental branch switches
 	if err := InstallPreCheckoutHook(hqRoot); err != nil {
-		fmt.Printf("   %s Could not install pre-checkout hook: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not install pre-checkout hook: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	}
 
 	// Create GitHub repo if requested
@@ -429,7 +430,7 @@ func InstallBranchProtection(hqRoot string) error {
 	if content, err := os.ReadFile(preCheckoutPath); err == nil {
 		if strings.Contains(string(content), "Gas Town pre-checkout hook") {
 			_ = os.Remove(preCheckoutPath) // Best effort removal
-			fmt.Printf("   ✓ Removed obsolete pre-checkout hook\n")
+			fmt.Printf("   %s Removed obsolete pre-checkout hook\n", ui.Glyph(ui.IconPass))
 		}
 	}
 
@@ -443,7 +444,7 @@ func InstallBranchProtection(hqRoot string) error {
 
 	// Check if already has branch protection
 	if strings.Contains(string(existingContent), BranchProtectionMarker) {
-		fmt.Printf("   ✓ Branch protection already installed\n")
+		fmt.Printf("   %s Branch protection already installed\n", ui.Glyph(ui.IconPass))
 		return nil
 	}
 
@@ -472,7 +473,7 @@ func InstallBranchProtection(hqRoot string) error {
 		return fmt.Errorf("writing hook: %w", err)
 	}
 
-	fmt.Printf("   ✓ Installed branch protection (auto-reverts non-main checkouts)\n")
+	fmt.Printf("   %s Installed branch protection (auto-reverts non-main checkouts)\n", ui.Glyph(ui.IconPass))
 	return nil
 }
 
@@ -0,0 +1,276 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/sessionindex"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	grepSessionsIgnoreCase bool
+	grepSessionsRegexp     bool
+	grepSessionsRig        string
+	grepSessionsLive       bool
+	grepSessionsRecorded   bool
+	grepSessionsLimit      int
+	grepSessionsJSON       bool
+)
+
+// grepSessionsMaxText bounds a matched line in text output.
+const grepSessionsMaxText = 200
+
+var grepSessionsCmd = &cobra.Command{
+	Use:     "grep-sessions <pattern>",
+	GroupID: GroupDiag,
+	Short:   "Search live and recorded session output",
+	Long: `Search agent session output for a pattern: the scrollback of live tmux
+panes, and the recorded session logs in <rig>/.runtime/session-logs.
+
+Every rig session's pane is recorded there as it runs (through tmux
+pipe-pane), as are the logs of Kubernetes pods. Each recorded line carries
+the time it was written.
+
+Each match names the agent, the wisp it was working at the time, when the
+output was recorded, and where: session and line.
+
+Recorded logs are searched through an index in .runtime/session-index,
+which is brought up to date before each search. The index keeps a filter
+of the text in each 64 KB chunk of a log, so only the chunks that can
+contain the pattern's literal text are read.
+
+The pattern is a plain string unless --regexp is given.
+
+Examples:
+  gt grep-sessions "connection refused"
+  gt grep-sessions -i "panic:" --rig gastown
+  gt grep-sessions -E "exit status [1-9]" --recorded --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGrepSessions,
+}
+
+func init() {
+	grepSessionsCmd.Flags().BoolVarP(&grepSessionsIgnoreCase, "ignore-case", "i", false, "Match case-insensitively")
+	grepSessionsCmd.Flags().BoolVarP(&grepSessionsRegexp, "regexp", "E", false, "Treat the pattern as a regular expression")
+	grepSessionsCmd.Flags().StringVar(&grepSessionsRig, "rig", "", "Only search this rig's sessions")
+	grepSessionsCmd.Flags().BoolVar(&grepSessionsLive, "live", false, "Only search live panes")
+	grepSessionsCmd.Flags().BoolVar(&grepSessionsRecorded, "recorded", false, "Only search recorded session logs")
+	grepSessionsCmd.Flags().IntVarP(&grepSessionsLimit, "limit", "n", 100, "Stop after this many matches (0 for no limit)")
+	grepSessionsCmd.Flags().BoolVar(&grepSessionsJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(grepSessionsCmd)
+}
+
+// SessionMatch is a gt grep-sessions match.
+type SessionMatch struct {
+	Source  string    `json:"source"` // "live" or "recorded"
+	Agent   string    `json:"agent,omitempty"`
+	Wisp    string    `json:"wisp,omitempty"`
+	Rig     string    `json:"rig,omitempty"`
+	Session string    `json:"session"`
+	Line    int       `json:"line"`
+	Time    time.Time `json:"time"`
+	Text    string    `json:"text"`
+}
+
+func runGrepSessions(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if grepSessionsLive && grepSessionsRecorded {
+		return fmt.Errorf("--live and --recorded are mutually exclusive")
+	}
+	expr := args[0]
+	if !grepSessionsRegexp {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if grepSessionsIgnoreCase {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	var matches []SessionMatch
+	if !grepSessionsRecorded {
+		matches = append(matches, grepLivePanes(re)...)
+	}
+	if !grepSessionsLive {
+		if _, err := sessionindex.Update(townRoot); err != nil {
+			return fmt.Errorf("updating session index: %w", err)
+		}
+		// The rig filter applies after the search, so ask for extra.
+		limit := grepSessionsLimit
+		if limit > 0 && grepSessionsRig != "" {
+			limit = 0
+		}
+		recorded, _, err := sessionindex.Search(townRoot, re, limit)
+		if err != nil {
+			return err
+		}
+		for _, m := range recorded {
+			matches = append(matches, SessionMatch{
+				Source:  "recorded",
+				Rig:     m.Rig,
+				Session: m.Session,
+				Line:    m.Line,
+				Time:    m.Time,
+				Text:    m.Text,
+			})
+		}
+	}
+
+	attributeSessionMatches(townRoot, matches)
+	kept := matches[:0]
+	for _, m := range matches {
+		if grepSessionsRig == "" || m.Rig == grepSessionsRig {
+			kept = append(kept, m)
+		}
+	}
+	matches = kept
+	if grepSessionsLimit > 0 && len(matches) > grepSessionsLimit {
+		matches = matches[:grepSessionsLimit]
+	}
+
+	if grepSessionsJSON {
+		if matches == nil {
+			matches = []SessionMatch{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(matches)
+	}
+	if len(matches) == 0 {
+		fmt.Printf("No session output matches %q\n", args[0])
+		return NewSilentExit(1)
+	}
+	for _, m := range matches {
+		who := m.Agent
+		if who == "" {
+			who = m.Session
+		}
+		if m.Wisp != "" {
+			who += " " + style.Dim.Render("("+m.Wisp+")")
+		}
+		when := "live"
+		if m.Source != "live" {
+			when = "unknown time"
+			if !m.Time.IsZero() {
+				when = ui.FormatDateTime(m.Time)
+			}
+		}
+		text := strings.TrimSpace(m.Text)
+		if len(text) > grepSessionsMaxText {
+			text = text[:grepSessionsMaxText] + "…"
+		}
+		fmt.Printf("%s  %s  %s\n    %s\n", style.Dim.Render(when), style.Bold.Render(who),
+			style.Dim.Render(fmt.Sprintf("%s:%d", m.Session, m.Line)), text)
+	}
+	if grepSessionsLimit > 0 && len(matches) == grepSessionsLimit {
+		fmt.Printf("%s\n", style.Dim.Render(fmt.Sprintf("(stopped at %d matches; use --limit to see more)", grepSessionsLimit)))
+	}
+	return nil
+}
+
+// grepLivePanes searches the scrollback of every live Gas Town session.
+func grepLivePanes(re *regexp.Regexp) []SessionMatch {
+	t := tmux.NewTmux()
+	sessions, err := t.ListSessions()
+	if err != nil {
+		return nil
+	}
+	now := time.Now()
+	var out []SessionMatch
+	for _, sess := range sessions {
+		if _, err := session.ParseSessionName(sess); err != nil {
+			continue
+		}
+		content, err := t.CapturePaneAll(sess)
+		if err != nil {
+			continue
+		}
+		for i, line := range strings.Split(content, "\n") {
+			if re.MatchString(line) {
+				out = append(out, SessionMatch{Source: "live", Session: sess, Line: i + 1, Time: now, Text: line})
+			}
+		}
+	}
+	return out
+}
+
+// attributeSessionMatches fills in each match's agent and rig from its
+// session name, and the wisp the agent had claimed when the output was
+// recorded, from the event log.
+func attributeSessionMatches(townRoot string, matches []SessionMatch) {
+	if len(matches) == 0 {
+		return
+	}
+	byAgent := map[string][]*stats.Timeline{}
+	if evs, err := events.ReadAll(townRoot); err == nil {
+		for _, t := range stats.BuildTimelines(evs) {
+			// Sling targets may be short forms (rig/name); key by address.
+			if id, err := session.ParseAddress(t.Agent); err == nil {
+				byAgent[id.Address()] = append(byAgent[id.Address()], t)
+			}
+		}
+	}
+	for _, ts := range byAgent {
+		sort.Slice(ts, func(i, j int) bool { return claimedAt(ts[i]).Before(claimedAt(ts[j])) })
+	}
+
+	for i := range matches {
+		m := &matches[i]
+		identity, err := session.ParseSessionName(m.Session)
+		if err != nil {
+			continue
+		}
+		m.Agent = identity.Address()
+		if identity.Rig != "" {
+			m.Rig = identity.Rig
+		}
+		m.Wisp = wispAt(byAgent[m.Agent], m.Time)
+	}
+}
+
+// wispAt returns the wisp an agent was working at a time: the last one it
+// claimed before then, if it had not yet completed or dropped it. Timelines are
+// sorted by claim time.
+func wispAt(timelines []*stats.Timeline, at time.Time) string {
+	if at.IsZero() {
+		return ""
+	}
+	var current *stats.Timeline
+	for _, t := range timelines {
+		if claimedAt(t).After(at) {
+			break
+		}
+		current = t
+	}
+	if current == nil ||
+		(current.IsComplete() && current.Completed.Before(at)) ||
+		(current.IsDropped() && current.Dropped.Before(at)) {
+		return ""
+	}
+	return current.Bead
+}
+
+func claimedAt(t *stats.Timeline) time.Time {
+	if !t.Claimed.IsZero() {
+		return t.Claimed
+	}
+	return t.Queued
+}
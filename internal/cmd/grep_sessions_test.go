@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/stats"
+)
+
+func TestWispAt(t *testing.T) {
+	base := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	at := func(m int) time.Time { return base.Add(time.Duration(m) * time.Minute) }
+	timelines := []*stats.Timeline{
+		{Bead: "gt-wisp-abandoned", Claimed: at(0)}, // Never completed
+		{Bead: "gt-wisp-one", Claimed: at(10), Completed: at(20)},
+		{Bead: "gt-wisp-two", Claimed: at(30), Dropped: at(40)},
+	}
+	tests := []struct {
+		at   time.Time
+		want string
+	}{
+		{at(-5), ""},
+		{at(5), "gt-wisp-abandoned"},
+		{at(15), "gt-wisp-one"},
+		{at(25), ""}, // Between wisps, not the earlier one left open
+		{at(35), "gt-wisp-two"},
+		{at(45), ""},
+		{time.Time{}, ""},
+	}
+	for _, tt := range tests {
+		if got := wispAt(timelines, tt.at); got != tt.want {
+			t.Errorf("wispAt(%v) = %q, want %q", tt.at, got, tt.want)
+		}
+	}
+}
@@ -55,6 +55,13 @@ Unlike --auto (state only) or normal handoff (polecat→gt-done redirect), --cyc
 always does a full respawn regardless of role. This enables crew workers and
 polecats to get a fresh context window when the current one fills up.
 
+The --to flag hands the current wisp (or the given bead) to another agent
+instead of a fresh session of yourself. A structured note (--state, --step,
+--gotcha) is recorded on the bead, ownership moves to the receiver under the
+bead lock, and the receiver is nudged; its next gt prime shows the note.
+  gt handoff --to gastown/crew/max --state "Tests pass, PR not opened" \
+    --step "Open the PR" --gotcha "Lint is flaky on the sandbox runner"
+
 Any molecule on the hook will be auto-continued by the new session.
 The SessionStart hook runs 'gt prime' to restore context.`,
 	RunE: runHandoff,
@@ -71,6 +78,10 @@ var (
 	handoffCycle      bool
 	handoffReason     string
 	handoffNoGitCheck bool
+	handoffTo         string
+	handoffState      string
+	handoffSteps      []string
+	handoffGotchas    []string
 )
 
 func init() {
@@ -84,6 +95,10 @@ func init() {
 	handoffCmd.Flags().BoolVar(&handoffCycle, "cycle", false, "Auto-cycle session (for PreCompact hooks that want full session replacement)")
 	handoffCmd.Flags().StringVar(&handoffReason, "reason", "", "Reason for handoff (e.g., 'compaction', 'idle')")
 	handoffCmd.Flags().BoolVar(&handoffNoGitCheck, "no-git-check", false, "Skip git workspace cleanliness check")
+	handoffCmd.Flags().StringVar(&handoffTo, "to", "", "Transfer the current wisp to another agent (e.g., gastown/crew/max)")
+	handoffCmd.Flags().StringVar(&handoffState, "state", "", "Where the work stands (required with --to)")
+	handoffCmd.Flags().StringArrayVar(&handoffSteps, "step", nil, "Remaining step for the receiver (repeatable, with --to)")
+	handoffCmd.Flags().StringArrayVar(&handoffGotchas, "gotcha", nil, "Gotcha the receiver should know about (repeatable, with --to)")
 	rootCmd.AddCommand(handoffCmd)
 }
 
@@ -120,6 +135,13 @@ func runHandoff(cmd *cobra.Command, args []string) error {
 		return runHandoffCycle()
 	}
 
+	// --to mode: transfer the current wisp to another agent with a
+	// structured note. Runs before the polecat redirect: a polecat handing
+	// its work to a peer is not ending its work via gt done.
+	if handoffTo != "" {
+		return runHandoffTransfer(args)
+	}
+
 	// Check if we're a polecat - polecats use gt done instead.
 	// Check GT_ROLE first: coordinators (mayor, witness, etc.) may have a stale
 	// GT_POLECAT in their environment from spawning polecats. Only block if the
@@ -734,6 +756,9 @@ type buildRestartCommandOpts struct {
 	// ContinueSession is true. If empty, falls back to a generic
 	// continuation message.
 	ContinuePrompt string
+	// Agent restarts the session on this agent instead of the one it runs
+	// now (used by gt failover to move a session to its fallback).
+	Agent string
 }
 
 func buildRestartCommand(sessionName string) (string, error) {
@@ -797,6 +822,9 @@ func buildRestartCommandWithOpts(sessionName string, opts buildRestartCommandOpt
 	// Fall back to tmux session environment if process env doesn't have it,
 	// since exec env vars may not propagate through all agent runtimes.
 	currentAgent, agentInEnv := os.LookupEnv("GT_AGENT")
+	if opts.Agent != "" {
+		currentAgent, agentInEnv = opts.Agent, true
+	}
 	if !agentInEnv {
 		// GT_AGENT not in process env at all — try tmux session environment
 		// as fallback, since exec env vars may not propagate through all runtimes.
@@ -869,7 +897,7 @@ func buildRestartCommandWithOpts(sessionName string, opts buildRestartCommandOpt
 	// Without this, custom agents that shadow built-in presets (e.g., custom
 	// "codex" running "opencode") would revert to GT_AGENT-based lookup after
 	// handoff, causing false liveness failures.
-	if processNames := os.Getenv("GT_PROCESS_NAMES"); processNames != "" {
+	if processNames := os.Getenv("GT_PROCESS_NAMES"); processNames != "" && opts.Agent == "" {
 		// Preserve existing process names from environment
 		exports = append(exports, "GT_PROCESS_NAMES="+processNames)
 	} else if currentAgent != "" {
@@ -1273,15 +1301,15 @@ func warnHandoffGitStatus() {
 	if err != nil || status.CleanExcludingBeads() {
 		return
 	}
-	fmt.Fprintf(os.Stderr, "%s workspace has uncommitted work: %s\n", ui.IconWarn, status.String())
+	fmt.Fprintf(os.Stderr, "%s workspace has uncommitted work: %s\n", ui.Glyph(ui.IconWarn), status.String())
 	if len(status.ModifiedFiles) > 0 {
-		fmt.Fprintf(os.Stderr, "%s   modified: %s\n", ui.IconWarn, strings.Join(status.ModifiedFiles, ", "))
+		fmt.Fprintf(os.Stderr, "%s   modified: %s\n", ui.Glyph(ui.IconWarn), strings.Join(status.ModifiedFiles, ", "))
 	}
 	if len(status.UntrackedFiles) > 0 {
-		fmt.Fprintf(os.Stderr, "%s   untracked: %s\n", ui.IconWarn, strings.Join(status.UntrackedFiles, ", "))
+		fmt.Fprintf(os.Stderr, "%s   untracked: %s\n", ui.Glyph(ui.IconWarn), strings.Join(status.UntrackedFiles, ", "))
 	}
 	if status.UnpushedCommits > 0 {
-		fmt.Fprintf(os.Stderr, "%s   %d unpushed commit(s) — run 'git push' before handoff\n", ui.IconWarn, status.UnpushedCommits)
+		fmt.Fprintf(os.Stderr, "%s   %d unpushed commit(s) — run 'git push' before handoff\n", ui.Glyph(ui.IconWarn), status.UnpushedCommits)
 	}
 	fmt.Fprintln(os.Stderr, "  (use --no-git-check to suppress this warning)")
 }
@@ -1348,7 +1376,7 @@ func hookBeadForHandoff(beadID string) error {
 		return fmt.Errorf("pinning bead: %w", err)
 	}
 
-	fmt.Printf("%s Work attached to hook (pinned bead)\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Work attached to hook (pinned bead)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	return nil
 }
 
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/cli"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// runHandoffTransfer hands a bead (default: the caller's hooked wisp) to the
+// agent named by --to. Ownership moves under the bead lock with the note
+// recorded on the bead, the agent hook slots follow, both ends are audited,
+// and the receiver is nudged to prime.
+func runHandoffTransfer(args []string) error {
+	if handoffState == "" {
+		return fmt.Errorf("--state is required with --to (where does the work stand?)")
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("--to takes at most one bead ID")
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	from, _, hookRoot, err := resolveSelfTarget()
+	if err != nil {
+		return err
+	}
+
+	var beadID string
+	if len(args) == 1 {
+		beadID = args[0]
+	} else {
+		roleInfo, err := GetRole()
+		if err != nil {
+			return fmt.Errorf("detecting role: %w", err)
+		}
+		beadID = detectHookedBead(hookRoot, roleInfo)
+		if beadID == "" {
+			return fmt.Errorf("nothing on your hook to hand off (pass a bead ID)")
+		}
+	}
+
+	to, pane, _, err := resolveTargetAgentFn(handoffTo)
+	if err != nil {
+		return fmt.Errorf("resolving --to %s: %w", handoffTo, err)
+	}
+	if to == from {
+		return fmt.Errorf("cannot hand off to yourself (use gt handoff without --to to cycle)")
+	}
+
+	note := &beads.HandoffNote{
+		From:      from,
+		To:        to,
+		State:     handoffState,
+		Remaining: handoffSteps,
+		Gotchas:   handoffGotchas,
+	}
+
+	fmt.Printf("%s Handing %s from %s to %s...\n", style.Bold.Render("🤝"), beadID, from, to)
+	if handoffDryRun {
+		fmt.Printf("Would record handoff note on %s:\n\n%s\n", beadID, beads.FormatHandoffNote(note))
+		fmt.Printf("Would reassign %s to %s (status=hooked) and nudge %s\n", beadID, to, to)
+		return nil
+	}
+
+	bd := beads.New(beads.ResolveHookDir(townRoot, beadID, hookRoot))
+	if err := bd.TransferBead(beadID, note); err != nil {
+		return fmt.Errorf("handing off %s: %w", beadID, err)
+	}
+	fmt.Printf("%s Ownership transferred, note recorded\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
+
+	// Move the agent hook slots to match. Non-fatal: the bead's assignee is
+	// authoritative and prime falls back to it.
+	if fromBeadID := agentIDToBeadID(from, townRoot); fromBeadID != "" {
+		ab := beads.New(beads.ResolveHookDir(townRoot, fromBeadID, townRoot))
+		if err := ab.ClearHookBead(fromBeadID); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: couldn't clear agent %s hook: %v\n", fromBeadID, err)
+		}
+	}
+	updateAgentHookBead(to, beadID, "", "")
+
+	payload := events.TransferPayload(beadID, from, to)
+	_ = events.LogAudit(events.TypeHandoffSent, from, payload)
+	_ = events.LogAudit(events.TypeHandoffReceived, to, payload)
+
+	if pane == "" {
+		fmt.Printf("%s No pane to nudge (%s will see the note on next gt prime)\n", style.Dim.Render(ui.Glyph("○")), to)
+		return nil
+	}
+	if err := nudgeHandoffReceiver(pane, beadID, from); err != nil {
+		fmt.Printf("%s Could not nudge %s: %v\n", style.Dim.Render(ui.Glyph("○")), to, err)
+		return nil
+	}
+	fmt.Printf("%s %s nudged\n", style.Bold.Render(ui.Glyph("▶")), to)
+	return nil
+}
+
+// nudgeHandoffReceiver tells the receiving session to prime and pick up the
+// handed-off work.
+func nudgeHandoffReceiver(pane, beadID, from string) error {
+	if os.Getenv("GT_TEST_NO_NUDGE") != "" {
+		return nil
+	}
+	prompt := fmt.Sprintf("Work handed off to you: %s from %s. Run `%s prime` to read the handoff note, then continue the work.",
+		beadID, from, cli.Name())
+	return tmux.NewTmux().NudgePane(pane, prompt)
+}
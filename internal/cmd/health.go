@@ -16,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/health"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -330,7 +331,7 @@ func checkOrphanDBs(townRoot string) []OrphanDB {
 
 func printHealthReport(r *HealthReport) {
 	// 1. Server
-	fmt.Printf("\n%s Dolt Server\n", style.Bold.Render("●"))
+	fmt.Printf("\n%s Dolt Server\n", style.Bold.Render(ui.Glyph("●")))
 	if r.Server.Running {
 		fmt.Printf("  Status: %s (PID %d)\n", style.Bold.Render("running"), r.Server.PID)
 		if r.Server.Port > 0 {
@@ -347,7 +348,7 @@ func printHealthReport(r *HealthReport) {
 
 	// 2. Databases
 	if len(r.Databases) > 0 {
-		fmt.Printf("\n%s Databases\n", style.Bold.Render("●"))
+		fmt.Printf("\n%s Databases\n", style.Bold.Render(ui.Glyph("●")))
 		for _, db := range r.Databases {
 			fmt.Printf("  %s: %d issues (%d open), %d wisps (%d open), %d commits\n",
 				style.Bold.Render(db.Name), db.Issues, db.OpenIssues,
@@ -356,9 +357,9 @@ func printHealthReport(r *HealthReport) {
 	}
 
 	// 3. Pollution
-	fmt.Printf("\n%s Pollution\n", style.Bold.Render("●"))
+	fmt.Printf("\n%s Pollution\n", style.Bold.Render(ui.Glyph("●")))
 	if len(r.Pollution) == 0 {
-		fmt.Printf("  %s No pollution detected\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s No pollution detected\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
 		fmt.Printf("  %s %d suspicious record(s):\n", style.Bold.Render("!"), len(r.Pollution))
 		for _, p := range r.Pollution {
@@ -367,39 +368,39 @@ func printHealthReport(r *HealthReport) {
 	}
 
 	// 4. Backups
-	fmt.Printf("\n%s Backups\n", style.Bold.Render("●"))
+	fmt.Printf("\n%s Backups\n", style.Bold.Render(ui.Glyph("●")))
 	if r.Backups.DoltFreshness != "" {
-		icon := style.Bold.Render("✓")
+		icon := style.Bold.Render(ui.Glyph(ui.IconPass))
 		if r.Backups.DoltStale {
 			icon = style.Bold.Render("!")
 		}
 		fmt.Printf("  %s Dolt filesystem: %s ago\n", icon, r.Backups.DoltFreshness)
 	} else {
-		fmt.Printf("  %s Dolt filesystem: not found\n", style.Dim.Render("○"))
+		fmt.Printf("  %s Dolt filesystem: not found\n", style.Dim.Render(ui.Glyph("○")))
 	}
 	if r.Backups.JSONLFreshness != "" {
-		icon := style.Bold.Render("✓")
+		icon := style.Bold.Render(ui.Glyph(ui.IconPass))
 		if r.Backups.JSONLStale {
 			icon = style.Bold.Render("!")
 		}
 		fmt.Printf("  %s JSONL git: %s ago\n", icon, r.Backups.JSONLFreshness)
 	} else {
-		fmt.Printf("  %s JSONL git: not found\n", style.Dim.Render("○"))
+		fmt.Printf("  %s JSONL git: not found\n", style.Dim.Render(ui.Glyph("○")))
 	}
 
 	// 5. Processes
-	fmt.Printf("\n%s Processes\n", style.Bold.Render("●"))
+	fmt.Printf("\n%s Processes\n", style.Bold.Render(ui.Glyph("●")))
 	if r.Processes.ZombieCount == 0 {
-		fmt.Printf("  %s No zombie processes\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s No zombie processes\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
 		fmt.Printf("  %s %d zombie(s): %v\n", style.Bold.Render("!"),
 			r.Processes.ZombieCount, r.Processes.ZombiePIDs)
 	}
 
 	// 6. Orphans
-	fmt.Printf("\n%s Orphan DBs\n", style.Bold.Render("●"))
+	fmt.Printf("\n%s Orphan DBs\n", style.Bold.Render(ui.Glyph("●")))
 	if len(r.Orphans) == 0 {
-		fmt.Printf("  %s None\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s None\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
 		for _, o := range r.Orphans {
 			fmt.Printf("  %s %s (%s)\n", style.Bold.Render("!"), o.Name, o.Size)
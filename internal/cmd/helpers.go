@@ -189,7 +189,7 @@ func ensureDefaultBranch(dir, roleName, rigPath string) error {
 		if err := g.Pull("origin", defaultBranch); err != nil {
 			return fmt.Errorf("pull failed on %s: %w", defaultBranch, err)
 		}
-		fmt.Printf("  %s Already on %s, pulled latest\n", style.Success.Render("✓"), defaultBranch)
+		fmt.Printf("  %s Already on %s, pulled latest\n", style.SuccessPrefix, defaultBranch)
 		return nil
 	}
 
@@ -203,7 +203,7 @@ func ensureDefaultBranch(dir, roleName, rigPath string) error {
 	if err := g.Pull("origin", defaultBranch); err != nil {
 		return fmt.Errorf("pull failed on %s: %w", defaultBranch, err)
 	}
-	fmt.Printf("  %s Switched to %s and pulled latest\n", style.Success.Render("✓"), defaultBranch)
+	fmt.Printf("  %s Switched to %s and pulled latest\n", style.SuccessPrefix, defaultBranch)
 
 	return nil
 }
@@ -229,7 +229,7 @@ func warnIfNotDefaultBranch(dir, roleName, rigPath string) {
 	}
 
 	fmt.Printf("\n%s %s is on branch '%s', not '%s'.\n",
-		style.Warning.Render("⚠"),
+		style.WarningPrefix,
 		roleName,
 		branch,
 		defaultBranch)
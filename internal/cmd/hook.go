@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -296,7 +297,7 @@ func runHook(_ *cobra.Command, args []string) error {
 
 		// Skip if it's the same bead we're trying to pin
 		if existing.ID == beadID {
-			fmt.Printf("%s Already hooked: %s\n", style.Bold.Render("✓"), beadID)
+			fmt.Printf("%s Already hooked: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), beadID)
 			return nil
 		}
 
@@ -305,7 +306,7 @@ func runHook(_ *cobra.Command, args []string) error {
 
 		if isComplete {
 			// Auto-replace completed bead
-			fmt.Printf("%s Replacing completed bead %s...\n", style.Dim.Render("ℹ"), existing.ID)
+			fmt.Printf("%s Replacing completed bead %s...\n", style.Dim.Render(ui.Glyph(ui.IconInfo)), existing.ID)
 			if !hookDryRun {
 				if hasAttachment {
 					// Close completed molecule bead (use bd close --force for pinned)
@@ -329,7 +330,7 @@ func runHook(_ *cobra.Command, args []string) error {
 			}
 		} else if hookForce {
 			// Force replace incomplete bead
-			fmt.Printf("%s Force-replacing incomplete bead %s...\n", style.Dim.Render("⚠"), existing.ID)
+			fmt.Printf("%s Force-replacing incomplete bead %s...\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), existing.ID)
 			if !hookDryRun {
 				// Unpin by setting status back to open
 				status := "open"
@@ -378,7 +379,7 @@ func runHook(_ *cobra.Command, args []string) error {
 			lastHookErr = err
 			if attempt < hookMaxRetries {
 				backoff := slingBackoff(attempt, hookBaseBackoff, hookBackoffMax)
-				fmt.Printf("%s Hook attempt %d failed, retrying in %v...\n", style.Warning.Render("⚠"), attempt, backoff)
+				fmt.Printf("%s Hook attempt %d failed, retrying in %v...\n", style.WarningPrefix, attempt, backoff)
 				time.Sleep(backoff)
 				continue
 			}
@@ -388,9 +389,9 @@ func runHook(_ *cobra.Command, args []string) error {
 	}
 
 	if targetAgent != "" {
-		fmt.Printf("%s Work attached to %s's hook\n", style.Bold.Render("✓"), agentID)
+		fmt.Printf("%s Work attached to %s's hook\n", style.Bold.Render(ui.Glyph(ui.IconPass)), agentID)
 	} else {
-		fmt.Printf("%s Work attached to hook (hooked bead)\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Work attached to hook (hooked bead)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	// Update agent bead's hook_bead field (matches gt sling behavior)
@@ -406,7 +407,7 @@ func runHook(_ *cobra.Command, args []string) error {
 
 	// Log hook event to activity feed (non-fatal)
 	if err := events.LogFeed(events.TypeHook, agentID, events.HookPayload(beadID)); err != nil {
-		fmt.Fprintf(os.Stderr, "%s Warning: failed to log hook event: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Fprintf(os.Stderr, "%s Warning: failed to log hook event: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	}
 
 	return nil
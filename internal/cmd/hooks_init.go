@@ -84,7 +84,7 @@ func runHooksInit(cmd *cobra.Command, args []string) error {
 		if err := hooks.SaveBase(base); err != nil {
 			return fmt.Errorf("saving base config: %w", err)
 		}
-		fmt.Printf("%s Created base config at %s\n", style.Success.Render("✓"), hooks.BasePath())
+		fmt.Printf("%s Created base config at %s\n", style.SuccessPrefix, hooks.BasePath())
 		return nil
 	}
 
@@ -134,7 +134,7 @@ func runHooksInit(cmd *cobra.Command, args []string) error {
 	if err := hooks.SaveBase(base); err != nil {
 		return fmt.Errorf("saving base config: %w", err)
 	}
-	fmt.Printf("%s Created base config at %s\n", style.Success.Render("✓"), hooks.BasePath())
+	fmt.Printf("%s Created base config at %s\n", style.SuccessPrefix, hooks.BasePath())
 
 	// Write overrides
 	for _, o := range overrides {
@@ -142,7 +142,7 @@ func runHooksInit(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  %s Failed to write override %s: %v\n", style.Warning.Render("!"), o.key, err)
 			continue
 		}
-		fmt.Printf("%s Created override %s\n", style.Success.Render("✓"), o.key)
+		fmt.Printf("%s Created override %s\n", style.SuccessPrefix, o.key)
 	}
 
 	fmt.Printf("\nVerify with: %s\n", style.Dim.Render("gt hooks diff"))
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/hooks"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -238,9 +239,9 @@ func padRight(s string, width int) string {
 func renderSyncStatus(status string) string {
 	switch status {
 	case "in sync":
-		return style.Success.Render("✓ in sync")
+		return style.Success.Render(ui.Glyph(ui.IconPass) + " in sync")
 	case "out of sync":
-		return style.Warning.Render("⚠ out of sync")
+		return style.Warning.Render(ui.Glyph(ui.IconWarn) + " out of sync")
 	case "missing":
 		return style.Dim.Render("- missing")
 	case "error":
@@ -9,6 +9,7 @@ import (
 	"github.com/BurntSushi/toml"
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -130,14 +131,14 @@ func runHooksRegistry(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
-		fmt.Printf("%s %s\n", style.Bold.Render("▸"), event)
+		fmt.Printf("%s %s\n", style.Bold.Render(ui.Glyph("▸")), event)
 
 		for _, h := range hooks {
 			count++
-			statusIcon := "●"
+			statusIcon := ui.Glyph("●")
 			statusColor := style.Success
 			if !h.def.Enabled {
-				statusIcon = "○"
+				statusIcon = ui.Glyph("○")
 				statusColor = style.Dim
 			}
 
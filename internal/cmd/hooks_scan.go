@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/hooks"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -196,12 +197,12 @@ func outputHooksHuman(townRoot string, hookInfos []HookInfo) error {
 			continue
 		}
 
-		fmt.Printf("%s %s\n", style.Bold.Render("▸"), hookType)
+		fmt.Printf("%s %s\n", style.Bold.Render(ui.Glyph("▸")), hookType)
 
 		for _, h := range typeHooks {
-			statusIcon := "●"
+			statusIcon := ui.Glyph("●")
 			if h.Status != "active" {
-				statusIcon = "○"
+				statusIcon = ui.Glyph("○")
 			}
 
 			matcherStr := ""
@@ -213,7 +214,7 @@ func outputHooksHuman(townRoot string, hookInfos []HookInfo) error {
 
 			if hooksScanVerbose {
 				for _, cmd := range h.Commands {
-					fmt.Printf("    %s %s\n", style.Dim.Render("→"), cmd)
+					fmt.Printf("    %s %s\n", style.Dim.Render(ui.Glyph("→")), cmd)
 				}
 			}
 		}
@@ -92,14 +92,14 @@ func runHooksSync(cmd *cobra.Command, args []string) error {
 			if hooksSyncDryRun {
 				fmt.Printf("  %s %s %s\n", style.Warning.Render("~"), relPath, style.Dim.Render("(would create)"))
 			} else {
-				fmt.Printf("  %s %s %s\n", style.Success.Render("✓"), relPath, style.Dim.Render("(created)"))
+				fmt.Printf("  %s %s %s\n", style.SuccessPrefix, relPath, style.Dim.Render("(created)"))
 			}
 			created++
 		case syncUpdated:
 			if hooksSyncDryRun {
 				fmt.Printf("  %s %s %s\n", style.Warning.Render("~"), relPath, style.Dim.Render("(would update)"))
 			} else {
-				fmt.Printf("  %s %s %s\n", style.Success.Render("✓"), relPath, style.Dim.Render("(updated)"))
+				fmt.Printf("  %s %s %s\n", style.SuccessPrefix, relPath, style.Dim.Render("(updated)"))
 			}
 			updated++
 		case syncUnchanged:
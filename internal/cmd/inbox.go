@@ -0,0 +1,394 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/inbox"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	inboxAll    bool
+	inboxJSON   bool
+	inboxNote   string
+	inboxKind   string
+	inboxDetail string
+)
+
+var inboxCmd = &cobra.Command{
+	Use:     "inbox",
+	GroupID: GroupComm,
+	Short:   "Overseer inbox: everything waiting on a human decision",
+	Long: `List everything that needs the overseer's attention, in one queue.
+
+The inbox collects:
+  approval    Pending approval requests (gt approval)
+  escalation  Unacknowledged escalations (gt escalate)
+  review      Review asks from agents (gt inbox add)
+  doctor      Critical doctor findings (cleared when the check passes again)
+
+Items are listed oldest first. Act on them from here (approve, deny,
+resolve) or in their own tool; either way they leave the inbox. Snooze an
+item to hide it for a while.
+
+Items that wait longer than the SLA (default 4h) escalate once: the daemon
+runs "gt inbox escalate", which mails the notify list (default overseer and
+mayor). Configure in settings/config.json:
+  "inbox": {"sla": "2h", "notify": ["overseer", "mayor/"]}
+
+Examples:
+  gt inbox                          # Waiting items (snoozed hidden)
+  gt inbox --all                    # Include snoozed items
+  gt inbox approve ap-abc123        # Approve a pending request
+  gt inbox resolve hq-esc-42        # Acknowledge an escalation
+  gt inbox snooze ib-xyz 2h         # Hide for two hours
+  gt inbox add "Review the auth refactor in gt-4f2" -m "PR #312"`,
+	Args: cobra.NoArgs,
+	RunE: runInboxList,
+}
+
+var inboxShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show an inbox item",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runInboxShow,
+}
+
+var inboxApproveCmd = &cobra.Command{
+	Use:   "approve <id>",
+	Short: "Approve a pending approval request",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runInboxDecide(args[0], true)
+	},
+}
+
+var inboxDenyCmd = &cobra.Command{
+	Use:   "deny <id>",
+	Short: "Deny a pending approval request",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runInboxDecide(args[0], false)
+	},
+}
+
+var inboxResolveCmd = &cobra.Command{
+	Use:     "resolve <id>",
+	Aliases: []string{"ack", "done"},
+	Short:   "Acknowledge an escalation or resolve a review ask",
+	Args:    cobra.ExactArgs(1),
+	RunE:    runInboxResolve,
+}
+
+var inboxSnoozeCmd = &cobra.Command{
+	Use:   "snooze <id> <duration>",
+	Short: "Hide an item for a while (e.g., 2h, 1d)",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runInboxSnooze,
+}
+
+var inboxAddCmd = &cobra.Command{
+	Use:   "add <title>",
+	Short: "Ask the overseer for a review or decision",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runInboxAdd,
+}
+
+var inboxEscalateCmd = &cobra.Command{
+	Use:   "escalate",
+	Short: "Notify about items past the SLA (run by the daemon)",
+	Long: `Mail the inbox notify list about items that have waited longer than the
+SLA. Each item escalates once; snoozing it restarts the clock. The daemon
+runs this every heartbeat.`,
+	Args: cobra.NoArgs,
+	RunE: runInboxEscalate,
+}
+
+func init() {
+	inboxCmd.Flags().BoolVar(&inboxAll, "all", false, "Include snoozed items")
+	inboxCmd.Flags().BoolVar(&inboxJSON, "json", false, "Output as JSON")
+	inboxShowCmd.Flags().BoolVar(&inboxJSON, "json", false, "Output as JSON")
+	inboxApproveCmd.Flags().StringVar(&inboxNote, "note", "", "Note for the requester")
+	inboxDenyCmd.Flags().StringVar(&inboxNote, "note", "", "Note for the requester")
+	inboxAddCmd.Flags().StringVar(&inboxKind, "kind", inbox.KindReview, "Item kind (review)")
+	inboxAddCmd.Flags().StringVarP(&inboxDetail, "message", "m", "", "Details (what to look at, links)")
+
+	inboxCmd.AddCommand(inboxShowCmd)
+	inboxCmd.AddCommand(inboxApproveCmd)
+	inboxCmd.AddCommand(inboxDenyCmd)
+	inboxCmd.AddCommand(inboxResolveCmd)
+	inboxCmd.AddCommand(inboxSnoozeCmd)
+	inboxCmd.AddCommand(inboxAddCmd)
+	inboxCmd.AddCommand(inboxEscalateCmd)
+	rootCmd.AddCommand(inboxCmd)
+}
+
+// collectInbox returns the town's inbox items. Source failures (e.g., beads
+// unavailable) are warned about, not fatal.
+func collectInbox(townRoot string) []*inbox.Item {
+	items, err := inbox.Collect(townRoot, inbox.Sources(townRoot))
+	if err != nil {
+		style.PrintWarning("some inbox sources failed: %v", err)
+	}
+	return items
+}
+
+func runInboxList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := inbox.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var items []*inbox.Item
+	snoozed := 0
+	for _, it := range collectInbox(townRoot) {
+		if it.Snoozed(now) && !inboxAll {
+			snoozed++
+			continue
+		}
+		items = append(items, it)
+	}
+
+	if inboxJSON {
+		if items == nil {
+			items = []*inbox.Item{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(items)
+	}
+	if len(items) == 0 {
+		fmt.Println("Inbox zero.")
+		if snoozed > 0 {
+			fmt.Println(style.Dim.Render(fmt.Sprintf("(%d snoozed; gt inbox --all)", snoozed)))
+		}
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tKIND\tAGE\tFROM\tTITLE")
+	for _, it := range items {
+		age := formatDuration(now.Sub(it.CreatedAt))
+		switch {
+		case it.Snoozed(now):
+			age += " (snoozed)"
+		case policy.SLA > 0 && now.Sub(it.CreatedAt) >= policy.SLA:
+			age = style.Warning.Render(age + " OVERDUE")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", it.ID, it.Kind, age, it.From, it.Title)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if snoozed > 0 {
+		fmt.Println(style.Dim.Render(fmt.Sprintf("\n%d snoozed item(s) hidden (gt inbox --all)", snoozed)))
+	}
+	return nil
+}
+
+func runInboxShow(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	it, err := inbox.Find(collectInbox(townRoot), args[0])
+	if err != nil {
+		return err
+	}
+	if inboxJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(it)
+	}
+
+	fmt.Printf("%s %s\n", style.Bold.Render(it.ID), it.Kind)
+	fmt.Printf("  Title:     %s\n", it.Title)
+	if it.Detail != "" {
+		fmt.Printf("  Detail:    %s\n", it.Detail)
+	}
+	if it.From != "" {
+		fmt.Printf("  From:      %s\n", it.From)
+	}
+	fmt.Printf("  Waiting:   %s (since %s)\n", formatDuration(time.Since(it.CreatedAt)), it.CreatedAt.Local().Format(time.RFC3339))
+	if it.SnoozedUntil != nil {
+		fmt.Printf("  Snoozed:   until %s\n", it.SnoozedUntil.Local().Format(time.RFC3339))
+	}
+	if it.EscalatedAt != nil {
+		fmt.Printf("  Escalated: %s\n", it.EscalatedAt.Local().Format(time.RFC3339))
+	}
+	switch it.Kind {
+	case inbox.KindApproval:
+		fmt.Printf("\n  gt inbox approve %s  |  gt inbox deny %s\n", it.ID, it.ID)
+	default:
+		fmt.Printf("\n  gt inbox resolve %s\n", it.ID)
+	}
+	return nil
+}
+
+func runInboxDecide(id string, approve bool) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	it, err := inbox.Find(collectInbox(townRoot), id)
+	if err != nil {
+		return err
+	}
+	if it.Kind != inbox.KindApproval {
+		return fmt.Errorf("%s is a %s, not an approval request (use gt inbox resolve)", id, it.Kind)
+	}
+	approvalNote = inboxNote
+	if err := runApprovalDecide(it.Ref, approve); err != nil {
+		return err
+	}
+	_ = inbox.Forget(townRoot, id)
+	return nil
+}
+
+func runInboxResolve(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	it, err := inbox.Find(collectInbox(townRoot), args[0])
+	if err != nil {
+		return err
+	}
+	by, _ := commentIdentity()
+
+	switch it.Kind {
+	case inbox.KindApproval:
+		return fmt.Errorf("%s is an approval request: use gt inbox approve or deny", it.ID)
+	case inbox.KindEscalation:
+		if err := beads.New(townRoot).AckEscalation(it.Ref, by); err != nil {
+			return fmt.Errorf("acknowledging %s: %w", it.Ref, err)
+		}
+		_ = inbox.Forget(townRoot, it.ID)
+	default:
+		if err := inbox.Resolve(townRoot, it.ID); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("%s Resolved %s (%s)\n", style.SuccessPrefix, it.ID, it.Title)
+	return nil
+}
+
+func runInboxSnooze(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	d, err := parseInboxDuration(args[1])
+	if err != nil {
+		return err
+	}
+	it, err := inbox.Find(collectInbox(townRoot), args[0])
+	if err != nil {
+		return err
+	}
+	until := time.Now().Add(d)
+	if err := inbox.Snooze(townRoot, it.ID, until); err != nil {
+		return err
+	}
+	fmt.Printf("%s Snoozed %s until %s\n", style.SuccessPrefix, it.ID, until.Local().Format("Mon 15:04"))
+	return nil
+}
+
+// parseInboxDuration parses a snooze duration, accepting days ("1d") too.
+func parseInboxDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		if d, err := time.ParseDuration(days + "h"); err == nil && d > 0 {
+			return d * 24, nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q (e.g., 30m, 2h, 1d)", s)
+	}
+	return d, nil
+}
+
+func runInboxAdd(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if inboxKind != inbox.KindReview {
+		return fmt.Errorf("--kind must be %q (other kinds are collected automatically)", inbox.KindReview)
+	}
+	from, _ := commentIdentity()
+	it := &inbox.Item{
+		Kind:   inboxKind,
+		Title:  args[0],
+		Detail: inboxDetail,
+		From:   from,
+	}
+	if err := inbox.Add(townRoot, it); err != nil {
+		return err
+	}
+	fmt.Printf("%s Added %s to the overseer inbox\n", style.SuccessPrefix, it.ID)
+	return nil
+}
+
+func runInboxEscalate(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := inbox.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	overdue := policy.Overdue(collectInbox(townRoot), now)
+	if len(overdue) == 0 {
+		return nil
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "%d inbox item(s) have waited longer than %s:\n\n", len(overdue), policy.SLA)
+	for _, it := range overdue {
+		fmt.Fprintf(&body, "  %s [%s] %s (waiting %s)\n", it.ID, it.Kind, it.Title, formatDuration(now.Sub(it.CreatedAt)))
+	}
+	body.WriteString("\nReview with: gt inbox")
+
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	var sendErrs []error
+	for _, to := range policy.Notify {
+		// Overdue items are urgent but not critical: quiet hours hold them
+		// for the morning digest.
+		if _, err := sendOrHoldMail(townRoot, router, &mail.Message{
+			From:     "inbox",
+			To:       to,
+			Subject:  fmt.Sprintf("INBOX: %d item(s) past SLA", len(overdue)),
+			Body:     body.String(),
+			Priority: mail.PriorityUrgent,
+		}, false); err != nil {
+			sendErrs = append(sendErrs, fmt.Errorf("%s: %w", to, err))
+		}
+	}
+	if len(sendErrs) == len(policy.Notify) {
+		return fmt.Errorf("notifying about overdue inbox items: %w", errors.Join(sendErrs...))
+	}
+	if err := inbox.MarkEscalated(townRoot, overdue, now); err != nil {
+		return err
+	}
+	fmt.Printf("Escalated %d overdue inbox item(s)\n", len(overdue))
+	return nil
+}
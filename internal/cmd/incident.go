@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/incident"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	incidentReason   string
+	incidentNoDoctor bool
+	incidentJSON     bool
+)
+
+var incidentCmd = &cobra.Command{
+	Use:     "incident",
+	GroupID: GroupDiag,
+	Short:   "Freeze the town and capture diagnostics when things go sideways",
+	Long: `Incident mode freezes the town and captures a consistent forensic bundle.
+
+'gt incident start' pauses the scheduler, stops the daemon from restarting
+agents or running patrols, and collects a diagnostics bundle under
+.runtime/incidents/<id>/:
+  manifest.json          The incident record and what was captured
+  doctor.txt             A fresh gt doctor report
+  daemon.log             The tail of the daemon log
+  events.jsonl           Recent activity events
+  decisions.jsonl        Recent daemon and scheduler decisions (gt why)
+  scheduler.json         Scheduler state
+  wisps.json             Open wisps
+  panes/<session>.txt    Recent output of every Gas Town tmux session
+
+Running agents are left alone so they can be inspected. 'gt incident end'
+lifts the freeze and resumes the scheduler if the incident paused it.
+
+Examples:
+  gt incident start --reason "refinery merging the same MR twice"
+  gt incident status
+  gt incident end`,
+}
+
+var incidentStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Freeze dispatch and recovery, and collect a diagnostics bundle",
+	Long: `Freeze dispatch and recovery, and collect a diagnostics bundle.
+
+Fails if an incident is already in progress.
+
+Examples:
+  gt incident start
+  gt incident start --reason "polecats stuck" --no-doctor`,
+	Args: cobra.NoArgs,
+	RunE: runIncidentStart,
+}
+
+var incidentEndCmd = &cobra.Command{
+	Use:   "end",
+	Short: "End the incident and resume normal operation",
+	Args:  cobra.NoArgs,
+	RunE:  runIncidentEnd,
+}
+
+var incidentStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the incident in progress, if any",
+	Args:  cobra.NoArgs,
+	RunE:  runIncidentStatus,
+}
+
+func init() {
+	incidentStartCmd.Flags().StringVar(&incidentReason, "reason", "", "What went wrong (recorded in the manifest)")
+	incidentStartCmd.Flags().BoolVar(&incidentNoDoctor, "no-doctor", false, "Skip the doctor snapshot (faster)")
+	for _, c := range []*cobra.Command{incidentStartCmd, incidentEndCmd, incidentStatusCmd} {
+		c.Flags().BoolVar(&incidentJSON, "json", false, "Output as JSON")
+		incidentCmd.AddCommand(c)
+	}
+	rootCmd.AddCommand(incidentCmd)
+}
+
+func runIncidentStart(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	opts := incident.StartOptions{Reason: incidentReason, Actor: detectActor()}
+	if !incidentNoDoctor {
+		opts.Doctor = func(w io.Writer) error {
+			report := newTownDoctor().Run(&doctor.CheckContext{TownRoot: townRoot})
+			report.Print(w, true, 0)
+			return nil
+		}
+	}
+	if !incidentJSON {
+		fmt.Printf("%s Freezing town and collecting diagnostics...\n", style.Bold.Render("🚨"))
+	}
+	inc, err := incident.Start(townRoot, opts)
+	if errors.Is(err, incident.ErrActive) {
+		if active, _ := incident.Active(townRoot); active != nil {
+			return fmt.Errorf("%w: %s (bundle %s); run 'gt incident end' first", err, active.ID, active.Bundle)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if incidentJSON {
+		return printIncidentJSON(inc)
+	}
+	if inc.PausedScheduler {
+		fmt.Printf("  %s Scheduler paused\n", style.SuccessPrefix)
+	} else {
+		fmt.Printf("  %s Scheduler was already paused\n", style.Dim.Render(ui.Glyph("○")))
+	}
+	fmt.Printf("  %s Daemon recovery and patrols frozen\n", style.SuccessPrefix)
+	fmt.Printf("  %s Captured %d artifact(s)\n", style.SuccessPrefix, len(inc.Artifacts))
+	for _, e := range inc.Errors {
+		style.PrintWarning("not captured: %s", e)
+	}
+	fmt.Printf("\nIncident %s started. Bundle: %s\n", style.Bold.Render(inc.ID), inc.Bundle)
+	fmt.Println(style.Dim.Render("Run 'gt incident end' to resume normal operation."))
+	return nil
+}
+
+func runIncidentEnd(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	inc, err := incident.End(townRoot, detectActor())
+	if err != nil {
+		return err
+	}
+	if incidentJSON {
+		return printIncidentJSON(inc)
+	}
+	fmt.Printf("%s Incident %s ended after %s\n", style.SuccessPrefix, inc.ID,
+		inc.EndedAt.Sub(inc.StartedAt).Round(time.Second))
+	if inc.PausedScheduler {
+		fmt.Println("  Scheduler resumed; daemon recovery resumes on its next heartbeat")
+	}
+	fmt.Printf("  Bundle: %s\n", inc.Bundle)
+	return nil
+}
+
+func runIncidentStatus(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	inc, err := incident.Active(townRoot)
+	if err != nil {
+		return err
+	}
+	if incidentJSON {
+		return printIncidentJSON(inc)
+	}
+	if inc == nil {
+		fmt.Println("No incident in progress")
+		return nil
+	}
+	fmt.Printf("%s Incident %s in progress since %s (%s ago)\n", style.Warning.Render("🚨"), style.Bold.Render(inc.ID),
+		inc.StartedAt.Local().Format("2006-01-02 15:04"), time.Since(inc.StartedAt).Round(time.Second))
+	if inc.Reason != "" {
+		fmt.Printf("  Reason: %s\n", inc.Reason)
+	}
+	if inc.StartedBy != "" {
+		fmt.Printf("  Started by: %s\n", inc.StartedBy)
+	}
+	fmt.Printf("  Bundle: %s\n", inc.Bundle)
+	return nil
+}
+
+func printIncidentJSON(inc *incident.Incident) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(inc)
+}
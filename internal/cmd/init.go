@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -72,16 +73,16 @@ func runInit(cmd *cobra.Command, args []string) error {
 			_ = os.WriteFile(gitkeep, []byte(""), 0644)
 		}
 
-		fmt.Printf("   ✓ Created %s/\n", dir)
+		fmt.Printf("   %s Created %s/\n", ui.Glyph(ui.IconPass), dir)
 		created++
 	}
 
 	// Update .git/info/exclude
 	if err := updateGitExclude(cwd); err != nil {
 		fmt.Printf("   %s Could not update .git/info/exclude: %v\n",
-			style.Dim.Render("⚠"), err)
+			style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else {
-		fmt.Printf("   ✓ Updated .git/info/exclude\n")
+		fmt.Printf("   %s Updated .git/info/exclude\n", ui.Glyph(ui.IconPass))
 	}
 
 	// Register custom beads types for Gas Town (agent, role, rig, convoy, slot).
@@ -89,9 +90,9 @@ func runInit(cmd *cobra.Command, args []string) error {
 	// The doctor check will catch missing types later.
 	if err := registerCustomTypes(cwd); err != nil {
 		fmt.Printf("   %s Could not register custom types: %v\n",
-			style.Dim.Render("⚠"), err)
+			style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else {
-		fmt.Printf("   ✓ Registered custom beads types\n")
+		fmt.Printf("   %s Registered custom beads types\n", ui.Glyph(ui.IconPass))
 	}
 
 	// Auto-configure the six-stage Dolt lifecycle with sensible defaults.
@@ -101,14 +102,14 @@ func runInit(cmd *cobra.Command, args []string) error {
 	if townRoot, err := workspace.FindFromCwd(); err == nil {
 		if err := daemon.EnsureLifecycleConfigFile(townRoot); err != nil {
 			fmt.Printf("   %s Could not configure lifecycle defaults: %v\n",
-				style.Dim.Render("⚠"), err)
+				style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		} else {
-			fmt.Printf("   ✓ Configured Dolt lifecycle (reaper, compactor, doctor, backup)\n")
+			fmt.Printf("   %s Configured Dolt lifecycle (reaper, compactor, doctor, backup)\n", ui.Glyph(ui.IconPass))
 		}
 	}
 
 	fmt.Printf("\n%s Rig initialized with %d directories.\n",
-		style.Bold.Render("✓"), created)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), created)
 	fmt.Println()
 	fmt.Println("Next steps:")
 	fmt.Printf("  1. Add this rig to a town: %s\n",
@@ -21,11 +21,13 @@ import (
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/formula"
 	"github.com/steveyegge/gastown/internal/hooks"
+	"github.com/steveyegge/gastown/internal/migrate"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/shell"
 	"github.com/steveyegge/gastown/internal/state"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/templates"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 	"github.com/steveyegge/gastown/internal/wrappers"
 )
@@ -126,7 +128,7 @@ func runInstall(cmd *cobra.Command, args []string) error {
 			if err := wrappers.Install(); err != nil {
 				return fmt.Errorf("installing wrapper scripts: %w", err)
 			}
-			fmt.Printf("✓ Installed gt-codex, gt-gemini, and gt-opencode to %s\n", wrappers.BinDir())
+			fmt.Printf("%s Installed gt-codex, gt-gemini, and gt-opencode to %s\n", ui.Glyph(ui.IconPass), wrappers.BinDir())
 			return nil
 		}
 		return fmt.Errorf("directory is already a Gas Town HQ (use --force to reinitialize)")
@@ -176,7 +178,7 @@ func runInstall(cmd *cobra.Command, args []string) error {
 						db.Close()
 						// Usable Dolt server on this port — skip the check.
 						fmt.Printf("   %s Using existing Dolt server on port %d\n",
-							style.Dim.Render("ℹ"), port)
+							style.Dim.Render(ui.Glyph(ui.IconInfo)), port)
 						goto portOK
 					}
 					db.Close()
@@ -214,7 +216,7 @@ func runInstall(cmd *cobra.Command, args []string) error {
 	if err := os.MkdirAll(mayorDir, 0755); err != nil {
 		return fmt.Errorf("creating mayor directory: %w", err)
 	}
-	fmt.Printf("   ✓ Created mayor/\n")
+	fmt.Printf("   %s Created mayor/\n", ui.Glyph(ui.IconPass))
 
 	// Determine owner (defaults to git user.email)
 	owner := installOwner
@@ -241,17 +243,19 @@ func runInstall(cmd *cobra.Command, args []string) error {
 			Owner:      owner,
 			PublicName: publicName,
 			CreatedAt:  time.Now(),
+			// Fresh towns start on the latest layout; install creates it.
+			LayoutVersion: migrate.Latest(),
 		}
 		if err := config.SaveTownConfig(townPath, townConfig); err != nil {
 			return fmt.Errorf("writing town.json: %w", err)
 		}
-		fmt.Printf("   ✓ Created mayor/town.json\n")
+		fmt.Printf("   %s Created mayor/town.json\n", ui.Glyph(ui.IconPass))
 	} else if err != nil {
 		return fmt.Errorf("checking town.json: %w", err)
 	} else if !townInfo.Mode().IsRegular() {
 		return fmt.Errorf("town.json exists but is not a regular file")
 	} else {
-		fmt.Printf("   • mayor/town.json already exists, preserving\n")
+		fmt.Printf("   %s mayor/town.json already exists, preserving\n", ui.Glyph("•"))
 	}
 
 	// Create rigs.json in mayor/ (only if it doesn't already exist).
@@ -265,13 +269,13 @@ func runInstall(cmd *cobra.Command, args []string) error {
 		if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
 			return fmt.Errorf("writing rigs.json: %w", err)
 		}
-		fmt.Printf("   ✓ Created mayor/rigs.json\n")
+		fmt.Printf("   %s Created mayor/rigs.json\n", ui.Glyph(ui.IconPass))
 	} else if err != nil {
 		return fmt.Errorf("checking rigs.json: %w", err)
 	} else if !rigsInfo.Mode().IsRegular() {
 		return fmt.Errorf("rigs.json exists but is not a regular file")
 	} else {
-		fmt.Printf("   • mayor/rigs.json already exists, preserving\n")
+		fmt.Printf("   %s mayor/rigs.json already exists, preserving\n", ui.Glyph("•"))
 	}
 
 	// Create a generic CLAUDE.md at the town root as an identity anchor.
@@ -281,11 +285,11 @@ func runInstall(cmd *cobra.Command, args []string) error {
 	// It is NOT role-specific — role context comes from gt prime.
 	// Crew/polecats have their own nested git repos and won't inherit this.
 	if created, err := createTownRootAgentMDs(absPath); err != nil {
-		fmt.Printf("   %s Could not create agent MDs at town root: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not create agent MDs at town root: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else if created {
-		fmt.Printf("   ✓ Created CLAUDE.md + AGENTS.md (town root identity anchor)\n")
+		fmt.Printf("   %s Created CLAUDE.md + AGENTS.md (town root identity anchor)\n", ui.Glyph(ui.IconPass))
 	} else {
-		fmt.Printf("   ✓ Preserved existing CLAUDE.md + AGENTS.md (town root identity anchor)\n")
+		fmt.Printf("   %s Preserved existing CLAUDE.md + AGENTS.md (town root identity anchor)\n", ui.Glyph(ui.IconPass))
 	}
 
 	// Create mayor settings (mayor runs from ~/gt/mayor/)
@@ -294,26 +298,26 @@ func runInstall(cmd *cobra.Command, args []string) error {
 	// causing crew/polecat/etc to cd to town root before running commands.
 	// mayorDir already defined above
 	if err := os.MkdirAll(mayorDir, 0755); err != nil {
-		fmt.Printf("   %s Could not create mayor directory: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not create mayor directory: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else {
 		mayorRuntimeConfig := config.ResolveRoleAgentConfig("mayor", absPath, mayorDir)
 		if err := runtime.EnsureSettingsForRole(mayorDir, mayorDir, "mayor", mayorRuntimeConfig); err != nil {
-			fmt.Printf("   %s Could not create mayor settings: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not create mayor settings: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		} else {
-			fmt.Printf("   ✓ Created mayor/.claude/settings.json\n")
+			fmt.Printf("   %s Created mayor/.claude/settings.json\n", ui.Glyph(ui.IconPass))
 		}
 	}
 
 	// Create deacon directory and settings (deacon runs from ~/gt/deacon/)
 	deaconDir := filepath.Join(absPath, "deacon")
 	if err := os.MkdirAll(deaconDir, 0755); err != nil {
-		fmt.Printf("   %s Could not create deacon directory: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not create deacon directory: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else {
 		deaconRuntimeConfig := config.ResolveRoleAgentConfig("deacon", absPath, deaconDir)
 		if err := runtime.EnsureSettingsForRole(deaconDir, deaconDir, "deacon", deaconRuntimeConfig); err != nil {
-			fmt.Printf("   %s Could not create deacon settings: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not create deacon settings: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		} else {
-			fmt.Printf("   ✓ Created deacon/.claude/settings.json\n")
+			fmt.Printf("   %s Created deacon/.claude/settings.json\n", ui.Glyph(ui.IconPass))
 		}
 	}
 
@@ -321,24 +325,24 @@ func runInstall(cmd *cobra.Command, args []string) error {
 	// This avoids gt doctor warning on fresh install.
 	bootDir := filepath.Join(deaconDir, "dogs", "boot")
 	if err := os.MkdirAll(bootDir, 0755); err != nil {
-		fmt.Printf("   %s Could not create boot directory: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not create boot directory: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	}
 
 	// Create plugins directory for town-level patrol plugins.
 	// This avoids gt doctor warning on fresh install.
 	pluginsDir := filepath.Join(absPath, "plugins")
 	if err := os.MkdirAll(pluginsDir, 0755); err != nil {
-		fmt.Printf("   %s Could not create plugins directory: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not create plugins directory: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else {
-		fmt.Printf("   ✓ Created plugins/\n")
+		fmt.Printf("   %s Created plugins/\n", ui.Glyph(ui.IconPass))
 	}
 
 	// Create daemon.json patrol config.
 	// This avoids gt doctor warning on fresh install.
 	if err := config.EnsureDaemonPatrolConfig(absPath); err != nil {
-		fmt.Printf("   %s Could not create daemon.json: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not create daemon.json: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else {
-		fmt.Printf("   ✓ Created mayor/daemon.json\n")
+		fmt.Printf("   %s Created mayor/daemon.json\n", ui.Glyph(ui.IconPass))
 	}
 
 	// Initialize git BEFORE beads so that bd can compute repository fingerprint.
@@ -361,7 +365,7 @@ func runInstall(cmd *cobra.Command, args []string) error {
 			// Identity was verified in preflight above.
 			// Create HQ database before starting server.
 			if _, _, err := doltserver.InitRig(absPath, "hq"); err != nil {
-				fmt.Printf("   %s Could not init HQ database: %v\n", style.Dim.Render("⚠"), err)
+				fmt.Printf("   %s Could not init HQ database: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 			}
 
 			// Start the Dolt server — bd commands need a running server.
@@ -369,31 +373,31 @@ func runInstall(cmd *cobra.Command, args []string) error {
 			// like a database). Stop it with 'gt dolt stop' when not needed.
 			if err := doltserver.Start(absPath); err != nil {
 				if !strings.Contains(err.Error(), "already running") {
-					fmt.Printf("   %s Could not start Dolt server: %v\n", style.Dim.Render("⚠"), err)
+					fmt.Printf("   %s Could not start Dolt server: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 				}
 			}
 		} else {
-			fmt.Printf("   %s dolt not found in PATH — Dolt backend may not fully initialize\n", style.Dim.Render("⚠"))
+			fmt.Printf("   %s dolt not found in PATH — Dolt backend may not fully initialize\n", style.Dim.Render(ui.Glyph(ui.IconWarn)))
 		}
 
 		if err := initTownBeads(absPath); err != nil {
-			fmt.Printf("   %s Could not initialize town beads: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not initialize town beads: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		} else {
-			fmt.Printf("   ✓ Initialized .beads/ (town-level beads with hq- prefix)\n")
+			fmt.Printf("   %s Initialized .beads/ (town-level beads with hq- prefix)\n", ui.Glyph(ui.IconPass))
 
 			// Provision embedded formulas to .beads/formulas/
 			if count, err := formula.ProvisionFormulas(absPath); err != nil {
 				// Non-fatal: formulas are optional, just convenience
-				fmt.Printf("   %s Could not provision formulas: %v\n", style.Dim.Render("⚠"), err)
+				fmt.Printf("   %s Could not provision formulas: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 			} else if count > 0 {
-				fmt.Printf("   ✓ Provisioned %d formulas\n", count)
+				fmt.Printf("   %s Provisioned %d formulas\n", ui.Glyph(ui.IconPass), count)
 			}
 		}
 
 		// Create town-level agent beads (Mayor, Deacon).
 		// These use hq- prefix and are stored in town beads for cross-rig coordination.
 		if err := initTownAgentBeads(absPath); err != nil {
-			fmt.Printf("   %s Could not create town-level agent beads: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not create town-level agent beads: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		}
 
 		// Set beads routing mode to explicit (required by gt doctor).
@@ -401,37 +405,37 @@ func runInstall(cmd *cobra.Command, args []string) error {
 		routingCmd.Dir = absPath
 		routingCmd.Env = withBeadsDirEnv(filepath.Join(absPath, ".beads"))
 		if out, err := routingCmd.CombinedOutput(); err != nil {
-			fmt.Printf("   %s Could not set routing.mode: %s\n", style.Dim.Render("⚠"), strings.TrimSpace(string(out)))
+			fmt.Printf("   %s Could not set routing.mode: %s\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), strings.TrimSpace(string(out)))
 		}
 	}
 
 	// Detect and save overseer identity
 	overseer, err := config.DetectOverseer(absPath)
 	if err != nil {
-		fmt.Printf("   %s Could not detect overseer identity: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not detect overseer identity: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else {
 		overseerPath := config.OverseerConfigPath(absPath)
 		if err := config.SaveOverseerConfig(overseerPath, overseer); err != nil {
-			fmt.Printf("   %s Could not save overseer config: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not save overseer config: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		} else {
-			fmt.Printf("   ✓ Detected overseer: %s (via %s)\n", overseer.FormatOverseerIdentity(), overseer.Source)
+			fmt.Printf("   %s Detected overseer: %s (via %s)\n", ui.Glyph(ui.IconPass), overseer.FormatOverseerIdentity(), overseer.Source)
 		}
 	}
 
 	// Create default escalation config in settings/escalation.json
 	escalationPath := config.EscalationConfigPath(absPath)
 	if err := config.SaveEscalationConfig(escalationPath, config.NewEscalationConfig()); err != nil {
-		fmt.Printf("   %s Could not create escalation config: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not create escalation config: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else {
-		fmt.Printf("   ✓ Created settings/escalation.json\n")
+		fmt.Printf("   %s Created settings/escalation.json\n", ui.Glyph(ui.IconPass))
 	}
 
 	// Provision town-level slash commands (.claude/commands/)
 	// All agents inherit these via Claude's directory traversal - no per-workspace copies needed.
 	if err := templates.ProvisionCommands(absPath); err != nil {
-		fmt.Printf("   %s Could not provision slash commands: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not provision slash commands: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	} else {
-		fmt.Printf("   ✓ Created .claude/commands/ (slash commands for all agents)\n")
+		fmt.Printf("   %s Created .claude/commands/ (slash commands for all agents)\n", ui.Glyph(ui.IconPass))
 	}
 
 	// Sync hooks to generate .claude/settings.json files for all targets.
@@ -443,30 +447,30 @@ func runInstall(cmd *cobra.Command, args []string) error {
 			}
 		}
 		if synced > 0 {
-			fmt.Printf("   ✓ Synced %d hook target(s)\n", synced)
+			fmt.Printf("   %s Synced %d hook target(s)\n", ui.Glyph(ui.IconPass), synced)
 		}
 	}
 
 	if installShell {
 		fmt.Println()
 		if err := shell.Install(); err != nil {
-			fmt.Printf("   %s Could not install shell integration: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not install shell integration: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		} else {
-			fmt.Printf("   ✓ Installed shell integration (%s)\n", shell.RCFilePath(shell.DetectShell()))
+			fmt.Printf("   %s Installed shell integration (%s)\n", ui.Glyph(ui.IconPass), shell.RCFilePath(shell.DetectShell()))
 		}
 		if err := state.Enable(Version); err != nil {
-			fmt.Printf("   %s Could not enable Gas Town: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not enable Gas Town: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		} else {
-			fmt.Printf("   ✓ Enabled Gas Town globally\n")
+			fmt.Printf("   %s Enabled Gas Town globally\n", ui.Glyph(ui.IconPass))
 		}
 	}
 
 	if installWrappers {
 		fmt.Println()
 		if err := wrappers.Install(); err != nil {
-			fmt.Printf("   %s Could not install wrapper scripts: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not install wrapper scripts: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		} else {
-			fmt.Printf("   ✓ Installed gt-codex and gt-opencode to %s\n", wrappers.BinDir())
+			fmt.Printf("   %s Installed gt-codex and gt-opencode to %s\n", ui.Glyph(ui.IconPass), wrappers.BinDir())
 		}
 	}
 
@@ -474,13 +478,13 @@ func runInstall(cmd *cobra.Command, args []string) error {
 	if installSupervisor {
 		fmt.Println()
 		if msg, err := templates.ProvisionSupervisor(absPath); err != nil {
-			fmt.Printf("   %s Could not configure supervisor: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not configure supervisor: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		} else {
-			fmt.Printf("   ✓ %s\n", msg)
+			fmt.Printf("   %s %s\n", ui.Glyph(ui.IconPass), msg)
 		}
 	}
 
-	fmt.Printf("\n%s HQ created successfully!\n", style.Bold.Render("✓"))
+	fmt.Printf("\n%s HQ created successfully!\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Println()
 	fmt.Println("Next steps:")
 	step := 1
@@ -630,14 +634,14 @@ func initTownBeads(townPath string) error {
 	prefixCmd.Dir = townPath
 	prefixCmd.Env = beadsEnv
 	if prefixOutput, prefixErr := prefixCmd.CombinedOutput(); prefixErr != nil {
-		fmt.Printf("   %s Could not set allowed_prefixes: %s\n", style.Dim.Render("⚠"), strings.TrimSpace(string(prefixOutput)))
+		fmt.Printf("   %s Could not set allowed_prefixes: %s\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), strings.TrimSpace(string(prefixOutput)))
 	}
 
 	// Ensure issues.jsonl exists — bd expects this file for git-tracked issue data.
 	issuesJSONL := filepath.Join(townPath, ".beads", "issues.jsonl")
 	if _, err := os.Stat(issuesJSONL); os.IsNotExist(err) {
 		if err := os.WriteFile(issuesJSONL, []byte{}, 0644); err != nil {
-			fmt.Printf("   %s Could not create issues.jsonl: %v\n", style.Dim.Render("⚠"), err)
+			fmt.Printf("   %s Could not create issues.jsonl: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		}
 	}
 
@@ -645,13 +649,13 @@ func initTownBeads(townPath string) error {
 	// This keeps hq-* operations stable even when invoked from rig worktrees.
 	if err := beads.AppendRoute(townPath, beads.Route{Prefix: "hq-", Path: "."}); err != nil {
 		// Non-fatal: routing still works in many contexts, but explicit mapping is preferred.
-		fmt.Printf("   %s Could not update routes.jsonl: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not update routes.jsonl: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	}
 
 	// Register hq-cv- prefix for convoy beads (auto-created by gt sling).
 	// Convoys use hq-cv-* IDs for visual distinction from other town beads.
 	if err := beads.AppendRoute(townPath, beads.Route{Prefix: "hq-cv-", Path: "."}); err != nil {
-		fmt.Printf("   %s Could not register convoy prefix: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("   %s Could not register convoy prefix: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	}
 
 	return nil
@@ -756,7 +760,7 @@ func initTownAgentBeads(townPath string) error {
 		if _, err := bd.CreateAgentBead(agent.id, agent.title, fields); err != nil {
 			return fmt.Errorf("creating %s: %w", agent.id, err)
 		}
-		fmt.Printf("   ✓ Created agent bead: %s\n", agent.id)
+		fmt.Printf("   %s Created agent bead: %s\n", ui.Glyph(ui.IconPass), agent.id)
 	}
 
 	return nil
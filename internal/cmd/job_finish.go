@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/sandbox"
+)
+
+var jobFinishExitCode int
+
+var jobFinishCmd = &cobra.Command{
+	Use:   "job-finish <run>",
+	Short: "Record and delete a session's Kubernetes Job (internal use)",
+	Long: `Record the final state of a Kubernetes session's Job and delete it.
+
+Called from the EXIT trap of a Kubernetes-backed session pane, so the Job
+never outlives its pane. Exits 0 if the Job succeeded, non-zero if it
+failed, and with --exit-code (the pane's own status) if it had not
+finished, so the pane-died hook logs the Job's real outcome.`,
+	Hidden: true, // Internal command called by the session pane
+	Args:   cobra.ExactArgs(1),
+	RunE:   runJobFinish,
+}
+
+func init() {
+	jobFinishCmd.Flags().IntVar(&jobFinishExitCode, "exit-code", 0, "Exit status of the pane's command")
+	rootCmd.AddCommand(jobFinishCmd)
+}
+
+func runJobFinish(cmd *cobra.Command, args []string) error {
+	run, err := sandbox.FinishJobRun(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gt job-finish: %v\n", err)
+	}
+	code := jobFinishExitCode
+	if run != nil {
+		switch run.State {
+		case sandbox.JobSucceeded:
+			code = 0
+		case sandbox.JobFailed:
+			if code == 0 {
+				code = 1
+			}
+		}
+	}
+	if code != 0 {
+		return NewSilentExit(code)
+	}
+	return nil
+}
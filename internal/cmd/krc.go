@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/krc"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -486,17 +487,17 @@ func runKrcAutoPrune(townRoot string, config *krc.Config) error {
 			remaining = 0
 		}
 		fmt.Printf("%s Auto-prune skipped (next in %s)\n",
-			style.Dim.Render("○"), krcFormatDuration(remaining))
+			style.Dim.Render(ui.Glyph("○")), krcFormatDuration(remaining))
 		return nil
 	}
 
 	if result.EventsPruned == 0 {
-		fmt.Printf("%s Auto-prune ran: no expired events\n", style.Dim.Render("○"))
+		fmt.Printf("%s Auto-prune ran: no expired events\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
 	fmt.Printf("%s Auto-pruned %d events (%s freed)\n",
-		style.Bold.Render("✓"),
+		style.Bold.Render(ui.Glyph(ui.IconPass)),
 		result.EventsPruned,
 		formatBytes(result.BytesBefore-result.BytesAfter))
 
@@ -535,7 +536,7 @@ func runKrcDecay(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 
 	if len(report.Types) == 0 {
-		fmt.Printf("%s No events found\n", style.Dim.Render("○"))
+		fmt.Printf("%s No events found\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
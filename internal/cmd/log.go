@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/townlog"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -101,7 +102,7 @@ func runLog(cmd *cobra.Command, args []string) error {
 
 	// Check if log file exists
 	if _, err := os.Stat(logPath); os.IsNotExist(err) {
-		fmt.Printf("%s No log file yet (no events recorded)\n", style.Dim.Render("○"))
+		fmt.Printf("%s No log file yet (no events recorded)\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -112,7 +113,7 @@ func runLog(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(events) == 0 {
-		fmt.Printf("%s No events in log\n", style.Dim.Render("○"))
+		fmt.Printf("%s No events in log\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -144,7 +145,7 @@ func runLog(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(events) == 0 {
-		fmt.Printf("%s No events match filter\n", style.Dim.Render("○"))
+		fmt.Printf("%s No events match filter\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -169,7 +170,7 @@ func followLog(logPath string) error {
 		}
 	}
 
-	fmt.Printf("%s Following %s (Ctrl+C to stop)\n\n", style.Dim.Render("○"), logPath)
+	fmt.Printf("%s Following %s (Ctrl+C to stop)\n\n", style.Dim.Render(ui.Glyph("○")), logPath)
 
 	tailCmd := exec.Command("tail", "-f", logPath)
 	tailCmd.Stdout = os.Stdout
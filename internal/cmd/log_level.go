@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/logging"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var logLevelClear bool
+
+var logLevelCmd = &cobra.Command{
+	Use:   "level [subsystem=level ...]",
+	Short: "Show or change structured log levels",
+	Long: `Show or change per-subsystem structured log levels.
+
+With no arguments, prints the effective level of each configured subsystem.
+Levels come from the logging section of settings/config.json:
+
+  "logging": {"level": "info", "levels": {"daemon": "debug", "doctor": "info"}}
+
+Arguments set runtime overrides. When the daemon is running they go through
+its control API (daemon/control.sock) and take effect in the daemon at once;
+other gt processes pick them up within a few seconds, without a restart.
+Overrides live in .runtime/log-levels.json and win over settings. Use '*' as
+the subsystem to override the default level.
+
+Levels: debug, info, warn, error.
+
+Examples:
+  gt log level                       # Show effective levels
+  gt log level daemon=debug          # Turn on daemon debug logging
+  gt log level '*=warn' doctor=info  # Quiet everything except doctor
+  gt log level --clear daemon        # Drop the daemon override
+  gt log level --clear               # Drop all overrides`,
+	RunE: runLogLevel,
+}
+
+func init() {
+	logLevelCmd.Flags().BoolVar(&logLevelClear, "clear", false, "Clear overrides for the named subsystems (all when none are named)")
+	logCmd.AddCommand(logLevelCmd)
+}
+
+func runLogLevel(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	if logLevelClear {
+		subsystems := args
+		if len(subsystems) == 0 {
+			overrides, err := logging.LoadOverrides(townRoot)
+			if err != nil {
+				return err
+			}
+			for sub := range overrides {
+				subsystems = append(subsystems, sub)
+			}
+		}
+		for _, sub := range subsystems {
+			if err := setLogLevel(townRoot, sub, ""); err != nil {
+				return fmt.Errorf("clearing %s: %w", sub, err)
+			}
+		}
+		fmt.Printf("%s Cleared %d override(s)\n", style.SuccessPrefix, len(subsystems))
+		return nil
+	}
+
+	for _, arg := range args {
+		sub, level, ok := strings.Cut(arg, "=")
+		if !ok || sub == "" || level == "" {
+			return fmt.Errorf("invalid argument %q: want subsystem=level", arg)
+		}
+		if err := setLogLevel(townRoot, sub, level); err != nil {
+			return err
+		}
+		fmt.Printf("%s %s → %s\n", style.SuccessPrefix, sub, strings.ToLower(level))
+	}
+	if len(args) > 0 {
+		return nil
+	}
+
+	var cfg *config.LoggingConfig
+	if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil {
+		cfg = settings.Logging
+	}
+	overrides, err := logging.LoadOverrides(townRoot)
+	if err != nil {
+		return err
+	}
+	effective := logging.NewLevels(cfg, logging.OverridesPath(townRoot)).Effective()
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SUBSYSTEM\tLEVEL\tSOURCE")
+	for _, sub := range logging.SortedSubsystems(effective) {
+		source := "settings"
+		if _, ok := overrides[sub]; ok {
+			source = "override"
+		} else if cfg == nil || (sub == logging.DefaultKey && cfg.Level == "") {
+			source = "default"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", sub, logging.LevelName(effective[sub]), style.Dim.Render(source))
+	}
+	return tw.Flush()
+}
+
+// setLogLevel sets (or, with level "", clears) a runtime override through
+// the daemon's control API, so the daemon applies it immediately. Without a
+// running daemon it writes the override file directly.
+func setLogLevel(townRoot, subsystem, level string) error {
+	_, err := daemon.Control(townRoot, daemon.ControlRequest{Op: daemon.ControlSetLogLevel, Subsystem: subsystem, Level: level})
+	if errors.Is(err, daemon.ErrNoControlServer) {
+		return logging.SetOverride(townRoot, subsystem, level)
+	}
+	return err
+}
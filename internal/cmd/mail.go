@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/mail"
 )
 
 // Mail command flags
@@ -31,7 +32,8 @@ var (
 	mailThreadJSON    bool
 	mailReplySubject  string
 	mailReplyMessage  string
-	mailStdin         bool // Read message body from stdin
+	mailStdin         bool   // Read message body from stdin
+	mailEncrypt       string // Secrets-store key sealing the body at rest
 
 	// Search flags
 	mailSearchFrom    string
@@ -124,6 +126,11 @@ Priority levels:
 
 Use --urgent as shortcut for --priority 0.
 
+--encrypt keeps the body encrypted at rest with a key from the town secrets
+store (default MAIL_KEY; create one with gt mail keygen). The sender and
+recipients read it as usual; anyone else sees [encrypted]. Replies stay
+encrypted with the same key. Subjects are not encrypted.
+
 Examples:
   gt mail send greenplace/Toast -s "Status check" -m "How's that bug fix going?"
   gt mail send mayor/ -s "Work complete" -m "Finished gt-abc"
@@ -134,6 +141,7 @@ Examples:
   gt mail send --self -s "Handoff" -m "Context for next session"
   gt mail send greenplace/Toast -s "Update" -m "Progress report" --cc overseer
   gt mail send list:oncall -s "Alert" -m "System down"
+  gt mail send mayor/ -s "Incident notes" -m "..." --encrypt
 
   # Read body from stdin (avoids shell quoting issues):
   gt mail send mayor/ -s "Update" --stdin <<'BODY'
@@ -471,6 +479,8 @@ func init() {
 	mailSendCmd.Flags().StringVar(&mailTo, "to", "", "Recipient address (alternative to positional argument)")
 	mailSendCmd.Flags().BoolVar(&mailSendSelf, "self", false, "Send to self (auto-detect from cwd)")
 	mailSendCmd.Flags().StringArrayVar(&mailCC, "cc", nil, "CC recipients (can be used multiple times)")
+	mailSendCmd.Flags().StringVar(&mailEncrypt, "encrypt", "", "Encrypt the body at rest with a secrets-store key (default key: "+mail.DefaultMailKey+")")
+	mailSendCmd.Flags().Lookup("encrypt").NoOptDefVal = mail.DefaultMailKey
 	_ = mailSendCmd.MarkFlagRequired("subject") // cobra flags: error only at runtime if missing
 
 	// Inbox flags
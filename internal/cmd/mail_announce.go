@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -49,7 +50,7 @@ func listAnnounceChannels(cfg *config.MessagingConfig) error {
 			fmt.Println("[]")
 			return nil
 		}
-		fmt.Printf("%s No announce channels configured\n", style.Dim.Render("○"))
+		fmt.Printf("%s No announce channels configured\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -93,7 +94,7 @@ func listAnnounceChannels(cfg *config.MessagingConfig) error {
 		if annCfg.RetainCount > 0 {
 			retainStr = fmt.Sprintf("%d messages", annCfg.RetainCount)
 		}
-		fmt.Printf("  %s %s\n", style.Bold.Render("●"), name)
+		fmt.Printf("  %s %s\n", style.Bold.Render(ui.Glyph("●")), name)
 		fmt.Printf("    Readers: %s\n", strings.Join(annCfg.Readers, ", "))
 		fmt.Printf("    Retain: %s\n", style.Dim.Render(retainStr))
 	}
@@ -144,7 +145,7 @@ func readAnnounceChannel(townRoot string, cfg *config.MessagingConfig, channelNa
 			priorityMarker = " " + style.Bold.Render("!")
 		}
 
-		fmt.Printf("  %s %s%s\n", style.Bold.Render("●"), msg.Title, priorityMarker)
+		fmt.Printf("  %s %s%s\n", style.Bold.Render(ui.Glyph("●")), msg.Title, priorityMarker)
 		fmt.Printf("    %s from %s\n",
 			style.Dim.Render(msg.ID),
 			msg.From)
@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -253,7 +254,7 @@ func runChannelShow(cmd *cobra.Command, args []string) error {
 			priorityMarker = " " + style.Bold.Render("!")
 		}
 
-		fmt.Printf("  %s %s%s\n", style.Bold.Render("●"), msg.Title, priorityMarker)
+		fmt.Printf("  %s %s%s\n", style.Bold.Render(ui.Glyph("●")), msg.Title, priorityMarker)
 		fmt.Printf("    %s from %s\n",
 			style.Dim.Render(msg.ID),
 			msg.From)
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 var (
@@ -106,7 +107,7 @@ func runMailDrain(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(messages) == 0 {
-		fmt.Printf("%s Inbox %s is empty, nothing to drain\n", style.Success.Render("✓"), address)
+		fmt.Printf("%s Inbox %s is empty, nothing to drain\n", style.SuccessPrefix, address)
 		return nil
 	}
 
@@ -147,7 +148,7 @@ func runMailDrain(cmd *cobra.Command, args []string) error {
 
 	if len(candidates) == 0 {
 		fmt.Printf("%s No drainable messages in %s (%d messages total)\n",
-			style.Success.Render("✓"), address, len(messages))
+			style.SuccessPrefix, address, len(messages))
 		return nil
 	}
 
@@ -178,7 +179,7 @@ func runMailDrain(cmd *cobra.Command, args []string) error {
 
 	if len(archiveErrors) > 0 {
 		fmt.Printf("%s Drained %d/%d messages from %s (%d remaining, %d errors)\n",
-			style.Bold.Render("⚠"), archived, len(candidates), address, remaining, len(archiveErrors))
+			style.Bold.Render(ui.Glyph(ui.IconWarn)), archived, len(candidates), address, remaining, len(archiveErrors))
 		for _, e := range archiveErrors {
 			fmt.Printf("  Error: %s\n", e)
 		}
@@ -186,7 +187,7 @@ func runMailDrain(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Drained %d messages from %s (%d remaining)\n",
-		style.Bold.Render("✓"), archived, address, remaining)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), archived, address, remaining)
 
 	// Summarize what was drained by type
 	typeCounts := make(map[string]int)
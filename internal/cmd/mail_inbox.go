@@ -11,8 +11,10 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/observer"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // getMailbox returns the mailbox for the given address.
@@ -32,6 +34,15 @@ func getMailbox(address string) (*mail.Mailbox, error) {
 	return mailbox, nil
 }
 
+// acknowledgeUnlessObserver acks delivery of msgs, except for read-only
+// observers, who leave no receipts.
+func acknowledgeUnlessObserver(mailbox *mail.Mailbox, address string, msgs []*mail.Message) error {
+	if observer.Enabled() {
+		return nil
+	}
+	return mailbox.AcknowledgeDeliveries(address, msgs)
+}
+
 func runMailInbox(cmd *cobra.Command, args []string) error {
 	// Check for mutually exclusive flags
 	if mailInboxAll && mailInboxUnread {
@@ -77,7 +88,7 @@ func runMailInbox(cmd *cobra.Command, args []string) error {
 			return err
 		}
 		// Ack after output so JSON reflects accurate read-time state.
-		if ackErr := mailbox.AcknowledgeDeliveries(address, messages); ackErr != nil {
+		if ackErr := acknowledgeUnlessObserver(mailbox, address, messages); ackErr != nil {
 			fmt.Fprintf(os.Stderr, "gt mail inbox: delivery ack failed: %v\n", ackErr)
 		}
 		return nil
@@ -97,9 +108,9 @@ func runMailInbox(cmd *cobra.Command, args []string) error {
 	}
 
 	for i, msg := range messages {
-		readMarker := "●"
+		readMarker := ui.Glyph("●")
 		if msg.Read {
-			readMarker = "○"
+			readMarker = ui.Glyph("○")
 		}
 		typeMarker := ""
 		if msg.Type != "" && msg.Type != mail.TypeNotification {
@@ -113,10 +124,14 @@ func runMailInbox(cmd *cobra.Command, args []string) error {
 		if msg.Wisp {
 			wispMarker = " " + style.Dim.Render("(wisp)")
 		}
+		encryptedMarker := ""
+		if msg.Encrypted != "" {
+			encryptedMarker = " " + style.Dim.Render("(encrypted)")
+		}
 
 		// Show 1-based index for easy reference with 'gt mail read <n>'
 		indexStr := style.Dim.Render(fmt.Sprintf("%d.", i+1))
-		fmt.Printf("  %s %s %s%s%s%s\n", indexStr, readMarker, msg.Subject, typeMarker, priorityMarker, wispMarker)
+		fmt.Printf("  %s %s %s%s%s%s%s\n", indexStr, readMarker, msg.Subject, typeMarker, priorityMarker, wispMarker, encryptedMarker)
 		fmt.Printf("      %s from %s\n",
 			style.Dim.Render(msg.ID),
 			msg.From)
@@ -125,13 +140,31 @@ func runMailInbox(cmd *cobra.Command, args []string) error {
 	}
 
 	// Ack after output so human-readable display is not delayed by bd subprocesses.
-	if ackErr := mailbox.AcknowledgeDeliveries(address, messages); ackErr != nil {
+	if ackErr := acknowledgeUnlessObserver(mailbox, address, messages); ackErr != nil {
 		fmt.Fprintf(os.Stderr, "gt mail inbox: delivery ack failed: %v\n", ackErr)
 	}
 
 	return nil
 }
 
+// resolveMailRef resolves a message ID or a 1-based inbox index (as shown by
+// gt mail inbox) to a message ID.
+func resolveMailRef(mailbox *mail.Mailbox, ref string) (string, error) {
+	idx, err := strconv.Atoi(ref)
+	if err != nil || idx <= 0 {
+		return ref, nil
+	}
+	// Numeric index: resolve to message ID by listing inbox
+	messages, err := mailbox.List()
+	if err != nil {
+		return "", fmt.Errorf("listing messages: %w", err)
+	}
+	if idx > len(messages) {
+		return "", fmt.Errorf("index %d out of range (inbox has %d messages)", idx, len(messages))
+	}
+	return messages[idx-1].ID, nil
+}
+
 func runMailRead(cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
 		return fmt.Errorf("message ID or index required\n\nRun 'gt mail inbox' to list messages and their IDs")
@@ -146,20 +179,9 @@ func runMailRead(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Check if the argument is a numeric index (1-based)
-	var msgID string
-	if idx, err := strconv.Atoi(msgRef); err == nil && idx > 0 {
-		// Numeric index: resolve to message ID by listing inbox
-		messages, err := mailbox.List()
-		if err != nil {
-			return fmt.Errorf("listing messages: %w", err)
-		}
-		if idx > len(messages) {
-			return fmt.Errorf("index %d out of range (inbox has %d messages)", idx, len(messages))
-		}
-		msgID = messages[idx-1].ID
-	} else {
-		msgID = msgRef
+	msgID, err := resolveMailRef(mailbox, msgRef)
+	if err != nil {
+		return err
 	}
 
 	msg, err := mailbox.Get(msgID)
@@ -170,9 +192,12 @@ func runMailRead(cmd *cobra.Command, args []string) error {
 	// Mark as read when viewed (adds "read" label, does not close/archive).
 	// Handoff messages are preserved via the hook mechanism, so marking
 	// read here is safe — hooked mail is found via gt hook, not the inbox.
-	if err := mailbox.MarkReadOnly(msgID); err != nil {
-		// Non-fatal: message was retrieved, just couldn't mark
-		style.PrintWarning("could not mark message as read: %v", err)
+	// Read-only observers leave mail unread.
+	if !observer.Enabled() {
+		if err := mailbox.MarkReadOnly(msgID); err != nil {
+			// Non-fatal: message was retrieved, just couldn't mark
+			style.PrintWarning("could not mark message as read: %v", err)
+		}
 	}
 
 	// JSON output
@@ -183,7 +208,7 @@ func runMailRead(cmd *cobra.Command, args []string) error {
 			return err
 		}
 		// Ack after output so JSON reflects accurate read-time state.
-		if ackErr := mailbox.AcknowledgeDeliveries(address, []*mail.Message{msg}); ackErr != nil {
+		if ackErr := acknowledgeUnlessObserver(mailbox, address, []*mail.Message{msg}); ackErr != nil {
 			fmt.Fprintf(os.Stderr, "gt mail read: delivery ack failed: %v\n", ackErr)
 		}
 		return nil
@@ -214,13 +239,16 @@ func runMailRead(cmd *cobra.Command, args []string) error {
 	if msg.ReplyTo != "" {
 		fmt.Printf("Reply-To: %s\n", style.Dim.Render(msg.ReplyTo))
 	}
+	if msg.Encrypted != "" {
+		fmt.Printf("Encrypted: %s\n", style.Dim.Render(msg.Encrypted))
+	}
 
 	if msg.Body != "" {
 		fmt.Printf("\n%s\n", msg.Body)
 	}
 
 	// Ack after output (non-fatal).
-	if ackErr := mailbox.AcknowledgeDeliveries(address, []*mail.Message{msg}); ackErr != nil {
+	if ackErr := acknowledgeUnlessObserver(mailbox, address, []*mail.Message{msg}); ackErr != nil {
 		fmt.Fprintf(os.Stderr, "gt mail read: delivery ack failed: %v\n", ackErr)
 	}
 
@@ -301,7 +329,7 @@ func runMailDelete(cmd *cobra.Command, args []string) error {
 	// Report results
 	if len(errors) > 0 {
 		fmt.Printf("%s Deleted %d/%d messages\n",
-			style.Bold.Render("⚠"), deleted, len(args))
+			style.Bold.Render(ui.Glyph(ui.IconWarn)), deleted, len(args))
 		for _, e := range errors {
 			fmt.Printf("  Error: %s\n", e)
 		}
@@ -309,9 +337,9 @@ func runMailDelete(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(args) == 1 {
-		fmt.Printf("%s Message deleted\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Message deleted\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
-		fmt.Printf("%s Deleted %d messages\n", style.Bold.Render("✓"), deleted)
+		fmt.Printf("%s Deleted %d messages\n", style.Bold.Render(ui.Glyph(ui.IconPass)), deleted)
 	}
 	return nil
 }
@@ -356,7 +384,7 @@ func runMailArchive(cmd *cobra.Command, args []string) error {
 	// Report results
 	if len(errors) > 0 {
 		fmt.Printf("%s Archived %d/%d messages\n",
-			style.Bold.Render("⚠"), archived, len(args))
+			style.Bold.Render(ui.Glyph(ui.IconWarn)), archived, len(args))
 		for _, e := range errors {
 			fmt.Printf("  Error: %s\n", e)
 		}
@@ -364,9 +392,9 @@ func runMailArchive(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(args) == 1 {
-		fmt.Printf("%s Message archived\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Message archived\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
-		fmt.Printf("%s Archived %d messages\n", style.Bold.Render("✓"), archived)
+		fmt.Printf("%s Archived %d messages\n", style.Bold.Render(ui.Glyph(ui.IconPass)), archived)
 	}
 	return nil
 }
@@ -400,7 +428,7 @@ func runMailArchiveStale(mailbox *mail.Mailbox, address string) error {
 	staleMessages := staleMessagesForSession(messages, sessionStart)
 	if mailArchiveDryRun {
 		if len(staleMessages) == 0 {
-			fmt.Printf("%s No stale messages found\n", style.Success.Render("✓"))
+			fmt.Printf("%s No stale messages found\n", style.SuccessPrefix)
 			return nil
 		}
 		fmt.Printf("%s Would archive %d stale message(s):\n", style.Dim.Render("(dry-run)"), len(staleMessages))
@@ -411,7 +439,7 @@ func runMailArchiveStale(mailbox *mail.Mailbox, address string) error {
 	}
 
 	if len(staleMessages) == 0 {
-		fmt.Printf("%s No stale messages to archive\n", style.Success.Render("✓"))
+		fmt.Printf("%s No stale messages to archive\n", style.SuccessPrefix)
 		return nil
 	}
 
@@ -426,7 +454,7 @@ func runMailArchiveStale(mailbox *mail.Mailbox, address string) error {
 	}
 
 	if len(errors) > 0 {
-		fmt.Printf("%s Archived %d/%d stale messages\n", style.Bold.Render("⚠"), archived, len(staleMessages))
+		fmt.Printf("%s Archived %d/%d stale messages\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), archived, len(staleMessages))
 		for _, e := range errors {
 			fmt.Printf("  Error: %s\n", e)
 		}
@@ -434,9 +462,9 @@ func runMailArchiveStale(mailbox *mail.Mailbox, address string) error {
 	}
 
 	if archived == 1 {
-		fmt.Printf("%s Stale message archived\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Stale message archived\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
-		fmt.Printf("%s Archived %d stale messages\n", style.Bold.Render("✓"), archived)
+		fmt.Printf("%s Archived %d stale messages\n", style.Bold.Render(ui.Glyph(ui.IconPass)), archived)
 	}
 	return nil
 }
@@ -475,7 +503,7 @@ func runMailMarkRead(cmd *cobra.Command, args []string) error {
 	// Report results
 	if len(errors) > 0 {
 		fmt.Printf("%s Marked %d/%d messages as read\n",
-			style.Bold.Render("⚠"), marked, len(args))
+			style.Bold.Render(ui.Glyph(ui.IconWarn)), marked, len(args))
 		for _, e := range errors {
 			fmt.Printf("  Error: %s\n", e)
 		}
@@ -483,9 +511,9 @@ func runMailMarkRead(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(args) == 1 {
-		fmt.Printf("%s Message marked as read\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Message marked as read\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
-		fmt.Printf("%s Marked %d messages as read\n", style.Bold.Render("✓"), marked)
+		fmt.Printf("%s Marked %d messages as read\n", style.Bold.Render(ui.Glyph(ui.IconPass)), marked)
 	}
 	return nil
 }
@@ -513,7 +541,7 @@ func runMailMarkUnread(cmd *cobra.Command, args []string) error {
 	// Report results
 	if len(errors) > 0 {
 		fmt.Printf("%s Marked %d/%d messages as unread\n",
-			style.Bold.Render("⚠"), marked, len(args))
+			style.Bold.Render(ui.Glyph(ui.IconWarn)), marked, len(args))
 		for _, e := range errors {
 			fmt.Printf("  Error: %s\n", e)
 		}
@@ -521,9 +549,9 @@ func runMailMarkUnread(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(args) == 1 {
-		fmt.Printf("%s Message marked as unread\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Message marked as unread\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
-		fmt.Printf("%s Marked %d messages as unread\n", style.Bold.Render("✓"), marked)
+		fmt.Printf("%s Marked %d messages as unread\n", style.Bold.Render(ui.Glyph(ui.IconPass)), marked)
 	}
 	return nil
 }
@@ -549,7 +577,7 @@ func runMailClear(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(messages) == 0 {
-		fmt.Printf("%s Inbox %s is already empty\n", style.Dim.Render("○"), address)
+		fmt.Printf("%s Inbox %s is already empty\n", style.Dim.Render(ui.Glyph("○")), address)
 		return nil
 	}
 
@@ -571,7 +599,7 @@ func runMailClear(cmd *cobra.Command, args []string) error {
 	// Report results
 	if len(errors) > 0 {
 		fmt.Printf("%s Cleared %d/%d messages from %s\n",
-			style.Bold.Render("⚠"), deleted, len(messages), address)
+			style.Bold.Render(ui.Glyph(ui.IconWarn)), deleted, len(messages), address)
 		for _, e := range errors {
 			fmt.Printf("  Error: %s\n", e)
 		}
@@ -579,6 +607,6 @@ func runMailClear(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Cleared %d messages from %s\n",
-		style.Bold.Render("✓"), deleted, address)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), deleted, address)
 	return nil
 }
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var mailKeygenCmd = &cobra.Command{
+	Use:   "keygen [name]",
+	Short: "Create a key for encrypted mail",
+	Long: `Generate a mail encryption key and store it in the town secrets store
+(mayor/secrets.env). The key is named MAIL_KEY unless a name is given.
+
+Send encrypted mail with gt mail send --encrypt (or --encrypt=<name>).
+An existing key is never replaced: mail sealed with it would become
+unreadable.
+
+Examples:
+  gt mail keygen
+  gt mail keygen INCIDENT_KEY`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMailKeygen,
+}
+
+func init() {
+	mailCmd.AddCommand(mailKeygenCmd)
+}
+
+func runMailKeygen(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	name := mail.DefaultMailKey
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	secrets, err := config.LoadSecrets(townRoot)
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[name]; ok {
+		return fmt.Errorf("secret %s already exists; mail sealed with it would become unreadable if replaced", name)
+	}
+	key, err := mail.GenerateMailKey()
+	if err != nil {
+		return err
+	}
+	secrets[name] = key
+	if err := config.SaveSecrets(townRoot, secrets); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Created mail key %s in %s\n", style.SuccessPrefix, style.Bold.Render(name), config.SecretsPath(townRoot))
+	return nil
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var (
+	mailPromoteTitle    string
+	mailPromoteType     string
+	mailPromoteRig      string
+	mailPromotePriority int
+	mailPromoteArchive  bool
+	mailPromoteJSON     bool
+)
+
+var mailPromoteCmd = &cobra.Command{
+	Use:   "promote <message-id|index>",
+	Short: "Turn a mail thread into a bead",
+	Long: `Promote a mail message, with its thread, into a bead so the work it asks
+for is tracked instead of lost in an inbox.
+
+The bead's title is the thread subject (without "Re:") and its description
+carries the whole thread over as initial context, oldest first, with a link
+back to the message. The bead is labeled from-mail, and the message is
+labeled promoted:<bead-id> so the link works in both directions. Priority
+follows the message's priority unless --priority is given.
+
+The bead goes in town beads by default, or in a rig's beads with --rig so
+it can be slung there. --json prints the result for agents.
+
+Examples:
+  gt mail promote hq-abc123
+  gt mail promote 2 --rig gastown --type bug
+  gt mail promote hq-abc123 --title "Fix flaky login test" --archive
+  gt mail promote hq-abc123 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMailPromote,
+}
+
+func init() {
+	mailPromoteCmd.Flags().StringVar(&mailPromoteTitle, "title", "", "Bead title (default: the thread subject)")
+	mailPromoteCmd.Flags().StringVar(&mailPromoteType, "type", "task", "Bead type: task, bug, feature, or epic")
+	mailPromoteCmd.Flags().StringVar(&mailPromoteRig, "rig", "", "Create the bead in this rig's beads (default: town beads)")
+	mailPromoteCmd.Flags().IntVarP(&mailPromotePriority, "priority", "p", -1, "Bead priority 0-4 (default: from the message)")
+	mailPromoteCmd.Flags().BoolVar(&mailPromoteArchive, "archive", false, "Archive the message after promoting it")
+	mailPromoteCmd.Flags().BoolVar(&mailPromoteJSON, "json", false, "Output as JSON")
+
+	mailCmd.AddCommand(mailPromoteCmd)
+}
+
+// MailPromotion is the gt mail promote result.
+type MailPromotion struct {
+	Message  string `json:"message"`
+	ThreadID string `json:"thread_id,omitempty"`
+	Bead     string `json:"bead"`
+	Title    string `json:"title"`
+	Rig      string `json:"rig,omitempty"`
+	Messages int    `json:"messages"` // Thread messages carried over
+}
+
+func runMailPromote(cmd *cobra.Command, args []string) error {
+	workDir, err := findMailWorkDir()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if mailPromotePriority > 4 {
+		return fmt.Errorf("invalid priority %d: must be 0-4", mailPromotePriority)
+	}
+
+	address := detectSender()
+	mailbox, err := getMailbox(address)
+	if err != nil {
+		return err
+	}
+	msgID, err := resolveMailRef(mailbox, args[0])
+	if err != nil {
+		return err
+	}
+	msg, err := mailbox.Get(msgID)
+	if err != nil {
+		return fmt.Errorf("getting message: %w", err)
+	}
+
+	thread := []*mail.Message{msg}
+	if msg.ThreadID != "" {
+		if msgs, err := mailbox.ListByThread(msg.ThreadID); err == nil && len(msgs) > 0 {
+			thread = msgs
+		} else if err != nil {
+			style.PrintWarning("could not load thread %s, promoting the message alone: %v", msg.ThreadID, err)
+		}
+	}
+
+	for _, m := range thread {
+		if m.Encrypted != "" {
+			return fmt.Errorf("message %s is encrypted; promoting it would store the thread in plaintext", m.ID)
+		}
+	}
+
+	draft := mail.DraftPromotion(msg, thread)
+	if mailPromoteTitle != "" {
+		draft.Title = mailPromoteTitle
+	}
+	if mailPromotePriority >= 0 {
+		draft.Priority = mailPromotePriority
+	}
+
+	beadDir := workDir
+	if mailPromoteRig != "" {
+		_, r, err := getRig(mailPromoteRig)
+		if err != nil {
+			return err
+		}
+		beadDir = r.Path
+	}
+	bd := beads.New(beadDir)
+	issue, err := bd.Create(beads.CreateOptions{
+		Title:       draft.Title,
+		Type:        mailPromoteType,
+		Priority:    draft.Priority,
+		Description: draft.Description,
+		Actor:       address,
+	})
+	if err != nil {
+		return fmt.Errorf("creating bead: %w", err)
+	}
+	if err := bd.Update(issue.ID, beads.UpdateOptions{AddLabels: []string{mail.FromMailLabel}}); err != nil {
+		style.PrintWarning("could not label %s: %v", issue.ID, err)
+	}
+
+	// Link back from the message. Messages live in town beads.
+	if err := beads.New(workDir).Update(msg.ID, beads.UpdateOptions{AddLabels: []string{mail.PromotedLabelPrefix + issue.ID}}); err != nil {
+		style.PrintWarning("could not link %s back to %s: %v", msg.ID, issue.ID, err)
+	}
+	if mailPromoteArchive {
+		if err := mailbox.Archive(msg.ID); err != nil {
+			style.PrintWarning("could not archive %s: %v", msg.ID, err)
+		}
+	}
+
+	result := MailPromotion{
+		Message:  msg.ID,
+		ThreadID: msg.ThreadID,
+		Bead:     issue.ID,
+		Title:    draft.Title,
+		Rig:      mailPromoteRig,
+		Messages: len(thread),
+	}
+	if mailPromoteJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	fmt.Printf("%s Promoted %s → %s: %s\n", style.SuccessPrefix, msg.ID, style.Bold.Render(issue.ID), draft.Title)
+	fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("%d thread message(s) carried over", len(thread))))
+	if mailPromoteRig != "" {
+		fmt.Printf("  Sling it: %s\n", style.Dim.Render(fmt.Sprintf("gt sling %s %s", issue.ID, mailPromoteRig)))
+	}
+	return nil
+}
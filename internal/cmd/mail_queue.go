@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -71,7 +72,7 @@ func runMailClaim(cmd *cobra.Command, args []string) error {
 		}
 		if len(eligibleIssues) == 0 {
 			fmt.Printf("%s No queues available for claiming (caller: %s)\n",
-				style.Dim.Render("○"), caller)
+				style.Dim.Render(ui.Glyph("○")), caller)
 			return nil
 		}
 
@@ -92,7 +93,7 @@ func runMailClaim(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(messages) == 0 {
-		fmt.Printf("%s No messages to claim in queue %s\n", style.Dim.Render("○"), queueName)
+		fmt.Printf("%s No messages to claim in queue %s\n", style.Dim.Render(ui.Glyph("○")), queueName)
 		return nil
 	}
 
@@ -135,12 +136,12 @@ func runMailClaim(cmd *cobra.Command, args []string) error {
 
 	if claimed == nil {
 		fmt.Printf("%s No messages to claim in queue %s (all contested)\n",
-			style.Dim.Render("○"), queueName)
+			style.Dim.Render(ui.Glyph("○")), queueName)
 		return nil
 	}
 
 	// Print claimed message details
-	fmt.Printf("%s Claimed message from queue %s\n", style.Bold.Render("✓"), queueName)
+	fmt.Printf("%s Claimed message from queue %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), queueName)
 	fmt.Printf("  ID: %s\n", claimed.ID)
 	fmt.Printf("  Subject: %s\n", claimed.Title)
 	if claimed.Description != "" {
@@ -322,7 +323,7 @@ func runMailRelease(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("releasing message: %w", err)
 	}
 
-	fmt.Printf("%s Released message back to queue %s\n", style.Bold.Render("✓"), msgInfo.QueueName)
+	fmt.Printf("%s Released message back to queue %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), msgInfo.QueueName)
 	fmt.Printf("  ID: %s\n", messageID)
 	fmt.Printf("  Subject: %s\n", msgInfo.Title)
 
@@ -591,7 +592,7 @@ func runMailQueueCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("creating queue: %w", err)
 	}
 
-	fmt.Printf("%s Created queue %s\n", style.Bold.Render("✓"), queueName)
+	fmt.Printf("%s Created queue %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), queueName)
 	fmt.Printf("  ID: %s\n", queueID)
 	fmt.Printf("  Claimers: %s\n", mailQueueClaimers)
 
@@ -679,7 +680,7 @@ func runMailQueueList(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(queues) == 0 {
-		fmt.Printf("%s No queues found\n", style.Dim.Render("○"))
+		fmt.Printf("%s No queues found\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -742,7 +743,7 @@ func runMailQueueDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("deleting queue: %w", err)
 	}
 
-	fmt.Printf("%s Deleted queue %s\n", style.Bold.Render("✓"), queueName)
+	fmt.Printf("%s Deleted queue %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), queueName)
 
 	return nil
 }
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	mailRetentionDryRun bool
+	mailRetentionJSON   bool
+)
+
+var mailRetentionCmd = &cobra.Command{
+	Use:   "retention",
+	Short: "Apply mail retention policies and size caps",
+	Long: `Apply the town's mail retention policy to every mailbox.
+
+The policy lives in settings/config.json under "mail_retention":
+  max_age            Archive a thread once its newest message is this old
+  max_messages       Archive the oldest messages beyond this count
+  max_bytes          Hard cap on a mailbox's subjects and bodies; the
+                     oldest messages are evicted to the archive until it fits
+  archive_max_age    Delete archived messages older than this
+  archive_max_bytes  Hard cap on the archive file; oldest deleted first
+  warn_percent       How full a cap gets before gt doctor warns (default 80)
+
+Pinned messages are never archived. The daemon runs this hourly when a
+policy is configured.
+
+Examples:
+  gt mail retention --dry-run   # Show what would be archived
+  gt mail retention
+  gt mail retention --json`,
+	Args: cobra.NoArgs,
+	RunE: runMailRetention,
+}
+
+func init() {
+	mailRetentionCmd.Flags().BoolVarP(&mailRetentionDryRun, "dry-run", "n", false, "Show what would be archived without changing anything")
+	mailRetentionCmd.Flags().BoolVar(&mailRetentionJSON, "json", false, "Output the report as JSON")
+	mailCmd.AddCommand(mailRetentionCmd)
+}
+
+func runMailRetention(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := mail.LoadRetentionPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	if !policy.Enabled() {
+		if !mailRetentionJSON {
+			fmt.Println(style.Dim.Render("No mail retention policy configured (mail_retention in settings/config.json)."))
+		}
+		return nil
+	}
+
+	report, err := mail.SweepRetention(townRoot, policy, time.Now(), mailRetentionDryRun)
+	if err != nil {
+		return err
+	}
+
+	if mailRetentionJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	verb, pruned := "Archived", "pruned"
+	if report.DryRun {
+		verb, pruned = "Would archive", "would prune"
+	}
+	for _, a := range report.Archived {
+		fmt.Printf("  %s %s %s %s\n", style.Dim.Render(a.Reason), a.Mailbox, a.Message.ID, style.Dim.Render(a.Message.Subject))
+	}
+	for _, e := range report.Errors {
+		style.PrintWarning("%s", e)
+	}
+	if len(report.Archived) == 0 && report.Purged == 0 {
+		// Quiet for the daemon's hourly run.
+		return nil
+	}
+	fmt.Printf("%s %s %d message(s)", style.SuccessPrefix, verb, len(report.Archived))
+	if report.Purged > 0 {
+		fmt.Printf(", %s %d from the archive", pruned, report.Purged)
+	}
+	fmt.Println()
+	return nil
+}
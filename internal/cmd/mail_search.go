@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // runMailSearch searches for messages matching a pattern.
@@ -61,9 +62,9 @@ func runMailSearch(cmd *cobra.Command, args []string) error {
 	}
 
 	for _, msg := range messages {
-		readMarker := "●"
+		readMarker := ui.Glyph("●")
 		if msg.Read {
-			readMarker = "○"
+			readMarker = ui.Glyph("○")
 		}
 		typeMarker := ""
 		if msg.Type != "" && msg.Type != mail.TypeNotification {
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -99,6 +100,9 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 	// Set CC recipients
 	msg.CC = mailCC
 
+	// Seal the body at rest (router.Send encrypts)
+	msg.Encrypted = mailEncrypt
+
 	// Suppress router-side notification when --no-notify is passed.
 	// Otherwise the router handles idle-aware notification per-recipient,
 	// which also works correctly for fan-out (groups, lists, channels).
@@ -126,6 +130,10 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 				style.PrintWarning("could not find original message %s for threading (new thread will be created)", mailReplyTo)
 			} else {
 				msg.ThreadID = original.ThreadID
+				// Replies in an encrypted thread stay encrypted
+				if msg.Encrypted == "" {
+					msg.Encrypted = original.Encrypted
+				}
 			}
 		}
 	}
@@ -155,7 +163,7 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("sending message: %w", err)
 		}
 		_ = events.LogFeed(events.TypeMail, from, events.MailPayload(to, mailSubject))
-		fmt.Printf("%s Message sent to %s\n", style.Bold.Render("✓"), to)
+		fmt.Printf("%s Message sent to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), to)
 		fmt.Printf("  Subject: %s\n", mailSubject)
 		return nil
 	}
@@ -203,13 +211,13 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 		if len(recipientAddrs) == 0 {
 			return fmt.Errorf("all sends failed: %s", strings.Join(sendErrs, "; "))
 		}
-		fmt.Fprintf(os.Stderr, "⚠ Some deliveries failed: %s\n", strings.Join(sendErrs, "; "))
+		fmt.Fprintf(os.Stderr, "%s Some deliveries failed: %s\n", ui.Glyph(ui.IconWarn), strings.Join(sendErrs, "; "))
 	}
 
 	// Log mail event to activity feed
 	_ = events.LogFeed(events.TypeMail, from, events.MailPayload(to, mailSubject))
 
-	fmt.Printf("%s Message sent to %s\n", style.Bold.Render("✓"), to)
+	fmt.Printf("%s Message sent to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), to)
 	fmt.Printf("  Subject: %s\n", mailSubject)
 
 	// Show resolved recipients if fan-out occurred
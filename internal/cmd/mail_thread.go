@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 func runMailThread(cmd *cobra.Command, args []string) error {
@@ -60,11 +61,15 @@ func runMailThread(cmd *cobra.Command, args []string) error {
 		if msg.Priority == mail.PriorityHigh || msg.Priority == mail.PriorityUrgent {
 			priorityMarker = " " + style.Bold.Render("!")
 		}
+		encryptedMarker := ""
+		if msg.Encrypted != "" {
+			encryptedMarker = " " + style.Dim.Render("(encrypted)")
+		}
 
 		if i > 0 {
 			fmt.Printf("  %s\n", style.Dim.Render("│"))
 		}
-		fmt.Printf("  %s %s%s%s\n", style.Bold.Render("●"), msg.Subject, typeMarker, priorityMarker)
+		fmt.Printf("  %s %s%s%s%s\n", style.Bold.Render(ui.Glyph("●")), msg.Subject, typeMarker, priorityMarker, encryptedMarker)
 		fmt.Printf("    %s from %s to %s\n",
 			style.Dim.Render(msg.ID),
 			msg.From, msg.To)
@@ -134,6 +139,8 @@ func runMailReply(cmd *cobra.Command, args []string) error {
 		Priority: mail.PriorityNormal,
 		ReplyTo:  msgID,
 		ThreadID: original.ThreadID,
+		// Replies in an encrypted thread stay encrypted
+		Encrypted: original.Encrypted,
 	}
 
 	// If original has no thread ID, create one
@@ -147,7 +154,7 @@ func runMailReply(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("sending reply: %w", err)
 	}
 
-	fmt.Printf("%s Reply sent to %s\n", style.Bold.Render("✓"), original.From)
+	fmt.Printf("%s Reply sent to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), original.From)
 	fmt.Printf("  Subject: %s\n", subject)
 	if original.ThreadID != "" {
 		fmt.Printf("  Thread: %s\n", style.Dim.Render(original.ThreadID))
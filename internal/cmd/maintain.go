@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -92,14 +93,14 @@ func runMaintain(cmd *cobra.Command, args []string) error {
 	}
 
 	// Phase 0: Build and display maintenance plan.
-	fmt.Printf("%s Building maintenance plan...\n", style.Bold.Render("●"))
+	fmt.Printf("%s Building maintenance plan...\n", style.Bold.Render(ui.Glyph("●")))
 
 	databases, err := doltserver.ListDatabases(townRoot)
 	if err != nil {
 		return fmt.Errorf("listing databases: %w", err)
 	}
 	if len(databases) == 0 {
-		fmt.Printf("%s No databases found — nothing to maintain\n", style.Dim.Render("○"))
+		fmt.Printf("%s No databases found — nothing to maintain\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -116,11 +117,11 @@ func runMaintain(cmd *cobra.Command, args []string) error {
 	// Display plan.
 	flattenCount := 0
 	backupCount := 0
-	fmt.Printf("\n%s Maintenance plan:\n", style.Bold.Render("●"))
+	fmt.Printf("\n%s Maintenance plan:\n", style.Bold.Render(ui.Glyph("●")))
 	for _, db := range dbInfos {
 		tags := ""
 		if db.commitCount >= maintainThreshold {
-			tags += fmt.Sprintf(" %s", style.Warning.Render("→ flatten"))
+			tags += fmt.Sprintf(" %s", style.Warning.Render(ui.Glyph("→")+" flatten"))
 			flattenCount++
 		}
 		if db.hasBackup {
@@ -135,7 +136,7 @@ func runMaintain(cmd *cobra.Command, args []string) error {
 	fmt.Printf("  Will gc: %d\n", len(dbInfos))
 
 	if maintainDryRun {
-		fmt.Printf("\n%s Dry run complete — no changes made\n", style.Dim.Render("ℹ"))
+		fmt.Printf("\n%s Dry run complete — no changes made\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
 		return nil
 	}
 
@@ -158,7 +159,7 @@ func runMaintain(cmd *cobra.Command, args []string) error {
 
 	// Phase 2: Backup.
 	if backupCount > 0 {
-		fmt.Printf("\n%s Backing up databases...\n", style.Bold.Render("●"))
+		fmt.Printf("\n%s Backing up databases...\n", style.Bold.Render(ui.Glyph("●")))
 		for _, db := range dbInfos {
 			if !db.hasBackup {
 				continue
@@ -167,40 +168,40 @@ func runMaintain(cmd *cobra.Command, args []string) error {
 			if err := maintainBackupSync(config.DataDir, db.name, backupName); err != nil {
 				fmt.Printf("  %s %s: backup failed: %v\n", style.Warning.Render("!"), db.name, err)
 			} else {
-				fmt.Printf("  %s %s backed up\n", style.Bold.Render("✓"), db.name)
+				fmt.Printf("  %s %s backed up\n", style.Bold.Render(ui.Glyph(ui.IconPass)), db.name)
 			}
 		}
 	}
 
 	// Phase 3: Reap (server up).
-	fmt.Printf("\n%s Reaping closed wisps...\n", style.Bold.Render("●"))
+	fmt.Printf("\n%s Reaping closed wisps...\n", style.Bold.Render(ui.Glyph("●")))
 	totalReaped := 0
 	for _, db := range dbInfos {
 		purged, err := doltserver.PurgeClosedEphemerals(townRoot, db.name, false)
 		if err != nil {
 			fmt.Printf("  %s %s: reap failed: %v\n", style.Warning.Render("!"), db.name, err)
 		} else if purged > 0 {
-			fmt.Printf("  %s %s: reaped %d wisps\n", style.Bold.Render("✓"), db.name, purged)
+			fmt.Printf("  %s %s: reaped %d wisps\n", style.Bold.Render(ui.Glyph(ui.IconPass)), db.name, purged)
 			totalReaped += purged
 		} else {
-			fmt.Printf("  %s %s: nothing to reap\n", style.Dim.Render("○"), db.name)
+			fmt.Printf("  %s %s: nothing to reap\n", style.Dim.Render(ui.Glyph("○")), db.name)
 		}
 	}
 
 	// Phase 4: Flatten (server up).
 	totalFlattened := 0
 	if flattenCount > 0 {
-		fmt.Printf("\n%s Flattening databases...\n", style.Bold.Render("●"))
+		fmt.Printf("\n%s Flattening databases...\n", style.Bold.Render(ui.Glyph("●")))
 		for _, db := range dbInfos {
 			if db.commitCount < maintainThreshold {
 				continue
 			}
 			preCount := db.commitCount
 			if err := maintainFlattenDB(config, db.name); err != nil {
-				fmt.Printf("  %s %s: flatten failed: %v\n", style.Bold.Render("✗"), db.name, err)
+				fmt.Printf("  %s %s: flatten failed: %v\n", style.Bold.Render(ui.Glyph("✗")), db.name, err)
 			} else {
 				postCount, _ := maintainCountCommits(config, db.name)
-				fmt.Printf("  %s %s: %d → %d commits\n", style.Bold.Render("✓"), db.name, preCount, postCount)
+				fmt.Printf("  %s %s: %d → %d commits\n", style.Bold.Render(ui.Glyph(ui.IconPass)), db.name, preCount, postCount)
 				totalFlattened++
 			}
 		}
@@ -208,20 +209,20 @@ func runMaintain(cmd *cobra.Command, args []string) error {
 
 	// Phase 5: GC (safe on running server — no downtime needed).
 	gcCount := 0
-	fmt.Printf("\n%s Running GC (via SQL on running server)...\n", style.Bold.Render("●"))
+	fmt.Printf("\n%s Running GC (via SQL on running server)...\n", style.Bold.Render(ui.Glyph("●")))
 	for _, db := range dbInfos {
 		gcStart := time.Now()
 		if err := maintainGCDatabase(config, db.name); err != nil {
 			fmt.Printf("  %s %s: gc failed: %v\n", style.Warning.Render("!"), db.name, err)
 		} else {
 			fmt.Printf("  %s %s: gc completed (%v)\n",
-				style.Bold.Render("✓"), db.name, time.Since(gcStart).Round(time.Millisecond))
+				style.Bold.Render(ui.Glyph(ui.IconPass)), db.name, time.Since(gcStart).Round(time.Millisecond))
 			gcCount++
 		}
 	}
 
 	elapsed := time.Since(start)
-	fmt.Printf("\n%s Maintenance complete (%v)\n", style.Success.Render("✓"), elapsed.Round(time.Second))
+	fmt.Printf("\n%s Maintenance complete (%v)\n", style.SuccessPrefix, elapsed.Round(time.Second))
 	fmt.Printf("  Wisps reaped: %d\n", totalReaped)
 	fmt.Printf("  Databases flattened: %d\n", totalFlattened)
 	fmt.Printf("  Databases gc'd: %d\n", gcCount)
@@ -12,6 +12,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -126,7 +127,7 @@ func runMayorStart(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Mayor session started. Attach with: %s\n",
-		style.Bold.Render("✓"),
+		style.Bold.Render(ui.Glyph(ui.IconPass)),
 		style.Dim.Render("gt mayor attach"))
 
 	return nil
@@ -146,7 +147,7 @@ func runMayorStop(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("%s Mayor session stopped.\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Mayor session stopped.\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	return nil
 }
 
@@ -224,7 +225,7 @@ func runMayorAttach(cmd *cobra.Command, args []string) error {
 				return fmt.Errorf("restarting runtime: %w", err)
 			}
 
-			fmt.Printf("%s Mayor restarted with context\n", style.Bold.Render("✓"))
+			fmt.Printf("%s Mayor restarted with context\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		}
 	}
 
@@ -242,8 +243,9 @@ func runMayorStatus(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		if err == mayor.ErrNotRunning {
 			fmt.Printf("%s Mayor session is %s\n",
-				style.Dim.Render("○"),
+				style.Dim.Render(ui.Glyph("○")),
 				"not running")
+			printMayorDeputyStatus(mgr)
 			fmt.Printf("\nStart with: %s\n", style.Dim.Render("gt mayor start"))
 			return nil
 		}
@@ -255,15 +257,34 @@ func runMayorStatus(cmd *cobra.Command, args []string) error {
 		status = "attached"
 	}
 	fmt.Printf("%s Mayor session is %s\n",
-		style.Bold.Render("●"),
+		style.Bold.Render(ui.Glyph("●")),
 		style.Bold.Render("running"))
 	fmt.Printf("  Status: %s\n", status)
 	fmt.Printf("  Created: %s\n", info.Created)
+	printMayorDeputyStatus(mgr)
 	fmt.Printf("\nAttach with: %s\n", style.Dim.Render("gt mayor attach"))
 
 	return nil
 }
 
+// printMayorDeputyStatus prints the deputy's state and the last failover,
+// if the town has either.
+func printMayorDeputyStatus(mgr *mayor.Manager) {
+	if mgr.DeputyRunning() {
+		fmt.Printf("  Deputy: %s\n", "standing by")
+	}
+	failovers, _ := mgr.Failovers()
+	if len(failovers) == 0 {
+		return
+	}
+	last := failovers[len(failovers)-1]
+	outcome := "deputy promoted"
+	if last.Error != "" {
+		outcome = style.Error.Render("failed: " + last.Error)
+	}
+	fmt.Printf("  Last failover: %s (%s)\n", last.At.Local().Format("2006-01-02 15:04"), outcome)
+}
+
 func runMayorRestart(cmd *cobra.Command, args []string) error {
 	mgr, err := getMayorManager()
 	if err != nil {
@@ -286,10 +307,8 @@ func runMayorRestart(cmd *cobra.Command, args []string) error {
 // Daemon failures are non-fatal (warned but do not block).
 func ensureMayorInfra(townRoot string) error {
 	// Load daemon.json env vars (e.g., GT_DOLT_PORT) so Dolt uses the right port.
-	if patrolCfg := daemon.LoadPatrolConfig(townRoot); patrolCfg != nil {
-		for k, v := range patrolCfg.Env {
-			os.Setenv(k, v)
-		}
+	for k, v := range daemon.PatrolEnv(townRoot, daemon.LoadPatrolConfig(townRoot)) {
+		os.Setenv(k, v)
 	}
 
 	// Daemon (non-fatal)
@@ -299,7 +318,7 @@ func ensureMayorInfra(townRoot string) error {
 		if err := ensureDaemon(townRoot); err != nil {
 			style.PrintWarning("daemon start failed: %v", err)
 		} else {
-			fmt.Printf("  %s Daemon started\n", style.Bold.Render("✓"))
+			fmt.Printf("  %s Daemon started\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		}
 	}
 
@@ -325,7 +344,7 @@ func ensureMayorInfra(townRoot string) error {
 					}
 					return fmt.Errorf("%s", msg)
 				}
-				fmt.Printf("  %s Dolt server started (port %d)\n", style.Bold.Render("✓"), doltCfg.Port)
+				fmt.Printf("  %s Dolt server started (port %d)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), doltCfg.Port)
 			}
 		}
 	}
@@ -6,10 +6,19 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/memory"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	memoriesSelf  bool
+	memoriesAgent string
 )
 
 func init() {
+	memoriesCmd.Flags().BoolVar(&memoriesSelf, "self", false, "List your own agent memory instead of the town memories")
+	memoriesCmd.Flags().StringVar(&memoriesAgent, "agent", "", "List another agent's memory (e.g. gastown/witness)")
 	memoriesCmd.GroupID = GroupWork
 	rootCmd.AddCommand(memoriesCmd)
 }
@@ -22,15 +31,24 @@ var memoriesCmd = &cobra.Command{
 Without arguments, lists all memories. With a search term, filters
 memories whose key or value contains the term (case-insensitive).
 
+With --self or --agent, lists an agent's own notes (see gt remember --self)
+instead. Use gt find to search every agent's notes at once.
+
 Examples:
   gt memories                    # List all memories
   gt memories refinery           # Search for memories about refinery
-  gt memories "worktree"         # Search for worktree-related memories`,
+  gt memories "worktree"         # Search for worktree-related memories
+  gt memories --self             # List your own notes
+  gt memories --agent gastown/witness dolt`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMemories,
 }
 
 func runMemories(cmd *cobra.Command, args []string) error {
+	if memoriesSelf || memoriesAgent != "" {
+		return runAgentMemories(args)
+	}
+
 	kvs, err := bdKvListJSON()
 	if err != nil {
 		return fmt.Errorf("listing memories: %w", err)
@@ -91,3 +109,42 @@ func runMemories(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// runAgentMemories lists one agent's own notes, optionally filtered.
+func runAgentMemories(args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	agent := memoriesAgent
+	if agent == "" {
+		if agent, err = selfAgent(); err != nil {
+			return err
+		}
+	}
+
+	notes, err := memory.Load(townRoot, agent)
+	if err != nil {
+		return fmt.Errorf("loading memory for %s: %w", agent, err)
+	}
+	var search string
+	if len(args) > 0 {
+		search = strings.ToLower(args[0])
+	}
+
+	var shown int
+	for _, n := range notes {
+		if search != "" && !strings.Contains(strings.ToLower(n.Text), search) {
+			continue
+		}
+		if shown == 0 {
+			fmt.Printf("%s\n\n", style.Bold.Render("Notes for "+agent))
+		}
+		fmt.Printf("  %s %s\n", style.Dim.Render(n.Time.Local().Format("2006-01-02")), n.Text)
+		shown++
+	}
+	if shown == 0 {
+		fmt.Printf("No notes for %s. Use 'gt remember --self \"insight\"' to add one.\n", agent)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/migrate"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	layoutMigrateDryRun   bool
+	layoutMigrateNoBackup bool
+	layoutMigrateJSON     bool
+)
+
+var migrateCmd = &cobra.Command{
+	Use:     "migrate",
+	GroupID: GroupDiag,
+	Short:   "Migrate the town's on-disk layout to this gt version",
+	Long: `Bring the town's on-disk layout up to the version this gt binary expects.
+
+When a release changes the town layout (new mayor subdirectories, renamed
+config files), it ships a migration step. The town records its layout
+version in mayor/town.json; gt migrate applies every pending step in order
+and records the new version after each one, so an interrupted migration
+resumes where it stopped. The layout-version doctor check flags towns that
+need migrating.
+
+Before the first step, the town's config files are copied to
+.runtime/migrate-backups/. Use --dry-run to preview the changes.
+
+Examples:
+  gt migrate --dry-run    # Show pending steps and what they would change
+  gt migrate              # Back up config, then apply pending steps
+  gt migrate --no-backup  # Skip the config backup`,
+	Args: cobra.NoArgs,
+	RunE: runMigrate,
+}
+
+func init() {
+	migrateCmd.Flags().BoolVar(&layoutMigrateDryRun, "dry-run", false, "Show what would change without modifying anything")
+	migrateCmd.Flags().BoolVar(&layoutMigrateNoBackup, "no-backup", false, "Skip backing up config before migrating")
+	migrateCmd.Flags().BoolVar(&layoutMigrateJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(migrateCmd)
+}
+
+func runMigrate(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	res, err := migrate.Run(townRoot, migrate.Options{DryRun: layoutMigrateDryRun, NoBackup: layoutMigrateNoBackup})
+	if layoutMigrateJSON && res != nil {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if encErr := enc.Encode(res); encErr != nil {
+			return encErr
+		}
+		return err
+	}
+	if err != nil {
+		if res != nil && res.To > res.From {
+			fmt.Printf("%s Migrated to layout v%d before the failure\n", style.WarningPrefix, res.To)
+		}
+		return err
+	}
+
+	if len(res.Steps) == 0 {
+		fmt.Printf("%s Town layout is current (v%d)\n", style.SuccessPrefix, res.From)
+		return nil
+	}
+
+	for _, s := range res.Steps {
+		fmt.Printf("  %s %s\n", style.Bold.Render(fmt.Sprintf("v%d", s.Version)), s.Description)
+		if len(s.Changes) == 0 {
+			fmt.Printf("      %s\n", style.Dim.Render("(nothing to change)"))
+		}
+		for _, c := range s.Changes {
+			fmt.Printf("      %s\n", c)
+		}
+	}
+	if layoutMigrateDryRun {
+		fmt.Printf("\n%s Dry run: layout v%d → v%d pending. Run 'gt migrate' to apply.\n",
+			style.Dim.Render(ui.Glyph("○")), res.From, migrate.Latest())
+		return nil
+	}
+
+	if res.Backup != "" {
+		fmt.Printf("\n%s\n", style.Dim.Render("Config backed up to "+res.Backup))
+	}
+	fmt.Printf("%s Migrated town layout v%d → v%d\n", style.SuccessPrefix, res.From, res.To)
+	return nil
+}
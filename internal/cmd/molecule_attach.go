@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -78,7 +79,7 @@ func runMoleculeAttach(cmd *cobra.Command, args []string) error {
 	}
 
 	attachment := beads.ParseAttachmentFields(issue)
-	fmt.Printf("%s Attached %s to %s\n", style.Bold.Render("✓"), moleculeID, pinnedBeadID)
+	fmt.Printf("%s Attached %s to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), moleculeID, pinnedBeadID)
 	if attachment != nil && attachment.AttachedAt != "" {
 		fmt.Printf("  attached_at: %s\n", attachment.AttachedAt)
 	}
@@ -103,7 +104,7 @@ func runMoleculeDetach(cmd *cobra.Command, args []string) error {
 	}
 
 	if attachment == nil {
-		fmt.Printf("%s No molecule attached to %s\n", style.Dim.Render("ℹ"), pinnedBeadID)
+		fmt.Printf("%s No molecule attached to %s\n", style.Dim.Render(ui.Glyph(ui.IconInfo)), pinnedBeadID)
 		return nil
 	}
 
@@ -118,7 +119,7 @@ func runMoleculeDetach(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("detaching molecule: %w", err)
 	}
 
-	fmt.Printf("%s Detached %s from %s\n", style.Bold.Render("✓"), previousMolecule, pinnedBeadID)
+	fmt.Printf("%s Detached %s from %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), previousMolecule, pinnedBeadID)
 
 	return nil
 }
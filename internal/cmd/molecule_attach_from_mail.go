@@ -10,6 +10,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -116,7 +117,7 @@ func runMoleculeAttachFromMail(cmd *cobra.Command, args []string) error {
 
 	// Output success
 	attachment := beads.ParseAttachmentFields(issue)
-	fmt.Printf("%s Attached molecule from mail\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Attached molecule from mail\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Printf("  Mail: %s\n", mailID)
 	fmt.Printf("  Hook: %s\n", hookBead.ID)
 	fmt.Printf("  Molecule: %s\n", moleculeID)
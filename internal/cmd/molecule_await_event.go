@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -144,7 +145,7 @@ func runMoleculeAwaitEvent(cmd *cobra.Command, args []string) error {
 			if labErr != nil {
 				if !awaitEventQuiet {
 					fmt.Printf("%s Could not read agent bead (starting at idle=0): %v\n",
-						style.Dim.Render("⚠"), labErr)
+						style.Dim.Render(ui.Glyph(ui.IconWarn)), labErr)
 				}
 			} else {
 				if idleStr, ok := labels["idle"]; ok {
@@ -176,7 +177,7 @@ func runMoleculeAwaitEvent(cmd *cobra.Command, args []string) error {
 			timeout = remaining
 			if !awaitEventQuiet && !moleculeJSON {
 				fmt.Printf("%s Resuming backoff window (%v remaining)\n",
-					style.Dim.Render("↻"), remaining.Round(time.Second))
+					style.Dim.Render(ui.Glyph("↻")), remaining.Round(time.Second))
 			}
 		}
 	}
@@ -188,7 +189,7 @@ func runMoleculeAwaitEvent(cmd *cobra.Command, args []string) error {
 
 	if !awaitEventQuiet && !moleculeJSON {
 		fmt.Printf("%s Awaiting event on channel %q (timeout: %v, idle: %d)...\n",
-			style.Dim.Render("⏳"), awaitEventChannel, timeout, idleCycles)
+			style.Dim.Render(ui.Glyph("⏳")), awaitEventChannel, timeout, idleCycles)
 	}
 
 	startTime := time.Now()
@@ -214,7 +215,7 @@ func runMoleculeAwaitEvent(cmd *cobra.Command, args []string) error {
 			if setErr := setAgentIdleCycles(awaitEventAgentBead, beadsDir, newIdle); setErr != nil {
 				if !awaitEventQuiet {
 					fmt.Printf("%s Failed to update idle count: %v\n",
-						style.Dim.Render("⚠"), setErr)
+						style.Dim.Render(ui.Glyph(ui.IconWarn)), setErr)
 				}
 			} else {
 				result.IdleCycles = newIdle
@@ -249,19 +250,19 @@ func runMoleculeAwaitEvent(cmd *cobra.Command, args []string) error {
 		switch result.Reason {
 		case "event":
 			fmt.Printf("%s %d event(s) received after %v\n",
-				style.Bold.Render("✓"), len(result.Events), result.Elapsed.Round(time.Millisecond))
+				style.Bold.Render(ui.Glyph(ui.IconPass)), len(result.Events), result.Elapsed.Round(time.Millisecond))
 			for _, ef := range result.Events {
 				// Show event type from content
 				var parsed map[string]interface{}
 				if json.Unmarshal(ef.Content, &parsed) == nil {
 					if t, ok := parsed["type"].(string); ok {
-						fmt.Printf("  %s %s\n", style.Dim.Render("→"), t)
+						fmt.Printf("  %s %s\n", style.Dim.Render(ui.Glyph("→")), t)
 					}
 				}
 			}
 		case "timeout":
 			fmt.Printf("%s Timeout after %v (idle cycle: %d)\n",
-				style.Dim.Render("⏱"), result.Elapsed.Round(time.Millisecond), result.IdleCycles)
+				style.Dim.Render(ui.Glyph("⏱")), result.Elapsed.Round(time.Millisecond), result.IdleCycles)
 		}
 	}
 
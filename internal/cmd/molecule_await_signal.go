@@ -16,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -155,7 +156,7 @@ func runMoleculeAwaitSignal(cmd *cobra.Command, args []string) error {
 			// Agent bead might not exist yet - that's OK, start at 0
 			if !awaitSignalQuiet {
 				fmt.Printf("%s Could not read agent bead (starting at idle=0): %v\n",
-					style.Dim.Render("⚠"), err)
+					style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 			}
 		} else {
 			if idleStr, ok := labels["idle"]; ok {
@@ -200,7 +201,7 @@ func runMoleculeAwaitSignal(cmd *cobra.Command, args []string) error {
 		if err := setAgentBackoffUntil(awaitSignalAgentBead, beadsDir, windowEnd); err != nil {
 			if !awaitSignalQuiet {
 				fmt.Printf("%s Failed to persist backoff window: %v\n",
-					style.Dim.Render("⚠"), err)
+					style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 			}
 		}
 	}
@@ -208,13 +209,13 @@ func runMoleculeAwaitSignal(cmd *cobra.Command, args []string) error {
 	if !awaitSignalQuiet && !moleculeJSON {
 		if resumed {
 			fmt.Printf("%s Resuming backoff (remaining: %v, idle: %d)...\n",
-				style.Dim.Render("⏳"), timeout.Round(time.Second), idleCycles)
+				style.Dim.Render(ui.Glyph("⏳")), timeout.Round(time.Second), idleCycles)
 		} else if awaitSignalAgentBead != "" {
 			fmt.Printf("%s Awaiting signal (timeout: %v, idle: %d)...\n",
-				style.Dim.Render("⏳"), timeout, idleCycles)
+				style.Dim.Render(ui.Glyph("⏳")), timeout, idleCycles)
 		} else {
 			fmt.Printf("%s Awaiting signal (timeout: %v)...\n",
-				style.Dim.Render("⏳"), timeout)
+				style.Dim.Render(ui.Glyph("⏳")), timeout)
 		}
 	}
 
@@ -237,7 +238,7 @@ func runMoleculeAwaitSignal(cmd *cobra.Command, args []string) error {
 		if err := setAgentIdleCycles(awaitSignalAgentBead, beadsDir, newIdleCycles); err != nil {
 			if !awaitSignalQuiet {
 				fmt.Printf("%s Failed to update agent bead idle count: %v\n",
-					style.Dim.Render("⚠"), err)
+					style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 			}
 		} else {
 			result.IdleCycles = newIdleCycles
@@ -246,7 +247,7 @@ func runMoleculeAwaitSignal(cmd *cobra.Command, args []string) error {
 		if err := updateAgentHeartbeat(awaitSignalAgentBead, beadsDir); err != nil {
 			if !awaitSignalQuiet {
 				fmt.Printf("%s Failed to update agent heartbeat: %v\n",
-					style.Dim.Render("⚠"), err)
+					style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 			}
 		}
 		// Clear the backoff window — timeout completed normally
@@ -256,7 +257,7 @@ func runMoleculeAwaitSignal(cmd *cobra.Command, args []string) error {
 		if err := updateAgentHeartbeat(awaitSignalAgentBead, beadsDir); err != nil {
 			if !awaitSignalQuiet {
 				fmt.Printf("%s Failed to update agent heartbeat: %v\n",
-					style.Dim.Render("⚠"), err)
+					style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 			}
 		}
 		// Report current idle cycles (caller should reset)
@@ -276,7 +277,7 @@ func runMoleculeAwaitSignal(cmd *cobra.Command, args []string) error {
 		switch result.Reason {
 		case "signal":
 			fmt.Printf("%s Signal received after %v\n",
-				style.Bold.Render("✓"), result.Elapsed.Round(time.Millisecond))
+				style.Bold.Render(ui.Glyph(ui.IconPass)), result.Elapsed.Round(time.Millisecond))
 			if result.Signal != "" {
 				// Truncate long signals
 				sig := result.Signal
@@ -288,10 +289,10 @@ func runMoleculeAwaitSignal(cmd *cobra.Command, args []string) error {
 		case "timeout":
 			if awaitSignalAgentBead != "" {
 				fmt.Printf("%s Timeout after %v (idle cycle: %d)\n",
-					style.Dim.Render("⏱"), result.Elapsed.Round(time.Millisecond), result.IdleCycles)
+					style.Dim.Render(ui.Glyph("⏱")), result.Elapsed.Round(time.Millisecond), result.IdleCycles)
 			} else {
 				fmt.Printf("%s Timeout after %v (no activity)\n",
-					style.Dim.Render("⏱"), result.Elapsed.Round(time.Millisecond))
+					style.Dim.Render(ui.Glyph("⏱")), result.Elapsed.Round(time.Millisecond))
 			}
 		}
 	}
@@ -10,6 +10,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 // DAGNode represents a node in the dependency graph.
@@ -20,7 +22,8 @@ type DAGNode struct {
 	Parallel     bool       `json:"parallel,omitempty"`
 	Dependencies []string   `json:"dependencies,omitempty"`
 	Dependents   []string   `json:"dependents,omitempty"`
-	Tier         int        `json:"tier"` // Execution tier (0 = root, higher = later)
+	External     []string   `json:"external,omitempty"` // Open blockers outside the molecule, as <rig>:<id>
+	Tier         int        `json:"tier"`               // Execution tier (0 = root, higher = later)
 	Children     []*DAGNode `json:"children,omitempty"`
 }
 
@@ -96,8 +99,15 @@ func runMoleculeDag(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("no steps found for %s (not a molecule root?)", rootID)
 	}
 
+	// Dependencies outside the molecule may live in other rigs; without a
+	// town they stay unresolved and count as open.
+	var xr *beads.XRefResolver
+	if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
+		xr, _ = beads.NewXRefResolver(townRoot)
+	}
+
 	// Build the DAG
-	dag, err := buildDAG(b, root, children)
+	dag, err := buildDAG(b, xr, root, children)
 	if err != nil {
 		return fmt.Errorf("building DAG: %w", err)
 	}
@@ -116,8 +126,10 @@ func runMoleculeDag(cmd *cobra.Command, args []string) error {
 	return outputDAGTree(dag)
 }
 
-// buildDAG constructs the DAG from molecule children.
-func buildDAG(b *beads.Beads, root *beads.Issue, children []*beads.Issue) (*DAGInfo, error) {
+// buildDAG constructs the DAG from molecule children. Blocking dependencies
+// on beads outside the molecule (including other rigs) are resolved through
+// xr, which may be nil, and recorded on the node as External when open.
+func buildDAG(b *beads.Beads, xr *beads.XRefResolver, root *beads.Issue, children []*beads.Issue) (*DAGInfo, error) {
 	dag := &DAGInfo{
 		RootID:    root.ID,
 		RootTitle: root.Title,
@@ -138,7 +150,9 @@ func buildDAG(b *beads.Beads, root *beads.Issue, children []*beads.Issue) (*DAGI
 
 	// Build closed set for status checking
 	closedIDs := make(map[string]bool)
+	inMolecule := make(map[string]bool)
 	for _, child := range children {
+		inMolecule[child.ID] = true
 		if child.Status == "closed" {
 			closedIDs[child.ID] = true
 		}
@@ -157,10 +171,17 @@ func buildDAG(b *beads.Beads, root *beads.Issue, children []*beads.Issue) (*DAGI
 			Status: child.Status,
 		}
 
-		// Extract dependencies (all blocking types)
+		// Extract dependencies (all blocking types). Steps are edges in the
+		// DAG; anything else blocks only while it is open.
 		for _, dep := range step.Dependencies {
-			if isBlockingDepType(dep.DependencyType) {
-				node.Dependencies = append(node.Dependencies, dep.ID)
+			if !isBlockingDepType(dep.DependencyType) {
+				continue
+			}
+			depID := beads.ExtractIssueID(dep.ID)
+			if inMolecule[depID] {
+				node.Dependencies = append(node.Dependencies, depID)
+			} else if ref, open := resolveExternalDep(xr, dep); open {
+				node.External = append(node.External, ref)
 			}
 		}
 
@@ -172,7 +193,7 @@ func buildDAG(b *beads.Beads, root *beads.Issue, children []*beads.Issue) (*DAGI
 
 		// Compute ready status for open steps
 		if child.Status == "open" {
-			allDepsClosed := true
+			allDepsClosed := len(node.External) == 0
 			for _, depID := range node.Dependencies {
 				if !closedIDs[depID] {
 					allDepsClosed = false
@@ -208,6 +229,23 @@ func buildDAG(b *beads.Beads, root *beads.Issue, children []*beads.Issue) (*DAGI
 	return dag, nil
 }
 
+// resolveExternalDep reads a dependency outside the molecule from the rig
+// that owns it. Returns its qualified ref and whether it is still open;
+// unresolvable dependencies are treated as open.
+func resolveExternalDep(xr *beads.XRefResolver, dep beads.IssueDep) (string, bool) {
+	if xr == nil {
+		return dep.ID, !beads.IssueStatus(dep.Status).IsTerminal()
+	}
+	issue, x, err := xr.Show(dep.ID)
+	if err != nil {
+		if x.ID != "" {
+			return x.String(), true
+		}
+		return dep.ID, true
+	}
+	return x.String(), !beads.IssueStatus(issue.Status).IsTerminal()
+}
+
 // computeTiers assigns execution tiers to each node.
 // Tier 0 = nodes with no dependencies, higher tiers depend on lower ones.
 func computeTiers(dag *DAGInfo) {
@@ -330,7 +368,7 @@ func outputDAGTree(dag *DAGInfo) error {
 	// Legend
 	fmt.Println()
 	fmt.Printf("   %s done  %s in_progress  %s ready  %s blocked\n",
-		style.Bold.Render("✓"), style.Bold.Render("⧖"), style.Bold.Render("○"), style.Dim.Render("◌"))
+		style.Bold.Render(ui.Glyph(ui.IconPass)), style.Bold.Render(ui.Glyph("⧖")), style.Bold.Render(ui.Glyph("○")), style.Dim.Render(ui.Glyph("◌")))
 
 	return nil
 }
@@ -357,13 +395,13 @@ func printNode(dag *DAGInfo, id, prefix string, isLast bool, visited map[string]
 	var icon string
 	switch node.Status {
 	case "closed":
-		icon = style.Bold.Render("✓")
+		icon = style.Bold.Render(ui.Glyph(ui.IconPass))
 	case "in_progress":
-		icon = style.Bold.Render("⧖")
+		icon = style.Bold.Render(ui.Glyph("⧖"))
 	case "ready":
-		icon = style.Bold.Render("○")
+		icon = style.Bold.Render(ui.Glyph("○"))
 	default:
-		icon = style.Dim.Render("◌")
+		icon = style.Dim.Render(ui.Glyph("◌"))
 	}
 
 	// Parallel marker
@@ -373,7 +411,11 @@ func printNode(dag *DAGInfo, id, prefix string, isLast bool, visited map[string]
 	}
 
 	// Print node
-	fmt.Printf("%s%s %s %s%s\n", prefix, connector, icon, node.ID, parallelMark)
+	externalMark := ""
+	if len(node.External) > 0 {
+		externalMark = style.Dim.Render(" ⇠ " + strings.Join(node.External, ", "))
+	}
+	fmt.Printf("%s%s %s %s%s%s\n", prefix, connector, icon, node.ID, parallelMark, externalMark)
 
 	// Child prefix
 	childPrefix := prefix
@@ -416,13 +458,13 @@ func outputDAGTiers(dag *DAGInfo) error {
 			var icon string
 			switch node.Status {
 			case "closed":
-				icon = style.Bold.Render("✓")
+				icon = style.Bold.Render(ui.Glyph(ui.IconPass))
 			case "in_progress":
-				icon = style.Bold.Render("⧖")
+				icon = style.Bold.Render(ui.Glyph("⧖"))
 			case "ready":
-				icon = style.Bold.Render("○")
+				icon = style.Bold.Render(ui.Glyph("○"))
 			default:
-				icon = style.Dim.Render("◌")
+				icon = style.Dim.Render(ui.Glyph("◌"))
 			}
 
 			// Parallel marker
@@ -436,6 +478,9 @@ func outputDAGTiers(dag *DAGInfo) error {
 			if len(node.Dependencies) > 0 {
 				depStr = fmt.Sprintf(" ← %s", strings.Join(node.Dependencies, ", "))
 			}
+			if len(node.External) > 0 {
+				depStr += style.Dim.Render(fmt.Sprintf(" ⇠ %s", strings.Join(node.External, ", ")))
+			}
 
 			fmt.Printf("       %s %s%s%s\n", icon, id, parallelMark, depStr)
 		}
@@ -450,7 +495,7 @@ func outputDAGTiers(dag *DAGInfo) error {
 	// Legend
 	fmt.Println()
 	fmt.Printf("   %s done  %s in_progress  %s ready  %s blocked\n",
-		style.Bold.Render("✓"), style.Bold.Render("⧖"), style.Bold.Render("○"), style.Dim.Render("◌"))
+		style.Bold.Render(ui.Glyph(ui.IconPass)), style.Bold.Render(ui.Glyph("⧖")), style.Bold.Render(ui.Glyph("○")), style.Dim.Render(ui.Glyph("◌")))
 
 	return nil
 }
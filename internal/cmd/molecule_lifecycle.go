@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -75,7 +76,7 @@ func runMoleculeBurn(cmd *cobra.Command, args []string) error {
 	attachment := beads.ParseAttachmentFields(handoff)
 	if attachment == nil || attachment.AttachedMolecule == "" {
 		fmt.Printf("%s No molecule attached to %s - nothing to burn\n",
-			style.Dim.Render("ℹ"), target)
+			style.Dim.Render(ui.Glyph(ui.IconInfo)), target)
 		return nil
 	}
 
@@ -202,7 +203,7 @@ func runMoleculeSquash(cmd *cobra.Command, args []string) error {
 	attachment := beads.ParseAttachmentFields(handoff)
 	if attachment == nil || attachment.AttachedMolecule == "" {
 		fmt.Printf("%s No molecule attached to %s - nothing to squash\n",
-			style.Dim.Render("ℹ"), target)
+			style.Dim.Render(ui.Glyph(ui.IconInfo)), target)
 		return nil
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -295,7 +296,7 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 	fmt.Printf("  Blocked:     %d\n", len(progress.BlockedSteps))
 
 	if progress.Complete {
-		fmt.Printf("\n  %s\n", style.Bold.Render("✓ Molecule complete!"))
+		fmt.Printf("\n  %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)+" Molecule complete!"))
 	}
 
 	return nil
@@ -726,7 +727,7 @@ func outputMoleculeStatus(status MoleculeStatusInfo) error {
 
 	// Check if the hooked bead is already closed (someone closed it externally)
 	if status.PinnedBead.Status == "closed" {
-		fmt.Printf("%s Hooked bead %s is already closed!\n", style.Bold.Render("⚠"), status.PinnedBead.ID)
+		fmt.Printf("%s Hooked bead %s is already closed!\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), status.PinnedBead.ID)
 		fmt.Printf("   Title: %s\n", status.PinnedBead.Title)
 		fmt.Printf("   This work was completed elsewhere. Clear your hook with: gt unsling\n")
 		return nil
@@ -785,7 +786,7 @@ func outputMoleculeStatus(status MoleculeStatusInfo) error {
 		fmt.Printf("  Blocked:     %d\n", len(status.Progress.BlockedSteps))
 
 		if status.Progress.Complete {
-			fmt.Printf("\n%s\n", style.Bold.Render("✓ Molecule complete!"))
+			fmt.Printf("\n%s\n", style.Bold.Render(ui.Glyph(ui.IconPass)+" Molecule complete!"))
 		}
 	}
 
@@ -842,15 +843,15 @@ func showGitDivergenceWarning() {
 	fmt.Println()
 	if ahead > 0 && behind > 0 {
 		fmt.Printf("%s Branch diverged: %d ahead, %d behind %s\n",
-			style.Warning.Render("⚠"), ahead, behind, remote)
+			style.WarningPrefix, ahead, behind, remote)
 		fmt.Printf("  Run 'git pull --rebase' before starting work\n")
 	} else if behind > 0 {
 		fmt.Printf("%s Branch is %d commits behind %s\n",
-			style.Warning.Render("⚠"), behind, remote)
+			style.WarningPrefix, behind, remote)
 		fmt.Printf("  Run 'git pull' to update\n")
 	} else {
 		fmt.Printf("%s Branch is %d commits ahead of %s (unpushed work)\n",
-			style.Dim.Render("ℹ"), ahead, remote)
+			style.Dim.Render(ui.Glyph(ui.IconInfo)), ahead, remote)
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -121,7 +122,7 @@ func runMoleculeStepDone(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("closing step: %w", err)
 		}
 		result.StepClosed = true
-		fmt.Printf("%s Closed step %s: %s\n", style.Bold.Render("✓"), stepID, step.Title)
+		fmt.Printf("%s Closed step %s: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), stepID, step.Title)
 	}
 
 	// Step 4: Find all ready steps (supports fan-out pattern)
@@ -169,7 +170,7 @@ func runMoleculeStepDone(cmd *cobra.Command, args []string) error {
 
 	case "no_more_ready":
 		fmt.Printf("\n%s All remaining steps are blocked - waiting on dependencies\n",
-			style.Dim.Render("ℹ"))
+			style.Dim.Render(ui.Glyph(ui.IconInfo)))
 		fmt.Printf("Run 'gt mol progress %s' to see blocked steps\n", moleculeID)
 		return nil
 	}
@@ -260,7 +261,7 @@ func findAllReadySteps(b *beads.Beads, moleculeID string) ([]*beads.Issue, bool,
 
 // handleStepContinue handles continuing to the next step.
 func handleStepContinue(cwd, townRoot string, nextStep *beads.Issue, dryRun bool) error {
-	fmt.Printf("\n%s Next step: %s\n", style.Bold.Render("→"), nextStep.ID)
+	fmt.Printf("\n%s Next step: %s\n", style.Bold.Render(ui.Glyph("→")), nextStep.ID)
 	fmt.Printf("  %s\n", nextStep.Title)
 
 	// Detect agent identity
@@ -307,7 +308,7 @@ func handleStepContinue(cwd, townRoot string, nextStep *beads.Issue, dryRun bool
 	if !tmux.IsInsideTmux() {
 		// Not in tmux - just print next action
 		fmt.Printf("\n%s Not in tmux - start new session with 'gt prime'\n",
-			style.Dim.Render("ℹ"))
+			style.Dim.Render(ui.Glyph(ui.IconInfo)))
 		return nil
 	}
 
@@ -349,7 +350,7 @@ func handleStepContinue(cwd, townRoot string, nextStep *beads.Issue, dryRun bool
 // handleParallelSteps handles executing multiple steps concurrently (fan-out pattern).
 // This function spawns goroutines to execute each step in parallel and waits for all to complete.
 func handleParallelSteps(cwd, townRoot, _ string, steps []*beads.Issue, dryRun bool) error {
-	fmt.Printf("\n%s Fan-out: %d parallel steps ready\n", style.Bold.Render("⚡"), len(steps))
+	fmt.Printf("\n%s Fan-out: %d parallel steps ready\n", style.Bold.Render(ui.Glyph("⚡")), len(steps))
 	for i, step := range steps {
 		fmt.Printf("  %d. %s: %s\n", i+1, step.ID, step.Title)
 	}
@@ -397,7 +398,7 @@ func handleParallelSteps(cwd, townRoot, _ string, steps []*beads.Issue, dryRun b
 			// Execute the step by closing it
 			// In a real implementation, the agent would process the step description
 			// For now, we just mark it as requiring manual execution
-			fmt.Printf("  %s Step %s ready for parallel execution\n", style.Dim.Render("→"), s.ID)
+			fmt.Printf("  %s Step %s ready for parallel execution\n", style.Dim.Render(ui.Glyph("→")), s.ID)
 		}(step)
 	}
 
@@ -411,14 +412,14 @@ func handleParallelSteps(cwd, townRoot, _ string, steps []*beads.Issue, dryRun b
 		}
 	}
 
-	fmt.Printf("\n%s All parallel steps marked as in_progress\n", style.Bold.Render("✓"))
-	fmt.Printf("%s Execute each step and close with: gt mol step done <step-id>\n", style.Dim.Render("ℹ"))
-	fmt.Printf("%s Once all parallel steps are closed, the gather step will become ready\n", style.Dim.Render("ℹ"))
+	fmt.Printf("\n%s All parallel steps marked as in_progress\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
+	fmt.Printf("%s Execute each step and close with: gt mol step done <step-id>\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
+	fmt.Printf("%s Once all parallel steps are closed, the gather step will become ready\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
 
 	// For the current agent, pick the first step to continue with
 	// Other steps can be picked up by other agents or run manually
 	if len(steps) > 0 {
-		fmt.Printf("\n%s Continuing with first parallel step: %s\n", style.Bold.Render("→"), steps[0].ID)
+		fmt.Printf("\n%s Continuing with first parallel step: %s\n", style.Bold.Render(ui.Glyph("→")), steps[0].ID)
 		return handleStepContinue(cwd, townRoot, steps[0], dryRun)
 	}
 
@@ -473,7 +474,7 @@ func handleMoleculeComplete(cwd, townRoot, moleculeID string, dryRun bool) error
 			if err := unpinCmd.Run(); err != nil {
 				style.PrintWarning("could not unpin bead: %v", err)
 			} else {
-				fmt.Printf("%s Work unpinned\n", style.Bold.Render("✓"))
+				fmt.Printf("%s Work unpinned\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 			}
 		}
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // MQ command flags
@@ -303,8 +304,8 @@ Example:
 
 func init() {
 	// Submit flags
-	mqSubmitCmd.Flags().StringVar(&mqSubmitBranch, "branch", "", "Source branch (default: current branch)")
-	mqSubmitCmd.Flags().StringVar(&mqSubmitIssue, "issue", "", "Source issue ID (default: parse from branch name)")
+	mqSubmitCmd.Flags().StringVar(&mqSubmitBranch, "branch", "", "Source branch (default: the branch registered for --issue, else current branch)")
+	mqSubmitCmd.Flags().StringVar(&mqSubmitIssue, "issue", "", "Source issue ID (default: the wisp the branch is registered to, else parse from branch name)")
 	mqSubmitCmd.Flags().StringVar(&mqSubmitEpic, "epic", "", "Target epic's integration branch instead of main")
 	mqSubmitCmd.Flags().IntVarP(&mqSubmitPriority, "priority", "p", -1, "Override priority (0-4, default: inherit from issue)")
 	mqSubmitCmd.Flags().BoolVar(&mqSubmitNoCleanup, "no-cleanup", false, "Don't auto-cleanup after submit (for polecats)")
@@ -442,9 +443,9 @@ func runMQRetry(cmd *cobra.Command, args []string) error {
 	}
 
 	if mqRetryNow {
-		fmt.Printf("%s Merge request processed\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Merge request processed\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
-		fmt.Printf("%s Merge request queued for retry\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Merge request queued for retry\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		fmt.Printf("  %s\n", style.Dim.Render("Will be processed on next refinery cycle"))
 	}
 
@@ -482,7 +483,7 @@ func runMQReject(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("rejecting MR: %w", err)
 	}
 
-	fmt.Printf("%s Rejected: %s\n", style.Bold.Render("✗"), result.Branch)
+	fmt.Printf("%s Rejected: %s\n", style.Bold.Render(ui.Glyph("✗")), result.Branch)
 	fmt.Printf("  Worker: %s\n", result.Worker)
 	fmt.Printf("  Reason: %s\n", mqRejectReason)
 
@@ -513,27 +514,27 @@ func runMQPostMerge(_ *cobra.Command, args []string) error {
 	}
 
 	mr := result.MR
-	fmt.Printf("%s Post-merge: %s\n", style.Bold.Render("✓"), mr.ID)
+	fmt.Printf("%s Post-merge: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), mr.ID)
 	fmt.Printf("  Branch: %s\n", mr.Branch)
 	fmt.Printf("  Worker: %s\n", mr.Worker)
 
 	if result.MRClosed {
-		fmt.Printf("  %s MR closed (merged)\n", style.Success.Render("✓"))
+		fmt.Printf("  %s MR closed (merged)\n", style.SuccessPrefix)
 	}
 	if result.SourceIssueClosed {
-		fmt.Printf("  %s Source issue closed: %s\n", style.Success.Render("✓"), result.SourceIssueID)
+		fmt.Printf("  %s Source issue closed: %s\n", style.SuccessPrefix, result.SourceIssueID)
 	} else if result.SourceIssueNotFound {
-		fmt.Printf("  %s Source issue: %s %s\n", style.Dim.Render("○"), result.SourceIssueID, style.Dim.Render("(already closed or not found)"))
+		fmt.Printf("  %s Source issue: %s %s\n", style.Dim.Render(ui.Glyph("○")), result.SourceIssueID, style.Dim.Render("(already closed or not found)"))
 	}
 
 	// Delete remote branch unless skipped
 	if mr.Branch == "" {
-		fmt.Printf("  %s No branch name in MR (skipping branch delete)\n", style.Dim.Render("○"))
+		fmt.Printf("  %s No branch name in MR (skipping branch delete)\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
 	if mqPostMergeSkipBranchDelete {
-		fmt.Printf("  %s Branch delete skipped (--skip-branch-delete)\n", style.Dim.Render("○"))
+		fmt.Printf("  %s Branch delete skipped (--skip-branch-delete)\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -547,22 +548,22 @@ func runMQPostMerge(_ *cobra.Command, args []string) error {
 	}
 
 	if !deleteEnabled {
-		fmt.Printf("  %s Branch delete disabled by config\n", style.Dim.Render("○"))
+		fmt.Printf("  %s Branch delete disabled by config\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
 	// Get git client for the rig
 	rigGit, err := getRigGit(r.Path)
 	if err != nil {
-		fmt.Printf("  %s branch delete: %v\n", style.Warning.Render("⚠"), err)
+		fmt.Printf("  %s branch delete: %v\n", style.WarningPrefix, err)
 		return nil // non-fatal: beads cleanup succeeded
 	}
 
 	// Delete remote branch
 	if err := rigGit.DeleteRemoteBranch("origin", mr.Branch); err != nil {
-		fmt.Printf("  %s remote branch delete: %v\n", style.Warning.Render("⚠"), err)
+		fmt.Printf("  %s remote branch delete: %v\n", style.WarningPrefix, err)
 	} else {
-		fmt.Printf("  %s Deleted remote branch: %s\n", style.Success.Render("✓"), mr.Branch)
+		fmt.Printf("  %s Deleted remote branch: %s\n", style.SuccessPrefix, mr.Branch)
 	}
 
 	// Also clean up the local tracking ref if it exists
@@ -570,7 +571,7 @@ func runMQPostMerge(_ *cobra.Command, args []string) error {
 		// Not a warning — local branch often doesn't exist
 		_ = err
 	} else {
-		fmt.Printf("  %s Deleted local branch: %s\n", style.Success.Render("✓"), mr.Branch)
+		fmt.Printf("  %s Deleted local branch: %s\n", style.SuccessPrefix, mr.Branch)
 	}
 
 	return nil
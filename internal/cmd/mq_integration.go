@@ -8,13 +8,15 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
-	"github.com/gofrs/flock"
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/locks"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -95,12 +97,15 @@ func getRigGit(rigPath string) (*git.Git, error) {
 	return git.NewGit(mayorPath), nil
 }
 
+// landLockWait bounds how long a land operation waits for another to finish.
+const landLockWait = 30 * time.Minute
+
 // createLandWorktree creates a temporary worktree from .repo.git for land operations.
 // This avoids disrupting running agents (refinery, mayor) by operating in an isolated worktree.
 // The caller MUST call the returned cleanup function when done (typically via defer).
 // The worktree is checked out to startBranch (e.g., "main").
 //
-// A global file lock serializes ALL land operations within a rig, even those targeting
+// A rig-wide lease serializes ALL land operations within a rig, even those targeting
 // different branches (e.g., landing epic-A to main and epic-C to staging). This is
 // intentional: the fixed .land-worktree path is reused across operations, and single-rig
 // simplicity is preferred over parallel landing. If per-branch parallelism is needed in
@@ -109,21 +114,21 @@ func createLandWorktree(rigPath, startBranch string) (*git.Git, func(), error) {
 	landPath := filepath.Join(rigPath, ".land-worktree")
 	noop := func() {}
 
-	// Acquire file lock to prevent concurrent land operations from racing.
-	// Matches the lockPolecat() pattern used elsewhere in the codebase.
-	lockDir := filepath.Join(rigPath, ".runtime", "locks")
-	if err := os.MkdirAll(lockDir, 0755); err != nil {
-		return nil, noop, fmt.Errorf("creating lock dir: %w", err)
-	}
-	fl := flock.New(filepath.Join(lockDir, "land-worktree.lock"))
-	if err := fl.Lock(); err != nil {
+	// Take the rig's land lease to prevent concurrent land operations from
+	// racing. It shows up in gt locks like the polecat locks.
+	fl, err := locks.Acquire(filepath.Dir(rigPath), "land-worktree/"+filepath.Base(rigPath), locks.Options{
+		Purpose: "land to " + startBranch,
+		TTL:     landLockWait,
+		Wait:    landLockWait,
+	})
+	if err != nil {
 		return nil, noop, fmt.Errorf("acquiring land worktree lock: %w", err)
 	}
 
 	// Get bare repo for worktree creation
 	bareRepoPath := filepath.Join(rigPath, ".repo.git")
 	if _, err := os.Stat(bareRepoPath); err != nil {
-		_ = fl.Unlock()
+		fl.Release()
 		return nil, noop, fmt.Errorf("bare repo not found at %s: %w", bareRepoPath, err)
 	}
 	bareGit := git.NewGitWithDir(bareRepoPath, "")
@@ -137,14 +142,14 @@ func createLandWorktree(rigPath, startBranch string) (*git.Git, func(), error) {
 	// Create worktree checked out to the target branch.
 	// Use --force because the branch may already be checked out in refinery/rig.
 	if err := bareGit.WorktreeAddExistingForce(landPath, startBranch); err != nil {
-		_ = fl.Unlock()
+		fl.Release()
 		return nil, noop, fmt.Errorf("creating land worktree: %w", err)
 	}
 
 	cleanup := func() {
 		_ = bareGit.WorktreeRemove(landPath, true)
 		_ = os.RemoveAll(landPath)
-		_ = fl.Unlock()
+		fl.Release()
 	}
 
 	return git.NewGit(landPath), cleanup, nil
@@ -356,7 +361,7 @@ func runMqIntegrationCreate(cmd *cobra.Command, args []string) error {
 	// hook guardrail only protects branches under that prefix.
 	if !strings.HasPrefix(branchName, "integration/") {
 		fmt.Printf("  %s Branch '%s' is outside the integration/ namespace.\n",
-			style.Bold.Render("⚠"),
+			style.Bold.Render(ui.Glyph(ui.IconWarn)),
 			branchName)
 		fmt.Printf("    The pre-push hook guardrail won't cover this branch.\n")
 	}
@@ -414,7 +419,7 @@ func runMqIntegrationCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Success output
-	fmt.Printf("\n%s Created integration branch\n", style.Bold.Render("✓"))
+	fmt.Printf("\n%s Created integration branch\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Printf("  Epic:   %s\n", epicID)
 	fmt.Printf("  Branch: %s\n", branchName)
 	fmt.Printf("  From:   %s\n", baseBranchDisplay)
@@ -475,7 +480,7 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 	epicAlreadyClosed := epic.Status == "closed"
 	if epicAlreadyClosed {
 		fmt.Printf("  %s Epic is already closed (may have been landed by another process)\n",
-			style.Bold.Render("⚠"))
+			style.Bold.Render(ui.Glyph(ui.IconWarn)))
 	}
 
 	// Fetch early so resolveEpicBranch and subsequent branch-existence
@@ -525,7 +530,7 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("fetching branch: %w", err)
 		}
 	}
-	fmt.Printf("  %s Branch exists (local and remote)\n", style.Bold.Render("✓"))
+	fmt.Printf("  %s Branch exists (local and remote)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	// 3. Verify all MRs targeting this integration branch are merged
 	fmt.Printf("Checking open merge requests...\n")
@@ -535,7 +540,7 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(openMRs) > 0 {
-		fmt.Printf("\n  %s Open merge requests targeting %s:\n", style.Bold.Render("⚠"), branchName)
+		fmt.Printf("\n  %s Open merge requests targeting %s:\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), branchName)
 		for _, mr := range openMRs {
 			fmt.Printf("    - %s: %s\n", mr.ID, mr.Title)
 		}
@@ -544,9 +549,9 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 		if !mqIntegrationLandForce {
 			return fmt.Errorf("cannot land: %d open MRs (use --force to override)", len(openMRs))
 		}
-		fmt.Printf("  %s Proceeding anyway (--force)\n", style.Dim.Render("⚠"))
+		fmt.Printf("  %s Proceeding anyway (--force)\n", style.Dim.Render(ui.Glyph(ui.IconWarn)))
 	} else {
-		fmt.Printf("  %s No open MRs targeting integration branch\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s No open MRs targeting integration branch\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	// 4. Verify all epic children are closed
@@ -568,7 +573,7 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(openChildren) > 0 {
-		fmt.Printf("\n  %s Open children of %s:\n", style.Bold.Render("⚠"), epicID)
+		fmt.Printf("\n  %s Open children of %s:\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), epicID)
 		for _, child := range openChildren {
 			fmt.Printf("    - %s [%s]: %s\n", child.ID, child.Status, child.Title)
 		}
@@ -577,11 +582,11 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 		if !mqIntegrationLandForce {
 			return fmt.Errorf("cannot land: %d children still open/in_progress (use --force to override)", len(openChildren))
 		}
-		fmt.Printf("  %s Proceeding anyway (--force)\n", style.Dim.Render("⚠"))
+		fmt.Printf("  %s Proceeding anyway (--force)\n", style.Dim.Render(ui.Glyph(ui.IconWarn)))
 	} else if len(children) > 0 {
-		fmt.Printf("  %s All %d children closed\n", style.Bold.Render("✓"), len(children))
+		fmt.Printf("  %s All %d children closed\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(children))
 	} else {
-		fmt.Printf("  %s No children found (landing empty integration branch)\n", style.Dim.Render("ℹ"))
+		fmt.Printf("  %s No children found (landing empty integration branch)\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
 	}
 
 	// Dry run stops here
@@ -603,7 +608,7 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 	alreadyMerged, err := g.IsAncestor("origin/"+branchName, "origin/"+targetBranch)
 	if err == nil && alreadyMerged {
 		fmt.Printf("  %s Integration branch already merged into %s — skipping to cleanup\n",
-			style.Bold.Render("✓"), targetBranch)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), targetBranch)
 		if warnings := cleanupIntegrationBranch(g, bd, epicID, branchName, targetBranch, epicAlreadyClosed); len(warnings) > 0 {
 			return fmt.Errorf("landed but cleanup incomplete: %s", strings.Join(warnings, "; "))
 		}
@@ -634,7 +639,7 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 		_ = landGit.AbortMerge()
 		return fmt.Errorf("merge failed: %w", err)
 	}
-	fmt.Printf("  %s Merged successfully\n", style.Bold.Render("✓"))
+	fmt.Printf("  %s Merged successfully\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	// 5. Run tests (if configured and not skipped)
 	if !mqIntegrationLandSkipTests {
@@ -643,10 +648,10 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 			fmt.Printf("Running tests: %s\n", testCmd)
 			if err := runTestCommand(landGit.WorkDir(), testCmd); err != nil {
 				// Tests failed - no need to reset, worktree is temporary
-				fmt.Printf("  %s Tests failed\n", style.Bold.Render("✗"))
+				fmt.Printf("  %s Tests failed\n", style.Bold.Render(ui.Glyph("✗")))
 				return fmt.Errorf("tests failed: %w", err)
 			}
-			fmt.Printf("  %s Tests passed\n", style.Bold.Render("✓"))
+			fmt.Printf("  %s Tests passed\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		} else {
 			fmt.Printf("  %s\n", style.Dim.Render("(no test command configured)"))
 		}
@@ -671,7 +676,7 @@ func runMqIntegrationLand(cmd *cobra.Command, args []string) error {
 	if err := landGit.PushWithEnv("origin", targetBranch, false, []string{"GT_INTEGRATION_LAND=1"}); err != nil {
 		return fmt.Errorf("push failed: %w", err)
 	}
-	fmt.Printf("  %s Pushed to origin\n", style.Bold.Render("✓"))
+	fmt.Printf("  %s Pushed to origin\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	if warnings := cleanupIntegrationBranch(g, bd, epicID, branchName, targetBranch, epicAlreadyClosed); len(warnings) > 0 {
 		return fmt.Errorf("landed but cleanup incomplete: %s", strings.Join(warnings, "; "))
@@ -699,7 +704,7 @@ func cleanupIntegrationBranch(g *git.Git, bd *beads.Beads, epicID, branchName, t
 		// leaves a non-retriable state (no branch for idempotent re-run).
 		return append(warnings, fmt.Sprintf("could not close epic (aborting cleanup): %v", err))
 	} else {
-		fmt.Printf("  %s Epic closed\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s Epic closed\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	// Delete integration branch (use bare repo git — ref-only operations)
@@ -710,7 +715,7 @@ func cleanupIntegrationBranch(g *git.Git, bd *beads.Beads, epicID, branchName, t
 		warnings = append(warnings, warning)
 		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("(%s)", warning)))
 	} else {
-		fmt.Printf("  %s Deleted from origin\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s Deleted from origin\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 	// Delete local
 	if err := g.DeleteBranch(branchName, true); err != nil {
@@ -718,14 +723,14 @@ func cleanupIntegrationBranch(g *git.Git, bd *beads.Beads, epicID, branchName, t
 		warnings = append(warnings, warning)
 		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("(%s)", warning)))
 	} else {
-		fmt.Printf("  %s Deleted locally\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s Deleted locally\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	// Report result
 	if len(warnings) > 0 {
-		fmt.Printf("\n%s Landed integration branch (with %d cleanup warning(s))\n", style.Bold.Render("⚠"), len(warnings))
+		fmt.Printf("\n%s Landed integration branch (with %d cleanup warning(s))\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), len(warnings))
 	} else {
-		fmt.Printf("\n%s Successfully landed integration branch\n", style.Bold.Render("✓"))
+		fmt.Printf("\n%s Successfully landed integration branch\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 	fmt.Printf("  Epic:   %s\n", epicID)
 	fmt.Printf("  Branch: %s → %s\n", branchName, targetBranch)
@@ -1025,7 +1030,7 @@ func printIntegrationStatus(output *IntegrationStatusOutput) error {
 	// Landing status
 	fmt.Println()
 	if output.ReadyToLand {
-		fmt.Printf("%s Integration branch is ready to land.\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Integration branch is ready to land.\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		if output.AutoLandEnabled {
 			fmt.Printf("  Auto-land: %s\n", style.Bold.Render("enabled"))
 		} else {
@@ -1034,15 +1039,15 @@ func printIntegrationStatus(output *IntegrationStatusOutput) error {
 		}
 	} else {
 		if output.ChildrenTotal == 0 {
-			fmt.Printf("%s Epic has no children yet.\n", style.Dim.Render("○"))
+			fmt.Printf("%s Epic has no children yet.\n", style.Dim.Render(ui.Glyph("○")))
 		} else if output.ChildrenClosed < output.ChildrenTotal {
 			fmt.Printf("%s Waiting for %d/%d children to close.\n",
-				style.Dim.Render("○"), output.ChildrenTotal-output.ChildrenClosed, output.ChildrenTotal)
+				style.Dim.Render(ui.Glyph("○")), output.ChildrenTotal-output.ChildrenClosed, output.ChildrenTotal)
 		} else if len(output.PendingMRs) > 0 {
 			fmt.Printf("%s Waiting for %d pending MRs to merge.\n",
-				style.Dim.Render("○"), len(output.PendingMRs))
+				style.Dim.Render(ui.Glyph("○")), len(output.PendingMRs))
 		} else if output.AheadOfBase == 0 {
-			fmt.Printf("%s No commits ahead of %s.\n", style.Dim.Render("○"), output.BaseBranch)
+			fmt.Printf("%s No commits ahead of %s.\n", style.Dim.Render(ui.Glyph("○")), output.BaseBranch)
 		}
 		// Show auto-land status even when not ready
 		if output.AutoLandEnabled {
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 func runMQList(cmd *cobra.Command, args []string) error {
@@ -288,7 +289,7 @@ func runMQList(cmd *cobra.Command, args []string) error {
 		}
 		if missingCount > 0 {
 			fmt.Printf("\n  %s %d MR(s) with missing branches\n",
-				style.Error.Render("⚠"),
+				style.Error.Render(ui.Glyph(ui.IconWarn)),
 				missingCount)
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // MQ next command flags
@@ -86,7 +87,7 @@ func runMQNext(cmd *cobra.Command, args []string) error {
 		if mqNextQuiet {
 			return nil // Silent exit
 		}
-		fmt.Printf("%s No ready merge requests in queue\n", style.Dim.Render("ℹ"))
+		fmt.Printf("%s No ready merge requests in queue\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
 		return nil
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // MRStatusOutput is the JSON output structure for gt mq status.
@@ -229,11 +230,11 @@ func printMqStatus(issue *beads.Issue, mrFields *beads.MRFields) error {
 func formatStatus(status string) string {
 	switch status {
 	case "open":
-		return style.Info.Render("● open")
+		return style.Info.Render(ui.Glyph("●") + " open")
 	case "in_progress":
-		return style.Bold.Render("▶ in_progress")
+		return style.Bold.Render(ui.Glyph("▶") + " in_progress")
 	case "closed":
-		return style.Dim.Render("✓ closed")
+		return style.Dim.Render(ui.Glyph(ui.IconPass) + " closed")
 	default:
 		return status
 	}
@@ -243,13 +244,13 @@ func formatStatus(status string) string {
 func getStatusIcon(status string) string {
 	switch status {
 	case "open":
-		return "○"
+		return ui.Glyph("○")
 	case "in_progress":
-		return "▶"
+		return ui.Glyph("▶")
 	case "closed":
-		return "✓"
+		return ui.Glyph(ui.IconPass)
 	default:
-		return "•"
+		return ui.Glyph("•")
 	}
 }
 
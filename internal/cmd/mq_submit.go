@@ -16,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -125,8 +126,11 @@ func runMqSubmit(cmd *cobra.Command, args []string) error {
 
 	g := git.NewGit(cwd)
 
-	// Get current branch
+	// Get current branch, preferring the branch registered for --issue
 	branch := mqSubmitBranch
+	if branch == "" && mqSubmitIssue != "" {
+		branch = registeredBranch(townRoot, mqSubmitIssue)
+	}
 	if branch == "" {
 		branch, err = g.CurrentBranch()
 		if err != nil {
@@ -147,17 +151,23 @@ func runMqSubmit(cmd *cobra.Command, args []string) error {
 	// Parse branch info
 	info := parseBranchName(branch)
 
-	// Override with explicit flags
+	// Explicit flags win, then the branch name; the rig's artifact registry
+	// covers branches whose names don't carry the issue
 	issueID := mqSubmitIssue
 	if issueID == "" {
 		issueID = info.Issue
 	}
+	if issueID == "" {
+		issueID = wispForBranch(townRoot, rigName, branch)
+	}
 	worker := info.Worker
 
 	if issueID == "" {
 		return fmt.Errorf("cannot determine source issue from branch '%s'; use --issue to specify", branch)
 	}
 
+	registerBranchArtifact(townRoot, issueID, branch, rigName)
+
 	// Initialize beads for looking up source issue
 	bd := beads.New(cwd)
 
@@ -220,7 +230,7 @@ func runMqSubmit(cmd *cobra.Command, args []string) error {
 
 	if existingMR != nil {
 		mrIssue = existingMR
-		fmt.Printf("%s MR already exists (idempotent)\n", style.Bold.Render("✓"))
+		fmt.Printf("%s MR already exists (idempotent)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
 		// Create MR bead (ephemeral wisp - will be cleaned up after merge)
 		mrIssue, err = bd.Create(beads.CreateOptions{
@@ -239,7 +249,7 @@ func runMqSubmit(cmd *cobra.Command, args []string) error {
 	}
 
 	// Success output
-	fmt.Printf("%s Submitted to merge queue\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Submitted to merge queue\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Printf("  MR ID: %s\n", style.Bold.Render(mrIssue.ID))
 	fmt.Printf("  Source: %s\n", branch)
 	fmt.Printf("  Target: %s\n", target)
@@ -253,7 +263,7 @@ func runMqSubmit(cmd *cobra.Command, args []string) error {
 	// send lifecycle request and wait for termination
 	if worker != "" && !mqSubmitNoCleanup {
 		fmt.Println()
-		fmt.Printf("%s Auto-cleanup: polecat work submitted\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Auto-cleanup: polecat work submitted\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		if err := polecatCleanup(rigName, worker, townRoot); err != nil {
 			// Non-fatal: warn but return success (MR was created)
 			style.PrintWarning("Could not auto-cleanup: %v", err)
@@ -291,11 +301,11 @@ Please verify state and execute lifecycle action.
 	if out, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("sending lifecycle request: %w: %s", err, string(out))
 	}
-	fmt.Printf("%s Sent shutdown request to %s\n", style.Bold.Render("✓"), manager)
+	fmt.Printf("%s Sent shutdown request to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), manager)
 
 	// Wait for retirement with periodic status
 	fmt.Println()
-	fmt.Printf("%s Waiting for retirement...\n", style.Dim.Render("◌"))
+	fmt.Printf("%s Waiting for retirement...\n", style.Dim.Render(ui.Glyph("◌")))
 	fmt.Println(style.Dim.Render("(Witness will terminate this session)"))
 
 	ticker := time.NewTicker(30 * time.Second)
@@ -310,7 +320,7 @@ Please verify state and execute lifecycle action.
 		select {
 		case <-ticker.C:
 			elapsed := time.Since(waitStart).Round(time.Second)
-			fmt.Printf("%s Still waiting (%v elapsed)...\n", style.Dim.Render("◌"), elapsed)
+			fmt.Printf("%s Still waiting (%v elapsed)...\n", style.Dim.Render(ui.Glyph("◌")), elapsed)
 			if elapsed >= 2*time.Minute {
 				fmt.Println(style.Dim.Render("  Hint: If witness isn't responding, you may need to:"))
 				fmt.Println(style.Dim.Render("  - Check if witness is running: gt rig status"))
@@ -19,7 +19,9 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/telemetry"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/term"
 )
 
 var (
@@ -68,7 +70,8 @@ Delivery modes (--mode):
              work but guarantees immediate delivery.
   queue      Write to a file queue; agent picks up via hook at next turn
              boundary. Zero interruption. Use for non-urgent coordination.
-  wait-idle  Wait for agent to become idle (prompt visible), then deliver
+  wait-idle  Wait for agent to finish responding (prompt visible and not
+             busy, or a quiet pane for agents without a prompt), then deliver
              directly. Falls back to queue on timeout. If both idle-wait and
              queue fail, falls back to immediate delivery as a last resort.
 
@@ -96,6 +99,12 @@ DND (Do Not Disturb):
   If the target has DND enabled (gt dnd on), the nudge is skipped.
   Use --force to override DND and send anyway.
 
+Input guard:
+  Direct deliveries are screened for dangerous commands (rm -rf outside the
+  worktree, force-push to main, curl | sh) and blocked; rules set to
+  "confirm" ask at the terminal instead. Every stopped nudge is audited.
+  Configure rules under "input_guard" in settings/config.json.
+
 Examples:
   gt nudge greenplace/furiosa "Check your mail and start working"
   gt nudge greenplace/alpha -m "What's your status?"
@@ -155,7 +164,7 @@ func deliverNudge(t *tmux.Tmux, sessionName, message, sender string) error {
 		err := t.WaitForIdle(sessionName, waitIdleTimeout)
 		if err == nil {
 			// Agent is idle — safe to deliver directly
-			return t.NudgeSession(sessionName, prefixedMessage)
+			return nudgeSessionConfirmed(t, sessionName, prefixedMessage)
 		}
 		// Terminal errors (session gone, no server) — propagate, don't queue.
 		// Queueing a nudge for a dead session means it will never be delivered.
@@ -171,15 +180,33 @@ func deliverNudge(t *tmux.Tmux, sessionName, message, sender string) error {
 			// Queue failed — fall back to immediate as last resort.
 			// Better to interrupt than lose the message entirely.
 			fmt.Fprintf(os.Stderr, "Warning: queue fallback failed (%v), delivering immediately\n", qErr)
-			return t.NudgeSession(sessionName, prefixedMessage)
+			return nudgeSessionConfirmed(t, sessionName, prefixedMessage)
 		}
 		return nil
 
 	default: // NudgeModeImmediate
-		return t.NudgeSession(sessionName, prefixedMessage)
+		return nudgeSessionConfirmed(t, sessionName, prefixedMessage)
 	}
 }
 
+// nudgeSessionConfirmed sends a nudge, asking the human at the terminal to
+// confirm when the session input guard flags it with a confirm rule.
+// Blocked sends, and confirm rules with no terminal to ask, fail.
+func nudgeSessionConfirmed(t *tmux.Tmux, sessionName, message string) error {
+	err := t.NudgeSession(sessionName, message)
+	var blocked *tmux.InputBlockedError
+	if !errors.As(err, &blocked) || !blocked.NeedsConfirmation() || !term.IsTerminal(int(os.Stdin.Fd())) {
+		return err
+	}
+	fmt.Printf("%s Nudge to %s matches input guard rule %q (%s):\n  %s\n",
+		style.WarningPrefix, sessionName, blocked.Rule, blocked.Description, blocked.Match)
+	if !promptYesNo("Send it anyway?") {
+		return err
+	}
+	tmux.ConfirmInput(sessionName, message)
+	return t.NudgeSession(sessionName, message)
+}
+
 // validNudgeModes is the set of allowed --mode values.
 var validNudgeModes = map[string]bool{
 	NudgeModeImmediate: true,
@@ -282,7 +309,7 @@ func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 	if townRoot != "" && !nudgeForceFlag {
 		shouldSend, level, _ := shouldNudgeTarget(townRoot, target, nudgeForceFlag)
 		if !shouldSend {
-			fmt.Printf("%s Target has DND enabled (%s) - nudge skipped\n", style.Dim.Render("○"), level)
+			fmt.Printf("%s Target has DND enabled (%s) - nudge skipped\n", style.Dim.Render(ui.Glyph("○")), level)
 			fmt.Printf("  Use %s to override\n", style.Bold.Render("--force"))
 			return nil
 		}
@@ -322,7 +349,7 @@ func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 		}
 		if !exists {
 			// Deacon not running - this is not an error, just log and return
-			fmt.Printf("%s Deacon not running, nudge skipped\n", style.Dim.Render("○"))
+			fmt.Printf("%s Deacon not running, nudge skipped\n", style.Dim.Render(ui.Glyph("○")))
 			return nil
 		}
 
@@ -330,7 +357,7 @@ func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 			return fmt.Errorf("nudging deacon: %w", err)
 		}
 
-		fmt.Printf("%s Nudged deacon (%s)\n", style.Bold.Render("✓"), nudgeModeFlag)
+		fmt.Printf("%s Nudged deacon (%s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), nudgeModeFlag)
 
 		// Log nudge event
 		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
@@ -398,7 +425,7 @@ func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 			return fmt.Errorf("nudging session: %w", err)
 		}
 
-		fmt.Printf("%s Nudged %s/%s (%s)\n", style.Bold.Render("✓"), rigName, polecatName, nudgeModeFlag)
+		fmt.Printf("%s Nudged %s/%s (%s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rigName, polecatName, nudgeModeFlag)
 
 		// Log nudge event
 		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
@@ -419,7 +446,7 @@ func runNudge(cmd *cobra.Command, args []string) (retErr error) {
 			return fmt.Errorf("nudging session: %w", err)
 		}
 
-		fmt.Printf("✓ Nudged %s (%s)\n", target, nudgeModeFlag)
+		fmt.Printf("%s Nudged %s (%s)\n", ui.Glyph(ui.IconPass), target, nudgeModeFlag)
 
 		// Log nudge event
 		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
@@ -496,7 +523,7 @@ func runNudgeChannel(channelName, message, sender string) error {
 		if targetAddr != "" {
 			if shouldSend, level, _ := shouldNudgeTarget(townRoot, targetAddr, false); !shouldSend {
 				skipped++
-				fmt.Printf("  %s %s (DND: %s)\n", style.Dim.Render("○"), sessionName, level)
+				fmt.Printf("  %s %s (DND: %s)\n", style.Dim.Render(ui.Glyph("○")), sessionName, level)
 				continue
 			}
 		}
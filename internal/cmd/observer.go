@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/observer"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var observerCmd = &cobra.Command{
+	Use:     "observer",
+	GroupID: GroupConfig,
+	Short:   "Manage read-only observer access",
+	RunE:    requireSubcommand,
+	Long: `Give stakeholders a read-only view of the town.
+
+Observers can see status, history, and logs but cannot change anything.
+There are two ways in:
+
+  Dashboard   gt dashboard --observer serves a read-only dashboard that
+              admits holders of an observer token. Every mutating API
+              call is refused.
+  CLI         With GT_OBSERVER=1 set, gt runs only read-only commands
+              (status, feed, log, list and show commands, ...) and
+              refuses everything else.
+
+Tokens are shown once when issued; only their hashes are kept, in
+mayor/observers.json.
+
+Commands:
+  gt observer add <name>      Issue a dashboard token
+  gt observer list            List observers
+  gt observer revoke <name>   Revoke an observer's token`,
+}
+
+var observerAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Issue a dashboard token for an observer",
+	Long: `Issue a read-only dashboard token. The token is printed once; store it
+somewhere safe and hand it to the observer.
+
+Examples:
+  gt observer add alice
+  # then: open http://host:8080/?token=<token>`,
+	Args: cobra.ExactArgs(1),
+	RunE: runObserverAdd,
+}
+
+var observerListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List observers",
+	Args:  cobra.NoArgs,
+	RunE:  runObserverList,
+}
+
+var observerRevokeCmd = &cobra.Command{
+	Use:   "revoke <name>",
+	Short: "Revoke an observer's token",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runObserverRevoke,
+}
+
+func init() {
+	observerCmd.AddCommand(observerAddCmd)
+	observerCmd.AddCommand(observerListCmd)
+	observerCmd.AddCommand(observerRevokeCmd)
+	rootCmd.AddCommand(observerCmd)
+}
+
+func runObserverAdd(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	reg, err := observer.Load(townRoot)
+	if err != nil {
+		return err
+	}
+	token, err := reg.Add(args[0], time.Now())
+	if err != nil {
+		return err
+	}
+	if err := reg.Save(townRoot); err != nil {
+		return fmt.Errorf("saving observers: %w", err)
+	}
+	fmt.Printf("%s Added observer %s\n\n", style.SuccessPrefix, args[0])
+	fmt.Printf("  %s\n\n", token)
+	fmt.Println(style.Dim.Render("This token is shown only once. Serve it with: gt dashboard --observer"))
+	return nil
+}
+
+func runObserverList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	reg, err := observer.Load(townRoot)
+	if err != nil {
+		return err
+	}
+	if len(reg.Observers) == 0 {
+		fmt.Println("No observers. Add one with: gt observer add <name>")
+		return nil
+	}
+	for _, o := range reg.Observers {
+		fmt.Printf("  %-20s %s\n", o.Name, style.Dim.Render("added "+o.CreatedAt.Local().Format("2006-01-02 15:04")))
+	}
+	return nil
+}
+
+func runObserverRevoke(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	reg, err := observer.Load(townRoot)
+	if err != nil {
+		return err
+	}
+	if !reg.Revoke(args[0]) {
+		return fmt.Errorf("no observer named %q", args[0])
+	}
+	if err := reg.Save(townRoot); err != nil {
+		return fmt.Errorf("saving observers: %w", err)
+	}
+	fmt.Printf("%s Revoked observer %s\n", style.SuccessPrefix, args[0])
+	return nil
+}
+
+// observerCommands are the commands an observer may run, by path below gt.
+// Anything not listed is refused when GT_OBSERVER is set, so new commands
+// are read-only for observers only once they are added here.
+var observerCommands = map[string]bool{
+	"":                       true,
+	"help":                   true,
+	"completion":             true,
+	"version":                true,
+	"info":                   true,
+	"status":                 true,
+	"vitals":                 true,
+	"health":                 true,
+	"log":                    true,
+	"feed":                   true,
+	"trail":                  true,
+	"audit":                  true,
+	"activity":               true,
+	"stats":                  true,
+	"forecast":               true,
+	"trace":                  true,
+	"costs":                  true,
+	"why":                    true,
+	"ready":                  true,
+	"show":                   true,
+	"cat":                    true,
+	"peek":                   true,
+	"grep-sessions":          true,
+	"dashboard":              true,
+	"doctor":                 true,
+	"doctor checks list":     true,
+	"doctor checks groups":   true,
+	"doctor checks describe": true,
+	"doctor baseline show":   true,
+	"convoy list":            true,
+	"convoy status":          true,
+	"rig list":               true,
+	"rig status":             true,
+	"polecat list":           true,
+	"polecat status":         true,
+	"crew list":              true,
+	"crew status":            true,
+	"agents list":            true,
+	"hooks list":             true,
+	"mail inbox":             true,
+	"mail read":              true,
+	"mail peek":              true,
+	"mail check":             true,
+	"mail thread":            true,
+	"mail search":            true,
+	"escalate list":          true,
+	"escalate show":          true,
+	"quota status":           true,
+	"failover status":        true,
+	"daemon status":          true,
+	"dolt status":            true,
+}
+
+// observerDeniedFlags are flags that make an allowed command mutate.
+var observerDeniedFlags = map[string][]string{
+	"doctor":     {"fix"},
+	"mail check": {"inject"},
+}
+
+// checkObserverAllowed refuses cmd when running as a read-only observer
+// and cmd can change the town.
+func checkObserverAllowed(cmd *cobra.Command) error {
+	if !observer.Enabled() {
+		return nil
+	}
+	path := observerCommandPath(cmd)
+	if !observerCommands[path] {
+		return fmt.Errorf("gt %s is not available to read-only observers (%s is set)", path, observer.EnvVar)
+	}
+	for _, name := range observerDeniedFlags[path] {
+		if f := cmd.Flags().Lookup(name); f != nil && f.Changed {
+			return fmt.Errorf("gt %s --%s is not available to read-only observers (%s is set)", path, name, observer.EnvVar)
+		}
+	}
+	return nil
+}
+
+// observerCommandPath returns cmd's path below the root command.
+func observerCommandPath(cmd *cobra.Command) string {
+	return strings.TrimPrefix(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()), " ")
+}
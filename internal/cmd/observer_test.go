@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/observer"
+)
+
+// Every allowlisted path must name a real command, or a rename would
+// silently lock observers out of it.
+func TestObserverCommands_Exist(t *testing.T) {
+	for path := range observerCommands {
+		if path == "" || path == "help" || path == "completion" {
+			continue
+		}
+		cmd, _, err := rootCmd.Find(strings.Fields(path))
+		if err != nil || observerCommandPath(cmd) != path {
+			t.Errorf("observer allowlist entry %q does not resolve to a command", path)
+		}
+	}
+}
+
+func TestCheckObserverAllowed(t *testing.T) {
+	find := func(args ...string) *cobra.Command {
+		t.Helper()
+		cmd, _, err := rootCmd.Find(args)
+		if err != nil {
+			t.Fatalf("Find(%v): %v", args, err)
+		}
+		return cmd
+	}
+
+	t.Setenv(observer.EnvVar, "")
+	if err := checkObserverAllowed(find("sling")); err != nil {
+		t.Errorf("non-observer blocked from sling: %v", err)
+	}
+
+	t.Setenv(observer.EnvVar, "1")
+	for _, args := range [][]string{{"status"}, {"mail", "inbox"}, {"failover", "status"}} {
+		if err := checkObserverAllowed(find(args...)); err != nil {
+			t.Errorf("observer blocked from %v: %v", args, err)
+		}
+	}
+	for _, args := range [][]string{{"sling"}, {"mail", "send"}, {"observer", "add"}, {"failover", "clear"}} {
+		if err := checkObserverAllowed(find(args...)); err == nil {
+			t.Errorf("observer allowed to run %v", args)
+		}
+	}
+
+	doctor := find("doctor")
+	if err := doctor.Flags().Set("fix", "true"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = doctor.Flags().Set("fix", "false")
+		doctor.Flags().Lookup("fix").Changed = false
+	})
+	if err := checkObserverAllowed(doctor); err == nil {
+		t.Error("observer allowed to run doctor --fix")
+	}
+}
@@ -17,6 +17,7 @@ import (
 	gitpkg "github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -235,7 +236,7 @@ func runOrphans(cmd *cobra.Command, args []string) error {
 
 	if len(filtered) > 0 {
 		foundAnything = true
-		fmt.Printf("%s Found %d orphaned commit(s):\n\n", style.Warning.Render("⚠"), len(filtered))
+		fmt.Printf("%s Found %d orphaned commit(s):\n\n", style.WarningPrefix, len(filtered))
 		for _, o := range filtered {
 			age := formatAge(o.Date)
 			fmt.Printf("  %s %s\n", style.Bold.Render(o.SHA[:8]), o.Subject)
@@ -254,10 +255,10 @@ func runOrphans(cmd *cobra.Command, args []string) error {
 	polecatBranches, skipped, err := findOrphanPolecatBranches(r.Path, rigName, defaultBranch)
 	if err != nil {
 		// Non-fatal: report the error but continue
-		fmt.Printf("%s Could not scan polecat worktrees: %v\n\n", style.Dim.Render("ℹ"), err)
+		fmt.Printf("%s Could not scan polecat worktrees: %v\n\n", style.Dim.Render(ui.Glyph(ui.IconInfo)), err)
 	} else if len(polecatBranches) > 0 {
 		foundAnything = true
-		fmt.Printf("%s Found %d unmerged polecat branch(es):\n\n", style.Warning.Render("⚠"), len(polecatBranches))
+		fmt.Printf("%s Found %d unmerged polecat branch(es):\n\n", style.WarningPrefix, len(polecatBranches))
 		for _, b := range polecatBranches {
 			fmt.Printf("  %s %s (%d commit(s) ahead of %s)\n",
 				style.Bold.Render(b.Polecat), b.Branch, b.AheadCount, defaultBranch)
@@ -276,7 +277,7 @@ func runOrphans(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(skipped) > 0 {
-		fmt.Printf("%s Skipped %d polecat(s) due to errors:\n", style.Warning.Render("⚠"), len(skipped))
+		fmt.Printf("%s Skipped %d polecat(s) due to errors:\n", style.WarningPrefix, len(skipped))
 		for _, s := range skipped {
 			fmt.Printf("  %s: %s\n", s.Polecat, s.Err)
 		}
@@ -285,10 +286,10 @@ func runOrphans(cmd *cobra.Command, args []string) error {
 
 	if !foundAnything {
 		if len(orphans) > 0 && len(filtered) == 0 {
-			fmt.Printf("%s No orphaned commits in the last %d days\n", style.Bold.Render("✓"), orphansDays)
+			fmt.Printf("%s No orphaned commits in the last %d days\n", style.Bold.Render(ui.Glyph(ui.IconPass)), orphansDays)
 			fmt.Printf("%s Use --days=N or --all to see older orphans\n", style.Dim.Render("Hint:"))
 		} else {
-			fmt.Printf("%s No orphaned work found\n", style.Bold.Render("✓"))
+			fmt.Printf("%s No orphaned work found\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		}
 	}
 
@@ -596,25 +597,25 @@ func runOrphansKill(cmd *cobra.Command, args []string) error {
 
 	// Check if there's anything to do
 	if len(filteredCommits) == 0 && len(procOrphans) == 0 {
-		fmt.Printf("%s No orphans found\n", style.Bold.Render("✓"))
+		fmt.Printf("%s No orphans found\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		return nil
 	}
 
 	// Show orphaned commits
 	if len(filteredCommits) > 0 {
-		fmt.Printf("%s Found %d orphaned commit(s) to remove:\n\n", style.Warning.Render("⚠"), len(filteredCommits))
+		fmt.Printf("%s Found %d orphaned commit(s) to remove:\n\n", style.WarningPrefix, len(filteredCommits))
 		for _, o := range filteredCommits {
 			fmt.Printf("  %s %s\n", style.Bold.Render(o.SHA[:8]), o.Subject)
 			fmt.Printf("    %s by %s\n\n", style.Dim.Render(formatAge(o.Date)), o.Author)
 		}
 	} else if len(commitOrphans) > 0 {
 		fmt.Printf("%s No orphaned commits in the last %d days (use --days=N or --all)\n\n",
-			style.Dim.Render("ℹ"), orphansKillDays)
+			style.Dim.Render(ui.Glyph(ui.IconInfo)), orphansKillDays)
 	}
 
 	// Show orphaned processes
 	if len(procOrphans) > 0 {
-		fmt.Printf("%s Found %d orphaned Claude process(es) to kill:\n\n", style.Warning.Render("⚠"), len(procOrphans))
+		fmt.Printf("%s Found %d orphaned Claude process(es) to kill:\n\n", style.WarningPrefix, len(procOrphans))
 		for _, o := range procOrphans {
 			displayArgs := o.Args
 			if len(displayArgs) > 80 {
@@ -626,7 +627,7 @@ func runOrphansKill(cmd *cobra.Command, args []string) error {
 	}
 
 	if orphansKillDryRun {
-		fmt.Printf("%s Dry run - no changes made\n", style.Dim.Render("ℹ"))
+		fmt.Printf("%s Dry run - no changes made\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
 		return nil
 	}
 
@@ -638,7 +639,7 @@ func runOrphansKill(cmd *cobra.Command, args []string) error {
 		var response string
 		_, _ = fmt.Scanln(&response)
 		if strings.ToLower(strings.TrimSpace(response)) != "y" {
-			fmt.Printf("%s Canceled\n", style.Dim.Render("ℹ"))
+			fmt.Printf("%s Canceled\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
 			return nil
 		}
 	}
@@ -653,7 +654,7 @@ func runOrphansKill(cmd *cobra.Command, args []string) error {
 		if err := gcCmd.Run(); err != nil {
 			return fmt.Errorf("git gc failed: %w", err)
 		}
-		fmt.Printf("%s Removed %d orphaned commit(s)\n", style.Bold.Render("✓"), len(filteredCommits))
+		fmt.Printf("%s Removed %d orphaned commit(s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(filteredCommits))
 	}
 
 	// Kill orphaned processes
@@ -669,33 +670,33 @@ func runOrphansKill(cmd *cobra.Command, args []string) error {
 		for _, o := range procOrphans {
 			proc, err := os.FindProcess(o.PID)
 			if err != nil {
-				fmt.Printf("  %s PID %d: %v\n", style.Error.Render("✗"), o.PID, err)
+				fmt.Printf("  %s PID %d: %v\n", style.Error.Render(ui.Glyph("✗")), o.PID, err)
 				failed++
 				continue
 			}
 
 			if err := proc.Signal(signal); err != nil {
 				if err == os.ErrProcessDone {
-					fmt.Printf("  %s PID %d: already terminated\n", style.Dim.Render("○"), o.PID)
+					fmt.Printf("  %s PID %d: already terminated\n", style.Dim.Render(ui.Glyph("○")), o.PID)
 					continue
 				}
-				fmt.Printf("  %s PID %d: %v\n", style.Error.Render("✗"), o.PID, err)
+				fmt.Printf("  %s PID %d: %v\n", style.Error.Render(ui.Glyph("✗")), o.PID, err)
 				failed++
 				continue
 			}
 
-			fmt.Printf("  %s PID %d killed\n", style.Bold.Render("✓"), o.PID)
+			fmt.Printf("  %s PID %d killed\n", style.Bold.Render(ui.Glyph(ui.IconPass)), o.PID)
 			killed++
 		}
 
-		fmt.Printf("%s %d process(es) killed", style.Bold.Render("✓"), killed)
+		fmt.Printf("%s %d process(es) killed", style.Bold.Render(ui.Glyph(ui.IconPass)), killed)
 		if failed > 0 {
 			fmt.Printf(", %d failed", failed)
 		}
 		fmt.Println()
 	}
 
-	fmt.Printf("\n%s Orphan cleanup complete\n", style.Bold.Render("✓"))
+	fmt.Printf("\n%s Orphan cleanup complete\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	return nil
 }
 
@@ -805,12 +806,12 @@ func runOrphansListProcesses(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(orphans) == 0 {
-		fmt.Printf("%s No orphaned Claude processes found (PPID=1)\n", style.Bold.Render("✓"))
+		fmt.Printf("%s No orphaned Claude processes found (PPID=1)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		fmt.Printf("%s Use --aggressive to find orphans via tmux session verification\n", style.Dim.Render("Hint:"))
 		return nil
 	}
 
-	fmt.Printf("%s Found %d orphaned Claude process(es) with PPID=1:\n\n", style.Warning.Render("⚠"), len(orphans))
+	fmt.Printf("%s Found %d orphaned Claude process(es) with PPID=1:\n\n", style.WarningPrefix, len(orphans))
 
 	for _, o := range orphans {
 		// Truncate args for display
@@ -836,11 +837,11 @@ func runOrphansListProcessesAggressive() error {
 	}
 
 	if len(zombies) == 0 {
-		fmt.Printf("%s No orphaned Claude processes found (aggressive mode)\n", style.Bold.Render("✓"))
+		fmt.Printf("%s No orphaned Claude processes found (aggressive mode)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		return nil
 	}
 
-	fmt.Printf("%s Found %d orphaned Claude process(es) not in any tmux session:\n\n", style.Warning.Render("⚠"), len(zombies))
+	fmt.Printf("%s Found %d orphaned Claude process(es) not in any tmux session:\n\n", style.WarningPrefix, len(zombies))
 
 	for _, z := range zombies {
 		ageStr := formatProcessAge(z.Age)
@@ -881,13 +882,13 @@ func runOrphansKillProcesses(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(orphans) == 0 {
-		fmt.Printf("%s No orphaned Claude processes found (PPID=1)\n", style.Bold.Render("✓"))
+		fmt.Printf("%s No orphaned Claude processes found (PPID=1)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		fmt.Printf("%s Use --aggressive to find orphans via tmux session verification\n", style.Dim.Render("Hint:"))
 		return nil
 	}
 
 	// Show what we're about to kill
-	fmt.Printf("%s Found %d orphaned Claude process(es) with PPID=1:\n\n", style.Warning.Render("⚠"), len(orphans))
+	fmt.Printf("%s Found %d orphaned Claude process(es) with PPID=1:\n\n", style.WarningPrefix, len(orphans))
 	for _, o := range orphans {
 		displayArgs := o.Args
 		if len(displayArgs) > 80 {
@@ -920,7 +921,7 @@ func runOrphansKillProcesses(cmd *cobra.Command, args []string) error {
 	for _, o := range orphans {
 		proc, err := os.FindProcess(o.PID)
 		if err != nil {
-			fmt.Printf("  %s PID %d: %v\n", style.Error.Render("✗"), o.PID, err)
+			fmt.Printf("  %s PID %d: %v\n", style.Error.Render(ui.Glyph("✗")), o.PID, err)
 			failed++
 			continue
 		}
@@ -928,15 +929,15 @@ func runOrphansKillProcesses(cmd *cobra.Command, args []string) error {
 		if err := proc.Signal(signal); err != nil {
 			// Process may have already exited
 			if err == os.ErrProcessDone {
-				fmt.Printf("  %s PID %d: already terminated\n", style.Dim.Render("○"), o.PID)
+				fmt.Printf("  %s PID %d: already terminated\n", style.Dim.Render(ui.Glyph("○")), o.PID)
 				continue
 			}
-			fmt.Printf("  %s PID %d: %v\n", style.Error.Render("✗"), o.PID, err)
+			fmt.Printf("  %s PID %d: %v\n", style.Error.Render(ui.Glyph("✗")), o.PID, err)
 			failed++
 			continue
 		}
 
-		fmt.Printf("  %s PID %d killed\n", style.Bold.Render("✓"), o.PID)
+		fmt.Printf("  %s PID %d killed\n", style.Bold.Render(ui.Glyph(ui.IconPass)), o.PID)
 		killed++
 	}
 
@@ -958,12 +959,12 @@ func runOrphansKillProcessesAggressive() error {
 	}
 
 	if len(zombies) == 0 {
-		fmt.Printf("%s No orphaned Claude processes found (aggressive mode)\n", style.Bold.Render("✓"))
+		fmt.Printf("%s No orphaned Claude processes found (aggressive mode)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		return nil
 	}
 
 	// Show what we're about to kill
-	fmt.Printf("%s Found %d orphaned Claude process(es) not in any tmux session:\n\n", style.Warning.Render("⚠"), len(zombies))
+	fmt.Printf("%s Found %d orphaned Claude process(es) not in any tmux session:\n\n", style.WarningPrefix, len(zombies))
 	for _, z := range zombies {
 		ageStr := formatProcessAge(z.Age)
 		fmt.Printf("  %s %s (age: %s, tty: %s)\n",
@@ -997,7 +998,7 @@ func runOrphansKillProcessesAggressive() error {
 	for _, z := range zombies {
 		proc, err := os.FindProcess(z.PID)
 		if err != nil {
-			fmt.Printf("  %s PID %d: %v\n", style.Error.Render("✗"), z.PID, err)
+			fmt.Printf("  %s PID %d: %v\n", style.Error.Render(ui.Glyph("✗")), z.PID, err)
 			failed++
 			continue
 		}
@@ -1005,15 +1006,15 @@ func runOrphansKillProcessesAggressive() error {
 		if err := proc.Signal(signal); err != nil {
 			// Process may have already exited
 			if err == os.ErrProcessDone {
-				fmt.Printf("  %s PID %d: already terminated\n", style.Dim.Render("○"), z.PID)
+				fmt.Printf("  %s PID %d: already terminated\n", style.Dim.Render(ui.Glyph("○")), z.PID)
 				continue
 			}
-			fmt.Printf("  %s PID %d: %v\n", style.Error.Render("✗"), z.PID, err)
+			fmt.Printf("  %s PID %d: %v\n", style.Error.Render(ui.Glyph("✗")), z.PID, err)
 			failed++
 			continue
 		}
 
-		fmt.Printf("  %s PID %d killed\n", style.Bold.Render("✓"), z.PID)
+		fmt.Printf("  %s PID %d killed\n", style.Bold.Render(ui.Glyph(ui.IconPass)), z.PID)
 		killed++
 	}
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 var (
@@ -33,7 +34,10 @@ This command aggregates them into permanent daily summaries.
 
 Examples:
   gt patrol digest --yesterday  # Aggregate yesterday's patrol digests
-  gt patrol digest --dry-run    # Preview what would be aggregated`,
+  gt patrol digest --dry-run    # Preview what would be aggregated
+  gt patrol status              # Daemon patrol intervals (incl. adaptive)
+  gt patrol disable wisp_reaper --for 48h --reason "debugging reaper"
+  gt patrol enable wisp_reaper  # Re-enable before the disable expires`,
 }
 
 var patrolDigestCmd = &cobra.Command{
@@ -59,6 +63,7 @@ func init() {
 	patrolCmd.AddCommand(patrolDigestCmd)
 	patrolCmd.AddCommand(patrolNewCmd)
 	patrolCmd.AddCommand(patrolReportCmd)
+	patrolCmd.AddCommand(patrolStatusCmd)
 	rootCmd.AddCommand(patrolCmd)
 
 	// Patrol digest flags
@@ -114,7 +119,7 @@ func runPatrolDigest(cmd *cobra.Command, args []string) error {
 		}
 	} else if existingID != "" {
 		fmt.Printf("%s Patrol digest already exists for %s (bead: %s)\n",
-			style.Dim.Render("○"), dateStr, existingID)
+			style.Dim.Render(ui.Glyph("○")), dateStr, existingID)
 		return nil
 	}
 
@@ -125,7 +130,7 @@ func runPatrolDigest(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(cycles) == 0 {
-		fmt.Printf("%s No patrol digests found for %s\n", style.Dim.Render("○"), dateStr)
+		fmt.Printf("%s No patrol digests found for %s\n", style.Dim.Render(ui.Glyph("○")), dateStr)
 		return nil
 	}
 
@@ -168,7 +173,7 @@ func runPatrolDigest(cmd *cobra.Command, args []string) error {
 		fmt.Fprintf(os.Stderr, "warning: failed to delete some source digests: %v\n", deleteErr)
 	}
 
-	fmt.Printf("%s Created Patrol Report %s (bead: %s)\n", style.Success.Render("✓"), dateStr, digestID)
+	fmt.Printf("%s Created Patrol Report %s (bead: %s)\n", style.SuccessPrefix, dateStr, digestID)
 	fmt.Printf("  Total: %d cycles\n", digest.TotalCycles)
 	for role, count := range digest.ByRole {
 		fmt.Printf("    %s: %d\n", role, count)
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	patrolDisableFor    string
+	patrolDisableUntil  string
+	patrolDisableReason string
+)
+
+var patrolDisableCmd = &cobra.Command{
+	Use:   "disable <patrol>",
+	Short: "Switch a patrol off for a while",
+	Long: `Temporarily disable a daemon patrol. The patrol's config in
+mayor/daemon.json is left alone; the daemon skips the patrol until the
+disable expires and then resumes it on its own, with no restart.
+
+A reason and an expiry are required, so nobody has to remember why a patrol
+was switched off or to turn it back on. --for takes a duration (48h, 7d);
+--until takes a date (2006-01-02) or an RFC3339 timestamp. Disabling a
+disabled patrol replaces its reason and expiry.
+
+Disabled patrols show in gt patrol status and gt doctor. Disables and
+re-enables are recorded in the audit log (gt audit).
+
+Examples:
+  gt patrol disable branch_sweep --for 48h --reason "release freeze"
+  gt patrol disable witness --until 2026-11-02 --reason "gastown migration"
+  gt patrol enable branch_sweep`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPatrolDisable,
+}
+
+var patrolEnableCmd = &cobra.Command{
+	Use:   "enable <patrol>",
+	Short: "Re-enable a temporarily disabled patrol",
+	Long: `Lift a gt patrol disable before it expires.
+
+This only clears temporary disables. A patrol switched off in
+mayor/daemon.json stays off until its config is changed.
+
+Examples:
+  gt patrol enable branch_sweep`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPatrolEnable,
+}
+
+func init() {
+	patrolDisableCmd.Flags().StringVar(&patrolDisableFor, "for", "", "How long to disable the patrol (e.g., 48h, 7d)")
+	patrolDisableCmd.Flags().StringVar(&patrolDisableUntil, "until", "", "When to re-enable the patrol (date or RFC3339 timestamp)")
+	patrolDisableCmd.Flags().StringVarP(&patrolDisableReason, "reason", "r", "", "Why the patrol is disabled (required)")
+	_ = patrolDisableCmd.MarkFlagRequired("reason")
+	patrolDisableCmd.MarkFlagsMutuallyExclusive("for", "until")
+	patrolDisableCmd.MarkFlagsOneRequired("for", "until")
+	patrolCmd.AddCommand(patrolDisableCmd)
+	patrolCmd.AddCommand(patrolEnableCmd)
+}
+
+func runPatrolDisable(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	name := args[0]
+	if err := checkPatrolName(townRoot, name); err != nil {
+		return err
+	}
+	reason := strings.TrimSpace(patrolDisableReason)
+	if reason == "" {
+		return fmt.Errorf("--reason must not be empty")
+	}
+
+	now := time.Now()
+	var until time.Time
+	if patrolDisableFor != "" {
+		d, err := parseDuration(patrolDisableFor)
+		if err != nil || d <= 0 {
+			return fmt.Errorf("invalid --for %q (want a duration like 48h or 7d)", patrolDisableFor)
+		}
+		until = now.Add(d)
+	} else {
+		if until, err = parseAckUntil(patrolDisableUntil, now); err != nil {
+			return err
+		}
+	}
+	if !until.After(now) {
+		return fmt.Errorf("--until %s is in the past", patrolDisableUntil)
+	}
+
+	actor := detectActor()
+	err = daemon.UpdatePatrolDisables(townRoot, func(disables map[string]daemon.PatrolDisable) error {
+		disables[name] = daemon.PatrolDisable{
+			Patrol: name,
+			Reason: reason,
+			By:     actor,
+			At:     now.UTC(),
+			Until:  until.UTC(),
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("saving patrol disables: %w", err)
+	}
+	_ = events.LogAudit(events.TypePatrolDisabled, actor, events.PatrolDisablePayload(name, reason, until))
+
+	fmt.Printf("%s Disabled patrol %s until %s\n", style.SuccessPrefix, style.Bold.Render(name), until.Local().Format("2006-01-02 15:04"))
+	if !daemon.IsPatrolEnabled(daemon.LoadPatrolConfig(townRoot), name) {
+		fmt.Printf("  %s\n", style.Dim.Render("(also disabled in mayor/daemon.json; it stays off after this expires)"))
+	}
+	return nil
+}
+
+func runPatrolEnable(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	name := args[0]
+	err = daemon.UpdatePatrolDisables(townRoot, func(disables map[string]daemon.PatrolDisable) error {
+		dis, ok := disables[name]
+		if !ok || !dis.Active(time.Now()) {
+			if !daemon.IsPatrolEnabled(daemon.LoadPatrolConfig(townRoot), name) {
+				return fmt.Errorf("patrol %s is disabled in mayor/daemon.json, not by gt patrol disable", name)
+			}
+			return fmt.Errorf("patrol %s is not disabled", name)
+		}
+		delete(disables, name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	_ = events.LogAudit(events.TypePatrolEnabled, detectActor(), events.PatrolDisablePayload(name, "", time.Time{}))
+
+	fmt.Printf("%s Re-enabled patrol %s\n", style.SuccessPrefix, style.Bold.Render(name))
+	return nil
+}
+
+// checkPatrolName rejects names that aren't daemon patrols.
+func checkPatrolName(townRoot, name string) error {
+	names := append([]string{}, heartbeatPatrols...)
+	for _, e := range daemon.PatrolSchedule(townRoot, daemon.LoadPatrolConfig(townRoot)) {
+		names = append(names, e.Name)
+	}
+	for _, n := range names {
+		if n == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown patrol %q (want one of: %s)", name, strings.Join(names, ", "))
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/cli"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -268,14 +270,14 @@ func outputPatrolContext(cfg PatrolConfig) {
 		patrolID, err = autoSpawnPatrol(cfg)
 		if err != nil {
 			if patrolID != "" {
-				fmt.Printf("⚠ %s\n", err.Error())
+				fmt.Printf("%s %s\n", ui.Glyph(ui.IconWarn), err.Error())
 			} else {
 				fmt.Println(style.Dim.Render(err.Error()))
 				fmt.Println(style.Dim.Render("Run `" + cli.Name() + " formula list` to troubleshoot."))
 				return
 			}
 		} else {
-			fmt.Printf("✓ Created and hooked patrol wisp: %s\n", patrolID)
+			fmt.Printf("%s Created and hooked patrol wisp: %s\n", ui.Glyph(ui.IconPass), patrolID)
 		}
 	} else {
 		// Has active patrol - show status
@@ -294,3 +296,38 @@ func outputPatrolContext(cfg PatrolConfig) {
 		fmt.Printf("Current patrol ID: %s\n", patrolID)
 	}
 }
+
+// patrolIdentity maps a role to its patrol name (the daemon.json entry) and
+// patrol agent identity (the patrol wisp assignee). ok is false for roles
+// that do not patrol.
+func patrolIdentity(roleInfo RoleInfo) (patrol, agent string, ok bool) {
+	switch roleInfo.Role {
+	case RoleDeacon:
+		return "deacon", "deacon", true
+	case RoleWitness:
+		return "witness", roleInfo.Rig + "/witness", true
+	case RoleRefinery:
+		return "refinery", roleInfo.Rig + "/refinery", true
+	}
+	return "", "", false
+}
+
+// patrolDryRunWisp returns the patrol wisp agent's run marker names, if that
+// wisp is still hooked to the agent; a marker for a finished cycle is
+// removed. If the wisp cannot be read the marker is trusted, so a dry run
+// stays read-only.
+func patrolDryRunWisp(townRoot, agent string) string {
+	wispID, ok := config.PatrolRunDryRun(townRoot, agent)
+	if !ok || wispID == "" {
+		return ""
+	}
+	issue, err := beads.New(resolveBeadDir(wispID)).Show(wispID)
+	if err != nil {
+		return wispID
+	}
+	if issue.Status != beads.StatusHooked || issue.Assignee != agent {
+		_ = config.ClearPatrolRunDryRun(townRoot, agent)
+		return ""
+	}
+	return wispID
+}
@@ -5,10 +5,19 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/style"
 )
 
-var patrolNewRole string
+// patrolDryRunLabel marks patrol wisps created with --dry-run.
+const patrolDryRunLabel = "gt:dry-run"
+
+var (
+	patrolNewRole   string
+	patrolNewDryRun bool
+)
 
 var patrolNewCmd = &cobra.Command{
 	Use:   "new",
@@ -22,14 +31,22 @@ For refinery patrols, MQ config variables (run_tests, test_command,
 target_branch, etc.) are read from the rig's config.json and settings/config.json and
 passed as --var args to the wisp.
 
+With --dry-run the cycle is read-only: the agent is told (on its next prime)
+to observe and report without acting, the dry-run guard refuses mutating
+tool use, and the cycle's patrol report is labeled advisory. The mode ends
+when the cycle is reported. To run every cycle of a patrol dry, set
+"dry_run": true on its entry in mayor/daemon.json.
+
 Examples:
   gt patrol new                  # Auto-detect role, create patrol
-  gt patrol new --role refinery  # Explicitly create refinery patrol`,
+  gt patrol new --role refinery  # Explicitly create refinery patrol
+  gt patrol new --dry-run        # Trial this cycle read-only`,
 	RunE: runPatrolNew,
 }
 
 func init() {
 	patrolNewCmd.Flags().StringVar(&patrolNewRole, "role", "", "Role override (deacon, witness, refinery)")
+	patrolNewCmd.Flags().BoolVar(&patrolNewDryRun, "dry-run", false, "Run this cycle read-only and mark its report advisory")
 }
 
 func runPatrolNew(cmd *cobra.Command, args []string) error {
@@ -87,6 +104,28 @@ func runPatrolNew(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if patrolNewDryRun {
+		if err := markPatrolDryRun(roleInfo.TownRoot, cfg, patrolID); err != nil {
+			return fmt.Errorf("marking %s as a dry run: %w", patrolID, err)
+		}
+	} else if err := config.ClearPatrolRunDryRun(roleInfo.TownRoot, cfg.Assignee); err != nil {
+		// A marker from an earlier --dry-run cycle must not carry over.
+		style.PrintWarning("could not clear dry-run marker: %v", err)
+	}
+
 	fmt.Println(patrolID)
 	return nil
 }
+
+// markPatrolDryRun makes the patrol cycle patrolID a dry run: a run marker
+// for the guard and prime, and a label on the wisp for the record.
+func markPatrolDryRun(townRoot string, cfg PatrolConfig, patrolID string) error {
+	if err := config.MarkPatrolRunDryRun(townRoot, cfg.Assignee, patrolID); err != nil {
+		return err
+	}
+	b := beads.New(cfg.BeadsDir)
+	if err := b.Update(patrolID, beads.UpdateOptions{AddLabels: []string{patrolDryRunLabel}}); err != nil {
+		style.PrintWarning("could not label %s as a dry run: %v", patrolID, err)
+	}
+	return nil
+}
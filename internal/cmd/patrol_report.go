@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/style"
 )
@@ -23,6 +24,8 @@ This replaces the old squash+new pattern with a single command that:
   2. Creates a new patrol wisp for the next cycle
 
 The summary is stored on the patrol root wisp for audit purposes.
+Reports from dry-run cycles (see 'gt patrol new --dry-run') are marked
+advisory: the summary says so and the wisp is labeled gt:advisory.
 
 Examples:
   gt patrol report --summary "All clear, no issues"
@@ -85,11 +88,18 @@ func runPatrolReport(cmd *cobra.Command, args []string) error {
 	// Close the current patrol root with the summary
 	b := beads.New(cfg.BeadsDir)
 
-	// Update the description with the patrol summary
+	// Update the description with the patrol summary. Dry-run reports are
+	// advisory: nothing was acted on, so readers must not assume it was.
 	desc := fmt.Sprintf("Patrol report: %s", patrolReportSummary)
-	if err := b.Update(patrolID, beads.UpdateOptions{
-		Description: &desc,
-	}); err != nil {
+	reason := "patrol cycle complete: " + patrolReportSummary
+	update := beads.UpdateOptions{Description: &desc}
+	dryRun := config.IsPatrolDryRun(roleInfo.TownRoot, cfg.RoleName, cfg.Assignee, patrolID)
+	if dryRun {
+		desc = fmt.Sprintf("Patrol report (ADVISORY, dry run; no actions taken): %s", patrolReportSummary)
+		reason = "patrol dry run complete (advisory): " + patrolReportSummary
+		update.AddLabels = []string{config.PatrolAdvisoryLabel}
+	}
+	if err := b.Update(patrolID, update); err != nil {
 		style.PrintWarning("could not update patrol summary: %v", err)
 	}
 
@@ -98,11 +108,19 @@ func runPatrolReport(cmd *cobra.Command, args []string) error {
 	forceCloseDescendants(b, patrolID)
 
 	// Close the patrol root
-	if err := b.ForceCloseWithReason("patrol cycle complete: "+patrolReportSummary, patrolID); err != nil {
+	if err := b.ForceCloseWithReason(reason, patrolID); err != nil {
 		return fmt.Errorf("closing patrol %s: %w", patrolID, err)
 	}
 
-	fmt.Printf("%s Closed patrol %s\n", style.Success.Render("✓"), patrolID)
+	if dryRun {
+		// A --dry-run marker covers one cycle; configured dry runs persist.
+		if err := config.ClearPatrolRunDryRun(roleInfo.TownRoot, cfg.Assignee); err != nil {
+			style.PrintWarning("could not clear dry-run marker: %v", err)
+		}
+		fmt.Printf("%s Closed patrol %s %s\n", style.SuccessPrefix, patrolID, style.Warning.Render("(advisory: dry run)"))
+	} else {
+		fmt.Printf("%s Closed patrol %s\n", style.SuccessPrefix, patrolID)
+	}
 
 	// Start next cycle
 	newPatrolID, err := autoSpawnPatrol(cfg)
@@ -115,6 +133,6 @@ func runPatrolReport(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("starting next patrol cycle: %w", err)
 	}
 
-	fmt.Printf("%s Started new patrol: %s\n", style.Success.Render("✓"), newPatrolID)
+	fmt.Printf("%s Started new patrol: %s\n", style.SuccessPrefix, newPatrolID)
 	return nil
 }
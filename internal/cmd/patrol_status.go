@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var patrolStatusJSON bool
+
+var patrolStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show daemon patrol schedules and effective intervals",
+	Long: `Show each ticker-driven daemon patrol, whether it is enabled, and how often
+it runs.
+
+With adaptive scheduling enabled ("adaptive" in mayor/daemon.json), patrols
+that report outcomes stretch their interval after repeated empty runs and
+tighten it after repeated productive ones, within configured bounds. The
+effective column shows the interval the daemon is currently using.
+
+With jitter enabled ("jitter" in mayor/daemon.json), each interval moves
+randomly by up to a percentage and each patrol's first run is offset, so
+patrols sharing an interval don't wake together. The drift column shows
+how late the last tick fired against its scheduled interval; drift beyond
+10% (at least 10s) is flagged, and usually means a slow patrol blocked the
+daemon loop. The recovery heartbeat is reported the same way.
+
+Patrols switched off with gt patrol disable show as paused, and are listed
+with their reason and expiry below the table.
+
+Examples:
+  gt patrol status
+  gt patrol status --json`,
+	Args: cobra.NoArgs,
+	RunE: runPatrolStatus,
+}
+
+func init() {
+	patrolStatusCmd.Flags().BoolVar(&patrolStatusJSON, "json", false, "Output as JSON")
+}
+
+func runPatrolStatus(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	config := daemon.LoadPatrolConfig(townRoot)
+	entries := daemon.PatrolSchedule(townRoot, config)
+
+	if patrolStatusJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+
+	adaptive := config != nil && config.Adaptive != nil && config.Adaptive.Enabled
+	fmt.Printf("%-22s %-9s %-10s %-10s %-10s %s\n", "PATROL", "STATE", "INTERVAL", "EFFECTIVE", "DRIFT", "")
+	for _, e := range entries {
+		state := style.Dim.Render("disabled")
+		if e.Disable != nil {
+			state = style.Warning.Render("paused  ")
+		} else if e.Enabled {
+			state = style.Success.Render("enabled ")
+		}
+		note := ""
+		if t := e.Tuning; t != nil {
+			note = fmt.Sprintf("adaptive %s–%s", formatPatrolInterval(t.Min), formatPatrolInterval(t.Max))
+			if !t.LastRun.IsZero() {
+				note += fmt.Sprintf(", last run found %d (%s)", t.LastFound, t.LastRun.Local().Format("01-02 15:04"))
+			}
+			note = style.Dim.Render(note)
+		}
+		fmt.Printf("%-22s %s  %-10s %-10s %s %s\n", e.Name, state, formatPatrolInterval(e.Interval), formatPatrolInterval(e.Effective), formatPatrolDrift(e.Timing), note)
+	}
+	if timing, err := daemon.LoadPatrolTiming(townRoot); err == nil {
+		if hb, ok := timing["heartbeat"]; ok {
+			fmt.Printf("%-22s %s  %-10s %-10s %s\n", "heartbeat", style.Success.Render("enabled "),
+				formatPatrolInterval(hb.Configured), formatPatrolInterval(hb.Configured), formatPatrolDrift(&hb))
+		}
+	}
+	printPatrolDisables(townRoot)
+	if !adaptive {
+		fmt.Println()
+		fmt.Println(style.Dim.Render("Adaptive scheduling is off. Enable it with \"adaptive\": {\"enabled\": true} in mayor/daemon.json."))
+	}
+	return nil
+}
+
+// printPatrolDisables lists patrols switched off by gt patrol disable.
+func printPatrolDisables(townRoot string) {
+	disables, err := daemon.LoadPatrolDisables(townRoot)
+	if err != nil {
+		style.PrintWarning("%v", err)
+		return
+	}
+	now := time.Now()
+	names := make([]string, 0, len(disables))
+	for name, d := range disables {
+		if d.Active(now) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	sort.Strings(names)
+	fmt.Println()
+	fmt.Println(style.Bold.Render("Temporarily disabled:"))
+	for _, name := range names {
+		d := disables[name]
+		note := d.Reason
+		if d.By != "" {
+			note += " (" + d.By + ")"
+		}
+		fmt.Printf("  %-20s until %s  %s\n", name, d.Until.Local().Format("2006-01-02 15:04"), style.Dim.Render(note))
+	}
+	fmt.Println(style.Dim.Render("Re-enable early with: gt patrol enable <patrol>"))
+}
+
+// formatPatrolInterval renders whole-unit durations compactly ("30m" not "30m0s").
+func formatPatrolInterval(d time.Duration) string {
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d >= time.Minute && d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return d.String()
+	}
+}
+
+// formatPatrolDrift renders the last tick's drift, padded to the DRIFT
+// column, flagging drift beyond tolerance.
+func formatPatrolDrift(t *daemon.PatrolTiming) string {
+	if t == nil || t.Ticks == 0 {
+		return style.Dim.Render(fmt.Sprintf("%-10s", "-"))
+	}
+	text := fmt.Sprintf("%-10s", signedDuration(t.LastDrift))
+	if t.Drifting() {
+		return style.Warning.Render(text) + style.Dim.Render(" (max "+signedDuration(t.MaxDrift)+")")
+	}
+	return text
+}
+
+// signedDuration renders d to the second with an explicit sign.
+func signedDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d >= 0 {
+		return "+" + d.String()
+	}
+	return d.String()
+}
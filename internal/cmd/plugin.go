@@ -12,6 +12,7 @@ import (
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/plugin"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -198,14 +199,14 @@ func outputPluginListJSON(plugins []*plugin.Plugin) error {
 
 func outputPluginListText(plugins []*plugin.Plugin, townRoot string) error {
 	if len(plugins) == 0 {
-		fmt.Printf("%s No plugins discovered\n", style.Dim.Render("○"))
+		fmt.Printf("%s No plugins discovered\n", style.Dim.Render(ui.Glyph("○")))
 		fmt.Printf("\n  Plugin directories:\n")
 		fmt.Printf("    %s/plugins/\n", townRoot)
 		fmt.Printf("\n  Create a plugin by adding a directory with plugin.md\n")
 		return nil
 	}
 
-	fmt.Printf("%s Discovered %d plugin(s)\n\n", style.Success.Render("●"), len(plugins))
+	fmt.Printf("%s Discovered %d plugin(s)\n\n", style.Success.Render(ui.Glyph("●")), len(plugins))
 
 	// Group by location
 	townPlugins := make([]*plugin.Plugin, 0)
@@ -417,7 +418,7 @@ func runPluginRun(cmd *cobra.Command, args []string) error {
 	}
 
 	if !gateOpen && !pluginRunForce {
-		fmt.Printf("%s Gate closed: %s\n", style.Warning.Render("⚠"), gateReason)
+		fmt.Printf("%s Gate closed: %s\n", style.WarningPrefix, gateReason)
 		fmt.Printf("  Use --force to bypass gate check\n")
 		return nil
 	}
@@ -425,7 +426,7 @@ func runPluginRun(cmd *cobra.Command, args []string) error {
 	// Execute the plugin
 	// For manual runs, we print the instructions for the agent/user to execute
 	// Automatic execution via dogs is handled by gt-n08ix.2
-	fmt.Printf("%s Running plugin: %s\n", style.Success.Render("●"), p.Name)
+	fmt.Printf("%s Running plugin: %s\n", style.Success.Render(ui.Glyph("●")), p.Name)
 	if pluginRunForce && !gateOpen {
 		fmt.Printf("  %s\n", style.Dim.Render("(gate bypassed with --force)"))
 	}
@@ -444,7 +445,7 @@ func runPluginRun(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: failed to record run: %v\n", err)
 	} else {
-		fmt.Printf("\n%s Recorded run: %s\n", style.Dim.Render("●"), beadID)
+		fmt.Printf("\n%s Recorded run: %s\n", style.Dim.Render(ui.Glyph("●")), beadID)
 	}
 
 	return nil
@@ -480,21 +481,21 @@ func runPluginHistory(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(runs) == 0 {
-		fmt.Printf("%s No execution history for plugin: %s\n", style.Dim.Render("○"), name)
+		fmt.Printf("%s No execution history for plugin: %s\n", style.Dim.Render(ui.Glyph("○")), name)
 		return nil
 	}
 
-	fmt.Printf("%s Execution history for %s (%d runs)\n\n", style.Success.Render("●"), name, len(runs))
+	fmt.Printf("%s Execution history for %s (%d runs)\n\n", style.Success.Render(ui.Glyph("●")), name, len(runs))
 
 	for _, run := range runs {
 		resultStyle := style.Success
-		resultIcon := "✓"
+		resultIcon := ui.Glyph(ui.IconPass)
 		if run.Result == plugin.ResultFailure {
 			resultStyle = style.Error
-			resultIcon = "✗"
+			resultIcon = ui.Glyph("✗")
 		} else if run.Result == plugin.ResultSkipped {
 			resultStyle = style.Dim
-			resultIcon = "○"
+			resultIcon = ui.Glyph("○")
 		}
 
 		fmt.Printf("  %s %s  %s\n",
@@ -17,6 +17,7 @@ import (
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/util"
 )
 
@@ -491,9 +492,9 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s\n\n", style.Bold.Render("Polecats"))
 	for _, p := range allPolecats {
 		// Session indicator
-		sessionStatus := style.Dim.Render("○")
+		sessionStatus := style.Dim.Render(ui.Glyph("○"))
 		if p.SessionRunning {
-			sessionStatus = style.Success.Render("●")
+			sessionStatus = style.Success.Render(ui.Glyph("●"))
 		}
 
 		// Display actual state, reconciled with tmux session liveness.
@@ -600,7 +601,7 @@ func runPolecatRemove(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
-		fmt.Printf("  %s removed\n", style.Success.Render("✓"))
+		fmt.Printf("  %s removed\n", style.SuccessPrefix)
 		removed++
 	}
 
@@ -1058,12 +1059,12 @@ func runPolecatCheckRecovery(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  Verdict:         %s\n", style.Warning.Render("NEEDS_MQ_SUBMIT"))
 		fmt.Printf("  MQ Status:       %s\n", status.MQStatus)
 		fmt.Println()
-		fmt.Printf("  %s Work is pushed but was never submitted to the merge queue.\n", style.Warning.Render("⚠"))
+		fmt.Printf("  %s Work is pushed but was never submitted to the merge queue.\n", style.WarningPrefix)
 		fmt.Println("  Submit to MQ before cleanup, or the branch will be orphaned.")
 	case "NEEDS_RECOVERY":
 		fmt.Printf("  Verdict:         %s\n", style.Error.Render("NEEDS_RECOVERY"))
 		fmt.Println()
-		fmt.Printf("  %s This polecat has unpushed/uncommitted work.\n", style.Warning.Render("⚠"))
+		fmt.Printf("  %s This polecat has unpushed/uncommitted work.\n", style.WarningPrefix)
 		fmt.Println("  Escalate to Mayor for recovery before cleanup.")
 	default:
 		fmt.Printf("  Verdict:         %s\n", style.Success.Render("SAFE_TO_NUKE"))
@@ -1071,7 +1072,7 @@ func runPolecatCheckRecovery(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  MQ Status:       %s\n", status.MQStatus)
 		}
 		fmt.Println()
-		fmt.Printf("  %s Safe to nuke - no work at risk.\n", style.Success.Render("✓"))
+		fmt.Printf("  %s Safe to nuke - no work at risk.\n", style.SuccessPrefix)
 	}
 
 	return nil
@@ -1199,7 +1200,7 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 		}
 
 		if polecatNukeForce {
-			fmt.Printf("%s Nuking %s/%s (--force)...\n", style.Warning.Render("⚠"), p.rigName, p.polecatName)
+			fmt.Printf("%s Nuking %s/%s (--force)...\n", style.WarningPrefix, p.rigName, p.polecatName)
 		} else {
 			fmt.Printf("Nuking %s/%s...\n", p.rigName, p.polecatName)
 		}
@@ -1214,7 +1215,7 @@ func runPolecatNuke(cmd *cobra.Command, args []string) error {
 
 	// Report results
 	if polecatNukeDryRun {
-		fmt.Printf("\n%s Would nuke %d polecat(s).\n", style.Info.Render("ℹ"), len(targets))
+		fmt.Printf("\n%s Would nuke %d polecat(s).\n", style.Info.Render(ui.Glyph(ui.IconInfo)), len(targets))
 		return nil
 	}
 
@@ -1256,10 +1257,10 @@ func nukePolecatFull(polecatName, rigName string, mgr *polecat.Manager, r *rig.R
 	sessMgr := polecat.NewSessionManager(t, r)
 	if err := sessMgr.Stop(polecatName, true); err != nil {
 		if !errors.Is(err, polecat.ErrSessionNotFound) {
-			fmt.Printf("  %s session kill failed: %v\n", style.Warning.Render("⚠"), err)
+			fmt.Printf("  %s session kill failed: %v\n", style.WarningPrefix, err)
 		}
 	} else {
-		fmt.Printf("  %s killed session\n", style.Success.Render("✓"))
+		fmt.Printf("  %s killed session\n", style.SuccessPrefix)
 	}
 
 	// Step 2: Get polecat info before deletion (for branch name + hooked work bead)
@@ -1298,9 +1299,9 @@ func nukePolecatFull(polecatName, rigName string, mgr *polecat.Manager, r *rig.R
 		if pushGit != nil {
 			refspec := branchToDelete + ":" + branchToDelete
 			if err := pushGit.Push("origin", refspec, false); err != nil {
-				fmt.Printf("  %s best-effort push failed (proceeding): %v\n", style.Dim.Render("○"), err)
+				fmt.Printf("  %s best-effort push failed (proceeding): %v\n", style.Dim.Render(ui.Glyph("○")), err)
 			} else {
-				fmt.Printf("  %s pushed branch %s before nuke\n", style.Success.Render("✓"), branchToDelete)
+				fmt.Printf("  %s pushed branch %s before nuke\n", style.SuccessPrefix, branchToDelete)
 			}
 		}
 	}
@@ -1308,12 +1309,12 @@ func nukePolecatFull(polecatName, rigName string, mgr *polecat.Manager, r *rig.R
 	// Step 3: Delete worktree (nuclear=true to bypass safety checks for stale polecats)
 	if err := mgr.RemoveWithOptions(polecatName, true, true, false); err != nil {
 		if errors.Is(err, polecat.ErrPolecatNotFound) {
-			fmt.Printf("  %s worktree already gone\n", style.Dim.Render("○"))
+			fmt.Printf("  %s worktree already gone\n", style.Dim.Render(ui.Glyph("○")))
 		} else {
 			return fmt.Errorf("worktree removal failed: %w", err)
 		}
 	} else {
-		fmt.Printf("  %s deleted worktree\n", style.Success.Render("✓"))
+		fmt.Printf("  %s deleted worktree\n", style.SuccessPrefix)
 	}
 
 	// Step 4: Delete local branch (if we know it)
@@ -1325,11 +1326,11 @@ func nukePolecatFull(polecatName, rigName string, mgr *polecat.Manager, r *rig.R
 	if branchToDelete != "" {
 		repoGit := getRepoGitForRig(r.Path)
 		if err := repoGit.DeleteBranch(branchToDelete, true); err != nil {
-			fmt.Printf("  %s branch delete: %v\n", style.Dim.Render("○"), err)
+			fmt.Printf("  %s branch delete: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 		} else {
-			fmt.Printf("  %s deleted local branch %s\n", style.Success.Render("✓"), branchToDelete)
+			fmt.Printf("  %s deleted local branch %s\n", style.SuccessPrefix, branchToDelete)
 		}
-		fmt.Printf("  %s remote branch preserved for refinery merge\n", style.Dim.Render("○"))
+		fmt.Printf("  %s remote branch preserved for refinery merge\n", style.Dim.Render(ui.Glyph("○")))
 	}
 
 	// Step 5: Reset agent bead for reuse (if exists)
@@ -1344,9 +1345,9 @@ func nukePolecatFull(polecatName, rigName string, mgr *polecat.Manager, r *rig.R
 	bd := beads.New(r.Path)
 	if err := bd.ResetAgentBeadForReuse(agentBeadID, "nuked"); err != nil {
 		// Bead may not exist (first spawn failed, or test environment)
-		fmt.Printf("  %s agent bead not found or already cleaned\n", style.Dim.Render("○"))
+		fmt.Printf("  %s agent bead not found or already cleaned\n", style.Dim.Render(ui.Glyph("○")))
 	} else {
-		fmt.Printf("  %s closed agent bead %s\n", style.Success.Render("✓"), agentBeadID)
+		fmt.Printf("  %s closed agent bead %s\n", style.SuccessPrefix, agentBeadID)
 	}
 
 	return nil
@@ -1366,7 +1367,7 @@ func nukeCleanupMolecules(workBeadID string, r *rig.Rig) {
 	issue, err := bd.Show(workBeadID)
 	if err != nil {
 		fmt.Printf("  %s molecule cleanup: could not fetch work bead %s: %v\n",
-			style.Dim.Render("○"), workBeadID, err)
+			style.Dim.Render(ui.Glyph("○")), workBeadID, err)
 		return
 	}
 
@@ -1388,7 +1389,7 @@ func nukeCleanupMolecules(workBeadID string, r *rig.Rig) {
 		Reason:    "polecat nuked: cleaning stale molecule",
 	}); detachErr != nil {
 		fmt.Printf("  %s molecule detach failed for %s: %v\n",
-			style.Warning.Render("⚠"), moleculeID, detachErr)
+			style.WarningPrefix, moleculeID, detachErr)
 		return
 	}
 
@@ -1397,17 +1398,17 @@ func nukeCleanupMolecules(workBeadID string, r *rig.Rig) {
 	// and every sling attempt fails with "bead has existing molecule(s)".
 	if err := bd.RemoveDependency(workBeadID, moleculeID); err != nil {
 		fmt.Printf("  %s molecule bond removal failed for %s → %s: %v\n",
-			style.Warning.Render("⚠"), workBeadID, moleculeID, err)
+			style.WarningPrefix, workBeadID, moleculeID, err)
 		// Non-fatal: detach already cleared the description pointer.
 	}
 
 	// Force-close the orphaned wisp root so it doesn't linger
 	if closeErr := bd.ForceCloseWithReason("burned: polecat nuked", moleculeID); closeErr != nil {
 		fmt.Printf("  %s molecule root close failed for %s: %v\n",
-			style.Warning.Render("⚠"), moleculeID, closeErr)
+			style.WarningPrefix, moleculeID, closeErr)
 	} else {
 		fmt.Printf("  %s burned stale molecule %s from work bead %s\n",
-			style.Success.Render("✓"), moleculeID, workBeadID)
+			style.SuccessPrefix, moleculeID, workBeadID)
 	}
 }
 
@@ -1417,7 +1418,7 @@ func cleanupOrphanedProcesses() {
 	results, err := util.CleanupZombieClaudeProcesses()
 	if err != nil {
 		// Non-fatal: log and continue
-		fmt.Printf("  %s orphan cleanup check failed: %v\n", style.Dim.Render("○"), err)
+		fmt.Printf("  %s orphan cleanup check failed: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 		return
 	}
 
@@ -1437,10 +1438,10 @@ func cleanupOrphanedProcesses() {
 	}
 
 	if killed > 0 {
-		fmt.Printf("  %s cleaned up %d orphaned process(es)\n", style.Success.Render("✓"), killed)
+		fmt.Printf("  %s cleaned up %d orphaned process(es)\n", style.SuccessPrefix, killed)
 	}
 	if escalated > 0 {
-		fmt.Printf("  %s %d process(es) survived SIGKILL (unkillable)\n", style.Warning.Render("⚠"), escalated)
+		fmt.Printf("  %s %d process(es) survived SIGKILL (unkillable)\n", style.WarningPrefix, escalated)
 	}
 }
 
@@ -1480,10 +1481,10 @@ func runPolecatStale(cmd *cobra.Command, args []string) error {
 
 	// Display results
 	for _, info := range staleInfos {
-		statusIcon := style.Success.Render("●")
+		statusIcon := style.Success.Render(ui.Glyph("●"))
 		statusText := "active"
 		if info.IsStale {
-			statusIcon = style.Warning.Render("○")
+			statusIcon = style.Warning.Render(ui.Glyph("○"))
 			statusText = "stale"
 		}
 
@@ -1580,7 +1581,7 @@ func runPolecatPrune(cmd *cobra.Command, args []string) error {
 
 	// First, prune stale remote-tracking refs so we detect deleted remote branches
 	if err := repoGit.FetchPrune("origin"); err != nil {
-		fmt.Printf("  %s fetch --prune: %v (continuing anyway)\n", style.Warning.Render("⚠"), err)
+		fmt.Printf("  %s fetch --prune: %v (continuing anyway)\n", style.WarningPrefix, err)
 	}
 
 	// Prune local branches that are merged or have no remote
@@ -1597,7 +1598,7 @@ func runPolecatPrune(cmd *cobra.Command, args []string) error {
 			verb = "Would prune"
 		}
 		for _, b := range pruned {
-			fmt.Printf("  %s %s (%s)\n", style.Success.Render("✓"), b.Name, b.Reason)
+			fmt.Printf("  %s %s (%s)\n", style.SuccessPrefix, b.Name, b.Reason)
 		}
 		fmt.Printf("\n%s %d local branch(es).\n", verb, len(pruned))
 	}
@@ -1629,9 +1630,9 @@ func runPolecatPrune(cmd *cobra.Command, args []string) error {
 				fmt.Printf("  Would delete remote: %s\n", style.Dim.Render(branch))
 			} else {
 				if delErr := repoGit.DeleteRemoteBranch("origin", branch); delErr != nil {
-					fmt.Printf("  %s remote %s: %v\n", style.Warning.Render("⚠"), branch, delErr)
+					fmt.Printf("  %s remote %s: %v\n", style.WarningPrefix, branch, delErr)
 				} else {
-					fmt.Printf("  %s deleted remote %s\n", style.Success.Render("✓"), branch)
+					fmt.Printf("  %s deleted remote %s\n", style.SuccessPrefix, branch)
 				}
 			}
 			remotePruned++
@@ -1721,14 +1722,14 @@ func runPolecatPoolInit(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(namesToCreate) == 0 {
-		fmt.Printf("\n%s Pool already at target size (%d polecats).\n", style.Bold.Render("✓"), len(existing))
+		fmt.Printf("\n%s Pool already at target size (%d polecats).\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(existing))
 		return nil
 	}
 
 	if polecatPoolInitDryRun {
 		fmt.Printf("\nWould create %d polecat(s):\n", len(namesToCreate))
 		for _, name := range namesToCreate {
-			fmt.Printf("  %s %s\n", style.Dim.Render("→"), name)
+			fmt.Printf("  %s %s\n", style.Dim.Render(ui.Glyph("→")), name)
 		}
 		return nil
 	}
@@ -1737,7 +1738,7 @@ func runPolecatPoolInit(cmd *cobra.Command, args []string) error {
 	fmt.Printf("\nCreating %d polecat(s)...\n", len(namesToCreate))
 	created := 0
 	for _, name := range namesToCreate {
-		fmt.Printf("  %s Creating %s...", style.Dim.Render("→"), name)
+		fmt.Printf("  %s Creating %s...", style.Dim.Render(ui.Glyph("→")), name)
 		p, addErr := mgr.Add(name)
 		if addErr != nil {
 			fmt.Printf(" %s %v\n", style.Warning.Render("FAILED"), addErr)
@@ -1745,15 +1746,15 @@ func runPolecatPoolInit(cmd *cobra.Command, args []string) error {
 		}
 		// Set agent state to idle (polecat was created without work)
 		if stateErr := mgr.SetAgentState(name, "idle"); stateErr != nil {
-			fmt.Printf(" %s (created but couldn't set idle state: %v)\n", style.Warning.Render("⚠"), stateErr)
+			fmt.Printf(" %s (created but couldn't set idle state: %v)\n", style.WarningPrefix, stateErr)
 		} else {
-			fmt.Printf(" %s (%s)\n", style.Success.Render("✓"), style.Dim.Render(p.ClonePath))
+			fmt.Printf(" %s (%s)\n", style.SuccessPrefix, style.Dim.Render(p.ClonePath))
 		}
 		created++
 	}
 
 	fmt.Printf("\n%s Pool initialized: %d created, %d total (target: %d)\n",
-		style.Bold.Render("✓"), created, created+len(existing), poolSize)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), created, created+len(existing), poolSize)
 
 	return nil
 }
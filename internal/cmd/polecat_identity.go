@@ -19,6 +19,7 @@ import (
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // Polecat identity command flags
@@ -337,9 +338,9 @@ func runPolecatIdentityList(cmd *cobra.Command, args []string) error {
 
 	for _, info := range identities {
 		// Status indicators
-		sessionIcon := style.Dim.Render("○")
+		sessionIcon := style.Dim.Render(ui.Glyph("○"))
 		if info.SessionRunning {
-			sessionIcon = style.Success.Render("●")
+			sessionIcon = style.Success.Render(ui.Glyph("●"))
 		}
 
 		worktreeIcon := ""
@@ -619,7 +620,7 @@ func runPolecatIdentityRename(cmd *cobra.Command, args []string) error {
 	fmt.Printf("  Old: %s\n", oldBeadID)
 	fmt.Printf("  New: %s\n", newBeadID)
 	fmt.Printf("\n%s Note: If a worktree exists for %s, you'll need to recreate it with the new name.\n",
-		style.Warning.Render("⚠"), oldName)
+		style.WarningPrefix, oldName)
 
 	return nil
 }
@@ -694,7 +695,7 @@ func runPolecatIdentityRemove(cmd *cobra.Command, args []string) error {
 		}
 		if cvCount > 0 {
 			fmt.Printf("%s Warning: This polecat has %d completed work item(s) in CV.\n",
-				style.Warning.Render("⚠"), cvCount)
+				style.WarningPrefix, cvCount)
 		}
 	}
 
@@ -12,11 +12,13 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/experiment"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -32,8 +34,10 @@ type SpawnedPolecatInfo struct {
 	Branch      string // Git branch name (for cleanup on rollback)
 
 	// Internal fields for deferred session start
-	account string
-	agent   string
+	account    string
+	agent      string
+	hookBead   string
+	experiment string // A/B variant tag ("<experiment>/<variant>"), if assigned
 }
 
 // AgentID returns the agent identifier (e.g., "gastown/polecats/Toast")
@@ -154,10 +158,29 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 		}
 	}
 
+	// A/B experiments: assign the wisp a variant before choosing a polecat so
+	// model variants apply to both reused and fresh polecats.
+	var experimentTag string
+	if exp, variant := assignExperimentVariant(townRoot, rigName, opts.HookBead, opts.Agent); exp != nil {
+		experimentTag = exp.Tag(variant)
+		if exp.Kind == experiment.KindModel {
+			opts.Agent = variant.Value
+		}
+		fmt.Printf("  Experiment %s: variant %s\n", exp.Name, variant.Name)
+	}
+
 	// Persistent polecat model (gt-4ac): try to reuse an idle polecat first.
 	// Idle polecats have completed their work but kept their sandbox (worktree).
 	// Reusing avoids the overhead of creating a new worktree.
-	idlePolecat, findErr := polecatMgr.FindIdlePolecat()
+	// When several are idle, prefer a warm one (pre-warm steps current), then
+	// the one with the best scorecard. Routing hooks get the final say.
+	scorer := idlePolecatScorer(townRoot, rigName)
+	idlePolecat, findErr := polecatMgr.FindBestIdlePolecat(routingHookScorer(townRoot, r, opts.HookBead, polecatMgr, func(name string) float64 {
+		if polecatMgr.IsWarm(name) {
+			return 1 + scorer(name) // scorecard scores are in [0, 1]
+		}
+		return scorer(name)
+	}))
 	if findErr == nil && idlePolecat != nil {
 		polecatName := idlePolecat.Name
 		fmt.Printf("Reusing idle polecat: %s\n", polecatName)
@@ -218,7 +241,7 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 			polecatSessMgr := polecat.NewSessionManager(t, r)
 			sessionName := polecatSessMgr.SessionName(polecatName)
 
-			fmt.Printf("%s Polecat %s reused (idle → working, session start deferred)\n", style.Bold.Render("✓"), polecatName)
+			fmt.Printf("%s Polecat %s reused (idle → working, session start deferred)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), polecatName)
 			_ = events.LogFeed(events.TypeSpawn, "gt", events.SpawnPayload(rigName, polecatName))
 
 			effectiveBranch := strings.TrimPrefix(baseBranch, "origin/")
@@ -236,6 +259,8 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 				Branch:      polecatObj.Branch,
 				account:     opts.Account,
 				agent:       opts.Agent,
+				hookBead:    opts.HookBead,
+				experiment:  experimentTag,
 			}, nil
 		}
 	}
@@ -299,7 +324,7 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 	polecatSessMgr := polecat.NewSessionManager(t, r)
 	sessionName := polecatSessMgr.SessionName(polecatName)
 
-	fmt.Printf("%s Polecat %s spawned (session start deferred)\n", style.Bold.Render("✓"), polecatName)
+	fmt.Printf("%s Polecat %s spawned (session start deferred)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), polecatName)
 
 	// Log spawn event to activity feed
 	_ = events.LogFeed(events.TypeSpawn, "gt", events.SpawnPayload(rigName, polecatName))
@@ -320,6 +345,8 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 		Branch:      polecatObj.Branch,
 		account:     opts.Account,
 		agent:       opts.Agent,
+		hookBead:    opts.HookBead,
+		experiment:  experimentTag,
 	}, nil
 }
 
@@ -366,9 +393,16 @@ func (s *SpawnedPolecatInfo) StartSession() (string, error) {
 	startOpts := polecat.SessionStartOptions{
 		RuntimeConfigDir: claudeConfigDir,
 		Agent:            s.agent,
+		Experiment:       s.experiment,
 	}
 	if s.agent != "" {
-		cmd, err := config.BuildPolecatStartupCommandWithAgentOverride(s.RigName, s.PolecatName, r.Path, "", s.agent)
+		cmd, err := config.BuildStartupCommandFromConfig(config.AgentEnvConfig{
+			Role:       constants.RolePolecat,
+			Rig:        s.RigName,
+			AgentName:  s.PolecatName,
+			TownRoot:   filepath.Dir(r.Path),
+			Experiment: s.experiment,
+		}, r.Path, "", s.agent)
 		if err != nil {
 			return "", err
 		}
@@ -377,6 +411,9 @@ func (s *SpawnedPolecatInfo) StartSession() (string, error) {
 	if err := polecatSessMgr.Start(s.PolecatName, startOpts); err != nil {
 		return "", fmt.Errorf("starting session: %w", err)
 	}
+	if s.experiment != "" {
+		recordExperimentAssignment(townRoot, s.experiment, s.hookBead, s.AgentID())
+	}
 
 	// Wait for runtime to be fully ready before returning.
 	// When an agent override is specified (e.g., --agent codex), resolve the runtime
@@ -499,3 +536,39 @@ func verifyWorktreeExists(clonePath string) error {
 
 	return nil
 }
+
+// routingHookScorer lets the town's and rig's routing hooks adjust the scores
+// of rigName's idle polecats (see polecat.ApplyRoutingHooks). Returns score
+// unchanged when there are no hooks or no idle polecats.
+func routingHookScorer(townRoot string, r *rig.Rig, bead string, mgr *polecat.Manager, score func(name string) float64) func(name string) float64 {
+	hooks := polecat.RoutingHooks(polecat.RoutingHookDirs(townRoot, r.Path)...)
+	if len(hooks) == 0 {
+		return score
+	}
+	polecats, err := mgr.List()
+	if err != nil {
+		return score
+	}
+	req := polecat.RoutingRequest{Rig: r.Name, Bead: bead}
+	for _, p := range polecats {
+		if p.State != polecat.StateIdle {
+			continue
+		}
+		req.Candidates = append(req.Candidates, polecat.RoutingCandidate{
+			Name:  p.Name,
+			Agent: r.Name + "/polecats/" + p.Name,
+			Score: score(p.Name),
+			Warm:  mgr.IsWarm(p.Name),
+		})
+	}
+	if len(req.Candidates) == 0 {
+		return score
+	}
+	scores := polecat.ApplyRoutingHooks(hooks, req)
+	return func(name string) float64 {
+		if s, ok := scores[name]; ok {
+			return s
+		}
+		return score(name)
+	}
+}
@@ -0,0 +1,343 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/decision"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/policy"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	policyJSON  bool
+	policyLimit int
+)
+
+var policyCmd = &cobra.Command{
+	Use:     "policy",
+	GroupID: GroupConfig,
+	Short:   "Town governance rules checked at decision points",
+	Long: `Manage the town's governance rules.
+
+Rules are checked at decision points:
+  sling    Dispatching a bead to a rig (bead, rig, formula, force)
+  patrol   A daemon patrol tick (patrol)
+  fix      Applying a doctor fix (check, category, status, safety,
+           unattended: true for gt doctor autofix)
+
+Every point also gets actor and role (who is acting), hour (0-23), and
+weekday ("mon".."sun"). At each point the rules are checked in order and
+the first whose condition holds allows or denies the action; when none
+matches it is allowed. Decisions made by a rule are logged (gt policy log).
+
+Configure rules, and example decisions for gt policy test, in
+settings/config.json:
+  "policy": {
+    "rules": [
+      {"name": "no-polecats-on-beads", "on": "sling",
+       "when": "rig == \"beads\" && role == \"polecat\"", "effect": "deny",
+       "reason": "beads is crew-only"},
+      {"name": "compaction-overnight", "on": "patrol",
+       "when": "patrol == \"compactor_dog\" && hour >= 7 && hour < 22",
+       "effect": "deny", "reason": "compaction runs overnight"},
+      {"name": "review-fixes-attended", "on": "fix",
+       "when": "unattended && safety != \"safe\"", "effect": "deny"}
+    ],
+    "tests": [
+      {"name": "crew may sling to beads", "on": "sling",
+       "input": {"rig": "beads", "role": "crew"}, "expect": "allow"}
+    ]
+  }
+
+Conditions use == != < <= > >= in && || !, string, number, and list
+literals, and glob(s, pattern), startsWith, endsWith, contains, and size.
+A missing field is null.`,
+	RunE: requireSubcommand,
+}
+
+var policyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the rules in evaluation order",
+	Args:  cobra.NoArgs,
+	RunE:  runPolicyList,
+}
+
+var policyTestCmd = &cobra.Command{
+	Use:   "test [<point> [field=value...]]",
+	Short: "Check the configured example decisions, or try one",
+	Long: `Check the rules against the example decisions in settings/config.json,
+or evaluate one decision from the command line.
+
+With no arguments every configured test is run; the command fails if any
+decision differs from the expected one. With a decision point and input
+fields, shows which rule would decide. Values are parsed as JSON where
+possible (true, 3, ["a","b"]), otherwise taken as strings. hour and weekday
+default to now. Nothing is logged.
+
+Examples:
+  gt policy test
+  gt policy test sling rig=beads role=polecat bead=gt-abc12
+  gt policy test patrol patrol=compactor_dog hour=14
+  gt policy test fix check=stale-binary safety=review unattended=true`,
+	RunE: runPolicyTest,
+}
+
+var policyLogCmd = &cobra.Command{
+	Use:   "log [point]",
+	Short: "Show recent decisions made by policy rules",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runPolicyLog,
+}
+
+func init() {
+	policyListCmd.Flags().BoolVar(&policyJSON, "json", false, "Output as JSON")
+	policyTestCmd.Flags().BoolVar(&policyJSON, "json", false, "Output as JSON")
+	policyLogCmd.Flags().BoolVar(&policyJSON, "json", false, "Output as JSON")
+	policyLogCmd.Flags().IntVar(&policyLimit, "limit", 20, "Number of recent decisions to show")
+
+	policyCmd.AddCommand(policyListCmd)
+	policyCmd.AddCommand(policyTestCmd)
+	policyCmd.AddCommand(policyLogCmd)
+	rootCmd.AddCommand(policyCmd)
+}
+
+func loadPolicyEngine() (string, *policy.Engine, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return "", nil, fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	engine, err := policy.Load(townRoot)
+	if err != nil {
+		return "", nil, err
+	}
+	return townRoot, engine, nil
+}
+
+func runPolicyList(cmd *cobra.Command, args []string) error {
+	_, engine, err := loadPolicyEngine()
+	if err != nil {
+		return err
+	}
+	rules := engine.Rules()
+	if policyJSON {
+		out := make([]any, 0, len(rules))
+		for _, r := range rules {
+			out = append(out, r.PolicyRule)
+		}
+		return printPolicyJSON(out)
+	}
+	if len(rules) == 0 {
+		fmt.Println("No policy rules configured; every action is allowed.")
+		fmt.Println(style.Dim.Render("Add rules under \"policy\" in settings/config.json (see gt policy --help)."))
+		return nil
+	}
+	for i, r := range rules {
+		effect := style.Success.Render(r.Effect)
+		if r.Effect == policy.EffectDeny {
+			effect = style.Warning.Render(r.Effect)
+		}
+		fmt.Printf("%2d. %s  %s %s\n", i+1, style.Bold.Render(r.Name), style.Dim.Render("on "+r.On+":"), effect)
+		when := r.When
+		if when == "" {
+			when = "always"
+		}
+		fmt.Printf("    when %s\n", when)
+		if r.Reason != "" {
+			fmt.Printf("    %s\n", style.Dim.Render(r.Reason))
+		}
+	}
+	return nil
+}
+
+func runPolicyTest(cmd *cobra.Command, args []string) error {
+	_, engine, err := loadPolicyEngine()
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return runPolicyTry(engine, args[0], args[1:])
+	}
+
+	results := engine.RunTests()
+	if policyJSON {
+		if err := printPolicyJSON(results); err != nil {
+			return err
+		}
+	} else if len(results) == 0 {
+		fmt.Println("No policy tests configured.")
+		fmt.Println(style.Dim.Render("Add example decisions under \"policy\".\"tests\" in settings/config.json."))
+		return nil
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Failure != "" {
+			failed++
+		}
+		if policyJSON {
+			continue
+		}
+		name := r.Test.Name
+		if name == "" {
+			name = r.Test.On
+		}
+		if r.Failure == "" {
+			fmt.Printf("%s %s\n", style.SuccessPrefix, name)
+		} else {
+			fmt.Printf("%s %s: %s\n", style.ErrorPrefix, name, r.Failure)
+		}
+	}
+	if failed > 0 {
+		if !policyJSON {
+			fmt.Printf("\n%d of %d policy test(s) failed\n", failed, len(results))
+		}
+		return NewSilentExit(1)
+	}
+	if !policyJSON {
+		fmt.Printf("\nAll %d policy test(s) passed\n", len(results))
+	}
+	return nil
+}
+
+// runPolicyTry evaluates one decision given as field=value arguments.
+func runPolicyTry(engine *policy.Engine, point string, fields []string) error {
+	if _, ok := policy.Points[point]; !ok {
+		points := make([]string, 0, len(policy.Points))
+		for p := range policy.Points {
+			points = append(points, p)
+		}
+		sort.Strings(points)
+		return fmt.Errorf("unknown decision point %q (want %s)", point, strings.Join(points, ", "))
+	}
+	in := policy.Input{}
+	for _, f := range fields {
+		key, value, ok := strings.Cut(f, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("invalid input %q (want field=value)", f)
+		}
+		in[key] = parsePolicyValue(value)
+	}
+	in = policy.WithDefaults(in, time.Now())
+	d := engine.Decide(point, in)
+	if policyJSON {
+		return printPolicyJSON(d)
+	}
+
+	switch {
+	case !d.Allowed:
+		fmt.Printf("%s denied by rule %s\n", style.ErrorPrefix, style.Bold.Render(d.Rule))
+	case d.Rule != "":
+		fmt.Printf("%s allowed by rule %s\n", style.SuccessPrefix, style.Bold.Render(d.Rule))
+	default:
+		fmt.Printf("%s allowed (no rule matched)\n", style.SuccessPrefix)
+	}
+	if d.Reason != "" {
+		fmt.Printf("  %s\n", d.Reason)
+	}
+	for _, e := range d.Errors {
+		fmt.Printf("  %s %s\n", style.Warning.Render("error in"), e)
+	}
+	return nil
+}
+
+// parsePolicyValue reads a command-line input value as JSON, falling back
+// to a plain string.
+func parsePolicyValue(s string) any {
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return n
+	}
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err == nil {
+		return v
+	}
+	return s
+}
+
+func runPolicyLog(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	point := ""
+	if len(args) > 0 {
+		point = args[0]
+	}
+	decisions, err := decision.Query(townRoot, decision.KindPolicy, point, policyLimit)
+	if err != nil {
+		return err
+	}
+	if policyJSON {
+		return printPolicyJSON(decisions)
+	}
+	if len(decisions) == 0 {
+		fmt.Println(style.Dim.Render("No policy decisions recorded. Decisions are logged when a rule allows or denies an action."))
+		return nil
+	}
+	for _, d := range decisions {
+		outcome := style.Success.Render(fmt.Sprintf("%-8s", d.Outcome))
+		if d.Outcome == decision.OutcomeDenied {
+			outcome = style.Warning.Render(fmt.Sprintf("%-8s", d.Outcome))
+		}
+		fmt.Printf("  %s  %-7s %s %s %s\n", d.Time.Local().Format("01-02 15:04:05"), d.Subject, outcome, d.Reason, style.Dim.Render(d.Detail))
+	}
+	return nil
+}
+
+func printPolicyJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// policyActorInput returns the actor and role fields of a policy input for
+// whoever is running this command.
+func policyActorInput() policy.Input {
+	info, err := GetRole()
+	if err != nil {
+		return policy.Input{"actor": "unknown"}
+	}
+	return policy.Input{"actor": info.ActorString(), "role": string(info.Role)}
+}
+
+// checkSlingPolicy applies the town's sling rules to dispatching beadID to
+// rigName.
+func checkSlingPolicy(townRoot, beadID, rigName, formula string, force bool) error {
+	in := policyActorInput()
+	in["bead"] = beadID
+	in["rig"] = rigName
+	in["formula"] = formula
+	in["force"] = force
+	d := policy.Evaluate(townRoot, policy.PointSling, beadID+" -> "+rigName, in)
+	if err := d.Denied(); err != nil {
+		return fmt.Errorf("cannot sling to rig %q: %w", rigName, err)
+	}
+	return nil
+}
+
+// doctorFixGate applies the town's fix rules before each doctor fix.
+// unattended is true for fixes nobody is watching (gt doctor autofix).
+func doctorFixGate(townRoot string, unattended bool) doctor.FixGate {
+	actor := policyActorInput()
+	return func(check doctor.Check, result *doctor.CheckResult) error {
+		in := policy.Input{
+			"check":      check.Name(),
+			"category":   result.Category,
+			"status":     strings.ToLower(result.Status.String()),
+			"safety":     string(doctor.CheckFixSafety(check)),
+			"unattended": unattended,
+		}
+		for k, v := range actor {
+			in[k] = v
+		}
+		return policy.Evaluate(townRoot, policy.PointFix, check.Name(), in).Denied()
+	}
+}
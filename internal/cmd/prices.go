@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/prices"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	pricesAt   string
+	pricesJSON bool
+	pricesURL  string
+)
+
+var pricesCmd = &cobra.Command{
+	Use:     "prices",
+	GroupID: GroupDiag,
+	Short:   "Show and update the model price table used for costs",
+	Long: `Show and update the per-model token prices used by gt costs.
+
+Prices are layered, highest first:
+
+  town        settings/prices.json (your overrides)
+  published   the price table at the town's url, fetched by
+              "gt prices update" and cached in .runtime/prices/published.json
+  builtin     the table compiled into gt
+
+Each model keeps a history of rates by effective date, so usage is costed
+at the rates in effect when it happened. Model keys are exact model IDs or
+prefixes ending in "*"; "default" prices models nothing else matches.
+
+settings/prices.json:
+
+  {
+    "url": "https://example.com/prices.json",
+    "auto_update": true,
+    "models": {
+      "claude-opus-*": [
+        {"input": 15, "output": 75, "cache_read": 1.5, "cache_write": 18.75},
+        {"from": "2026-01-01", "input": 5, "output": 25, "cache_read": 0.5, "cache_write": 6.25}
+      ]
+    }
+  }
+
+Prices are USD per million tokens. There is no default url: without one,
+only the built-in table and your overrides apply. With auto_update the
+daemon runs "gt prices update" daily.
+
+Examples:
+  gt prices show                        # Current rate of every model
+  gt prices show claude-opus-4-5-20251101  # One model's rate and history
+  gt prices show --at 2026-01-15        # Rates in effect on a date
+  gt prices update                      # Fetch the table at the town's url`,
+	RunE: requireSubcommand,
+}
+
+var pricesShowCmd = &cobra.Command{
+	Use:   "show [model]",
+	Short: "Show current rates, or one model's rate history",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runPricesShow,
+}
+
+var pricesUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Fetch the published price table",
+	Long: `Fetch the price table at the town's url (or --url) and merge it into
+the town's cache.
+
+Rates are kept by effective date: a dated rate that changed is a correction
+and replaces the cached one, while a changed undated rate takes effect from
+today, so earlier usage is still costed at the old rate.
+
+Examples:
+  gt prices update
+  gt prices update --url https://example.com/prices.json`,
+	RunE: runPricesUpdate,
+}
+
+func init() {
+	pricesShowCmd.Flags().StringVar(&pricesAt, "at", "", "Show rates in effect on a date (YYYY-MM-DD)")
+	pricesShowCmd.Flags().BoolVar(&pricesJSON, "json", false, "Output as JSON")
+	pricesUpdateCmd.Flags().StringVar(&pricesURL, "url", "", "Fetch from this URL instead of the configured one")
+	pricesUpdateCmd.Flags().BoolVar(&pricesJSON, "json", false, "Output changed rates as JSON")
+	pricesCmd.AddCommand(pricesShowCmd, pricesUpdateCmd)
+	rootCmd.AddCommand(pricesCmd)
+}
+
+// PriceJSON is one model's rate in gt prices show --json.
+type PriceJSON struct {
+	Model  string        `json:"model"`
+	Layer  string        `json:"layer"`
+	Rate   prices.Rate   `json:"rate"`
+	Series []prices.Rate `json:"history,omitempty"`
+}
+
+func runPricesShow(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	set, err := prices.Load(townRoot)
+	if err != nil {
+		return err
+	}
+	at := time.Now()
+	if pricesAt != "" {
+		if at, err = time.ParseInLocation("2006-01-02", pricesAt, time.Local); err != nil {
+			return fmt.Errorf("invalid --at date (use YYYY-MM-DD): %w", err)
+		}
+	}
+
+	var rows []PriceJSON
+	if len(args) == 1 {
+		rate, layer := set.Rate(args[0], at)
+		history, _ := set.History(args[0])
+		rows = append(rows, PriceJSON{Model: args[0], Layer: layer, Rate: rate, Series: history})
+	} else {
+		seen := map[string]bool{}
+		for _, l := range set.Layers {
+			for model := range l.Models {
+				if !seen[model] {
+					seen[model] = true
+					rate, layer := set.Rate(model, at)
+					rows = append(rows, PriceJSON{Model: model, Layer: layer, Rate: rate})
+				}
+			}
+		}
+		sort.Slice(rows, func(i, j int) bool { return rows[i].Model < rows[j].Model })
+	}
+
+	if pricesJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+
+	fmt.Printf("%s USD per million tokens, as of %s\n\n", style.Bold.Render("Prices"), at.Format("2006-01-02"))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODEL\tINPUT\tOUTPUT\tCACHE READ\tCACHE WRITE\tFROM\tLAYER")
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Model, formatPrice(r.Rate.Input), formatPrice(r.Rate.Output),
+			formatPrice(r.Rate.CacheRead), formatPrice(r.Rate.CacheWrite), rateFrom(r.Rate), r.Layer)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(rows) == 1 && len(rows[0].Series) > 1 {
+		fmt.Printf("\n%s\n", style.Bold.Render("History:"))
+		for _, r := range rows[0].Series {
+			fmt.Printf("  %-10s input %s, output %s, cache read %s, cache write %s\n", rateFrom(r),
+				formatPrice(r.Input), formatPrice(r.Output), formatPrice(r.CacheRead), formatPrice(r.CacheWrite))
+		}
+	}
+	return nil
+}
+
+func formatPrice(p float64) string {
+	return fmt.Sprintf("$%g", p)
+}
+
+func rateFrom(r prices.Rate) string {
+	if r.From == "" {
+		return "always"
+	}
+	return r.From
+}
+
+func runPricesUpdate(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	url := pricesURL
+	if url == "" {
+		set, err := prices.Load(townRoot)
+		if err != nil {
+			return err
+		}
+		url = set.SourceURL()
+	}
+
+	table, changes, err := prices.Update(townRoot, url, time.Now())
+	if err != nil {
+		return err
+	}
+	if pricesJSON {
+		if changes == nil {
+			changes = []prices.Change{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(changes)
+	}
+	if len(changes) == 0 {
+		fmt.Printf("%s Prices up to date (version %s)\n", style.SuccessPrefix, table.Version)
+		return nil
+	}
+	fmt.Printf("%s Updated prices to version %s (%d change(s)):\n", style.SuccessPrefix, table.Version, len(changes))
+	for _, c := range changes {
+		was := "new"
+		if c.Old != nil {
+			was = fmt.Sprintf("was %s/%s", formatPrice(c.Old.Input), formatPrice(c.Old.Output))
+		}
+		fmt.Printf("  %s from %s: input %s, output %s %s\n", c.Model, rateFrom(c.Rate),
+			formatPrice(c.Rate.Input), formatPrice(c.Rate.Output), style.Dim.Render("("+was+")"))
+	}
+	return nil
+}
@@ -17,9 +17,12 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/cli"
 	"github.com/steveyegge/gastown/internal/lock"
+	"github.com/steveyegge/gastown/internal/memory"
+	"github.com/steveyegge/gastown/internal/primecache"
 	"github.com/steveyegge/gastown/internal/state"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/telemetry"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -28,6 +31,7 @@ var primeDryRun bool
 var primeState bool
 var primeStateJSON bool
 var primeExplain bool
+var primeNoCache bool
 
 // primeHookSource stores the SessionStart source ("startup", "resume", "clear", "compact")
 // when running in hook mode. Used to provide lighter output on compaction/resume.
@@ -77,7 +81,15 @@ HOOK MODE (--hook):
   Claude Code sends JSON on stdin:
     {"session_id": "uuid", "transcript_path": "/path", "source": "startup|resume"}
 
-  Other agents can set GT_SESSION_ID environment variable instead.`,
+  Other agents can set GT_SESSION_ID environment variable instead.
+
+PRIME CACHE:
+  The role context (template, CONTEXT.md, rig conventions) and the beads
+  workflow context (bd prime) are cached in .runtime/prime-cache. Keys use
+  cheap inputs only: the role template, the worktree's HEAD, and the sizes
+  and mtimes of the files each section reads (the event log stands in for
+  the rig's open wisps). Agents in the same rig share bd prime entries; any
+  input change misses. Use --no-cache to bypass it.`,
 	RunE: runPrime,
 }
 
@@ -92,6 +104,8 @@ func init() {
 		"Output state as JSON (requires --state)")
 	primeCmd.Flags().BoolVar(&primeExplain, "explain", false,
 		"Show why each section was included")
+	primeCmd.Flags().BoolVar(&primeNoCache, "no-cache", false,
+		"Rebuild the role and beads workflow context instead of using the prime cache")
 	rootCmd.AddCommand(primeCmd)
 }
 
@@ -171,7 +185,7 @@ func runPrime(cmd *cobra.Command, args []string) (retErr error) {
 
 	outputMoleculeContext(ctx)
 	outputCheckpointContext(ctx)
-	runPrimeExternalTools(cwd)
+	runPrimeExternalTools(ctx, cwd)
 
 	if ctx.Role == RoleMayor {
 		checkPendingEscalations(ctx)
@@ -204,6 +218,7 @@ func runPrimeCompactResume(ctx RoleContext, cwd string) {
 
 	// Session metadata for seance
 	outputSessionMetadata(ctx)
+	outputPatrolDryRunNotice(ctx)
 
 	// Find hooked work and output continuation directive (not full autonomous startup).
 	// The agent already knows what it was doing — just remind it of the hook.
@@ -305,7 +320,7 @@ func warnRoleMismatch(roleInfo RoleInfo, cwd string) {
 	if !roleInfo.Mismatch {
 		return
 	}
-	fmt.Printf("\n%s\n", style.Bold.Render("⚠️  ROLE/LOCATION MISMATCH"))
+	fmt.Printf("\n%s\n", style.Bold.Render(ui.Glyph("⚠️")+"  ROLE/LOCATION MISMATCH"))
 	fmt.Printf("You are %s (from $GT_ROLE) but your cwd suggests %s.\n",
 		style.Bold.Render(string(roleInfo.Role)),
 		style.Bold.Render(string(roleInfo.CwdRole)))
@@ -341,34 +356,83 @@ func outputRoleContext(ctx RoleContext) (string, error) {
 	outputSessionMetadata(ctx)
 
 	explain(true, fmt.Sprintf("Role context: detected role is %s", ctx.Role))
-	formula, err := outputPrimeContext(ctx)
+	formula, err := outputRolePack(ctx)
 	if err != nil {
 		return "", err
 	}
 
-	outputContextFile(ctx)
+	outputPatrolDryRunNotice(ctx)
 	outputHandoffContent(ctx)
 	outputAttachmentStatus(ctx)
 	return formula, nil
 }
 
+// outputRolePack outputs the role context, CONTEXT.md, and rig conventions.
+// The pack is served from the prime cache when its inputs are unchanged (see
+// primeCacheKey), and the returned formula is then the whole cached pack.
+// Roles without a template use the hardcoded fallback, which is not cached.
+func outputRolePack(ctx RoleContext) (string, error) {
+	var cache *primecache.Cache
+	key, cacheable := primeCacheKey(ctx, ctx.WorkDir, primecache.SectionRole)
+	if cacheable && !primeNoCache {
+		cache = primecache.New(ctx.TownRoot)
+		if pack, ok := cache.Get(key); ok {
+			explain(true, "Role context: served from prime cache")
+			fmt.Print(pack)
+			return pack, nil
+		}
+	}
+
+	formula, err := renderPrimeContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if formula == "" {
+		outputPrimeContextFallback(ctx)
+		fmt.Print(contextFileText(ctx) + rigConventionsText(ctx))
+		return "", nil
+	}
+
+	pack := formula + contextFileText(ctx) + rigConventionsText(ctx)
+	if cache != nil {
+		if err := cache.Put(key, pack); err != nil {
+			fmt.Fprintf(os.Stderr, "prime cache: %v\n", err)
+		}
+	}
+	fmt.Print(pack)
+	return formula, nil
+}
+
 // runPrimeExternalTools runs bd prime, memory injection, and gt mail check --inject.
 // Skipped in dry-run mode with explain output.
-func runPrimeExternalTools(cwd string) {
+func runPrimeExternalTools(ctx RoleContext, cwd string) {
 	if primeDryRun {
 		explain(true, "bd prime: skipped in dry-run mode")
 		explain(true, "memory injection: skipped in dry-run mode")
 		explain(true, "gt mail check --inject: skipped in dry-run mode")
 		return
 	}
-	runBdPrime(cwd)
+	runBdPrime(ctx, cwd)
 	runMemoryInject()
+	runAgentMemoryInject(ctx)
 	runMailCheckInject(cwd)
 }
 
 // runBdPrime runs `bd prime` and outputs the result.
-// This provides beads workflow context to the agent.
-func runBdPrime(workDir string) {
+// This provides beads workflow context to the agent. Output is served from
+// the prime cache when its inputs are unchanged (see primeCacheKey).
+func runBdPrime(ctx RoleContext, workDir string) {
+	var cache *primecache.Cache
+	key, cacheable := primeCacheKey(ctx, workDir, primecache.SectionBeads)
+	if cacheable && !primeNoCache {
+		cache = primecache.New(ctx.TownRoot)
+		if output, ok := cache.Get(key); ok {
+			explain(true, "bd prime: served from prime cache")
+			printBdPrimeOutput(output)
+			return
+		}
+	}
+
 	cmd := exec.Command("bd", "prime")
 	cmd.Dir = workDir
 	cmd.Env = os.Environ()
@@ -387,6 +451,15 @@ func runBdPrime(workDir string) {
 	}
 
 	output := strings.TrimSpace(stdout.String())
+	if cache != nil {
+		if err := cache.Put(key, output); err != nil {
+			fmt.Fprintf(os.Stderr, "prime cache: %v\n", err)
+		}
+	}
+	printBdPrimeOutput(output)
+}
+
+func printBdPrimeOutput(output string) {
 	if output != "" {
 		fmt.Println()
 		fmt.Println(output)
@@ -424,6 +497,31 @@ func runMemoryInject() {
 	}
 }
 
+// runAgentMemoryInject outputs the agent's own notes (gt remember --self):
+// the newest that fit the prime budget.
+func runAgentMemoryInject(ctx RoleContext) {
+	notes, err := memory.Load(ctx.TownRoot, ctx.ActorString())
+	if err != nil || len(notes) == 0 {
+		return // Silently skip: memory is an aid, not a requirement
+	}
+	kept, omitted := memory.Budget(notes, memory.DefaultPrimeBudget)
+	if len(kept) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("# Your Notes")
+	fmt.Println()
+	fmt.Println("Learnings you saved in earlier sessions (gt remember --self):")
+	fmt.Println()
+	for _, n := range kept {
+		fmt.Printf("- %s (%s)\n", n.Text, n.Time.Local().Format("2006-01-02"))
+	}
+	if omitted > 0 {
+		fmt.Printf("\n_%d older note(s) omitted; see gt memories --self._\n", omitted)
+	}
+}
+
 // runMailCheckInject runs `gt mail check --inject` and outputs the result.
 // This injects any pending mail into the agent's context.
 func runMailCheckInject(workDir string) {
@@ -463,6 +561,10 @@ func checkSlungWork(ctx RoleContext) bool {
 
 	outputAutonomousDirective(ctx, hookedBead, hasMolecule)
 	outputHookedBeadDetails(hookedBead)
+	outputWispTypeContext(ctx, hookedBead)
+	outputWispCriteriaContext(hookedBead)
+	outputHandoffNote(ctx, hookedBead.ID)
+	outputWispComments(ctx, hookedBead.ID)
 
 	if hasMolecule {
 		outputMoleculeWorkflow(ctx, attachment)
@@ -631,6 +733,65 @@ func outputHookedBeadDetails(hookedBead *beads.Issue) {
 	fmt.Println()
 }
 
+// maxPrimeComments is how many recent wisp comments prime shows.
+const maxPrimeComments = 5
+
+// outputWispComments shows the most recent comments on the hooked wisp, so
+// findings and steering left mid-flight reach the agent's next session.
+func outputWispComments(ctx RoleContext, beadID string) {
+	all, err := beads.New(ctx.WorkDir).Comments(beadID)
+	if err != nil {
+		return
+	}
+	// Handoff notes are shown by outputHandoffNote.
+	var comments []*beads.Comment
+	for _, c := range all {
+		if c.Kind != beads.CommentKindHandoff {
+			comments = append(comments, c)
+		}
+	}
+	if len(comments) == 0 {
+		return
+	}
+	fmt.Printf("%s\n\n", style.Bold.Render("## 💬 Wisp Comments"))
+	if len(comments) > maxPrimeComments {
+		fmt.Printf("  (%d earlier comments: %s wisp comments %s)\n\n", len(comments)-maxPrimeComments, cli.Name(), beadID)
+		comments = comments[len(comments)-maxPrimeComments:]
+	}
+	for _, c := range comments {
+		printWispComment(c, "  ")
+	}
+}
+
+// outputHandoffNote displays the latest note left by an agent that handed
+// this bead to the current agent (gt handoff --to).
+func outputHandoffNote(ctx RoleContext, beadID string) {
+	agentID := getAgentIdentity(ctx)
+	if agentID == "" {
+		return
+	}
+	note, c, err := beads.New(ctx.WorkDir).LatestHandoffNote(beadID, agentID)
+	if err != nil || note == nil {
+		return
+	}
+	fmt.Printf("%s\n\n", style.Bold.Render("## 🤝 Handed Off to You by "+note.From))
+	fmt.Printf("%s %s\n\n", style.Dim.Render("at"), c.CreatedAt.Local().Format("2006-01-02 15:04"))
+	fmt.Printf("**State:** %s\n", note.State)
+	if len(note.Remaining) > 0 {
+		fmt.Println("\n**Remaining steps:**")
+		for i, step := range note.Remaining {
+			fmt.Printf("%d. %s\n", i+1, step)
+		}
+	}
+	if len(note.Gotchas) > 0 {
+		fmt.Println("\n**Gotchas:**")
+		for _, g := range note.Gotchas {
+			fmt.Printf("- %s\n", g)
+		}
+	}
+	fmt.Println()
+}
+
 // outputMoleculeWorkflow displays attached molecule context with current step.
 func outputMoleculeWorkflow(ctx RoleContext, attachment *beads.AttachmentFields) {
 	fmt.Printf("%s\n\n", style.Bold.Render("## 🧬 ATTACHED FORMULA (WORKFLOW CHECKLIST)"))
@@ -690,7 +851,7 @@ func outputRalphLoopDirective(_ RoleContext, attachment *beads.AttachmentFields)
 	fmt.Println("2. Preserve work via git commits between steps")
 	fmt.Println("3. Stop when POLECAT_DONE is output or max iterations reached")
 	fmt.Println()
-	fmt.Printf("%s\n", style.Bold.Render("⚠️  Run the /ralph-loop command above. Do NOT work step-by-step manually."))
+	fmt.Printf("%s\n", style.Bold.Render(ui.Glyph("⚠️")+"  Run the /ralph-loop command above. Do NOT work step-by-step manually."))
 }
 
 // buildRalphPromptFromMolecule constructs the Ralph loop prompt text from molecule steps.
@@ -812,7 +973,7 @@ func acquireIdentityLock(ctx RoleContext) error {
 	if err := l.Acquire(sessionID); err != nil {
 		if errors.Is(err, lock.ErrLocked) {
 			// Another agent owns this identity
-			fmt.Printf("\n%s\n\n", style.Bold.Render("⚠️  IDENTITY COLLISION DETECTED"))
+			fmt.Printf("\n%s\n\n", style.Bold.Render(ui.Glyph("⚠️")+"  IDENTITY COLLISION DETECTED"))
 			fmt.Printf("Another agent already claims this worker identity.\n\n")
 
 			// Show lock details
@@ -974,7 +1135,7 @@ func checkPendingEscalations(ctx RoleContext) {
 		case 1:
 			severity = "HIGH"
 		}
-		fmt.Printf("  • [%s] %s (%s)\n", severity, e.Title, e.ID)
+		fmt.Printf("  %s [%s] %s (%s)\n", ui.Glyph("•"), severity, e.Title, e.ID)
 	}
 	if len(escalations) > maxShow {
 		fmt.Printf("  ... and %d more\n", len(escalations)-maxShow)
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"os/exec"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/conventions"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/primecache"
+	"github.com/steveyegge/gastown/internal/templates"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// primeCacheKey builds the prime cache key for one section of an agent's
+// prime output. Only cheap inputs go into it: the embedded role template,
+// the worktree's HEAD read straight from .git, and size/mtime stamps of the
+// files the section reads, so a hit never renders anything or runs git.
+//
+// The beads section (bd prime) is stamped with the beads PRIME.md, the bd
+// binary, and the town event log, which changes whenever a wisp is slung or
+// completed. The role section (role template, CONTEXT.md, and rig
+// conventions) is rendered per agent and stamped with the files those read.
+// ok is false for roles without a template.
+func primeCacheKey(ctx RoleContext, workDir, section string) (primecache.Key, bool) {
+	roleName, ok := primeTemplateName(ctx.Role)
+	if !ok || ctx.TownRoot == "" {
+		return primecache.Key{}, false
+	}
+
+	roleSource, _ := templates.RoleSource(roleName)
+	key := primecache.Key{
+		Section:      section,
+		Role:         roleName,
+		Rig:          ctx.Rig,
+		TemplateHash: primecache.HashSources(roleSource),
+		// Not every role works in a git worktree; an empty HEAD is a valid input.
+		Head: git.ReadHead(workDir),
+	}
+	if path := experimentPromptTemplate(ctx.TownRoot); path != "" {
+		key.Stamps = append(key.Stamps, primecache.Stamp(path))
+	}
+
+	switch section {
+	case primecache.SectionBeads:
+		key.Stamps = append(key.Stamps,
+			primecache.Stamp(filepath.Join(beads.ResolveBeadsDir(workDir), "PRIME.md")),
+			primecache.Stamp(filepath.Join(ctx.TownRoot, events.EventsFile)))
+		// bd renders the context, so a bd upgrade must miss too.
+		if path, err := exec.LookPath("bd"); err == nil {
+			key.Stamps = append(key.Stamps, primecache.Stamp(path))
+		}
+	case primecache.SectionRole:
+		key.Agent, key.WorkDir = ctx.Polecat, ctx.WorkDir
+		key.Stamps = append(key.Stamps,
+			primecache.Stamp(filepath.Join(ctx.TownRoot, workspace.PrimaryMarker)),
+			primecache.Stamp(filepath.Join(ctx.TownRoot, "CONTEXT.md")))
+		if ctx.Rig != "" {
+			rigPath := filepath.Join(ctx.TownRoot, ctx.Rig)
+			key.Stamps = append(key.Stamps,
+				primecache.Stamp(filepath.Join(rigPath, "config.json")),
+				primecache.Stamp(conventions.SummaryPath(rigPath)))
+		}
+	}
+	return key, true
+}
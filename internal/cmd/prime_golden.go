@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/templates"
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+var (
+	primeGoldenDir    string
+	primeGoldenRoles  string
+	primeGoldenUpdate bool
+	primeGoldenJSON   bool
+)
+
+var primeGoldenCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Check role templates against golden prime output",
+	Long: `Render each role template against fixture contexts and compare the
+output to golden files, so template changes are reviewed as diffs.
+
+A fixture directory holds <name>.json fixtures and <name>.golden.md goldens.
+A fixture names the role, the template data (RoleData field names), and
+optional token bounds:
+
+  {"role": "polecat", "data": {"RigName": "myrig", "Polecat": "TestCat"},
+   "min_tokens": 1900, "max_tokens": 5000}
+
+Tokens are estimated at four bytes per token. The default directory is the
+one the templates package's own tests use, relative to a gastown checkout.
+
+Examples:
+  gt prime test                                   # Embedded templates
+  gt prime test --roles internal/templates/roles  # Templates as edited on disk
+  gt prime test --roles internal/templates/roles --update`,
+	Args: cobra.NoArgs,
+	RunE: runPrimeGolden,
+}
+
+func init() {
+	primeGoldenCmd.Flags().StringVar(&primeGoldenDir, "dir", "internal/templates/testdata/prime", "Fixture and golden directory")
+	primeGoldenCmd.Flags().StringVar(&primeGoldenRoles, "roles", "", "Render role templates from this directory instead of the embedded ones")
+	primeGoldenCmd.Flags().BoolVar(&primeGoldenUpdate, "update", false, "Rewrite golden files from the rendered output")
+	primeGoldenCmd.Flags().BoolVar(&primeGoldenJSON, "json", false, "Output results as JSON")
+	primeCmd.AddCommand(primeGoldenCmd)
+}
+
+func runPrimeGolden(cmd *cobra.Command, args []string) error {
+	results, err := templates.RunGolden(primeGoldenDir, templates.GoldenOptions{
+		RolesDir: primeGoldenRoles,
+		Update:   primeGoldenUpdate,
+	})
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, r := range results {
+		if !r.Passed() {
+			failed++
+		}
+	}
+
+	if primeGoldenJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+	} else {
+		for _, r := range results {
+			switch {
+			case !r.Passed():
+				fmt.Printf("%s %s (%s, ~%d tokens)\n", style.Error.Render(ui.Glyph("✗")), r.Fixture, r.Role, r.Tokens)
+				for _, f := range r.Failures {
+					fmt.Printf("    %s\n", f)
+				}
+				for _, d := range r.Diff {
+					fmt.Printf("    %s\n", style.Dim.Render(d))
+				}
+			case r.Updated:
+				fmt.Printf("%s %s (%s, ~%d tokens) golden updated\n", style.SuccessPrefix, r.Fixture, r.Role, r.Tokens)
+			default:
+				fmt.Printf("%s %s (%s, ~%d tokens)\n", style.SuccessPrefix, r.Fixture, r.Role, r.Tokens)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d fixture(s) failed", failed, len(results))
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/steveyegge/gastown/internal/formula"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // MoleculeCurrentOutput represents the JSON output of bd mol current.
@@ -43,14 +44,14 @@ func showMoleculeExecutionPrompt(workDir, moleculeID string) {
 
 	if err := cmd.Run(); err != nil {
 		// Fall back to simple message if bd mol current fails
-		fmt.Println(style.Bold.Render("→ PROPULSION PRINCIPLE: Work is on your hook. RUN IT."))
+		fmt.Println(style.Bold.Render(ui.Glyph("→") + " PROPULSION PRINCIPLE: Work is on your hook. RUN IT."))
 		fmt.Println("  Begin working on this molecule immediately.")
 		fmt.Printf("  Check status with: bd mol current %s\n", moleculeID)
 		return
 	}
 	// Handle bd exit 0 bug: empty stdout means not found
 	if stdout.Len() == 0 {
-		fmt.Println(style.Bold.Render("→ PROPULSION PRINCIPLE: Work is on your hook. RUN IT."))
+		fmt.Println(style.Bold.Render(ui.Glyph("→") + " PROPULSION PRINCIPLE: Work is on your hook. RUN IT."))
 		fmt.Println("  Begin working on this molecule immediately.")
 		return
 	}
@@ -59,7 +60,7 @@ func showMoleculeExecutionPrompt(workDir, moleculeID string) {
 	var outputs []MoleculeCurrentOutput
 	if err := json.Unmarshal(stdout.Bytes(), &outputs); err != nil || len(outputs) == 0 {
 		// Fall back to simple message
-		fmt.Println(style.Bold.Render("→ PROPULSION PRINCIPLE: Work is on your hook. RUN IT."))
+		fmt.Println(style.Bold.Render(ui.Glyph("→") + " PROPULSION PRINCIPLE: Work is on your hook. RUN IT."))
 		fmt.Println("  Begin working on this molecule immediately.")
 		return
 	}
@@ -89,7 +90,7 @@ func showMoleculeExecutionPrompt(workDir, moleculeID string) {
 		}
 
 		// The propulsion directive
-		fmt.Println(style.Bold.Render("→ EXECUTE THIS STEP NOW."))
+		fmt.Println(style.Bold.Render(ui.Glyph("→") + " EXECUTE THIS STEP NOW."))
 		fmt.Println()
 		fmt.Println("When complete:")
 		fmt.Printf("  1. Close the step: bd close %s\n", step.ID)
@@ -97,7 +98,7 @@ func showMoleculeExecutionPrompt(workDir, moleculeID string) {
 		fmt.Println("  3. Continue until molecule complete")
 	} else {
 		// No next step - molecule may be complete
-		fmt.Println(style.Bold.Render("✓ MOLECULE COMPLETE"))
+		fmt.Println(style.Bold.Render(ui.Glyph(ui.IconPass) + " MOLECULE COMPLETE"))
 		fmt.Println()
 		fmt.Println("All steps are done. You may:")
 		fmt.Println("  - Report completion to supervisor")
@@ -10,48 +10,31 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/checkpoint"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/conventions"
 	"github.com/steveyegge/gastown/internal/deacon"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/templates"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
-// outputPrimeContext outputs the role-specific context using templates or fallback.
-// Returns the rendered template content (empty string when using fallback path).
-func outputPrimeContext(ctx RoleContext) (string, error) {
-	// Try to use templates first
+// renderPrimeContext renders the role-specific context from templates.
+// Returns an empty string when the role has no template and the caller
+// should use the hardcoded fallback output instead.
+func renderPrimeContext(ctx RoleContext) (string, error) {
+	// Try to use templates first; the caller falls back to hardcoded output
 	tmpl, err := templates.New()
 	if err != nil {
-		// Fall back to hardcoded output if templates fail
-		outputPrimeContextFallback(ctx)
 		return "", nil
 	}
 
-	// Map role to template name
-	var roleName string
-	switch ctx.Role {
-	case RoleMayor:
-		roleName = constants.RoleMayor
-	case RoleDeacon:
-		roleName = constants.RoleDeacon
-	case RoleWitness:
-		roleName = constants.RoleWitness
-	case RoleRefinery:
-		roleName = constants.RoleRefinery
-	case RolePolecat:
-		roleName = constants.RolePolecat
-	case RoleCrew:
-		roleName = constants.RoleCrew
-	case RoleBoot:
-		roleName = "boot"
-	case RoleDog:
-		roleName = "dog"
-	default:
+	roleName, ok := primeTemplateName(ctx.Role)
+	if !ok {
 		// Unknown role - use fallback
-		outputPrimeContextFallback(ctx)
 		return "", nil
 	}
 
@@ -81,16 +64,49 @@ func outputPrimeContext(ctx RoleContext) (string, error) {
 		DeaconSession: session.DeaconSessionName(),
 	}
 
-	// Render and output
-	output, err := tmpl.RenderRole(roleName, data)
-	if err != nil {
-		return "", fmt.Errorf("rendering template: %w", err)
+	// Render and output. A prompt experiment variant replaces the embedded
+	// polecat template; if it fails to render, fall back to the embedded one.
+	var output string
+	if path := experimentPromptTemplate(ctx.TownRoot); path != "" && ctx.Role == RolePolecat {
+		output, err = templates.RenderRoleFile(path, data)
+		if err != nil {
+			style.PrintWarning("experiment prompt variant: %v (using default template)", err)
+			output = ""
+		}
+	}
+	if output == "" {
+		output, err = tmpl.RenderRole(roleName, data)
+		if err != nil {
+			return "", fmt.Errorf("rendering template: %w", err)
+		}
 	}
 
-	fmt.Print(output)
 	return output, nil
 }
 
+// primeTemplateName maps a role to its role template name.
+func primeTemplateName(role Role) (string, bool) {
+	switch role {
+	case RoleMayor:
+		return constants.RoleMayor, true
+	case RoleDeacon:
+		return constants.RoleDeacon, true
+	case RoleWitness:
+		return constants.RoleWitness, true
+	case RoleRefinery:
+		return constants.RoleRefinery, true
+	case RolePolecat:
+		return constants.RolePolecat, true
+	case RoleCrew:
+		return constants.RoleCrew, true
+	case RoleBoot:
+		return "boot", true
+	case RoleDog:
+		return "dog", true
+	}
+	return "", false
+}
+
 func outputPrimeContextFallback(ctx RoleContext) {
 	switch ctx.Role {
 	case RoleMayor:
@@ -343,19 +359,58 @@ func outputCommandQuickReference(ctx RoleContext) {
 	fmt.Println()
 }
 
-// outputContextFile reads and displays the CONTEXT.md file from the town root.
-// This provides a simple plugin point for operators to inject custom instructions
-// that all agents (including polecats) will see during priming.
-func outputContextFile(ctx RoleContext) {
+// contextFileText returns the CONTEXT.md file from the town root, ready to
+// print. This provides a simple plugin point for operators to inject custom
+// instructions that all agents (including polecats) will see during priming.
+func contextFileText(ctx RoleContext) string {
 	contextPath := filepath.Join(ctx.TownRoot, "CONTEXT.md")
 	data, err := os.ReadFile(contextPath)
 	if err != nil {
 		explain(true, "CONTEXT.md: not found at "+contextPath)
-		return
+		return ""
 	}
 	explain(true, "CONTEXT.md: found at "+contextPath+", injecting contents")
+	return "\n" + string(data)
+}
+
+// rigConventionsText returns the rig's compiled conventions summary (see gt
+// conventions build), ready to print, for agents working in a rig.
+func rigConventionsText(ctx RoleContext) string {
+	if ctx.Rig == "" {
+		return ""
+	}
+	summary := conventions.Load(filepath.Join(ctx.TownRoot, ctx.Rig))
+	if summary == "" {
+		explain(true, "Rig conventions: no summary built for "+ctx.Rig)
+		return ""
+	}
+	explain(true, "Rig conventions: injecting summary for "+ctx.Rig)
+	return "\n" + summary
+}
+
+// outputPatrolDryRunNotice tells a patrol agent in dry-run mode that this
+// cycle is read-only. The dry-run guard enforces it; this is what makes the
+// agent plan for it instead of tripping over blocked commands.
+func outputPatrolDryRunNotice(ctx RoleContext) {
+	patrol, agent, ok := patrolIdentity(ctx)
+	if !ok || !config.IsPatrolDryRun(ctx.TownRoot, patrol, agent, patrolDryRunWisp(ctx.TownRoot, agent)) {
+		return
+	}
+	explain(true, "Patrol dry run: "+agent+" is in dry-run mode")
+	fmt.Println()
+	fmt.Println("## ⏸ DRY RUN: READ-ONLY PATROL")
+	fmt.Println()
+	fmt.Println("This patrol cycle is a dry run. Run your patrol as usual, but take no actions:")
+	fmt.Println("- Do not edit files, commit, push, merge, or change branches")
+	fmt.Println("- Do not create, update, or close beads other than your own patrol wisp steps")
+	fmt.Println("- Do not sling, nudge, mail, escalate, nuke, or restart anything")
+	fmt.Println()
+	fmt.Println("Wherever the patrol says to act, write down instead exactly what you would have")
+	fmt.Println("done and why. Put those findings in the cycle's report:")
+	fmt.Println()
+	fmt.Println("    gt patrol report --summary \"Would have: ...\"")
 	fmt.Println()
-	fmt.Print(string(data))
+	fmt.Println("The report is marked advisory. Mutating commands are blocked by a guard.")
 }
 
 // outputHandoffContent reads and displays the pinned handoff bead for the role.
@@ -564,7 +619,7 @@ func outputHandoffWarning(prevSession string) {
 		fmt.Printf("Your predecessor (%s) handed off to you.\n", prevSession)
 	}
 	fmt.Println()
-	fmt.Println(style.Bold.Render("⚠️  DO NOT run /handoff - that was your predecessor's action."))
+	fmt.Println(style.Bold.Render(ui.Glyph("⚠️") + "  DO NOT run /handoff - that was your predecessor's action."))
 	fmt.Println("   The /handoff you see in context is NOT a request for you.")
 	fmt.Println()
 	fmt.Println("Instead: Check your hook (`" + cli.Name() + " mol status`) and mail (`" + cli.Name() + " mail inbox`).")
@@ -1,16 +1,30 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/branchsweep"
+	"github.com/steveyegge/gastown/internal/config"
 	gitpkg "github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 var (
 	pruneBranchesDryRun  bool
 	pruneBranchesPattern string
+	pruneBranchesStale   bool
+	pruneBranchesRig     string
+	pruneBranchesMaxAge  time.Duration
+	pruneBranchesRemote  bool
+	pruneBranchesJSON    bool
 )
 
 var pruneBranchesCmd = &cobra.Command{
@@ -32,21 +46,39 @@ polecat/*) that are either:
 Safety: Uses git branch -d (not -D) so only fully-merged branches are deleted.
 Never deletes the current branch or the default branch.
 
+With --stale, sweeps the shared repo of every rig (or --rig) instead: wisp
+branches whose wisp closed, or was abandoned, more than --max-age ago are
+deleted. A branch whose changes aren't on the default branch (even as a
+squash) is first saved as a patch under .runtime/branch-patches/<rig>/.
+Branches checked out in a worktree, or whose wisp can't be identified from
+the branch name, are kept. The daemon's branch_sweep patrol runs the same
+sweep on a schedule.
+
 Examples:
   gt prune-branches              # Clean up stale polecat branches
   gt prune-branches --dry-run    # Show what would be deleted
-  gt prune-branches --pattern "feature/*"  # Custom pattern`,
+  gt prune-branches --pattern "feature/*"  # Custom pattern
+  gt prune-branches --stale --dry-run      # Preview the stale wisp branch sweep
+  gt prune-branches --stale --rig gastown --max-age 72h --remote`,
 	RunE: runPruneBranches,
 }
 
 func init() {
 	pruneBranchesCmd.Flags().BoolVar(&pruneBranchesDryRun, "dry-run", false, "Show what would be deleted without deleting")
 	pruneBranchesCmd.Flags().StringVar(&pruneBranchesPattern, "pattern", "polecat/*", "Branch name pattern to match")
+	pruneBranchesCmd.Flags().BoolVar(&pruneBranchesStale, "stale", false, "Sweep wisp branches of finished wisps in every rig's shared repo")
+	pruneBranchesCmd.Flags().StringVar(&pruneBranchesRig, "rig", "", "With --stale: sweep only this rig")
+	pruneBranchesCmd.Flags().DurationVar(&pruneBranchesMaxAge, "max-age", branchsweep.DefaultMaxAge, "With --stale: keep branches whose wisp finished more recently")
+	pruneBranchesCmd.Flags().BoolVar(&pruneBranchesRemote, "remote", false, "With --stale: also delete merged branches on origin")
+	pruneBranchesCmd.Flags().BoolVar(&pruneBranchesJSON, "json", false, "With --stale: output as JSON")
 
 	rootCmd.AddCommand(pruneBranchesCmd)
 }
 
 func runPruneBranches(cmd *cobra.Command, args []string) error {
+	if pruneBranchesStale {
+		return runSweepStaleBranches()
+	}
 	g := gitpkg.NewGit(".")
 	if !g.IsRepo() {
 		return fmt.Errorf("not a git repository")
@@ -55,7 +87,7 @@ func runPruneBranches(cmd *cobra.Command, args []string) error {
 	// Run fetch --prune first to clean up stale remote tracking refs
 	if err := g.FetchPrune("origin"); err != nil {
 		// Non-fatal: we can still prune based on current state
-		fmt.Printf("%s Warning: git fetch --prune failed: %v\n", style.Warning.Render("⚠"), err)
+		fmt.Printf("%s Warning: git fetch --prune failed: %v\n", style.WarningPrefix, err)
 	}
 
 	pruned, err := g.PruneStaleBranches(pruneBranchesPattern, pruneBranchesDryRun)
@@ -64,14 +96,14 @@ func runPruneBranches(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(pruned) == 0 {
-		fmt.Printf("%s No stale branches found matching %q\n", style.Bold.Render("✓"), pruneBranchesPattern)
+		fmt.Printf("%s No stale branches found matching %q\n", style.Bold.Render(ui.Glyph(ui.IconPass)), pruneBranchesPattern)
 		return nil
 	}
 
 	if pruneBranchesDryRun {
-		fmt.Printf("%s Would prune %d branch(es):\n\n", style.Warning.Render("⚠"), len(pruned))
+		fmt.Printf("%s Would prune %d branch(es):\n\n", style.WarningPrefix, len(pruned))
 	} else {
-		fmt.Printf("%s Pruned %d branch(es):\n\n", style.Bold.Render("✓"), len(pruned))
+		fmt.Printf("%s Pruned %d branch(es):\n\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(pruned))
 	}
 
 	for _, b := range pruned {
@@ -85,7 +117,7 @@ func runPruneBranches(cmd *cobra.Command, args []string) error {
 			reasonStr = "remote deleted, merged to main"
 		}
 		fmt.Printf("  %s %s (%s)\n",
-			style.Dim.Render("•"),
+			style.Dim.Render(ui.Glyph("•")),
 			b.Name,
 			style.Dim.Render(reasonStr))
 	}
@@ -93,3 +125,79 @@ func runPruneBranches(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// runSweepStaleBranches sweeps the wisp branches of finished wisps.
+func runSweepStaleBranches() error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	rigs := []string{pruneBranchesRig}
+	if pruneBranchesRig == "" {
+		rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+		if err != nil {
+			return fmt.Errorf("loading rigs config: %w", err)
+		}
+		rigs = rigs[:0]
+		for name := range rigsConfig.Rigs {
+			rigs = append(rigs, name)
+		}
+		sort.Strings(rigs)
+	}
+
+	sweeper := branchsweep.New(townRoot, branchsweep.Options{
+		MaxAge:       pruneBranchesMaxAge,
+		DeleteRemote: pruneBranchesRemote,
+		DryRun:       pruneBranchesDryRun,
+	})
+	swept := []branchsweep.Swept{}
+	var failures []string
+	for _, rigName := range rigs {
+		result, err := sweeper.SweepRig(rigName)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", rigName, err))
+			continue
+		}
+		swept = append(swept, result...)
+	}
+
+	if pruneBranchesJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(swept); err != nil {
+			return err
+		}
+	} else {
+		printSweptBranches(swept)
+	}
+	for _, f := range failures {
+		fmt.Fprintf(os.Stderr, "%s %s\n", style.WarningPrefix, f)
+	}
+	if len(failures) > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+func printSweptBranches(swept []branchsweep.Swept) {
+	if len(swept) == 0 {
+		fmt.Printf("%s No stale wisp branches\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
+		return
+	}
+	if pruneBranchesDryRun {
+		fmt.Printf("%s Would sweep %d branch(es):\n\n", style.WarningPrefix, len(swept))
+	} else {
+		fmt.Printf("%s Swept %d branch(es):\n\n", style.Bold.Render(ui.Glyph(ui.IconPass)), len(swept))
+	}
+	for _, s := range swept {
+		detail := fmt.Sprintf("%s %s, %s", s.Bead, s.Status, s.Reason)
+		if s.Remote {
+			detail += ", remote deleted"
+		}
+		fmt.Printf("  %s %s/%s (%s)\n", style.Dim.Render(ui.Glyph("•")), s.Rig, s.Branch, style.Dim.Render(detail))
+		if s.Patch != "" {
+			fmt.Printf("      patch: %s\n", s.Patch)
+		}
+	}
+	fmt.Println()
+}
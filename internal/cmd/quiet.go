@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/quiethours"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	quietJSON  bool
+	quietForce bool
+)
+
+var quietCmd = &cobra.Command{
+	Use:     "quiet",
+	GroupID: GroupComm,
+	Short:   "Show notification quiet hours and the held digest",
+	Long: `Show which notification channels are in quiet hours and what is held
+for the digest.
+
+During a channel's quiet hours only critical notifications go out. Others
+(doctor findings, overdue inbox items, non-critical escalation pings) are
+held and sent as one digest per recipient when the window ends. The daemon
+runs "gt quiet flush" every heartbeat.
+
+Configure in settings/config.json (windows may cross midnight; a channel
+with an empty window is never quiet):
+  "quiet_hours": {
+    "timezone": "America/New_York",
+    "start": "23:00", "end": "08:00",
+    "channels": {"mail": {"start": "", "end": ""}}
+  }
+
+Channels: mail, slack, email, sms. Until gt can post to slack, email, and
+sms, digests held on those channels are mailed to the overseer.
+
+Examples:
+  gt quiet               # Channel status and held notifications
+  gt quiet flush         # Send digests for channels out of quiet hours
+  gt quiet flush --force # Send everything held now`,
+	Args: cobra.NoArgs,
+	RunE: runQuietStatus,
+}
+
+var quietFlushCmd = &cobra.Command{
+	Use:   "flush",
+	Short: "Send held notifications whose quiet hours have ended",
+	Args:  cobra.NoArgs,
+	RunE:  runQuietFlush,
+}
+
+func init() {
+	quietCmd.Flags().BoolVar(&quietJSON, "json", false, "Output as JSON")
+	quietFlushCmd.Flags().BoolVar(&quietForce, "force", false, "Send everything held, even during quiet hours")
+	quietCmd.AddCommand(quietFlushCmd)
+	rootCmd.AddCommand(quietCmd)
+}
+
+// quietChannels are the notification channels quiet hours apply to.
+var quietChannels = []string{quiethours.ChannelMail, quiethours.ChannelSlack, quiethours.ChannelEmail, quiethours.ChannelSMS}
+
+func runQuietStatus(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := quiethours.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	held, err := quiethours.Pending(townRoot)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	if quietJSON {
+		quiet := map[string]bool{}
+		for _, ch := range quietChannels {
+			quiet[ch] = policy.Quiet(ch, now)
+		}
+		if held == nil {
+			held = []quiethours.Held{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(map[string]interface{}{
+			"timezone": policy.Location().String(),
+			"quiet":    quiet,
+			"held":     held,
+		})
+	}
+
+	fmt.Printf("%s %s (%s)\n\n", style.Bold.Render("Quiet hours"),
+		now.In(policy.Location()).Format("15:04"), policy.Location())
+	for _, ch := range quietChannels {
+		state := style.Success.Render("sending")
+		if policy.Quiet(ch, now) {
+			state = style.Warning.Render("quiet (critical only)")
+		}
+		fmt.Printf("  %-6s %s\n", ch, state)
+	}
+	fmt.Println()
+	if len(held) == 0 {
+		fmt.Println("Nothing held.")
+		return nil
+	}
+	fmt.Printf("%d held notification(s):\n", len(held))
+	for _, h := range held {
+		to := h.To
+		if to == "" {
+			to = "-"
+		}
+		fmt.Printf("  %s  %-5s %-12s %s\n", h.At.In(policy.Location()).Format("01-02 15:04"), h.Channel, to, h.Subject)
+	}
+	return nil
+}
+
+func runQuietFlush(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	policy, err := quiethours.LoadPolicy(townRoot)
+	if err != nil {
+		return err
+	}
+	due, err := policy.TakeDue(townRoot, time.Now(), quietForce)
+	if err != nil || len(due) == 0 {
+		return err
+	}
+
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	for _, group := range groupHeld(due) {
+		h := group[0]
+		subject := fmt.Sprintf("DIGEST: %d notification(s) held during quiet hours", len(group))
+		var body strings.Builder
+		for _, g := range group {
+			fmt.Fprintf(&body, "── %s (%s)\n", g.Subject, g.At.In(policy.Location()).Format("01-02 15:04"))
+			if g.Body != "" {
+				fmt.Fprintf(&body, "%s\n", strings.TrimRight(g.Body, "\n"))
+			}
+			body.WriteString("\n")
+		}
+		to, via := h.To, ""
+		if h.Channel != quiethours.ChannelMail {
+			// External channels are not wired to a sender yet (see
+			// executeExternalActions), so their digests go to the overseer,
+			// the human they were meant for, by mail.
+			to, via = "overseer", " by mail"
+			subject = fmt.Sprintf("DIGEST: %d %s notification(s) held during quiet hours", len(group), h.Channel)
+		}
+		if err := router.Send(&mail.Message{From: "digest", To: to, Subject: subject, Body: body.String()}); err != nil {
+			style.PrintWarning("could not send %s digest to %s: %v", h.Channel, to, err)
+			// Put the group back so the digest isn't lost.
+			for _, g := range group {
+				_ = quiethours.Hold(townRoot, g)
+			}
+			continue
+		}
+		fmt.Printf("%s Sent %s digest (%d) to %s%s\n", style.SuccessPrefix, h.Channel, len(group), to, via)
+	}
+	return nil
+}
+
+// groupHeld splits due notifications (sorted by channel and recipient) into
+// one group per digest.
+func groupHeld(due []quiethours.Held) [][]quiethours.Held {
+	var groups [][]quiethours.Held
+	for i, h := range due {
+		if i == 0 || h.Channel != due[i-1].Channel || h.To != due[i-1].To {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], h)
+	}
+	return groups
+}
+
+// sendOrHoldMail sends a notification mail now, or holds it for the digest
+// if the mail channel is in quiet hours and the mail is not critical.
+// Returns true if the mail was held.
+func sendOrHoldMail(townRoot string, router *mail.Router, msg *mail.Message, critical bool) (bool, error) {
+	if policy, err := quiethours.LoadPolicy(townRoot); err == nil && policy.Hold(quiethours.ChannelMail, critical, time.Now()) {
+		return true, quiethours.Hold(townRoot, quiethours.Held{
+			Channel: quiethours.ChannelMail,
+			To:      msg.To,
+			Subject: msg.Subject,
+			Body:    msg.Body,
+		})
+	}
+	return false, router.Send(msg)
+}
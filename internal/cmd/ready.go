@@ -121,24 +121,13 @@ func runReady(cmd *cobra.Command, args []string) error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			townBeadsPath := beads.GetTownBeadsPath(townRoot)
-			townBeads := beads.New(townBeadsPath)
-			issues, err := townBeads.Ready()
+			issues, err := readyWork(beads.GetTownBeadsPath(townRoot))
 
 			mu.Lock()
 			defer mu.Unlock()
-			src := ReadySource{Name: "town"}
+			src := ReadySource{Name: "town", Issues: issues}
 			if err != nil {
 				src.Error = err.Error()
-			} else {
-				// Filter out formula scaffolds (gt-579)
-				formulaNames := getFormulaNames(townBeadsPath)
-				filtered := filterFormulaScaffolds(issues, formulaNames)
-				// Defense-in-depth: also filter wisps that shouldn't appear in ready work
-				wispIDs := getWispIDs(townBeadsPath)
-				filtered = filterWisps(filtered, wispIDs)
-				// Filter identity beads (agents, roles, rigs) - not actionable work
-				src.Issues = filterIdentityBeads(filtered)
 			}
 			sources = append(sources, src)
 		}()
@@ -151,23 +140,13 @@ func runReady(cmd *cobra.Command, args []string) error {
 			defer wg.Done()
 			// Use rig root path where rig-level beads are stored
 			// BeadsPath returns rig root; redirect system handles mayor/rig routing
-			rigBeads := beads.New(r.BeadsPath())
-			issues, err := rigBeads.Ready()
+			issues, err := readyWork(r.BeadsPath())
 
 			mu.Lock()
 			defer mu.Unlock()
-			src := ReadySource{Name: r.Name}
+			src := ReadySource{Name: r.Name, Issues: issues}
 			if err != nil {
 				src.Error = err.Error()
-			} else {
-				// Filter out formula scaffolds (gt-579)
-				formulaNames := getFormulaNames(r.BeadsPath())
-				filtered := filterFormulaScaffolds(issues, formulaNames)
-				// Defense-in-depth: also filter wisps that shouldn't appear in ready work
-				wispIDs := getWispIDs(r.BeadsPath())
-				filtered = filterWisps(filtered, wispIDs)
-				// Filter identity beads (agents, roles, rigs) - not actionable work
-				src.Issues = filterIdentityBeads(filtered)
 			}
 			sources = append(sources, src)
 		}(r)
@@ -253,6 +232,21 @@ func runReady(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// readyWork returns the actionable ready beads at beadsPath: bd ready
+// without formula scaffolds, wisps, or identity beads.
+func readyWork(beadsPath string) ([]*beads.Issue, error) {
+	issues, err := beads.New(beadsPath).Ready()
+	if err != nil {
+		return nil, err
+	}
+	// Filter out formula scaffolds (gt-579)
+	filtered := filterFormulaScaffolds(issues, getFormulaNames(beadsPath))
+	// Defense-in-depth: also filter wisps that shouldn't appear in ready work
+	filtered = filterWisps(filtered, getWispIDs(beadsPath))
+	// Filter identity beads (agents, roles, rigs) - not actionable work
+	return filterIdentityBeads(filtered), nil
+}
+
 func printReadyHuman(result ReadyResult) error {
 	if result.Summary.Total == 0 {
 		fmt.Println("No ready work across town.")
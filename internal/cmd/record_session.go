@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/sessionindex"
+)
+
+var recordSessionCmd = &cobra.Command{
+	Use:   "record-session <log>",
+	Short: "Append time-stamped pane output to a session log (internal use)",
+	Long: `Read pane output from stdin and append it to a session log, each line
+prefixed with the time it was written.
+
+Called internally by tmux pipe-pane for every rig session, so that
+gt grep-sessions can search and date recorded output.`,
+	Hidden: true, // Internal command called by tmux
+	Args:   cobra.ExactArgs(1),
+	RunE:   runRecordSession,
+}
+
+func init() {
+	rootCmd.AddCommand(recordSessionCmd)
+}
+
+func runRecordSession(cmd *cobra.Command, args []string) error {
+	f, err := os.OpenFile(args[0], os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644) //nolint:gosec // G304: path is the session log tmux was given
+	if err != nil {
+		return fmt.Errorf("opening session log: %w", err)
+	}
+	defer f.Close()
+	return sessionindex.Record(f, os.Stdin)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -312,7 +313,7 @@ func runRefineryStart(cmd *cobra.Command, args []string) error {
 
 	if err := mgr.Start(refineryForeground, refineryAgentOverride); err != nil {
 		if err == refinery.ErrAlreadyRunning {
-			fmt.Printf("%s Refinery is already running\n", style.Dim.Render("⚠"))
+			fmt.Printf("%s Refinery is already running\n", style.Dim.Render(ui.Glyph(ui.IconWarn)))
 			return nil
 		}
 		return fmt.Errorf("starting refinery: %w", err)
@@ -323,7 +324,7 @@ func runRefineryStart(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	fmt.Printf("%s Refinery started for %s\n", style.Bold.Render("✓"), rigName)
+	fmt.Printf("%s Refinery started for %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rigName)
 	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt refinery status' to check progress"))
 	return nil
 }
@@ -341,13 +342,13 @@ func runRefineryStop(cmd *cobra.Command, args []string) error {
 
 	if err := mgr.Stop(); err != nil {
 		if err == refinery.ErrNotRunning {
-			fmt.Printf("%s Refinery is not running\n", style.Dim.Render("⚠"))
+			fmt.Printf("%s Refinery is not running\n", style.Dim.Render(ui.Glyph(ui.IconWarn)))
 			return nil
 		}
 		return fmt.Errorf("stopping refinery: %w", err)
 	}
 
-	fmt.Printf("%s Refinery stopped for %s\n", style.Bold.Render("✓"), rigName)
+	fmt.Printf("%s Refinery stopped for %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rigName)
 	return nil
 }
 
@@ -397,12 +398,12 @@ func runRefineryStatus(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s Refinery: %s\n\n", style.Bold.Render("⚙"), rigName)
 
 	if running {
-		fmt.Printf("  State: %s\n", style.Bold.Render("● running"))
+		fmt.Printf("  State: %s\n", style.Bold.Render(ui.Glyph("●")+" running"))
 		if sessionInfo != nil {
 			fmt.Printf("  Session: %s\n", sessionInfo.Name)
 		}
 	} else {
-		fmt.Printf("  State: %s\n", style.Dim.Render("○ stopped"))
+		fmt.Printf("  State: %s\n", style.Dim.Render(ui.Glyph("○")+" stopped"))
 	}
 
 	fmt.Printf("\n  Queue: %d pending\n", queueLen)
@@ -518,7 +519,7 @@ func runRefineryAttach(cmd *cobra.Command, args []string) error {
 		if err := mgr.Start(false, refineryAgentOverride); err != nil {
 			return fmt.Errorf("starting refinery: %w", err)
 		}
-		fmt.Printf("%s Refinery started\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Refinery started\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	// Attach to session using exec to properly forward TTY
@@ -552,7 +553,7 @@ func runRefineryRestart(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("starting refinery: %w", err)
 	}
 
-	fmt.Printf("%s Refinery restarted for %s\n", style.Bold.Render("✓"), rigName)
+	fmt.Printf("%s Refinery restarted for %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rigName)
 	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt refinery attach' to connect"))
 	return nil
 }
@@ -589,7 +590,7 @@ func runRefineryClaim(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("claiming MR: %w", err)
 	}
 
-	fmt.Printf("%s Claimed %s for %s\n", style.Bold.Render("✓"), mrID, workerID)
+	fmt.Printf("%s Claimed %s for %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), mrID, workerID)
 	return nil
 }
 
@@ -616,7 +617,7 @@ func runRefineryRelease(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("releasing MR: %w", err)
 	}
 
-	fmt.Printf("%s Released %s back to queue\n", style.Bold.Render("✓"), mrID)
+	fmt.Printf("%s Released %s back to queue\n", style.Bold.Render(ui.Glyph(ui.IconPass)), mrID)
 	return nil
 }
 
@@ -743,7 +744,7 @@ func runRefineryReady(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(anomalies) > 0 {
-		fmt.Printf("\n%s Queue anomalies:\n\n", style.Bold.Render("⚠"))
+		fmt.Printf("\n%s Queue anomalies:\n\n", style.Bold.Render(ui.Glyph(ui.IconWarn)))
 		for i, anomaly := range anomalies {
 			line := fmt.Sprintf("  %d. [%s] %s", i+1, anomaly.Type, anomaly.ID)
 			fmt.Println(line)
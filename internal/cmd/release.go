@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 var releaseReason string
@@ -57,10 +58,10 @@ func runRelease(cmd *cobra.Command, args []string) error {
 		}
 
 		if err != nil {
-			fmt.Printf("%s Failed to release %s: %v\n", style.Dim.Render("✗"), id, err)
+			fmt.Printf("%s Failed to release %s: %v\n", style.Dim.Render(ui.Glyph("✗")), id, err)
 			failed++
 		} else {
-			fmt.Printf("%s Released %s → open\n", style.Bold.Render("✓"), id)
+			fmt.Printf("%s Released %s → open\n", style.Bold.Render(ui.Glyph(ui.IconPass)), id)
 			released++
 		}
 	}
@@ -10,15 +10,21 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/memory"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 const memoryKeyPrefix = "memory."
 
-var rememberKey string
+var (
+	rememberKey  string
+	rememberSelf bool
+)
 
 func init() {
 	rememberCmd.Flags().StringVar(&rememberKey, "key", "", "Explicit key slug (default: auto-generated from content)")
+	rememberCmd.Flags().BoolVar(&rememberSelf, "self", false, "Store in your own agent memory instead of the shared town memories")
 	rememberCmd.GroupID = GroupWork
 	rootCmd.AddCommand(rememberCmd)
 }
@@ -34,10 +40,16 @@ This replaces filesystem-based MEMORY.md with bead-backed storage.
 The key is auto-generated from the content if not specified.
 Use --key to provide an explicit slug for easy retrieval.
 
+With --self, the note goes into your own agent memory instead: a private,
+append-only list of distilled learnings (conventions you discovered,
+recurring failure causes) that only your future sessions are primed with.
+Keep self notes short; prime includes the newest that fit a size budget.
+
 Examples:
   gt remember "Refinery uses worktree, cannot checkout main"
   gt remember --key refinery-worktree "Refinery uses worktree, cannot checkout main"
-  gt remember "Always use --stdin for multi-line mail"`,
+  gt remember "Always use --stdin for multi-line mail"
+  gt remember --self "make test needs DOLT_PORT set in this rig"`,
 	Args: cobra.ExactArgs(1),
 	RunE: runRemember,
 }
@@ -47,6 +59,12 @@ func runRemember(cmd *cobra.Command, args []string) error {
 	if strings.TrimSpace(content) == "" {
 		return fmt.Errorf("memory content cannot be empty")
 	}
+	if rememberSelf {
+		if rememberKey != "" {
+			return fmt.Errorf("--key does not apply to --self notes")
+		}
+		return rememberForSelf(content)
+	}
 
 	key := rememberKey
 	if key == "" {
@@ -69,10 +87,36 @@ func runRemember(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("storing memory: %w", err)
 	}
 
-	fmt.Printf("%s %s memory: %s\n", style.Success.Render("✓"), verb, style.Bold.Render(key))
+	fmt.Printf("%s %s memory: %s\n", style.SuccessPrefix, verb, style.Bold.Render(key))
 	return nil
 }
 
+// rememberForSelf appends a note to the current agent's own memory.
+func rememberForSelf(content string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	agent, err := selfAgent()
+	if err != nil {
+		return err
+	}
+	if err := memory.Append(townRoot, agent, content); err != nil {
+		return fmt.Errorf("storing note: %w", err)
+	}
+	fmt.Printf("%s Remembered for %s\n", style.SuccessPrefix, style.Bold.Render(agent))
+	return nil
+}
+
+// selfAgent returns the current agent's identity for its own memory.
+func selfAgent() (string, error) {
+	agent := detectActor()
+	if agent == "unknown" || agent == "" {
+		return "", fmt.Errorf("cannot tell which agent you are; run from an agent's workspace")
+	}
+	return agent, nil
+}
+
 // autoKey generates a short key from content using first few meaningful words.
 func autoKey(content string) string {
 	// Take first ~5 words, lowercase, hyphenate
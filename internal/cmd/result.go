@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/agentresult"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	resultBead   string
+	resultDryRun bool
+	resultJSON   bool
+)
+
+var resultCmd = &cobra.Command{
+	Use:     "result",
+	GroupID: GroupComm,
+	Short:   "Collect structured results returned by agents",
+	Long: `Collect structured results (verdicts, artifacts, follow-ups) from agents.
+
+Agents return results as JSON, either in a fenced block in their output:
+
+  ` + "```gt:result" + `
+  {"kind": "verdict", "bead": "gt-abc12", "verdict": "approve", "summary": "LGTM"}
+  ` + "```" + `
+
+or as a file dropped in their worktree under ` + agentresult.DropDir + `/*.json
+(one object or an array). The file drop is the reliable path; fenced blocks
+are parsed from the pane on a best-effort basis.
+
+Kinds:
+  verdict     verdict: approve | reject | needs_changes (summary required
+              unless approving). Recorded as a comment on the bead.
+  artifacts   artifacts: [{path, description}]. One comment per artifact.
+  followups   followups: [{title, description, type, priority}]. Each is
+              filed as a new bead and noted on the source bead.
+
+Results without a "bead" apply to the agent's hooked bead. gt done collects
+a polecat's results automatically before it goes idle.`,
+	RunE: requireSubcommand,
+}
+
+var resultCollectCmd = &cobra.Command{
+	Use:   "collect <agent>",
+	Short: "Extract, validate, and route an agent's results",
+	Long: `Extract an agent's results from its pane and worktree, validate them,
+and route them to beads.
+
+Dropped files are removed once routed; pane results are remembered so a
+block still on screen isn't routed twice. Invalid results are reported and
+left in place.
+
+Examples:
+  gt result collect gastown/polecats/Toast
+  gt result collect gastown/crew/max --bead gt-abc12
+  gt result collect gastown/witness --dry-run --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runResultCollect,
+}
+
+var resultCheckCmd = &cobra.Command{
+	Use:   "check [file]",
+	Short: "Validate result JSON or output containing gt:result blocks",
+	Long: `Validate results without routing them. Reads the file, or stdin when no
+file is given. Agents can use this to check a result before dropping it.
+
+Examples:
+  gt result check .runtime/results/review.json
+  echo '{"kind":"verdict","verdict":"approve"}' | gt result check`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runResultCheck,
+}
+
+func init() {
+	resultCollectCmd.Flags().StringVar(&resultBead, "bead", "", "Bead for results that name none (default: the agent's hooked bead)")
+	resultCollectCmd.Flags().BoolVar(&resultDryRun, "dry-run", false, "Show what would be routed without routing it")
+	resultCollectCmd.Flags().BoolVar(&resultJSON, "json", false, "Output as JSON")
+
+	resultCmd.AddCommand(resultCollectCmd)
+	resultCmd.AddCommand(resultCheckCmd)
+	rootCmd.AddCommand(resultCmd)
+}
+
+func runResultCollect(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	id, err := session.ParseAddress(args[0])
+	if err != nil {
+		return err
+	}
+	address := id.Address()
+	t := tmux.NewTmux()
+	sessionID := id.SessionName()
+	if running, _ := t.HasSession(sessionID); !running {
+		return fmt.Errorf("%s is not running", address)
+	}
+	workDir, err := t.GetPaneWorkDir(sessionID)
+	if err != nil {
+		return fmt.Errorf("finding %s's worktree: %w", address, err)
+	}
+
+	pane, _ := t.CapturePaneAll(sessionID)
+	bead := resultBead
+	if bead == "" {
+		bead = agentHookedBead(townRoot, id)
+	}
+	c, err := collectAgentResults(townRoot, id, pane, workDir, bead, resultDryRun)
+	if err != nil {
+		return err
+	}
+
+	if resultJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(struct {
+			Routed  []*agentresult.Routed `json:"routed"`
+			Invalid []string              `json:"invalid,omitempty"`
+			Failed  []string              `json:"failed,omitempty"`
+		}{c.Routed, c.Invalid, c.Failed}); err != nil {
+			return err
+		}
+	} else {
+		c.print(resultDryRun)
+	}
+	if len(c.Invalid) > 0 || len(c.Failed) > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// resultCollection is the outcome of collecting one agent's results.
+type resultCollection struct {
+	Routed  []*agentresult.Routed
+	Invalid []string // Results that failed to parse or validate
+	Failed  []string // Valid results that could not be routed
+}
+
+// collectAgentResults extracts an agent's results from its pane output and
+// its worktree's drop directory, and routes them. Results without a bead
+// go to bead. Routed drop files are removed and routed pane results are
+// remembered; with dryRun nothing is routed or changed.
+func collectAgentResults(townRoot string, id *session.AgentIdentity, pane, workDir, bead string, dryRun bool) (*resultCollection, error) {
+	address := id.Address()
+
+	// Pane results first (best-effort), skipping those routed before.
+	c := &resultCollection{}
+	var results []agentresult.Result
+	routedBefore := agentresult.LoadRouted(workDir)
+	parsed, errs := agentresult.ParseOutput(pane)
+	for _, r := range parsed {
+		if !routedBefore[r.Digest()] {
+			results = append(results, r)
+		}
+	}
+	for _, e := range errs {
+		c.Invalid = append(c.Invalid, "pane: "+e.Error())
+	}
+	files, err := agentresult.DropFiles(workDir)
+	if err != nil {
+		return nil, err
+	}
+	routedFiles := map[string]bool{}
+	for _, f := range files {
+		parsed, err := agentresult.ReadDrop(f)
+		if err != nil {
+			c.Invalid = append(c.Invalid, err.Error())
+			continue
+		}
+		routedFiles[f] = true
+		results = append(results, parsed...)
+	}
+
+	beadsDir := townRoot
+	if id.Rig != "" {
+		beadsDir = filepath.Join(townRoot, id.Rig)
+	}
+	router := &agentresult.Router{Beads: beads.New(beadsDir), Actor: address, Role: string(id.Role)}
+
+	var paneDigests []string
+	for _, r := range results {
+		if dryRun {
+			target := r.Bead
+			if target == "" {
+				target = bead
+			}
+			c.Routed = append(c.Routed, &agentresult.Routed{Kind: r.Kind, Bead: target, Source: r.Source})
+			continue
+		}
+		out, err := router.Route(r, bead)
+		if err != nil {
+			c.Failed = append(c.Failed, fmt.Sprintf("%s (%s): %v", r.Kind, r.Source, err))
+			delete(routedFiles, r.Source)
+			continue
+		}
+		c.Routed = append(c.Routed, out)
+		if r.Source == "pane" {
+			paneDigests = append(paneDigests, r.Digest())
+		}
+		_ = events.LogFeed(events.TypeAgentResult, address, events.AgentResultPayload(address, out.Kind, out.Bead, out.Created))
+	}
+	if !dryRun {
+		if err := agentresult.MarkRouted(workDir, paneDigests); err != nil {
+			fmt.Fprintf(os.Stderr, "%s Could not record routed pane results: %v\n", style.WarningPrefix, err)
+		}
+		for f := range routedFiles {
+			_ = os.Remove(f)
+		}
+	}
+	return c, nil
+}
+
+// print reports the collection for a human.
+func (c *resultCollection) print(dryRun bool) {
+	routed, invalid, failed := c.Routed, c.Invalid, c.Failed
+	verb := "Routed"
+	if dryRun {
+		verb = "Would route"
+	}
+	if len(routed) == 0 && len(invalid) == 0 && len(failed) == 0 {
+		fmt.Printf("%s No new results\n", style.Dim.Render(ui.Glyph("○")))
+		return
+	}
+	for _, r := range routed {
+		line := fmt.Sprintf("%s %s %s", style.SuccessPrefix, verb, r.Kind)
+		if r.Bead != "" {
+			line += " → " + r.Bead
+		}
+		if len(r.Created) > 0 {
+			line += " (filed " + strings.Join(r.Created, ", ") + ")"
+		}
+		fmt.Println(line + style.Dim.Render("  ["+filepath.Base(r.Source)+"]"))
+	}
+	for _, msg := range invalid {
+		fmt.Printf("%s Invalid: %s\n", style.Error.Render(ui.Glyph("✗")), msg)
+	}
+	for _, msg := range failed {
+		fmt.Printf("%s Failed: %s\n", style.Error.Render(ui.Glyph("✗")), msg)
+	}
+}
+
+// agentHookedBead returns the bead hooked to an agent, or "" if none.
+func agentHookedBead(townRoot string, id *session.AgentIdentity) string {
+	a := &agentLifecycle{id: id, townRoot: townRoot}
+	return a.hookedBead()
+}
+
+func runResultCheck(cmd *cobra.Command, args []string) error {
+	var (
+		data []byte
+		err  error
+	)
+	if len(args) == 1 {
+		data, err = os.ReadFile(args[0])
+	} else {
+		data, err = io.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		return err
+	}
+
+	var results []agentresult.Result
+	if strings.Contains(string(data), "```"+agentresult.FenceTag) {
+		var errs []error
+		results, errs = agentresult.ParseOutput(string(data))
+		if len(errs) > 0 {
+			return errors.Join(errs...)
+		}
+	} else if results, err = agentresult.Decode(data, "input"); err != nil {
+		return err
+	}
+	for _, r := range results {
+		fmt.Printf("%s %s result is valid\n", style.SuccessPrefix, r.Kind)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/agentresult"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func TestCollectAgentResults_DryRun(t *testing.T) {
+	workDir := t.TempDir()
+	dropDir := filepath.Join(workDir, agentresult.DropDir)
+	if err := os.MkdirAll(dropDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	drop := filepath.Join(dropDir, "review.json")
+	if err := os.WriteFile(drop, []byte(`{"kind":"verdict","verdict":"approve"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	pane := "Done.\n```gt:result\n{\"kind\":\"followups\",\"bead\":\"gt-xyz\",\"followups\":[{\"title\":\"Flaky test\"}]}\n```\n" +
+		"```gt:result\n{\"kind\":\"verdict\",\"verdict\":\"maybe\"}\n```\n"
+
+	id := &session.AgentIdentity{Role: session.RolePolecat, Rig: "gastown", Name: "Toast"}
+	c, err := collectAgentResults(t.TempDir(), id, pane, workDir, "gt-abc", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Routed) != 2 || len(c.Invalid) != 1 || len(c.Failed) != 0 {
+		t.Fatalf("collection = %+v, want 2 routed and 1 invalid", c)
+	}
+	beadsByKind := map[string]string{}
+	for _, r := range c.Routed {
+		beadsByKind[r.Kind] = r.Bead
+	}
+	if beadsByKind["followups"] != "gt-xyz" || beadsByKind["verdict"] != "gt-abc" {
+		t.Errorf("routed beads = %v, want the named bead kept and the default applied", beadsByKind)
+	}
+	if _, err := os.Stat(drop); err != nil {
+		t.Errorf("dry run removed the drop file: %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // Resume command checks for handoff messages.
@@ -49,13 +50,13 @@ func checkHandoffMessages() error {
 		// Check for HANDOFF in output
 		outputStr := string(output)
 		if !containsHandoff(outputStr) {
-			fmt.Printf("%s No handoff messages in inbox\n", style.Dim.Render("○"))
+			fmt.Printf("%s No handoff messages in inbox\n", style.Dim.Render(ui.Glyph("○")))
 			fmt.Printf("  Handoff messages have 'HANDOFF' in the subject.\n")
 			return nil
 		}
 		fmt.Printf("%s Found handoff message(s):\n\n", style.Bold.Render("🤝"))
 		fmt.Println(outputStr)
-		fmt.Printf("\n%s Read with: gt mail read <id>\n", style.Bold.Render("→"))
+		fmt.Printf("\n%s Read with: gt mail read <id>\n", style.Bold.Render(ui.Glyph("→")))
 		return nil
 	}
 
@@ -79,7 +80,7 @@ func checkHandoffMessages() error {
 			fmt.Printf("%s Found handoff message(s):\n\n", style.Bold.Render("🤝"))
 			fmt.Println(outputStr)
 		} else {
-			fmt.Printf("%s No handoff messages in inbox\n", style.Dim.Render("○"))
+			fmt.Printf("%s No handoff messages in inbox\n", style.Dim.Render(ui.Glyph("○")))
 		}
 		return nil
 	}
@@ -106,7 +107,7 @@ func checkHandoffMessages() error {
 	}
 
 	if len(handoffs) == 0 {
-		fmt.Printf("%s No handoff messages in inbox\n", style.Dim.Render("○"))
+		fmt.Printf("%s No handoff messages in inbox\n", style.Dim.Render(ui.Glyph("○")))
 		fmt.Printf("  Handoff messages have 'HANDOFF' in the subject.\n")
 		fmt.Printf("  Use 'gt handoff -s \"...\"' to create one when handing off.\n")
 		return nil
@@ -128,9 +129,9 @@ func checkHandoffMessages() error {
 	}
 
 	if len(handoffs) == 1 {
-		fmt.Printf("%s Read full message: gt mail read %s\n", style.Bold.Render("→"), handoffs[0].ID)
+		fmt.Printf("%s Read full message: gt mail read %s\n", style.Bold.Render(ui.Glyph("→")), handoffs[0].ID)
 	} else {
-		fmt.Printf("%s Read messages: gt mail read <id>\n", style.Bold.Render("→"))
+		fmt.Printf("%s Read messages: gt mail read <id>\n", style.Bold.Render(ui.Glyph("→")))
 	}
 	fmt.Printf("%s Clear after reading: gt mail close <id>\n", style.Dim.Render("💡"))
 
@@ -2,6 +2,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/approval"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/crew"
@@ -25,6 +27,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/wisp"
 	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -111,6 +114,10 @@ kill them automatically.
 
 To fully remove a rig, delete the directory manually after unregistering.
 
+If the town's approval policy gates rig-remove (see 'gt approval'), removing
+a rig needs overseer approval: the first attempt files a request; re-run once
+it is approved.
+
 Examples:
   gt rig remove myproject                    # Unregister (fails if sessions running)
   gt rig remove myproject --force            # Kill sessions then unregister
@@ -217,7 +224,7 @@ Examples:
 
 var rigStatusCmd = &cobra.Command{
 	Use:        "status [rig]",
-	SuggestFor: []string{"health", "health-check", "healthcheck"},
+	SuggestFor: []string{"health-check", "healthcheck"},
 	Short:      "Show detailed status for a specific rig",
 	Long: `Show detailed status for a specific rig including all workers.
 
@@ -409,7 +416,7 @@ func checkUncommittedWork(r *rig.Rig, rigName, operation string, force bool) (pr
 	polecats, err := listPolecatsForWorkCheck(r)
 	if err != nil {
 		fmt.Printf("%s Could not check polecats for uncommitted work: %v\n",
-			style.Warning.Render("⚠"), err)
+			style.WarningPrefix, err)
 		return confirmUnsafeProceed(force)
 	}
 	if len(polecats) == 0 {
@@ -453,13 +460,13 @@ func checkUncommittedWork(r *rig.Rig, rigName, operation string, force bool) (pr
 
 	if len(problemPolecats) > 0 {
 		fmt.Printf("\n%s Cannot %s %s - polecats have uncommitted work:\n",
-			style.Warning.Render("⚠"), operation, rigName)
+			style.WarningPrefix, operation, rigName)
 		for _, pp := range problemPolecats {
 			fmt.Printf("  %s: %s\n", style.Bold.Render(pp.name), pp.status.String())
 		}
 	}
 	if len(checkErrors) > 0 {
-		fmt.Printf("\n%s Could not verify uncommitted work for:\n", style.Warning.Render("⚠"))
+		fmt.Printf("\n%s Could not verify uncommitted work for:\n", style.WarningPrefix)
 		for _, checkErr := range checkErrors {
 			fmt.Printf("  %s: %v\n", style.Bold.Render(checkErr.name), checkErr.err)
 		}
@@ -599,7 +606,7 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 		defaultBranch = rigCfg.DefaultBranch
 	}
 
-	fmt.Printf("\n%s Rig created in %.1fs\n", style.Success.Render("✓"), elapsed.Seconds())
+	fmt.Printf("\n%s Rig created in %.1fs\n", style.SuccessPrefix, elapsed.Seconds())
 	fmt.Printf("\nStructure:\n")
 	fmt.Printf("  %s/\n", name)
 	fmt.Printf("  ├── config.json\n")
@@ -766,13 +773,13 @@ func runRigList(cmd *cobra.Command, args []string) error {
 
 		fmt.Printf("%s%s%s\n", led, space, style.Bold.Render(ri.Name))
 
-		witnessIcon := style.Dim.Render("○")
+		witnessIcon := style.Dim.Render(ui.Glyph("○"))
 		if ri.Witness == "running" {
-			witnessIcon = style.Success.Render("●")
+			witnessIcon = style.Success.Render(ui.Glyph("●"))
 		}
-		refineryIcon := style.Dim.Render("○")
+		refineryIcon := style.Dim.Render(ui.Glyph("○"))
 		if ri.Refinery == "running" {
-			refineryIcon = style.Success.Render("●")
+			refineryIcon = style.Success.Render(ui.Glyph("●"))
 		}
 
 		fmt.Printf("   Witness: %s %s  Refinery: %s %s\n",
@@ -800,6 +807,15 @@ func runRigRemove(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("loading rigs config: %w", err)
 	}
 
+	// Removing a rig is an approval-gated action.
+	if err := requireApproval(context.Background(), townRoot, approvalGate{
+		Action: approval.ActionRigRemove,
+		Target: name,
+		Reason: "gt rig remove " + name,
+	}); err != nil {
+		return err
+	}
+
 	// Get the rig's beads prefix before removing (needed for route cleanup)
 	var beadsPrefix string
 	if entry, ok := rigsConfig.Rigs[name]; ok && entry.BeadsConfig != nil {
@@ -822,7 +838,7 @@ func runRigRemove(cmd *cobra.Command, args []string) error {
 	if len(sessions) > 0 {
 		if !rigRemoveForce {
 			fmt.Printf("%s Rig %s has %d running tmux session(s):\n",
-				style.Warning.Render("⚠"), name, len(sessions))
+				style.WarningPrefix, name, len(sessions))
 			for _, s := range sessions {
 				fmt.Printf("  - %s\n", s)
 			}
@@ -873,7 +889,7 @@ func runRigRemove(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Printf("%s Rig %s removed from registry\n", style.Success.Render("✓"), name)
+	fmt.Printf("%s Rig %s removed from registry\n", style.SuccessPrefix, name)
 	fmt.Printf("\nNote: Files at %s were NOT deleted.\n", filepath.Join(townRoot, name))
 	fmt.Printf("To delete: %s\n", style.Dim.Render(fmt.Sprintf("rm -rf %s", filepath.Join(townRoot, name))))
 
@@ -1055,7 +1071,7 @@ func runRigAdopt(_ *cobra.Command, args []string) error {
 			if err := mgr.InitBeads(rigPath, prefix, name); err != nil {
 				fmt.Printf("  %s Could not init bd database: %v\n", style.Warning.Render("!"), err)
 			} else {
-				fmt.Printf("  %s Initialized beads database (Dolt)\n", style.Success.Render("✓"))
+				fmt.Printf("  %s Initialized beads database (Dolt)\n", style.SuccessPrefix)
 			}
 		}
 		break
@@ -1070,14 +1086,14 @@ func runRigAdopt(_ *cobra.Command, args []string) error {
 		} else if err := mgr.InitBeads(rigPath, result.BeadsPrefix, name); err != nil {
 			fmt.Printf("  %s Could not init beads database: %v\n", style.Warning.Render("!"), err)
 		} else {
-			fmt.Printf("  %s Initialized beads database\n", style.Success.Render("✓"))
+			fmt.Printf("  %s Initialized beads database\n", style.SuccessPrefix)
 		}
 	}
 
 	// Print results
-	fmt.Printf("\n%s Rig %s adopted\n", style.Success.Render("✓"), name)
+	fmt.Printf("\n%s Rig %s adopted\n", style.SuccessPrefix, name)
 	if result.FromConfig {
-		fmt.Printf("  %s Read configuration from existing config.json\n", style.Dim.Render("ℹ"))
+		fmt.Printf("  %s Read configuration from existing config.json\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
 	}
 	fmt.Printf("  Repository: %s\n", result.GitURL)
 	fmt.Printf("  Prefix: %s\n", result.BeadsPrefix)
@@ -1127,7 +1143,7 @@ func runRigReset(cmd *cobra.Command, args []string) error {
 		if err := townBd.ClearHandoffContent(roleKey); err != nil {
 			return fmt.Errorf("clearing handoff content: %w", err)
 		}
-		fmt.Printf("%s Cleared handoff content for %s\n", style.Success.Render("✓"), roleKey)
+		fmt.Printf("%s Cleared handoff content for %s\n", style.SuccessPrefix, roleKey)
 	}
 
 	// Clear stale mail messages
@@ -1138,9 +1154,9 @@ func runRigReset(cmd *cobra.Command, args []string) error {
 		}
 		if result.Closed > 0 || result.Cleared > 0 {
 			fmt.Printf("%s Cleared mail: %d closed, %d pinned cleared\n",
-				style.Success.Render("✓"), result.Closed, result.Cleared)
+				style.SuccessPrefix, result.Closed, result.Cleared)
 		} else {
-			fmt.Printf("%s No mail to clear\n", style.Success.Render("✓"))
+			fmt.Printf("%s No mail to clear\n", style.SuccessPrefix)
 		}
 	}
 
@@ -1168,7 +1184,7 @@ func runResetStale(bd *beads.Beads, dryRun bool) error {
 	}
 
 	if len(issues) == 0 {
-		fmt.Printf("%s No in_progress issues found\n", style.Success.Render("✓"))
+		fmt.Printf("%s No in_progress issues found\n", style.SuccessPrefix)
 		return nil
 	}
 
@@ -1223,7 +1239,7 @@ func runResetStale(bd *beads.Beads, dryRun bool) error {
 				Assignee: &emptyAssignee,
 			}); err != nil {
 				fmt.Printf("  %s Failed to reset %s: %v\n",
-					style.Warning.Render("⚠"),
+					style.WarningPrefix,
 					issue.ID, err)
 				continue
 			}
@@ -1238,15 +1254,15 @@ func runResetStale(bd *beads.Beads, dryRun bool) error {
 				style.Dim.Render("(dry-run)"),
 				resetCount, skippedCount)
 		} else {
-			fmt.Printf("%s No stale issues found\n", style.Success.Render("✓"))
+			fmt.Printf("%s No stale issues found\n", style.SuccessPrefix)
 		}
 	} else {
 		if resetCount > 0 {
 			fmt.Printf("%s Reset %d stale issues: %v\n",
-				style.Success.Render("✓"),
+				style.SuccessPrefix,
 				resetCount, resetIssues)
 		} else {
-			fmt.Printf("%s No stale issues to reset\n", style.Success.Render("✓"))
+			fmt.Printf("%s No stale issues to reset\n", style.SuccessPrefix)
 		}
 		if skippedCount > 0 {
 			fmt.Printf("  Skipped %d persistent (crew) issues\n", skippedCount)
@@ -1360,10 +1376,10 @@ func runRigBoot(cmd *cobra.Command, args []string) error {
 
 	// Report results
 	if len(started) > 0 {
-		fmt.Printf("%s Started: %s\n", style.Success.Render("✓"), strings.Join(started, ", "))
+		fmt.Printf("%s Started: %s\n", style.SuccessPrefix, strings.Join(started, ", "))
 	}
 	if len(skipped) > 0 {
-		fmt.Printf("%s Skipped: %s\n", style.Dim.Render("•"), strings.Join(skipped, ", "))
+		fmt.Printf("%s Skipped: %s\n", style.Dim.Render(ui.Glyph("•")), strings.Join(skipped, ", "))
 	}
 
 	return nil
@@ -1393,7 +1409,7 @@ func runRigStart(cmd *cobra.Command, args []string) error {
 	for _, rigName := range args {
 		r, err := rigMgr.GetRig(rigName)
 		if err != nil {
-			fmt.Printf("%s Rig '%s' not found\n", style.Warning.Render("⚠"), rigName)
+			fmt.Printf("%s Rig '%s' not found\n", style.WarningPrefix, rigName)
 			failedRigs = append(failedRigs, rigName)
 			continue
 		}
@@ -1401,7 +1417,7 @@ func runRigStart(cmd *cobra.Command, args []string) error {
 		// Check if rig is parked or docked (uses bead labels + wisp state)
 		if blocked, reason := IsRigParkedOrDocked(townRoot, rigName); blocked {
 			fmt.Printf("%s Rig '%s' is %s - skipping (use 'gt rig unpark' or 'gt rig undock' first)\n",
-				style.Warning.Render("⚠"), rigName, reason)
+				style.WarningPrefix, rigName, reason)
 			continue
 		}
 
@@ -1423,7 +1439,7 @@ func runRigStart(cmd *cobra.Command, args []string) error {
 				if err == witness.ErrAlreadyRunning {
 					skipped = append(skipped, "witness")
 				} else {
-					fmt.Printf("  %s Failed to start witness: %v\n", style.Warning.Render("⚠"), err)
+					fmt.Printf("  %s Failed to start witness: %v\n", style.WarningPrefix, err)
 					hasError = true
 				}
 			} else {
@@ -1440,7 +1456,7 @@ func runRigStart(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  Starting refinery...\n")
 			refMgr := refinery.NewManager(r)
 			if err := refMgr.Start(false, ""); err != nil {
-				fmt.Printf("  %s Failed to start refinery: %v\n", style.Warning.Render("⚠"), err)
+				fmt.Printf("  %s Failed to start refinery: %v\n", style.WarningPrefix, err)
 				hasError = true
 			} else {
 				started = append(started, "refinery")
@@ -1449,10 +1465,10 @@ func runRigStart(cmd *cobra.Command, args []string) error {
 
 		// Report results for this rig
 		if len(started) > 0 {
-			fmt.Printf("  %s Started: %s\n", style.Success.Render("✓"), strings.Join(started, ", "))
+			fmt.Printf("  %s Started: %s\n", style.SuccessPrefix, strings.Join(started, ", "))
 		}
 		if len(skipped) > 0 {
-			fmt.Printf("  %s Skipped: %s (already running)\n", style.Dim.Render("•"), strings.Join(skipped, ", "))
+			fmt.Printf("  %s Skipped: %s (already running)\n", style.Dim.Render(ui.Glyph("•")), strings.Join(skipped, ", "))
 		}
 
 		if hasError {
@@ -1465,10 +1481,10 @@ func runRigStart(cmd *cobra.Command, args []string) error {
 
 	// Summary
 	if len(successRigs) > 0 {
-		fmt.Printf("%s Started rigs: %s\n", style.Success.Render("✓"), strings.Join(successRigs, ", "))
+		fmt.Printf("%s Started rigs: %s\n", style.SuccessPrefix, strings.Join(successRigs, ", "))
 	}
 	if len(failedRigs) > 0 {
-		fmt.Printf("%s Failed rigs: %s\n", style.Warning.Render("⚠"), strings.Join(failedRigs, ", "))
+		fmt.Printf("%s Failed rigs: %s\n", style.WarningPrefix, strings.Join(failedRigs, ", "))
 		return fmt.Errorf("some rigs failed to start")
 	}
 
@@ -1537,14 +1553,14 @@ func runRigShutdown(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(errors) > 0 {
-		fmt.Printf("\n%s Some agents failed to stop:\n", style.Warning.Render("⚠"))
+		fmt.Printf("\n%s Some agents failed to stop:\n", style.WarningPrefix)
 		for _, e := range errors {
 			fmt.Printf("  - %s\n", e)
 		}
 		return fmt.Errorf("shutdown incomplete")
 	}
 
-	fmt.Printf("%s Rig %s shut down successfully\n", style.Success.Render("✓"), rigName)
+	fmt.Printf("%s Rig %s shut down successfully\n", style.SuccessPrefix, rigName)
 	return nil
 }
 
@@ -1570,7 +1586,7 @@ func runRigReboot(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("boot failed: %w", err)
 	}
 
-	fmt.Printf("\n%s Rig %s rebooted successfully\n", style.Success.Render("✓"), rigName)
+	fmt.Printf("\n%s Rig %s rebooted successfully\n", style.SuccessPrefix, rigName)
 	return nil
 }
 
@@ -1623,9 +1639,9 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 	witMgr := witness.NewManager(r)
 	witnessRunning, _ := witMgr.IsRunning()
 	if witnessRunning {
-		fmt.Printf("  %s running\n", style.Success.Render("●"))
+		fmt.Printf("  %s running\n", style.Success.Render(ui.Glyph("●")))
 	} else {
-		fmt.Printf("  %s stopped\n", style.Dim.Render("○"))
+		fmt.Printf("  %s stopped\n", style.Dim.Render(ui.Glyph("○")))
 	}
 	fmt.Println()
 
@@ -1634,14 +1650,14 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 	refMgr := refinery.NewManager(r)
 	refineryRunning, _ := refMgr.IsRunning()
 	if refineryRunning {
-		fmt.Printf("  %s running\n", style.Success.Render("●"))
+		fmt.Printf("  %s running\n", style.Success.Render(ui.Glyph("●")))
 		// Show queue size
 		queue, err := refMgr.Queue()
 		if err == nil && len(queue) > 0 {
 			fmt.Printf("  Queue: %d items\n", len(queue))
 		}
 	} else {
-		fmt.Printf("  %s stopped\n", style.Dim.Render("○"))
+		fmt.Printf("  %s stopped\n", style.Dim.Render(ui.Glyph("○")))
 	}
 	fmt.Println()
 
@@ -1658,9 +1674,9 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 			sessionName := session.PolecatSessionName(session.PrefixFor(rigName), p.Name)
 			hasSession, _ := t.HasSession(sessionName)
 
-			sessionIcon := style.Dim.Render("○")
+			sessionIcon := style.Dim.Render(ui.Glyph("○"))
 			if hasSession {
-				sessionIcon = style.Success.Render("●")
+				sessionIcon = style.Success.Render(ui.Glyph("●"))
 			}
 
 			// Reconcile display state with tmux session liveness.
@@ -1696,9 +1712,9 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 			sessionName := crewSessionName(rigName, w.Name)
 			hasSession, _ := t.HasSession(sessionName)
 
-			sessionIcon := style.Dim.Render("○")
+			sessionIcon := style.Dim.Render(ui.Glyph("○"))
 			if hasSession {
-				sessionIcon = style.Success.Render("●")
+				sessionIcon = style.Success.Render(ui.Glyph("●"))
 			}
 
 			// Get git info
@@ -1743,7 +1759,7 @@ func runRigStop(cmd *cobra.Command, args []string) error {
 	for _, rigName := range args {
 		r, err := rigMgr.GetRig(rigName)
 		if err != nil {
-			fmt.Printf("%s Rig '%s' not found\n", style.Warning.Render("⚠"), rigName)
+			fmt.Printf("%s Rig '%s' not found\n", style.WarningPrefix, rigName)
 			failed = append(failed, rigName)
 			continue
 		}
@@ -1788,13 +1804,13 @@ func runRigStop(cmd *cobra.Command, args []string) error {
 		}
 
 		if len(errors) > 0 {
-			fmt.Printf("%s Some agents in %s failed to stop:\n", style.Warning.Render("⚠"), rigName)
+			fmt.Printf("%s Some agents in %s failed to stop:\n", style.WarningPrefix, rigName)
 			for _, e := range errors {
 				fmt.Printf("  - %s\n", e)
 			}
 			failed = append(failed, rigName)
 		} else {
-			fmt.Printf("%s Rig %s stopped\n", style.Success.Render("✓"), rigName)
+			fmt.Printf("%s Rig %s stopped\n", style.SuccessPrefix, rigName)
 			succeeded = append(succeeded, rigName)
 		}
 	}
@@ -1803,10 +1819,10 @@ func runRigStop(cmd *cobra.Command, args []string) error {
 	if len(args) > 1 {
 		fmt.Println()
 		if len(succeeded) > 0 {
-			fmt.Printf("%s Stopped: %s\n", style.Success.Render("✓"), strings.Join(succeeded, ", "))
+			fmt.Printf("%s Stopped: %s\n", style.SuccessPrefix, strings.Join(succeeded, ", "))
 		}
 		if len(failed) > 0 {
-			fmt.Printf("%s Failed: %s\n", style.Warning.Render("⚠"), strings.Join(failed, ", "))
+			fmt.Printf("%s Failed: %s\n", style.WarningPrefix, strings.Join(failed, ", "))
 			return fmt.Errorf("some rigs failed to stop")
 		}
 	} else if len(failed) > 0 {
@@ -1842,7 +1858,7 @@ func runRigRestart(cmd *cobra.Command, args []string) error {
 	for _, rigName := range args {
 		r, err := rigMgr.GetRig(rigName)
 		if err != nil {
-			fmt.Printf("%s Rig '%s' not found\n", style.Warning.Render("⚠"), rigName)
+			fmt.Printf("%s Rig '%s' not found\n", style.WarningPrefix, rigName)
 			failed = append(failed, rigName)
 			continue
 		}
@@ -1890,7 +1906,7 @@ func runRigRestart(cmd *cobra.Command, args []string) error {
 		}
 
 		if len(stopErrors) > 0 {
-			fmt.Printf("  %s Stop errors:\n", style.Warning.Render("⚠"))
+			fmt.Printf("  %s Stop errors:\n", style.WarningPrefix)
 			for _, e := range stopErrors {
 				fmt.Printf("    - %s\n", e)
 			}
@@ -1915,7 +1931,7 @@ func runRigRestart(cmd *cobra.Command, args []string) error {
 				if err == witness.ErrAlreadyRunning {
 					skipped = append(skipped, "witness")
 				} else {
-					fmt.Printf("    %s Failed to start witness: %v\n", style.Warning.Render("⚠"), err)
+					fmt.Printf("    %s Failed to start witness: %v\n", style.WarningPrefix, err)
 					startErrors = append(startErrors, fmt.Sprintf("witness: %v", err))
 				}
 			} else {
@@ -1931,7 +1947,7 @@ func runRigRestart(cmd *cobra.Command, args []string) error {
 		} else {
 			fmt.Printf("    Starting refinery...\n")
 			if err := refMgr.Start(false, ""); err != nil {
-				fmt.Printf("    %s Failed to start refinery: %v\n", style.Warning.Render("⚠"), err)
+				fmt.Printf("    %s Failed to start refinery: %v\n", style.WarningPrefix, err)
 				startErrors = append(startErrors, fmt.Sprintf("refinery: %v", err))
 			} else {
 				started = append(started, "refinery")
@@ -1940,20 +1956,20 @@ func runRigRestart(cmd *cobra.Command, args []string) error {
 
 		// Report results for this rig
 		if len(started) > 0 {
-			fmt.Printf("  %s Started: %s\n", style.Success.Render("✓"), strings.Join(started, ", "))
+			fmt.Printf("  %s Started: %s\n", style.SuccessPrefix, strings.Join(started, ", "))
 		}
 		if len(skipped) > 0 {
-			fmt.Printf("  %s Skipped: %s (already running)\n", style.Dim.Render("•"), strings.Join(skipped, ", "))
+			fmt.Printf("  %s Skipped: %s (already running)\n", style.Dim.Render(ui.Glyph("•")), strings.Join(skipped, ", "))
 		}
 
 		if len(startErrors) > 0 {
-			fmt.Printf("  %s Start errors:\n", style.Warning.Render("⚠"))
+			fmt.Printf("  %s Start errors:\n", style.WarningPrefix)
 			for _, e := range startErrors {
 				fmt.Printf("    - %s\n", e)
 			}
 			failed = append(failed, rigName)
 		} else {
-			fmt.Printf("%s Rig %s restarted\n", style.Success.Render("✓"), rigName)
+			fmt.Printf("%s Rig %s restarted\n", style.SuccessPrefix, rigName)
 			succeeded = append(succeeded, rigName)
 		}
 		fmt.Println()
@@ -1962,10 +1978,10 @@ func runRigRestart(cmd *cobra.Command, args []string) error {
 	// Summary
 	if len(args) > 1 {
 		if len(succeeded) > 0 {
-			fmt.Printf("%s Restarted: %s\n", style.Success.Render("✓"), strings.Join(succeeded, ", "))
+			fmt.Printf("%s Restarted: %s\n", style.SuccessPrefix, strings.Join(succeeded, ", "))
 		}
 		if len(failed) > 0 {
-			fmt.Printf("%s Failed: %s\n", style.Warning.Render("⚠"), strings.Join(failed, ", "))
+			fmt.Printf("%s Failed: %s\n", style.WarningPrefix, strings.Join(failed, ", "))
 			return fmt.Errorf("some rigs failed to restart")
 		}
 	} else if len(failed) > 0 {
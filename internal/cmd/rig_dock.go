@@ -15,6 +15,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -112,7 +113,7 @@ func runRigDock(cmd *cobra.Command, args []string) error {
 	// Check if already docked
 	for _, label := range rigBead.Labels {
 		if label == RigDockedLabel {
-			fmt.Printf("%s Rig %s is already docked\n", style.Dim.Render("•"), rigName)
+			fmt.Printf("%s Rig %s is already docked\n", style.Dim.Render(ui.Glyph("•")), rigName)
 			return nil
 		}
 	}
@@ -178,7 +179,7 @@ func runRigDock(cmd *cobra.Command, args []string) error {
 	}
 
 	// Output
-	fmt.Printf("%s Rig %s docked (global)\n", style.Success.Render("✓"), rigName)
+	fmt.Printf("%s Rig %s docked (global)\n", style.SuccessPrefix, rigName)
 	fmt.Printf("  Label added: %s\n", RigDockedLabel)
 	for _, msg := range stoppedAgents {
 		fmt.Printf("  %s\n", msg)
@@ -222,7 +223,7 @@ func runRigUndock(cmd *cobra.Command, args []string) error {
 	rigBead, err := bd.Show(rigBeadID)
 	if err != nil {
 		// Rig identity bead doesn't exist (legacy rig) - can't be docked
-		fmt.Printf("%s Rig %s has no identity bead and is not docked\n", style.Dim.Render("•"), rigName)
+		fmt.Printf("%s Rig %s has no identity bead and is not docked\n", style.Dim.Render(ui.Glyph("•")), rigName)
 		return nil
 	}
 
@@ -235,7 +236,7 @@ func runRigUndock(cmd *cobra.Command, args []string) error {
 		}
 	}
 	if !isDocked {
-		fmt.Printf("%s Rig %s is not docked\n", style.Dim.Render("•"), rigName)
+		fmt.Printf("%s Rig %s is not docked\n", style.Dim.Render(ui.Glyph("•")), rigName)
 		return nil
 	}
 
@@ -255,7 +256,7 @@ func runRigUndock(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Printf("%s Rig %s undocked\n", style.Success.Render("✓"), rigName)
+	fmt.Printf("%s Rig %s undocked\n", style.SuccessPrefix, rigName)
 	fmt.Printf("  Label removed: %s\n", RigDockedLabel)
 	fmt.Printf("  Daemon can now auto-restart agents\n")
 	fmt.Printf("  Use '%s' to start agents immediately\n", style.Dim.Render("gt rig start "+rigName))
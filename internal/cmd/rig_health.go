@@ -0,0 +1,411 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+var rigHealthJSON bool
+
+var rigHealthCmd = &cobra.Command{
+	Use:   "health [rig]",
+	Short: "Show a health drill-down for one rig",
+	Long: `Show a focused health report for one rig, for whoever owns that repo,
+without the whole-town firehose of gt doctor and gt status.
+
+The report combines:
+- Doctor findings from the rig-scoped checks (as gt doctor --rig)
+- Beads stats: issues by status, and how many closed this week
+- In-flight wisps in the rig, oldest first, with their ages
+- The last patrol that touched the rig
+- Worktree status of each polecat and crew clone (branch, uncommitted files)
+- The rig's cost over the last 7 days
+
+If no rig is specified, infers the rig from the current directory.
+
+Examples:
+  gt rig health
+  gt rig health gastown
+  gt rig health gastown --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runRigHealth,
+}
+
+func init() {
+	rigHealthCmd.Flags().BoolVar(&rigHealthJSON, "json", false, "Output as JSON")
+	rigCmd.AddCommand(rigHealthCmd)
+}
+
+// RigHealthReport is the gt rig health output.
+type RigHealthReport struct {
+	Rig        string              `json:"rig"`
+	Doctor     []RigHealthFinding  `json:"doctor"`
+	ChecksRun  int                 `json:"checks_run"`
+	Beads      RigBeadStats        `json:"beads"`
+	Wisps      []RigHealthWisp     `json:"wisps"`
+	LastPatrol *RigHealthPatrol    `json:"last_patrol,omitempty"`
+	Worktrees  []RigHealthWorktree `json:"worktrees"`
+	Cost       RigWeekCost         `json:"cost_week"`
+}
+
+// RigHealthFinding is a non-OK doctor result for the rig.
+type RigHealthFinding struct {
+	Check    string   `json:"check"`
+	Status   string   `json:"status"`
+	Severity string   `json:"severity,omitempty"`
+	Message  string   `json:"message"`
+	Details  []string `json:"details,omitempty"`
+	FixHint  string   `json:"fix_hint,omitempty"`
+}
+
+// RigBeadStats summarizes the rig's beads.
+type RigBeadStats struct {
+	ByStatus       map[string]int `json:"by_status"`
+	ClosedThisWeek int            `json:"closed_this_week"`
+	Error          string         `json:"error,omitempty"`
+}
+
+// RigHealthWisp is an in-flight wisp in the rig.
+type RigHealthWisp struct {
+	ID      string    `json:"id"`
+	Title   string    `json:"title"`
+	Status  string    `json:"status"`
+	Agent   string    `json:"agent,omitempty"`
+	SlungAt time.Time `json:"slung_at"`
+}
+
+// RigHealthPatrol is the most recent patrol event naming the rig.
+type RigHealthPatrol struct {
+	Type    string    `json:"type"`
+	Actor   string    `json:"actor"`
+	At      time.Time `json:"at"`
+	Message string    `json:"message,omitempty"`
+}
+
+// RigHealthWorktree is the git state of one polecat or crew clone.
+type RigHealthWorktree struct {
+	Name      string `json:"name"`
+	Kind      string `json:"kind"` // polecat or crew
+	Branch    string `json:"branch,omitempty"`
+	Issue     string `json:"issue,omitempty"`
+	Running   bool   `json:"running"`
+	Dirty     int    `json:"dirty_files"`
+	StatusErr string `json:"error,omitempty"`
+}
+
+// RigWeekCost is the rig's cost over the last 7 days.
+type RigWeekCost struct {
+	TotalUSD float64            `json:"total_usd"`
+	Sessions int                `json:"sessions"`
+	ByRole   map[string]float64 `json:"by_role,omitempty"`
+}
+
+func runRigHealth(cmd *cobra.Command, args []string) error {
+	var rigName string
+	if len(args) > 0 {
+		rigName = args[0]
+	} else {
+		roleInfo, err := GetRole()
+		if err != nil {
+			return fmt.Errorf("detecting rig from current directory: %w", err)
+		}
+		if roleInfo.Rig == "" {
+			return fmt.Errorf("could not detect rig from current directory; please specify rig name")
+		}
+		rigName = roleInfo.Rig
+	}
+	townRoot, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	report := RigHealthReport{Rig: rigName}
+	report.Doctor, report.ChecksRun = rigDoctorFindings(townRoot, rigName, now)
+
+	issues, err := beads.New(r.BeadsPath()).List(beads.ListOptions{Status: "all", Priority: -1})
+	report.Beads = summarizeRigBeads(issues, now)
+	if err != nil {
+		report.Beads.Error = err.Error()
+	}
+
+	report.Wisps = []RigHealthWisp{}
+	if items, err := collectWisps(townRoot, loadWispSettings(townRoot)); err == nil {
+		for _, it := range items {
+			if it.Rig == rigName {
+				report.Wisps = append(report.Wisps, RigHealthWisp{
+					ID: it.ID, Title: it.Title, Status: it.Status, Agent: it.Agent, SlungAt: it.SlungAt,
+				})
+			}
+		}
+	}
+
+	if evs, err := events.ReadAll(townRoot); err == nil {
+		report.LastPatrol = lastRigPatrol(evs, rigName)
+	}
+
+	report.Worktrees = rigWorktrees(townRoot, r)
+
+	var costs []CostEntry
+	if digests, err := queryDigestBeads(7); err == nil {
+		costs = append(costs, digests...)
+	}
+	if today, err := querySessionCostEntries(now); err == nil {
+		costs = append(costs, today...)
+	}
+	report.Cost = rigWeekCost(costs, rigName)
+
+	if rigHealthJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	printRigHealth(report, now)
+	return nil
+}
+
+// rigDoctorFindings runs the rig-scoped doctor checks, with the town's acks
+// and severity policy applied, and returns the non-OK results and how many
+// checks ran.
+func rigDoctorFindings(townRoot, rigName string, now time.Time) ([]RigHealthFinding, int) {
+	d := doctor.NewDoctor()
+	d.RegisterAll(doctor.RigChecks()...)
+	d.Register(doctor.NewRigEnvProfileCheck())
+	d.Register(doctor.NewWorktreeGitdirCheck())
+	report := d.Run(&doctor.CheckContext{TownRoot: townRoot, RigName: rigName})
+
+	if acks, err := doctor.LoadAcks(townRoot); err == nil {
+		acks.Apply(report, now)
+	}
+	if policy, err := doctor.LoadPolicy(townRoot); err == nil {
+		policy.Apply(report)
+	}
+
+	findings := []RigHealthFinding{}
+	for _, res := range report.Checks {
+		if res.Status == doctor.StatusOK || res.Acknowledged {
+			continue
+		}
+		findings = append(findings, RigHealthFinding{
+			Check:    res.Name,
+			Status:   res.Status.String(),
+			Severity: string(res.Severity),
+			Message:  res.Message,
+			Details:  res.Details,
+			FixHint:  res.FixHint,
+		})
+	}
+	return findings, len(report.Checks)
+}
+
+// summarizeRigBeads counts issues by status (tombstones excluded) and those
+// closed in the 7 days before now.
+func summarizeRigBeads(issues []*beads.Issue, now time.Time) RigBeadStats {
+	stats := RigBeadStats{ByStatus: map[string]int{}}
+	weekAgo := now.Add(-7 * 24 * time.Hour)
+	for _, issue := range issues {
+		if issue.Status == "tombstone" {
+			continue
+		}
+		stats.ByStatus[issue.Status]++
+		if issue.Status == "closed" && issue.ClosedAt != "" {
+			if t, err := time.Parse(time.RFC3339, issue.ClosedAt); err == nil && t.After(weekAgo) {
+				stats.ClosedThisWeek++
+			}
+		}
+	}
+	return stats
+}
+
+// lastRigPatrol returns the most recent patrol event for rig, or nil.
+func lastRigPatrol(evs []events.Event, rigName string) *RigHealthPatrol {
+	var last *RigHealthPatrol
+	for _, ev := range evs {
+		if ev.Type != events.TypePatrolStarted && ev.Type != events.TypePatrolComplete {
+			continue
+		}
+		if ev.PayloadString("rig") != rigName {
+			continue
+		}
+		at := ev.Time()
+		if last == nil || !at.Before(last.At) {
+			last = &RigHealthPatrol{Type: ev.Type, Actor: ev.Actor, At: at, Message: ev.PayloadString("message")}
+		}
+	}
+	return last
+}
+
+// rigWeekCost totals the cost entries attributed to rig.
+func rigWeekCost(entries []CostEntry, rigName string) RigWeekCost {
+	cost := RigWeekCost{ByRole: map[string]float64{}}
+	for _, e := range entries {
+		if e.Rig != rigName {
+			continue
+		}
+		cost.TotalUSD += e.CostUSD
+		cost.Sessions++
+		cost.ByRole[e.Role] += e.CostUSD
+	}
+	return cost
+}
+
+// rigWorktrees reports the git state of the rig's polecat and crew clones.
+func rigWorktrees(townRoot string, r *rig.Rig) []RigHealthWorktree {
+	t := tmux.NewTmux()
+	out := []RigHealthWorktree{}
+	status := func(w *RigHealthWorktree, path string) {
+		g := git.NewGit(path)
+		if w.Branch == "" {
+			w.Branch, _ = g.CurrentBranch()
+		}
+		st, err := g.Status()
+		if err != nil {
+			w.StatusErr = err.Error()
+			return
+		}
+		w.Dirty = len(st.Modified) + len(st.Added) + len(st.Deleted) + len(st.Untracked)
+	}
+
+	if polecats, err := polecat.NewManager(r, git.NewGit(r.Path), t).List(); err == nil {
+		for _, p := range polecats {
+			w := RigHealthWorktree{Name: p.Name, Kind: "polecat", Branch: p.Branch, Issue: p.Issue}
+			w.Running, _ = t.HasSession(session.PolecatSessionName(session.PrefixFor(r.Name), p.Name))
+			status(&w, p.ClonePath)
+			out = append(out, w)
+		}
+	}
+	if workers, err := crew.NewManager(r, git.NewGit(townRoot)).List(); err == nil {
+		for _, c := range workers {
+			w := RigHealthWorktree{Name: c.Name, Kind: "crew"}
+			w.Running, _ = t.HasSession(crewSessionName(r.Name, c.Name))
+			status(&w, c.ClonePath)
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+func printRigHealth(report RigHealthReport, now time.Time) {
+	fmt.Printf("%s\n\n", style.Bold.Render("Rig health: "+report.Rig))
+
+	fmt.Printf("%s", style.Bold.Render("Doctor"))
+	if len(report.Doctor) == 0 {
+		fmt.Printf(" %s all %d rig checks passed\n", style.SuccessPrefix, report.ChecksRun)
+	} else {
+		fmt.Printf(" (%d of %d checks)\n", len(report.Doctor), report.ChecksRun)
+		for _, f := range report.Doctor {
+			icon := style.WarningPrefix
+			if f.Status == doctor.StatusError.String() {
+				icon = style.Error.Render(ui.Glyph("✗"))
+			}
+			fmt.Printf("  %s %s: %s\n", icon, f.Check, f.Message)
+			if f.FixHint != "" {
+				fmt.Printf("      %s\n", style.Dim.Render("→ "+f.FixHint))
+			}
+		}
+	}
+	fmt.Println()
+
+	fmt.Printf("%s", style.Bold.Render("Beads"))
+	if report.Beads.Error != "" {
+		fmt.Printf(" %s %s\n", style.WarningPrefix, report.Beads.Error)
+	} else {
+		statuses := make([]string, 0, len(report.Beads.ByStatus))
+		for s := range report.Beads.ByStatus {
+			statuses = append(statuses, s)
+		}
+		sort.Strings(statuses)
+		parts := make([]string, 0, len(statuses))
+		for _, s := range statuses {
+			parts = append(parts, fmt.Sprintf("%s %d", s, report.Beads.ByStatus[s]))
+		}
+		if len(parts) == 0 {
+			parts = append(parts, "none")
+		}
+		fmt.Printf("\n  %s\n  closed this week: %d\n", strings.Join(parts, ", "), report.Beads.ClosedThisWeek)
+	}
+	fmt.Println()
+
+	fmt.Printf("%s", style.Bold.Render("Wisps in flight"))
+	if len(report.Wisps) == 0 {
+		fmt.Printf(" (none)\n")
+	} else {
+		fmt.Printf(" (%d)\n", len(report.Wisps))
+		for _, w := range report.Wisps {
+			who := w.Agent
+			if who == "" {
+				who = "unassigned"
+			}
+			fmt.Printf("  %s  %-11s %-8s %s  %s\n", style.Bold.Render(w.ID), w.Status,
+				formatDurationAgo(now.Sub(w.SlungAt)), w.Title, style.Dim.Render(who))
+		}
+	}
+	fmt.Println()
+
+	fmt.Printf("%s\n", style.Bold.Render("Last patrol"))
+	if report.LastPatrol == nil {
+		fmt.Printf("  %s\n", style.Dim.Render("no patrol has touched this rig"))
+	} else {
+		p := report.LastPatrol
+		line := fmt.Sprintf("%s by %s, %s ago", strings.ReplaceAll(p.Type, "_", " "), p.Actor, formatDurationAgo(now.Sub(p.At)))
+		if p.Message != "" {
+			line += ": " + p.Message
+		}
+		fmt.Printf("  %s\n", line)
+	}
+	fmt.Println()
+
+	fmt.Printf("%s", style.Bold.Render("Worktrees"))
+	if len(report.Worktrees) == 0 {
+		fmt.Printf(" (none)\n")
+	} else {
+		fmt.Printf(" (%d)\n", len(report.Worktrees))
+		for _, w := range report.Worktrees {
+			icon := style.Dim.Render(ui.Glyph("○"))
+			if w.Running {
+				icon = style.Success.Render(ui.Glyph("●"))
+			}
+			state := style.Success.Render("clean")
+			switch {
+			case w.StatusErr != "":
+				state = style.Error.Render("git error: " + w.StatusErr)
+			case w.Dirty > 0:
+				state = style.Warning.Render(fmt.Sprintf("%d uncommitted", w.Dirty))
+			}
+			issue := ""
+			if w.Issue != "" {
+				issue = " → " + w.Issue
+			}
+			fmt.Printf("  %s %s/%s: %s%s  %s\n", icon, w.Kind, w.Name, w.Branch, issue, state)
+		}
+	}
+	fmt.Println()
+
+	fmt.Printf("%s\n", style.Bold.Render("Cost (last 7 days)"))
+	fmt.Printf("  $%.2f across %d session(s)\n", report.Cost.TotalUSD, report.Cost.Sessions)
+	roles := make([]string, 0, len(report.Cost.ByRole))
+	for role := range report.Cost.ByRole {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	for _, role := range roles {
+		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("%s: $%.2f", role, report.Cost.ByRole[role])))
+	}
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestSummarizeRigBeads(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	issues := []*beads.Issue{
+		{ID: "gt-1", Status: "open"},
+		{ID: "gt-2", Status: "open"},
+		{ID: "gt-3", Status: "in_progress"},
+		{ID: "gt-4", Status: "closed", ClosedAt: now.Add(-2 * 24 * time.Hour).Format(time.RFC3339)},
+		{ID: "gt-5", Status: "closed", ClosedAt: now.Add(-10 * 24 * time.Hour).Format(time.RFC3339)},
+		{ID: "gt-6", Status: "tombstone"},
+	}
+	got := summarizeRigBeads(issues, now)
+	if got.ByStatus["open"] != 2 || got.ByStatus["in_progress"] != 1 || got.ByStatus["closed"] != 2 {
+		t.Errorf("ByStatus = %v", got.ByStatus)
+	}
+	if _, ok := got.ByStatus["tombstone"]; ok {
+		t.Error("tombstones should not be counted")
+	}
+	if got.ClosedThisWeek != 1 {
+		t.Errorf("ClosedThisWeek = %d, want 1", got.ClosedThisWeek)
+	}
+}
+
+func TestLastRigPatrol(t *testing.T) {
+	evs := []events.Event{
+		{Timestamp: "2026-03-10T08:00:00Z", Type: events.TypePatrolStarted, Actor: "gastown/witness", Payload: events.PatrolPayload("gastown", 2, "")},
+		{Timestamp: "2026-03-10T08:05:00Z", Type: events.TypePatrolComplete, Actor: "gastown/witness", Payload: events.PatrolPayload("gastown", 2, "all healthy")},
+		{Timestamp: "2026-03-10T09:00:00Z", Type: events.TypePatrolComplete, Actor: "beads/witness", Payload: events.PatrolPayload("beads", 1, "")},
+		{Timestamp: "2026-03-10T10:00:00Z", Type: events.TypeSling, Actor: "mayor", Payload: map[string]interface{}{"rig": "gastown"}},
+	}
+	got := lastRigPatrol(evs, "gastown")
+	if got == nil {
+		t.Fatal("lastRigPatrol = nil")
+	}
+	if got.Type != events.TypePatrolComplete || got.Message != "all healthy" {
+		t.Errorf("lastRigPatrol = %+v, want the 08:05 completion", got)
+	}
+	if lastRigPatrol(evs, "unknown") != nil {
+		t.Error("want nil for a rig no patrol touched")
+	}
+}
+
+func TestRigWeekCost(t *testing.T) {
+	entries := []CostEntry{
+		{Rig: "gastown", Role: "polecat", CostUSD: 1.5},
+		{Rig: "gastown", Role: "witness", CostUSD: 0.25},
+		{Rig: "gastown", Role: "polecat", CostUSD: 2},
+		{Rig: "beads", Role: "polecat", CostUSD: 9},
+		{Role: "mayor", CostUSD: 3},
+	}
+	got := rigWeekCost(entries, "gastown")
+	if got.TotalUSD != 3.75 || got.Sessions != 3 {
+		t.Errorf("total = %.2f over %d sessions, want 3.75 over 3", got.TotalUSD, got.Sessions)
+	}
+	if got.ByRole["polecat"] != 3.5 {
+		t.Errorf("ByRole = %v", got.ByRole)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/wisp"
 	"github.com/steveyegge/gastown/internal/witness"
 )
@@ -74,7 +75,7 @@ func runRigPark(cmd *cobra.Command, args []string) error {
 
 	if len(errs) > 0 {
 		for _, err := range errs {
-			fmt.Printf("%s %v\n", style.Error.Render("✗"), err)
+			fmt.Printf("%s %v\n", style.Error.Render(ui.Glyph("✗")), err)
 		}
 		return fmt.Errorf("failed to park %d rig(s)", len(errs))
 	}
@@ -128,7 +129,7 @@ func parkOneRig(rigName string) error {
 	}
 
 	// Output
-	fmt.Printf("%s Rig %s parked (local only)\n", style.Success.Render("✓"), rigName)
+	fmt.Printf("%s Rig %s parked (local only)\n", style.SuccessPrefix, rigName)
 	for _, msg := range stoppedAgents {
 		fmt.Printf("  %s\n", msg)
 	}
@@ -148,7 +149,7 @@ func runRigUnpark(cmd *cobra.Command, args []string) error {
 
 	if len(errs) > 0 {
 		for _, err := range errs {
-			fmt.Printf("%s %v\n", style.Error.Render("✗"), err)
+			fmt.Printf("%s %v\n", style.Error.Render(ui.Glyph("✗")), err)
 		}
 		return fmt.Errorf("failed to unpark %d rig(s)", len(errs))
 	}
@@ -169,7 +170,7 @@ func unparkOneRig(rigName string) error {
 		return fmt.Errorf("clearing parked status: %w", err)
 	}
 
-	fmt.Printf("%s Rig %s unparked\n", style.Success.Render("✓"), rigName)
+	fmt.Printf("%s Rig %s unparked\n", style.SuccessPrefix, rigName)
 	fmt.Printf("  Daemon can now auto-restart agents\n")
 	fmt.Printf("  Use '%s' to start agents immediately\n", style.Dim.Render("gt rig start "+rigName))
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -99,7 +100,7 @@ func runRigQuickAdd(cmd *cobra.Command, args []string) error {
 	addCmd.Stdout = os.Stdout
 	addCmd.Stderr = os.Stderr
 	if err := addCmd.Run(); err != nil {
-		fmt.Printf("\n%s Failed to add rig. You can try manually:\n", style.Warning.Render("⚠"))
+		fmt.Printf("\n%s Failed to add rig. You can try manually:\n", style.WarningPrefix)
 		fmt.Printf("  cd %s && gt rig add %s %s\n", townRoot, rigName, gitURL)
 		return fmt.Errorf("gt rig add failed: %w", err)
 	}
@@ -122,13 +123,13 @@ func runRigQuickAdd(cmd *cobra.Command, args []string) error {
 	crewCmd.Stdout = os.Stdout
 	crewCmd.Stderr = os.Stderr
 	if err := crewCmd.Run(); err != nil {
-		fmt.Printf("  %s Could not create crew workspace: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("  %s Could not create crew workspace: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		fmt.Printf("  Run manually: cd %s && gt crew add %s --rig %s\n", filepath.Join(townRoot, rigName), user, rigName)
 	}
 
 	crewPath := filepath.Join(townRoot, rigName, "crew", user)
 	if !quickAddQuiet {
-		fmt.Printf("\n%s Added to Gas Town!\n", style.Success.Render("✓"))
+		fmt.Printf("\n%s Added to Gas Town!\n", style.SuccessPrefix)
 		fmt.Printf("\nYour workspace: %s\n", style.Bold.Render(crewPath))
 	}
 
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var rigReprefixDryRun bool
+
+var rigReprefixCmd = &cobra.Command{
+	Use:   "reprefix <rig> <new-prefix>",
+	Short: "Change a rig's bead ID prefix, forwarding old IDs",
+	Long: `Rename every bead in a rig from its current ID prefix to a new one.
+
+The rig's database is rewritten with 'bd rename-prefix', then the town is
+updated to match:
+  - the prefix registry in mayor/town.json retires the old prefix, recording
+    a forwarding record so old IDs (in other rigs' beads, mail, hooks, notes)
+    still resolve to the renamed beads
+  - routes.jsonl, rigs.json, and the rig's config.json get the new prefix
+
+Stop the rig's agents first; beads created under the old prefix mid-rename
+would be stranded.
+
+Examples:
+  gt rig reprefix gastown gt --dry-run
+  gt rig reprefix my-project mp`,
+	Args: cobra.ExactArgs(2),
+	RunE: runRigReprefix,
+}
+
+func init() {
+	rigReprefixCmd.Flags().BoolVar(&rigReprefixDryRun, "dry-run", false, "Show what would change without changing it")
+	rigCmd.AddCommand(rigReprefixCmd)
+}
+
+func runRigReprefix(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+	newPrefix := strings.TrimSuffix(args[1], "-")
+	if err := config.ValidatePrefix(newPrefix); err != nil {
+		return err
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		return fmt.Errorf("loading rigs: %w", err)
+	}
+	entry, ok := rigsConfig.Rigs[rigName]
+	if !ok {
+		return fmt.Errorf("rig %q not found", rigName)
+	}
+	if entry.BeadsConfig == nil || entry.BeadsConfig.Prefix == "" {
+		return fmt.Errorf("rig %q has no beads prefix", rigName)
+	}
+	oldPrefix := strings.TrimSuffix(entry.BeadsConfig.Prefix, "-")
+	if oldPrefix == newPrefix {
+		return fmt.Errorf("rig %q already uses prefix %s-", rigName, newPrefix)
+	}
+
+	townPath := constants.MayorTownPath(townRoot)
+	townConfig, err := config.LoadTownConfig(townPath)
+	if err != nil {
+		return fmt.Errorf("loading town config: %w", err)
+	}
+	// Towns that predate the registry get the rig's entry on the spot.
+	if _, err := townConfig.RegisterPrefix(oldPrefix, rigName); err != nil {
+		return err
+	}
+	if err := townConfig.RetirePrefix(oldPrefix, newPrefix); err != nil {
+		return err
+	}
+
+	townBeads := beads.GetTownBeadsPath(townRoot)
+	routes, err := beads.LoadRoutes(townBeads)
+	if err != nil {
+		return fmt.Errorf("loading routes: %w", err)
+	}
+	routeIdx := -1
+	for i, r := range routes {
+		switch r.Prefix {
+		case oldPrefix + "-":
+			routeIdx = i
+		case newPrefix + "-":
+			return fmt.Errorf("%w: routes.jsonl already routes %s- to %s", config.ErrPrefixConflict, newPrefix, r.Path)
+		}
+	}
+	if routeIdx < 0 {
+		return fmt.Errorf("no route for %s- in routes.jsonl", oldPrefix)
+	}
+	beadsDir := beads.ResolveBeadsDir(filepath.Join(townRoot, routes[routeIdx].Path))
+
+	fmt.Printf("Reprefix %s: %s- → %s-\n", style.Bold.Render(rigName), oldPrefix, newPrefix)
+	fmt.Printf("  bd rename-prefix %s- in %s\n", newPrefix, beadsDir)
+	fmt.Printf("  registry: retire %s- (forwarding to %s-)\n", oldPrefix, newPrefix)
+	fmt.Printf("  routes.jsonl, rigs.json, %s/config.json: %s- → %s-\n", rigName, oldPrefix, newPrefix)
+	if rigReprefixDryRun {
+		fmt.Println(style.Dim.Render("(dry run: nothing changed)"))
+		return nil
+	}
+
+	b := beads.NewWithBeadsDir(filepath.Dir(beadsDir), beadsDir)
+	if _, err := b.Run("rename-prefix", newPrefix+"-"); err != nil {
+		return fmt.Errorf("renaming beads: %w", err)
+	}
+
+	// The database is renamed; from here on, record the new prefix everywhere
+	// so old IDs forward instead of dangling.
+	if err := config.SaveTownConfig(townPath, townConfig); err != nil {
+		return fmt.Errorf("saving prefix registry: %w", err)
+	}
+	routes[routeIdx].Prefix = newPrefix + "-"
+	if err := beads.WriteRoutes(townBeads, routes); err != nil {
+		return fmt.Errorf("writing routes: %w", err)
+	}
+	entry.BeadsConfig.Prefix = newPrefix
+	rigsConfig.Rigs[rigName] = entry
+	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+		return fmt.Errorf("saving rigs config: %w", err)
+	}
+	if err := setRigConfigPrefix(filepath.Join(townRoot, rigName), newPrefix); err != nil {
+		style.PrintWarning("could not update %s/config.json: %v", rigName, err)
+	}
+
+	fmt.Printf("%s %s now uses %s-; %s- IDs forward to it\n", style.SuccessPrefix, rigName, newPrefix, oldPrefix)
+	return nil
+}
+
+// setRigConfigPrefix patches beads.prefix in a rig's config.json, leaving
+// every other field as written.
+func setRigConfigPrefix(rigPath, prefix string) error {
+	path := filepath.Join(rigPath, "config.json")
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed from the town root
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	beadsCfg, _ := raw["beads"].(map[string]any)
+	if beadsCfg == nil {
+		beadsCfg = map[string]any{}
+	}
+	beadsCfg["prefix"] = prefix
+	raw["beads"] = beadsCfg
+	out, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(out, '\n'), 0644) //nolint:gosec // G306: rig config is not secret
+}
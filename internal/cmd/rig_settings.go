@@ -159,7 +159,7 @@ func runRigSettingsSet(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Set %s=%v in settings for rig %s\n",
-		style.Success.Render("✓"), keyPath, formatValueForDisplay(value), rigName)
+		style.SuccessPrefix, keyPath, formatValueForDisplay(value), rigName)
 	return nil
 }
 
@@ -194,7 +194,7 @@ func runRigSettingsUnset(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Unset %s from settings for rig %s\n",
-		style.Success.Render("✓"), keyPath, rigName)
+		style.SuccessPrefix, keyPath, rigName)
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -340,7 +341,7 @@ func parseRoleString(s string) (Role, string, string) {
 
 	// Simple roles
 	switch s {
-	case constants.RoleMayor:
+	case constants.RoleMayor, constants.MayorDeputy:
 		return RoleMayor, "", ""
 	case constants.RoleDeacon:
 		return RoleDeacon, "", ""
@@ -489,7 +490,7 @@ func runRoleShow(cmd *cobra.Command, args []string) error {
 	// Show mismatch warning
 	if info.Mismatch {
 		fmt.Println()
-		fmt.Printf("%s\n", style.Bold.Render("⚠️  ROLE MISMATCH"))
+		fmt.Printf("%s\n", style.Bold.Render(ui.Glyph("⚠️")+"  ROLE MISMATCH"))
 		fmt.Printf("  GT_ROLE=%s (authoritative)\n", info.EnvRole)
 		fmt.Printf("  cwd suggests: %s\n", info.CwdRole)
 		fmt.Println()
@@ -546,7 +547,7 @@ func runRoleHome(cmd *cobra.Command, args []string) error {
 
 	// Warn if computed home doesn't match cwd
 	if home != cwd && !strings.HasPrefix(cwd, home) {
-		fmt.Fprintf(os.Stderr, "⚠️  Warning: cwd (%s) is not within role home (%s)\n", cwd, home)
+		fmt.Fprintf(os.Stderr, "%s  Warning: cwd (%s) is not within role home (%s)\n", ui.Glyph("⚠️"), cwd, home)
 	}
 
 	fmt.Println(home)
@@ -585,7 +586,7 @@ func runRoleDetect(cmd *cobra.Command, args []string) error {
 		parsedRole, _, _ := parseRoleString(envRole)
 		if parsedRole != ctx.Role {
 			fmt.Println()
-			fmt.Printf("%s\n", style.Bold.Render("⚠️  Mismatch with $GT_ROLE"))
+			fmt.Printf("%s\n", style.Bold.Render(ui.Glyph("⚠️")+"  Mismatch with $GT_ROLE"))
 			fmt.Printf("  $GT_ROLE=%s\n", envRole)
 			fmt.Println("  The env var takes precedence in normal operation.")
 		}
@@ -642,12 +643,12 @@ func runRoleEnv(cmd *cobra.Command, args []string) error {
 
 	// Warn if env was incomplete and we filled from cwd
 	if info.EnvIncomplete {
-		fmt.Fprintf(os.Stderr, "⚠️  Warning: env vars incomplete, filled from cwd\n")
+		fmt.Fprintf(os.Stderr, "%s  Warning: env vars incomplete, filled from cwd\n", ui.Glyph("⚠️"))
 	}
 
 	// Warn if computed home doesn't match cwd
 	if home != cwd && !strings.HasPrefix(cwd, home) {
-		fmt.Fprintf(os.Stderr, "⚠️  Warning: cwd (%s) is not within role home (%s)\n", cwd, home)
+		fmt.Fprintf(os.Stderr, "%s  Warning: cwd (%s) is not within role home (%s)\n", ui.Glyph("⚠️"), cwd, home)
 	}
 
 	// Get canonical env vars from shared source of truth
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/cli"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/logging"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
@@ -71,6 +72,10 @@ var beadsExemptCommands = map[string]bool{
 	"run-migration":       true, // Migration orchestrator handles its own beads checks
 	"health":              true, // Health check doesn't require beads
 	"upgrade":             true, // Post-install migration orchestrator
+	"support-bundle":      true, // Diagnostic; must work when beads is broken
+	"diff-config":         true, // Reads config files only
+	"record-session":      true, // Runs under tmux pipe-pane for a session's lifetime
+	"job-finish":          true, // Runs in a session pane's EXIT trap, needs only kubectl
 }
 
 // Commands exempt from the town root branch warning.
@@ -83,6 +88,8 @@ var branchCheckExemptCommands = map[string]bool{
 	"install":    true, // Initial setup
 	"git-init":   true, // Git setup
 	"upgrade":    true, // Post-install migration
+	"record-session": true, // Runs under tmux pipe-pane, no one sees the warning
+	"job-finish":     true, // Runs in a session pane's EXIT trap, no one sees the warning
 }
 
 // persistentPreRun runs before every command.
@@ -103,6 +110,11 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 	// Initialize CLI theme (dark/light mode support)
 	initCLITheme()
 
+	// Read-only observers may only run commands that change nothing
+	if err := checkObserverAllowed(cmd); err != nil {
+		return err
+	}
+
 	// Log command usage telemetry (fire-and-forget, excludes tap/signal)
 	logCommandUsage(cmd, args)
 
@@ -115,6 +127,11 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 		if err := session.InitRegistry(townRoot); err != nil {
 			fmt.Fprintf(os.Stderr, "WARNING: failed to initialize town registry: %v\n", err)
 		}
+		// Structured logging: levels from town settings, text output only
+		// when logging.file is set so CLI output stays clean.
+		if err := logging.Setup(logging.Options{TownRoot: townRoot}); err != nil {
+			fmt.Fprintf(os.Stderr, "WARNING: failed to set up logging: %v\n", err)
+		}
 	}
 
 	// Get the root command name being run
@@ -143,7 +160,7 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 
 	// Check beads version (non-blocking - warn only)
 	if err := CheckBeadsVersion(); err != nil {
-		fmt.Fprintf(os.Stderr, "\n%s beads (bd) version issue:\n", style.Bold.Render("⚠️  WARNING:"))
+		fmt.Fprintf(os.Stderr, "\n%s beads (bd) version issue:\n", style.Bold.Render(ui.Glyph("⚠️")+"  WARNING:"))
 		fmt.Fprintf(os.Stderr, "   %v\n", err)
 		fmt.Fprintf(os.Stderr, "   Run %s for details.\n\n", style.Dim.Render("gt doctor"))
 	}
@@ -152,18 +169,21 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 
 // initCLITheme initializes the CLI color theme based on settings and environment.
 func initCLITheme() {
-	// Try to load town settings for CLITheme config
-	var configTheme string
+	// Try to load town settings for CLITheme and CLILocale config
+	var configTheme, configLocale string
 	if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
 		settingsPath := config.TownSettingsPath(townRoot)
 		if settings, err := config.LoadOrCreateTownSettings(settingsPath); err == nil {
 			configTheme = settings.CLITheme
+			configLocale = settings.CLILocale
 		}
 	}
 
 	// Initialize theme with config value (env var takes precedence inside InitTheme)
 	ui.InitTheme(configTheme)
 	ui.ApplyThemeMode()
+	ui.InitLocale(configLocale)
+	style.ApplyTheme()
 }
 
 // touchPolecatHeartbeat touches the session heartbeat file for polecat agents.
@@ -224,7 +244,7 @@ func warnIfTownRootOffMain() {
 
 	// Town root is on wrong branch - warn the user
 	fmt.Fprintf(os.Stderr, "\n%s Town root is on branch '%s' (should be 'main')\n",
-		style.Bold.Render("⚠️  WARNING:"), branch)
+		style.Bold.Render(ui.Glyph("⚠️")+"  WARNING:"), branch)
 	fmt.Fprintf(os.Stderr, "   This can cause gt commands to fail. Run: %s\n\n",
 		style.Dim.Render("gt doctor --fix"))
 }
@@ -278,6 +298,11 @@ func Execute() int {
 	}
 	if provider != nil {
 		defer func() {
+			// Leave any metric attributes the cardinality guard stripped
+			// where gt doctor can report them.
+			if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
+				_ = telemetry.SaveCardinalityReport(townRoot)
+			}
 			shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 			defer cancel()
 			_ = provider.Shutdown(shutdownCtx)
@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -225,7 +226,7 @@ func runSchedulerList(cmd *cobra.Command, args []string) error {
 	for rig, beads := range byRig {
 		fmt.Printf("  %s (%d):\n", style.Bold.Render(rig), len(beads))
 		for _, b := range beads {
-			indicator := "○"
+			indicator := ui.Glyph("○")
 			if b.Blocked {
 				indicator = "⏸"
 			}
@@ -249,7 +250,7 @@ func runSchedulerPause(cmd *cobra.Command, args []string) error {
 	}
 
 	if state.Paused {
-		fmt.Printf("%s Scheduler is already paused (by %s)\n", style.Dim.Render("○"), state.PausedBy)
+		fmt.Printf("%s Scheduler is already paused (by %s)\n", style.Dim.Render(ui.Glyph("○")), state.PausedBy)
 		return nil
 	}
 
@@ -275,7 +276,7 @@ func runSchedulerResume(cmd *cobra.Command, args []string) error {
 	}
 
 	if !state.Paused {
-		fmt.Printf("%s Scheduler is not paused\n", style.Dim.Render("○"))
+		fmt.Printf("%s Scheduler is not paused\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -284,7 +285,7 @@ func runSchedulerResume(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("saving scheduler state: %w", err)
 	}
 
-	fmt.Printf("%s Scheduler resumed\n", style.Bold.Render("▶"))
+	fmt.Printf("%s Scheduler resumed\n", style.Bold.Render(ui.Glyph("▶")))
 	return nil
 }
 
@@ -317,10 +318,10 @@ func runSchedulerClear(cmd *cobra.Command, args []string) error {
 		}
 
 		if closed == 0 {
-			fmt.Printf("%s No sling context found for %s\n", style.Dim.Render("○"), schedulerClearBead)
+			fmt.Printf("%s No sling context found for %s\n", style.Dim.Render(ui.Glyph("○")), schedulerClearBead)
 		} else {
 			fmt.Printf("%s Removed %s from scheduler (closed %d context(s))\n",
-				style.Bold.Render("✓"), schedulerClearBead, closed)
+				style.Bold.Render(ui.Glyph(ui.IconPass)), schedulerClearBead, closed)
 		}
 		return nil
 	}
@@ -346,7 +347,7 @@ func runSchedulerClear(cmd *cobra.Command, args []string) error {
 		cleared++
 	}
 
-	fmt.Printf("%s Cleared %d context bead(s) from scheduler\n", style.Bold.Render("✓"), cleared)
+	fmt.Printf("%s Cleared %d context bead(s) from scheduler\n", style.Bold.Render(ui.Glyph(ui.IconPass)), cleared)
 	return nil
 }
 
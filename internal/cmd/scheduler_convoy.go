@@ -7,6 +7,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -80,7 +81,7 @@ func runConvoyScheduleByID(convoyID string, opts convoyScheduleOpts) error {
 			skippedNoRig++
 			prefix := beads.ExtractPrefix(t.ID)
 			fmt.Printf("  %s %s: cannot resolve rig from prefix %q (town-root or unknown)\n",
-				style.Dim.Render("○"), t.ID, prefix)
+				style.Dim.Render(ui.Glyph("○")), t.ID, prefix)
 			continue
 		}
 
@@ -129,7 +130,7 @@ func runConvoyScheduleByID(convoyID string, opts convoyScheduleOpts) error {
 			HookRawBead: opts.HookRawBead,
 		})
 		if err != nil {
-			fmt.Printf("  %s %s: %v\n", style.Dim.Render("✗"), c.ID, err)
+			fmt.Printf("  %s %s: %v\n", style.Dim.Render(ui.Glyph("✗")), c.ID, err)
 			continue
 		}
 		successCount++
@@ -196,7 +197,7 @@ func runConvoySlingByID(convoyID string, opts convoyScheduleOpts) error {
 			skippedNoRig++
 			prefix := beads.ExtractPrefix(t.ID)
 			fmt.Printf("  %s %s: cannot resolve rig from prefix %q (town-root or unknown)\n",
-				style.Dim.Render("○"), t.ID, prefix)
+				style.Dim.Render(ui.Glyph("○")), t.ID, prefix)
 			continue
 		}
 		candidates = append(candidates, slingCandidate{ID: t.ID, Title: t.Title, RigName: rigName})
@@ -228,13 +229,13 @@ func runConvoySlingByID(convoyID string, opts convoyScheduleOpts) error {
 	}
 
 	fmt.Printf("%s Dispatching %d issue(s) from convoy %s...\n",
-		style.Bold.Render("▶"), len(candidates), convoyID)
+		style.Bold.Render(ui.Glyph("▶")), len(candidates), convoyID)
 
 	successCount := 0
 	successfulRigs := make(map[string]bool)
 	for i, c := range candidates {
 		if slingMaxConcurrent > 0 && i >= slingMaxConcurrent {
-			fmt.Printf("  %s Reached --max-concurrent limit (%d)\n", style.Dim.Render("○"), slingMaxConcurrent)
+			fmt.Printf("  %s Reached --max-concurrent limit (%d)\n", style.Dim.Render(ui.Glyph("○")), slingMaxConcurrent)
 			break
 		}
 
@@ -252,7 +253,7 @@ func runConvoySlingByID(convoyID string, opts convoyScheduleOpts) error {
 			BeadsDir:      filepath.Join(townRoot, ".beads"),
 		})
 		if err != nil {
-			fmt.Printf("  %s %s: %v\n", style.Dim.Render("✗"), c.ID, err)
+			fmt.Printf("  %s %s: %v\n", style.Dim.Render(ui.Glyph("✗")), c.ID, err)
 			continue
 		}
 		successCount++
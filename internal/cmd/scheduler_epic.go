@@ -11,6 +11,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -83,7 +84,7 @@ func runEpicScheduleByID(epicID string, opts epicScheduleOpts) error {
 			skippedNoRig++
 			prefix := beads.ExtractPrefix(c.ID)
 			fmt.Printf("  %s %s: cannot resolve rig from prefix %q (town-root or unknown)\n",
-				style.Dim.Render("○"), c.ID, prefix)
+				style.Dim.Render(ui.Glyph("○")), c.ID, prefix)
 			continue
 		}
 
@@ -132,7 +133,7 @@ func runEpicScheduleByID(epicID string, opts epicScheduleOpts) error {
 			NoConvoy:    true, // Epic is the organizing structure
 		})
 		if err != nil {
-			fmt.Printf("  %s %s: %v\n", style.Dim.Render("✗"), c.ID, err)
+			fmt.Printf("  %s %s: %v\n", style.Dim.Render(ui.Glyph("✗")), c.ID, err)
 			continue
 		}
 		successCount++
@@ -198,7 +199,7 @@ func runEpicSlingByID(epicID string, opts epicScheduleOpts) error {
 			skippedNoRig++
 			prefix := beads.ExtractPrefix(c.ID)
 			fmt.Printf("  %s %s: cannot resolve rig from prefix %q (town-root or unknown)\n",
-				style.Dim.Render("○"), c.ID, prefix)
+				style.Dim.Render(ui.Glyph("○")), c.ID, prefix)
 			continue
 		}
 		candidates = append(candidates, slingCandidate{ID: c.ID, Title: c.Title, RigName: rigName})
@@ -230,13 +231,13 @@ func runEpicSlingByID(epicID string, opts epicScheduleOpts) error {
 	}
 
 	fmt.Printf("%s Dispatching %d child(ren) from epic %s...\n",
-		style.Bold.Render("▶"), len(candidates), epicID)
+		style.Bold.Render(ui.Glyph("▶")), len(candidates), epicID)
 
 	successCount := 0
 	successfulRigs := make(map[string]bool)
 	for i, c := range candidates {
 		if slingMaxConcurrent > 0 && i >= slingMaxConcurrent {
-			fmt.Printf("  %s Reached --max-concurrent limit (%d)\n", style.Dim.Render("○"), slingMaxConcurrent)
+			fmt.Printf("  %s Reached --max-concurrent limit (%d)\n", style.Dim.Render(ui.Glyph("○")), slingMaxConcurrent)
 			break
 		}
 
@@ -254,7 +255,7 @@ func runEpicSlingByID(epicID string, opts epicScheduleOpts) error {
 			BeadsDir:      filepath.Join(townRoot, ".beads"),
 		})
 		if err != nil {
-			fmt.Printf("  %s %s: %v\n", style.Dim.Render("✗"), c.ID, err)
+			fmt.Printf("  %s %s: %v\n", style.Dim.Render(ui.Glyph("✗")), c.ID, err)
 			continue
 		}
 		successCount++
@@ -19,6 +19,7 @@ import (
 	"github.com/steveyegge/gastown/internal/suggest"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/townlog"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -276,7 +277,7 @@ func runSessionStart(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Session started. Attach with: %s\n",
-		style.Bold.Render("✓"),
+		style.Bold.Render(ui.Glyph(ui.IconPass)),
 		style.Dim.Render(fmt.Sprintf("gt session at %s/%s", rigName, polecatName)))
 
 	// Log wake event
@@ -309,7 +310,7 @@ func runSessionStop(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("stopping session: %w", err)
 	}
 
-	fmt.Printf("%s Session stopped.\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Session stopped.\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	// Log kill event
 	if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
@@ -416,9 +417,9 @@ func runSessionList(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("%s\n\n", style.Bold.Render("Active Sessions"))
 	for _, s := range allSessions {
-		status := style.Bold.Render("●")
+		status := style.Bold.Render(ui.Glyph("●"))
 		if !s.Running {
-			status = style.Dim.Render("○")
+			status = style.Dim.Render(ui.Glyph("○"))
 		}
 		fmt.Printf("  %s %s/%s\n", status, s.Rig, s.Polecat)
 		fmt.Printf("    %s\n", style.Dim.Render(s.SessionID))
@@ -490,7 +491,7 @@ func runSessionInject(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Message sent to %s/%s\n",
-		style.Bold.Render("✓"), rigName, polecatName)
+		style.Bold.Render(ui.Glyph(ui.IconPass)), rigName, polecatName)
 	return nil
 }
 
@@ -542,7 +543,7 @@ func runSessionRestart(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s Session restarted. Attach with: %s\n",
-		style.Bold.Render("✓"),
+		style.Bold.Render(ui.Glyph(ui.IconPass)),
 		style.Dim.Render(fmt.Sprintf("gt session at %s/%s", rigName, polecatName)))
 	return nil
 }
@@ -572,9 +573,9 @@ func runSessionStatus(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s Session: %s/%s\n\n", style.Bold.Render("📺"), rigName, polecatName)
 
 	if info.Running {
-		fmt.Printf("  State: %s\n", style.Bold.Render("● running"))
+		fmt.Printf("  State: %s\n", style.Bold.Render(ui.Glyph("●")+" running"))
 	} else {
-		fmt.Printf("  State: %s\n", style.Dim.Render("○ stopped"))
+		fmt.Printf("  State: %s\n", style.Dim.Render(ui.Glyph("○")+" stopped"))
 		return nil
 	}
 
@@ -679,16 +680,16 @@ func runSessionCheck(cmd *cobra.Command, args []string) error {
 			// Check if session exists
 			running, err := t.HasSession(sessionName)
 			if err != nil {
-				fmt.Printf("  %s %s/%s: %s\n", style.Bold.Render("⚠"), r.Name, polecatName, style.Dim.Render("error checking session"))
+				fmt.Printf("  %s %s/%s: %s\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), r.Name, polecatName, style.Dim.Render("error checking session"))
 				continue
 			}
 
 			if running {
-				fmt.Printf("  %s %s/%s: %s\n", style.Bold.Render("✓"), r.Name, polecatName, style.Dim.Render("session alive"))
+				fmt.Printf("  %s %s/%s: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, polecatName, style.Dim.Render("session alive"))
 				totalHealthy++
 			} else {
 				// Check if polecat has work on hook (would need restart)
-				fmt.Printf("  %s %s/%s: %s\n", style.Bold.Render("✗"), r.Name, polecatName, style.Dim.Render("session not running"))
+				fmt.Printf("  %s %s/%s: %s\n", style.Bold.Render(ui.Glyph("✗")), r.Name, polecatName, style.Dim.Render("session not running"))
 				totalCrashed++
 			}
 		}
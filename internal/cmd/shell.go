@@ -10,6 +10,7 @@ import (
 	"github.com/steveyegge/gastown/internal/shell"
 	"github.com/steveyegge/gastown/internal/state"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 var shellCmd = &cobra.Command{
@@ -70,11 +71,11 @@ func runShellInstall(cmd *cobra.Command, args []string) error {
 	}
 
 	if err := state.Enable(Version); err != nil {
-		fmt.Printf("%s Could not enable Gas Town: %v\n", style.Dim.Render("⚠"), err)
+		fmt.Printf("%s Could not enable Gas Town: %v\n", style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 	}
 
 	rcPath := shell.RCFilePath(shell.DetectShell())
-	fmt.Printf("%s Shell integration installed (%s)\n", style.Success.Render("✓"), rcPath)
+	fmt.Printf("%s Shell integration installed (%s)\n", style.SuccessPrefix, rcPath)
 	fmt.Println()
 	fmt.Printf("Run 'source %s' or open a new terminal to activate.\n", rcPath)
 	return nil
@@ -85,7 +86,7 @@ func runShellRemove(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("%s Shell integration removed\n", style.Success.Render("✓"))
+	fmt.Printf("%s Shell integration removed\n", style.SuccessPrefix)
 	return nil
 }
 
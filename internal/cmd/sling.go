@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -12,10 +13,12 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
-	"github.com/steveyegge/gastown/internal/lock"
+	"github.com/steveyegge/gastown/internal/locks"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/telemetry"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/wisp"
 	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -129,6 +132,7 @@ var (
 	slingBaseBranch    string // --base-branch: override base branch for polecat worktree
 	slingRalph         bool   // --ralph: enable Ralph Wiggum loop mode for multi-step workflows
 	slingFormula       string // --formula: override formula for dispatch (default: mol-polecat-work)
+	slingEstimate      string // --estimate: effort estimate recorded for the slung beads
 )
 
 func init() {
@@ -154,6 +158,7 @@ func init() {
 	slingCmd.Flags().IntVar(&slingMaxConcurrent, "max-concurrent", 0, "Limit concurrent polecat spawns in batch mode (0 = no limit)")
 	slingCmd.Flags().StringVar(&slingBaseBranch, "base-branch", "", "Override base branch for polecat worktree (e.g., 'develop', 'release/v2')")
 	slingCmd.Flags().BoolVar(&slingRalph, "ralph", false, "Enable Ralph Wiggum loop mode (fresh context per step, for multi-step workflows)")
+	slingCmd.Flags().StringVar(&slingEstimate, "estimate", "", "Effort estimate for the slung work (e.g., 45m, 2h; see gt wisp estimate)")
 	slingCmd.Flags().StringVar(&slingFormula, "formula", "", "Formula to apply (default: mol-polecat-work for polecat targets)")
 
 	slingCmd.AddCommand(slingRespawnResetCmd)
@@ -220,6 +225,23 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		}
 	}
 
+	// Record --estimate for the slung beads once the sling succeeds
+	if slingEstimate != "" {
+		estimate, err := parseEstimate(slingEstimate)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if retErr != nil || slingDryRun {
+				return
+			}
+			actor := detectActor()
+			for _, beadID := range slingEstimateBeads(args) {
+				_ = events.LogFeed(events.TypeEstimate, actor, events.EstimatePayload(beadID, estimate))
+			}
+		}()
+	}
+
 	// Disable Dolt auto-commit for all bd commands run during sling (gt-u6n6a).
 	// Under concurrent load (batch slinging), auto-commits from individual bd writes
 	// cause manifest contention and 'database is read only' errors. The Dolt server
@@ -269,6 +291,12 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		args[i] = strings.TrimRight(args[i], "/")
 	}
 
+	// Fully-qualified bead references (<rig>:<bead-id>) are resolved to bare
+	// IDs here, so every dispatch path below sees routable IDs.
+	if err := resolveSlingBeadRefs(townRoot, args); err != nil {
+		return err
+	}
+
 	// Validate target format early, before any dispatch path (bead, formula, batch)
 	// can trigger resolveTarget side-effects like polecat spawning.
 	if len(args) > 1 {
@@ -567,6 +595,13 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		return fmt.Errorf("refusing to sling deferred bead %s: %q\nDeferred work should not consume polecat slots. Use --force to override", beadID, info.Title)
 	}
 
+	// Guard against slinging work that waits on another rig. bd ready only
+	// sees statuses in the bead's own database, so cross-rig blockers are
+	// checked here. Use --force to dispatch anyway.
+	if blockers := slingCrossRigBlockers(townRoot, beadID, info); len(blockers) > 0 && !slingForce {
+		return fmt.Errorf("bead %s is blocked by work in other rigs: %s\nUse --force to sling anyway", beadID, formatXBlockers(blockers))
+	}
+
 	originalStatus := info.Status
 	originalAssignee := info.Assignee
 	force := slingForce // local copy to avoid mutating package-level flag
@@ -578,7 +613,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		// a dead polecat with a matching target triggers re-sling, not a no-op.
 		if (info.Status == "hooked" || info.Status == "in_progress") && info.Assignee != "" && isHookedAgentDeadFn(info.Assignee) {
 			fmt.Printf("%s Hooked agent %s has no active session, auto-forcing re-sling...\n",
-				style.Warning.Render("⚠"), info.Assignee)
+				style.WarningPrefix, info.Assignee)
 			force = true
 		} else {
 			// Agent is alive (or bead is pinned) — check idempotency before erroring.
@@ -606,7 +641,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 				if formulaName == "" {
 					// Plain sling to same target: no-op.
 					fmt.Printf("%s Bead %s is already %s to %s, no-op\n",
-						style.Dim.Render("○"), beadID, info.Status, info.Assignee)
+						style.Dim.Render(ui.Glyph("○")), beadID, info.Status, info.Assignee)
 					return nil
 				}
 				// Formula-on-bead with matching target: fall through so
@@ -638,6 +673,17 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	if len(args) > 1 {
 		target = args[1]
 	}
+	// Saved wisp views can route work: with no explicit target, a bead that
+	// matches a view with a target (settings wisps.views) is slung there
+	// instead of to self.
+	if target == "" {
+		issue := &beads.Issue{ID: beadID, Title: info.Title, Status: info.Status, Priority: info.Priority,
+			Type: info.IssueType, Assignee: info.Assignee, Labels: info.Labels}
+		if routed, view := wisp.RouteTarget(loadWispSettings(townRoot), issue, beads.QueryEnv{}); routed != "" {
+			fmt.Printf("%s Routing %s to %s (wisp view %s)\n", style.Dim.Render(ui.Glyph("○")), beadID, routed, view)
+			target = routed
+		}
+	}
 	resolved, err := resolveTarget(target, ResolveTargetOptions{
 		DryRun:     slingDryRun,
 		Force:      force,
@@ -684,7 +730,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 
 	// Handle --force when bead is already hooked/in_progress: send shutdown to old polecat and unhook (GH#1380)
 	if (info.Status == "hooked" || info.Status == "in_progress") && force && info.Assignee != "" {
-		fmt.Printf("%s Bead already hooked to %s, forcing reassignment...\n", style.Warning.Render("⚠"), info.Assignee)
+		fmt.Printf("%s Bead already hooked to %s, forcing reassignment...\n", style.WarningPrefix, info.Assignee)
 
 		// Determine requester identity from env vars, fall back to "gt-sling"
 		requester := "gt-sling"
@@ -716,7 +762,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 				if err := router.Send(shutdownMsg); err != nil {
 					fmt.Printf("%s Could not send shutdown to witness: %v\n", style.Dim.Render("Warning:"), err)
 				} else {
-					fmt.Printf("%s Sent LIFECYCLE:Shutdown to %s/witness for %s\n", style.Bold.Render("→"), oldRigName, oldPolecatName)
+					fmt.Printf("%s Sent LIFECYCLE:Shutdown to %s/witness for %s\n", style.Bold.Render(ui.Glyph("→")), oldRigName, oldPolecatName)
 				}
 			}
 		}
@@ -746,7 +792,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 					// Log warning but don't fail - convoy is optional
 					fmt.Printf("%s Could not create auto-convoy: %v\n", style.Dim.Render("Warning:"), err)
 				} else {
-					fmt.Printf("%s Created convoy 🚚 %s\n", style.Bold.Render("→"), convoyID)
+					fmt.Printf("%s Created convoy 🚚 %s\n", style.Bold.Render(ui.Glyph("→")), convoyID)
 					fmt.Printf("  Tracking: %s\n", beadID)
 					if slingOwned {
 						fmt.Printf("  Lifecycle: caller-managed (owned)\n")
@@ -757,7 +803,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 				}
 			}
 		} else {
-			fmt.Printf("%s Already tracked by convoy %s\n", style.Dim.Render("○"), existingConvoy)
+			fmt.Printf("%s Already tracked by convoy %s\n", style.Dim.Render(ui.Glyph("○")), existingConvoy)
 		}
 	}
 
@@ -792,7 +838,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 					len(existingMolecules), strings.Join(existingMolecules, ", "))
 			} else if stale {
 				fmt.Printf("  %s Burning %d stale molecule(s) from previous assignment: %s\n",
-					style.Warning.Render("⚠"), len(existingMolecules), strings.Join(existingMolecules, ", "))
+					style.WarningPrefix, len(existingMolecules), strings.Join(existingMolecules, ", "))
 				if err := burnExistingMolecules(existingMolecules, beadID, townRoot); err != nil {
 					return fmt.Errorf("burning stale molecules: %w", err)
 				}
@@ -842,7 +888,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 			// Otherwise, a wisp creation failure (e.g., missing required vars) leaves an orphaned polecat.
 			if newPolecatInfo != nil {
 				fmt.Printf("%s Formula instantiation failed, rolling back spawned polecat %s...\n",
-					style.Warning.Render("⚠"), newPolecatInfo.PolecatName)
+					style.WarningPrefix, newPolecatInfo.PolecatName)
 				rollbackSlingArtifactsFn(newPolecatInfo, beadID, hookWorkDir, "")
 				// Under --force, if this bead was previously pinned, rollback's unhook would otherwise
 				// clear the pinned state. Restore pinned state so we don't lose the original hook.
@@ -853,8 +899,8 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 			return fmt.Errorf("instantiating formula %s: %w", formulaName, err)
 		}
 
-		fmt.Printf("%s Formula wisp created: %s\n", style.Bold.Render("✓"), result.WispRootID)
-		fmt.Printf("%s Formula bonded to %s\n", style.Bold.Render("✓"), beadID)
+		fmt.Printf("%s Formula wisp created: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), result.WispRootID)
+		fmt.Printf("%s Formula bonded to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), beadID)
 
 		// Record attached molecule - will be stored in BASE bead (not wisp).
 		// The base bead is hooked, and its attached_molecule points to the wisp.
@@ -881,7 +927,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		return err
 	}
 
-	fmt.Printf("%s Work attached to hook (status=hooked)\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Work attached to hook (status=hooked)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	// Log sling event to activity feed
 	actor := detectActor()
@@ -910,10 +956,10 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		fmt.Printf("%s Could not store fields in bead: %v\n", style.Dim.Render("Warning:"), err)
 	} else {
 		if slingArgs != "" {
-			fmt.Printf("%s Args stored in bead (durable)\n", style.Bold.Render("✓"))
+			fmt.Printf("%s Args stored in bead (durable)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		}
 		if slingNoMerge {
-			fmt.Printf("%s No-merge mode enabled (work stays on feature branch)\n", style.Bold.Render("✓"))
+			fmt.Printf("%s No-merge mode enabled (work stays on feature branch)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		}
 	}
 
@@ -935,7 +981,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		if err != nil {
 			// Rollback: session failed, clean up zombie artifacts (worktree, hooked bead).
 			// Without rollback, next sling attempt fails with "bead already hooked" (gt-jn40ft).
-			fmt.Printf("%s Session failed, rolling back spawned polecat %s...\n", style.Warning.Render("⚠"), newPolecatInfo.PolecatName)
+			fmt.Printf("%s Session failed, rolling back spawned polecat %s...\n", style.WarningPrefix, newPolecatInfo.PolecatName)
 			rollbackSlingArtifactsFn(newPolecatInfo, beadID, hookWorkDir, "")
 			return fmt.Errorf("starting polecat session: %w", err)
 		}
@@ -950,9 +996,9 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		// Fresh polecat already got StartupNudge from SessionManager.Start()
 	} else if isSelfSling {
 		// Self-sling: agent already knows about the work (just slung it)
-		fmt.Printf("%s Self-sling: work hooked, will process on next turn\n", style.Dim.Render("○"))
+		fmt.Printf("%s Self-sling: work hooked, will process on next turn\n", style.Dim.Render(ui.Glyph("○")))
 	} else if targetPane == "" {
-		fmt.Printf("%s No pane to nudge (agent will discover work via gt prime)\n", style.Dim.Render("○"))
+		fmt.Printf("%s No pane to nudge (agent will discover work via gt prime)\n", style.Dim.Render(ui.Glyph("○")))
 	} else {
 		// Ensure agent is ready before nudging (prevents race condition where
 		// message arrives before Claude has fully started - see issue #115)
@@ -960,16 +1006,16 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		if sessionName != "" {
 			if err := ensureAgentReady(sessionName); err != nil {
 				// Non-fatal: warn and continue, agent will discover work via gt prime
-				fmt.Printf("%s Could not verify agent ready: %v\n", style.Dim.Render("○"), err)
+				fmt.Printf("%s Could not verify agent ready: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 			}
 		}
 
 		if err := injectStartPrompt(targetPane, beadID, slingSubject, slingArgs); err != nil {
 			// Graceful fallback for no-tmux mode
-			fmt.Printf("%s Could not nudge (no tmux?): %v\n", style.Dim.Render("○"), err)
+			fmt.Printf("%s Could not nudge (no tmux?): %v\n", style.Dim.Render(ui.Glyph("○")), err)
 			fmt.Printf("  Agent will discover work via gt prime / bd show\n")
 		} else {
-			fmt.Printf("%s Start prompt sent\n", style.Bold.Render("▶"))
+			fmt.Printf("%s Start prompt sent\n", style.Bold.Render(ui.Glyph("▶")))
 		}
 	}
 
@@ -1029,27 +1075,19 @@ func restorePinnedBead(townRoot, beadID, assignee string) {
 	if err := cmd.Run(); err != nil {
 		fmt.Printf("  %s Could not restore pinned state for bead %s: %v\n", style.Dim.Render("Warning:"), beadID, err)
 	} else {
-		fmt.Printf("  %s Restored pinned state for bead %s\n", style.Dim.Render("○"), beadID)
+		fmt.Printf("  %s Restored pinned state for bead %s\n", style.Dim.Render(ui.Glyph("○")), beadID)
 	}
 }
 
 func tryAcquireSlingBeadLock(townRoot, beadID string) (func(), error) {
-	lockDir := filepath.Join(townRoot, ".runtime", "locks", "sling")
-	if err := os.MkdirAll(lockDir, 0755); err != nil {
-		return nil, fmt.Errorf("creating sling lock dir: %w", err)
+	h, err := locks.Acquire(townRoot, "sling/"+beadID, locks.Options{Purpose: "slinging " + beadID})
+	if errors.Is(err, locks.ErrHeld) {
+		return nil, fmt.Errorf("bead %s is already being slung; retry after the current assignment completes", beadID)
 	}
-
-	safeBeadID := strings.NewReplacer("/", "_", ":", "_").Replace(beadID)
-	lockPath := filepath.Join(lockDir, safeBeadID+".flock")
-	release, locked, err := lock.FlockTryAcquire(lockPath)
 	if err != nil {
 		return nil, fmt.Errorf("acquiring sling lock for bead %s: %w", beadID, err)
 	}
-	if !locked {
-		return nil, fmt.Errorf("bead %s is already being slung; retry after the current assignment completes", beadID)
-	}
-
-	return release, nil
+	return h.Release, nil
 }
 
 // rollbackSlingArtifacts cleans up artifacts left by a partial sling when session start fails.
@@ -1076,7 +1114,7 @@ func rollbackSlingArtifacts(spawnInfo *SpawnedPolecatInfo, beadID, hookWorkDir,
 						fmt.Printf("  %s Could not burn stale molecule(s) from %s: %v\n", style.Dim.Render("Warning:"), beadID, burnErr)
 					} else {
 						fmt.Printf("  %s Burned %d stale molecule(s): %s\n",
-							style.Dim.Render("○"), len(existingMolecules), strings.Join(existingMolecules, ", "))
+							style.Dim.Render(ui.Glyph("○")), len(existingMolecules), strings.Join(existingMolecules, ", "))
 					}
 				}
 			}
@@ -1088,7 +1126,7 @@ func rollbackSlingArtifacts(spawnInfo *SpawnedPolecatInfo, beadID, hookWorkDir,
 			if err := unhookCmd.Run(); err != nil {
 				fmt.Printf("  %s Could not unhook bead %s: %v\n", style.Dim.Render("Warning:"), beadID, err)
 			} else {
-				fmt.Printf("  %s Unhooked bead %s\n", style.Dim.Render("○"), beadID)
+				fmt.Printf("  %s Unhooked bead %s\n", style.Dim.Render(ui.Glyph("○")), beadID)
 			}
 		}
 	}
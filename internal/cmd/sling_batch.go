@@ -15,6 +15,7 @@ import (
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -126,7 +127,7 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 		// Admission control: throttle spawns when --max-concurrent is set
 		if slingMaxConcurrent > 0 && activeCount >= slingMaxConcurrent {
 			fmt.Printf("\n%s Max concurrent limit reached (%d), waiting for capacity...\n",
-				style.Warning.Render("⏳"), slingMaxConcurrent)
+				style.Warning.Render(ui.Glyph("⏳")), slingMaxConcurrent)
 			// Wait for sessions to settle before spawning more
 			for wait := 0; wait < 30; wait++ {
 				time.Sleep(2 * time.Second)
@@ -179,7 +180,7 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 				polecatName = result.PolecatName
 			}
 			results = append(results, batchResult{beadID: beadID, polecat: polecatName, success: false, errMsg: errMsg})
-			fmt.Printf("  %s %s\n", style.Dim.Render("✗"), errMsg)
+			fmt.Printf("  %s %s\n", style.Dim.Render(ui.Glyph("✗")), errMsg)
 			continue
 		}
 
@@ -210,7 +211,7 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 	if successCount < len(beadIDs) {
 		for _, r := range results {
 			if !r.success {
-				fmt.Printf("  %s %s: %s\n", style.Dim.Render("✗"), r.beadID, r.errMsg)
+				fmt.Printf("  %s %s: %s\n", style.Dim.Render(ui.Glyph("✗")), r.beadID, r.errMsg)
 			}
 		}
 	}
@@ -245,7 +246,7 @@ func cleanupSpawnedPolecat(spawnInfo *SpawnedPolecatInfo, rigName, convoyID stri
 			style.Dim.Render("Warning:"), spawnInfo.PolecatName, err)
 	} else {
 		fmt.Printf("  %s Cleaned up orphaned polecat %s\n",
-			style.Dim.Render("○"), spawnInfo.PolecatName)
+			style.Dim.Render(ui.Glyph("○")), spawnInfo.PolecatName)
 	}
 
 	// Delete the git branch if we know it (following nukePolecatFull pattern)
@@ -346,11 +347,11 @@ func getRepoGitForRig(rigPath string) *git.Git {
 func deletePolecatBranch(branchName string, repoGit *git.Git, hasPendingMR bool) {
 	_ = hasPendingMR // preserved for API compat, no longer consulted
 	if err := repoGit.DeleteBranch(branchName, true); err != nil {
-		fmt.Printf("  %s branch delete: %v\n", style.Dim.Render("○"), err)
+		fmt.Printf("  %s branch delete: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 	} else {
-		fmt.Printf("  %s deleted local branch %s\n", style.Success.Render("✓"), branchName)
+		fmt.Printf("  %s deleted local branch %s\n", style.SuccessPrefix, branchName)
 	}
-	fmt.Printf("  %s remote branch preserved for refinery merge\n", style.Dim.Render("○"))
+	fmt.Printf("  %s remote branch preserved for refinery merge\n", style.Dim.Render(ui.Glyph("○")))
 }
 
 // closeConvoy closes a convoy with the given reason.
@@ -368,6 +369,6 @@ func closeConvoy(convoyID, reason string) {
 	if err := closeCmd.Run(); err != nil {
 		fmt.Printf("  %s Could not close convoy %s: %v\n", style.Dim.Render("Warning:"), convoyID, err)
 	} else {
-		fmt.Printf("  %s Closed convoy %s\n", style.Dim.Render("○"), convoyID)
+		fmt.Printf("  %s Closed convoy %s\n", style.Dim.Render(ui.Glyph("○")), convoyID)
 	}
 }
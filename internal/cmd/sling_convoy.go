@@ -13,6 +13,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/telemetry"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -277,14 +278,14 @@ func printConvoyConflict(beadID, convoyID string) {
 		for _, t := range tracked {
 			marker := " "
 			if t.ID == beadID {
-				marker = "→"
+				marker = ui.Glyph("→")
 			}
-			statusIcon := "○"
+			statusIcon := ui.Glyph("○")
 			switch t.Status {
 			case "open":
-				statusIcon = "●"
+				statusIcon = ui.Glyph("●")
 			case "closed":
-				statusIcon = "✓"
+				statusIcon = ui.Glyph(ui.IconPass)
 			case "hooked", "pinned":
 				statusIcon = "◆"
 			}
@@ -9,6 +9,7 @@ import (
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // SlingParams captures everything needed to sling one bead to a rig.
@@ -120,6 +121,10 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 			}
 			return result, fmt.Errorf("cannot sling to %s rig %q\n%s %s", reason, params.RigName, undoCmd, params.RigName)
 		}
+		if err := checkSlingPolicy(townRoot, params.BeadID, params.RigName, params.FormulaName, params.Force); err != nil {
+			result.ErrMsg = "denied by policy"
+			return result, err
+		}
 	}
 
 	// 1. Get bead info + status check
@@ -146,7 +151,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 		// programmatic dispatch also handles stale hooks from nuked polecats.
 		if (info.Status == "hooked" || info.Status == "in_progress") && info.Assignee != "" && isHookedAgentDeadFn(info.Assignee) {
 			fmt.Printf("  %s Hooked agent %s has no active session, auto-forcing dispatch...\n",
-				style.Warning.Render("⚠"), info.Assignee)
+				style.WarningPrefix, info.Assignee)
 			params.Force = true
 		} else {
 			result.ErrMsg = "already " + info.Status
@@ -187,7 +192,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 				if err := router.Send(shutdownMsg); err != nil {
 					fmt.Printf("  %s Could not send shutdown to witness: %v\n", style.Dim.Render("Warning:"), err)
 				} else {
-					fmt.Printf("  %s Sent LIFECYCLE:Shutdown to %s/witness for %s\n", style.Bold.Render("→"), oldRigName, oldPolecatName)
+					fmt.Printf("  %s Sent LIFECYCLE:Shutdown to %s/witness for %s\n", style.Bold.Render(ui.Glyph("→")), oldRigName, oldPolecatName)
 				}
 				router.WaitPendingNotifications()
 			}
@@ -206,7 +211,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 				(info.Assignee != "" && isHookedAgentDeadFn(info.Assignee))
 			if stale {
 				fmt.Printf("  %s Burning %d stale molecule(s): %s\n",
-					style.Warning.Render("⚠"), len(existingMolecules), strings.Join(existingMolecules, ", "))
+					style.WarningPrefix, len(existingMolecules), strings.Join(existingMolecules, ", "))
 				if err := burnExistingMolecules(existingMolecules, params.BeadID, townRoot); err != nil {
 					result.ErrMsg = fmt.Sprintf("burn failed: %v", err)
 					return result, fmt.Errorf("burning stale molecules: %w", err)
@@ -252,10 +257,10 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 			if err != nil {
 				fmt.Printf("  %s Could not create auto-convoy: %v\n", style.Dim.Render("Warning:"), err)
 			} else {
-				fmt.Printf("  %s Created convoy %s\n", style.Bold.Render("→"), convoyID)
+				fmt.Printf("  %s Created convoy %s\n", style.Bold.Render(ui.Glyph("→")), convoyID)
 			}
 		} else {
-			fmt.Printf("  %s Already tracked by convoy %s\n", style.Dim.Render("○"), existingConvoy)
+			fmt.Printf("  %s Already tracked by convoy %s\n", style.Dim.Render(ui.Glyph("○")), existingConvoy)
 		}
 	}
 
@@ -299,7 +304,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 			// spawned polecat. We still hook the raw bead so work can proceed (e.g., missing required vars).
 			fmt.Printf("  %s Could not apply formula: %v (hooking raw bead)\n", style.Dim.Render("Warning:"), err)
 		} else {
-			fmt.Printf("  %s Formula %s applied\n", style.Bold.Render("✓"), params.FormulaName)
+			fmt.Printf("  %s Formula %s applied\n", style.Bold.Render(ui.Glyph(ui.IconPass)), params.FormulaName)
 			beadToHook = formulaResult.BeadToHook
 			attachedMoleculeID = formulaResult.WispRootID
 		}
@@ -315,7 +320,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 		return result, fmt.Errorf("failed to hook bead: %w", err)
 	}
 
-	fmt.Printf("  %s Work attached to %s\n", style.Bold.Render("✓"), spawnInfo.PolecatName)
+	fmt.Printf("  %s Work attached to %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), spawnInfo.PolecatName)
 
 	// 8. Log sling event
 	actor := detectActor()
@@ -346,12 +351,12 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 	// 11. Start polecat session
 	pane, err := spawnInfo.StartSession()
 	if err != nil {
-		fmt.Printf("  %s Could not start session: %v, cleaning up partial state...\n", style.Dim.Render("✗"), err)
+		fmt.Printf("  %s Could not start session: %v, cleaning up partial state...\n", style.Dim.Render(ui.Glyph("✗")), err)
 		rollbackSlingArtifactsFn(spawnInfo, beadToHook, hookWorkDir, convoyID)
 		result.ErrMsg = fmt.Sprintf("session failed: %v", err)
 		return result, fmt.Errorf("starting polecat session: %w", err)
 	}
-	fmt.Printf("  %s Session started for %s\n", style.Bold.Render("▶"), spawnInfo.PolecatName)
+	fmt.Printf("  %s Session started for %s\n", style.Bold.Render(ui.Glyph("▶")), spawnInfo.PolecatName)
 	_ = pane
 
 	result.Success = true
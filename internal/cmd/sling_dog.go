@@ -9,6 +9,7 @@ import (
 	"github.com/steveyegge/gastown/internal/dog"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -106,7 +107,7 @@ func DispatchToDog(dogName string, opts DogDispatchOptions) (*DogDispatchInfo, e
 				if err != nil {
 					return nil, fmt.Errorf("creating dog %s: %w", dogName, err)
 				}
-				fmt.Printf("✓ Created dog %s\n", dogName)
+				fmt.Printf("%s Created dog %s\n", ui.Glyph(ui.IconPass), dogName)
 				spawned = true
 			} else {
 				return nil, fmt.Errorf("dog %s not found (use --create to add)", dogName)
@@ -136,7 +137,7 @@ func DispatchToDog(dogName string, opts DogDispatchOptions) (*DogDispatchInfo, e
 			if err != nil {
 				return nil, fmt.Errorf("creating dog %s: %w", newName, err)
 			}
-			fmt.Printf("✓ Auto-created dog %s (no idle dogs, pool %d/%d)\n", newName, len(dogs)+1, maxDogPoolSize)
+			fmt.Printf("%s Auto-created dog %s (no idle dogs, pool %d/%d)\n", ui.Glyph(ui.IconPass), newName, len(dogs)+1, maxDogPoolSize)
 			spawned = true
 		}
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -113,7 +114,7 @@ func runSlingFormula(args []string) error {
 		if resolved.NewPolecatInfo == nil {
 			return
 		}
-		fmt.Printf("%s Rolling back spawned polecat %s...\n", style.Warning.Render("⚠"), resolved.NewPolecatInfo.PolecatName)
+		fmt.Printf("%s Rolling back spawned polecat %s...\n", style.WarningPrefix, resolved.NewPolecatInfo.PolecatName)
 		rollbackSlingArtifactsFn(resolved.NewPolecatInfo, beadID, formulaWorkDir, "")
 	}
 
@@ -168,7 +169,7 @@ func runSlingFormula(args []string) error {
 		return fmt.Errorf("parsing wisp output: %w", err)
 	}
 
-	fmt.Printf("%s Wisp created: %s\n", style.Bold.Render("✓"), wispRootID)
+	fmt.Printf("%s Wisp created: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), wispRootID)
 
 	// Step 3: Hook the wisp bead with retry and verification.
 	// See: https://github.com/steveyegge/gastown/issues/148
@@ -176,7 +177,7 @@ func runSlingFormula(args []string) error {
 	if err := hookBeadWithRetry(wispRootID, targetAgent, hookDir); err != nil {
 		return err
 	}
-	fmt.Printf("%s Attached to hook (status=hooked)\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Attached to hook (status=hooked)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	// Log sling event to activity feed (formula slinging)
 	actor := detectActor()
@@ -201,7 +202,7 @@ func runSlingFormula(args []string) error {
 	if err := storeFieldsInBead(wispRootID, fieldUpdates); err != nil {
 		fmt.Printf("%s Could not store fields in bead: %v\n", style.Dim.Render("Warning:"), err)
 	} else if slingArgs != "" {
-		fmt.Printf("%s Args stored in bead (durable)\n", style.Bold.Render("✓"))
+		fmt.Printf("%s Args stored in bead (durable)\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 
 	// Start delayed dog session now that hook is set
@@ -230,11 +231,11 @@ func runSlingFormula(args []string) error {
 	// Skip for self-sling - agent is currently processing the sling command and will see
 	// the hooked work on next turn. Nudging would inject text while agent is busy.
 	if isSelfSling {
-		fmt.Printf("%s Self-sling: work hooked, will process on next turn\n", style.Dim.Render("○"))
+		fmt.Printf("%s Self-sling: work hooked, will process on next turn\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 	if targetPane == "" {
-		fmt.Printf("%s No pane to nudge (agent will discover work via gt prime)\n", style.Dim.Render("○"))
+		fmt.Printf("%s No pane to nudge (agent will discover work via gt prime)\n", style.Dim.Render(ui.Glyph("○")))
 		return nil
 	}
 
@@ -252,10 +253,10 @@ func runSlingFormula(args []string) error {
 	t := tmux.NewTmux()
 	if err := t.NudgePane(targetPane, prompt); err != nil {
 		// Graceful fallback for no-tmux mode
-		fmt.Printf("%s Could not nudge (no tmux?): %v\n", style.Dim.Render("○"), err)
+		fmt.Printf("%s Could not nudge (no tmux?): %v\n", style.Dim.Render(ui.Glyph("○")), err)
 		fmt.Printf("  Agent will discover work via gt prime / bd show\n")
 	} else {
-		fmt.Printf("%s Nudged to start\n", style.Bold.Render("▶"))
+		fmt.Printf("%s Nudged to start\n", style.Bold.Render(ui.Glyph("▶")))
 	}
 
 	return nil
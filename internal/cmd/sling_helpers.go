@@ -78,6 +78,7 @@ type beadInfo struct {
 	Title        string           `json:"title"`
 	Status       string           `json:"status"`
 	Assignee     string           `json:"assignee"`
+	Priority     int              `json:"priority"`
 	Description  string           `json:"description"`
 	Labels       []string         `json:"labels,omitempty"`
 	Dependencies []beads.IssueDep `json:"dependencies,omitempty"`
@@ -684,7 +685,7 @@ func nudgeRefinery(rigName, message string) {
 	}
 
 	t := tmux.NewTmux()
-	if err := t.NudgeSession(refinerySession, message); err != nil {
+	if err := t.NudgeWhenIdle(refinerySession, message, constants.NudgeIdleTimeout); err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: failed to nudge refinery %s: %v\n", refinerySession, err)
 	}
 }
@@ -1030,7 +1031,7 @@ func hookBeadWithRetry(beadID, targetAgent, hookDir string) error {
 			}
 			if attempt < maxRetries {
 				backoff := slingBackoff(attempt, baseBackoff, maxBackoff)
-				fmt.Printf("%s Hook attempt %d failed, retrying in %v...\n", style.Warning.Render("⚠"), attempt, backoff)
+				fmt.Printf("%s Hook attempt %d failed, retrying in %v...\n", style.WarningPrefix, attempt, backoff)
 				time.Sleep(backoff)
 				continue
 			}
@@ -1046,7 +1047,7 @@ func hookBeadWithRetry(beadID, targetAgent, hookDir string) error {
 			lastErr = fmt.Errorf("verifying hook: %w", verifyErr)
 			if attempt < maxRetries {
 				backoff := slingBackoff(attempt, baseBackoff, maxBackoff)
-				fmt.Printf("%s Hook verification failed, retrying in %v...\n", style.Warning.Render("⚠"), backoff)
+				fmt.Printf("%s Hook verification failed, retrying in %v...\n", style.WarningPrefix, backoff)
 				time.Sleep(backoff)
 				continue
 			}
@@ -1058,7 +1059,7 @@ func hookBeadWithRetry(beadID, targetAgent, hookDir string) error {
 				verifyInfo.Status, verifyInfo.Assignee, targetAgent)
 			if attempt < maxRetries {
 				backoff := slingBackoff(attempt, baseBackoff, maxBackoff)
-				fmt.Printf("%s %v, retrying in %v...\n", style.Warning.Render("⚠"), lastErr, backoff)
+				fmt.Printf("%s %v, retrying in %v...\n", style.WarningPrefix, lastErr, backoff)
 				time.Sleep(backoff)
 				continue
 			}
@@ -1204,3 +1205,74 @@ func updateAgentMode(agentID, mode, workDir, townBeadsDir string) {
 		fmt.Fprintf(os.Stderr, "Warning: couldn't set agent %s mode: %v\n", agentBeadID, err)
 	}
 }
+
+// resolveSlingBeadRefs rewrites fully-qualified bead references among the
+// sling arguments (and --on) to bare bead IDs. The last argument is left
+// alone when it is a target.
+func resolveSlingBeadRefs(townRoot string, args []string) error {
+	beadArgs := args
+	if len(args) > 1 {
+		beadArgs = args[:len(args)-1]
+	}
+	var xr *beads.XRefResolver
+	resolve := func(ref string) (string, error) {
+		if !beads.IsQualifiedRef(ref) {
+			return ref, nil
+		}
+		if xr == nil {
+			var err error
+			if xr, err = beads.NewXRefResolver(townRoot); err != nil {
+				return "", err
+			}
+		}
+		x, err := xr.Resolve(ref)
+		if err != nil {
+			return "", err
+		}
+		return x.ID, nil
+	}
+	for i, arg := range beadArgs {
+		id, err := resolve(arg)
+		if err != nil {
+			return err
+		}
+		beadArgs[i] = id
+	}
+	if slingOnTarget != "" {
+		id, err := resolve(slingOnTarget)
+		if err != nil {
+			return err
+		}
+		slingOnTarget = id
+	}
+	return nil
+}
+
+// slingCrossRigBlockers returns the bead's open blocking dependencies in
+// other rigs. Resolution failures leave the bead unblocked: sling should not
+// be held hostage by a routing problem in an unrelated rig.
+func slingCrossRigBlockers(townRoot, beadID string, info *beadInfo) []beads.XBlocker {
+	if len(info.Dependencies) == 0 {
+		return nil
+	}
+	xr, err := beads.NewXRefResolver(townRoot)
+	if err != nil {
+		return nil
+	}
+	var open []beads.XBlocker
+	for _, b := range xr.CrossRigBlockers(&beads.Issue{ID: beadID, Dependencies: info.Dependencies}) {
+		if b.Err == "" {
+			open = append(open, b)
+		}
+	}
+	return open
+}
+
+// formatXBlockers renders cross-rig blockers as "rig:id (status)" items.
+func formatXBlockers(blockers []beads.XBlocker) string {
+	parts := make([]string, len(blockers))
+	for i, b := range blockers {
+		parts[i] = fmt.Sprintf("%s (%s)", b.Ref, b.Status)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -97,7 +98,7 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 	}
 	if existingCtx != nil {
 		fmt.Printf("%s Bead %s is already scheduled (context: %s), no-op\n",
-			style.Dim.Render("○"), beadID, existingCtx.ID)
+			style.Dim.Render(ui.Glyph("○")), beadID, existingCtx.ID)
 		return nil
 	}
 
@@ -177,7 +178,7 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 			if err != nil {
 				fmt.Printf("%s Could not create auto-convoy: %v\n", style.Dim.Render("Warning:"), err)
 			} else {
-				fmt.Printf("%s Created convoy %s\n", style.Bold.Render("→"), convoyID)
+				fmt.Printf("%s Created convoy %s\n", style.Bold.Render(ui.Glyph("→")), convoyID)
 				// Update the context bead fields with convoy ID
 				fields.Convoy = convoyID
 				if updateErr := townBeads.UpdateSlingContextFields(ctxBead.ID, fields); updateErr != nil {
@@ -185,14 +186,14 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 				}
 			}
 		} else {
-			fmt.Printf("%s Already tracked by convoy %s\n", style.Dim.Render("○"), existingConvoy)
+			fmt.Printf("%s Already tracked by convoy %s\n", style.Dim.Render(ui.Glyph("○")), existingConvoy)
 		}
 	}
 
 	actor := detectActor()
 	_ = events.LogFeed(events.TypeSchedulerEnqueue, actor, events.SchedulerEnqueuePayload(beadID, rigName))
 
-	fmt.Printf("%s Scheduled %s → %s (context: %s)\n", style.Bold.Render("✓"), beadID, rigName, ctxBead.ID)
+	fmt.Printf("%s Scheduled %s → %s (context: %s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), beadID, rigName, ctxBead.ID)
 	return nil
 }
 
@@ -229,7 +230,7 @@ func runBatchSchedule(beadIDs []string, rigName string) error {
 			Ralph:       slingRalph,
 		})
 		if err != nil {
-			fmt.Printf("  %s %s: %v\n", style.Dim.Render("✗"), beadID, err)
+			fmt.Printf("  %s %s: %v\n", style.Dim.Render(ui.Glyph("✗")), beadID, err)
 			continue
 		}
 		successCount++
@@ -287,7 +288,7 @@ func areScheduled(beadIDs []string) map[string]bool {
 	contexts, err := townBeads.ListOpenSlingContexts()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%s Warning: could not list sling contexts: %v (treating all as scheduled)\n",
-			style.Dim.Render("⚠"), err)
+			style.Dim.Render(ui.Glyph(ui.IconWarn)), err)
 		// Fail closed: treat all as scheduled to avoid duplicate scheduling
 		for _, id := range beadIDs {
 			result[id] = true
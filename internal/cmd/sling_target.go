@@ -191,6 +191,9 @@ func resolveTarget(target string, opts ResolveTargetOptions) (*ResolvedTarget, e
 				}
 				return nil, fmt.Errorf("cannot sling to %s rig %q\n%s %s", reason, rigName, undoCmd, rigName)
 			}
+			if err := checkSlingPolicy(townRoot, opts.BeadID, rigName, "", opts.Force); err != nil {
+				return nil, err
+			}
 		}
 
 		if opts.BeadID != "" && !opts.Force {
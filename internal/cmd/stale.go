@@ -107,7 +107,7 @@ func outputStaleJSON(output StaleOutput) error {
 
 func outputStaleText(output StaleOutput) error {
 	if output.Stale {
-		fmt.Printf("%s Binary is stale\n", style.Warning.Render("⚠"))
+		fmt.Printf("%s Binary is stale\n", style.WarningPrefix)
 		fmt.Printf("  Binary: %s\n", version.ShortCommit(output.BinaryCommit))
 		fmt.Printf("  Repo:   %s\n", version.ShortCommit(output.RepoCommit))
 		if output.CommitsBehind > 0 {
@@ -115,7 +115,7 @@ func outputStaleText(output StaleOutput) error {
 		}
 		fmt.Printf("\n  Run 'go install ./cmd/gt' to rebuild\n")
 	} else {
-		fmt.Printf("%s Binary is fresh\n", style.Success.Render("✓"))
+		fmt.Printf("%s Binary is fresh\n", style.SuccessPrefix)
 		fmt.Printf("  Commit: %s\n", version.ShortCommit(output.BinaryCommit))
 	}
 	return nil
@@ -26,6 +26,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -192,7 +193,7 @@ func runStart(cmd *cobra.Command, args []string) error {
 	}
 
 	if err := config.EnsureDaemonPatrolConfig(townRoot); err != nil {
-		fmt.Printf("  %s Could not ensure daemon config: %v\n", style.Dim.Render("○"), err)
+		fmt.Printf("  %s Could not ensure daemon config: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 	}
 
 	t := tmux.NewTmux()
@@ -201,9 +202,9 @@ func runStart(cmd *cobra.Command, args []string) error {
 	// This prevents session name conflicts and resource accumulation from
 	// zombie sessions (tmux alive but Claude dead).
 	if cleaned, err := t.CleanupOrphanedSessions(session.IsKnownSession); err != nil {
-		fmt.Printf("  %s Could not clean orphaned sessions: %v\n", style.Dim.Render("○"), err)
+		fmt.Printf("  %s Could not clean orphaned sessions: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 	} else if cleaned > 0 {
-		fmt.Printf("  %s Cleaned up %d orphaned session(s)\n", style.Bold.Render("✓"), cleaned)
+		fmt.Printf("  %s Cleaned up %d orphaned session(s)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), cleaned)
 	}
 
 	fmt.Printf("Starting Gas Town from %s\n\n", style.Dim.Render(townRoot))
@@ -213,7 +214,7 @@ func runStart(cmd *cobra.Command, args []string) error {
 	// Discover rigs once upfront to avoid redundant calls from parallel goroutines
 	rigs, rigsErr := discoverAllRigs(townRoot)
 	if rigsErr != nil {
-		fmt.Printf("  %s Could not discover rigs: %v\n", style.Dim.Render("○"), rigsErr)
+		fmt.Printf("  %s Could not discover rigs: %v\n", style.Dim.Render(ui.Glyph("○")), rigsErr)
 		// Continue anyway - core agents don't need rigs
 	}
 
@@ -236,18 +237,18 @@ func runStart(cmd *cobra.Command, args []string) error {
 		if running {
 			doltOK = true
 			mu.Lock()
-			fmt.Printf("  %s Dolt server already running\n", style.Dim.Render("○"))
+			fmt.Printf("  %s Dolt server already running\n", style.Dim.Render(ui.Glyph("○")))
 			mu.Unlock()
 			return
 		}
 		if err := doltserver.Start(townRoot); err != nil {
 			mu.Lock()
-			fmt.Printf("  %s Dolt server failed: %v\n", style.Dim.Render("○"), err)
+			fmt.Printf("  %s Dolt server failed: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 			mu.Unlock()
 		} else {
 			doltOK = true
 			mu.Lock()
-			fmt.Printf("  %s Dolt server started (port %d)\n", style.Bold.Render("✓"), doltserver.DefaultPort)
+			fmt.Printf("  %s Dolt server started (port %d)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), doltserver.DefaultPort)
 			mu.Unlock()
 		}
 	}()
@@ -293,7 +294,7 @@ func runStart(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Println()
-	fmt.Printf("%s Gas Town is running\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Gas Town is running\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Println()
 	fmt.Printf("  Attach to Mayor:  %s\n", style.Dim.Render("gt mayor attach"))
 	fmt.Printf("  Attach to Deacon: %s\n", style.Dim.Render("gt deacon attach"))
@@ -317,7 +318,7 @@ func startCoreAgents(townRoot string, agentOverride string, mu *sync.Mutex) erro
 		if err := mayorMgr.Start(agentOverride); err != nil {
 			if errors.Is(err, mayor.ErrAlreadyRunning) {
 				mu.Lock()
-				fmt.Printf("  %s Mayor already running\n", style.Dim.Render("○"))
+				fmt.Printf("  %s Mayor already running\n", style.Dim.Render(ui.Glyph("○")))
 				mu.Unlock()
 			} else {
 				errMu.Lock()
@@ -326,12 +327,12 @@ func startCoreAgents(townRoot string, agentOverride string, mu *sync.Mutex) erro
 				}
 				errMu.Unlock()
 				mu.Lock()
-				fmt.Printf("  %s Mayor failed: %v\n", style.Dim.Render("○"), err)
+				fmt.Printf("  %s Mayor failed: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 				mu.Unlock()
 			}
 		} else {
 			mu.Lock()
-			fmt.Printf("  %s Mayor started\n", style.Bold.Render("✓"))
+			fmt.Printf("  %s Mayor started\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 			mu.Unlock()
 		}
 	}()
@@ -344,7 +345,7 @@ func startCoreAgents(townRoot string, agentOverride string, mu *sync.Mutex) erro
 		if err := deaconMgr.Start(agentOverride); err != nil {
 			if errors.Is(err, deacon.ErrAlreadyRunning) {
 				mu.Lock()
-				fmt.Printf("  %s Deacon already running\n", style.Dim.Render("○"))
+				fmt.Printf("  %s Deacon already running\n", style.Dim.Render(ui.Glyph("○")))
 				mu.Unlock()
 			} else {
 				errMu.Lock()
@@ -353,12 +354,12 @@ func startCoreAgents(townRoot string, agentOverride string, mu *sync.Mutex) erro
 				}
 				errMu.Unlock()
 				mu.Lock()
-				fmt.Printf("  %s Deacon failed: %v\n", style.Dim.Render("○"), err)
+				fmt.Printf("  %s Deacon failed: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 				mu.Unlock()
 			}
 		} else {
 			mu.Lock()
-			fmt.Printf("  %s Deacon started\n", style.Bold.Render("✓"))
+			fmt.Printf("  %s Deacon started\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 			mu.Unlock()
 		}
 	}()
@@ -402,11 +403,11 @@ func startWitnessForRig(r *rig.Rig) string {
 	witMgr := witness.NewManager(r)
 	if err := witMgr.Start(false, "", nil); err != nil {
 		if errors.Is(err, witness.ErrAlreadyRunning) {
-			return fmt.Sprintf("  %s %s witness already running\n", style.Dim.Render("○"), r.Name)
+			return fmt.Sprintf("  %s %s witness already running\n", style.Dim.Render(ui.Glyph("○")), r.Name)
 		}
-		return fmt.Sprintf("  %s %s witness failed: %v\n", style.Dim.Render("○"), r.Name, err)
+		return fmt.Sprintf("  %s %s witness failed: %v\n", style.Dim.Render(ui.Glyph("○")), r.Name, err)
 	}
-	return fmt.Sprintf("  %s %s witness started\n", style.Bold.Render("✓"), r.Name)
+	return fmt.Sprintf("  %s %s witness started\n", style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name)
 }
 
 // startRefineryForRig starts the refinery for a single rig and returns a status message.
@@ -414,11 +415,11 @@ func startRefineryForRig(r *rig.Rig) string {
 	refineryMgr := refinery.NewManager(r)
 	if err := refineryMgr.Start(false, ""); err != nil {
 		if errors.Is(err, refinery.ErrAlreadyRunning) {
-			return fmt.Sprintf("  %s %s refinery already running\n", style.Dim.Render("○"), r.Name)
+			return fmt.Sprintf("  %s %s refinery already running\n", style.Dim.Render(ui.Glyph("○")), r.Name)
 		}
-		return fmt.Sprintf("  %s %s refinery failed: %v\n", style.Dim.Render("○"), r.Name, err)
+		return fmt.Sprintf("  %s %s refinery failed: %v\n", style.Dim.Render(ui.Glyph("○")), r.Name, err)
 	}
-	return fmt.Sprintf("  %s %s refinery started\n", style.Bold.Render("✓"), r.Name)
+	return fmt.Sprintf("  %s %s refinery started\n", style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name)
 }
 
 // startConfiguredCrew starts crew members configured in rig settings in parallel.
@@ -447,7 +448,7 @@ func startConfiguredCrew(t *tmux.Tmux, rigs []*rig.Rig, townRoot string, mu *syn
 
 	if atomic.LoadInt32(&startedAny) == 0 {
 		mu.Lock()
-		fmt.Printf("  %s No crew configured or all already running\n", style.Dim.Render("○"))
+		fmt.Printf("  %s No crew configured or all already running\n", style.Dim.Render(ui.Glyph("○")))
 		mu.Unlock()
 	}
 }
@@ -473,18 +474,18 @@ func startOrRestartCrewMember(t *tmux.Tmux, r *rig.Rig, crewName, townRoot strin
 			})
 			agentCmd := config.BuildCrewStartupCommand(r.Name, crewName, r.Path, beacon)
 			if err := t.SendKeys(sessionID, agentCmd); err != nil {
-				return fmt.Sprintf("  %s %s/%s restart failed: %v\n", style.Dim.Render("○"), r.Name, crewName, err), false
+				return fmt.Sprintf("  %s %s/%s restart failed: %v\n", style.Dim.Render(ui.Glyph("○")), r.Name, crewName, err), false
 			}
-			return fmt.Sprintf("  %s %s/%s agent restarted\n", style.Bold.Render("✓"), r.Name, crewName), true
+			return fmt.Sprintf("  %s %s/%s agent restarted\n", style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, crewName), true
 		}
 		// Agent is alive — nothing to do
-		return fmt.Sprintf("  %s %s/%s already running\n", style.Dim.Render("○"), r.Name, crewName), false
+		return fmt.Sprintf("  %s %s/%s already running\n", style.Dim.Render(ui.Glyph("○")), r.Name, crewName), false
 	}
 
 	if err := startCrewMember(r.Name, crewName, townRoot); err != nil {
-		return fmt.Sprintf("  %s %s/%s failed: %v\n", style.Dim.Render("○"), r.Name, crewName, err), false
+		return fmt.Sprintf("  %s %s/%s failed: %v\n", style.Dim.Render(ui.Glyph("○")), r.Name, crewName, err), false
 	}
-	return fmt.Sprintf("  %s %s/%s started\n", style.Bold.Render("✓"), r.Name, crewName), true
+	return fmt.Sprintf("  %s %s/%s started\n", style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, crewName), true
 }
 
 // discoverAllRigs finds all rigs in the workspace.
@@ -516,7 +517,7 @@ func runShutdown(cmd *cobra.Command, args []string) error {
 	toStop, preserved := categorizeSessions(sessions)
 
 	if len(toStop) == 0 {
-		fmt.Printf("%s Gas Town was not running\n", style.Dim.Render("○"))
+		fmt.Printf("%s Gas Town was not running\n", style.Dim.Render(ui.Glyph("○")))
 
 		// Still check for orphaned daemons even if no sessions are running
 		if townRoot != "" {
@@ -531,13 +532,13 @@ func runShutdown(cmd *cobra.Command, args []string) error {
 	// Show what will happen
 	fmt.Println("Sessions to stop:")
 	for _, sess := range toStop {
-		fmt.Printf("  %s %s\n", style.Bold.Render("→"), sess)
+		fmt.Printf("  %s %s\n", style.Bold.Render(ui.Glyph("→")), sess)
 	}
 	if len(preserved) > 0 && !shutdownAll {
 		fmt.Println()
 		fmt.Println("Sessions preserved (crew):")
 		for _, sess := range preserved {
-			fmt.Printf("  %s %s\n", style.Dim.Render("○"), sess)
+			fmt.Printf("  %s %s\n", style.Dim.Render(ui.Glyph("○")), sess)
 		}
 	}
 	fmt.Println()
@@ -609,18 +610,25 @@ func runGracefulShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) err
 	// Phase 1: Send ESC to all agents to interrupt them
 	fmt.Printf("Phase 1: Sending ESC to %d agent(s)...\n", len(gtSessions))
 	for _, sess := range gtSessions {
-		fmt.Printf("  %s Interrupting %s\n", style.Bold.Render("→"), sess)
+		fmt.Printf("  %s Interrupting %s\n", style.Bold.Render(ui.Glyph("→")), sess)
 		_ = t.SendKeysRaw(sess, "Escape") // best-effort interrupt
 	}
 
 	// Phase 2: Send shutdown message asking agents to handoff
 	fmt.Printf("\nPhase 2: Requesting handoff from agents...\n")
 	shutdownMsg := "[SHUTDOWN] Gas Town is shutting down. Please save your state and update your handoff bead, then type /exit or wait to be terminated."
+	// Let the interrupted agents settle at their prompts, then send the
+	// message. They settle in parallel, so this takes one timeout at most.
+	var wg sync.WaitGroup
 	for _, sess := range gtSessions {
-		// Small delay then send the message
-		time.Sleep(constants.ShutdownNotifyDelay)
-		_ = t.SendKeys(sess, shutdownMsg) // best-effort notification
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = t.WaitForIdle(sess, constants.InterruptSettleTimeout)
+			_ = t.SendKeys(sess, shutdownMsg) // best-effort notification
+		}()
 	}
+	wg.Wait()
 
 	// Phase 3: Wait for agents to complete handoff
 	fmt.Printf("\nPhase 3: Waiting %ds for agents to complete handoff...\n", shutdownWait)
@@ -629,7 +637,7 @@ func runGracefulShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) err
 	// Wait with countdown
 	for remaining := shutdownWait; remaining > 0; remaining -= 5 {
 		if remaining < shutdownWait {
-			fmt.Printf("  %s %ds remaining...\n", style.Dim.Render("⏳"), remaining)
+			fmt.Printf("  %s %ds remaining...\n", style.Dim.Render(ui.Glyph("⏳")), remaining)
 		}
 		sleepTime := 5
 		if remaining < 5 {
@@ -672,7 +680,7 @@ func runGracefulShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) err
 	verifyNoOrphans()
 
 	fmt.Println()
-	fmt.Printf("%s Graceful shutdown complete (%d sessions stopped)\n", style.Bold.Render("✓"), stopped)
+	fmt.Printf("%s Graceful shutdown complete (%d sessions stopped)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), stopped)
 	return nil
 }
 
@@ -715,7 +723,7 @@ func runImmediateShutdown(t *tmux.Tmux, gtSessions []string, townRoot string) er
 	verifyNoOrphans()
 
 	fmt.Println()
-	fmt.Printf("%s Gas Town shutdown complete (%d sessions stopped)\n", style.Bold.Render("✓"), stopped)
+	fmt.Printf("%s Gas Town shutdown complete (%d sessions stopped)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), stopped)
 
 	return nil
 }
@@ -782,7 +790,7 @@ func killSessionsInOrder(t *tmux.Tmux, sessions []string, mayorSession, deaconSe
 		// killed the processes and the session auto-closed
 		stillExists, _ := t.HasSession(sess)
 		if !stillExists {
-			fmt.Printf("  %s %s stopped\n", style.Bold.Render("✓"), sess)
+			fmt.Printf("  %s %s stopped\n", style.Bold.Render(ui.Glyph(ui.IconPass)), sess)
 			return true
 		}
 		return false
@@ -836,7 +844,7 @@ func cleanupPolecats(townRoot string) {
 	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
 	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
 	if err != nil {
-		fmt.Printf("  %s Could not load rigs config: %v\n", style.Dim.Render("○"), err)
+		fmt.Printf("  %s Could not load rigs config: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 		return
 	}
 
@@ -846,7 +854,7 @@ func cleanupPolecats(townRoot string) {
 	// Discover all rigs
 	rigs, err := rigMgr.DiscoverRigs()
 	if err != nil {
-		fmt.Printf("  %s Could not discover rigs: %v\n", style.Dim.Render("○"), err)
+		fmt.Printf("  %s Could not discover rigs: %v\n", style.Dim.Render(ui.Glyph("○")), err)
 		return
 	}
 
@@ -871,7 +879,7 @@ func cleanupPolecats(townRoot string) {
 				// Can't check, be safe and skip unless nuclear
 				if !shutdownNuclear {
 					fmt.Printf("  %s %s/%s: could not check status, skipping\n",
-						style.Dim.Render("○"), r.Name, p.Name)
+						style.Dim.Render(ui.Glyph("○")), r.Name, p.Name)
 					totalSkipped++
 					continue
 				}
@@ -885,14 +893,14 @@ func cleanupPolecats(townRoot string) {
 				}
 				// Nuclear mode: warn but proceed
 				fmt.Printf("  %s %s/%s: NUCLEAR - removing despite %s\n",
-					style.Bold.Render("⚠"), r.Name, p.Name, status.String())
+					style.Bold.Render(ui.Glyph(ui.IconWarn)), r.Name, p.Name, status.String())
 			}
 
 			// Clean: remove worktree and branch
 			// selfNuke=false because this is gt start --shutdown cleanup, not polecat self-deleting
 			if err := polecatMgr.RemoveWithOptions(p.Name, true, shutdownNuclear, false); err != nil {
 				fmt.Printf("  %s %s/%s: cleanup failed: %v\n",
-					style.Dim.Render("○"), r.Name, p.Name, err)
+					style.Dim.Render(ui.Glyph("○")), r.Name, p.Name, err)
 				totalSkipped++
 				continue
 			}
@@ -903,7 +911,7 @@ func cleanupPolecats(townRoot string) {
 			mayorGit := git.NewGit(mayorPath)
 			_ = mayorGit.DeleteBranch(branchName, true) // Ignore errors
 
-			fmt.Printf("  %s %s/%s: cleaned up\n", style.Bold.Render("✓"), r.Name, p.Name)
+			fmt.Printf("  %s %s/%s: cleaned up\n", style.Bold.Render(ui.Glyph(ui.IconPass)), r.Name, p.Name)
 			totalCleaned++
 		}
 	}
@@ -912,16 +920,16 @@ func cleanupPolecats(townRoot string) {
 	if len(uncommittedPolecats) > 0 {
 		fmt.Println()
 		fmt.Printf("  %s Polecats with uncommitted work (use --nuclear to force):\n",
-			style.Bold.Render("⚠"))
+			style.Bold.Render(ui.Glyph(ui.IconWarn)))
 		for _, pc := range uncommittedPolecats {
-			fmt.Printf("    • %s\n", pc)
+			fmt.Printf("    %s %s\n", ui.Glyph("•"), pc)
 		}
 	}
 
 	if totalCleaned > 0 || totalSkipped > 0 {
 		fmt.Printf("  Cleaned: %d, Skipped: %d\n", totalCleaned, totalSkipped)
 	} else {
-		fmt.Printf("  %s No polecats to clean up\n", style.Dim.Render("○"))
+		fmt.Printf("  %s No polecats to clean up\n", style.Dim.Render(ui.Glyph("○")))
 	}
 }
 
@@ -934,40 +942,40 @@ func stopDaemonIfRunning(townRoot string) {
 
 	if err != nil {
 		// Detection error - report it but continue with fallback
-		fmt.Printf("  %s Daemon detection warning: %s\n", style.Bold.Render("⚠"), err.Error())
+		fmt.Printf("  %s Daemon detection warning: %s\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), err.Error())
 	}
 
 	if running {
 		// PID file points to live daemon - stop it
 		if err := daemon.StopDaemon(townRoot); err != nil {
 			fmt.Printf("  %s Failed to stop daemon (PID %d): %s\n",
-				style.Bold.Render("✗"), pid, err.Error())
+				style.Bold.Render(ui.Glyph("✗")), pid, err.Error())
 		} else {
-			fmt.Printf("  %s Daemon stopped (was PID %d)\n", style.Bold.Render("✓"), pid)
+			fmt.Printf("  %s Daemon stopped (was PID %d)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), pid)
 		}
 	} else {
-		fmt.Printf("  %s Daemon not tracked by PID file\n", style.Dim.Render("○"))
+		fmt.Printf("  %s Daemon not tracked by PID file\n", style.Dim.Render(ui.Glyph("○")))
 	}
 
 	// Fallback: Search for orphaned daemon processes
 	orphaned, err := daemon.FindOrphanedDaemons(townRoot)
 	if err != nil {
 		fmt.Printf("  %s Warning: failed to search for orphaned daemons: %v\n",
-			style.Dim.Render("○"), err)
+			style.Dim.Render(ui.Glyph("○")), err)
 		return
 	}
 
 	if len(orphaned) > 0 {
 		fmt.Printf("  %s Found %d orphaned daemon process(es): %v\n",
-			style.Bold.Render("⚠"), len(orphaned), orphaned)
+			style.Bold.Render(ui.Glyph(ui.IconWarn)), len(orphaned), orphaned)
 
 		killed, err := daemon.KillOrphanedDaemons(townRoot)
 		if err != nil {
 			fmt.Printf("  %s Failed to kill orphaned daemons: %v\n",
-				style.Bold.Render("✗"), err)
+				style.Bold.Render(ui.Glyph("✗")), err)
 		} else if killed > 0 {
 			fmt.Printf("  %s Killed %d orphaned daemon(s)\n",
-				style.Bold.Render("✓"), killed)
+				style.Bold.Render(ui.Glyph(ui.IconPass)), killed)
 		}
 	}
 }
@@ -1037,13 +1045,13 @@ func runStartCrew(cmd *cobra.Command, args []string) error {
 	})
 	if err != nil {
 		if errors.Is(err, crew.ErrSessionRunning) {
-			fmt.Printf("%s Session already running: %s\n", style.Dim.Render("○"), crewMgr.SessionName(name))
+			fmt.Printf("%s Session already running: %s\n", style.Dim.Render(ui.Glyph("○")), crewMgr.SessionName(name))
 		} else {
 			return err
 		}
 	} else {
 		fmt.Printf("%s Started crew workspace: %s/%s\n",
-			style.Bold.Render("✓"), rigName, name)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), rigName, name)
 	}
 
 	fmt.Printf("Attach with: %s\n", style.Dim.Render(fmt.Sprintf("gt crew at %s", name)))
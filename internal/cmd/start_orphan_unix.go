@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/util"
 )
 
@@ -21,12 +22,12 @@ func cleanupOrphanedClaude(graceSecs int) {
 	// Find orphaned processes
 	orphans, err := util.FindOrphanedClaudeProcesses()
 	if err != nil {
-		fmt.Printf("  %s Warning: %v\n", style.Bold.Render("⚠"), err)
+		fmt.Printf("  %s Warning: %v\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), err)
 		return
 	}
 
 	if len(orphans) == 0 {
-		fmt.Printf("  %s No orphaned processes found\n", style.Dim.Render("○"))
+		fmt.Printf("  %s No orphaned processes found\n", style.Dim.Render(ui.Glyph("○")))
 		return
 	}
 
@@ -36,13 +37,13 @@ func cleanupOrphanedClaude(graceSecs int) {
 		if err := syscall.Kill(orphan.PID, syscall.SIGTERM); err != nil {
 			if err != syscall.ESRCH {
 				fmt.Printf("  %s PID %d: failed to send SIGTERM: %v\n",
-					style.Bold.Render("⚠"), orphan.PID, err)
+					style.Bold.Render(ui.Glyph(ui.IconWarn)), orphan.PID, err)
 			}
 			continue
 		}
 		termPIDs = append(termPIDs, orphan.PID)
 		fmt.Printf("  %s PID %d: sent SIGTERM (waiting %ds before SIGKILL)\n",
-			style.Bold.Render("→"), orphan.PID, graceSecs)
+			style.Bold.Render(ui.Glyph("→")), orphan.PID, graceSecs)
 	}
 
 	if len(termPIDs) == 0 {
@@ -51,7 +52,7 @@ func cleanupOrphanedClaude(graceSecs int) {
 
 	// Wait for grace period
 	fmt.Printf("  %s Waiting %d seconds for processes to terminate gracefully...\n",
-		style.Dim.Render("⏳"), graceSecs)
+		style.Dim.Render(ui.Glyph("⏳")), graceSecs)
 	time.Sleep(time.Duration(graceSecs) * time.Second)
 
 	// Check which processes are still alive and send SIGKILL
@@ -68,22 +69,22 @@ func cleanupOrphanedClaude(graceSecs int) {
 		if err := syscall.Kill(pid, syscall.SIGKILL); err != nil {
 			if err != syscall.ESRCH {
 				fmt.Printf("  %s PID %d: failed to send SIGKILL: %v\n",
-					style.Bold.Render("⚠"), pid, err)
+					style.Bold.Render(ui.Glyph(ui.IconWarn)), pid, err)
 			}
 			continue
 		}
 		killedCount++
 		fmt.Printf("  %s PID %d: sent SIGKILL (did not respond to SIGTERM)\n",
-			style.Bold.Render("✓"), pid)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), pid)
 	}
 
 	if alreadyDeadCount > 0 {
 		fmt.Printf("  %s %d process(es) terminated gracefully from SIGTERM\n",
-			style.Bold.Render("✓"), alreadyDeadCount)
+			style.Bold.Render(ui.Glyph(ui.IconPass)), alreadyDeadCount)
 	}
 	if killedCount == 0 && alreadyDeadCount > 0 {
 		fmt.Printf("  %s All processes cleaned up successfully\n",
-			style.Bold.Render("✓"))
+			style.Bold.Render(ui.Glyph(ui.IconPass)))
 	}
 }
 
@@ -92,7 +93,7 @@ func cleanupOrphanedClaude(graceSecs int) {
 func verifyNoOrphans() {
 	orphans, err := util.FindOrphanedClaudeProcesses()
 	if err != nil {
-		fmt.Printf("  %s Could not verify: %v\n", style.Bold.Render("⚠"), err)
+		fmt.Printf("  %s Could not verify: %v\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), err)
 		return
 	}
 
@@ -105,12 +106,12 @@ func verifyNoOrphans() {
 
 	totalSurvivors := len(orphans) + len(zombies)
 	if totalSurvivors == 0 {
-		fmt.Printf("  %s No orphaned Claude processes detected\n", style.Bold.Render("✓"))
+		fmt.Printf("  %s No orphaned Claude processes detected\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		return
 	}
 
 	fmt.Printf("  %s %d Claude process(es) survived shutdown:\n",
-		style.Bold.Render("⚠"), totalSurvivors)
+		style.Bold.Render(ui.Glyph(ui.IconWarn)), totalSurvivors)
 
 	// Kill orphans (TTY-less)
 	for _, o := range orphans {
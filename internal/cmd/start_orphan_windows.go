@@ -6,17 +6,18 @@ import (
 	"fmt"
 
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // cleanupOrphanedClaude is a Windows stub.
 // Orphan cleanup requires Unix-specific signals (SIGTERM/SIGKILL).
 func cleanupOrphanedClaude(graceSecs int) {
 	fmt.Printf("  %s Orphan cleanup not supported on Windows\n",
-		style.Dim.Render("○"))
+		style.Dim.Render(ui.Glyph("○")))
 }
 
 // verifyNoOrphans is a Windows stub.
 func verifyNoOrphans() {
 	fmt.Printf("  %s Orphan verification not supported on Windows\n",
-		style.Dim.Render("○"))
+		style.Dim.Render(ui.Glyph("○")))
 }
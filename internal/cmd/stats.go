@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	statsBy          string
+	statsSince       string
+	statsJSON        bool
+	statsCalibration bool
+	statsAbandons    bool
+	statsUtilization bool
+)
+
+var statsCmd = &cobra.Command{
+	Use:     "stats",
+	GroupID: GroupDiag,
+	Short:   "Show wisp cycle-time and throughput analytics",
+	Long: `Show cycle-time and throughput analytics for completed wisps.
+
+Each wisp's timeline is reconstructed from the event log:
+  queued          slung (or scheduled) to an agent
+  claimed         hooked by the agent
+  first activity  the agent's first event after claiming
+  completed       gt done
+
+Completed timelines are also kept in .runtime/stats/wisps.jsonl so analytics
+survive event-log pruning.
+
+Columns:
+  DONE      wisps completed in the window
+  /WEEK     completion throughput per week
+  CYCLE     claimed → completed (p50 / p90 / p99)
+  WAIT      queued → claimed (p50 / p90)
+  STARTUP   claimed → first activity (p50)
+
+With --calibration, compares effort estimates (gt wisp estimate, gt sling
+--estimate) with actual cycle time instead:
+  EST       completed wisps with an estimate (and without one)
+  RATIO     actual / estimate (p50 / p90); above 1 means work ran long
+  ON TARGET share of wisps within 25% of their estimate
+  BIAS      under: estimates run short; over: estimates run long
+
+With --abandons, counts wisps handed back with gt wisp abandon, by reason
+code, instead (--by rig, agent, week, or reason):
+  TOTAL     abandons in the window
+  REQUEUED  returned to the queue
+  CLOSED    closed without being done
+  REASONS   reason codes, most common first
+
+With --utilization, shows how each agent's wall-clock time in the window
+split between generating (waiting on the model), waiting (on tools the model
+ran, such as builds and tests), and idle, from its session transcripts; plus
+host load and memory and the wisps waiting to be claimed. The report ends
+with the likely bottleneck: model latency, host resources, or lack of queued
+work.
+
+Examples:
+  gt stats                   # Per-rig stats for the last 30 days
+  gt stats --by agent        # Per-agent
+  gt stats --by type         # Per wisp type
+  gt stats --by week --since 90d
+  gt stats --calibration --by agent
+  gt stats --abandons --by reason
+  gt stats --utilization --since 24h
+  gt stats --json`,
+	RunE: runStats,
+}
+
+func init() {
+	statsCmd.Flags().StringVar(&statsBy, "by", stats.ByRig, "Group by: rig, agent, week, or type (reason with --abandons)")
+	statsCmd.Flags().StringVar(&statsSince, "since", "30d", "Only count wisps completed within this window (e.g., 7d, 24h)")
+	statsCmd.Flags().BoolVar(&statsJSON, "json", false, "Output as JSON")
+	statsCmd.Flags().BoolVar(&statsCalibration, "calibration", false, "Compare effort estimates with actual cycle time")
+	statsCmd.Flags().BoolVar(&statsAbandons, "abandons", false, "Count abandoned wisps by reason code")
+	statsCmd.Flags().BoolVar(&statsUtilization, "utilization", false, "Show agent time generating vs waiting vs idle, and the bottleneck")
+	statsCmd.MarkFlagsMutuallyExclusive("calibration", "abandons", "utilization")
+	rootCmd.AddCommand(statsCmd)
+}
+
+func runStats(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	window, err := parseDuration(statsSince)
+	if err != nil {
+		return fmt.Errorf("invalid --since %q: %w", statsSince, err)
+	}
+	now := time.Now()
+	since := now.Add(-window)
+
+	if statsUtilization {
+		return runStatsUtilization(townRoot, since, now)
+	}
+
+	if statsAbandons {
+		evs, err := events.ReadAll(townRoot)
+		if err != nil {
+			return fmt.Errorf("reading events: %w", err)
+		}
+		groups, err := stats.SummarizeAbandons(evs, statsBy, since)
+		if err != nil {
+			return err
+		}
+		return printStatsAbandons(groups)
+	}
+
+	key, err := stats.GroupKey(statsBy)
+	if err != nil {
+		return err
+	}
+
+	timelines, err := stats.Load(townRoot)
+	if err != nil {
+		return fmt.Errorf("loading wisp timelines: %w", err)
+	}
+	if statsCalibration {
+		return printStatsCalibration(stats.Calibrate(timelines, key, since))
+	}
+	groups := stats.Summarize(timelines, key, since, now)
+
+	if statsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(groups)
+	}
+
+	if len(groups) == 0 {
+		fmt.Printf("No wisp activity in the last %s.\n", statsSince)
+		return nil
+	}
+
+	fmt.Printf("%s %s\n\n", style.Bold.Render("Wisp stats by "+statsBy), style.Dim.Render("(last "+statsSince+")"))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tDONE\tOPEN\t/WEEK\tCYCLE p50/p90/p99\tWAIT p50/p90\tSTARTUP p50")
+	for _, g := range groups {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%.1f\t%s / %s / %s\t%s / %s\t%s\n",
+			g.Key, g.Completed, g.InFlight, g.PerWeek,
+			statsDuration(g.CycleTime.P50), statsDuration(g.CycleTime.P90), statsDuration(g.CycleTime.P99),
+			statsDuration(g.QueueWait.P50), statsDuration(g.QueueWait.P90),
+			statsDuration(g.StartupLatency.P50),
+		)
+	}
+	return w.Flush()
+}
+
+// statsDuration formats a percentile, showing "-" when no samples exist.
+func statsDuration(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return formatDuration(d)
+}
+
+func printStatsCalibration(cals []stats.Calibration) error {
+	if statsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(cals)
+	}
+	if len(cals) == 0 {
+		fmt.Printf("No completed wisps in the last %s.\n", statsSince)
+		return nil
+	}
+
+	fmt.Printf("%s %s\n\n", style.Bold.Render("Estimate calibration by "+statsBy), style.Dim.Render("(last "+statsSince+")"))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tEST\tRATIO p50/p90\tON TARGET\tBIAS")
+	for _, c := range cals {
+		if c.Estimated == 0 {
+			fmt.Fprintf(w, "%s\t0 (%d without)\t-\t-\t-\n", c.Key, c.Unestimated)
+			continue
+		}
+		bias := c.Bias()
+		if bias == "" {
+			bias = "-"
+		}
+		fmt.Fprintf(w, "%s\t%d (%d without)\t%.2f× / %.2f×\t%.0f%%\t%s\n",
+			c.Key, c.Estimated, c.Unestimated, c.RatioP50, c.RatioP90, c.OnTarget*100, bias)
+	}
+	return w.Flush()
+}
+
+func printStatsAbandons(groups []stats.AbandonStats) error {
+	if statsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(groups)
+	}
+	if len(groups) == 0 {
+		fmt.Printf("No wisps abandoned in the last %s.\n", statsSince)
+		return nil
+	}
+
+	fmt.Printf("%s %s\n\n", style.Bold.Render("Abandoned wisps by "+statsBy), style.Dim.Render("(last "+statsSince+")"))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tTOTAL\tREQUEUED\tCLOSED\tREASONS")
+	for _, g := range groups {
+		reasons := make([]string, 0, len(g.Reasons))
+		for r := range g.Reasons {
+			reasons = append(reasons, r)
+		}
+		sort.Slice(reasons, func(i, j int) bool {
+			if g.Reasons[reasons[i]] != g.Reasons[reasons[j]] {
+				return g.Reasons[reasons[i]] > g.Reasons[reasons[j]]
+			}
+			return reasons[i] < reasons[j]
+		})
+		for i, r := range reasons {
+			reasons[i] = fmt.Sprintf("%s %d", r, g.Reasons[r])
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\n", g.Key, g.Total, g.Requeued, g.Closed, strings.Join(reasons, ", "))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// UtilizationReport is the JSON form of gt stats --utilization.
+type UtilizationReport struct {
+	Since      time.Time             `json:"since"`
+	Until      time.Time             `json:"until"`
+	Agents     []stats.Utilization   `json:"agents"`
+	Host       stats.HostUtilization `json:"host"`
+	Queued     int                   `json:"queued"`
+	Bottleneck stats.Bottleneck      `json:"bottleneck"`
+}
+
+func runStatsUtilization(townRoot string, since, now time.Time) error {
+	agents, err := agentUtilization(townRoot, since, now)
+	if err != nil {
+		return err
+	}
+	timelines, err := stats.Load(townRoot)
+	if err != nil {
+		return fmt.Errorf("loading wisp timelines: %w", err)
+	}
+	queued := 0
+	for _, t := range timelines {
+		if !t.Queued.IsZero() && t.Claimed.IsZero() && !t.IsComplete() && !t.IsDropped() {
+			queued++
+		}
+	}
+	host := readHostUtilization()
+	report := UtilizationReport{
+		Since:      since,
+		Until:      now,
+		Agents:     agents,
+		Host:       host,
+		Queued:     queued,
+		Bottleneck: stats.Diagnose(agents, host, queued),
+	}
+
+	if statsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	fmt.Printf("%s %s\n\n", style.Bold.Render("Agent utilization"), style.Dim.Render("(last "+statsSince+")"))
+	if len(agents) == 0 {
+		fmt.Printf("  %s\n", style.Dim.Render("(no agent transcripts in the window)"))
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "AGENT\tWALL\tGENERATING\tWAITING\tIDLE\tTURNS")
+		for _, u := range agents {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n",
+				u.Agent, formatDuration(u.Wall),
+				utilizationShare(u, u.Generating), utilizationShare(u, u.Waiting), utilizationShare(u, u.Idle),
+				u.Turns)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\n%s\n", style.Bold.Render("Host"))
+	if host.Load15 > 0 {
+		fmt.Printf("  Load:   %.2f %.2f %.2f on %d CPUs (%.0f%% per CPU over 15m)\n",
+			host.Load1, host.Load5, host.Load15, host.CPUs, host.LoadPerCPU()*100)
+	} else {
+		fmt.Printf("  Load:   %s on %d CPUs\n", style.Dim.Render("unavailable"), host.CPUs)
+	}
+	if host.MemTotalKB > 0 {
+		fmt.Printf("  Memory: %s of %s available (%.0f%%)\n",
+			formatRSS(host.MemAvailableKB), formatRSS(host.MemTotalKB), host.MemAvailableShare()*100)
+	}
+	fmt.Printf("  Queue:  %d wisps waiting to be claimed\n", queued)
+
+	fmt.Printf("\n%s %s\n", style.Bold.Render("Bottleneck:"), bottleneckLabel(report.Bottleneck.Kind))
+	fmt.Printf("  %s\n", style.Dim.Render(report.Bottleneck.Reason))
+	return nil
+}
+
+func utilizationShare(u stats.Utilization, d time.Duration) string {
+	return fmt.Sprintf("%.0f%%", u.Share(d)*100)
+}
+
+func bottleneckLabel(kind string) string {
+	switch kind {
+	case stats.BottleneckModel:
+		return "model latency"
+	case stats.BottleneckHost:
+		return "host resources"
+	case stats.BottleneckWork:
+		return "lack of queued work"
+	case stats.BottleneckDispatch:
+		return "dispatch (work queued, agents idle)"
+	default:
+		return "none"
+	}
+}
+
+// agentUtilization analyzes the Claude Code transcripts of every agent
+// working directory in the town that were written to in the window.
+func agentUtilization(townRoot string, since, now time.Time) ([]stats.Utilization, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	projectsDir := filepath.Join(home, ".claude", "projects")
+	dirs, err := os.ReadDir(projectsDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading transcripts: %w", err)
+	}
+
+	townPrefix := strings.ReplaceAll(townRoot, "/", "-") + "-"
+	byAgent := make(map[string][]stats.ActivityEntry)
+	for _, d := range dirs {
+		rest, ok := strings.CutPrefix(d.Name(), townPrefix)
+		if !d.IsDir() || !ok {
+			continue
+		}
+		workDir, ok := decodeProjectWorkDir(townRoot, rest)
+		if !ok {
+			continue
+		}
+		role := detectRole(workDir, townRoot)
+		if role.Role == RoleUnknown {
+			continue
+		}
+		agent := role.ActorString()
+		entries, err := transcriptActivity(filepath.Join(projectsDir, d.Name()), since)
+		if err != nil {
+			return nil, err
+		}
+		byAgent[agent] = append(byAgent[agent], entries...)
+	}
+
+	var result []stats.Utilization
+	for agent, entries := range byAgent {
+		if u := stats.AnalyzeActivity(agent, entries, since, now); u.Wall > 0 {
+			result = append(result, u)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Agent < result[j].Agent })
+	return result, nil
+}
+
+// transcriptActivity reads the entries of the transcripts in projectDir
+// modified since the window start.
+func transcriptActivity(projectDir string, since time.Time) ([]stats.ActivityEntry, error) {
+	files, err := filepath.Glob(filepath.Join(projectDir, "*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	var entries []stats.ActivityEntry
+	for _, path := range files {
+		if info, err := os.Stat(path); err != nil || info.ModTime().Before(since) {
+			continue
+		}
+		f, err := os.Open(path) //nolint:gosec // G304: path is under ~/.claude/projects
+		if err != nil {
+			continue
+		}
+		got, err := stats.ParseTranscriptActivity(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		entries = append(entries, got...)
+	}
+	return entries, nil
+}
+
+// decodeProjectWorkDir maps a Claude project directory name, relative to
+// the town's, back to a working directory. Names encode "/" as "-", which
+// is ambiguous with dashes in names, so the path is resolved against the
+// directories that exist.
+func decodeProjectWorkDir(dir, rest string) (string, bool) {
+	if rest == "" {
+		return dir, true
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", false
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		name := e.Name()
+		if rest == name {
+			return filepath.Join(dir, name), true
+		}
+		if next, ok := strings.CutPrefix(rest, name+"-"); ok {
+			if path, ok := decodeProjectWorkDir(filepath.Join(dir, name), next); ok {
+				return path, true
+			}
+		}
+	}
+	return "", false
+}
+
+// readHostUtilization samples load and memory from /proc, leaving them zero
+// where /proc is unavailable.
+func readHostUtilization() stats.HostUtilization {
+	h := stats.HostUtilization{CPUs: runtime.NumCPU()}
+	if data, err := os.ReadFile("/proc/loadavg"); err == nil {
+		f := strings.Fields(string(data))
+		if len(f) >= 3 {
+			h.Load1, _ = strconv.ParseFloat(f[0], 64)
+			h.Load5, _ = strconv.ParseFloat(f[1], 64)
+			h.Load15, _ = strconv.ParseFloat(f[2], 64)
+		}
+	}
+	if f, err := os.Open("/proc/meminfo"); err == nil {
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) < 2 {
+				continue
+			}
+			kb, _ := strconv.ParseInt(fields[1], 10, 64)
+			switch fields[0] {
+			case "MemTotal:":
+				h.MemTotalKB = kb
+			case "MemAvailable:":
+				h.MemAvailableKB = kb
+			}
+		}
+	}
+	return h
+}
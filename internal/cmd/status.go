@@ -8,12 +8,14 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/artifact"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
@@ -26,6 +28,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 	"golang.org/x/term"
 )
@@ -61,15 +64,17 @@ func init() {
 
 // TownStatus represents the overall status of the workspace.
 type TownStatus struct {
-	Name     string         `json:"name"`
-	Location string         `json:"location"`
-	Overseer *OverseerInfo  `json:"overseer,omitempty"` // Human operator
-	Daemon   *ServiceInfo   `json:"daemon,omitempty"`   // Daemon status
-	Dolt     *DoltInfo      `json:"dolt,omitempty"`     // Dolt server status
-	Tmux     *TmuxInfo      `json:"tmux,omitempty"`     // Tmux server status
-	Agents   []AgentRuntime `json:"agents"`             // Global agents (Mayor, Deacon)
-	Rigs     []RigStatus    `json:"rigs"`
-	Summary  StatusSum      `json:"summary"`
+	Name     string               `json:"name"`
+	Location string               `json:"location"`
+	Overseer *OverseerInfo        `json:"overseer,omitempty"` // Human operator
+	Daemon   *ServiceInfo         `json:"daemon,omitempty"`   // Daemon status
+	Dolt     *DoltInfo            `json:"dolt,omitempty"`     // Dolt server status
+	Tmux     *TmuxInfo            `json:"tmux,omitempty"`     // Tmux server status
+	Agents   []AgentRuntime       `json:"agents"`             // Global agents (Mayor, Deacon)
+	Rigs     []RigStatus          `json:"rigs"`
+	Summary  StatusSum            `json:"summary"`
+	Views    map[string]int       `json:"views,omitempty"`    // In-flight wisps per saved view
+	Failover []FailoverStatusItem `json:"failover,omitempty"` // Agents failed over to a fallback
 }
 
 // ServiceInfo represents a background service status.
@@ -108,19 +113,21 @@ type OverseerInfo struct {
 
 // AgentRuntime represents the runtime state of an agent.
 type AgentRuntime struct {
-	Name         string `json:"name"`                    // Display name (e.g., "mayor", "witness")
-	Address      string `json:"address"`                 // Full address (e.g., "greenplace/witness")
-	Session      string `json:"session"`                 // tmux session name
-	Role         string `json:"role"`                    // Role type
-	Running      bool   `json:"running"`                 // Is tmux session running?
-	HasWork      bool   `json:"has_work"`                // Has pinned work?
-	WorkTitle    string `json:"work_title,omitempty"`    // Title of pinned work
-	HookBead     string `json:"hook_bead,omitempty"`     // Pinned bead ID from agent bead
-	State        string `json:"state,omitempty"`         // Agent state from agent bead
-	UnreadMail   int    `json:"unread_mail"`             // Number of unread messages
-	FirstSubject string `json:"first_subject,omitempty"` // Subject of first unread message
-	AgentAlias   string `json:"agent_alias,omitempty"`   // Configured agent name (e.g., "opus-46", "pi")
-	AgentInfo    string `json:"agent_info,omitempty"`    // Runtime summary (e.g., "claude/opus", "pi/kimi-k2p5")
+	Name         string   `json:"name"`                    // Display name (e.g., "mayor", "witness")
+	Address      string   `json:"address"`                 // Full address (e.g., "greenplace/witness")
+	Session      string   `json:"session"`                 // tmux session name
+	Role         string   `json:"role"`                    // Role type
+	Running      bool     `json:"running"`                 // Is tmux session running?
+	HasWork      bool     `json:"has_work"`                // Has pinned work?
+	WorkTitle    string   `json:"work_title,omitempty"`    // Title of pinned work
+	HookBead     string   `json:"hook_bead,omitempty"`     // Pinned bead ID from agent bead
+	State        string   `json:"state,omitempty"`         // Agent state from agent bead
+	UnreadMail   int      `json:"unread_mail"`             // Number of unread messages
+	FirstSubject string   `json:"first_subject,omitempty"` // Subject of first unread message
+	AgentAlias   string   `json:"agent_alias,omitempty"`   // Configured agent name (e.g., "opus-46", "pi")
+	AgentInfo    string   `json:"agent_info,omitempty"`    // Runtime summary (e.g., "claude/opus", "pi/kimi-k2p5")
+	BlockedOn    []string `json:"blocked_on,omitempty"`    // Open cross-rig blockers of the hooked bead (<rig>:<id>)
+	Artifacts    []string `json:"artifacts,omitempty"`     // Artifacts registered on the hooked bead (<kind>:<ref>)
 }
 
 // RigStatus represents status of a single rig.
@@ -743,6 +750,10 @@ func gatherStatus() (TownStatus, error) {
 
 	beadsWg.Wait()
 
+	// Hooks slung across rigs are missing from their rig's database; read them
+	// from the owning rig and collect their cross-rig blockers.
+	hookBlockers := resolveCrossRigHooks(townRoot, allAgentBeads, allHookBeads)
+
 	// Create mail router for inbox lookups
 	mailRouter := mail.NewRouter(townRoot)
 
@@ -880,12 +891,22 @@ func gatherStatus() (TownStatus, error) {
 
 	wg.Wait()
 
+	// Registered artifacts of hooked beads, keyed by wisp
+	hookArtifacts := make(map[string][]string)
+	if arts, err := artifact.ListAll(townRoot); err == nil {
+		for _, a := range arts {
+			hookArtifacts[a.Wisp] = append(hookArtifacts[a.Wisp], a.Kind+":"+a.Ref)
+		}
+	}
+
 	// Enrich agents with runtime info — inspect actual running processes
 	for i := range status.Agents {
 		a := &status.Agents[i]
 		alias, info := resolveAgentDisplay(townSettings, a.Role, a.Session, a.Running)
 		a.AgentAlias = alias
 		a.AgentInfo = info
+		a.BlockedOn = hookBlockers[a.HookBead]
+		a.Artifacts = hookArtifacts[a.HookBead]
 	}
 	for i := range status.Rigs {
 		for j := range status.Rigs[i].Agents {
@@ -893,6 +914,8 @@ func gatherStatus() (TownStatus, error) {
 			alias, info := resolveAgentDisplay(townSettings, a.Role, a.Session, a.Running)
 			a.AgentAlias = alias
 			a.AgentInfo = info
+			a.BlockedOn = hookBlockers[a.HookBead]
+			a.Artifacts = hookArtifacts[a.HookBead]
 		}
 	}
 
@@ -910,6 +933,25 @@ func gatherStatus() (TownStatus, error) {
 	}
 	status.Summary.RigCount = len(rigs)
 
+	// Saved wisp views (settings wisps.views). Skipped in --fast mode since
+	// it reads every in-flight wisp's bead.
+	if townSettings != nil && !statusFast {
+		status.Views = wispViewCounts(townRoot, townSettings.Wisps)
+	}
+
+	// Active provider failovers (gt failover)
+	if st, err := config.LoadFailoverState(townRoot); err == nil {
+		for agent, af := range st.Agents {
+			if af.Active() {
+				status.Failover = append(status.Failover, FailoverStatusItem{
+					Agent: agent, Fallback: af.Fallback, Active: true, Since: &af.Since,
+					LastError: af.LastError,
+				})
+			}
+		}
+		sort.Slice(status.Failover, func(i, j int) bool { return status.Failover[i].Agent < status.Failover[j].Agent })
+	}
+
 	return status, nil
 }
 
@@ -972,6 +1014,29 @@ func outputStatusText(w io.Writer, status TownStatus) error {
 		fmt.Fprintln(w)
 	}
 
+	// Provider failovers: new sessions are not getting their configured agent
+	if len(status.Failover) > 0 {
+		parts := make([]string, 0, len(status.Failover))
+		for _, f := range status.Failover {
+			parts = append(parts, fmt.Sprintf("%s → %s %s", f.Agent, f.Fallback, style.Dim.Render("(since "+ui.FormatClock(*f.Since)+")")))
+		}
+		fmt.Fprintf(w, "%s %s %s\n\n", style.WarningPrefix, style.Bold.Render("Failover:"), strings.Join(parts, "  "))
+	}
+
+	// Saved wisp views
+	if len(status.Views) > 0 {
+		names := make([]string, 0, len(status.Views))
+		for name := range status.Views {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		parts := make([]string, 0, len(names))
+		for _, name := range names {
+			parts = append(parts, fmt.Sprintf("%s %d", name, status.Views[name]))
+		}
+		fmt.Fprintf(w, "%s %s\n\n", style.Bold.Render("Views:"), strings.Join(parts, "  "))
+	}
+
 	// Role icons - uses centralized emojis from constants package
 	roleIcons := map[string]string{
 		constants.RoleMayor:    constants.EmojiMayor,
@@ -1209,6 +1274,12 @@ func renderAgentDetails(w io.Writer, agent AgentRuntime, indent string, hooks []
 	}
 
 	fmt.Fprintf(w, "%s  hook: %s\n", indent, hookStr)
+	if len(agent.BlockedOn) > 0 {
+		fmt.Fprintf(w, "%s  %s %s\n", indent, style.Warning.Render("waiting on:"), strings.Join(agent.BlockedOn, ", "))
+	}
+	if len(agent.Artifacts) > 0 {
+		fmt.Fprintf(w, "%s  artifacts: %s\n", indent, strings.Join(agent.Artifacts, ", "))
+	}
 
 	// Line 3: Mail (if any unread)
 	if agent.UnreadMail > 0 {
@@ -1239,12 +1310,12 @@ func formatMQSummary(mq *MQSummary) string {
 		return ""
 	}
 	// Add state indicator
-	stateIcon := "○" // idle
+	stateIcon := ui.Glyph("○") // idle
 	switch mq.State {
 	case "processing":
-		stateIcon = style.Success.Render("●")
+		stateIcon = style.Success.Render(ui.Glyph("●"))
 	case "blocked":
-		stateIcon = style.Error.Render("○")
+		stateIcon = style.Error.Render(ui.Glyph("○"))
 	}
 	// Add health warning if stale
 	healthSuffix := ""
@@ -1293,12 +1364,12 @@ func renderAgentCompactWithSuffix(w io.Writer, agent AgentRuntime, indent string
 	hookSuffix := ""
 	if hookBead != "" {
 		if hookTitle != "" {
-			hookSuffix = style.Dim.Render(" → ") + truncateWithEllipsis(hookTitle, 30)
+			hookSuffix = style.Dim.Render(" "+ui.Glyph("→")+" ") + truncateWithEllipsis(hookTitle, 30)
 		} else {
-			hookSuffix = style.Dim.Render(" → ") + hookBead
+			hookSuffix = style.Dim.Render(" "+ui.Glyph("→")+" ") + hookBead
 		}
 	} else if hookTitle != "" {
-		hookSuffix = style.Dim.Render(" → ") + truncateWithEllipsis(hookTitle, 30)
+		hookSuffix = style.Dim.Render(" "+ui.Glyph("→")+" ") + truncateWithEllipsis(hookTitle, 30)
 	}
 
 	// Mail indicator
@@ -1313,8 +1384,8 @@ func renderAgentCompactWithSuffix(w io.Writer, agent AgentRuntime, indent string
 		agentSuffix = " " + style.Dim.Render("["+agent.AgentInfo+"]")
 	}
 
-	// Print single line: name + status + agent-info + hook + mail + suffix
-	fmt.Fprintf(w, "%s%-12s %s%s%s%s%s\n", indent, agent.Name, statusIndicator, agentSuffix, hookSuffix, mailSuffix, suffix)
+	// Print single line: name + status + agent-info + hook + blockers + mail + suffix
+	fmt.Fprintf(w, "%s%-12s %s%s%s%s%s%s\n", indent, agent.Name, statusIndicator, agentSuffix, hookSuffix, formatBlockedOnSuffix(agent), mailSuffix, suffix)
 }
 
 // renderAgentCompact renders a single-line agent status
@@ -1339,12 +1410,12 @@ func renderAgentCompact(w io.Writer, agent AgentRuntime, indent string, hooks []
 	hookSuffix := ""
 	if hookBead != "" {
 		if hookTitle != "" {
-			hookSuffix = style.Dim.Render(" → ") + truncateWithEllipsis(hookTitle, 30)
+			hookSuffix = style.Dim.Render(" "+ui.Glyph("→")+" ") + truncateWithEllipsis(hookTitle, 30)
 		} else {
-			hookSuffix = style.Dim.Render(" → ") + hookBead
+			hookSuffix = style.Dim.Render(" "+ui.Glyph("→")+" ") + hookBead
 		}
 	} else if hookTitle != "" {
-		hookSuffix = style.Dim.Render(" → ") + truncateWithEllipsis(hookTitle, 30)
+		hookSuffix = style.Dim.Render(" "+ui.Glyph("→")+" ") + truncateWithEllipsis(hookTitle, 30)
 	}
 
 	// Mail indicator
@@ -1360,7 +1431,7 @@ func renderAgentCompact(w io.Writer, agent AgentRuntime, indent string, hooks []
 	}
 
 	// Print single line: name + status + agent-info + hook + mail
-	fmt.Fprintf(w, "%s%-12s %s%s%s%s\n", indent, agent.Name, statusIndicator, agentSuffix, hookSuffix, mailSuffix)
+	fmt.Fprintf(w, "%s%-12s %s%s%s%s%s\n", indent, agent.Name, statusIndicator, agentSuffix, hookSuffix, formatBlockedOnSuffix(agent), mailSuffix)
 }
 
 // buildStatusIndicator creates the visual status indicator for an agent.
@@ -1372,9 +1443,9 @@ func buildStatusIndicator(agent AgentRuntime) string {
 	// Base indicator from tmux state
 	var indicator string
 	if sessionExists {
-		indicator = style.Success.Render("●")
+		indicator = style.Success.Render(ui.Glyph("●"))
 	} else {
-		indicator = style.Error.Render("○")
+		indicator = style.Error.Render(ui.Glyph("○"))
 	}
 
 	// Add non-observable state suffix if present
@@ -1398,10 +1469,10 @@ func formatHookInfo(hookBead, title string, maxLen int) string {
 		return ""
 	}
 	if title == "" {
-		return fmt.Sprintf(" → %s", hookBead)
+		return fmt.Sprintf(" %s %s", ui.Glyph("→"), hookBead)
 	}
 	title = truncateWithEllipsis(title, maxLen)
-	return fmt.Sprintf(" → %s", title)
+	return fmt.Sprintf(" %s %s", ui.Glyph("→"), title)
 }
 
 // truncateWithEllipsis shortens a string to maxLen, adding "..." if truncated
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// resolveCrossRigHooks completes hookBeads with hooks that live outside the
+// hooking agent's rig (status reads each rig's hooks from its own database,
+// so a bead slung across rigs comes back missing), and returns the open
+// cross-rig blockers of every hook bead as "<rig>:<id>" refs, keyed by hook
+// bead ID. Lookups only happen for hooks that are missing or have
+// dependencies, so towns without cross-rig work pay nothing.
+func resolveCrossRigHooks(townRoot string, agentBeads, hookBeads map[string]*beads.Issue) map[string][]string {
+	var missing []string
+	for _, agent := range agentBeads {
+		hookID := agent.HookBead
+		if hookID == "" {
+			if fields := beads.ParseAgentFields(agent.Description); fields != nil {
+				hookID = fields.HookBead
+			}
+		}
+		if hookID != "" && hookBeads[hookID] == nil {
+			missing = append(missing, hookID)
+		}
+	}
+	var withDeps bool
+	for _, hook := range hookBeads {
+		if len(hook.Dependencies) > 0 {
+			withDeps = true
+			break
+		}
+	}
+	if len(missing) == 0 && !withDeps {
+		return nil
+	}
+
+	xr, err := beads.NewXRefResolver(townRoot)
+	if err != nil {
+		return nil
+	}
+	for _, id := range missing {
+		if issue, _, err := xr.Show(id); err == nil {
+			hookBeads[id] = issue
+		}
+	}
+
+	blockers := make(map[string][]string)
+	for id, hook := range hookBeads {
+		if len(hook.Dependencies) == 0 {
+			continue
+		}
+		for _, b := range xr.CrossRigBlockers(hook) {
+			if b.Err == "" {
+				blockers[id] = append(blockers[id], b.Ref.String())
+			}
+		}
+	}
+	return blockers
+}
+
+// formatBlockedOnSuffix renders an agent's cross-rig blockers for the
+// compact status line.
+func formatBlockedOnSuffix(agent AgentRuntime) string {
+	if len(agent.BlockedOn) == 0 {
+		return ""
+	}
+	return style.Warning.Render(" ⏸ " + strings.Join(agent.BlockedOn, ","))
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/support"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	supportBundleOutput   string
+	supportBundleNoDoctor bool
+)
+
+var supportBundleCmd = &cobra.Command{
+	Use:     "support-bundle",
+	GroupID: GroupDiag,
+	Short:   "Package diagnostics for a gastown bug report",
+	Long: `Write a shareable tarball with what maintainers need to diagnose a bug
+report against gastown itself:
+
+  version.txt       gt version and build
+  environment.txt   OS, tool versions (git, tmux, bd, dolt), GT_/BD_/DOLT_ env
+  doctor.txt        A fresh gt doctor report
+  config/           Town and rig configuration
+  logs/             The last 1000 lines of the daemon and town logs
+  manifest.json     What was included and how many secrets were redacted
+
+Secrets are redacted on the way in: values under keys like token, secret,
+password, or api_key; credential-shaped strings (GitHub, Slack, OpenAI and
+AWS keys); and credentials embedded in URLs. Review the bundle before
+attaching it to a public issue.
+
+Examples:
+  gt support-bundle
+  gt support-bundle -o /tmp/gt-support.tar.gz
+  gt support-bundle --no-doctor`,
+	Args: cobra.NoArgs,
+	RunE: runSupportBundle,
+}
+
+func init() {
+	supportBundleCmd.Flags().StringVarP(&supportBundleOutput, "output", "o", "", "Output path (default: gt-support-<timestamp>.tar.gz in the current directory)")
+	supportBundleCmd.Flags().BoolVar(&supportBundleNoDoctor, "no-doctor", false, "Skip the doctor report (faster)")
+	rootCmd.AddCommand(supportBundleCmd)
+}
+
+func runSupportBundle(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	path := supportBundleOutput
+	if path == "" {
+		path = fmt.Sprintf("gt-support-%s.tar.gz", time.Now().Format("20060102-150405"))
+	}
+	if path, err = filepath.Abs(path); err != nil {
+		return err
+	}
+
+	opts := support.Options{
+		Path:    path,
+		Version: fmt.Sprintf("%s\nGo version: %s", versionString(), runtime.Version()),
+	}
+	if !supportBundleNoDoctor {
+		opts.Doctor = func(w io.Writer) error {
+			report := newTownDoctor().Run(&doctor.CheckContext{TownRoot: townRoot})
+			report.Print(w, true, 0)
+			return nil
+		}
+		fmt.Println(style.Dim.Render("Running gt doctor..."))
+	}
+
+	m, err := support.Create(townRoot, opts)
+	if err != nil {
+		return err
+	}
+	for _, e := range m.Errors {
+		style.PrintWarning("not included: %s", e)
+	}
+	fmt.Printf("%s Wrote %s (%d files, %d secret(s) redacted)\n", style.SuccessPrefix, path, len(m.Files), m.Redactions)
+	fmt.Println(style.Dim.Render("Review the bundle before sharing it: tar -tzvf " + path))
+	return nil
+}
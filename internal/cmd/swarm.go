@@ -20,6 +20,7 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/swarm"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -271,7 +272,7 @@ func runSwarmCreate(cmd *cobra.Command, args []string) error {
 	integration := fmt.Sprintf("swarm/%s", swarmEpic)
 
 	// Output
-	fmt.Printf("%s Created swarm %s\n\n", style.Bold.Render("✓"), swarmEpic)
+	fmt.Printf("%s Created swarm %s\n\n", style.Bold.Render(ui.Glyph(ui.IconPass)), swarmEpic)
 	fmt.Printf("  Epic:        %s\n", swarmEpic)
 	fmt.Printf("  Rig:         %s\n", rigName)
 	fmt.Printf("  Base commit: %s\n", truncate(baseCommit, 8))
@@ -376,7 +377,7 @@ func runSwarmStart(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	fmt.Printf("%s Swarm %s starting with %d ready tasks\n", style.Bold.Render("✓"), swarmID, len(status.Ready))
+	fmt.Printf("%s Swarm %s starting with %d ready tasks\n", style.Bold.Render(ui.Glyph(ui.IconPass)), swarmID, len(status.Ready))
 
 	// If workers were specified in create, use them; otherwise prompt user
 	if len(swarmWorkers) > 0 {
@@ -385,7 +386,7 @@ func runSwarmStart(cmd *cobra.Command, args []string) error {
 	} else {
 		fmt.Printf("\nReady tasks:\n")
 		for _, task := range status.Ready {
-			fmt.Printf("  ○ %s: %s\n", task.ID, task.Title)
+			fmt.Printf("  %s %s: %s\n", ui.Glyph("○"), task.ID, task.Title)
 		}
 		fmt.Printf("\nUse 'gt sling <task-id> <rig>/<worker>' to assign tasks\n")
 	}
@@ -481,7 +482,7 @@ func runSwarmDispatch(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("slinging task: %w", err)
 	}
 
-	fmt.Printf("%s Dispatched %s: %s → fresh polecat\n", style.Bold.Render("✓"), task.ID, task.Title)
+	fmt.Printf("%s Dispatched %s: %s → fresh polecat\n", style.Bold.Render(ui.Glyph(ui.IconPass)), task.ID, task.Title)
 
 	// Show remaining tasks
 	if len(unassigned) > 1 {
@@ -790,7 +791,7 @@ func runSwarmLand(cmd *cobra.Command, args []string) error {
 		style.PrintWarning("couldn't close swarm epic in beads: %v", err)
 	}
 
-	fmt.Printf("%s Swarm %s landed to main\n", style.Bold.Render("✓"), sw.ID)
+	fmt.Printf("%s Swarm %s landed to main\n", style.Bold.Render(ui.Glyph(ui.IconPass)), sw.ID)
 	fmt.Printf("  Sessions stopped: %d\n", result.SessionsStopped)
 	fmt.Printf("  Branches cleaned: %d\n", result.BranchesCleaned)
 	return nil
@@ -849,7 +850,7 @@ func runSwarmCancel(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("closing swarm: %w", err)
 	}
 
-	fmt.Printf("%s Swarm %s canceled\n", style.Bold.Render("✓"), swarmID)
+	fmt.Printf("%s Swarm %s canceled\n", style.Bold.Render(ui.Glyph(ui.IconPass)), swarmID)
 	return nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/formula"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -174,11 +175,11 @@ func runSynthesisStart(cmd *cobra.Command, args []string) error {
 
 	if !allComplete && !synthesisForce {
 		fmt.Printf("\n%s Not all legs complete. Use --force to proceed anyway.\n",
-			style.Warning.Render("⚠"))
+			style.WarningPrefix)
 		fmt.Printf("\nIncomplete legs:\n")
 		for _, leg := range legOutputs {
 			if leg.Status != "closed" {
-				fmt.Printf("  ○ %s: %s [%s]\n", leg.LegID, leg.Title, leg.Status)
+				fmt.Printf("  %s %s: %s [%s]\n", ui.Glyph("○"), leg.LegID, leg.Title, leg.Status)
 			}
 		}
 		return nil
@@ -226,7 +227,7 @@ func runSynthesisStart(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("creating synthesis bead: %w", err)
 	}
-	fmt.Printf("%s Created synthesis bead: %s\n", style.Bold.Render("✓"), synthesisID)
+	fmt.Printf("%s Created synthesis bead: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), synthesisID)
 
 	// Sling to target rig
 	fmt.Printf("  Slinging to %s...\n", targetRig)
@@ -234,7 +235,7 @@ func runSynthesisStart(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("slinging synthesis: %w", err)
 	}
 
-	fmt.Printf("%s Synthesis started\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Synthesis started\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Printf("  Monitor: gt convoy status %s\n", convoyID)
 
 	return nil
@@ -275,9 +276,9 @@ func runSynthesisStatus(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("\n  %s\n", style.Bold.Render("Legs:"))
 	for _, leg := range legOutputs {
-		status := "○"
+		status := ui.Glyph("○")
 		if leg.Status == "closed" {
-			status = "✓"
+			status = ui.Glyph(ui.IconPass)
 		}
 		fileStatus := ""
 		if leg.HasFile {
@@ -289,7 +290,7 @@ func runSynthesisStatus(cmd *cobra.Command, args []string) error {
 	// Synthesis readiness
 	fmt.Printf("\n  %s\n", style.Bold.Render("Synthesis:"))
 	if allComplete {
-		fmt.Printf("    %s Ready - all legs complete\n", style.Success.Render("✓"))
+		fmt.Printf("    %s Ready - all legs complete\n", style.SuccessPrefix)
 		fmt.Printf("    Run: gt synthesis start %s\n", convoyID)
 	} else {
 		completedCount := 0
@@ -299,7 +300,7 @@ func runSynthesisStatus(cmd *cobra.Command, args []string) error {
 			}
 		}
 		fmt.Printf("    %s Waiting - %d/%d legs complete\n",
-			style.Warning.Render("○"), completedCount, len(legOutputs))
+			style.Warning.Render(ui.Glyph("○")), completedCount, len(legOutputs))
 	}
 
 	if f != nil && f.Synthesis != nil {
@@ -345,7 +346,7 @@ func runSynthesisClose(cmd *cobra.Command, args []string) error {
 
 	// Idempotent: if already closed, just report it
 	if normalizeConvoyStatus(status) == convoyStatusClosed {
-		fmt.Printf("%s Convoy %s is already closed\n", style.Dim.Render("○"), convoyID)
+		fmt.Printf("%s Convoy %s is already closed\n", style.Dim.Render(ui.Glyph("○")), convoyID)
 		return nil
 	}
 
@@ -362,7 +363,7 @@ func runSynthesisClose(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("closing convoy: %w", err)
 	}
 
-	fmt.Printf("%s Convoy closed: %s\n", style.Bold.Render("✓"), convoyID)
+	fmt.Printf("%s Convoy closed: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), convoyID)
 
 	// TODO: Trigger notification if configured
 	// Parse description for "Notify: <address>" and send mail
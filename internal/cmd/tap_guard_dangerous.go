@@ -1,13 +1,19 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/approval"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 var tapGuardDangerousCmd = &cobra.Command{
@@ -24,6 +30,10 @@ This guard blocks operations that could cause irreversible damage:
 The guard reads the tool input from stdin (Claude Code hook protocol)
 and exits with code 2 to block dangerous operations.
 
+If the town's approval policy gates force-push (see 'gt approval'), a force
+push is allowed once the overseer approves it instead: the first attempt
+files a request for the branch and parks the agent's hooked wisp.
+
 Exit codes:
   0 - Operation allowed
   2 - Operation BLOCKED`,
@@ -38,6 +48,7 @@ func init() {
 type dangerousPattern struct {
 	contains []string // all substrings must be present
 	reason   string
+	action   string // approval action that can allow it, if any
 }
 
 var dangerousPatterns = []dangerousPattern{
@@ -48,10 +59,12 @@ var dangerousPatterns = []dangerousPattern{
 	{
 		contains: []string{"git", "push", "--force"},
 		reason:   "Force push rewrites remote history and can destroy others' work",
+		action:   approval.ActionForcePush,
 	},
 	{
 		contains: []string{"git", "push", "-f"},
 		reason:   "Force push rewrites remote history and can destroy others' work",
+		action:   approval.ActionForcePush,
 	},
 	{
 		contains: []string{"git", "reset", "--hard"},
@@ -81,6 +94,16 @@ func runTapGuardDangerous(cmd *cobra.Command, args []string) error {
 	// Check against dangerous patterns
 	for _, pattern := range dangerousPatterns {
 		if matchesDangerous(command, pattern) {
+			hint := "If this is intentional, ask the user to run it manually."
+			if pattern.action != "" {
+				allowed, msg := dangerousApproval(pattern.action, command)
+				if allowed {
+					return nil
+				}
+				if msg != "" {
+					hint = msg
+				}
+			}
 			fmt.Fprintln(os.Stderr, "")
 			fmt.Fprintln(os.Stderr, "╔══════════════════════════════════════════════════════════════════╗")
 			fmt.Fprintln(os.Stderr, "║  ❌ DANGEROUS COMMAND BLOCKED                                    ║")
@@ -88,7 +111,7 @@ func runTapGuardDangerous(cmd *cobra.Command, args []string) error {
 			fmt.Fprintf(os.Stderr, "║  Command: %-53s ║\n", truncateStr(command, 53))
 			fmt.Fprintf(os.Stderr, "║  Reason:  %-53s ║\n", truncateStr(pattern.reason, 53))
 			fmt.Fprintln(os.Stderr, "║                                                                  ║")
-			fmt.Fprintln(os.Stderr, "║  If this is intentional, ask the user to run it manually.        ║")
+			fmt.Fprintf(os.Stderr, "║  %-64s║\n", truncateStr(hint, 64))
 			fmt.Fprintln(os.Stderr, "╚══════════════════════════════════════════════════════════════════╝")
 			fmt.Fprintln(os.Stderr, "")
 			return NewSilentExit(2) // Exit 2 = BLOCK
@@ -99,6 +122,45 @@ func runTapGuardDangerous(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// dangerousApproval checks a dangerous command against the town's approval
+// policy. It returns true if an approval allows it; otherwise msg says what
+// is pending, or is empty when the action is not gated.
+func dangerousApproval(action, command string) (bool, string) {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return false, ""
+	}
+	policy, err := approval.LoadPolicy(townRoot)
+	if err != nil || !policy.Requires(action) {
+		return false, ""
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return false, ""
+	}
+	target, err := git.NewGit(cwd).CurrentBranch()
+	if err != nil {
+		return false, ""
+	}
+	var bead string
+	if id, err := session.ParseAddress(detectActor()); err == nil {
+		bead = agentHookedBead(townRoot, id)
+	}
+	err = requireApproval(context.Background(), townRoot, approvalGate{
+		Action: action,
+		Target: target,
+		Reason: command,
+		Bead:   bead,
+	})
+	if err == nil {
+		return true, ""
+	}
+	if errors.Is(err, errApprovalPending) {
+		return false, "Awaiting overseer approval; retry once approved."
+	}
+	return false, err.Error()
+}
+
 // extractCommand extracts the bash command from Claude Code hook input JSON.
 // The input format is: {"tool_name": "Bash", "tool_input": {"command": "..."}}
 func extractCommand(input []byte) string {
@@ -1,7 +1,12 @@
 package cmd
 
 import (
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/approval"
 )
 
 func TestExtractCommand(t *testing.T) {
@@ -82,3 +87,59 @@ func TestMatchesDangerous(t *testing.T) {
 		})
 	}
 }
+
+func TestDangerousApproval_ForcePush(t *testing.T) {
+	townRoot := t.TempDir()
+	for path, content := range map[string]string{
+		"mayor/town.json":      `{"type":"town","version":2,"name":"test"}`,
+		"settings/config.json": `{"type":"town-settings","version":1,"approvals":{"actions":["force-push"]}}`,
+	} {
+		full := filepath.Join(townRoot, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	repo := filepath.Join(townRoot, "work")
+	if err := os.MkdirAll(repo, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"init", "-b", "fix-it"},
+		{"-c", "user.name=t", "-c", "user.email=t@t", "commit", "--allow-empty", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	t.Chdir(repo)
+
+	// The first push files a request and stays blocked.
+	if allowed, msg := dangerousApproval(approval.ActionForcePush, "git push -f"); allowed || msg == "" {
+		t.Fatalf("unapproved force push: allowed=%v msg=%q", allowed, msg)
+	}
+	r, err := approval.Find(townRoot, approval.ActionForcePush, "fix-it")
+	if err != nil || r == nil || !r.IsPending() {
+		t.Fatalf("pending request = %+v, %v", r, err)
+	}
+
+	// Once approved, one push is allowed.
+	if _, err := approval.Decide(townRoot, r.ID, true, "overseer", ""); err != nil {
+		t.Fatal(err)
+	}
+	if allowed, _ := dangerousApproval(approval.ActionForcePush, "git push -f"); !allowed {
+		t.Error("approved force push was blocked")
+	}
+	if allowed, _ := dangerousApproval(approval.ActionForcePush, "git push -f"); allowed {
+		t.Error("approval allowed a second force push")
+	}
+
+	// Ungated actions fall back to the plain block.
+	if allowed, msg := dangerousApproval(approval.ActionDeploy, "deploy"); allowed || msg != "" {
+		t.Errorf("ungated action: allowed=%v msg=%q", allowed, msg)
+	}
+}
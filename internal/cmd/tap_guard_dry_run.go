@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+var tapGuardDryRunCmd = &cobra.Command{
+	Use:   "dry-run",
+	Short: "Block mutating tool use in dry-run patrols",
+	Long: `Block mutating tool use while a patrol runs in dry-run mode.
+
+A patrol is in dry-run mode when its cycle was created with
+'gt patrol new --dry-run', its daemon.json entry sets "dry_run": true,
+or GT_PATROL_DRY_RUN is set. Outside dry-run mode the guard allows
+everything.
+
+In dry-run mode it blocks:
+  - Edit, MultiEdit, Write, NotebookEdit
+  - Bash commands that change state: git commits, pushes, merges and
+    branch changes; bd writes; gt dispatch, mail, and lifecycle commands;
+    file removal, moves, and output redirection
+
+Reading, listing, and 'gt patrol report' stay allowed, so the patrol can
+observe and file its (advisory) report.
+
+Exit codes:
+  0 - Operation allowed
+  2 - Operation BLOCKED`,
+	RunE: runTapGuardDryRun,
+}
+
+func init() {
+	tapGuardCmd.AddCommand(tapGuardDryRunCmd)
+}
+
+// dryRunMutatingTools are tools that only exist to change files.
+var dryRunMutatingTools = map[string]bool{
+	"Edit":         true,
+	"MultiEdit":    true,
+	"Write":        true,
+	"NotebookEdit": true,
+}
+
+// dryRunMutatingCommands are command word prefixes that change state. A
+// shell segment is mutating when its leading words start with any of them.
+var dryRunMutatingCommands = [][]string{
+	{"git", "commit"}, {"git", "push"}, {"git", "merge"}, {"git", "rebase"},
+	{"git", "reset"}, {"git", "checkout"}, {"git", "switch"}, {"git", "cherry-pick"},
+	{"git", "revert"}, {"git", "tag"}, {"git", "stash"}, {"git", "clean"},
+	{"git", "rm"}, {"git", "mv"}, {"git", "add"}, {"git", "am"}, {"git", "apply"},
+	{"git", "branch", "-d"}, {"git", "branch", "-D"}, {"git", "worktree", "add"},
+	{"git", "worktree", "remove"},
+	{"bd", "create"}, {"bd", "update"}, {"bd", "close"}, {"bd", "reopen"},
+	{"bd", "delete"}, {"bd", "dep", "add"}, {"bd", "dep", "remove"}, {"bd", "label", "add"},
+	{"bd", "label", "remove"}, {"bd", "comments", "add"}, {"bd", "mol", "pour"},
+	{"bd", "mol", "bond"}, {"bd", "mol", "burn"}, {"bd", "rename-prefix"},
+	{"gt", "sling"}, {"gt", "unsling"}, {"gt", "nudge"}, {"gt", "mail", "send"},
+	{"gt", "mail", "reply"}, {"gt", "escalate"}, {"gt", "done"}, {"gt", "close"},
+	{"gt", "handoff"}, {"gt", "release"}, {"gt", "convoy", "create"}, {"gt", "convoy", "add"},
+	{"gt", "convoy", "close"}, {"gt", "polecat", "nuke"}, {"gt", "polecat", "remove"},
+	{"gt", "mq", "submit"}, {"gt", "mq", "reject"}, {"gt", "rig", "park"}, {"gt", "rig", "dock"},
+	{"gt", "rig", "remove"},
+	{"rm"}, {"mv"}, {"cp"}, {"touch"}, {"mkdir"}, {"chmod"}, {"tee"}, {"kill"},
+	{"pkill"}, {"tmux", "kill-session"}, {"tmux", "send-keys"},
+}
+
+// isPatrolBookkeeping reports whether a bd write only touches patrol wisps
+// (IDs containing "-wisp-"): a dry-run patrol still walks its own steps.
+// The IDs are the arguments before the first flag.
+func isPatrolBookkeeping(words []string) bool {
+	if len(words) < 3 || words[0] != "bd" || (words[1] != "close" && words[1] != "update") {
+		return false
+	}
+	var ids int
+	for _, w := range words[2:] {
+		if strings.HasPrefix(w, "-") {
+			break
+		}
+		if !strings.Contains(w, "-wisp-") {
+			return false
+		}
+		ids++
+	}
+	return ids > 0
+}
+
+func runTapGuardDryRun(cmd *cobra.Command, args []string) error {
+	roleInfo, err := GetRole()
+	if err != nil {
+		return nil // Not in a town: nothing to guard
+	}
+	patrol, agent, ok := patrolIdentity(roleInfo)
+	if !ok || !config.IsPatrolDryRun(roleInfo.TownRoot, patrol, agent, patrolDryRunWisp(roleInfo.TownRoot, agent)) {
+		return nil
+	}
+
+	input, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return nil // Fail open for non-hook usage
+	}
+	reason := dryRunBlockReason(input)
+	if reason == "" {
+		return nil
+	}
+
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "╔══════════════════════════════════════════════════════════════════╗")
+	fmt.Fprintln(os.Stderr, "║  ⏸  DRY RUN: MUTATING OPERATION BLOCKED                          ║")
+	fmt.Fprintln(os.Stderr, "╠══════════════════════════════════════════════════════════════════╣")
+	fmt.Fprintf(os.Stderr, "║  %-63s ║\n", truncateStr(reason, 63))
+	fmt.Fprintln(os.Stderr, "║                                                                  ║")
+	fmt.Fprintln(os.Stderr, "║  This patrol is read-only. Record what you would have done in    ║")
+	fmt.Fprintln(os.Stderr, "║  your patrol report instead (gt patrol report --summary ...).    ║")
+	fmt.Fprintln(os.Stderr, "╚══════════════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(os.Stderr, "")
+	return NewSilentExit(2) // Exit 2 = BLOCK
+}
+
+// dryRunBlockReason returns why the hook input describes a mutating
+// operation, or "" when it is allowed.
+func dryRunBlockReason(input []byte) string {
+	var hookInput struct {
+		ToolName  string `json:"tool_name"`
+		ToolInput struct {
+			Command string `json:"command"`
+		} `json:"tool_input"`
+	}
+	if err := json.Unmarshal(input, &hookInput); err != nil {
+		return ""
+	}
+	if dryRunMutatingTools[hookInput.ToolName] {
+		return hookInput.ToolName + " modifies files"
+	}
+	if hookInput.ToolInput.Command != "" {
+		if m := mutatingShellCommand(hookInput.ToolInput.Command); m != "" {
+			return "Command: " + m
+		}
+	}
+	return ""
+}
+
+// mutatingShellCommand returns the first state-changing segment of a shell
+// command line, or "". The split on shell operators is deliberately simple:
+// quoting is not parsed, so an operator inside quotes can only cause an
+// over-block, never an under-block of the segment that follows.
+func mutatingShellCommand(command string) string {
+	if hasFileRedirect(command) {
+		return command
+	}
+	segments := strings.FieldsFunc(command, func(r rune) bool {
+		return r == ';' || r == '&' || r == '|' || r == '\n' || r == '(' || r == ')' || r == '`'
+	})
+	for _, seg := range segments {
+		words := strings.Fields(seg)
+		// Skip env assignments and wrappers so "FOO=1 sudo git push" is seen.
+		for len(words) > 0 && (strings.Contains(words[0], "=") || words[0] == "sudo" || words[0] == "env" || words[0] == "command") {
+			words = words[1:]
+		}
+		if len(words) == 0 || isPatrolBookkeeping(words) {
+			continue
+		}
+		for _, prefix := range dryRunMutatingCommands {
+			if hasWordPrefix(words, prefix) {
+				return strings.Join(words, " ")
+			}
+		}
+	}
+	return ""
+}
+
+func hasWordPrefix(words, prefix []string) bool {
+	if len(words) < len(prefix) {
+		return false
+	}
+	for i, p := range prefix {
+		if words[i] != p {
+			return false
+		}
+	}
+	return true
+}
+
+// hasFileRedirect reports whether command redirects output into a file.
+// Redirects to /dev/null and between descriptors (2>&1) are not writes.
+func hasFileRedirect(command string) bool {
+	for i := 0; i < len(command); i++ {
+		if command[i] != '>' {
+			continue
+		}
+		rest := strings.TrimLeft(command[i+1:], ">")
+		if strings.HasPrefix(rest, "&") {
+			continue
+		}
+		target := strings.Fields(rest)
+		if len(target) > 0 && target[0] != "/dev/null" {
+			return true
+		}
+		for i+1 < len(command) && command[i+1] == '>' {
+			i++
+		}
+	}
+	return false
+}
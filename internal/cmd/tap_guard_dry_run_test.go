@@ -0,0 +1,33 @@
+package cmd
+
+import "testing"
+
+func TestDryRunBlockReason(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		blocked bool
+	}{
+		{"edit tool", `{"tool_name":"Edit","tool_input":{"file_path":"x"}}`, true},
+		{"read tool", `{"tool_name":"Read","tool_input":{"file_path":"x"}}`, false},
+		{"git status", `{"tool_name":"Bash","tool_input":{"command":"git status && git log -3"}}`, false},
+		{"git push after read", `{"tool_name":"Bash","tool_input":{"command":"git fetch; git push origin main"}}`, true},
+		{"env-prefixed push", `{"tool_name":"Bash","tool_input":{"command":"FOO=1 git push"}}`, true},
+		{"bd list", `{"tool_name":"Bash","tool_input":{"command":"bd list --status=open 2>&1 | head"}}`, false},
+		{"bd close bead", `{"tool_name":"Bash","tool_input":{"command":"bd close gt-abc --reason done"}}`, true},
+		{"bd close patrol step", `{"tool_name":"Bash","tool_input":{"command":"bd close gt-wisp-abc.3 --reason \"all clear\""}}`, false},
+		{"gt sling", `{"tool_name":"Bash","tool_input":{"command":"gt sling gt-abc gastown"}}`, true},
+		{"gt patrol report", `{"tool_name":"Bash","tool_input":{"command":"gt patrol report --summary ok"}}`, false},
+		{"redirect to file", `{"tool_name":"Bash","tool_input":{"command":"echo hi > notes.txt"}}`, true},
+		{"redirect to null", `{"tool_name":"Bash","tool_input":{"command":"gt status >/dev/null 2>&1"}}`, false},
+		{"garbage", `not json`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := dryRunBlockReason([]byte(tt.input))
+			if (reason != "") != tt.blocked {
+				t.Errorf("dryRunBlockReason = %q, blocked want %v", reason, tt.blocked)
+			}
+		})
+	}
+}
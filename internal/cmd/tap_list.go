@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -114,7 +115,7 @@ func runTapList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	fmt.Printf("\n%s Tap Handlers\n\n", style.Bold.Render("⚡"))
+	fmt.Printf("\n%s Tap Handlers\n\n", style.Bold.Render(ui.Glyph("⚡")))
 
 	// Group by kind
 	byKind := make(map[string][]tapHandler)
@@ -130,13 +131,13 @@ func runTapList(cmd *cobra.Command, args []string) error {
 		}
 
 		kindLabel := cases.Title(language.English).String(kind) + "s"
-		fmt.Printf("%s %s\n", style.Bold.Render("▸"), kindLabel)
+		fmt.Printf("%s %s\n", style.Bold.Render(ui.Glyph("▸")), kindLabel)
 
 		for _, h := range group {
-			statusIcon := "●"
+			statusIcon := ui.Glyph("●")
 			statusStyle := style.Success
 			if !h.implemented {
-				statusIcon = "○"
+				statusIcon = ui.Glyph("○")
 				statusStyle = style.Dim
 			}
 
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/muesli/termenv"
 	"github.com/spf13/cobra"
@@ -12,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -21,7 +23,7 @@ var (
 )
 
 // Valid CLI theme modes
-var validCLIThemes = []string{"auto", "dark", "light"}
+var validCLIThemes = []string{"auto", "dark", "light", "none", "accessible"}
 
 var themeCmd = &cobra.Command{
 	Use:     "theme [name]",
@@ -52,23 +54,31 @@ Use --all to apply to sessions across all rigs.`,
 
 var themeCLICmd = &cobra.Command{
 	Use:   "cli [mode]",
-	Short: "View or set CLI color scheme (dark/light/auto)",
+	Short: "View or set CLI color scheme (dark/light/auto/none/accessible)",
 	Long: `Manage CLI output color scheme for Gas Town commands.
 
 Without arguments, shows the current CLI theme mode and detection.
 With a mode argument, sets the CLI theme preference.
 
 Modes:
-  auto   - Automatically detect terminal background (default)
-  dark   - Force dark mode colors (light text for dark backgrounds)
-  light  - Force light mode colors (dark text for light backgrounds)
+  auto        - Automatically detect terminal background (default)
+  dark        - Force dark mode colors (light text for dark backgrounds)
+  light       - Force light mode colors (dark text for light backgrounds)
+  none        - No color; glyphs and line drawing are kept
+  accessible  - For screen readers: no color, status glyphs spelled out
+                as words ([ok], [warning], [failed]), no separator lines
 
 The setting is stored in town settings (settings/config.json) and can
 be overridden per-session via the GT_THEME environment variable.
 
+Dates and times follow the locale: "cli_locale" in town settings, else
+the LC_ALL, LC_TIME, or LANG environment (GT_LOCALE overrides both).
+Without a locale, dates are ISO (2006-01-02) with a 24-hour clock.
+
 Examples:
   gt theme cli              # Show current CLI theme
   gt theme cli dark         # Set CLI theme to dark mode
+  gt theme cli accessible   # Screen-reader friendly output
   gt theme cli auto         # Reset to auto-detection
   GT_THEME=light gt status  # Override for a single command`,
 	RunE: runThemeCLI,
@@ -429,13 +439,16 @@ func runThemeCLI(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  Detected:   %s background\n", detected)
 		}
 
+		loc := ui.GetLocale()
+		fmt.Printf("  Locale:     %s (%s)\n", loc.Name, ui.FormatDateTime(time.Now()))
+
 		return nil
 	}
 
 	// Set CLI theme
 	mode := strings.ToLower(args[0])
 	if !isValidCLITheme(mode) {
-		return fmt.Errorf("invalid CLI theme '%s' (valid: auto, dark, light)", mode)
+		return fmt.Errorf("invalid CLI theme '%s' (valid: %s)", mode, strings.Join(validCLIThemes, ", "))
 	}
 
 	// Load existing settings
@@ -453,9 +466,14 @@ func runThemeCLI(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("CLI theme set to '%s'\n", mode)
-	if mode == "auto" {
+	switch mode {
+	case "auto":
 		fmt.Println("Colors will adapt to your terminal's background.")
-	} else {
+	case "none":
+		fmt.Println("Output will not use color.")
+	case "accessible":
+		fmt.Println("Output will not use color, and status glyphs will be spelled out.")
+	default:
 		fmt.Printf("Colors optimized for %s backgrounds.\n", mode)
 	}
 
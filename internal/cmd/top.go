@@ -0,0 +1,312 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"golang.org/x/term"
+)
+
+var (
+	topInterval int
+	topOnce     bool
+	topJSON     bool
+	topSort     string
+	topRig      string
+)
+
+// topWarmup is the gap between the first two process samples, so the first
+// frame already has CPU rates.
+const topWarmup = time.Second
+
+// topHotCPU highlights agents using at least this much of a core.
+const topHotCPU = 80.0
+
+var topCmd = &cobra.Command{
+	Use:     "top",
+	GroupID: GroupDiag,
+	Short:   "Live per-agent CPU, memory, spend, and work monitor",
+	Long: `Show a top-style view of running agents, refreshed every few seconds.
+
+Columns:
+  CPU%     CPU of the agent's session process tree (100% = one core)
+  MEM      resident memory of the session process tree
+  $/HR     token spend rate over the last 10 minutes
+  SPENT    token spend of the current session transcript
+  STATE    agent state from the agent bead
+  WISP     the bead on the agent's hook
+
+Use it to spot the one polecat pegging the machine or burning tokens.
+
+Examples:
+  gt top                  # Refresh every 3s, sorted by CPU
+  gt top --sort spend     # Biggest spenders first
+  gt top --rig gastown -n 5
+  gt top --once --json    # One sample, for scripts`,
+	Args: cobra.NoArgs,
+	RunE: runTop,
+}
+
+func init() {
+	topCmd.Flags().IntVarP(&topInterval, "interval", "n", 3, "Refresh interval in seconds")
+	topCmd.Flags().BoolVar(&topOnce, "once", false, "Print one sample and exit")
+	topCmd.Flags().BoolVar(&topJSON, "json", false, "Output one sample as JSON (implies --once)")
+	topCmd.Flags().StringVar(&topSort, "sort", "cpu", "Sort by: cpu, mem, spend, name")
+	topCmd.Flags().StringVar(&topRig, "rig", "", "Only show agents in this rig")
+	rootCmd.AddCommand(topCmd)
+}
+
+// TopRow is one agent's sample.
+type TopRow struct {
+	Agent      string  `json:"agent"`
+	Session    string  `json:"session"`
+	Role       string  `json:"role"`
+	State      string  `json:"state,omitempty"`
+	Wisp       string  `json:"wisp,omitempty"`
+	WispTitle  string  `json:"wisp_title,omitempty"`
+	CPUPercent float64 `json:"cpu_percent"`
+	RSSKB      int64   `json:"rss_kb"`
+	SpendRate  float64 `json:"usd_per_hour"`
+	Spent      float64 `json:"usd_spent"`
+}
+
+// topMonitor carries samples between frames: the previous process table for
+// CPU deltas, and a transcript tracker per session.
+type topMonitor struct {
+	tmux  *tmux.Tmux
+	prev  procTable
+	spend map[string]*spendTracker
+}
+
+func runTop(cmd *cobra.Command, args []string) error {
+	switch topSort {
+	case "cpu", "mem", "spend", "name":
+	default:
+		return fmt.Errorf("invalid --sort %q (want cpu, mem, spend, or name)", topSort)
+	}
+	if topInterval <= 0 {
+		return fmt.Errorf("interval must be positive, got %d", topInterval)
+	}
+	// Mail counts are not shown; skip their lookups on every refresh.
+	statusFast = true
+
+	m := &topMonitor{tmux: tmux.NewTmux(), prev: readProcTable(), spend: make(map[string]*spendTracker)}
+	time.Sleep(topWarmup)
+
+	if topOnce || topJSON {
+		rows, err := m.sample()
+		if err != nil {
+			return err
+		}
+		if topJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(rows)
+		}
+		renderTop(os.Stdout, rows)
+		return nil
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	ticker := time.NewTicker(time.Duration(topInterval) * time.Second)
+	defer ticker.Stop()
+
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+	for {
+		var buf bytes.Buffer
+		if isTTY {
+			buf.WriteString("\033[H\033[2J") // ANSI: cursor home + clear screen
+		}
+		header := fmt.Sprintf("[%s] gt top (every %ds, Ctrl+C to stop)", time.Now().Format("15:04:05"), topInterval)
+		if isTTY {
+			header = style.Dim.Render(header)
+		}
+		fmt.Fprintf(&buf, "%s\n\n", header)
+
+		if rows, err := m.sample(); err != nil {
+			fmt.Fprintf(&buf, "Error: %v\n", err)
+		} else {
+			renderTop(&buf, rows)
+		}
+		_, _ = os.Stdout.Write(buf.Bytes())
+
+		select {
+		case <-sigChan:
+			if isTTY {
+				fmt.Println("\nStopped.")
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sample gathers one frame of rows for running agents.
+func (m *topMonitor) sample() ([]TopRow, error) {
+	status, err := gatherStatus()
+	if err != nil {
+		return nil, err
+	}
+	cur := readProcTable()
+	now := time.Now()
+
+	agents := status.Agents
+	if topRig != "" {
+		agents = nil
+	}
+	for _, r := range status.Rigs {
+		if topRig == "" || r.Name == topRig {
+			agents = append(agents, r.Agents...)
+		}
+	}
+
+	var rows []TopRow
+	seen := make(map[string]bool)
+	for _, a := range agents {
+		if !a.Running || a.Session == "" {
+			continue
+		}
+		seen[a.Session] = true
+		row := TopRow{
+			Agent:     a.Address,
+			Session:   a.Session,
+			Role:      a.Role,
+			State:     a.State,
+			Wisp:      a.HookBead,
+			WispTitle: a.WorkTitle,
+		}
+		if pidStr, err := m.tmux.GetPanePID(a.Session); err == nil {
+			if pid, err := strconv.Atoi(pidStr); err == nil {
+				row.CPUPercent, row.RSSKB = treeUsage(m.prev, cur, pid)
+			}
+		}
+		if tr := m.tracker(a.Session); tr != nil {
+			tr.update(now)
+			row.Spent = tr.total
+			row.SpendRate = tr.hourlyRate()
+		}
+		rows = append(rows, row)
+	}
+	m.prev = cur
+	for sess := range m.spend {
+		if !seen[sess] {
+			delete(m.spend, sess)
+		}
+	}
+
+	sortTopRows(rows, topSort)
+	return rows, nil
+}
+
+// tracker returns the spend tracker for a session's latest transcript,
+// replacing it when the session starts a new transcript (e.g. after handoff).
+func (m *topMonitor) tracker(session string) *spendTracker {
+	workDir, err := getTmuxSessionWorkDir(session)
+	if err != nil {
+		return nil
+	}
+	projectDir, err := getClaudeProjectDir(workDir)
+	if err != nil {
+		return nil
+	}
+	path, err := findLatestTranscript(projectDir)
+	if err != nil {
+		return nil
+	}
+	if tr := m.spend[session]; tr != nil && tr.path == path {
+		return tr
+	}
+	tr := &spendTracker{path: path}
+	m.spend[session] = tr
+	return tr
+}
+
+func sortTopRows(rows []TopRow, by string) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		switch by {
+		case "mem":
+			if rows[i].RSSKB != rows[j].RSSKB {
+				return rows[i].RSSKB > rows[j].RSSKB
+			}
+		case "spend":
+			if rows[i].SpendRate != rows[j].SpendRate {
+				return rows[i].SpendRate > rows[j].SpendRate
+			}
+		case "cpu":
+			if rows[i].CPUPercent != rows[j].CPUPercent {
+				return rows[i].CPUPercent > rows[j].CPUPercent
+			}
+		}
+		return rows[i].Agent < rows[j].Agent
+	})
+}
+
+func renderTop(w io.Writer, rows []TopRow) {
+	if len(rows) == 0 {
+		fmt.Fprintln(w, style.Dim.Render("No running agents"))
+		return
+	}
+
+	var cpu, rate float64
+	var rss int64
+	for _, r := range rows {
+		cpu += r.CPUPercent
+		rss += r.RSSKB
+		rate += r.SpendRate
+	}
+	fmt.Fprintf(w, "%d agents  CPU %.0f%%  MEM %s  spend $%.2f/hr\n\n", len(rows), cpu, formatRSS(rss), rate)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "AGENT\tCPU%\tMEM\t$/HR\tSPENT\tSTATE\tWISP")
+	var hot []TopRow
+	for _, r := range rows {
+		if r.CPUPercent >= topHotCPU {
+			hot = append(hot, r)
+		}
+		state := r.State
+		if state == "" {
+			state = "-"
+		}
+		wisp := "-"
+		if r.Wisp != "" {
+			wisp = r.Wisp
+			if r.WispTitle != "" {
+				wisp += " " + truncateWithEllipsis(r.WispTitle, 40)
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%.1f\t%s\t%.2f\t%.2f\t%s\t%s\n",
+			r.Agent, r.CPUPercent, formatRSS(r.RSSKB), r.SpendRate, r.Spent, state, wisp)
+	}
+	_ = tw.Flush()
+
+	// Styled after the table: ANSI codes inside cells break tabwriter alignment.
+	for _, r := range hot {
+		fmt.Fprintf(w, "\n%s %s is using %.0f%% CPU", style.WarningPrefix, r.Agent, r.CPUPercent)
+	}
+	if len(hot) > 0 {
+		fmt.Fprintln(w)
+	}
+}
+
+// formatRSS renders kilobytes as M or G.
+func formatRSS(kb int64) string {
+	if kb >= 1024*1024 {
+		return fmt.Sprintf("%.1fG", float64(kb)/(1024*1024))
+	}
+	return fmt.Sprintf("%dM", kb/1024)
+}
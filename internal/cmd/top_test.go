@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestParseProcStat(t *testing.T) {
+	// Command names may contain spaces and parens.
+	line := "4242 (claude (main) x) S 4200 4242 4242 0 -1 4194560 1 0 0 0 250 50 0 0 20 0 12 0 100 123456 2048 18446744073709551615"
+	st, ok := parseProcStat(line, 4)
+	if !ok {
+		t.Fatal("parseProcStat failed")
+	}
+	if st.PPID != 4200 || st.CPU != 3*time.Second || st.RSSKB != 8192 {
+		t.Errorf("parseProcStat = %+v", st)
+	}
+	if _, ok := parseProcStat("garbage", 4); ok {
+		t.Error("parseProcStat accepted garbage")
+	}
+}
+
+func TestParsePSTime(t *testing.T) {
+	for in, want := range map[string]time.Duration{
+		"00:07":      7 * time.Second,
+		"01:02:03":   time.Hour + 2*time.Minute + 3*time.Second,
+		"2-00:00:01": 48*time.Hour + time.Second,
+		"0:01.50":    1500 * time.Millisecond,
+	} {
+		if got := parsePSTime(in); got != want {
+			t.Errorf("parsePSTime(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestTreeUsage(t *testing.T) {
+	start := time.Now()
+	prev := procTable{At: start, Procs: map[int]procStat{
+		10: {PPID: 1, CPU: time.Second, RSSKB: 100},
+		11: {PPID: 10, CPU: 2 * time.Second, RSSKB: 200},
+		99: {PPID: 1, CPU: 0, RSSKB: 999}, // Not in the tree
+	}}
+	cur := procTable{At: start.Add(2 * time.Second), Procs: map[int]procStat{
+		10: {PPID: 1, CPU: 1500 * time.Millisecond, RSSKB: 100},
+		11: {PPID: 10, CPU: 3 * time.Second, RSSKB: 300},
+		12: {PPID: 11, CPU: 500 * time.Millisecond, RSSKB: 50}, // Started since prev
+		99: {PPID: 1, CPU: 10 * time.Second, RSSKB: 999},
+	}}
+	cpu, rss := treeUsage(prev, cur, 10)
+	if rss != 450 {
+		t.Errorf("rss = %d, want 450", rss)
+	}
+	// 0.5s + 1s + 0.5s over 2s of wall time.
+	if cpu < 99.9 || cpu > 100.1 {
+		t.Errorf("cpu = %.2f, want 100", cpu)
+	}
+	if cpu, rss := treeUsage(prev, cur, 777); cpu != 0 || rss != 0 {
+		t.Errorf("missing root = %v, %v; want zeros", cpu, rss)
+	}
+}
+
+func TestSpendTracker(t *testing.T) {
+	now := time.Now().UTC()
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	line := func(at time.Time, out int) string {
+		return `{"type":"assistant","timestamp":"` + at.Format(time.RFC3339Nano) +
+			`","message":{"model":"default","usage":{"output_tokens":` + strconv.Itoa(out) + `}}}` + "\n"
+	}
+	// One old message outside the window, one recent.
+	content := line(now.Add(-time.Hour), 1_000_000) + `{"type":"user"}` + "\n" + line(now.Add(-time.Minute), 100_000)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tr := &spendTracker{path: path}
+	tr.update(now)
+	// Default pricing: $15 per million output tokens.
+	if got, want := tr.total, 16.5; got < want-0.001 || got > want+0.001 {
+		t.Errorf("total = %v, want %v", got, want)
+	}
+	if got, want := tr.hourlyRate(), 1.5*6; got < want-0.001 || got > want+0.001 {
+		t.Errorf("hourlyRate = %v, want %v", got, want)
+	}
+
+	// Appended lines are picked up incrementally.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(line(now, 100_000))
+	f.Close()
+	tr.update(now)
+	if got, want := tr.total, 18.0; got < want-0.001 || got > want+0.001 {
+		t.Errorf("total after append = %v, want %v", got, want)
+	}
+}
+
+func TestSortTopRows(t *testing.T) {
+	rows := []TopRow{
+		{Agent: "b", CPUPercent: 5, RSSKB: 10, SpendRate: 3},
+		{Agent: "a", CPUPercent: 90, RSSKB: 5, SpendRate: 1},
+		{Agent: "c", CPUPercent: 5, RSSKB: 50, SpendRate: 2},
+	}
+	sortTopRows(rows, "cpu")
+	if rows[0].Agent != "a" || rows[1].Agent != "b" {
+		t.Errorf("cpu order = %v %v %v", rows[0].Agent, rows[1].Agent, rows[2].Agent)
+	}
+	sortTopRows(rows, "mem")
+	if rows[0].Agent != "c" {
+		t.Errorf("mem order starts with %s", rows[0].Agent)
+	}
+	sortTopRows(rows, "spend")
+	if rows[0].Agent != "b" {
+		t.Errorf("spend order starts with %s", rows[0].Agent)
+	}
+}
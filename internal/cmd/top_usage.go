@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// procStat is one process's row in a process table sample.
+type procStat struct {
+	PPID  int
+	CPU   time.Duration // Cumulative user+system CPU time
+	RSSKB int64
+}
+
+// procTable is a point-in-time snapshot of every process.
+type procTable struct {
+	At    time.Time
+	Procs map[int]procStat
+}
+
+// readProcTable snapshots the process table: from /proc on Linux (tick
+// precision), from ps elsewhere (whole-second CPU times).
+var readProcTable = func() procTable {
+	if runtime.GOOS == "linux" {
+		if t, ok := readProcFS("/proc"); ok {
+			return t
+		}
+	}
+	return readProcPS()
+}
+
+// linuxClockTicks is USER_HZ, which is 100 on every mainstream Linux build.
+const linuxClockTicks = 100
+
+func readProcFS(root string) (procTable, bool) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return procTable{}, false
+	}
+	t := procTable{At: time.Now(), Procs: make(map[int]procStat)}
+	pageKB := int64(os.Getpagesize() / 1024)
+	for _, e := range entries {
+		pid, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(root, e.Name(), "stat")) //nolint:gosec // G304: /proc path
+		if err != nil {
+			continue // Exited since ReadDir
+		}
+		if st, ok := parseProcStat(string(data), pageKB); ok {
+			t.Procs[pid] = st
+		}
+	}
+	return t, true
+}
+
+// parseProcStat parses a /proc/<pid>/stat line. The command name is
+// parenthesized and may itself contain spaces or parens, so fields are
+// counted from the last ')'.
+func parseProcStat(line string, pageKB int64) (procStat, bool) {
+	i := strings.LastIndexByte(line, ')')
+	if i < 0 {
+		return procStat{}, false
+	}
+	f := strings.Fields(line[i+1:])
+	// f[0]=state f[1]=ppid ... f[11]=utime f[12]=stime ... f[21]=rss (pages)
+	if len(f) < 22 {
+		return procStat{}, false
+	}
+	ppid, err1 := strconv.Atoi(f[1])
+	utime, err2 := strconv.ParseInt(f[11], 10, 64)
+	stime, err3 := strconv.ParseInt(f[12], 10, 64)
+	rss, err4 := strconv.ParseInt(f[21], 10, 64)
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
+		return procStat{}, false
+	}
+	return procStat{
+		PPID:  ppid,
+		CPU:   time.Duration(utime+stime) * time.Second / linuxClockTicks,
+		RSSKB: rss * pageKB,
+	}, true
+}
+
+func readProcPS() procTable {
+	t := procTable{At: time.Now(), Procs: make(map[int]procStat)}
+	out, err := exec.Command("ps", "-eo", "pid=,ppid=,time=,rss=").Output()
+	if err != nil {
+		return t
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		f := strings.Fields(line)
+		if len(f) < 4 {
+			continue
+		}
+		pid, err1 := strconv.Atoi(f[0])
+		ppid, err2 := strconv.Atoi(f[1])
+		rss, err3 := strconv.ParseInt(f[3], 10, 64)
+		if err1 != nil || err2 != nil || err3 != nil {
+			continue
+		}
+		t.Procs[pid] = procStat{PPID: ppid, CPU: parsePSTime(f[2]), RSSKB: rss}
+	}
+	return t
+}
+
+// parsePSTime parses ps's cumulative CPU time: [[dd-]hh:]mm:ss[.frac].
+func parsePSTime(s string) time.Duration {
+	var days int
+	if d, rest, ok := strings.Cut(s, "-"); ok {
+		days, _ = strconv.Atoi(d)
+		s = rest
+	}
+	parts := strings.Split(s, ":")
+	var secs float64
+	for _, p := range parts {
+		v, _ := strconv.ParseFloat(p, 64)
+		secs = secs*60 + v
+	}
+	return time.Duration((float64(days)*86400 + secs) * float64(time.Second))
+}
+
+// treeUsage returns the CPU percentage (of one core) and resident memory of
+// root and all its descendants, with CPU measured between prev and cur.
+// Processes that started since prev count their whole CPU time.
+func treeUsage(prev, cur procTable, root int) (cpuPct float64, rssKB int64) {
+	children := make(map[int][]int, len(cur.Procs))
+	for pid, st := range cur.Procs {
+		children[st.PPID] = append(children[st.PPID], pid)
+	}
+	if _, ok := cur.Procs[root]; !ok {
+		return 0, 0
+	}
+
+	var cpu time.Duration
+	stack := []int{root}
+	for len(stack) > 0 {
+		pid := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		st := cur.Procs[pid]
+		rssKB += st.RSSKB
+		delta := st.CPU
+		if p, ok := prev.Procs[pid]; ok && p.CPU <= st.CPU {
+			delta -= p.CPU
+		}
+		cpu += delta
+		stack = append(stack, children[pid]...)
+	}
+
+	wall := cur.At.Sub(prev.At)
+	if wall <= 0 {
+		return 0, rssKB
+	}
+	return float64(cpu) / float64(wall) * 100, rssKB
+}
+
+// spendWindow is the trailing window token spend rates are measured over.
+const spendWindow = 10 * time.Minute
+
+type spendPoint struct {
+	At   time.Time
+	Cost float64
+}
+
+// spendTracker follows one transcript incrementally, so each refresh reads
+// only what was appended since the last.
+type spendTracker struct {
+	path   string
+	offset int64
+	total  float64
+	recent []spendPoint
+}
+
+// update reads new transcript lines and drops points older than the window.
+func (s *spendTracker) update(now time.Time) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	if info, err := f.Stat(); err != nil || info.Size() < s.offset {
+		// Truncated or replaced: start over.
+		s.offset, s.total, s.recent = 0, 0, nil
+	}
+	if _, err := f.Seek(s.offset, io.SeekStart); err != nil {
+		return
+	}
+
+	r := bufio.NewReaderSize(f, 256*1024)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err != nil {
+			break // Partial trailing line is re-read next time
+		}
+		s.offset += int64(len(line))
+
+		var msg struct {
+			Type      string                 `json:"type"`
+			Timestamp string                 `json:"timestamp"`
+			Message   *TranscriptMessageBody `json:"message,omitempty"`
+		}
+		if json.Unmarshal(line, &msg) != nil || msg.Type != "assistant" || msg.Message == nil || msg.Message.Usage == nil {
+			continue
+		}
+		u := msg.Message.Usage
+		at, atErr := time.Parse(time.RFC3339Nano, msg.Timestamp)
+		cost := calculateCost(&TokenUsage{
+			Model:                    msg.Message.Model,
+			InputTokens:              u.InputTokens,
+			CacheCreationInputTokens: u.CacheCreationInputTokens,
+			CacheReadInputTokens:     u.CacheReadInputTokens,
+			OutputTokens:             u.OutputTokens,
+			At:                       at,
+		})
+		s.total += cost
+		if atErr == nil {
+			s.recent = append(s.recent, spendPoint{At: at, Cost: cost})
+		}
+	}
+
+	cutoff := now.Add(-spendWindow)
+	keep := s.recent[:0]
+	for _, p := range s.recent {
+		if p.At.After(cutoff) {
+			keep = append(keep, p)
+		}
+	}
+	s.recent = keep
+}
+
+// hourlyRate extrapolates the window's spend to USD per hour.
+func (s *spendTracker) hourlyRate() float64 {
+	var sum float64
+	for _, p := range s.recent {
+		sum += p.Cost
+	}
+	return sum * float64(time.Hour) / float64(spendWindow)
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/backup"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	townCloneBeads bool
+	townCloneRigs  []string
+	townCloneJSON  bool
+)
+
+var townCloneCmd = &cobra.Command{
+	Use:   "clone [dest]",
+	Short: "Create a disposable sandbox copy of the town",
+	Long: `Copy the town's configuration into a throwaway sandbox town.
+
+The sandbox is for trialing risky patrol or config changes without touching
+production agents. It gets:
+  - A copy of town and rig config (town.json, rigs.json, daemon.json,
+    settings, rig configs, routes)
+  - For each rig, a local bare repository standing in for the remote and a
+    fresh clone of it; the sandbox rigs.json points at these, so nothing
+    pushed from the sandbox reaches the real remote
+  - Its own Dolt port in mayor/daemon.json
+  - A town name suffixed with "-sandbox" and a mayor/sandbox.json marker
+
+With --beads, the beads databases are copied too (from a consistent
+snapshot, like gt backup). Without it the sandbox starts with no beads.
+
+Tmux session names are global, so don't start the sandbox's mayor or deacon
+while production's are running. Delete the sandbox with rm -rf when done.
+
+Without [dest], a new temp directory is used. An existing [dest] must be empty.
+
+Examples:
+  gt town clone
+  gt town clone /tmp/trial --beads
+  gt town clone --rig gastown --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runTownClone,
+}
+
+func init() {
+	townCloneCmd.Flags().BoolVar(&townCloneBeads, "beads", false, "Also copy the beads databases")
+	townCloneCmd.Flags().StringSliceVar(&townCloneRigs, "rig", nil, "Only clone these rigs (repeatable; default all)")
+	townCloneCmd.Flags().BoolVar(&townCloneJSON, "json", false, "Output the result as JSON")
+	townCmd.AddCommand(townCloneCmd)
+}
+
+func runTownClone(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if sb := backup.LoadSandbox(townRoot); sb != nil {
+		return fmt.Errorf("%s is already a sandbox of %s", townRoot, sb.Source)
+	}
+
+	opts := backup.CloneOptions{Beads: townCloneBeads, Rigs: townCloneRigs}
+	if len(args) == 1 {
+		opts.Dest = args[0]
+	}
+	sb, err := backup.Clone(townRoot, opts)
+	if err != nil {
+		return err
+	}
+
+	if townCloneJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(sb)
+	}
+
+	fmt.Printf("%s Sandbox town created at %s\n", style.SuccessPrefix, style.Bold.Render(sb.Root))
+	fmt.Printf("  %d config file(s)\n", sb.Files)
+	for _, r := range sb.Rigs {
+		fmt.Printf("  rig %s %s\n", r.Name, style.Dim.Render("→ "+r.Upstream))
+	}
+	if townCloneBeads {
+		fmt.Printf("  %d beads database(s)\n", len(sb.Databases))
+	}
+	for _, e := range sb.Errors {
+		style.PrintWarning("%s", e)
+	}
+
+	fmt.Println()
+	if sb.DoltPort != 0 {
+		fmt.Printf("Use it with: %s\n", style.Dim.Render(fmt.Sprintf("cd %s && export GT_DOLT_PORT=%d", sb.Root, sb.DoltPort)))
+	}
+	fmt.Println(style.Dim.Render("Tmux session names are shared with production: don't start the sandbox's agents while production's are running."))
+	return nil
+}
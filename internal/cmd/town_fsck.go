@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/fsck"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	townFsckRepair bool
+	townFsckJSON   bool
+	townFsckAll    bool
+)
+
+var townFsckCmd = &cobra.Command{
+	Use:   "fsck",
+	Short: "Deep structural integrity scan of the town",
+	Long: `Walk the entire town tree and check its structure.
+
+Where 'gt doctor' runs targeted checks, fsck looks at every file gt owns:
+  - Known config files (town.json, rigs.json, daemon.json, settings, rig
+    configs, routes) are loaded through their real loaders and validated
+  - Other JSON and JSONL files are checked for well-formedness
+  - Leftover temp files from interrupted writes are found
+  - Unexpected files in mayor/ and settings/ are reported
+  - rigs.json, rig directories, and routes are cross-checked
+
+Git clones, worktrees, and beads databases are skipped.
+
+With --repair, fsck moves leftover temp files and invalid non-critical files
+aside (so defaults apply) and drops unparseable lines from JSONL logs.
+Nothing is deleted outright: moved and rewritten files go to
+.runtime/fsck-quarantine/<timestamp>/. Critical files (town.json, rigs.json,
+rig config.json, routes.jsonl) are only reported.
+
+Exits 1 if errors remain.
+
+Examples:
+  gt town fsck
+  gt town fsck --repair
+  gt town fsck --json`,
+	Args: cobra.NoArgs,
+	RunE: runTownFsck,
+}
+
+func init() {
+	townFsckCmd.Flags().BoolVar(&townFsckRepair, "repair", false, "Repair or quarantine problems that can be fixed mechanically")
+	townFsckCmd.Flags().BoolVar(&townFsckJSON, "json", false, "Output the report as JSON")
+	townFsckCmd.Flags().BoolVarP(&townFsckAll, "all", "a", false, "Include informational findings")
+	townCmd.AddCommand(townFsckCmd)
+}
+
+func runTownFsck(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	now := time.Now()
+	report, err := fsck.Scan(townRoot, now)
+	if err != nil {
+		return err
+	}
+	repaired := 0
+	if townFsckRepair {
+		repaired = fsck.Repair(report, now)
+	}
+
+	if townFsckJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		printFsckReport(report, repaired)
+	}
+
+	if len(report.Unresolved(fsck.SeverityError)) > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+func printFsckReport(report *fsck.Report, repaired int) {
+	shown := 0
+	for _, f := range report.Findings {
+		if f.Severity == fsck.SeverityInfo && !townFsckAll {
+			continue
+		}
+		shown++
+		icon := style.Dim.Render("·")
+		switch {
+		case f.Repaired:
+			icon = style.SuccessPrefix
+		case f.Severity == fsck.SeverityError:
+			icon = style.Error.Render(ui.Glyph("✗"))
+		case f.Severity == fsck.SeverityWarning:
+			icon = style.WarningPrefix
+		}
+		fmt.Printf("%s %s %s\n", icon, style.Bold.Render(f.Path), style.Dim.Render("["+string(f.Kind)+"]"))
+		fmt.Printf("    %s\n", f.Message)
+		switch {
+		case f.Repaired && f.MovedTo != "":
+			fmt.Printf("    %s %s (original: %s)\n", style.Dim.Render("repaired:"), f.Action, f.MovedTo)
+		case f.Repaired:
+			fmt.Printf("    %s %s\n", style.Dim.Render("repaired:"), f.Action)
+		case f.RepairErr != "":
+			fmt.Printf("    %s %s\n", style.Error.Render("repair failed:"), f.RepairErr)
+		case f.Action != fsck.ActionNone:
+			fmt.Printf("    %s %s\n", style.Dim.Render("fixable with --repair:"), f.Action)
+		}
+	}
+
+	if shown > 0 {
+		fmt.Println()
+	}
+	fmt.Printf("Scanned %d files: %d error(s), %d warning(s), %d info",
+		report.Scanned, report.Count(fsck.SeverityError), report.Count(fsck.SeverityWarning), report.Count(fsck.SeverityInfo))
+	if repaired > 0 {
+		fmt.Printf(", %d repaired", repaired)
+	}
+	fmt.Println()
+	if hidden := report.Count(fsck.SeverityInfo); hidden > 0 && !townFsckAll {
+		fmt.Printf("%s\n", style.Dim.Render(fmt.Sprintf("(%d informational finding(s) hidden; use --all)", hidden)))
+	}
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/trace"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var traceJSON bool
+
+var traceCmd = &cobra.Command{
+	Use:     "trace <bead-id>",
+	GroupID: GroupDiag,
+	Short:   "Show a wisp's end-to-end timeline",
+	Long: `Reconstruct a single wisp's full timeline from every store that records it.
+
+Sources:
+  events       .events.jsonl: sling, hook, spawn, prime, nudges, merges, done
+  townlog      logs/town.log: agent lifecycle (spawn, wake, crash, kill)
+  stats        .runtime/stats/wisps.jsonl: stage times after log pruning
+  experiments  prompt/model variant assignments
+  artifacts    branches, patches, reports, and docs registered (gt artifact)
+  beads        creation, closure, and wisp comments
+
+Events that name the bead are always shown. Events about the assigned agent
+(prime, prompts, deaths) are shown only while the agent held the wisp.
+
+Examples:
+  gt trace gt-abc            # Timeline in the terminal
+  gt trace gt-abc --json     # Export for tooling`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTrace,
+}
+
+func init() {
+	traceCmd.Flags().BoolVar(&traceJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(traceCmd)
+}
+
+func runTrace(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	t, err := trace.Load(townRoot, args[0])
+	if err != nil {
+		return err
+	}
+
+	if traceJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(t)
+	}
+
+	header := style.Bold.Render(t.Bead)
+	if t.Title != "" {
+		header += " " + t.Title
+	}
+	fmt.Println(header)
+	var meta []string
+	for _, kv := range [][2]string{{"status", t.Status}, {"agent", t.Agent}, {"rig", t.Rig}} {
+		if kv[1] != "" {
+			meta = append(meta, kv[0]+"="+kv[1])
+		}
+	}
+	if d := t.Duration(); d > 0 {
+		meta = append(meta, "span="+formatDuration(d))
+	}
+	if len(meta) > 0 {
+		fmt.Println(style.Dim.Render(strings.Join(meta, "  ")))
+	}
+	if st := t.Stages; st != nil {
+		var stages []string
+		for _, s := range []struct {
+			name string
+			d    func() (time.Duration, bool)
+		}{
+			{"wait", st.QueueWait}, {"startup", st.StartupLatency}, {"cycle", st.CycleTime},
+		} {
+			if d, ok := s.d(); ok {
+				stages = append(stages, s.name+"="+formatDuration(d))
+			}
+		}
+		if len(stages) > 0 {
+			fmt.Println(style.Dim.Render(strings.Join(stages, "  ")))
+		}
+	}
+	for _, a := range t.Artifacts {
+		fmt.Printf("%s %-6s %s %s\n", style.Dim.Render("artifact"), a.Kind, a.Ref, style.Dim.Render("("+a.ID+")"))
+	}
+	fmt.Println()
+
+	var prev time.Time
+	for _, e := range t.Entries {
+		ts := e.Time.Local().Format("01-02 15:04:05")
+		delta := ""
+		if !prev.IsZero() {
+			delta = "+" + formatDuration(e.Time.Sub(prev))
+		}
+		prev = e.Time
+		line := fmt.Sprintf("  %s %8s  %s %s", ts, delta, traceKindLabel(e.Kind), e.Summary)
+		if e.Actor != "" {
+			line += " " + style.Dim.Render("("+e.Actor+")")
+		}
+		fmt.Println(line)
+	}
+	if len(t.Entries) == 0 {
+		fmt.Println(style.Dim.Render("  No timeline entries found."))
+	}
+
+	for _, w := range t.Warnings {
+		style.PrintWarning("could not read %s", w)
+	}
+	return nil
+}
+
+// traceKindLabel renders an entry kind as a fixed-width colored tag.
+func traceKindLabel(k trace.Kind) string {
+	label := fmt.Sprintf("%-8s", k)
+	switch k {
+	case trace.KindDone, trace.KindClosed:
+		return style.Success.Render(label)
+	case trace.KindReview, trace.KindGate:
+		return style.Warning.Render(label)
+	case trace.KindSling, trace.KindClaim, trace.KindSpawn, trace.KindPrime:
+		return style.Bold.Render(label)
+	}
+	return style.Dim.Render(label)
+}
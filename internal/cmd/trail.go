@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -291,7 +292,7 @@ func runTrailBeads(cmd *cobra.Command, args []string) error {
 			Status:    parts[2],
 			Agent:     parts[3],
 			UpdatedAt: updatedAt,
-			UpdateRel: relativeTime(updatedAt),
+			UpdateRel: ui.FormatRelative(updatedAt),
 		})
 	}
 
@@ -457,7 +458,7 @@ func readHookTrailEntries(eventsPath string, since time.Time, limit int) ([]Hook
 			Actor:     actor,
 			Bead:      bead,
 			Timestamp: ts,
-			TimeRel:   relativeTime(ts),
+			TimeRel:   ui.FormatRelative(ts),
 		})
 		if len(entries) >= limit {
 			break
@@ -477,33 +478,3 @@ func findBeadsDir() (string, error) {
 	// Fall back to town root
 	return findMailWorkDir()
 }
-
-func relativeTime(t time.Time) string {
-	if t.IsZero() {
-		return ""
-	}
-
-	diff := time.Since(t)
-	switch {
-	case diff < time.Minute:
-		return "just now"
-	case diff < time.Hour:
-		mins := int(diff.Minutes())
-		if mins == 1 {
-			return "1 minute ago"
-		}
-		return fmt.Sprintf("%d minutes ago", mins)
-	case diff < 24*time.Hour:
-		hours := int(diff.Hours())
-		if hours == 1 {
-			return "1 hour ago"
-		}
-		return fmt.Sprintf("%d hours ago", hours)
-	default:
-		days := int(diff.Hours() / 24)
-		if days == 1 {
-			return "1 day ago"
-		}
-		return fmt.Sprintf("%d days ago", days)
-	}
-}
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/shell"
 	"github.com/steveyegge/gastown/internal/state"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/wrappers"
 )
 
@@ -59,15 +60,15 @@ func runUninstall(cmd *cobra.Command, args []string) error {
 		fmt.Println("This will remove Gas Town from your system.")
 		fmt.Println()
 		fmt.Println("The following will be removed:")
-		fmt.Printf("  • Shell integration (%s)\n", shell.RCFilePath(shell.DetectShell()))
-		fmt.Printf("  • Wrapper scripts (%s)\n", wrappers.BinDir())
-		fmt.Printf("  • State directory (%s)\n", state.StateDir())
-		fmt.Printf("  • Config directory (%s)\n", state.ConfigDir())
-		fmt.Printf("  • Cache directory (%s)\n", state.CacheDir())
+		fmt.Printf("  %s Shell integration (%s)\n", ui.Glyph("•"), shell.RCFilePath(shell.DetectShell()))
+		fmt.Printf("  %s Wrapper scripts (%s)\n", ui.Glyph("•"), wrappers.BinDir())
+		fmt.Printf("  %s State directory (%s)\n", ui.Glyph("•"), state.StateDir())
+		fmt.Printf("  %s Config directory (%s)\n", ui.Glyph("•"), state.ConfigDir())
+		fmt.Printf("  %s Cache directory (%s)\n", ui.Glyph("•"), state.CacheDir())
 
 		if uninstallWorkspace {
 			fmt.Println()
-			fmt.Printf("  %s WORKSPACE WILL BE DELETED\n", style.Warning.Render("⚠"))
+			fmt.Printf("  %s WORKSPACE WILL BE DELETED\n", style.WarningPrefix)
 			fmt.Println("     This cannot be undone!")
 		}
 
@@ -92,31 +93,31 @@ func runUninstall(cmd *cobra.Command, args []string) error {
 	if err := shell.Remove(); err != nil {
 		errors = append(errors, fmt.Sprintf("shell integration: %v", err))
 	} else {
-		fmt.Printf("  %s Removed shell integration\n", style.Success.Render("✓"))
+		fmt.Printf("  %s Removed shell integration\n", style.SuccessPrefix)
 	}
 
 	if err := wrappers.Remove(); err != nil {
 		errors = append(errors, fmt.Sprintf("wrapper scripts: %v", err))
 	} else {
-		fmt.Printf("  %s Removed wrapper scripts\n", style.Success.Render("✓"))
+		fmt.Printf("  %s Removed wrapper scripts\n", style.SuccessPrefix)
 	}
 
 	if err := os.RemoveAll(state.StateDir()); err != nil && !os.IsNotExist(err) {
 		errors = append(errors, fmt.Sprintf("state directory: %v", err))
 	} else {
-		fmt.Printf("  %s Removed state directory\n", style.Success.Render("✓"))
+		fmt.Printf("  %s Removed state directory\n", style.SuccessPrefix)
 	}
 
 	if err := os.RemoveAll(state.ConfigDir()); err != nil && !os.IsNotExist(err) {
 		errors = append(errors, fmt.Sprintf("config directory: %v", err))
 	} else {
-		fmt.Printf("  %s Removed config directory\n", style.Success.Render("✓"))
+		fmt.Printf("  %s Removed config directory\n", style.SuccessPrefix)
 	}
 
 	if err := os.RemoveAll(state.CacheDir()); err != nil && !os.IsNotExist(err) {
 		errors = append(errors, fmt.Sprintf("cache directory: %v", err))
 	} else {
-		fmt.Printf("  %s Removed cache directory\n", style.Success.Render("✓"))
+		fmt.Printf("  %s Removed cache directory\n", style.SuccessPrefix)
 	}
 
 	if uninstallWorkspace {
@@ -125,22 +126,22 @@ func runUninstall(cmd *cobra.Command, args []string) error {
 			if err := os.RemoveAll(workspaceDir); err != nil {
 				errors = append(errors, fmt.Sprintf("workspace: %v", err))
 			} else {
-				fmt.Printf("  %s Removed workspace: %s\n", style.Success.Render("✓"), workspaceDir)
+				fmt.Printf("  %s Removed workspace: %s\n", style.SuccessPrefix, workspaceDir)
 			}
 		}
 	}
 
 	if len(errors) > 0 {
 		fmt.Println()
-		fmt.Printf("%s Some components could not be removed:\n", style.Warning.Render("⚠"))
+		fmt.Printf("%s Some components could not be removed:\n", style.WarningPrefix)
 		for _, e := range errors {
-			fmt.Printf("  • %s\n", e)
+			fmt.Printf("  %s %s\n", ui.Glyph("•"), e)
 		}
 		return fmt.Errorf("uninstall incomplete")
 	}
 
 	fmt.Println()
-	fmt.Printf("%s Gas Town has been uninstalled\n", style.Success.Render("✓"))
+	fmt.Printf("%s Gas Town has been uninstalled\n", style.SuccessPrefix)
 	fmt.Println()
 	fmt.Println("To reinstall, run:")
 	fmt.Printf("  %s\n", style.Dim.Render("go install github.com/steveyegge/gastown/cmd/gt@latest"))
@@ -10,6 +10,7 @@ import (
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -185,9 +186,9 @@ func runUnslingWith(cmd *cobra.Command, args []string, dryRun, force bool) error
 		cleaned := cleanStaleHookedBeads(cmd, b, agentID, targetBeadID, townRoot, beadsPath, dryRun)
 		if !cleaned {
 			if targetAgent != "" {
-				fmt.Printf("%s No work hooked for %s\n", style.Dim.Render("ℹ"), agentID)
+				fmt.Printf("%s No work hooked for %s\n", style.Dim.Render(ui.Glyph(ui.IconInfo)), agentID)
 			} else {
-				fmt.Printf("%s Nothing on your hook\n", style.Dim.Render("ℹ"))
+				fmt.Printf("%s Nothing on your hook\n", style.Dim.Render(ui.Glyph(ui.IconInfo)))
 			}
 		}
 		return nil
@@ -264,7 +265,7 @@ func runUnslingWith(cmd *cobra.Command, args []string, dryRun, force bool) error
 	// Log unhook event
 	_ = events.LogFeed(events.TypeUnhook, agentID, events.UnhookPayload(hookedBeadID))
 
-	fmt.Printf("%s Work removed from hook\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Work removed from hook\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	fmt.Printf("  Agent %s hook cleared (was: %s)\n", agentID, hookedBeadID)
 
 	return nil
@@ -361,7 +362,7 @@ func cleanStaleHookedBeads(cmd *cobra.Command, b *beads.Beads, agentID, targetBe
 			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: couldn't clean up stale bead %s: %v\n", sb.ID, err)
 			continue
 		}
-		fmt.Printf("%s Cleaned up stale bead %s (was hooked, now open)\n", style.Bold.Render("✓"), sb.ID)
+		fmt.Printf("%s Cleaned up stale bead %s (was hooked, now open)\n", style.Bold.Render(ui.Glyph(ui.IconPass)), sb.ID)
 	}
 	return true
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -163,10 +164,8 @@ func runUp(cmd *cobra.Command, args []string) error {
 
 	// Load daemon.json env vars so services (Dolt, etc.) use the right config.
 	// The daemon does this too, but gt up starts services before the daemon.
-	if patrolCfg := daemon.LoadPatrolConfig(townRoot); patrolCfg != nil {
-		for k, v := range patrolCfg.Env {
-			os.Setenv(k, v)
-		}
+	for k, v := range daemon.PatrolEnv(townRoot, daemon.LoadPatrolConfig(townRoot)) {
+		os.Setenv(k, v)
 	}
 
 	allOK := true
@@ -395,9 +394,9 @@ func runUp(cmd *cobra.Command, args []string) error {
 
 	fmt.Println()
 	if allOK {
-		fmt.Printf("%s All services running\n", style.Bold.Render("✓"))
+		fmt.Printf("%s All services running\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 	} else {
-		fmt.Printf("%s Some services failed to start\n", style.Bold.Render("✗"))
+		fmt.Printf("%s Some services failed to start\n", style.Bold.Render(ui.Glyph("✗")))
 		return fmt.Errorf("not all services started")
 	}
 
@@ -708,45 +707,15 @@ func startCrewFromSettings(townRoot, rigName string) ([]string, map[string]error
 	started := []string{}
 	errors := map[string]error{}
 
-	rigPath := filepath.Join(townRoot, rigName)
-
-	// Load rig settings
-	settingsPath := filepath.Join(rigPath, "settings", "config.json")
-	settings, err := config.LoadRigSettings(settingsPath)
-	if err != nil {
-		// No settings file or error - skip crew startup
-		return started, errors
-	}
-
-	if settings.Crew == nil || settings.Crew.Startup == "" {
-		// No crew startup preference
+	toStart := startupCrew(townRoot, rigName)
+	if len(toStart) == 0 {
 		return started, errors
 	}
-
-	// Get available crew members using helper
 	crewMgr, _, err := getCrewManager(rigName)
 	if err != nil {
 		return started, errors
 	}
 
-	crewWorkers, err := crewMgr.List()
-	if err != nil {
-		return started, errors
-	}
-
-	if len(crewWorkers) == 0 {
-		return started, errors
-	}
-
-	// Extract crew names
-	crewNames := make([]string, len(crewWorkers))
-	for i, w := range crewWorkers {
-		crewNames[i] = w.Name
-	}
-
-	// Parse startup preference and determine which crew to start
-	toStart := parseCrewStartupPreference(settings.Crew.Startup, crewNames)
-
 	// Start each crew member using Manager
 	for _, crewName := range toStart {
 		if err := crewMgr.Start(crewName, crew.StartOptions{}); err != nil {
@@ -763,6 +732,30 @@ func startCrewFromSettings(townRoot, rigName string) ([]string, map[string]error
 	return started, errors
 }
 
+// startupCrew returns the crew members a rig's settings (crew.startup)
+// start with the town, or none if the rig has no preference.
+func startupCrew(townRoot, rigName string) []string {
+	settingsPath := filepath.Join(townRoot, rigName, "settings", "config.json")
+	settings, err := config.LoadRigSettings(settingsPath)
+	if err != nil || settings.Crew == nil || settings.Crew.Startup == "" {
+		return nil
+	}
+
+	crewMgr, _, err := getCrewManager(rigName)
+	if err != nil {
+		return nil
+	}
+	crewWorkers, err := crewMgr.List()
+	if err != nil || len(crewWorkers) == 0 {
+		return nil
+	}
+	crewNames := make([]string, len(crewWorkers))
+	for i, w := range crewWorkers {
+		crewNames[i] = w.Name
+	}
+	return parseCrewStartupPreference(settings.Crew.Startup, crewNames)
+}
+
 // parseCrewStartupPreference parses the natural language crew startup preference.
 // Examples: "max", "joe and max", "all", "none", "pick one"
 func parseCrewStartupPreference(pref string, available []string) []string {
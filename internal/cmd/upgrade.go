@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/cli"
@@ -20,6 +21,9 @@ var (
 	upgradeDryRun  bool
 	upgradeVerbose bool
 	upgradeNoStart bool
+
+	upgradeRollingRestart bool
+	upgradeAgentTimeout   time.Duration
 )
 
 var upgradeCmd = &cobra.Command{
@@ -36,6 +40,16 @@ a new binary. It orchestrates all migration steps in the right order:
   3. Daemon defaults      Ensure daemon.json has lifecycle defaults
   4. Hooks sync           Regenerate settings.json from hook registry
   5. Formula update       Update formulas from embedded copies
+  6. Rolling restart      With --rolling: restart the daemon and agents
+                          still running the old binary
+
+The rolling restart replaces a manual full-town restart. The daemon is
+drained and started from the new binary, then agent sessions older than
+the binary are restarted one at a time, infrastructure first (deacon,
+witnesses, refineries), then mayor, crew, and polecats. Each agent gets
+the shutdown handshake and keeps its hooked wisp and checkpoint, as with
+'gt agents restart'. The roll halts at the first agent that fails to come
+back. The session running gt upgrade is skipped.
 
 Each step reports what changed. Use --dry-run to preview without modifying.
 
@@ -43,7 +57,9 @@ Examples:
   gt upgrade                  # Run all migration steps
   gt upgrade --dry-run        # Show what would change
   gt upgrade --verbose        # Show detailed output
-  gt upgrade --no-start       # Suppress starting daemon during doctor fix`,
+  gt upgrade --no-start       # Suppress starting daemon during doctor fix
+  gt upgrade --rolling        # Migrate, then roll the daemon and agents
+  gt upgrade --rolling --dry-run`,
 	RunE:         runUpgrade,
 	SilenceUsage: true,
 }
@@ -52,6 +68,8 @@ func init() {
 	upgradeCmd.Flags().BoolVar(&upgradeDryRun, "dry-run", false, "Show what would change without modifying anything")
 	upgradeCmd.Flags().BoolVarP(&upgradeVerbose, "verbose", "v", false, "Show detailed output")
 	upgradeCmd.Flags().BoolVar(&upgradeNoStart, "no-start", false, "Suppress starting daemon/agents during doctor fix")
+	upgradeCmd.Flags().BoolVar(&upgradeRollingRestart, "rolling", false, "Restart the daemon and agents still on the old binary, one at a time")
+	upgradeCmd.Flags().DurationVar(&upgradeAgentTimeout, "agent-timeout", 60*time.Second, "With --rolling, how long each agent gets to exit after the shutdown handshake")
 	rootCmd.AddCommand(upgradeCmd)
 }
 
@@ -97,6 +115,11 @@ func runUpgrade(cmd *cobra.Command, args []string) error {
 	r5 := upgradeFormulas(townRoot)
 	results = append(results, r5)
 
+	// Step 6: Roll the daemon and agents onto the new binary
+	if upgradeRollingRestart {
+		results = append(results, upgradeRolling(townRoot))
+	}
+
 	// Print summary
 	printUpgradeSummary(results)
 
@@ -151,6 +174,7 @@ func upgradeDoctor(townRoot string) upgradeResult {
 	if upgradeDryRun {
 		report = d.RunStreaming(ctx, os.Stdout, 0)
 	} else {
+		d.SetFixGate(doctorFixGate(townRoot, false))
 		report = d.FixStreaming(ctx, os.Stdout, 0)
 	}
 
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+// rollingRestartOrder restarts town infrastructure before the agents it
+// supervises, and the mayor (often attached to a human) late.
+var rollingRestartOrder = map[session.Role]int{
+	session.RoleDeacon:   0,
+	session.RoleWitness:  1,
+	session.RoleRefinery: 2,
+	session.RoleMayor:    3,
+	session.RoleCrew:     4,
+	session.RolePolecat:  5,
+}
+
+// staleAgentSessions returns the agents whose tmux sessions were created
+// before binTime, in rolling restart order. Sessions that aren't agents,
+// boot (owned by the daemon), and skip (the caller's own session) are left
+// alone.
+func staleAgentSessions(sessions []string, created func(string) (int64, error), binTime time.Time, skip string) []*session.AgentIdentity {
+	var stale []*session.AgentIdentity
+	for _, name := range sessions {
+		if name == skip {
+			continue
+		}
+		id, err := session.ParseSessionName(name)
+		if err != nil {
+			continue
+		}
+		if _, ok := rollingRestartOrder[id.Role]; !ok || (id.Role == session.RoleDeacon && id.Name == "boot") {
+			continue
+		}
+		ts, err := created(name)
+		if err != nil || !time.Unix(ts, 0).Before(binTime) {
+			continue
+		}
+		stale = append(stale, id)
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		return rollingRestartOrder[stale[i].Role] < rollingRestartOrder[stale[j].Role]
+	})
+	return stale
+}
+
+// upgradeRolling restarts everything still running the old binary: the
+// daemon first, then each agent session in turn through the graceful
+// shutdown handshake and checkpoint/resume (see gt agents restart). The roll
+// halts at the first agent that fails to come back.
+func upgradeRolling(townRoot string) upgradeResult {
+	result := upgradeResult{step: "Rolling restart"}
+
+	fmt.Printf("\n  %s %s\n", style.Bold.Render("6."), "Restarting daemon and agents still on the old binary...")
+
+	binTime, err := getBinaryModTime()
+	if err != nil {
+		result.details = append(result.details, fmt.Sprintf("binary time: %v", err))
+		fmt.Printf("     %s Could not stat gt binary: %v\n", style.ErrorPrefix, err)
+		return result
+	}
+
+	// Daemon: drain (SIGTERM, then wait) and start from the new binary.
+	if running, pid, _ := daemon.IsRunning(townRoot); running {
+		state, _ := daemon.LoadState(townRoot)
+		if state == nil || state.StartedAt.Before(binTime) {
+			switch {
+			case upgradeDryRun:
+				fmt.Printf("     %s daemon (PID %d) %s\n", style.WarningPrefix, pid, style.Dim.Render("would restart"))
+				result.changed++
+			default:
+				if err := daemon.StopDaemon(townRoot); err != nil {
+					result.details = append(result.details, fmt.Sprintf("daemon stop: %v", err))
+					fmt.Printf("     %s Could not stop daemon: %v\n", style.ErrorPrefix, err)
+					return result
+				}
+				newPID, _, err := spawnDaemon(townRoot)
+				if err != nil {
+					result.details = append(result.details, fmt.Sprintf("daemon start: %v", err))
+					fmt.Printf("     %s Could not restart daemon: %v\n", style.ErrorPrefix, err)
+					return result
+				}
+				fmt.Printf("     %s daemon %s\n", style.SuccessPrefix, style.Dim.Render(fmt.Sprintf("restarted (PID %d → %d)", pid, newPID)))
+				result.changed++
+			}
+		} else {
+			fmt.Printf("     %s daemon %s\n", style.SuccessPrefix, style.Dim.Render("already on the new binary"))
+		}
+	}
+
+	t := tmux.NewTmux()
+	sessions, err := t.ListSessions()
+	if err != nil {
+		result.details = append(result.details, fmt.Sprintf("listing sessions: %v", err))
+		fmt.Printf("     %s Could not list sessions: %v\n", style.ErrorPrefix, err)
+		return result
+	}
+	own := tmux.CurrentSessionName()
+	stale := staleAgentSessions(sessions, t.GetSessionCreatedUnix, binTime, own)
+	if len(stale) == 0 {
+		fmt.Printf("     %s agents %s\n", style.SuccessPrefix, style.Dim.Render("all on the new binary"))
+	}
+
+	for i, id := range stale {
+		if upgradeDryRun {
+			fmt.Printf("     %s %s %s\n", style.WarningPrefix, id.Address(), style.Dim.Render("would restart"))
+			result.changed++
+			continue
+		}
+		fmt.Printf("     [%d/%d] ", i+1, len(stale))
+		a, err := resolveAgentLifecycle(id.Address())
+		if err == nil {
+			err = a.restart(upgradeAgentTimeout, false, "gt upgrade")
+		}
+		if err != nil {
+			result.details = append(result.details,
+				fmt.Sprintf("%s: %v (halted; %d agent(s) not restarted)", id.Address(), err, len(stale)-i-1))
+			fmt.Printf("     %s Halting rolling restart: %v\n", style.ErrorPrefix, err)
+			return result
+		}
+		result.changed++
+	}
+
+	if own != "" {
+		if id, err := session.ParseSessionName(own); err == nil {
+			fmt.Printf("     %s %s %s\n", style.Dim.Render(ui.Glyph("○")), id.Address(),
+				style.Dim.Render("skipped (this session); restart it with gt agents restart "+id.Address()))
+		}
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func TestStaleAgentSessions(t *testing.T) {
+	original := session.DefaultRegistry()
+	t.Cleanup(func() { session.SetDefaultRegistry(original) })
+	reg := session.NewPrefixRegistry()
+	reg.Register("gt", "gastown")
+	session.SetDefaultRegistry(reg)
+
+	binTime := time.Unix(1000, 0)
+	created := map[string]int64{
+		"gt-morsov":   500,  // polecat, stale
+		"hq-mayor":    500,  // stale, but the caller's own session
+		"gt-crew-max": 500,  // stale
+		"hq-deacon":   500,  // stale
+		"gt-witness":  1500, // started after the new binary
+		"hq-boot":     500,  // daemon-owned
+		"scratch":     500,  // not an agent
+		"gt-refinery": 900,  // stale
+	}
+	sessions := []string{"gt-morsov", "hq-mayor", "gt-crew-max", "hq-deacon", "gt-witness", "hq-boot", "scratch", "gt-refinery"}
+
+	got := staleAgentSessions(sessions, func(name string) (int64, error) { return created[name], nil }, binTime, "hq-mayor")
+
+	var names []string
+	for _, id := range got {
+		names = append(names, id.SessionName())
+	}
+	want := []string{"hq-deacon", "gt-refinery", "gt-crew-max", "gt-morsov"}
+	if len(names) != len(want) {
+		t.Fatalf("staleAgentSessions() = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("staleAgentSessions()[%d] = %s, want %s (full: %v)", i, names[i], want[i], names)
+		}
+	}
+}
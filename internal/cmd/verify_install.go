@@ -0,0 +1,434 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/doltserver"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/townlog"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	verifyInstallTimeout time.Duration
+	verifyInstallKeep    bool
+)
+
+// verifyStubAgent is the agent alias the sandbox rig's polecats run.
+const verifyStubAgent = "verify-stub"
+
+var verifyInstallCmd = &cobra.Command{
+	Use:     "verify-install",
+	GroupID: GroupDiag,
+	Short:   "Smoke-test the full pipeline with a sandbox rig and a stub agent",
+	Long: `Prove a new install works end to end by running one wisp through the
+whole machine.
+
+verify-install:
+  1. Checks that git, bd, dolt, and tmux are installed
+  2. Creates a throwaway sandbox rig from a local one-commit repository
+  3. Configures the rig's polecats to run a stub agent, which completes
+     its hooked wisp with gt done instead of calling a model
+  4. Files a trivial bead in the rig and slings it
+  5. Waits for the stub polecat to finish
+  6. Verifies every record the run should leave behind: sling, spawn, and
+     done events in the event log, the completed timeline in the wisp
+     history ledger (gt stats), and the done entry in the town log
+
+The sandbox rig is unregistered and deleted afterwards, even on failure,
+unless --keep is given. Exits non-zero if any stage fails.
+
+Examples:
+  gt verify-install
+  gt verify-install --timeout 5m
+  gt verify-install --keep      # Leave the sandbox rig for inspection`,
+	Args: cobra.NoArgs,
+	RunE: runVerifyInstall,
+}
+
+func init() {
+	verifyInstallCmd.Flags().DurationVar(&verifyInstallTimeout, "timeout", 3*time.Minute, "How long to wait for the stub polecat to finish")
+	verifyInstallCmd.Flags().BoolVar(&verifyInstallKeep, "keep", false, "Keep the sandbox rig instead of removing it")
+	rootCmd.AddCommand(verifyInstallCmd)
+}
+
+// VerifyStage is the outcome of one verify-install stage.
+type VerifyStage struct {
+	Name   string
+	OK     bool
+	Detail string
+}
+
+// installVerifier carries state between verify-install stages.
+type installVerifier struct {
+	townRoot string
+	gtPath   string
+	rigName  string
+	prefix   string
+	repoDir  string // Sandbox upstream repository
+	rigPath  string
+	beadID   string
+	started  time.Time
+}
+
+func runVerifyInstall(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	gtPath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("locating gt binary: %w", err)
+	}
+
+	suffix := strconv.FormatInt(time.Now().UnixNano()%(36*36*36*36), 36)
+	v := &installVerifier{
+		townRoot: townRoot,
+		gtPath:   gtPath,
+		rigName:  "gtverify_" + suffix,
+		prefix:   "vf" + suffix,
+		started:  time.Now(),
+	}
+	fmt.Printf("%s Verifying install with sandbox rig %s\n\n", style.Bold.Render(ui.Glyph("→")), style.Bold.Render(v.rigName))
+
+	stages := []struct {
+		name string
+		run  func() (string, error)
+	}{
+		{"prerequisites", v.checkPrerequisites},
+		{"sandbox rig", v.createSandboxRig},
+		{"stub agent", v.installStubAgent},
+		{"file bead", v.fileBead},
+		{"sling", v.sling},
+		{"completion", v.waitForCompletion},
+	}
+	failed := false
+	for _, s := range stages {
+		detail, err := s.run()
+		if err != nil {
+			printVerifyStage(VerifyStage{Name: s.name, Detail: err.Error()})
+			failed = true
+			break
+		}
+		printVerifyStage(VerifyStage{Name: s.name, OK: true, Detail: detail})
+	}
+	if !failed {
+		for _, s := range verifyPipelineRecords(townRoot, v.rigName, v.beadID) {
+			printVerifyStage(s)
+			failed = failed || !s.OK
+		}
+	}
+
+	fmt.Println()
+	v.cleanup()
+
+	if failed {
+		return NewSilentExit(1)
+	}
+	fmt.Printf("\n%s Install verified in %s\n", style.SuccessPrefix, formatDuration(time.Since(v.started)))
+	return nil
+}
+
+func printVerifyStage(s VerifyStage) {
+	mark := style.SuccessPrefix
+	if !s.OK {
+		mark = style.Error.Render(ui.Glyph("✗"))
+	}
+	fmt.Printf("  %s %-14s %s\n", mark, s.Name, style.Dim.Render(s.Detail))
+}
+
+func (v *installVerifier) checkPrerequisites() (string, error) {
+	var missing []string
+	for _, bin := range []string{"git", "bd", "dolt", "tmux"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			missing = append(missing, bin)
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("not on PATH: %s", strings.Join(missing, ", "))
+	}
+	return "git, bd, dolt, tmux", nil
+}
+
+// createSandboxRig adds a rig cloned from a fresh local repository. It goes
+// through the rig manager directly because gt rig add only accepts remote
+// URLs, and leaves the rig out of daemon patrols.
+func (v *installVerifier) createSandboxRig() (string, error) {
+	repoDir, err := os.MkdirTemp("", "gt-verify-repo-")
+	if err != nil {
+		return "", err
+	}
+	v.repoDir = repoDir
+	if out, err := exec.Command("git", "init", "-q", "-b", "main", repoDir).CombinedOutput(); err != nil {
+		return "", fmt.Errorf("git init: %v: %s", err, strings.TrimSpace(string(out)))
+	}
+	if err := os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("# gt verify-install sandbox\n"), 0644); err != nil {
+		return "", err
+	}
+	repoGit := git.NewGit(repoDir)
+	if err := repoGit.Add("README.md"); err != nil {
+		return "", err
+	}
+	commit := exec.Command("git", "-c", "user.name=gt verify-install", "-c", "user.email=verify-install@gastown.local",
+		"commit", "-q", "-m", "verify-install sandbox")
+	commit.Dir = repoDir
+	if out, err := commit.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("git commit: %v: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	rigsPath := filepath.Join(v.townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		return "", fmt.Errorf("loading rigs config: %w", err)
+	}
+	rigPath := filepath.Join(v.townRoot, v.rigName)
+	if _, err := os.Stat(rigPath); err == nil {
+		return "", fmt.Errorf("%s already exists", rigPath)
+	}
+	// From here on cleanup owns rigPath, including a half-created rig.
+	v.rigPath = rigPath
+	mgr := rig.NewManager(v.townRoot, rigsConfig, git.NewGit(v.townRoot))
+	r, err := mgr.AddRig(rig.AddRigOptions{
+		Name:          v.rigName,
+		GitURL:        repoDir,
+		BeadsPrefix:   v.prefix,
+		DefaultBranch: "main",
+	})
+	if err != nil {
+		return "", fmt.Errorf("adding rig: %w", err)
+	}
+	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+		return "", fmt.Errorf("saving rigs config: %w", err)
+	}
+	return fmt.Sprintf("%s (prefix %s)", r.Name, v.prefix), nil
+}
+
+// installStubAgent points the sandbox rig's polecats at a script that
+// completes whatever is hooked without making changes.
+func (v *installVerifier) installStubAgent() (string, error) {
+	stubPath := filepath.Join(v.rigPath, ".runtime", "verify-stub-agent.sh")
+	if err := os.MkdirAll(filepath.Dir(stubPath), 0755); err != nil {
+		return "", err
+	}
+	script := fmt.Sprintf("#!/bin/sh\n# gt verify-install stub agent: completes the hooked wisp without changes.\nsleep 2\nexec %q done --cleanup-status=clean\n", v.gtPath)
+	if err := os.WriteFile(stubPath, []byte(script), 0755); err != nil { //nolint:gosec // G306: must be executable
+		return "", err
+	}
+
+	settingsPath := config.RigSettingsPath(v.rigPath)
+	settings, err := config.LoadRigSettings(settingsPath)
+	if err != nil {
+		settings = config.NewRigSettings()
+	}
+	if settings.Agents == nil {
+		settings.Agents = make(map[string]*config.RuntimeConfig)
+	}
+	settings.Agents[verifyStubAgent] = &config.RuntimeConfig{
+		Command:    stubPath,
+		Args:       []string{},
+		PromptMode: "none",
+		Tmux:       &config.RuntimeTmuxConfig{ProcessNames: []string{"sh", "gt"}, ReadyDelayMs: 500},
+	}
+	settings.RoleAgents = map[string]string{"polecat": verifyStubAgent}
+	if err := config.SaveRigSettings(settingsPath, settings); err != nil {
+		return "", fmt.Errorf("saving rig settings: %w", err)
+	}
+	return stubPath, nil
+}
+
+func (v *installVerifier) fileBead() (string, error) {
+	issue, err := beads.New(v.rigPath).Create(beads.CreateOptions{
+		Title:       "verify-install smoke test",
+		Type:        "task",
+		Priority:    4,
+		Description: "Filed by gt verify-install. The stub agent completes it without changes.",
+		Actor:       detectActor(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating bead: %w", err)
+	}
+	v.beadID = issue.ID
+	return issue.ID, nil
+}
+
+func (v *installVerifier) sling() (string, error) {
+	c := exec.Command(v.gtPath, "sling", v.beadID, v.rigName, "--agent", verifyStubAgent) //nolint:gosec // G204: our own binary
+	c.Dir = v.townRoot
+	out, err := c.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("gt sling: %v\n%s", err, strings.TrimSpace(string(out)))
+	}
+	return fmt.Sprintf("gt sling %s %s", v.beadID, v.rigName), nil
+}
+
+// waitForCompletion polls the event log for the bead's done event.
+func (v *installVerifier) waitForCompletion() (string, error) {
+	deadline := time.Now().Add(verifyInstallTimeout)
+	for {
+		evs, _ := events.ReadAll(v.townRoot)
+		for _, e := range evs {
+			if e.Type == events.TypeDone && e.PayloadString("bead") == v.beadID {
+				return fmt.Sprintf("%s done by %s after %s", v.beadID, e.Actor, formatDuration(time.Since(v.started))), nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("no gt done for %s within %s (stub agent session may have failed to start; try --keep and inspect the polecat)", v.beadID, verifyInstallTimeout)
+		}
+		time.Sleep(2 * time.Second)
+	}
+}
+
+// verifyPipelineRecords checks that a completed wisp left every record the
+// pipeline writes: its events, its history ledger row, and its town log entry.
+func verifyPipelineRecords(townRoot, rigName, beadID string) []VerifyStage {
+	var out []VerifyStage
+
+	evStage := VerifyStage{Name: "events"}
+	evs, err := events.ReadAll(townRoot)
+	if err != nil {
+		evStage.Detail = err.Error()
+	} else {
+		seen := map[string]bool{}
+		for _, e := range evs {
+			switch {
+			case (e.Type == events.TypeSling || e.Type == events.TypeDone) && e.PayloadString("bead") == beadID:
+				seen[e.Type] = true
+			case e.Type == events.TypeSpawn && e.PayloadString("rig") == rigName:
+				seen[e.Type] = true
+			}
+		}
+		var missing []string
+		for _, typ := range []string{events.TypeSling, events.TypeSpawn, events.TypeDone} {
+			if !seen[typ] {
+				missing = append(missing, typ)
+			}
+		}
+		evStage.OK = len(missing) == 0
+		evStage.Detail = "sling, spawn, done"
+		if !evStage.OK {
+			evStage.Detail = "missing " + strings.Join(missing, ", ") + " event(s)"
+		}
+	}
+	out = append(out, evStage)
+
+	histStage := VerifyStage{Name: "history", Detail: "no completed timeline in " + stats.LedgerPath(townRoot)}
+	if timelines, err := stats.LoadLedger(townRoot); err != nil {
+		histStage.Detail = err.Error()
+	} else {
+		for _, t := range timelines {
+			if t.Bead == beadID && t.IsComplete() {
+				histStage.OK = true
+				histStage.Detail = "ledger row for " + beadID
+			}
+		}
+	}
+	out = append(out, histStage)
+
+	auditStage := VerifyStage{Name: "audit log", Detail: "no done entry for " + rigName + " in the town log"}
+	if entries, err := townlog.ReadEvents(townRoot); err != nil {
+		auditStage.Detail = err.Error()
+	} else {
+		for _, e := range entries {
+			// Town log lines don't round-trip their context, so match the
+			// sandbox rig's agent rather than the bead.
+			if e.Type == townlog.EventDone && strings.HasPrefix(e.Agent, rigName+"/") {
+				auditStage.OK = true
+				auditStage.Detail = "done by " + e.Agent
+			}
+		}
+	}
+	out = append(out, auditStage)
+
+	return out
+}
+
+// cleanup kills the sandbox rig's sessions, deletes its agent beads,
+// unregisters it (rigs.json, routes.jsonl, and the town prefix registry),
+// drops its Dolt database, and deletes it along with the sandbox repository.
+// Failures are reported, not returned: the verdict stands either way.
+func (v *installVerifier) cleanup() {
+	if v.rigPath == "" {
+		if v.repoDir != "" {
+			_ = os.RemoveAll(v.repoDir)
+		}
+		return
+	}
+	if verifyInstallKeep {
+		fmt.Printf("%s Kept sandbox rig %s (repo %s)\n", style.Dim.Render(ui.Glyph("○")), v.rigPath, v.repoDir)
+		fmt.Printf("  Remove with: %s\n", style.Dim.Render(fmt.Sprintf("gt rig remove %s --force && rm -rf %s %s", v.rigName, v.rigPath, v.repoDir)))
+		return
+	}
+
+	t := tmux.NewTmux()
+	if sessions, err := findRigSessions(t, v.rigName); err == nil {
+		for _, s := range sessions {
+			_ = t.KillSessionWithProcesses(s)
+		}
+	}
+	// Agent beads go first, while the route and rig database still resolve.
+	v.removeAgentBeads()
+	rigsPath := filepath.Join(v.townRoot, "mayor", "rigs.json")
+	if rigsConfig, err := config.LoadRigsConfig(rigsPath); err == nil {
+		mgr := rig.NewManager(v.townRoot, rigsConfig, git.NewGit(v.townRoot))
+		if mgr.RemoveRig(v.rigName) == nil {
+			if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+				style.PrintWarning("could not unregister sandbox rig %s: %v", v.rigName, err)
+				return
+			}
+		}
+	}
+	if err := beads.RemoveRoute(v.townRoot, v.prefix+"-"); err != nil {
+		style.PrintWarning("could not remove sandbox route %s-: %v", v.prefix, err)
+	}
+	townPath := constants.MayorTownPath(v.townRoot)
+	if townCfg, err := config.LoadTownConfig(townPath); err == nil && townCfg.UnregisterPrefix(v.prefix, v.rigName) {
+		if err := config.SaveTownConfig(townPath, townCfg); err != nil {
+			style.PrintWarning("could not unregister sandbox prefix %s-: %v", v.prefix, err)
+		}
+	}
+	if _, err := os.Stat(doltserver.RigDatabaseDir(v.townRoot, v.rigName)); err == nil {
+		if err := doltserver.RemoveDatabase(v.townRoot, v.rigName, true); err != nil {
+			style.PrintWarning("could not remove sandbox database %s: %v", v.rigName, err)
+		}
+	}
+	_ = os.RemoveAll(v.rigPath)
+	_ = os.RemoveAll(v.repoDir)
+	fmt.Printf("%s Removed sandbox rig %s\n", style.Dim.Render(ui.Glyph("○")), v.rigName)
+}
+
+// removeAgentBeads deletes the sandbox rig's agent beads (witness, refinery,
+// and the stub polecat). They normally live in the rig's own database, but
+// any that were routed to town beads would outlive it.
+func (v *installVerifier) removeAgentBeads() {
+	for _, dir := range []string{v.rigPath, v.townRoot} {
+		bd := beads.New(dir)
+		agents, err := bd.ListAgentBeads()
+		if err != nil {
+			continue
+		}
+		for id := range agents {
+			if !strings.HasPrefix(id, v.prefix+"-") {
+				continue
+			}
+			if err := bd.DeleteAgentBead(id); err != nil {
+				style.PrintWarning("could not delete sandbox agent bead %s: %v", id, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/townlog"
+)
+
+func writeVerifyEvents(t *testing.T, townRoot string, evs []events.Event) {
+	t.Helper()
+	f, err := os.Create(filepath.Join(townRoot, events.EventsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	for _, e := range evs {
+		if err := enc.Encode(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestVerifyPipelineRecords(t *testing.T) {
+	townRoot := t.TempDir()
+	polecat := "gtverify_1/polecats/Toast"
+	writeVerifyEvents(t, townRoot, []events.Event{
+		{Timestamp: "2026-03-10T08:00:00Z", Type: events.TypeSling, Actor: "mayor", Payload: events.SlingPayload("vf1-abc", "gtverify_1")},
+		{Timestamp: "2026-03-10T08:00:05Z", Type: events.TypeSpawn, Actor: "gt", Payload: events.SpawnPayload("gtverify_1", "Toast")},
+		{Timestamp: "2026-03-10T08:00:10Z", Type: events.TypeHook, Actor: polecat, Payload: events.HookPayload("vf1-abc")},
+		{Timestamp: "2026-03-10T08:01:00Z", Type: events.TypeDone, Actor: polecat, Payload: events.DonePayload("vf1-abc", "polecat/Toast")},
+	})
+
+	stages := verifyPipelineRecords(townRoot, "gtverify_1", "vf1-abc")
+	if len(stages) != 3 {
+		t.Fatalf("got %d stages, want events, history, audit log", len(stages))
+	}
+	if !stages[0].OK {
+		t.Errorf("events stage failed: %s", stages[0].Detail)
+	}
+	if stages[1].OK || stages[2].OK {
+		t.Errorf("history/audit stages passed without ledger or town log: %+v", stages[1:])
+	}
+
+	if err := townlog.NewLogger(townRoot).Log(townlog.EventDone, polecat, "vf1-abc"); err != nil {
+		t.Fatal(err)
+	}
+	if err := stats.RecordCompletion(townRoot, "vf1-abc", nil, ""); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range verifyPipelineRecords(townRoot, "gtverify_1", "vf1-abc") {
+		if !s.OK {
+			t.Errorf("stage %s failed: %s", s.Name, s.Detail)
+		}
+	}
+}
+
+func TestVerifyPipelineRecords_MissingEvents(t *testing.T) {
+	townRoot := t.TempDir()
+	writeVerifyEvents(t, townRoot, []events.Event{
+		{Timestamp: "2026-03-10T08:00:00Z", Type: events.TypeSling, Actor: "mayor", Payload: events.SlingPayload("vf1-abc", "gtverify_1")},
+		{Timestamp: "2026-03-10T08:00:05Z", Type: events.TypeSpawn, Actor: "gt", Payload: events.SpawnPayload("otherrig", "Nux")},
+	})
+	got := verifyPipelineRecords(townRoot, "gtverify_1", "vf1-abc")[0]
+	if got.OK || got.Detail != "missing spawn, done event(s)" {
+		t.Errorf("events stage = %+v", got)
+	}
+}
@@ -42,16 +42,7 @@ and the git revision the binary was built from (if available).`,
 			return
 		}
 
-		commit := resolveCommitHash()
-		branch := resolveBranch()
-
-		if commit != "" && branch != "" {
-			fmt.Printf("gt version %s (%s: %s@%s)\n", Version, Build, branch, version.ShortCommit(commit))
-		} else if commit != "" {
-			fmt.Printf("gt version %s (%s: %s)\n", Version, Build, version.ShortCommit(commit))
-		} else {
-			fmt.Printf("gt version %s (%s)\n", Version, Build)
-		}
+		fmt.Println(versionString())
 
 		if versionVerbose {
 			fmt.Printf("Timestamp: %s\n", time.Now().Format(time.RFC3339))
@@ -71,6 +62,19 @@ func init() {
 	}
 }
 
+// versionString describes the binary: "gt version 0.9.0 (dev: main@abc1234)".
+func versionString() string {
+	commit := resolveCommitHash()
+	branch := resolveBranch()
+
+	if commit != "" && branch != "" {
+		return fmt.Sprintf("gt version %s (%s: %s@%s)", Version, Build, branch, version.ShortCommit(commit))
+	} else if commit != "" {
+		return fmt.Sprintf("gt version %s (%s: %s)", Version, Build, version.ShortCommit(commit))
+	}
+	return fmt.Sprintf("gt version %s (%s)", Version, Build)
+}
+
 func resolveCommitHash() string {
 	if Commit != "" {
 		return Commit
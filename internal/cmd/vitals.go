@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -46,7 +47,7 @@ func printVitalsDoltServers(townRoot string) {
 	if running {
 		m := doltserver.GetHealthMetrics(townRoot)
 		fmt.Printf("  %s :%d  production  PID %d  %s  %d/%d conn  %v\n",
-			style.Success.Render("●"), config.Port, pid,
+			style.Success.Render(ui.Glyph("●")), config.Port, pid,
 			m.DiskUsageHuman, m.Connections, m.MaxConnections,
 			m.QueryLatency.Round(time.Millisecond))
 		for _, w := range m.Warnings {
@@ -54,12 +55,12 @@ func printVitalsDoltServers(townRoot string) {
 		}
 	} else {
 		fmt.Printf("  %s :%d  production  %s\n",
-			style.Dim.Render("○"), config.Port, style.Dim.Render("not running"))
+			style.Dim.Render(ui.Glyph("○")), config.Port, style.Dim.Render("not running"))
 	}
 
 	// Zombie dolt processes (test servers not cleaned up)
 	for _, z := range findVitalsZombies(config.Port) {
-		fmt.Printf("  %s :%s test zombie PID %s\n", style.Warning.Render("○"), z.port, z.pid)
+		fmt.Printf("  %s :%s test zombie PID %s\n", style.Warning.Render(ui.Glyph("○")), z.port, z.pid)
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -203,7 +204,7 @@ func runWarrantFile(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("writing warrant: %w", err)
 	}
 
-	fmt.Printf("✓ Filed death warrant for %s\n", style.Bold.Render(target))
+	fmt.Printf("%s Filed death warrant for %s\n", ui.Glyph(ui.IconPass), style.Bold.Render(target))
 	fmt.Printf("  Reason: %s\n", warrantReason)
 	fmt.Printf("  ID: %s\n", warrant.ID)
 
@@ -321,13 +322,13 @@ func runWarrantExecute(cmd *cobra.Command, args []string) error {
 			if err := tm.KillSessionWithProcesses(sessionName); err != nil {
 				return fmt.Errorf("killing session %s: %w", sessionName, err)
 			}
-			fmt.Printf("✓ Terminated session %s\n", sessionName)
+			fmt.Printf("%s Terminated session %s\n", ui.Glyph(ui.IconPass), sessionName)
 		} else {
 			fmt.Printf("  Session %s not found (already dead)\n", sessionName)
 		}
 	}
 
-	fmt.Printf("✓ Warrant executed for %s\n", style.Bold.Render(target))
+	fmt.Printf("%s Warrant executed for %s\n", ui.Glyph(ui.IconPass), style.Bold.Render(target))
 	return nil
 }
 
@@ -0,0 +1,285 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/decision"
+	"github.com/steveyegge/gastown/internal/incident"
+	"github.com/steveyegge/gastown/internal/policy"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	whyJSON  bool
+	whyLimit int
+)
+
+// heartbeatPatrols run on every daemon heartbeat rather than on a ticker.
+var heartbeatPatrols = []string{"deacon", "witness", "refinery", "handler"}
+
+var whyCmd = &cobra.Command{
+	Use:     "why",
+	GroupID: GroupDiag,
+	Short:   "Explain the daemon's recent scheduling decisions",
+	Long: `Explain why the daemon did, or did not, run a patrol or dispatch a bead.
+
+The daemon and scheduler record each decision with a reason:
+  disabled    Patrol turned off in mayor/daemon.json
+  shutdown    gt down was in progress
+  predicate   A precondition was false (outside the maintenance window,
+              nothing to work on, bead blocked)
+  budget      No capacity left (polecat slots or batch size)
+  breaker     Restart backoff, crash loop, or dispatch circuit breaker
+  paused      Scheduler paused
+  parked      Rig parked, docked, or auto-restart blocked
+  incident    Town frozen by gt incident start
+  policy      Denied by a town policy rule (gt policy)
+
+Each command first checks the current state, then lists the most recent
+recorded decisions, newest first.
+
+Examples:
+  gt why patrol wisp_reaper
+  gt why patrol witness --limit 20
+  gt why sling gt-abc12
+  gt why sling gt-abc12 --json`,
+}
+
+var whyPatrolCmd = &cobra.Command{
+	Use:   "patrol <name>",
+	Short: "Explain recent runs and skips of a daemon patrol",
+	Long: `Explain recent runs and skips of a daemon patrol.
+
+Patrol names match mayor/daemon.json: deacon, witness, refinery, handler,
+and the ticker patrols listed by 'gt patrol status'.
+
+Examples:
+  gt why patrol compactor_dog
+  gt why patrol deacon --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWhyPatrol,
+}
+
+var whySlingCmd = &cobra.Command{
+	Use:   "sling <bead>",
+	Short: "Explain why a scheduled bead has or hasn't been dispatched",
+	Long: `Explain why a scheduled bead has or hasn't been dispatched.
+
+Checks the scheduler state, the bead's sling context, and whether the bead
+is ready, then lists the scheduler's recent decisions about it.
+
+Examples:
+  gt why sling gt-abc12`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWhySling,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{whyPatrolCmd, whySlingCmd} {
+		c.Flags().BoolVar(&whyJSON, "json", false, "Output as JSON")
+		c.Flags().IntVar(&whyLimit, "limit", 10, "Number of recent decisions to show")
+		whyCmd.AddCommand(c)
+	}
+	rootCmd.AddCommand(whyCmd)
+}
+
+// whyCheck is one piece of current state that affects the decision.
+type whyCheck struct {
+	Check   string `json:"check"`
+	OK      bool   `json:"ok"`
+	Detail  string `json:"detail"`
+	Blocker bool   `json:"blocker,omitempty"` // Stops the subject from running right now
+}
+
+// whyReport is the output of gt why.
+type whyReport struct {
+	Kind      string              `json:"kind"`
+	Subject   string              `json:"subject"`
+	Checks    []whyCheck          `json:"checks"`
+	Decisions []decision.Decision `json:"decisions"`
+}
+
+func runWhyPatrol(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	name := args[0]
+	report := &whyReport{Kind: decision.KindPatrol, Subject: name}
+
+	if running, _, _ := daemon.IsRunning(townRoot); running {
+		report.Checks = append(report.Checks, whyCheck{Check: "daemon", OK: true, Detail: "running"})
+	} else {
+		report.Checks = append(report.Checks, whyCheck{Check: "daemon", Blocker: true, Detail: "not running (gt daemon start)"})
+	}
+	if daemon.IsShutdownInProgress(townRoot) {
+		report.Checks = append(report.Checks, whyCheck{Check: "shutdown", Blocker: true, Detail: "gt down in progress"})
+	}
+	if inc, _ := incident.Active(townRoot); inc != nil {
+		report.Checks = append(report.Checks, whyCheck{Check: "incident", Blocker: true, Detail: inc.ID + " in progress (gt incident end)"})
+	}
+
+	patrolConfig := daemon.LoadPatrolConfig(townRoot)
+	if daemon.IsPatrolEnabled(patrolConfig, name) {
+		report.Checks = append(report.Checks, whyCheck{Check: "config", OK: true, Detail: "enabled in mayor/daemon.json"})
+	} else {
+		report.Checks = append(report.Checks, whyCheck{Check: "config", Blocker: true, Detail: "disabled in mayor/daemon.json"})
+	}
+	if dis, ok := daemon.ActivePatrolDisable(townRoot, name, time.Now()); ok {
+		report.Checks = append(report.Checks, whyCheck{Check: "disable", Blocker: true,
+			Detail: fmt.Sprintf("disabled until %s: %s (gt patrol enable)", dis.Until.Local().Format("2006-01-02 15:04"), dis.Reason)})
+	}
+	if engine, err := policy.Load(townRoot); err == nil {
+		in := policy.WithDefaults(policy.Input{"patrol": name, "actor": "daemon", "role": "daemon"}, time.Now())
+		if d := engine.Decide(policy.PointPatrol, in); !d.Allowed {
+			report.Checks = append(report.Checks, whyCheck{Check: "policy", Blocker: true, Detail: d.Denied().Error() + " (gt policy list)"})
+		}
+	}
+
+	schedule := "unknown patrol"
+	for _, e := range daemon.PatrolSchedule(townRoot, patrolConfig) {
+		if e.Name == name {
+			schedule = "every " + formatPatrolInterval(e.Effective)
+			if e.Effective != e.Interval {
+				schedule += fmt.Sprintf(" (adaptive; base %s)", formatPatrolInterval(e.Interval))
+			}
+		}
+	}
+	for _, p := range heartbeatPatrols {
+		if p == name {
+			schedule = "every daemon heartbeat"
+		}
+	}
+	report.Checks = append(report.Checks, whyCheck{Check: "schedule", OK: schedule != "unknown patrol", Detail: schedule})
+
+	if report.Decisions, err = decision.Query(townRoot, decision.KindPatrol, name, whyLimit); err != nil {
+		return err
+	}
+	return printWhyReport(report)
+}
+
+func runWhySling(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	beadID := args[0]
+	report := &whyReport{Kind: decision.KindSling, Subject: beadID}
+
+	state, err := capacity.LoadState(townRoot)
+	if err != nil {
+		return fmt.Errorf("loading scheduler state: %w", err)
+	}
+	if state.Paused {
+		report.Checks = append(report.Checks, whyCheck{Check: "scheduler", Blocker: true, Detail: fmt.Sprintf("paused by %s (gt scheduler resume)", state.PausedBy)})
+	} else {
+		report.Checks = append(report.Checks, whyCheck{Check: "scheduler", OK: true, Detail: "active"})
+	}
+
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+	schedulerCfg := settings.Scheduler
+	if schedulerCfg == nil {
+		schedulerCfg = capacity.DefaultSchedulerConfig()
+	}
+	if maxPolecats := schedulerCfg.GetMaxPolecats(); maxPolecats <= 0 {
+		report.Checks = append(report.Checks, whyCheck{Check: "capacity", Blocker: true, Detail: "deferred dispatch is off (scheduler.max_polecats)"})
+	} else {
+		active := countActivePolecats()
+		report.Checks = append(report.Checks, whyCheck{
+			Check: "capacity", OK: active < maxPolecats, Blocker: active >= maxPolecats,
+			Detail: fmt.Sprintf("%d of %d polecat slots in use", active, maxPolecats),
+		})
+	}
+
+	contexts, err := listAllSlingContexts(townRoot)
+	if err != nil {
+		return fmt.Errorf("listing sling contexts: %w", err)
+	}
+	var fields *capacity.SlingContextFields
+	for _, ctx := range contexts {
+		if f := beads.ParseSlingContextFields(ctx.Description); f != nil && f.WorkBeadID == beadID {
+			fields = f
+			break
+		}
+	}
+	switch {
+	case fields == nil:
+		report.Checks = append(report.Checks, whyCheck{Check: "scheduled", Blocker: true, Detail: "no open sling context (not scheduled, or already dispatched)"})
+	case fields.DispatchFailures >= maxDispatchFailures:
+		report.Checks = append(report.Checks, whyCheck{Check: "breaker", Blocker: true,
+			Detail: fmt.Sprintf("failed %d times, circuit-broken: %s", fields.DispatchFailures, fields.LastFailure)})
+	default:
+		detail := "scheduled for " + fields.TargetRig
+		if fields.DispatchFailures > 0 {
+			detail += fmt.Sprintf(" (%d of %d failures before circuit break)", fields.DispatchFailures, maxDispatchFailures)
+		}
+		report.Checks = append(report.Checks, whyCheck{Check: "scheduled", OK: true, Detail: detail})
+	}
+	if fields != nil {
+		if listReadyWorkBeadIDs(townRoot)[beadID] {
+			report.Checks = append(report.Checks, whyCheck{Check: "ready", OK: true, Detail: "unblocked"})
+		} else {
+			report.Checks = append(report.Checks, whyCheck{Check: "ready", Blocker: true, Detail: "blocked (not in bd ready)"})
+		}
+	}
+
+	if report.Decisions, err = decision.Query(townRoot, decision.KindSling, beadID, whyLimit); err != nil {
+		return err
+	}
+	return printWhyReport(report)
+}
+
+func printWhyReport(report *whyReport) error {
+	if whyJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	fmt.Printf("%s %s\n\n", style.Bold.Render(report.Kind), report.Subject)
+	fmt.Println(style.Bold.Render("Now"))
+	for _, c := range report.Checks {
+		mark := style.SuccessPrefix
+		if c.Blocker {
+			mark = style.Warning.Render(ui.Glyph("✗"))
+		} else if !c.OK {
+			mark = style.Dim.Render("·")
+		}
+		fmt.Printf("  %s %-10s %s\n", mark, c.Check, c.Detail)
+	}
+
+	fmt.Println()
+	fmt.Println(style.Bold.Render("Recent decisions"))
+	if len(report.Decisions) == 0 {
+		fmt.Println(style.Dim.Render("  None recorded. Decisions are logged by the daemon and scheduler as they run."))
+		return nil
+	}
+	for _, d := range report.Decisions {
+		outcome := d.Outcome
+		switch d.Outcome {
+		case decision.OutcomeRan, decision.OutcomeDispatched:
+			outcome = style.Success.Render(fmt.Sprintf("%-10s", outcome))
+		case decision.OutcomeFailed:
+			outcome = style.Warning.Render(fmt.Sprintf("%-10s", outcome))
+		default:
+			outcome = style.Dim.Render(fmt.Sprintf("%-10s", outcome))
+		}
+		why := strings.TrimSpace(d.Reason + " " + style.Dim.Render(d.Detail))
+		fmt.Printf("  %s  %s %s\n", d.Time.Local().Format("01-02 15:04:05"), outcome, why)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var wispCmd = &cobra.Command{
+	Use:     "wisp",
+	GroupID: GroupWork,
+	Short:   "Work with wisps (units of slung work)",
+	Long: `Work with wisps: beads that have been slung to an agent.
+
+Subcommands:
+  abandon    Hand a wisp back with a reason code
+  advance    Move a typed wisp through its lifecycle
+  comment    Append a structured comment to a wisp
+  comments   Show a wisp's comments
+  label      Add or remove labels on a wisp
+  list       List in-flight wisps, optionally through a saved view
+  type       Set a wisp's type
+  types      Show wisp types and their workflows
+  views      Show saved wisp views`,
+	RunE: requireSubcommand,
+}
+
+var (
+	wispCommentArtifact string
+	wispCommentRole     string
+	wispCommentAuthor   string
+	wispCommentsJSON    bool
+)
+
+var wispCommentCmd = &cobra.Command{
+	Use:     "comment <bead-id> <message...>",
+	Aliases: []string{"annotate"},
+	Short:   "Append a structured comment to a wisp",
+	Long: `Append a comment to a wisp's bead.
+
+Comments record the author, role, and time, plus an optional artifact link.
+Patrols use them to leave findings; humans use them to steer work in flight.
+The most recent comments are shown in the assigned agent's prime context on
+its next session.
+
+The author defaults to the current agent identity; the role is derived from
+it ("human" when run outside an agent).
+
+Examples:
+  gt wisp comment gt-abc "Prefer the smaller refactor; skip the API change"
+  gt wisp comment gt-abc "CI is red on main, not your fault" --artifact https://ci/run/42
+  gt wisp annotate gt-abc "Lint fails in sandbox" --role witness`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runWispComment,
+}
+
+var wispCommentsCmd = &cobra.Command{
+	Use:   "comments <bead-id>",
+	Short: "Show a wisp's comments",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runWispComments,
+}
+
+func init() {
+	wispCommentCmd.Flags().StringVar(&wispCommentArtifact, "artifact", "", "Link to a supporting artifact (URL or path)")
+	wispCommentCmd.Flags().StringVar(&wispCommentRole, "role", "", "Role to record (default: derived from identity)")
+	wispCommentCmd.Flags().StringVar(&wispCommentAuthor, "author", "", "Author to record (default: current identity)")
+	wispCommentsCmd.Flags().BoolVar(&wispCommentsJSON, "json", false, "Output as JSON")
+
+	wispCmd.AddCommand(wispCommentCmd)
+	wispCmd.AddCommand(wispCommentsCmd)
+	rootCmd.AddCommand(wispCmd)
+}
+
+func runWispComment(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	beadID := args[0]
+	comment := &beads.Comment{
+		Author:   wispCommentAuthor,
+		Role:     wispCommentRole,
+		Body:     strings.Join(args[1:], " "),
+		Artifact: wispCommentArtifact,
+	}
+	if comment.Author == "" || comment.Role == "" {
+		author, role := commentIdentity()
+		if comment.Author == "" {
+			comment.Author = author
+		}
+		if comment.Role == "" {
+			comment.Role = role
+		}
+	}
+
+	if err := beads.New(townRoot).AddComment(beadID, comment); err != nil {
+		return fmt.Errorf("commenting on %s: %w", beadID, err)
+	}
+	fmt.Printf("%s Commented on %s as %s\n", style.SuccessPrefix, style.Bold.Render(beadID), comment.Author)
+	return nil
+}
+
+// commentIdentity returns the author and role to record for a comment.
+func commentIdentity() (author, role string) {
+	info, err := GetRole()
+	if err != nil || info.Role == RoleUnknown {
+		if user := os.Getenv("USER"); user != "" {
+			return user, "human"
+		}
+		return "human", "human"
+	}
+	return info.ActorString(), string(info.Role)
+}
+
+func runWispComments(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	comments, err := beads.New(townRoot).Comments(args[0])
+	if err != nil {
+		return fmt.Errorf("listing comments on %s: %w", args[0], err)
+	}
+
+	if wispCommentsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(comments)
+	}
+	if len(comments) == 0 {
+		fmt.Printf("No comments on %s.\n", args[0])
+		return nil
+	}
+	for _, c := range comments {
+		printWispComment(c, "")
+	}
+	return nil
+}
+
+// printWispComment prints one comment with an optional indent.
+func printWispComment(c *beads.Comment, indent string) {
+	who := c.Author
+	if c.Role != "" && c.Role != c.Author {
+		who += " (" + c.Role + ")"
+	}
+	fmt.Printf("%s%s %s\n", indent, style.Bold.Render(who), style.Dim.Render(c.CreatedAt.Local().Format("2006-01-02 15:04")))
+	for _, line := range strings.Split(strings.TrimRight(c.Body, "\n"), "\n") {
+		fmt.Printf("%s  %s\n", indent, line)
+	}
+	if c.Artifact != "" {
+		fmt.Printf("%s  %s %s\n", indent, style.Dim.Render("artifact:"), c.Artifact)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/wisp"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	wispAbandonReason   string
+	wispAbandonNote     string
+	wispAbandonClose    bool
+	wispAbandonRequeue  bool
+	wispAbandonNoPatch  bool
+	wispAbandonWorktree string
+	wispAbandonDryRun   bool
+)
+
+var wispAbandonCmd = &cobra.Command{
+	Use:   "abandon <bead-id>",
+	Short: "Hand a wisp back with a reason code",
+	Long: `Give up on a wisp explicitly instead of leaving it hooked until a patrol
+finds it stale. Agents abandon work they can't finish; humans abandon work
+on an agent's behalf.
+
+Abandoning:
+  1. Saves the holder's partial work (commits and uncommitted changes,
+     including untracked files) as a patch under .runtime/abandoned/
+  2. Comments on the bead with the reason, note, and patch, so whoever
+     picks it up next sees why it came back
+  3. Clears the holder's hook and requeues the wisp (open, unassigned)
+     or closes it, and labels it gt:abandoned:<reason>
+  4. Logs a wisp_abandon event; gt stats --abandons reports on them
+
+Reason codes:
+  blocked      Waiting on other work, a person, or an outside system
+  unclear      The spec is ambiguous, contradictory, or missing
+  too-large    Too big for one wisp; needs splitting
+  env-broken   Build, test, or tooling environment is broken
+  stuck        No progress after repeated attempts
+  duplicate    Already done or tracked elsewhere (closes by default)
+  obsolete     No longer needed (closes by default)
+  other        Anything else (requires --note)
+
+The patch is taken from the holder's polecat or crew worktree; pass
+--worktree when it lives elsewhere. The worktree itself is left as is.
+Apply a saved patch with git apply.
+
+Examples:
+  gt wisp abandon gt-abc --reason unclear --note "Which API version?"
+  gt wisp abandon gt-abc --reason duplicate --note "Same as gt-xyz"
+  gt wisp abandon gt-abc --reason too-large --close
+  gt stats --abandons --by reason`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWispAbandon,
+}
+
+func init() {
+	wispAbandonCmd.Flags().StringVarP(&wispAbandonReason, "reason", "r", "", "Reason code (required; see above)")
+	wispAbandonCmd.Flags().StringVarP(&wispAbandonNote, "note", "m", "", "Free-form explanation for the next claimer")
+	wispAbandonCmd.Flags().BoolVar(&wispAbandonClose, "close", false, "Close the wisp instead of requeueing it")
+	wispAbandonCmd.Flags().BoolVar(&wispAbandonRequeue, "requeue", false, "Requeue the wisp even if its reason closes by default")
+	wispAbandonCmd.Flags().BoolVar(&wispAbandonNoPatch, "no-patch", false, "Don't save the holder's partial work")
+	wispAbandonCmd.Flags().StringVar(&wispAbandonWorktree, "worktree", "", "Worktree holding the partial work (default: the holder's)")
+	wispAbandonCmd.Flags().BoolVarP(&wispAbandonDryRun, "dry-run", "n", false, "Show what would be done")
+	_ = wispAbandonCmd.MarkFlagRequired("reason")
+	wispAbandonCmd.MarkFlagsMutuallyExclusive("close", "requeue")
+	wispCmd.AddCommand(wispAbandonCmd)
+}
+
+func runWispAbandon(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	beadID := args[0]
+	reason, err := wisp.LookupAbandonReason(wispAbandonReason)
+	if err != nil {
+		return err
+	}
+	if reason.Code == "other" && strings.TrimSpace(wispAbandonNote) == "" {
+		return fmt.Errorf("--reason other requires --note")
+	}
+
+	b := beads.New(resolveBeadDir(beadID))
+	issue, err := b.Show(beadID)
+	if err != nil {
+		return fmt.Errorf("getting %s: %w", beadID, err)
+	}
+	if issue.Status == "closed" {
+		return fmt.Errorf("%s is already closed", beadID)
+	}
+	holder := issue.Assignee
+
+	outcome := wisp.AbandonRequeue
+	if (reason.Close || wispAbandonClose) && !wispAbandonRequeue {
+		outcome = wisp.AbandonClose
+	}
+
+	now := time.Now()
+	var patch, patchPath string
+	if !wispAbandonNoPatch {
+		worktree := wispAbandonWorktree
+		if worktree == "" {
+			worktree = abandonWorktree(townRoot, holder)
+		}
+		if worktree != "" {
+			if patch, err = partialWork(worktree); err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: couldn't capture partial work from %s: %v\n", worktree, err)
+			}
+		}
+		if patch != "" {
+			patchPath = wisp.AbandonPatchPath(townRoot, beadID, now)
+		}
+	}
+
+	if wispAbandonDryRun {
+		fmt.Printf("Would abandon %s (%s) as %s; it would be %s\n", beadID, issue.Title, reason.Code, outcome)
+		if holder != "" {
+			fmt.Printf("Would clear %s's hook\n", holder)
+		}
+		if patchPath != "" {
+			fmt.Printf("Would save %d lines of partial work to %s\n", strings.Count(patch, "\n"), patchPath)
+		}
+		return nil
+	}
+
+	if patchPath != "" {
+		if err := os.MkdirAll(filepath.Dir(patchPath), 0755); err != nil {
+			return fmt.Errorf("creating patch directory: %w", err)
+		}
+		if err := os.WriteFile(patchPath, []byte(patch), 0644); err != nil { //nolint:gosec // G306: patches are shared with the next claimer
+			return fmt.Errorf("saving partial work: %w", err)
+		}
+	}
+
+	if holder != "" {
+		clearAbandonedHook(cmd, townRoot, holder, beadID)
+	}
+
+	author, role := commentIdentity()
+	body := fmt.Sprintf("Abandoned (%s) and %s.", reason.Code, outcome)
+	if wispAbandonNote != "" {
+		body += "\n" + wispAbandonNote
+	}
+	if patchPath != "" {
+		body += "\nPartial work saved; apply with: git apply " + patchPath
+	}
+	if err := b.AddComment(beadID, &beads.Comment{
+		Author:   author,
+		Role:     role,
+		Body:     body,
+		Artifact: patchPath,
+		Kind:     beads.CommentKindAbandon,
+	}); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: couldn't comment on %s: %v\n", beadID, err)
+	}
+
+	label := []string{wisp.AbandonLabelPrefix + reason.Code}
+	if outcome == wisp.AbandonClose {
+		if err := b.Update(beadID, beads.UpdateOptions{AddLabels: label}); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: couldn't label %s: %v\n", beadID, err)
+		}
+		closeReason := "abandoned: " + reason.Code
+		if wispAbandonNote != "" {
+			closeReason += ": " + wispAbandonNote
+		}
+		if err := b.CloseWithReason(closeReason, beadID); err != nil {
+			return fmt.Errorf("closing %s: %w", beadID, err)
+		}
+	} else {
+		openStatus := "open"
+		emptyAssignee := ""
+		if err := b.Update(beadID, beads.UpdateOptions{
+			Status:    &openStatus,
+			Assignee:  &emptyAssignee,
+			AddLabels: label,
+		}); err != nil {
+			return fmt.Errorf("requeueing %s: %w", beadID, err)
+		}
+	}
+
+	_ = events.LogFeed(events.TypeWispAbandon, detectActor(), events.WispAbandonPayload(beadID, holder, reason.Code, outcome, patchPath))
+
+	fmt.Printf("%s Abandoned %s (%s): %s\n", style.SuccessPrefix, style.Bold.Render(beadID), reason.Code, outcome)
+	if holder != "" {
+		fmt.Printf("  %s\n", style.Dim.Render("hook cleared for "+holder))
+	}
+	if patchPath != "" {
+		fmt.Printf("  %s\n", style.Dim.Render("partial work: "+patchPath))
+	}
+	return nil
+}
+
+// abandonWorktree returns the worktree of the polecat or crew member agentID,
+// or "" when it has none.
+func abandonWorktree(townRoot, agentID string) string {
+	parts := strings.Split(agentID, "/")
+	if len(parts) != 3 {
+		return ""
+	}
+	rigName, kind, name := parts[0], parts[1], parts[2]
+	switch kind {
+	case constants.DirPolecats:
+		return resolvePolecatWorktree(filepath.Join(townRoot, rigName, constants.DirPolecats), name, rigName)
+	case constants.RoleCrew:
+		dir := filepath.Join(townRoot, rigName, constants.RoleCrew, name)
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir
+		}
+	}
+	return ""
+}
+
+// partialWork returns the worktree's changes since it branched from the
+// remote default branch: commits, uncommitted edits, and untracked files.
+func partialWork(worktree string) (string, error) {
+	g := git.NewGit(worktree)
+	base, err := g.MergeBase("HEAD", "origin/"+g.RemoteDefaultBranch())
+	if err != nil {
+		// No remote to compare with: keep just the uncommitted work
+		base = "HEAD"
+	}
+	return g.WorkingDiff(base)
+}
+
+// clearAbandonedHook clears beadID from agentID's hook. Failures are
+// warnings: the bead's own status change is what requeues the work.
+func clearAbandonedHook(cmd *cobra.Command, townRoot, agentID, beadID string) {
+	agentBeadID := agentIDToBeadID(agentID, townRoot)
+	if agentBeadID == "" {
+		return
+	}
+	rigName := strings.Split(agentID, "/")[0]
+	fallbackPath := filepath.Join(townRoot, rigName)
+	if rigName == "mayor" || rigName == "deacon" {
+		fallbackPath = townRoot
+	}
+	b := beads.New(beads.ResolveHookDir(townRoot, agentBeadID, fallbackPath))
+	agentBead, err := b.Show(agentBeadID)
+	if err != nil || agentBead.HookBead != beadID {
+		return
+	}
+	if err := b.ClearHookBead(agentBeadID); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: couldn't clear hook from agent bead %s: %v\n", agentBeadID, err)
+		return
+	}
+	_ = events.LogFeed(events.TypeUnhook, agentID, events.UnhookPayload(beadID))
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAbandonWorktree(t *testing.T) {
+	townRoot := t.TempDir()
+	polecat := filepath.Join(townRoot, "gastown", "polecats", "Toast", "gastown")
+	crew := filepath.Join(townRoot, "gastown", "crew", "max")
+	for _, dir := range []string{polecat, crew} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := map[string]string{
+		"gastown/polecats/Toast": polecat,
+		"gastown/crew/max":       crew,
+		"gastown/crew/nobody":    "",
+		"gastown/witness":        "",
+		"mayor":                  "",
+		"":                       "",
+	}
+	for agent, want := range tests {
+		if got := abandonWorktree(townRoot, agent); got != want {
+			t.Errorf("abandonWorktree(%q) = %q, want %q", agent, got, want)
+		}
+	}
+}
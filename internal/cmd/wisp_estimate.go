@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/wisp"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// calibrationWindow is how far back estimate calibration looks.
+const calibrationWindow = 90 * 24 * time.Hour
+
+var wispEstimateCmd = &cobra.Command{
+	Use:   "estimate <bead-id> <effort>",
+	Short: "Record an effort estimate for a wisp",
+	Long: `Record how long a wisp is expected to take, as a duration (45m, 2h, 1d).
+
+Estimate when creating or claiming the work; the latest estimate made
+before gt done counts. At completion the estimate is compared with the
+actual cycle time (claimed → done), and gt stats --calibration reports how
+well each agent and wisp type estimates.
+
+When the agent holding the wisp (or its type) has a track record, the
+calibrated expectation is shown alongside the estimate.
+
+gt sling --estimate records an estimate for the slung beads in one step.
+
+Examples:
+  gt wisp estimate gt-abc 2h
+  gt sling gt-abc gastown --estimate 90m`,
+	Args: cobra.ExactArgs(2),
+	RunE: runWispEstimate,
+}
+
+func init() {
+	wispCmd.AddCommand(wispEstimateCmd)
+}
+
+func runWispEstimate(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	beadID := args[0]
+	estimate, err := parseEstimate(args[1])
+	if err != nil {
+		return err
+	}
+	if err := events.LogFeed(events.TypeEstimate, detectActor(), events.EstimatePayload(beadID, estimate)); err != nil {
+		return fmt.Errorf("recording estimate: %w", err)
+	}
+	fmt.Printf("%s %s estimated at %s\n", style.SuccessPrefix, style.Bold.Render(beadID), formatDuration(estimate))
+
+	if hint := calibratedEstimateHint(townRoot, beadID, estimate); hint != "" {
+		fmt.Printf("  %s\n", style.Dim.Render(hint))
+	}
+	return nil
+}
+
+// parseEstimate parses an effort estimate, which must be positive.
+func parseEstimate(s string) (time.Duration, error) {
+	d, err := parseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid estimate %q: want a positive duration like 45m, 2h, or 1d", s)
+	}
+	return d, nil
+}
+
+// calibratedEstimateHint describes what estimate would be expected to take
+// given the track record of the wisp's agent, or else its type. Returns ""
+// when neither has enough estimated wisps.
+func calibratedEstimateHint(townRoot, beadID string, estimate time.Duration) string {
+	timelines, err := stats.Load(townRoot)
+	if err != nil {
+		return ""
+	}
+	var agent, wispType string
+	for _, t := range timelines {
+		if t.Bead == beadID {
+			agent, wispType = t.Agent, t.Type
+		}
+	}
+	if wispType == "" {
+		if issue, err := beads.New(resolveBeadDir(beadID)).Show(beadID); err == nil {
+			if t, ok := wisp.TypeOf(wisp.Types(loadWispSettings(townRoot)), issue); ok {
+				wispType = t.Name
+			}
+		}
+	}
+
+	since := time.Now().Add(-calibrationWindow)
+	for _, g := range []struct{ by, key string }{{stats.ByAgent, agent}, {stats.ByType, wispType}} {
+		if g.key == "" {
+			continue
+		}
+		keyFn, _ := stats.GroupKey(g.by)
+		for _, c := range stats.Calibrate(timelines, keyFn, since) {
+			if c.Key != g.key {
+				continue
+			}
+			if adjusted, ok := c.Adjust(estimate); ok {
+				return fmt.Sprintf("%s %s's estimates run %.2f× (median of %d): expect ~%s",
+					g.by, g.key, c.RatioP50, c.Estimated, formatDuration(adjusted))
+			}
+		}
+	}
+	return ""
+}
+
+// slingEstimateBeads returns the beads a gt sling invocation slings, for
+// recording --estimate: the --on target for formula slings, otherwise the
+// arguments that look like bead IDs (excluding a rig target).
+func slingEstimateBeads(args []string) []string {
+	if slingOnTarget != "" {
+		return []string{slingOnTarget}
+	}
+	var out []string
+	for i, a := range args {
+		if i > 0 && i == len(args)-1 {
+			if _, isRig := IsRigName(a); isRig {
+				continue
+			}
+		}
+		if looksLikeBeadID(a) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
@@ -0,0 +1,304 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/wisp"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	wispTemplatesJSON  bool
+	wispNewRig         string
+	wispNewSummary     string
+	wispNewCriteria    []string
+	wispNewTests       []string
+	wispNewPriority    int
+	wispNewJSON        bool
+	wispCriteriaJSON   bool
+	wispCriteriaStrict bool
+)
+
+var wispTemplatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Show the templates gt wisp new scaffolds from",
+	Long: `Show the town's wisp templates: the wisp type they create, the
+description sections they scaffold, and their default acceptance criteria
+and test expectations.
+
+Built-in templates are bugfix, feature, and spike. Override them or add
+custom templates in settings/config.json:
+
+  "wisps": {
+    "templates": {
+      "bugfix": {"tests": ["make test passes"]},
+      "docs": {
+        "type": "chore",
+        "sections": ["Audience", "Outline"],
+        "criteria": ["Reviewed by a maintainer"]
+      }
+    }
+  }
+
+Examples:
+  gt wisp templates
+  gt wisp templates --json`,
+	Args: cobra.NoArgs,
+	RunE: runWispTemplates,
+}
+
+var wispNewCmd = &cobra.Command{
+	Use:   "new <template> <title>",
+	Short: "Create a wisp from a template",
+	Long: `Create a wisp bead from a template, with its description scaffolded:
+a heading per template section to fill in, and checklists of acceptance
+criteria and test expectations.
+
+Acceptance criteria are stored in the bead's acceptance criteria, which gt
+done will not close with unchecked items; test expectations go in the
+description. --criterion and --test replace the template's defaults. The
+wisp gets the template's wisp type, so it follows that type's workflow and
+merge gates.
+
+Examples:
+  gt wisp new bugfix "Login fails on Safari" --rig gastown
+  gt wisp new feature "Add CSV export" -m "Users want their data out." \
+    --criterion "Export includes every column" --test "Round-trip test"
+  gt wisp new spike "Can we drop the Redis cache?"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runWispNew,
+}
+
+var wispCriteriaCmd = &cobra.Command{
+	Use:   "criteria <bead-id>",
+	Short: "Show a wisp's acceptance criteria and test expectations",
+	Long: `Show a wisp's definition of done: the checklist items of its acceptance
+criteria and of the description's "Test Expectations" section.
+
+With --check, exits 1 if any item is unchecked, so a refinery gate can
+require a finished checklist. Gate commands get the wisp's ID in
+GT_WISP_ID, its criteria as JSON in GT_WISP_CRITERIA, and its registered
+artifacts (gt artifact) as JSON in GT_WISP_ARTIFACTS. When the refinery
+gates a batch of stacked MRs, GT_WISP_ID is unset, GT_WISP_IDS lists every
+wisp in the batch, and the JSON lists cover them all.
+
+Examples:
+  gt wisp criteria gt-abc
+  gt wisp criteria gt-abc --json
+  gt wisp criteria "$GT_WISP_ID" --check`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWispCriteria,
+}
+
+func init() {
+	wispTemplatesCmd.Flags().BoolVar(&wispTemplatesJSON, "json", false, "Output as JSON")
+
+	wispNewCmd.Flags().StringVar(&wispNewRig, "rig", "", "Create the wisp in this rig's beads (default: current directory)")
+	wispNewCmd.Flags().StringVarP(&wispNewSummary, "message", "m", "", "Summary that opens the description")
+	wispNewCmd.Flags().StringArrayVar(&wispNewCriteria, "criterion", nil, "Acceptance criterion (repeatable; replaces the template's)")
+	wispNewCmd.Flags().StringArrayVar(&wispNewTests, "test", nil, "Test expectation (repeatable; replaces the template's)")
+	wispNewCmd.Flags().IntVar(&wispNewPriority, "priority", -1, "Priority 0-4 (default: the wisp type's)")
+	wispNewCmd.Flags().BoolVar(&wispNewJSON, "json", false, "Output the created bead as JSON")
+
+	wispCriteriaCmd.Flags().BoolVar(&wispCriteriaJSON, "json", false, "Output as JSON")
+	wispCriteriaCmd.Flags().BoolVar(&wispCriteriaStrict, "check", false, "Exit 1 if any item is unchecked")
+
+	wispCmd.AddCommand(wispTemplatesCmd)
+	wispCmd.AddCommand(wispNewCmd)
+	wispCmd.AddCommand(wispCriteriaCmd)
+}
+
+// loadWispTemplates returns the town's wisp templates and types, validated.
+func loadWispTemplates(townRoot string) (map[string]wisp.Template, map[string]wisp.Type, error) {
+	types, err := loadWispTypes(townRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+	templates := wisp.Templates(loadWispSettings(townRoot))
+	for _, name := range wisp.TemplateNames(templates) {
+		if err := templates[name].Validate(types); err != nil {
+			return nil, nil, err
+		}
+	}
+	return templates, types, nil
+}
+
+func runWispTemplates(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	templates, _, err := loadWispTemplates(townRoot)
+	if err != nil {
+		return err
+	}
+	names := wisp.TemplateNames(templates)
+
+	if wispTemplatesJSON {
+		out := make([]wisp.Template, 0, len(names))
+		for _, name := range names {
+			out = append(out, templates[name])
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+	for _, name := range names {
+		t := templates[name]
+		typeName := t.Type
+		if typeName == "" {
+			typeName = "untyped"
+		}
+		fmt.Printf("%s  %s\n", style.Bold.Render(name), typeName)
+		if t.Description != "" {
+			fmt.Printf("    %s\n", style.Dim.Render(t.Description))
+		}
+		if len(t.Sections) > 0 {
+			fmt.Printf("    %s\n", style.Dim.Render("sections: "+strings.Join(t.Sections, ", ")))
+		}
+		for _, c := range t.Criteria {
+			fmt.Printf("    %s %s\n", style.Dim.Render("criterion:"), c)
+		}
+		for _, c := range t.Tests {
+			fmt.Printf("    %s %s\n", style.Dim.Render("test:"), c)
+		}
+	}
+	return nil
+}
+
+func runWispNew(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if wispNewPriority > 4 {
+		return fmt.Errorf("invalid priority %d: must be 0-4", wispNewPriority)
+	}
+	templates, types, err := loadWispTemplates(townRoot)
+	if err != nil {
+		return err
+	}
+	tmplName, title := args[0], args[1]
+	tmpl, ok := templates[tmplName]
+	if !ok {
+		return fmt.Errorf("unknown wisp template %q (known: %s)", tmplName, strings.Join(wisp.TemplateNames(templates), ", "))
+	}
+
+	beadDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if wispNewRig != "" {
+		_, r, err := getRig(wispNewRig)
+		if err != nil {
+			return err
+		}
+		beadDir = r.Path
+	}
+
+	description, acceptance := tmpl.Scaffold(wispNewSummary, wispNewCriteria, wispNewTests)
+	bd := beads.New(beadDir)
+	issue, err := bd.Create(beads.CreateOptions{
+		Title:              title,
+		Priority:           wispNewPriority,
+		Description:        description,
+		AcceptanceCriteria: acceptance,
+	})
+	if err != nil {
+		return fmt.Errorf("creating wisp: %w", err)
+	}
+	if t, ok := types[tmpl.Type]; ok {
+		if err := bd.Update(issue.ID, t.Assign(issue, wispNewPriority >= 0)); err != nil {
+			style.PrintWarning("could not give %s wisp type %s: %v", issue.ID, t.Name, err)
+		}
+	}
+
+	if wispNewJSON {
+		if created, err := bd.Show(issue.ID); err == nil {
+			issue = created
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(issue)
+	}
+	fmt.Printf("%s Created %s from template %s: %s\n", style.SuccessPrefix, style.Bold.Render(issue.ID), tmpl.Name, title)
+	fmt.Printf("  %s\n", style.Dim.Render("Fill in the description sections with: bd edit "+issue.ID))
+	return nil
+}
+
+func runWispCriteria(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+	issue, err := beads.New(resolveBeadDir(beadID)).Show(beadID)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", beadID, err)
+	}
+	criteria := wisp.Criteria(issue)
+	unchecked := wisp.Unchecked(criteria)
+
+	if wispCriteriaJSON {
+		if criteria == nil {
+			criteria = []wisp.Criterion{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(criteria); err != nil {
+			return err
+		}
+	} else {
+		printWispCriteria(criteria)
+		if len(criteria) == 0 {
+			fmt.Printf("%s has no acceptance criteria or test expectations\n", beadID)
+		}
+	}
+	if wispCriteriaStrict && unchecked > 0 {
+		if !wispCriteriaJSON {
+			fmt.Printf("%s %d of %d item(s) unchecked\n", style.Error.Render(ui.Glyph("✗")), unchecked, len(criteria))
+		}
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// printWispCriteria prints criteria as checklists grouped by kind.
+func printWispCriteria(criteria []wisp.Criterion) {
+	for _, kind := range []struct{ kind, heading string }{
+		{wisp.CriterionAcceptance, wisp.CriteriaHeading},
+		{wisp.CriterionTest, wisp.TestsHeading},
+	} {
+		first := true
+		for _, c := range criteria {
+			if c.Kind != kind.kind {
+				continue
+			}
+			if first {
+				fmt.Printf("  %s\n", style.Bold.Render(kind.heading+":"))
+				first = false
+			}
+			mark := "[ ]"
+			if c.Done {
+				mark = style.Success.Render("[x]")
+			}
+			fmt.Printf("    %s %s\n", mark, c.Text)
+		}
+	}
+}
+
+// outputWispCriteriaContext adds the hooked wisp's definition of done to
+// the prime context.
+func outputWispCriteriaContext(hookedBead *beads.Issue) {
+	criteria := wisp.Criteria(hookedBead)
+	if len(criteria) == 0 {
+		return
+	}
+	fmt.Printf("%s\n\n", style.Bold.Render("## Definition of Done"))
+	printWispCriteria(criteria)
+	fmt.Printf("\n  Check items off as you meet them; gt done will not close the wisp\n")
+	fmt.Printf("  while acceptance criteria are unchecked. Review with: gt wisp criteria %s\n\n", hookedBead.ID)
+}
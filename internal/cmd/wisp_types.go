@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/wisp"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	wispTypesJSON        bool
+	wispTypeKeepPriority bool
+)
+
+var wispTypesCmd = &cobra.Command{
+	Use:   "types",
+	Short: "Show wisp types and their workflows",
+	Long: `Show the town's wisp types: lifecycle states, required merge gates,
+default priority, and prime template.
+
+Built-in types are bug, feature, chore, spike, and patrol-finding. Override them
+or add custom types in settings/config.json:
+
+  "wisps": {
+    "types": {
+      "patrol-finding": {"priority": 2},
+      "docs": {
+        "states": ["open", "drafted", "closed"],
+        "gates": ["lint"],
+        "prime_template": "settings/prime/docs.md"
+      }
+    }
+  }
+
+Gates name refinery quality gates; "*" means all of them and an empty list
+means none, so a typed wisp merges after only the gates its type requires.
+
+Examples:
+  gt wisp types
+  gt wisp types --json`,
+	Args: cobra.NoArgs,
+	RunE: runWispTypes,
+}
+
+var wispTypeCmd = &cobra.Command{
+	Use:   "type <bead-id> <type>",
+	Short: "Set a wisp's type",
+	Long: `Give a wisp a type. The wisp starts in the type's first lifecycle state
+and takes the type's default priority (unless --keep-priority).
+
+Examples:
+  gt wisp type gt-abc patrol-finding
+  gt wisp type gt-abc bug --keep-priority`,
+	Args: cobra.ExactArgs(2),
+	RunE: runWispType,
+}
+
+var wispAdvanceCmd = &cobra.Command{
+	Use:   "advance <bead-id> [state]",
+	Short: "Move a typed wisp through its lifecycle",
+	Long: `Move a typed wisp to the next state of its type's lifecycle, or to the
+named state. Reaching the final state closes the bead.
+
+Examples:
+  gt wisp advance gt-abc              # open → triaged
+  gt wisp advance gt-abc closed`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runWispAdvance,
+}
+
+func init() {
+	wispTypesCmd.Flags().BoolVar(&wispTypesJSON, "json", false, "Output as JSON")
+	wispTypeCmd.Flags().BoolVar(&wispTypeKeepPriority, "keep-priority", false, "Keep the wisp's current priority")
+
+	wispCmd.AddCommand(wispTypesCmd)
+	wispCmd.AddCommand(wispTypeCmd)
+	wispCmd.AddCommand(wispAdvanceCmd)
+}
+
+// loadWispTypes returns the town's wisp types, validated.
+func loadWispTypes(townRoot string) (map[string]wisp.Type, error) {
+	types := wisp.Types(loadWispSettings(townRoot))
+	for _, name := range wisp.TypeNames(types) {
+		if err := types[name].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return types, nil
+}
+
+func runWispTypes(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	types, err := loadWispTypes(townRoot)
+	if err != nil {
+		return err
+	}
+	names := wisp.TypeNames(types)
+
+	if wispTypesJSON {
+		out := make([]wisp.Type, 0, len(names))
+		for _, name := range names {
+			out = append(out, types[name])
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+	for _, name := range names {
+		t := types[name]
+		priority := "-"
+		if t.Priority != nil {
+			priority = fmt.Sprintf("P%d", *t.Priority)
+		}
+		fmt.Printf("%s  %s  %s\n", style.Bold.Render(name), priority, strings.Join(t.States, " → "))
+		if t.Description != "" {
+			fmt.Printf("    %s\n", style.Dim.Render(t.Description))
+		}
+		fmt.Printf("    %s\n", style.Dim.Render("gates: "+formatWispTypeGates(t.Gates)))
+		if t.PrimeTemplate != "" {
+			fmt.Printf("    %s\n", style.Dim.Render("prime template: "+t.PrimeTemplate))
+		}
+	}
+	return nil
+}
+
+func formatWispTypeGates(gates []string) string {
+	switch {
+	case len(gates) == 0:
+		return "none"
+	case len(gates) == 1 && gates[0] == wisp.AllGates:
+		return "all"
+	}
+	return strings.Join(gates, ", ")
+}
+
+func runWispType(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	types, err := loadWispTypes(townRoot)
+	if err != nil {
+		return err
+	}
+	beadID, typeName := args[0], args[1]
+	t, ok := types[typeName]
+	if !ok {
+		return fmt.Errorf("unknown wisp type %q (known: %s)", typeName, strings.Join(wisp.TypeNames(types), ", "))
+	}
+
+	bd := beads.New(resolveBeadDir(beadID))
+	issue, err := bd.Show(beadID)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", beadID, err)
+	}
+	opts := t.Assign(issue, wispTypeKeepPriority)
+	if err := bd.Update(beadID, opts); err != nil {
+		return fmt.Errorf("typing %s: %w", beadID, err)
+	}
+	detail := "state " + t.States[0]
+	if opts.Priority != nil {
+		detail += fmt.Sprintf(", P%d", *opts.Priority)
+	}
+	fmt.Printf("%s %s is a %s (%s)\n", style.SuccessPrefix, style.Bold.Render(beadID), t.Name, detail)
+	return nil
+}
+
+func runWispAdvance(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	types, err := loadWispTypes(townRoot)
+	if err != nil {
+		return err
+	}
+	beadID := args[0]
+	bd := beads.New(resolveBeadDir(beadID))
+	issue, err := bd.Show(beadID)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", beadID, err)
+	}
+	t, ok := wisp.TypeOf(types, issue)
+	if !ok {
+		return fmt.Errorf("%s has no wisp type (set one with gt wisp type %s <type>)", beadID, beadID)
+	}
+
+	from := t.StateOf(issue)
+	to := ""
+	if len(args) > 1 {
+		to = args[1]
+	} else if to, ok = t.NextState(from); !ok {
+		return fmt.Errorf("%s is already in %s's final state (%s)", beadID, t.Name, from)
+	}
+	opts, closeBead, err := t.Transition(issue, to)
+	if err != nil {
+		return err
+	}
+	if err := bd.Update(beadID, opts); err != nil {
+		return fmt.Errorf("advancing %s: %w", beadID, err)
+	}
+	if closeBead && issue.Status != "closed" {
+		if err := bd.CloseWithReason(fmt.Sprintf("%s: %s", t.Name, to), beadID); err != nil {
+			return fmt.Errorf("closing %s: %w", beadID, err)
+		}
+	}
+	fmt.Printf("%s %s %s → %s\n", style.SuccessPrefix, style.Bold.Render(beadID), from, to)
+	return nil
+}
+
+// outputWispTypeContext adds the hooked wisp's type workflow, and the
+// type's prime template if it has one, to the prime context.
+func outputWispTypeContext(ctx RoleContext, hookedBead *beads.Issue) {
+	if ctx.TownRoot == "" {
+		return
+	}
+	types := wisp.Types(loadWispSettings(ctx.TownRoot))
+	t, ok := wisp.TypeOf(types, hookedBead)
+	if !ok || t.Validate() != nil {
+		return
+	}
+	fmt.Printf("%s\n\n", style.Bold.Render("## Wisp Type: "+t.Name))
+	fmt.Printf("  Workflow: %s (now: %s)\n", strings.Join(t.States, " → "), t.StateOf(hookedBead))
+	fmt.Printf("  Merge gates: %s\n", formatWispTypeGates(t.Gates))
+	fmt.Printf("  Advance with: gt wisp advance %s\n\n", hookedBead.ID)
+	if t.PrimeTemplate == "" {
+		return
+	}
+	data, err := os.ReadFile(filepath.Join(ctx.TownRoot, filepath.FromSlash(t.PrimeTemplate))) //nolint:gosec // G304: path is from town config
+	if err != nil {
+		fmt.Printf("  %s prime template %s: %v\n\n", style.WarningPrefix, t.PrimeTemplate, err)
+		return
+	}
+	fmt.Println(strings.TrimRight(string(data), "\n"))
+	fmt.Println()
+}
@@ -0,0 +1,336 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/wisp"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	wispListView  string
+	wispListLabel string
+	wispListRig   string
+	wispListJSON  bool
+	wispViewsJSON bool
+)
+
+var wispLabelCmd = &cobra.Command{
+	Use:   "label <bead-id> <+label|-label>...",
+	Short: "Add or remove labels on a wisp",
+	Long: `Add or remove free-form labels on a wisp's bead.
+
+Prefix a label with + (or nothing) to add it and - to remove it. Labels
+starting with "gt:" are reserved for Gas Town and cannot be changed here.
+
+Labels drive saved views (gt wisp list --view), and can be tracked as a
+metrics dimension via wisps.metric_labels in settings/config.json.
+
+Examples:
+  gt wisp label gt-abc +frontend +urgent
+  gt wisp label gt-abc -urgent`,
+	// Flag parsing is off so "-urgent" reaches us as a label to remove.
+	DisableFlagParsing: true,
+	RunE:               runWispLabel,
+}
+
+var wispListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List in-flight wisps, optionally through a saved view",
+	Long: `List wisps that have been slung and not yet completed.
+
+Saved views are named bead queries defined in settings/config.json:
+
+  "wisps": {
+    "views": {
+      "urgent-frontend": {
+        "query": "label = frontend AND priority <= 1",
+        "description": "P0/P1 frontend work",
+        "target": "webapp"
+      }
+    }
+  }
+
+The same views are used by gt status, gt feed --view, sling routing (a
+bead matching a view with a target is slung there when no target is
+given), and the /api/wisps endpoint of gt dashboard.
+
+Examples:
+  gt wisp list
+  gt wisp list --view urgent-frontend
+  gt wisp list --label frontend --rig gastown --json`,
+	Args: cobra.NoArgs,
+	RunE: runWispList,
+}
+
+var wispViewsCmd = &cobra.Command{
+	Use:   "views",
+	Short: "Show saved wisp views",
+	Args:  cobra.NoArgs,
+	RunE:  runWispViews,
+}
+
+func init() {
+	wispListCmd.Flags().StringVar(&wispListView, "view", "", "Only wisps matching this saved view")
+	wispListCmd.Flags().StringVar(&wispListLabel, "label", "", "Only wisps with this label")
+	wispListCmd.Flags().StringVar(&wispListRig, "rig", "", "Only wisps in this rig")
+	wispListCmd.Flags().BoolVar(&wispListJSON, "json", false, "Output as JSON")
+	wispViewsCmd.Flags().BoolVar(&wispViewsJSON, "json", false, "Output as JSON")
+
+	wispCmd.AddCommand(wispLabelCmd)
+	wispCmd.AddCommand(wispListCmd)
+	wispCmd.AddCommand(wispViewsCmd)
+}
+
+// WispListItem is one in-flight wisp as shown by gt wisp list.
+type WispListItem struct {
+	ID       string    `json:"id"`
+	Title    string    `json:"title"`
+	Status   string    `json:"status"`
+	Priority int       `json:"priority"`
+	Rig      string    `json:"rig,omitempty"`
+	Agent    string    `json:"agent,omitempty"`
+	Labels   []string  `json:"labels,omitempty"`
+	SlungAt  time.Time `json:"slung_at,omitempty"`
+	Views    []string  `json:"views,omitempty"`
+	issue    *beads.Issue
+}
+
+func runWispLabel(cmd *cobra.Command, args []string) error {
+	// Handle --help since DisableFlagParsing bypasses Cobra's help handling
+	if helped, err := checkHelpFlag(cmd, args); helped || err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("usage: %s", cmd.UseLine())
+	}
+	if _, err := workspace.FindFromCwdOrError(); err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	beadID := args[0]
+	add, remove, err := wisp.ParseLabelArgs(args[1:])
+	if err != nil {
+		return err
+	}
+	if err := beads.New(resolveBeadDir(beadID)).Update(beadID, beads.UpdateOptions{AddLabels: add, RemoveLabels: remove}); err != nil {
+		return fmt.Errorf("labeling %s: %w", beadID, err)
+	}
+	var changes []string
+	for _, l := range add {
+		changes = append(changes, "+"+l)
+	}
+	for _, l := range remove {
+		changes = append(changes, "-"+l)
+	}
+	fmt.Printf("%s %s %s\n", style.SuccessPrefix, style.Bold.Render(beadID), strings.Join(changes, " "))
+	return nil
+}
+
+func runWispList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	settings := loadWispSettings(townRoot)
+
+	var pred *beads.Predicate
+	if wispListView != "" {
+		if pred, _, err = wisp.CompileView(settings, wispListView); err != nil {
+			return err
+		}
+	}
+
+	items, err := collectWisps(townRoot, settings)
+	if err != nil {
+		return err
+	}
+	var out []*WispListItem
+	for _, it := range items {
+		if wispListRig != "" && it.Rig != wispListRig {
+			continue
+		}
+		if wispListLabel != "" && !beads.HasLabel(it.issue, wispListLabel) {
+			continue
+		}
+		if pred != nil && !pred.Match(it.issue, beads.QueryEnv{Rig: it.Rig}) {
+			continue
+		}
+		out = append(out, it)
+	}
+
+	if wispListJSON {
+		if out == nil {
+			out = []*WispListItem{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+	if len(out) == 0 {
+		fmt.Println("No in-flight wisps.")
+		return nil
+	}
+	for _, it := range out {
+		where := it.Agent
+		if where == "" {
+			where = it.Rig
+		}
+		fmt.Printf("%s  P%d  %-11s %s\n", style.Bold.Render(it.ID), it.Priority, it.Status, it.Title)
+		meta := []string{where}
+		if labels := wisp.UserLabels(it.Labels); len(labels) > 0 {
+			meta = append(meta, "labels: "+strings.Join(labels, ", "))
+		}
+		fmt.Printf("    %s\n", style.Dim.Render(strings.Join(meta, "  ")))
+	}
+	return nil
+}
+
+func runWispViews(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	settings := loadWispSettings(townRoot)
+	names := wisp.ViewNames(settings)
+
+	if wispViewsJSON {
+		views := map[string]config.WispView{}
+		if settings != nil && settings.Views != nil {
+			views = settings.Views
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(views)
+	}
+	if len(names) == 0 {
+		fmt.Println("No wisp views configured (add wisps.views to settings/config.json).")
+		return nil
+	}
+	for _, name := range names {
+		v := settings.Views[name]
+		fmt.Printf("%s  %s\n", style.Bold.Render(name), v.Query)
+		if v.Description != "" {
+			fmt.Printf("    %s\n", style.Dim.Render(v.Description))
+		}
+		if v.Target != "" {
+			fmt.Printf("    %s\n", style.Dim.Render("sling target: "+v.Target))
+		}
+		if _, _, err := wisp.CompileView(settings, name); err != nil {
+			fmt.Printf("    %s %v\n", style.WarningPrefix, err)
+		}
+	}
+	return nil
+}
+
+// loadWispSettings returns the town's wisp settings, or nil.
+func loadWispSettings(townRoot string) *config.WispSettings {
+	ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil
+	}
+	return ts.Wisps
+}
+
+// collectWisps returns the town's in-flight wisps (slung, not completed),
+// oldest first, with bead details and the saved views each one matches.
+// Wisps whose bead can no longer be read are skipped.
+func collectWisps(townRoot string, settings *config.WispSettings) ([]*WispListItem, error) {
+	evs, err := events.ReadAll(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("reading events: %w", err)
+	}
+	var open []*stats.Timeline
+	for _, t := range stats.BuildTimelines(evs) {
+		if !t.IsComplete() {
+			open = append(open, t)
+		}
+	}
+	sort.Slice(open, func(i, j int) bool { return open[i].Queued.Before(open[j].Queued) })
+
+	preds := map[string]*beads.Predicate{}
+	for _, name := range wisp.ViewNames(settings) {
+		if p, _, err := wisp.CompileView(settings, name); err == nil {
+			preds[name] = p
+		}
+	}
+
+	bd := beads.New(townRoot)
+	var items []*WispListItem
+	for _, t := range open {
+		issue, err := bd.Show(t.Bead)
+		if err != nil || issue.Status == "closed" || issue.Status == "tombstone" {
+			continue
+		}
+		it := &WispListItem{
+			ID:       issue.ID,
+			Title:    issue.Title,
+			Status:   issue.Status,
+			Priority: issue.Priority,
+			Rig:      t.Rig,
+			Agent:    t.Agent,
+			Labels:   issue.Labels,
+			SlungAt:  t.Queued,
+			issue:    issue,
+		}
+		for _, name := range wisp.ViewNames(settings) {
+			if p := preds[name]; p != nil && p.Match(issue, beads.QueryEnv{Rig: t.Rig}) {
+				it.Views = append(it.Views, name)
+			}
+		}
+		items = append(items, it)
+	}
+	return items, nil
+}
+
+// wispViewBeadIDs returns the IDs of the in-flight wisps in a saved view.
+func wispViewBeadIDs(townRoot, view string) ([]string, error) {
+	settings := loadWispSettings(townRoot)
+	if _, _, err := wisp.CompileView(settings, view); err != nil {
+		return nil, err
+	}
+	items, err := collectWisps(townRoot, settings)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, it := range items {
+		if slices.Contains(it.Views, view) {
+			ids = append(ids, it.ID)
+		}
+	}
+	return ids, nil
+}
+
+// wispViewCounts returns how many in-flight wisps each saved view matches.
+func wispViewCounts(townRoot string, settings *config.WispSettings) map[string]int {
+	names := wisp.ViewNames(settings)
+	if len(names) == 0 {
+		return nil
+	}
+	items, err := collectWisps(townRoot, settings)
+	if err != nil {
+		return nil
+	}
+	counts := make(map[string]int, len(names))
+	for _, name := range names {
+		counts[name] = 0
+	}
+	for _, it := range items {
+		for _, v := range it.Views {
+			counts[v]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,12 @@
+package cmd
+
+import "testing"
+
+func TestWispLabelCmd_RemovalIsNotAFlag(t *testing.T) {
+	if err := wispLabelCmd.ParseFlags([]string{"gt-abc", "+frontend", "-urgent"}); err != nil {
+		t.Fatalf("ParseFlags() = %v, want -urgent passed through as a label", err)
+	}
+	if err := runWispLabel(wispLabelCmd, []string{"gt-abc"}); err == nil {
+		t.Error("runWispLabel() with no labels should fail")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -170,7 +171,7 @@ func runWitnessStart(cmd *cobra.Command, args []string) error {
 
 	if err := mgr.Start(witnessForeground, witnessAgentOverride, witnessEnvOverrides); err != nil {
 		if err == witness.ErrAlreadyRunning {
-			fmt.Printf("%s Witness is already running\n", style.Dim.Render("⚠"))
+			fmt.Printf("%s Witness is already running\n", style.Dim.Render(ui.Glyph(ui.IconWarn)))
 			fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness attach' to connect"))
 			return nil
 		}
@@ -178,12 +179,12 @@ func runWitnessStart(cmd *cobra.Command, args []string) error {
 	}
 
 	if witnessForeground {
-		fmt.Printf("%s Note: Foreground mode no longer runs patrol loop\n", style.Dim.Render("⚠"))
+		fmt.Printf("%s Note: Foreground mode no longer runs patrol loop\n", style.Dim.Render(ui.Glyph(ui.IconWarn)))
 		fmt.Printf("  %s\n", style.Dim.Render("Patrol logic is now handled by mol-witness-patrol molecule"))
 		return nil
 	}
 
-	fmt.Printf("%s Witness started for %s\n", style.Bold.Render("✓"), rigName)
+	fmt.Printf("%s Witness started for %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rigName)
 	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness attach' to connect"))
 	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness status' to check progress"))
 	return nil
@@ -211,7 +212,7 @@ func runWitnessStop(cmd *cobra.Command, args []string) error {
 	// Update state file
 	if err := mgr.Stop(); err != nil {
 		if err == witness.ErrNotRunning && !running {
-			fmt.Printf("%s Witness is not running\n", style.Dim.Render("⚠"))
+			fmt.Printf("%s Witness is not running\n", style.Dim.Render(ui.Glyph(ui.IconWarn)))
 			return nil
 		}
 		// Even if manager.Stop fails, if we killed the session it's stopped
@@ -220,7 +221,7 @@ func runWitnessStop(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Printf("%s Witness stopped for %s\n", style.Bold.Render("✓"), rigName)
+	fmt.Printf("%s Witness stopped for %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rigName)
 	return nil
 }
 
@@ -269,12 +270,12 @@ func runWitnessStatus(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s Witness: %s\n\n", style.Bold.Render(AgentTypeIcons[AgentWitness]), rigName)
 
 	if running {
-		fmt.Printf("  State: %s\n", style.Bold.Render("● running"))
+		fmt.Printf("  State: %s\n", style.Bold.Render(ui.Glyph("●")+" running"))
 		if sessionInfo != nil {
 			fmt.Printf("  Session: %s\n", sessionInfo.Name)
 		}
 	} else {
-		fmt.Printf("  State: %s\n", style.Dim.Render("○ stopped"))
+		fmt.Printf("  State: %s\n", style.Dim.Render(ui.Glyph("○")+" stopped"))
 	}
 
 	// Show monitored polecats
@@ -283,7 +284,7 @@ func runWitnessStatus(cmd *cobra.Command, args []string) error {
 		fmt.Printf("    %s\n", style.Dim.Render("(none)"))
 	} else {
 		for _, p := range polecats {
-			fmt.Printf("    • %s\n", p)
+			fmt.Printf("    %s %s\n", ui.Glyph("•"), p)
 		}
 	}
 
@@ -363,7 +364,7 @@ func runWitnessRestart(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("starting witness: %w", err)
 	}
 
-	fmt.Printf("%s Witness restarted for %s\n", style.Bold.Render("✓"), rigName)
+	fmt.Printf("%s Witness restarted for %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), rigName)
 	fmt.Printf("  %s\n", style.Dim.Render("Use 'gt witness attach' to connect"))
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/wasteland"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -100,7 +101,7 @@ func runWlJoin(cmd *cobra.Command, args []string) error {
 	// This avoids failing on unrelated town-config errors for the no-op case.
 	if existing, loadErr := wasteland.LoadConfig(townRoot); loadErr == nil {
 		if existing.Upstream == upstream {
-			fmt.Printf("%s Already joined wasteland: %s\n", style.Bold.Render("⚠"), upstream)
+			fmt.Printf("%s Already joined wasteland: %s\n", style.Bold.Render(ui.Glyph(ui.IconWarn)), upstream)
 			fmt.Printf("  Handle: %s\n", existing.RigHandle)
 			fmt.Printf("  Fork: %s/%s\n", existing.ForkOrg, existing.ForkDB)
 			fmt.Printf("  Local: %s\n", existing.LocalDir)
@@ -145,7 +146,7 @@ func runWlJoin(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("\n%s Joined wasteland: %s\n", style.Bold.Render("✓"), upstream)
+	fmt.Printf("\n%s Joined wasteland: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), upstream)
 	fmt.Printf("  Handle: %s\n", cfg.RigHandle)
 	fmt.Printf("  Fork: %s/%s\n", cfg.ForkOrg, cfg.ForkDB)
 	fmt.Printf("  Local: %s\n", cfg.LocalDir)
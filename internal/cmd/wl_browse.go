@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -81,7 +82,7 @@ func runWLBrowse(cmd *cobra.Command, args []string) error {
 	if err := cloneCmd.Run(); err != nil {
 		return fmt.Errorf("cloning %s: %w\nEnsure the database exists on DoltHub: https://www.dolthub.com/%s", remote, err, remote)
 	}
-	fmt.Printf("%s Cloned successfully\n\n", style.Bold.Render("✓"))
+	fmt.Printf("%s Cloned successfully\n\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	query := buildBrowseQuery(BrowseFilter{
 		Status:   wlBrowseStatus,
@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/wasteland"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -55,7 +56,7 @@ func runWlClaim(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("%s Claimed %s\n", style.Bold.Render("✓"), wantedID)
+	fmt.Printf("%s Claimed %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), wantedID)
 	fmt.Printf("  Claimed by: %s\n", rigHandle)
 	fmt.Printf("  Title: %s\n", item.Title)
 
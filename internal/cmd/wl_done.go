@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/wasteland"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -66,7 +67,7 @@ func runWlDone(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("%s Completion submitted for %s\n", style.Bold.Render("✓"), wantedID)
+	fmt.Printf("%s Completion submitted for %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), wantedID)
 	fmt.Printf("  Completion ID: %s\n", completionID)
 	fmt.Printf("  Completed by: %s\n", rigHandle)
 	fmt.Printf("  Evidence: %s\n", wlDoneEvidence)
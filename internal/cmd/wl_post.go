@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/wasteland"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -96,7 +97,7 @@ func runWlPost(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("%s Posted wanted item: %s\n", style.Bold.Render("✓"), style.Bold.Render(item.ID))
+	fmt.Printf("%s Posted wanted item: %s\n", style.Bold.Render(ui.Glyph(ui.IconPass)), style.Bold.Render(item.ID))
 	fmt.Printf("  Title:    %s\n", item.Title)
 	if item.Project != "" {
 		fmt.Printf("  Project:  %s\n", item.Project)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/wasteland"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -78,7 +79,7 @@ func runWLSync(cmd *cobra.Command, args []string) error {
 		diffCmd.Stdout = os.Stdout
 		diffCmd.Stderr = os.Stderr
 		if err := diffCmd.Run(); err != nil {
-			fmt.Printf("%s Already up to date.\n", style.Bold.Render("✓"))
+			fmt.Printf("%s Already up to date.\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 		}
 		return nil
 	}
@@ -93,7 +94,7 @@ func runWLSync(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("pulling from upstream: %w", err)
 	}
 
-	fmt.Printf("\n%s Synced with upstream\n", style.Bold.Render("✓"))
+	fmt.Printf("\n%s Synced with upstream\n", style.Bold.Render(ui.Glyph(ui.IconPass)))
 
 	// Show summary
 	summaryQuery := `SELECT
@@ -164,6 +164,38 @@ func ApplyCostTier(settings *TownSettings, tier CostTier) error {
 	return nil
 }
 
+// ApplyCostTierToRig writes the tier's role_agents and agent presets to rig
+// settings, overriding the town's tier for that rig only. As with
+// ApplyCostTier, only tier-managed roles are modified; for the standard tier
+// they are removed, so the rig falls back to the town's assignments.
+func ApplyCostTierToRig(settings *RigSettings, tier CostTier) error {
+	roleAgents := CostTierRoleAgents(tier)
+	if roleAgents == nil {
+		return fmt.Errorf("invalid cost tier: %q (valid: %s)", tier, strings.Join(ValidCostTiers(), ", "))
+	}
+
+	if settings.RoleAgents == nil {
+		settings.RoleAgents = make(map[string]string)
+	}
+	for _, role := range TierManagedRoles {
+		if agentName := roleAgents[role]; agentName == "" {
+			delete(settings.RoleAgents, role)
+		} else {
+			settings.RoleAgents[role] = agentName
+		}
+	}
+
+	if agents := CostTierAgents(tier); len(agents) > 0 {
+		if settings.Agents == nil {
+			settings.Agents = make(map[string]*RuntimeConfig)
+		}
+		for name, rc := range agents {
+			settings.Agents[name] = rc
+		}
+	}
+	return nil
+}
+
 // GetCurrentTier infers the current cost tier from the settings' RoleAgents.
 // Returns the tier name if it matches a known tier exactly, or empty string for custom configs.
 // Only tier-managed roles are compared — non-tier custom entries are ignored.
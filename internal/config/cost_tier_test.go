@@ -447,6 +447,35 @@ func TestApplyCostTier_PreservesCustomRoleAgents(t *testing.T) {
 	})
 }
 
+func TestApplyCostTierToRig(t *testing.T) {
+	t.Parallel()
+	settings := NewRigSettings()
+	settings.RoleAgents = map[string]string{"custom-role": "custom-agent", "polecat": "claude-opus"}
+
+	if err := ApplyCostTierToRig(settings, TierBudget); err != nil {
+		t.Fatalf("ApplyCostTierToRig: %v", err)
+	}
+	if settings.RoleAgents["polecat"] != "claude-sonnet" || settings.RoleAgents["witness"] != "claude-haiku" {
+		t.Errorf("budget tier role agents = %v", settings.RoleAgents)
+	}
+	if settings.RoleAgents["custom-role"] != "custom-agent" {
+		t.Error("rig tier should preserve non-tier RoleAgents entry 'custom-role'")
+	}
+	if settings.Agents["claude-haiku"] == nil {
+		t.Error("budget tier should add the claude-haiku preset to rig agents")
+	}
+
+	if err := ApplyCostTierToRig(settings, TierStandard); err != nil {
+		t.Fatalf("ApplyCostTierToRig: %v", err)
+	}
+	if _, ok := settings.RoleAgents["polecat"]; ok {
+		t.Error("standard tier should remove the rig's tier-managed roles")
+	}
+	if err := ApplyCostTierToRig(settings, "bogus"); err == nil {
+		t.Error("expected error for invalid tier")
+	}
+}
+
 func TestTierDescription(t *testing.T) {
 	t.Parallel()
 	for _, tier := range ValidCostTiers() {
@@ -0,0 +1,189 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Effective settings are a town's configuration files layered over their
+// compiled-in defaults and flattened to dotted keys, so two configurations
+// can be compared setting by setting (gt diff-config). Keys are prefixed
+// by layer:
+//
+//	town.<path>                  settings/config.json
+//	daemon.<path>                mayor/daemon.json
+//	rig.<name>.<path>            <rig>/settings/config.json
+//	rig.<name>.env.resolved.<K>  the rig's env profile after interpolation
+//
+// Arrays are kept whole as JSON values; empty values are omitted, so an
+// absent key and an empty one compare equal.
+
+// SettingChange is one setting that differs between two flattened configs.
+// From or To is empty when the setting is only set on one side.
+type SettingChange struct {
+	Key  string `json:"key"`
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+// DefaultSettings returns the flattened compiled-in defaults for the town
+// and the named rigs.
+func DefaultSettings(rigs []string) map[string]string {
+	out := make(map[string]string)
+	flattenInto(out, "town", mustJSONMap(NewTownSettings()))
+	flattenInto(out, "daemon", mustJSONMap(NewDaemonPatrolConfig()))
+	for _, rig := range rigs {
+		flattenInto(out, "rig."+rig, mustJSONMap(NewRigSettings()))
+	}
+	return out
+}
+
+// EffectiveSettings returns the flattened effective configuration of the
+// town and the named rigs. A missing file contributes its defaults; a file
+// that cannot be parsed is an error, since its settings are unknowable.
+func EffectiveSettings(townRoot string, rigs []string) (map[string]string, error) {
+	out := make(map[string]string)
+	layers := []struct {
+		prefix   string
+		path     string
+		defaults interface{}
+	}{
+		{"town", TownSettingsPath(townRoot), NewTownSettings()},
+		{"daemon", DaemonPatrolConfigPath(townRoot), NewDaemonPatrolConfig()},
+	}
+	for _, rig := range rigs {
+		layers = append(layers, struct {
+			prefix   string
+			path     string
+			defaults interface{}
+		}{"rig." + rig, RigSettingsPath(filepath.Join(townRoot, rig)), NewRigSettings()})
+	}
+
+	for _, l := range layers {
+		merged := mustJSONMap(l.defaults)
+		data, err := os.ReadFile(l.path) //nolint:gosec // G304: path is constructed internally
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("reading %s: %w", l.path, err)
+		}
+		if err == nil {
+			var file map[string]interface{}
+			if err := json.Unmarshal(data, &file); err != nil {
+				return nil, fmt.Errorf("parsing %s: %w", l.path, err)
+			}
+			mergeJSONMaps(merged, file)
+		}
+		flattenInto(out, l.prefix, merged)
+	}
+
+	for _, rig := range rigs {
+		rigPath := filepath.Join(townRoot, rig)
+		resolved := LoadRigEnvProfile(rigPath).Resolve(rigPath, os.LookupEnv)
+		for k, v := range resolved.Vars {
+			if v != "" {
+				out["rig."+rig+".env.resolved."+k] = redactSetting(k, v)
+			}
+		}
+	}
+	return out, nil
+}
+
+// TownRigNames returns the town's registered rig names, sorted.
+func TownRigNames(townRoot string) []string {
+	cfg, err := LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(cfg.Rigs))
+	for name := range cfg.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DiffSettings lists the settings that differ between base and cur, sorted
+// by key.
+func DiffSettings(base, cur map[string]string) []SettingChange {
+	var changes []SettingChange
+	for k, v := range cur {
+		if base[k] != v {
+			changes = append(changes, SettingChange{Key: k, From: base[k], To: v})
+		}
+	}
+	for k, v := range base {
+		if _, ok := cur[k]; !ok {
+			changes = append(changes, SettingChange{Key: k, From: v})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// mustJSONMap round-trips v through JSON into a generic map. v is always
+// one of this package's config structs, which marshal cleanly.
+func mustJSONMap(v interface{}) map[string]interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("marshaling %T: %v", v, err))
+	}
+	var m map[string]interface{}
+	_ = json.Unmarshal(data, &m)
+	return m
+}
+
+// mergeJSONMaps overlays src onto dst: objects merge key by key, anything
+// else in src replaces dst's value.
+func mergeJSONMaps(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if sv, ok := v.(map[string]interface{}); ok {
+			if dv, ok := dst[k].(map[string]interface{}); ok {
+				mergeJSONMaps(dv, sv)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+func flattenInto(out map[string]string, prefix string, v interface{}) {
+	switch val := v.(type) {
+	case nil:
+	case map[string]interface{}:
+		for k, child := range val {
+			flattenInto(out, prefix+"."+k, child)
+		}
+	case string:
+		if val != "" {
+			out[prefix] = redactSetting(prefix, val)
+		}
+	case []interface{}:
+		if len(val) > 0 {
+			data, _ := json.Marshal(val)
+			out[prefix] = string(data)
+		}
+	default:
+		data, _ := json.Marshal(val)
+		out[prefix] = string(data)
+	}
+}
+
+// redactSetting replaces values of secret-looking keys with a short hash,
+// so a change is still visible without the value being printed or saved.
+func redactSetting(key, value string) string {
+	k := strings.ToUpper(key)
+	for _, word := range []string{"PASSWORD", "SECRET", "TOKEN", "API_KEY", "APIKEY"} {
+		if strings.Contains(k, word) && !strings.HasSuffix(k, "_ENV") {
+			sum := sha256.Sum256([]byte(value))
+			return "sha256:" + hex.EncodeToString(sum[:])[:12]
+		}
+	}
+	return value
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEffectiveSettings_LayersOverDefaults(t *testing.T) {
+	townRoot := t.TempDir()
+	writeFile := func(rel, content string) {
+		t.Helper()
+		p := filepath.Join(townRoot, rel)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("settings/config.json", `{"default_agent": "codex", "operational": {"session": {"claude_start_timeout": "10s"}}}`)
+	writeFile("gastown/settings/config.json", `{"env": {"vars": {"BASE": "/opt", "TOOLS": "${BASE}/tools", "API_TOKEN": "hunter2"}}}`)
+
+	defaults := DefaultSettings([]string{"gastown"})
+	current, err := EffectiveSettings(townRoot, []string{"gastown"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := current["town.default_agent"]; got != "codex" {
+		t.Errorf("town.default_agent = %q, want codex", got)
+	}
+	if got := current["town.operational.session.claude_start_timeout"]; got != "10s" {
+		t.Errorf("nested override = %q, want 10s", got)
+	}
+	// Defaults untouched by the files survive the layering.
+	if current["daemon.patrols.witness.enabled"] != defaults["daemon.patrols.witness.enabled"] {
+		t.Error("daemon defaults should carry through when mayor/daemon.json is missing")
+	}
+	if got := current["rig.gastown.env.resolved.TOOLS"]; got != "/opt/tools" {
+		t.Errorf("interpolated env = %q, want /opt/tools", got)
+	}
+	for k, v := range current {
+		if strings.Contains(v, "hunter2") {
+			t.Errorf("secret leaked in %s", k)
+		}
+	}
+
+	changes := DiffSettings(defaults, current)
+	keys := make(map[string]SettingChange)
+	for _, c := range changes {
+		keys[c.Key] = c
+	}
+	if c := keys["town.default_agent"]; c.From != "claude" || c.To != "codex" {
+		t.Errorf("default_agent change = %+v", c)
+	}
+	if _, ok := keys["daemon.patrols.witness.enabled"]; ok {
+		t.Error("unchanged default should not be reported")
+	}
+}
+
+func TestEffectiveSettings_BadFile(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "settings"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(TownSettingsPath(townRoot), []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := EffectiveSettings(townRoot, nil); err == nil {
+		t.Fatal("expected parse error")
+	}
+}
+
+func TestDiffSettings_AddedAndRemoved(t *testing.T) {
+	changes := DiffSettings(map[string]string{"a": "1", "b": "2"}, map[string]string{"b": "2", "c": "3"})
+	if len(changes) != 2 || changes[0] != (SettingChange{Key: "a", From: "1"}) || changes[1] != (SettingChange{Key: "c", To: "3"}) {
+		t.Errorf("DiffSettings = %+v", changes)
+	}
+}
@@ -60,6 +60,11 @@ type AgentEnvConfig struct {
 	// Added as gt.session to OTEL_RESOURCE_ATTRIBUTES so all Claude logs from a
 	// single GT session can be correlated, and as GT_SESSION env var.
 	SessionName string
+
+	// Experiment is the A/B experiment variant tag ("<experiment>/<variant>")
+	// assigned to this session's work. Sets GT_EXPERIMENT and is added as
+	// gt.experiment to OTEL_RESOURCE_ATTRIBUTES so outcomes can be compared.
+	Experiment string
 }
 
 // AgentEnv returns all environment variables for an agent based on the config.
@@ -161,6 +166,12 @@ func AgentEnv(cfg AgentEnvConfig) map[string]string {
 		env["GT_SESSION"] = cfg.SessionName
 	}
 
+	// Set GT_EXPERIMENT when the session's work is part of an A/B experiment,
+	// so gt prime can apply a prompt variant and telemetry can be tagged.
+	if cfg.Experiment != "" {
+		env["GT_EXPERIMENT"] = cfg.Experiment
+	}
+
 	// Set GT_AGENT when an agent override is in use.
 	// This makes the override visible via tmux show-environment so that
 	// IsAgentAlive and waitForPolecatReady use the correct process names.
@@ -247,6 +258,9 @@ func AgentEnv(cfg AgentEnvConfig) map[string]string {
 		if cfg.SessionName != "" {
 			attrs = append(attrs, "gt.session="+sanitizeOTELAttrValue(cfg.SessionName, 80))
 		}
+		if cfg.Experiment != "" {
+			attrs = append(attrs, "gt.experiment="+sanitizeOTELAttrValue(cfg.Experiment, 80))
+		}
 		if len(attrs) > 0 {
 			env["OTEL_RESOURCE_ATTRIBUTES"] = strings.Join(attrs, ",")
 		}
@@ -314,6 +328,17 @@ func AgentEnv(cfg AgentEnvConfig) map[string]string {
 		}
 	}
 
+	// Per-rig env profile (settings/config.json "env"). Problems are reported
+	// by gt doctor's rig-env-profile check rather than at every session start.
+	if cfg.Rig != "" && cfg.TownRoot != "" {
+		rigPath := filepath.Join(cfg.TownRoot, cfg.Rig)
+		if profile := LoadRigEnvProfile(rigPath); profile != nil {
+			for k, v := range profile.Resolve(rigPath, SecretLookup(cfg.TownRoot, os.LookupEnv)).Vars {
+				env[k] = v
+			}
+		}
+	}
+
 	return env
 }
 
@@ -0,0 +1,223 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// EnvProfile is a rig's session environment profile (settings/config.json
+// "env"). It is injected into every agent session started for the rig, so
+// tools that a login shell would set up (GOFLAGS, nvm's PATH, ...) are present
+// inside tmux too.
+//
+// Files are read first, then Vars, then PathPrepend. Values may reference
+// other variables as $VAR or ${VAR}; profile variables resolve before the
+// parent environment.
+type EnvProfile struct {
+	// Vars are environment variables to set.
+	Vars map[string]string `json:"vars,omitempty"`
+
+	// PathPrepend lists directories to put in front of PATH. A leading ~
+	// expands to the home directory; relative paths are relative to the rig.
+	PathPrepend []string `json:"path_prepend,omitempty"`
+
+	// Files are direnv-style env files, relative to the rig (e.g., ".envrc").
+	// Only static lines are understood: KEY=VALUE, export KEY=VALUE, and
+	// PATH_add <dir>. Anything else is skipped (gt doctor reports it); the
+	// files are never executed.
+	Files []string `json:"files,omitempty"`
+}
+
+// ResolvedEnv is the outcome of resolving an EnvProfile.
+type ResolvedEnv struct {
+	// Vars are the variables to inject, including PATH when it changed.
+	Vars map[string]string
+
+	// Problems are non-fatal issues: missing files or directories, reserved
+	// keys, and unsupported lines.
+	Problems []string
+}
+
+// reservedEnvPrefixes and reservedEnvKeys are owned by Gas Town's role
+// identity (see AgentEnv) and cannot be overridden by a profile.
+var (
+	reservedEnvPrefixes = []string{"GT_", "BD_"}
+	reservedEnvKeys     = map[string]bool{
+		"BEADS_AGENT_NAME":        true,
+		"GIT_AUTHOR_NAME":         true,
+		"GIT_CEILING_DIRECTORIES": true,
+		"CLAUDECODE":              true,
+	}
+)
+
+// IsReservedEnvKey reports whether key is managed by Gas Town and ignored in
+// env profiles.
+func IsReservedEnvKey(key string) bool {
+	if reservedEnvKeys[key] {
+		return true
+	}
+	for _, p := range reservedEnvPrefixes {
+		if strings.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadRigEnvProfile returns the env profile from a rig's settings, or nil if
+// the rig has none.
+func LoadRigEnvProfile(rigPath string) *EnvProfile {
+	settings, err := LoadRigSettings(RigSettingsPath(rigPath))
+	if err != nil || settings == nil {
+		return nil
+	}
+	return settings.Env
+}
+
+// Resolve evaluates the profile for a rig. lookup supplies the parent
+// environment (os.LookupEnv in production).
+func (p *EnvProfile) Resolve(rigPath string, lookup func(string) (string, bool)) ResolvedEnv {
+	out := ResolvedEnv{Vars: make(map[string]string)}
+	if p == nil {
+		return out
+	}
+	get := func(key string) string {
+		if v, ok := out.Vars[key]; ok {
+			return v
+		}
+		v, _ := lookup(key)
+		return v
+	}
+	set := func(key, value, source string) {
+		if IsReservedEnvKey(key) {
+			out.Problems = append(out.Problems, fmt.Sprintf("%s: %s is reserved by Gas Town, ignored", source, key))
+			return
+		}
+		out.Vars[key] = os.Expand(value, get)
+	}
+
+	var pathAdds []string
+	for _, file := range p.Files {
+		path := resolveProfilePath(rigPath, file)
+		vars, adds, unsupported, err := ParseEnvFile(path)
+		if err != nil {
+			out.Problems = append(out.Problems, fmt.Sprintf("%s: %v", file, err))
+			continue
+		}
+		for _, kv := range vars {
+			set(kv[0], kv[1], file)
+		}
+		for _, dir := range adds {
+			pathAdds = append(pathAdds, resolveProfilePath(filepath.Dir(path), dir))
+		}
+		for _, line := range unsupported {
+			out.Problems = append(out.Problems, fmt.Sprintf("%s: unsupported line skipped: %s", file, line))
+		}
+	}
+
+	keys := make([]string, 0, len(p.Vars))
+	for k := range p.Vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		set(k, p.Vars[k], "vars")
+	}
+
+	for _, dir := range p.PathPrepend {
+		pathAdds = append(pathAdds, resolveProfilePath(rigPath, dir))
+	}
+	if len(pathAdds) > 0 {
+		for _, dir := range pathAdds {
+			if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+				out.Problems = append(out.Problems, fmt.Sprintf("PATH entry %s does not exist", dir))
+			}
+		}
+		parts := append(pathAdds, filepath.SplitList(get("PATH"))...)
+		out.Vars["PATH"] = strings.Join(dedupe(parts), string(os.PathListSeparator))
+	}
+	return out
+}
+
+// resolveProfilePath expands ~ and makes relative paths relative to base.
+func resolveProfilePath(base, p string) string {
+	if p == "~" || strings.HasPrefix(p, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			p = filepath.Join(home, strings.TrimPrefix(p, "~"))
+		}
+	}
+	p = os.ExpandEnv(p)
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(base, p)
+	}
+	return filepath.Clean(p)
+}
+
+func dedupe(items []string) []string {
+	seen := make(map[string]bool, len(items))
+	out := items[:0:0]
+	for _, it := range items {
+		if it == "" || seen[it] {
+			continue
+		}
+		seen[it] = true
+		out = append(out, it)
+	}
+	return out
+}
+
+// ParseEnvFile reads a direnv/dotenv-style file without executing it.
+// Returns KEY/VALUE pairs in file order, PATH_add directories, and lines that
+// were not understood. Blank lines and comments are ignored.
+func ParseEnvFile(path string) (vars [][2]string, pathAdds []string, unsupported []string, err error) {
+	f, err := os.Open(path) //nolint:gosec // G304: path comes from rig settings
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, "PATH_add "); ok {
+			pathAdds = append(pathAdds, unquoteEnvValue(strings.TrimSpace(rest)))
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || !isEnvKey(key) || strings.ContainsAny(value, "`") || strings.Contains(value, "$(") {
+			unsupported = append(unsupported, line)
+			continue
+		}
+		vars = append(vars, [2]string{key, unquoteEnvValue(strings.TrimSpace(value))})
+	}
+	return vars, pathAdds, unsupported, scanner.Err()
+}
+
+func isEnvKey(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		if c == '_' || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (i > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func unquoteEnvValue(v string) string {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		return v[1 : len(v)-1]
+	}
+	return v
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEnvProfile_Resolve(t *testing.T) {
+	t.Parallel()
+	rigPath := t.TempDir()
+	binDir := filepath.Join(rigPath, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	envrc := `# rig env
+export GOFLAGS=-mod=mod
+NODE_VERSION="20"
+PATH_add bin
+GT_ROLE=mayor
+eval "$(nvm env)"
+`
+	if err := os.WriteFile(filepath.Join(rigPath, ".envrc"), []byte(envrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	profile := &EnvProfile{
+		Vars:        map[string]string{"NODE_HOME": "/opt/node/${NODE_VERSION}"},
+		PathPrepend: []string{"missing"},
+		Files:       []string{".envrc"},
+	}
+	lookup := func(key string) (string, bool) {
+		if key == "PATH" {
+			return "/usr/bin", true
+		}
+		return "", false
+	}
+	res := profile.Resolve(rigPath, lookup)
+
+	for key, want := range map[string]string{
+		"GOFLAGS":      "-mod=mod",
+		"NODE_VERSION": "20",
+		"NODE_HOME":    "/opt/node/20",
+		"PATH":         strings.Join([]string{binDir, filepath.Join(rigPath, "missing"), "/usr/bin"}, string(os.PathListSeparator)),
+	} {
+		if got := res.Vars[key]; got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+	if _, ok := res.Vars["GT_ROLE"]; ok {
+		t.Error("reserved GT_ROLE must not be overridden")
+	}
+	if len(res.Problems) != 3 {
+		t.Errorf("Problems = %q, want reserved key, unsupported line, missing PATH entry", res.Problems)
+	}
+}
+
+func TestAgentEnv_AppliesRigEnvProfile(t *testing.T) {
+	t.Parallel()
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	settings := NewRigSettings()
+	settings.Env = &EnvProfile{Vars: map[string]string{"GOFLAGS": "-count=1", "BD_ACTOR": "nope"}}
+	if err := SaveRigSettings(RigSettingsPath(rigPath), settings); err != nil {
+		t.Fatal(err)
+	}
+
+	env := AgentEnv(AgentEnvConfig{Role: "polecat", Rig: "gastown", AgentName: "Toast", TownRoot: townRoot})
+	if env["GOFLAGS"] != "-count=1" {
+		t.Errorf("GOFLAGS = %q, want -count=1", env["GOFLAGS"])
+	}
+	if env["BD_ACTOR"] != "gastown/polecats/Toast" {
+		t.Errorf("BD_ACTOR = %q, profile must not override it", env["BD_ACTOR"])
+	}
+}
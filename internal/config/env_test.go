@@ -156,6 +156,22 @@ func TestAgentEnv_WithoutRuntimeConfigDir(t *testing.T) {
 	assertNotSet(t, env, "CLAUDE_CONFIG_DIR")
 }
 
+func TestAgentEnv_WithExperiment(t *testing.T) {
+	t.Parallel()
+	env := AgentEnv(AgentEnvConfig{
+		Role:       "polecat",
+		Rig:        "myrig",
+		AgentName:  "Toast",
+		TownRoot:   "/town",
+		Experiment: "cheap/haiku",
+	})
+
+	assertEnv(t, env, "GT_EXPERIMENT", "cheap/haiku")
+	if attrs, ok := env["OTEL_RESOURCE_ATTRIBUTES"]; ok && !strings.Contains(attrs, "gt.experiment=cheap/haiku") {
+		t.Errorf("OTEL_RESOURCE_ATTRIBUTES = %q, want gt.experiment tag", attrs)
+	}
+}
+
 func TestAgentEnvSimple(t *testing.T) {
 	t.Parallel()
 	env := AgentEnvSimple("polecat", "myrig", "Toast")
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// FailoverState tracks provider errors and active failovers by agent name
+// (.runtime/failover/state.json). It is written by gt failover check and
+// read whenever a role's agent is resolved.
+type FailoverState struct {
+	Agents map[string]*AgentFailover `json:"agents"`
+
+	// Panes records what each session's pane showed at the last scan, so
+	// an error still on screen is not counted again.
+	Panes map[string]*PaneSeen `json:"panes,omitempty"`
+}
+
+// PaneSeen is the bottom of a session's pane at the last scan, as hashes.
+type PaneSeen struct {
+	Tail   string   `json:"tail"`             // Hash of the checked lines
+	Errors []string `json:"errors,omitempty"` // Hashes of the error lines among them
+}
+
+// AgentFailover is one agent's error streak and, while failed over, the
+// agent its new sessions use instead.
+type AgentFailover struct {
+	Streak      int       `json:"streak"`                  // Consecutive scans that saw new errors
+	LastError   string    `json:"last_error,omitempty"`    // Most recent matched pane line
+	LastErrorAt time.Time `json:"last_error_at,omitempty"` // When errors were last seen
+	HealthyAt   time.Time `json:"healthy_at,omitempty"`    // Last sign the agent works again
+	Fallback    string    `json:"fallback,omitempty"`      // Set while failed over
+	Since       time.Time `json:"since,omitempty"`         // When the failover began
+}
+
+// Active reports whether the agent is failed over.
+func (a *AgentFailover) Active() bool {
+	return a != nil && a.Fallback != ""
+}
+
+// FailoverStatePath returns the failover state file.
+func FailoverStatePath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "failover", "state.json")
+}
+
+// LoadFailoverState reads the failover state; a missing file is an empty state.
+func LoadFailoverState(townRoot string) (*FailoverState, error) {
+	st := &FailoverState{}
+	data, err := os.ReadFile(FailoverStatePath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading failover state: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, st); err != nil {
+			return nil, fmt.Errorf("parsing failover state: %w", err)
+		}
+	}
+	if st.Agents == nil {
+		st.Agents = map[string]*AgentFailover{}
+	}
+	return st, nil
+}
+
+// withFailover swaps rc for its fallback agent while rc's agent is failed
+// over. Unresolvable fallbacks leave rc unchanged.
+func withFailover(rc *RuntimeConfig, townRoot, rigPath string) *RuntimeConfig {
+	if rc == nil || rc.ResolvedAgent == "" || townRoot == "" {
+		return rc
+	}
+	st, err := LoadFailoverState(townRoot)
+	if err != nil {
+		return rc
+	}
+	af := st.Agents[rc.ResolvedAgent]
+	if !af.Active() {
+		return rc
+	}
+
+	townSettings, err := LoadOrCreateTownSettings(TownSettingsPath(townRoot))
+	if err != nil {
+		townSettings = NewTownSettings()
+	}
+	var rigSettings *RigSettings
+	if rigPath != "" {
+		rigSettings, _ = LoadRigSettings(RigSettingsPath(rigPath))
+	}
+	fallback := lookupAgentConfigIfExists(af.Fallback, townSettings, rigSettings)
+	if fallback == nil {
+		fmt.Fprintf(os.Stderr, "warning: failover agent %q for %s not found, keeping %s\n", af.Fallback, rc.ResolvedAgent, rc.ResolvedAgent)
+		return rc
+	}
+	fallback.ResolvedAgent = af.Fallback
+	return fallback
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestResolveRoleAgentConfig_Failover(t *testing.T) {
+	t.Parallel()
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "testrig")
+
+	settings := NewTownSettings()
+	settings.RoleAgents = map[string]string{"polecat": "claude-opus"}
+	settings.Agents = map[string]*RuntimeConfig{
+		"claude-opus":   {Command: "claude", Args: []string{"--model", "opus"}},
+		"claude-sonnet": {Command: "claude", Args: []string{"--model", "sonnet"}},
+	}
+	if err := SaveTownSettings(TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatalf("SaveTownSettings: %v", err)
+	}
+
+	if rc := ResolveRoleAgentConfig("polecat", townRoot, rigPath); rc.ResolvedAgent != "claude-opus" {
+		t.Fatalf("before failover ResolvedAgent = %q, want claude-opus", rc.ResolvedAgent)
+	}
+
+	st := &FailoverState{Agents: map[string]*AgentFailover{
+		"claude-opus": {Streak: 3, Fallback: "claude-sonnet", Since: time.Now()},
+	}}
+	data, err := json.Marshal(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := FailoverStatePath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rc := ResolveRoleAgentConfig("polecat", townRoot, rigPath)
+	if rc.ResolvedAgent != "claude-sonnet" || !slices.Contains(rc.Args, "sonnet") {
+		t.Errorf("during failover got agent %q args %v, want claude-sonnet", rc.ResolvedAgent, rc.Args)
+	}
+	if rc := ResolveRoleAgentConfig("mayor", townRoot, ""); rc.ResolvedAgent == "claude-sonnet" {
+		t.Error("failover leaked into a role that doesn't use the failed agent")
+	}
+}
@@ -0,0 +1,104 @@
+package config
+
+import "fmt"
+
+// Input guard actions.
+const (
+	InputGuardBlock   = "block"
+	InputGuardConfirm = "confirm"
+	InputGuardOff     = "off"
+)
+
+// DefaultInputGuardRules returns the built-in dangerous-input rules.
+func DefaultInputGuardRules() []InputGuardRule {
+	return []InputGuardRule{
+		{
+			Name:           "rm-rf-outside-worktree",
+			Pattern:        `(?i)\brm\s+(?:-\S+\s+)*?-[a-z]*(?:r[a-z]*f|f[a-z]*r)[a-z]*\b`,
+			Action:         InputGuardBlock,
+			OutsideWorkDir: true,
+			Description:    "recursive delete outside the session's worktree",
+		},
+		{
+			Name: "force-push-main",
+			Pattern: `\bgit\s+push\b[^;&|\n]*?(?:(?:\s--force\S*|\s-f\b)[^;&|\n]*\b(?:main|master)\b|` +
+				`\b(?:main|master)\b[^;&|\n]*(?:\s--force\S*|\s-f\b)|\s\+\S*\b(?:main|master)\b)`,
+			Action:      InputGuardBlock,
+			Description: "force-push to main",
+		},
+		{
+			Name:        "curl-pipe-shell",
+			Pattern:     `\b(?:curl|wget)\b[^;&\n]*\|\s*(?:sudo\s+)?(?:ba|z|da|k)?sh\b`,
+			Action:      InputGuardConfirm,
+			Description: "piping a download into a shell",
+		},
+	}
+}
+
+// EffectiveRules returns the rules the guard enforces: the built-in rules,
+// overridden or extended by the configured ones, minus any set to "off".
+// A nil config yields the built-in rules; a disabled one yields none.
+func (c *InputGuardConfig) EffectiveRules() ([]InputGuardRule, error) {
+	if c != nil && c.Disabled {
+		return nil, nil
+	}
+	rules := DefaultInputGuardRules()
+	if c == nil {
+		return rules, nil
+	}
+	for _, r := range c.Rules {
+		if r.Name == "" {
+			return nil, fmt.Errorf("input guard rule with pattern %q has no name", r.Pattern)
+		}
+		switch r.Action {
+		case "", InputGuardBlock, InputGuardConfirm, InputGuardOff:
+		default:
+			return nil, fmt.Errorf("input guard rule %q: invalid action %q (want block, confirm, or off)", r.Name, r.Action)
+		}
+		replaced := false
+		for i := range rules {
+			if rules[i].Name != r.Name {
+				continue
+			}
+			// Overrides inherit what they leave out, so {"name": ..., "action":
+			// "confirm"} just softens a built-in rule.
+			if r.Pattern == "" {
+				r.Pattern = rules[i].Pattern
+				r.OutsideWorkDir = rules[i].OutsideWorkDir
+			}
+			if r.Description == "" {
+				r.Description = rules[i].Description
+			}
+			rules[i] = r
+			replaced = true
+		}
+		if !replaced {
+			if r.Pattern == "" && r.Action != InputGuardOff {
+				return nil, fmt.Errorf("input guard rule %q has no pattern", r.Name)
+			}
+			rules = append(rules, r)
+		}
+	}
+
+	effective := rules[:0]
+	for _, r := range rules {
+		if r.Action == InputGuardOff {
+			continue
+		}
+		if r.Action == "" {
+			r.Action = InputGuardBlock
+		}
+		effective = append(effective, r)
+	}
+	return effective, nil
+}
+
+// LoadInputGuardConfig reads the input guard settings from town settings.
+// A missing settings file yields nil (built-in rules).
+func LoadInputGuardConfig(townRoot string) (*InputGuardConfig, error) {
+	settings, err := LoadOrCreateTownSettings(TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return settings.InputGuard, nil
+}
@@ -0,0 +1,54 @@
+package config
+
+import "testing"
+
+func TestInputGuardEffectiveRules(t *testing.T) {
+	var nilCfg *InputGuardConfig
+	rules, err := nilCfg.EffectiveRules()
+	if err != nil || len(rules) != len(DefaultInputGuardRules()) {
+		t.Fatalf("nil config: %d rules, %v", len(rules), err)
+	}
+
+	if rules, _ := (&InputGuardConfig{Disabled: true}).EffectiveRules(); len(rules) != 0 {
+		t.Errorf("disabled guard should have no rules, got %d", len(rules))
+	}
+
+	cfg := &InputGuardConfig{Rules: []InputGuardRule{
+		{Name: "force-push-main", Action: InputGuardConfirm},
+		{Name: "curl-pipe-shell", Action: InputGuardOff},
+		{Name: "drop-table", Pattern: `(?i)drop\s+table`},
+	}}
+	rules, err = cfg.EffectiveRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := make(map[string]InputGuardRule)
+	for _, r := range rules {
+		byName[r.Name] = r
+	}
+	if _, ok := byName["curl-pipe-shell"]; ok {
+		t.Error("rule set to off should be dropped")
+	}
+	fp := byName["force-push-main"]
+	if fp.Action != InputGuardConfirm || fp.Pattern == "" || fp.Description == "" {
+		t.Errorf("override should keep built-in pattern and description: %+v", fp)
+	}
+	if r := byName["drop-table"]; r.Action != InputGuardBlock {
+		t.Errorf("custom rule action = %q, want block", r.Action)
+	}
+	if !byName["rm-rf-outside-worktree"].OutsideWorkDir {
+		t.Error("untouched built-in rule should be kept")
+	}
+}
+
+func TestInputGuardEffectiveRules_Invalid(t *testing.T) {
+	for name, cfg := range map[string]*InputGuardConfig{
+		"no name":    {Rules: []InputGuardRule{{Pattern: "x"}}},
+		"no pattern": {Rules: []InputGuardRule{{Name: "custom"}}},
+		"bad action": {Rules: []InputGuardRule{{Name: "custom", Pattern: "x", Action: "warn"}}},
+	} {
+		if _, err := cfg.EffectiveRules(); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
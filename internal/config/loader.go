@@ -470,6 +470,11 @@ func validateDaemonPatrolConfig(c *DaemonPatrolConfig) error {
 	if c.Version > CurrentDaemonPatrolConfigVersion {
 		return fmt.Errorf("%w: got %d, max supported %d", ErrInvalidVersion, c.Version, CurrentDaemonPatrolConfigVersion)
 	}
+	for name, p := range c.Patrols {
+		if err := p.PatrolAgentOptions.Validate(); err != nil {
+			return fmt.Errorf("patrols.%s: %w", name, err)
+		}
+	}
 	return nil
 }
 
@@ -1196,6 +1201,9 @@ func lookupAgentConfigIfExists(name string, townSettings *TownSettings, rigSetti
 //  2. Town's RoleAgents[role] - if set, look up that agent
 //  3. Fall back to ResolveAgentConfig (rig's Agent → town's DefaultAgent → "claude")
 //
+// While the resolved agent is failed over (gt failover), its fallback agent
+// is returned instead.
+//
 // If a configured agent is not found or its binary doesn't exist, a warning is
 // printed to stderr and it falls back to the default agent.
 //
@@ -1206,6 +1214,8 @@ func ResolveRoleAgentConfig(role, townRoot, rigPath string) *RuntimeConfig {
 	resolveConfigMu.Lock()
 	defer resolveConfigMu.Unlock()
 	rc := resolveRoleAgentConfigCore(role, townRoot, rigPath)
+	rc = withFailover(rc, townRoot, rigPath)
+	rc = withPatrolAgentOptions(rc, role, townRoot)
 	return withRoleSettingsFlag(rc, role, rigPath)
 }
 
@@ -1231,14 +1241,14 @@ func ResolveWorkerAgentConfig(workerName, townRoot, rigPath string) *RuntimeConf
 				_ = LoadRigAgentRegistry(RigAgentRegistryPath(rigPath))
 				if rc := lookupCustomAgentConfig(agentName, townSettings, rigSettings); rc != nil {
 					rc.ResolvedAgent = agentName
-					return withRoleSettingsFlag(rc, "crew", rigPath)
+					return withRoleSettingsFlag(withFailover(rc, townRoot, rigPath), "crew", rigPath)
 				}
 				if err := ValidateAgentConfig(agentName, townSettings, rigSettings); err != nil {
 					fmt.Fprintf(os.Stderr, "warning: worker_agents[%s]=%s - %v, falling back\n", workerName, agentName, err)
 				} else {
 					rc := lookupAgentConfig(agentName, townSettings, rigSettings)
 					rc.ResolvedAgent = agentName
-					return withRoleSettingsFlag(rc, "crew", rigPath)
+					return withRoleSettingsFlag(withFailover(rc, townRoot, rigPath), "crew", rigPath)
 				}
 			}
 		}
@@ -1246,7 +1256,7 @@ func ResolveWorkerAgentConfig(workerName, townRoot, rigPath string) *RuntimeConf
 
 	// Fall back to crew role resolution (already holds lock; use core function)
 	rc := resolveRoleAgentConfigCore("crew", townRoot, rigPath)
-	return withRoleSettingsFlag(rc, "crew", rigPath)
+	return withRoleSettingsFlag(withFailover(rc, townRoot, rigPath), "crew", rigPath)
 }
 
 // isClaudeAgent returns true if the RuntimeConfig represents a Claude agent.
@@ -1645,6 +1655,19 @@ func fillRuntimeDefaults(rc *RuntimeConfig) *RuntimeConfig {
 		}
 	}
 
+	if rc.Sandbox != nil {
+		sb := *rc.Sandbox
+		sb.Mounts = append([]string(nil), rc.Sandbox.Mounts...)
+		sb.ExtraArgs = append([]string(nil), rc.Sandbox.ExtraArgs...)
+		result.Sandbox = &sb
+	}
+
+	if rc.Kubernetes != nil {
+		k := *rc.Kubernetes
+		k.Secrets = append([]string(nil), rc.Kubernetes.Secrets...)
+		result.Kubernetes = &k
+	}
+
 	// Resolve preset for data-driven defaults.
 	// Use provider if set, otherwise try to match by command name.
 	presetName := result.Provider
@@ -1930,6 +1953,7 @@ func BuildStartupCommand(envVars map[string]string, rigPath, prompt string) stri
 	for k, v := range rc.Env {
 		resolvedEnv[k] = v
 	}
+	ExpandSecretRefs(townRoot, resolvedEnv)
 
 	SanitizeAgentEnv(resolvedEnv, envVars)
 
@@ -2109,6 +2133,7 @@ func BuildStartupCommandWithAgentOverride(envVars map[string]string, rigPath, pr
 	for k, v := range rc.Env {
 		resolvedEnv[k] = v
 	}
+	ExpandSecretRefs(townRoot, resolvedEnv)
 
 	SanitizeAgentEnv(resolvedEnv, envVars)
 
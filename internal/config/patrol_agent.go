@@ -0,0 +1,197 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PatrolAgentOptions tunes the agent session a patrol runs in, so cheap models
+// can handle triage patrols while heavyweight models run refactoring ones.
+// The options are set per patrol in mayor/daemon.json, next to "agent":
+//
+//	"witness": {"enabled": true, "model": "haiku", "effort": "low",
+//	            "allowed_tools": ["Bash", "Read"], "persona_file": "mayor/witness-persona.md"}
+//
+// They apply on top of whatever agent role_agents (or a cost tier) resolves
+// for the patrol's role. Only Claude agents understand them; other runtimes
+// start unchanged, with a warning.
+type PatrolAgentOptions struct {
+	// Model selects the model (e.g., "haiku", "sonnet", "opus").
+	Model string `json:"model,omitempty"`
+
+	// Effort sets the thinking budget: "low", "medium", or "high".
+	Effort string `json:"effort,omitempty"`
+
+	// AllowedTools restricts the session to these tools.
+	AllowedTools []string `json:"allowed_tools,omitempty"`
+
+	// Persona is appended to the system prompt.
+	Persona string `json:"persona,omitempty"`
+
+	// PersonaFile is read (relative to the town root) and appended to the
+	// system prompt after Persona.
+	PersonaFile string `json:"persona_file,omitempty"`
+
+	// DryRun runs every cycle of the patrol read-only (see IsPatrolDryRun).
+	// Unlike the other options it applies to any agent runtime.
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// effortThinkingTokens maps effort levels to Claude's thinking token budget.
+var effortThinkingTokens = map[string]string{
+	"low":    "1024",
+	"medium": "8000",
+	"high":   "31999",
+}
+
+// IsZero reports whether no option is set.
+func (o PatrolAgentOptions) IsZero() bool {
+	return o.Model == "" && o.Effort == "" && len(o.AllowedTools) == 0 && o.Persona == "" && o.PersonaFile == "" && !o.DryRun
+}
+
+// patrolDryRunDisallowedTools are denied outright to dry-run Claude patrols;
+// Bash stays available for read-only commands and is vetted by the guard.
+var patrolDryRunDisallowedTools = []string{"Edit", "MultiEdit", "Write", "NotebookEdit"}
+
+// Validate checks option values.
+func (o PatrolAgentOptions) Validate() error {
+	if o.Effort != "" {
+		if _, ok := effortThinkingTokens[o.Effort]; !ok {
+			return fmt.Errorf("invalid effort %q (want low, medium, or high)", o.Effort)
+		}
+	}
+	return nil
+}
+
+// patrolRoles are the roles whose sessions are started as patrols. The patrol
+// entry in daemon.json has the same name as the role.
+var patrolRoles = map[string]bool{"deacon": true, "witness": true, "refinery": true}
+
+// LoadPatrolAgentOptions returns the agent options configured for a patrol
+// in mayor/daemon.json. Missing files or entries yield zero options.
+//
+// Entries are decoded one at a time because daemon.json also carries patrols
+// (dolt_server, wisp_reaper, ...) whose shapes differ from PatrolConfig.
+func LoadPatrolAgentOptions(townRoot, patrol string) (PatrolAgentOptions, error) {
+	data, err := os.ReadFile(DaemonPatrolConfigPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return PatrolAgentOptions{}, nil
+		}
+		return PatrolAgentOptions{}, fmt.Errorf("reading daemon patrol config: %w", err)
+	}
+	var raw struct {
+		Patrols map[string]json.RawMessage `json:"patrols"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return PatrolAgentOptions{}, fmt.Errorf("parsing daemon patrol config: %w", err)
+	}
+	entry, ok := raw.Patrols[patrol]
+	if !ok {
+		return PatrolAgentOptions{}, nil
+	}
+	var opts PatrolAgentOptions
+	if err := json.Unmarshal(entry, &opts); err != nil {
+		return PatrolAgentOptions{}, fmt.Errorf("parsing patrols.%s: %w", patrol, err)
+	}
+	if err := opts.Validate(); err != nil {
+		return PatrolAgentOptions{}, fmt.Errorf("patrols.%s: %w", patrol, err)
+	}
+	return opts, nil
+}
+
+// withPatrolAgentOptions applies a patrol role's configured agent options to
+// rc. Non-patrol roles and unconfigured patrols are returned unchanged.
+func withPatrolAgentOptions(rc *RuntimeConfig, role, townRoot string) *RuntimeConfig {
+	if rc == nil || townRoot == "" || !patrolRoles[role] {
+		return rc
+	}
+	opts, err := LoadPatrolAgentOptions(townRoot, role)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: %v, ignoring patrol agent options\n", err)
+		return rc
+	}
+	if opts.IsZero() {
+		return rc
+	}
+	if opts.DryRun {
+		rc = withPatrolDryRunEnv(rc)
+	}
+	if !isClaudeAgent(rc) {
+		claudeOnly := opts
+		claudeOnly.DryRun = false
+		if !claudeOnly.IsZero() {
+			fmt.Fprintf(os.Stderr, "warning: patrols.%s agent options only apply to Claude agents, ignoring for %s\n", role, rc.Command)
+		}
+		return rc
+	}
+
+	persona := opts.Persona
+	if opts.PersonaFile != "" {
+		path := opts.PersonaFile
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(townRoot, path)
+		}
+		data, err := os.ReadFile(path) //nolint:gosec // G304: path is operator-configured
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: patrols.%s persona_file: %v\n", role, err)
+		} else if text := strings.TrimSpace(string(data)); text != "" {
+			if persona != "" {
+				persona += "\n\n"
+			}
+			persona += text
+		}
+	}
+
+	// Copy what we modify: rc may share Args/Env with a registry preset.
+	out := *rc
+	args := make([]string, 0, len(rc.Args)+6)
+	for i := 0; i < len(rc.Args); i++ {
+		arg := rc.Args[i]
+		if opts.Model != "" && (arg == "--model" || arg == "-m") && i+1 < len(rc.Args) {
+			i++ // drop the preset's model in favour of the patrol's
+			continue
+		}
+		if opts.Model != "" && strings.HasPrefix(arg, "--model=") {
+			continue
+		}
+		args = append(args, arg)
+	}
+	if opts.Model != "" {
+		args = append(args, "--model", opts.Model)
+	}
+	if len(opts.AllowedTools) > 0 {
+		args = append(args, "--allowedTools", strings.Join(opts.AllowedTools, ","))
+	}
+	if opts.DryRun {
+		args = append(args, "--disallowedTools", strings.Join(patrolDryRunDisallowedTools, ","))
+	}
+	if persona != "" {
+		args = append(args, "--append-system-prompt", persona)
+	}
+	out.Args = args
+
+	if opts.Effort != "" {
+		env := make(map[string]string, len(rc.Env)+1)
+		for k, v := range out.Env {
+			env[k] = v
+		}
+		env["MAX_THINKING_TOKENS"] = effortThinkingTokens[opts.Effort]
+		out.Env = env
+	}
+	return &out
+}
+
+// withPatrolDryRunEnv returns a copy of rc with PatrolDryRunEnv set.
+func withPatrolDryRunEnv(rc *RuntimeConfig) *RuntimeConfig {
+	out := *rc
+	out.Env = make(map[string]string, len(rc.Env)+1)
+	for k, v := range rc.Env {
+		out.Env[k] = v
+	}
+	out.Env[PatrolDryRunEnv] = "1"
+	return &out
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func writePatrolConfig(t *testing.T, townRoot, body string) {
+	t.Helper()
+	path := DaemonPatrolConfigPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolveRoleAgentConfig_PatrolAgentOptions(t *testing.T) {
+	t.Parallel()
+	townRoot := t.TempDir()
+	if err := os.WriteFile(filepath.Join(townRoot, "persona.md"), []byte("Be terse.\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// dolt_remotes has a numeric interval; it must not break decoding.
+	writePatrolConfig(t, townRoot, `{
+  "type": "daemon-patrol-config",
+  "version": 1,
+  "patrols": {
+    "deacon": {"enabled": true, "agent": "deacon", "model": "haiku", "effort": "low",
+               "allowed_tools": ["Bash", "Read"], "persona": "You triage.", "persona_file": "persona.md"},
+    "dolt_remotes": {"enabled": true, "interval": 900000000000}
+  }
+}`)
+
+	rc := ResolveRoleAgentConfig("deacon", townRoot, "")
+	for _, want := range [][]string{
+		{"--model", "haiku"},
+		{"--allowedTools", "Bash,Read"},
+		{"--append-system-prompt", "You triage.\n\nBe terse."},
+	} {
+		i := slices.Index(rc.Args, want[0])
+		if i < 0 || i+1 >= len(rc.Args) || rc.Args[i+1] != want[1] {
+			t.Errorf("Args = %q, want %s %q", rc.Args, want[0], want[1])
+		}
+	}
+	if rc.Env["MAX_THINKING_TOKENS"] != "1024" {
+		t.Errorf("MAX_THINKING_TOKENS = %q, want 1024", rc.Env["MAX_THINKING_TOKENS"])
+	}
+
+	// Roles without a patrol entry are untouched.
+	if rc := ResolveRoleAgentConfig("mayor", townRoot, ""); slices.Contains(rc.Args, "--model") {
+		t.Errorf("mayor Args = %q, want no --model", rc.Args)
+	}
+}
+
+func TestPatrolAgentOptions_ReplacesPresetModel(t *testing.T) {
+	t.Parallel()
+	townRoot := t.TempDir()
+	writePatrolConfig(t, townRoot, `{"patrols": {"witness": {"enabled": true, "model": "opus"}}}`)
+
+	preset := claudeHaikuPreset()
+	rc := withPatrolAgentOptions(preset, "witness", townRoot)
+	if n := slices.Index(rc.Args, "haiku"); n >= 0 {
+		t.Errorf("Args = %q, preset model should be replaced", rc.Args)
+	}
+	if i := slices.Index(rc.Args, "--model"); i < 0 || rc.Args[i+1] != "opus" {
+		t.Errorf("Args = %q, want --model opus", rc.Args)
+	}
+	if !slices.Contains(preset.Args, "haiku") {
+		t.Error("applying options must not mutate the preset")
+	}
+}
+
+func TestLoadDaemonPatrolConfig_RejectsBadEffort(t *testing.T) {
+	t.Parallel()
+	townRoot := t.TempDir()
+	writePatrolConfig(t, townRoot, `{"type": "daemon-patrol-config", "version": 1,
+  "patrols": {"refinery": {"enabled": true, "effort": "extreme"}}}`)
+
+	if _, err := LoadDaemonPatrolConfig(DaemonPatrolConfigPath(townRoot)); err == nil {
+		t.Error("LoadDaemonPatrolConfig should reject an unknown effort level")
+	}
+	rc := ResolveRoleAgentConfig("refinery", townRoot, "")
+	if _, ok := rc.Env["MAX_THINKING_TOKENS"]; ok {
+		t.Error("invalid options should be ignored at startup")
+	}
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Patrol dry runs let an operator trial an aggressive patrol: the agent is
+// primed to observe and report without changing anything, mutating tool use
+// is refused by the dry-run guard, and the cycle's report is marked advisory.
+//
+// A patrol is in dry-run mode when any of these is set:
+//   - "dry_run": true on its entry in mayor/daemon.json (every cycle)
+//   - PatrolDryRunEnv in the session environment
+//   - a run marker written by 'gt patrol new --dry-run', for that cycle's
+//     patrol wisp only
+
+// PatrolDryRunEnv marks a patrol session as dry-run. Sessions of patrols
+// configured with dry_run get it at startup.
+const PatrolDryRunEnv = "GT_PATROL_DRY_RUN"
+
+// PatrolAdvisoryLabel marks patrol reports produced by a dry run.
+const PatrolAdvisoryLabel = "gt:advisory"
+
+// patrolDryRunDir holds per-agent run markers.
+func patrolDryRunDir(townRoot string) string {
+	return filepath.Join(constants.TownRuntimePath(townRoot), "patrol-dry-run")
+}
+
+// patrolDryRunMarker returns the run marker path for a patrol agent
+// ("deacon", "<rig>/witness", "<rig>/refinery").
+func patrolDryRunMarker(townRoot, agent string) string {
+	return filepath.Join(patrolDryRunDir(townRoot), strings.ReplaceAll(agent, "/", "_"))
+}
+
+// MarkPatrolRunDryRun records that agent's current patrol cycle (wispID) is
+// a dry run. The marker lasts until ClearPatrolRunDryRun.
+func MarkPatrolRunDryRun(townRoot, agent, wispID string) error {
+	if err := os.MkdirAll(patrolDryRunDir(townRoot), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(patrolDryRunMarker(townRoot, agent), []byte(wispID+"\n"), 0644) //nolint:gosec // G306: marker is not secret
+}
+
+// ClearPatrolRunDryRun removes agent's run marker, if any.
+func ClearPatrolRunDryRun(townRoot, agent string) error {
+	if err := os.Remove(patrolDryRunMarker(townRoot, agent)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// PatrolRunDryRun returns the patrol wisp marked as a dry run for agent.
+func PatrolRunDryRun(townRoot, agent string) (wispID string, ok bool) {
+	data, err := os.ReadFile(patrolDryRunMarker(townRoot, agent)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return "", false
+	}
+	return strings.TrimSpace(string(data)), true
+}
+
+// IsPatrolDryRun reports whether the patrol agent (of the given patrol role)
+// is running in dry-run mode, from the environment, the patrol's daemon.json
+// entry, or a run marker for wispID, the agent's current patrol wisp. A
+// marker left for another cycle does not count.
+func IsPatrolDryRun(townRoot, patrol, agent, wispID string) bool {
+	if v := os.Getenv(PatrolDryRunEnv); v == "1" || strings.EqualFold(v, "true") {
+		return true
+	}
+	if townRoot == "" {
+		return false
+	}
+	if marked, ok := PatrolRunDryRun(townRoot, agent); ok && wispID != "" && marked == wispID {
+		return true
+	}
+	opts, err := LoadPatrolAgentOptions(townRoot, patrol)
+	return err == nil && opts.DryRun
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestIsPatrolDryRun(t *testing.T) {
+	t.Setenv(PatrolDryRunEnv, "")
+	townRoot := t.TempDir()
+	writePatrolConfig(t, townRoot, `{"patrols": {"refinery": {"enabled": true, "dry_run": true}}}`)
+
+	if !IsPatrolDryRun(townRoot, "refinery", "gastown/refinery", "") {
+		t.Error("configured dry_run patrol should be dry")
+	}
+	if IsPatrolDryRun(townRoot, "witness", "gastown/witness", "") {
+		t.Error("witness has no dry-run configuration")
+	}
+
+	// A run marker covers one agent's marked patrol wisp until cleared.
+	if err := MarkPatrolRunDryRun(townRoot, "gastown/witness", "gt-wisp-abc"); err != nil {
+		t.Fatal(err)
+	}
+	if id, ok := PatrolRunDryRun(townRoot, "gastown/witness"); !ok || id != "gt-wisp-abc" {
+		t.Errorf("PatrolRunDryRun = %q, %v", id, ok)
+	}
+	if !IsPatrolDryRun(townRoot, "witness", "gastown/witness", "gt-wisp-abc") || IsPatrolDryRun(townRoot, "witness", "beads/witness", "gt-wisp-abc") {
+		t.Error("run marker should apply to gastown/witness only")
+	}
+	if IsPatrolDryRun(townRoot, "witness", "gastown/witness", "gt-wisp-def") {
+		t.Error("run marker should not apply to a later patrol wisp")
+	}
+	if err := ClearPatrolRunDryRun(townRoot, "gastown/witness"); err != nil {
+		t.Fatal(err)
+	}
+	if IsPatrolDryRun(townRoot, "witness", "gastown/witness", "gt-wisp-abc") {
+		t.Error("cleared marker should end the dry run")
+	}
+	if err := ClearPatrolRunDryRun(townRoot, "gastown/witness"); err != nil {
+		t.Errorf("clearing twice: %v", err)
+	}
+
+	t.Setenv(PatrolDryRunEnv, "1")
+	if !IsPatrolDryRun(townRoot, "deacon", "deacon", "") {
+		t.Error("env should force a dry run")
+	}
+}
+
+func TestPatrolAgentOptions_DryRun(t *testing.T) {
+	t.Parallel()
+	townRoot := t.TempDir()
+	writePatrolConfig(t, townRoot, `{"patrols": {"witness": {"enabled": true, "dry_run": true}}}`)
+
+	rc := ResolveRoleAgentConfig("witness", townRoot, "")
+	if rc.Env[PatrolDryRunEnv] != "1" {
+		t.Errorf("Env[%s] = %q, want 1", PatrolDryRunEnv, rc.Env[PatrolDryRunEnv])
+	}
+	i := slices.Index(rc.Args, "--disallowedTools")
+	if i < 0 || i+1 >= len(rc.Args) || !strings.Contains(rc.Args[i+1], "Write") {
+		t.Errorf("Args = %q, want --disallowedTools with Write", rc.Args)
+	}
+}
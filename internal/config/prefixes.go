@@ -0,0 +1,160 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// TownPrefixOwner is the registry owner of town-level prefixes (hq-, hq-cv-).
+const TownPrefixOwner = "town"
+
+// ErrPrefixConflict is returned when registering a prefix that another owner
+// holds, or that was retired.
+var ErrPrefixConflict = errors.New("prefix conflict")
+
+// PrefixEntry registers one bead ID prefix in the town's prefix registry
+// (town.json "prefixes"). The registry is the authority on which rig owns a
+// prefix; routes.jsonl and rigs.json are expected to agree with it.
+type PrefixEntry struct {
+	Prefix  string   `json:"prefix"`            // Without trailing hyphen, e.g. "gt"
+	Owner   string   `json:"owner"`             // Rig name, or TownPrefixOwner
+	Retired []string `json:"retired,omitempty"` // Former prefixes; their IDs forward to Prefix
+}
+
+// registryPrefixRe validates registered prefixes.
+// NOTE: This MUST stay in sync with prefixRe in internal/beads/beads_types.go.
+var registryPrefixRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{0,19}$`)
+
+// ValidatePrefix checks a bead prefix (without trailing hyphen) is well formed.
+func ValidatePrefix(prefix string) error {
+	if !registryPrefixRe.MatchString(prefix) || strings.HasSuffix(prefix, "-") {
+		return fmt.Errorf("invalid bead prefix %q: must start with a letter and contain only letters, digits, and inner hyphens (max 20)", prefix)
+	}
+	return nil
+}
+
+// LookupPrefix returns the registry entry for prefix, and whether prefix is
+// one of the entry's retired prefixes rather than its current one.
+func (c *TownConfig) LookupPrefix(prefix string) (*PrefixEntry, bool) {
+	for i := range c.Prefixes {
+		e := &c.Prefixes[i]
+		if e.Prefix == prefix {
+			return e, false
+		}
+		for _, r := range e.Retired {
+			if r == prefix {
+				return e, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// RegisterPrefix records that owner uses prefix. Re-registering an owner's
+// own prefix is a no-op; a prefix held by another owner, or retired, is
+// ErrPrefixConflict. Reports whether the registry changed.
+func (c *TownConfig) RegisterPrefix(prefix, owner string) (bool, error) {
+	if err := ValidatePrefix(prefix); err != nil {
+		return false, err
+	}
+	if e, retired := c.LookupPrefix(prefix); e != nil {
+		switch {
+		case retired:
+			return false, fmt.Errorf("%w: %s- was retired in favor of %s-", ErrPrefixConflict, prefix, e.Prefix)
+		case e.Owner != owner:
+			return false, fmt.Errorf("%w: %s- is registered to %s", ErrPrefixConflict, prefix, e.Owner)
+		}
+		return false, nil
+	}
+	c.Prefixes = append(c.Prefixes, PrefixEntry{Prefix: prefix, Owner: owner})
+	sort.Slice(c.Prefixes, func(i, j int) bool { return c.Prefixes[i].Prefix < c.Prefixes[j].Prefix })
+	return true, nil
+}
+
+// UnregisterPrefix removes owner's registry entry for prefix, along with
+// the retired prefixes it forwards, so the prefixes can be reused. Entries
+// held by another owner are left alone. Reports whether the registry changed.
+func (c *TownConfig) UnregisterPrefix(prefix, owner string) bool {
+	for i, e := range c.Prefixes {
+		if e.Prefix == prefix && e.Owner == owner {
+			c.Prefixes = append(c.Prefixes[:i], c.Prefixes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// RetirePrefix renames old to new in the registry, keeping old as a retired
+// prefix whose IDs forward to new.
+func (c *TownConfig) RetirePrefix(old, new string) error {
+	if err := ValidatePrefix(new); err != nil {
+		return err
+	}
+	e, retired := c.LookupPrefix(old)
+	if e == nil || retired {
+		return fmt.Errorf("prefix %s- is not registered", old)
+	}
+	if other, _ := c.LookupPrefix(new); other != nil {
+		return fmt.Errorf("%w: %s- is already registered to %s", ErrPrefixConflict, new, other.Owner)
+	}
+	e.Retired = append(e.Retired, e.Prefix)
+	e.Prefix = new
+	sort.Slice(c.Prefixes, func(i, j int) bool { return c.Prefixes[i].Prefix < c.Prefixes[j].Prefix })
+	return nil
+}
+
+// ForwardID rewrites a bead ID under a retired prefix to the prefix that
+// replaced it. Other IDs are returned unchanged.
+func (c *TownConfig) ForwardID(id string) string {
+	e, prefix, retired := c.RegisteredPrefixFor(id)
+	if e == nil || !retired {
+		return id
+	}
+	return e.Prefix + id[len(prefix):]
+}
+
+// RegisteredPrefixFor returns the registry entry whose current or retired
+// prefix is the longest match for id (so hq-cv-abc matches hq-cv, not hq),
+// the matched prefix, and whether it is a retired one.
+func (c *TownConfig) RegisteredPrefixFor(id string) (*PrefixEntry, string, bool) {
+	var match *PrefixEntry
+	var prefix string
+	var retired bool
+	for i := range c.Prefixes {
+		e := &c.Prefixes[i]
+		candidates := append([]string{e.Prefix}, e.Retired...)
+		for k, p := range candidates {
+			if strings.HasPrefix(id, p+"-") && len(p) > len(prefix) {
+				match, prefix, retired = e, p, k > 0
+			}
+		}
+	}
+	return match, prefix, retired
+}
+
+// LoadTownPrefixes returns the town's prefix registry, or nil when the town
+// has no town.json or no registry yet.
+func LoadTownPrefixes(townRoot string) *TownConfig {
+	cfg, err := LoadTownConfig(constants.MayorTownPath(townRoot))
+	if err != nil || len(cfg.Prefixes) == 0 {
+		return nil
+	}
+	return cfg
+}
+
+// ForwardBeadID forwards id through the town's prefix registry (see
+// TownConfig.ForwardID). IDs are returned unchanged without a registry.
+func ForwardBeadID(townRoot, id string) string {
+	if townRoot == "" {
+		return id
+	}
+	if cfg := LoadTownPrefixes(townRoot); cfg != nil {
+		return cfg.ForwardID(id)
+	}
+	return id
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPrefixRegistry(t *testing.T) {
+	c := &TownConfig{Name: "town"}
+	for _, reg := range []struct{ prefix, owner string }{
+		{"hq", TownPrefixOwner}, {"hq-cv", TownPrefixOwner}, {"gt", "gastown"},
+	} {
+		if _, err := c.RegisterPrefix(reg.prefix, reg.owner); err != nil {
+			t.Fatalf("RegisterPrefix(%s): %v", reg.prefix, err)
+		}
+	}
+	if changed, err := c.RegisterPrefix("gt", "gastown"); err != nil || changed {
+		t.Errorf("re-registering own prefix = %v, %v; want no-op", changed, err)
+	}
+	if _, err := c.RegisterPrefix("gt", "beads"); !errors.Is(err, ErrPrefixConflict) {
+		t.Errorf("registering another rig's prefix err = %v, want ErrPrefixConflict", err)
+	}
+	if _, err := c.RegisterPrefix("bad-", "beads"); err == nil {
+		t.Error("trailing hyphen should be invalid")
+	}
+
+	if err := c.RetirePrefix("gt", "gas"); err != nil {
+		t.Fatalf("RetirePrefix: %v", err)
+	}
+	if _, err := c.RegisterPrefix("gt", "beads"); !errors.Is(err, ErrPrefixConflict) {
+		t.Errorf("registering a retired prefix err = %v, want ErrPrefixConflict", err)
+	}
+	if err := c.RetirePrefix("gas", "hq"); !errors.Is(err, ErrPrefixConflict) {
+		t.Errorf("retiring into a taken prefix err = %v, want ErrPrefixConflict", err)
+	}
+
+	for in, want := range map[string]string{
+		"gt-abc.1":  "gas-abc.1",
+		"gas-abc":   "gas-abc",
+		"hq-cv-xyz": "hq-cv-xyz",
+		"bd-123":    "bd-123",
+	} {
+		if got := c.ForwardID(in); got != want {
+			t.Errorf("ForwardID(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if e, p, retired := c.RegisteredPrefixFor("hq-cv-xyz"); e == nil || p != "hq-cv" || retired {
+		t.Errorf("RegisteredPrefixFor(hq-cv-xyz) = %v, %q, %v; want the hq-cv entry", e, p, retired)
+	}
+
+	if c.UnregisterPrefix("gas", "beads") {
+		t.Error("unregistering another owner's prefix should be a no-op")
+	}
+	if !c.UnregisterPrefix("gas", "gastown") {
+		t.Fatal("UnregisterPrefix(gas) should remove the entry")
+	}
+	if _, err := c.RegisterPrefix("gt", "beads"); err != nil {
+		t.Errorf("an unregistered entry's retired prefix should be free again: %v", err)
+	}
+}
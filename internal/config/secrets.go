@@ -0,0 +1,133 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// The secrets store keeps credentials out of town and rig config files.
+// Secrets live in mayor/secrets.env (KEY=VALUE lines, readable only by the
+// owner); env values in config refer to them as ${secret:NAME}, and the
+// reference is resolved only when a session's environment is built.
+
+// secretRefPrefix is the os.Expand variable prefix for secret references.
+const secretRefPrefix = "secret:"
+
+var secretRefPattern = regexp.MustCompile(`\$\{secret:([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// SecretsPath returns the town's secrets store.
+func SecretsPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirMayor, "secrets.env")
+}
+
+// SecretRef returns the config reference to a stored secret.
+func SecretRef(name string) string {
+	return "${" + secretRefPrefix + name + "}"
+}
+
+// LoadSecrets reads the secrets store. A missing store is empty.
+func LoadSecrets(townRoot string) (map[string]string, error) {
+	secrets := make(map[string]string)
+	vars, _, _, err := ParseEnvFile(SecretsPath(townRoot))
+	if os.IsNotExist(err) {
+		return secrets, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading secrets: %w", err)
+	}
+	for _, kv := range vars {
+		secrets[kv[0]] = kv[1]
+	}
+	return secrets, nil
+}
+
+// FormatSecrets renders a secrets store file.
+func FormatSecrets(secrets map[string]string) ([]byte, error) {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		if !isEnvKey(name) {
+			return nil, fmt.Errorf("invalid secret name %q", name)
+		}
+		if v := secrets[name]; strings.ContainsAny(v, "\n`") || strings.Contains(v, "$(") {
+			return nil, fmt.Errorf("secret %s: values may not contain newlines, backticks, or $(", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("# Gas Town secrets. Reference as ${secret:NAME} in env config.\n")
+	for _, name := range names {
+		// ParseEnvFile strips one pair of outer quotes and nothing else.
+		fmt.Fprintf(&b, "%s='%s'\n", name, secrets[name])
+	}
+	return []byte(b.String()), nil
+}
+
+// SaveSecrets writes the secrets store readable only by its owner.
+func SaveSecrets(townRoot string, secrets map[string]string) error {
+	data, err := FormatSecrets(secrets)
+	if err != nil {
+		return err
+	}
+	path := SecretsPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating secrets directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("writing secrets: %w", err)
+	}
+	// WriteFile keeps the mode of an existing file; tighten it regardless.
+	return os.Chmod(path, 0600)
+}
+
+// ExpandSecretRefs replaces ${secret:NAME} references in env values with
+// the stored secrets. Unknown secrets expand to "".
+func ExpandSecretRefs(townRoot string, env map[string]string) {
+	var secrets map[string]string
+	for k, v := range env {
+		if !strings.Contains(v, "${"+secretRefPrefix) {
+			continue
+		}
+		if secrets == nil {
+			secrets = loadSecretsOrEmpty(townRoot)
+		}
+		env[k] = secretRefPattern.ReplaceAllStringFunc(v, func(ref string) string {
+			return secrets[secretRefPattern.FindStringSubmatch(ref)[1]]
+		})
+	}
+}
+
+// SecretLookup wraps an environment lookup so ${secret:NAME} references
+// resolve from the town's secrets store. The store is read on first use.
+func SecretLookup(townRoot string, lookup func(string) (string, bool)) func(string) (string, bool) {
+	var secrets map[string]string
+	return func(key string) (string, bool) {
+		name, ok := strings.CutPrefix(key, secretRefPrefix)
+		if !ok {
+			return lookup(key)
+		}
+		if secrets == nil {
+			secrets = loadSecretsOrEmpty(townRoot)
+		}
+		v, ok := secrets[name]
+		return v, ok
+	}
+}
+
+// loadSecretsOrEmpty reads the secrets store for expansion, where a missing
+// or unreadable store leaves references empty.
+func loadSecretsOrEmpty(townRoot string) map[string]string {
+	if townRoot != "" {
+		if secrets, err := LoadSecrets(townRoot); err == nil {
+			return secrets
+		}
+	}
+	return map[string]string{}
+}
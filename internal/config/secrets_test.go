@@ -0,0 +1,60 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSecrets_RoundTripAndExpand(t *testing.T) {
+	townRoot := t.TempDir()
+	if secrets, err := LoadSecrets(townRoot); err != nil || len(secrets) != 0 {
+		t.Fatalf("LoadSecrets on empty town = %v, %v; want empty", secrets, err)
+	}
+
+	want := map[string]string{
+		"API_KEY":  "sk-abc'def ghi",
+		"DB_TOKEN": `"quoted"`,
+	}
+	if err := SaveSecrets(townRoot, want); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(SecretsPath(townRoot))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("secrets perm = %o, want 600", perm)
+	}
+	got, err := LoadSecrets(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("secret %s = %q, want %q", k, got[k], v)
+		}
+	}
+
+	env := map[string]string{
+		"ANTHROPIC_API_KEY": SecretRef("API_KEY"),
+		"MIXED":             "Bearer " + SecretRef("DB_TOKEN"),
+		"MISSING":           SecretRef("NOPE"),
+		"PLAIN":             "value",
+	}
+	ExpandSecretRefs(townRoot, env)
+	if env["ANTHROPIC_API_KEY"] != want["API_KEY"] || env["MIXED"] != "Bearer "+want["DB_TOKEN"] || env["MISSING"] != "" || env["PLAIN"] != "value" {
+		t.Errorf("ExpandSecretRefs = %v", env)
+	}
+
+	rigPath := filepath.Join(townRoot, "gastown")
+	profile := &EnvProfile{Vars: map[string]string{"KEY": SecretRef("API_KEY")}}
+	lookup := SecretLookup(townRoot, func(string) (string, bool) { return "", false })
+	if v := profile.Resolve(rigPath, lookup).Vars["KEY"]; v != want["API_KEY"] {
+		t.Errorf("profile KEY = %q, want the stored secret", v)
+	}
+
+	if err := SaveSecrets(townRoot, map[string]string{"BAD": "a\nb"}); err == nil {
+		t.Error("SaveSecrets accepted a multi-line value")
+	}
+}
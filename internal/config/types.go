@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +21,13 @@ type TownConfig struct {
 	Owner      string    `json:"owner,omitempty"`       // owner email (entity identity)
 	PublicName string    `json:"public_name,omitempty"` // public display name
 	CreatedAt  time.Time `json:"created_at"`
+
+	// LayoutVersion is the on-disk town layout version, advanced by gt migrate.
+	// Zero means the town predates layout versioning.
+	LayoutVersion int `json:"layout_version,omitempty"`
+
+	// Prefixes is the bead ID prefix registry (see PrefixEntry).
+	Prefixes []PrefixEntry `json:"prefixes,omitempty"`
 }
 
 // MayorConfig represents town-level behavioral configuration (mayor/config.json).
@@ -43,11 +51,19 @@ type TownSettings struct {
 	Version int    `json:"version"` // schema version
 
 	// CLITheme controls CLI output color scheme.
-	// Values: "dark", "light", "auto" (default).
+	// Values: "dark", "light", "auto" (default), "none", "accessible".
 	// "auto" lets the terminal emulator's background color guide the choice.
+	// "none" disables color; "accessible" also spells out status glyphs and
+	// drops decorative line drawing, for screen readers.
 	// Can be overridden by GT_THEME environment variable.
 	CLITheme string `json:"cli_theme,omitempty"`
 
+	// CLILocale sets how CLI output formats dates and times, as a locale
+	// name such as "en_US" or "de_DE". Default: the LC_ALL, LC_TIME, or LANG
+	// environment, else ISO dates and a 24-hour clock.
+	// Can be overridden by GT_LOCALE environment variable.
+	CLILocale string `json:"cli_locale,omitempty"`
+
 	// DefaultAgent is the name of the agent preset to use by default.
 	// Can be a built-in preset ("claude", "gemini", "codex", "cursor", "auggie", "amp", "opencode", "copilot")
 	// or a custom agent name defined in settings/agents.json.
@@ -81,6 +97,12 @@ type TownSettings struct {
 	// FeedCurator configures event deduplication and aggregation windows.
 	FeedCurator *FeedCuratorConfig `json:"feed_curator,omitempty"`
 
+	// EventPublisher forwards town events to an MQTT or NATS broker.
+	EventPublisher *EventPublisherConfig `json:"event_publisher,omitempty"`
+
+	// Wisps configures wisp labels: saved views and metric dimensions.
+	Wisps *WispSettings `json:"wisps,omitempty"`
+
 	// Convoy configures convoy behavior settings.
 	Convoy *ConvoyConfig `json:"convoy,omitempty"`
 
@@ -89,6 +111,10 @@ type TownSettings struct {
 	// Values: "standard", "economy", "budget", or empty for custom configs.
 	CostTier string `json:"cost_tier,omitempty"`
 
+	// Budget sets monthly cost budgets for the town and its rigs, with
+	// projected-overrun alerts and an automatic downgrade to a cheaper tier.
+	Budget *BudgetConfig `json:"budget,omitempty"`
+
 	// Scheduler configures the capacity scheduler for polecat dispatch.
 	Scheduler *capacity.SchedulerConfig `json:"scheduler,omitempty"`
 
@@ -96,6 +122,46 @@ type TownSettings struct {
 	// These were previously hardcoded as Go constants throughout the codebase.
 	// All values are optional — omitted values use compiled-in defaults.
 	Operational *OperationalConfig `json:"operational,omitempty"`
+
+	// Doctor configures gt doctor severity overrides, exit codes, and notifications.
+	Doctor *DoctorPolicyConfig `json:"doctor,omitempty"`
+
+	// Approvals configures which actions need human approval before running.
+	Approvals *ApprovalsConfig `json:"approvals,omitempty"`
+
+	// Inbox configures the overseer inbox SLA and who is notified when it lapses.
+	Inbox *InboxConfig `json:"inbox,omitempty"`
+
+	// QuietHours holds back non-critical notifications overnight and sends
+	// them as a digest when quiet hours end.
+	QuietHours *QuietHoursConfig `json:"quiet_hours,omitempty"`
+
+	// Archive moves old completed wisps, session logs, and patrol logs
+	// into compressed cold storage (gt archive).
+	Archive *ArchiveConfig `json:"archive,omitempty"`
+
+	// MailRetention bounds mailbox growth: old threads are archived,
+	// oversized mailboxes evict their oldest mail (gt mail retention).
+	MailRetention *MailRetentionConfig `json:"mail_retention,omitempty"`
+
+	// Logging configures structured log levels and outputs.
+	Logging *LoggingConfig `json:"logging,omitempty"`
+
+	// InputGuard screens text sent into agent sessions for dangerous
+	// commands (rm -rf outside the worktree, force-push to main, curl | sh).
+	InputGuard *InputGuardConfig `json:"input_guard,omitempty"`
+
+	// Failover switches new sessions to a fallback agent while an agent's
+	// provider is failing, and back once it recovers (gt failover).
+	Failover *FailoverConfig `json:"failover,omitempty"`
+
+	// Policy holds governance rules checked at decision points: slinging
+	// to a rig, running a patrol, applying a doctor fix (gt policy).
+	Policy *PolicyConfig `json:"policy,omitempty"`
+
+	// Artifacts sets how long registered artifacts (branches, patches,
+	// reports, docs) are kept after their wisp closes (gt artifact).
+	Artifacts *ArtifactsConfig `json:"artifacts,omitempty"`
 }
 
 // NewTownSettings creates a new TownSettings with defaults.
@@ -185,6 +251,142 @@ func DefaultFeedCuratorConfig() *FeedCuratorConfig {
 	}
 }
 
+// EventPublisherConfig configures the daemon's optional event publisher,
+// which forwards town events to a message broker for home-lab and fleet
+// integrations. Topics follow <prefix>/<town>/<rig>/<event-type> (MQTT) or
+// <prefix>.<town>.<rig>.<event-type> (NATS); town-level events use the rig
+// segment "town".
+type EventPublisherConfig struct {
+	// Protocol is "mqtt" or "nats".
+	Protocol string `json:"protocol"`
+
+	// URL is the broker address, e.g. "tcp://broker:1883", "mqtts://broker:8883",
+	// "nats://broker:4222", or "tls://broker:4222". Credentials may be given
+	// as URL user info.
+	URL string `json:"url"`
+
+	// TopicPrefix is the first topic segment. Default: "gastown".
+	TopicPrefix string `json:"topic_prefix,omitempty"`
+
+	// QoS is the MQTT delivery guarantee: 0 (at most once, default) or 1
+	// (at least once). Core NATS is always at most once.
+	QoS int `json:"qos,omitempty"`
+
+	// Types limits which event types are published (globs allowed).
+	// Empty publishes everything.
+	Types []string `json:"types,omitempty"`
+
+	// ClientID identifies the MQTT client. Default: "gastown-<town>".
+	ClientID string `json:"client_id,omitempty"`
+
+	// Username and PasswordEnv authenticate with the broker. The password is
+	// read from the named environment variable so it stays out of settings.
+	Username    string `json:"username,omitempty"`
+	PasswordEnv string `json:"password_env,omitempty"`
+}
+
+// Validate checks the publisher settings.
+func (c *EventPublisherConfig) Validate() error {
+	switch c.Protocol {
+	case "mqtt", "nats":
+	default:
+		return fmt.Errorf("event_publisher.protocol must be \"mqtt\" or \"nats\", got %q", c.Protocol)
+	}
+	if c.URL == "" {
+		return fmt.Errorf("event_publisher.url is required")
+	}
+	if c.QoS < 0 || c.QoS > 1 {
+		return fmt.Errorf("event_publisher.qos must be 0 or 1, got %d", c.QoS)
+	}
+	return nil
+}
+
+// MaxWispMetricLabels caps how many wisp labels may become metric
+// dimensions. Every label listed multiplies the series count of the wisp
+// histograms, so the list is truncated rather than trusted.
+const MaxWispMetricLabels = 10
+
+// WispSettings configures wisp labels and saved views.
+type WispSettings struct {
+	// Views are named bead queries over wisps, e.g.
+	// "urgent-frontend": {"query": "label = frontend AND priority <= 1"}.
+	// Views are shared by gt wisp list --view, gt status, gt feed --view,
+	// sling routing, and /api/wisps.
+	Views map[string]WispView `json:"views,omitempty"`
+
+	// MetricLabels lists the labels recorded as the "label" dimension on
+	// wisp cycle metrics. Other labels are recorded as "other". Only the
+	// first MaxWispMetricLabels entries are honored.
+	MetricLabels []string `json:"metric_labels,omitempty"`
+
+	// Types configures wisp types (bug, feature, chore, patrol-finding, or
+	// custom ones). Entries override the built-in type of the same name
+	// field by field; see gt wisp types.
+	Types map[string]WispTypeConfig `json:"types,omitempty"`
+
+	// Templates configures the templates gt wisp new scaffolds wisps from
+	// (bugfix, feature, spike, or custom ones). Entries override the
+	// built-in template of the same name field by field; see gt wisp
+	// templates.
+	Templates map[string]WispTemplateConfig `json:"templates,omitempty"`
+}
+
+// WispTypeConfig is the workflow of one wisp type.
+type WispTypeConfig struct {
+	// Description is shown by gt wisp types.
+	Description string `json:"description,omitempty"`
+
+	// States is the type's lifecycle, in order. The first state is where a
+	// wisp of this type starts; reaching the last closes its bead.
+	States []string `json:"states,omitempty"`
+
+	// Gates names the refinery quality gates a wisp of this type must pass
+	// before merging. Omitted inherits (all gates for custom types); an
+	// empty list means none; "*" means every configured gate. Only lists
+	// set here take effect: built-in types always pass every gate.
+	Gates []string `json:"gates,omitempty"`
+
+	// Priority is the priority (0-4) given to a wisp when it is typed.
+	Priority *int `json:"priority,omitempty"`
+
+	// PrimeTemplate is a town-relative markdown file added to gt prime for
+	// agents working a wisp of this type.
+	PrimeTemplate string `json:"prime_template,omitempty"`
+}
+
+// WispTemplateConfig scaffolds the description of a new wisp.
+type WispTemplateConfig struct {
+	// Description is shown by gt wisp templates.
+	Description string `json:"description,omitempty"`
+
+	// Type is the wisp type given to wisps created from the template.
+	Type string `json:"type,omitempty"`
+
+	// Sections are the description headings scaffolded for the author to
+	// fill in, ahead of the test expectations.
+	Sections []string `json:"sections,omitempty"`
+
+	// Criteria are the acceptance criteria used when none are given.
+	Criteria []string `json:"criteria,omitempty"`
+
+	// Tests are the test expectations used when none are given.
+	Tests []string `json:"tests,omitempty"`
+}
+
+// WispView is a saved wisp filter.
+type WispView struct {
+	// Query is a bead query over wisp fields and labels, e.g.
+	// "label = frontend AND priority <= 1".
+	Query string `json:"query"`
+
+	// Description is shown by gt wisp views.
+	Description string `json:"description,omitempty"`
+
+	// Target is where gt sling routes a matching bead when no target is
+	// given (a rig name or agent address). Empty leaves routing alone.
+	Target string `json:"target,omitempty"`
+}
+
 // OperationalConfig groups operational thresholds that were previously hardcoded
 // as Go constants. All fields are optional — omitted values use compiled-in defaults.
 // This enables per-town tuning without code changes (ZFC: Zero Fixed Constants).
@@ -417,6 +619,347 @@ type ConvoyConfig struct {
 	NotifyOnComplete bool `json:"notify_on_complete,omitempty"`
 }
 
+// DoctorPolicyConfig configures how gt doctor findings are graded and surfaced.
+// Severities are "info", "warning", "error", and "critical".
+type DoctorPolicyConfig struct {
+	// Severities overrides the severity of individual checks by name when they
+	// report a problem. Unlisted checks use their own severity.
+	// Example: {"patrol-hooks-wired": "critical", "stale-binary": "info"}
+	Severities map[string]string `json:"severities,omitempty"`
+
+	// ExitCodes maps severities to gt doctor exit codes; the most severe finding
+	// picks the code. Default: {"error": 1, "critical": 1}, others exit 0.
+	ExitCodes map[string]int `json:"exit_codes,omitempty"`
+
+	// Notify lists severities whose findings are mailed to the mayor.
+	// Default: none.
+	Notify []string `json:"notify,omitempty"`
+
+	// CacheTTLs overrides how long individual checks' results are reused
+	// between doctor runs (Go durations; "0" disables caching for a check).
+	// Slow checks set their own defaults; gt doctor --no-cache bypasses all.
+	// Example: {"clone-divergence": "15m", "default-branch-all-rigs": "0"}
+	CacheTTLs map[string]string `json:"cache_ttls,omitempty"`
+
+	// Groups defines composite checks: a group name mapped to the check name
+	// globs it rolls up. Entries replace built-in groups of the same name.
+	// Example: {"merge-health": ["refinery-*", "branch-policy"]}
+	Groups map[string][]string `json:"groups,omitempty"`
+
+	// NotifyGroups mails the mayor when a group's rollup reaches a severity,
+	// mapping group name to the minimum severity that notifies.
+	// Example: {"beads-health": "error"}
+	NotifyGroups map[string]string `json:"notify_groups,omitempty"`
+
+	// Autofix lets the daemon apply safe doctor fixes unattended, inside
+	// change windows (gt doctor autofix). Default: off.
+	Autofix *DoctorAutofixConfig `json:"autofix,omitempty"`
+}
+
+// DoctorAutofixConfig configures scheduled doctor fixes. Only checks whose
+// fixes are graded safe are ever applied unattended.
+type DoctorAutofixConfig struct {
+	// Enabled turns scheduled autofix on.
+	Enabled bool `json:"enabled"`
+
+	// Interval is how often the daemon runs gt doctor autofix. Default: "1h".
+	// Windows shorter than the interval may be missed.
+	Interval string `json:"interval,omitempty"`
+
+	// Timezone is the IANA zone the windows are in. Default: the host's.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Windows are the change windows fixes may be applied in. Without a
+	// window nothing is applied.
+	Windows []ChangeWindow `json:"windows,omitempty"`
+
+	// Checks limits autofix to these check name globs. Default: every
+	// check with a safe fix.
+	Checks []string `json:"checks,omitempty"`
+
+	// Notify lists the mail addresses sent a summary of applied fixes.
+	// Default: ["overseer"].
+	Notify []string `json:"notify,omitempty"`
+}
+
+// ChangeWindow is a recurring period in which changes may be made.
+type ChangeWindow struct {
+	// Days limits the window to days of the week ("mon".."sun"), naming
+	// the day the window starts. Default: every day.
+	Days []string `json:"days,omitempty"`
+
+	// Start and End ("HH:MM"); a window may cross midnight.
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ApprovalsConfig configures human approval gates.
+type ApprovalsConfig struct {
+	// Actions lists the gated actions: "force-push", "deploy", "rig-remove".
+	// Default: none.
+	Actions []string `json:"actions"`
+
+	// Timeout is how long a request waits for a decision before expiring.
+	// Default: "24h".
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// PolicyConfig configures town governance rules (gt policy).
+type PolicyConfig struct {
+	// Rules are checked in order at their decision point; the first whose
+	// condition holds decides. When none matches the action is allowed.
+	Rules []PolicyRule `json:"rules,omitempty"`
+
+	// Tests are example decisions checked by gt policy test.
+	Tests []PolicyTest `json:"tests,omitempty"`
+}
+
+// PolicyRule allows or denies an action at one decision point.
+type PolicyRule struct {
+	// Name identifies the rule in decision logs and gt policy output.
+	Name string `json:"name"`
+
+	// On is the decision point: "sling", "patrol", or "fix".
+	On string `json:"on"`
+
+	// When is the condition over the decision's input, e.g.
+	// `rig == "beads" && role == "polecat"`. Empty always matches.
+	When string `json:"when,omitempty"`
+
+	// Effect is "allow" or "deny".
+	Effect string `json:"effect"`
+
+	// Reason is shown when the rule denies an action.
+	Reason string `json:"reason,omitempty"`
+}
+
+// PolicyTest is an example decision with its expected outcome.
+type PolicyTest struct {
+	Name string `json:"name"`
+
+	// On is the decision point, as in PolicyRule.
+	On string `json:"on"`
+
+	// Input is the decision's input fields.
+	Input map[string]any `json:"input"`
+
+	// Expect is "allow" or "deny".
+	Expect string `json:"expect"`
+
+	// Rule, when set, is the rule expected to decide.
+	Rule string `json:"rule,omitempty"`
+}
+
+// InboxConfig configures the overseer inbox (gt inbox).
+type InboxConfig struct {
+	// SLA is how long an item may wait for the overseer before it escalates.
+	// Default: "4h". "0" disables SLA escalation.
+	SLA string `json:"sla,omitempty"`
+
+	// Notify lists the mail addresses told about overdue items.
+	// Default: ["overseer", "mayor/"].
+	Notify []string `json:"notify,omitempty"`
+}
+
+// BudgetConfig configures monthly cost budgets (gt costs budget). Spend is
+// month-to-date from the cost digests and log; the projection extends the
+// current run rate to the end of the month.
+type BudgetConfig struct {
+	// MonthlyUSD is the town-wide monthly budget. 0 means none.
+	MonthlyUSD float64 `json:"monthly_usd,omitempty"`
+
+	// Rigs sets monthly budgets (USD) for individual rigs.
+	Rigs map[string]float64 `json:"rigs,omitempty"`
+
+	// WarnAt lists the fractions of a budget, spent or projected, that
+	// trigger an alert (each once per month). Default: [0.5, 0.8, 1.0].
+	WarnAt []float64 `json:"warn_at,omitempty"`
+
+	// DowngradeAt is the fraction of a budget spent at which new sessions
+	// switch to DowngradeTier until the month ends. 0 disables downgrades.
+	DowngradeAt float64 `json:"downgrade_at,omitempty"`
+
+	// DowngradeTier is the cost tier applied on downgrade. Default: "budget".
+	DowngradeTier string `json:"downgrade_tier,omitempty"`
+
+	// Notify lists the mail addresses told about alerts and downgrades.
+	// Default: ["overseer", "mayor/"].
+	Notify []string `json:"notify,omitempty"`
+}
+
+// QuietHoursConfig configures per-channel quiet hours for notifications.
+// During a channel's quiet hours only critical notifications go out; the
+// rest are batched into a digest sent when the window ends.
+type QuietHoursConfig struct {
+	// Timezone is the IANA zone the windows are in (e.g., "Europe/Berlin").
+	// Default: the host's local zone.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Start and End ("HH:MM") are the default window for every channel.
+	// A window may cross midnight ("23:00"–"08:00").
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+
+	// Channels overrides the window per channel: "mail", "slack", "email",
+	// "sms". A channel with an empty window is never quiet.
+	Channels map[string]QuietWindow `json:"channels,omitempty"`
+}
+
+// QuietWindow is a daily quiet period ("HH:MM" start and end).
+type QuietWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ArchiveConfig configures when artifacts move to cold storage. Ages are
+// durations ("72h") or days ("30d"); "0" turns archival off for that kind.
+type ArchiveConfig struct {
+	// Dir is where archives and their index live. Relative paths are
+	// resolved against the town root. Default: ".runtime/archive".
+	Dir string `json:"dir,omitempty"`
+
+	// Wisps is how long a closed wisp stays in the beads database.
+	// Default: "3d". The wisp reaper never deletes a closed wisp before
+	// this age plus a day.
+	Wisps string `json:"wisps,omitempty"`
+
+	// Sessions is how long a session log stays in a rig's
+	// .runtime/session-logs. Default: "14d".
+	Sessions string `json:"sessions,omitempty"`
+
+	// Logs is how long a rotated patrol log stays in logs/ and daemon/.
+	// Default: "30d".
+	Logs string `json:"logs,omitempty"`
+}
+
+// MailRetentionConfig bounds how much mail a mailbox keeps. Ages are
+// durations ("72h") or days ("30d"); empty or zero disables a limit.
+// Limits apply per mailbox, except the archive caps, which apply to the
+// shared archive file.
+type MailRetentionConfig struct {
+	// MaxAge archives a thread once its newest message is this old.
+	MaxAge string `json:"max_age,omitempty"`
+
+	// MaxMessages is how many messages a mailbox keeps; the oldest beyond
+	// it are archived.
+	MaxMessages int `json:"max_messages,omitempty"`
+
+	// MaxBytes is a hard cap on a mailbox's subjects and bodies; the
+	// oldest messages are evicted to the archive until it fits.
+	MaxBytes int64 `json:"max_bytes,omitempty"`
+
+	// ArchiveMaxAge deletes archived messages older than this.
+	ArchiveMaxAge string `json:"archive_max_age,omitempty"`
+
+	// ArchiveMaxBytes is a hard cap on the archive file; the oldest
+	// archived messages are deleted until it fits.
+	ArchiveMaxBytes int64 `json:"archive_max_bytes,omitempty"`
+
+	// WarnPercent is how full a cap must be before gt doctor warns.
+	// Default: 80.
+	WarnPercent int `json:"warn_percent,omitempty"`
+}
+
+// LoggingConfig configures gt's structured logger.
+type LoggingConfig struct {
+	// Level is the default level for all subsystems: debug, info, warn, error.
+	// Default: "info".
+	Level string `json:"level,omitempty"`
+
+	// Levels overrides the level per subsystem, e.g. {"daemon": "debug"}.
+	Levels map[string]string `json:"levels,omitempty"`
+
+	// File is a log file for processes that have no log file of their own
+	// (the daemon always logs to daemon/daemon.log). Relative paths are
+	// resolved against the town root. Default: none.
+	File string `json:"file,omitempty"`
+
+	// OTel controls whether log records are also exported through
+	// OpenTelemetry when telemetry is enabled. Default: true.
+	OTel *bool `json:"otel,omitempty"`
+}
+
+// InputGuardConfig configures the session input guard, which scans text
+// before it is sent into an agent session. The built-in rules always apply
+// unless Disabled is set or a rule of the same name overrides them.
+type InputGuardConfig struct {
+	// Disabled turns the guard off entirely.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Rules adds rules, or replaces built-in rules of the same name.
+	// A rule with action "off" disables the built-in rule it names.
+	Rules []InputGuardRule `json:"rules,omitempty"`
+}
+
+// InputGuardRule is one dangerous-input pattern.
+type InputGuardRule struct {
+	// Name identifies the rule in audit events and telemetry.
+	Name string `json:"name"`
+
+	// Pattern is an RE2 regular expression matched against the outgoing text.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Action is "block" (default), "confirm" (block unless a human at a
+	// terminal confirms), or "off".
+	Action string `json:"action,omitempty"`
+
+	// OutsideWorkDir restricts the rule to matches whose path arguments
+	// resolve outside the target session's working directory.
+	OutsideWorkDir bool `json:"outside_workdir,omitempty"`
+
+	// Description is shown when the rule blocks.
+	Description string `json:"description,omitempty"`
+}
+
+// FailoverConfig configures provider failover. An agent fails over when
+// its sessions show new provider errors on Threshold consecutive scans; new
+// sessions then start on its fallback until no errors have been seen for
+// RecoverAfter and the agent has shown it works again.
+type FailoverConfig struct {
+	// Fallbacks maps an agent name to the agent its sessions fail over to,
+	// e.g. {"claude-opus": "claude-sonnet", "claude": "gemini"}.
+	Fallbacks map[string]string `json:"fallbacks,omitempty"`
+
+	// Threshold is how many consecutive scans must see errors before
+	// failing over. Default: 3.
+	Threshold int `json:"threshold,omitempty"`
+
+	// Interval is how often the daemon scans sessions. Default: "2m".
+	Interval string `json:"interval,omitempty"`
+
+	// RecoverAfter is how long an agent must go without errors before its
+	// failover is cleared. Default: "15m".
+	RecoverAfter string `json:"recover_after,omitempty"`
+
+	// Patterns replaces the built-in provider error patterns (RE2,
+	// matched case-insensitively against each new line at the bottom of
+	// each pane).
+	Patterns []string `json:"patterns,omitempty"`
+
+	// HealthCheck is a shell command run for each failed-over agent on
+	// every scan, with GT_AGENT set; exit 0 means the provider works again.
+	// Sessions still running the agent that print new output without errors
+	// count too. A failover is only cleared after such a signal.
+	HealthCheck string `json:"health_check,omitempty"`
+
+	// ResumeSessions also restarts running sessions that are failing on the
+	// fallback agent; they pick their work back up from their checkpoint.
+	ResumeSessions bool `json:"resume_sessions,omitempty"`
+
+	// Notify lists the mail addresses told about failovers and recoveries.
+	// Default: ["overseer"].
+	Notify []string `json:"notify,omitempty"`
+}
+
+// ArtifactsConfig holds per-kind retention for registered artifacts.
+// Ages are durations ("72h") or days ("30d"), counted from when the
+// artifact's wisp closed; "0" keeps an artifact forever.
+type ArtifactsConfig struct {
+	// Retention maps an artifact kind (branch, patch, report, doc) to how
+	// long it is kept. Defaults: branch and patch "30d", report and doc "90d".
+	Retention map[string]string `json:"retention,omitempty"`
+}
+
 // ParseDurationOrDefault parses a Go duration string, returning fallback on error or empty input.
 func ParseDurationOrDefault(s string, fallback time.Duration) time.Duration {
 	if s == "" {
@@ -456,6 +999,8 @@ type PatrolConfig struct {
 	Interval string   `json:"interval,omitempty"` // e.g., "5m"
 	Agent    string   `json:"agent,omitempty"`    // agent that runs this patrol
 	Rigs     []string `json:"rigs,omitempty"`     // rigs this patrol manages (empty = all)
+
+	PatrolAgentOptions // model, effort, tools, and persona for the patrol's agent
 }
 
 // CurrentDaemonPatrolConfigVersion is the current schema version for DaemonPatrolConfig.
@@ -560,6 +1105,86 @@ type WorkflowConfig struct {
 	DefaultFormula string `json:"default_formula,omitempty"`
 }
 
+// PrewarmConfig configures dependency pre-warm steps that run in a polecat's
+// worktree when it is spawned or reused, so the agent's first build doesn't
+// spend minutes downloading modules.
+type PrewarmConfig struct {
+	// Steps run in order in the worktree root.
+	Steps []PrewarmStep `json:"steps,omitempty"`
+
+	// SharedCache points package manager caches (npm, yarn, pip) at a cache
+	// shared by all polecats in the rig (<rig>/.runtime/prewarm-cache).
+	// Default: true. Go already shares its module cache per user.
+	SharedCache *bool `json:"shared_cache,omitempty"`
+
+	// Timeout bounds each step (Go duration string). Default: "10m".
+	Timeout string `json:"timeout,omitempty"`
+
+	// CacheMaxSize caps the shared cache (e.g., "5GB", "500MB"). gt doctor
+	// warns past the cap and --fix prunes it. Default: "10GB".
+	CacheMaxSize string `json:"cache_max_size,omitempty"`
+}
+
+// PrewarmStep is one pre-warm command.
+type PrewarmStep struct {
+	// Name identifies the step in output and metrics (e.g., "go-mod").
+	Name string `json:"name"`
+
+	// Command is run with sh -c in the worktree (e.g., "go mod download").
+	Command string `json:"command"`
+
+	// When names a file that must exist in the worktree for the step to run
+	// (e.g., "package.json"). Empty means always run.
+	When string `json:"when,omitempty"`
+
+	// Inputs are worktree files whose content decides whether a reused
+	// worktree is still warm (e.g., "go.sum", "package-lock.json"). When set
+	// and unchanged since the last successful run, the step is skipped.
+	Inputs []string `json:"inputs,omitempty"`
+}
+
+// IsSharedCache reports whether the rig-wide package cache is enabled.
+func (c *PrewarmConfig) IsSharedCache() bool {
+	return c == nil || c.SharedCache == nil || *c.SharedCache
+}
+
+// DefaultPrewarmCacheMaxBytes is the shared cache cap when none is configured.
+const DefaultPrewarmCacheMaxBytes int64 = 10 << 30
+
+// CacheMaxBytes returns the shared cache cap in bytes.
+func (c *PrewarmConfig) CacheMaxBytes() int64 {
+	if c != nil && c.CacheMaxSize != "" {
+		if n, err := ParseByteSize(c.CacheMaxSize); err == nil && n > 0 {
+			return n
+		}
+	}
+	return DefaultPrewarmCacheMaxBytes
+}
+
+// ParseByteSize parses sizes like "512", "500MB", "5GB", or "1.5G"
+// (binary units: 1KB = 1024 bytes).
+func ParseByteSize(s string) (int64, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	num := strings.TrimRight(strings.TrimSuffix(s, "B"), "KMGT")
+	unit := strings.TrimSuffix(strings.TrimPrefix(s, num), "B")
+	mult := map[string]float64{"": 1, "K": 1 << 10, "M": 1 << 20, "G": 1 << 30, "T": 1 << 40}[unit]
+	n, err := strconv.ParseFloat(strings.TrimSpace(num), 64)
+	if err != nil || mult == 0 || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return int64(n * mult), nil
+}
+
+// StepTimeout returns the per-step timeout.
+func (c *PrewarmConfig) StepTimeout() time.Duration {
+	if c != nil && c.Timeout != "" {
+		if d, err := time.ParseDuration(c.Timeout); err == nil && d > 0 {
+			return d
+		}
+	}
+	return 10 * time.Minute
+}
+
 // RigSettings represents per-rig behavioral configuration (settings/config.json).
 type RigSettings struct {
 	Type       string            `json:"type"`                  // "rig-settings"
@@ -571,6 +1196,14 @@ type RigSettings struct {
 	Workflow   *WorkflowConfig   `json:"workflow,omitempty"`    // workflow settings
 	Runtime    *RuntimeConfig    `json:"runtime,omitempty"`     // LLM runtime settings (deprecated: use Agent)
 
+	// Env is injected into every agent session started for this rig
+	// (vars, PATH additions, and direnv-style files). See EnvProfile.
+	Env *EnvProfile `json:"env,omitempty"`
+
+	// Prewarm runs dependency pre-warm steps in new and reused polecat
+	// worktrees. See PrewarmConfig.
+	Prewarm *PrewarmConfig `json:"prewarm,omitempty"`
+
 	// Agent selects which agent preset to use for this rig.
 	// Can be a built-in preset ("claude", "gemini", "codex", "cursor", "auggie", "amp", "opencode", "copilot")
 	// or a custom agent defined in settings/agents.json.
@@ -655,6 +1288,14 @@ type RuntimeConfig struct {
 	// Instructions controls the per-workspace instruction file name.
 	Instructions *RuntimeInstructionsConfig `json:"instructions,omitempty"`
 
+	// Sandbox runs the agent inside a container (Docker/Podman) instead of
+	// directly on the host. Nil means no isolation (the default).
+	Sandbox *RuntimeSandboxConfig `json:"sandbox,omitempty"`
+
+	// Kubernetes runs the agent as a Kubernetes Job instead of on the host.
+	// Mutually exclusive with Sandbox. Nil means no Kubernetes runner.
+	Kubernetes *RuntimeKubernetesConfig `json:"kubernetes,omitempty"`
+
 	// ResolvedAgent is the agent name that was resolved during config lookup.
 	// Set by ResolveRoleAgentConfig / resolveAgentConfigInternal so that
 	// BuildStartupCommand can export GT_AGENT for process detection.
@@ -709,6 +1350,82 @@ type RuntimeInstructionsConfig struct {
 	File string `json:"file,omitempty"`
 }
 
+// RuntimeSandboxConfig configures container isolation for agent sessions.
+// When set on an agent's runtime config, the session's startup command runs
+// inside a container with the town root mounted at its host path, so
+// untrusted or risky work cannot touch the rest of the host. The image must
+// provide gt, bd, and the agent CLI.
+type RuntimeSandboxConfig struct {
+	// Engine is the container CLI: "docker" or "podman". Default: "docker".
+	Engine string `json:"engine,omitempty"`
+
+	// Image is the container image providing the agent toolchain (required).
+	Image string `json:"image"`
+
+	// Network is the container network mode: "none", "bridge", or "host".
+	// Default: "host", so gt and bd inside the container reach the town's
+	// Dolt server on localhost. With "bridge" or "none" they cannot, unless
+	// the rig's beads point at a server reachable from the container.
+	Network string `json:"network,omitempty"`
+
+	// CPUs limits CPU usage (e.g., "2" or "1.5"). Empty means unlimited.
+	CPUs string `json:"cpus,omitempty"`
+
+	// Memory limits memory usage (e.g., "4g"). Empty means unlimited.
+	Memory string `json:"memory,omitempty"`
+
+	// PidsLimit caps the number of processes in the container. Zero means unlimited.
+	PidsLimit int `json:"pids_limit,omitempty"`
+
+	// ReadOnlyRoot mounts the container root filesystem read-only.
+	// The rig mount and extra mounts remain writable unless marked ":ro".
+	ReadOnlyRoot bool `json:"read_only_root,omitempty"`
+
+	// Mounts are additional bind mounts in "host:container[:ro]" form.
+	Mounts []string `json:"mounts,omitempty"`
+
+	// ExtraArgs are passed verbatim to "<engine> run" before the image name.
+	ExtraArgs []string `json:"extra_args,omitempty"`
+}
+
+// RuntimeKubernetesConfig configures the Kubernetes Job runner for agent sessions.
+// The session's tmux pane submits the Job, attaches to its pod, and streams
+// the pod log into the rig's session log directory.
+type RuntimeKubernetesConfig struct {
+	// Context is the kubectl context to use. Empty uses the current context.
+	Context string `json:"context,omitempty"`
+
+	// Namespace is where Jobs are created. Default: "default".
+	Namespace string `json:"namespace,omitempty"`
+
+	// Image is the container image providing the agent toolchain (required).
+	Image string `json:"image"`
+
+	// CPU is the CPU request and limit (e.g., "2"). Empty means unset.
+	CPU string `json:"cpu,omitempty"`
+
+	// Memory is the memory request and limit (e.g., "4Gi"). Empty means unset.
+	Memory string `json:"memory,omitempty"`
+
+	// Secrets names Kubernetes Secrets whose keys are exposed as env vars.
+	Secrets []string `json:"secrets,omitempty"`
+
+	// ServiceAccount is the pod's service account. Empty uses the namespace default.
+	ServiceAccount string `json:"service_account,omitempty"`
+
+	// HostPathRig mounts the rig via hostPath at its host path. Only useful on
+	// single-node clusters (kind, minikube) where the node shares the host disk.
+	HostPathRig bool `json:"host_path_rig,omitempty"`
+
+	// ActiveDeadline bounds the Job's runtime (e.g., "4h"). Empty means unbounded.
+	ActiveDeadline string `json:"active_deadline,omitempty"`
+
+	// TTLAfterFinished is how long finished Jobs are kept (e.g., "1h") if
+	// nothing deleted them first; a Job is normally deleted when its session
+	// pane exits or the session is stopped. Default: "1h".
+	TTLAfterFinished string `json:"ttl_after_finished,omitempty"`
+}
+
 // DefaultRuntimeConfig returns a RuntimeConfig with sensible defaults.
 func DefaultRuntimeConfig() *RuntimeConfig {
 	return normalizeRuntimeConfig(&RuntimeConfig{Provider: "claude"})
@@ -801,6 +1518,17 @@ func normalizeRuntimeConfig(rc *RuntimeConfig) *RuntimeConfig {
 		i := *rc.Instructions
 		rc.Instructions = &i
 	}
+	if rc.Sandbox != nil {
+		sb := *rc.Sandbox
+		sb.Mounts = append([]string(nil), rc.Sandbox.Mounts...)
+		sb.ExtraArgs = append([]string(nil), rc.Sandbox.ExtraArgs...)
+		rc.Sandbox = &sb
+	}
+	if rc.Kubernetes != nil {
+		k := *rc.Kubernetes
+		k.Secrets = append([]string(nil), rc.Kubernetes.Secrets...)
+		rc.Kubernetes = &k
+	}
 
 	if rc.Provider == "" {
 		rc.Provider = "claude"
@@ -14,6 +14,14 @@ const (
 	// ShutdownNotifyDelay is the pause after sending shutdown notification.
 	ShutdownNotifyDelay = 500 * time.Millisecond
 
+	// InterruptSettleTimeout bounds the wait for an interrupted agent to
+	// finish responding (see tmux.WaitForIdle) before it is sent a message.
+	InterruptSettleTimeout = 5 * time.Second
+
+	// NudgeIdleTimeout bounds how long a patrol nudge waits for its target
+	// to finish responding, so it lands between turns (see tmux.NudgeWhenIdle).
+	NudgeIdleTimeout = 15 * time.Second
+
 	// ClaudeStartTimeout is how long to wait for Claude to start in a session.
 	// Configurable via operational.session.claude_start_timeout.
 	ClaudeStartTimeout = 60 * time.Second
@@ -181,6 +189,12 @@ const (
 	BranchIntegrationPrefix = "integration/"
 )
 
+// Network ports.
+const (
+	// DashboardPort is the default port of the gt dashboard HTTP server.
+	DashboardPort = 8080
+)
+
 // Tmux session names.
 // Mayor and Deacon use hq- prefix: hq-mayor, hq-deacon (town-level, one per machine).
 // Rig-level services use gt- prefix: gt-<rig>-witness, gt-<rig>-refinery, etc.
@@ -212,6 +226,10 @@ const (
 
 	// RoleDeacon is the deacon agent role.
 	RoleDeacon = "deacon"
+
+	// MayorDeputy is the identity (GT_ROLE and BD_ACTOR) of the Mayor's
+	// standby deputy until it is promoted.
+	MayorDeputy = "mayor/deputy"
 )
 
 // Role emojis - centralized for easy customization.
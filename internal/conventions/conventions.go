@@ -0,0 +1,204 @@
+// Package conventions compiles a rig's conventions summary: a short markdown
+// digest of how the rig's repository expects work to be done, distilled from
+// its lint configs, CI workflows, contributing guides, build manifests, and
+// test layout. gt prime includes the summary for agents working in the rig,
+// so they follow the repo's conventions without rediscovering them.
+//
+// The summary is written to <rig>/.runtime/conventions.md with a state file
+// recording a hash of every source it was built from; Stale reports when any
+// source has changed (or appeared, or gone) since, which is how the
+// conventions patrol decides when to rebuild.
+package conventions
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// maxSummaryBytes caps the compiled summary so it stays a small part of the
+// prime context.
+const maxSummaryBytes = 6 * 1024
+
+// State records what a summary was built from.
+type State struct {
+	BuiltAt time.Time         `json:"built_at"`
+	Sources map[string]string `json:"sources"` // Repo-relative path -> content hash
+}
+
+// SummaryPath returns where a rig's compiled summary is written.
+func SummaryPath(rigPath string) string {
+	return filepath.Join(rigPath, constants.DirRuntime, "conventions.md")
+}
+
+// StatePath returns where a rig's build state is written.
+func StatePath(rigPath string) string {
+	return filepath.Join(rigPath, constants.DirRuntime, "conventions.json")
+}
+
+// RepoDir returns the rig checkout conventions are read from (the mayor's
+// clone, which tracks the default branch).
+func RepoDir(rigPath string) string {
+	return filepath.Join(rigPath, constants.DirMayor, constants.DirRig)
+}
+
+// Build compiles the rig's summary and writes it with its state.
+func Build(rigName, rigPath string) (*State, error) {
+	repo := RepoDir(rigPath)
+	if _, err := os.Stat(repo); err != nil {
+		return nil, fmt.Errorf("rig checkout not found: %w", err)
+	}
+	sources := findSources(repo)
+	summary := compile(rigName, repo, sources)
+
+	state := &State{BuiltAt: time.Now().UTC(), Sources: hashSources(repo, sources)}
+	if err := os.MkdirAll(filepath.Dir(SummaryPath(rigPath)), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(SummaryPath(rigPath), []byte(summary), 0644); err != nil { //nolint:gosec // G306: summary is not secret
+		return nil, fmt.Errorf("writing summary: %w", err)
+	}
+	if err := util.EnsureDirAndWriteJSON(StatePath(rigPath), state); err != nil {
+		return nil, fmt.Errorf("writing state: %w", err)
+	}
+	return state, nil
+}
+
+// Load returns the rig's compiled summary, or "" if none has been built.
+func Load(rigPath string) string {
+	data, err := os.ReadFile(SummaryPath(rigPath)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// Stale reports whether the rig's summary is missing or any of its sources
+// changed since it was built.
+func Stale(rigPath string) bool {
+	data, err := os.ReadFile(StatePath(rigPath)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return true
+	}
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return true
+	}
+	repo := RepoDir(rigPath)
+	current := hashSources(repo, findSources(repo))
+	if len(current) != len(state.Sources) {
+		return true
+	}
+	for rel, sum := range current {
+		if state.Sources[rel] != sum {
+			return true
+		}
+	}
+	return false
+}
+
+func hashSources(repo string, sources []string) map[string]string {
+	out := make(map[string]string, len(sources))
+	for _, rel := range sources {
+		data, err := os.ReadFile(filepath.Join(repo, rel)) //nolint:gosec // G304: path from the rig's own checkout
+		if err != nil {
+			continue
+		}
+		sum := sha256.Sum256(data)
+		out[filepath.ToSlash(rel)] = hex.EncodeToString(sum[:8])
+	}
+	return out
+}
+
+// sourcePatterns are the repo-relative globs conventions are compiled from.
+var sourcePatterns = []string{
+	// Manifests and build entry points
+	"go.mod", "package.json", "Cargo.toml", "pyproject.toml", "Makefile", "justfile",
+	// Lint and format configs
+	".golangci.yml", ".golangci.yaml", ".golangci.toml",
+	".eslintrc", ".eslintrc.*", "eslint.config.*", ".prettierrc", ".prettierrc.*", "biome.json",
+	"ruff.toml", ".ruff.toml", ".flake8", "rustfmt.toml", ".rustfmt.toml", "clippy.toml", ".editorconfig",
+	// CI
+	".github/workflows/*.yml", ".github/workflows/*.yaml", ".gitlab-ci.yml", ".circleci/config.yml",
+	// Guides
+	"CONTRIBUTING.md", ".github/CONTRIBUTING.md", "docs/CONTRIBUTING.md", "AGENTS.md",
+}
+
+// findSources returns the repo-relative paths of the convention sources
+// present in repo, sorted.
+func findSources(repo string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, pattern := range sourcePatterns {
+		matches, _ := filepath.Glob(filepath.Join(repo, filepath.FromSlash(pattern)))
+		for _, m := range matches {
+			if info, err := os.Stat(m); err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+			if rel, err := filepath.Rel(repo, m); err == nil && !seen[rel] {
+				seen[rel] = true
+				out = append(out, filepath.ToSlash(rel))
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// compile renders the summary from the sources present.
+func compile(rigName, repo string, sources []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Conventions for %s\n\n", rigName)
+	b.WriteString("_Compiled by gt conventions build from the rig's repo; follow these unless your task says otherwise._\n")
+
+	section := func(title string, lines []string) {
+		if len(lines) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n### %s\n\n", title)
+		for _, l := range lines {
+			fmt.Fprintf(&b, "- %s\n", l)
+		}
+	}
+
+	var tooling, lint, ci []string
+	var guides []string
+	for _, rel := range sources {
+		data, err := os.ReadFile(filepath.Join(repo, filepath.FromSlash(rel))) //nolint:gosec // G304: path from the rig's own checkout
+		if err != nil {
+			continue
+		}
+		switch kind, tool := classify(rel); kind {
+		case kindManifest:
+			tooling = append(tooling, describeManifest(rel, data)...)
+		case kindLint:
+			lint = append(lint, describeLint(rel, tool, data))
+		case kindCI:
+			ci = append(ci, describeCI(rel, data))
+		case kindGuide:
+			guides = append(guides, describeGuide(rel, data)...)
+		}
+	}
+
+	section("Tooling and commands", tooling)
+	section("Lint and format", lint)
+	section("CI runs", ci)
+	section("Patterns", patterns(repo))
+	section("From the contributing guides", guides)
+
+	out := b.String()
+	if len(out) > maxSummaryBytes {
+		cut := strings.LastIndex(out[:maxSummaryBytes], "\n")
+		out = out[:cut+1] + "- _(truncated)_\n"
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+package conventions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeRepoFile(t *testing.T, repo, rel, content string) {
+	t.Helper()
+	path := filepath.Join(repo, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildAndStale(t *testing.T) {
+	rigPath := t.TempDir()
+	repo := RepoDir(rigPath)
+	writeRepoFile(t, repo, "go.mod", "module example.com/widget\n\ngo 1.24\n")
+	writeRepoFile(t, repo, "Makefile", ".PHONY: build test\nbuild:\n\tgo build ./...\ntest: build\n\tgo test ./...\nVERSION := 1\n")
+	writeRepoFile(t, repo, ".golangci.yml", "linters:\n  enable: [errcheck]\n")
+	writeRepoFile(t, repo, ".github/workflows/ci.yml", "jobs:\n  test:\n    steps:\n      - run: make test\n      - run: |\n          golangci-lint run\n")
+	writeRepoFile(t, repo, "CONTRIBUTING.md", "# Contributing\n\nSome prose.\n\n- Keep commits small\n  - nested example\n- Wrap errors with %w\n")
+	writeRepoFile(t, repo, "widget.go", "package widget\n")
+	writeRepoFile(t, repo, "widget_test.go", "package widget\n")
+
+	if !Stale(rigPath) {
+		t.Fatal("Stale before any build = false")
+	}
+	if _, err := Build("widget", rigPath); err != nil {
+		t.Fatal(err)
+	}
+	summary := Load(rigPath)
+	for _, want := range []string{
+		"## Conventions for widget",
+		"Go module `example.com/widget` (go 1.24)",
+		"make targets: `build`, `test`",
+		"golangci-lint is configured (`.golangci.yml`)",
+		"`.github/workflows/ci.yml`: `make test`, `golangci-lint run`",
+		"`*_test.go` (1 test files for 1 source files)",
+		"Keep commits small _(CONTRIBUTING.md)_",
+		"Wrap errors with %w",
+	} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("summary missing %q:\n%s", want, summary)
+		}
+	}
+	if strings.Contains(summary, "nested example") || strings.Contains(summary, "VERSION") {
+		t.Errorf("summary includes noise:\n%s", summary)
+	}
+
+	if Stale(rigPath) {
+		t.Error("Stale right after build = true")
+	}
+	writeRepoFile(t, repo, ".editorconfig", "[*]\nindent_style = tab\n")
+	if !Stale(rigPath) {
+		t.Error("Stale after adding a source = false")
+	}
+}
@@ -0,0 +1,313 @@
+package conventions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+type sourceKind int
+
+const (
+	kindManifest sourceKind = iota
+	kindLint
+	kindCI
+	kindGuide
+)
+
+// lintTools maps lint/format config names to the tool they configure.
+var lintTools = map[string]string{
+	".golangci":     "golangci-lint",
+	".eslintrc":     "ESLint",
+	"eslint.config": "ESLint",
+	".prettierrc":   "Prettier",
+	"biome":         "Biome",
+	"ruff":          "Ruff",
+	".ruff":         "Ruff",
+	".flake8":       "flake8",
+	"rustfmt":       "rustfmt",
+	".rustfmt":      "rustfmt",
+	"clippy":        "Clippy",
+	".editorconfig": "EditorConfig",
+}
+
+// classify says what kind of source rel is, and for lint configs which tool.
+func classify(rel string) (sourceKind, string) {
+	base := path.Base(rel)
+	switch {
+	case strings.HasPrefix(rel, ".github/workflows/"), base == ".gitlab-ci.yml", strings.HasPrefix(rel, ".circleci/"):
+		return kindCI, ""
+	case strings.HasSuffix(base, ".md"):
+		return kindGuide, ""
+	}
+	stem := strings.TrimSuffix(base, path.Ext(base))
+	if base == ".editorconfig" || base == ".flake8" || base == ".eslintrc" || base == ".prettierrc" {
+		stem = base
+	}
+	if tool, ok := lintTools[stem]; ok {
+		return kindLint, tool
+	}
+	return kindManifest, ""
+}
+
+var (
+	goVersionRe  = regexp.MustCompile(`(?m)^go\s+(\S+)`)
+	goModuleRe   = regexp.MustCompile(`(?m)^module\s+(\S+)`)
+	makeTargetRe = regexp.MustCompile(`(?m)^([A-Za-z0-9][A-Za-z0-9_.-]*)\s*:([^=]|$)`)
+	ciRunRe      = regexp.MustCompile(`^\s*(?:-\s*)?run:\s*(.*)$`)
+)
+
+// maxListed caps list-like extractions (targets, scripts, commands).
+const maxListed = 12
+
+func describeManifest(rel string, data []byte) []string {
+	switch path.Base(rel) {
+	case "go.mod":
+		line := "Go"
+		if m := goModuleRe.FindSubmatch(data); m != nil {
+			line += fmt.Sprintf(" module `%s`", m[1])
+		}
+		if m := goVersionRe.FindSubmatch(data); m != nil {
+			line += fmt.Sprintf(" (go %s)", m[1])
+		}
+		return []string{line + "; build with `go build ./...`, test with `go test ./...`"}
+	case "package.json":
+		var pkg struct {
+			Scripts        map[string]string `json:"scripts"`
+			PackageManager string            `json:"packageManager"`
+		}
+		if json.Unmarshal(data, &pkg) != nil {
+			return []string{"Node project (package.json)"}
+		}
+		line := "Node project"
+		if pkg.PackageManager != "" {
+			line += fmt.Sprintf(" using %s", strings.SplitN(pkg.PackageManager, "@", 2)[0])
+		}
+		if names := sortedKeys(pkg.Scripts); len(names) > 0 {
+			line += "; scripts: " + codeList(names)
+		}
+		return []string{line}
+	case "Cargo.toml":
+		var c struct {
+			Package struct {
+				Name    string `toml:"name"`
+				Edition string `toml:"edition"`
+			} `toml:"package"`
+			Workspace map[string]interface{} `toml:"workspace"`
+		}
+		line := "Rust"
+		if _, err := toml.Decode(string(data), &c); err == nil {
+			switch {
+			case c.Workspace != nil:
+				line += " workspace"
+			case c.Package.Name != "":
+				line += fmt.Sprintf(" crate `%s`", c.Package.Name)
+			}
+			if c.Package.Edition != "" {
+				line += fmt.Sprintf(" (edition %s)", c.Package.Edition)
+			}
+		}
+		return []string{line + "; test with `cargo test`"}
+	case "pyproject.toml":
+		var p struct {
+			Tool map[string]interface{} `toml:"tool"`
+		}
+		line := "Python project (pyproject.toml)"
+		if _, err := toml.Decode(string(data), &p); err == nil && len(p.Tool) > 0 {
+			line += "; configured tools: " + codeList(sortedKeys(p.Tool))
+		}
+		return []string{line}
+	case "Makefile", "justfile":
+		var targets []string
+		seen := map[string]bool{}
+		for _, m := range makeTargetRe.FindAllSubmatch(data, -1) {
+			t := string(m[1])
+			if !strings.HasPrefix(t, ".") && !seen[t] {
+				seen[t] = true
+				targets = append(targets, t)
+			}
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+		tool := "make"
+		if path.Base(rel) == "justfile" {
+			tool = "just"
+		}
+		return []string{fmt.Sprintf("%s targets: %s", tool, codeList(targets))}
+	}
+	return nil
+}
+
+func describeLint(rel, tool string, data []byte) string {
+	line := fmt.Sprintf("%s is configured (`%s`)", tool, rel)
+	if tool == "EditorConfig" {
+		var settings []string
+		for _, l := range strings.Split(string(data), "\n") {
+			l = strings.TrimSpace(l)
+			for _, key := range []string{"indent_style", "indent_size", "max_line_length", "end_of_line"} {
+				if strings.HasPrefix(l, key) {
+					settings = append(settings, strings.ReplaceAll(l, " ", ""))
+				}
+			}
+			if len(settings) >= 4 {
+				break
+			}
+		}
+		if len(settings) > 0 {
+			line += ": " + strings.Join(settings, ", ")
+		}
+		return line
+	}
+	return line + "; run it before pushing"
+}
+
+// describeCI lists the shell commands a CI file runs.
+func describeCI(rel string, data []byte) string {
+	var cmds []string
+	seen := map[string]bool{}
+	lines := strings.Split(string(data), "\n")
+	for i, l := range lines {
+		m := ciRunRe.FindStringSubmatch(l)
+		if m == nil {
+			continue
+		}
+		cmd := strings.TrimSpace(m[1])
+		if cmd == "|" || cmd == ">" || cmd == "|-" || cmd == ">-" {
+			// Block scalar: take its first line.
+			cmd = ""
+			for _, next := range lines[i+1:] {
+				if next = strings.TrimSpace(next); next != "" {
+					cmd = next
+					break
+				}
+			}
+		}
+		cmd = strings.Trim(cmd, `"'`)
+		if cmd != "" && !seen[cmd] {
+			seen[cmd] = true
+			cmds = append(cmds, cmd)
+		}
+	}
+	if len(cmds) == 0 {
+		return fmt.Sprintf("`%s`", rel)
+	}
+	return fmt.Sprintf("`%s`: %s", rel, codeList(cmds))
+}
+
+// maxGuideLines caps what each contributing guide contributes.
+const maxGuideLines = 15
+
+// describeGuide keeps a guide's bullet points: the rules, without the prose.
+func describeGuide(rel string, data []byte) []string {
+	var out []string
+	for _, l := range strings.Split(string(data), "\n") {
+		t := strings.TrimSpace(l)
+		if !strings.HasPrefix(t, "- ") && !strings.HasPrefix(t, "* ") {
+			continue
+		}
+		// Top-level bullets only; nested ones are usually examples.
+		if strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t") {
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s _(%s)_", strings.TrimSpace(t[2:]), path.Base(rel)))
+		if len(out) >= maxGuideLines {
+			break
+		}
+	}
+	return out
+}
+
+// maxWalk bounds the test-layout scan on very large repos.
+const maxWalk = 20000
+
+// patterns describes the repo's test layout.
+func patterns(repo string) []string {
+	var goFiles, goTests, jsTests, pyTests int
+	testDirs := map[string]bool{}
+	walked := 0
+	_ = filepath.WalkDir(repo, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil //nolint:nilerr // unreadable entries are skipped
+		}
+		if d.IsDir() {
+			switch d.Name() {
+			case ".git", "node_modules", "vendor", "target", ".venv", "dist", "build":
+				return filepath.SkipDir
+			case "tests", "test", "__tests__", "spec":
+				if rel, err := filepath.Rel(repo, p); err == nil {
+					testDirs[filepath.ToSlash(rel)] = true
+				}
+			}
+			return nil
+		}
+		if walked++; walked > maxWalk {
+			return filepath.SkipAll
+		}
+		name := d.Name()
+		switch {
+		case strings.HasSuffix(name, "_test.go"):
+			goTests++
+		case strings.HasSuffix(name, ".go"):
+			goFiles++
+		case strings.Contains(name, ".test.") || strings.Contains(name, ".spec."):
+			jsTests++
+		case strings.HasPrefix(name, "test_") && strings.HasSuffix(name, ".py"):
+			pyTests++
+		}
+		return nil
+	})
+
+	var out []string
+	if goTests > 0 {
+		out = append(out, fmt.Sprintf("Go tests live beside the code as `*_test.go` (%d test files for %d source files)", goTests, goFiles))
+	}
+	if jsTests > 0 {
+		out = append(out, fmt.Sprintf("JS/TS tests use `*.test.*` / `*.spec.*` files (%d found)", jsTests))
+	}
+	if pyTests > 0 {
+		out = append(out, fmt.Sprintf("Python tests use `test_*.py` files (%d found)", pyTests))
+	}
+	if len(testDirs) > 0 {
+		dirs := sortedKeys(testDirs)
+		if len(dirs) > 5 {
+			dirs = dirs[:5]
+		}
+		out = append(out, "Test directories: "+codeList(dirs))
+	}
+	return out
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// codeList renders items as inline code, capped at maxListed.
+func codeList(items []string) string {
+	extra := 0
+	if len(items) > maxListed {
+		extra = len(items) - maxListed
+		items = items[:maxListed]
+	}
+	quoted := make([]string, len(items))
+	for i, it := range items {
+		quoted[i] = "`" + it + "`"
+	}
+	s := strings.Join(quoted, ", ")
+	if extra > 0 {
+		s += fmt.Sprintf(" (+%d more)", extra)
+	}
+	return s
+}
@@ -0,0 +1,100 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/internal/branchsweep"
+	"github.com/steveyegge/gastown/internal/decision"
+)
+
+const defaultBranchSweepInterval = 6 * time.Hour
+
+// BranchSweepConfig holds configuration for the branch_sweep patrol.
+// This patrol deletes wisp branches whose wisps closed or were abandoned
+// more than max_age ago (see gt prune-branches --stale). Unmerged branches
+// are saved as patches under .runtime/branch-patches/ before deletion.
+type BranchSweepConfig struct {
+	// Enabled controls whether the patrol runs.
+	Enabled bool `json:"enabled"`
+
+	// IntervalStr is how often to sweep (e.g., "12h"). Default: 6h.
+	IntervalStr string `json:"interval,omitempty"`
+
+	// MaxAgeStr is how long after its wisp finished a branch is kept
+	// (e.g., "72h"). Default: 7 days.
+	MaxAgeStr string `json:"max_age,omitempty"`
+
+	// DeleteRemote also deletes merged branches on origin.
+	DeleteRemote bool `json:"delete_remote,omitempty"`
+
+	// DryRun logs what would be swept without deleting anything.
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// branchSweepInterval returns the configured sweep interval, or the default (6h).
+func branchSweepInterval(config *DaemonPatrolConfig) time.Duration {
+	if config != nil && config.Patrols != nil && config.Patrols.BranchSweep != nil {
+		if config.Patrols.BranchSweep.IntervalStr != "" {
+			if d, err := time.ParseDuration(config.Patrols.BranchSweep.IntervalStr); err == nil && d > 0 {
+				return d
+			}
+		}
+	}
+	return defaultBranchSweepInterval
+}
+
+// branchSweepOptions returns the sweep options from the patrol config.
+func branchSweepOptions(config *DaemonPatrolConfig) branchsweep.Options {
+	opts := branchsweep.Options{MaxAge: branchsweep.DefaultMaxAge}
+	if config == nil || config.Patrols == nil || config.Patrols.BranchSweep == nil {
+		return opts
+	}
+	cfg := config.Patrols.BranchSweep
+	if cfg.MaxAgeStr != "" {
+		if d, err := time.ParseDuration(cfg.MaxAgeStr); err == nil && d > 0 {
+			opts.MaxAge = d
+		}
+	}
+	opts.DeleteRemote = cfg.DeleteRemote
+	opts.DryRun = cfg.DryRun
+	return opts
+}
+
+// runBranchSweep sweeps stale wisp branches in every operational rig.
+func (d *Daemon) runBranchSweep() {
+	sweeper := branchsweep.New(d.config.TownRoot, branchSweepOptions(d.patrolConfig))
+	verb := "swept"
+	if sweeper.DryRun {
+		verb = "would sweep"
+	}
+
+	var total, failed int
+	for _, rigName := range d.getPatrolRigs("branch_sweep") {
+		swept, err := sweeper.SweepRig(rigName)
+		if err != nil {
+			failed++
+			d.logger.Printf("branch_sweep: %s: %v", rigName, err)
+			continue
+		}
+		for _, s := range swept {
+			detail := s.Reason
+			if s.Patch != "" {
+				detail += ", patch " + s.Patch
+			}
+			if s.Remote {
+				detail += ", remote deleted"
+			}
+			d.logger.Printf("branch_sweep: %s %s/%s (%s %s; %s)", verb, rigName, s.Branch, s.Bead, s.Status, detail)
+		}
+		total += len(swept)
+	}
+
+	switch {
+	case failed > 0:
+		d.failPatrol("%d rig(s) failed to sweep", failed)
+	case total == 0:
+		d.skipPatrol(decision.ReasonNotReady, "no stale branches")
+	default:
+		d.logger.Printf("branch_sweep: %s %d branch(es)", verb, total)
+	}
+}
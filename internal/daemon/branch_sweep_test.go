@@ -0,0 +1,56 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/branchsweep"
+)
+
+func TestBranchSweepInterval(t *testing.T) {
+	if got := branchSweepInterval(nil); got != defaultBranchSweepInterval {
+		t.Errorf("expected default %v, got %v", defaultBranchSweepInterval, got)
+	}
+
+	config := &DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{
+			BranchSweep: &BranchSweepConfig{Enabled: true, IntervalStr: "12h"},
+		},
+	}
+	if got := branchSweepInterval(config); got != 12*time.Hour {
+		t.Errorf("expected 12h, got %v", got)
+	}
+
+	config.Patrols.BranchSweep.IntervalStr = "nope"
+	if got := branchSweepInterval(config); got != defaultBranchSweepInterval {
+		t.Errorf("expected default for invalid, got %v", got)
+	}
+}
+
+func TestBranchSweepOptions(t *testing.T) {
+	if got := branchSweepOptions(nil); got.MaxAge != branchsweep.DefaultMaxAge || got.DeleteRemote || got.DryRun {
+		t.Errorf("expected defaults, got %+v", got)
+	}
+
+	config := &DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{
+			BranchSweep: &BranchSweepConfig{Enabled: true, MaxAgeStr: "72h", DeleteRemote: true, DryRun: true},
+		},
+	}
+	got := branchSweepOptions(config)
+	if got.MaxAge != 72*time.Hour || !got.DeleteRemote || !got.DryRun {
+		t.Errorf("expected 72h/remote/dry-run, got %+v", got)
+	}
+}
+
+func TestBranchSweepOptIn(t *testing.T) {
+	if IsPatrolEnabled(&DaemonPatrolConfig{Patrols: &PatrolsConfig{}}, "branch_sweep") {
+		t.Error("branch_sweep should be disabled unless configured")
+	}
+	config := &DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{BranchSweep: &BranchSweepConfig{Enabled: true}},
+	}
+	if !IsPatrolEnabled(config, "branch_sweep") {
+		t.Error("branch_sweep should be enabled when configured")
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/decision"
 )
 
 const (
@@ -124,6 +125,7 @@ func (d *Daemon) runCompactorDog() {
 	databases := d.compactorDatabases()
 	if len(databases) == 0 {
 		d.logger.Printf("compactor_dog: no databases to compact")
+		d.skipPatrol(decision.ReasonNotReady, "no databases to compact")
 		mol.failStep("inspect", "no databases found")
 		return
 	}
@@ -184,6 +186,8 @@ func (d *Daemon) runCompactorDog() {
 	d.logger.Printf("compactor_dog: cycle complete — compacted=%d skipped=%d errors=%d",
 		compacted, skipped, errors)
 	mol.closeStep("report")
+
+	d.recordPatrolOutcome("compactor_dog", compacted+errors)
 }
 
 // compactorDatabases returns the list of databases to consider for compaction.
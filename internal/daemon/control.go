@@ -0,0 +1,174 @@
+package daemon
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/logging"
+)
+
+// ErrNoControlServer is returned by Control when the daemon's control socket
+// is not available (daemon stopped, or an older daemon without it).
+var ErrNoControlServer = errors.New("daemon control socket not available")
+
+// controlTimeout bounds one control request, both sides.
+const controlTimeout = 5 * time.Second
+
+// Control operations.
+const (
+	// ControlLogLevels returns the daemon's effective log levels.
+	ControlLogLevels = "log-levels"
+
+	// ControlSetLogLevel sets (or, with an empty Level, clears) the runtime
+	// log level override for Subsystem and applies it immediately.
+	ControlSetLogLevel = "set-log-level"
+)
+
+// ControlSocketPath returns the daemon's control socket.
+func ControlSocketPath(townRoot string) string {
+	return filepath.Join(townRoot, "daemon", "control.sock")
+}
+
+// ControlRequest is the single JSON line a client sends on the control
+// socket. The daemon answers with one ControlResponse line and hangs up.
+type ControlRequest struct {
+	Op        string `json:"op"`
+	Subsystem string `json:"subsystem,omitempty"`
+	Level     string `json:"level,omitempty"`
+}
+
+// ControlResponse is the daemon's answer to a ControlRequest.
+type ControlResponse struct {
+	OK     bool              `json:"ok"`
+	Error  string            `json:"error,omitempty"`
+	Levels map[string]string `json:"levels,omitempty"` // Effective levels after the request
+}
+
+// controlServer serves ControlRequests on ControlSocketPath.
+type controlServer struct {
+	townRoot string
+	ln       net.Listener
+	wg       sync.WaitGroup
+}
+
+// listenControl starts the control server. A stale socket left by a crashed
+// daemon is replaced.
+func listenControl(townRoot string) (*controlServer, error) {
+	sockPath := ControlSocketPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(sockPath), 0755); err != nil {
+		return nil, fmt.Errorf("creating socket dir: %w", err)
+	}
+	_ = os.Remove(sockPath)
+	ln, err := net.Listen("unix", sockPath)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", sockPath, err)
+	}
+	_ = os.Chmod(sockPath, 0600) // changes daemon behavior; owner only
+
+	s := &controlServer{townRoot: townRoot, ln: ln}
+	s.wg.Add(1)
+	go s.accept()
+	return s, nil
+}
+
+// Close stops the server and waits for in-flight requests.
+func (s *controlServer) Close() {
+	_ = s.ln.Close()
+	s.wg.Wait()
+	_ = os.Remove(ControlSocketPath(s.townRoot))
+}
+
+func (s *controlServer) accept() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return // listener closed
+		}
+		s.wg.Add(1)
+		go s.serve(conn)
+	}
+}
+
+func (s *controlServer) serve(conn net.Conn) {
+	defer s.wg.Done()
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(controlTimeout))
+	var req ControlRequest
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	if err != nil {
+		return
+	}
+	resp := ControlResponse{OK: true}
+	if err := json.Unmarshal(line, &req); err != nil {
+		resp = ControlResponse{Error: fmt.Sprintf("parsing request: %v", err)}
+	} else if err := s.handle(req); err != nil {
+		resp = ControlResponse{Error: err.Error()}
+	}
+	if resp.OK {
+		resp.Levels = levelNames(logging.EffectiveLevels())
+	}
+	_ = json.NewEncoder(conn).Encode(resp)
+}
+
+func (s *controlServer) handle(req ControlRequest) error {
+	switch req.Op {
+	case ControlLogLevels:
+		return nil
+	case ControlSetLogLevel:
+		if req.Subsystem == "" {
+			return errors.New("set-log-level needs a subsystem")
+		}
+		// Persist through the override file so other gt processes and a
+		// restarted daemon agree, then apply it here without waiting.
+		if err := logging.SetOverride(s.townRoot, req.Subsystem, req.Level); err != nil {
+			return err
+		}
+		logging.Refresh()
+		logging.For(logging.SubsystemDaemon).Info("log level changed via control API",
+			"target", req.Subsystem, "level", req.Level)
+		return nil
+	}
+	return fmt.Errorf("unknown control op %q", req.Op)
+}
+
+func levelNames(levels map[string]slog.Level) map[string]string {
+	out := make(map[string]string, len(levels))
+	for sub, lvl := range levels {
+		out[sub] = logging.LevelName(lvl)
+	}
+	return out
+}
+
+// Control sends one request to the running daemon's control socket.
+// Returns ErrNoControlServer if the socket cannot be reached, and the
+// daemon's error if it rejected the request.
+func Control(townRoot string, req ControlRequest) (*ControlResponse, error) {
+	conn, err := net.DialTimeout("unix", ControlSocketPath(townRoot), controlTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNoControlServer, err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(controlTimeout))
+
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return nil, fmt.Errorf("sending control request: %w", err)
+	}
+	var resp ControlResponse
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("reading control response: %w", err)
+	}
+	if !resp.OK {
+		return &resp, errors.New(resp.Error)
+	}
+	return &resp, nil
+}
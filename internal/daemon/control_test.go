@@ -0,0 +1,45 @@
+package daemon
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/logging"
+)
+
+func TestControlSetLogLevel(t *testing.T) {
+	townRoot := t.TempDir()
+	if _, err := Control(townRoot, ControlRequest{Op: ControlLogLevels}); !errors.Is(err, ErrNoControlServer) {
+		t.Fatalf("Control without a daemon = %v, want ErrNoControlServer", err)
+	}
+
+	if err := logging.Setup(logging.Options{TownRoot: townRoot}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = logging.Setup(logging.Options{}) })
+	srv, err := listenControl(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	resp, err := Control(townRoot, ControlRequest{Op: ControlSetLogLevel, Subsystem: logging.SubsystemDaemon, Level: "debug"})
+	if err != nil {
+		t.Fatalf("set-log-level: %v", err)
+	}
+	if got := resp.Levels[logging.SubsystemDaemon]; got != "debug" {
+		t.Errorf("response level = %q, want debug", got)
+	}
+	// Applied immediately, without waiting for the override refresh.
+	if !logging.Enabled(logging.SubsystemDaemon, slog.LevelDebug) {
+		t.Error("daemon debug logging should be enabled right away")
+	}
+
+	if _, err := Control(townRoot, ControlRequest{Op: ControlSetLogLevel, Subsystem: logging.SubsystemDaemon, Level: "loud"}); err == nil {
+		t.Error("an unknown level should be rejected")
+	}
+	if _, err := Control(townRoot, ControlRequest{Op: "reboot"}); err == nil {
+		t.Error("an unknown op should be rejected")
+	}
+}
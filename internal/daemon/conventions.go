@@ -0,0 +1,65 @@
+package daemon
+
+import (
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/conventions"
+	"github.com/steveyegge/gastown/internal/decision"
+)
+
+const defaultConventionsInterval = time.Hour
+
+// ConventionsConfig holds configuration for the conventions patrol.
+// This patrol recompiles each rig's conventions summary (see gt conventions
+// build) when the rig's lint configs, CI files, or guides change.
+type ConventionsConfig struct {
+	// Enabled controls whether the patrol runs.
+	Enabled bool `json:"enabled"`
+
+	// IntervalStr is how often to check for changed sources (e.g., "30m").
+	// Default: 1h.
+	IntervalStr string `json:"interval,omitempty"`
+}
+
+// conventionsInterval returns the configured check interval, or the default (1h).
+func conventionsInterval(config *DaemonPatrolConfig) time.Duration {
+	if config != nil && config.Patrols != nil && config.Patrols.Conventions != nil {
+		if config.Patrols.Conventions.IntervalStr != "" {
+			if d, err := time.ParseDuration(config.Patrols.Conventions.IntervalStr); err == nil && d > 0 {
+				return d
+			}
+		}
+	}
+	return defaultConventionsInterval
+}
+
+// runConventions rebuilds the summaries of rigs whose sources changed.
+// Non-fatal: a rig that fails to build is logged and retried next tick.
+func (d *Daemon) runConventions() {
+	rigs := d.getKnownRigs()
+	sort.Strings(rigs)
+
+	var rebuilt, failed int
+	for _, rigName := range rigs {
+		rigPath := filepath.Join(d.config.TownRoot, rigName)
+		if !conventions.Stale(rigPath) {
+			continue
+		}
+		if _, err := conventions.Build(rigName, rigPath); err != nil {
+			d.logger.Printf("conventions: %s: %v", rigName, err)
+			failed++
+			continue
+		}
+		d.logger.Printf("conventions: rebuilt summary for %s", rigName)
+		rebuilt++
+	}
+
+	switch {
+	case failed > 0:
+		d.failPatrol("%d rig(s) failed to build", failed)
+	case rebuilt == 0:
+		d.skipPatrol(decision.ReasonNotReady, "no rig conventions changed")
+	}
+}
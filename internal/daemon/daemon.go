@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -21,14 +22,22 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/boot"
+	"github.com/steveyegge/gastown/internal/budget"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/decision"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/failover"
 	"github.com/steveyegge/gastown/internal/feed"
 	gitpkg "github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/incident"
+	"github.com/steveyegge/gastown/internal/logging"
 	"github.com/steveyegge/gastown/internal/mayor"
+	"github.com/steveyegge/gastown/internal/prices"
+	"github.com/steveyegge/gastown/internal/pubsub"
+	"github.com/steveyegge/gastown/internal/quiethours"
 	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
@@ -37,6 +46,7 @@ import (
 	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/wisp"
 	"github.com/steveyegge/gastown/internal/witness"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 // Daemon is the town-level background service.
@@ -48,12 +58,16 @@ type Daemon struct {
 	patrolConfig  *DaemonPatrolConfig
 	tmux          *tmux.Tmux
 	logger        *log.Logger
+	log           *slog.Logger // Structured logger; nil in tests, see structured()
 	ctx           context.Context
 	cancel        context.CancelFunc
 	curator       *feed.Curator
+	eventStream   *events.StreamServer
+	control       *controlServer
 	convoyManager *ConvoyManager
 	beadsStores   map[string]beadsdk.Storage
 	doltServer *DoltServerManager
+	rigDolt    *RigDoltSupervisor
 	krcPruner  *KRCPruner
 
 	// Mass death detection: track recent session deaths
@@ -99,6 +113,39 @@ type Daemon struct {
 	// lastMaintenanceRun tracks when scheduled maintenance last ran.
 	// Only accessed from heartbeat loop goroutine - no sync needed.
 	lastMaintenanceRun time.Time
+
+	// lastMailRetention tracks when mail retention last ran.
+	// Only accessed from heartbeat loop goroutine - no sync needed.
+	lastMailRetention time.Time
+
+	// lastBudgetCheck tracks when cost budgets were last checked.
+	// Only accessed from heartbeat loop goroutine - no sync needed.
+	lastBudgetCheck time.Time
+
+	// lastPriceUpdate tracks when the price table was last fetched.
+	// Only accessed from heartbeat loop goroutine - no sync needed.
+	lastPriceUpdate time.Time
+
+	// lastDoctorAutofix tracks when gt doctor autofix last ran.
+	// Only accessed from heartbeat loop goroutine - no sync needed.
+	lastDoctorAutofix time.Time
+
+	// lastFailoverCheck tracks when sessions were last scanned for provider errors.
+	// Only accessed from heartbeat loop goroutine - no sync needed.
+	lastFailoverCheck time.Time
+
+	// tuners adjusts adaptively scheduled patrol intervals, keyed by patrol.
+	// Nil unless adaptive scheduling is enabled.
+	tuners map[string]*patrolTuner
+
+	// timers measures each patrol's ticks for drift and jitters the next
+	// one, keyed by patrol ("heartbeat" for the recovery heartbeat).
+	// Only accessed from heartbeat loop goroutine - no sync needed.
+	timers map[string]*patrolTimer
+
+	// patrolRun is the decision for the patrol currently inside runPatrol.
+	// Only accessed from heartbeat loop goroutine - no sync needed.
+	patrolRun *decision.Decision
 }
 
 // sessionDeath records a detected session death for mass death analysis.
@@ -120,6 +167,20 @@ const (
 	// (single source of truth). See: gt-tr3d
 	hungSessionThreshold = constants.HungSessionThreshold
 
+	// mailRetentionInterval is how often the heartbeat applies mail retention.
+	mailRetentionInterval = time.Hour
+
+	// budgetCheckInterval is how often the heartbeat checks cost budgets.
+	budgetCheckInterval = time.Hour
+
+	// priceUpdateInterval is how often the heartbeat fetches the published
+	// price table, when auto_update is set in settings/prices.json.
+	priceUpdateInterval = 24 * time.Hour
+
+	// defaultDoctorAutofixInterval is how often the heartbeat runs gt doctor
+	// autofix when doctor.autofix sets no interval.
+	defaultDoctorAutofixInterval = time.Hour
+
 	// doctorMolCooldown is the minimum interval between mol-dog-doctor molecules.
 	// Configurable via operational.daemon.doctor_mol_cooldown.
 	doctorMolCooldown = 5 * time.Minute
@@ -142,7 +203,13 @@ func New(config *Config) (*Daemon, error) {
 		Compress:   true,
 	}
 
-	logger := log.New(logWriter, "", log.LstdFlags)
+	// All daemon output goes through the shared structured logger so levels
+	// follow the town's logging config and records are also exported via OTel.
+	// Printf call sites use the *log.Logger adapter.
+	if err := logging.Setup(logging.Options{TownRoot: config.TownRoot, Writer: logWriter}); err != nil {
+		return nil, fmt.Errorf("setting up logging: %w", err)
+	}
+	logger := logging.StdLogger(logging.SubsystemDaemon)
 	ctx, cancel := context.WithCancel(context.Background())
 
 	// Initialize session prefix and agent registries from town root.
@@ -169,9 +236,10 @@ func New(config *Config) (*Daemon, error) {
 	if patrolConfig != nil {
 		logger.Printf("Loaded patrol config from %s", PatrolConfigFile(config.TownRoot))
 		// Propagate env vars from daemon.json to this process and all spawned sessions.
-		for k, v := range patrolConfig.Env {
+		// Values may be secrets, so only names are logged.
+		for k, v := range PatrolEnv(config.TownRoot, patrolConfig) {
 			os.Setenv(k, v)
-			logger.Printf("Set env %s=%s from daemon.json", k, v)
+			logger.Printf("Set env %s from daemon.json", k)
 		}
 	}
 
@@ -184,6 +252,17 @@ func New(config *Config) (*Daemon, error) {
 		}
 	}
 
+	// Initialize the per-rig Dolt server supervisor if configured
+	var rigDolt *RigDoltSupervisor
+	if IsPatrolEnabled(patrolConfig, "rig_dolt_servers") {
+		rigDolt = NewRigDoltSupervisor(config.TownRoot, patrolConfig.Patrols.RigDoltServers, logger.Printf)
+		logger.Printf("Rig Dolt server supervision enabled for %v", patrolConfig.Patrols.RigDoltServers.Rigs)
+	} else {
+		// The patrol was turned off: point any rigs still on a dedicated
+		// server back at the town server and stop their servers.
+		NewRigDoltSupervisor(config.TownRoot, nil, logger.Printf).Release()
+	}
+
 	// PATCH-006: Resolve binary paths at startup.
 	gtPath, err := exec.LookPath("gt")
 	if err != nil {
@@ -238,9 +317,11 @@ func New(config *Config) (*Daemon, error) {
 		patrolConfig:   patrolConfig,
 		tmux:           tmux.NewTmux(),
 		logger:         logger,
+		log:            logging.For(logging.SubsystemDaemon),
 		ctx:            ctx,
 		cancel:         cancel,
 		doltServer:     doltServer,
+		rigDolt:        rigDolt,
 		gtPath:         gtPath,
 		bdPath:         bdPath,
 		restartTracker: restartTracker,
@@ -249,6 +330,15 @@ func New(config *Config) (*Daemon, error) {
 	}, nil
 }
 
+// structured returns the daemon's structured logger. Daemons built directly
+// in tests have none, so fall back to the shared daemon subsystem logger.
+func (d *Daemon) structured() *slog.Logger {
+	if d.log != nil {
+		return d.log
+	}
+	return logging.For(logging.SubsystemDaemon)
+}
+
 // Run starts the daemon main loop.
 func (d *Daemon) Run() error {
 	d.logger.Printf("Daemon starting (PID %d)", os.Getpid())
@@ -305,8 +395,10 @@ func (d *Daemon) Run() error {
 
 	// Fixed recovery-focused heartbeat (no activity-based backoff)
 	// Normal wake is handled by feed subscription (bd activity --follow)
-	timer := time.NewTimer(d.recoveryHeartbeatInterval())
+	heartbeatInterval := d.recoveryHeartbeatInterval()
+	timer := time.NewTimer(heartbeatInterval)
 	defer timer.Stop()
+	d.trackPatrol(heartbeatTimer, heartbeatInterval, heartbeatInterval, func(next time.Duration) { timer.Reset(next) }, true)
 
 	d.logger.Printf("Daemon running, recovery heartbeat interval %v", d.recoveryHeartbeatInterval())
 
@@ -318,6 +410,25 @@ func (d *Daemon) Run() error {
 		d.logger.Println("Feed curator started")
 	}
 
+	// Serve the events log to external subscribers (gt events tail).
+	if srv, err := events.ListenAndServe(d.config.TownRoot); err != nil {
+		d.logger.Printf("Warning: failed to start event stream socket: %v", err)
+	} else {
+		d.eventStream = srv
+		d.logger.Printf("Event stream socket listening on %s", events.SocketPath(d.config.TownRoot))
+	}
+
+	// Serve runtime control requests (gt log level).
+	if srv, err := listenControl(d.config.TownRoot); err != nil {
+		d.logger.Printf("Warning: failed to start control socket: %v", err)
+	} else {
+		d.control = srv
+		d.logger.Printf("Control socket listening on %s", ControlSocketPath(d.config.TownRoot))
+	}
+
+	// Forward events to an MQTT/NATS broker if configured (event_publisher).
+	d.startEventPublisher()
+
 	// Start convoy manager (event-driven + periodic stranded scan)
 	// Try opening beads stores eagerly; if Dolt isn't ready yet,
 	// pass the opener as a callback for lazy retry on each poll tick.
@@ -381,7 +492,7 @@ func (d *Daemon) Run() error {
 	var doltRemotesChan <-chan time.Time
 	if IsPatrolEnabled(d.patrolConfig, "dolt_remotes") {
 		interval := doltRemotesInterval(d.patrolConfig)
-		doltRemotesTicker = time.NewTicker(interval)
+		doltRemotesTicker = d.newPatrolTicker("dolt_remotes", interval)
 		doltRemotesChan = doltRemotesTicker.C
 		defer doltRemotesTicker.Stop()
 		d.logger.Printf("Dolt remotes push ticker started (interval %v)", interval)
@@ -393,7 +504,7 @@ func (d *Daemon) Run() error {
 	var doltBackupChan <-chan time.Time
 	if IsPatrolEnabled(d.patrolConfig, "dolt_backup") {
 		interval := doltBackupInterval(d.patrolConfig)
-		doltBackupTicker = time.NewTicker(interval)
+		doltBackupTicker = d.newPatrolTicker("dolt_backup", interval)
 		doltBackupChan = doltBackupTicker.C
 		defer doltBackupTicker.Stop()
 		d.logger.Printf("Dolt backup ticker started (interval %v)", interval)
@@ -405,19 +516,26 @@ func (d *Daemon) Run() error {
 	var jsonlGitBackupChan <-chan time.Time
 	if IsPatrolEnabled(d.patrolConfig, "jsonl_git_backup") {
 		interval := jsonlGitBackupInterval(d.patrolConfig)
-		jsonlGitBackupTicker = time.NewTicker(interval)
+		jsonlGitBackupTicker = d.newPatrolTicker("jsonl_git_backup", interval)
 		jsonlGitBackupChan = jsonlGitBackupTicker.C
 		defer jsonlGitBackupTicker.Stop()
 		d.logger.Printf("JSONL git backup ticker started (interval %v)", interval)
 	}
 
+	// Adaptive scheduling: resume tuned intervals from the last run.
+	saved, err := LoadPatrolTuning(d.config.TownRoot)
+	if err != nil {
+		d.logger.Printf("Warning: failed to load patrol tuning: %v", err)
+	}
+	d.tuners = newPatrolTuners(d.patrolConfig, saved)
+
 	// Start wisp reaper ticker if configured.
 	// Closes stale wisps (abandoned molecule steps, old patrol data) across all databases.
 	var wispReaperTicker *time.Ticker
 	var wispReaperChan <-chan time.Time
 	if IsPatrolEnabled(d.patrolConfig, "wisp_reaper") {
 		interval := wispReaperInterval(d.patrolConfig)
-		wispReaperTicker = time.NewTicker(interval)
+		wispReaperTicker = d.newPatrolTicker("wisp_reaper", interval)
 		wispReaperChan = wispReaperTicker.C
 		defer wispReaperTicker.Stop()
 		d.logger.Printf("Wisp reaper ticker started (interval %v)", interval)
@@ -429,7 +547,7 @@ func (d *Daemon) Run() error {
 	var doctorDogChan <-chan time.Time
 	if IsPatrolEnabled(d.patrolConfig, "doctor_dog") {
 		interval := doctorDogInterval(d.patrolConfig)
-		doctorDogTicker = time.NewTicker(interval)
+		doctorDogTicker = d.newPatrolTicker("doctor_dog", interval)
 		doctorDogChan = doctorDogTicker.C
 		defer doctorDogTicker.Stop()
 		d.logger.Printf("Doctor dog ticker started (interval %v)", interval)
@@ -441,7 +559,7 @@ func (d *Daemon) Run() error {
 	var compactorDogChan <-chan time.Time
 	if IsPatrolEnabled(d.patrolConfig, "compactor_dog") {
 		interval := compactorDogInterval(d.patrolConfig)
-		compactorDogTicker = time.NewTicker(interval)
+		compactorDogTicker = d.newPatrolTicker("compactor_dog", interval)
 		compactorDogChan = compactorDogTicker.C
 		defer compactorDogTicker.Stop()
 		d.logger.Printf("Compactor dog ticker started (interval %v)", interval)
@@ -454,13 +572,74 @@ func (d *Daemon) Run() error {
 	var scheduledMaintenanceChan <-chan time.Time
 	if IsPatrolEnabled(d.patrolConfig, "scheduled_maintenance") {
 		interval := maintenanceCheckInterval(d.patrolConfig)
-		scheduledMaintenanceTicker = time.NewTicker(interval)
+		scheduledMaintenanceTicker = d.newPatrolTicker("scheduled_maintenance", interval)
 		scheduledMaintenanceChan = scheduledMaintenanceTicker.C
 		defer scheduledMaintenanceTicker.Stop()
 		window := maintenanceWindow(d.patrolConfig)
 		d.logger.Printf("Scheduled maintenance ticker started (check interval %v, window %s)", interval, window)
 	}
 
+	// Start town backup ticker if configured.
+	// Takes a full town snapshot (configs, history, mail, beads) and prunes old ones.
+	var townBackupTicker *time.Ticker
+	var townBackupChan <-chan time.Time
+	if IsPatrolEnabled(d.patrolConfig, "town_backup") {
+		interval := TownBackupInterval(d.patrolConfig)
+		townBackupTicker = d.newPatrolTicker("town_backup", interval)
+		townBackupChan = townBackupTicker.C
+		defer townBackupTicker.Stop()
+		d.logger.Printf("Town backup ticker started (interval %v)", interval)
+	}
+
+	// Start conventions ticker if configured.
+	// Rebuilds each rig's conventions summary when its source files change.
+	var conventionsTicker *time.Ticker
+	var conventionsChan <-chan time.Time
+	if IsPatrolEnabled(d.patrolConfig, "conventions") {
+		interval := conventionsInterval(d.patrolConfig)
+		conventionsTicker = d.newPatrolTicker("conventions", interval)
+		conventionsChan = conventionsTicker.C
+		defer conventionsTicker.Stop()
+		d.logger.Printf("Conventions ticker started (interval %v)", interval)
+	}
+
+	// Start roster ticker if configured.
+	// Reconciles running agents with mayor/roster.json via gt agents apply.
+	var rosterTicker *time.Ticker
+	var rosterChan <-chan time.Time
+	if IsPatrolEnabled(d.patrolConfig, "roster") {
+		interval := rosterInterval(d.patrolConfig)
+		rosterTicker = d.newPatrolTicker("roster", interval)
+		rosterChan = rosterTicker.C
+		defer rosterTicker.Stop()
+		d.logger.Printf("Roster ticker started (interval %v)", interval)
+	}
+
+	// Start branch sweep ticker if configured.
+	// Deletes wisp branches whose wisps finished long ago.
+	var branchSweepTicker *time.Ticker
+	var branchSweepChan <-chan time.Time
+	if IsPatrolEnabled(d.patrolConfig, "branch_sweep") {
+		interval := branchSweepInterval(d.patrolConfig)
+		branchSweepTicker = d.newPatrolTicker("branch_sweep", interval)
+		branchSweepChan = branchSweepTicker.C
+		defer branchSweepTicker.Stop()
+		d.logger.Printf("Branch sweep ticker started (interval %v)", interval)
+	}
+
+	// Start rig Dolt server supervision if configured.
+	// Starts, probes, and restarts dedicated per-rig Dolt servers.
+	var rigDoltTicker *time.Ticker
+	var rigDoltChan <-chan time.Time
+	if d.rigDolt != nil {
+		interval := rigDoltServersInterval(d.patrolConfig)
+		rigDoltTicker = d.newPatrolTicker("rig_dolt_servers", interval)
+		rigDoltChan = rigDoltTicker.C
+		defer rigDoltTicker.Stop()
+		d.logger.Printf("Rig Dolt server ticker started (interval %v)", interval)
+		d.runRigDoltServers()
+	}
+
 	// Note: PATCH-010 uses per-session hooks in deacon/manager.go (SetAutoRespawnHook).
 	// Global pane-died hooks don't fire reliably in tmux 3.2a, so we rely on the
 	// per-session approach which has been tested to work for continuous recovery.
@@ -502,57 +681,65 @@ func (d *Daemon) Run() error {
 		case <-doltRemotesChan:
 			// Periodic Dolt remote push — pushes databases to their configured
 			// git remotes on a 15-minute cadence (independent of heartbeat).
-			if !d.isShutdownInProgress() {
-				d.pushDoltRemotes()
-			}
+			d.runPatrol("dolt_remotes", d.pushDoltRemotes)
 
 		case <-doltBackupChan:
 			// Periodic Dolt filesystem backup — syncs production databases to
 			// local backup directory on a 15-minute cadence.
-			if !d.isShutdownInProgress() {
-				d.syncDoltBackups()
-			}
+			d.runPatrol("dolt_backup", d.syncDoltBackups)
 
 		case <-jsonlGitBackupChan:
 			// Periodic JSONL git backup — exports issues, scrubs ephemeral data,
 			// commits and pushes to git repo.
-			if !d.isShutdownInProgress() {
-				d.syncJsonlGitBackup()
-			}
+			d.runPatrol("jsonl_git_backup", d.syncJsonlGitBackup)
 
 		case <-wispReaperChan:
 			// Periodic wisp reaper — closes stale wisps (abandoned molecule steps,
 			// old patrol data) to prevent unbounded table growth (Clown Show audit).
-			if !d.isShutdownInProgress() {
-				d.reapWisps()
-			}
+			d.runPatrol("wisp_reaper", d.reapWisps)
 
 		case <-doctorDogChan:
 			// Doctor dog — comprehensive Dolt health monitor: connectivity, latency,
 			// gc, zombie detection, backup staleness, and disk usage checks.
-			if !d.isShutdownInProgress() {
-				d.runDoctorDog()
-			}
+			d.runPatrol("doctor_dog", d.runDoctorDog)
 
 		case <-compactorDogChan:
 			// Compactor dog — flattens Dolt commit history on production databases.
 			// Reclaims commit graph storage, then runs gc to reclaim chunks.
-			if !d.isShutdownInProgress() {
-				d.runCompactorDog()
-			}
+			d.runPatrol("compactor_dog", d.runCompactorDog)
 
 		case <-scheduledMaintenanceChan:
 			// Scheduled maintenance — checks if we're in the maintenance window
 			// and runs `gt maintain --force` when commit counts exceed threshold.
-			if !d.isShutdownInProgress() {
-				d.runScheduledMaintenance()
-			}
+			d.runPatrol("scheduled_maintenance", d.runScheduledMaintenance)
+
+		case <-townBackupChan:
+			// Scheduled town snapshot with retention.
+			d.runPatrol("town_backup", d.runTownBackup)
+
+		case <-conventionsChan:
+			// Recompile rig conventions summaries whose sources changed.
+			d.runPatrol("conventions", d.runConventions)
+
+		case <-rosterChan:
+			// Start declared agents and stop undeclared ones.
+			d.runPatrol("roster", d.runRoster)
+
+		case <-branchSweepChan:
+			// Delete stale wisp branches, saving unmerged ones as patches.
+			d.runPatrol("branch_sweep", d.runBranchSweep)
+
+		case <-rigDoltChan:
+			// Keep dedicated per-rig Dolt servers running.
+			d.runRigDoltServers()
 
 		case <-timer.C:
+			d.observeTick(heartbeatTimer)
 			d.heartbeat(state)
 
-			// Fixed recovery interval (no activity-based backoff)
-			timer.Reset(d.recoveryHeartbeatInterval())
+			// Fixed recovery interval (no activity-based backoff),
+			// jittered if configured
+			d.armPatrol(heartbeatTimer, d.recoveryHeartbeatInterval())
 		}
 	}
 }
@@ -581,19 +768,36 @@ func (d *Daemon) heartbeat(state *State) {
 		return
 	}
 
+	// Skip recovery while an incident is open (gt incident start): restarting
+	// or dispatching agents would disturb the state being investigated.
+	if incident.IsActive(d.config.TownRoot) {
+		d.logger.Println("Incident in progress, skipping heartbeat")
+		return
+	}
+
 	d.metrics.recordHeartbeat(d.ctx)
-	d.logger.Println("Heartbeat starting (recovery-focused)")
+	d.structured().Debug("Heartbeat starting (recovery-focused)")
 
 	// 0. Ensure Dolt server is running (if configured)
 	// This must happen before beads operations that depend on Dolt.
 	d.ensureDoltServerRunning()
 
+	// Clear lapsed gt patrol disable entries so those patrols resume.
+	d.expirePatrolDisables()
+	_, deaconDisabled := ActivePatrolDisable(d.config.TownRoot, "deacon", time.Now())
+
 	// 1. Ensure Deacon is running (restart if dead)
 	// Check patrol config - can be disabled in mayor/daemon.json
 	if IsPatrolEnabled(d.patrolConfig, "deacon") {
-		d.ensureDeaconRunning()
+		d.runPatrol("deacon", d.ensureDeaconRunning)
+		if deaconDisabled {
+			// A temporary disable must stop the running deacon too, or it
+			// keeps patrolling on its own until the disable lapses. (hq-2mstj)
+			d.killDeaconSessions()
+		}
 	} else {
 		d.logger.Printf("Deacon patrol disabled in config, skipping")
+		d.recordPatrol("deacon", decision.OutcomeSkipped, decision.ReasonDisabled, "")
 		// Kill leftover deacon/boot sessions from before patrol was disabled.
 		// Without this, a stale deacon keeps running its own patrol loop,
 		// spawning witnesses and refineries despite daemon config. (hq-2mstj)
@@ -603,23 +807,24 @@ func (d *Daemon) heartbeat(state *State) {
 	// 2. Poke Boot for intelligent triage (stuck/nudge/interrupt)
 	// Boot handles nuanced "is Deacon responsive" decisions
 	// Only run if Deacon patrol is enabled
-	if IsPatrolEnabled(d.patrolConfig, "deacon") {
+	if IsPatrolEnabled(d.patrolConfig, "deacon") && !deaconDisabled {
 		d.ensureBootRunning()
 	}
 
 	// 3. Direct Deacon heartbeat check (belt-and-suspenders)
 	// Boot may not detect all stuck states; this provides a fallback
 	// Only run if Deacon patrol is enabled
-	if IsPatrolEnabled(d.patrolConfig, "deacon") {
+	if IsPatrolEnabled(d.patrolConfig, "deacon") && !deaconDisabled {
 		d.checkDeaconHeartbeat()
 	}
 
 	// 4. Ensure Witnesses are running for all rigs (restart if dead)
 	// Check patrol config - can be disabled in mayor/daemon.json
 	if IsPatrolEnabled(d.patrolConfig, "witness") {
-		d.ensureWitnessesRunning()
+		d.runPatrol("witness", d.ensureWitnessesRunning)
 	} else {
 		d.logger.Printf("Witness patrol disabled in config, skipping")
+		d.recordPatrol("witness", decision.OutcomeSkipped, decision.ReasonDisabled, "")
 		// Kill leftover witness sessions from before patrol was disabled. (hq-2mstj)
 		d.killWitnessSessions()
 	}
@@ -627,21 +832,28 @@ func (d *Daemon) heartbeat(state *State) {
 	// 5. Ensure Refineries are running for all rigs (restart if dead)
 	// Check patrol config - can be disabled in mayor/daemon.json
 	if IsPatrolEnabled(d.patrolConfig, "refinery") {
-		d.ensureRefineriesRunning()
+		d.runPatrol("refinery", d.ensureRefineriesRunning)
 	} else {
 		d.logger.Printf("Refinery patrol disabled in config, skipping")
+		d.recordPatrol("refinery", decision.OutcomeSkipped, decision.ReasonDisabled, "")
 		// Kill leftover refinery sessions from before patrol was disabled. (hq-2mstj)
 		d.killRefinerySessions()
 	}
 
-	// 6. Ensure Mayor is running (restart if dead)
-	d.ensureMayorRunning()
+	// 6. Ensure Mayor is running (restart if dead, or fail over to its deputy)
+	if deputyEnabled(d.patrolConfig) {
+		d.superviseMayor()
+	} else {
+		d.ensureMayorRunning()
+		d.killDeputySession()
+	}
 
 	// 6.5. Handle Dog lifecycle: cleanup stuck dogs and dispatch plugins
 	if IsPatrolEnabled(d.patrolConfig, "handler") {
-		d.handleDogs()
+		d.runPatrol("handler", d.handleDogs)
 	} else {
 		d.logger.Printf("Handler patrol disabled in config, skipping")
+		d.recordPatrol("handler", decision.OutcomeSkipped, decision.ReasonDisabled, "")
 	}
 
 	// 7. Process lifecycle requests
@@ -678,6 +890,27 @@ func (d *Daemon) heartbeat(state *State) {
 	// daemon.log uses lumberjack for automatic rotation; this handles Dolt server logs.
 	d.rotateOversizedLogs()
 
+	// 16. Escalate overseer inbox items that have waited past their SLA.
+	d.escalateOverdueInbox()
+
+	// 17. Send notification digests held during quiet hours that have ended.
+	d.flushQuietHoursDigest()
+
+	// 18. Archive old mail and enforce mailbox size caps (hourly).
+	d.enforceMailRetention()
+
+	// 19. Alert on cost budgets and downgrade exhausted ones (hourly).
+	d.checkCostBudgets()
+
+	// 20. Fetch the published model price table (daily, opt-in).
+	d.updatePrices()
+
+	// 21. Apply safe doctor fixes inside change windows (opt-in).
+	d.runDoctorAutofix()
+
+	// 22. Fail agents over to their fallback on provider outages (opt-in).
+	d.checkProviderFailover()
+
 	// Update state
 	state.LastHeartbeat = time.Now()
 	state.HeartbeatCount++
@@ -895,11 +1128,13 @@ func (d *Daemon) ensureDeaconRunning() {
 	if d.restartTracker != nil {
 		if d.restartTracker.IsInCrashLoop(agentID) {
 			d.logger.Printf("Deacon is in crash loop, skipping restart (use 'gt daemon clear-backoff deacon' to reset)")
+			d.skipPatrol(decision.ReasonBreaker, "crash loop; reset with 'gt daemon clear-backoff deacon'")
 			return
 		}
 		if !d.restartTracker.CanRestart(agentID) {
 			remaining := d.restartTracker.GetBackoffRemaining(agentID)
 			d.logger.Printf("Deacon restart in backoff, %s remaining", remaining.Round(time.Second))
+			d.skipPatrol(decision.ReasonBreaker, "restart backoff, %s remaining", remaining.Round(time.Second))
 			return
 		}
 	}
@@ -1062,7 +1297,7 @@ func (d *Daemon) restartStuckDeacon(sessionName string) {
 // Called on each heartbeat to maintain witness patrol loops.
 // Respects the rigs filter in daemon.json patrol config.
 func (d *Daemon) ensureWitnessesRunning() {
-	rigs := d.getPatrolRigs("witness")
+	rigs := d.rosterRigs(d.getPatrolRigs("witness"), session.RoleWitness)
 	for _, rigName := range rigs {
 		d.ensureWitnessRunning(rigName)
 	}
@@ -1074,6 +1309,7 @@ func (d *Daemon) ensureWitnessRunning(rigName string) {
 	// Check rig operational state before auto-starting
 	if operational, reason := d.isRigOperational(rigName); !operational {
 		d.logger.Printf("Skipping witness auto-start for %s: %s", rigName, reason)
+		d.recordPatrol("witness", decision.OutcomeSkipped, decision.ReasonParked, rigName+": "+reason)
 		return
 	}
 
@@ -1098,23 +1334,23 @@ func (d *Daemon) ensureWitnessRunning(rigName string) {
 	if err := mgr.Start(false, "", nil); err != nil {
 		if err == witness.ErrAlreadyRunning {
 			// Already running - this is the expected case
-			d.logger.Printf("Witness for %s already running, skipping spawn", rigName)
+			d.structured().Debug("Witness already running, skipping spawn", logging.KeyRig, rigName)
 			return
 		}
-		d.logger.Printf("Error starting witness for %s: %v", rigName, err)
+		d.structured().Error("Error starting witness", logging.KeyRig, rigName, logging.KeyError, err)
 		return
 	}
 
 	d.metrics.recordRestart(d.ctx, "witness")
 	telemetry.RecordDaemonRestart(d.ctx, "witness-"+rigName)
-	d.logger.Printf("Witness session for %s started successfully", rigName)
+	d.structured().Info("Witness session started", logging.KeyRig, rigName)
 }
 
 // ensureRefineriesRunning ensures refineries are running for configured rigs.
 // Called on each heartbeat to maintain refinery merge queue processing.
 // Respects the rigs filter in daemon.json patrol config.
 func (d *Daemon) ensureRefineriesRunning() {
-	rigs := d.getPatrolRigs("refinery")
+	rigs := d.rosterRigs(d.getPatrolRigs("refinery"), session.RoleRefinery)
 	for _, rigName := range rigs {
 		d.ensureRefineryRunning(rigName)
 	}
@@ -1126,6 +1362,7 @@ func (d *Daemon) ensureRefineryRunning(rigName string) {
 	// Check rig operational state before auto-starting
 	if operational, reason := d.isRigOperational(rigName); !operational {
 		d.logger.Printf("Skipping refinery auto-start for %s: %s", rigName, reason)
+		d.recordPatrol("refinery", decision.OutcomeSkipped, decision.ReasonParked, rigName+": "+reason)
 		return
 	}
 
@@ -1150,16 +1387,16 @@ func (d *Daemon) ensureRefineryRunning(rigName string) {
 	if err := mgr.Start(false, ""); err != nil {
 		if err == refinery.ErrAlreadyRunning {
 			// Already running - this is the expected case when fix is working
-			d.logger.Printf("Refinery for %s already running, skipping spawn", rigName)
+			d.structured().Debug("Refinery already running, skipping spawn", logging.KeyRig, rigName)
 			return
 		}
-		d.logger.Printf("Error starting refinery for %s: %v", rigName, err)
+		d.structured().Error("Error starting refinery", logging.KeyRig, rigName, logging.KeyError, err)
 		return
 	}
 
 	d.metrics.recordRestart(d.ctx, "refinery")
 	telemetry.RecordDaemonRestart(d.ctx, "refinery-"+rigName)
-	d.logger.Printf("Refinery session for %s started successfully", rigName)
+	d.structured().Info("Refinery session started", logging.KeyRig, rigName)
 }
 
 // ensureMayorRunning ensures the Mayor is running.
@@ -1390,6 +1627,16 @@ func (d *Daemon) shutdown(state *State) error { //nolint:unparam // error return
 		d.logger.Println("Feed curator stopped")
 	}
 
+	if d.eventStream != nil {
+		d.eventStream.Close()
+		d.logger.Println("Event stream socket closed")
+	}
+
+	if d.control != nil {
+		d.control.Close()
+		d.logger.Println("Control socket closed")
+	}
+
 	// Stop convoy manager (also closes beads stores)
 	if d.convoyManager != nil {
 		d.convoyManager.Stop()
@@ -1415,8 +1662,18 @@ func (d *Daemon) shutdown(state *State) error { //nolint:unparam // error return
 		}
 	}
 
+	// Leave the dedicated rig Dolt servers running: the rigs' beads point at
+	// them, and the next daemon resumes supervising them.
+	if d.rigDolt != nil {
+		d.rigDolt.Detach()
+		d.logger.Println("Rig Dolt servers left running for the next daemon")
+	}
+
 	// Flush and stop OTel providers (5s deadline to avoid blocking shutdown).
 	if d.otelProvider != nil {
+		if err := telemetry.SaveCardinalityReport(d.config.TownRoot); err != nil {
+			d.logger.Printf("Warning: saving telemetry cardinality report: %v", err)
+		}
 		shutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 		if err := d.otelProvider.Shutdown(shutCtx); err != nil {
@@ -2097,3 +2354,202 @@ func (d *Daemon) dispatchQueuedWork() {
 		d.logger.Printf("Scheduler dispatch: %s", string(out))
 	}
 }
+
+// escalateOverdueInbox shells out to `gt inbox escalate`, which mails the
+// inbox notify list about items past their SLA (each item escalates once).
+func (d *Daemon) escalateOverdueInbox() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, d.gtPath, "inbox", "escalate") //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = append(os.Environ(), "GT_DAEMON=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		d.logger.Printf("Inbox escalation failed: %v (output: %s)", err, string(out))
+	} else if len(out) > 0 {
+		d.logger.Printf("Inbox: %s", strings.TrimSpace(string(out)))
+	}
+}
+
+// flushQuietHoursDigest shells out to `gt quiet flush`, which sends the
+// notifications held for channels whose quiet hours have ended.
+func (d *Daemon) flushQuietHoursDigest() {
+	if _, err := os.Stat(quiethours.DigestPath(d.config.TownRoot)); err != nil {
+		return // Nothing held
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, d.gtPath, "quiet", "flush") //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = append(os.Environ(), "GT_DAEMON=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		d.logger.Printf("Quiet-hours digest flush failed: %v (output: %s)", err, string(out))
+	} else if len(out) > 0 {
+		d.logger.Printf("Quiet hours: %s", strings.TrimSpace(string(out)))
+	}
+}
+
+// enforceMailRetention shells out to `gt mail retention` at most once per
+// mailRetentionInterval, when a mail_retention policy is configured.
+func (d *Daemon) enforceMailRetention() {
+	if time.Since(d.lastMailRetention) < mailRetentionInterval {
+		return
+	}
+	ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(d.config.TownRoot))
+	if err != nil || ts.MailRetention == nil {
+		return
+	}
+	d.lastMailRetention = time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, d.gtPath, "mail", "retention") //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = append(os.Environ(), "GT_DAEMON=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		d.logger.Printf("Mail retention failed: %v (output: %s)", err, string(out))
+	} else if len(out) > 0 {
+		d.logger.Printf("Mail retention: %s", strings.TrimSpace(string(out)))
+	}
+}
+
+// checkCostBudgets shells out to `gt costs budget check` every
+// budgetCheckInterval, when budgets are configured. It alerts on crossed
+// thresholds and projected overruns, and downgrades exhausted budgets.
+func (d *Daemon) checkCostBudgets() {
+	if time.Since(d.lastBudgetCheck) < budgetCheckInterval {
+		return
+	}
+	ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(d.config.TownRoot))
+	if err != nil {
+		return
+	}
+	if _, statErr := os.Stat(budget.StatePath(d.config.TownRoot)); ts.Budget == nil && statErr != nil {
+		return // No budgets, and no downgrade left to restore
+	}
+	d.lastBudgetCheck = time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, d.gtPath, "costs", "budget", "check") //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = append(os.Environ(), "GT_DAEMON=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		d.logger.Printf("Budget check failed: %v (output: %s)", err, string(out))
+	} else if len(out) > 0 {
+		d.logger.Printf("Budget: %s", strings.TrimSpace(string(out)))
+	}
+}
+
+// updatePrices shells out to `gt prices update` every priceUpdateInterval
+// when settings/prices.json sets auto_update. The cached table's age counts
+// too, so daemon restarts don't refetch.
+func (d *Daemon) updatePrices() {
+	if time.Since(d.lastPriceUpdate) < priceUpdateInterval {
+		return
+	}
+	town, err := prices.LoadTable(prices.TownPath(d.config.TownRoot))
+	if err != nil || !town.AutoUpdate {
+		return
+	}
+	d.lastPriceUpdate = time.Now()
+	if info, err := os.Stat(prices.PublishedPath(d.config.TownRoot)); err == nil && time.Since(info.ModTime()) < priceUpdateInterval {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, d.gtPath, "prices", "update") //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = append(os.Environ(), "GT_DAEMON=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		d.logger.Printf("Price update failed: %v (output: %s)", err, string(out))
+	} else if len(out) > 0 {
+		d.logger.Printf("Prices: %s", strings.TrimSpace(string(out)))
+	}
+}
+
+// runDoctorAutofix shells out to `gt doctor autofix` every autofix interval
+// when doctor.autofix is enabled. The command itself applies fixes only
+// inside a change window, audits them, and mails the summary.
+func (d *Daemon) runDoctorAutofix() {
+	ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(d.config.TownRoot))
+	if err != nil || ts.Doctor == nil || ts.Doctor.Autofix == nil || !ts.Doctor.Autofix.Enabled {
+		return
+	}
+	interval := defaultDoctorAutofixInterval
+	if iv, err := time.ParseDuration(ts.Doctor.Autofix.Interval); err == nil && iv > 0 {
+		interval = iv
+	}
+	if time.Since(d.lastDoctorAutofix) < interval {
+		return
+	}
+	d.lastDoctorAutofix = time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, d.gtPath, "doctor", "autofix") //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = append(os.Environ(), "GT_DAEMON=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		d.logger.Printf("Doctor autofix failed: %v (output: %s)", err, string(out))
+	} else if len(out) > 0 {
+		d.logger.Printf("Doctor autofix: %s", strings.TrimSpace(string(out)))
+	}
+}
+
+// checkProviderFailover shells out to `gt failover check` every failover
+// interval while any agent has a fallback, or a failover is still active.
+// The command scans sessions for provider errors, fails agents over or back,
+// and mails the notify list.
+func (d *Daemon) checkProviderFailover() {
+	ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(d.config.TownRoot))
+	if err != nil {
+		return
+	}
+	if _, statErr := os.Stat(config.FailoverStatePath(d.config.TownRoot)); (ts.Failover == nil || len(ts.Failover.Fallbacks) == 0) && statErr != nil {
+		return // No fallbacks, and no failover left to clear
+	}
+	interval := failover.DefaultInterval
+	if ts.Failover != nil {
+		if iv, err := time.ParseDuration(ts.Failover.Interval); err == nil && iv > 0 {
+			interval = iv
+		}
+	}
+	if time.Since(d.lastFailoverCheck) < interval {
+		return
+	}
+	d.lastFailoverCheck = time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, d.gtPath, "failover", "check") //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = append(os.Environ(), "GT_DAEMON=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		d.logger.Printf("Failover check failed: %v (output: %s)", err, string(out))
+	} else if len(out) > 0 {
+		d.logger.Printf("Failover: %s", strings.TrimSpace(string(out)))
+	}
+}
+
+// startEventPublisher starts the optional broker forwarder configured in
+// settings/config.json "event_publisher". It runs until the daemon stops.
+func (d *Daemon) startEventPublisher() {
+	ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(d.config.TownRoot))
+	if err != nil || ts.EventPublisher == nil {
+		return
+	}
+	town, err := workspace.GetTownName(d.config.TownRoot)
+	if err != nil || town == "" {
+		town = filepath.Base(d.config.TownRoot)
+	}
+	fwd, err := pubsub.NewForwarder(d.config.TownRoot, town, ts.EventPublisher, d.logger.Printf)
+	if err != nil {
+		d.logger.Printf("Warning: event publisher disabled: %v", err)
+		return
+	}
+	d.logger.Printf("Event publisher forwarding to %s broker %s", ts.EventPublisher.Protocol, ts.EventPublisher.URL)
+	go fwd.Run(d.ctx)
+}
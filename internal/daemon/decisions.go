@@ -0,0 +1,98 @@
+package daemon
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/decision"
+	"github.com/steveyegge/gastown/internal/incident"
+	"github.com/steveyegge/gastown/internal/policy"
+)
+
+// runPatrol runs one patrol tick and records why it did or did not do its
+// work, for `gt why patrol`. Patrols explain an early exit with skipPatrol
+// or failPatrol; a patrol that returns without either is recorded as ran.
+func (d *Daemon) runPatrol(name string, fn func()) {
+	d.observeTick(name)
+	if d.isShutdownInProgress() {
+		d.recordPatrol(name, decision.OutcomeSkipped, decision.ReasonShutdown, "")
+		return
+	}
+	if incident.IsActive(d.config.TownRoot) {
+		d.recordPatrol(name, decision.OutcomeSkipped, decision.ReasonIncident, "gt incident end to resume")
+		return
+	}
+	if !IsPatrolEnabled(d.patrolConfig, name) {
+		d.recordPatrol(name, decision.OutcomeSkipped, decision.ReasonDisabled, "")
+		return
+	}
+	if d.patrolDisabled(name) {
+		return
+	}
+	if d.patrolDeniedByPolicy(name) {
+		return
+	}
+
+	d.patrolRun = &decision.Decision{Kind: decision.KindPatrol, Subject: name, Outcome: decision.OutcomeRan}
+	defer func() { d.patrolRun = nil }()
+	fn()
+	d.patrolRun.Time = time.Now()
+	d.writeDecision(*d.patrolRun)
+}
+
+// patrolDeniedByPolicy reports whether a town policy rule forbids the
+// patrol from running now, recording the skip.
+func (d *Daemon) patrolDeniedByPolicy(name string) bool {
+	dec := policy.Evaluate(d.config.TownRoot, policy.PointPatrol, name, policy.Input{
+		"patrol": name,
+		"actor":  "daemon",
+		"role":   "daemon",
+	})
+	for _, e := range dec.Errors {
+		d.logger.Printf("Warning: policy for patrol %s: %s", name, e)
+	}
+	if dec.Allowed {
+		return false
+	}
+	d.recordPatrol(name, decision.OutcomeSkipped, decision.ReasonPolicy, dec.Denied().Error())
+	return true
+}
+
+// skipPatrol marks the patrol running under runPatrol as skipped. Outside
+// runPatrol it is a no-op.
+func (d *Daemon) skipPatrol(reason, format string, args ...interface{}) {
+	if d.patrolRun == nil {
+		return
+	}
+	d.patrolRun.Outcome = decision.OutcomeSkipped
+	d.patrolRun.Reason = reason
+	d.patrolRun.Detail = fmt.Sprintf(format, args...)
+}
+
+// failPatrol marks the patrol running under runPatrol as failed.
+func (d *Daemon) failPatrol(format string, args ...interface{}) {
+	if d.patrolRun == nil {
+		return
+	}
+	d.patrolRun.Outcome = decision.OutcomeFailed
+	d.patrolRun.Detail = fmt.Sprintf(format, args...)
+}
+
+// recordPatrol records a standalone decision about a patrol, such as one
+// rig being skipped while the rest of the patrol runs.
+func (d *Daemon) recordPatrol(name, outcome, reason, detail string) {
+	d.writeDecision(decision.Decision{
+		Time:    time.Now(),
+		Kind:    decision.KindPatrol,
+		Subject: name,
+		Outcome: outcome,
+		Reason:  reason,
+		Detail:  detail,
+	})
+}
+
+func (d *Daemon) writeDecision(dec decision.Decision) {
+	if err := decision.Record(d.config.TownRoot, dec); err != nil {
+		d.logger.Printf("Warning: failed to record %s decision: %v", dec.Subject, err)
+	}
+}
@@ -0,0 +1,40 @@
+package daemon
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/decision"
+)
+
+func TestRunPatrolRecordsDecisions(t *testing.T) {
+	townRoot := t.TempDir()
+	d := &Daemon{
+		config:       &Config{TownRoot: townRoot},
+		patrolConfig: adaptiveTestConfig(),
+		logger:       log.New(io.Discard, "", 0),
+	}
+
+	d.runPatrol("wisp_reaper", func() { d.skipPatrol(decision.ReasonNotReady, "no databases to reap") })
+	d.runPatrol("wisp_reaper", func() {})
+	called := false
+	d.runPatrol("compactor_dog", func() { called = true })
+	if called {
+		t.Error("disabled patrol ran")
+	}
+	d.skipPatrol(decision.ReasonBreaker, "outside runPatrol") // no-op
+
+	got, err := decision.Query(townRoot, decision.KindPatrol, "wisp_reaper", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Outcome != decision.OutcomeRan ||
+		got[1].Outcome != decision.OutcomeSkipped || got[1].Reason != decision.ReasonNotReady || got[1].Detail != "no databases to reap" {
+		t.Errorf("wisp_reaper decisions = %+v", got)
+	}
+	got, _ = decision.Query(townRoot, decision.KindPatrol, "compactor_dog", 0)
+	if len(got) != 1 || got[0].Reason != decision.ReasonDisabled {
+		t.Errorf("compactor_dog decisions = %+v", got)
+	}
+}
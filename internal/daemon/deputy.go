@@ -0,0 +1,191 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/mayor"
+)
+
+// defaultDeputyLapse is how long the Mayor may go unseen before its deputy
+// is promoted.
+const defaultDeputyLapse = 5 * time.Minute
+
+// DeputyConfig keeps a standby deputy beside the Mayor, primed with the
+// Mayor's checkpoint, and promotes it when the Mayor's heartbeat lapses.
+// Without a deputy the daemon cold-starts a dead Mayor.
+type DeputyConfig struct {
+	// Enabled turns the deputy on.
+	Enabled bool `json:"enabled"`
+
+	// LapseAfter is how long the Mayor may go unseen before the deputy is
+	// promoted, e.g. "5m". Default: 5m.
+	LapseAfter string `json:"lapse_after,omitempty"`
+
+	// Agent optionally runs the deputy with a different agent alias.
+	Agent string `json:"agent,omitempty"`
+}
+
+// deputyEnabled reports whether the Mayor has a deputy.
+func deputyEnabled(config *DaemonPatrolConfig) bool {
+	return config != nil && config.Deputy != nil && config.Deputy.Enabled
+}
+
+// deputyLapse returns how long the Mayor may go unseen before failover.
+func deputyLapse(config *DaemonPatrolConfig) time.Duration {
+	if deputyEnabled(config) && config.Deputy.LapseAfter != "" {
+		if d, err := time.ParseDuration(config.Deputy.LapseAfter); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultDeputyLapse
+}
+
+// deputyAction is what the daemon does about the Mayor and its deputy on
+// one heartbeat.
+type deputyAction int
+
+const (
+	deputyStandBy   deputyAction = iota // Mayor alive, deputy standing by
+	deputyStart                         // Mayor alive, no deputy: start one
+	deputyWait                          // Mayor down, heartbeat not yet lapsed
+	deputyPromote                       // Mayor lapsed: promote the deputy
+	deputyColdStart                     // Mayor down, no deputy: start a Mayor
+)
+
+// decideDeputy picks the action for one heartbeat. A Mayor that has never
+// been seen counts as lapsed.
+func decideDeputy(mayorAlive, deputyAlive bool, lastSeen, now time.Time, lapse time.Duration) deputyAction {
+	switch {
+	case mayorAlive && deputyAlive:
+		return deputyStandBy
+	case mayorAlive:
+		return deputyStart
+	case !deputyAlive:
+		return deputyColdStart
+	case !lastSeen.IsZero() && now.Sub(lastSeen) < lapse:
+		return deputyWait
+	default:
+		return deputyPromote
+	}
+}
+
+// superviseMayor keeps the Mayor and its deputy running, promoting the
+// deputy when the Mayor's heartbeat lapses. Used instead of
+// ensureMayorRunning when the deputy is enabled.
+func (d *Daemon) superviseMayor() {
+	mgr := mayor.NewManager(d.config.TownRoot)
+	mayorSession := mgr.SessionName()
+	mayorAlive := false
+	if exists, _ := d.tmux.HasSession(mayorSession); exists {
+		mayorAlive = d.tmux.IsAgentAlive(mayorSession)
+	}
+
+	now := time.Now()
+	if mayorAlive {
+		if err := mayor.WriteHeartbeat(d.config.TownRoot, &mayor.Heartbeat{Timestamp: now.UTC(), Session: mayorSession}); err != nil {
+			d.logger.Printf("Warning: failed to write Mayor heartbeat: %v", err)
+		}
+	}
+	var lastSeen time.Time
+	if hb := mayor.ReadHeartbeat(d.config.TownRoot); hb != nil {
+		lastSeen = hb.Timestamp
+	}
+
+	lapse := deputyLapse(d.patrolConfig)
+	switch decideDeputy(mayorAlive, mgr.DeputyRunning(), lastSeen, now, lapse) {
+	case deputyStart:
+		if err := mgr.StartDeputy(d.patrolConfig.Deputy.Agent); err != nil && !errors.Is(err, mayor.ErrAlreadyRunning) {
+			d.logger.Printf("Error starting Mayor deputy: %v", err)
+			return
+		}
+		d.logger.Println("Mayor deputy started")
+	case deputyWait:
+		d.logger.Printf("Mayor not running (last seen %s ago), deputy promotes at %s",
+			now.Sub(lastSeen).Round(time.Second), lapse)
+	case deputyPromote:
+		d.promoteDeputy(mgr, lastSeen)
+	case deputyColdStart:
+		d.ensureMayorRunning()
+	}
+}
+
+// promoteDeputy fails over to the deputy, recording the failover and
+// notifying the overseer. The next heartbeat starts a new deputy.
+func (d *Daemon) promoteDeputy(mgr *mayor.Manager, lastSeen time.Time) {
+	var lastSeenStr string
+	if !lastSeen.IsZero() {
+		lastSeenStr = lastSeen.UTC().Format(time.RFC3339)
+	}
+	d.logger.Printf("Mayor heartbeat lapsed (last seen %s), promoting deputy", orNever(lastSeenStr))
+
+	cp, err := mgr.PromoteDeputy()
+	record := mayor.Failover{At: time.Now().UTC(), LastSeen: lastSeen}
+	if cp != nil {
+		record.Checkpoint = cp.Summary()
+	}
+	if err != nil {
+		record.Error = err.Error()
+		d.logger.Printf("Error promoting Mayor deputy: %v", err)
+	} else {
+		d.logger.Println("Mayor deputy promoted")
+		_ = mayor.WriteHeartbeat(d.config.TownRoot, &mayor.Heartbeat{Timestamp: record.At, Session: mgr.SessionName()})
+	}
+
+	if rerr := mayor.RecordFailover(d.config.TownRoot, record); rerr != nil {
+		d.logger.Printf("Warning: failed to record Mayor failover: %v", rerr)
+	}
+	_ = events.LogFeed(events.TypeMayorFailover, "daemon",
+		events.MayorFailoverPayload(lastSeenStr, record.Checkpoint, record.Error))
+	d.notifyOverseerOfFailover(record)
+}
+
+// notifyOverseerOfFailover mails the overseer about a Mayor failover.
+func (d *Daemon) notifyOverseerOfFailover(f mayor.Failover) {
+	subject := "MAYOR_FAILOVER: deputy promoted"
+	outcome := "The deputy has taken over as Mayor. A new deputy will be started.\n\nNo action required."
+	if f.Error != "" {
+		subject = "MAYOR_FAILOVER: promotion failed"
+		outcome = fmt.Sprintf("Promotion failed: %s\n\nThe daemon will cold-start the Mayor if the deputy is gone.", f.Error)
+	}
+	var lastSeen string
+	if !f.LastSeen.IsZero() {
+		lastSeen = f.LastSeen.Format(time.RFC3339)
+	}
+	body := fmt.Sprintf(`The Mayor's heartbeat lapsed and the daemon failed over to its deputy.
+
+last_seen: %s
+checkpoint: %s
+
+%s`, orNever(lastSeen), f.Checkpoint, outcome)
+
+	cmd := exec.Command(d.gtPath, "mail", "send", "overseer", "-s", subject, "-m", body) //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = os.Environ() // Inherit PATH to find gt executable
+	if err := cmd.Run(); err != nil {
+		d.logger.Printf("Warning: failed to notify overseer of Mayor failover: %v", err)
+	}
+}
+
+// killDeputySession kills a leftover deputy session.
+// Called when the deputy is disabled so a stale deputy isn't left standing by.
+func (d *Daemon) killDeputySession() {
+	name := mayor.DeputySessionName()
+	if exists, _ := d.tmux.HasSession(name); exists {
+		d.logger.Printf("Killing leftover %s session (deputy disabled)", name)
+		if err := d.tmux.KillSessionWithProcesses(name); err != nil {
+			d.logger.Printf("Error killing %s session: %v", name, err)
+		}
+	}
+}
+
+func orNever(s string) string {
+	if s == "" {
+		return "never"
+	}
+	return s
+}
@@ -0,0 +1,49 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecideDeputy(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	lapse := 5 * time.Minute
+	tests := []struct {
+		name        string
+		mayorAlive  bool
+		deputyAlive bool
+		lastSeen    time.Time
+		want        deputyAction
+	}{
+		{"both running", true, true, now, deputyStandBy},
+		{"mayor without deputy", true, false, now, deputyStart},
+		{"mayor down within lapse", false, true, now.Add(-2 * time.Minute), deputyWait},
+		{"mayor lapsed", false, true, now.Add(-lapse), deputyPromote},
+		{"mayor never seen", false, true, time.Time{}, deputyPromote},
+		{"mayor down, no deputy", false, false, now.Add(-time.Hour), deputyColdStart},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decideDeputy(tt.mayorAlive, tt.deputyAlive, tt.lastSeen, now, lapse); got != tt.want {
+				t.Errorf("decideDeputy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeputyLapse(t *testing.T) {
+	if got := deputyLapse(nil); got != defaultDeputyLapse {
+		t.Errorf("deputyLapse(nil) = %v, want %v", got, defaultDeputyLapse)
+	}
+	cfg := &DaemonPatrolConfig{Deputy: &DeputyConfig{Enabled: true, LapseAfter: "90s"}}
+	if got := deputyLapse(cfg); got != 90*time.Second {
+		t.Errorf("deputyLapse(90s) = %v", got)
+	}
+	cfg.Deputy.LapseAfter = "soon"
+	if got := deputyLapse(cfg); got != defaultDeputyLapse {
+		t.Errorf("invalid lapse_after should fall back to the default, got %v", got)
+	}
+	if deputyEnabled(&DaemonPatrolConfig{Deputy: &DeputyConfig{}}) {
+		t.Error("deputy should be off unless enabled")
+	}
+}
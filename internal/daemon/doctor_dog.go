@@ -112,6 +112,7 @@ func (d *Daemon) runDoctorDog() {
 
 	if mol.rootID == "" {
 		d.logger.Printf("doctor_dog: molecule pour failed (non-fatal), skipping cycle")
+		d.failPatrol("molecule pour failed")
 		return
 	}
 
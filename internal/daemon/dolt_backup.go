@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/decision"
 )
 
 const (
@@ -49,6 +50,7 @@ func (d *Daemon) syncDoltBackups() {
 	}
 	if _, err := os.Stat(dataDir); os.IsNotExist(err) {
 		d.logger.Printf("dolt_backup: data dir %s does not exist, skipping", dataDir)
+		d.skipPatrol(decision.ReasonNotReady, "data dir %s does not exist", dataDir)
 		mol.failStep("sync", "data dir does not exist")
 		return
 	}
@@ -61,6 +63,7 @@ func (d *Daemon) syncDoltBackups() {
 
 	if len(databases) == 0 {
 		d.logger.Printf("dolt_backup: no databases with backup remotes found")
+		d.skipPatrol(decision.ReasonNotReady, "no databases with backup remotes")
 		mol.failStep("sync", "no databases with backup remotes")
 		return
 	}
@@ -9,6 +9,8 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/decision"
 )
 
 const (
@@ -36,12 +38,14 @@ func (d *Daemon) pushDoltRemotes() {
 	// Need dolt server to be configured for data dir
 	if d.doltServer == nil || !d.doltServer.IsEnabled() {
 		d.logger.Printf("dolt_remotes: dolt server not configured, skipping")
+		d.skipPatrol(decision.ReasonNotReady, "dolt server not configured")
 		return
 	}
 
 	dataDir := d.doltServer.config.DataDir
 	if dataDir == "" {
 		d.logger.Printf("dolt_remotes: no data dir configured, skipping")
+		d.skipPatrol(decision.ReasonNotReady, "no dolt data dir configured")
 		return
 	}
 
@@ -65,12 +69,14 @@ func (d *Daemon) pushDoltRemotes() {
 		}
 		if err != nil {
 			d.logger.Printf("dolt_remotes: error discovering databases: %v", err)
+			d.failPatrol("discovering databases: %v", err)
 			return
 		}
 	}
 
 	if len(databases) == 0 {
 		d.logger.Printf("dolt_remotes: no databases with remotes found")
+		d.skipPatrol(decision.ReasonNotReady, "no databases with remotes")
 		return
 	}
 
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/decision"
 )
 
 const (
@@ -94,6 +95,7 @@ func (d *Daemon) syncJsonlGitBackup() {
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
 			d.logger.Printf("jsonl_git_backup: cannot determine home dir: %v", err)
+			d.failPatrol("cannot determine home dir: %v", err)
 			return
 		}
 		gitRepo = filepath.Join(homeDir, ".dolt-archive", "git")
@@ -102,6 +104,7 @@ func (d *Daemon) syncJsonlGitBackup() {
 	// Verify git repo exists.
 	if _, err := os.Stat(filepath.Join(gitRepo, ".git")); os.IsNotExist(err) {
 		d.logger.Printf("jsonl_git_backup: git repo %s does not exist, skipping", gitRepo)
+		d.skipPatrol(decision.ReasonNotReady, "git repo %s does not exist", gitRepo)
 		return
 	}
 
@@ -115,6 +118,7 @@ func (d *Daemon) syncJsonlGitBackup() {
 	databases := config.Databases
 	if len(databases) == 0 {
 		d.logger.Printf("jsonl_git_backup: no databases configured, skipping")
+		d.skipPatrol(decision.ReasonNotReady, "no databases configured")
 		return
 	}
 
@@ -127,6 +131,7 @@ func (d *Daemon) syncJsonlGitBackup() {
 	}
 	if _, err := os.Stat(dataDir); os.IsNotExist(err) {
 		d.logger.Printf("jsonl_git_backup: data dir %s does not exist, skipping", dataDir)
+		d.skipPatrol(decision.ReasonNotReady, "data dir %s does not exist", dataDir)
 		return
 	}
 
@@ -3,6 +3,7 @@ package daemon
 import (
 	"context"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -23,6 +24,10 @@ type daemonMetrics struct {
 	// polecatSpawns counts polecat session spawns, labeled by rig name.
 	polecatSpawns metric.Int64Counter
 
+	// patrolDrift records how late (or early) each patrol and heartbeat
+	// tick fired against its scheduled interval, labeled by patrol.
+	patrolDrift metric.Float64Histogram
+
 	// doltMu protects dolt gauge values written by the health check goroutine.
 	doltMu             sync.RWMutex
 	doltConnections    int64
@@ -62,6 +67,14 @@ func newDaemonMetrics() (*daemonMetrics, error) {
 		return nil, err
 	}
 
+	dm.patrolDrift, err = m.Float64Histogram("gastown.daemon.patrol.drift_seconds",
+		metric.WithDescription("Patrol tick lateness against its scheduled interval"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Dolt observable gauges — values are updated by health checks and
 	// collected by the SDK on each export interval.
 	connGauge, err := m.Int64ObservableGauge("gastown.dolt.connections",
@@ -147,6 +160,16 @@ func (dm *daemonMetrics) recordPolecatSpawn(ctx context.Context, rigName string)
 	)
 }
 
+// recordPatrolDrift records one tick's drift, labeled with the patrol name.
+func (dm *daemonMetrics) recordPatrolDrift(ctx context.Context, patrol string, drift time.Duration) {
+	if dm == nil {
+		return
+	}
+	dm.patrolDrift.Record(ctx, drift.Seconds(),
+		metric.WithAttributes(attribute.String("patrol", patrol)),
+	)
+}
+
 // updateDoltHealth stores the latest Dolt health snapshot for observable gauges.
 func (dm *daemonMetrics) updateDoltHealth(conns, maxConns int64, latencyMs float64, diskBytes int64, healthy bool) {
 	if dm == nil {
@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
 )
 
 func TestLoadPatrolConfig(t *testing.T) {
@@ -45,6 +47,27 @@ func TestLoadPatrolConfig(t *testing.T) {
 	}
 }
 
+func TestPatrolEnv_ResolvesSecrets(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := config.SaveSecrets(townRoot, map[string]string{"API_KEY": "s3cret"}); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &DaemonPatrolConfig{Env: map[string]string{
+		"ANTHROPIC_API_KEY": config.SecretRef("API_KEY"),
+		"GT_DOLT_PORT":      "3308",
+	}}
+	env := PatrolEnv(townRoot, cfg)
+	if env["ANTHROPIC_API_KEY"] != "s3cret" || env["GT_DOLT_PORT"] != "3308" {
+		t.Errorf("PatrolEnv = %v", env)
+	}
+	if cfg.Env["ANTHROPIC_API_KEY"] != config.SecretRef("API_KEY") {
+		t.Error("PatrolEnv modified the config")
+	}
+	if PatrolEnv(townRoot, nil) != nil {
+		t.Error("nil config should have no env")
+	}
+}
+
 func TestIsPatrolEnabled_NilConfig(t *testing.T) {
 	// Should default to enabled when config is nil
 	if !IsPatrolEnabled(nil, "refinery") {
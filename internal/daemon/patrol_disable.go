@@ -0,0 +1,162 @@
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/decision"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// PatrolDisable temporarily switches a patrol off (gt patrol disable). It
+// lives beside mayor/daemon.json rather than in it, so the patrol's own
+// config survives the pause and the daemon notices without a restart. A
+// disable lapses on its own at Until.
+type PatrolDisable struct {
+	Patrol string    `json:"patrol"`
+	Reason string    `json:"reason"`
+	By     string    `json:"by,omitempty"`
+	At     time.Time `json:"at"`
+	Until  time.Time `json:"until"`
+}
+
+// Active reports whether the disable is still in force at now.
+func (p PatrolDisable) Active(now time.Time) bool {
+	return now.Before(p.Until)
+}
+
+// PatrolDisablesFile returns the path of the temporary patrol disables.
+func PatrolDisablesFile(townRoot string) string {
+	return filepath.Join(townRoot, constants.RoleMayor, "patrol-disables.json")
+}
+
+// LoadPatrolDisables reads the temporary patrol disables, keyed by patrol,
+// including lapsed ones not yet cleared. A missing file means none.
+func LoadPatrolDisables(townRoot string) (map[string]PatrolDisable, error) {
+	disables := map[string]PatrolDisable{}
+	data, err := os.ReadFile(PatrolDisablesFile(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if os.IsNotExist(err) {
+		return disables, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading patrol disables: %w", err)
+	}
+	var list []PatrolDisable
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("parsing patrol disables: %w", err)
+	}
+	for _, p := range list {
+		disables[p.Patrol] = p
+	}
+	return disables, nil
+}
+
+// SavePatrolDisables writes the temporary patrol disables, sorted by patrol.
+// An empty set removes the file.
+func SavePatrolDisables(townRoot string, disables map[string]PatrolDisable) error {
+	path := PatrolDisablesFile(townRoot)
+	if len(disables) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	list := make([]PatrolDisable, 0, len(disables))
+	for _, p := range disables {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Patrol < list[j].Patrol })
+	return util.EnsureDirAndWriteJSON(path, list)
+}
+
+// UpdatePatrolDisables applies fn to the temporary patrol disables and saves
+// the result, holding a file lock so gt patrol disable/enable and the
+// daemon's expiry sweep cannot overwrite each other. If fn returns an error
+// nothing is saved.
+func UpdatePatrolDisables(townRoot string, fn func(map[string]PatrolDisable) error) error {
+	path := PatrolDisablesFile(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating patrol disables dir: %w", err)
+	}
+	fl := flock.New(path + ".lock")
+	if err := fl.Lock(); err != nil {
+		return fmt.Errorf("acquiring patrol disables lock: %w", err)
+	}
+	defer fl.Unlock() //nolint:errcheck // best-effort unlock
+
+	disables, err := LoadPatrolDisables(townRoot)
+	if err != nil {
+		return err
+	}
+	if err := fn(disables); err != nil {
+		return err
+	}
+	return SavePatrolDisables(townRoot, disables)
+}
+
+// ActivePatrolDisable returns the disable in force for patrol, if any.
+func ActivePatrolDisable(townRoot, patrol string, now time.Time) (PatrolDisable, bool) {
+	disables, err := LoadPatrolDisables(townRoot)
+	if err != nil {
+		return PatrolDisable{}, false
+	}
+	p, ok := disables[patrol]
+	if !ok || !p.Active(now) {
+		return PatrolDisable{}, false
+	}
+	return p, true
+}
+
+// patrolDisabled reports whether patrol is temporarily disabled, recording
+// the skip for gt why.
+func (d *Daemon) patrolDisabled(name string) bool {
+	p, ok := ActivePatrolDisable(d.config.TownRoot, name, time.Now())
+	if !ok {
+		return false
+	}
+	d.recordPatrol(name, decision.OutcomeSkipped, decision.ReasonDisabled,
+		fmt.Sprintf("disabled until %s: %s", p.Until.Local().Format("2006-01-02 15:04"), p.Reason))
+	return true
+}
+
+// expirePatrolDisables clears lapsed patrol disables and audits each
+// patrol coming back on.
+func (d *Daemon) expirePatrolDisables() {
+	if disables, err := LoadPatrolDisables(d.config.TownRoot); err != nil || len(disables) == 0 {
+		return
+	}
+	now := time.Now()
+	var expired []PatrolDisable
+	errNoneExpired := errors.New("no expired disables")
+	err := UpdatePatrolDisables(d.config.TownRoot, func(disables map[string]PatrolDisable) error {
+		for name, p := range disables {
+			if !p.Active(now) {
+				expired = append(expired, p)
+				delete(disables, name)
+			}
+		}
+		if len(expired) == 0 {
+			return errNoneExpired
+		}
+		return nil
+	})
+	if errors.Is(err, errNoneExpired) {
+		return
+	}
+	if err != nil {
+		d.logger.Printf("Failed to clear expired patrol disables: %v", err)
+		return
+	}
+	for _, p := range expired {
+		d.logger.Printf("Patrol %s re-enabled: disable expired (%s)", p.Patrol, p.Reason)
+		_ = events.LogAudit(events.TypePatrolEnabled, "daemon", events.PatrolDisablePayload(p.Patrol, "expired: "+p.Reason, time.Time{}))
+	}
+}
@@ -0,0 +1,98 @@
+package daemon
+
+import (
+	"errors"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/decision"
+)
+
+func TestPatrolDisables_SkipAndExpire(t *testing.T) {
+	townRoot := t.TempDir()
+	d := &Daemon{
+		config:       &Config{TownRoot: townRoot},
+		patrolConfig: adaptiveTestConfig(),
+		logger:       log.New(io.Discard, "", 0),
+	}
+	now := time.Now()
+	if err := SavePatrolDisables(townRoot, map[string]PatrolDisable{
+		"wisp_reaper": {Patrol: "wisp_reaper", Reason: "debugging", At: now, Until: now.Add(time.Hour)},
+		"deacon":      {Patrol: "deacon", Reason: "lapsed", At: now.Add(-2 * time.Hour), Until: now.Add(-time.Minute)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	called := false
+	d.runPatrol("wisp_reaper", func() { called = true })
+	if called {
+		t.Error("temporarily disabled patrol ran")
+	}
+	got, _ := decision.Query(townRoot, decision.KindPatrol, "wisp_reaper", 0)
+	if len(got) != 1 || got[0].Reason != decision.ReasonDisabled || got[0].Detail == "" {
+		t.Errorf("wisp_reaper decisions = %+v, want one disabled skip with detail", got)
+	}
+	if _, ok := ActivePatrolDisable(townRoot, "deacon", now); ok {
+		t.Error("lapsed disable reported active")
+	}
+
+	d.expirePatrolDisables()
+	disables, err := LoadPatrolDisables(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := disables["deacon"]; ok || len(disables) != 1 {
+		t.Errorf("after expiry disables = %v, want only wisp_reaper", disables)
+	}
+
+	if err := SavePatrolDisables(townRoot, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(PatrolDisablesFile(townRoot)); !os.IsNotExist(err) {
+		t.Errorf("empty disables left %s behind", PatrolDisablesFile(townRoot))
+	}
+}
+
+func TestUpdatePatrolDisables(t *testing.T) {
+	townRoot := t.TempDir()
+	until := time.Now().Add(time.Hour)
+	add := func(name string) func(map[string]PatrolDisable) error {
+		return func(disables map[string]PatrolDisable) error {
+			disables[name] = PatrolDisable{Patrol: name, Reason: "test", Until: until}
+			return nil
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"deacon", "witness", "refinery", "compactor"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := UpdatePatrolDisables(townRoot, add(name)); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	disables, err := LoadPatrolDisables(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(disables) != 4 {
+		t.Errorf("concurrent updates kept %d disables, want 4", len(disables))
+	}
+
+	if err := UpdatePatrolDisables(townRoot, func(disables map[string]PatrolDisable) error {
+		delete(disables, "deacon")
+		return errors.New("boom")
+	}); err == nil {
+		t.Fatal("UpdatePatrolDisables should return fn's error")
+	}
+	if _, ok := ActivePatrolDisable(townRoot, "deacon", time.Now()); !ok {
+		t.Error("a failed update must not be saved")
+	}
+}
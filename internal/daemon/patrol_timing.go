@@ -0,0 +1,266 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+const (
+	// defaultJitterPercent is how far each interval may move either way
+	// when jitter is enabled without a percent.
+	defaultJitterPercent = 10
+
+	// maxJitterPercent keeps a jittered interval from collapsing to zero.
+	maxJitterPercent = 50
+
+	// minDriftTolerance is the smallest lateness reported as drift; below
+	// it a tick is on time whatever its interval.
+	minDriftTolerance = 10 * time.Second
+
+	// heartbeatTimer names the recovery heartbeat in timing state.
+	heartbeatTimer = "heartbeat"
+)
+
+// JitterConfig spreads patrol wakeups so that patrols sharing an interval
+// don't all fire at once. Each interval is moved by up to Percent either
+// way, and with SpreadStart each patrol's first run is offset by a stable
+// fraction of its interval.
+type JitterConfig struct {
+	// Enabled turns jitter on.
+	Enabled bool `json:"enabled"`
+
+	// Percent is the largest deviation of each interval, in percent. Default: 10.
+	Percent int `json:"percent,omitempty"`
+
+	// PerPatrol overrides Percent for individual patrols, keyed by patrol
+	// name ("heartbeat" for the recovery heartbeat). 0 disables jitter for
+	// that patrol.
+	PerPatrol map[string]int `json:"per_patrol,omitempty"`
+
+	// SpreadStart offsets each ticker patrol's first run. Default: true.
+	SpreadStart *bool `json:"spread_start,omitempty"`
+}
+
+// jitterPercent returns the jitter for a patrol, or 0 when it has none.
+func (c *JitterConfig) jitterPercent(name string) int {
+	if c == nil || !c.Enabled {
+		return 0
+	}
+	pct := c.Percent
+	if pct <= 0 {
+		pct = defaultJitterPercent
+	}
+	if p, ok := c.PerPatrol[name]; ok {
+		pct = p
+	}
+	return min(max(pct, 0), maxJitterPercent)
+}
+
+// spreadStart reports whether first runs are offset.
+func (c *JitterConfig) spreadStart() bool {
+	if c == nil || !c.Enabled {
+		return false
+	}
+	return c.SpreadStart == nil || *c.SpreadStart
+}
+
+// jitterInterval moves base by a random amount within pct percent.
+func jitterInterval(base time.Duration, pct int) time.Duration {
+	if pct <= 0 || base <= 0 {
+		return base
+	}
+	span := base * time.Duration(pct) / 100
+	if span <= 0 {
+		return base
+	}
+	return base - span + rand.N(2*span+1) //nolint:gosec // scheduling jitter, not security
+}
+
+// spreadOffset returns a patrol's first-run delay: a fraction of interval
+// derived from the patrol's name, so it is stable across restarts and
+// differs between patrols.
+func spreadOffset(name string, interval time.Duration) time.Duration {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	offset := interval * time.Duration(h.Sum32()%1000+1) / 1000
+	return max(offset, time.Second)
+}
+
+// PatrolTiming is the observed tick timing of one patrol (or the recovery
+// heartbeat), persisted so gt patrol status can report drift.
+type PatrolTiming struct {
+	Configured time.Duration `json:"configured"`
+	Scheduled  time.Duration `json:"scheduled"` // Last armed interval, jitter included
+	JitterPct  int           `json:"jitter_pct,omitempty"`
+	Ticks      int           `json:"ticks"`
+	LastTick   time.Time     `json:"last_tick,omitempty"`
+	LastActual time.Duration `json:"last_actual,omitempty"` // Time from arming to the tick being handled
+	LastDrift  time.Duration `json:"last_drift"`            // LastActual minus the scheduled interval
+	MaxDrift   time.Duration `json:"max_drift"`
+}
+
+// DriftTolerance is the lateness tolerated for a tick scheduled after d.
+func DriftTolerance(d time.Duration) time.Duration {
+	return max(d/10, minDriftTolerance)
+}
+
+// Drifting reports whether the last tick fired later than tolerated. A
+// late tick usually means the daemon loop was blocked by a slow patrol.
+func (t PatrolTiming) Drifting() bool {
+	return t.Ticks > 0 && t.LastDrift > DriftTolerance(t.Scheduled)
+}
+
+// patrolTimer tracks one patrol's ticker or timer so each tick can be
+// measured and the next one jittered.
+// Only accessed from the main loop goroutine - no sync needed.
+type patrolTimer struct {
+	PatrolTiming
+	armedAt time.Time
+	reset   func(time.Duration)
+	oneShot bool // A timer its caller re-arms (see armPatrol), not a ticker
+}
+
+// PatrolTimingFile returns the path of the persisted tick timing.
+func PatrolTimingFile(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "patrol-timing.json")
+}
+
+// LoadPatrolTiming reads the persisted tick timing, keyed by patrol.
+// Returns an empty map if the daemon has not written any.
+func LoadPatrolTiming(townRoot string) (map[string]PatrolTiming, error) {
+	state := map[string]PatrolTiming{}
+	data, err := os.ReadFile(PatrolTimingFile(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", PatrolTimingFile(townRoot), err)
+	}
+	return state, nil
+}
+
+func savePatrolTiming(townRoot string, timers map[string]*patrolTimer) error {
+	state := make(map[string]PatrolTiming, len(timers))
+	for name, t := range timers {
+		state[name] = t.PatrolTiming
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := PatrolTimingFile(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644) //nolint:gosec // G306: not sensitive
+}
+
+// jitterConfig returns the configured jitter, or nil.
+func (d *Daemon) jitterConfig() *JitterConfig {
+	if d.patrolConfig == nil {
+		return nil
+	}
+	return d.patrolConfig.Jitter
+}
+
+// trackPatrol registers a patrol's ticker or timer, already armed for
+// first, so its ticks are measured and rescheduled.
+func (d *Daemon) trackPatrol(name string, configured, first time.Duration, reset func(time.Duration), oneShot bool) {
+	if d.timers == nil {
+		d.timers = map[string]*patrolTimer{}
+	}
+	d.timers[name] = &patrolTimer{
+		PatrolTiming: PatrolTiming{
+			Configured: configured,
+			Scheduled:  first,
+			JitterPct:  d.jitterConfig().jitterPercent(name),
+		},
+		armedAt: time.Now(),
+		reset:   reset,
+		oneShot: oneShot,
+	}
+}
+
+// armPatrol reschedules a tracked patrol's next tick at base, jittered.
+// One-shot timers are re-armed by their caller after each tick with the
+// currently configured interval. No-op for untracked patrols.
+func (d *Daemon) armPatrol(name string, base time.Duration) {
+	t := d.timers[name]
+	if t == nil {
+		return
+	}
+	if t.oneShot {
+		t.Configured = base
+	}
+	t.Scheduled = jitterInterval(base, t.JitterPct)
+	t.armedAt = time.Now()
+	t.reset(t.Scheduled)
+}
+
+// observeTick measures a tracked patrol's tick against its schedule,
+// records the drift, and arms the next tick. No-op for untracked patrols.
+func (d *Daemon) observeTick(name string) {
+	t := d.timers[name]
+	if t == nil {
+		return
+	}
+	now := time.Now()
+	t.Ticks++
+	t.LastTick = now
+	t.LastActual = now.Sub(t.armedAt)
+	t.LastDrift = t.LastActual - t.Scheduled
+	t.MaxDrift = max(t.MaxDrift, t.LastDrift)
+	d.metrics.recordPatrolDrift(d.ctx, name, t.LastDrift)
+	if t.Drifting() {
+		d.logger.Printf("%s: tick drifted %v late (scheduled %v, fired after %v)",
+			name, t.LastDrift.Round(time.Second), t.Scheduled, t.LastActual.Round(time.Second))
+	}
+
+	base := t.Configured
+	if tu := d.tuners[name]; tu != nil {
+		base = tu.Effective
+	}
+	// Tickers keep their period, so only re-arm when the next interval
+	// differs: jitter, or the end of a spread start.
+	if !t.oneShot && (t.JitterPct > 0 || t.Scheduled != base) {
+		d.armPatrol(name, base)
+	} else {
+		t.armedAt = now
+	}
+
+	if err := savePatrolTiming(d.config.TownRoot, d.timers); err != nil {
+		d.logger.Printf("Warning: failed to save patrol timing: %v", err)
+	}
+}
+
+// newPatrolTicker starts a patrol's ticker and tracks it. Adaptive patrols
+// start at their effective interval. With jitter, the first tick is offset
+// (spread start) or jittered, and every later tick is jittered.
+func (d *Daemon) newPatrolTicker(name string, interval time.Duration) *time.Ticker {
+	base := interval
+	if t := d.tuners[name]; t != nil {
+		if t.Effective != interval {
+			d.logger.Printf("%s: resuming adaptive interval %v (configured %v)", name, t.Effective, interval)
+		}
+		base = t.Effective
+	}
+	jc := d.jitterConfig()
+	first := jitterInterval(base, jc.jitterPercent(name))
+	if jc.spreadStart() {
+		first = spreadOffset(name, base)
+		d.logger.Printf("%s: first run in %v (spread start)", name, first.Round(time.Second))
+	}
+	ticker := time.NewTicker(first)
+	d.trackPatrol(name, interval, first, ticker.Reset, false)
+	return ticker
+}
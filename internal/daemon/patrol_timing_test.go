@@ -0,0 +1,100 @@
+package daemon
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+)
+
+func TestJitterInterval(t *testing.T) {
+	base := 10 * time.Minute
+	for i := 0; i < 100; i++ {
+		got := jitterInterval(base, 10)
+		if got < 9*time.Minute || got > 11*time.Minute {
+			t.Fatalf("jitterInterval(10m, 10) = %v, want within 9m–11m", got)
+		}
+	}
+	if got := jitterInterval(base, 0); got != base {
+		t.Errorf("jitterInterval(10m, 0) = %v, want unchanged", got)
+	}
+}
+
+func TestJitterConfigPercent(t *testing.T) {
+	var off *JitterConfig
+	if off.jitterPercent("wisp_reaper") != 0 || off.spreadStart() {
+		t.Error("nil jitter config should disable jitter and spread start")
+	}
+	c := &JitterConfig{Enabled: true, PerPatrol: map[string]int{"doctor_dog": 0, "heartbeat": 90}}
+	if got := c.jitterPercent("wisp_reaper"); got != defaultJitterPercent {
+		t.Errorf("default percent = %d, want %d", got, defaultJitterPercent)
+	}
+	if got := c.jitterPercent("doctor_dog"); got != 0 {
+		t.Errorf("per-patrol 0 = %d, want jitter disabled", got)
+	}
+	if got := c.jitterPercent("heartbeat"); got != maxJitterPercent {
+		t.Errorf("per-patrol 90 = %d, want capped at %d", got, maxJitterPercent)
+	}
+	if !c.spreadStart() {
+		t.Error("spread start should default on when jitter is enabled")
+	}
+}
+
+func TestSpreadOffset(t *testing.T) {
+	interval := time.Hour
+	a := spreadOffset("dolt_backup", interval)
+	if a != spreadOffset("dolt_backup", interval) {
+		t.Error("spread offset should be stable for a patrol")
+	}
+	if a == spreadOffset("dolt_remotes", interval) {
+		t.Error("patrols sharing an interval should get different offsets")
+	}
+	if a <= 0 || a > interval {
+		t.Errorf("spreadOffset = %v, want within (0, %v]", a, interval)
+	}
+}
+
+func TestObserveTickRecordsDrift(t *testing.T) {
+	townRoot := t.TempDir()
+	cfg := &DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{DoctorDog: &DoctorDogConfig{Enabled: true, IntervalStr: "5m"}},
+	}
+	d := &Daemon{
+		config:       &Config{TownRoot: townRoot},
+		patrolConfig: cfg,
+		logger:       log.New(io.Discard, "", 0),
+	}
+	ticker := d.newPatrolTicker("doctor_dog", 5*time.Minute)
+	defer ticker.Stop()
+
+	// Pretend the tick was armed 7m ago: it fired 2m late.
+	d.timers["doctor_dog"].armedAt = time.Now().Add(-7 * time.Minute)
+	d.observeTick("doctor_dog")
+	d.observeTick("wisp_reaper") // untracked: must be a no-op, not a panic
+
+	tm := d.timers["doctor_dog"].PatrolTiming
+	if tm.Ticks != 1 || tm.LastDrift < 2*time.Minute || tm.LastDrift > 2*time.Minute+time.Second {
+		t.Fatalf("timing = %+v, want one tick ~2m late", tm)
+	}
+	if !tm.Drifting() {
+		t.Error("a tick 2m late on a 5m interval should be drifting")
+	}
+
+	for _, e := range PatrolSchedule(townRoot, cfg) {
+		if e.Name != "doctor_dog" {
+			continue
+		}
+		if e.Timing == nil || e.Timing.Ticks != 1 || !e.Timing.Drifting() {
+			t.Errorf("schedule timing = %+v, want the persisted drifting tick", e.Timing)
+		}
+		return
+	}
+	t.Fatal("doctor_dog missing from schedule")
+}
+
+func TestPatrolTimingOnTime(t *testing.T) {
+	tm := PatrolTiming{Scheduled: 3 * time.Minute, Ticks: 1, LastDrift: 5 * time.Second}
+	if tm.Drifting() {
+		t.Error("5s late on a 3m interval is within tolerance")
+	}
+}
@@ -0,0 +1,281 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+const (
+	defaultAdaptiveQuietRuns = 3
+	defaultAdaptiveBusyRuns  = 2
+
+	// Without configured bounds, an adaptive patrol may run as often as
+	// twice its base rate and as rarely as a quarter of it.
+	defaultAdaptiveMinFactor = 2
+	defaultAdaptiveMaxFactor = 4
+)
+
+// AdaptiveConfig enables outcome-driven patrol intervals. A patrol that
+// finds nothing QuietRuns times in a row doubles its interval (up to Max);
+// one that finds work BusyRuns times in a row halves it (down to Min).
+//
+// Only patrols that report an outcome are tuned: wisp_reaper (wisps
+// closed, purged, or auto-closed) and compactor_dog (databases compacted
+// or failed). The others keep their configured interval.
+type AdaptiveConfig struct {
+	// Enabled turns adaptive scheduling on.
+	Enabled bool `json:"enabled"`
+
+	// QuietRuns is how many consecutive empty runs stretch the interval. Default: 3.
+	QuietRuns int `json:"quiet_runs,omitempty"`
+
+	// BusyRuns is how many consecutive productive runs tighten it. Default: 2.
+	BusyRuns int `json:"busy_runs,omitempty"`
+
+	// Bounds limits each patrol's interval, keyed by patrol name.
+	// Default: half to four times the configured interval.
+	Bounds map[string]AdaptiveBounds `json:"bounds,omitempty"`
+}
+
+// AdaptiveBounds limits how far a patrol's interval may move.
+type AdaptiveBounds struct {
+	Min string `json:"min,omitempty"` // e.g. "10m"
+	Max string `json:"max,omitempty"` // e.g. "4h"
+}
+
+// adaptivePatrols maps each tunable patrol to its configured interval.
+var adaptivePatrols = map[string]func(*DaemonPatrolConfig) time.Duration{
+	"wisp_reaper":   wispReaperInterval,
+	"compactor_dog": compactorDogInterval,
+}
+
+// PatrolTuning is the adaptive state of one patrol, persisted so that
+// gt patrol status can show it and a daemon restart resumes from it.
+type PatrolTuning struct {
+	Base        time.Duration `json:"base"`
+	Min         time.Duration `json:"min"`
+	Max         time.Duration `json:"max"`
+	Effective   time.Duration `json:"effective"`
+	QuietStreak int           `json:"quiet_streak"`
+	BusyStreak  int           `json:"busy_streak"`
+	LastFound   int           `json:"last_found"`
+	LastRun     time.Time     `json:"last_run,omitempty"`
+}
+
+// patrolTuner adjusts one patrol's ticker from the outcomes of its runs.
+// Only accessed from the main loop goroutine - no sync needed.
+type patrolTuner struct {
+	PatrolTuning
+	quietRuns int
+	busyRuns  int
+}
+
+// PatrolTuningFile returns the path of the persisted adaptive state.
+func PatrolTuningFile(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "patrol-tuning.json")
+}
+
+// LoadPatrolTuning reads the persisted adaptive state, keyed by patrol.
+// Returns an empty map if the daemon has not written any.
+func LoadPatrolTuning(townRoot string) (map[string]PatrolTuning, error) {
+	state := map[string]PatrolTuning{}
+	data, err := os.ReadFile(PatrolTuningFile(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", PatrolTuningFile(townRoot), err)
+	}
+	return state, nil
+}
+
+func savePatrolTuning(townRoot string, tuners map[string]*patrolTuner) error {
+	state := make(map[string]PatrolTuning, len(tuners))
+	for name, t := range tuners {
+		state[name] = t.PatrolTuning
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := PatrolTuningFile(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644) //nolint:gosec // G306: not sensitive
+}
+
+// adaptiveBounds returns the min and max interval for a patrol.
+func adaptiveBounds(config *AdaptiveConfig, name string, base time.Duration) (time.Duration, time.Duration) {
+	lo, hi := base/defaultAdaptiveMinFactor, base*defaultAdaptiveMaxFactor
+	if b, ok := config.Bounds[name]; ok {
+		if d, err := time.ParseDuration(b.Min); err == nil && d > 0 {
+			lo = d
+		}
+		if d, err := time.ParseDuration(b.Max); err == nil && d > 0 {
+			hi = d
+		}
+	}
+	if lo > base {
+		lo = base
+	}
+	if hi < base {
+		hi = base
+	}
+	return lo, hi
+}
+
+// newPatrolTuners builds tuners for the enabled adaptive patrols, resuming
+// each from saved state when its configured interval hasn't changed.
+func newPatrolTuners(config *DaemonPatrolConfig, saved map[string]PatrolTuning) map[string]*patrolTuner {
+	if config == nil || config.Adaptive == nil || !config.Adaptive.Enabled {
+		return nil
+	}
+	ac := config.Adaptive
+	tuners := map[string]*patrolTuner{}
+	for name, interval := range adaptivePatrols {
+		if !IsPatrolEnabled(config, name) {
+			continue
+		}
+		base := interval(config)
+		lo, hi := adaptiveBounds(ac, name, base)
+		t := &patrolTuner{
+			PatrolTuning: PatrolTuning{Base: base, Min: lo, Max: hi, Effective: base},
+			quietRuns:    ac.QuietRuns,
+			busyRuns:     ac.BusyRuns,
+		}
+		if t.quietRuns <= 0 {
+			t.quietRuns = defaultAdaptiveQuietRuns
+		}
+		if t.busyRuns <= 0 {
+			t.busyRuns = defaultAdaptiveBusyRuns
+		}
+		if prev, ok := saved[name]; ok && prev.Base == base {
+			t.Effective = min(max(prev.Effective, lo), hi)
+			t.QuietStreak, t.BusyStreak = prev.QuietStreak, prev.BusyStreak
+			t.LastFound, t.LastRun = prev.LastFound, prev.LastRun
+		}
+		tuners[name] = t
+	}
+	return tuners
+}
+
+// observe records a run that found `found` items and returns true if the
+// effective interval changed.
+func (t *patrolTuner) observe(found int, now time.Time) bool {
+	t.LastFound, t.LastRun = found, now
+	if found == 0 {
+		t.QuietStreak++
+		t.BusyStreak = 0
+	} else {
+		t.BusyStreak++
+		t.QuietStreak = 0
+	}
+
+	next := t.Effective
+	switch {
+	case t.QuietStreak >= t.quietRuns:
+		next = min(t.Effective*2, t.Max)
+		t.QuietStreak = 0
+	case t.BusyStreak >= t.busyRuns:
+		next = max(t.Effective/2, t.Min)
+		t.BusyStreak = 0
+	}
+	if next == t.Effective {
+		return false
+	}
+	t.Effective = next
+	return true
+}
+
+// recordPatrolOutcome feeds a patrol run's result to its tuner, resetting
+// the patrol's ticker when the interval moves. No-op for patrols that are
+// not adaptively scheduled.
+func (d *Daemon) recordPatrolOutcome(name string, found int) {
+	t := d.tuners[name]
+	if t == nil {
+		return
+	}
+	prev := t.Effective
+	if t.observe(found, time.Now()) {
+		d.armPatrol(name, t.Effective)
+		verb := "stretched"
+		if t.Effective < prev {
+			verb = "tightened"
+		}
+		d.logger.Printf("%s: adaptive interval %s %v → %v (bounds %v–%v)", name, verb, prev, t.Effective, t.Min, t.Max)
+	}
+	if err := savePatrolTuning(d.config.TownRoot, d.tuners); err != nil {
+		d.logger.Printf("Warning: failed to save patrol tuning: %v", err)
+	}
+}
+
+// PatrolScheduleEntry describes one ticker-driven daemon patrol.
+type PatrolScheduleEntry struct {
+	Name      string         `json:"name"`
+	Enabled   bool           `json:"enabled"`
+	Interval  time.Duration  `json:"interval"`
+	Effective time.Duration  `json:"effective"`
+	Tuning    *PatrolTuning  `json:"tuning,omitempty"`  // Set for adaptively scheduled patrols
+	Timing    *PatrolTiming  `json:"timing,omitempty"`  // Observed tick timing, once the daemon has run it
+	Disable   *PatrolDisable `json:"disable,omitempty"` // Set while temporarily disabled (gt patrol disable)
+}
+
+// PatrolSchedule returns the configured and effective interval of every
+// ticker-driven patrol, using the daemon's persisted adaptive state and
+// tick timing.
+func PatrolSchedule(townRoot string, config *DaemonPatrolConfig) []PatrolScheduleEntry {
+	saved, _ := LoadPatrolTuning(townRoot)
+	tuners := newPatrolTuners(config, saved)
+	timing, _ := LoadPatrolTiming(townRoot)
+	disables, _ := LoadPatrolDisables(townRoot)
+	now := time.Now()
+
+	patrols := []struct {
+		name     string
+		interval func(*DaemonPatrolConfig) time.Duration
+	}{
+		{"dolt_remotes", doltRemotesInterval},
+		{"dolt_backup", doltBackupInterval},
+		{"jsonl_git_backup", jsonlGitBackupInterval},
+		{"wisp_reaper", wispReaperInterval},
+		{"doctor_dog", doctorDogInterval},
+		{"compactor_dog", compactorDogInterval},
+		{"scheduled_maintenance", maintenanceCheckInterval},
+		{"town_backup", TownBackupInterval},
+		{"conventions", conventionsInterval},
+		{"roster", rosterInterval},
+		{"branch_sweep", branchSweepInterval},
+		{"rig_dolt_servers", rigDoltServersInterval},
+	}
+	entries := make([]PatrolScheduleEntry, 0, len(patrols))
+	for _, p := range patrols {
+		e := PatrolScheduleEntry{
+			Name:     p.name,
+			Enabled:  IsPatrolEnabled(config, p.name),
+			Interval: p.interval(config),
+		}
+		e.Effective = e.Interval
+		if t := tuners[p.name]; t != nil {
+			tuning := t.PatrolTuning
+			e.Effective = tuning.Effective
+			e.Tuning = &tuning
+		}
+		if t, ok := timing[p.name]; ok {
+			e.Timing = &t
+		}
+		if dis, ok := disables[p.name]; ok && dis.Active(now) {
+			e.Disable = &dis
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
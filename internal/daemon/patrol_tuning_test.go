@@ -0,0 +1,123 @@
+package daemon
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+)
+
+func adaptiveTestConfig() *DaemonPatrolConfig {
+	return &DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{
+			WispReaper:   &WispReaperConfig{Enabled: true, IntervalStr: "30m"},
+			CompactorDog: &CompactorDogConfig{Enabled: false},
+		},
+		Adaptive: &AdaptiveConfig{
+			Enabled: true,
+			Bounds:  map[string]AdaptiveBounds{"wisp_reaper": {Min: "10m", Max: "1h"}},
+		},
+	}
+}
+
+func TestPatrolTunerStretchesAndTightens(t *testing.T) {
+	tuners := newPatrolTuners(adaptiveTestConfig(), nil)
+	if _, ok := tuners["compactor_dog"]; ok {
+		t.Error("disabled patrol should not be tuned")
+	}
+	tu := tuners["wisp_reaper"]
+	if tu == nil || tu.Effective != 30*time.Minute || tu.Min != 10*time.Minute || tu.Max != time.Hour {
+		t.Fatalf("tuner = %+v", tu)
+	}
+
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		if tu.observe(0, now) {
+			t.Fatalf("interval changed after %d quiet run(s)", i+1)
+		}
+	}
+	if !tu.observe(0, now) || tu.Effective != time.Hour {
+		t.Fatalf("after 3 quiet runs effective = %v, want 1h", tu.Effective)
+	}
+	for i := 0; i < 3; i++ {
+		tu.observe(0, now)
+	}
+	if tu.Effective != time.Hour {
+		t.Errorf("effective = %v, want capped at max 1h", tu.Effective)
+	}
+
+	tu.observe(5, now)
+	if !tu.observe(2, now) || tu.Effective != 30*time.Minute {
+		t.Fatalf("after 2 busy runs effective = %v, want 30m", tu.Effective)
+	}
+	tu.observe(1, now)
+	tu.observe(1, now)
+	tu.observe(1, now)
+	tu.observe(1, now)
+	if tu.Effective != 10*time.Minute {
+		t.Errorf("effective = %v, want floored at min 10m", tu.Effective)
+	}
+
+	tu.observe(1, now)
+	tu.observe(0, now)
+	if tu.BusyStreak != 0 || tu.QuietStreak != 1 {
+		t.Errorf("a quiet run should reset the busy streak: %+v", tu.PatrolTuning)
+	}
+}
+
+func TestPatrolTunersDisabledByDefault(t *testing.T) {
+	cfg := adaptiveTestConfig()
+	cfg.Adaptive.Enabled = false
+	if tuners := newPatrolTuners(cfg, nil); tuners != nil {
+		t.Errorf("tuners = %v, want nil when adaptive scheduling is off", tuners)
+	}
+	d := &Daemon{}
+	d.recordPatrolOutcome("wisp_reaper", 3) // must be a no-op, not a panic
+}
+
+func TestRecordPatrolOutcomePersistsAndResumes(t *testing.T) {
+	townRoot := t.TempDir()
+	cfg := adaptiveTestConfig()
+	d := &Daemon{
+		config:       &Config{TownRoot: townRoot},
+		patrolConfig: cfg,
+		logger:       log.New(io.Discard, "", 0),
+		tuners:       newPatrolTuners(cfg, nil),
+	}
+	ticker := d.newPatrolTicker("wisp_reaper", wispReaperInterval(cfg))
+	defer ticker.Stop()
+	for i := 0; i < 3; i++ {
+		d.recordPatrolOutcome("wisp_reaper", 0)
+	}
+
+	schedule := PatrolSchedule(townRoot, cfg)
+	var found bool
+	for _, e := range schedule {
+		if e.Name != "wisp_reaper" {
+			if e.Tuning != nil {
+				t.Errorf("%s should not be tuned", e.Name)
+			}
+			continue
+		}
+		found = true
+		if e.Interval != 30*time.Minute || e.Effective != time.Hour || e.Tuning == nil {
+			t.Errorf("wisp_reaper schedule = %+v, want 30m configured, 1h effective", e)
+		}
+	}
+	if !found {
+		t.Fatal("wisp_reaper missing from schedule")
+	}
+
+	saved, err := LoadPatrolTuning(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed := newPatrolTuners(cfg, saved)["wisp_reaper"]; resumed.Effective != time.Hour {
+		t.Errorf("resumed effective = %v, want 1h", resumed.Effective)
+	}
+
+	cfg.Patrols.WispReaper.IntervalStr = "20m"
+	if fresh := newPatrolTuners(cfg, saved)["wisp_reaper"]; fresh.Effective != 20*time.Minute {
+		t.Errorf("changed base interval should discard saved state, got %v", fresh.Effective)
+	}
+}
@@ -0,0 +1,662 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/doltserver"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+const (
+	defaultRigDoltInterval        = 30 * time.Second
+	defaultRigDoltBasePort        = 3400
+	defaultRigDoltRestartDelay    = 5 * time.Second
+	defaultRigDoltMaxRestartDelay = 5 * time.Minute
+	defaultRigDoltMaxRestarts     = 5
+	defaultRigDoltRestartWindow   = 10 * time.Minute
+
+	// rigDoltStartGrace is how long a new server may fail probes before it
+	// counts as failed.
+	rigDoltStartGrace = 30 * time.Second
+
+	// rigDoltPortSpan bounds the port search above the base port.
+	rigDoltPortSpan = 1000
+)
+
+// RigDoltServersConfig holds configuration for the rig_dolt_servers patrol.
+// The patrol supervises a dedicated dolt sql-server for each listed rig,
+// for rigs whose beads traffic should not share the town server. Each
+// server gets its own port and data directory (.dolt-data-rigs/<rig>); once
+// the rig's database is in that directory, the rig's beads are pointed at
+// the server. Servers are probed every interval and restarted with
+// exponential backoff; a server that keeps dying is left down and escalated
+// to the mayor. gt doctor's rig-dolt-servers check shows their health.
+//
+// When a rig is dropped from Rigs, or the patrol is disabled, its beads are
+// pointed back at the town server and its server is stopped; its database
+// has to be moved back into the town data directory by hand.
+type RigDoltServersConfig struct {
+	// Enabled controls whether the patrol runs.
+	Enabled bool `json:"enabled"`
+
+	// Rigs lists the rigs that get a dedicated server. Other rigs stay on
+	// the town server.
+	Rigs []string `json:"rigs,omitempty"`
+
+	// Host is the address servers bind to. Default: 127.0.0.1.
+	Host string `json:"host,omitempty"`
+
+	// BasePort is the first port tried when allocating. Default: 3400.
+	BasePort int `json:"base_port,omitempty"`
+
+	// IntervalStr is how often servers are probed (e.g., "1m"). Default: 30s.
+	IntervalStr string `json:"interval,omitempty"`
+
+	// RestartDelayStr is the first restart delay; it doubles with each
+	// restart up to MaxRestartDelayStr. Defaults: 5s and 5m.
+	RestartDelayStr    string `json:"restart_delay,omitempty"`
+	MaxRestartDelayStr string `json:"max_restart_delay,omitempty"`
+
+	// MaxRestarts within RestartWindowStr marks a server as crash-looping:
+	// it is not restarted again until the window passes. Defaults: 5, 10m.
+	MaxRestarts      int    `json:"max_restarts,omitempty"`
+	RestartWindowStr string `json:"restart_window,omitempty"`
+}
+
+// rigDoltSettings is RigDoltServersConfig with defaults applied.
+type rigDoltSettings struct {
+	rigs            []string
+	host            string
+	basePort        int
+	restartDelay    time.Duration
+	maxRestartDelay time.Duration
+	maxRestarts     int
+	restartWindow   time.Duration
+}
+
+// rigDoltServersInterval returns the configured probe interval, or the default (30s).
+func rigDoltServersInterval(config *DaemonPatrolConfig) time.Duration {
+	if config != nil && config.Patrols != nil && config.Patrols.RigDoltServers != nil {
+		if d, err := time.ParseDuration(config.Patrols.RigDoltServers.IntervalStr); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultRigDoltInterval
+}
+
+func newRigDoltSettings(cfg *RigDoltServersConfig) rigDoltSettings {
+	s := rigDoltSettings{
+		host:            "127.0.0.1",
+		basePort:        defaultRigDoltBasePort,
+		restartDelay:    defaultRigDoltRestartDelay,
+		maxRestartDelay: defaultRigDoltMaxRestartDelay,
+		maxRestarts:     defaultRigDoltMaxRestarts,
+		restartWindow:   defaultRigDoltRestartWindow,
+	}
+	if cfg == nil {
+		return s
+	}
+	s.rigs = append(s.rigs, cfg.Rigs...)
+	sort.Strings(s.rigs)
+	if cfg.Host != "" {
+		s.host = cfg.Host
+	}
+	if cfg.BasePort > 0 {
+		s.basePort = cfg.BasePort
+	}
+	if cfg.MaxRestarts > 0 {
+		s.maxRestarts = cfg.MaxRestarts
+	}
+	for _, f := range []struct {
+		val string
+		dst *time.Duration
+	}{
+		{cfg.RestartDelayStr, &s.restartDelay},
+		{cfg.MaxRestartDelayStr, &s.maxRestartDelay},
+		{cfg.RestartWindowStr, &s.restartWindow},
+	} {
+		if d, err := time.ParseDuration(f.val); err == nil && d > 0 {
+			*f.dst = d
+		}
+	}
+	return s
+}
+
+// Rig server statuses.
+const (
+	RigDoltRunning   = "running"    // Answering probes
+	RigDoltStarting  = "starting"   // Started, not answering yet
+	RigDoltBackoff   = "backoff"    // Down, waiting to restart
+	RigDoltCrashLoop = "crash-loop" // Restart cap reached; escalated
+)
+
+// RigDoltServer is the supervisor's record of one rig's server.
+type RigDoltServer struct {
+	Rig      string `json:"rig"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	PID      int    `json:"pid,omitempty"`
+	DataDir  string `json:"data_dir"`
+	Database string `json:"database"`
+	Status   string `json:"status"`
+
+	// Serving reports whether the rig's beads point at this server. They
+	// are pointed at it once Database is in DataDir.
+	Serving bool `json:"serving"`
+
+	// Restarts counts starts within the restart window.
+	Restarts    int       `json:"restarts"`
+	NextRestart time.Time `json:"next_restart,omitempty"`
+	LastError   string    `json:"last_error,omitempty"`
+	StartedAt   time.Time `json:"started_at,omitempty"`
+	LastHealthy time.Time `json:"last_healthy,omitempty"`
+}
+
+// Addr returns the server's host:port.
+func (s *RigDoltServer) Addr() string {
+	return net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+}
+
+// RigDoltState is the supervisor's last view of every rig server, written to
+// daemon/rig-dolt-servers.json after each patrol.
+type RigDoltState struct {
+	UpdatedAt time.Time                 `json:"updated_at"`
+	Servers   map[string]*RigDoltServer `json:"servers"`
+}
+
+// RigDoltStateFile returns the path of the rig server state file.
+func RigDoltStateFile(townRoot string) string {
+	return filepath.Join(townRoot, "daemon", "rig-dolt-servers.json")
+}
+
+// RigDoltDataDir returns the data directory of a rig's dedicated server.
+func RigDoltDataDir(townRoot, rigName string) string {
+	return filepath.Join(townRoot, ".dolt-data-rigs", rigName)
+}
+
+// LoadRigDoltState reads the rig server state. A missing file is an empty state.
+func LoadRigDoltState(townRoot string) (*RigDoltState, error) {
+	state := &RigDoltState{Servers: map[string]*RigDoltServer{}}
+	data, err := os.ReadFile(RigDoltStateFile(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filepath.Base(RigDoltStateFile(townRoot)), err)
+	}
+	if state.Servers == nil {
+		state.Servers = map[string]*RigDoltServer{}
+	}
+	return state, nil
+}
+
+// rigDoltProc is a supervised server and its restart bookkeeping.
+type rigDoltProc struct {
+	RigDoltServer
+	process      *os.Process
+	restartTimes []time.Time
+	delay        time.Duration
+	escalated    bool
+}
+
+// RigDoltSupervisor starts, probes, and restarts the dedicated Dolt servers
+// of the rigs listed in the rig_dolt_servers patrol config.
+type RigDoltSupervisor struct {
+	townRoot string
+	settings rigDoltSettings
+	logger   func(format string, v ...interface{})
+
+	mu    sync.Mutex
+	procs map[string]*rigDoltProc
+
+	// Test hooks (nil = use real implementations)
+	startFn    func(p *RigDoltServer) (int, error)
+	probeFn    func(p *RigDoltServer) error
+	aliveFn    func(p *rigDoltProc) bool
+	stopFn     func(p *rigDoltProc)
+	portFreeFn func(host string, port int) bool
+	escalateFn func(p *RigDoltServer)
+	nowFn      func() time.Time
+}
+
+// NewRigDoltSupervisor creates a supervisor, resuming ports and PIDs from
+// the state file so servers survive daemon restarts. Servers in the state
+// file whose rigs are no longer configured are released on the first Tick.
+func NewRigDoltSupervisor(townRoot string, cfg *RigDoltServersConfig, logger func(format string, v ...interface{})) *RigDoltSupervisor {
+	s := &RigDoltSupervisor{
+		townRoot: townRoot,
+		settings: newRigDoltSettings(cfg),
+		logger:   logger,
+		procs:    map[string]*rigDoltProc{},
+	}
+	if state, err := LoadRigDoltState(townRoot); err == nil {
+		for rigName, prev := range state.Servers {
+			s.procs[rigName] = &rigDoltProc{RigDoltServer: RigDoltServer{
+				Rig: rigName, Host: s.settings.host, Port: prev.Port, PID: prev.PID,
+				StartedAt: prev.StartedAt, LastHealthy: prev.LastHealthy,
+			}}
+		}
+	}
+	return s
+}
+
+func (s *RigDoltSupervisor) now() time.Time {
+	if s.nowFn != nil {
+		return s.nowFn()
+	}
+	return time.Now()
+}
+
+// Tick supervises every configured rig server once, releases the servers
+// of rigs dropped from the config, and saves the state.
+func (s *RigDoltSupervisor) Tick() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	configured := map[string]bool{}
+	for _, rigName := range s.settings.rigs {
+		configured[rigName] = true
+	}
+	for rigName, p := range s.procs {
+		if !configured[rigName] {
+			s.release(p)
+		}
+	}
+	for _, rigName := range s.settings.rigs {
+		s.supervise(s.proc(rigName), now)
+	}
+	s.saveLocked(now)
+}
+
+// proc returns the record for rigName, creating it on first use.
+func (s *RigDoltSupervisor) proc(rigName string) *rigDoltProc {
+	p, ok := s.procs[rigName]
+	if !ok {
+		p = &rigDoltProc{RigDoltServer: RigDoltServer{Rig: rigName, Host: s.settings.host}}
+		s.procs[rigName] = p
+	}
+	p.DataDir = RigDoltDataDir(s.townRoot, rigName)
+	p.Database = doltserver.RigDatabaseName(s.townRoot, rigName)
+	return p
+}
+
+// supervise brings one server toward running: probe it if up, restart it
+// if it died or stopped answering, and honor backoff and the restart cap.
+func (s *RigDoltSupervisor) supervise(p *rigDoltProc, now time.Time) {
+	if p.PID > 0 && s.alive(p) {
+		err := s.probe(&p.RigDoltServer)
+		if err == nil {
+			p.Status = RigDoltRunning
+			p.LastHealthy = now
+			p.LastError = ""
+			p.NextRestart = time.Time{}
+			if now.Sub(p.StartedAt) >= s.settings.restartWindow && (len(p.restartTimes) > 0 || p.escalated) {
+				s.logger("rig_dolt_servers: %s healthy for %v, resetting backoff", p.Rig, s.settings.restartWindow)
+				p.restartTimes, p.delay, p.escalated = nil, 0, false
+			}
+			s.pointBeads(p)
+			return
+		}
+		if p.Status == RigDoltStarting && now.Sub(p.StartedAt) < rigDoltStartGrace {
+			p.LastError = err.Error()
+			return
+		}
+		s.logger("rig_dolt_servers: %s server unhealthy: %v, restarting", p.Rig, err)
+		p.LastError = err.Error()
+		s.stop(p)
+		s.scheduleRestart(p, now)
+	} else if p.PID > 0 {
+		s.logger("rig_dolt_servers: %s server (PID %d) died", p.Rig, p.PID)
+		p.LastError = fmt.Sprintf("server exited (PID %d)", p.PID)
+		p.PID, p.process = 0, nil
+		s.scheduleRestart(p, now)
+	}
+
+	if now.Before(p.NextRestart) {
+		p.Status = RigDoltBackoff
+		return
+	}
+	s.pruneRestarts(p, now)
+	if len(p.restartTimes) >= s.settings.maxRestarts {
+		p.Status = RigDoltCrashLoop
+		if !p.escalated {
+			p.escalated = true
+			s.logger("rig_dolt_servers: %s server restart cap reached (%d restarts in %v), escalating",
+				p.Rig, len(p.restartTimes), s.settings.restartWindow)
+			s.escalate(&p.RigDoltServer)
+		}
+		return
+	}
+
+	port, err := s.allocatePort(p)
+	if err != nil {
+		p.LastError = err.Error()
+		s.scheduleRestart(p, now)
+		return
+	}
+	p.Port = port
+	p.restartTimes = append(p.restartTimes, now)
+	p.Restarts = len(p.restartTimes)
+	pid, err := s.start(p)
+	if err != nil {
+		s.logger("rig_dolt_servers: starting %s server: %v", p.Rig, err)
+		p.LastError = err.Error()
+		s.scheduleRestart(p, now)
+		return
+	}
+	s.logger("rig_dolt_servers: started %s server (PID %d) on %s", p.Rig, pid, p.Addr())
+	p.PID = pid
+	p.StartedAt = now
+	p.Status = RigDoltStarting
+}
+
+// scheduleRestart sets the next restart time and doubles the delay.
+func (s *RigDoltSupervisor) scheduleRestart(p *rigDoltProc, now time.Time) {
+	if p.delay <= 0 {
+		p.delay = s.settings.restartDelay
+	}
+	p.NextRestart = now.Add(p.delay)
+	p.Status = RigDoltBackoff
+	p.delay *= 2
+	if p.delay > s.settings.maxRestartDelay {
+		p.delay = s.settings.maxRestartDelay
+	}
+}
+
+// pruneRestarts drops restarts older than the restart window.
+func (s *RigDoltSupervisor) pruneRestarts(p *rigDoltProc, now time.Time) {
+	cutoff := now.Add(-s.settings.restartWindow)
+	kept := p.restartTimes[:0]
+	for _, t := range p.restartTimes {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	p.restartTimes = kept
+	p.Restarts = len(kept)
+}
+
+// allocatePort keeps a server's port while it is free and not claimed by
+// another rig or the town server; otherwise it takes the first such port
+// at or above the base port.
+func (s *RigDoltSupervisor) allocatePort(p *rigDoltProc) (int, error) {
+	taken := map[int]bool{doltserver.DefaultConfig(s.townRoot).Port: true}
+	for name, other := range s.procs {
+		if name != p.Rig && other.Port > 0 {
+			taken[other.Port] = true
+		}
+	}
+	usable := func(port int) bool { return !taken[port] && s.portFree(s.settings.host, port) }
+	if p.Port > 0 && usable(p.Port) {
+		return p.Port, nil
+	}
+	for port := s.settings.basePort; port < s.settings.basePort+rigDoltPortSpan; port++ {
+		if usable(port) {
+			if p.Port > 0 {
+				s.logger("rig_dolt_servers: %s port %d unavailable, moving to %d", p.Rig, p.Port, port)
+			}
+			return port, nil
+		}
+	}
+	return 0, fmt.Errorf("no free port in %d-%d", s.settings.basePort, s.settings.basePort+rigDoltPortSpan-1)
+}
+
+// pointBeads points the rig's beads at its server once the rig's database
+// is in the server's data directory. Until then the rig stays on the town
+// server, so a half-moved database is never split across two servers.
+func (s *RigDoltSupervisor) pointBeads(p *rigDoltProc) {
+	if _, err := os.Stat(filepath.Join(p.DataDir, p.Database, ".dolt")); err != nil {
+		p.Serving = false
+		return
+	}
+	changed, err := doltserver.SetRigServerAddr(s.townRoot, p.Rig, p.Host, p.Port)
+	if err != nil {
+		s.logger("rig_dolt_servers: pointing %s beads at %s: %v", p.Rig, p.Addr(), err)
+		p.Serving = false
+		return
+	}
+	if changed {
+		s.logger("rig_dolt_servers: %s beads now use %s", p.Rig, p.Addr())
+	}
+	p.Serving = true
+}
+
+// runRigDoltServers runs the rig_dolt_servers patrol. While the patrol is
+// disabled with gt patrol disable, the rig servers are released instead of
+// being left running unsupervised.
+func (d *Daemon) runRigDoltServers() {
+	if _, ok := ActivePatrolDisable(d.config.TownRoot, "rig_dolt_servers", time.Now()); ok {
+		d.rigDolt.Release()
+	}
+	d.runPatrol("rig_dolt_servers", d.rigDolt.Tick)
+}
+
+// Detach saves the state on daemon shutdown and leaves the servers running.
+// The rigs' beads still point at them, so stopping them would cut the rigs
+// off until the next daemon started them again; the next supervisor resumes
+// them from the state file instead.
+func (s *RigDoltSupervisor) Detach() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.saveLocked(s.now())
+}
+
+// Release points every rig back at the town server and stops its dedicated
+// server. The daemon calls it while the patrol is disabled, so no rig is
+// left on a server nobody supervises.
+func (s *RigDoltSupervisor) Release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.procs) == 0 {
+		return
+	}
+	for _, p := range s.procs {
+		s.release(p)
+	}
+	s.saveLocked(s.now())
+}
+
+// release points the rig's beads back at the town server, then stops its
+// server and forgets it. If the beads cannot be repointed the server is
+// kept running, so the rig is not cut off, and the release is retried on
+// the next Tick.
+func (s *RigDoltSupervisor) release(p *rigDoltProc) {
+	changed, err := doltserver.ResetRigServerAddr(s.townRoot, p.Rig)
+	if err != nil {
+		s.logger("rig_dolt_servers: pointing %s beads back at the town server: %v", p.Rig, err)
+		return
+	}
+	if changed {
+		s.logger("rig_dolt_servers: %s beads back on the town server", p.Rig)
+	}
+	if p.PID > 0 && s.alive(p) {
+		s.stop(p)
+	}
+	delete(s.procs, p.Rig)
+	dataDir := RigDoltDataDir(s.townRoot, p.Rig)
+	if _, err := os.Stat(filepath.Join(dataDir, doltserver.RigDatabaseName(s.townRoot, p.Rig), ".dolt")); err == nil {
+		s.logger("rig_dolt_servers: %s database is still in %s; move it back into %s",
+			p.Rig, dataDir, doltserver.DefaultConfig(s.townRoot).DataDir)
+	}
+}
+
+func (s *RigDoltSupervisor) saveLocked(now time.Time) {
+	state := &RigDoltState{UpdatedAt: now.UTC(), Servers: map[string]*RigDoltServer{}}
+	for rigName, p := range s.procs {
+		rec := p.RigDoltServer
+		state.Servers[rigName] = &rec
+	}
+	if err := util.EnsureDirAndWriteJSON(RigDoltStateFile(s.townRoot), state); err != nil {
+		s.logger("rig_dolt_servers: saving state: %v", err)
+	}
+}
+
+func (s *RigDoltSupervisor) pidFile(rigName string) string {
+	return filepath.Join(s.townRoot, "daemon", "dolt-rig-"+rigName+".pid")
+}
+
+func (s *RigDoltSupervisor) logFile(rigName string) string {
+	return filepath.Join(s.townRoot, "daemon", "dolt-rig-"+rigName+".log")
+}
+
+// alive reports whether the server process is still running: the process
+// we started, or the one in the PID file after a daemon restart.
+func (s *RigDoltSupervisor) alive(p *rigDoltProc) bool {
+	if s.aliveFn != nil {
+		return s.aliveFn(p)
+	}
+	if p.process != nil {
+		if isProcessAlive(p.process) {
+			return true
+		}
+		p.process = nil
+		return false
+	}
+	pid, alive, err := verifyPIDOwnership(s.pidFile(p.Rig))
+	return err == nil && alive && pid == p.PID
+}
+
+// start launches dolt sql-server for the rig, detached so it survives a
+// daemon restart.
+func (s *RigDoltSupervisor) start(p *rigDoltProc) (int, error) {
+	if s.startFn != nil {
+		return s.startFn(&p.RigDoltServer)
+	}
+	if err := os.MkdirAll(p.DataDir, 0755); err != nil {
+		return 0, fmt.Errorf("creating data directory: %w", err)
+	}
+	doltPath, err := exec.LookPath("dolt")
+	if err != nil {
+		return 0, fmt.Errorf("dolt not found in PATH: %w", err)
+	}
+	logFile, err := os.OpenFile(s.logFile(p.Rig), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, fmt.Errorf("opening log file: %w", err)
+	}
+	cmd := exec.Command(doltPath, "sql-server", //nolint:gosec // G204: args are constructed internally
+		"--host", p.Host,
+		"--port", strconv.Itoa(p.Port),
+		"--data-dir", p.DataDir)
+	cmd.Dir = p.DataDir
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	setSysProcAttr(cmd)
+	if err := cmd.Start(); err != nil {
+		_ = logFile.Close()
+		return 0, fmt.Errorf("starting dolt sql-server: %w", err)
+	}
+	go func() {
+		_ = cmd.Wait()
+		_ = logFile.Close()
+	}()
+	p.process = cmd.Process
+	if _, err := writePIDFile(s.pidFile(p.Rig), cmd.Process.Pid); err != nil {
+		s.logger("rig_dolt_servers: writing %s PID file: %v", p.Rig, err)
+	}
+	return cmd.Process.Pid, nil
+}
+
+// probe checks that the server accepts connections and answers a query.
+func (s *RigDoltSupervisor) probe(p *RigDoltServer) error {
+	if s.probeFn != nil {
+		return s.probeFn(p)
+	}
+	conn, err := net.DialTimeout("tcp", p.Addr(), 2*time.Second)
+	if err != nil {
+		return fmt.Errorf("not accepting connections on %s", p.Addr())
+	}
+	_ = conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), doltCmdTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "dolt", "sql", //nolint:gosec // G204: args are constructed internally
+		"--host", p.Host, "--port", strconv.Itoa(p.Port), "--user", "root", "--no-tls",
+		"-q", "SELECT active_branch()")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("query failed: %w (%s)", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// stop terminates the server, forcing it after five seconds.
+func (s *RigDoltSupervisor) stop(p *rigDoltProc) {
+	defer func() {
+		p.PID, p.process = 0, nil
+		_ = os.Remove(s.pidFile(p.Rig))
+	}()
+	if s.stopFn != nil {
+		s.stopFn(p)
+		return
+	}
+	process := p.process
+	if process == nil {
+		var err error
+		if process, err = os.FindProcess(p.PID); err != nil {
+			return
+		}
+	}
+	s.logger("rig_dolt_servers: stopping %s server (PID %d)", p.Rig, p.PID)
+	_ = sendTermSignal(process)
+	for i := 0; i < 50; i++ {
+		if !isProcessAlive(process) {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	_ = sendKillSignal(process)
+}
+
+func (s *RigDoltSupervisor) portFree(host string, port int) bool {
+	if s.portFreeFn != nil {
+		return s.portFreeFn(host, port)
+	}
+	ln, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+	if err != nil {
+		return false
+	}
+	_ = ln.Close()
+	return true
+}
+
+// escalate tells the mayor and the rig's witness that the rig's server is
+// crash-looping.
+func (s *RigDoltSupervisor) escalate(p *RigDoltServer) {
+	if s.escalateFn != nil {
+		s.escalateFn(p)
+		return
+	}
+	subject := fmt.Sprintf("ESCALATION: %s Dolt server crash-looping (%d restarts)", p.Rig, p.Restarts)
+	body := fmt.Sprintf(`The dedicated Dolt server for rig %s has restarted %d times within %v.
+The daemon will not restart it again until the window passes.
+
+Last error: %s
+Data dir: %s
+Log file: %s
+Address: %s
+
+Check the log, fix the cause, then run gt doctor to confirm the server is healthy.`,
+		p.Rig, p.Restarts, s.settings.restartWindow,
+		p.LastError, p.DataDir, s.logFile(p.Rig), p.Addr())
+	townRoot, logger := s.townRoot, s.logger
+	go func() {
+		sendDoltAlertMail(townRoot, "mayor/", subject, body, logger)
+		sendDoltAlertMail(townRoot, p.Rig+"/witness", subject, body, logger)
+	}()
+}
@@ -0,0 +1,275 @@
+package daemon
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/doltserver"
+)
+
+// newTestRigDolt returns a supervisor with fake process hooks: started
+// servers stay alive until killed, and probes pass unless failing is set.
+func newTestRigDolt(t *testing.T, cfg *RigDoltServersConfig) (*RigDoltSupervisor, *time.Time, map[string]bool) {
+	t.Helper()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	alive := map[string]bool{}
+	nextPID := 1000
+	s := NewRigDoltSupervisor(t.TempDir(), cfg, func(string, ...interface{}) {})
+	s.nowFn = func() time.Time { return now }
+	s.portFreeFn = func(string, int) bool { return true }
+	s.startFn = func(p *RigDoltServer) (int, error) {
+		nextPID++
+		alive[p.Rig] = true
+		return nextPID, nil
+	}
+	s.aliveFn = func(p *rigDoltProc) bool { return alive[p.Rig] }
+	s.probeFn = func(*RigDoltServer) error { return nil }
+	s.stopFn = func(p *rigDoltProc) { alive[p.Rig] = false }
+	s.escalateFn = func(*RigDoltServer) {}
+	return s, &now, alive
+}
+
+func TestRigDoltServersInterval(t *testing.T) {
+	if got := rigDoltServersInterval(nil); got != defaultRigDoltInterval {
+		t.Errorf("expected default %v, got %v", defaultRigDoltInterval, got)
+	}
+	config := &DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{
+			RigDoltServers: &RigDoltServersConfig{Enabled: true, IntervalStr: "1m"},
+		},
+	}
+	if got := rigDoltServersInterval(config); got != time.Minute {
+		t.Errorf("expected 1m, got %v", got)
+	}
+	if IsPatrolEnabled(&DaemonPatrolConfig{Patrols: &PatrolsConfig{}}, "rig_dolt_servers") {
+		t.Error("rig_dolt_servers should be disabled unless configured")
+	}
+	if !IsPatrolEnabled(config, "rig_dolt_servers") {
+		t.Error("rig_dolt_servers should be enabled when configured")
+	}
+}
+
+func TestRigDoltPortAllocation(t *testing.T) {
+	s, _, _ := newTestRigDolt(t, &RigDoltServersConfig{Enabled: true, Rigs: []string{"alpha", "beta"}, BasePort: 3306})
+	busy := map[int]bool{3308: true}
+	s.portFreeFn = func(_ string, port int) bool { return !busy[port] }
+	s.Tick()
+
+	// alpha takes the base port; beta skips alpha's port, the town
+	// server's 3307, and the busy 3308.
+	if got := s.procs["alpha"].Port; got != 3306 {
+		t.Errorf("alpha port = %d, want 3306", got)
+	}
+	if got := s.procs["beta"].Port; got != 3309 {
+		t.Errorf("beta port = %d, want 3309", got)
+	}
+
+	// A restarted server keeps its port while it is free.
+	s.procs["beta"].PID = 0
+	s.procs["beta"].NextRestart = time.Time{}
+	s.Tick()
+	if got := s.procs["beta"].Port; got != 3309 {
+		t.Errorf("beta port after restart = %d, want 3309", got)
+	}
+}
+
+func TestRigDoltCrashLoop(t *testing.T) {
+	s, now, alive := newTestRigDolt(t, &RigDoltServersConfig{
+		Enabled: true, Rigs: []string{"alpha"},
+		RestartDelayStr: "10s", MaxRestarts: 3, RestartWindowStr: "10m",
+	})
+	escalations := 0
+	s.escalateFn = func(*RigDoltServer) { escalations++ }
+
+	var delays []time.Duration
+	for i := 0; i < 8; i++ {
+		s.Tick()
+		p := s.procs["alpha"]
+		if p.Status == RigDoltBackoff {
+			delays = append(delays, p.NextRestart.Sub(*now))
+			*now = p.NextRestart
+			continue
+		}
+		// Crash whatever was started.
+		alive["alpha"] = false
+		*now = now.Add(time.Second)
+	}
+
+	p := s.procs["alpha"]
+	if p.Status != RigDoltCrashLoop {
+		t.Fatalf("status = %q, want %q", p.Status, RigDoltCrashLoop)
+	}
+	if escalations != 1 {
+		t.Errorf("escalations = %d, want 1", escalations)
+	}
+	if len(delays) < 2 || delays[0] != 10*time.Second || delays[1] != 20*time.Second {
+		t.Errorf("backoff delays = %v, want 10s then 20s", delays)
+	}
+
+	// After the window passes the server is started again.
+	*now = now.Add(11 * time.Minute)
+	s.Tick()
+	if p.Status != RigDoltStarting {
+		t.Errorf("status after window = %q, want %q", p.Status, RigDoltStarting)
+	}
+}
+
+func TestRigDoltUnhealthyRestart(t *testing.T) {
+	s, now, _ := newTestRigDolt(t, &RigDoltServersConfig{Enabled: true, Rigs: []string{"alpha"}})
+	failing := true
+	s.probeFn = func(*RigDoltServer) error {
+		if failing {
+			return os.ErrDeadlineExceeded
+		}
+		return nil
+	}
+
+	s.Tick() // start
+	*now = now.Add(10 * time.Second)
+	s.Tick() // within grace
+	if got := s.procs["alpha"].Status; got != RigDoltStarting {
+		t.Fatalf("status within grace = %q, want %q", got, RigDoltStarting)
+	}
+	*now = now.Add(rigDoltStartGrace)
+	s.Tick() // past grace: stopped and backed off
+	if got := s.procs["alpha"]; got.Status != RigDoltBackoff || got.PID != 0 {
+		t.Fatalf("after grace = %q (PID %d), want backoff with no process", got.Status, got.PID)
+	}
+
+	failing = false
+	*now = s.procs["alpha"].NextRestart
+	s.Tick()
+	s.Tick()
+	if got := s.procs["alpha"].Status; got != RigDoltRunning {
+		t.Errorf("status = %q, want %q", got, RigDoltRunning)
+	}
+}
+
+func TestRigDoltPointsBeadsOnceDatabaseMoved(t *testing.T) {
+	s, _, _ := newTestRigDolt(t, &RigDoltServersConfig{Enabled: true, Rigs: []string{"alpha"}, BasePort: 3500})
+	beadsDir := filepath.Join(s.townRoot, "alpha", ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	metadataPath := filepath.Join(beadsDir, "metadata.json")
+	if err := os.WriteFile(metadataPath, []byte(`{"dolt_database":"alpha"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Tick()
+	s.Tick()
+	if s.procs["alpha"].Serving {
+		t.Fatal("beads pointed at a server without the rig database")
+	}
+
+	if err := os.MkdirAll(filepath.Join(RigDoltDataDir(s.townRoot, "alpha"), "alpha", ".dolt"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	s.Tick()
+	if !s.procs["alpha"].Serving {
+		t.Fatal("beads not pointed at the server after the database moved")
+	}
+	data, err := os.ReadFile(metadataPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var meta map[string]interface{}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		t.Fatal(err)
+	}
+	if meta["dolt_server_host"] != "127.0.0.1" || meta["dolt_server_port"] != float64(3500) || meta["dolt_database"] != "alpha" {
+		t.Errorf("metadata = %v", meta)
+	}
+
+	state, err := LoadRigDoltState(s.townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := state.Servers["alpha"]; got == nil || got.Status != RigDoltRunning || !got.Serving || got.Port != 3500 {
+		t.Errorf("saved state = %+v", got)
+	}
+
+	// A new supervisor resumes the port from the state file.
+	resumed := NewRigDoltSupervisor(s.townRoot, &RigDoltServersConfig{Enabled: true, Rigs: []string{"alpha"}}, func(string, ...interface{}) {})
+	if got := resumed.procs["alpha"]; got == nil || got.Port != 3500 {
+		t.Errorf("resumed = %+v, want port 3500", got)
+	}
+}
+
+func TestRigDoltDetachLeavesServersRunning(t *testing.T) {
+	cfg := &RigDoltServersConfig{Enabled: true, Rigs: []string{"alpha"}}
+	s, _, alive := newTestRigDolt(t, cfg)
+	s.Tick()
+	pid := s.procs["alpha"].PID
+	s.Detach()
+	if !alive["alpha"] {
+		t.Fatal("Detach() stopped the alpha server")
+	}
+
+	resumed := NewRigDoltSupervisor(s.townRoot, cfg, func(string, ...interface{}) {})
+	if p := resumed.procs["alpha"]; p == nil || p.PID != pid || p.Port != s.procs["alpha"].Port {
+		t.Errorf("next supervisor resumed %+v, want PID %d", p, pid)
+	}
+}
+
+func TestRigDoltReleasesUnsupervisedRigs(t *testing.T) {
+	s, _, alive := newTestRigDolt(t, &RigDoltServersConfig{Enabled: true, Rigs: []string{"alpha", "beta"}, BasePort: 3500})
+	for _, rigName := range []string{"alpha", "beta"} {
+		if err := os.MkdirAll(filepath.Join(s.townRoot, rigName, ".beads"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Join(RigDoltDataDir(s.townRoot, rigName), rigName, ".dolt"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.Tick()
+	s.Tick()
+	readMeta := func(rigName string) map[string]interface{} {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join(s.townRoot, rigName, ".beads", "metadata.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var meta map[string]interface{}
+		if err := json.Unmarshal(data, &meta); err != nil {
+			t.Fatal(err)
+		}
+		return meta
+	}
+	if meta := readMeta("alpha"); meta[doltserver.DedicatedServerKey] != true {
+		t.Fatalf("alpha metadata = %v, want dedicated server", meta)
+	}
+
+	// Dropping beta from the config releases it on the next Tick.
+	s.settings.rigs = []string{"alpha"}
+	s.Tick()
+	if alive["beta"] || !alive["alpha"] {
+		t.Errorf("alive = %v, want only alpha running", alive)
+	}
+	townPort := float64(doltserver.DefaultConfig(s.townRoot).Port)
+	if meta := readMeta("beta"); meta["dolt_server_port"] != townPort || meta[doltserver.DedicatedServerKey] != nil {
+		t.Errorf("beta metadata = %v, want town server port %v", meta, townPort)
+	}
+	state, err := LoadRigDoltState(s.townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := state.Servers["beta"]; ok {
+		t.Error("released beta server still in the state file")
+	}
+
+	// Disabling the patrol releases the rest.
+	s.Release()
+	if alive["alpha"] {
+		t.Error("Release() left the alpha server running")
+	}
+	if meta := readMeta("alpha"); meta["dolt_server_port"] != townPort || meta[doltserver.DedicatedServerKey] != nil {
+		t.Errorf("alpha metadata = %v, want town server port %v", meta, townPort)
+	}
+	if state, _ := LoadRigDoltState(s.townRoot); len(state.Servers) != 0 {
+		t.Errorf("state after Release() = %v, want no servers", state.Servers)
+	}
+}
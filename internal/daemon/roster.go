@@ -0,0 +1,110 @@
+package daemon
+
+import (
+	"encoding/json"
+	"os/exec"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/decision"
+	"github.com/steveyegge/gastown/internal/roster"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+const defaultRosterInterval = 10 * time.Minute
+
+// RosterConfig holds configuration for the roster patrol.
+// This patrol reconciles running agents with mayor/roster.json (see gt
+// agents apply), starting declared agents and stopping undeclared ones.
+type RosterConfig struct {
+	// Enabled controls whether the patrol runs.
+	Enabled bool `json:"enabled"`
+
+	// IntervalStr is how often to reconcile (e.g., "5m"). Default: 10m.
+	IntervalStr string `json:"interval,omitempty"`
+}
+
+// rosterInterval returns the configured reconcile interval, or the default (10m).
+func rosterInterval(config *DaemonPatrolConfig) time.Duration {
+	if config != nil && config.Patrols != nil && config.Patrols.Roster != nil {
+		if config.Patrols.Roster.IntervalStr != "" {
+			if d, err := time.ParseDuration(config.Patrols.Roster.IntervalStr); err == nil && d > 0 {
+				return d
+			}
+		}
+	}
+	return defaultRosterInterval
+}
+
+// rosterApplyAction mirrors one entry of gt agents apply --json.
+type rosterApplyAction struct {
+	Agent  string `json:"agent"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// runRoster reconciles running agents with the roster via gt agents apply.
+func (d *Daemon) runRoster() {
+	r, err := roster.Load(d.config.TownRoot)
+	if err != nil {
+		d.logger.Printf("roster: %v", err)
+		d.failPatrol("%v", err)
+		return
+	}
+	if r == nil {
+		d.skipPatrol(decision.ReasonNotReady, "no roster at %s", roster.Path(d.config.TownRoot))
+		return
+	}
+
+	cmd := exec.CommandContext(d.ctx, d.gtPath, "agents", "apply", "--json") //nolint:gosec // G204: args are constructed internally
+	cmd.Dir = d.config.TownRoot
+	output, runErr := cmd.Output()
+
+	var actions []rosterApplyAction
+	if err := json.Unmarshal(output, &actions); err != nil {
+		d.logger.Printf("roster: gt agents apply failed: %v", runErr)
+		d.failPatrol("gt agents apply: %v", runErr)
+		return
+	}
+
+	var changed, failed int
+	for _, a := range actions {
+		switch a.Status {
+		case "done":
+			changed++
+			d.logger.Printf("roster: %s %s", a.Action, a.Agent)
+		case "failed":
+			failed++
+			d.logger.Printf("roster: failed to %s %s: %s", a.Action, a.Agent, a.Detail)
+		}
+	}
+
+	switch {
+	case failed > 0:
+		d.failPatrol("%d agent(s) failed to reconcile", failed)
+	case changed == 0:
+		d.skipPatrol(decision.ReasonNotReady, "agents match the roster")
+	}
+}
+
+// rosterRigs drops the rigs whose roster entry doesn't declare role, so the
+// heartbeat doesn't restart an agent the roster patrol stopped. Without the
+// patrol the roster is only declared state and every role is kept, as it
+// is for rigs the roster doesn't list, towns without a roster, and an
+// unreadable roster, leaving the heartbeat's default.
+func (d *Daemon) rosterRigs(rigs []string, role session.Role) []string {
+	if !IsPatrolEnabled(d.patrolConfig, "roster") {
+		return rigs
+	}
+	r, err := roster.Load(d.config.TownRoot)
+	if err != nil || r == nil {
+		return rigs
+	}
+	var out []string
+	for _, rigName := range rigs {
+		if r.Allows(&session.AgentIdentity{Role: role, Rig: rigName}) {
+			out = append(out, rigName)
+		}
+	}
+	return out
+}
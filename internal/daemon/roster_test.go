@@ -0,0 +1,47 @@
+package daemon
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/roster"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func TestRosterInterval(t *testing.T) {
+	if got := rosterInterval(nil); got != defaultRosterInterval {
+		t.Errorf("rosterInterval(nil) = %v, want %v", got, defaultRosterInterval)
+	}
+	cfg := &DaemonPatrolConfig{Patrols: &PatrolsConfig{Roster: &RosterConfig{Enabled: true, IntervalStr: "5m"}}}
+	if got := rosterInterval(cfg); got != 5*time.Minute {
+		t.Errorf("rosterInterval(5m) = %v", got)
+	}
+	if IsPatrolEnabled(nil, "roster") {
+		t.Error("roster patrol should be opt-in")
+	}
+}
+
+func TestRosterRigs(t *testing.T) {
+	townRoot := t.TempDir()
+	d := &Daemon{config: &Config{TownRoot: townRoot}}
+	rigs := []string{"beads", "gastown", "wyvern"}
+	if got := d.rosterRigs(rigs, session.RoleWitness); !reflect.DeepEqual(got, rigs) {
+		t.Errorf("without a roster rosterRigs() = %v, want all rigs", got)
+	}
+
+	if err := roster.Save(townRoot, &roster.Roster{Rigs: map[string]roster.RigRoster{
+		"gastown": {Witness: true},
+		"beads":   {Refinery: true},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.rosterRigs(rigs, session.RoleWitness); !reflect.DeepEqual(got, rigs) {
+		t.Errorf("without the roster patrol rosterRigs() = %v, want all rigs", got)
+	}
+
+	d.patrolConfig = &DaemonPatrolConfig{Patrols: &PatrolsConfig{Roster: &RosterConfig{Enabled: true}}}
+	if got, want := d.rosterRigs(rigs, session.RoleWitness), []string{"gastown", "wyvern"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("rosterRigs(witness) = %v, want %v", got, want)
+	}
+}
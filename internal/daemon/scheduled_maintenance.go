@@ -6,6 +6,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/decision"
 )
 
 const (
@@ -150,6 +152,7 @@ func (d *Daemon) runScheduledMaintenance() {
 	window := maintenanceWindow(d.patrolConfig)
 	if window == "" {
 		d.logger.Printf("scheduled_maintenance: no window configured, skipping")
+		d.skipPatrol(decision.ReasonNotReady, "no maintenance window configured")
 		return
 	}
 
@@ -157,12 +160,14 @@ func (d *Daemon) runScheduledMaintenance() {
 
 	// Check if we're in the maintenance window.
 	if !isInMaintenanceWindow(now, window) {
+		d.skipPatrol(decision.ReasonNotReady, "outside maintenance window %s", window)
 		return // Not in window — silent skip (this fires every 5 minutes)
 	}
 
 	// Check if we already ran recently (respect interval).
 	interval := maintenanceInterval(d.patrolConfig)
 	if !shouldRunMaintenance(now, d.lastMaintenanceRun, interval) {
+		d.skipPatrol(decision.ReasonNotReady, "already checked at %s (interval %s)", d.lastMaintenanceRun.Format("15:04"), interval)
 		return // Already ran this window
 	}
 
@@ -173,6 +178,7 @@ func (d *Daemon) runScheduledMaintenance() {
 	databases := d.compactorDatabases() // Reuse the same DB discovery
 	if len(databases) == 0 {
 		d.logger.Printf("scheduled_maintenance: no databases found")
+		d.skipPatrol(decision.ReasonNotReady, "no databases found")
 		return
 	}
 
@@ -195,6 +201,7 @@ func (d *Daemon) runScheduledMaintenance() {
 
 	if !needsMaintenance {
 		d.logger.Printf("scheduled_maintenance: all databases below threshold, skipping")
+		d.skipPatrol(decision.ReasonNotReady, "all databases below %d commits", threshold)
 		d.lastMaintenanceRun = now // Don't re-check until next interval
 		return
 	}
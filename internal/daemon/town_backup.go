@@ -0,0 +1,84 @@
+package daemon
+
+import (
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/backup"
+)
+
+const defaultTownBackupInterval = 24 * time.Hour
+
+// TownBackupConfig holds configuration for the town_backup patrol.
+// This patrol takes a full town snapshot (see gt backup) on a schedule and
+// prunes old snapshots.
+type TownBackupConfig struct {
+	// Enabled controls whether scheduled backups run.
+	Enabled bool `json:"enabled"`
+
+	// IntervalStr is how often to snapshot, as a string (e.g., "12h").
+	// Default: 24h.
+	IntervalStr string `json:"interval,omitempty"`
+
+	// Retain is how many snapshots to keep. Default: 14.
+	Retain int `json:"retain,omitempty"`
+
+	// Dir is where snapshots are written. Default: <town>/.runtime/backups.
+	Dir string `json:"dir,omitempty"`
+}
+
+// TownBackupInterval returns the configured backup interval, or the default (24h).
+func TownBackupInterval(config *DaemonPatrolConfig) time.Duration {
+	if config != nil && config.Patrols != nil && config.Patrols.TownBackup != nil {
+		if config.Patrols.TownBackup.IntervalStr != "" {
+			if d, err := time.ParseDuration(config.Patrols.TownBackup.IntervalStr); err == nil && d > 0 {
+				return d
+			}
+		}
+	}
+	return defaultTownBackupInterval
+}
+
+// TownBackupDir returns the configured snapshot directory, or the default.
+func TownBackupDir(config *DaemonPatrolConfig, townRoot string) string {
+	if config != nil && config.Patrols != nil && config.Patrols.TownBackup != nil && config.Patrols.TownBackup.Dir != "" {
+		return config.Patrols.TownBackup.Dir
+	}
+	return backup.DefaultDir(townRoot)
+}
+
+// townBackupRetain returns the configured retention count, or the default.
+func townBackupRetain(config *DaemonPatrolConfig) int {
+	if config != nil && config.Patrols != nil && config.Patrols.TownBackup != nil && config.Patrols.TownBackup.Retain > 0 {
+		return config.Patrols.TownBackup.Retain
+	}
+	return backup.DefaultRetain
+}
+
+// runTownBackup takes a scheduled snapshot and applies retention.
+// Non-fatal: errors are logged; gt doctor warns when backups go stale.
+func (d *Daemon) runTownBackup() {
+	if !IsPatrolEnabled(d.patrolConfig, "town_backup") {
+		return
+	}
+
+	dir := TownBackupDir(d.patrolConfig, d.config.TownRoot)
+	m, err := backup.Create(d.config.TownRoot, backup.Options{Dir: dir, Trigger: backup.TriggerScheduled})
+	if err != nil {
+		d.logger.Printf("town_backup: snapshot failed: %v", err)
+		d.failPatrol("snapshot failed: %v", err)
+		return
+	}
+	if m.OK() {
+		d.logger.Printf("town_backup: wrote %s (%d files, %d databases)", m.ID, m.Files, len(m.Databases))
+	} else {
+		d.logger.Printf("town_backup: wrote %s with %d error(s): %s", m.ID, len(m.Errors), strings.Join(m.Errors, "; "))
+	}
+
+	removed, err := backup.Prune(dir, townBackupRetain(d.patrolConfig))
+	if err != nil {
+		d.logger.Printf("town_backup: prune failed: %v", err)
+	} else if len(removed) > 0 {
+		d.logger.Printf("town_backup: pruned %d old snapshot(s)", len(removed))
+	}
+}
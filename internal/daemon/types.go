@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/util"
 )
@@ -111,6 +112,11 @@ type PatrolConfig struct {
 
 	// Rigs limits this patrol to specific rigs. If empty, all rigs are patrolled.
 	Rigs []string `json:"rigs,omitempty"`
+
+	// PatrolAgentOptions tune the patrol's agent session (model, effort,
+	// allowed tools, persona). Agent startup reads them from daemon.json;
+	// they are declared here so SavePatrolConfig round-trips them.
+	config.PatrolAgentOptions
 }
 
 // PatrolsConfig holds configuration for all patrols.
@@ -128,6 +134,11 @@ type PatrolsConfig struct {
 	CompactorDog           *CompactorDogConfig            `json:"compactor_dog,omitempty"`
 	ScheduledMaintenance   *ScheduledMaintenanceConfig    `json:"scheduled_maintenance,omitempty"`
 	RestartTracker         *RestartTrackerConfig          `json:"restart_tracker,omitempty"`
+	TownBackup             *TownBackupConfig              `json:"town_backup,omitempty"`
+	Conventions            *ConventionsConfig             `json:"conventions,omitempty"`
+	Roster                 *RosterConfig                  `json:"roster,omitempty"`
+	BranchSweep            *BranchSweepConfig             `json:"branch_sweep,omitempty"`
+	RigDoltServers         *RigDoltServersConfig          `json:"rig_dolt_servers,omitempty"`
 }
 
 // DoltRemotesConfig holds configuration for the dolt_remotes patrol.
@@ -197,6 +208,12 @@ type DaemonPatrolConfig struct {
 	Version   int               `json:"version"`
 	Heartbeat *PatrolConfig     `json:"heartbeat,omitempty"`
 	Patrols   *PatrolsConfig    `json:"patrols,omitempty"`
+	// Adaptive enables outcome-driven patrol intervals (see AdaptiveConfig).
+	Adaptive  *AdaptiveConfig   `json:"adaptive,omitempty"`
+	// Jitter spreads patrol wakeups and offsets first runs (see JitterConfig).
+	Jitter    *JitterConfig     `json:"jitter,omitempty"`
+	// Deputy keeps a standby Mayor to fail over to (see DeputyConfig).
+	Deputy    *DeputyConfig     `json:"deputy,omitempty"`
 	// Env holds environment variables to set at startup.
 	// Propagated to all sessions spawned by the daemon and read by gt up/mayor attach.
 	// Example: {"GT_DOLT_PORT": "43211"}
@@ -226,6 +243,21 @@ func LoadPatrolConfig(townRoot string) *DaemonPatrolConfig {
 	return &config
 }
 
+// PatrolEnv returns the env vars of patrolConfig (from daemon.json) with
+// ${secret:NAME} references resolved from the town secrets store, ready to
+// export. It returns nil for a nil config.
+func PatrolEnv(townRoot string, patrolConfig *DaemonPatrolConfig) map[string]string {
+	if patrolConfig == nil || len(patrolConfig.Env) == 0 {
+		return nil
+	}
+	env := make(map[string]string, len(patrolConfig.Env))
+	for k, v := range patrolConfig.Env {
+		env[k] = v
+	}
+	config.ExpandSecretRefs(townRoot, env)
+	return env
+}
+
 // SavePatrolConfig saves patrol configuration to mayor/daemon.json.
 func SavePatrolConfig(townRoot string, config *DaemonPatrolConfig) error {
 	configFile := PatrolConfigFile(townRoot)
@@ -281,6 +313,36 @@ func IsPatrolEnabled(config *DaemonPatrolConfig, patrol string) bool {
 		}
 		return config.Patrols.CompactorDog.Enabled
 	}
+	if patrol == "town_backup" {
+		if config == nil || config.Patrols == nil || config.Patrols.TownBackup == nil {
+			return false
+		}
+		return config.Patrols.TownBackup.Enabled
+	}
+	if patrol == "conventions" {
+		if config == nil || config.Patrols == nil || config.Patrols.Conventions == nil {
+			return false
+		}
+		return config.Patrols.Conventions.Enabled
+	}
+	if patrol == "roster" {
+		if config == nil || config.Patrols == nil || config.Patrols.Roster == nil {
+			return false
+		}
+		return config.Patrols.Roster.Enabled
+	}
+	if patrol == "branch_sweep" {
+		if config == nil || config.Patrols == nil || config.Patrols.BranchSweep == nil {
+			return false
+		}
+		return config.Patrols.BranchSweep.Enabled
+	}
+	if patrol == "rig_dolt_servers" {
+		if config == nil || config.Patrols == nil || config.Patrols.RigDoltServers == nil {
+			return false
+		}
+		return config.Patrols.RigDoltServers.Enabled
+	}
 	if patrol == "scheduled_maintenance" {
 		if config == nil || config.Patrols == nil || config.Patrols.ScheduledMaintenance == nil {
 			return false
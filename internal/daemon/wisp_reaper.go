@@ -7,7 +7,9 @@ import (
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/steveyegge/gastown/internal/archive"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/decision"
 )
 
 const (
@@ -17,6 +19,9 @@ const (
 	defaultWispMaxAge = 24 * time.Hour
 	// Closed wisps older than this are permanently deleted.
 	defaultWispDeleteAge = 7 * 24 * time.Hour
+	// archiveGrace is how long past the archive age a closed wisp is kept,
+	// so gt archive gets a chance to archive it before it is deleted.
+	archiveGrace = 24 * time.Hour
 	// Alert threshold: if open wisp count exceeds this, escalate.
 	wispAlertThreshold = 500
 	// Closed mail (gt:message) older than this is permanently deleted.
@@ -105,6 +110,16 @@ func wispDeleteAge(config *DaemonPatrolConfig) time.Duration {
 	return defaultWispDeleteAge
 }
 
+// deleteAfterArchive returns deleteAge, raised if needed so closed wisps are
+// deleted strictly after they are due for archival (archiveAge 0: archival
+// off).
+func deleteAfterArchive(deleteAge, archiveAge time.Duration) time.Duration {
+	if archiveAge > 0 && deleteAge < archiveAge+archiveGrace {
+		return archiveAge + archiveGrace
+	}
+	return deleteAge
+}
+
 // reapWisps is the thin orchestrator for the wisp_reaper patrol.
 // It pours a mol-dog-reaper molecule and delegates to step functions
 // that mirror the formula: scan → reap → purge → auto-close → report.
@@ -119,6 +134,12 @@ func (d *Daemon) reapWisps() {
 		deleteAge: wispDeleteAge(d.patrolConfig),
 		dryRun:    config.DryRun,
 	}
+	if policy, err := archive.LoadPolicy(d.config.TownRoot); err == nil {
+		if age := deleteAfterArchive(rc.deleteAge, policy.WispAge); age != rc.deleteAge {
+			d.logger.Printf("wisp_reaper: delete age %v raised to %v to follow archival at %v", rc.deleteAge, age, policy.WispAge)
+			rc.deleteAge = age
+		}
+	}
 	rc.cutoff = time.Now().UTC().Add(-rc.maxAge)
 	rc.deleteCutoff = time.Now().UTC().Add(-rc.deleteAge)
 
@@ -139,6 +160,7 @@ func (d *Daemon) reapWisps() {
 	}
 	if len(rc.databases) == 0 {
 		d.logger.Printf("wisp_reaper: no databases to reap")
+		d.skipPatrol(decision.ReasonNotReady, "no databases to reap")
 		mol.failStep("scan", "no databases found")
 		return
 	}
@@ -156,6 +178,8 @@ func (d *Daemon) reapWisps() {
 
 	// Step 5: Report — log summary.
 	d.reaperReport(rc, mol)
+
+	d.recordPatrolOutcome("wisp_reaper", rc.totalReaped+rc.totalPurged+rc.totalMailPurged+rc.totalAutoClosed)
 }
 
 // reaperReap closes stale wisps whose parent molecule is already closed.
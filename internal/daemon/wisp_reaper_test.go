@@ -68,6 +68,22 @@ func TestWispDeleteAge(t *testing.T) {
 	}
 }
 
+func TestDeleteAfterArchive(t *testing.T) {
+	day := 24 * time.Hour
+	for _, tc := range []struct {
+		del, arch, want time.Duration
+	}{
+		{7 * day, 3 * day, 7 * day},
+		{7 * day, 7 * day, 8 * day},
+		{2 * day, 3 * day, 4 * day},
+		{2 * day, 0, 2 * day},
+	} {
+		if got := deleteAfterArchive(tc.del, tc.arch); got != tc.want {
+			t.Errorf("deleteAfterArchive(%v, %v) = %v, want %v", tc.del, tc.arch, got, tc.want)
+		}
+	}
+}
+
 func TestParentCheckWhere(t *testing.T) {
 	sql := parentCheckWhere("testdb")
 	// Should reference the correct database in all subqueries.
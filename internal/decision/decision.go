@@ -0,0 +1,168 @@
+// Package decision records why the daemon and scheduler did, or did not,
+// act on a patrol or a scheduled bead.
+//
+// Decisions are appended to <town>/.runtime/decisions.jsonl and read back by
+// `gt why`. The log is bounded: once it grows past maxLogBytes the oldest
+// entries are dropped.
+package decision
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Decision kinds.
+const (
+	KindPatrol = "patrol" // A daemon patrol tick (subject: patrol name)
+	KindSling  = "sling"  // A scheduler dispatch pass (subject: work bead ID)
+	KindPolicy = "policy" // A town policy rule decided (subject: decision point)
+)
+
+// Outcomes.
+const (
+	OutcomeRan        = "ran"
+	OutcomeSkipped    = "skipped"
+	OutcomeDeferred   = "deferred"
+	OutcomeDispatched = "dispatched"
+	OutcomeFailed     = "failed"
+	OutcomeAllowed    = "allowed"
+	OutcomeDenied     = "denied"
+)
+
+// Reasons explain a skipped or deferred outcome.
+const (
+	ReasonDisabled = "disabled"  // Turned off in mayor/daemon.json
+	ReasonShutdown = "shutdown"  // gt down in progress
+	ReasonNotReady = "predicate" // A precondition was false (window, blocked, nothing configured)
+	ReasonBudget   = "budget"    // No capacity left (polecat slots, batch size)
+	ReasonBreaker  = "breaker"   // Circuit breaker or restart backoff is open
+	ReasonPaused   = "paused"    // Scheduler paused
+	ReasonParked   = "parked"    // Rig parked, docked, or auto-restart blocked
+	ReasonIncident = "incident"  // Town frozen by gt incident start
+	ReasonPolicy   = "policy"    // Denied by a town policy rule (gt policy)
+)
+
+// LogFile is the decision log, relative to the town root.
+var LogFile = filepath.Join(constants.DirRuntime, "decisions.jsonl")
+
+// maxLogBytes bounds the log; a write that pushes it past this keeps only
+// the newest half.
+const maxLogBytes = 2 << 20
+
+// Decision is one recorded scheduling decision.
+type Decision struct {
+	Time    time.Time `json:"ts"`
+	Kind    string    `json:"kind"`
+	Subject string    `json:"subject"`
+	Outcome string    `json:"outcome"`
+	Reason  string    `json:"reason,omitempty"`
+	Detail  string    `json:"detail,omitempty"`
+}
+
+// Record appends decisions to the town's decision log. Recording is
+// best-effort for callers, but errors are returned for those that care.
+func Record(townRoot string, decisions ...Decision) error {
+	if len(decisions) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, d := range decisions {
+		if d.Time.IsZero() {
+			d.Time = time.Now()
+		}
+		data, err := json.Marshal(d)
+		if err != nil {
+			return fmt.Errorf("marshaling decision: %w", err)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	path := filepath.Join(townRoot, LogFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	fl := flock.New(path + ".lock")
+	if err := fl.Lock(); err != nil {
+		return fmt.Errorf("acquiring decision log lock: %w", err)
+	}
+	defer fl.Unlock() //nolint:errcheck // best-effort unlock
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: operational data
+	if err != nil {
+		return fmt.Errorf("opening decision log: %w", err)
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing decision log: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return trim(path)
+}
+
+// trim drops the oldest half of the log once it exceeds maxLogBytes.
+// Callers hold the log lock.
+func trim(path string) error {
+	info, err := os.Stat(path)
+	if err != nil || info.Size() <= maxLogBytes {
+		return err
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return err
+	}
+	data = data[len(data)-maxLogBytes/2:]
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		data = data[i+1:]
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil { //nolint:gosec // G306: operational data
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Query returns the most recent decisions of kind about subject, newest
+// first. An empty subject matches every subject; limit <= 0 means no limit.
+func Query(townRoot, kind, subject string, limit int) ([]Decision, error) {
+	f, err := os.Open(filepath.Join(townRoot, LogFile)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening decision log: %w", err)
+	}
+	defer f.Close()
+
+	var matched []Decision
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var d Decision
+		if err := json.Unmarshal(scanner.Bytes(), &d); err != nil {
+			continue
+		}
+		if d.Kind == kind && (subject == "" || d.Subject == subject) {
+			matched = append(matched, d)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	out := make([]Decision, 0, len(matched))
+	for i := len(matched) - 1; i >= 0 && (limit <= 0 || len(out) < limit); i-- {
+		out = append(out, matched[i])
+	}
+	return out, nil
+}
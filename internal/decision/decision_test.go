@@ -0,0 +1,65 @@
+package decision
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecordAndQuery(t *testing.T) {
+	town := t.TempDir()
+	base := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	if err := Record(town,
+		Decision{Time: base, Kind: KindPatrol, Subject: "wisp_reaper", Outcome: OutcomeRan},
+		Decision{Time: base.Add(time.Minute), Kind: KindSling, Subject: "gt-1", Outcome: OutcomeDeferred, Reason: ReasonBudget},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := Record(town, Decision{Time: base.Add(2 * time.Minute), Kind: KindPatrol, Subject: "wisp_reaper", Outcome: OutcomeSkipped, Reason: ReasonNotReady}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Query(town, KindPatrol, "wisp_reaper", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Outcome != OutcomeSkipped || got[1].Outcome != OutcomeRan {
+		t.Fatalf("Query = %+v, want newest first", got)
+	}
+	if got, _ := Query(town, KindPatrol, "wisp_reaper", 1); len(got) != 1 || got[0].Reason != ReasonNotReady {
+		t.Errorf("limited Query = %+v", got)
+	}
+	if got, _ := Query(town, KindSling, "", 0); len(got) != 1 || got[0].Subject != "gt-1" {
+		t.Errorf("sling Query = %+v", got)
+	}
+	if got, err := Query(t.TempDir(), KindPatrol, "", 0); err != nil || len(got) != 0 {
+		t.Errorf("Query on empty town = %v, %v", got, err)
+	}
+}
+
+func TestRecordTrimsLog(t *testing.T) {
+	town := t.TempDir()
+	detail := strings.Repeat("x", 1000)
+	batch := make([]Decision, 500)
+	for i := range batch {
+		batch[i] = Decision{Kind: KindPatrol, Subject: "deacon", Outcome: OutcomeRan, Detail: detail}
+	}
+	for i := 0; i < 6; i++ {
+		if err := Record(town, batch...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	info, err := os.Stat(filepath.Join(town, LogFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() > maxLogBytes {
+		t.Errorf("log is %d bytes, want at most %d", info.Size(), maxLogBytes)
+	}
+	got, err := Query(town, KindPatrol, "deacon", 0)
+	if err != nil || len(got) == 0 {
+		t.Fatalf("Query after trim = %d, %v", len(got), err)
+	}
+}
@@ -0,0 +1,115 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Ack acknowledges a known doctor finding so it stops failing the run.
+type Ack struct {
+	Check  string    `json:"check"`
+	Reason string    `json:"reason,omitempty"`
+	By     string    `json:"by,omitempty"`
+	At     time.Time `json:"at"`
+	Until  time.Time `json:"until,omitempty"` // Zero means no expiry
+}
+
+// Expired reports whether the ack has lapsed at now.
+func (a Ack) Expired(now time.Time) bool {
+	return !a.Until.IsZero() && !now.Before(a.Until)
+}
+
+// AckStore is the set of acknowledgements for a town, persisted at
+// <town>/.runtime/doctor-acks.json.
+type AckStore struct {
+	Acks []Ack `json:"acks"`
+}
+
+// AcksPath returns the path of the town's doctor acknowledgement file.
+func AcksPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "doctor-acks.json")
+}
+
+// LoadAcks reads the town's acknowledgements. A missing file yields an empty store.
+func LoadAcks(townRoot string) (*AckStore, error) {
+	data, err := os.ReadFile(AcksPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &AckStore{}, nil
+		}
+		return nil, fmt.Errorf("reading doctor acks: %w", err)
+	}
+	var s AckStore
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parsing doctor acks: %w", err)
+	}
+	return &s, nil
+}
+
+// SaveAcks writes the town's acknowledgements.
+func SaveAcks(townRoot string, s *AckStore) error {
+	return util.EnsureDirAndWriteJSON(AcksPath(townRoot), s)
+}
+
+// Add records an ack, replacing any existing ack for the same check.
+func (s *AckStore) Add(ack Ack) {
+	s.Remove(ack.Check)
+	s.Acks = append(s.Acks, ack)
+	sort.Slice(s.Acks, func(i, j int) bool { return s.Acks[i].Check < s.Acks[j].Check })
+}
+
+// Remove deletes the ack for a check. Returns false if none existed.
+func (s *AckStore) Remove(check string) bool {
+	for i, a := range s.Acks {
+		if a.Check == check {
+			s.Acks = append(s.Acks[:i], s.Acks[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Active returns the ack for a check if one exists and has not expired.
+func (s *AckStore) Active(check string, now time.Time) (Ack, bool) {
+	for _, a := range s.Acks {
+		if a.Check == check && !a.Expired(now) {
+			return a, true
+		}
+	}
+	return Ack{}, false
+}
+
+// Prune drops expired acks and returns how many were removed.
+func (s *AckStore) Prune(now time.Time) int {
+	kept := s.Acks[:0]
+	for _, a := range s.Acks {
+		if !a.Expired(now) {
+			kept = append(kept, a)
+		}
+	}
+	removed := len(s.Acks) - len(kept)
+	s.Acks = kept
+	return removed
+}
+
+// Apply marks non-OK results that have an active ack as acknowledged and
+// recounts the report summary so they no longer count as warnings or errors.
+func (s *AckStore) Apply(r *Report, now time.Time) {
+	for _, c := range r.Checks {
+		if c.Status == StatusOK {
+			continue
+		}
+		if ack, ok := s.Active(c.Name, now); ok {
+			c.Acknowledged = true
+			c.AckReason = ack.Reason
+		}
+	}
+	r.recount()
+}
@@ -0,0 +1,103 @@
+package doctor
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAckStore_SaveLoadRoundTrip(t *testing.T) {
+	townRoot := t.TempDir()
+
+	store, err := LoadAcks(townRoot)
+	if err != nil {
+		t.Fatalf("LoadAcks (missing file): %v", err)
+	}
+	if len(store.Acks) != 0 {
+		t.Fatalf("expected empty store, got %d", len(store.Acks))
+	}
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	store.Add(Ack{Check: "stale-binary", Reason: "release pending", At: now, Until: now.Add(24 * time.Hour)})
+	store.Add(Ack{Check: "daemon", At: now})
+	store.Add(Ack{Check: "stale-binary", Reason: "replaced", At: now})
+	if err := SaveAcks(townRoot, store); err != nil {
+		t.Fatalf("SaveAcks: %v", err)
+	}
+
+	loaded, err := LoadAcks(townRoot)
+	if err != nil {
+		t.Fatalf("LoadAcks: %v", err)
+	}
+	if len(loaded.Acks) != 2 {
+		t.Fatalf("loaded %d acks, want 2 (re-ack replaces)", len(loaded.Acks))
+	}
+	if loaded.Acks[1].Check != "stale-binary" || loaded.Acks[1].Reason != "replaced" {
+		t.Errorf("stale-binary ack = %+v", loaded.Acks[1])
+	}
+}
+
+func TestAckStore_Expiry(t *testing.T) {
+	now := time.Now()
+	store := &AckStore{}
+	store.Add(Ack{Check: "expired", Until: now.Add(-time.Minute)})
+	store.Add(Ack{Check: "live", Until: now.Add(time.Hour)})
+	store.Add(Ack{Check: "forever"})
+
+	if _, ok := store.Active("expired", now); ok {
+		t.Error("expired ack should not be active")
+	}
+	if _, ok := store.Active("live", now); !ok {
+		t.Error("live ack should be active")
+	}
+	if _, ok := store.Active("forever", now); !ok {
+		t.Error("ack without expiry should be active")
+	}
+	if n := store.Prune(now); n != 1 || len(store.Acks) != 2 {
+		t.Errorf("Prune() = %d, remaining %d; want 1, 2", n, len(store.Acks))
+	}
+	if !store.Remove("live") || store.Remove("live") {
+		t.Error("Remove should succeed once")
+	}
+}
+
+func TestAckStore_Apply(t *testing.T) {
+	now := time.Now()
+	store := &AckStore{}
+	store.Add(Ack{Check: "daemon", Reason: "known outage"})
+	store.Add(Ack{Check: "routes", Until: now.Add(-time.Hour)})
+
+	r := reportWith(
+		&CheckResult{Name: "daemon", Status: StatusError, Message: "not running"},
+		&CheckResult{Name: "routes", Status: StatusWarning},
+		&CheckResult{Name: "ok", Status: StatusOK},
+	)
+	store.Apply(r, now)
+
+	if !r.Checks[0].Acknowledged || r.Checks[1].Acknowledged {
+		t.Errorf("acknowledged = %v, %v; want true, false", r.Checks[0].Acknowledged, r.Checks[1].Acknowledged)
+	}
+	if r.Summary.Errors != 0 || r.Summary.Warnings != 1 || r.Summary.Acknowledged != 1 || r.Summary.OK != 1 {
+		t.Errorf("summary = %+v", r.Summary)
+	}
+	if r.HasErrors() {
+		t.Error("acknowledged error should not count")
+	}
+
+	p := DefaultPolicy()
+	p.Apply(r)
+	if code := p.ExitCode(r); code != 0 {
+		t.Errorf("ExitCode() = %d, want 0", code)
+	}
+
+	var buf bytes.Buffer
+	r.PrintSummaryOnly(&buf, false, 0)
+	out := buf.String()
+	if !strings.Contains(out, "ACKNOWLEDGED") || !strings.Contains(out, "daemon: not running (known outage)") {
+		t.Errorf("summary should list acknowledged findings separately:\n%s", out)
+	}
+	if strings.Contains(out, "FAILURES") {
+		t.Errorf("acknowledged error should not appear under FAILURES:\n%s", out)
+	}
+}
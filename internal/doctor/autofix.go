@@ -0,0 +1,208 @@
+package doctor
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// FixSafety grades whether a check's fix may be applied unattended.
+type FixSafety string
+
+const (
+	// FixSafe fixes only add missing local configuration or clear caches
+	// and orphaned state. They don't restart agents, rewrite git history,
+	// or delete work, so the daemon may apply them in a change window.
+	FixSafe FixSafety = "safe"
+
+	// FixReview fixes need someone to run gt doctor --fix. Fixable checks
+	// that don't grade themselves are treated as FixReview.
+	FixReview FixSafety = "review"
+)
+
+// fixSafetyGetter is implemented by checks that grade their fix.
+type fixSafetyGetter interface {
+	FixSafety() FixSafety
+}
+
+// CheckFixSafety returns how safe a check's fix is ("" if it has none).
+func CheckFixSafety(check Check) FixSafety {
+	if !check.CanFix() {
+		return ""
+	}
+	if g, ok := check.(fixSafetyGetter); ok {
+		return g.FixSafety()
+	}
+	return FixReview
+}
+
+// AutofixPolicy decides when the daemon may fix what, and who hears about it.
+type AutofixPolicy struct {
+	enabled bool
+	loc     *time.Location
+	windows []changeWindow
+	checks  []string
+	notify  []string
+}
+
+// changeWindow is a daily window in minutes after local midnight, on the
+// days it may start (nil means every day).
+type changeWindow struct {
+	days       map[time.Weekday]bool
+	start, end int
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// NewAutofixPolicy builds an autofix policy from town settings. nil means
+// autofix is off.
+func NewAutofixPolicy(cfg *config.DoctorAutofixConfig) (*AutofixPolicy, error) {
+	p := &AutofixPolicy{loc: time.Local, notify: []string{"overseer"}}
+	if cfg == nil {
+		return p, nil
+	}
+	p.enabled = cfg.Enabled
+	if cfg.Interval != "" {
+		// The daemon schedules runs; validate here so gt doctor reports it
+		if d, err := time.ParseDuration(cfg.Interval); err != nil || d <= 0 {
+			return nil, fmt.Errorf("doctor.autofix.interval: invalid duration %q", cfg.Interval)
+		}
+	}
+	if cfg.Timezone != "" {
+		loc, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("doctor.autofix.timezone: %w", err)
+		}
+		p.loc = loc
+	}
+	for i, w := range cfg.Windows {
+		cw, err := parseChangeWindow(w)
+		if err != nil {
+			return nil, fmt.Errorf("doctor.autofix.windows[%d]: %w", i, err)
+		}
+		p.windows = append(p.windows, cw)
+	}
+	for _, g := range cfg.Checks {
+		if _, err := path.Match(g, ""); err != nil {
+			return nil, fmt.Errorf("doctor.autofix.checks: %q: %w", g, err)
+		}
+	}
+	p.checks = cfg.Checks
+	if len(cfg.Notify) > 0 {
+		p.notify = cfg.Notify
+	}
+	return p, nil
+}
+
+// LoadAutofixPolicy reads the autofix policy from town settings.
+func LoadAutofixPolicy(townRoot string) (*AutofixPolicy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	if settings.Doctor == nil {
+		return NewAutofixPolicy(nil)
+	}
+	return NewAutofixPolicy(settings.Doctor.Autofix)
+}
+
+func parseChangeWindow(w config.ChangeWindow) (changeWindow, error) {
+	var cw changeWindow
+	var err error
+	if cw.start, err = parseClockMinute(w.Start); err != nil {
+		return cw, err
+	}
+	if cw.end, err = parseClockMinute(w.End); err != nil {
+		return cw, err
+	}
+	if cw.start == cw.end {
+		return cw, fmt.Errorf("window %s-%s is empty", w.Start, w.End)
+	}
+	for _, d := range w.Days {
+		lower := strings.ToLower(d)
+		day, ok := weekdays[lower[:min(3, len(lower))]]
+		if !ok {
+			return cw, fmt.Errorf("unknown day %q (want mon..sun)", d)
+		}
+		if cw.days == nil {
+			cw.days = map[time.Weekday]bool{}
+		}
+		cw.days[day] = true
+	}
+	return cw, nil
+}
+
+func parseClockMinute(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q (want HH:MM)", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (w changeWindow) startsOn(day time.Weekday) bool {
+	return w.days == nil || w.days[day]
+}
+
+func (w changeWindow) contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	if w.start < w.end {
+		return w.startsOn(t.Weekday()) && minute >= w.start && minute < w.end
+	}
+	// Crosses midnight: the early-morning part belongs to yesterday's window
+	if minute >= w.start {
+		return w.startsOn(t.Weekday())
+	}
+	return minute < w.end && w.startsOn(t.AddDate(0, 0, -1).Weekday())
+}
+
+// Enabled reports whether scheduled autofix is turned on.
+func (p *AutofixPolicy) Enabled() bool { return p.enabled }
+
+// Notify returns the addresses sent a summary of applied fixes.
+func (p *AutofixPolicy) Notify() []string { return p.notify }
+
+// InWindow reports whether now falls in a change window.
+func (p *AutofixPolicy) InWindow(now time.Time) bool {
+	local := now.In(p.loc)
+	for _, w := range p.windows {
+		if w.contains(local) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allowed reports whether autofix may apply check's fix: it must be graded
+// safe and, when the policy names checks, match one of them.
+func (p *AutofixPolicy) Allowed(check Check) bool {
+	if CheckFixSafety(check) != FixSafe {
+		return false
+	}
+	if len(p.checks) == 0 {
+		return true
+	}
+	for _, g := range p.checks {
+		if ok, _ := path.Match(g, check.Name()); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectAutofix keeps only the checks the policy allows to autofix.
+func (d *Doctor) SelectAutofix(p *AutofixPolicy) {
+	kept := d.checks[:0]
+	for _, c := range d.checks {
+		if p.Allowed(c) {
+			kept = append(kept, c)
+		}
+	}
+	d.checks = kept
+}
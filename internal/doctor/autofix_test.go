@@ -0,0 +1,103 @@
+package doctor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// safeMockCheck is a fixable mock check that grades its fix safe.
+type safeMockCheck struct{ *mockCheck }
+
+func (safeMockCheck) FixSafety() FixSafety { return FixSafe }
+
+func TestAutofixPolicy_InWindow(t *testing.T) {
+	p, err := NewAutofixPolicy(&config.DoctorAutofixConfig{
+		Enabled:  true,
+		Timezone: "UTC",
+		Windows: []config.ChangeWindow{
+			{Days: []string{"sat"}, Start: "22:00", End: "02:00"},
+			{Start: "12:00", End: "12:30"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(s string) time.Time {
+		tm, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+	tests := []struct {
+		when string
+		want bool
+	}{
+		{"2026-10-17T23:00:00Z", true},  // Saturday night
+		{"2026-10-18T01:59:00Z", true},  // Sunday morning, Saturday's window
+		{"2026-10-18T02:00:00Z", false}, // window end is exclusive
+		{"2026-10-18T23:00:00Z", false}, // Sunday night
+		{"2026-10-16T01:00:00Z", false}, // Friday morning, Thursday's window
+		{"2026-10-14T12:15:00Z", true},  // daily window
+		{"2026-10-14T12:30:00Z", false},
+	}
+	for _, tt := range tests {
+		if got := p.InWindow(at(tt.when)); got != tt.want {
+			t.Errorf("InWindow(%s) = %v, want %v", tt.when, got, tt.want)
+		}
+	}
+}
+
+func TestAutofixPolicy_Allowed(t *testing.T) {
+	p, err := NewAutofixPolicy(&config.DoctorAutofixConfig{Checks: []string{"stale-*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unfixable := newMockCheck("stale-locks", StatusWarning)
+	review := newMockCheck("stale-locks", StatusWarning)
+	review.fixable = true
+	safe := safeMockCheck{newMockCheck("stale-locks", StatusWarning)}
+	safe.fixable = true
+	other := safeMockCheck{newMockCheck("cache-health", StatusWarning)}
+	other.fixable = true
+
+	if CheckFixSafety(unfixable) != "" || CheckFixSafety(review) != FixReview || CheckFixSafety(safe) != FixSafe {
+		t.Error("CheckFixSafety() graded a check wrong")
+	}
+	if p.Allowed(unfixable) || p.Allowed(review) || p.Allowed(other) {
+		t.Error("Allowed() let through an unsafe or unlisted check")
+	}
+	if !p.Allowed(safe) {
+		t.Error("Allowed() rejected a safe, listed check")
+	}
+
+	d := NewDoctor()
+	d.RegisterAll(unfixable, review, safe, other)
+	d.SelectAutofix(p)
+	if n := len(d.Checks()); n != 1 {
+		t.Errorf("SelectAutofix() kept %d checks, want 1", n)
+	}
+}
+
+func TestNewAutofixPolicy_Invalid(t *testing.T) {
+	for name, cfg := range map[string]*config.DoctorAutofixConfig{
+		"interval": {Interval: "soon"},
+		"timezone": {Timezone: "Mars/Olympus"},
+		"start":    {Windows: []config.ChangeWindow{{Start: "25:00", End: "02:00"}}},
+		"empty":    {Windows: []config.ChangeWindow{{Start: "02:00", End: "02:00"}}},
+		"day":      {Windows: []config.ChangeWindow{{Days: []string{"someday"}, Start: "01:00", End: "02:00"}}},
+		"shrink":   {Windows: []config.ChangeWindow{{Days: []string{"Ȿ"}, Start: "01:00", End: "02:00"}}}, // lowercases to fewer bytes
+		"glob":     {Checks: []string{"["}},
+	} {
+		if _, err := NewAutofixPolicy(cfg); err == nil {
+			t.Errorf("%s: NewAutofixPolicy() should fail", name)
+		}
+	}
+
+	p, err := NewAutofixPolicy(nil)
+	if err != nil || p.Enabled() || p.InWindow(time.Now()) {
+		t.Errorf("nil config should give a disabled policy, got %+v, %v", p, err)
+	}
+}
@@ -0,0 +1,93 @@
+package doctor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/backup"
+	"github.com/steveyegge/gastown/internal/daemon"
+)
+
+// defaultMaxBackupAge is how old the last good backup may be when scheduled
+// backups are not enabled.
+const defaultMaxBackupAge = 7 * 24 * time.Hour
+
+// BackupFreshnessCheck warns when the town's last good backup is too old.
+// With the town_backup patrol enabled, "too old" is two missed intervals;
+// otherwise it is a week. A town that has never been backed up, with no
+// scheduled backups, is not warned about: the check starts once the first
+// backup exists.
+type BackupFreshnessCheck struct {
+	BaseCheck
+}
+
+// NewBackupFreshnessCheck creates a new backup freshness check.
+func NewBackupFreshnessCheck() *BackupFreshnessCheck {
+	return &BackupFreshnessCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "backup-freshness",
+			CheckDescription: "Check that a recent good town backup exists",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run checks the age of the newest good snapshot.
+func (c *BackupFreshnessCheck) Run(ctx *CheckContext) *CheckResult {
+	patrol := daemon.LoadPatrolConfig(ctx.TownRoot)
+	dir := daemon.TownBackupDir(patrol, ctx.TownRoot)
+	maxAge := defaultMaxBackupAge
+	scheduled := daemon.IsPatrolEnabled(patrol, "town_backup")
+	if scheduled {
+		maxAge = 2 * daemon.TownBackupInterval(patrol)
+	}
+
+	fixHint := "Run 'gt backup create', or enable scheduled backups (patrols.town_backup in mayor/daemon.json)"
+	if scheduled {
+		fixHint = "Scheduled backups are not succeeding; check the daemon log and run 'gt backup create'"
+	}
+
+	snapshots, err := backup.List(dir)
+	if err == nil && len(snapshots) == 0 && !scheduled {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No town backups yet (checked once 'gt backup create' has run)",
+		}
+	}
+	good, err := backup.LastGood(dir)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not read backups",
+			Details: []string{err.Error()},
+			FixHint: fixHint,
+		}
+	}
+	if good == nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "No good town backup found",
+			Details: []string{"Backup directory: " + dir},
+			FixHint: fixHint,
+		}
+	}
+
+	age := time.Since(good.CreatedAt)
+	if age > maxAge {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Last good backup %s is %s old (limit %s)", good.ID, age.Round(time.Minute), maxAge),
+			Details: []string{"Backup directory: " + dir},
+			FixHint: fixHint,
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: fmt.Sprintf("Last good backup %s (%s ago)", good.ID, age.Round(time.Minute)),
+	}
+}
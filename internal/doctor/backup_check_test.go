@@ -0,0 +1,44 @@
+package doctor
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/backup"
+)
+
+func TestBackupFreshnessCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	check := NewBackupFreshnessCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("never backed up: status = %v, want OK (%s)", result.Status, result.Message)
+	}
+
+	m, err := backup.Create(townRoot, backup.Options{Components: []string{backup.ComponentConfig}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("fresh backup: status = %v, want OK (%s)", result.Status, result.Message)
+	}
+
+	// Once a backup exists, having no good one is a warning.
+	m.Errors = []string{"database gt: sync failed"}
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(backup.DefaultDir(townRoot), m.ID+".json"), data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if result := check.Run(ctx); result.Status != StatusWarning {
+		t.Errorf("no good backup: status = %v, want warning (%s)", result.Status, result.Message)
+	}
+}
@@ -370,10 +370,10 @@ func (c *RoleLabelCheck) Run(ctx *CheckContext) *CheckResult {
 		}
 	}
 
-	// Use injected beadShower or create real one
+	// Use injected beadShower or the context's beads client
 	shower := c.beadShower
 	if shower == nil {
-		shower = beads.New(ctx.TownRoot)
+		shower = ctx.Beads(ctx.TownRoot)
 	}
 
 	var missingLabel []string
@@ -212,7 +212,7 @@ func (c *BeadsRedirectTargetCheck) Fix(ctx *CheckContext) error {
 		}
 
 		// Canonical location exists — recompute and rewrite the redirect
-		if err := recomputeRedirect(ctx.TownRoot, bt.worktreePath); err != nil {
+		if err := recomputeRedirect(ctx.Tx, ctx.TownRoot, bt.worktreePath); err != nil {
 			relWt, _ := filepath.Rel(ctx.TownRoot, bt.worktreePath)
 			unfixable = append(unfixable, relWt)
 		}
@@ -256,7 +256,7 @@ func hasBeadsSetup(beadsDir string) bool {
 }
 
 // recomputeRedirect rewrites a worktree's .beads/redirect to point to the correct target.
-func recomputeRedirect(townRoot, worktreePath string) error {
+func recomputeRedirect(tx *FixTx, townRoot, worktreePath string) error {
 	relPath, err := filepath.Rel(townRoot, worktreePath)
 	if err != nil {
 		return err
@@ -307,5 +307,5 @@ func recomputeRedirect(townRoot, worktreePath string) error {
 
 	// Write redirect file
 	redirectFile := filepath.Join(worktreeBeads, "redirect")
-	return os.WriteFile(redirectFile, []byte(redirectContent+"\n"), 0644)
+	return tx.WriteFile(redirectFile, []byte(redirectContent+"\n"), 0644)
 }
@@ -6,6 +6,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/rig"
 )
@@ -328,6 +329,9 @@ func NewCloneDivergenceCheck() *CloneDivergenceCheck {
 	}
 }
 
+// CacheTTL lets repeated doctor runs reuse a recent result (inspects every clone).
+func (c *CloneDivergenceCheck) CacheTTL() time.Duration { return 5 * time.Minute }
+
 // cloneInfo holds information about a single clone.
 type cloneInfo struct {
 	path     string
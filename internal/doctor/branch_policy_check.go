@@ -0,0 +1,134 @@
+package doctor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// BranchPolicyCheck reports existing branches that violate a rig's branch
+// policy (config.json "branch_policy"): polecat branches outside the
+// allowed prefixes, which the merge queue will refuse, and crew commits
+// waiting on the default branch in rigs that forbid direct commits.
+type BranchPolicyCheck struct {
+	BaseCheck
+}
+
+// NewBranchPolicyCheck creates a new branch policy check.
+func NewBranchPolicyCheck() *BranchPolicyCheck {
+	return &BranchPolicyCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "branch-policy",
+			CheckDescription: "Check existing branches against each rig's branch policy",
+			CheckCategory:    CategoryRig,
+		},
+	}
+}
+
+// Run checks the polecat and crew worktrees of every rig with a policy.
+func (c *BranchPolicyCheck) Run(ctx *CheckContext) *CheckResult {
+	rigsCfg, err := ctx.Config().RigsConfig()
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "No rigs configured"}
+	}
+
+	var details []string
+	policies := 0
+	for rigName := range rigsCfg.Rigs {
+		if ctx.RigName != "" && rigName != ctx.RigName {
+			continue
+		}
+		rigPath := filepath.Join(ctx.TownRoot, rigName)
+		cfg, err := rig.LoadRigConfig(rigPath)
+		if err != nil || cfg.BranchPolicy == nil {
+			continue
+		}
+		policies++
+		details = append(details, c.checkRig(rigName, rigPath, cfg)...)
+	}
+	sort.Strings(details)
+
+	if policies == 0 {
+		return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "No rig declares a branch policy"}
+	}
+	if len(details) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("Branches follow the policy of %d rig(s)", policies),
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d branch policy violation(s)", len(details)),
+		Details: details,
+		FixHint: "Rename offending polecat branches or fix polecat_branch_template; submit crew work with 'gt mq submit' instead of pushing to the default branch",
+	}
+}
+
+// checkRig returns the policy violations in one rig's worktrees.
+func (c *BranchPolicyCheck) checkRig(rigName, rigPath string, cfg *rig.RigConfig) []string {
+	policy := cfg.BranchPolicy
+	defaultBranch := cfg.DefaultBranch
+	if defaultBranch == "" {
+		defaultBranch = "main"
+	}
+
+	var out []string
+	for name, dir := range polecatWorktrees(rigPath, rigName) {
+		branch, err := git.NewGit(dir).CurrentBranch()
+		if err != nil || branch == "" || branch == defaultBranch || branch == "HEAD" {
+			continue
+		}
+		if err := policy.CheckBranchName(branch); err != nil {
+			out = append(out, fmt.Sprintf("%s: polecat %s is on %s, outside allowed prefixes",
+				rigName, name, branch))
+		}
+	}
+
+	if policy.DirectCommitsAllowed() {
+		return out
+	}
+	crewDirs, _ := filepath.Glob(filepath.Join(rigPath, "crew", "*"))
+	for _, dir := range crewDirs {
+		g := git.NewGit(dir)
+		branch, err := g.CurrentBranch()
+		if err != nil || branch != defaultBranch {
+			continue
+		}
+		ahead, err := g.CommitsAhead("origin/"+defaultBranch, "HEAD")
+		if err != nil || ahead == 0 {
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s: crew %s has %d unpushed commit(s) on %s; the rig forbids direct commits",
+			rigName, filepath.Base(dir), ahead, defaultBranch))
+	}
+	return out
+}
+
+// polecatWorktrees returns the git worktrees of a rig's polecats by name, in
+// either the polecats/<name>/<rig> layout or the older polecats/<name>.
+func polecatWorktrees(rigPath, rigName string) map[string]string {
+	entries, err := os.ReadDir(filepath.Join(rigPath, "polecats"))
+	if err != nil {
+		return nil
+	}
+	dirs := map[string]string{}
+	for _, e := range entries {
+		if !e.IsDir() || e.Name()[0] == '.' {
+			continue
+		}
+		base := filepath.Join(rigPath, "polecats", e.Name())
+		if _, err := os.Stat(filepath.Join(base, rigName, ".git")); err == nil {
+			dirs[e.Name()] = filepath.Join(base, rigName)
+		} else if _, err := os.Stat(filepath.Join(base, ".git")); err == nil {
+			dirs[e.Name()] = base
+		}
+	}
+	return dirs
+}
@@ -0,0 +1,73 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestBranchPolicyCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	if err := os.MkdirAll(rigPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	ctx := &CheckContext{
+		TownRoot:    townRoot,
+		ConfigStore: fakeConfigStore{rigs: &config.RigsConfig{Rigs: map[string]config.RigEntry{"gastown": {}}}},
+	}
+	check := NewBranchPolicyCheck()
+
+	if result := check.Run(ctx); result.Status != StatusOK || !strings.Contains(result.Message, "No rig declares") {
+		t.Fatalf("without a policy: %s %q", result.Status, result.Message)
+	}
+
+	writeRigConfig := func(policy string) {
+		t.Helper()
+		cfg := `{"type":"rig","name":"gastown","default_branch":"main","branch_policy":` + policy + `}`
+		if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(cfg), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeRigConfig(`{"allowed_prefixes":["wisp/"],"allow_direct_commits":false}`)
+
+	// origin with one commit on main
+	origin := filepath.Join(townRoot, "origin.git")
+	runGit(t, "", "init", "--bare", "-b", "main", origin)
+	initBareWithCommit(t, origin)
+
+	// Polecats on a conforming and a non-conforming branch
+	for name, branch := range map[string]string{"Toast": "wisp/gt-1", "Nux": "polecat/Nux-abc"} {
+		dir := filepath.Join(rigPath, "polecats", name, "gastown")
+		runGit(t, "", "clone", "-q", origin, dir)
+		runGit(t, dir, "checkout", "-q", "-b", branch)
+	}
+	// Crew with an unpushed commit on main, and crew in sync
+	for _, name := range []string{"max", "joe"} {
+		runGit(t, "", "clone", "-q", origin, filepath.Join(rigPath, "crew", name))
+	}
+	runGit(t, filepath.Join(rigPath, "crew", "max"), "commit", "--allow-empty", "-m", "direct")
+
+	result := check.Run(ctx)
+	if result.Status != StatusWarning {
+		t.Fatalf("Status = %s, want warning: %q", result.Status, result.Message)
+	}
+	details := strings.Join(result.Details, "\n")
+	for _, want := range []string{"polecat Nux is on polecat/Nux-abc", "crew max has 1 unpushed commit(s) on main"} {
+		if !strings.Contains(details, want) {
+			t.Errorf("Details missing %q:\n%s", want, details)
+		}
+	}
+	if len(result.Details) != 2 {
+		t.Errorf("got %d details, want 2:\n%s", len(result.Details), details)
+	}
+
+	// Allowing direct commits clears the crew finding
+	writeRigConfig(`{"allowed_prefixes":["wisp/","polecat/"]}`)
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("with a permissive policy: %s %v", result.Status, result.Details)
+	}
+}
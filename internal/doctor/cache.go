@@ -0,0 +1,192 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// cacheTTLGetter is implemented by checks slow enough that reusing a recent
+// result beats re-probing (remote endpoints, Dolt queries across rigs).
+type cacheTTLGetter interface {
+	CacheTTL() time.Duration
+}
+
+// cachedResult is a persisted check result.
+type cachedResult struct {
+	Status   CheckStatus `json:"status"`
+	Message  string      `json:"message,omitempty"`
+	Details  []string    `json:"details,omitempty"`
+	FixHint  string      `json:"fix_hint,omitempty"`
+	Category string      `json:"category,omitempty"`
+	Severity Severity    `json:"severity,omitempty"`
+	At       time.Time   `json:"at"`
+}
+
+// ResultCache reuses recent results of checks that have a cache TTL, so
+// frequent doctor runs (watch mode, scripted intervals) don't re-run slow
+// probes every time. Results are keyed by check and rig, and persisted at
+// <town>/.runtime/doctor-cache.json. A nil *ResultCache caches nothing.
+type ResultCache struct {
+	mu      sync.Mutex
+	ttls    map[string]time.Duration // Town overrides; 0 disables caching
+	Results map[string]cachedResult  `json:"results"`
+	now     func() time.Time
+}
+
+// CachePath returns the path of the town's doctor result cache.
+func CachePath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "doctor-cache.json")
+}
+
+// LoadResultCache reads the town's result cache and applies TTL overrides
+// from the doctor policy ("cache_ttls"). Invalid overrides are skipped, so
+// those checks keep their own TTLs; the doctor-settings check reports them
+// (see ValidateCacheTTLs). A missing or corrupt cache file yields an empty
+// cache.
+func LoadResultCache(townRoot string, cfg *config.DoctorPolicyConfig) *ResultCache {
+	c := &ResultCache{ttls: map[string]time.Duration{}, Results: map[string]cachedResult{}, now: time.Now}
+	if cfg != nil {
+		for name, s := range cfg.CacheTTLs {
+			if d, err := parseCacheTTL(name, s); err == nil {
+				c.ttls[name] = d
+			}
+		}
+	}
+	data, err := os.ReadFile(CachePath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err == nil {
+		_ = json.Unmarshal(data, c)
+		if c.Results == nil {
+			c.Results = map[string]cachedResult{}
+		}
+	}
+	return c
+}
+
+// ValidateCacheTTLs reports every invalid "cache_ttls" entry in the doctor
+// policy, sorted by check name.
+func ValidateCacheTTLs(cfg *config.DoctorPolicyConfig) []error {
+	if cfg == nil {
+		return nil
+	}
+	names := make([]string, 0, len(cfg.CacheTTLs))
+	for name := range cfg.CacheTTLs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var errs []error
+	for _, name := range names {
+		if _, err := parseCacheTTL(name, cfg.CacheTTLs[name]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func parseCacheTTL(name, s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("doctor.cache_ttls[%q]: invalid duration %q", name, s)
+	}
+	return d, nil
+}
+
+// SaveResultCache writes the cache back to disk.
+func SaveResultCache(townRoot string, c *ResultCache) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return util.EnsureDirAndWriteJSON(CachePath(townRoot), c)
+}
+
+// TTL returns how long a check's results may be reused: the town override
+// if set, else the check's own CacheTTL, else 0 (never cached).
+func (c *ResultCache) TTL(check Check) time.Duration {
+	if c == nil {
+		return 0
+	}
+	if d, ok := c.ttls[check.Name()]; ok {
+		return d
+	}
+	if g, ok := check.(cacheTTLGetter); ok {
+		return g.CacheTTL()
+	}
+	return 0
+}
+
+func cacheKey(ctx *CheckContext, name string) string {
+	if ctx.RigName == "" {
+		return name
+	}
+	return name + "@" + ctx.RigName
+}
+
+// Lookup returns a fresh cached result for check, marked Cached.
+func (c *ResultCache) Lookup(ctx *CheckContext, check Check) (*CheckResult, bool) {
+	ttl := c.TTL(check)
+	if ttl <= 0 {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.Results[cacheKey(ctx, check.Name())]
+	if !ok || c.now().Sub(e.At) >= ttl {
+		return nil, false
+	}
+	return &CheckResult{
+		Name:     check.Name(),
+		Status:   e.Status,
+		Message:  e.Message,
+		Details:  append([]string(nil), e.Details...),
+		FixHint:  e.FixHint,
+		Category: e.Category,
+		Severity: e.Severity,
+		Cached:   true,
+		CachedAt: e.At,
+	}, true
+}
+
+// Store records a freshly run result for checks that have a cache TTL. A
+// result that was just fixed drops the entry instead: the next run should
+// see the repaired state, not "(fixed)".
+func (c *ResultCache) Store(ctx *CheckContext, check Check, r *CheckResult) {
+	if c.TTL(check) <= 0 || r.Cached {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := cacheKey(ctx, check.Name())
+	if r.Fixed {
+		delete(c.Results, key)
+		return
+	}
+	c.Results[key] = cachedResult{
+		Status:   r.Status,
+		Message:  r.Message,
+		Details:  r.Details,
+		FixHint:  r.FixHint,
+		Category: r.Category,
+		Severity: r.Severity,
+		At:       c.now(),
+	}
+}
+
+// Clear drops every cached result (e.g., when town config changes).
+func (c *ResultCache) Clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Results = map[string]cachedResult{}
+}
@@ -0,0 +1,170 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/health"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/primecache"
+)
+
+// CacheHealthCheck inspects the caches Gas Town manages: the town prime
+// cache, each rig's shared pre-warm package cache, and the warm-state stamps
+// of pooled polecat worktrees. It reports corruption, caches over their cap,
+// and stale entries. The fix only deletes cache data, which is rebuilt on
+// demand.
+type CacheHealthCheck struct {
+	FixableCheck
+	primeNeedsRepair bool
+	overCap          []oversizedCache
+	badStamps        []string
+}
+
+type oversizedCache struct {
+	dir   string
+	size  int64
+	limit int64
+}
+
+// NewCacheHealthCheck creates a new cache health check.
+func NewCacheHealthCheck() *CacheHealthCheck {
+	return &CacheHealthCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "cache-health",
+				CheckDescription: "Check prime, pre-warm, and warm worktree caches for corruption and bloat",
+				CheckCategory:    CategoryCleanup,
+			},
+		},
+	}
+}
+
+// Run inspects the caches.
+func (c *CacheHealthCheck) Run(ctx *CheckContext) *CheckResult {
+	c.primeNeedsRepair = false
+	c.overCap = nil
+	c.badStamps = nil
+	var details []string
+
+	prime, err := primecache.New(ctx.TownRoot).Inspect()
+	if err != nil {
+		details = append(details, fmt.Sprintf("prime cache: %v", err))
+	} else if prime.Corrupt > 0 || prime.Stale > 0 {
+		c.primeNeedsRepair = true
+		details = append(details, fmt.Sprintf("prime cache: %d corrupt, %d stale of %d entries", prime.Corrupt, prime.Stale, prime.Entries))
+	}
+
+	rigs := findAllRigs(ctx.TownRoot)
+	if ctx.RigName != "" {
+		rigs = []string{filepath.Join(ctx.TownRoot, ctx.RigName)}
+	}
+	for _, rigPath := range rigs {
+		rigName := filepath.Base(rigPath)
+
+		cacheDir := polecat.PrewarmCacheDir(rigPath)
+		if _, err := os.Stat(cacheDir); err == nil {
+			var prewarm *config.PrewarmConfig
+			if settings, err := config.LoadRigSettings(config.RigSettingsPath(rigPath)); err == nil {
+				prewarm = settings.Prewarm
+			}
+			limit := prewarm.CacheMaxBytes()
+			if size, err := health.DirSize(cacheDir); err != nil {
+				details = append(details, fmt.Sprintf("%s: pre-warm cache unreadable: %v", rigName, err))
+			} else if size > limit {
+				c.overCap = append(c.overCap, oversizedCache{dir: cacheDir, size: size, limit: limit})
+				details = append(details, fmt.Sprintf("%s: pre-warm cache is %s (cap %s)", rigName, formatBytes(size), formatBytes(limit)))
+			}
+		}
+
+		stamps, _ := filepath.Glob(filepath.Join(rigPath, "polecats", "*", polecat.PrewarmStampFile))
+		for _, stamp := range stamps {
+			polecatDir := filepath.Dir(stamp)
+			reason := ""
+			if _, err := os.Stat(filepath.Join(polecatDir, rigName)); err != nil {
+				reason = "stale (worktree gone)"
+			} else if data, err := os.ReadFile(stamp); err != nil || !json.Valid(data) { //nolint:gosec // G304: path from glob under the rig
+				reason = "corrupt"
+			}
+			if reason != "" {
+				c.badStamps = append(c.badStamps, stamp)
+				details = append(details, fmt.Sprintf("%s: warm stamp for %s is %s", rigName, filepath.Base(polecatDir), reason))
+			}
+		}
+	}
+
+	if len(details) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "Caches are healthy",
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d cache problem(s)", len(details)),
+		Details: details,
+		FixHint: "Run 'gt doctor --fix' to prune caches (data is rebuilt on demand)",
+	}
+}
+
+// FixSafety grades the fix safe for autofix: it only repairs and prunes caches.
+func (c *CacheHealthCheck) FixSafety() FixSafety { return FixSafe }
+
+// Fix prunes the problems found by Run.
+func (c *CacheHealthCheck) Fix(ctx *CheckContext) error {
+	if c.primeNeedsRepair {
+		if _, err := primecache.New(ctx.TownRoot).Repair(); err != nil {
+			return fmt.Errorf("repairing prime cache: %w", err)
+		}
+	}
+	for _, stamp := range c.badStamps {
+		// A missing stamp just marks the worktree cold; the next reuse re-warms it.
+		if err := ctx.Tx.Remove(stamp); err != nil {
+			return err
+		}
+	}
+	for _, oc := range c.overCap {
+		if err := pruneCacheDir(oc.dir, oc.size, oc.limit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneCacheDir removes whole per-tool subdirectories (npm, yarn, pip),
+// largest first, until the cache fits under limit. Deleting individual files
+// could leave a package manager's index pointing at missing content; dropping
+// a whole tool cache is always safe.
+func pruneCacheDir(dir string, size, limit int64) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", dir, err)
+	}
+	type sub struct {
+		path string
+		size int64
+	}
+	var subs []sub
+	for _, e := range entries {
+		path := filepath.Join(dir, e.Name())
+		n, _ := health.DirSize(path)
+		subs = append(subs, sub{path, n})
+	}
+	sort.Slice(subs, func(i, j int) bool { return subs[i].size > subs[j].size })
+	for _, s := range subs {
+		if size <= limit {
+			break
+		}
+		if err := os.RemoveAll(s.path); err != nil {
+			return fmt.Errorf("pruning %s: %w", s.path, err)
+		}
+		size -= s.size
+	}
+	return nil
+}
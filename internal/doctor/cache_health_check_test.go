@@ -0,0 +1,67 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestCacheHealthCheck_PrunesOversizedCacheAndBadStamps(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	ctx := &CheckContext{TownRoot: townRoot, RigName: "gastown"}
+
+	check := NewCacheHealthCheck()
+	if res := check.Run(ctx); res.Status != StatusOK {
+		t.Fatalf("empty town: status = %v, details = %q", res.Status, res.Details)
+	}
+
+	settings := config.NewRigSettings()
+	settings.Prewarm = &config.PrewarmConfig{CacheMaxSize: "1KB"}
+	if err := config.SaveRigSettings(config.RigSettingsPath(rigPath), settings); err != nil {
+		t.Fatal(err)
+	}
+	cache := polecat.PrewarmCacheDir(rigPath)
+	for tool, size := range map[string]int{"npm": 4096, "pip": 100} {
+		dir := filepath.Join(cache, tool)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "blob"), make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A stamp whose worktree is gone, and a corrupt one next to a live worktree.
+	for name, body := range map[string]string{"Gone": `{"steps":{}}`, "Toast": `{not json`} {
+		dir := filepath.Join(rigPath, "polecats", name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, polecat.PrewarmStampFile), []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(rigPath, "polecats", "Toast", "gastown"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	res := check.Run(ctx)
+	if res.Status != StatusWarning || len(res.Details) != 3 {
+		t.Fatalf("status = %v, details = %q; want 3 problems", res.Status, res.Details)
+	}
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(cache, "npm")); !os.IsNotExist(err) {
+		t.Error("largest tool cache should be pruned")
+	}
+	if _, err := os.Stat(filepath.Join(cache, "pip")); err != nil {
+		t.Error("pruning should stop once under the cap")
+	}
+	if res := check.Run(ctx); res.Status != StatusOK {
+		t.Errorf("after fix: status = %v, details = %q", res.Status, res.Details)
+	}
+}
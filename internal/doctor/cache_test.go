@@ -0,0 +1,105 @@
+package doctor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// slowCheck counts its runs and declares a cache TTL.
+type slowCheck struct {
+	BaseCheck
+	ttl    time.Duration
+	status CheckStatus
+	runs   int
+}
+
+func (c *slowCheck) Run(ctx *CheckContext) *CheckResult {
+	c.runs++
+	return &CheckResult{Name: c.CheckName, Status: c.status, Message: "probed"}
+}
+
+func (c *slowCheck) CacheTTL() time.Duration { return c.ttl }
+
+func newSlowCheck(name string, ttl time.Duration) *slowCheck {
+	return &slowCheck{BaseCheck: BaseCheck{CheckName: name}, ttl: ttl, status: StatusWarning}
+}
+
+func TestResultCache_ReuseAndExpiry(t *testing.T) {
+	townRoot := t.TempDir()
+	cache := LoadResultCache(townRoot, nil)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	slow := newSlowCheck("remote-probe", 5*time.Minute)
+	fast := newSlowCheck("local-file", 0)
+	d := NewDoctor()
+	d.RegisterAll(slow, fast)
+	d.SetCache(cache)
+	ctx := &CheckContext{TownRoot: townRoot}
+
+	d.Run(ctx)
+	now = now.Add(time.Minute)
+	report := d.Run(ctx)
+	if slow.runs != 1 || fast.runs != 2 {
+		t.Fatalf("runs = slow %d, fast %d; want 1, 2", slow.runs, fast.runs)
+	}
+	if r := report.Checks[0]; !r.Cached || r.Status != StatusWarning || r.Message != "probed" {
+		t.Errorf("cached result = %+v", r)
+	}
+
+	// A rig-scoped run has its own entry.
+	d.Run(&CheckContext{TownRoot: townRoot, RigName: "gastown"})
+	if slow.runs != 2 {
+		t.Errorf("rig run reused town result (runs = %d)", slow.runs)
+	}
+
+	now = now.Add(5 * time.Minute)
+	d.Run(ctx)
+	if slow.runs != 3 {
+		t.Errorf("expired entry reused (runs = %d)", slow.runs)
+	}
+
+	// The cache survives a save/load round trip.
+	if err := SaveResultCache(townRoot, cache); err != nil {
+		t.Fatal(err)
+	}
+	loaded := LoadResultCache(townRoot, nil)
+	loaded.now = cache.now
+	if _, ok := loaded.Lookup(ctx, slow); !ok {
+		t.Error("reloaded cache missing entry")
+	}
+}
+
+func TestResultCache_OverridesAndFix(t *testing.T) {
+	townRoot := t.TempDir()
+	cache := LoadResultCache(townRoot, &config.DoctorPolicyConfig{
+		CacheTTLs: map[string]string{"remote-probe": "0", "local-file": "1m"},
+	})
+	slow := newSlowCheck("remote-probe", 5*time.Minute)
+	fast := newSlowCheck("local-file", 0)
+	if cache.TTL(slow) != 0 || cache.TTL(fast) != time.Minute {
+		t.Errorf("TTLs = %v, %v; want 0, 1m", cache.TTL(slow), cache.TTL(fast))
+	}
+
+	ctx := &CheckContext{TownRoot: townRoot}
+	cache.Store(ctx, fast, &CheckResult{Name: "local-file", Status: StatusError})
+	if _, ok := cache.Lookup(ctx, fast); !ok {
+		t.Fatal("stored result not found")
+	}
+	cache.Store(ctx, fast, &CheckResult{Name: "local-file", Status: StatusOK, Fixed: true})
+	if _, ok := cache.Lookup(ctx, fast); ok {
+		t.Error("fixed result left a cache entry")
+	}
+
+	bad := &config.DoctorPolicyConfig{CacheTTLs: map[string]string{"remote-probe": "soon", "local-file": "1m"}}
+	if errs := ValidateCacheTTLs(bad); len(errs) != 1 {
+		t.Errorf("ValidateCacheTTLs = %v, want one error", errs)
+	}
+	// An invalid override is skipped rather than failing the load.
+	cache = LoadResultCache(townRoot, bad)
+	if cache.TTL(slow) != 5*time.Minute || cache.TTL(fast) != time.Minute {
+		t.Errorf("TTLs with a bad override = %v, %v; want 5m, 1m", cache.TTL(slow), cache.TTL(fast))
+	}
+}
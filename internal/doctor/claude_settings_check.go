@@ -678,12 +678,14 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 			continue
 		}
 
-		// Delete the stale settings file
-		if err := os.Remove(sf.path); err != nil {
+		// Delete the stale settings file. The delete and the recreation
+		// below are staged on ctx.Tx, so a failed recreation restores the
+		// old file rather than leaving the agent with no settings.
+		if err := ctx.Tx.Remove(sf.path); err != nil {
 			errors = append(errors, fmt.Sprintf("failed to delete %s: %v", sf.path, err))
 			continue
 		}
-		fmt.Printf("  Deleted stale: %s\n", sf.path)
+		fmt.Printf("  Replacing stale: %s\n", sf.path)
 		needsRestart = true
 
 		claudeDir := filepath.Dir(sf.path)
@@ -693,7 +695,10 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 		// removing it creates a race window where the daemon could recreate
 		// settings before the fix does (gt-99u).
 		if sf.wrongLocation {
-			_ = os.Remove(claudeDir) // Best-effort, will fail if not empty
+			_ = ctx.Tx.Do("remove "+claudeDir, func() error {
+				_ = os.Remove(claudeDir) // Best-effort, will fail if not empty
+				return nil
+			}, func() error { return os.MkdirAll(claudeDir, 0755) })
 		}
 
 		// Handle town-root files: redirect to mayor/ instead of recreating at root.
@@ -704,16 +709,19 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 
 			if strings.HasSuffix(claudeDir, ".claude") {
 				// Town-root .claude/settings{.local}.json → recreate at mayor/.claude/
-				if err := os.MkdirAll(mayorDir, 0755); err == nil {
-					runtimeConfig := config.ResolveRoleAgentConfig("mayor", ctx.TownRoot, mayorDir)
-					_ = runtime.EnsureSettingsForRole(mayorDir, mayorDir, "mayor", runtimeConfig)
-				}
+				_ = ctx.Tx.Do("recreate settings in "+mayorDir, func() error {
+					if err := os.MkdirAll(mayorDir, 0755); err == nil {
+						runtimeConfig := config.ResolveRoleAgentConfig("mayor", ctx.TownRoot, mayorDir)
+						_ = runtime.EnsureSettingsForRole(mayorDir, mayorDir, "mayor", runtimeConfig)
+					}
+					return nil
+				}, nil)
 			}
 
 			// Town-root files were inherited by ALL agents via directory traversal.
 			// Warn user to restart agents - don't auto-kill sessions as that's too disruptive,
 			// especially since deacon runs gt doctor automatically which would create a loop.
-			fmt.Printf("\n  %s Town-root settings were moved. Restart agents to pick up new config:\n", style.Warning.Render("⚠"))
+			fmt.Printf("\n  %s Town-root settings were moved. Restart agents to pick up new config:\n", style.WarningPrefix)
 			fmt.Printf("      gt up --restore\n\n")
 			continue
 		}
@@ -736,7 +744,10 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 			}
 		}
 		runtimeConfig := config.ResolveRoleAgentConfig(sf.agentType, ctx.TownRoot, rigPath)
-		if err := runtime.EnsureSettingsForRole(settingsDir, workDir, sf.agentType, runtimeConfig); err != nil {
+		agentType := sf.agentType
+		if err := ctx.Tx.Do("recreate settings for "+sf.path, func() error {
+			return runtime.EnsureSettingsForRole(settingsDir, workDir, agentType, runtimeConfig)
+		}, nil); err != nil {
 			errors = append(errors, fmt.Sprintf("failed to recreate settings for %s: %v", sf.path, err))
 			continue
 		}
@@ -747,12 +758,16 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 		if ctx.RestartSessions {
 			if sf.agentType == "witness" || sf.agentType == "refinery" ||
 				sf.agentType == "deacon" || sf.agentType == "mayor" {
-				running, _ := t.HasSession(sf.sessionName)
-				if running {
-					// Cycle the agent by killing and letting gt up restart it.
-					// Use KillSessionWithProcesses to ensure all descendant processes are killed.
-					_ = t.KillSessionWithProcesses(sf.sessionName)
-				}
+				// Cycle the agent by killing and letting gt up restart it,
+				// once its new settings are in place.
+				// Use KillSessionWithProcesses to ensure all descendant processes are killed.
+				sessionName := sf.sessionName
+				_ = ctx.Tx.Do("restart "+sessionName, func() error {
+					if running, _ := t.HasSession(sessionName); running {
+						_ = t.KillSessionWithProcesses(sessionName)
+					}
+					return nil
+				}, nil)
 			}
 		}
 	}
@@ -766,7 +781,7 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 
 	// Tell user to restart agents so they create correct settings
 	if needsRestart && !ctx.RestartSessions {
-		fmt.Printf("\n  %s Restart agents to create new settings:\n", style.Warning.Render("⚠"))
+		fmt.Printf("\n  %s Restart agents to create new settings:\n", style.WarningPrefix)
 		fmt.Printf("      gt up --restore\n")
 		fmt.Printf("\n  If you had custom Claude settings edits, re-apply them via 'gt hooks override <role>'.\n\n")
 	}
@@ -58,6 +58,9 @@ func (c *CommandsCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 }
 
+// FixSafety grades the fix safe for autofix: it only provisions missing slash commands.
+func (c *CommandsCheck) FixSafety() FixSafety { return FixSafe }
+
 // Fix provisions missing slash commands at town level.
 func (c *CommandsCheck) Fix(ctx *CheckContext) error {
 	if len(c.missingCommands) == 0 {
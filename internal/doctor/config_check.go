@@ -255,8 +255,8 @@ func (c *LegacyGastownCheck) Run(ctx *CheckContext) *CheckResult {
 // Fix removes legacy .gastown/ directories.
 func (c *LegacyGastownCheck) Fix(ctx *CheckContext) error {
 	for _, dir := range c.legacyDirs {
-		if err := os.RemoveAll(dir); err != nil {
-			return fmt.Errorf("failed to remove %s: %w", dir, err)
+		if err := ctx.Tx.RemoveAll(dir); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -336,17 +336,18 @@ func (c *SessionHookCheck) Run(ctx *CheckContext) *CheckResult {
 }
 
 // Fix updates settings.json files to use 'gt prime --hook' instead of bare 'gt prime'.
+// The rewrites are staged on ctx.Tx so they apply to all files or none.
 func (c *SessionHookCheck) Fix(ctx *CheckContext) error {
 	for _, path := range c.filesToFix {
-		if err := c.fixSettingsFile(path); err != nil {
+		if err := c.fixSettingsFile(ctx.Tx, path); err != nil {
 			return fmt.Errorf("failed to fix %s: %w", path, err)
 		}
 	}
 	return nil
 }
 
-// fixSettingsFile updates a single settings.json file.
-func (c *SessionHookCheck) fixSettingsFile(path string) error {
+// fixSettingsFile stages the update of a single settings.json file.
+func (c *SessionHookCheck) fixSettingsFile(tx *FixTx, path string) error {
 	// Read file
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -423,7 +424,7 @@ func (c *SessionHookCheck) fixSettingsFile(path string) error {
 	newData := []byte(buf.String())
 
 	// Write back
-	if err := os.WriteFile(path, newData, 0644); err != nil {
+	if err := tx.WriteFile(path, newData, 0644); err != nil {
 		return fmt.Errorf("failed to write file: %w", err)
 	}
 
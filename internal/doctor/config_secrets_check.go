@@ -0,0 +1,465 @@
+package doctor
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/experiment"
+)
+
+// ConfigSecretsCheck scans town and rig config files and prime templates for
+// values that look like live credentials. Config files get copied into
+// support bundles, pasted into issues, and committed to rig repos; keys
+// belong in the secrets store (mayor/secrets.env) instead.
+//
+// Fix moves credentials found in env maps into the store and replaces them
+// with ${secret:NAME} references, which are resolved when a session's
+// environment is built (and when daemon.json's env is exported). Only the
+// env entry itself is rewritten. Credentials anywhere else are reported
+// only: no other config value knows how to resolve a reference.
+type ConfigSecretsCheck struct {
+	FixableCheck
+	findings []secretFinding // Populated by Run, consumed by Fix
+}
+
+// secretFinding is one credential-looking value. The value is kept for Fix
+// and never printed.
+type secretFinding struct {
+	path   string   // Absolute file path
+	rel    string   // Path relative to the town root
+	where  string   // JSON key path, or "line N" in templates
+	keys   []string // JSON key path, for Fix
+	why    string
+	value  string
+	envKey string // Env var the value is assigned to; "" if not in an env map
+}
+
+// NewConfigSecretsCheck creates a new config secrets check.
+func NewConfigSecretsCheck() *ConfigSecretsCheck {
+	return &ConfigSecretsCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "config-secrets",
+				CheckDescription: "Check config files and prime templates for plaintext credentials",
+				CheckCategory:    CategoryConfig,
+			},
+		},
+	}
+}
+
+// knownSecretFormats are credential formats recognizable from the value alone.
+var knownSecretFormats = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{"an Anthropic API key", regexp.MustCompile(`\bsk-ant-[A-Za-z0-9_-]{20,}`)},
+	{"an OpenAI API key", regexp.MustCompile(`\bsk-(proj-)?[A-Za-z0-9_-]{20,}`)},
+	{"a GitHub token", regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{20,}|github_pat_[A-Za-z0-9_]{20,})`)},
+	{"a Slack token", regexp.MustCompile(`\bxox[abpr]-[A-Za-z0-9-]{10,}`)},
+	{"an AWS access key", regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`)},
+	{"a Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`)},
+	{"a Stripe key", regexp.MustCompile(`\b[sr]k_live_[0-9A-Za-z]{20,}`)},
+	{"a private key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+}
+
+// credentialKey matches keys whose values are credentials.
+var credentialKey = regexp.MustCompile(`(?i)(token|secret|passw(or)?d|api[_-]?key|access[_-]?key|private[_-]?key|credential|auth)`)
+
+// templateAssignment matches "key: value" and "key=value" in templates.
+var templateAssignment = regexp.MustCompile(`(?i)(?:token|secret|passw(?:or)?d|api[_-]?key|access[_-]?key|credential)\w*["']?\s*[:=]\s*["']?([^\s"']+)`)
+
+// Entropy thresholds, in bits per character. Random base64 scores about 5.5
+// and random hex 4; identifiers, paths, and words score under 3.5.
+const (
+	credentialKeyEntropy = 3.0 // Value under a credential-looking key
+	bareTokenEntropy     = 4.3 // Long value under any key
+	bareTokenMinLen      = 32
+)
+
+// Run scans config files and templates.
+func (c *ConfigSecretsCheck) Run(ctx *CheckContext) *CheckResult {
+	c.findings = nil
+	files, templates := secretScanFiles(ctx)
+	for _, path := range files {
+		c.findings = append(c.findings, scanConfigSecrets(ctx.TownRoot, path)...)
+	}
+	for _, path := range templates {
+		c.findings = append(c.findings, scanTemplateSecrets(ctx.TownRoot, path)...)
+	}
+
+	if len(c.findings) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("No plaintext credentials in %d config file(s) and %d template(s)", len(files), len(templates)),
+		}
+	}
+
+	var details []string
+	migratable := 0
+	for _, f := range c.findings {
+		details = append(details, fmt.Sprintf("%s: %s looks like %s", f.rel, f.where, f.why))
+		if f.envKey != "" {
+			migratable++
+		}
+	}
+	hint := "Move credentials into mayor/secrets.env and reference them as ${secret:NAME} from env config"
+	if migratable > 0 {
+		hint = fmt.Sprintf("Run 'gt doctor --fix' to move %d env value(s) into mayor/secrets.env; move the rest by hand", migratable)
+		if migratable == len(c.findings) {
+			hint = "Run 'gt doctor --fix' to move them into mayor/secrets.env as ${secret:NAME} references"
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d plaintext credential(s) in config", len(c.findings)),
+		Details: details,
+		FixHint: hint,
+	}
+}
+
+// Fix moves credentials found in env maps into the secrets store and
+// replaces them with references. The store and every rewritten file are
+// staged on ctx.Tx so they apply together.
+func (c *ConfigSecretsCheck) Fix(ctx *CheckContext) error {
+	secrets, err := config.LoadSecrets(ctx.TownRoot)
+	if err != nil {
+		return err
+	}
+	byValue := make(map[string]string, len(secrets))
+	for name, v := range secrets {
+		byValue[v] = name
+	}
+
+	rewrites := make(map[string][]byte)
+	var order []string
+	for _, f := range c.findings {
+		if f.envKey == "" {
+			continue
+		}
+		name, ok := byValue[f.value]
+		if !ok {
+			name = uniqueSecretName(secrets, f.envKey)
+			secrets[name] = f.value
+			byValue[f.value] = name
+		}
+		data, ok := rewrites[f.path]
+		if !ok {
+			if data, err = os.ReadFile(f.path); err != nil { //nolint:gosec // G304: path is from the scan
+				return fmt.Errorf("reading %s: %w", f.rel, err)
+			}
+			order = append(order, f.path)
+		}
+		if data, err = replaceJSONString(data, f.keys, config.SecretRef(name)); err != nil {
+			return fmt.Errorf("%s: %s: %w", f.rel, f.where, err)
+		}
+		rewrites[f.path] = data
+	}
+	if len(order) == 0 {
+		return nil
+	}
+
+	data, err := config.FormatSecrets(secrets)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Tx.WriteFile(config.SecretsPath(ctx.TownRoot), data, 0600); err != nil {
+		return err
+	}
+	for _, path := range order {
+		if err := ctx.Tx.WriteFile(path, rewrites[path], 0644); err != nil {
+			return err
+		}
+	}
+	c.findings = nil
+	return nil
+}
+
+// secretScanFiles returns the JSON config files and prime templates to scan.
+func secretScanFiles(ctx *CheckContext) (files, templates []string) {
+	globs := []string{
+		filepath.Join(ctx.TownRoot, "settings", "*.json"),
+		filepath.Join(ctx.TownRoot, constants.DirMayor, "*.json"),
+	}
+	rigs := findAllRigs(ctx.TownRoot)
+	if ctx.RigName != "" {
+		rigs = []string{filepath.Join(ctx.TownRoot, ctx.RigName)}
+	}
+	for _, rigPath := range rigs {
+		globs = append(globs,
+			filepath.Join(rigPath, "config.json"),
+			filepath.Join(rigPath, "settings", "*.json"))
+	}
+	for _, g := range globs {
+		matches, _ := filepath.Glob(g)
+		files = append(files, matches...)
+	}
+
+	seen := make(map[string]bool)
+	addTemplate := func(path string) {
+		if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() && !seen[path] {
+			seen[path] = true
+			templates = append(templates, path)
+		}
+	}
+	matches, _ := filepath.Glob(filepath.Join(ctx.TownRoot, "templates", "*.tmpl"))
+	for _, m := range matches {
+		addTemplate(m)
+	}
+	if store, err := experiment.LoadStore(ctx.TownRoot); err == nil {
+		for _, e := range store.Experiments {
+			if e.Kind != experiment.KindPrompt {
+				continue
+			}
+			for _, v := range e.Variants {
+				addTemplate(filepath.Join(ctx.TownRoot, v.Value))
+			}
+		}
+	}
+	sort.Strings(templates)
+	return files, templates
+}
+
+// scanConfigSecrets reports credential-looking string values in a JSON file.
+func scanConfigSecrets(townRoot, path string) []secretFinding {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is from the scan
+	if err != nil {
+		return nil
+	}
+	var v interface{}
+	if json.Unmarshal(data, &v) != nil {
+		return nil
+	}
+	rel := relToTown(townRoot, path)
+	var findings []secretFinding
+	var walk func(keys []string, v interface{})
+	walk = func(keys []string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			names := make([]string, 0, len(v))
+			for k := range v {
+				names = append(names, k)
+			}
+			sort.Strings(names)
+			for _, k := range names {
+				walk(append(keys, k), v[k])
+			}
+		case []interface{}:
+			for i, item := range v {
+				walk(append(keys, fmt.Sprintf("[%d]", i)), item)
+			}
+		case string:
+			key := ""
+			if len(keys) > 0 {
+				key = keys[len(keys)-1]
+			}
+			why := classifySecret(key, v)
+			if why == "" {
+				return
+			}
+			f := secretFinding{path: path, rel: rel, where: strings.Join(keys, "."), keys: append([]string(nil), keys...), why: why, value: v}
+			if len(keys) >= 2 && isEnvMap(keys[:len(keys)-1]) {
+				f.envKey = key
+			}
+			findings = append(findings, f)
+		}
+	}
+	walk(nil, v)
+	return findings
+}
+
+// scanTemplateSecrets reports lines of a template that carry credentials.
+func scanTemplateSecrets(townRoot, path string) []secretFinding {
+	f, err := os.Open(path) //nolint:gosec // G304: path is from the scan
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	rel := relToTown(townRoot, path)
+	var findings []secretFinding
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		why := classifySecret("", line)
+		if why == "" {
+			if m := templateAssignment.FindStringSubmatch(line); m != nil && !isSecretPlaceholder(m[1]) && shannonEntropy(m[1]) >= credentialKeyEntropy && len(m[1]) >= 8 {
+				why = "an inline credential"
+			}
+		}
+		if why != "" {
+			findings = append(findings, secretFinding{path: path, rel: rel, where: fmt.Sprintf("line %d", n), why: why})
+		}
+	}
+	return findings
+}
+
+// classifySecret returns what value (stored under key) looks like, or "" if
+// it doesn't look like a credential.
+func classifySecret(key, value string) string {
+	if isSecretPlaceholder(value) || strings.HasSuffix(strings.ToLower(key), "hash") {
+		return ""
+	}
+	for _, f := range knownSecretFormats {
+		if f.pattern.MatchString(value) {
+			return f.name
+		}
+	}
+	if key == "" || strings.ContainsAny(value, " \t/") {
+		return ""
+	}
+	// Keys ending in _env name the variable holding a credential (e.g.,
+	// password_env), not the credential itself.
+	if credentialKey.MatchString(key) && !strings.HasSuffix(strings.ToLower(key), "_env") &&
+		len(value) >= 8 && shannonEntropy(value) >= credentialKeyEntropy {
+		return "a credential"
+	}
+	if len(value) >= bareTokenMinLen && !isHexLike(value) && shannonEntropy(value) >= bareTokenEntropy {
+		return "a high-entropy token"
+	}
+	return ""
+}
+
+// isSecretPlaceholder reports values that reference a credential instead of
+// holding one: ${secret:NAME}, $VAR, and template actions.
+func isSecretPlaceholder(v string) bool {
+	return strings.Contains(v, "${") || strings.HasPrefix(v, "$") || strings.Contains(v, "{{")
+}
+
+// isEnvMap reports whether keys address an environment map: an agent's
+// "env" or an env profile's "env.vars".
+func isEnvMap(keys []string) bool {
+	last := keys[len(keys)-1]
+	return last == "env" || (last == "vars" && len(keys) >= 2 && keys[len(keys)-2] == "env")
+}
+
+// isHexLike reports hashes, commit SHAs, and UUIDs, which are random but
+// not secret.
+func isHexLike(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF-", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// shannonEntropy returns the entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+	var h float64
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		h -= p * math.Log2(p)
+	}
+	return h
+}
+
+// uniqueSecretName derives a secret name from an env key, suffixed if the
+// store already uses it.
+func uniqueSecretName(secrets map[string]string, key string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, strings.ToUpper(key))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "SECRET_" + name
+	}
+	if _, taken := secrets[name]; !taken {
+		return name
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s_%d", name, i)
+		if _, taken := secrets[candidate]; !taken {
+			return candidate
+		}
+	}
+}
+
+func jsonString(s string) []byte {
+	data, _ := json.Marshal(s)
+	return data
+}
+
+// replaceJSONString replaces the string value at keys in the JSON document
+// data with repl, leaving the rest of the document byte for byte. Equal
+// values under other keys are not touched.
+func replaceJSONString(data []byte, keys []string, repl string) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	start, end := -1, -1
+	var walk func(path []string) error
+	walk = func(path []string) error {
+		before := dec.InputOffset()
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case json.Delim:
+			if t == '{' {
+				for dec.More() {
+					key, err := dec.Token()
+					if err != nil {
+						return err
+					}
+					if err := walk(append(path[:len(path):len(path)], key.(string))); err != nil {
+						return err
+					}
+				}
+			} else {
+				for i := 0; dec.More(); i++ {
+					if err := walk(append(path[:len(path):len(path)], fmt.Sprintf("[%d]", i))); err != nil {
+						return err
+					}
+				}
+			}
+			_, err = dec.Token() // Closing delimiter
+			return err
+		case string:
+			if start < 0 && slices.Equal(path, keys) {
+				// The span since the previous token holds the separator, then the quoted value.
+				end = int(dec.InputOffset())
+				start = int(before) + bytes.IndexByte(data[before:end], '"')
+			}
+		}
+		return nil
+	}
+	if err := walk(nil); err != nil {
+		return nil, fmt.Errorf("parsing: %w", err)
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("value not found")
+	}
+	out := append([]byte(nil), data[:start]...)
+	out = append(out, jsonString(repl)...)
+	return append(out, data[end:]...), nil
+}
+
+func relToTown(townRoot, path string) string {
+	if rel, err := filepath.Rel(townRoot, path); err == nil {
+		return rel
+	}
+	return path
+}
@@ -0,0 +1,56 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// ConfigSnapshot is the town's effective configuration as of the last
+// fully green gt doctor run. gt diff-config compares against it to show
+// what changed since the town was last healthy.
+type ConfigSnapshot struct {
+	SavedAt  time.Time         `json:"saved_at"`
+	Settings map[string]string `json:"settings"`
+}
+
+// ConfigSnapshotPath returns the path of the town's known-good config snapshot.
+func ConfigSnapshotPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "config-known-good.json")
+}
+
+// LoadConfigSnapshot reads the known-good snapshot. Returns nil, nil if
+// none was saved.
+func LoadConfigSnapshot(townRoot string) (*ConfigSnapshot, error) {
+	data, err := os.ReadFile(ConfigSnapshotPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading config snapshot: %w", err)
+	}
+	var s ConfigSnapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parsing config snapshot: %w", err)
+	}
+	return &s, nil
+}
+
+// SaveConfigSnapshot records the town's current effective configuration
+// as known-good.
+func SaveConfigSnapshot(townRoot string) error {
+	settings, err := config.EffectiveSettings(townRoot, config.TownRigNames(townRoot))
+	if err != nil {
+		return err
+	}
+	return util.EnsureDirAndWriteJSON(ConfigSnapshotPath(townRoot), &ConfigSnapshot{
+		SavedAt:  time.Now().UTC(),
+		Settings: settings,
+	})
+}
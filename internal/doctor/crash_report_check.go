@@ -49,7 +49,7 @@ func (c *CrashReportCheck) Run(ctx *CheckContext) *CheckResult {
 
 	// Look for crash reports in the last 24 hours
 	lookbackWindow := 24 * time.Hour
-	cutoff := time.Now().Add(-lookbackWindow)
+	cutoff := ctx.Now().Add(-lookbackWindow)
 
 	// macOS crash report locations
 	homeDir, err := os.UserHomeDir()
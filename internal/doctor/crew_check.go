@@ -164,7 +164,7 @@ func (c *CrewStateCheck) Fix(ctx *CheckContext) error {
 			continue
 		}
 
-		if err := os.WriteFile(ic.stateFile, data, 0644); err != nil {
+		if err := ctx.Tx.WriteFile(ic.stateFile, data, 0644); err != nil {
 			lastErr = fmt.Errorf("%s/%s: %w", ic.rigName, ic.crewName, err)
 			continue
 		}
@@ -0,0 +1,128 @@
+package doctor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/incident"
+)
+
+// heartbeatStaleFactor is how many configured intervals may pass without a
+// heartbeat before it counts as stalled.
+const heartbeatStaleFactor = 2
+
+// DaemonHeartbeatCheck reads the daemon's last recorded heartbeat and warns
+// when it is older than twice the configured interval. It tells a dead
+// daemon apart from one whose process is alive but whose heartbeat loop has
+// stalled (blocked on a slow patrol, wedged on Dolt, and so on).
+type DaemonHeartbeatCheck struct {
+	BaseCheck
+	now       func() time.Time
+	isRunning func(townRoot string) (bool, int, error)
+}
+
+// NewDaemonHeartbeatCheck creates a new daemon heartbeat check.
+func NewDaemonHeartbeatCheck() *DaemonHeartbeatCheck {
+	return &DaemonHeartbeatCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "daemon-heartbeat",
+			CheckDescription: "Check the daemon's last heartbeat is recent",
+			CheckCategory:    CategoryInfrastructure,
+			CheckDependsOn:   []string{"daemon"},
+		},
+		now:       time.Now,
+		isRunning: daemon.IsRunning,
+	}
+}
+
+// Run compares the last heartbeat against the configured interval.
+func (c *DaemonHeartbeatCheck) Run(ctx *CheckContext) *CheckResult {
+	interval := config.LoadOperationalConfig(ctx.TownRoot).GetDaemonConfig().RecoveryHeartbeatIntervalD()
+	limit := interval * heartbeatStaleFactor
+
+	state, err := daemon.LoadState(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusWarning, Message: "Could not read daemon state", Details: []string{err.Error()}}
+	}
+	running, pid, err := c.isRunning(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusError, Message: "Failed to check daemon status", Details: []string{err.Error()}}
+	}
+
+	now := c.now()
+	details := heartbeatCadence(ctx.TownRoot, state, interval, now)
+
+	if !running {
+		msg := "Daemon is dead: no heartbeat has been recorded"
+		if !state.LastHeartbeat.IsZero() {
+			msg = fmt.Sprintf("Daemon is dead: last heartbeat %s ago", now.Sub(state.LastHeartbeat).Round(time.Second))
+		}
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: msg,
+			Details: details,
+			FixHint: "Run 'gt daemon start'",
+		}
+	}
+
+	// The daemon's first heartbeat runs at startup; until then, measure
+	// from when it started.
+	last := state.LastHeartbeat
+	if last.IsZero() || last.Before(state.StartedAt) {
+		last = state.StartedAt
+	}
+	age := now.Sub(last)
+	if last.IsZero() || age <= limit {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("Heartbeat %s ago (interval %s)", age.Round(time.Second), interval),
+			Details: details,
+		}
+	}
+
+	// The daemon deliberately skips heartbeats during an incident.
+	if inc, _ := incident.Active(ctx.TownRoot); inc != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Heartbeat paused %s by incident %s", age.Round(time.Second), inc.ID),
+			Details: details,
+			FixHint: "Run 'gt incident end' to resume recovery",
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusError,
+		Message: fmt.Sprintf("Daemon alive (PID %d) but heartbeat stalled: last beat %s ago, over %dx the %s interval", pid, age.Round(time.Second), heartbeatStaleFactor, interval),
+		Details: details,
+		FixHint: "Check daemon/daemon.log for the patrol it is stuck in, then 'gt daemon stop && gt daemon start'",
+	}
+}
+
+// heartbeatCadence describes the configured and observed heartbeat cadence.
+func heartbeatCadence(townRoot string, state *daemon.State, interval time.Duration, now time.Time) []string {
+	details := []string{"Configured interval: " + interval.String()}
+	if state.LastHeartbeat.IsZero() {
+		return details
+	}
+	details = append(details, fmt.Sprintf("Last heartbeat: %s (%s ago)",
+		state.LastHeartbeat.Local().Format("2006-01-02 15:04:05"), now.Sub(state.LastHeartbeat).Round(time.Second)))
+	// The first heartbeat runs at startup, so count-1 intervals separate
+	// the start from the last one.
+	if state.HeartbeatCount > 1 && state.LastHeartbeat.After(state.StartedAt) {
+		avg := state.LastHeartbeat.Sub(state.StartedAt) / time.Duration(state.HeartbeatCount-1)
+		details = append(details, fmt.Sprintf("Observed cadence: every %s over %d heartbeats", avg.Round(time.Second), state.HeartbeatCount))
+	}
+	if timing, err := daemon.LoadPatrolTiming(townRoot); err == nil {
+		if hb, ok := timing["heartbeat"]; ok && hb.Ticks > 0 {
+			details = append(details, fmt.Sprintf("Last interval: %s (scheduled %s, max drift %s)",
+				hb.LastActual.Round(time.Second), hb.Scheduled.Round(time.Second), hb.MaxDrift.Round(time.Second)))
+		}
+	}
+	return details
+}
@@ -0,0 +1,68 @@
+package doctor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/daemon"
+)
+
+func TestDaemonHeartbeatCheck(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	started := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		running bool
+		last    time.Duration // ago; 0 = no heartbeat recorded
+		count   int64
+		status  CheckStatus
+		message string
+	}{
+		{name: "recent", running: true, last: 2 * time.Minute, count: 20, status: StatusOK, message: "Heartbeat 2m0s ago"},
+		{name: "stalled", running: true, last: 7 * time.Minute, count: 19, status: StatusError, message: "heartbeat stalled"},
+		{name: "dead", running: false, last: 20 * time.Minute, count: 14, status: StatusWarning, message: "Daemon is dead: last heartbeat 20m0s ago"},
+		{name: "never ran", running: false, status: StatusWarning, message: "no heartbeat has been recorded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			townRoot := t.TempDir()
+			state := &daemon.State{Running: tt.running, StartedAt: started, HeartbeatCount: tt.count}
+			if tt.last > 0 {
+				state.LastHeartbeat = now.Add(-tt.last)
+			}
+			if err := daemon.SaveState(townRoot, state); err != nil {
+				t.Fatal(err)
+			}
+
+			c := NewDaemonHeartbeatCheck()
+			c.now = func() time.Time { return now }
+			c.isRunning = func(string) (bool, int, error) { return tt.running, 4242, nil }
+			result := c.Run(&CheckContext{TownRoot: townRoot})
+
+			if result.Status != tt.status {
+				t.Errorf("status = %v, want %v (%s)", result.Status, tt.status, result.Message)
+			}
+			if !strings.Contains(result.Message, tt.message) {
+				t.Errorf("message = %q, want it to contain %q", result.Message, tt.message)
+			}
+			if !strings.Contains(strings.Join(result.Details, "\n"), "Configured interval: 3m0s") {
+				t.Errorf("details should show the configured interval: %v", result.Details)
+			}
+		})
+	}
+}
+
+func TestHeartbeatCadence(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	state := &daemon.State{
+		StartedAt:      now.Add(-time.Hour),
+		LastHeartbeat:  now.Add(-time.Minute),
+		HeartbeatCount: 12,
+	}
+	details := strings.Join(heartbeatCadence(t.TempDir(), state, 3*time.Minute, now), "\n")
+	if !strings.Contains(details, "Observed cadence: every 5m22s over 12 heartbeats") {
+		t.Errorf("details = %s", details)
+	}
+}
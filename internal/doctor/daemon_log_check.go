@@ -0,0 +1,165 @@
+package doctor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/daemon"
+)
+
+const (
+	// daemonLogWindow is how far back the daemon log is scanned.
+	daemonLogWindow = 24 * time.Hour
+
+	// daemonLogTailBytes caps how much of the log is read. The log rotates,
+	// so the tail covers the window on all but the noisiest daemons.
+	daemonLogTailBytes = 4 << 20
+
+	// daemonLogTimeLayout is the log.LstdFlags prefix the daemon writes.
+	daemonLogTimeLayout = "2006/01/02 15:04:05"
+)
+
+// daemonLogPattern is a known-bad daemon log pattern. A pattern reports once
+// it matches at least Threshold lines in the window; Threshold > 1 is for
+// failures that are only worrying when they repeat.
+type daemonLogPattern struct {
+	Name      string
+	Regexp    *regexp.Regexp
+	Threshold int
+	Status    CheckStatus
+}
+
+var daemonLogPatterns = []daemonLogPattern{
+	{Name: "panic", Regexp: regexp.MustCompile(`(^|\s)panic: |goroutine \d+ \[running\]|fatal error: `), Threshold: 1, Status: StatusError},
+	{Name: "patrol-launch", Regexp: regexp.MustCompile(`Error (starting|spawning|restarting) |Scheduler dispatch failed`), Threshold: 1, Status: StatusWarning},
+	{Name: "export-failure", Regexp: regexp.MustCompile(`(export|sync|snapshot|prune) failed|git operations failed|consecutive push failures`), Threshold: 3, Status: StatusWarning},
+	{Name: "dolt-server", Regexp: regexp.MustCompile(`Error ensuring Dolt server|failed to stop Dolt server`), Threshold: 3, Status: StatusWarning},
+}
+
+// daemonLogHit summarizes matches for one pattern.
+type daemonLogHit struct {
+	count    int
+	lastAt   time.Time
+	lastLine string
+}
+
+// DaemonLogCheck scans the recent daemon log for known bad patterns so silent
+// daemon degradation shows up in doctor rather than only in the log file.
+type DaemonLogCheck struct {
+	BaseCheck
+	window time.Duration
+	now    func() time.Time
+}
+
+// NewDaemonLogCheck creates a new daemon log check.
+func NewDaemonLogCheck() *DaemonLogCheck {
+	return &DaemonLogCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "daemon-log-errors",
+			CheckDescription: "Scan the recent daemon log for panics and repeated failures",
+			CheckCategory:    CategoryInfrastructure,
+		},
+		window: daemonLogWindow,
+		now:    time.Now,
+	}
+}
+
+// Run scans the daemon log.
+func (c *DaemonLogCheck) Run(ctx *CheckContext) *CheckResult {
+	logPath := daemon.DefaultConfig(ctx.TownRoot).LogFile
+	f, err := os.Open(logPath) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "No daemon log yet"}
+		}
+		return &CheckResult{Name: c.Name(), Status: StatusWarning, Message: "Could not read daemon log", Details: []string{err.Error()}}
+	}
+	defer f.Close()
+
+	hits, err := scanDaemonLog(f, c.now().Add(-c.window))
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusWarning, Message: "Could not read daemon log", Details: []string{err.Error()}}
+	}
+
+	status := StatusOK
+	var details []string
+	for _, p := range daemonLogPatterns {
+		h := hits[p.Name]
+		if h == nil || h.count < p.Threshold {
+			continue
+		}
+		if p.Status == StatusError || status == StatusOK {
+			status = p.Status
+		}
+		details = append(details, fmt.Sprintf("%s: %d in last %s (latest %s: %s)",
+			p.Name, h.count, c.window, h.lastAt.Format("01-02 15:04"), truncateLogLine(h.lastLine, 120)))
+	}
+
+	if status == StatusOK {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("No known error patterns in the last %s", c.window),
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  status,
+		Message: fmt.Sprintf("Daemon log shows %d problem pattern(s) in the last %s", len(details), c.window),
+		Details: details,
+		FixHint: "Inspect with 'gt daemon logs -n 200'",
+	}
+}
+
+// scanDaemonLog counts pattern matches in lines at or after since. Lines
+// without a timestamp (stack traces, stderr) take the previous line's time.
+func scanDaemonLog(f *os.File, since time.Time) (map[string]*daemonLogHit, error) {
+	if info, err := f.Stat(); err == nil && info.Size() > daemonLogTailBytes {
+		if _, err := f.Seek(-daemonLogTailBytes, io.SeekEnd); err != nil {
+			return nil, err
+		}
+	}
+
+	hits := make(map[string]*daemonLogHit)
+	var at time.Time
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(line) >= len(daemonLogTimeLayout) {
+			if t, err := time.ParseInLocation(daemonLogTimeLayout, string(line[:len(daemonLogTimeLayout)]), time.Local); err == nil {
+				at = t
+			}
+		}
+		if at.IsZero() || at.Before(since) {
+			continue
+		}
+		for _, p := range daemonLogPatterns {
+			if !p.Regexp.Match(line) {
+				continue
+			}
+			h := hits[p.Name]
+			if h == nil {
+				h = &daemonLogHit{}
+				hits[p.Name] = h
+			}
+			h.count++
+			h.lastAt = at
+			h.lastLine = string(bytes.TrimSpace(line))
+			break
+		}
+	}
+	return hits, sc.Err()
+}
+
+func truncateLogLine(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-3] + "..."
+}
@@ -0,0 +1,101 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeDaemonLog(t *testing.T, townRoot string, lines ...string) {
+	t.Helper()
+	dir := filepath.Join(townRoot, "daemon")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "daemon.log"), []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDaemonLogCheck(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.Local)
+	ts := func(ago time.Duration) string { return now.Add(-ago).Format(daemonLogTimeLayout) }
+
+	tests := []struct {
+		name    string
+		lines   []string
+		status  CheckStatus
+		details []string
+	}{
+		{
+			name:   "no log",
+			status: StatusOK,
+		},
+		{
+			name:   "clean",
+			lines:  []string{ts(time.Hour) + " Heartbeat complete"},
+			status: StatusOK,
+		},
+		{
+			name: "single export failure is tolerated",
+			lines: []string{
+				ts(time.Hour) + " jsonl_git_backup: hq: export failed: timeout",
+				ts(30*time.Minute) + " dolt_backup: hq: sync failed: busy",
+			},
+			status: StatusOK,
+		},
+		{
+			name: "repeated export failures",
+			lines: []string{
+				ts(3*time.Hour) + " jsonl_git_backup: hq: export failed: timeout",
+				ts(2*time.Hour) + " jsonl_git_backup: hq: export failed: timeout",
+				ts(time.Hour) + " dolt_backup: hq: sync failed: busy",
+			},
+			status:  StatusWarning,
+			details: []string{"export-failure: 3"},
+		},
+		{
+			name: "old failures fall outside the window",
+			lines: []string{
+				ts(48*time.Hour) + " Error starting witness for gastown: boom",
+				ts(47*time.Hour) + " panic: nil map",
+			},
+			status: StatusOK,
+		},
+		{
+			name: "panic stack inherits timestamp",
+			lines: []string{
+				ts(time.Hour) + " Error starting refinery for gastown: no session",
+				ts(time.Minute) + " heartbeat",
+				"panic: runtime error: invalid memory address",
+				"goroutine 1 [running]:",
+			},
+			status:  StatusError,
+			details: []string{"panic: 2", "patrol-launch: 1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			townRoot := t.TempDir()
+			if tt.lines != nil {
+				writeDaemonLog(t, townRoot, tt.lines...)
+			}
+			check := NewDaemonLogCheck()
+			check.now = func() time.Time { return now }
+
+			result := check.Run(&CheckContext{TownRoot: townRoot})
+			if result.Status != tt.status {
+				t.Fatalf("status = %v, want %v (%s: %v)", result.Status, tt.status, result.Message, result.Details)
+			}
+			joined := strings.Join(result.Details, "\n")
+			for _, want := range tt.details {
+				if !strings.Contains(joined, want) {
+					t.Errorf("details %q missing %q", joined, want)
+				}
+			}
+		})
+	}
+}
@@ -74,10 +74,11 @@ func (c *DeprecatedMergeQueueKeysCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 }
 
-// Fix removes deprecated keys from all affected settings files.
+// Fix removes deprecated keys from all affected settings files. The rewrites
+// are staged on ctx.Tx so a failure part way leaves every file untouched.
 func (c *DeprecatedMergeQueueKeysCheck) Fix(ctx *CheckContext) error {
 	for settingsPath, keys := range c.affectedFiles {
-		if err := removeDeprecatedKeys(settingsPath, keys); err != nil {
+		if err := removeDeprecatedKeys(ctx.Tx, settingsPath, keys); err != nil {
 			return fmt.Errorf("fixing %s: %w", settingsPath, err)
 		}
 	}
@@ -110,8 +111,8 @@ func findDeprecatedKeys(path string) []string {
 }
 
 // removeDeprecatedKeys reads a settings file, removes deprecated keys from
-// the merge_queue section, and writes it back preserving other fields.
-func removeDeprecatedKeys(path string, keys []string) error {
+// the merge_queue section, and stages writing it back preserving other fields.
+func removeDeprecatedKeys(tx *FixTx, path string, keys []string) error {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return err
@@ -150,5 +151,5 @@ func removeDeprecatedKeys(path string, keys []string) error {
 		return fmt.Errorf("marshaling settings: %w", err)
 	}
 
-	return os.WriteFile(path, append(out, '\n'), 0o644)
+	return tx.WriteFile(path, append(out, '\n'), 0o644)
 }
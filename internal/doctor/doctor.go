@@ -4,14 +4,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/logging"
 	"github.com/steveyegge/gastown/internal/ui"
 )
 
 // Doctor manages and executes health checks.
 type Doctor struct {
-	checks []Check
+	checks  []Check
+	cache   *ResultCache
+	jobs    int           // Checks run at once by Run/RunStreaming (<=1: one at a time)
+	timeout time.Duration // Per-check time limit (0: none)
+	fixGate FixGate
 }
 
 // NewDoctor creates a new Doctor with no registered checks.
@@ -31,6 +37,23 @@ func (d *Doctor) RegisterAll(checks ...Check) {
 	d.checks = append(d.checks, checks...)
 }
 
+// SetCache enables reuse of recent results for checks with a cache TTL.
+// Run/RunStreaming read and refresh the cache; Fix/FixStreaming always run
+// checks fresh but refresh the cache with what they find. nil disables it.
+func (d *Doctor) SetCache(c *ResultCache) {
+	d.cache = c
+}
+
+// FixGate decides whether a failed check's fix may be applied. A non-nil
+// error skips the fix and is reported in the check's details.
+type FixGate func(check Check, result *CheckResult) error
+
+// SetFixGate consults gate before every fix Fix/FixStreaming attempts.
+// nil allows every fix.
+func (d *Doctor) SetFixGate(gate FixGate) {
+	d.fixGate = gate
+}
+
 // Checks returns the list of registered checks.
 func (d *Doctor) Checks() []Check {
 	return d.checks
@@ -50,6 +73,9 @@ func (d *Doctor) Run(ctx *CheckContext) *Report {
 // If w is non-nil, prints each check name as it starts and result when done.
 // If slowThreshold > 0, shows hourglass icon for slow checks.
 func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time.Duration) *Report {
+	if d.jobs > 1 && len(d.checks) > 1 {
+		return d.runParallel(ctx, w, slowThreshold)
+	}
 	report := NewReport()
 
 	for _, check := range d.checks {
@@ -58,45 +84,12 @@ func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 			fmt.Fprintf(w, "  %s  %s...", ui.RenderMuted("○"), check.Name())
 		}
 
-		start := time.Now()
-		result := check.Run(ctx)
-		result.Elapsed = time.Since(start)
-
-		// Ensure check name is populated
-		if result.Name == "" {
-			result.Name = check.Name()
-		}
-		// Set category from check if available
-		if cg, ok := check.(categoryGetter); ok && result.Category == "" {
-			result.Category = cg.Category()
-		}
+		result := d.runCached(ctx, check)
 
 		// Stream: overwrite line with result
 		if w != nil {
-			var statusIcon string
-			switch result.Status {
-			case StatusOK:
-				statusIcon = ui.RenderPassIcon()
-			case StatusWarning:
-				statusIcon = ui.RenderWarnIcon()
-			case StatusError:
-				statusIcon = ui.RenderFailIcon()
-			}
-			// Check if slow (hourglass replaces spaces to maintain alignment)
-			isSlow := slowThreshold > 0 && result.Elapsed >= slowThreshold
-			slowIndicator := "  "
-			if isSlow {
-				report.Summary.Slow++
-				slowIndicator = "⏳"
-			}
-			fmt.Fprintf(w, "\r  %s%s%s", statusIcon, slowIndicator, result.Name)
-			if result.Message != "" {
-				fmt.Fprintf(w, "%s", ui.RenderMuted(" "+result.Message))
-			}
-			if isSlow {
-				fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+formatDuration(result.Elapsed)+")"))
-			}
-			fmt.Fprintln(w)
+			fmt.Fprint(w, "\r")
+			printResultLine(w, report, result, slowThreshold)
 		}
 
 		report.Add(result)
@@ -105,6 +98,64 @@ func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 	return report
 }
 
+// runCached runs one check, or reuses its cached result, and fills in the
+// result's name and category.
+func (d *Doctor) runCached(ctx *CheckContext, check Check) *CheckResult {
+	result, cached := d.cache.Lookup(ctx, check)
+	if !cached {
+		start := time.Now()
+		result = d.runCheck(ctx, check)
+		result.Elapsed = time.Since(start)
+	}
+
+	// Ensure check name is populated
+	if result.Name == "" {
+		result.Name = check.Name()
+	}
+	logging.For(logging.SubsystemDoctor).Debug("check finished", "check", result.Name, "status", result.Status.String(), "elapsed", result.Elapsed, "cached", cached)
+	// Set category from check if available
+	if cg, ok := check.(categoryGetter); ok && result.Category == "" {
+		result.Category = cg.Category()
+	}
+	result.Fixable = check.CanFix()
+	if !result.TimedOut {
+		d.cache.Store(ctx, check, result)
+	}
+	return result
+}
+
+// printResultLine prints a finished check's status line, counting it in
+// report's summary if it was slow.
+func printResultLine(w io.Writer, report *Report, result *CheckResult, slowThreshold time.Duration) {
+	var statusIcon string
+	switch result.Status {
+	case StatusOK:
+		statusIcon = ui.RenderPassIcon()
+	case StatusWarning:
+		statusIcon = ui.RenderWarnIcon()
+	case StatusError:
+		statusIcon = ui.RenderFailIcon()
+	}
+	// Check if slow (hourglass replaces spaces to maintain alignment)
+	isSlow := slowThreshold > 0 && result.Elapsed >= slowThreshold
+	slowIndicator := "  "
+	if isSlow {
+		report.Summary.Slow++
+		slowIndicator = "⏳"
+	}
+	fmt.Fprintf(w, "  %s%s%s", statusIcon, slowIndicator, result.Name)
+	if result.Message != "" {
+		fmt.Fprintf(w, "%s", ui.RenderMuted(" "+result.Message))
+	}
+	if isSlow {
+		fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+formatDuration(result.Elapsed)+")"))
+	}
+	if result.Cached {
+		fmt.Fprintf(w, "%s", ui.RenderMuted(" (cached "+formatDuration(time.Since(result.CachedAt))+" ago)"))
+	}
+	fmt.Fprintln(w)
+}
+
 // Fix runs all checks with auto-fix enabled where possible.
 // It first runs the check, then if it fails and can be fixed, attempts the fix.
 func (d *Doctor) Fix(ctx *CheckContext) *Report {
@@ -123,6 +174,32 @@ func safeFixCheck(check Check, ctx *CheckContext) (retErr error) {
 	return check.Fix(ctx)
 }
 
+// fixTransactionally runs check's Fix with a fresh FixTx and applies what it
+// staged. If Fix fails its staged changes are discarded; if applying them
+// fails the ones already applied are rolled back. The returned details
+// say what happened to each staged change.
+func fixTransactionally(check Check, ctx *CheckContext) ([]string, error) {
+	tx := &FixTx{}
+	ctx.Tx = tx
+	defer func() { ctx.Tx = nil }()
+
+	if err := safeFixCheck(check, ctx); err != nil {
+		var details []string
+		for _, d := range tx.Discard() {
+			details = append(details, "Not applied: "+d)
+		}
+		return details, err
+	}
+	if err := tx.Commit(); err != nil {
+		var txErr *FixTxError
+		if errors.As(err, &txErr) {
+			return txErr.Details(), err
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
 // FixStreaming runs all checks with auto-fix and optional real-time output.
 // If w is non-nil, prints each check name as it starts and result when done.
 // If slowThreshold > 0, shows hourglass icon for slow checks.
@@ -136,7 +213,7 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 		}
 
 		start := time.Now()
-		result := check.Run(ctx)
+		result := d.runCheck(ctx, check)
 		if result.Name == "" {
 			result.Name = check.Name()
 		}
@@ -145,8 +222,19 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 			result.Category = cg.Category()
 		}
 
-		// Attempt fix if check failed and is fixable
-		if result.Status != StatusOK && check.CanFix() {
+		// Attempt fix if check failed, is fixable, and the gate allows it.
+		// A timed-out check's Run may still be going, so it is never fixed.
+		var gateErr error
+		if result.TimedOut && check.CanFix() {
+			gateErr = errors.New("check timed out; its run may still be in progress")
+			result.Details = append(result.Details, "Skipped fix: "+gateErr.Error())
+		}
+		if gateErr == nil && result.Status != StatusOK && check.CanFix() && d.fixGate != nil {
+			if gateErr = d.fixGate(check, result); gateErr != nil {
+				result.Details = append(result.Details, "Skipped fix: "+gateErr.Error())
+			}
+		}
+		if result.Status != StatusOK && check.CanFix() && gateErr == nil {
 			// Stream: show the problem with fixing indicator (all on same line)
 			if w != nil {
 				var problemIcon string
@@ -163,26 +251,40 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 				fmt.Fprintf(w, "%s", ui.RenderMuted(" (fixing)..."))
 			}
 
-			err := safeFixCheck(check, ctx)
+			// Serialize fixes with any concurrent doctor run. The lock covers
+			// the re-run too, so verification sees this run's fix alone.
+			unlock, err := acquireFixLock(ctx.TownRoot, check.Name())
+			var txDetails []string
 			if err == nil {
-				// Re-run check to verify fix worked
-				result = check.Run(ctx)
-				if result.Name == "" {
-					result.Name = check.Name()
-				}
-				// Set category again after re-run
-				if cg, ok := check.(categoryGetter); ok && result.Category == "" {
-					result.Category = cg.Category()
+				txDetails, err = fixTransactionally(check, ctx)
+				if err == nil {
+					// Re-run check to verify fix worked
+					result = d.runCheck(ctx, check)
+					if result.Name == "" {
+						result.Name = check.Name()
+					}
+					// Set category again after re-run
+					if cg, ok := check.(categoryGetter); ok && result.Category == "" {
+						result.Category = cg.Category()
+					}
+					// Update message to indicate fix was applied
+					if result.Status == StatusOK {
+						result.Message = result.Message + " (fixed)"
+						result.Fixed = true
+					}
 				}
-				// Update message to indicate fix was applied
-				if result.Status == StatusOK {
-					result.Message = result.Message + " (fixed)"
-					result.Fixed = true
-				}
-			} else if errors.Is(err, ErrSkippedNoStart) {
+				unlock()
+			}
+			result.Details = append(result.Details, txDetails...)
+			switch {
+			case err == nil:
+			case errors.Is(err, ErrSkippedNoStart):
 				// Fix skipped due to --no-start flag
 				result.Details = append(result.Details, "Skipped: --no-start suppresses startup")
-			} else {
+			case errors.Is(err, ErrFixLocked):
+				// Another doctor is fixing; leave the problem for it (or a re-run)
+				result.Details = append(result.Details, "Skipped: "+strings.TrimPrefix(err.Error(), "skipped: "))
+			default:
 				// Fix failed, add error to details
 				result.Details = append(result.Details, "Fix failed: "+err.Error())
 			}
@@ -190,6 +292,8 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 
 		// Record total elapsed time including any fix attempts
 		result.Elapsed = time.Since(start)
+		result.Fixable = check.CanFix()
+		d.cache.Store(ctx, check, result)
 
 		// Stream: overwrite line with final result
 		if w != nil {
@@ -239,6 +343,15 @@ type BaseCheck struct {
 	CheckName        string
 	CheckDescription string
 	CheckCategory    string // Category for grouping (e.g., CategoryCore)
+
+	// CheckDependsOn names checks that must finish before this one starts
+	// when checks run in parallel (see Doctor.SetJobs).
+	CheckDependsOn []string
+}
+
+// DependsOn returns the checks this check runs after.
+func (b *BaseCheck) DependsOn() []string {
+	return b.CheckDependsOn
 }
 
 // Category returns the check's category for grouping in output.
@@ -0,0 +1,62 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// DoctorSettingsCheck reports a town settings file doctor cannot use: one
+// that does not parse, or whose doctor policy (severities, exit codes,
+// notifications, groups, cache TTLs) is invalid. gt doctor runs on the
+// defaults in that case instead of aborting, so this check is where the
+// problem shows.
+type DoctorSettingsCheck struct {
+	BaseCheck
+}
+
+// NewDoctorSettingsCheck creates a new doctor settings check.
+func NewDoctorSettingsCheck() *DoctorSettingsCheck {
+	return &DoctorSettingsCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "doctor-settings",
+			CheckDescription: "Check the doctor policy and cache TTLs in settings/config.json are valid",
+			CheckCategory:    CategoryConfig,
+		},
+	}
+}
+
+// Run loads the doctor policy the way gt doctor does, then validates the
+// cache TTL overrides, which gt doctor skips one by one when invalid.
+func (c *DoctorSettingsCheck) Run(ctx *CheckContext) *CheckResult {
+	fixHint := "Fix the \"doctor\" section of " + config.TownSettingsPath(ctx.TownRoot)
+	if _, err := LoadPolicy(ctx.TownRoot); err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "Doctor settings are invalid; this run used the default policy",
+			Details: []string{err.Error()},
+			FixHint: fixHint,
+		}
+	}
+	if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(ctx.TownRoot)); err == nil {
+		if errs := ValidateCacheTTLs(settings.Doctor); len(errs) > 0 {
+			details := make([]string, len(errs))
+			for i, err := range errs {
+				details[i] = err.Error()
+			}
+			return &CheckResult{
+				Name:    c.Name(),
+				Status:  StatusError,
+				Message: fmt.Sprintf("%d invalid cache TTL(s); those checks use their default TTL", len(errs)),
+				Details: details,
+				FixHint: fixHint,
+			}
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: "Doctor policy is valid",
+	}
+}
@@ -0,0 +1,35 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestDoctorSettingsCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	path := config.TownSettingsPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	check := NewDoctorSettingsCheck()
+
+	for name, tc := range map[string]struct {
+		settings string
+		want     CheckStatus
+	}{
+		"valid":            {`{"type":"town-settings","version":1,"doctor":{"severities":{"daemon":"critical"}}}`, StatusOK},
+		"bad severity":     {`{"type":"town-settings","version":1,"doctor":{"severities":{"daemon":"fatal"}}}`, StatusError},
+		"unparseable file": {`{"type":"town-settings",`, StatusError},
+		"bad cache ttl":    {`{"type":"town-settings","version":1,"doctor":{"cache_ttls":{"daemon":"soon"}}}`, StatusError},
+	} {
+		if err := os.WriteFile(path, []byte(tc.settings), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if got := check.Run(&CheckContext{TownRoot: townRoot}); got.Status != tc.want {
+			t.Errorf("%s: status = %v, want %v (%s %v)", name, got.Status, tc.want, got.Message, got.Details)
+		}
+	}
+}
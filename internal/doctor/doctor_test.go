@@ -2,6 +2,7 @@ package doctor
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -423,3 +424,65 @@ func TestFixableCheck(t *testing.T) {
 		t.Error("FixableCheck.CanFix() should return true")
 	}
 }
+
+func TestDoctor_FixSkipsWhenLockHeld(t *testing.T) {
+	townRoot := t.TempDir()
+	prev := fixLockWait
+	fixLockWait = 0
+	t.Cleanup(func() { fixLockWait = prev })
+
+	// Another doctor run holds the lock mid-fix.
+	unlock, err := acquireFixLock(townRoot, "other-check")
+	if err != nil {
+		t.Fatalf("acquireFixLock: %v", err)
+	}
+
+	check := newMockCheck("fixable", StatusError)
+	check.fixable = true
+	d := NewDoctor()
+	d.Register(check)
+
+	report := d.Fix(&CheckContext{TownRoot: townRoot})
+	if check.fixCount != 0 {
+		t.Error("Fix() should not run while another doctor holds the lock")
+	}
+	details := strings.Join(report.Checks[0].Details, "\n")
+	if !strings.Contains(details, "another doctor holds the lock") || !strings.Contains(details, "other-check") {
+		t.Errorf("details should name the lock holder, got %q", details)
+	}
+
+	unlock()
+	if ReadFixLockHolder(townRoot) != nil {
+		t.Error("releasing the lock should clear the holder record")
+	}
+	report = d.Fix(&CheckContext{TownRoot: townRoot})
+	if check.fixCount != 1 || !report.Checks[0].Fixed {
+		t.Errorf("fix should apply once the lock is free (fixCount=%d)", check.fixCount)
+	}
+}
+
+func TestDoctor_FixGate(t *testing.T) {
+	gated := newMockCheck("gated", StatusError)
+	gated.fixable = true
+	allowed := newMockCheck("allowed", StatusError)
+	allowed.fixable = true
+	d := NewDoctor()
+	d.RegisterAll(gated, allowed)
+	d.SetFixGate(func(check Check, result *CheckResult) error {
+		if check.Name() == "gated" {
+			return errors.New("denied by policy \"no-gated\"")
+		}
+		return nil
+	})
+
+	report := d.Fix(&CheckContext{TownRoot: t.TempDir()})
+	if gated.fixCount != 0 || report.Checks[0].Status != StatusError {
+		t.Errorf("gated fix ran (fixCount=%d)", gated.fixCount)
+	}
+	if details := strings.Join(report.Checks[0].Details, "\n"); !strings.Contains(details, "no-gated") {
+		t.Errorf("details should name the denial, got %q", details)
+	}
+	if allowed.fixCount != 1 || !report.Checks[1].Fixed {
+		t.Errorf("allowed fix should apply (fixCount=%d)", allowed.fixCount)
+	}
+}
@@ -0,0 +1,220 @@
+package doctor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/backup"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/deps"
+	"github.com/steveyegge/gastown/internal/hooks"
+	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/version"
+)
+
+// Baseline is a snapshot of a known-good environment: binary versions,
+// config file hashes, and hook settings hashes. DriftCheck compares the
+// current environment against it.
+type Baseline struct {
+	SavedAt  time.Time         `json:"saved_at"`
+	SavedBy  string            `json:"saved_by,omitempty"`
+	Binaries map[string]string `json:"binaries"`
+	Configs  map[string]string `json:"configs"` // Town-relative path -> content hash
+	Hooks    map[string]string `json:"hooks"`   // Hook target key -> settings hash
+}
+
+// BaselinePath returns the path of the town's doctor baseline file.
+func BaselinePath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "doctor-baseline.json")
+}
+
+// LoadBaseline reads the town's baseline. Returns nil, nil if none was saved.
+func LoadBaseline(townRoot string) (*Baseline, error) {
+	data, err := os.ReadFile(BaselinePath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading doctor baseline: %w", err)
+	}
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("parsing doctor baseline: %w", err)
+	}
+	return &b, nil
+}
+
+// SaveBaseline writes the town's baseline.
+func SaveBaseline(townRoot string, b *Baseline) error {
+	return util.EnsureDirAndWriteJSON(BaselinePath(townRoot), b)
+}
+
+// CaptureBaseline snapshots the town's current environment.
+func CaptureBaseline(townRoot string) *Baseline {
+	b := &Baseline{
+		SavedAt:  time.Now().UTC(),
+		Binaries: binaryVersions(),
+		Configs:  map[string]string{},
+		Hooks:    map[string]string{},
+	}
+	for _, rel := range backup.ConfigFiles(townRoot) {
+		if sum, ok := fileHash(filepath.Join(townRoot, filepath.FromSlash(rel))); ok {
+			b.Configs[rel] = sum
+		}
+	}
+	if targets, err := hooks.DiscoverTargets(townRoot); err == nil {
+		for _, t := range targets {
+			if sum, ok := fileHash(t.Path); ok {
+				b.Hooks[t.DisplayKey()] = sum
+			}
+		}
+	}
+	return b
+}
+
+// Drift lists what changed between the baseline and cur, one line per
+// difference, sorted within each section.
+func (b *Baseline) Drift(cur *Baseline) []string {
+	var out []string
+	out = append(out, diffVersions("binary", b.Binaries, cur.Binaries)...)
+	out = append(out, diffHashes("config", b.Configs, cur.Configs)...)
+	out = append(out, diffHashes("hooks", b.Hooks, cur.Hooks)...)
+	return out
+}
+
+func diffVersions(kind string, old, cur map[string]string) []string {
+	var out []string
+	for _, name := range unionKeys(old, cur) {
+		if old[name] != cur[name] {
+			out = append(out, fmt.Sprintf("%s %s: %s → %s", kind, name, orNone(old[name]), orNone(cur[name])))
+		}
+	}
+	return out
+}
+
+func diffHashes(kind string, old, cur map[string]string) []string {
+	var out []string
+	for _, name := range unionKeys(old, cur) {
+		before, hadBefore := old[name]
+		after, hasNow := cur[name]
+		switch {
+		case !hadBefore:
+			out = append(out, fmt.Sprintf("%s %s: added", kind, name))
+		case !hasNow:
+			out = append(out, fmt.Sprintf("%s %s: removed", kind, name))
+		case before != after:
+			out = append(out, fmt.Sprintf("%s %s: changed", kind, name))
+		}
+	}
+	return out
+}
+
+func unionKeys(a, b map[string]string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	for k := range a {
+		seen[k] = true
+	}
+	for k := range b {
+		seen[k] = true
+	}
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
+
+func fileHash(path string) (string, bool) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path from town config listing
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16], true
+}
+
+// binaryVersions reports the versions of gt and the tools it drives. A
+// variable so tests don't depend on what is installed.
+var binaryVersions = func() map[string]string {
+	v := map[string]string{}
+	if commit := version.BinaryCommit(); commit != "" {
+		v["gt"] = version.ShortCommit(commit)
+	}
+	if _, bd := deps.CheckBeads(); bd != "" {
+		v["bd"] = bd
+	}
+	if _, dolt, _ := deps.CheckDolt(); dolt != "" {
+		v["dolt"] = dolt
+	}
+	for name, args := range map[string][]string{"git": {"--version"}, "tmux": {"-V"}} {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		out, err := exec.CommandContext(ctx, name, args...).Output()
+		cancel()
+		if err == nil {
+			fields := strings.Fields(string(out))
+			if len(fields) > 0 {
+				v[name] = fields[len(fields)-1]
+			}
+		}
+	}
+	return v
+}
+
+// DriftCheck reports what changed in the environment since the baseline
+// saved by 'gt doctor baseline save'.
+type DriftCheck struct {
+	BaseCheck
+}
+
+// NewDriftCheck creates a new environment drift check.
+func NewDriftCheck() *DriftCheck {
+	return &DriftCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "env-drift",
+			CheckDescription: "Check for environment changes since the saved baseline",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run compares the current environment against the saved baseline.
+func (c *DriftCheck) Run(ctx *CheckContext) *CheckResult {
+	base, err := LoadBaseline(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusWarning, Message: "Invalid doctor baseline", Details: []string{err.Error()},
+			FixHint: "Run 'gt doctor baseline save' to record a new baseline"}
+	}
+	if base == nil {
+		return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "No baseline saved (run 'gt doctor baseline save' while healthy)"}
+	}
+
+	since := base.SavedAt.Local().Format("2006-01-02 15:04")
+	drift := base.Drift(CaptureBaseline(ctx.TownRoot))
+	if len(drift) == 0 {
+		return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "No drift since baseline of " + since}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d change(s) since baseline of %s", len(drift), since),
+		Details: drift,
+		FixHint: "If these changes are expected, run 'gt doctor baseline save' to accept them",
+	}
+}
@@ -0,0 +1,62 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDriftCheck(t *testing.T) {
+	town := t.TempDir()
+	orig := binaryVersions
+	t.Cleanup(func() { binaryVersions = orig })
+	versions := map[string]string{"bd": "0.57.0", "dolt": "1.50.0"}
+	binaryVersions = func() map[string]string {
+		out := map[string]string{}
+		for k, v := range versions {
+			out[k] = v
+		}
+		return out
+	}
+
+	write := func(rel, content string) {
+		t.Helper()
+		path := filepath.Join(town, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("mayor/town.json", `{"name":"test"}`)
+	write("settings/config.json", `{"type":"town-settings"}`)
+
+	check := NewDriftCheck()
+	ctx := &CheckContext{TownRoot: town}
+	if r := check.Run(ctx); r.Status != StatusOK {
+		t.Fatalf("no baseline: %+v, want OK", r)
+	}
+
+	if err := SaveBaseline(town, CaptureBaseline(town)); err != nil {
+		t.Fatal(err)
+	}
+	if r := check.Run(ctx); r.Status != StatusOK {
+		t.Fatalf("unchanged: %+v, want OK", r)
+	}
+
+	versions["bd"] = "0.58.0"
+	write("settings/config.json", `{"type":"town-settings","version":2}`)
+	write("mayor/rigs.json", `{}`)
+
+	r := check.Run(ctx)
+	want := []string{
+		"binary bd: 0.57.0 → 0.58.0",
+		"config mayor/rigs.json: added",
+		"config settings/config.json: changed",
+	}
+	if r.Status != StatusWarning || !reflect.DeepEqual(r.Details, want) {
+		t.Fatalf("after drift: status=%v details=%q, want warning with %q", r.Status, r.Details, want)
+	}
+}
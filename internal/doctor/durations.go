@@ -0,0 +1,72 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// durationWeight is how much a new run moves a check's typical duration
+// (exponential moving average), so one slow run doesn't dominate.
+const durationWeight = 0.3
+
+// Durations tracks each check's typical run time across doctor runs,
+// persisted at <town>/.runtime/doctor-durations.json.
+type Durations struct {
+	Checks map[string]time.Duration `json:"checks"`
+}
+
+// DurationsPath returns the path of the town's check duration history.
+func DurationsPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "doctor-durations.json")
+}
+
+// LoadDurations reads the town's check durations. A missing file yields an
+// empty history.
+func LoadDurations(townRoot string) (*Durations, error) {
+	d := &Durations{Checks: map[string]time.Duration{}}
+	data, err := os.ReadFile(DurationsPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return d, nil
+		}
+		return nil, fmt.Errorf("reading doctor durations: %w", err)
+	}
+	if err := json.Unmarshal(data, d); err != nil {
+		return nil, fmt.Errorf("parsing doctor durations: %w", err)
+	}
+	if d.Checks == nil {
+		d.Checks = map[string]time.Duration{}
+	}
+	return d, nil
+}
+
+// SaveDurations writes the town's check durations.
+func SaveDurations(townRoot string, d *Durations) error {
+	return util.EnsureDirAndWriteJSON(DurationsPath(townRoot), d)
+}
+
+// Record folds a report's check timings into the history.
+func (d *Durations) Record(report *Report) {
+	for _, r := range report.Checks {
+		if r.Elapsed <= 0 {
+			continue
+		}
+		prev, ok := d.Checks[r.Name]
+		if !ok {
+			d.Checks[r.Name] = r.Elapsed
+			continue
+		}
+		d.Checks[r.Name] = prev + time.Duration(durationWeight*float64(r.Elapsed-prev))
+	}
+}
+
+// Typical returns a check's typical duration, or 0 if it has never run.
+func (d *Durations) Typical(check string) time.Duration {
+	return d.Checks[check]
+}
@@ -9,4 +9,8 @@ var (
 
 	// ErrSkippedNoStart is returned when a fix is skipped due to --no-start.
 	ErrSkippedNoStart = errors.New("skipped: --no-start suppresses daemon/agent startup")
+
+	// ErrFixLocked is returned when a fix is skipped because another doctor
+	// run is applying fixes in the same town.
+	ErrFixLocked = errors.New("skipped: another doctor holds the lock")
 )
@@ -0,0 +1,207 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Timeouts for external check scripts. A script that overruns is killed.
+const (
+	externalRunTimeout = time.Minute
+	externalFixTimeout = 5 * time.Minute
+)
+
+// ExternalChecksDir returns the directory of site-specific check scripts.
+func ExternalChecksDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.RoleMayor, "doctor.d")
+}
+
+// ExternalCheck runs an executable from mayor/doctor.d as a doctor check.
+//
+// The script is run with no arguments from the town root, with GT_TOWN_ROOT
+// and GT_RIG (empty without --rig) set, plus GT_DOCTOR_VERBOSE=1 and
+// GT_DOCTOR_NO_START=1 under --verbose and --no-start. It prints one JSON
+// object on stdout:
+//
+//	{"status": "ok" | "warning" | "error", "message": "...",
+//	 "details": ["..."], "fix_hint": "...", "fix_command": "...",
+//	 "fixable": true, "name": "..."}
+//
+// Only status is required. fix_command is a command the user can run to
+// fix the problem, shown when there is no fix_hint. fixable: true lets
+// gt doctor --fix run the script with --fix, which exits 0 once fixed;
+// the check is then re-run to verify. name, if given, must match the file
+// name without its extension, which is the check's name. A script that
+// prints nothing is OK if it exits 0 and an error otherwise.
+type ExternalCheck struct {
+	BaseCheck
+	path    string
+	fixable atomic.Bool // Set by the latest run
+}
+
+// externalOutput is what a check script prints.
+type externalOutput struct {
+	Name       string   `json:"name"`
+	Status     string   `json:"status"`
+	Message    string   `json:"message"`
+	Details    []string `json:"details"`
+	FixHint    string   `json:"fix_hint"`
+	FixCommand string   `json:"fix_command"`
+	Fixable    bool     `json:"fixable"`
+}
+
+// DiscoverExternalChecks returns a check for each executable in
+// mayor/doctor.d, in file name order. Hidden files and editor backups
+// (name~) are ignored. A missing directory means no checks.
+func DiscoverExternalChecks(townRoot string) ([]*ExternalCheck, error) {
+	dir := ExternalChecksDir(townRoot)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", dir, err)
+	}
+	var checks []*ExternalCheck
+	for _, e := range entries {
+		name := e.Name()
+		if strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(dir, name)) // Follow symlinks
+		if err != nil || !info.Mode().IsRegular() || info.Mode()&0111 == 0 {
+			continue
+		}
+		checks = append(checks, NewExternalCheck(filepath.Join(dir, name)))
+	}
+	return checks, nil
+}
+
+// NewExternalCheck creates a check that runs the script at path.
+func NewExternalCheck(path string) *ExternalCheck {
+	base := filepath.Base(path)
+	return &ExternalCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        strings.TrimSuffix(base, filepath.Ext(base)),
+			CheckDescription: "Site check " + filepath.Join(constants.RoleMayor, "doctor.d", base),
+			CheckCategory:    CategoryCustom,
+		},
+		path: path,
+	}
+}
+
+// Path returns the script's path.
+func (c *ExternalCheck) Path() string { return c.path }
+
+// CanFix reports whether the latest run said the problem is fixable.
+func (c *ExternalCheck) CanFix() bool { return c.fixable.Load() }
+
+// Run executes the script and converts its output to a result.
+func (c *ExternalCheck) Run(ctx *CheckContext) *CheckResult {
+	c.fixable.Store(false)
+	stdout, stderr, err := c.exec(ctx, externalRunTimeout)
+	if len(bytes.TrimSpace(stdout)) == 0 {
+		if err == nil {
+			return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "passed"}
+		}
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: scriptFailure(err, stderr),
+			FixHint: "Check the script at " + c.path,
+		}
+	}
+
+	var out externalOutput
+	if jsonErr := json.Unmarshal(stdout, &out); jsonErr != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "script printed invalid JSON",
+			Details: []string{jsonErr.Error()},
+			FixHint: "Check the script at " + c.path,
+		}
+	}
+	result := &CheckResult{
+		Name:    c.Name(),
+		Message: out.Message,
+		Details: out.Details,
+		FixHint: out.FixHint,
+	}
+	switch strings.ToLower(out.Status) {
+	case "ok":
+		result.Status = StatusOK
+	case "warning":
+		result.Status = StatusWarning
+	case "error":
+		result.Status = StatusError
+	default:
+		result.Status = StatusError
+		result.Message = fmt.Sprintf("script reported unknown status %q (want ok, warning, or error)", out.Status)
+		return result
+	}
+	if out.Name != "" && out.Name != c.Name() {
+		result.Details = append(result.Details,
+			fmt.Sprintf("Script reports name %q but is installed as %q", out.Name, c.Name()))
+	}
+	if result.FixHint == "" && out.FixCommand != "" {
+		result.FixHint = "Run: " + out.FixCommand
+	}
+	if result.Message == "" && err != nil {
+		result.Message = scriptFailure(err, stderr)
+	}
+	c.fixable.Store(out.Fixable)
+	return result
+}
+
+// Fix runs the script with --fix.
+func (c *ExternalCheck) Fix(ctx *CheckContext) error {
+	_, stderr, err := c.exec(ctx, externalFixTimeout, "--fix")
+	if err != nil {
+		return errors.New(scriptFailure(err, stderr))
+	}
+	return nil
+}
+
+func (c *ExternalCheck) exec(ctx *CheckContext, timeout time.Duration, args ...string) (stdout, stderr []byte, err error) {
+	runCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	cmd := exec.CommandContext(runCtx, c.path, args...) //nolint:gosec // G204: town-owned scripts in mayor/doctor.d
+	cmd.Dir = ctx.TownRoot
+	cmd.Env = append(os.Environ(), "GT_TOWN_ROOT="+ctx.TownRoot, "GT_RIG="+ctx.RigName)
+	if ctx.Verbose {
+		cmd.Env = append(cmd.Env, "GT_DOCTOR_VERBOSE=1")
+	}
+	if ctx.NoStart {
+		cmd.Env = append(cmd.Env, "GT_DOCTOR_NO_START=1")
+	}
+	var out, errOut bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &errOut
+	err = cmd.Run()
+	if runCtx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %s", formatDuration(timeout))
+	}
+	return out.Bytes(), errOut.Bytes(), err
+}
+
+// scriptFailure describes a failed script run by its error and the last
+// line it wrote to stderr.
+func scriptFailure(err error, stderr []byte) string {
+	lines := strings.Split(strings.TrimSpace(string(stderr)), "\n")
+	if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+		return fmt.Sprintf("%v: %s", err, last)
+	}
+	return err.Error()
+}
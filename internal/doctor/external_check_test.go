@@ -0,0 +1,83 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func writeCheckScript(t *testing.T, townRoot, name, body string, mode os.FileMode) {
+	t.Helper()
+	dir := ExternalChecksDir(townRoot)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+body), mode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExternalChecks_DiscoverRunFix(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts")
+	}
+	townRoot := t.TempDir()
+	marker := filepath.Join(townRoot, "fixed")
+	writeCheckScript(t, townRoot, "vpn.sh", `
+if [ "$1" = "--fix" ]; then touch "$GT_TOWN_ROOT/fixed"; exit 0; fi
+if [ -f "$GT_TOWN_ROOT/fixed" ]; then echo '{"status":"ok","message":"up"}'; exit 0; fi
+echo '{"name":"vpn","status":"error","message":"tunnel down","details":["wg0 missing"],"fix_command":"wg-quick up wg0","fixable":true}'
+exit 1
+`, 0755)
+	writeCheckScript(t, townRoot, "quiet", "exit 0\n", 0755)
+	writeCheckScript(t, townRoot, "broken", "echo oops >&2; exit 3\n", 0755)
+	writeCheckScript(t, townRoot, "garbage", "echo not json\n", 0755)
+	writeCheckScript(t, townRoot, "notes.txt", "not executable\n", 0644)
+	writeCheckScript(t, townRoot, ".hidden", "exit 1\n", 0755)
+
+	checks, err := DiscoverExternalChecks(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	d := NewDoctor()
+	for _, c := range checks {
+		names = append(names, c.Name())
+		d.Register(c)
+	}
+	if strings.Join(names, ",") != "broken,garbage,quiet,vpn" {
+		t.Fatalf("discovered %v", names)
+	}
+
+	ctx := &CheckContext{TownRoot: townRoot}
+	report := d.Run(ctx)
+	byName := map[string]*CheckResult{}
+	for _, r := range report.Checks {
+		byName[r.Name] = r
+	}
+	if r := byName["quiet"]; r.Status != StatusOK {
+		t.Errorf("quiet = %+v, want OK", r)
+	}
+	if r := byName["broken"]; r.Status != StatusError || !strings.Contains(r.Message, "oops") {
+		t.Errorf("broken = %+v, want error with stderr", r)
+	}
+	if r := byName["garbage"]; r.Status != StatusError || !strings.Contains(r.Message, "invalid JSON") {
+		t.Errorf("garbage = %+v, want invalid JSON error", r)
+	}
+	vpn := byName["vpn"]
+	if vpn.Status != StatusError || vpn.Message != "tunnel down" || !vpn.Fixable || vpn.FixHint != "Run: wg-quick up wg0" {
+		t.Errorf("vpn = %+v", vpn)
+	}
+	if _, err := os.Stat(marker); err == nil {
+		t.Fatal("Run must not fix")
+	}
+
+	d = NewDoctor()
+	d.Register(NewExternalCheck(filepath.Join(ExternalChecksDir(townRoot), "vpn.sh")))
+	report = d.Fix(ctx)
+	if r := report.Checks[0]; !r.Fixed || r.Status != StatusOK {
+		t.Errorf("vpn after fix = %+v, want fixed", r)
+	}
+}
@@ -0,0 +1,64 @@
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/locks"
+)
+
+// fixLockWait bounds how long a fix waits for another doctor run to finish
+// its current fix before giving up with ErrFixLocked.
+var fixLockWait = 10 * time.Second
+
+// fixLockName is the town lock that serializes doctor fixes. Concurrent
+// doctor runs (a human at the terminal and the daemon, say) can otherwise
+// interleave read-modify-write fixes on shared configs.
+const fixLockName = "doctor-fix"
+
+// FixLockHolder describes the doctor run currently applying fixes.
+type FixLockHolder struct {
+	PID       int       `json:"pid"`
+	Command   string    `json:"command,omitempty"`
+	Check     string    `json:"check"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// ReadFixLockHolder returns the recorded holder of the fix lock, or nil if
+// none is recorded. The record is informational: a stale record left by a
+// crashed run does not block anything, since the flock itself is released
+// when the process exits.
+func ReadFixLockHolder(townRoot string) *FixLockHolder {
+	l := locks.Read(townRoot, fixLockName)
+	if l == nil {
+		return nil
+	}
+	return &FixLockHolder{PID: l.PID, Command: l.Command, Check: l.Purpose, StartedAt: l.AcquiredAt}
+}
+
+// acquireFixLock takes the town's fix lock for one check's fix, waiting up to
+// fixLockWait for a concurrent doctor to release it. Returns ErrFixLocked
+// (wrapped with the holder, when known) if the lock stays busy.
+func acquireFixLock(townRoot, checkName string) (func(), error) {
+	if townRoot == "" {
+		return func() {}, nil
+	}
+	if _, err := os.Stat(townRoot); err != nil {
+		// No town on disk (tests, or a root that vanished): nothing to share.
+		return func() {}, nil
+	}
+
+	h, err := locks.Acquire(townRoot, fixLockName, locks.Options{Purpose: checkName, Wait: fixLockWait})
+	if errors.Is(err, locks.ErrHeld) {
+		if h := ReadFixLockHolder(townRoot); h != nil {
+			return nil, fmt.Errorf("%w (pid %d fixing %s since %s)", ErrFixLocked, h.PID, h.Check, h.StartedAt.Local().Format("15:04:05"))
+		}
+		return nil, ErrFixLocked
+	}
+	if err != nil {
+		return nil, err
+	}
+	return h.Release, nil
+}
@@ -0,0 +1,273 @@
+package doctor
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// FixTx stages the changes a fix makes so they land together or not at all.
+//
+// Fixes record their file writes and removals (and any external step that
+// must land with them) on ctx.Tx instead of applying them directly. Doctor applies
+// the staged changes once Fix returns without error, writing each file
+// atomically, and restores everything already applied if a later change
+// fails. A fix that errors out before returning leaves nothing applied.
+//
+// A nil *FixTx applies each change immediately, so checks behave the same
+// when their Fix is called outside of Doctor (tests, one-off callers).
+type FixTx struct {
+	changes []*fixChange
+}
+
+type fixChange struct {
+	desc  string
+	apply func() error
+	undo  func() error // nil: cannot be rolled back
+	done  func()       // optional: runs once every change has applied
+}
+
+// WriteFile stages writing data to path. The original content (or absence)
+// is captured at apply time so rollback restores it exactly.
+func (tx *FixTx) WriteFile(path string, data []byte, perm os.FileMode) error {
+	var restore func() error
+	return tx.stage(&fixChange{
+		desc: "write " + path,
+		apply: func() error {
+			var err error
+			if restore, err = snapshotFile(path); err != nil {
+				return err
+			}
+			return util.AtomicWriteFile(path, data, perm)
+		},
+		undo: func() error { return restore() },
+	})
+}
+
+// Remove stages removing the file at path.
+func (tx *FixTx) Remove(path string) error {
+	var restore func() error
+	return tx.stage(&fixChange{
+		desc: "remove " + path,
+		apply: func() error {
+			var err error
+			if restore, err = snapshotFile(path); err != nil {
+				return err
+			}
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return nil
+		},
+		undo: func() error { return restore() },
+	})
+}
+
+// RemoveAll stages removing path and everything under it. The tree is
+// moved aside when applied and only deleted once the whole fix has applied,
+// so rollback can move it back.
+func (tx *FixTx) RemoveAll(path string) error {
+	aside := path + ".gt-doctor-rm"
+	var moved bool
+	return tx.stage(&fixChange{
+		desc: "remove " + path,
+		apply: func() error {
+			if _, err := os.Lstat(path); os.IsNotExist(err) {
+				return nil
+			}
+			if err := os.RemoveAll(aside); err != nil {
+				return err
+			}
+			if err := os.Rename(path, aside); err != nil {
+				return err
+			}
+			moved = true
+			return nil
+		},
+		undo: func() error {
+			if !moved {
+				return nil
+			}
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+			return os.Rename(aside, path)
+		},
+		done: func() {
+			if moved {
+				_ = os.RemoveAll(aside)
+			}
+		},
+	})
+}
+
+// Rewrite stages a change to the file at path made by write, for files
+// written through a package helper (config, routes) rather than raw bytes.
+// Rollback restores the content path had before write ran.
+func (tx *FixTx) Rewrite(path string, write func() error) error {
+	var restore func() error
+	return tx.stage(&fixChange{
+		desc: "write " + path,
+		apply: func() error {
+			var err error
+			if restore, err = snapshotFile(path); err != nil {
+				return err
+			}
+			return write()
+		},
+		undo: func() error { return restore() },
+	})
+}
+
+// Do stages an arbitrary change. undo reverses it on rollback; pass nil for
+// changes that cannot be reversed, which are then reported as still applied.
+func (tx *FixTx) Do(desc string, apply, undo func() error) error {
+	return tx.stage(&fixChange{desc: desc, apply: apply, undo: undo})
+}
+
+// Pending returns the descriptions of the staged changes.
+func (tx *FixTx) Pending() []string {
+	if tx == nil {
+		return nil
+	}
+	descs := make([]string, len(tx.changes))
+	for i, c := range tx.changes {
+		descs[i] = c.desc
+	}
+	return descs
+}
+
+func (tx *FixTx) stage(c *fixChange) error {
+	if tx == nil {
+		if err := c.apply(); err != nil {
+			return fmt.Errorf("%s: %w", c.desc, err)
+		}
+		if c.done != nil {
+			c.done()
+		}
+		return nil
+	}
+	tx.changes = append(tx.changes, c)
+	return nil
+}
+
+// Commit applies the staged changes in order. If one fails, the changes
+// already applied are undone in reverse order and a *FixTxError reports
+// exactly what happened to each change.
+func (tx *FixTx) Commit() error {
+	if tx == nil {
+		return nil
+	}
+	for i, c := range tx.changes {
+		err := c.apply()
+		if err == nil {
+			continue
+		}
+		txErr := &FixTxError{Failed: c.desc, Err: err}
+		for _, later := range tx.changes[i+1:] {
+			txErr.NotApplied = append(txErr.NotApplied, later.desc)
+		}
+		for j := i - 1; j >= 0; j-- {
+			done := tx.changes[j]
+			switch {
+			case done.undo == nil:
+				txErr.StillApplied = append(txErr.StillApplied, done.desc+" (not reversible)")
+			default:
+				if uerr := done.undo(); uerr != nil {
+					txErr.StillApplied = append(txErr.StillApplied, fmt.Sprintf("%s (rollback failed: %v)", done.desc, uerr))
+				} else {
+					txErr.RolledBack = append(txErr.RolledBack, done.desc)
+				}
+			}
+		}
+		tx.changes = nil
+		return txErr
+	}
+	for _, c := range tx.changes {
+		if c.done != nil {
+			c.done()
+		}
+	}
+	tx.changes = nil
+	return nil
+}
+
+// Discard drops the staged changes without applying them, returning their
+// descriptions.
+func (tx *FixTx) Discard() []string {
+	pending := tx.Pending()
+	if tx != nil {
+		tx.changes = nil
+	}
+	return pending
+}
+
+// FixTxError describes a fix whose staged changes failed to apply.
+type FixTxError struct {
+	Failed       string   // The change that failed
+	Err          error    // Why it failed
+	RolledBack   []string // Applied earlier, then restored
+	StillApplied []string // Applied earlier and could not be restored
+	NotApplied   []string // Staged after the failure, never attempted
+}
+
+func (e *FixTxError) Error() string {
+	msg := fmt.Sprintf("%s: %v", e.Failed, e.Err)
+	var parts []string
+	if n := len(e.RolledBack); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d rolled back", n))
+	}
+	if n := len(e.StillApplied); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d still applied", n))
+	}
+	if n := len(e.NotApplied); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d not applied", n))
+	}
+	if len(parts) > 0 {
+		msg += " (" + strings.Join(parts, ", ") + ")"
+	}
+	return msg
+}
+
+func (e *FixTxError) Unwrap() error { return e.Err }
+
+// Details lists the fate of each change, for CheckResult.Details.
+func (e *FixTxError) Details() []string {
+	var lines []string
+	for _, d := range e.RolledBack {
+		lines = append(lines, "Rolled back: "+d)
+	}
+	for _, d := range e.StillApplied {
+		lines = append(lines, "Still applied: "+d)
+	}
+	for _, d := range e.NotApplied {
+		lines = append(lines, "Not applied: "+d)
+	}
+	return lines
+}
+
+// snapshotFile captures path's current content and mode and returns a
+// function that puts it back (removing the file if it did not exist).
+func snapshotFile(path string) (func() error, error) {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return func() error {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return nil
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path comes from the fixing check
+	if err != nil {
+		return nil, err
+	}
+	return func() error {
+		return util.AtomicWriteFile(path, data, info.Mode().Perm())
+	}, nil
+}
@@ -0,0 +1,170 @@
+package doctor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// twoFileCheck stages a write to each of its files; the second can be made
+// to fail by pointing it into a missing directory.
+type twoFileCheck struct {
+	FixableCheck
+	first, second string
+}
+
+func (c *twoFileCheck) Run(ctx *CheckContext) *CheckResult {
+	data, _ := os.ReadFile(c.first)
+	if string(data) == "fixed" {
+		return &CheckResult{Name: c.CheckName, Status: StatusOK}
+	}
+	return &CheckResult{Name: c.CheckName, Status: StatusError, Message: "broken"}
+}
+
+func (c *twoFileCheck) Fix(ctx *CheckContext) error {
+	if err := ctx.Tx.WriteFile(c.first, []byte("fixed"), 0644); err != nil {
+		return err
+	}
+	return ctx.Tx.WriteFile(c.second, []byte("fixed"), 0644)
+}
+
+func TestDoctor_FixRollsBackPartialFix(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "a.json")
+	if err := os.WriteFile(first, []byte("original"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	check := &twoFileCheck{
+		FixableCheck: FixableCheck{BaseCheck: BaseCheck{CheckName: "two-file"}},
+		first:        first,
+		second:       filepath.Join(dir, "missing", "b.json"),
+	}
+
+	d := NewDoctor()
+	d.Register(check)
+	report := d.Fix(&CheckContext{TownRoot: dir})
+
+	data, _ := os.ReadFile(first)
+	if string(data) != "original" {
+		t.Errorf("first file = %q, want rolled back to %q", data, "original")
+	}
+	if info, _ := os.Stat(first); info.Mode().Perm() != 0600 {
+		t.Errorf("first file mode = %v, want 0600 restored", info.Mode().Perm())
+	}
+	result := report.Checks[0]
+	if result.Fixed {
+		t.Fatal("partial fix reported as fixed")
+	}
+	details := strings.Join(result.Details, "\n")
+	if !strings.Contains(details, "Rolled back: write "+first) || !strings.Contains(details, "Fix failed: write ") {
+		t.Errorf("details = %q", details)
+	}
+}
+
+func TestDoctor_FixAppliesStagedChanges(t *testing.T) {
+	dir := t.TempDir()
+	check := &twoFileCheck{
+		FixableCheck: FixableCheck{BaseCheck: BaseCheck{CheckName: "two-file"}},
+		first:        filepath.Join(dir, "a.json"),
+		second:       filepath.Join(dir, "b.json"),
+	}
+	d := NewDoctor()
+	d.Register(check)
+	report := d.Fix(&CheckContext{TownRoot: dir})
+	if !report.Checks[0].Fixed {
+		t.Fatalf("result = %+v, want fixed", report.Checks[0])
+	}
+	if data, _ := os.ReadFile(check.second); string(data) != "fixed" {
+		t.Errorf("second file = %q", data)
+	}
+}
+
+func TestFixTx_Commit(t *testing.T) {
+	dir := t.TempDir()
+	created := filepath.Join(dir, "new")
+	removed := filepath.Join(dir, "old")
+	if err := os.WriteFile(removed, []byte("keep me"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := &FixTx{}
+	_ = tx.WriteFile(created, []byte("x"), 0644)
+	_ = tx.Remove(removed)
+	_ = tx.Do("irreversible", func() error { return nil }, nil)
+	_ = tx.Do("boom", func() error { return errors.New("bd down") }, nil)
+	_ = tx.Do("never", func() error { t.Error("ran change after failure"); return nil }, nil)
+	if _, err := os.Stat(created); !os.IsNotExist(err) {
+		t.Fatal("staged write applied before Commit")
+	}
+
+	err := tx.Commit()
+	var txErr *FixTxError
+	if !errors.As(err, &txErr) {
+		t.Fatalf("Commit() = %v, want *FixTxError", err)
+	}
+	if _, err := os.Stat(created); !os.IsNotExist(err) {
+		t.Error("created file not rolled back")
+	}
+	if data, _ := os.ReadFile(removed); string(data) != "keep me" {
+		t.Errorf("removed file = %q, want restored", data)
+	}
+	if txErr.Failed != "boom" || len(txErr.RolledBack) != 2 || len(txErr.StillApplied) != 1 || len(txErr.NotApplied) != 1 {
+		t.Errorf("txErr = %+v", txErr)
+	}
+}
+
+func TestFixTx_NilAppliesImmediately(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "f")
+	var tx *FixTx
+	if err := tx.WriteFile(path, []byte("now"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "now" {
+		t.Errorf("file = %q", data)
+	}
+}
+
+func TestFixTx_RemoveAllAndRewrite(t *testing.T) {
+	dir := t.TempDir()
+	tree := filepath.Join(dir, "tree")
+	if err := os.MkdirAll(filepath.Join(tree, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tree, "sub", "f"), []byte("keep"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg := filepath.Join(dir, "cfg")
+	if err := os.WriteFile(cfg, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rewrite := func() error { return os.WriteFile(cfg, []byte("new"), 0644) }
+
+	tx := &FixTx{}
+	_ = tx.RemoveAll(tree)
+	_ = tx.Rewrite(cfg, rewrite)
+	_ = tx.Do("boom", func() error { return errors.New("bd down") }, nil)
+	if err := tx.Commit(); err == nil {
+		t.Fatal("Commit() = nil, want error")
+	}
+	if data, _ := os.ReadFile(filepath.Join(tree, "sub", "f")); string(data) != "keep" {
+		t.Errorf("tree not restored, f = %q", data)
+	}
+	if data, _ := os.ReadFile(cfg); string(data) != "old" {
+		t.Errorf("cfg = %q, want restored", data)
+	}
+
+	_ = tx.RemoveAll(tree)
+	_ = tx.Rewrite(cfg, rewrite)
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 || entries[0].Name() != "cfg" {
+		t.Errorf("dir after commit = %v, want only cfg", entries)
+	}
+	if data, _ := os.ReadFile(cfg); string(data) != "new" {
+		t.Errorf("cfg = %q, want new", data)
+	}
+}
@@ -0,0 +1,199 @@
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+// Group is a composite check: a named set of related checks whose results
+// roll up into one status, so a report can be read group by group.
+type Group struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Members     []string `json:"members"` // Check name globs
+}
+
+// DefaultGroups are the built-in check groups.
+var DefaultGroups = []Group{
+	{
+		Name:        "beads-health",
+		Description: "Beads databases, redirects, locks, and the Dolt server",
+		Members: []string{
+			"beads-*", "town-beads-config", "rig-beads-exist", "stale-beads-redirect",
+			"unregistered-beads-dirs", "stale-locks", "jsonl-bloat", "dolt-*",
+		},
+	},
+	{
+		Name:        "git-health",
+		Description: "Clones, branches, and git hooks",
+		Members: []string{
+			"branch-*", "clone-divergence", "default-branch-*", "hooks-path-*",
+			"repo-bloat", "worktree-gitdir-valid", "persistent-role-branches",
+			"town-git", "town-root-branch", "bare-repo-*", "mayor-clone-exists",
+		},
+	},
+	{
+		Name:        "patrol-health",
+		Description: "Patrol molecules, triggers, and plugins",
+		Members:     []string{"patrol-*", "daemon", "daemon-heartbeat", "boot-health"},
+	},
+	{
+		Name:        "session-health",
+		Description: "Agent tmux sessions",
+		Members: []string{
+			"zombie-sessions", "orphan-sessions", "orphan-processes",
+			"session-name-format", "session-hooks", "linked-panes", "tmux-global-env",
+		},
+	},
+}
+
+// Contains reports whether the group rolls up the named check.
+func (g Group) Contains(check string) bool {
+	for _, m := range g.Members {
+		if ok, _ := path.Match(m, check); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Groups returns the built-in groups merged with the town's custom groups,
+// keyed by name.
+func Groups(cfg *config.DoctorPolicyConfig) (map[string]Group, error) {
+	groups := make(map[string]Group, len(DefaultGroups))
+	for _, g := range DefaultGroups {
+		groups[g.Name] = g
+	}
+	if cfg == nil {
+		return groups, nil
+	}
+	for name, members := range cfg.Groups {
+		if len(members) == 0 {
+			return nil, fmt.Errorf("doctor.groups[%s]: no member checks", name)
+		}
+		for _, m := range members {
+			if _, err := path.Match(m, ""); err != nil {
+				return nil, fmt.Errorf("doctor.groups[%s]: %q: %w", name, m, err)
+			}
+		}
+		groups[name] = Group{Name: name, Members: members}
+	}
+	return groups, nil
+}
+
+// GroupNames returns the group names in sorted order.
+func GroupNames(groups map[string]Group) []string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GroupResult is a group's rollup over the results of its member checks.
+type GroupResult struct {
+	Name     string
+	Status   CheckStatus // Worst status among unacknowledged members
+	Severity Severity    // Most severe member finding (after Policy.Apply)
+	Members  []*CheckResult
+}
+
+// Failing returns the members with unacknowledged problems.
+func (g *GroupResult) Failing() []*CheckResult {
+	var out []*CheckResult
+	for _, m := range g.Members {
+		if m.Status != StatusOK && !m.Acknowledged {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// Message summarizes the rollup, e.g. "2 of 9 checks failing".
+func (g *GroupResult) Message() string {
+	failing := g.Failing()
+	if len(failing) == 0 {
+		return fmt.Sprintf("%d checks passed", len(g.Members))
+	}
+	names := make([]string, len(failing))
+	for i, m := range failing {
+		names[i] = m.Name
+	}
+	return fmt.Sprintf("%d of %d checks failing: %s", len(failing), len(g.Members), strings.Join(names, ", "))
+}
+
+// Rollup computes the rollup of every group with at least one result in the
+// report, in group name order.
+func Rollup(r *Report, groups map[string]Group) []*GroupResult {
+	var out []*GroupResult
+	for _, name := range GroupNames(groups) {
+		g := groups[name]
+		gr := &GroupResult{Name: name}
+		for _, c := range r.Checks {
+			if !g.Contains(c.Name) {
+				continue
+			}
+			gr.Members = append(gr.Members, c)
+			if c.Acknowledged {
+				continue
+			}
+			if c.Status > gr.Status {
+				gr.Status = c.Status
+			}
+			if c.Severity.Rank() > gr.Severity.Rank() {
+				gr.Severity = c.Severity
+			}
+		}
+		if len(gr.Members) > 0 {
+			out = append(out, gr)
+		}
+	}
+	return out
+}
+
+// PrintGroups prints one line per group with its failing members beneath
+// it, then the problems of checks that belong to no group. In verbose mode
+// every member is listed.
+func PrintGroups(w io.Writer, r *Report, groups map[string]Group, verbose bool) {
+	_, _ = fmt.Fprintln(w, ui.RenderCategory("Groups"))
+	rollup := Rollup(r, groups)
+	grouped := map[*CheckResult]bool{}
+	for _, g := range rollup {
+		_, _ = fmt.Fprintf(w, "  %s  %s%s\n", statusIcon(g.Status), g.Name, mutedMessage(g.Message()))
+		for _, m := range g.Members {
+			grouped[m] = true
+			if verbose || (m.Status != StatusOK && !m.Acknowledged) {
+				_, _ = fmt.Fprintf(w, "     %s%s %s%s\n", ui.RenderTreeLast(), statusIcon(m.Status), m.Name, mutedMessage(m.Message))
+			}
+		}
+	}
+
+	var ungrouped []*CheckResult
+	for _, c := range r.Checks {
+		if !grouped[c] && c.Status != StatusOK && !c.Acknowledged {
+			ungrouped = append(ungrouped, c)
+		}
+	}
+	if len(ungrouped) > 0 {
+		_, _ = fmt.Fprintln(w)
+		_, _ = fmt.Fprintln(w, ui.RenderCategory("Ungrouped"))
+		for _, c := range ungrouped {
+			_, _ = fmt.Fprintf(w, "  %s  %s%s\n", statusIcon(c.Status), c.Name, mutedMessage(c.Message))
+		}
+	}
+	_, _ = fmt.Fprintln(w)
+}
+
+func mutedMessage(msg string) string {
+	if msg == "" {
+		return ""
+	}
+	return ui.RenderMuted(" " + msg)
+}
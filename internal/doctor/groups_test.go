@@ -0,0 +1,109 @@
+package doctor
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestRollup(t *testing.T) {
+	groups, err := Groups(&config.DoctorPolicyConfig{
+		Groups: map[string][]string{"merge-health": {"refinery-*", "branch-policy"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := reportWith(
+		&CheckResult{Name: "beads-binary", Status: StatusOK},
+		&CheckResult{Name: "dolt-server-reachable", Status: StatusError, Message: "connection refused"},
+		&CheckResult{Name: "stale-locks", Status: StatusWarning, Acknowledged: true},
+		&CheckResult{Name: "branch-policy", Status: StatusWarning},
+		&CheckResult{Name: "themes", Status: StatusWarning},
+	)
+	DefaultPolicy().Apply(r)
+
+	got := map[string]*GroupResult{}
+	for _, g := range Rollup(r, groups) {
+		got[g.Name] = g
+	}
+	if len(got) != 3 {
+		t.Fatalf("Rollup() groups = %v, want beads-health, git-health, merge-health", got)
+	}
+	beads := got["beads-health"]
+	if beads.Status != StatusError || beads.Severity != SeverityError || len(beads.Members) != 3 {
+		t.Errorf("beads-health = %+v", beads)
+	}
+	if msg := beads.Message(); msg != "1 of 3 checks failing: dolt-server-reachable" {
+		t.Errorf("Message() = %q", msg)
+	}
+	if g := got["merge-health"]; g.Status != StatusWarning || len(g.Members) != 1 {
+		t.Errorf("merge-health = %+v", g)
+	}
+
+	var out bytes.Buffer
+	PrintGroups(&out, r, groups, false)
+	text := out.String()
+	for _, want := range []string{"beads-health", "dolt-server-reachable", "UNGROUPED", "themes"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("PrintGroups output missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "beads-binary") {
+		t.Errorf("PrintGroups listed a passing member without verbose:\n%s", text)
+	}
+}
+
+func TestGroups_Invalid(t *testing.T) {
+	if _, err := Groups(&config.DoctorPolicyConfig{Groups: map[string][]string{"empty": nil}}); err == nil {
+		t.Error("group with no members should fail")
+	}
+	if _, err := NewPolicy(&config.DoctorPolicyConfig{NotifyGroups: map[string]string{"nope": "error"}}); err == nil {
+		t.Error("notify_groups for an unknown group should fail")
+	}
+}
+
+func TestPolicy_NotifyGroups(t *testing.T) {
+	p, err := NewPolicy(&config.DoctorPolicyConfig{
+		NotifyGroups: map[string]string{"beads-health": "error", "git-health": "critical"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := reportWith(
+		&CheckResult{Name: "dolt-server-reachable", Status: StatusError},
+		&CheckResult{Name: "branch-policy", Status: StatusError},
+	)
+	p.Apply(r)
+	notify := p.ToNotify(r)
+	if len(notify) != 1 || notify[0].Name != "beads-health" || notify[0].Severity != SeverityError {
+		t.Fatalf("ToNotify() = %+v, want only the beads-health group", notify)
+	}
+}
+
+func TestSelectGroup(t *testing.T) {
+	d := NewDoctor()
+	d.RegisterAll(
+		newMockCheck("dolt-binary", StatusOK),
+		newMockCheck("stale-locks", StatusOK),
+		newMockCheck("wisp-gc", StatusOK),
+	)
+	only, err := ParseSelectors([]string{"group=Beads-Health"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, _ := Groups(nil)
+	if err := ResolveGroups(only, groups); err != nil {
+		t.Fatal(err)
+	}
+	d.Select(only, nil)
+	if n := len(d.Checks()); n != 2 {
+		t.Errorf("group=beads-health selected %d checks, want 2", n)
+	}
+
+	bad, _ := ParseSelectors([]string{"group=nope"})
+	if err := ResolveGroups(bad, groups); err == nil {
+		t.Error("unknown group should fail to resolve")
+	}
+}
@@ -0,0 +1,292 @@
+package doctor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// maxHistoryRuns bounds the run history; older runs are dropped.
+const maxHistoryRuns = 200
+
+// historyIDFormat names runs by their start time.
+const historyIDFormat = "20060102-150405"
+
+// HistoryCheck is one check's result as recorded in the run history.
+type HistoryCheck struct {
+	Name         string      `json:"name"`
+	Status       CheckStatus `json:"status"`
+	Message      string      `json:"message,omitempty"`
+	Details      []string    `json:"details,omitempty"`
+	Category     string      `json:"category,omitempty"`
+	Severity     Severity    `json:"severity,omitempty"`
+	Acknowledged bool        `json:"acknowledged,omitempty"`
+}
+
+// HistoryRun is one recorded doctor run, for gt doctor diff.
+type HistoryRun struct {
+	ID        string         `json:"id"`
+	Timestamp time.Time      `json:"timestamp"`
+	Rig       string         `json:"rig,omitempty"`
+	Checks    []HistoryCheck `json:"checks"`
+}
+
+// Check returns the named check's result, or nil if the run didn't include it.
+func (r *HistoryRun) Check(name string) *HistoryCheck {
+	for i := range r.Checks {
+		if r.Checks[i].Name == name {
+			return &r.Checks[i]
+		}
+	}
+	return nil
+}
+
+// HistoryPath returns the path of the town's doctor run history.
+func HistoryPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "doctor-history.jsonl")
+}
+
+// NewHistoryRun captures a finished report for the history.
+func NewHistoryRun(report *Report, rig string) *HistoryRun {
+	run := &HistoryRun{
+		ID:        report.Timestamp.Format(historyIDFormat),
+		Timestamp: report.Timestamp,
+		Rig:       rig,
+	}
+	for _, c := range report.Checks {
+		run.Checks = append(run.Checks, HistoryCheck{
+			Name:         c.Name,
+			Status:       c.Status,
+			Message:      c.Message,
+			Details:      c.Details,
+			Category:     c.Category,
+			Severity:     c.Severity,
+			Acknowledged: c.Acknowledged,
+		})
+	}
+	return run
+}
+
+// LoadHistory reads the town's doctor runs, oldest first. A missing file is
+// an empty history; unparseable lines are skipped.
+func LoadHistory(townRoot string) ([]*HistoryRun, error) {
+	f, err := os.Open(HistoryPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading doctor history: %w", err)
+	}
+	defer f.Close()
+
+	var runs []*HistoryRun
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var run HistoryRun
+		if json.Unmarshal(scanner.Bytes(), &run) == nil && run.ID != "" {
+			runs = append(runs, &run)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading doctor history: %w", err)
+	}
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].Timestamp.Before(runs[j].Timestamp) })
+	return runs, nil
+}
+
+// AppendHistory records a run, keeping the newest maxHistoryRuns. Runs in
+// the same second get a numeric suffix so IDs stay unique.
+func AppendHistory(townRoot string, run *HistoryRun) error {
+	runs, err := LoadHistory(townRoot)
+	if err != nil {
+		return err
+	}
+	base, id := run.ID, run.ID
+	for n := 2; slices.ContainsFunc(runs, func(r *HistoryRun) bool { return r.ID == id }); n++ {
+		id = fmt.Sprintf("%s.%d", base, n)
+	}
+	run.ID = id
+	runs = append(runs, run)
+	if len(runs) > maxHistoryRuns {
+		runs = runs[len(runs)-maxHistoryRuns:]
+	}
+
+	var b strings.Builder
+	for _, r := range runs {
+		data, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		b.Write(data)
+		b.WriteByte('\n')
+	}
+	path := HistoryPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return util.AtomicWriteFile(path, []byte(b.String()), 0644)
+}
+
+// FindHistoryRun resolves a run reference against runs (oldest first):
+//
+//	latest, latest~N    the newest run, or the Nth run before it
+//	<id>                a run ID or unique ID prefix (e.g. 20260302-0915)
+func FindHistoryRun(runs []*HistoryRun, ref string) (*HistoryRun, error) {
+	if len(runs) == 0 {
+		return nil, fmt.Errorf("no doctor runs recorded yet")
+	}
+	if rest, ok := strings.CutPrefix(ref, "latest"); ok {
+		back := 0
+		if rest != "" {
+			n, err := strconv.Atoi(strings.TrimPrefix(rest, "~"))
+			if !strings.HasPrefix(rest, "~") || err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid run reference %q (want latest~N)", ref)
+			}
+			back = n
+		}
+		if back >= len(runs) {
+			return nil, fmt.Errorf("%s: only %d runs recorded", ref, len(runs))
+		}
+		return runs[len(runs)-1-back], nil
+	}
+
+	var match *HistoryRun
+	for _, r := range runs {
+		if r.ID == ref {
+			return r, nil
+		}
+		if strings.HasPrefix(r.ID, ref) {
+			if match != nil {
+				return nil, fmt.Errorf("run reference %q is ambiguous (%s, %s, ...)", ref, match.ID, r.ID)
+			}
+			match = r
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("no doctor run matches %q (see gt doctor diff --list)", ref)
+	}
+	return match, nil
+}
+
+// HistoryRunAt returns the newest run at or before t.
+func HistoryRunAt(runs []*HistoryRun, t time.Time) (*HistoryRun, error) {
+	for i := len(runs) - 1; i >= 0; i-- {
+		if !runs[i].Timestamp.After(t) {
+			return runs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no doctor run recorded at or before %s", t.Local().Format("2006-01-02 15:04"))
+}
+
+// CheckDiff is how one check changed between two runs.
+type CheckDiff struct {
+	Name string `json:"name"`
+
+	// From and To are nil when the check didn't run in that run.
+	From *HistoryCheck `json:"from,omitempty"`
+	To   *HistoryCheck `json:"to,omitempty"`
+
+	StatusChanged  bool     `json:"status_changed"`
+	MessageChanged bool     `json:"message_changed"`
+	AddedDetails   []string `json:"added_details,omitempty"`
+	RemovedDetails []string `json:"removed_details,omitempty"`
+
+	// Regressed is set when the check got worse; FirstSeen is the first
+	// run since which it has been at least that bad.
+	Regressed bool      `json:"regressed"`
+	FirstSeen string    `json:"first_seen,omitempty"`
+	FirstAt   time.Time `json:"first_at,omitempty"`
+}
+
+// DiffHistoryRuns compares run a with run b, using the runs in between
+// (from history, oldest first) to date each regression. Unchanged checks
+// are left out. Regressions come first, then by check name.
+func DiffHistoryRuns(history []*HistoryRun, a, b *HistoryRun) []CheckDiff {
+	names := map[string]bool{}
+	for _, c := range a.Checks {
+		names[c.Name] = true
+	}
+	for _, c := range b.Checks {
+		names[c.Name] = true
+	}
+
+	var diffs []CheckDiff
+	for name := range names {
+		from, to := a.Check(name), b.Check(name)
+		d := CheckDiff{Name: name, From: from, To: to}
+		switch {
+		case from == nil || to == nil:
+			d.StatusChanged = true
+			d.Regressed = to != nil && to.Status != StatusOK
+		default:
+			d.StatusChanged = from.Status != to.Status
+			d.MessageChanged = from.Message != to.Message
+			d.AddedDetails = missingFrom(to.Details, from.Details)
+			d.RemovedDetails = missingFrom(from.Details, to.Details)
+			d.Regressed = to.Status > from.Status
+		}
+		if !d.StatusChanged && !d.MessageChanged && len(d.AddedDetails) == 0 && len(d.RemovedDetails) == 0 {
+			continue
+		}
+		if d.Regressed {
+			if first := firstRegressed(history, a, b, name); first != nil {
+				d.FirstSeen, d.FirstAt = first.ID, first.Timestamp
+			}
+		}
+		diffs = append(diffs, d)
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].Regressed != diffs[j].Regressed {
+			return diffs[i].Regressed
+		}
+		return diffs[i].Name < diffs[j].Name
+	})
+	return diffs
+}
+
+// firstRegressed walks back from b through the runs after a and returns the
+// earliest run since which name has been at least as bad as in b.
+func firstRegressed(history []*HistoryRun, a, b *HistoryRun, name string) *HistoryRun {
+	worst := b.Check(name).Status
+	first := b
+	for i := len(history) - 1; i >= 0; i-- {
+		r := history[i]
+		if !r.Timestamp.Before(b.Timestamp) || r == b {
+			continue
+		}
+		if !r.Timestamp.After(a.Timestamp) {
+			break
+		}
+		c := r.Check(name)
+		if c == nil {
+			continue // Not run (e.g. --only); says nothing either way
+		}
+		if c.Status < worst {
+			break
+		}
+		first = r
+	}
+	return first
+}
+
+// missingFrom returns the entries of xs not in ys.
+func missingFrom(xs, ys []string) []string {
+	var out []string
+	for _, x := range xs {
+		if !slices.Contains(ys, x) {
+			out = append(out, x)
+		}
+	}
+	return out
+}
@@ -0,0 +1,99 @@
+package doctor
+
+import (
+	"testing"
+	"time"
+)
+
+func historyRun(at time.Time, checks ...HistoryCheck) *HistoryRun {
+	return &HistoryRun{ID: at.Format(historyIDFormat), Timestamp: at, Checks: checks}
+}
+
+func TestHistory_AppendLoadFind(t *testing.T) {
+	townRoot := t.TempDir()
+	base := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		report := &Report{Timestamp: base.Add(time.Duration(i) * time.Hour)}
+		report.Add(&CheckResult{Name: "daemon", Status: StatusOK})
+		if err := AppendHistory(townRoot, NewHistoryRun(report, "")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A second run in the same second gets a distinct ID.
+	if err := AppendHistory(townRoot, historyRun(base.Add(2*time.Hour))); err != nil {
+		t.Fatal(err)
+	}
+
+	runs, err := LoadHistory(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 4 {
+		t.Fatalf("loaded %d runs, want 4", len(runs))
+	}
+	if runs[3].ID != "20260302-110000.2" {
+		t.Errorf("duplicate run ID = %s, want suffixed", runs[3].ID)
+	}
+
+	if r, err := FindHistoryRun(runs, "latest~3"); err != nil || r != runs[0] {
+		t.Errorf("latest~3 = %v, %v; want first run", r, err)
+	}
+	if r, err := FindHistoryRun(runs, "20260302-10"); err != nil || r != runs[1] {
+		t.Errorf("prefix lookup = %v, %v; want second run", r, err)
+	}
+	if _, err := FindHistoryRun(runs, "20260302-11"); err == nil {
+		t.Error("ambiguous prefix should fail")
+	}
+	if _, err := FindHistoryRun(runs, "latest~9"); err == nil {
+		t.Error("latest~9 should fail with 4 runs")
+	}
+	if r, err := HistoryRunAt(runs, base.Add(90*time.Minute)); err != nil || r != runs[1] {
+		t.Errorf("HistoryRunAt = %v, %v; want second run", r, err)
+	}
+}
+
+func TestDiffHistoryRuns(t *testing.T) {
+	base := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	ok := func(name string) HistoryCheck { return HistoryCheck{Name: name, Status: StatusOK, Message: "fine"} }
+	bad := func(name string, status CheckStatus, details ...string) HistoryCheck {
+		return HistoryCheck{Name: name, Status: status, Message: "broken", Details: details}
+	}
+	history := []*HistoryRun{
+		historyRun(base, ok("daemon"), ok("dolt"), bad("hooks", StatusWarning, "a"), ok("gone")),
+		historyRun(base.Add(time.Hour), bad("daemon", StatusError), ok("dolt"), bad("hooks", StatusWarning, "a")),
+		historyRun(base.Add(2*time.Hour), ok("daemon"), bad("dolt", StatusWarning)),
+		historyRun(base.Add(3*time.Hour), bad("daemon", StatusError), bad("dolt", StatusWarning), bad("hooks", StatusWarning, "b")),
+		historyRun(base.Add(4*time.Hour), bad("daemon", StatusError), bad("dolt", StatusError), bad("hooks", StatusWarning, "b"), ok("new")),
+	}
+	diffs := DiffHistoryRuns(history, history[0], history[4])
+
+	byName := map[string]CheckDiff{}
+	for _, d := range diffs {
+		byName[d.Name] = d
+	}
+	if len(diffs) != 5 {
+		t.Fatalf("got %d diffs, want 5: %+v", len(diffs), diffs)
+	}
+	if !diffs[0].Regressed || !diffs[1].Regressed {
+		t.Errorf("regressions should sort first: %+v", diffs)
+	}
+
+	// daemon recovered in between, so it has been failing since the 4th run.
+	if d := byName["daemon"]; !d.Regressed || d.FirstSeen != history[3].ID {
+		t.Errorf("daemon = %+v, want regression first seen in %s", d, history[3].ID)
+	}
+	// dolt got worse again in the last run.
+	if d := byName["dolt"]; !d.Regressed || d.FirstSeen != history[4].ID {
+		t.Errorf("dolt = %+v, want regression first seen in %s", d, history[4].ID)
+	}
+	// hooks kept its status but its details changed.
+	if d := byName["hooks"]; d.Regressed || d.StatusChanged || len(d.AddedDetails) != 1 || len(d.RemovedDetails) != 1 {
+		t.Errorf("hooks = %+v, want detail-only change", d)
+	}
+	if d := byName["gone"]; d.To != nil || d.Regressed {
+		t.Errorf("gone = %+v, want not-run, no regression", d)
+	}
+	if d := byName["new"]; d.From != nil || d.Regressed {
+		t.Errorf("new = %+v, want new OK check, no regression", d)
+	}
+}
@@ -0,0 +1,107 @@
+package doctor
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
+
+// JSONSchemaVersion identifies the layout of JSONReport. Fields may be added
+// without a bump; renaming or removing one, or changing its meaning, bumps it.
+const JSONSchemaVersion = 1
+
+// JSONReport is the machine-readable form of a doctor run (gt doctor --json),
+// for CI pipelines and other tools.
+type JSONReport struct {
+	Schema    int         `json:"schema"`
+	Timestamp time.Time   `json:"timestamp"`
+	Healthy   bool        `json:"healthy"` // No errors or warnings
+	Summary   JSONSummary `json:"summary"`
+	Checks    []JSONCheck `json:"checks"`
+}
+
+// JSONSummary counts a run's results.
+type JSONSummary struct {
+	Total        int `json:"total"`
+	OK           int `json:"ok"`
+	Warnings     int `json:"warnings"`
+	Errors       int `json:"errors"`
+	Fixed        int `json:"fixed"`
+	Acknowledged int `json:"acknowledged"`
+
+	// MaxSeverity is the most severe graded finding ("" when all pass).
+	MaxSeverity Severity `json:"max_severity,omitempty"`
+}
+
+// JSONCheck is one check's result. Status is "ok", "warning", or "error".
+type JSONCheck struct {
+	Name         string   `json:"name"`
+	Category     string   `json:"category,omitempty"`
+	Status       string   `json:"status"`
+	Severity     Severity `json:"severity,omitempty"`
+	Message      string   `json:"message,omitempty"`
+	Details      []string `json:"details,omitempty"`
+	FixHint      string   `json:"fix_hint,omitempty"`
+	DurationMS   int64    `json:"duration_ms"`
+	Fixable      bool     `json:"fixable"`
+	Fixed        bool     `json:"fixed,omitempty"`
+	Acknowledged bool     `json:"acknowledged,omitempty"`
+	AckReason    string   `json:"ack_reason,omitempty"`
+	Cached       bool     `json:"cached,omitempty"`
+	TimedOut     bool     `json:"timed_out,omitempty"`
+}
+
+// JSON converts the report to its machine-readable form.
+func (r *Report) JSON() *JSONReport {
+	out := &JSONReport{
+		Schema:    JSONSchemaVersion,
+		Timestamp: r.Timestamp,
+		Healthy:   r.IsHealthy(),
+		Summary: JSONSummary{
+			Total:        r.Summary.Total,
+			OK:           r.Summary.OK,
+			Warnings:     r.Summary.Warnings,
+			Errors:       r.Summary.Errors,
+			Fixed:        r.Summary.Fixed,
+			Acknowledged: r.Summary.Acknowledged,
+			MaxSeverity:  MaxSeverity(r),
+		},
+		Checks: make([]JSONCheck, 0, len(r.Checks)),
+	}
+	for _, c := range r.Checks {
+		out.Checks = append(out.Checks, JSONCheck{
+			Name:         c.Name,
+			Category:     c.Category,
+			Status:       strings.ToLower(c.Status.String()),
+			Severity:     c.Severity,
+			Message:      c.Message,
+			Details:      c.Details,
+			FixHint:      c.FixHint,
+			DurationMS:   c.Elapsed.Milliseconds(),
+			Fixable:      c.Fixable,
+			Fixed:        c.Fixed,
+			Acknowledged: c.Acknowledged,
+			AckReason:    c.AckReason,
+			Cached:       c.Cached,
+			TimedOut:     c.TimedOut,
+		})
+	}
+	return out
+}
+
+// PrintJSON writes the report as indented JSON, in place of Print.
+func (r *Report) PrintJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r.JSON())
+}
+
+// ReadJSONReport parses a report written by PrintJSON.
+func ReadJSONReport(rd io.Reader) (*JSONReport, error) {
+	var report JSONReport
+	if err := json.NewDecoder(rd).Decode(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
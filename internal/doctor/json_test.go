@@ -0,0 +1,50 @@
+package doctor
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReportJSON_RoundTrip(t *testing.T) {
+	report := &Report{Timestamp: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	report.Add(&CheckResult{Name: "daemon", Status: StatusOK, Category: CategoryInfrastructure, Elapsed: 1500 * time.Millisecond})
+	report.Add(&CheckResult{
+		Name:     "rigs-registry-valid",
+		Status:   StatusError,
+		Message:  "2 rigs missing",
+		Details:  []string{"gastown", "beads"},
+		FixHint:  "Run gt doctor --fix",
+		Fixable:  true,
+		Severity: SeverityError,
+	})
+
+	var buf bytes.Buffer
+	if err := report.PrintJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{`"schema": 1`, `"fix_hint"`, `"duration_ms": 1500`, `"status": "error"`} {
+		if !strings.Contains(buf.String(), key) {
+			t.Errorf("JSON output missing %s:\n%s", key, buf.String())
+		}
+	}
+
+	got, err := ReadJSONReport(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Healthy || got.Summary.Total != 2 || got.Summary.Errors != 1 || got.Summary.MaxSeverity != SeverityError {
+		t.Errorf("summary = %+v healthy=%v", got.Summary, got.Healthy)
+	}
+	if len(got.Checks) != 2 {
+		t.Fatalf("got %d checks, want 2", len(got.Checks))
+	}
+	c := got.Checks[1]
+	if c.Name != "rigs-registry-valid" || !c.Fixable || len(c.Details) != 2 || c.FixHint == "" {
+		t.Errorf("check = %+v", c)
+	}
+	if got.Checks[0].Status != "ok" || got.Checks[0].Category != CategoryInfrastructure {
+		t.Errorf("first check = %+v", got.Checks[0])
+	}
+}
@@ -70,6 +70,9 @@ func (c *LandWorktreeGitignoreCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 }
 
+// FixSafety grades the fix safe for autofix: it only adds a .gitignore entry.
+func (c *LandWorktreeGitignoreCheck) FixSafety() FixSafety { return FixSafe }
+
 // Fix adds .land-worktree/ to .gitignore in all affected rigs.
 func (c *LandWorktreeGitignoreCheck) Fix(ctx *CheckContext) error {
 	for _, rigPath := range c.affectedRigs {
@@ -0,0 +1,56 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/migrate"
+)
+
+// LayoutVersionCheck flags towns whose on-disk layout is older (or newer)
+// than this binary expects.
+type LayoutVersionCheck struct {
+	BaseCheck
+}
+
+// NewLayoutVersionCheck creates a new town layout version check.
+func NewLayoutVersionCheck() *LayoutVersionCheck {
+	return &LayoutVersionCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "layout-version",
+			CheckDescription: "Check the town layout version matches this gt binary",
+			CheckCategory:    CategoryCore,
+		},
+	}
+}
+
+// Run compares the town's recorded layout version with the latest.
+func (c *LayoutVersionCheck) Run(ctx *CheckContext) *CheckResult {
+	current, err := migrate.LayoutVersion(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusWarning, Message: "Could not read town layout version", Details: []string{err.Error()}}
+	}
+
+	latest := migrate.Latest()
+	switch {
+	case current > latest:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Town layout v%d is newer than this gt supports (v%d)", current, latest),
+			FixHint: "Upgrade gt to the version that migrated this town",
+		}
+	case current < latest:
+		var details []string
+		for _, s := range migrate.Pending(current) {
+			details = append(details, fmt.Sprintf("v%d: %s", s.Version, s.Description))
+		}
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Town layout v%d is behind this gt (v%d)", current, latest),
+			Details: details,
+			FixHint: "Run 'gt migrate --dry-run' to preview, then 'gt migrate'",
+		}
+	}
+	return &CheckResult{Name: c.Name(), Status: StatusOK, Message: fmt.Sprintf("Town layout is current (v%d)", current)}
+}
@@ -0,0 +1,33 @@
+package doctor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/migrate"
+)
+
+func TestLayoutVersionCheck(t *testing.T) {
+	town := t.TempDir()
+	check := NewLayoutVersionCheck()
+	ctx := &CheckContext{TownRoot: town}
+
+	for _, tt := range []struct {
+		layout int
+		want   CheckStatus
+	}{
+		{0, StatusWarning},
+		{migrate.Latest(), StatusOK},
+		{migrate.Latest() + 1, StatusWarning},
+	} {
+		cfg := &config.TownConfig{Type: "town", Version: config.CurrentTownVersion, Name: "test", CreatedAt: time.Now(), LayoutVersion: tt.layout}
+		if err := config.SaveTownConfig(constants.MayorTownPath(town), cfg); err != nil {
+			t.Fatal(err)
+		}
+		if r := check.Run(ctx); r.Status != tt.want {
+			t.Errorf("layout v%d: %+v, want status %v", tt.layout, r, tt.want)
+		}
+	}
+}
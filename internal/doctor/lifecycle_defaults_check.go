@@ -96,5 +96,7 @@ func (c *LifecycleDefaultsCheck) Run(ctx *CheckContext) *CheckResult {
 
 // Fix populates missing lifecycle patrol entries with defaults.
 func (c *LifecycleDefaultsCheck) Fix(ctx *CheckContext) error {
-	return daemon.EnsureLifecycleConfigFile(ctx.TownRoot)
+	return ctx.Tx.Rewrite(daemon.PatrolConfigFile(ctx.TownRoot), func() error {
+		return daemon.EnsureLifecycleConfigFile(ctx.TownRoot)
+	})
 }
@@ -0,0 +1,74 @@
+package doctor
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+// MailRetentionCheck warns when a mailbox, or the mail archive, is close to
+// the size caps configured in mail_retention.
+type MailRetentionCheck struct {
+	BaseCheck
+	list func(townRoot string) (map[string][]*mail.Message, error)
+}
+
+// NewMailRetentionCheck creates a new mail retention check.
+func NewMailRetentionCheck() *MailRetentionCheck {
+	return &MailRetentionCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "mail-retention",
+			CheckDescription: "Check mailboxes against their mail retention caps",
+			CheckCategory:    CategoryInfrastructure,
+		},
+		list: mail.ListMailboxes,
+	}
+}
+
+// Run measures every mailbox against the configured caps.
+func (c *MailRetentionCheck) Run(ctx *CheckContext) *CheckResult {
+	policy, err := mail.LoadRetentionPolicy(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusWarning, Message: "Invalid mail retention policy", Details: []string{err.Error()}}
+	}
+	if policy.MaxMessages == 0 && policy.MaxBytes == 0 && policy.ArchiveMaxBytes == 0 {
+		return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "No mail size caps configured"}
+	}
+
+	var details []string
+	if policy.MaxMessages > 0 || policy.MaxBytes > 0 {
+		boxes, err := c.list(ctx.TownRoot)
+		if err != nil {
+			return &CheckResult{Name: c.Name(), Status: StatusWarning, Message: "Could not list mailboxes", Details: []string{err.Error()}}
+		}
+		names := make([]string, 0, len(boxes))
+		for name := range boxes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if u := policy.Usage(name, boxes[name]); policy.NearCap(u) {
+				details = append(details, fmt.Sprintf("%s: %d%% of cap (%d messages, %d bytes)", name, u.Percent, u.Messages, u.Bytes))
+			}
+		}
+	}
+	if policy.ArchiveMaxBytes > 0 {
+		mb := mail.NewMailboxWithBeadsDir("", ctx.TownRoot, filepath.Join(ctx.TownRoot, ".beads"))
+		if size := mb.ArchiveSize(); size*100/policy.ArchiveMaxBytes >= int64(policy.WarnPercent) {
+			details = append(details, fmt.Sprintf("mail archive: %d%% of cap (%d bytes)", size*100/policy.ArchiveMaxBytes, size))
+		}
+	}
+
+	if len(details) == 0 {
+		return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "All mailboxes within their caps"}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d mailbox(es) at or above %d%% of their cap", len(details), policy.WarnPercent),
+		Details: details,
+		FixHint: "Run 'gt mail retention' to archive old mail now, or raise the caps in mail_retention",
+	}
+}
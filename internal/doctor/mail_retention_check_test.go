@@ -0,0 +1,41 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+func TestMailRetentionCheck(t *testing.T) {
+	town := t.TempDir()
+	check := NewMailRetentionCheck()
+	listed := false
+	check.list = func(string) (map[string][]*mail.Message, error) {
+		listed = true
+		return map[string][]*mail.Message{
+			"gastown/witness": {{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "d"}, {ID: "e"}},
+			"mayor/":          {{ID: "f"}},
+		}, nil
+	}
+	ctx := &CheckContext{TownRoot: town}
+
+	if r := check.Run(ctx); r.Status != StatusOK || listed {
+		t.Fatalf("no caps: %+v (listed=%v), want OK without listing mail", r, listed)
+	}
+
+	settings := filepath.Join(town, "settings", "config.json")
+	if err := os.MkdirAll(filepath.Dir(settings), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(settings, []byte(`{"type":"town-settings","version":1,"mail_retention":{"max_messages":5}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := check.Run(ctx)
+	if r.Status != StatusWarning || len(r.Details) != 1 || !strings.HasPrefix(r.Details[0], "gastown/witness: 100%") {
+		t.Fatalf("result = %+v, want witness mailbox at its cap", r)
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/steveyegge/gastown/internal/doltserver"
-	"github.com/steveyegge/gastown/internal/util"
 )
 
 // DoltMetadataCheck verifies that all rig .beads/metadata.json files have
@@ -121,7 +120,7 @@ func (c *DoltMetadataCheck) Fix(ctx *CheckContext) error {
 	}
 
 	for _, rigName := range c.missingMetadata {
-		if err := c.writeDoltMetadata(ctx.TownRoot, rigName); err != nil {
+		if err := c.writeDoltMetadata(ctx.Tx, ctx.TownRoot, rigName); err != nil {
 			return fmt.Errorf("fixing %s: %w", rigName, err)
 		}
 	}
@@ -151,8 +150,8 @@ func (c *DoltMetadataCheck) hasDoltMetadata(beadsDir, expectedDB string) bool {
 		metadata.DoltDatabase == expectedDB
 }
 
-// writeDoltMetadata writes dolt server config to a rig's metadata.json.
-func (c *DoltMetadataCheck) writeDoltMetadata(townRoot, rigName string) error {
+// writeDoltMetadata stages writing dolt server config to a rig's metadata.json.
+func (c *DoltMetadataCheck) writeDoltMetadata(tx *FixTx, townRoot, rigName string) error {
 	// Use FindOrCreateRigBeadsDir to atomically resolve and create the directory,
 	// avoiding the TOCTOU race in the stat-then-use pattern.
 	beadsDir, err := c.findOrCreateRigBeadsDir(townRoot, rigName)
@@ -179,7 +178,7 @@ func (c *DoltMetadataCheck) writeDoltMetadata(townRoot, rigName string) error {
 		return fmt.Errorf("marshaling metadata: %w", err)
 	}
 
-	if err := util.AtomicWriteFile(metadataPath, append(data, '\n'), 0600); err != nil {
+	if err := tx.WriteFile(metadataPath, append(data, '\n'), 0600); err != nil {
 		return fmt.Errorf("writing metadata.json: %w", err)
 	}
 
@@ -375,6 +374,9 @@ func NewDoltOrphanedDatabaseCheck() *DoltOrphanedDatabaseCheck {
 	}
 }
 
+// CacheTTL lets repeated doctor runs reuse a recent result (queries every Dolt database).
+func (c *DoltOrphanedDatabaseCheck) CacheTTL() time.Duration { return 5 * time.Minute }
+
 // Run checks for orphaned databases.
 func (c *DoltOrphanedDatabaseCheck) Run(ctx *CheckContext) *CheckResult {
 	c.orphanNames = nil
@@ -6,7 +6,9 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/doltserver"
 )
 
@@ -45,9 +47,19 @@ func NewNullAssigneeCheck() *NullAssigneeCheck {
 	}
 }
 
-const nullAssigneeSelectQuery = `SELECT id, title, updated_at FROM issues WHERE status = 'in_progress' AND (assignee IS NULL OR assignee = '') ORDER BY updated_at ASC`
+// CacheTTL lets repeated doctor runs reuse a recent result (queries every rig database).
+func (c *NullAssigneeCheck) CacheTTL() time.Duration { return 5 * time.Minute }
 
-const nullAssigneeFixQuery = `UPDATE issues SET status = 'open', assignee = '' WHERE status = 'in_progress' AND (assignee IS NULL OR assignee = '')`
+// nullAssigneeQuery matches in_progress beads with a NULL or empty assignee.
+// A NULL column compares equal to "" when rendered to SQL.
+var nullAssigneeQuery = beads.MustQuery(`status = in_progress AND assignee = ""`)
+
+// nullAssigneeStatements returns the select and fix SQL for nullAssigneeQuery.
+func nullAssigneeStatements() (selectSQL, fixSQL string) {
+	where, _ := nullAssigneeQuery.SQL(beads.QueryEnv{}) // No rig predicate, so rendering cannot fail
+	return "SELECT id, title, updated_at FROM issues WHERE " + where + " ORDER BY updated_at ASC",
+		"UPDATE issues SET status = 'open', assignee = '' WHERE " + where
+}
 
 // Run queries each rig database for in_progress beads with NULL/empty assignee.
 func (c *NullAssigneeCheck) Run(ctx *CheckContext) *CheckResult {
@@ -122,7 +134,8 @@ func (c *NullAssigneeCheck) Fix(ctx *CheckContext) error {
 		rigDir := filepath.Join(ctx.TownRoot, db)
 
 		// Reset beads via direct SQL (bypasses bd ORM which fails on NULL assignee).
-		if err := execBdSQLWrite(rigDir, nullAssigneeFixQuery); err != nil {
+		_, fixSQL := nullAssigneeStatements()
+		if err := execBdSQLWrite(rigDir, fixSQL); err != nil {
 			errs = append(errs, fmt.Sprintf("%s: update failed: %v", db, err))
 			continue
 		}
@@ -145,7 +158,8 @@ func (c *NullAssigneeCheck) Fix(ctx *CheckContext) error {
 // queryNullAssigneeBeads returns in_progress beads with NULL/empty assignee for a rig.
 // Uses bd sql --csv (raw SQL passthrough, not affected by bd ORM deserialization).
 func queryNullAssigneeBeads(rigDir string) ([]nullAssigneeRow, error) {
-	cmd := exec.Command("bd", "sql", "--csv", nullAssigneeSelectQuery) //nolint:gosec // G204: args are constants
+	selectSQL, _ := nullAssigneeStatements()
+	cmd := exec.Command("bd", "sql", "--csv", selectSQL) //nolint:gosec // G204: SQL is rendered from a constant query
 	cmd.Dir = rigDir
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -174,7 +188,7 @@ func queryNullAssigneeBeads(rigDir string) ([]nullAssigneeRow, error) {
 
 // execBdSQLWrite executes a SQL write statement via bd sql.
 func execBdSQLWrite(rigDir, query string) error {
-	cmd := exec.Command("bd", "sql", query) //nolint:gosec // G204: query is a constant
+	cmd := exec.Command("bd", "sql", query) //nolint:gosec // G204: query is rendered from a constant query
 	cmd.Dir = rigDir
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -25,14 +25,6 @@ type SessionLister interface {
 	ListSessions() ([]string, error)
 }
 
-type realSessionLister struct {
-	t *tmux.Tmux
-}
-
-func (r *realSessionLister) ListSessions() ([]string, error) {
-	return r.t.ListSessions()
-}
-
 // NewOrphanSessionCheck creates a new orphan session check.
 func NewOrphanSessionCheck() *OrphanSessionCheck {
 	return &OrphanSessionCheck{
@@ -57,7 +49,7 @@ func NewOrphanSessionCheckWithSessionLister(lister SessionLister) *OrphanSession
 func (c *OrphanSessionCheck) Run(ctx *CheckContext) *CheckResult {
 	lister := c.sessionLister
 	if lister == nil {
-		lister = &realSessionLister{t: tmux.NewTmux()}
+		lister = ctx.Sessions()
 	}
 
 	sessions, err := lister.ListSessions()
@@ -0,0 +1,162 @@
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// dependencyGetter is implemented by checks that must run after others
+// (BaseCheck.CheckDependsOn).
+type dependencyGetter interface {
+	DependsOn() []string
+}
+
+// timeoutGetter is implemented by checks that need a time limit other than
+// the doctor's default.
+type timeoutGetter interface {
+	Timeout() time.Duration
+}
+
+// SetJobs sets how many checks Run and RunStreaming execute at once. Checks
+// still start after the checks they depend on, and results are reported in
+// registration order. n <= 1 runs checks one at a time. Fix and
+// FixStreaming always run one at a time, since fixes change shared state.
+func (d *Doctor) SetJobs(n int) {
+	d.jobs = n
+}
+
+// SetCheckTimeout bounds how long a check may run. A check that overruns is
+// reported as a warning and its result discarded; it cannot be interrupted,
+// so it finishes in the background. 0 means no limit.
+func (d *Doctor) SetCheckTimeout(timeout time.Duration) {
+	d.timeout = timeout
+}
+
+// runCheck runs check within its time limit, turning a panic into an error
+// result.
+func (d *Doctor) runCheck(ctx *CheckContext, check Check) *CheckResult {
+	timeout := d.timeout
+	if tg, ok := check.(timeoutGetter); ok && tg.Timeout() > 0 {
+		timeout = tg.Timeout()
+	}
+	if timeout <= 0 {
+		return safeRunCheck(check, ctx)
+	}
+
+	done := make(chan *CheckResult, 1)
+	go func() { done <- safeRunCheck(check, ctx) }()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case result := <-done:
+		return result
+	case <-timer.C:
+		return &CheckResult{
+			Name:     check.Name(),
+			Status:   StatusWarning,
+			Message:  fmt.Sprintf("timed out after %s", formatDuration(timeout)),
+			FixHint:  fmt.Sprintf("Re-run with a longer --timeout, or skip it with --skip %s", check.Name()),
+			TimedOut: true,
+		}
+	}
+}
+
+// safeRunCheck calls check.Run with panic recovery, like safeFixCheck.
+func safeRunCheck(check Check, ctx *CheckContext) (result *CheckResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = &CheckResult{
+				Name:    check.Name(),
+				Status:  StatusError,
+				Message: fmt.Sprintf("check panicked: %v", r),
+			}
+		}
+	}()
+	return check.Run(ctx)
+}
+
+// runParallel runs the checks on d.jobs workers. A check starts once every
+// registered check it depends on has finished. Results stream in
+// registration order as soon as each one and all before it are done.
+func (d *Doctor) runParallel(ctx *CheckContext, w io.Writer, slowThreshold time.Duration) *Report {
+	report := NewReport()
+	n := len(d.checks)
+
+	// Create lazily-initialized services before workers share ctx.
+	_ = ctx.Sessions()
+
+	index := make(map[string]int, n)
+	for i, check := range d.checks {
+		index[check.Name()] = i
+	}
+	pending := make([]int, n)
+	dependents := make([][]int, n)
+	for i, check := range d.checks {
+		dg, ok := check.(dependencyGetter)
+		if !ok {
+			continue
+		}
+		for _, name := range dg.DependsOn() {
+			// Dependencies that aren't registered (e.g. --skip) don't block.
+			if j, ok := index[name]; ok && j != i {
+				pending[i]++
+				dependents[j] = append(dependents[j], i)
+			}
+		}
+	}
+
+	var ready []int
+	for i := range d.checks {
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	results := make([]*CheckResult, n)
+	started := make([]bool, n)
+	finished := make([]bool, n)
+	done := make(chan int)
+	running, nfinished, next := 0, 0, 0
+	for nfinished < n {
+		for running < d.jobs && len(ready) > 0 {
+			i := ready[0]
+			ready = ready[1:]
+			started[i] = true
+			running++
+			go func(i int) {
+				results[i] = d.runCached(ctx, d.checks[i])
+				done <- i
+			}(i)
+		}
+		if running == 0 {
+			// Nothing runnable: the remaining checks depend on each other.
+			// Run them anyway rather than hang.
+			for i := range d.checks {
+				if !started[i] {
+					ready = append(ready, i)
+				}
+			}
+			continue
+		}
+
+		i := <-done
+		running--
+		nfinished++
+		finished[i] = true
+		for _, k := range dependents[i] {
+			if pending[k]--; pending[k] == 0 && !started[k] {
+				ready = append(ready, k)
+			}
+		}
+		for next < n && finished[next] {
+			if w != nil {
+				printResultLine(w, report, results[next], slowThreshold)
+			}
+			report.Add(results[next])
+			next++
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,164 @@
+package doctor
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// funcCheck runs fn as its check.
+type funcCheck struct {
+	BaseCheck
+	fn func() *CheckResult
+}
+
+func newFuncCheck(name string, fn func() *CheckResult, dependsOn ...string) *funcCheck {
+	return &funcCheck{
+		BaseCheck: BaseCheck{CheckName: name, CheckDependsOn: dependsOn},
+		fn:        fn,
+	}
+}
+
+func (c *funcCheck) Run(ctx *CheckContext) *CheckResult { return c.fn() }
+
+func testContext() *CheckContext {
+	return &CheckContext{TownRoot: "/tmp/town"}
+}
+
+func TestRunParallel_ConcurrentAndOrdered(t *testing.T) {
+	var active, peak atomic.Int32
+	slow := func() *CheckResult {
+		n := active.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		active.Add(-1)
+		return &CheckResult{Status: StatusOK}
+	}
+
+	d := NewDoctor()
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		d.Register(newFuncCheck(name, slow))
+	}
+	d.SetJobs(3)
+
+	var out bytes.Buffer
+	report := d.RunStreaming(testContext(), &out, 0)
+	if got := peak.Load(); got < 2 || got > 3 {
+		t.Errorf("peak concurrency = %d, want 2-3", got)
+	}
+	var names []string
+	for _, r := range report.Checks {
+		names = append(names, r.Name)
+	}
+	if strings.Join(names, "") != "abcdef" {
+		t.Errorf("report order = %v, want registration order", names)
+	}
+	if strings.Index(out.String(), " a") > strings.Index(out.String(), " f") {
+		t.Errorf("streamed output out of order:\n%s", out.String())
+	}
+}
+
+func TestRunParallel_Dependencies(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	record := func(name string, delay time.Duration) func() *CheckResult {
+		return func() *CheckResult {
+			time.Sleep(delay)
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			return &CheckResult{Status: StatusOK}
+		}
+	}
+
+	d := NewDoctor()
+	d.Register(newFuncCheck("exists", record("exists", 30*time.Millisecond)))
+	d.Register(newFuncCheck("valid", record("valid", 0), "exists"))
+	d.Register(newFuncCheck("other", record("other", 0), "skipped-check"))
+	// A cycle must not hang the run.
+	d.Register(newFuncCheck("x", record("x", 0), "y"))
+	d.Register(newFuncCheck("y", record("y", 0), "x"))
+	d.SetJobs(4)
+
+	report := d.Run(testContext())
+	if len(report.Checks) != 5 {
+		t.Fatalf("got %d results, want 5", len(report.Checks))
+	}
+	pos := map[string]int{}
+	for i, name := range order {
+		pos[name] = i
+	}
+	if pos["valid"] < pos["exists"] {
+		t.Errorf("valid ran before its dependency: %v", order)
+	}
+	if pos["other"] > pos["exists"] {
+		t.Errorf("unregistered dependency blocked other: %v", order)
+	}
+}
+
+func TestRunCheck_TimeoutAndPanic(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	d := NewDoctor()
+	d.Register(newFuncCheck("hangs", func() *CheckResult {
+		<-release
+		return &CheckResult{Status: StatusOK}
+	}))
+	d.Register(newFuncCheck("panics", func() *CheckResult { panic("boom") }))
+	d.SetCheckTimeout(20 * time.Millisecond)
+
+	report := d.Run(testContext())
+	hung, panicked := report.Checks[0], report.Checks[1]
+	if !hung.TimedOut || hung.Status != StatusWarning || !strings.Contains(hung.Message, "timed out") {
+		t.Errorf("hung check = %+v, want timed-out warning", hung)
+	}
+	if panicked.Status != StatusError || !strings.Contains(panicked.Message, "boom") {
+		t.Errorf("panicking check = %+v, want error result", panicked)
+	}
+}
+
+// hungFixableCheck blocks in Run until released and records Fix calls.
+type hungFixableCheck struct {
+	FixableCheck
+	release chan struct{}
+	fixed   atomic.Bool
+}
+
+func (c *hungFixableCheck) Run(ctx *CheckContext) *CheckResult {
+	<-c.release
+	return &CheckResult{Status: StatusError}
+}
+
+func (c *hungFixableCheck) Fix(ctx *CheckContext) error {
+	c.fixed.Store(true)
+	return nil
+}
+
+func TestFixStreaming_SkipsTimedOutCheck(t *testing.T) {
+	check := &hungFixableCheck{
+		FixableCheck: FixableCheck{BaseCheck: BaseCheck{CheckName: "hangs"}},
+		release:      make(chan struct{}),
+	}
+	defer close(check.release)
+
+	d := NewDoctor()
+	d.Register(check)
+	d.SetCheckTimeout(20 * time.Millisecond)
+
+	report := d.FixStreaming(&CheckContext{TownRoot: t.TempDir()}, nil, 0)
+	if check.fixed.Load() {
+		t.Error("Fix ran while the timed-out check was still running")
+	}
+	if got := report.Checks[0]; !got.TimedOut || got.Fixed {
+		t.Errorf("result = %+v, want unfixed timed-out warning", got)
+	}
+}
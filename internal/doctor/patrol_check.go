@@ -222,7 +222,9 @@ func (c *PatrolHooksWiredCheck) Run(ctx *CheckContext) *CheckResult {
 
 // Fix creates the daemon patrol config with defaults.
 func (c *PatrolHooksWiredCheck) Fix(ctx *CheckContext) error {
-	return config.EnsureDaemonPatrolConfig(ctx.TownRoot)
+	return ctx.Tx.Rewrite(config.DaemonPatrolConfigPath(ctx.TownRoot), func() error {
+		return config.EnsureDaemonPatrolConfig(ctx.TownRoot)
+	})
 }
 
 // PatrolNotStuckCheck detects wisps that have been in_progress too long.
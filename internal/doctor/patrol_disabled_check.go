@@ -0,0 +1,68 @@
+package doctor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/daemon"
+)
+
+// PatrolDisabledCheck lists patrols switched off with gt patrol disable,
+// with who disabled them, why, and until when, so a paused patrol is never
+// a surprise.
+type PatrolDisabledCheck struct {
+	BaseCheck
+}
+
+// NewPatrolDisabledCheck creates a new patrol disabled check.
+func NewPatrolDisabledCheck() *PatrolDisabledCheck {
+	return &PatrolDisabledCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "patrol-disabled",
+			CheckDescription: "Report patrols temporarily disabled with gt patrol disable",
+			CheckCategory:    CategoryPatrol,
+		},
+	}
+}
+
+// Run reports the active patrol disables.
+func (c *PatrolDisabledCheck) Run(ctx *CheckContext) *CheckResult {
+	disables, err := daemon.LoadPatrolDisables(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Can't read patrol disables",
+			Details: []string{err.Error()},
+			FixHint: "Fix or remove " + daemon.PatrolDisablesFile(ctx.TownRoot),
+		}
+	}
+
+	now := ctx.Now()
+	var details []string
+	for _, d := range disables {
+		if !d.Active(now) {
+			continue
+		}
+		detail := fmt.Sprintf("%s: disabled until %s: %s", d.Patrol, d.Until.Local().Format("2006-01-02 15:04"), d.Reason)
+		if d.By != "" {
+			detail += " (" + d.By + ")"
+		}
+		details = append(details, detail)
+	}
+	if len(details) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No patrols temporarily disabled",
+		}
+	}
+	sort.Strings(details)
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d patrol(s) temporarily disabled", len(details)),
+		Details: details,
+		FixHint: "They re-enable on their own at expiry; run 'gt patrol enable <patrol>' to re-enable one sooner",
+	}
+}
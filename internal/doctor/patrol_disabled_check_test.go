@@ -0,0 +1,34 @@
+package doctor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/daemon"
+)
+
+func TestPatrolDisabledCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	ctx := &CheckContext{TownRoot: townRoot}
+	check := NewPatrolDisabledCheck()
+
+	if res := check.Run(ctx); res.Status != StatusOK {
+		t.Fatalf("no disables: status = %v, want OK", res.Status)
+	}
+
+	now := time.Now()
+	if err := daemon.SavePatrolDisables(townRoot, map[string]daemon.PatrolDisable{
+		"branch_sweep": {Patrol: "branch_sweep", Reason: "release freeze", By: "mayor", At: now, Until: now.Add(48 * time.Hour)},
+		"wisp_reaper":  {Patrol: "wisp_reaper", Reason: "lapsed", At: now.Add(-2 * time.Hour), Until: now.Add(-time.Hour)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	res := check.Run(ctx)
+	if res.Status != StatusWarning || len(res.Details) != 1 {
+		t.Fatalf("status = %v, details = %q; want one warning", res.Status, res.Details)
+	}
+	if !strings.Contains(res.Details[0], "branch_sweep") || !strings.Contains(res.Details[0], "release freeze") {
+		t.Errorf("detail = %q, want the patrol and its reason", res.Details[0])
+	}
+}
@@ -0,0 +1,181 @@
+package doctor
+
+import (
+	"fmt"
+	"net"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/doltserver"
+)
+
+// managedPort is a local TCP port a Gas Town service listens on.
+type managedPort struct {
+	Service  string // Human-readable service name
+	Port     int
+	OwnerPID int    // PID expected to hold the port, 0 if not known
+	OwnerCmd string // Substring of the expected holder's command line
+	OwnerArg string // Further substring the holder's command line must contain, if set
+	Required bool   // A conflict breaks the town (error) rather than an optional tool (warning)
+}
+
+// Port probes, variables so tests can stand in for lsof, ps, and the network.
+var (
+	// portListenerPID returns the PID listening on port, or 0.
+	portListenerPID = func(port int) int {
+		pid, _ := doltserver.PortHolder(port)
+		return pid
+	}
+
+	// processCommandLine returns pid's command line, or "" if unknown.
+	processCommandLine = func(pid int) string {
+		out, err := exec.Command("ps", "-p", strconv.Itoa(pid), "-o", "args=").Output() //nolint:gosec // G204: pid is an integer
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(out))
+	}
+
+	// portInUse reports whether something is listening on port.
+	portInUse = func(port int) bool {
+		ln, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+		if err != nil {
+			return true
+		}
+		_ = ln.Close()
+		return false
+	}
+)
+
+// PortConflictCheck verifies that the ports Gas Town's services expect are
+// either free or held by the right process: the town's Dolt sql-server (and
+// any other local Dolt server a rig is configured for) and the dashboard's
+// HTTP server. When another process has grabbed one, it reports that
+// process's PID and command.
+type PortConflictCheck struct {
+	BaseCheck
+}
+
+// NewPortConflictCheck creates a new port conflict check.
+func NewPortConflictCheck() *PortConflictCheck {
+	return &PortConflictCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "port-conflicts",
+			CheckDescription: "Check that service ports are free or held by the right process",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run checks each managed port.
+func (c *PortConflictCheck) Run(ctx *CheckContext) *CheckResult {
+	ports := managedPorts(ctx.TownRoot)
+
+	status := StatusOK
+	var details []string
+	for _, p := range ports {
+		problem := checkManagedPort(p)
+		if problem == "" {
+			continue
+		}
+		details = append(details, problem)
+		if p.Required {
+			status = StatusError
+		} else if status == StatusOK {
+			status = StatusWarning
+		}
+	}
+
+	if status == StatusOK {
+		return &CheckResult{
+			Name:     c.Name(),
+			Status:   StatusOK,
+			Message:  fmt.Sprintf("%d service port(s) free or held by their service", len(ports)),
+			Category: c.CheckCategory,
+		}
+	}
+	return &CheckResult{
+		Name:     c.Name(),
+		Status:   status,
+		Message:  fmt.Sprintf("%d service port(s) held by another process", len(details)),
+		Details:  details,
+		FixHint:  "Stop the offending process, or move the service (GT_DOLT_PORT in mayor/daemon.json env; gt dashboard --port)",
+		Category: c.CheckCategory,
+	}
+}
+
+// managedPorts lists the local ports the town's services use.
+func managedPorts(townRoot string) []managedPort {
+	var ports []managedPort
+	seen := make(map[int]bool)
+
+	cfg := doltserver.DefaultConfig(townRoot)
+	if !cfg.IsRemote() {
+		p := managedPort{Service: "dolt sql-server", Port: cfg.Port, OwnerCmd: "dolt", OwnerArg: cfg.DataDir, Required: true}
+		if state, err := doltserver.LoadState(townRoot); err == nil && state.Running && state.Port == cfg.Port {
+			p.OwnerPID = state.PID
+		}
+		ports = append(ports, p)
+		seen[cfg.Port] = true
+	}
+
+	// Rigs may point at other local Dolt servers.
+	var extra []int
+	for addr := range (&DoltServerReachableCheck{}).findServerModeRigsByAddr(townRoot) {
+		host, portStr, err := net.SplitHostPort(addr)
+		if err != nil || !isLoopbackHost(host) {
+			continue
+		}
+		if port, err := strconv.Atoi(portStr); err == nil && !seen[port] {
+			seen[port] = true
+			extra = append(extra, port)
+		}
+	}
+	sort.Ints(extra)
+	for _, port := range extra {
+		ports = append(ports, managedPort{Service: "dolt sql-server (rig)", Port: port, OwnerCmd: "dolt", Required: true})
+	}
+
+	if !seen[constants.DashboardPort] {
+		ports = append(ports, managedPort{Service: "gt dashboard", Port: constants.DashboardPort, OwnerCmd: "gt dashboard"})
+	}
+	return ports
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// checkManagedPort returns a description of p's conflict, or "" if the port
+// is free or held by its service: the expected PID, or a process whose
+// command line matches (the PID on record goes stale across restarts).
+func checkManagedPort(p managedPort) string {
+	pid := portListenerPID(p.Port)
+	if pid <= 0 {
+		if portInUse(p.Port) {
+			return fmt.Sprintf("port %d (%s): in use by a process that could not be identified", p.Port, p.Service)
+		}
+		return ""
+	}
+	if p.OwnerPID > 0 && pid == p.OwnerPID {
+		return ""
+	}
+	cmdline := processCommandLine(pid)
+	if cmdline != "" && strings.Contains(cmdline, p.OwnerCmd) && strings.Contains(cmdline, p.OwnerArg) {
+		return ""
+	}
+	if cmdline == "" {
+		cmdline = "unknown command"
+	}
+	if p.OwnerPID > 0 {
+		return fmt.Sprintf("port %d (%s): held by PID %d (%s), expected PID %d", p.Port, p.Service, pid, cmdline, p.OwnerPID)
+	}
+	return fmt.Sprintf("port %d (%s): held by PID %d (%s)", p.Port, p.Service, pid, cmdline)
+}
@@ -0,0 +1,96 @@
+package doctor
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/doltserver"
+)
+
+// stubPorts replaces the port probes: holders maps port → PID and
+// commands maps PID → command line.
+func stubPorts(t *testing.T, holders map[int]int, commands map[int]string) {
+	t.Helper()
+	oldPID, oldCmd, oldInUse := portListenerPID, processCommandLine, portInUse
+	portListenerPID = func(port int) int { return holders[port] }
+	processCommandLine = func(pid int) string { return commands[pid] }
+	portInUse = func(port int) bool { return holders[port] != 0 }
+	t.Cleanup(func() { portListenerPID, processCommandLine, portInUse = oldPID, oldCmd, oldInUse })
+}
+
+func runPortCheck(t *testing.T, townRoot string) *CheckResult {
+	t.Helper()
+	t.Setenv("GT_DOLT_HOST", "")
+	t.Setenv("GT_DOLT_PORT", "")
+	return NewPortConflictCheck().Run(&CheckContext{TownRoot: townRoot})
+}
+
+func TestPortConflictCheck_Free(t *testing.T) {
+	stubPorts(t, nil, nil)
+	result := runPortCheck(t, t.TempDir())
+	if result.Status != StatusOK {
+		t.Errorf("status = %v, want OK: %s %v", result.Status, result.Message, result.Details)
+	}
+}
+
+func TestPortConflictCheck_HeldByOwnService(t *testing.T) {
+	townRoot := t.TempDir()
+	dataDir := filepath.Join(townRoot, ".dolt-data")
+	stubPorts(t,
+		map[int]int{doltserver.DefaultPort: 100, constants.DashboardPort: 200},
+		map[int]string{
+			100: "dolt sql-server --port 3307 --data-dir " + dataDir,
+			200: "/usr/local/bin/gt dashboard",
+		})
+	result := runPortCheck(t, townRoot)
+	if result.Status != StatusOK {
+		t.Errorf("status = %v, want OK: %s %v", result.Status, result.Message, result.Details)
+	}
+}
+
+func TestPortConflictCheck_DoltPortGrabbed(t *testing.T) {
+	stubPorts(t,
+		map[int]int{doltserver.DefaultPort: 4242},
+		map[int]string{4242: "python3 -m http.server 3307"})
+	result := runPortCheck(t, t.TempDir())
+	if result.Status != StatusError {
+		t.Fatalf("status = %v, want error", result.Status)
+	}
+	if len(result.Details) != 1 || !strings.Contains(result.Details[0], "PID 4242 (python3 -m http.server 3307)") {
+		t.Errorf("details = %v, want offending PID and command", result.Details)
+	}
+}
+
+func TestPortConflictCheck_OtherTownsDolt(t *testing.T) {
+	stubPorts(t,
+		map[int]int{doltserver.DefaultPort: 4242},
+		map[int]string{4242: "dolt sql-server --data-dir /elsewhere/.dolt-data"})
+	result := runPortCheck(t, t.TempDir())
+	if result.Status != StatusError {
+		t.Errorf("status = %v, want error for another town's dolt: %v", result.Status, result.Details)
+	}
+}
+
+func TestPortConflictCheck_DashboardPortIsWarning(t *testing.T) {
+	stubPorts(t,
+		map[int]int{constants.DashboardPort: 55},
+		map[int]string{55: "node server.js"})
+	result := runPortCheck(t, t.TempDir())
+	if result.Status != StatusWarning {
+		t.Errorf("status = %v, want warning: %v", result.Status, result.Details)
+	}
+}
+
+func TestPortConflictCheck_UnidentifiedHolder(t *testing.T) {
+	stubPorts(t, nil, nil)
+	portInUse = func(port int) bool { return port == doltserver.DefaultPort }
+	result := runPortCheck(t, t.TempDir())
+	if result.Status != StatusError {
+		t.Fatalf("status = %v, want error", result.Status)
+	}
+	if !strings.Contains(result.Details[0], "could not be identified") {
+		t.Errorf("details = %v", result.Details)
+	}
+}
@@ -171,11 +171,14 @@ func (c *BranchProtectionCheck) Fix(ctx *CheckContext) error {
 		return fmt.Errorf("creating hooks directory: %w", err)
 	}
 
-	// Remove obsolete pre-checkout hook if it's ours
+	// Remove obsolete pre-checkout hook if it's ours. Staged on ctx.Tx with
+	// the post-checkout write, so the two hooks change together.
 	preCheckoutPath := filepath.Join(hooksDir, "pre-checkout")
 	if content, err := os.ReadFile(preCheckoutPath); err == nil {
 		if strings.Contains(string(content), "Gas Town pre-checkout hook") {
-			_ = os.Remove(preCheckoutPath) // Best effort removal
+			if err := ctx.Tx.Remove(preCheckoutPath); err != nil {
+				return fmt.Errorf("removing pre-checkout hook: %w", err)
+			}
 		}
 	}
 
@@ -211,7 +214,7 @@ func (c *BranchProtectionCheck) Fix(ctx *CheckContext) error {
 	}
 
 	// Write the hook
-	if err := os.WriteFile(hookPath, []byte(newContent), 0755); err != nil {
+	if err := ctx.Tx.WriteFile(hookPath, []byte(newContent), 0755); err != nil {
 		return fmt.Errorf("writing hook: %w", err)
 	}
 
@@ -0,0 +1,115 @@
+package doctor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// PrefixRegistryCheck verifies routes.jsonl agrees with the town's bead
+// prefix registry (town.json "prefixes"). The registry is what bead creation
+// validates against and what forwards IDs under retired prefixes, so a route
+// it does not know about is a prefix gt will refuse to create beads under.
+type PrefixRegistryCheck struct {
+	FixableCheck
+	unregistered []beads.Route
+}
+
+// NewPrefixRegistryCheck creates a new prefix registry check.
+func NewPrefixRegistryCheck() *PrefixRegistryCheck {
+	return &PrefixRegistryCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "prefix-registry",
+				CheckDescription: "Check routes.jsonl prefixes are in the town prefix registry",
+				CheckCategory:    CategoryConfig,
+			},
+		},
+	}
+}
+
+// Run compares each route against the registry.
+func (c *PrefixRegistryCheck) Run(ctx *CheckContext) *CheckResult {
+	c.unregistered = nil
+
+	townConfig, err := config.LoadTownConfig(constants.MayorTownPath(ctx.TownRoot))
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No town.json (prefix registry not applicable)",
+		}
+	}
+	routes, err := beads.LoadRoutes(beads.GetTownBeadsPath(ctx.TownRoot))
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Could not read routes.jsonl: %v", err),
+		}
+	}
+
+	var details []string
+	var conflicts int
+	for _, r := range routes {
+		prefix := strings.TrimSuffix(r.Prefix, "-")
+		owner := beads.RouteOwner(r.Path)
+		e, retired := townConfig.LookupPrefix(prefix)
+		switch {
+		case e == nil:
+			c.unregistered = append(c.unregistered, r)
+			details = append(details, fmt.Sprintf("%s- (%s) is not registered", prefix, owner))
+		case retired:
+			conflicts++
+			details = append(details, fmt.Sprintf("%s- is still routed but was retired in favor of %s-", prefix, e.Prefix))
+		case e.Owner != owner:
+			conflicts++
+			details = append(details, fmt.Sprintf("%s- routes to %s but is registered to %s", prefix, r.Path, e.Owner))
+		}
+	}
+
+	if len(details) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("%d prefix(es) registered, routes agree", len(townConfig.Prefixes)),
+		}
+	}
+
+	result := &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d route prefix(es) disagree with the registry", len(details)),
+		Details: details,
+		FixHint: "Run 'gt doctor --fix' to register unregistered prefixes",
+	}
+	if conflicts > 0 {
+		result.Status = StatusError
+		result.FixHint = "Fix the route or rig prefix by hand (see gt rig reprefix); --fix only registers new prefixes"
+	}
+	return result
+}
+
+// Fix registers route prefixes missing from the registry. Conflicting ones
+// are left for a human.
+func (c *PrefixRegistryCheck) Fix(ctx *CheckContext) error {
+	if len(c.unregistered) == 0 {
+		return nil
+	}
+	path := constants.MayorTownPath(ctx.TownRoot)
+	townConfig, err := config.LoadTownConfig(path)
+	if err != nil {
+		return err
+	}
+	for _, r := range c.unregistered {
+		if _, err := townConfig.RegisterPrefix(strings.TrimSuffix(r.Prefix, "-"), beads.RouteOwner(r.Path)); err != nil {
+			return err
+		}
+	}
+	return ctx.Tx.Rewrite(path, func() error {
+		return config.SaveTownConfig(path, townConfig)
+	})
+}
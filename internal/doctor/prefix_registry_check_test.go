@@ -0,0 +1,51 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+func TestPrefixRegistryCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	townPath := constants.MayorTownPath(townRoot)
+	cfg := &config.TownConfig{Type: "town", Name: "town", Prefixes: []config.PrefixEntry{
+		{Prefix: "hq", Owner: config.TownPrefixOwner},
+	}}
+	if err := config.SaveTownConfig(townPath, cfg); err != nil {
+		t.Fatal(err)
+	}
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	routes := []beads.Route{{Prefix: "hq-", Path: "."}, {Prefix: "gt-", Path: "gastown/mayor/rig"}}
+	if err := beads.WriteRoutes(beadsDir, routes); err != nil {
+		t.Fatal(err)
+	}
+
+	check := NewPrefixRegistryCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+	if r := check.Run(ctx); r.Status != StatusWarning || len(r.Details) != 1 {
+		t.Fatalf("Run = %v %v, want one unregistered prefix", r.Status, r.Details)
+	}
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if r := check.Run(ctx); r.Status != StatusOK {
+		t.Errorf("after fix = %v %v, want OK", r.Status, r.Details)
+	}
+
+	// A route into a rig other than the registered owner is an error.
+	routes[1].Path = "beads/mayor/rig"
+	if err := beads.WriteRoutes(beadsDir, routes); err != nil {
+		t.Fatal(err)
+	}
+	if r := check.Run(ctx); r.Status != StatusError {
+		t.Errorf("owner mismatch status = %v, want error", r.Status)
+	}
+}
@@ -0,0 +1,218 @@
+package doctor
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+const (
+	// bloatMaxBlobSize is the largest file a wisp branch may add before it
+	// is reported.
+	bloatMaxBlobSize = 5 << 20
+
+	// bloatRecentBranchAge limits the scan to branches committed to
+	// recently; older unmerged branches are the branch sweeper's business.
+	bloatRecentBranchAge = 7 * 24 * time.Hour
+)
+
+// junkDirs are directories of build output, dependencies and caches that
+// never belong in a commit.
+var junkDirs = []string{
+	"node_modules", "__pycache__", ".pytest_cache", ".mypy_cache", ".tox",
+	".venv", "venv", ".gradle", ".next", "coverage", "htmlcov", ".terraform",
+}
+
+// junkPatterns are file name patterns of generated artifacts.
+var junkPatterns = []string{
+	"*.pyc", "*.pyo", "*.class", "*.o", "*.a", "*.so", "*.dylib", "*.dll",
+	"*.exe", "*.test", "*.out", "*.log", "*.zip", "*.tar", "*.tar.gz", "*.tgz",
+	"*.jar", "*.war", "*.iso", "*.dmg", "*.sqlite", "*.db",
+	".DS_Store", "*.coverprofile",
+}
+
+// RepoBloatCheck reports large files and generated artifacts (dependency
+// directories, build output, binaries) that agents committed on recent
+// wisp branches, so they are caught before merging adds them to the
+// repo's history for good.
+type RepoBloatCheck struct {
+	BaseCheck
+	maxBlobSize int64
+}
+
+// NewRepoBloatCheck creates a new repo bloat check.
+func NewRepoBloatCheck() *RepoBloatCheck {
+	return &RepoBloatCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "repo-bloat",
+			CheckDescription: "Detect large files and build artifacts on unmerged wisp branches",
+			CheckCategory:    CategoryRig,
+		},
+		maxBlobSize: bloatMaxBlobSize,
+	}
+}
+
+// Run scans the recent unmerged polecat branches of every rig.
+func (c *RepoBloatCheck) Run(ctx *CheckContext) *CheckResult {
+	rigsCfg, err := ctx.Config().RigsConfig()
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "No rigs configured"}
+	}
+
+	var details []string
+	scanned := 0
+	for rigName := range rigsCfg.Rigs {
+		if ctx.RigName != "" && rigName != ctx.RigName {
+			continue
+		}
+		found, n := c.checkRig(ctx, rigName)
+		details = append(details, found...)
+		scanned += n
+	}
+	sort.Strings(details)
+
+	if len(details) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("No large files or artifacts on %d recent wisp branch(es)", scanned),
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d large file(s) or artifact(s) on unmerged wisp branches", len(details)),
+		Details: details,
+		FixHint: "Drop the files from the branch history (git rebase -i, or git rm --cached and amend) before it merges, and add them to .gitignore",
+	}
+}
+
+// checkRig returns the offending files on one rig's recent wisp branches
+// and the number of branches scanned.
+func (c *RepoBloatCheck) checkRig(ctx *CheckContext, rigName string) ([]string, int) {
+	rigPath := filepath.Join(ctx.TownRoot, rigName)
+	g := sharedRepo(rigPath)
+	if g == nil {
+		return nil, 0
+	}
+	cfg, _ := rig.LoadRigConfig(rigPath)
+	defaultBranch := "main"
+	var policy *rig.BranchPolicy
+	if cfg != nil {
+		if cfg.DefaultBranch != "" {
+			defaultBranch = cfg.DefaultBranch
+		}
+		policy = cfg.BranchPolicy
+	}
+	base := "origin/" + defaultBranch
+	if ok, _ := g.RefExists(base); !ok {
+		base = defaultBranch
+	}
+
+	var out []string
+	scanned := 0
+	for _, branch := range wispBranches(g, policy) {
+		if at, err := g.CommitTime(branch); err != nil || ctx.Now().Sub(at) > bloatRecentBranchAge {
+			continue
+		}
+		if merged, err := g.IsAncestor(branch, base); err != nil || merged {
+			continue
+		}
+		blobs, err := g.NewBlobs(base, branch)
+		if err != nil {
+			continue
+		}
+		scanned++
+		for _, b := range blobs {
+			if reason := c.offense(b); reason != "" {
+				out = append(out, fmt.Sprintf("%s: %s: %s (%s)", rigName, branch, b.Path, reason))
+			}
+		}
+	}
+	return out, scanned
+}
+
+// offense says why a blob doesn't belong in the repo, or "" if it does.
+func (c *RepoBloatCheck) offense(b git.Blob) string {
+	size := formatBlobSize(b.Size)
+	if dir := junkDir(b.Path); dir != "" {
+		return fmt.Sprintf("%s, inside %s/", size, dir)
+	}
+	name := path.Base(b.Path)
+	for _, pattern := range junkPatterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return fmt.Sprintf("%s, matches %s", size, pattern)
+		}
+	}
+	if b.Size > c.maxBlobSize {
+		return fmt.Sprintf("%s, over %s", size, formatBlobSize(c.maxBlobSize))
+	}
+	return ""
+}
+
+// junkDir returns the junk directory a path is inside, or "".
+func junkDir(p string) string {
+	parts := strings.Split(p, "/")
+	for _, part := range parts[:len(parts)-1] {
+		for _, dir := range junkDirs {
+			if part == dir {
+				return dir
+			}
+		}
+	}
+	return ""
+}
+
+// wispBranches lists the repo's polecat branches and any the rig's branch
+// policy allows.
+func wispBranches(g *git.Git, policy *rig.BranchPolicy) []string {
+	patterns := []string{"polecat/*"}
+	if policy != nil {
+		for _, prefix := range policy.AllowedPrefixes {
+			patterns = append(patterns, prefix+"*")
+		}
+	}
+	seen := map[string]bool{}
+	var out []string
+	for _, pattern := range patterns {
+		branches, _ := g.ListBranches(pattern)
+		for _, b := range branches {
+			if b = strings.TrimSpace(b); b != "" && !seen[b] {
+				seen[b] = true
+				out = append(out, b)
+			}
+		}
+	}
+	return out
+}
+
+// sharedRepo returns the rig's shared repo (.repo.git, or mayor/rig for
+// older rigs), or nil if it has neither.
+func sharedRepo(rigPath string) *git.Git {
+	bare := filepath.Join(rigPath, ".repo.git")
+	if info, err := os.Stat(bare); err == nil && info.IsDir() {
+		return git.NewGitWithDir(bare, "")
+	}
+	mayor := filepath.Join(rigPath, "mayor", "rig")
+	if _, err := os.Stat(filepath.Join(mayor, ".git")); err == nil {
+		return git.NewGit(mayor)
+	}
+	return nil
+}
+
+func formatBlobSize(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
@@ -0,0 +1,87 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestRepoBloatCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	ctx := &CheckContext{
+		TownRoot:    townRoot,
+		ConfigStore: fakeConfigStore{rigs: &config.RigsConfig{Rigs: map[string]config.RigEntry{"gastown": {}}}},
+	}
+
+	// Shared repo with main and two wisp branches
+	origin := filepath.Join(townRoot, "origin.git")
+	runGit(t, "", "init", "--bare", "-b", "main", origin)
+	initBareWithCommit(t, origin)
+	work := filepath.Join(townRoot, "work")
+	runGit(t, "", "clone", "-q", origin, work)
+
+	commit := func(branch string, files map[string]int) {
+		t.Helper()
+		runGit(t, work, "checkout", "-q", "-B", branch, "main")
+		for name, size := range files {
+			p := filepath.Join(work, name)
+			if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(p, []byte(strings.Repeat("x", size)), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		runGit(t, work, "add", "-A")
+		runGit(t, work, "commit", "-q", "-m", branch)
+		runGit(t, work, "push", "-q", origin, branch)
+	}
+	commit("polecat/Toast/gt-1", map[string]int{
+		"main.go":                  100,
+		"web/node_modules/x/a.js":  10,
+		"assets/video.mp4":         2048,
+		"build/app.exe":            12,
+		"internal/ok/ok_test.go":   100,
+		"internal/ok/testdata.txt": 100,
+	})
+	// Deleting a file later on the branch doesn't take it out of history.
+	runGit(t, work, "rm", "-q", "build/app.exe")
+	runGit(t, work, "commit", "-q", "-m", "remove exe")
+	runGit(t, work, "push", "-q", origin, "polecat/Toast/gt-1")
+	commit("polecat/Nux/gt-2", map[string]int{"README.md": 10})
+
+	runGit(t, "", "clone", "-q", "--bare", origin, filepath.Join(rigPath, ".repo.git"))
+
+	check := NewRepoBloatCheck()
+	check.maxBlobSize = 1024
+	result := check.Run(ctx)
+	if result.Status != StatusWarning {
+		t.Fatalf("Status = %s, want warning: %q", result.Status, result.Message)
+	}
+	details := strings.Join(result.Details, "\n")
+	for _, want := range []string{
+		"gastown: polecat/Toast/gt-1: web/node_modules/x/a.js (10 B, inside node_modules/)",
+		"assets/video.mp4 (2.0 KB, over 1.0 KB)",
+		"build/app.exe (12 B, matches *.exe)",
+	} {
+		if !strings.Contains(details, want) {
+			t.Errorf("details missing %q:\n%s", want, details)
+		}
+	}
+	if len(result.Details) != 3 {
+		t.Errorf("Details = %v, want 3 offenders", result.Details)
+	}
+
+	// Merged branches are no longer reported.
+	runGit(t, work, "checkout", "-q", "main")
+	runGit(t, work, "merge", "-q", "--no-edit", "polecat/Toast/gt-1")
+	runGit(t, work, "push", "-q", origin, "main")
+	runGit(t, filepath.Join(rigPath, ".repo.git"), "fetch", "-q", "origin", "main:main")
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("after merge: %s %q %v", result.Status, result.Message, result.Details)
+	}
+}
@@ -81,7 +81,7 @@ func (c *RigBeadsCheck) Run(ctx *CheckContext) *CheckResult {
 	// Check each rig for its identity bead
 	for rigName, info := range rigSet {
 		rigBeadsPath := filepath.Join(ctx.TownRoot, info.beadsPath)
-		bd := beads.New(rigBeadsPath)
+		bd := ctx.Beads(rigBeadsPath)
 
 		rigBeadID := beads.RigBeadIDWithPrefix(info.prefix, rigName)
 		if _, err := bd.Show(rigBeadID); err != nil {
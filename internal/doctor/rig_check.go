@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
@@ -202,6 +203,9 @@ func (c *GitExcludeConfiguredCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 }
 
+// FixSafety grades the fix safe for autofix: it only appends entries to .git/info/exclude.
+func (c *GitExcludeConfiguredCheck) FixSafety() FixSafety { return FixSafe }
+
 // Fix appends missing entries to .git/info/exclude.
 func (c *GitExcludeConfiguredCheck) Fix(ctx *CheckContext) error {
 	if len(c.missingEntries) == 0 {
@@ -215,32 +219,28 @@ func (c *GitExcludeConfiguredCheck) Fix(ctx *CheckContext) error {
 	}
 
 	// Append missing entries
-	f, err := os.OpenFile(c.excludePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
-	if err != nil {
-		return fmt.Errorf("failed to open exclude file: %w", err)
+	perm := os.FileMode(0600)
+	existing, err := os.ReadFile(c.excludePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read exclude file: %w", err)
+	}
+	if info, statErr := os.Stat(c.excludePath); statErr == nil {
+		perm = info.Mode().Perm()
 	}
-	defer f.Close()
 
 	// Add a header comment if file is empty or new
-	info, _ := f.Stat()
-	if info.Size() == 0 {
-		if _, err := f.WriteString("# Gas Town directories\n"); err != nil {
-			return err
-		}
-	} else {
+	var b strings.Builder
+	b.Write(existing)
+	if len(existing) > 0 {
 		// Add newline before new entries
-		if _, err := f.WriteString("\n# Gas Town directories\n"); err != nil {
-			return err
-		}
+		b.WriteString("\n")
 	}
-
+	b.WriteString("# Gas Town directories\n")
 	for _, entry := range c.missingEntries {
-		if _, err := f.WriteString(entry + "\n"); err != nil {
-			return err
-		}
+		b.WriteString(entry + "\n")
 	}
 
-	return nil
+	return ctx.Tx.WriteFile(c.excludePath, []byte(b.String()), perm)
 }
 
 // HooksPathConfiguredCheck verifies all clones have core.hooksPath set to .githooks.
@@ -458,7 +458,7 @@ func (c *WitnessExistsCheck) Fix(ctx *CheckContext) error {
 			return fmt.Errorf("failed to create witness/mail/: %w", err)
 		}
 		inboxPath := filepath.Join(mailDir, "inbox.jsonl")
-		if err := os.WriteFile(inboxPath, []byte{}, 0644); err != nil {
+		if err := ctx.Tx.WriteFile(inboxPath, []byte{}, 0644); err != nil {
 			return fmt.Errorf("failed to create inbox.jsonl: %w", err)
 		}
 	}
@@ -565,7 +565,7 @@ func (c *RefineryExistsCheck) Fix(ctx *CheckContext) error {
 			return fmt.Errorf("failed to create refinery/mail/: %w", err)
 		}
 		inboxPath := filepath.Join(mailDir, "inbox.jsonl")
-		if err := os.WriteFile(inboxPath, []byte{}, 0644); err != nil {
+		if err := ctx.Tx.WriteFile(inboxPath, []byte{}, 0644); err != nil {
 			return fmt.Errorf("failed to create inbox.jsonl: %w", err)
 		}
 	}
@@ -1050,19 +1050,31 @@ func (c *BeadsRedirectCheck) Fix(ctx *CheckContext) error {
 	if hasTrackedBeads {
 		// Check if local beads have conflicting data
 		if hasLocalBeads && hasBeadsData(rigBeadsDir) {
-			// Remove conflicting local beads directory
-			if err := os.RemoveAll(rigBeadsDir); err != nil {
+			// Remove conflicting local beads directory. Staged with the
+			// redirect so a failed write puts the local beads back.
+			if err := ctx.Tx.RemoveAll(rigBeadsDir); err != nil {
 				return fmt.Errorf("removing conflicting local beads: %w", err)
 			}
 		}
 
 		// Create .beads directory if needed
-		if err := os.MkdirAll(rigBeadsDir, 0755); err != nil {
+		var created bool
+		if err := ctx.Tx.Do("create "+rigBeadsDir, func() error {
+			if _, err := os.Stat(rigBeadsDir); os.IsNotExist(err) {
+				created = true
+			}
+			return os.MkdirAll(rigBeadsDir, 0755)
+		}, func() error {
+			if created {
+				return os.Remove(rigBeadsDir)
+			}
+			return nil
+		}); err != nil {
 			return fmt.Errorf("creating .beads directory: %w", err)
 		}
 
 		// Write redirect file
-		if err := os.WriteFile(redirectPath, []byte("mayor/rig/.beads\n"), 0644); err != nil {
+		if err := ctx.Tx.WriteFile(redirectPath, []byte("mayor/rig/.beads\n"), 0644); err != nil {
 			return fmt.Errorf("writing redirect file: %w", err)
 		}
 	}
@@ -1297,6 +1309,9 @@ func NewDefaultBranchAllRigsCheck() *DefaultBranchAllRigsCheck {
 	}
 }
 
+// CacheTTL lets repeated doctor runs reuse a recent result (probes every rig remote).
+func (c *DefaultBranchAllRigsCheck) CacheTTL() time.Duration { return 10 * time.Minute }
+
 // Run checks default_branch for every discovered rig.
 func (c *DefaultBranchAllRigsCheck) Run(ctx *CheckContext) *CheckResult {
 	entries, err := os.ReadDir(ctx.TownRoot)
@@ -1750,7 +1765,7 @@ func (c *BareRepoExistsCheck) Fix(ctx *CheckContext) error {
 
 		// Write gitdir file (points back to the worktree's .git file)
 		gitdirFile := filepath.Join(wtMetaDir, "gitdir")
-		if err := os.WriteFile(gitdirFile, []byte(wtPath+"/.git\n"), 0644); err != nil {
+		if err := ctx.Tx.WriteFile(gitdirFile, []byte(wtPath+"/.git\n"), 0644); err != nil {
 			continue
 		}
 
@@ -1764,7 +1779,7 @@ func (c *BareRepoExistsCheck) Fix(ctx *CheckContext) error {
 		}
 
 		headFile := filepath.Join(wtMetaDir, "HEAD")
-		if err := os.WriteFile(headFile, []byte(headContent), 0644); err != nil {
+		if err := ctx.Tx.WriteFile(headFile, []byte(headContent), 0644); err != nil {
 			continue
 		}
 	}
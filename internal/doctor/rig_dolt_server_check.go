@@ -0,0 +1,131 @@
+package doctor
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/doltserver"
+)
+
+// RigDoltServerCheck reports the health of the dedicated per-rig Dolt
+// servers supervised by the daemon's rig_dolt_servers patrol. It combines
+// the supervisor's last recorded state with a live connection probe.
+type RigDoltServerCheck struct {
+	BaseCheck
+}
+
+// NewRigDoltServerCheck creates a new rig Dolt server check.
+func NewRigDoltServerCheck() *RigDoltServerCheck {
+	return &RigDoltServerCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "rig-dolt-servers",
+			CheckDescription: "Check health of dedicated per-rig Dolt servers",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run reports each configured rig server's status.
+func (c *RigDoltServerCheck) Run(ctx *CheckContext) *CheckResult {
+	patrol := daemon.LoadPatrolConfig(ctx.TownRoot)
+	if !daemon.IsPatrolEnabled(patrol, "rig_dolt_servers") || len(patrol.Patrols.RigDoltServers.Rigs) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No per-rig Dolt servers configured",
+		}
+	}
+
+	state, err := daemon.LoadRigDoltState(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not read rig Dolt server state",
+			Details: []string{err.Error()},
+			FixHint: "Restart the daemon ('gt daemon stop', then 'gt daemon start')",
+		}
+	}
+
+	var details []string
+	var down, pending, unmoved []string
+	for _, rigName := range patrol.Patrols.RigDoltServers.Rigs {
+		srv := state.Servers[rigName]
+		if srv == nil || srv.Port == 0 {
+			pending = append(pending, rigName)
+			details = append(details, fmt.Sprintf("%s: not started yet", rigName))
+			continue
+		}
+
+		reachable := false
+		if conn, err := net.DialTimeout("tcp", srv.Addr(), 2*time.Second); err == nil {
+			_ = conn.Close()
+			reachable = true
+		}
+
+		line := fmt.Sprintf("%s: %s on %s", rigName, srv.Status, srv.Addr())
+		if srv.PID > 0 {
+			line += fmt.Sprintf(" (PID %d)", srv.PID)
+		}
+		if srv.Restarts > 0 {
+			line += fmt.Sprintf(", %d restart(s)", srv.Restarts)
+		}
+		switch {
+		case srv.Status == daemon.RigDoltCrashLoop || (srv.Status == daemon.RigDoltRunning && !reachable):
+			down = append(down, rigName)
+			if !reachable {
+				line += ", unreachable"
+			}
+			if srv.LastError != "" {
+				line += ": " + srv.LastError
+			}
+		case srv.Status != daemon.RigDoltRunning:
+			pending = append(pending, rigName)
+			if !srv.NextRestart.IsZero() {
+				line += fmt.Sprintf(", next restart %s", srv.NextRestart.Local().Format("15:04:05"))
+			}
+		case !srv.Serving:
+			unmoved = append(unmoved, rigName)
+			line += fmt.Sprintf(", database %s still on the town server", srv.Database)
+		}
+		details = append(details, line)
+	}
+
+	total := len(patrol.Patrols.RigDoltServers.Rigs)
+	switch {
+	case len(down) > 0:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: fmt.Sprintf("%d of %d rig Dolt server(s) down: %s", len(down), total, strings.Join(down, ", ")),
+			Details: details,
+			FixHint: "Check daemon/dolt-rig-<rig>.log; crash-looping servers restart once the restart window passes",
+		}
+	case len(pending) > 0:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%d of %d rig Dolt server(s) not running yet: %s", len(pending), total, strings.Join(pending, ", ")),
+			Details: details,
+			FixHint: "Make sure the daemon is running ('gt daemon start'); it starts rig servers on its next patrol",
+		}
+	case len(unmoved) > 0:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%d rig(s) still served by the town Dolt server: %s", len(unmoved), strings.Join(unmoved, ", ")),
+			Details: details,
+			FixHint: fmt.Sprintf("With the rig idle, move its database from %s into %s; the daemon switches the rig over on its next patrol",
+				doltserver.DefaultConfig(ctx.TownRoot).DataDir, daemon.RigDoltDataDir(ctx.TownRoot, "<rig>")),
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: fmt.Sprintf("%d rig Dolt server(s) healthy", total),
+		Details: details,
+	}
+}
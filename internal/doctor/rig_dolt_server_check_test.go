@@ -0,0 +1,69 @@
+package doctor
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+func TestRigDoltServerCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	check := NewRigDoltServerCheck()
+	ctx := &CheckContext{TownRoot: townRoot}
+
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("not configured: status = %v, want OK (%s)", result.Status, result.Message)
+	}
+
+	config := &daemon.DaemonPatrolConfig{
+		Type:    "daemon-patrol-config",
+		Version: 1,
+		Patrols: &daemon.PatrolsConfig{
+			RigDoltServers: &daemon.RigDoltServersConfig{Enabled: true, Rigs: []string{"alpha"}},
+		},
+	}
+	if err := daemon.SavePatrolConfig(townRoot, config); err != nil {
+		t.Fatal(err)
+	}
+	if result := check.Run(ctx); result.Status != StatusWarning {
+		t.Errorf("no state: status = %v, want warning (%s)", result.Status, result.Message)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	writeState := func(srv daemon.RigDoltServer) {
+		t.Helper()
+		srv.Rig, srv.Host, srv.Port = "alpha", "127.0.0.1", port
+		state := &daemon.RigDoltState{UpdatedAt: time.Now(), Servers: map[string]*daemon.RigDoltServer{"alpha": &srv}}
+		if err := util.EnsureDirAndWriteJSON(daemon.RigDoltStateFile(townRoot), state); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeState(daemon.RigDoltServer{Status: daemon.RigDoltRunning, Serving: true})
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("healthy: status = %v, want OK (%s)", result.Status, result.Message)
+	}
+
+	writeState(daemon.RigDoltServer{Status: daemon.RigDoltRunning, Database: "alpha"})
+	if result := check.Run(ctx); result.Status != StatusWarning {
+		t.Errorf("database not moved: status = %v, want warning (%s)", result.Status, result.Message)
+	}
+
+	writeState(daemon.RigDoltServer{Status: daemon.RigDoltCrashLoop, Restarts: 5, LastError: "server exited"})
+	if result := check.Run(ctx); result.Status != StatusError {
+		t.Errorf("crash loop: status = %v, want error (%s)", result.Status, result.Message)
+	}
+
+	_ = ln.Close()
+	writeState(daemon.RigDoltServer{Status: daemon.RigDoltRunning, Serving: true})
+	if result := check.Run(ctx); result.Status != StatusError {
+		t.Errorf("unreachable: status = %v, want error (%s)", result.Status, result.Message)
+	}
+}
@@ -0,0 +1,72 @@
+package doctor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// RigEnvProfileCheck validates per-rig env profiles (settings/config.json
+// "env"): env files exist and parse, PATH additions exist, and no reserved
+// Gas Town variables are overridden. A broken profile fails silently at
+// session start, so agents only notice when GOFLAGS or node are missing.
+type RigEnvProfileCheck struct {
+	BaseCheck
+}
+
+// NewRigEnvProfileCheck creates a new rig env profile check.
+func NewRigEnvProfileCheck() *RigEnvProfileCheck {
+	return &RigEnvProfileCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "rig-env-profile",
+			CheckDescription: "Check per-rig session env profiles resolve cleanly",
+			CheckCategory:    CategoryRig,
+		},
+	}
+}
+
+// Run resolves each rig's env profile and reports problems.
+func (c *RigEnvProfileCheck) Run(ctx *CheckContext) *CheckResult {
+	rigs := findAllRigs(ctx.TownRoot)
+	if ctx.RigName != "" {
+		rigs = []string{filepath.Join(ctx.TownRoot, ctx.RigName)}
+	}
+
+	profiles := 0
+	var details []string
+	for _, rigPath := range rigs {
+		profile := config.LoadRigEnvProfile(rigPath)
+		if profile == nil {
+			continue
+		}
+		profiles++
+		rigName := filepath.Base(rigPath)
+		for _, problem := range profile.Resolve(rigPath, os.LookupEnv).Problems {
+			details = append(details, fmt.Sprintf("%s: %s", rigName, problem))
+		}
+	}
+
+	if profiles == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No rig env profiles configured",
+		}
+	}
+	if len(details) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("%d rig env profile(s) resolve cleanly", profiles),
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d problem(s) in rig env profiles", len(details)),
+		Details: details,
+		FixHint: "Edit the \"env\" section of <rig>/settings/config.json; env files may only contain KEY=VALUE, export KEY=VALUE, and PATH_add lines",
+	}
+}
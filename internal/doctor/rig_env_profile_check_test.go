@@ -0,0 +1,37 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestRigEnvProfileCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	ctx := &CheckContext{TownRoot: townRoot, RigName: "gastown"}
+
+	check := NewRigEnvProfileCheck()
+	if res := check.Run(ctx); res.Status != StatusOK {
+		t.Fatalf("no profile: status = %v, want OK", res.Status)
+	}
+
+	settings := config.NewRigSettings()
+	settings.Env = &config.EnvProfile{Files: []string{".envrc"}}
+	if err := config.SaveRigSettings(config.RigSettingsPath(rigPath), settings); err != nil {
+		t.Fatal(err)
+	}
+	res := check.Run(ctx)
+	if res.Status != StatusWarning || len(res.Details) != 1 {
+		t.Fatalf("missing env file: status = %v, details = %q, want one warning", res.Status, res.Details)
+	}
+
+	if err := os.WriteFile(filepath.Join(rigPath, ".envrc"), []byte("export GOFLAGS=-mod=mod\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if res := check.Run(ctx); res.Status != StatusOK {
+		t.Errorf("valid profile: status = %v, details = %q, want OK", res.Status, res.Details)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/beads"
-	"github.com/steveyegge/gastown/internal/config"
 )
 
 // RigRoutesJSONLCheck detects and fixes routes.jsonl files in rig .beads directories.
@@ -48,7 +47,7 @@ func (c *RigRoutesJSONLCheck) Run(ctx *CheckContext) *CheckResult {
 	c.affectedRigs = nil // Reset
 
 	// Get list of rigs from multiple sources
-	rigDirs := c.findRigDirectories(ctx.TownRoot)
+	rigDirs := c.findRigDirectories(ctx)
 
 	if len(rigDirs) == 0 {
 		return &CheckResult{
@@ -111,8 +110,8 @@ func (c *RigRoutesJSONLCheck) Fix(ctx *CheckContext) error {
 	}
 
 	for _, info := range c.affectedRigs {
-		if err := os.Remove(info.routesPath); err != nil {
-			return fmt.Errorf("deleting %s: %w", info.routesPath, err)
+		if err := ctx.Tx.Remove(info.routesPath); err != nil {
+			return err
 		}
 	}
 
@@ -120,13 +119,13 @@ func (c *RigRoutesJSONLCheck) Fix(ctx *CheckContext) error {
 }
 
 // findRigDirectories finds all rig directories in the town.
-func (c *RigRoutesJSONLCheck) findRigDirectories(townRoot string) []string {
+func (c *RigRoutesJSONLCheck) findRigDirectories(ctx *CheckContext) []string {
+	townRoot := ctx.TownRoot
 	var rigDirs []string
 	seen := make(map[string]bool)
 
 	// Source 1: rigs.json registry
-	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
-	if rigsConfig, err := config.LoadRigsConfig(rigsPath); err == nil {
+	if rigsConfig, err := ctx.Config().RigsConfig(); err == nil {
 		for rigName := range rigsConfig.Rigs {
 			rigPath := filepath.Join(townRoot, rigName)
 			if _, err := os.Stat(rigPath); err == nil && !seen[rigPath] {
@@ -179,7 +179,7 @@ func TestRigRoutesJSONLCheck_FindRigDirectories(t *testing.T) {
 		}
 
 		check := NewRigRoutesJSONLCheck()
-		rigs := check.findRigDirectories(tmpDir)
+		rigs := check.findRigDirectories(&CheckContext{TownRoot: tmpDir})
 
 		if len(rigs) != 2 {
 			t.Errorf("expected 2 rigs, got %d: %v", len(rigs), rigs)
@@ -198,7 +198,7 @@ func TestRigRoutesJSONLCheck_FindRigDirectories(t *testing.T) {
 		}
 
 		check := NewRigRoutesJSONLCheck()
-		rigs := check.findRigDirectories(tmpDir)
+		rigs := check.findRigDirectories(&CheckContext{TownRoot: tmpDir})
 
 		if len(rigs) != 0 {
 			t.Errorf("expected 0 rigs (mayor and .beads should be excluded), got %d: %v", len(rigs), rigs)
@@ -229,7 +229,7 @@ func TestRigRoutesJSONLCheck_FindRigDirectories(t *testing.T) {
 		}
 
 		check := NewRigRoutesJSONLCheck()
-		rigs := check.findRigDirectories(tmpDir)
+		rigs := check.findRigDirectories(&CheckContext{TownRoot: tmpDir})
 
 		// Should find realrig but NOT deacon
 		if len(rigs) != 1 {
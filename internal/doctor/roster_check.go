@@ -0,0 +1,163 @@
+package doctor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/roster"
+)
+
+// sessionEnvReader reads a session's environment. *tmux.Tmux implements it;
+// session backends without it skip the mis-roled comparison.
+type sessionEnvReader interface {
+	GetEnvironment(session, key string) (string, error)
+}
+
+// RosterDriftCheck compares the agents declared in mayor/roster.json with
+// the running sessions and the town's registered rigs and crew. It reports
+// declared agents that aren't running, running agents the roster leaves
+// out, and agents whose session runs under another role's identity.
+type RosterDriftCheck struct {
+	FixableCheck
+	misRoled []string // Addresses of mis-roled agents, for Fix
+}
+
+// NewRosterDriftCheck creates a new roster drift check.
+func NewRosterDriftCheck() *RosterDriftCheck {
+	return &RosterDriftCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "roster-drift",
+				CheckDescription: "Compare running agents with the agent roster",
+				CheckCategory:    CategoryCleanup,
+			},
+		},
+	}
+}
+
+// Run diffs the roster against running sessions and registered state.
+func (c *RosterDriftCheck) Run(ctx *CheckContext) *CheckResult {
+	c.misRoled = nil
+	r, err := roster.Load(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "Agent roster is invalid",
+			Details: []string{err.Error()},
+			FixHint: "Edit mayor/roster.json or rewrite it with 'gt agents roster --init --force'",
+		}
+	}
+	if r == nil {
+		return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "No agent roster (see gt agents roster --init)"}
+	}
+
+	names, err := ctx.Sessions().ListSessions()
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusWarning, Message: "Could not list sessions", Details: []string{err.Error()}}
+	}
+	running := roster.Agents(names)
+	drift := r.Diff(running)
+
+	var details []string
+	for _, id := range drift.Missing {
+		details = append(details, fmt.Sprintf("missing: %s is declared but not running", id.Address()))
+	}
+	for _, id := range drift.Extra {
+		details = append(details, fmt.Sprintf("extra: %s is running but not declared", id.Address()))
+	}
+	if env, ok := ctx.Sessions().(sessionEnvReader); ok {
+		for _, id := range running {
+			if !r.Manages(id.Rig) {
+				continue
+			}
+			role, err := env.GetEnvironment(id.SessionName(), "GT_ROLE")
+			if err != nil || role == "" || role == id.Address() {
+				continue
+			}
+			c.misRoled = append(c.misRoled, id.Address())
+			details = append(details, fmt.Sprintf("mis-roled: %s runs as GT_ROLE=%s", id.Address(), role))
+		}
+	}
+	unregistered := c.unregistered(ctx, r)
+	details = append(details, unregistered...)
+
+	if len(details) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("%d declared agent(s) match the roster", len(r.Declared())),
+		}
+	}
+
+	hint := "Run 'gt agents apply' or 'gt doctor --fix'"
+	if len(c.misRoled) > 0 {
+		hint += "; mis-roled agents are restarted with --restart-sessions"
+	}
+	if len(unregistered) > 0 {
+		hint += "; add unregistered rigs and crew (gt rig add, gt crew add) or drop them from the roster"
+	}
+	return &CheckResult{
+		Name:   c.Name(),
+		Status: StatusWarning,
+		Message: fmt.Sprintf("Agents drift from the roster: %d missing, %d extra, %d mis-roled, %d unregistered",
+			len(drift.Missing), len(drift.Extra), len(c.misRoled), len(unregistered)),
+		Details: details,
+		FixHint: hint,
+	}
+}
+
+// unregistered reports rostered rigs missing from mayor/rigs.json and
+// declared crew without a workspace; the reconciler can't start either.
+func (c *RosterDriftCheck) unregistered(ctx *CheckContext, r *roster.Roster) []string {
+	rigsCfg, err := ctx.Config().RigsConfig()
+	if err != nil {
+		return nil
+	}
+	var out []string
+	for rig, rr := range r.Rigs {
+		if _, ok := rigsCfg.Rigs[rig]; !ok {
+			out = append(out, fmt.Sprintf("unregistered: rig %s is not in mayor/rigs.json", rig))
+			continue
+		}
+		for _, name := range rr.Crew {
+			if _, err := os.Stat(filepath.Join(ctx.TownRoot, rig, "crew", name)); os.IsNotExist(err) {
+				out = append(out, fmt.Sprintf("unregistered: %s/crew/%s has no crew workspace", rig, name))
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Fix runs the reconciler (gt agents apply), and with --restart-sessions
+// restarts mis-roled agents so they come back under their own identity.
+func (c *RosterDriftCheck) Fix(ctx *CheckContext) error {
+	if ctx.NoStart {
+		return ErrSkippedNoStart
+	}
+	gtPath, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	run := func(args ...string) error {
+		cmd := exec.Command(gtPath, args...) //nolint:gosec // G204: args are constructed internally
+		cmd.Dir = ctx.TownRoot
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("gt %s: %w\n%s", strings.Join(args, " "), err, out)
+		}
+		return nil
+	}
+	if ctx.RestartSessions {
+		for _, addr := range c.misRoled {
+			if err := run("agents", "restart", addr); err != nil {
+				return err
+			}
+		}
+	}
+	return run("agents", "apply")
+}
@@ -0,0 +1,106 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/roster"
+)
+
+// fakeRosterSessions is a session backend with per-session GT_ROLE values.
+type fakeRosterSessions struct {
+	roles map[string]string // Session name -> GT_ROLE
+}
+
+func (f *fakeRosterSessions) ListSessions() ([]string, error) {
+	var out []string
+	for name := range f.roles {
+		out = append(out, name)
+	}
+	return out, nil
+}
+
+func (f *fakeRosterSessions) HasSession(name string) (bool, error) {
+	_, ok := f.roles[name]
+	return ok, nil
+}
+
+func (f *fakeRosterSessions) RenameSession(string, string) error { return nil }
+
+func (f *fakeRosterSessions) GetEnvironment(session, key string) (string, error) {
+	return f.roles[session], nil
+}
+
+func TestRosterDriftCheck(t *testing.T) {
+	setupTestRegistry(t)
+	town := t.TempDir()
+	check := NewRosterDriftCheck()
+	ctx := &CheckContext{
+		TownRoot:       town,
+		ConfigStore:    fakeConfigStore{rigs: &config.RigsConfig{Rigs: map[string]config.RigEntry{"gastown": {}, "beads": {}}}},
+		SessionBackend: &fakeRosterSessions{roles: map[string]string{}},
+	}
+	if r := check.Run(ctx); r.Status != StatusOK {
+		t.Fatalf("no roster: %+v, want OK", r)
+	}
+
+	if err := roster.Save(town, &roster.Roster{Rigs: map[string]roster.RigRoster{
+		"gastown": {Witness: true, Refinery: true, Crew: []string{"max", "joe"}},
+		"beads":   {},
+		"wyvern":  {Witness: true},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(town, "gastown", "crew", "max"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	ctx.SessionBackend = &fakeRosterSessions{roles: map[string]string{
+		"gt-witness":   "gastown/witness",
+		"gt-crew-max":  "gastown/crew/joe", // Mis-roled
+		"bd-refinery":  "beads/refinery",   // Extra
+		"gt-polecat-a": "gastown/polecats/a",
+		"hq-mayor":     "mayor",
+	}}
+
+	r := check.Run(ctx)
+	if r.Status != StatusWarning {
+		t.Fatalf("drift: %+v, want warning", r)
+	}
+	details := strings.Join(r.Details, "\n")
+	for _, want := range []string{
+		"missing: gastown/refinery",
+		"missing: gastown/crew/joe",
+		"missing: wyvern/witness",
+		"extra: beads/refinery",
+		"mis-roled: gastown/crew/max runs as GT_ROLE=gastown/crew/joe",
+		"unregistered: gastown/crew/joe has no crew workspace",
+		"unregistered: rig wyvern is not in mayor/rigs.json",
+	} {
+		if !strings.Contains(details, want) {
+			t.Errorf("details missing %q:\n%s", want, details)
+		}
+	}
+	if strings.Contains(details, "polecats") || strings.Contains(details, ": mayor") {
+		t.Errorf("polecats and town agents aren't rostered:\n%s", details)
+	}
+	if len(check.misRoled) != 1 || check.misRoled[0] != "gastown/crew/max" {
+		t.Errorf("misRoled = %v", check.misRoled)
+	}
+}
+
+func TestRosterDriftCheckInvalidRoster(t *testing.T) {
+	town := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(town, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(roster.Path(town), []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	r := NewRosterDriftCheck().Run(&CheckContext{TownRoot: town})
+	if r.Status != StatusError {
+		t.Errorf("invalid roster: %+v, want error", r)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/beads"
-	"github.com/steveyegge/gastown/internal/config"
 )
 
 // determineRigBeadsPath returns the correct route path for a rig based on its actual layout.
@@ -114,8 +113,7 @@ func (c *RoutesCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 
 	// Load rigs registry
-	rigsPath := filepath.Join(ctx.TownRoot, "mayor", "rigs.json")
-	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	rigsConfig, err := ctx.Config().RigsConfig()
 	if err != nil {
 		// No rigs config - check for missing town/convoy routes and validate existing routes
 		if missingTownRoute || missingConvoyRoute {
@@ -341,12 +339,11 @@ func (c *RoutesCheck) Fix(ctx *CheckContext) error {
 	}
 
 	// Load rigs registry
-	rigsPath := filepath.Join(ctx.TownRoot, "mayor", "rigs.json")
-	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	rigsConfig, err := ctx.Config().RigsConfig()
 	if err != nil {
 		// No rigs config - just write town root route if we added it
 		if modified {
-			return beads.WriteRoutes(beadsDir, routes)
+			return writeRoutes(ctx.Tx, beadsDir, routes)
 		}
 		return nil
 	}
@@ -413,8 +410,15 @@ func (c *RoutesCheck) Fix(ctx *CheckContext) error {
 	}
 
 	if modified {
-		return beads.WriteRoutes(beadsDir, routes)
+		return writeRoutes(ctx.Tx, beadsDir, routes)
 	}
 
 	return nil
 }
+
+// writeRoutes stages rewriting routes.jsonl in beadsDir.
+func writeRoutes(tx *FixTx, beadsDir string, routes []beads.Route) error {
+	return tx.Rewrite(filepath.Join(beadsDir, beads.RoutesFileName), func() error {
+		return beads.WriteRoutes(beadsDir, routes)
+	})
+}
@@ -0,0 +1,145 @@
+package doctor
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// categoryAliases maps short category names accepted by selectors to the
+// display categories checks report.
+var categoryAliases = map[string]string{
+	"config": CategoryConfig,
+	"infra":  CategoryInfrastructure,
+}
+
+// Selector matches checks by name, category, group, or fix capability. It
+// is parsed from "name=<glob>", "category=<glob>", "group=<name>",
+// "fixable=<bool>", or a bare name glob such as "patrol-*". Matching is
+// case-insensitive. Group selectors match nothing until ResolveGroups.
+type Selector struct {
+	Field   string // "name", "category", "group", or "fixable"
+	Pattern string
+
+	group *Group
+}
+
+// ParseSelector parses a --only/--skip expression.
+func ParseSelector(expr string) (Selector, error) {
+	field, pattern, ok := strings.Cut(expr, "=")
+	if !ok {
+		field, pattern = "name", expr
+	}
+	field = strings.ToLower(strings.TrimSpace(field))
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	if pattern == "" {
+		return Selector{}, fmt.Errorf("selector %q: empty pattern", expr)
+	}
+	switch field {
+	case "name", "category":
+		if _, err := path.Match(pattern, ""); err != nil {
+			return Selector{}, fmt.Errorf("selector %q: %w", expr, err)
+		}
+		if alias, ok := categoryAliases[pattern]; ok && field == "category" {
+			pattern = strings.ToLower(alias)
+		}
+	case "fixable":
+		if _, err := strconv.ParseBool(pattern); err != nil {
+			return Selector{}, fmt.Errorf("selector %q: fixable must be true or false", expr)
+		}
+	case "group":
+	default:
+		return Selector{}, fmt.Errorf("selector %q: unknown field %q (want name, category, group, or fixable)", expr, field)
+	}
+	return Selector{Field: field, Pattern: pattern}, nil
+}
+
+// ParseSelectors parses a list of selector expressions.
+func ParseSelectors(exprs []string) ([]Selector, error) {
+	out := make([]Selector, 0, len(exprs))
+	for _, e := range exprs {
+		s, err := ParseSelector(e)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+// ResolveGroups binds group selectors to their groups, failing on a group
+// that does not exist.
+func ResolveGroups(sels []Selector, groups map[string]Group) error {
+	for i := range sels {
+		if sels[i].Field != "group" {
+			continue
+		}
+		for name, g := range groups {
+			if strings.EqualFold(name, sels[i].Pattern) {
+				g := g
+				sels[i].group = &g
+			}
+		}
+		if sels[i].group == nil {
+			return fmt.Errorf("unknown check group %q (known: %s)", sels[i].Pattern, strings.Join(GroupNames(groups), ", "))
+		}
+	}
+	return nil
+}
+
+// Match reports whether the selector matches check.
+func (s Selector) Match(check Check) bool {
+	var value string
+	switch s.Field {
+	case "group":
+		return s.group != nil && s.group.Contains(check.Name())
+	case "name":
+		value = check.Name()
+	case "category":
+		value = CheckCategory(check)
+	case "fixable":
+		want, _ := strconv.ParseBool(s.Pattern)
+		return check.CanFix() == want
+	}
+	ok, _ := path.Match(s.Pattern, strings.ToLower(value))
+	return ok
+}
+
+// CheckCategory returns a check's category, or "" if it declares none.
+func CheckCategory(check Check) string {
+	if cg, ok := check.(categoryGetter); ok {
+		return cg.Category()
+	}
+	return ""
+}
+
+// Selected reports whether check is matched by at least one of only (any
+// check when only is empty) and by none of skip.
+func Selected(check Check, only, skip []Selector) bool {
+	if len(only) > 0 && !matchAny(only, check) {
+		return false
+	}
+	return !matchAny(skip, check)
+}
+
+// Select keeps only the checks Selected by only and skip. Registration
+// order is preserved.
+func (d *Doctor) Select(only, skip []Selector) {
+	kept := d.checks[:0]
+	for _, c := range d.checks {
+		if Selected(c, only, skip) {
+			kept = append(kept, c)
+		}
+	}
+	d.checks = kept
+}
+
+func matchAny(sels []Selector, c Check) bool {
+	for _, s := range sels {
+		if s.Match(c) {
+			return true
+		}
+	}
+	return false
+}
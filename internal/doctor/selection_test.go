@@ -0,0 +1,95 @@
+package doctor
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestDoctorSelect(t *testing.T) {
+	mk := func(name, category string, fixable bool) Check {
+		c := newMockCheck(name, StatusOK)
+		c.CheckCategory = category
+		c.fixable = fixable
+		return c
+	}
+	newSuite := func() *Doctor {
+		d := NewDoctor()
+		d.RegisterAll(
+			mk("patrol-molecules-exist", CategoryPatrol, false),
+			mk("patrol-not-stuck", CategoryPatrol, false),
+			mk("wisp-gc", CategoryCleanup, true),
+			mk("settings", CategoryConfig, true),
+			mk("dolt-binary", CategoryInfrastructure, false),
+		)
+		return d
+	}
+
+	tests := []struct {
+		only, skip []string
+		want       string
+	}{
+		{nil, nil, "[patrol-molecules-exist patrol-not-stuck wisp-gc settings dolt-binary]"},
+		{[]string{"category=patrol"}, nil, "[patrol-molecules-exist patrol-not-stuck]"},
+		{[]string{"category=Config"}, nil, "[settings]"}, // alias, case-insensitive
+		{nil, []string{"patrol-*"}, "[wisp-gc settings dolt-binary]"},
+		{[]string{"fixable=true"}, []string{"name=settings"}, "[wisp-gc]"},
+		{[]string{"category=cleanup", "dolt-*"}, nil, "[wisp-gc dolt-binary]"},
+	}
+	for _, tt := range tests {
+		only, err := ParseSelectors(tt.only)
+		if err != nil {
+			t.Fatal(err)
+		}
+		skip, err := ParseSelectors(tt.skip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := newSuite()
+		d.Select(only, skip)
+		var names []string
+		for _, c := range d.Checks() {
+			names = append(names, c.Name())
+		}
+		if got := fmt.Sprint(names); got != tt.want {
+			t.Errorf("only=%v skip=%v: got %s, want %s", tt.only, tt.skip, got, tt.want)
+		}
+	}
+}
+
+func TestParseSelector_Errors(t *testing.T) {
+	for _, bad := range []string{"", "color=red", "fixable=maybe", "name=[", "category="} {
+		if _, err := ParseSelector(bad); err == nil {
+			t.Errorf("ParseSelector(%q) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestDurations_RecordAndPersist(t *testing.T) {
+	townRoot := t.TempDir()
+	d, err := LoadDurations(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := NewReport()
+	report.Add(&CheckResult{Name: "a", Elapsed: time.Second})
+	d.Record(report)
+	report = NewReport()
+	report.Add(&CheckResult{Name: "a", Elapsed: 2 * time.Second})
+	d.Record(report)
+	if got, want := d.Typical("a"), 1300*time.Millisecond; got != want {
+		t.Errorf("Typical(a) = %v, want %v (moving average)", got, want)
+	}
+
+	if err := SaveDurations(townRoot, d); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadDurations(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Typical("a") != d.Typical("a") || loaded.Typical("missing") != 0 {
+		t.Errorf("reloaded durations = %v", loaded.Checks)
+	}
+}
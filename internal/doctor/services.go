@@ -0,0 +1,82 @@
+package doctor
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// ConfigStore loads town configuration.
+type ConfigStore interface {
+	// RigsConfig loads the rig registry (mayor/rigs.json).
+	RigsConfig() (*config.RigsConfig, error)
+}
+
+// BeadsClient opens beads stores.
+type BeadsClient interface {
+	// Open returns the store for the beads database that serves dir.
+	Open(dir string) BeadsStore
+}
+
+// BeadsStore is the read side of a beads database that checks use.
+type BeadsStore interface {
+	Show(id string) (*beads.Issue, error)
+	List(opts beads.ListOptions) ([]*beads.Issue, error)
+}
+
+// SessionBackend lists and manages agent sessions. *tmux.Tmux implements it.
+type SessionBackend interface {
+	ListSessions() ([]string, error)
+	HasSession(name string) (bool, error)
+	RenameSession(oldName, newName string) error
+}
+
+// Clock tells the time.
+type Clock interface {
+	Now() time.Time
+}
+
+// Config returns the context's ConfigStore, defaulting to the town's files.
+func (ctx *CheckContext) Config() ConfigStore {
+	if ctx.ConfigStore != nil {
+		return ctx.ConfigStore
+	}
+	return townConfigStore{townRoot: ctx.TownRoot}
+}
+
+// Beads returns the beads store serving dir.
+func (ctx *CheckContext) Beads(dir string) BeadsStore {
+	if ctx.BeadsClient != nil {
+		return ctx.BeadsClient.Open(dir)
+	}
+	return beads.New(dir)
+}
+
+// Sessions returns the context's SessionBackend, defaulting to tmux.
+func (ctx *CheckContext) Sessions() SessionBackend {
+	if ctx.SessionBackend == nil {
+		ctx.SessionBackend = tmux.NewTmux()
+	}
+	return ctx.SessionBackend
+}
+
+// Now returns the current time from the context's Clock.
+func (ctx *CheckContext) Now() time.Time {
+	if ctx.Clock != nil {
+		return ctx.Clock.Now()
+	}
+	return time.Now()
+}
+
+// townConfigStore reads configuration from the town's files on every call,
+// so a check sees changes made by an earlier fix in the same run.
+type townConfigStore struct {
+	townRoot string
+}
+
+func (s townConfigStore) RigsConfig() (*config.RigsConfig, error) {
+	return config.LoadRigsConfig(filepath.Join(s.townRoot, "mayor", "rigs.json"))
+}
@@ -0,0 +1,105 @@
+package doctor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+type fakeBeadsClient struct {
+	issues map[string]*beads.Issue
+	opened []string
+}
+
+func (f *fakeBeadsClient) Open(dir string) BeadsStore {
+	f.opened = append(f.opened, dir)
+	return f
+}
+
+func (f *fakeBeadsClient) Show(id string) (*beads.Issue, error) {
+	if issue, ok := f.issues[id]; ok {
+		return issue, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (f *fakeBeadsClient) List(beads.ListOptions) ([]*beads.Issue, error) {
+	return nil, nil
+}
+
+type fakeConfigStore struct {
+	rigs *config.RigsConfig
+}
+
+func (f fakeConfigStore) RigsConfig() (*config.RigsConfig, error) {
+	return f.rigs, nil
+}
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
+func TestCheckContextInjectedBeads(t *testing.T) {
+	town := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(town, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	routes := []beads.Route{{Prefix: "gt-", Path: "gastown/mayor/rig"}}
+	if err := beads.WriteRoutes(filepath.Join(town, ".beads"), routes); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &fakeBeadsClient{issues: map[string]*beads.Issue{}}
+	ctx := &CheckContext{TownRoot: town, BeadsClient: client}
+	check := NewRigBeadsCheck()
+
+	if r := check.Run(ctx); r.Status != StatusError {
+		t.Fatalf("rig bead missing: %+v, want error", r)
+	}
+	if len(client.opened) != 1 || client.opened[0] != filepath.Join(town, "gastown/mayor/rig") {
+		t.Errorf("opened = %v, want the rig's beads dir", client.opened)
+	}
+
+	id := beads.RigBeadIDWithPrefix("gt", "gastown")
+	client.issues[id] = &beads.Issue{ID: id}
+	if r := check.Run(ctx); r.Status != StatusOK {
+		t.Errorf("rig bead present: %+v, want OK", r)
+	}
+}
+
+func TestCheckContextInjectedConfig(t *testing.T) {
+	town := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(town, "gastown"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	ctx := &CheckContext{
+		TownRoot:    town,
+		ConfigStore: fakeConfigStore{rigs: &config.RigsConfig{Rigs: map[string]config.RigEntry{"gastown": {}}}},
+	}
+
+	dirs := NewRigRoutesJSONLCheck().findRigDirectories(ctx)
+	if len(dirs) != 1 || dirs[0] != filepath.Join(town, "gastown") {
+		t.Errorf("findRigDirectories = %v, want the rig from the injected config", dirs)
+	}
+}
+
+func TestCheckContextDefaults(t *testing.T) {
+	ctx := &CheckContext{TownRoot: t.TempDir()}
+	if _, err := ctx.Config().RigsConfig(); err == nil {
+		t.Error("default ConfigStore loaded a rigs.json that does not exist")
+	}
+	if d := time.Since(ctx.Now()); d < 0 || d > time.Minute {
+		t.Errorf("default Now() is %v off", d)
+	}
+
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ctx.Clock = fixedClock(at)
+	if !ctx.Now().Equal(at) {
+		t.Errorf("Now() = %v, want the injected clock's %v", ctx.Now(), at)
+	}
+}
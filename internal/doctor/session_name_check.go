@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/session"
-	"github.com/steveyegge/gastown/internal/tmux"
 )
 
 // tmuxRenamer is the minimal tmux interface needed by Fix().
@@ -62,7 +61,7 @@ func NewMalformedSessionNameCheck() *MalformedSessionNameCheck {
 func (c *MalformedSessionNameCheck) Run(ctx *CheckContext) *CheckResult {
 	lister := c.sessionListerForTest
 	if lister == nil {
-		lister = &realSessionLister{t: tmux.NewTmux()}
+		lister = ctx.Sessions()
 	}
 
 	reg := c.registryForTest
@@ -137,7 +136,7 @@ func (c *MalformedSessionNameCheck) Fix(ctx *CheckContext) error {
 	if c.tmuxForTest != nil {
 		t = c.tmuxForTest
 	} else {
-		t = tmux.NewTmux()
+		t = ctx.Sessions()
 	}
 	var lastErr error
 
@@ -0,0 +1,211 @@
+package doctor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Severity grades how much a failing check matters to a town. It is
+// independent of CheckStatus: a check reports what it found, and the town
+// policy decides how seriously to take it.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityError    Severity = "error"
+	SeverityCritical Severity = "critical"
+)
+
+// severityRank orders severities from least to most severe.
+var severityRank = map[Severity]int{
+	SeverityInfo:     1,
+	SeverityWarning:  2,
+	SeverityError:    3,
+	SeverityCritical: 4,
+}
+
+// ParseSeverity validates a severity name.
+func ParseSeverity(s string) (Severity, error) {
+	sev := Severity(s)
+	if _, ok := severityRank[sev]; !ok {
+		return "", fmt.Errorf("unknown severity %q (want info, warning, error, or critical)", s)
+	}
+	return sev, nil
+}
+
+// Rank returns the ordering weight of a severity (0 for unknown/empty).
+func (s Severity) Rank() int {
+	return severityRank[s]
+}
+
+// DefaultSeverity returns the severity implied by a check status.
+// OK results have no severity.
+func DefaultSeverity(status CheckStatus) Severity {
+	switch status {
+	case StatusWarning:
+		return SeverityWarning
+	case StatusError:
+		return SeverityError
+	default:
+		return ""
+	}
+}
+
+// severityTag returns " [severity]" when policy graded a result differently
+// from what its status implies, so overrides are visible in the summary.
+func severityTag(c *CheckResult) string {
+	if c.Severity == "" || c.Severity == DefaultSeverity(c.Status) {
+		return ""
+	}
+	return " [" + string(c.Severity) + "]"
+}
+
+// Policy maps check findings to severities, exit codes, and notifications.
+type Policy struct {
+	overrides map[string]Severity
+	exitCodes map[Severity]int
+	notify    map[Severity]bool
+
+	groups      map[string]Group
+	groupNotify map[string]Severity // Group → minimum severity that notifies
+}
+
+// DefaultPolicy returns the built-in policy: errors exit 1, nothing notifies.
+func DefaultPolicy() *Policy {
+	groups, _ := Groups(nil) // Built-in groups only; cannot fail
+	return &Policy{
+		overrides: map[string]Severity{},
+		exitCodes: map[Severity]int{SeverityError: 1, SeverityCritical: 1},
+		notify:    map[Severity]bool{},
+
+		groups:      groups,
+		groupNotify: map[string]Severity{},
+	}
+}
+
+// NewPolicy builds a policy from town settings. A nil config yields
+// DefaultPolicy. Exit codes not named in the config keep their defaults.
+func NewPolicy(cfg *config.DoctorPolicyConfig) (*Policy, error) {
+	p := DefaultPolicy()
+	if cfg == nil {
+		return p, nil
+	}
+	for check, s := range cfg.Severities {
+		sev, err := ParseSeverity(s)
+		if err != nil {
+			return nil, fmt.Errorf("doctor.severities[%s]: %w", check, err)
+		}
+		p.overrides[check] = sev
+	}
+	for s, code := range cfg.ExitCodes {
+		sev, err := ParseSeverity(s)
+		if err != nil {
+			return nil, fmt.Errorf("doctor.exit_codes: %w", err)
+		}
+		if code < 0 || code > 255 {
+			return nil, fmt.Errorf("doctor.exit_codes[%s]: %d out of range 0-255", s, code)
+		}
+		p.exitCodes[sev] = code
+	}
+	for _, s := range cfg.Notify {
+		sev, err := ParseSeverity(s)
+		if err != nil {
+			return nil, fmt.Errorf("doctor.notify: %w", err)
+		}
+		p.notify[sev] = true
+	}
+	groups, err := Groups(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p.groups = groups
+	for name, s := range cfg.NotifyGroups {
+		if _, ok := groups[name]; !ok {
+			return nil, fmt.Errorf("doctor.notify_groups: unknown group %q", name)
+		}
+		sev, err := ParseSeverity(s)
+		if err != nil {
+			return nil, fmt.Errorf("doctor.notify_groups[%s]: %w", name, err)
+		}
+		p.groupNotify[name] = sev
+	}
+	return p, nil
+}
+
+// LoadPolicy reads the doctor policy from the town's settings/config.json.
+func LoadPolicy(townRoot string) (*Policy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return NewPolicy(settings.Doctor)
+}
+
+// Apply sets the Severity of every non-OK, unacknowledged result. A town
+// override wins over the severity a check set itself, which in turn wins
+// over the status-derived default.
+func (p *Policy) Apply(r *Report) {
+	for _, c := range r.Checks {
+		if c.Status == StatusOK || c.Acknowledged {
+			c.Severity = ""
+			continue
+		}
+		if sev, ok := p.overrides[c.Name]; ok {
+			c.Severity = sev
+		} else if c.Severity == "" {
+			c.Severity = DefaultSeverity(c.Status)
+		}
+	}
+}
+
+// MaxSeverity returns the most severe finding in the report (empty if none).
+func MaxSeverity(r *Report) Severity {
+	var worst Severity
+	for _, c := range r.Checks {
+		if c.Severity.Rank() > worst.Rank() {
+			worst = c.Severity
+		}
+	}
+	return worst
+}
+
+// ExitCode returns the exit code for a report after Apply: the code mapped
+// to its most severe finding, or 0.
+func (p *Policy) ExitCode(r *Report) int {
+	return p.exitCodes[MaxSeverity(r)]
+}
+
+// Groups returns the check groups known to the policy: the built-in groups
+// and the town's custom ones.
+func (p *Policy) Groups() map[string]Group {
+	return p.groups
+}
+
+// ToNotify returns the findings whose severity the policy notifies on,
+// most severe first. A group whose rollup reaches its notify_groups
+// severity is included as a finding named after the group.
+func (p *Policy) ToNotify(r *Report) []*CheckResult {
+	var out []*CheckResult
+	for _, c := range r.Checks {
+		if c.Severity != "" && p.notify[c.Severity] {
+			out = append(out, c)
+		}
+	}
+	for _, g := range Rollup(r, p.groups) {
+		if min, ok := p.groupNotify[g.Name]; ok && g.Severity != "" && g.Severity.Rank() >= min.Rank() {
+			out = append(out, &CheckResult{
+				Name:     g.Name,
+				Status:   g.Status,
+				Message:  "group " + g.Message(),
+				Severity: g.Severity,
+			})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Severity.Rank() > out[j].Severity.Rank()
+	})
+	return out
+}
@@ -0,0 +1,138 @@
+package doctor
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestParseSeverity(t *testing.T) {
+	for _, s := range []string{"info", "warning", "error", "critical"} {
+		if _, err := ParseSeverity(s); err != nil {
+			t.Errorf("ParseSeverity(%q) error = %v", s, err)
+		}
+	}
+	if _, err := ParseSeverity("fatal"); err == nil {
+		t.Error("ParseSeverity(fatal) should fail")
+	}
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	p := DefaultPolicy()
+
+	r := reportWith(
+		&CheckResult{Name: "a", Status: StatusOK},
+		&CheckResult{Name: "b", Status: StatusWarning},
+	)
+	p.Apply(r)
+	if r.Checks[0].Severity != "" || r.Checks[1].Severity != SeverityWarning {
+		t.Errorf("severities = %q, %q", r.Checks[0].Severity, r.Checks[1].Severity)
+	}
+	if code := p.ExitCode(r); code != 0 {
+		t.Errorf("warnings only: ExitCode() = %d, want 0", code)
+	}
+
+	r = reportWith(&CheckResult{Name: "c", Status: StatusError})
+	p.Apply(r)
+	if code := p.ExitCode(r); code != 1 {
+		t.Errorf("error: ExitCode() = %d, want 1", code)
+	}
+	if got := p.ToNotify(r); len(got) != 0 {
+		t.Errorf("default policy should not notify, got %d", len(got))
+	}
+}
+
+func TestPolicy_Overrides(t *testing.T) {
+	p, err := NewPolicy(&config.DoctorPolicyConfig{
+		Severities: map[string]string{
+			"patrol-hooks-wired": "critical",
+			"stale-binary":       "info",
+		},
+		ExitCodes: map[string]int{"critical": 3, "warning": 2},
+		Notify:    []string{"critical", "error"},
+	})
+	if err != nil {
+		t.Fatalf("NewPolicy: %v", err)
+	}
+
+	r := reportWith(
+		&CheckResult{Name: "stale-binary", Status: StatusError},
+		&CheckResult{Name: "patrol-hooks-wired", Status: StatusWarning},
+		&CheckResult{Name: "routes", Status: StatusError},
+		&CheckResult{Name: "self-graded", Status: StatusWarning, Severity: SeverityInfo},
+	)
+	p.Apply(r)
+
+	want := []Severity{SeverityInfo, SeverityCritical, SeverityError, SeverityInfo}
+	for i, c := range r.Checks {
+		if c.Severity != want[i] {
+			t.Errorf("%s severity = %q, want %q", c.Name, c.Severity, want[i])
+		}
+	}
+	if code := p.ExitCode(r); code != 3 {
+		t.Errorf("ExitCode() = %d, want 3 (critical)", code)
+	}
+
+	notify := p.ToNotify(r)
+	if len(notify) != 2 || notify[0].Name != "patrol-hooks-wired" || notify[1].Name != "routes" {
+		t.Errorf("ToNotify() = %+v, want critical then error", notify)
+	}
+
+	// Demoted error alone exits 0.
+	r = reportWith(&CheckResult{Name: "stale-binary", Status: StatusError})
+	p.Apply(r)
+	if code := p.ExitCode(r); code != 0 {
+		t.Errorf("demoted error: ExitCode() = %d, want 0", code)
+	}
+}
+
+func TestNewPolicy_Invalid(t *testing.T) {
+	tests := []*config.DoctorPolicyConfig{
+		{Severities: map[string]string{"x": "fatal"}},
+		{ExitCodes: map[string]int{"bogus": 2}},
+		{ExitCodes: map[string]int{"error": 300}},
+		{Notify: []string{"loud"}},
+	}
+	for i, cfg := range tests {
+		if _, err := NewPolicy(cfg); err == nil {
+			t.Errorf("case %d: NewPolicy() should fail", i)
+		}
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "settings"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `{"type":"town-settings","version":1,"doctor":{"exit_codes":{"warning":4}}}`
+	if err := os.WriteFile(filepath.Join(townRoot, "settings", "config.json"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := LoadPolicy(townRoot)
+	if err != nil {
+		t.Fatalf("LoadPolicy: %v", err)
+	}
+	r := reportWith(&CheckResult{Name: "a", Status: StatusWarning})
+	p.Apply(r)
+	if code := p.ExitCode(r); code != 4 {
+		t.Errorf("ExitCode() = %d, want 4", code)
+	}
+}
+
+func TestSummary_ShowsSeverityOverride(t *testing.T) {
+	p, _ := NewPolicy(&config.DoctorPolicyConfig{Severities: map[string]string{"hooks": "critical"}})
+	r := reportWith(&CheckResult{Name: "hooks", Status: StatusWarning, Message: "unwired"})
+	p.Apply(r)
+
+	var buf bytes.Buffer
+	r.PrintSummaryOnly(&buf, false, 0)
+	if !strings.Contains(buf.String(), "hooks: unwired [critical]") {
+		t.Errorf("summary should tag overridden severity:\n%s", buf.String())
+	}
+}
@@ -155,14 +155,14 @@ func (c *StaleBeadsRedirectCheck) Fix(ctx *CheckContext) error {
 	// Remove stale files
 	for _, relPath := range c.staleLocations {
 		beadsDir := filepath.Join(ctx.TownRoot, relPath)
-		if err := cleanStaleBeadsFiles(beadsDir); err != nil {
+		if err := cleanStaleBeadsFiles(ctx.Tx, beadsDir); err != nil {
 			return fmt.Errorf("cleaning %s: %w", relPath, err)
 		}
 	}
 
 	// Create missing redirects
 	for _, issue := range c.missingRedirects {
-		if err := beads.SetupRedirect(issue.townRoot, issue.worktreePath); err != nil {
+		if err := setupRedirect(ctx.Tx, issue); err != nil {
 			relPath, _ := filepath.Rel(ctx.TownRoot, issue.worktreePath)
 			return fmt.Errorf("creating redirect for %s: %w", relPath, err)
 		}
@@ -170,7 +170,7 @@ func (c *StaleBeadsRedirectCheck) Fix(ctx *CheckContext) error {
 
 	// Fix incorrect redirects (same as creating - SetupRedirect overwrites)
 	for _, issue := range c.incorrectRedirects {
-		if err := beads.SetupRedirect(issue.townRoot, issue.worktreePath); err != nil {
+		if err := setupRedirect(ctx.Tx, issue); err != nil {
 			relPath, _ := filepath.Rel(ctx.TownRoot, issue.worktreePath)
 			return fmt.Errorf("fixing redirect for %s: %w", relPath, err)
 		}
@@ -179,6 +179,14 @@ func (c *StaleBeadsRedirectCheck) Fix(ctx *CheckContext) error {
 	return nil
 }
 
+// setupRedirect stages beads.SetupRedirect for a worktree. SetupRedirect also
+// clears runtime files from the worktree's .beads, so it cannot be undone.
+func setupRedirect(tx *FixTx, issue redirectIssue) error {
+	return tx.Do("set up redirect in "+issue.worktreePath, func() error {
+		return beads.SetupRedirect(issue.townRoot, issue.worktreePath)
+	}, nil)
+}
+
 // findRigDirs returns all rig directories in the town.
 func findRigDirs(townRoot string) ([]string, error) {
 	var rigs []string
@@ -302,7 +310,7 @@ func hasRedirectWithStaleFiles(beadsDir string) bool {
 
 // cleanStaleBeadsFiles removes stale files from a .beads directory,
 // preserving the redirect file and .gitignore.
-func cleanStaleBeadsFiles(beadsDir string) error {
+func cleanStaleBeadsFiles(tx *FixTx, beadsDir string) error {
 	// Verify redirect exists before cleaning
 	redirectPath := filepath.Join(beadsDir, "redirect")
 	if _, err := os.Stat(redirectPath); os.IsNotExist(err) {
@@ -316,7 +324,7 @@ func cleanStaleBeadsFiles(beadsDir string) error {
 			continue
 		}
 		for _, match := range matches {
-			if err := os.RemoveAll(match); err != nil {
+			if err := tx.RemoveAll(match); err != nil {
 				return fmt.Errorf("removing %s: %w", filepath.Base(match), err)
 			}
 		}
@@ -325,7 +333,7 @@ func cleanStaleBeadsFiles(beadsDir string) error {
 	// Also remove mq directory if it exists
 	mqDir := filepath.Join(beadsDir, "mq")
 	if _, err := os.Stat(mqDir); err == nil {
-		if err := os.RemoveAll(mqDir); err != nil {
+		if err := tx.RemoveAll(mqDir); err != nil {
 			return fmt.Errorf("removing mq: %w", err)
 		}
 	}
@@ -0,0 +1,97 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/locks"
+)
+
+// StaleLocksCheck reports town lock leases that outlived their holders.
+//
+// Orphaned leases (the holder exited without releasing) block nothing, since
+// the flock goes with the process, but they mislead anyone reading the lock
+// directory; --fix removes them. Leases still held past their expiry usually
+// mean a hung holder and are reported for a human to look at: the lock is
+// never broken out from under a live process.
+type StaleLocksCheck struct {
+	FixableCheck
+	orphaned []string
+}
+
+// NewStaleLocksCheck creates a new stale locks check.
+func NewStaleLocksCheck() *StaleLocksCheck {
+	return &StaleLocksCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "stale-locks",
+				CheckDescription: "Detect expired or orphaned town lock leases",
+				CheckCategory:    CategoryCleanup,
+			},
+		},
+	}
+}
+
+// Run lists lease records and classifies each.
+func (c *StaleLocksCheck) Run(ctx *CheckContext) *CheckResult {
+	c.orphaned = nil
+
+	statuses, err := locks.List(ctx.TownRoot, ctx.Now())
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not list lock leases",
+			Details: []string{err.Error()},
+		}
+	}
+
+	var details []string
+	var expired int
+	for _, s := range statuses {
+		switch s.State {
+		case locks.StateOrphaned:
+			c.orphaned = append(c.orphaned, s.Name)
+			details = append(details, fmt.Sprintf("%s: orphaned lease left by pid %d", s.Name, s.PID))
+		case locks.StateExpired:
+			expired++
+			details = append(details, fmt.Sprintf("%s: held by %s, lease expired %s (holder may be hung)",
+				s.Name, s.Lease.String(), s.ExpiresAt.Local().Format("15:04:05")))
+		}
+	}
+
+	if len(details) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("%d lock lease(s), none stale", len(statuses)),
+		}
+	}
+
+	result := &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d orphaned, %d expired lock lease(s)", len(c.orphaned), expired),
+		Details: details,
+	}
+	switch {
+	case expired > 0:
+		result.FixHint = "Check the listed holders; kill a hung one to release its lock"
+	default:
+		result.FixHint = "Run 'gt doctor --fix' to remove orphaned leases"
+	}
+	return result
+}
+
+// FixSafety grades the fix safe for autofix: it only clears locks whose holder is gone.
+func (c *StaleLocksCheck) FixSafety() FixSafety { return FixSafe }
+
+// Fix removes orphaned lease records. Locks that became held again since Run
+// are left alone.
+func (c *StaleLocksCheck) Fix(ctx *CheckContext) error {
+	for _, name := range c.orphaned {
+		if _, err := locks.ClearOrphaned(ctx.TownRoot, name); err != nil {
+			return fmt.Errorf("clearing %s: %w", name, err)
+		}
+	}
+	return nil
+}
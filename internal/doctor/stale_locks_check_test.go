@@ -0,0 +1,50 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/locks"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+func TestStaleLocksCheck(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("advisory flock is a no-op on Windows")
+	}
+	townRoot := t.TempDir()
+	check := NewStaleLocksCheck()
+
+	if r := check.Run(&CheckContext{TownRoot: townRoot}); r.Status != StatusOK {
+		t.Fatalf("empty town status = %v, want OK", r.Status)
+	}
+
+	held, err := locks.Acquire(townRoot, "merge-queue", locks.Options{TTL: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer held.Release()
+	orphan := locks.Lease{Name: "beads-sync", PID: 999999, AcquiredAt: time.Now().Add(-time.Hour)}
+	if err := util.AtomicWriteJSON(filepath.Join(locks.Dir(townRoot), "beads-sync.json"), orphan); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := &CheckContext{TownRoot: townRoot, Clock: fixedClock(time.Now().Add(time.Hour))}
+	r := check.Run(ctx)
+	if r.Status != StatusWarning || len(r.Details) != 2 {
+		t.Fatalf("Run = %v %v, want warning with 2 details", r.Status, r.Details)
+	}
+
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(locks.Dir(townRoot), "beads-sync.json")); !os.IsNotExist(err) {
+		t.Error("Fix should remove the orphaned lease")
+	}
+	if locks.Read(townRoot, "merge-queue") == nil {
+		t.Error("Fix must not touch a held lease")
+	}
+}
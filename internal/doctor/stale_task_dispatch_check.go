@@ -163,7 +163,7 @@ func (c *StaleTaskDispatchCheck) Fix(ctx *CheckContext) error {
 		}
 		data = append(data, '\n')
 
-		if err := os.WriteFile(target.Path, data, 0644); err != nil {
+		if err := ctx.Tx.WriteFile(target.Path, data, 0644); err != nil {
 			errs = append(errs, fmt.Sprintf("%s: write: %v", target.DisplayKey(), err))
 			continue
 		}
@@ -0,0 +1,59 @@
+package doctor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/telemetry"
+)
+
+// cardinalityWindow is how recent a guard violation must be to report.
+// Older entries are from call sites that have presumably been fixed.
+const cardinalityWindow = 7 * 24 * time.Hour
+
+// TelemetryCardinalityCheck reports metric attributes the telemetry
+// cardinality guard has stripped. Each one is a call site putting an
+// unbounded value (a session or bead ID, say) on a metric.
+type TelemetryCardinalityCheck struct {
+	BaseCheck
+	now func() time.Time
+}
+
+// NewTelemetryCardinalityCheck creates a new telemetry cardinality check.
+func NewTelemetryCardinalityCheck() *TelemetryCardinalityCheck {
+	return &TelemetryCardinalityCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "telemetry-cardinality",
+			CheckDescription: "Report metric attributes stripped by the telemetry cardinality guard",
+			CheckCategory:    CategoryInfrastructure,
+		},
+		now: time.Now,
+	}
+}
+
+// Run reads the town's cardinality report.
+func (c *TelemetryCardinalityCheck) Run(ctx *CheckContext) *CheckResult {
+	report, err := telemetry.ReadCardinalityReport(ctx.TownRoot)
+	if err != nil {
+		return &CheckResult{Name: c.Name(), Status: StatusWarning, Message: "Could not read cardinality report", Details: []string{err.Error()}}
+	}
+
+	since := c.now().Add(-cardinalityWindow)
+	var details []string
+	for _, v := range report {
+		if v.LastSeen.Before(since) {
+			continue
+		}
+		details = append(details, fmt.Sprintf("%s: stripped %d time(s), last %s", v.Key, v.Count, v.LastSeen.Local().Format("01-02 15:04")))
+	}
+	if len(details) == 0 {
+		return &CheckResult{Name: c.Name(), Status: StatusOK, Message: "No disallowed metric attributes"}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d disallowed metric attribute key(s) stripped in the last %s", len(details), cardinalityWindow),
+		Details: details,
+		FixHint: "Move these attributes from the metric to the log event in internal/telemetry, or add the key to the allowlist if its values are bounded",
+	}
+}
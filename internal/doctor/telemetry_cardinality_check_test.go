@@ -0,0 +1,41 @@
+package doctor
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/telemetry"
+)
+
+func TestTelemetryCardinalityCheck(t *testing.T) {
+	town := t.TempDir()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	check := NewTelemetryCardinalityCheck()
+	check.now = func() time.Time { return now }
+	ctx := &CheckContext{TownRoot: town}
+
+	if r := check.Run(ctx); r.Status != StatusOK {
+		t.Fatalf("no report: %+v", r)
+	}
+
+	report := []telemetry.CardinalityViolation{
+		{Key: "session", Count: 40, LastSeen: now.Add(-time.Hour)},
+		{Key: "bead_id", Count: 3, LastSeen: now.Add(-30 * 24 * time.Hour)}, // fixed long ago
+	}
+	data, _ := json.Marshal(report)
+	path := telemetry.CardinalityReportPath(town)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := check.Run(ctx)
+	if r.Status != StatusWarning || len(r.Details) != 1 {
+		t.Fatalf("result = %+v, want one recent violation", r)
+	}
+}
@@ -203,12 +203,17 @@ func (c *TestutilSymlinkCheck) Fix(ctx *CheckContext) error {
 		}
 
 		// Remove existing dir/symlink
-		if err := os.RemoveAll(issue.path); err != nil {
+		if err := ctx.Tx.RemoveAll(issue.path); err != nil {
 			return fmt.Errorf("cannot remove %s: %w", issue.path, err)
 		}
 
 		// Create symlink
-		if err := os.Symlink(relTarget, issue.path); err != nil {
+		path := issue.path
+		if err := ctx.Tx.Do("symlink "+path, func() error {
+			return os.Symlink(relTarget, path)
+		}, func() error {
+			return os.Remove(path)
+		}); err != nil {
 			return fmt.Errorf("cannot create symlink at %s: %w", issue.path, err)
 		}
 	}
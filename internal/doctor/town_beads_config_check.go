@@ -83,5 +83,7 @@ func (c *TownBeadsConfigCheck) Fix(ctx *CheckContext) error {
 		return nil
 	}
 	beadsDir := filepath.Join(ctx.TownRoot, ".beads")
-	return beads.EnsureConfigYAMLFromMetadataIfMissing(beadsDir, "hq")
+	return ctx.Tx.Rewrite(filepath.Join(beadsDir, "config.yaml"), func() error {
+		return beads.EnsureConfigYAMLFromMetadataIfMissing(beadsDir, "hq")
+	})
 }
@@ -103,7 +103,7 @@ func (c *TownCLAUDEmdCheck) Fix(ctx *CheckContext) error {
 
 	// If file is missing, create it from the canonical template
 	if c.fileMissing {
-		return os.WriteFile(claudePath, []byte(canonical), 0644)
+		return ctx.Tx.WriteFile(claudePath, []byte(canonical), 0644)
 	}
 
 	// File exists but is missing sections — append them
@@ -144,7 +144,7 @@ func (c *TownCLAUDEmdCheck) Fix(ctx *CheckContext) error {
 	}
 
 	updated := current + toAppend.String()
-	return os.WriteFile(claudePath, []byte(updated), 0644)
+	return ctx.Tx.WriteFile(claudePath, []byte(updated), 0644)
 }
 
 // h2Section represents a section of markdown delimited by H2 headings.
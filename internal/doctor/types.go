@@ -18,6 +18,7 @@ const (
 	CategoryConfig        = "Configuration"
 	CategoryCleanup       = "Cleanup"
 	CategoryHooks         = "Hooks"
+	CategoryCustom        = "Custom" // Site checks from mayor/doctor.d
 )
 
 // CategoryOrder defines the display order for categories
@@ -29,6 +30,7 @@ var CategoryOrder = []string{
 	CategoryConfig,
 	CategoryCleanup,
 	CategoryHooks,
+	CategoryCustom,
 }
 
 // CheckStatus represents the result status of a health check.
@@ -64,6 +66,19 @@ type CheckContext struct {
 	Verbose         bool   // Enable verbose output
 	RestartSessions bool   // Restart patrol sessions when fixing (requires explicit --restart-sessions flag)
 	NoStart         bool   // Suppress starting daemon/agents during --fix
+
+	// Tx stages the current fix's changes (see FixTx). Set by Doctor while
+	// a Fix runs; nil otherwise, in which case changes apply immediately.
+	Tx *FixTx
+
+	// Services checks use instead of building their own clients, so every
+	// check in a run shares one configuration and tests can inject fakes.
+	// Reach them through Config, Beads, Sessions, and Now; a nil service
+	// falls back to the real implementation.
+	ConfigStore    ConfigStore
+	BeadsClient    BeadsClient
+	SessionBackend SessionBackend
+	Clock          Clock
 }
 
 // RigPath returns the full path to the rig directory.
@@ -88,6 +103,16 @@ type CheckResult struct {
 	Category string        // Category for grouping (e.g., CategoryCore)
 	Elapsed  time.Duration // How long the check took to run
 	Fixed    bool          // True if this check was auto-fixed
+	Fixable  bool          // True if the check can auto-fix (gt doctor --fix)
+	Severity Severity      // Graded severity for non-OK results (set by Policy.Apply)
+
+	Acknowledged bool   // Non-OK result suppressed by a gt doctor ack
+	AckReason    string // Reason recorded with the ack
+
+	Cached   bool      // Reused from the result cache instead of re-run
+	CachedAt time.Time // When the cached result was produced
+
+	TimedOut bool // Abandoned after the per-check timeout (see Doctor.SetCheckTimeout)
 }
 
 // Check defines the interface for a health check.
@@ -111,14 +136,15 @@ type Check interface {
 
 // ReportSummary summarizes the results of all checks.
 type ReportSummary struct {
-	Total       int
-	OK          int
-	Warnings    int
-	Errors      int
-	Fixed       int           // Checks that were auto-fixed
-	Slow        int           // Checks that took longer than threshold (counted during Print)
-	SlowestName string        // Name of the slowest check
-	SlowestTime time.Duration // Duration of the slowest check
+	Total        int
+	OK           int
+	Warnings     int
+	Errors       int
+	Fixed        int           // Checks that were auto-fixed
+	Acknowledged int           // Non-OK checks suppressed by an active ack
+	Slow         int           // Checks that took longer than threshold (counted during Print)
+	SlowestName  string        // Name of the slowest check
+	SlowestTime  time.Duration // Duration of the slowest check
 }
 
 // Report contains all check results and a summary.
@@ -139,14 +165,27 @@ func NewReport() *Report {
 // Add adds a check result to the report and updates the summary.
 func (r *Report) Add(result *CheckResult) {
 	r.Checks = append(r.Checks, result)
+	r.count(result)
+
+	// Track the slowest check
+	if result.Elapsed > r.Summary.SlowestTime {
+		r.Summary.SlowestName = result.Name
+		r.Summary.SlowestTime = result.Elapsed
+	}
+}
+
+// count updates the status counters for one result.
+func (r *Report) count(result *CheckResult) {
 	r.Summary.Total++
 
-	switch result.Status {
-	case StatusOK:
+	switch {
+	case result.Acknowledged:
+		r.Summary.Acknowledged++
+	case result.Status == StatusOK:
 		r.Summary.OK++
-	case StatusWarning:
+	case result.Status == StatusWarning:
 		r.Summary.Warnings++
-	case StatusError:
+	case result.Status == StatusError:
 		r.Summary.Errors++
 	}
 
@@ -154,11 +193,15 @@ func (r *Report) Add(result *CheckResult) {
 	if result.Fixed {
 		r.Summary.Fixed++
 	}
+}
 
-	// Track the slowest check
-	if result.Elapsed > r.Summary.SlowestTime {
-		r.Summary.SlowestName = result.Name
-		r.Summary.SlowestTime = result.Elapsed
+// recount rebuilds the status counters after results were modified in place
+// (e.g., by acknowledgements). Slow-check tracking is preserved.
+func (r *Report) recount() {
+	r.Summary.Total, r.Summary.OK, r.Summary.Warnings, r.Summary.Errors = 0, 0, 0, 0
+	r.Summary.Fixed, r.Summary.Acknowledged = 0, 0
+	for _, c := range r.Checks {
+		r.count(c)
 	}
 }
 
@@ -202,7 +245,7 @@ func (r *Report) PrintSummaryOnly(w io.Writer, verbose bool, slowThreshold time.
 		for _, check := range warnings {
 			if len(check.Details) > 0 {
 				for _, detail := range check.Details {
-					_, _ = fmt.Fprintf(w, "     %s%s\n", ui.MutedStyle.Render(ui.TreeLast), ui.RenderMuted(detail))
+					_, _ = fmt.Fprintf(w, "     %s%s\n", ui.RenderTreeLast(), ui.RenderMuted(detail))
 				}
 			}
 		}
@@ -305,7 +348,7 @@ func (r *Report) printCheck(w io.Writer, check *CheckResult, verbose bool, slowT
 	// Print details in verbose mode or for non-OK results (with tree connector)
 	if len(check.Details) > 0 && (verbose || check.Status != StatusOK) {
 		for _, detail := range check.Details {
-			_, _ = fmt.Fprintf(w, "     %s%s\n", ui.MutedStyle.Render(ui.TreeLast), ui.RenderMuted(detail))
+			_, _ = fmt.Fprintf(w, "     %s%s\n", ui.RenderTreeLast(), ui.RenderMuted(detail))
 		}
 	}
 }
@@ -342,6 +385,9 @@ func (r *Report) printSummary(w io.Writer, slowThreshold time.Duration) {
 	if r.Summary.Fixed > 0 {
 		summary += fmt.Sprintf("  🔧 %d fixed", r.Summary.Fixed)
 	}
+	if r.Summary.Acknowledged > 0 {
+		summary += ui.RenderMuted(fmt.Sprintf("  %s %d acknowledged", ui.IconSkip, r.Summary.Acknowledged))
+	}
 	if slowThreshold > 0 && r.Summary.Slow > 0 {
 		summary += fmt.Sprintf("  ⏳ %d slow (slowest: %s %s)",
 			r.Summary.Slow,
@@ -355,9 +401,11 @@ func (r *Report) printSummary(w io.Writer, slowThreshold time.Duration) {
 // printWarningsSection outputs separate sections for failures, warnings, and fixed items.
 func (r *Report) printWarningsSection(w io.Writer, issues []*CheckResult) {
 	// Separate into categories
-	var failures, warnings, fixed []*CheckResult
+	var failures, warnings, fixed, acked []*CheckResult
 	for _, check := range issues {
-		if check.Fixed {
+		if check.Acknowledged {
+			acked = append(acked, check)
+		} else if check.Fixed {
 			fixed = append(fixed, check)
 		} else if check.Status == StatusError {
 			failures = append(failures, check)
@@ -374,21 +422,21 @@ func (r *Report) printWarningsSection(w io.Writer, issues []*CheckResult) {
 	}
 
 	// If nothing to report, show success message
-	if len(failures) == 0 && len(warnings) == 0 && len(fixed) == 0 {
+	if len(failures) == 0 && len(warnings) == 0 && len(fixed) == 0 && len(acked) == 0 {
 		_, _ = fmt.Fprintln(w)
-		_, _ = fmt.Fprintln(w, ui.RenderPass(ui.IconPass+" All checks passed"))
+		_, _ = fmt.Fprintln(w, ui.RenderPass(ui.Glyph(ui.IconPass)+" All checks passed"))
 		return
 	}
 
 	// Print FAILURES section
 	if len(failures) > 0 {
 		_, _ = fmt.Fprintln(w)
-		_, _ = fmt.Fprintln(w, ui.RenderFail(ui.IconFail+"  FAILURES"))
+		_, _ = fmt.Fprintln(w, ui.RenderFail(ui.Glyph(ui.IconFail)+"  FAILURES"))
 		for i, check := range failures {
-			line := fmt.Sprintf("%s: %s", check.Name, check.Message)
+			line := fmt.Sprintf("%s: %s%s", check.Name, check.Message, severityTag(check))
 			_, _ = fmt.Fprintf(w, "  %s  %s %s\n", ui.RenderFailIcon(), ui.RenderFail(fmt.Sprintf("%d.", i+1)), ui.RenderFail(line))
 			if check.FixHint != "" {
-				_, _ = fmt.Fprintf(w, "        %s%s\n", ui.MutedStyle.Render(ui.TreeLast), check.FixHint)
+				_, _ = fmt.Fprintf(w, "        %s%s\n", ui.RenderTreeLast(), check.FixHint)
 			}
 		}
 	}
@@ -396,12 +444,12 @@ func (r *Report) printWarningsSection(w io.Writer, issues []*CheckResult) {
 	// Print WARNINGS section
 	if len(warnings) > 0 {
 		_, _ = fmt.Fprintln(w)
-		_, _ = fmt.Fprintln(w, ui.RenderWarn(ui.IconWarn+"  WARNINGS"))
+		_, _ = fmt.Fprintln(w, ui.RenderWarn(ui.Glyph(ui.IconWarn)+"  WARNINGS"))
 		for i, check := range warnings {
-			line := fmt.Sprintf("%s: %s", check.Name, check.Message)
+			line := fmt.Sprintf("%s: %s%s", check.Name, check.Message, severityTag(check))
 			_, _ = fmt.Fprintf(w, "  %s  %s %s\n", ui.RenderWarnIcon(), ui.RenderWarn(fmt.Sprintf("%d.", i+1)), line)
 			if check.FixHint != "" {
-				_, _ = fmt.Fprintf(w, "        %s%s\n", ui.MutedStyle.Render(ui.TreeLast), check.FixHint)
+				_, _ = fmt.Fprintf(w, "        %s%s\n", ui.RenderTreeLast(), check.FixHint)
 			}
 		}
 	}
@@ -416,9 +464,22 @@ func (r *Report) printWarningsSection(w io.Writer, issues []*CheckResult) {
 		}
 	}
 
-	// If only fixed items, show success message
+	// Print ACKNOWLEDGED section (known findings suppressed via gt doctor ack)
+	if len(acked) > 0 {
+		_, _ = fmt.Fprintln(w)
+		_, _ = fmt.Fprintln(w, ui.RenderMuted(ui.IconSkip+"  ACKNOWLEDGED"))
+		for i, check := range acked {
+			line := fmt.Sprintf("%s: %s", check.Name, check.Message)
+			if check.AckReason != "" {
+				line += " (" + check.AckReason + ")"
+			}
+			_, _ = fmt.Fprintf(w, "  %s  %s %s\n", ui.RenderMuted(ui.IconSkip), ui.RenderMuted(fmt.Sprintf("%d.", i+1)), ui.RenderMuted(line))
+		}
+	}
+
+	// If only fixed or acknowledged items, show success message
 	if len(failures) == 0 && len(warnings) == 0 {
 		_, _ = fmt.Fprintln(w)
-		_, _ = fmt.Fprintln(w, ui.RenderPass(ui.IconPass+" All remaining checks passed"))
+		_, _ = fmt.Fprintln(w, ui.RenderPass(ui.Glyph(ui.IconPass)+" All remaining checks passed"))
 	}
 }
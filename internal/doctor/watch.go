@@ -0,0 +1,127 @@
+package doctor
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+// Transition records a check whose status changed between two runs.
+type Transition struct {
+	Name  string
+	From  CheckStatus
+	To    CheckStatus
+	IsNew bool // Check did not appear in the previous run
+}
+
+// Worsened returns true if the check moved to a more severe status.
+func (t Transition) Worsened() bool {
+	return t.To > t.From
+}
+
+// DiffReports returns the checks whose status differs between prev and cur,
+// in cur's check order. A nil prev yields no transitions.
+func DiffReports(prev, cur *Report) []Transition {
+	if prev == nil || cur == nil {
+		return nil
+	}
+	before := make(map[string]CheckStatus, len(prev.Checks))
+	for _, c := range prev.Checks {
+		before[c.Name] = effectiveStatus(c)
+	}
+
+	var out []Transition
+	for _, c := range cur.Checks {
+		status := effectiveStatus(c)
+		from, ok := before[c.Name]
+		if !ok {
+			if status != StatusOK {
+				out = append(out, Transition{Name: c.Name, From: StatusOK, To: status, IsNew: true})
+			}
+			continue
+		}
+		if from != status {
+			out = append(out, Transition{Name: c.Name, From: from, To: status})
+		}
+	}
+	return out
+}
+
+// effectiveStatus treats acknowledged findings as OK for change tracking.
+func effectiveStatus(c *CheckResult) CheckStatus {
+	if c.Acknowledged {
+		return StatusOK
+	}
+	return c.Status
+}
+
+// NewErrors returns the transitions that moved a check into StatusError.
+func NewErrors(transitions []Transition) []Transition {
+	var out []Transition
+	for _, t := range transitions {
+		if t.To == StatusError && t.From != StatusError {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// PrintWatchFrame renders one watch-mode frame: the non-OK checks of the
+// current report, followed by the status changes since the previous run.
+func PrintWatchFrame(w io.Writer, r *Report, transitions []Transition) {
+	changed := make(map[string]Transition, len(transitions))
+	for _, t := range transitions {
+		changed[t.Name] = t
+	}
+
+	for _, check := range r.Checks {
+		if effectiveStatus(check) == StatusOK {
+			if t, ok := changed[check.Name]; !ok || t.To != StatusOK {
+				continue
+			}
+		}
+		_, _ = fmt.Fprintf(w, "  %s  %s", statusIcon(check.Status), check.Name)
+		if check.Message != "" {
+			_, _ = fmt.Fprintf(w, "%s", ui.RenderMuted(" "+check.Message))
+		}
+		if t, ok := changed[check.Name]; ok {
+			_, _ = fmt.Fprintf(w, "  %s", renderTransition(t))
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+
+	_, _ = fmt.Fprintln(w, ui.RenderSeparator())
+	r.printSummary(w, 0)
+	if len(transitions) > 0 {
+		_, _ = fmt.Fprintf(w, "%s\n", ui.RenderMuted(fmt.Sprintf("%d check(s) changed since last run", len(transitions))))
+	}
+}
+
+// statusIcon returns the styled icon for a status.
+func statusIcon(s CheckStatus) string {
+	switch s {
+	case StatusWarning:
+		return ui.RenderWarnIcon()
+	case StatusError:
+		return ui.RenderFailIcon()
+	default:
+		return ui.RenderPassIcon()
+	}
+}
+
+// renderTransition formats a status change, e.g. "[OK → Error]".
+func renderTransition(t Transition) string {
+	if t.IsNew {
+		return ui.RenderAccent("[new]")
+	}
+	label := fmt.Sprintf("[%s → %s]", t.From, t.To)
+	switch {
+	case t.To == StatusOK:
+		return ui.RenderPass(label)
+	case t.Worsened():
+		return ui.RenderFail(label)
+	default:
+		return ui.RenderWarn(label)
+	}
+}
@@ -0,0 +1,81 @@
+package doctor
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func reportWith(results ...*CheckResult) *Report {
+	r := NewReport()
+	for _, c := range results {
+		r.Add(c)
+	}
+	return r
+}
+
+func TestDiffReports(t *testing.T) {
+	prev := reportWith(
+		&CheckResult{Name: "daemon", Status: StatusOK},
+		&CheckResult{Name: "routes", Status: StatusWarning},
+		&CheckResult{Name: "dolt", Status: StatusError},
+	)
+	cur := reportWith(
+		&CheckResult{Name: "daemon", Status: StatusError},
+		&CheckResult{Name: "routes", Status: StatusWarning},
+		&CheckResult{Name: "dolt", Status: StatusOK},
+		&CheckResult{Name: "added", Status: StatusWarning},
+		&CheckResult{Name: "added-ok", Status: StatusOK},
+	)
+
+	got := DiffReports(prev, cur)
+	if len(got) != 3 {
+		t.Fatalf("DiffReports() = %+v, want 3 transitions", got)
+	}
+	if got[0].Name != "daemon" || got[0].From != StatusOK || got[0].To != StatusError || !got[0].Worsened() {
+		t.Errorf("daemon transition = %+v", got[0])
+	}
+	if got[1].Name != "dolt" || got[1].Worsened() {
+		t.Errorf("dolt transition = %+v", got[1])
+	}
+	if got[2].Name != "added" || !got[2].IsNew {
+		t.Errorf("added transition = %+v", got[2])
+	}
+
+	errs := NewErrors(got)
+	if len(errs) != 1 || errs[0].Name != "daemon" {
+		t.Errorf("NewErrors() = %+v, want only daemon", errs)
+	}
+}
+
+func TestDiffReports_NilPrev(t *testing.T) {
+	cur := reportWith(&CheckResult{Name: "daemon", Status: StatusError})
+	if got := DiffReports(nil, cur); got != nil {
+		t.Errorf("DiffReports(nil, cur) = %+v, want nil", got)
+	}
+}
+
+func TestPrintWatchFrame(t *testing.T) {
+	prev := reportWith(
+		&CheckResult{Name: "daemon", Status: StatusError},
+		&CheckResult{Name: "quiet", Status: StatusOK},
+	)
+	cur := reportWith(
+		&CheckResult{Name: "daemon", Status: StatusOK, Message: "running"},
+		&CheckResult{Name: "quiet", Status: StatusOK},
+	)
+
+	var buf bytes.Buffer
+	PrintWatchFrame(&buf, cur, DiffReports(prev, cur))
+	out := buf.String()
+
+	if !strings.Contains(out, "daemon") || !strings.Contains(out, "Error → OK") {
+		t.Errorf("frame should show recovered check with transition:\n%s", out)
+	}
+	if strings.Contains(out, "quiet") {
+		t.Errorf("frame should hide unchanged OK checks:\n%s", out)
+	}
+	if !strings.Contains(out, "1 check(s) changed") {
+		t.Errorf("frame should count changes:\n%s", out)
+	}
+}
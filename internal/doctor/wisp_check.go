@@ -6,6 +6,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 // WispGCCheck detects and cleans orphaned wisps that are older than a threshold.
@@ -97,32 +99,16 @@ func (c *WispGCCheck) countAbandonedWisps(rigPath string) int {
 		return 0
 	}
 
-	var wisps []struct {
-		ID        string `json:"id"`
-		Status    string `json:"status"`
-		Ephemeral bool   `json:"ephemeral"`
-		UpdatedAt string `json:"updated_at"`
-	}
+	var wisps []*beads.Issue
 	if err := json.Unmarshal(output, &wisps); err != nil {
 		return 0
 	}
 
-	cutoff := time.Now().Add(-c.threshold)
-	count := 0
-	for _, w := range wisps {
-		if w.Status == "closed" {
-			continue
-		}
-		updatedAt, err := time.Parse(time.RFC3339, w.UpdatedAt)
-		if err != nil {
-			continue
-		}
-		if !updatedAt.IsZero() && updatedAt.Before(cutoff) {
-			count++
-		}
+	abandoned, err := beads.Query(fmt.Sprintf("status != closed AND updated_at < now()-%s", c.threshold))
+	if err != nil {
+		return 0
 	}
-
-	return count
+	return len(abandoned.Filter(wisps, beads.QueryEnv{}))
 }
 
 // Fix runs bd mol wisp gc in each rig with abandoned wisps.
@@ -55,6 +55,7 @@ func NewTownConfigValidCheck() *TownConfigValidCheck {
 			CheckName:        "town-config-valid",
 			CheckDescription: "Check that mayor/town.json is valid with required fields",
 			CheckCategory:    CategoryCore,
+			CheckDependsOn:   []string{"town-config-exists"},
 		},
 	}
 }
@@ -175,7 +176,7 @@ func (c *RigsRegistryExistsCheck) Fix(ctx *CheckContext) error {
 		return fmt.Errorf("marshaling empty rigs.json: %w", err)
 	}
 
-	return os.WriteFile(rigsPath, data, 0644)
+	return ctx.Tx.WriteFile(rigsPath, data, 0644)
 }
 
 // RigsRegistryValidCheck verifies mayor/rigs.json is valid and rigs exist.
@@ -192,6 +193,7 @@ func NewRigsRegistryValidCheck() *RigsRegistryValidCheck {
 				CheckName:        "rigs-registry-valid",
 				CheckDescription: "Check that registered rigs exist on disk",
 				CheckCategory:    CategoryCore,
+				CheckDependsOn:   []string{"rigs-registry-exists"},
 			},
 		},
 	}
@@ -310,7 +312,7 @@ func (c *RigsRegistryValidCheck) Fix(ctx *CheckContext) error {
 		return fmt.Errorf("marshaling rigs.json: %w", err)
 	}
 
-	return os.WriteFile(rigsPath, newData, 0644)
+	return ctx.Tx.WriteFile(rigsPath, newData, 0644)
 }
 
 // MayorExistsCheck verifies the mayor/ directory structure.
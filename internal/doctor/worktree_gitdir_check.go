@@ -235,8 +235,9 @@ func (c *WorktreeGitdirCheck) Fix(ctx *CheckContext) error {
 
 		// .repo.git exists but worktree entry is missing - re-create the worktree.
 		// First remove the broken .git file so git worktree add can create a fresh one.
+		// Both steps are staged so a failed add puts the .git file back.
 		gitFile := filepath.Join(bw.worktreePath, ".git")
-		if err := os.Remove(gitFile); err != nil {
+		if err := ctx.Tx.Remove(gitFile); err != nil {
 			lastErr = fmt.Errorf("%s: cannot remove broken .git file: %w", bw.worktreePath, err)
 			continue
 		}
@@ -251,10 +252,15 @@ func (c *WorktreeGitdirCheck) Fix(ctx *CheckContext) error {
 		}
 
 		// Re-create the worktree
-		cmd = exec.Command("git", "-C", bw.bareRepoPath, "worktree", "add", bw.worktreePath, branch)
-		if output, err := cmd.CombinedOutput(); err != nil {
-			lastErr = fmt.Errorf("%s: failed to re-create worktree: %v (%s)",
-				bw.worktreePath, err, strings.TrimSpace(string(output)))
+		bareRepoPath, worktreePath := bw.bareRepoPath, bw.worktreePath
+		if err := ctx.Tx.Do("re-create worktree "+worktreePath, func() error {
+			cmd := exec.Command("git", "-C", bareRepoPath, "worktree", "add", worktreePath, branch)
+			if output, err := cmd.CombinedOutput(); err != nil {
+				return fmt.Errorf("failed to re-create worktree: %v (%s)", err, strings.TrimSpace(string(output)))
+			}
+			return nil
+		}, nil); err != nil {
+			lastErr = fmt.Errorf("%s: %w", bw.worktreePath, err)
 		}
 	}
 
@@ -2308,6 +2308,99 @@ func EnsureAllMetadata(townRoot string) (updated []string, errs []error) {
 	return updated, errs
 }
 
+// RigDatabaseName returns the Dolt database a rig's beads use: dolt_database
+// from its metadata.json, or the rig name when unset.
+func RigDatabaseName(townRoot, rigName string) string {
+	var metadata struct {
+		DoltDatabase string `json:"dolt_database"`
+	}
+	if data, err := os.ReadFile(filepath.Join(FindRigBeadsDir(townRoot, rigName), "metadata.json")); err == nil {
+		_ = json.Unmarshal(data, &metadata)
+	}
+	if metadata.DoltDatabase != "" {
+		return metadata.DoltDatabase
+	}
+	return rigName
+}
+
+// DedicatedServerKey is the metadata.json field that marks a rig's
+// dolt_server_host and dolt_server_port as its own dedicated server rather
+// than the town server. bd commands run by gt leave GT_DOLT_PORT out of the
+// environment for such rigs, since it names the town server and would
+// override the address in metadata.json.
+const DedicatedServerKey = "dolt_server_dedicated"
+
+// SetRigServerAddr points a rig's beads at its dedicated Dolt server on
+// host:port by setting dolt_server_host and dolt_server_port in its
+// metadata.json and marking them with DedicatedServerKey. Other fields are
+// preserved. Returns whether the file changed.
+func SetRigServerAddr(townRoot, rigName, host string, port int) (bool, error) {
+	beadsDir, err := FindOrCreateRigBeadsDir(townRoot, rigName)
+	if err != nil {
+		return false, fmt.Errorf("resolving beads directory for rig %q: %w", rigName, err)
+	}
+	return updateServerAddr(beadsDir, func(existing map[string]interface{}) bool {
+		if h, _ := existing["dolt_server_host"].(string); h == host {
+			if p, ok := existing["dolt_server_port"].(float64); ok && int(p) == port {
+				if dedicated, _ := existing[DedicatedServerKey].(bool); dedicated {
+					return false
+				}
+			}
+		}
+		existing["dolt_server_host"] = host
+		existing["dolt_server_port"] = port
+		existing[DedicatedServerKey] = true
+		return true
+	})
+}
+
+// ResetRigServerAddr points a rig's beads back at the town Dolt server
+// after SetRigServerAddr moved them to a dedicated one. A rig that was not
+// moved is left alone. Returns whether the file changed.
+func ResetRigServerAddr(townRoot, rigName string) (bool, error) {
+	config := DefaultConfig(townRoot)
+	host := config.Host
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	return updateServerAddr(FindRigBeadsDir(townRoot, rigName), func(existing map[string]interface{}) bool {
+		if dedicated, _ := existing[DedicatedServerKey].(bool); !dedicated {
+			return false
+		}
+		existing["dolt_server_host"] = host
+		existing["dolt_server_port"] = config.Port
+		delete(existing, DedicatedServerKey)
+		return true
+	})
+}
+
+// updateServerAddr applies update to the metadata.json in beadsDir and
+// writes it back if update reports a change.
+func updateServerAddr(beadsDir string, update func(existing map[string]interface{}) bool) (bool, error) {
+	metadataPath := filepath.Join(beadsDir, "metadata.json")
+
+	mu := getMetadataMu(metadataPath)
+	mu.Lock()
+	defer mu.Unlock()
+
+	existing := make(map[string]interface{})
+	if data, err := os.ReadFile(metadataPath); err == nil {
+		_ = json.Unmarshal(data, &existing) // best effort
+	}
+	if !update(existing) {
+		return false, nil
+	}
+
+	data, err := json.MarshalIndent(existing, "", "  ")
+	if err != nil {
+		return false, fmt.Errorf("marshaling metadata: %w", err)
+	}
+	if err := util.AtomicWriteFile(metadataPath, append(data, '\n'), 0600); err != nil {
+		return false, fmt.Errorf("writing metadata.json: %w", err)
+	}
+	return true, nil
+}
+
 // FindRigBeadsDir returns the .beads directory path for a rig (read-only lookup).
 // For "hq", returns <townRoot>/.beads.
 // For other rigs, returns <townRoot>/<rigName>/mayor/rig/.beads if it exists,
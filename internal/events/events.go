@@ -5,8 +5,10 @@
 package events
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -46,14 +48,24 @@ const (
 	TypeBoot    = "boot"
 	TypeHalt    = "halt"
 
+	// Agent-to-agent handoff (gt handoff --to); one event per end
+	TypeHandoffSent     = "handoff_sent"
+	TypeHandoffReceived = "handoff_received"
+
 	// Session events (for seance discovery)
 	TypeSessionStart = "session_start"
 	TypeSessionEnd   = "session_end"
 
+	// Agent lifecycle transitions (gt agents stop/start/restart)
+	TypeAgentState = "agent_state"
+
 	// Session death events (for crash investigation)
 	TypeSessionDeath = "session_death" // Feed-visible session termination
 	TypeMassDeath    = "mass_death"    // Multiple sessions died in short window
 
+	// Mayor failover: the daemon promoted the Mayor's deputy
+	TypeMayorFailover = "mayor_failover"
+
 	// Witness patrol events
 	TypePatrolStarted   = "patrol_started"
 	TypePolecatChecked  = "polecat_checked"
@@ -74,6 +86,33 @@ const (
 	TypeSchedulerDispatch       = "scheduler_dispatch"        // Bead dispatched from scheduler
 	TypeSchedulerDispatchFailed = "scheduler_dispatch_failed" // Bead dispatch failed (requeued)
 	TypeSchedulerCloseRetry     = "scheduler_close_retry"     // Context close needed last-resort attempt
+
+	// Approval gate events
+	TypeApprovalRequested = "approval_requested"
+	TypeApprovalDecided   = "approval_decided"
+
+	// Session input guard events
+	TypeInputBlocked = "input_blocked"
+
+	// Wisp effort estimates (compared with actual cycle time by gt stats)
+	TypeEstimate = "estimate"
+
+	// Wisps handed back with a reason code (gt wisp abandon)
+	TypeWispAbandon = "wisp_abandon"
+
+	// Structured results returned by agents (see agentresult)
+	TypeAgentResult = "agent_result"
+
+	// Doctor fixes the daemon applied unattended (gt doctor autofix)
+	TypeDoctorAutofix = "doctor_autofix"
+
+	// Provider failover: an agent moved to its fallback, or back (gt failover)
+	TypeProviderFailover  = "provider_failover"
+	TypeProviderRecovered = "provider_recovered"
+
+	// Patrols switched off for a while and back on (gt patrol disable/enable)
+	TypePatrolDisabled = "patrol_disabled"
+	TypePatrolEnabled  = "patrol_enabled"
 )
 
 // EventsFile is the name of the raw events log.
@@ -148,6 +187,59 @@ func write(event Event) error {
 	return nil
 }
 
+// ReadAll reads every event from the town's raw events log, oldest first.
+// Malformed lines are skipped. A missing log yields no events.
+func ReadAll(townRoot string) ([]Event, error) {
+	return readUpTo(townRoot, -1)
+}
+
+// readUpTo reads the events in the first limit bytes of the log, or the
+// whole log when limit is negative.
+func readUpTo(townRoot string, limit int64) ([]Event, error) {
+	f, err := os.Open(filepath.Join(townRoot, EventsFile)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening events file: %w", err)
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if limit >= 0 {
+		r = io.LimitReader(f, limit)
+	}
+	var out []Event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out, scanner.Err()
+}
+
+// Time parses the event timestamp. Returns the zero time if malformed.
+func (e Event) Time() time.Time {
+	t, _ := time.Parse(time.RFC3339, e.Timestamp)
+	return t
+}
+
+// PayloadString returns a payload field as a string ("" if absent).
+func (e Event) PayloadString(key string) string {
+	v, ok := e.Payload[key]
+	if !ok || v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
+
 // Payload helpers for common event structures.
 
 // SlingPayload creates a payload for sling events.
@@ -176,6 +268,15 @@ func HandoffPayload(subject string, toSession bool) map[string]interface{} {
 	return p
 }
 
+// TransferPayload creates a payload for agent-to-agent handoff events.
+func TransferPayload(beadID, from, to string) map[string]interface{} {
+	return map[string]interface{}{
+		"bead": beadID,
+		"from": from,
+		"to":   to,
+	}
+}
+
 // DonePayload creates a payload for done events.
 func DonePayload(beadID, branch string) map[string]interface{} {
 	return map[string]interface{}{
@@ -323,6 +424,24 @@ func MassDeathPayload(count int, window string, sessions []string, possibleCause
 	return p
 }
 
+// MayorFailoverPayload creates a payload for mayor failover events.
+// lastSeen: when the Mayor was last seen alive, RFC 3339 ("" if never)
+// checkpoint: summary of the Mayor checkpoint handed to the deputy
+// errMsg: why the promotion failed ("" on success)
+func MayorFailoverPayload(lastSeen, checkpoint, errMsg string) map[string]interface{} {
+	p := map[string]interface{}{}
+	if lastSeen != "" {
+		p["last_seen"] = lastSeen
+	}
+	if checkpoint != "" {
+		p["checkpoint"] = checkpoint
+	}
+	if errMsg != "" {
+		p["error"] = errMsg
+	}
+	return p
+}
+
 // SessionPayload creates a payload for session start/end events.
 // sessionID: Claude Code session UUID
 // role: Gas Town role (e.g., "gastown/crew/joe", "deacon")
@@ -343,6 +462,24 @@ func SessionPayload(sessionID, role, topic, cwd string) map[string]interface{} {
 	return p
 }
 
+// AgentStatePayload creates a payload for agent lifecycle transitions.
+// agent is the agent address; from/to are lifecycle states (e.g., "running",
+// "stopping", "stopped"); bead is the hooked wisp, if any.
+func AgentStatePayload(agent, from, to, bead, reason string) map[string]interface{} {
+	p := map[string]interface{}{
+		"agent": agent,
+		"from":  from,
+		"to":    to,
+	}
+	if bead != "" {
+		p["bead"] = bead
+	}
+	if reason != "" {
+		p["reason"] = reason
+	}
+	return p
+}
+
 // SchedulerEnqueuePayload creates a payload for scheduler enqueue events.
 func SchedulerEnqueuePayload(beadID, rig string) map[string]interface{} {
 	return map[string]interface{}{
@@ -368,3 +505,83 @@ func SchedulerDispatchFailedPayload(beadID, rig, errMsg string) map[string]inter
 		"error": errMsg,
 	}
 }
+
+// ApprovalPayload creates a payload for approval gate events.
+// status is empty for requests and "approved", "denied", or "expired" for decisions.
+func ApprovalPayload(id, action, target, status string) map[string]interface{} {
+	p := map[string]interface{}{
+		"id":     id,
+		"action": action,
+		"target": target,
+	}
+	if status != "" {
+		p["status"] = status
+	}
+	return p
+}
+
+// InputBlockedPayload creates a payload for session input guard events.
+// outcome is "blocked", or "confirmed" when a human let a confirm rule's
+// match through; match is the offending text.
+func InputBlockedPayload(session, rule, action, outcome, match string) map[string]interface{} {
+	return map[string]interface{}{
+		"session": session,
+		"rule":    rule,
+		"action":  action,
+		"outcome": outcome,
+		"match":   match,
+	}
+}
+
+// EstimatePayload creates a payload for wisp effort estimate events.
+func EstimatePayload(beadID string, estimate time.Duration) map[string]interface{} {
+	return map[string]interface{}{
+		"bead":     beadID,
+		"estimate": estimate.String(),
+	}
+}
+
+// WispAbandonPayload creates a payload for an abandoned wisp. agent is who
+// held it; outcome is requeued or closed; patch is the saved partial work.
+func WispAbandonPayload(beadID, agent, reason, outcome, patch string) map[string]interface{} {
+	p := map[string]interface{}{
+		"bead":    beadID,
+		"reason":  reason,
+		"outcome": outcome,
+	}
+	if agent != "" {
+		p["agent"] = agent
+	}
+	if patch != "" {
+		p["patch"] = patch
+	}
+	return p
+}
+
+// AgentResultPayload creates a payload for a routed agent result.
+func AgentResultPayload(agent, kind, bead string, created []string) map[string]interface{} {
+	p := map[string]interface{}{
+		"agent": agent,
+		"kind":  kind,
+	}
+	if bead != "" {
+		p["bead"] = bead
+	}
+	if len(created) > 0 {
+		p["created"] = created
+	}
+	return p
+}
+
+// PatrolDisablePayload creates a payload for patrol disable and enable events.
+// until is when a disable lapses; zero for enables.
+func PatrolDisablePayload(patrol, reason string, until time.Time) map[string]interface{} {
+	p := map[string]interface{}{"patrol": patrol}
+	if reason != "" {
+		p["reason"] = reason
+	}
+	if !until.IsZero() {
+		p["until"] = until.UTC().Format(time.RFC3339)
+	}
+	return p
+}
@@ -1,6 +1,8 @@
 package events
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -257,3 +259,49 @@ func TestSessionPayload_Minimal(t *testing.T) {
 		t.Error("expected no cwd key when empty")
 	}
 }
+
+func TestAgentStatePayload(t *testing.T) {
+	p := AgentStatePayload("gastown/polecats/Toast", "running", "stopping", "gt-1", "gt agents stop")
+	if p["agent"] != "gastown/polecats/Toast" || p["from"] != "running" || p["to"] != "stopping" {
+		t.Errorf("unexpected payload: %v", p)
+	}
+	if p["bead"] != "gt-1" || p["reason"] != "gt agents stop" {
+		t.Errorf("expected bead and reason, got %v", p)
+	}
+	p = AgentStatePayload("mayor", "stopped", "running", "", "")
+	if _, ok := p["bead"]; ok {
+		t.Error("expected no bead key when empty")
+	}
+}
+
+func TestReadAll(t *testing.T) {
+	townRoot := t.TempDir()
+	if evs, err := ReadAll(townRoot); err != nil || evs != nil {
+		t.Fatalf("ReadAll(missing) = %v, %v; want nil, nil", evs, err)
+	}
+
+	data := `{"ts":"2026-01-02T03:04:05Z","type":"hook","actor":"gastown/polecats/Toast","payload":{"bead":"gt-1"}}
+not json
+{"ts":"bad","type":"done","payload":{"count":3}}
+`
+	if err := os.WriteFile(filepath.Join(townRoot, EventsFile), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	evs, err := ReadAll(townRoot)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if len(evs) != 2 {
+		t.Fatalf("ReadAll returned %d events, want 2 (malformed line skipped)", len(evs))
+	}
+	if evs[0].PayloadString("bead") != "gt-1" || evs[0].Time().Year() != 2026 {
+		t.Errorf("first event = %+v", evs[0])
+	}
+	if !evs[1].Time().IsZero() {
+		t.Error("malformed timestamp should parse to zero time")
+	}
+	if evs[1].PayloadString("count") != "3" || evs[1].PayloadString("missing") != "" {
+		t.Errorf("PayloadString conversions wrong: %+v", evs[1].Payload)
+	}
+}
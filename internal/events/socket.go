@@ -0,0 +1,240 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ErrNoStreamServer is returned by Subscribe when the daemon's event socket
+// is not available (daemon stopped, or an older daemon without it).
+var ErrNoStreamServer = errors.New("event stream socket not available")
+
+// subscriberBuffer is how many events a slow subscriber may lag before the
+// server drops it rather than stall everyone else.
+const subscriberBuffer = 256
+
+// subscriberWriteTimeout bounds each write to a subscriber, so a client that
+// stops reading is dropped instead of holding its connection open.
+const subscriberWriteTimeout = 10 * time.Second
+
+// SocketPath returns the daemon's event stream socket.
+func SocketPath(townRoot string) string {
+	return filepath.Join(townRoot, "daemon", "events.sock")
+}
+
+// SubscribeRequest is the first (and only) line a client sends on the event
+// socket. The server then streams matching events as NDJSON, one Event per
+// line, until either side hangs up.
+type SubscribeRequest struct {
+	Filter
+
+	// Backlog asks for up to this many recent matching events before the
+	// live stream starts.
+	Backlog int `json:"backlog,omitempty"`
+}
+
+// StreamServer fans the town's events log out to socket subscribers, so
+// scripts can react to events without polling the log themselves.
+type StreamServer struct {
+	townRoot string
+	ln       net.Listener
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+
+	mu   sync.Mutex
+	subs map[*subscriber]struct{}
+
+	// offset is how far into the events log broadcast has delivered. A new
+	// subscriber's backlog is read up to it, so no event is both in the
+	// backlog and streamed live.
+	offset int64
+}
+
+type subscriber struct {
+	filter Filter
+	ch     chan Event
+	done   chan struct{}
+	once   sync.Once
+}
+
+func (s *subscriber) close() { s.once.Do(func() { close(s.done) }) }
+
+// ListenAndServe starts the event stream server on SocketPath. A stale socket
+// left by a crashed daemon is replaced. Call Close to stop it.
+func ListenAndServe(townRoot string) (*StreamServer, error) {
+	sockPath := SocketPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(sockPath), 0755); err != nil {
+		return nil, fmt.Errorf("creating socket dir: %w", err)
+	}
+	_ = os.Remove(sockPath)
+	ln, err := net.Listen("unix", sockPath)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", sockPath, err)
+	}
+	_ = os.Chmod(sockPath, 0600) // events name agents and beads; owner only
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &StreamServer{townRoot: townRoot, ln: ln, cancel: cancel, subs: make(map[*subscriber]struct{}),
+		offset: logSize(townRoot)}
+	s.wg.Add(2)
+	go s.broadcast(ctx)
+	go s.accept()
+	return s, nil
+}
+
+// Close stops the server and disconnects all subscribers.
+func (s *StreamServer) Close() {
+	s.cancel()
+	_ = s.ln.Close()
+	s.mu.Lock()
+	for sub := range s.subs {
+		sub.close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+	_ = os.Remove(SocketPath(s.townRoot))
+}
+
+// broadcast follows the events log once for all subscribers.
+func (s *StreamServer) broadcast(ctx context.Context) {
+	defer s.wg.Done()
+	s.mu.Lock()
+	start := s.offset
+	s.mu.Unlock()
+	_ = followFrom(ctx, s.townRoot, start, Filter{}, func(e Event, offset int64) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.offset = offset
+		for sub := range s.subs {
+			if !sub.filter.Match(e) {
+				continue
+			}
+			select {
+			case sub.ch <- e:
+			default:
+				sub.close() // too far behind; the client can reconnect
+				delete(s.subs, sub)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *StreamServer) accept() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return // listener closed
+		}
+		s.wg.Add(1)
+		go s.serve(conn)
+	}
+}
+
+func (s *StreamServer) serve(conn net.Conn) {
+	defer s.wg.Done()
+	defer conn.Close()
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var req SubscribeRequest
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	if err != nil || json.Unmarshal(line, &req) != nil {
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	sub := &subscriber{filter: req.Filter, ch: make(chan Event, subscriberBuffer), done: make(chan struct{})}
+	s.mu.Lock()
+	s.subs[sub] = struct{}{}
+	backlogEnd := s.offset
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.subs, sub)
+		s.mu.Unlock()
+		sub.close()
+	}()
+
+	// Notice the client hanging up even when no events are flowing, and
+	// unblock a pending write when the subscriber is dropped or the server
+	// closes.
+	go func() {
+		_, _ = conn.Read(make([]byte, 1))
+		sub.close()
+	}()
+	go func() {
+		<-sub.done
+		_ = conn.Close()
+	}()
+
+	enc := json.NewEncoder(conn)
+	send := func(e Event) bool {
+		_ = conn.SetWriteDeadline(time.Now().Add(subscriberWriteTimeout))
+		return enc.Encode(e) == nil
+	}
+	// Events past backlogEnd arrive on sub.ch instead.
+	if backlog, err := recentUpTo(s.townRoot, req.Filter, req.Backlog, backlogEnd); err == nil {
+		for _, e := range backlog {
+			if !send(e) {
+				return
+			}
+		}
+	}
+	for {
+		select {
+		case <-sub.done:
+			return
+		case e := <-sub.ch:
+			if !send(e) {
+				return
+			}
+		}
+	}
+}
+
+// Subscribe connects to the daemon's event socket and calls fn for each
+// streamed event until ctx is done, the server hangs up, or fn errors.
+// Returns ErrNoStreamServer if the socket cannot be reached.
+func Subscribe(ctx context.Context, townRoot string, req SubscribeRequest, fn func(Event) error) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", SocketPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrNoStreamServer, err)
+	}
+	defer conn.Close()
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return fmt.Errorf("sending subscribe request: %w", err)
+	}
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Event
+		if json.Unmarshal(scanner.Bytes(), &e) != nil {
+			continue
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading event stream: %w", err)
+	}
+	return nil
+}
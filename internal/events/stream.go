@@ -0,0 +1,151 @@
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+// followPoll is how often Follow checks the events log for new lines.
+const followPoll = 100 * time.Millisecond
+
+// Filter selects events for a stream. Empty fields match everything.
+type Filter struct {
+	// Types are event types to include; glob patterns like "merge_*" work.
+	Types []string `json:"types,omitempty"`
+
+	// Actor restricts events to one actor (e.g., "gastown/witness").
+	Actor string `json:"actor,omitempty"`
+}
+
+// Match reports whether e passes the filter.
+func (f Filter) Match(e Event) bool {
+	if f.Actor != "" && e.Actor != f.Actor {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if ok, _ := path.Match(t, e.Type); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Recent returns the last n events matching filter, oldest first.
+func Recent(townRoot string, filter Filter, n int) ([]Event, error) {
+	return recentUpTo(townRoot, filter, n, -1)
+}
+
+// recentUpTo is Recent over the first limit bytes of the log, or the whole
+// log when limit is negative.
+func recentUpTo(townRoot string, filter Filter, n int, limit int64) ([]Event, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	all, err := readUpTo(townRoot, limit)
+	if err != nil {
+		return nil, err
+	}
+	var out []Event
+	for i := len(all) - 1; i >= 0 && len(out) < n; i-- {
+		if filter.Match(all[i]) {
+			out = append(out, all[i])
+		}
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out, nil
+}
+
+// Follow calls fn for each matching event appended to the town's events log
+// after Follow starts, until ctx is done or fn returns an error. It tolerates
+// a missing log (waits for it) and truncation (restarts from the top).
+func Follow(ctx context.Context, townRoot string, filter Filter, fn func(Event) error) error {
+	return followFrom(ctx, townRoot, logSize(townRoot), filter, func(e Event, _ int64) error {
+		return fn(e)
+	})
+}
+
+// logSize returns the size of the events log, or 0 if there is none.
+func logSize(townRoot string) int64 {
+	if info, err := os.Stat(filepath.Join(townRoot, EventsFile)); err == nil {
+		return info.Size()
+	}
+	return 0
+}
+
+// followFrom is Follow starting at byte offset of the log. fn also gets the
+// offset just past each event.
+func followFrom(ctx context.Context, townRoot string, offset int64, filter Filter, fn func(Event, int64) error) error {
+	eventsPath := filepath.Join(townRoot, EventsFile)
+	var (
+		f       *os.File
+		reader  *bufio.Reader
+		partial []byte
+	)
+	defer func() {
+		if f != nil {
+			_ = f.Close()
+		}
+	}()
+
+	ticker := time.NewTicker(followPoll)
+	defer ticker.Stop()
+	for {
+		if f == nil {
+			opened, err := os.Open(eventsPath) //nolint:gosec // G304: path is constructed internally
+			if err == nil {
+				if _, err := opened.Seek(offset, io.SeekStart); err != nil {
+					_ = opened.Close()
+					return fmt.Errorf("seeking events file: %w", err)
+				}
+				f, reader = opened, bufio.NewReader(opened)
+			}
+		}
+		if f != nil {
+			if info, err := os.Stat(eventsPath); err != nil || info.Size() < offset {
+				// Rotated or truncated: start over on the new file.
+				_ = f.Close()
+				f, reader, offset, partial = nil, nil, 0, nil
+				continue
+			}
+			for {
+				line, err := reader.ReadBytes('\n')
+				offset += int64(len(line))
+				if err != nil {
+					// Keep an incomplete trailing line until the writer finishes it.
+					partial = append(partial, line...)
+					break
+				}
+				if len(partial) > 0 {
+					line = append(partial, line...)
+					partial = nil
+				}
+				var e Event
+				if json.Unmarshal(bytes.TrimSpace(line), &e) != nil || !filter.Match(e) {
+					continue
+				}
+				if err := fn(e, offset); err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func appendEvent(t *testing.T, townRoot string, e Event) {
+	t.Helper()
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(filepath.Join(townRoot, EventsFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		t.Fatal(err)
+	}
+}
+
+var errStop = errors.New("stop")
+
+func TestFilterMatch(t *testing.T) {
+	e := Event{Type: TypeMergeFailed, Actor: "gastown/refinery"}
+	for _, tc := range []struct {
+		f    Filter
+		want bool
+	}{
+		{Filter{}, true},
+		{Filter{Types: []string{"merge_*"}}, true},
+		{Filter{Types: []string{TypeMerged}}, false},
+		{Filter{Actor: "gastown/witness"}, false},
+	} {
+		if got := tc.f.Match(e); got != tc.want {
+			t.Errorf("%+v.Match = %v, want %v", tc.f, got, tc.want)
+		}
+	}
+}
+
+func TestFollow_NewEventsOnly(t *testing.T) {
+	townRoot := t.TempDir()
+	appendEvent(t, townRoot, Event{Type: TypeMerged, Actor: "old"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go func() {
+		time.Sleep(3 * followPoll)
+		appendEvent(t, townRoot, Event{Type: TypeSling, Actor: "skip"})
+		appendEvent(t, townRoot, Event{Type: TypeMerged, Actor: "new"})
+	}()
+
+	var got []string
+	err := Follow(ctx, townRoot, Filter{Types: []string{TypeMerged}}, func(e Event) error {
+		got = append(got, e.Actor)
+		return errStop
+	})
+	if !errors.Is(err, errStop) || len(got) != 1 || got[0] != "new" {
+		t.Errorf("Follow = %v, got %q; want only the new merged event", err, got)
+	}
+}
+
+func TestStreamServer_Subscribe(t *testing.T) {
+	townRoot := t.TempDir()
+	appendEvent(t, townRoot, Event{Type: TypeMerged, Actor: "backlog"})
+
+	srv, err := ListenAndServe(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go func() {
+		time.Sleep(3 * followPoll)
+		appendEvent(t, townRoot, Event{Type: TypeMerged, Actor: "live"})
+	}()
+
+	var got []string
+	err = Subscribe(ctx, townRoot, SubscribeRequest{Filter: Filter{Types: []string{TypeMerged}}, Backlog: 5}, func(e Event) error {
+		got = append(got, e.Actor)
+		if len(got) == 2 {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) || len(got) != 2 || got[0] != "backlog" || got[1] != "live" {
+		t.Errorf("Subscribe = %v, got %q; want backlog then live", err, got)
+	}
+
+	srv.Close()
+	if err := Subscribe(context.Background(), townRoot, SubscribeRequest{}, func(Event) error { return nil }); !errors.Is(err, ErrNoStreamServer) {
+		t.Errorf("Subscribe after Close = %v, want ErrNoStreamServer", err)
+	}
+}
+
+func TestStreamServer_BacklogNotRepeatedLive(t *testing.T) {
+	townRoot := t.TempDir()
+	appendEvent(t, townRoot, Event{Type: TypeMerged, Actor: "old"})
+
+	srv, err := ListenAndServe(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	// Written between two polls of the log: the event is in the file when
+	// the backlog is read and still due to be broadcast.
+	time.Sleep(followPoll + followPoll/2)
+	appendEvent(t, townRoot, Event{Type: TypeMerged, Actor: "racing"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go func() {
+		time.Sleep(3 * followPoll)
+		appendEvent(t, townRoot, Event{Type: TypeMerged, Actor: "live"})
+	}()
+
+	var got []string
+	err = Subscribe(ctx, townRoot, SubscribeRequest{Backlog: 5}, func(e Event) error {
+		got = append(got, e.Actor)
+		if e.Actor == "live" {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) || strings.Join(got, ",") != "old,racing,live" {
+		t.Errorf("Subscribe = %v, got %q; want each event once", err, got)
+	}
+}
@@ -0,0 +1,200 @@
+// Package experiment runs A/B experiments comparing prime-template or model
+// variants across wisps.
+//
+// An experiment defines exactly two variants and a split. When sling spawns a
+// polecat for a wisp in the experiment's scope, the wisp is deterministically
+// assigned a variant (by hashing the bead ID), the variant is applied to the
+// polecat session, and the assignment is appended to a durable ledger so
+// outcomes can be compared later with gt experiment report.
+package experiment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Experiment kinds.
+const (
+	KindPrompt = "prompt" // Variants supply a polecat prime template file
+	KindModel  = "model"  // Variants supply an agent/runtime alias
+)
+
+// Experiment statuses.
+const (
+	StatusActive  = "active"
+	StatusStopped = "stopped"
+)
+
+// EnvVar carries "<experiment>/<variant>" into a polecat session so prime and
+// telemetry can pick up the assigned variant.
+const EnvVar = "GT_EXPERIMENT"
+
+// DefaultSplit is the percentage of wisps assigned to the first variant.
+const DefaultSplit = 50
+
+var (
+	// ErrNotFound is returned when an experiment does not exist.
+	ErrNotFound = errors.New("experiment not found")
+	// ErrExists is returned when creating an experiment whose name is taken.
+	ErrExists = errors.New("experiment already exists")
+)
+
+// Variant is one arm of an experiment.
+type Variant struct {
+	Name string `json:"name"`
+	// Value is a template path (relative to the town root) for prompt
+	// experiments, or an agent alias (e.g., "claude-haiku") for model ones.
+	Value string `json:"value"`
+}
+
+// Experiment is an A/B comparison between two variants.
+type Experiment struct {
+	Name     string     `json:"name"`
+	Kind     string     `json:"kind"`
+	Rig      string     `json:"rig,omitempty"` // Empty = all rigs
+	Split    int        `json:"split"`         // Percent of wisps assigned to Variants[0]
+	Variants [2]Variant `json:"variants"`
+	Status   string     `json:"status"`
+	Created  time.Time  `json:"created"`
+	Stopped  *time.Time `json:"stopped,omitempty"`
+}
+
+// Validate checks the experiment definition.
+func (e *Experiment) Validate() error {
+	if e.Name == "" || strings.ContainsAny(e.Name, "/ ") {
+		return fmt.Errorf("invalid experiment name %q", e.Name)
+	}
+	if e.Kind != KindPrompt && e.Kind != KindModel {
+		return fmt.Errorf("invalid kind %q (want %s or %s)", e.Kind, KindPrompt, KindModel)
+	}
+	if e.Split < 0 || e.Split > 100 {
+		return fmt.Errorf("split must be between 0 and 100, got %d", e.Split)
+	}
+	for i, v := range e.Variants {
+		if v.Name == "" || v.Value == "" {
+			return fmt.Errorf("variant %d needs a name and a value", i+1)
+		}
+	}
+	if e.Variants[0].Name == e.Variants[1].Name {
+		return fmt.Errorf("variant names must differ")
+	}
+	return nil
+}
+
+// Assign returns the variant for a bead. Assignment is a stable hash of the
+// experiment name and bead ID, so re-slinging a wisp keeps its variant.
+func (e *Experiment) Assign(bead string) Variant {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(e.Name + "/" + bead))
+	if int(h.Sum32()%100) < e.Split {
+		return e.Variants[0]
+	}
+	return e.Variants[1]
+}
+
+// Variant returns the named variant.
+func (e *Experiment) Variant(name string) (Variant, bool) {
+	for _, v := range e.Variants {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return Variant{}, false
+}
+
+// Tag returns the EnvVar value for a variant of this experiment.
+func (e *Experiment) Tag(v Variant) string {
+	return e.Name + "/" + v.Name
+}
+
+// ParseTag splits an EnvVar value into experiment and variant names.
+func ParseTag(tag string) (experiment, variant string, ok bool) {
+	experiment, variant, ok = strings.Cut(tag, "/")
+	if !ok || experiment == "" || variant == "" {
+		return "", "", false
+	}
+	return experiment, variant, true
+}
+
+// Store is the persisted set of experiments.
+type Store struct {
+	Experiments []*Experiment `json:"experiments"`
+}
+
+// StorePath returns the path of the town's experiment definitions.
+func StorePath(townRoot string) string {
+	return filepath.Join(townRoot, "settings", "experiments.json")
+}
+
+// LoadStore reads experiment definitions. A missing file is an empty store.
+func LoadStore(townRoot string) (*Store, error) {
+	data, err := os.ReadFile(StorePath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Store{}, nil
+		}
+		return nil, fmt.Errorf("reading experiments: %w", err)
+	}
+	var s Store
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parsing experiments: %w", err)
+	}
+	return &s, nil
+}
+
+// SaveStore writes experiment definitions.
+func SaveStore(townRoot string, s *Store) error {
+	sort.Slice(s.Experiments, func(i, j int) bool { return s.Experiments[i].Name < s.Experiments[j].Name })
+	return util.EnsureDirAndWriteJSON(StorePath(townRoot), s)
+}
+
+// Get returns the named experiment.
+func (s *Store) Get(name string) (*Experiment, error) {
+	for _, e := range s.Experiments {
+		if e.Name == name {
+			return e, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Add validates and adds a new experiment.
+func (s *Store) Add(e *Experiment) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+	if _, err := s.Get(e.Name); err == nil {
+		return fmt.Errorf("%w: %s", ErrExists, e.Name)
+	}
+	s.Experiments = append(s.Experiments, e)
+	return nil
+}
+
+// ActiveFor returns the active experiment covering rig, or nil. When several
+// match, a rig-scoped experiment wins over a town-wide one, then the oldest.
+func (s *Store) ActiveFor(rig string) *Experiment {
+	var best *Experiment
+	for _, e := range s.Experiments {
+		if e.Status != StatusActive || (e.Rig != "" && e.Rig != rig) {
+			continue
+		}
+		switch {
+		case best == nil:
+			best = e
+		case best.Rig == "" && e.Rig != "":
+			best = e
+		case (best.Rig == "") == (e.Rig == "") && e.Created.Before(best.Created):
+			best = e
+		}
+	}
+	return best
+}
@@ -0,0 +1,162 @@
+package experiment
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/stats"
+)
+
+func newExperiment(name string, split int) *Experiment {
+	return &Experiment{
+		Name:  name,
+		Kind:  KindModel,
+		Split: split,
+		Variants: [2]Variant{
+			{Name: "control", Value: "claude"},
+			{Name: "haiku", Value: "claude-haiku"},
+		},
+		Status:  StatusActive,
+		Created: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestAssignIsStableAndHonorsSplit(t *testing.T) {
+	e := newExperiment("cheap", 50)
+	counts := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		bead := "gt-" + string(rune('a'+i%26)) + string(rune('a'+i/26))
+		v := e.Assign(bead)
+		if again := e.Assign(bead); again != v {
+			t.Fatalf("Assign(%s) not stable: %v then %v", bead, v, again)
+		}
+		counts[v.Name]++
+	}
+	if counts["control"] < 400 || counts["haiku"] < 400 {
+		t.Errorf("50/50 split produced %v", counts)
+	}
+
+	all := newExperiment("all", 100)
+	if v := all.Assign("gt-x"); v.Name != "control" {
+		t.Errorf("split 100 assigned %s", v.Name)
+	}
+	none := newExperiment("none", 0)
+	if v := none.Assign("gt-x"); v.Name != "haiku" {
+		t.Errorf("split 0 assigned %s", v.Name)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	bad := []*Experiment{
+		{Name: "a/b", Kind: KindModel, Variants: [2]Variant{{"x", "1"}, {"y", "2"}}},
+		{Name: "k", Kind: "color", Variants: [2]Variant{{"x", "1"}, {"y", "2"}}},
+		{Name: "s", Kind: KindModel, Split: 101, Variants: [2]Variant{{"x", "1"}, {"y", "2"}}},
+		{Name: "v", Kind: KindPrompt, Variants: [2]Variant{{"x", ""}, {"y", "2"}}},
+		{Name: "d", Kind: KindPrompt, Variants: [2]Variant{{"x", "1"}, {"x", "2"}}},
+	}
+	for _, e := range bad {
+		if err := e.Validate(); err == nil {
+			t.Errorf("Validate(%s) should fail", e.Name)
+		}
+	}
+	if err := newExperiment("ok", 50).Validate(); err != nil {
+		t.Errorf("Validate(ok) = %v", err)
+	}
+}
+
+func TestStoreRoundTripAndActiveFor(t *testing.T) {
+	townRoot := t.TempDir()
+	s, err := LoadStore(townRoot)
+	if err != nil || len(s.Experiments) != 0 {
+		t.Fatalf("LoadStore(empty) = %+v, %v", s, err)
+	}
+
+	town := newExperiment("town", 50)
+	scoped := newExperiment("scoped", 50)
+	scoped.Rig = "gastown"
+	stopped := newExperiment("stopped", 50)
+	stopped.Rig = "beads"
+	stopped.Status = StatusStopped
+	for _, e := range []*Experiment{town, scoped, stopped} {
+		if err := s.Add(e); err != nil {
+			t.Fatalf("Add(%s): %v", e.Name, err)
+		}
+	}
+	if err := s.Add(newExperiment("town", 50)); !errors.Is(err, ErrExists) {
+		t.Errorf("duplicate Add = %v, want ErrExists", err)
+	}
+	if err := SaveStore(townRoot, s); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = LoadStore(townRoot)
+	if err != nil || len(s.Experiments) != 3 {
+		t.Fatalf("LoadStore() = %+v, %v", s, err)
+	}
+	if e := s.ActiveFor("gastown"); e == nil || e.Name != "scoped" {
+		t.Errorf("ActiveFor(gastown) = %+v, want scoped", e)
+	}
+	if e := s.ActiveFor("beads"); e == nil || e.Name != "town" {
+		t.Errorf("ActiveFor(beads) = %+v, want town (stopped is ignored)", e)
+	}
+	if _, err := s.Get("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(missing) = %v, want ErrNotFound", err)
+	}
+}
+
+func TestParseTag(t *testing.T) {
+	if e, v, ok := ParseTag("cheap/haiku"); !ok || e != "cheap" || v != "haiku" {
+		t.Errorf("ParseTag = %q, %q, %v", e, v, ok)
+	}
+	for _, bad := range []string{"", "cheap", "/haiku", "cheap/"} {
+		if _, _, ok := ParseTag(bad); ok {
+			t.Errorf("ParseTag(%q) should fail", bad)
+		}
+	}
+}
+
+func TestBuildReport(t *testing.T) {
+	townRoot := t.TempDir()
+	e := newExperiment("cheap", 50)
+	now := time.Now()
+	for _, a := range []Assignment{
+		{Experiment: "cheap", Variant: "control", Bead: "gt-1", At: now},
+		{Experiment: "cheap", Variant: "haiku", Bead: "gt-2", At: now},
+		{Experiment: "cheap", Variant: "control", Bead: "gt-2", At: now.Add(time.Minute)}, // re-sling wins
+		{Experiment: "cheap", Variant: "haiku", Bead: "gt-3", At: now},
+		{Experiment: "other", Variant: "x", Bead: "gt-9", At: now},
+	} {
+		if err := Record(townRoot, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assignments, err := LoadAssignments(townRoot, "cheap")
+	if err != nil || len(assignments) != 3 {
+		t.Fatalf("LoadAssignments() = %+v, %v", assignments, err)
+	}
+
+	done := func(bead string) *stats.Timeline {
+		return &stats.Timeline{Bead: bead, Claimed: now, Completed: now.Add(time.Hour)}
+	}
+	merge := func(typ, bead string) events.Event {
+		p := events.MergePayload("mr-"+bead, "Toast", "polecat/Toast", "")
+		p["bead"] = bead
+		return events.Event{Type: typ, Actor: "gastown/refinery", Payload: p}
+	}
+	r := BuildReport(e, assignments,
+		[]*stats.Timeline{done("gt-1"), done("gt-2")},
+		[]events.Event{merge(events.TypeMerged, "gt-1"), merge(events.TypeMergeFailed, "gt-2")},
+		map[string]float64{"gt-1": 2, "gt-2": 1, "gt-3": 0.5},
+	)
+
+	control, haiku := r.Variants[0], r.Variants[1]
+	if control.Assigned != 2 || control.Completed != 2 || control.CompletionRate != 1 ||
+		control.ReviewPassRate != 0.5 || control.CostPerWisp != 1.5 {
+		t.Errorf("control = %+v", control)
+	}
+	if haiku.Assigned != 1 || haiku.Completed != 0 || haiku.CompletionRate != 0 || haiku.CostUSD != 0.5 {
+		t.Errorf("haiku = %+v", haiku)
+	}
+}
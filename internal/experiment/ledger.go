@@ -0,0 +1,118 @@
+package experiment
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Assignment records that a wisp was run under a variant.
+type Assignment struct {
+	Experiment string    `json:"experiment"`
+	Variant    string    `json:"variant"`
+	Bead       string    `json:"bead"`
+	Agent      string    `json:"agent"`
+	At         time.Time `json:"at"`
+}
+
+// LedgerPath returns the path of the assignment ledger.
+func LedgerPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "experiments", "assignments.jsonl")
+}
+
+// Record appends an assignment to the ledger.
+func Record(townRoot string, a Assignment) error {
+	path := LedgerPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating experiments directory: %w", err)
+	}
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("encoding assignment: %w", err)
+	}
+	data = append(data, '\n')
+
+	fl := flock.New(path + ".lock")
+	if err := fl.Lock(); err != nil {
+		return fmt.Errorf("acquiring ledger lock: %w", err)
+	}
+	defer fl.Unlock() //nolint:errcheck // best-effort unlock
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: assignments are non-sensitive
+	if err != nil {
+		return fmt.Errorf("opening ledger: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing ledger: %w", err)
+	}
+	return f.Close()
+}
+
+// LoadAssignments returns the assignments for an experiment. When a bead was
+// assigned more than once (re-sling), the latest assignment wins.
+func LoadAssignments(townRoot, experiment string) ([]Assignment, error) {
+	all, err := readLedger(townRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int)
+	var out []Assignment
+	for _, a := range all {
+		if a.Experiment != experiment {
+			continue
+		}
+		if i, ok := index[a.Bead]; ok {
+			out[i] = a
+			continue
+		}
+		index[a.Bead] = len(out)
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+// AssignmentsForBead returns every assignment recorded for a bead, oldest first.
+func AssignmentsForBead(townRoot, bead string) ([]Assignment, error) {
+	all, err := readLedger(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	var out []Assignment
+	for _, a := range all {
+		if a.Bead == bead {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// readLedger reads every well-formed assignment in ledger order.
+func readLedger(townRoot string) ([]Assignment, error) {
+	f, err := os.Open(LedgerPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening ledger: %w", err)
+	}
+	defer f.Close()
+
+	var out []Assignment
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var a Assignment
+		if err := json.Unmarshal(scanner.Bytes(), &a); err != nil || a.Bead == "" {
+			continue
+		}
+		out = append(out, a)
+	}
+	return out, scanner.Err()
+}
@@ -0,0 +1,79 @@
+package experiment
+
+import (
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/stats"
+)
+
+// VariantReport compares outcomes for one variant.
+type VariantReport struct {
+	Variant        string  `json:"variant"`
+	Value          string  `json:"value"`
+	Assigned       int     `json:"assigned"`
+	Completed      int     `json:"completed"`
+	CompletionRate float64 `json:"completion_rate"`
+	Merged         int     `json:"merged"`
+	MergeFailed    int     `json:"merge_failed"`
+	ReviewPassRate float64 `json:"review_pass_rate"`
+	CostUSD        float64 `json:"cost_usd"`
+	CostPerWisp    float64 `json:"cost_per_wisp"`
+}
+
+// Report is the outcome comparison for an experiment.
+type Report struct {
+	Experiment *Experiment      `json:"experiment"`
+	Variants   [2]VariantReport `json:"variants"`
+}
+
+// BuildReport joins assignments with wisp timelines, merge outcomes from the
+// event log (matched on the merge event's bead), and per-bead session costs.
+func BuildReport(e *Experiment, assignments []Assignment, timelines []*stats.Timeline, evs []events.Event, costByBead map[string]float64) *Report {
+	r := &Report{Experiment: e}
+	variantOf := make(map[string]int, len(assignments))
+	for i, v := range e.Variants {
+		r.Variants[i] = VariantReport{Variant: v.Name, Value: v.Value}
+	}
+	for _, a := range assignments {
+		for i, v := range e.Variants {
+			if a.Variant == v.Name {
+				variantOf[a.Bead] = i
+				r.Variants[i].Assigned++
+				r.Variants[i].CostUSD += costByBead[a.Bead]
+			}
+		}
+	}
+
+	for _, t := range timelines {
+		if i, ok := variantOf[t.Bead]; ok && t.IsComplete() {
+			r.Variants[i].Completed++
+		}
+	}
+	for _, ev := range evs {
+		if ev.Type != events.TypeMerged && ev.Type != events.TypeMergeFailed {
+			continue
+		}
+		i, ok := variantOf[ev.PayloadString("bead")]
+		if !ok {
+			continue
+		}
+		if ev.Type == events.TypeMerged {
+			r.Variants[i].Merged++
+		} else {
+			r.Variants[i].MergeFailed++
+		}
+	}
+
+	for i := range r.Variants {
+		v := &r.Variants[i]
+		if v.Assigned > 0 {
+			v.CompletionRate = float64(v.Completed) / float64(v.Assigned)
+		}
+		if v.Completed > 0 {
+			v.CostPerWisp = v.CostUSD / float64(v.Completed)
+		}
+		if reviewed := v.Merged + v.MergeFailed; reviewed > 0 {
+			v.ReviewPassRate = float64(v.Merged) / float64(reviewed)
+		}
+	}
+	return r
+}
@@ -0,0 +1,125 @@
+// Package export writes beads, their state transitions, and wisp timing as
+// flat tables (CSV or Parquet) for offline analysis in pandas or DuckDB.
+//
+// Each table has a fixed, versioned column schema: columns are only ever
+// appended, and a change that alters an existing column bumps the table's
+// version. Incremental exports record a per-table high-water mark so each
+// run only writes rows that changed since the last one.
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// SchemaKey is the Parquet footer metadata key holding a table's schema ID.
+const SchemaKey = "gastown.export.schema"
+
+// Kind is a column's value type.
+type Kind int
+
+const (
+	KindString Kind = iota // string
+	KindInt                // int64
+	KindTime               // time.Time, written as UTC milliseconds
+)
+
+// Column is one column of a table schema.
+type Column struct {
+	Name string
+	Kind Kind
+}
+
+// Schema is a table's fixed column layout.
+type Schema struct {
+	Name    string
+	Version int
+	Columns []Column
+}
+
+// Table is a schema plus rows. Each row has one value per column; nil is
+// null.
+type Table struct {
+	Schema
+	Rows [][]any
+}
+
+// SchemaID identifies the table's schema, e.g. "issues/v1".
+func (s Schema) SchemaID() string { return fmt.Sprintf("%s/v%d", s.Name, s.Version) }
+
+// Formats lists the supported output formats.
+var Formats = []string{"csv", "parquet"}
+
+// Write writes t in format ("csv" or "parquet").
+func Write(w io.Writer, t *Table, format string) error {
+	switch format {
+	case "csv":
+		return WriteCSV(w, t)
+	case "parquet":
+		return WriteParquet(w, t)
+	default:
+		return fmt.Errorf("unknown export format %q: want csv or parquet", format)
+	}
+}
+
+// WriteCSV writes t with a header row. Times are RFC 3339 in UTC and nulls
+// are empty.
+func WriteCSV(w io.Writer, t *Table) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		header[i] = c.Name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	rec := make([]string, len(t.Columns))
+	for _, row := range t.Rows {
+		for i, v := range row {
+			switch v := v.(type) {
+			case nil:
+				rec[i] = ""
+			case string:
+				rec[i] = v
+			case int64:
+				rec[i] = strconv.FormatInt(v, 10)
+			case time.Time:
+				rec[i] = v.UTC().Format(time.RFC3339)
+			default:
+				return fmt.Errorf("column %s: unsupported value %T", t.Columns[i].Name, v)
+			}
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// optString returns s, or nil when it is empty.
+func optString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// optTime returns t, or nil when it is zero.
+func optTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC()
+}
+
+// optSeconds returns d in whole seconds, or nil when !ok.
+func optSeconds(d time.Duration, ok bool) any {
+	if !ok {
+		return nil
+	}
+	return int64(d / time.Second)
+}
@@ -0,0 +1,287 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// This is a minimal Parquet writer for flat tables: one row group, one
+// uncompressed PLAIN-encoded data page per column, every column OPTIONAL.
+// That is all offline analysis in pandas or DuckDB needs, and it keeps the
+// export free of a columnar-format dependency.
+
+var parquetMagic = []byte("PAR1")
+
+// Parquet enums (parquet.thrift).
+const (
+	pqTypeInt64     = 2
+	pqTypeByteArray = 6
+
+	pqRepetitionOptional = 1
+
+	pqConvertedUTF8            = 0
+	pqConvertedTimestampMillis = 9
+
+	pqEncodingPlain = 0
+	pqEncodingRLE   = 3
+
+	pqCodecUncompressed = 0
+	pqPageData          = 0
+)
+
+// Thrift compact protocol type IDs.
+const (
+	tcI32    = 5
+	tcI64    = 6
+	tcBinary = 8
+	tcList   = 9
+	tcStruct = 12
+)
+
+// thriftWriter encodes the Thrift compact protocol, which Parquet uses for
+// page headers and the file footer.
+type thriftWriter struct {
+	buf  bytes.Buffer
+	last []int16 // Last field ID written, per open struct
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	w.buf.Write(b[:n])
+}
+
+func (w *thriftWriter) zigzag(v int64) { w.varint(uint64((v << 1) ^ (v >> 63))) }
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	last := &w.last[len(w.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.zigzag(int64(id))
+	}
+	*last = id
+}
+
+func (w *thriftWriter) begin() { w.last = append(w.last, 0) }
+
+func (w *thriftWriter) end() {
+	w.buf.WriteByte(0) // stop
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *thriftWriter) i32(id int16, v int32) { w.field(id, tcI32); w.zigzag(int64(v)) }
+func (w *thriftWriter) i64(id int16, v int64) { w.field(id, tcI64); w.zigzag(v) }
+
+func (w *thriftWriter) str(id int16, s string) {
+	w.field(id, tcBinary)
+	w.rawString(s)
+}
+
+func (w *thriftWriter) rawString(s string) {
+	w.varint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+// structField writes a nested struct whose fields body writes.
+func (w *thriftWriter) structField(id int16, body func()) {
+	w.field(id, tcStruct)
+	w.begin()
+	body()
+	w.end()
+}
+
+// list writes a list header; the caller writes n elements of elemType.
+func (w *thriftWriter) list(id int16, elemType byte, n int) {
+	w.field(id, tcList)
+	if n < 15 {
+		w.buf.WriteByte(byte(n)<<4 | elemType)
+	} else {
+		w.buf.WriteByte(0xF0 | elemType)
+		w.varint(uint64(n))
+	}
+}
+
+// listStruct writes one struct element of a list.
+func (w *thriftWriter) listStruct(body func()) {
+	w.begin()
+	body()
+	w.end()
+}
+
+// pqColumn is one encoded column chunk.
+type pqColumn struct {
+	col        Column
+	chunk      []byte // Page header + page
+	dataOffset int64
+}
+
+// encodeLevels RLE-encodes definition levels (bit width 1), prefixed with
+// their byte length as data page v1 requires.
+func encodeLevels(defined []bool) []byte {
+	var w thriftWriter
+	for i := 0; i < len(defined); {
+		j := i
+		for j < len(defined) && defined[j] == defined[i] {
+			j++
+		}
+		w.varint(uint64(j-i) << 1) // RLE run header
+		if defined[i] {
+			w.buf.WriteByte(1)
+		} else {
+			w.buf.WriteByte(0)
+		}
+		i = j
+	}
+	out := make([]byte, 4, 4+w.buf.Len())
+	binary.LittleEndian.PutUint32(out, uint32(w.buf.Len()))
+	return append(out, w.buf.Bytes()...)
+}
+
+// encodeColumn builds column c's data page from rows.
+func encodeColumn(t *Table, c int) ([]byte, error) {
+	col := t.Columns[c]
+	defined := make([]bool, len(t.Rows))
+	var values bytes.Buffer
+	for r, row := range t.Rows {
+		v := row[c]
+		if v == nil {
+			continue
+		}
+		defined[r] = true
+		switch col.Kind {
+		case KindString:
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("column %s row %d: want string, got %T", col.Name, r, v)
+			}
+			_ = binary.Write(&values, binary.LittleEndian, uint32(len(s)))
+			values.WriteString(s)
+		case KindInt:
+			n, ok := v.(int64)
+			if !ok {
+				return nil, fmt.Errorf("column %s row %d: want int64, got %T", col.Name, r, v)
+			}
+			_ = binary.Write(&values, binary.LittleEndian, n)
+		case KindTime:
+			ts, ok := v.(time.Time)
+			if !ok {
+				return nil, fmt.Errorf("column %s row %d: want time.Time, got %T", col.Name, r, v)
+			}
+			_ = binary.Write(&values, binary.LittleEndian, ts.UnixMilli())
+		}
+	}
+
+	page := append(encodeLevels(defined), values.Bytes()...)
+
+	var h thriftWriter
+	h.begin()
+	h.i32(1, pqPageData)
+	h.i32(2, int32(len(page)))
+	h.i32(3, int32(len(page)))
+	h.structField(5, func() {
+		h.i32(1, int32(len(t.Rows)))
+		h.i32(2, pqEncodingPlain)
+		h.i32(3, pqEncodingRLE)
+		h.i32(4, pqEncodingRLE)
+	})
+	h.end()
+	return append(h.buf.Bytes(), page...), nil
+}
+
+func (k Kind) parquetType() int32 {
+	if k == KindString {
+		return pqTypeByteArray
+	}
+	return pqTypeInt64
+}
+
+// WriteParquet writes t as a Parquet file. The table's schema name and
+// version are recorded in the footer's key-value metadata.
+func WriteParquet(out io.Writer, t *Table) error {
+	var file bytes.Buffer
+	file.Write(parquetMagic)
+
+	cols := make([]pqColumn, len(t.Columns))
+	for i, col := range t.Columns {
+		chunk, err := encodeColumn(t, i)
+		if err != nil {
+			return err
+		}
+		cols[i] = pqColumn{col: col, chunk: chunk, dataOffset: int64(file.Len())}
+		file.Write(chunk)
+	}
+
+	var m thriftWriter
+	m.begin()
+	m.i32(1, 1) // version
+	m.list(2, tcStruct, len(t.Columns)+1)
+	m.listStruct(func() {
+		m.str(4, "schema")
+		m.i32(5, int32(len(t.Columns)))
+	})
+	for _, col := range t.Columns {
+		m.listStruct(func() {
+			m.i32(1, col.Kind.parquetType())
+			m.i32(3, pqRepetitionOptional)
+			m.str(4, col.Name)
+			switch col.Kind {
+			case KindString:
+				m.i32(6, pqConvertedUTF8)
+			case KindTime:
+				m.i32(6, pqConvertedTimestampMillis)
+			}
+		})
+	}
+	m.i64(3, int64(len(t.Rows)))
+	if len(t.Rows) == 0 {
+		m.list(4, tcStruct, 0)
+	} else {
+		var total int64
+		for _, c := range cols {
+			total += int64(len(c.chunk))
+		}
+		m.list(4, tcStruct, 1)
+		m.listStruct(func() {
+			m.list(1, tcStruct, len(cols))
+			for _, c := range cols {
+				m.listStruct(func() {
+					m.i64(2, c.dataOffset)
+					m.structField(3, func() {
+						m.i32(1, c.col.Kind.parquetType())
+						m.list(2, tcI32, 2)
+						m.zigzag(pqEncodingPlain)
+						m.zigzag(pqEncodingRLE)
+						m.list(3, tcBinary, 1)
+						m.rawString(c.col.Name)
+						m.i32(4, pqCodecUncompressed)
+						m.i64(5, int64(len(t.Rows)))
+						m.i64(6, int64(len(c.chunk)))
+						m.i64(7, int64(len(c.chunk)))
+						m.i64(9, c.dataOffset)
+					})
+				})
+			}
+			m.i64(2, total)
+			m.i64(3, int64(len(t.Rows)))
+		})
+	}
+	m.list(5, tcStruct, 1)
+	m.listStruct(func() {
+		m.str(1, SchemaKey)
+		m.str(2, t.SchemaID())
+	})
+	m.str(6, "gastown gt bead export")
+	m.end()
+
+	file.Write(m.buf.Bytes())
+	_ = binary.Write(&file, binary.LittleEndian, uint32(m.buf.Len()))
+	file.Write(parquetMagic)
+
+	_, err := out.Write(file.Bytes())
+	return err
+}
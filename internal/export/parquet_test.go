@@ -0,0 +1,190 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+// thriftReader decodes the Thrift compact protocol into generic values:
+// structs as map[int16]any, lists as []any, i32/i64 as int64, and
+// binaries as string. Enough to check what WriteParquet produces.
+type thriftReader struct {
+	b   []byte
+	pos int
+	t   *testing.T
+}
+
+func (r *thriftReader) byte() byte {
+	c := r.b[r.pos]
+	r.pos++
+	return c
+}
+
+func (r *thriftReader) varint() uint64 {
+	v, n := binary.Uvarint(r.b[r.pos:])
+	if n <= 0 {
+		r.t.Fatalf("bad varint at %d", r.pos)
+	}
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) zigzag() int64 {
+	v := r.varint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *thriftReader) value(typ byte) any {
+	switch typ {
+	case 1:
+		return true
+	case 2:
+		return false
+	case tcI32, tcI64:
+		return r.zigzag()
+	case tcBinary:
+		n := int(r.varint())
+		s := string(r.b[r.pos : r.pos+n])
+		r.pos += n
+		return s
+	case tcList:
+		h := r.byte()
+		n, elem := int(h>>4), h&0x0F
+		if n == 15 {
+			n = int(r.varint())
+		}
+		out := make([]any, n)
+		for i := range out {
+			out[i] = r.value(elem)
+		}
+		return out
+	case tcStruct:
+		return r.structure()
+	}
+	r.t.Fatalf("unsupported thrift type %d at %d", typ, r.pos)
+	return nil
+}
+
+func (r *thriftReader) structure() map[int16]any {
+	out := map[int16]any{}
+	var last int16
+	for {
+		h := r.byte()
+		if h == 0 {
+			return out
+		}
+		typ := h & 0x0F
+		id := last + int16(h>>4)
+		if h>>4 == 0 {
+			id = int16(r.zigzag())
+		}
+		out[id] = r.value(typ)
+		last = id
+	}
+}
+
+func TestWriteParquetRoundTrip(t *testing.T) {
+	ts := time.Date(2026, 3, 10, 9, 30, 0, 0, time.UTC)
+	table := &Table{
+		Schema: Schema{Name: "sample", Version: 2, Columns: []Column{
+			{"id", KindString}, {"n", KindInt}, {"at", KindTime},
+		}},
+		Rows: [][]any{
+			{"gt-1", int64(7), ts},
+			{"gt-2", nil, nil},
+			{"gt-3", int64(-1), ts.Add(time.Second)},
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteParquet(&buf, table); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	if !bytes.HasPrefix(data, parquetMagic) || !bytes.HasSuffix(data, parquetMagic) {
+		t.Fatal("missing PAR1 magic")
+	}
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer := &thriftReader{b: data[len(data)-8-footerLen : len(data)-8], t: t}
+	meta := footer.structure()
+
+	if meta[3].(int64) != 3 {
+		t.Errorf("num_rows = %v, want 3", meta[3])
+	}
+	schema := meta[2].([]any)
+	if len(schema) != 4 || schema[0].(map[int16]any)[5].(int64) != 3 {
+		t.Fatalf("schema = %v, want root with 3 children", schema)
+	}
+	for i, want := range []string{"id", "n", "at"} {
+		if got := schema[i+1].(map[int16]any)[4]; got != want {
+			t.Errorf("schema[%d] name = %v, want %s", i+1, got, want)
+		}
+	}
+	kv := meta[5].([]any)[0].(map[int16]any)
+	if kv[1] != SchemaKey || kv[2] != "sample/v2" {
+		t.Errorf("key-value metadata = %v", kv)
+	}
+
+	chunks := meta[4].([]any)[0].(map[int16]any)[1].([]any)
+	readColumn := func(i int) (defined []bool, values []byte) {
+		cm := chunks[i].(map[int16]any)[3].(map[int16]any)
+		page := &thriftReader{b: data, pos: int(cm[9].(int64)), t: t}
+		header := page.structure()
+		if header[5].(map[int16]any)[1].(int64) != 3 {
+			t.Fatalf("column %d: num_values = %v", i, header[5])
+		}
+		body := data[page.pos : page.pos+int(header[2].(int64))]
+		levelsLen := int(binary.LittleEndian.Uint32(body))
+		levels := &thriftReader{b: body[4 : 4+levelsLen], t: t}
+		for levels.pos < len(levels.b) {
+			run := int(levels.varint() >> 1)
+			v := levels.byte() == 1
+			for k := 0; k < run; k++ {
+				defined = append(defined, v)
+			}
+		}
+		return defined, body[4+levelsLen:]
+	}
+
+	defined, values := readColumn(0)
+	if len(defined) != 3 || !defined[1] {
+		t.Errorf("id definition levels = %v", defined)
+	}
+	if n := binary.LittleEndian.Uint32(values); n != 4 || string(values[4:8]) != "gt-1" {
+		t.Errorf("first id = %q", values[4:4+n])
+	}
+
+	defined, values = readColumn(1)
+	if !defined[0] || defined[1] || !defined[2] || len(values) != 16 {
+		t.Errorf("n column: defined %v, %d value bytes", defined, len(values))
+	}
+	if got := int64(binary.LittleEndian.Uint64(values[8:])); got != -1 {
+		t.Errorf("n[2] = %d, want -1", got)
+	}
+
+	_, values = readColumn(2)
+	if got := int64(binary.LittleEndian.Uint64(values)); got != ts.UnixMilli() {
+		t.Errorf("at[0] = %d, want %d", got, ts.UnixMilli())
+	}
+}
+
+func TestWriteParquetEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteParquet(&buf, &Table{Schema: IssuesSchema}); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	meta := (&thriftReader{b: data[len(data)-8-footerLen : len(data)-8], t: t}).structure()
+	if meta[3].(int64) != 0 || len(meta[4].([]any)) != 0 {
+		t.Errorf("empty table: num_rows %v, row groups %v", meta[3], meta[4])
+	}
+}
+
+func TestWriteParquetTypeMismatch(t *testing.T) {
+	table := &Table{Schema: Schema{Name: "bad", Version: 1, Columns: []Column{{"n", KindInt}}}, Rows: [][]any{{"seven"}}}
+	if err := WriteParquet(&bytes.Buffer{}, table); err == nil {
+		t.Error("expected an error for a string in an int column")
+	}
+}
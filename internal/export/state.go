@@ -0,0 +1,84 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// stateFileName is the incremental export state, kept in the output
+// directory so each destination tracks its own progress.
+const stateFileName = ".gt-export-state.json"
+
+// State records, per table, the mark of the last incremental export and
+// the schema it was written with.
+type State struct {
+	Tables map[string]TableState `json:"tables"`
+}
+
+// TableState is one table's incremental export progress.
+type TableState struct {
+	Schema     string    `json:"schema"`
+	Mark       time.Time `json:"mark"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// StatePath returns the state file for an output directory.
+func StatePath(dir string) string { return filepath.Join(dir, stateFileName) }
+
+// LoadState reads an output directory's export state. Returns an empty
+// state if nothing has been exported there incrementally.
+func LoadState(dir string) (*State, error) {
+	s := &State{Tables: map[string]TableState{}}
+	data, err := os.ReadFile(StatePath(dir)) //nolint:gosec // G304: path is constructed from the output directory
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", StatePath(dir), err)
+	}
+	if s.Tables == nil {
+		s.Tables = map[string]TableState{}
+	}
+	return s, nil
+}
+
+// Save writes the export state to dir.
+func (s *State) Save(dir string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(StatePath(dir), data, 0644) //nolint:gosec // G306: not sensitive
+}
+
+// After returns the mark to resume a table from, or zero when the table
+// has not been exported or its schema has changed since (a schema change
+// needs a full re-export).
+func (s *State) After(schema Schema) time.Time {
+	ts, ok := s.Tables[schema.Name]
+	if !ok || ts.Schema != schema.SchemaID() {
+		return time.Time{}
+	}
+	return ts.Mark
+}
+
+// Advance records a table's new mark. A zero mark (nothing exported)
+// keeps the previous one.
+func (s *State) Advance(schema Schema, mark, now time.Time) {
+	prev := s.Tables[schema.Name]
+	if prev.Schema != schema.SchemaID() {
+		prev = TableState{}
+	}
+	if mark.After(prev.Mark) {
+		prev.Mark = mark
+	}
+	prev.Schema = schema.SchemaID()
+	prev.ExportedAt = now
+	s.Tables[schema.Name] = prev
+}
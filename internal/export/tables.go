@@ -0,0 +1,235 @@
+package export
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/stats"
+)
+
+// Table schemas. Append new columns at the end; bump Version for any
+// change to an existing column.
+var (
+	IssuesSchema = Schema{Name: "issues", Version: 1, Columns: []Column{
+		{"id", KindString},
+		{"rig", KindString}, // "" for town beads
+		{"title", KindString},
+		{"type", KindString},
+		{"status", KindString},
+		{"priority", KindInt},
+		{"assignee", KindString},
+		{"created_by", KindString},
+		{"parent", KindString},
+		{"labels", KindString}, // Sorted, ";"-separated
+		{"ephemeral", KindInt}, // 1 for wisps
+		{"created_at", KindTime},
+		{"updated_at", KindTime},
+		{"closed_at", KindTime},
+	}}
+
+	TransitionsSchema = Schema{Name: "transitions", Version: 1, Columns: []Column{
+		{"bead", KindString},
+		{"at", KindTime},
+		{"state", KindString}, // See transitionStates
+		{"event", KindString},
+		{"actor", KindString},
+		{"rig", KindString},
+	}}
+
+	WispsSchema = Schema{Name: "wisps", Version: 1, Columns: []Column{
+		{"bead", KindString},
+		{"rig", KindString},
+		{"agent", KindString},
+		{"type", KindString},
+		{"queued_at", KindTime},
+		{"claimed_at", KindTime},
+		{"first_activity_at", KindTime},
+		{"completed_at", KindTime},
+		{"queue_wait_s", KindInt},
+		{"startup_s", KindInt},
+		{"cycle_s", KindInt},
+		{"lead_s", KindInt},
+		{"estimate_s", KindInt},
+	}}
+)
+
+// Schemas lists every exported table, in export order.
+var Schemas = []Schema{IssuesSchema, TransitionsSchema, WispsSchema}
+
+// transitionStates maps the events that move a bead through its lifecycle
+// to the state it enters.
+var transitionStates = map[string]string{
+	events.TypeSling:                   "queued",
+	events.TypeSchedulerEnqueue:        "queued",
+	events.TypeSchedulerDispatch:       "dispatched",
+	events.TypeSchedulerDispatchFailed: "requeued",
+	events.TypeHook:                    "claimed",
+	events.TypeUnhook:                  "released",
+	events.TypeHandoff:                 "handed_off",
+	events.TypeDone:                    "completed",
+	events.TypeMergeStarted:            "merging",
+	events.TypeMerged:                  "merged",
+	events.TypeMergeFailed:             "merge_failed",
+}
+
+// Filter selects rows by their timestamp: for issues the last update, for
+// transitions the event time, and for wisps the latest stage reached.
+type Filter struct {
+	Since time.Time // Keep rows at or after Since (zero: no bound)
+	After time.Time // Keep rows strictly after After, the last incremental mark (zero: no bound)
+}
+
+func (f Filter) keep(t time.Time) bool {
+	if t.IsZero() {
+		return f.Since.IsZero() && f.After.IsZero()
+	}
+	return !t.Before(f.Since) && (f.After.IsZero() || t.After(f.After))
+}
+
+// IssueSet is the issues of one beads database.
+type IssueSet struct {
+	Rig    string // "" for town beads
+	Issues []*beads.Issue
+}
+
+func parseIssueTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
+
+// IssuesTable builds the issues table, sorted by ID. It returns the
+// latest update time exported, the table's next incremental mark.
+func IssuesTable(sets []IssueSet, f Filter) (*Table, time.Time) {
+	t := &Table{Schema: IssuesSchema}
+	var mark time.Time
+	for _, set := range sets {
+		for _, issue := range set.Issues {
+			updated := parseIssueTime(issue.UpdatedAt)
+			if !f.keep(updated) {
+				continue
+			}
+			if updated.After(mark) {
+				mark = updated
+			}
+			labels := append([]string(nil), issue.Labels...)
+			sort.Strings(labels)
+			var ephemeral int64
+			if issue.Ephemeral {
+				ephemeral = 1
+			}
+			t.Rows = append(t.Rows, []any{
+				issue.ID,
+				optString(set.Rig),
+				issue.Title,
+				optString(issueType(issue)),
+				optString(issue.Status),
+				int64(issue.Priority),
+				optString(issue.Assignee),
+				optString(issue.CreatedBy),
+				optString(issue.Parent),
+				optString(strings.Join(labels, ";")),
+				ephemeral,
+				optTime(parseIssueTime(issue.CreatedAt)),
+				optTime(updated),
+				optTime(parseIssueTime(issue.ClosedAt)),
+			})
+		}
+	}
+	sort.SliceStable(t.Rows, func(i, j int) bool { return t.Rows[i][0].(string) < t.Rows[j][0].(string) })
+	return t, mark
+}
+
+// issueType returns the issue's type, falling back to its gt:<type> label.
+func issueType(issue *beads.Issue) string {
+	if issue.Type != "" {
+		return issue.Type
+	}
+	for _, l := range issue.Labels {
+		if strings.HasPrefix(l, "gt:") {
+			return strings.TrimPrefix(l, "gt:")
+		}
+	}
+	return ""
+}
+
+// TransitionsTable builds the state transitions table from the town event
+// log, in log order. It returns the latest event time exported.
+func TransitionsTable(evs []events.Event, f Filter) (*Table, time.Time) {
+	t := &Table{Schema: TransitionsSchema}
+	var mark time.Time
+	for _, e := range evs {
+		state, ok := transitionStates[e.Type]
+		bead := e.PayloadString("bead")
+		if !ok || bead == "" {
+			continue
+		}
+		at := e.Time()
+		if at.IsZero() || !f.keep(at) {
+			continue
+		}
+		if at.After(mark) {
+			mark = at
+		}
+		rig := e.PayloadString("rig")
+		if rig == "" {
+			rig = stats.RigFromAgent(e.Actor)
+		}
+		t.Rows = append(t.Rows, []any{bead, at.UTC(), state, e.Type, optString(e.Actor), optString(rig)})
+	}
+	return t, mark
+}
+
+// latestStage is the last lifecycle stage time a wisp reached.
+func latestStage(tl *stats.Timeline) time.Time {
+	var latest time.Time
+	for _, ts := range []time.Time{tl.Queued, tl.Claimed, tl.FirstActivity, tl.Completed} {
+		if ts.After(latest) {
+			latest = ts
+		}
+	}
+	return latest
+}
+
+// WispsTable builds the wisp timing table, sorted by bead. It returns the
+// latest stage time exported.
+func WispsTable(timelines []*stats.Timeline, f Filter) (*Table, time.Time) {
+	t := &Table{Schema: WispsSchema}
+	var mark time.Time
+	for _, tl := range timelines {
+		latest := latestStage(tl)
+		if !f.keep(latest) {
+			continue
+		}
+		if latest.After(mark) {
+			mark = latest
+		}
+		rig := tl.Rig
+		if rig == "" {
+			rig = stats.RigFromAgent(tl.Agent)
+		}
+		var estimate any
+		if tl.Estimate > 0 {
+			estimate = int64(tl.Estimate / time.Second)
+		}
+		t.Rows = append(t.Rows, []any{
+			tl.Bead,
+			optString(rig),
+			optString(tl.Agent),
+			optString(tl.Type),
+			optTime(tl.Queued),
+			optTime(tl.Claimed),
+			optTime(tl.FirstActivity),
+			optTime(tl.Completed),
+			optSeconds(tl.QueueWait()),
+			optSeconds(tl.StartupLatency()),
+			optSeconds(tl.CycleTime()),
+			optSeconds(tl.LeadTime()),
+			estimate,
+		})
+	}
+	sort.SliceStable(t.Rows, func(i, j int) bool { return t.Rows[i][0].(string) < t.Rows[j][0].(string) })
+	return t, mark
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/stats"
+)
+
+func TestIssuesTableFilterAndCSV(t *testing.T) {
+	sets := []IssueSet{
+		{Issues: []*beads.Issue{
+			{ID: "hq-2", Title: "Town task", Status: "open", Priority: 2, Labels: []string{"ops", "gt:task"}, UpdatedAt: "2026-03-02T10:00:00Z"},
+			{ID: "hq-1", Title: "Old", Status: "closed", UpdatedAt: "2025-12-01T10:00:00Z"},
+		}},
+		{Rig: "gastown", Issues: []*beads.Issue{
+			{ID: "gt-1", Title: "Fix, then ship", Type: "bug", Status: "open", Priority: 1, UpdatedAt: "2026-03-05T10:00:00Z", Ephemeral: true},
+		}},
+	}
+	table, mark := IssuesTable(sets, Filter{Since: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	if len(table.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2 after --since", len(table.Rows))
+	}
+	if want := time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC); !mark.Equal(want) {
+		t.Errorf("mark = %v, want %v", mark, want)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, table); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if lines[0] != "id,rig,title,type,status,priority,assignee,created_by,parent,labels,ephemeral,created_at,updated_at,closed_at" {
+		t.Errorf("header = %s", lines[0])
+	}
+	if lines[1] != `gt-1,gastown,"Fix, then ship",bug,open,1,,,,,1,,2026-03-05T10:00:00Z,` {
+		t.Errorf("row 1 = %s", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "hq-2,,Town task,task,open,2,,,,gt:task;ops,0,") {
+		t.Errorf("row 2 = %s", lines[2])
+	}
+
+	// Incremental: only rows after the mark.
+	table, _ = IssuesTable(sets, Filter{After: mark})
+	if len(table.Rows) != 0 {
+		t.Errorf("incremental export after the mark = %d rows, want 0", len(table.Rows))
+	}
+}
+
+func TestTransitionsAndWispsTables(t *testing.T) {
+	t0 := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) string { return t0.Add(d).Format(time.RFC3339) }
+	evs := []events.Event{
+		{Timestamp: at(0), Type: events.TypeSling, Actor: "mayor", Payload: map[string]interface{}{"bead": "gt-1"}},
+		{Timestamp: at(time.Minute), Type: events.TypeHook, Actor: "gastown/polecats/Toast", Payload: map[string]interface{}{"bead": "gt-1"}},
+		{Timestamp: at(2 * time.Minute), Type: events.TypeNudge, Actor: "deacon", Payload: map[string]interface{}{"bead": "gt-1"}},
+		{Timestamp: at(time.Hour), Type: events.TypeDone, Actor: "gastown/polecats/Toast", Payload: map[string]interface{}{"bead": "gt-1"}},
+	}
+	tr, mark := TransitionsTable(evs, Filter{})
+	if len(tr.Rows) != 3 {
+		t.Fatalf("got %d transitions, want 3 (nudge is not a transition)", len(tr.Rows))
+	}
+	if tr.Rows[1][2] != "claimed" || tr.Rows[1][5] != "gastown" {
+		t.Errorf("hook transition = %v", tr.Rows[1])
+	}
+	if !mark.Equal(t0.Add(time.Hour)) {
+		t.Errorf("mark = %v", mark)
+	}
+
+	wisps, _ := WispsTable(stats.BuildTimelines(evs), Filter{})
+	if len(wisps.Rows) != 1 {
+		t.Fatalf("got %d wisps, want 1", len(wisps.Rows))
+	}
+	row := wisps.Rows[0]
+	if row[8] != int64(60) || row[10] != int64(59*60) || row[11] != int64(3600) || row[12] != nil {
+		t.Errorf("wisp timing = queue %v cycle %v lead %v estimate %v", row[8], row[10], row[11], row[12])
+	}
+}
+
+func TestStateAdvanceAndSchemaChange(t *testing.T) {
+	dir := t.TempDir()
+	s, err := LoadState(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mark := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.Advance(IssuesSchema, mark, time.Now())
+	s.Advance(IssuesSchema, time.Time{}, time.Now()) // empty export keeps the mark
+	if err := s.Save(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = LoadState(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.After(IssuesSchema).Equal(mark) {
+		t.Errorf("After = %v, want %v", s.After(IssuesSchema), mark)
+	}
+	bumped := IssuesSchema
+	bumped.Version++
+	if !s.After(bumped).IsZero() {
+		t.Error("a schema change should restart the table from scratch")
+	}
+}
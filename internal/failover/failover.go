@@ -0,0 +1,370 @@
+// Package failover moves agents off a failing provider.
+//
+// Each scan reads the bottom of every Gas Town session's pane for provider
+// errors and attributes them to the session's agent (GT_AGENT). Only output
+// new since the previous scan counts, so an error left on an idle screen is
+// seen once. An agent whose sessions show new errors on Threshold
+// consecutive scans is failed over: role resolution hands its new sessions
+// the configured fallback agent. The failover is cleared once no errors
+// have been seen for RecoverAfter and the agent has given a health signal
+// (new error-free output, or a passing HealthCheck); new sessions then go
+// back to the original agent. State lives in .runtime/failover/state.json.
+package failover
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Defaults for unset policy fields.
+const (
+	DefaultThreshold    = 3
+	DefaultInterval     = 2 * time.Minute
+	DefaultRecoverAfter = 15 * time.Minute
+)
+
+// DefaultNotify are the addresses told about failovers and recoveries.
+var DefaultNotify = []string{"overseer"}
+
+// DefaultPatterns match provider outage errors in the framing agents print
+// them with, so prose that mentions an error code does not match. Rate
+// limits are left to gt quota: they're per account, not per provider.
+var DefaultPatterns = []string{
+	`^\W*API Error: 5\d\d\b`,                              // Claude: server error mid-request
+	`^\W*API Error: (Connection error|Request timed out)`, // Claude: provider unreachable
+	`"type":\s*"overloaded_error"`,                        // Anthropic overload response body
+	`^\W*(error:?\s*)?(502 Bad Gateway|503 Service Unavailable|504 Gateway Timeout)\W*$`, // Bare HTTP gateway errors
+	`^\W*\[API Error: .*"status":\s*"(UNAVAILABLE|INTERNAL)"`,                            // Gemini CLI status errors
+}
+
+// checkLines is how many lines at the bottom of a pane are checked. An
+// error that has scrolled further up has been recovered from.
+const checkLines = 15
+
+// Policy holds the failover settings.
+type Policy struct {
+	Fallbacks      map[string]string
+	Threshold      int
+	Interval       time.Duration
+	RecoverAfter   time.Duration
+	ResumeSessions bool
+	Notify         []string
+	HealthCheck    string
+	patterns       []*regexp.Regexp
+}
+
+// NewPolicy builds a policy from town settings; nil configures no failover.
+func NewPolicy(cfg *config.FailoverConfig) (*Policy, error) {
+	p := &Policy{
+		Fallbacks:    map[string]string{},
+		Threshold:    DefaultThreshold,
+		Interval:     DefaultInterval,
+		RecoverAfter: DefaultRecoverAfter,
+		Notify:       DefaultNotify,
+	}
+	patterns := DefaultPatterns
+	if cfg != nil {
+		for agent, fallback := range cfg.Fallbacks {
+			if fallback == "" || fallback == agent {
+				return nil, fmt.Errorf("failover.fallbacks[%s]: fallback must name a different agent", agent)
+			}
+			p.Fallbacks[agent] = fallback
+		}
+		if cfg.Threshold > 0 {
+			p.Threshold = cfg.Threshold
+		}
+		var err error
+		if p.Interval, err = parseDuration("interval", cfg.Interval, p.Interval); err != nil {
+			return nil, err
+		}
+		if p.RecoverAfter, err = parseDuration("recover_after", cfg.RecoverAfter, p.RecoverAfter); err != nil {
+			return nil, err
+		}
+		if len(cfg.Patterns) > 0 {
+			patterns = cfg.Patterns
+		}
+		p.ResumeSessions = cfg.ResumeSessions
+		p.HealthCheck = cfg.HealthCheck
+		if len(cfg.Notify) > 0 {
+			p.Notify = cfg.Notify
+		}
+	}
+	for _, pat := range patterns {
+		re, err := regexp.Compile("(?i)" + pat)
+		if err != nil {
+			return nil, fmt.Errorf("failover.patterns: %q: %w", pat, err)
+		}
+		p.patterns = append(p.patterns, re)
+	}
+	return p, nil
+}
+
+func parseDuration(field, s string, fallback time.Duration) (time.Duration, error) {
+	if s == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("failover.%s: invalid duration %q", field, s)
+	}
+	return d, nil
+}
+
+// LoadPolicy reads the failover policy from town settings.
+func LoadPolicy(townRoot string) (*Policy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return NewPolicy(settings.Failover)
+}
+
+// Enabled reports whether any agent has a fallback.
+func (p *Policy) Enabled() bool {
+	return len(p.Fallbacks) > 0
+}
+
+// MatchError returns the first line in the bottom of pane content that looks
+// like a provider error, or "".
+func (p *Policy) MatchError(content string) string {
+	if _, errs := p.errorLines(content); len(errs) > 0 {
+		return errs[0]
+	}
+	return ""
+}
+
+// errorLines returns the non-empty lines in the bottom of pane content and
+// those among them that look like provider errors.
+func (p *Policy) errorLines(content string) (tail, errs []string) {
+	lines := strings.Split(content, "\n")
+	for _, line := range lines[max(0, len(lines)-checkLines):] {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		tail = append(tail, line)
+		for _, re := range p.patterns {
+			if re.MatchString(line) {
+				errs = append(errs, line)
+				break
+			}
+		}
+	}
+	return tail, errs
+}
+
+func hashLine(s string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// TmuxClient is the interface for tmux operations needed by Scan.
+type TmuxClient interface {
+	ListSessions() ([]string, error)
+	CapturePane(session string, lines int) (string, error)
+	GetEnvironment(session, key string) (string, error)
+}
+
+// SessionScan is one session's agent, whether its pane shows output new
+// since the last scan, and the new provider error among it, if any.
+type SessionScan struct {
+	Session string `json:"session"`
+	Agent   string `json:"agent"`
+	Fresh   bool   `json:"fresh,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Scan checks every Gas Town session that records its agent. It compares
+// each pane with what st.Panes recorded at the last scan, reports only
+// errors that were not already on screen, and records the panes for the
+// next scan.
+func (p *Policy) Scan(t TmuxClient, st *config.FailoverState) ([]SessionScan, error) {
+	sessions, err := t.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+	panes := map[string]*config.PaneSeen{}
+	var out []SessionScan
+	for _, sess := range sessions {
+		if !session.IsKnownSession(sess) {
+			continue
+		}
+		agent, err := t.GetEnvironment(sess, "GT_AGENT")
+		if err != nil || strings.TrimSpace(agent) == "" {
+			continue
+		}
+		scan := SessionScan{Session: sess, Agent: strings.TrimSpace(agent)}
+		prev := st.Panes[sess]
+		if content, err := t.CapturePane(sess, checkLines*2); err == nil {
+			tail, errs := p.errorLines(content)
+			seen := &config.PaneSeen{Tail: hashLine(strings.Join(tail, "\n"))}
+			// An error counts when its line appears more often than it did
+			// last scan: a new error, not one still on screen.
+			before := map[string]int{}
+			if prev != nil {
+				for _, h := range prev.Errors {
+					before[h]++
+				}
+			}
+			for _, line := range errs {
+				h := hashLine(line)
+				seen.Errors = append(seen.Errors, h)
+				if before[h] > 0 {
+					before[h]--
+				} else if scan.Error == "" {
+					scan.Error = line
+				}
+			}
+			scan.Fresh = prev == nil || prev.Tail != seen.Tail
+			panes[sess] = seen
+		} else if prev != nil {
+			panes[sess] = prev
+		}
+		out = append(out, scan)
+	}
+	st.Panes = panes
+	return out, nil
+}
+
+// CheckHealth runs the HealthCheck command for agent and reports whether
+// it passed. It is false when no HealthCheck is configured.
+func (p *Policy) CheckHealth(townRoot, agent string) bool {
+	if p.HealthCheck == "" {
+		return false
+	}
+	cmd := exec.Command("sh", "-c", p.HealthCheck) //nolint:gosec // G204: command comes from town settings
+	cmd.Dir = townRoot
+	cmd.Env = append(os.Environ(), "GT_AGENT="+agent)
+	return cmd.Run() == nil
+}
+
+// RecordHealth records a health signal for a failed-over agent.
+func RecordHealth(st *config.FailoverState, agent string, now time.Time) {
+	if af := st.Agents[agent]; af.Active() {
+		af.HealthyAt = now.UTC()
+	}
+}
+
+// Transition is an agent failing over or recovering.
+type Transition struct {
+	Agent     string `json:"agent"`
+	Fallback  string `json:"fallback"`
+	Recovered bool   `json:"recovered"`          // false: failed over
+	Error     string `json:"error,omitempty"`    // Matched line, on failover
+	Sessions  int    `json:"sessions,omitempty"` // Erroring sessions, on failover
+}
+
+// Update folds a scan into the state and returns the agents that failed
+// over or recovered, in agent order. Only agents with a fallback are tracked.
+// New errors extend an agent's streak; new error-free output resets it and
+// is a health signal. A scan with no new output from an agent changes
+// nothing, and a failover is only cleared after a health signal.
+func (p *Policy) Update(st *config.FailoverState, scans []SessionScan, now time.Time) []Transition {
+	erroring := map[string][]SessionScan{}
+	healthy := map[string]bool{}
+	for _, s := range scans {
+		if s.Error != "" {
+			erroring[s.Agent] = append(erroring[s.Agent], s)
+		} else if s.Fresh {
+			healthy[s.Agent] = true
+		}
+	}
+
+	agents := make([]string, 0, len(p.Fallbacks))
+	for agent := range p.Fallbacks {
+		agents = append(agents, agent)
+	}
+	sort.Strings(agents)
+
+	var out []Transition
+	for _, agent := range agents {
+		af := st.Agents[agent]
+		if af == nil {
+			af = &config.AgentFailover{}
+		}
+		if errs := erroring[agent]; len(errs) > 0 {
+			af.Streak++
+			af.LastError = errs[0].Error
+			af.LastErrorAt = now.UTC()
+			if !af.Active() && af.Streak >= p.Threshold {
+				af.Fallback = p.Fallbacks[agent]
+				af.Since = now.UTC()
+				out = append(out, Transition{Agent: agent, Fallback: af.Fallback, Error: af.LastError, Sessions: len(errs)})
+			}
+		} else {
+			if healthy[agent] {
+				af.Streak = 0
+				if af.Active() {
+					af.HealthyAt = now.UTC()
+				}
+			}
+			if af.Active() && now.Sub(af.LastErrorAt) >= p.RecoverAfter && af.HealthyAt.After(af.LastErrorAt) {
+				out = append(out, Transition{Agent: agent, Fallback: af.Fallback, Recovered: true})
+				af.Fallback = ""
+				af.Since = time.Time{}
+				af.HealthyAt = time.Time{}
+			}
+		}
+		if af.Streak == 0 && !af.Active() {
+			delete(st.Agents, agent)
+			continue
+		}
+		st.Agents[agent] = af
+	}
+
+	// Fallbacks removed from settings end their failovers.
+	for _, agent := range sortedAgents(st) {
+		if _, ok := p.Fallbacks[agent]; ok {
+			continue
+		}
+		if af := st.Agents[agent]; af.Active() {
+			out = append(out, Transition{Agent: agent, Fallback: af.Fallback, Recovered: true})
+		}
+		delete(st.Agents, agent)
+	}
+	return out
+}
+
+func sortedAgents(st *config.FailoverState) []string {
+	agents := make([]string, 0, len(st.Agents))
+	for agent := range st.Agents {
+		agents = append(agents, agent)
+	}
+	sort.Strings(agents)
+	return agents
+}
+
+// ToResume returns the erroring sessions still running an agent that is
+// failed over, which ResumeSessions restarts on the fallback.
+func ToResume(st *config.FailoverState, scans []SessionScan) []SessionScan {
+	var out []SessionScan
+	for _, s := range scans {
+		if s.Error != "" && st.Agents[s.Agent].Active() {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Clear ends an agent's failover by hand. It reports whether one was active.
+func Clear(st *config.FailoverState, agent string) bool {
+	af := st.Agents[agent]
+	delete(st.Agents, agent)
+	return af.Active()
+}
+
+// SaveState writes the failover state.
+func SaveState(townRoot string, st *config.FailoverState) error {
+	return util.EnsureDirAndWriteJSON(config.FailoverStatePath(townRoot), st)
+}
@@ -0,0 +1,188 @@
+package failover
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// mockTmux implements TmuxClient for testing.
+type mockTmux struct {
+	panes map[string]string // session -> captured content
+	agent map[string]string // session -> GT_AGENT
+}
+
+func (m *mockTmux) ListSessions() ([]string, error) {
+	var out []string
+	for s := range m.panes {
+		out = append(out, s)
+	}
+	return append(out, "scratch"), nil
+}
+
+func (m *mockTmux) CapturePane(session string, lines int) (string, error) {
+	return m.panes[session], nil
+}
+
+func (m *mockTmux) GetEnvironment(session, key string) (string, error) {
+	if a, ok := m.agent[session]; ok && key == "GT_AGENT" {
+		return a, nil
+	}
+	return "", fmt.Errorf("env %s not set in session %s", key, session)
+}
+
+func newTestPolicy(t *testing.T) *Policy {
+	t.Helper()
+	p, err := NewPolicy(&config.FailoverConfig{
+		Fallbacks:    map[string]string{"claude-opus": "claude-sonnet"},
+		Threshold:    2,
+		RecoverAfter: "10m",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestScan(t *testing.T) {
+	r := session.NewPrefixRegistry()
+	r.Register("gt", "gastown")
+	old := session.DefaultRegistry()
+	session.SetDefaultRegistry(r)
+	t.Cleanup(func() { session.SetDefaultRegistry(old) })
+
+	tm := &mockTmux{
+		panes: map[string]string{
+			"gt-gastown-polecat-nux":   "Working...\n  ⎿  API Error: 529 {\"type\":\"error\",\"error\":{\"type\":\"overloaded_error\"}}\n",
+			"gt-gastown-polecat-furia": "All tests pass.\n",
+			"gt-gastown-witness":       "API Error: 500 Internal server error\n",
+		},
+		agent: map[string]string{
+			"gt-gastown-polecat-nux":   "claude-opus",
+			"gt-gastown-polecat-furia": "claude-opus",
+			"scratch":                  "claude-opus",
+		},
+	}
+	p := newTestPolicy(t)
+	st := &config.FailoverState{Agents: map[string]*config.AgentFailover{}}
+	scans, err := p.Scan(tm, st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	for _, s := range scans {
+		got[s.Session] = s.Error
+	}
+	if len(got) != 2 {
+		t.Fatalf("Scan() = %+v, want the two gastown sessions that record an agent", scans)
+	}
+	if got["gt-gastown-polecat-nux"] == "" || got["gt-gastown-polecat-furia"] != "" {
+		t.Errorf("Scan() errors = %v", got)
+	}
+
+	// An error still on screen is not counted again, and an unchanged
+	// pane is not fresh output.
+	scans, _ = p.Scan(tm, st)
+	for _, s := range scans {
+		if s.Error != "" || s.Fresh {
+			t.Errorf("rescan of unchanged pane = %+v", s)
+		}
+	}
+
+	// The same error printed again is new.
+	tm.panes["gt-gastown-polecat-nux"] += "Retrying...\n  ⎿  API Error: 529 {\"type\":\"error\",\"error\":{\"type\":\"overloaded_error\"}}\n"
+	scans, _ = p.Scan(tm, st)
+	for _, s := range scans {
+		if s.Session == "gt-gastown-polecat-nux" && (s.Error == "" || !s.Fresh) {
+			t.Errorf("repeated error not seen: %+v", s)
+		}
+	}
+}
+
+func TestMatchError_OnlyBottomOfPane(t *testing.T) {
+	p := newTestPolicy(t)
+	content := "API Error: 503 Service Unavailable\n"
+	for i := 0; i < checkLines; i++ {
+		content += fmt.Sprintf("line %d\n", i)
+	}
+	if line := p.MatchError(content); line != "" {
+		t.Errorf("MatchError() = %q, want a scrolled-off error ignored", line)
+	}
+	for _, prose := range []string{
+		"We should handle API errors gracefully",
+		"The proxy returns 503 Service Unavailable when the pool is empty.",
+		"Fixed handling of API Error: 500 responses in the client",
+	} {
+		if line := p.MatchError(prose); line != "" {
+			t.Errorf("MatchError() = %q, want prose ignored", line)
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	p := newTestPolicy(t)
+	st := &config.FailoverState{Agents: map[string]*config.AgentFailover{}}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	failing := []SessionScan{{Session: "gt-a", Agent: "claude-opus", Fresh: true, Error: "API Error: 500"}}
+	healthy := []SessionScan{{Session: "gt-a", Agent: "claude-opus", Fresh: true}}
+	idle := []SessionScan{{Session: "gt-a", Agent: "claude-opus"}}
+
+	if tr := p.Update(st, failing, now); len(tr) != 0 {
+		t.Fatalf("first erroring scan: transitions = %+v, want none below threshold", tr)
+	}
+	if tr := p.Update(st, healthy, now.Add(time.Minute)); len(tr) != 0 || st.Agents["claude-opus"] != nil {
+		t.Fatalf("a healthy scan should reset the streak, state = %+v", st.Agents)
+	}
+	p.Update(st, failing, now.Add(2*time.Minute))
+	tr := p.Update(st, failing, now.Add(3*time.Minute))
+	if len(tr) != 1 || tr[0].Recovered || tr[0].Fallback != "claude-sonnet" {
+		t.Fatalf("transitions = %+v, want failover to claude-sonnet", tr)
+	}
+	if resume := ToResume(st, failing); len(resume) != 1 {
+		t.Errorf("ToResume() = %+v, want the failing session", resume)
+	}
+
+	if tr := p.Update(st, healthy, now.Add(5*time.Minute)); len(tr) != 0 {
+		t.Fatalf("recovered before recover_after: %+v", tr)
+	}
+	p.Update(st, failing, now.Add(6*time.Minute))
+	if tr := p.Update(st, idle, now.Add(20*time.Minute)); len(tr) != 0 {
+		t.Fatalf("recovered on a health signal older than the last error: %+v", tr)
+	}
+	RecordHealth(st, "claude-opus", now.Add(21*time.Minute))
+	tr = p.Update(st, idle, now.Add(22*time.Minute))
+	if len(tr) != 1 || !tr[0].Recovered || st.Agents["claude-opus"].Active() {
+		t.Fatalf("transitions = %+v, want recovery", tr)
+	}
+}
+
+func TestUpdate_RemovedFallbackRecovers(t *testing.T) {
+	p := newTestPolicy(t)
+	st := &config.FailoverState{Agents: map[string]*config.AgentFailover{
+		"gemini": {Fallback: "claude", Since: time.Now()},
+	}}
+	tr := p.Update(st, nil, time.Now())
+	if len(tr) != 1 || tr[0].Agent != "gemini" || !tr[0].Recovered || st.Agents["gemini"] != nil {
+		t.Errorf("transitions = %+v, state = %+v", tr, st.Agents)
+	}
+}
+
+func TestNewPolicy_Invalid(t *testing.T) {
+	for name, cfg := range map[string]*config.FailoverConfig{
+		"self":     {Fallbacks: map[string]string{"claude": "claude"}},
+		"interval": {Interval: "often"},
+		"recover":  {RecoverAfter: "-1m"},
+		"pattern":  {Patterns: []string{"("}},
+	} {
+		if _, err := NewPolicy(cfg); err == nil {
+			t.Errorf("%s: NewPolicy() should fail", name)
+		}
+	}
+	p, err := NewPolicy(nil)
+	if err != nil || p.Enabled() {
+		t.Errorf("nil config should give a disabled policy, got %+v, %v", p, err)
+	}
+}
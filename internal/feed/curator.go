@@ -538,6 +538,12 @@ func (c *Curator) generateSummary(event *events.Event) string {
 		}
 		return "Multiple sessions died simultaneously"
 
+	case events.TypeMayorFailover:
+		if errMsg, _ := event.Payload["error"].(string); errMsg != "" {
+			return fmt.Sprintf("Mayor failover failed: %s", errMsg)
+		}
+		return "Mayor heartbeat lapsed: deputy promoted to Mayor"
+
 	default:
 		return fmt.Sprintf("%s: %s", event.Actor, event.Type)
 	}
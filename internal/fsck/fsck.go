@@ -0,0 +1,381 @@
+// Package fsck implements a deep structural integrity scan of a town.
+//
+// Where gt doctor runs targeted checks, fsck walks the whole town tree:
+// every known config file is loaded through its real loader, every other
+// JSON/JSONL file is checked for well-formedness, leftover temp files are
+// found, and the rig registry and routes are cross-checked against the
+// directories they point at. Repair fixes what can be fixed mechanically and
+// moves anything it replaces into a quarantine directory instead of deleting it.
+package fsck
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/prices"
+)
+
+// Kind classifies a finding.
+type Kind string
+
+const (
+	KindInvalid      Kind = "invalid"      // File fails its schema or does not parse
+	KindLeftover     Kind = "leftover"     // Stale temp file from an interrupted write
+	KindUnknown      Kind = "unknown"      // Unexpected file in a gt-managed directory
+	KindDangling     Kind = "dangling"     // Reference to something that does not exist
+	KindUnregistered Kind = "unregistered" // Rig directory missing from the registry
+)
+
+// Severity of a finding.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Action is the repair fsck can apply to a finding.
+type Action string
+
+const (
+	ActionNone       Action = ""           // Report only; needs a human
+	ActionQuarantine Action = "quarantine" // Move the file into quarantine
+	ActionTrim       Action = "trim"       // Drop unparseable JSONL lines; original is quarantined
+)
+
+// QuarantineDir is where repaired files are moved, relative to the town root.
+const QuarantineDir = constants.DirRuntime + "/fsck-quarantine"
+
+// LeftoverAge is how old a temp file must be before it counts as leftover,
+// so writes in flight are not reported.
+const LeftoverAge = time.Hour
+
+// Finding is one problem found by a scan.
+type Finding struct {
+	Path      string   `json:"path"` // Relative to the town root, slash-separated
+	Kind      Kind     `json:"kind"`
+	Severity  Severity `json:"severity"`
+	Message   string   `json:"message"`
+	Action    Action   `json:"action,omitempty"`
+	Repaired  bool     `json:"repaired,omitempty"`
+	RepairErr string   `json:"repair_error,omitempty"`
+	MovedTo   string   `json:"moved_to,omitempty"`
+}
+
+// Report is the result of a scan.
+type Report struct {
+	TownRoot string     `json:"town_root"`
+	Scanned  int        `json:"scanned"`
+	Findings []*Finding `json:"findings"`
+}
+
+// Count returns the number of findings with the given severity.
+func (r *Report) Count(sev Severity) int {
+	n := 0
+	for _, f := range r.Findings {
+		if f.Severity == sev {
+			n++
+		}
+	}
+	return n
+}
+
+// Unresolved returns findings of the given severity that were not repaired.
+func (r *Report) Unresolved(sev Severity) []*Finding {
+	var out []*Finding
+	for _, f := range r.Findings {
+		if f.Severity == sev && !f.Repaired {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// schema validates one known file. Critical files are never moved aside,
+// since gt cannot run without them.
+type schema struct {
+	validate func(path string) error
+	critical bool
+}
+
+var townSchemas = map[string]schema{
+	"mayor/" + constants.FileTownJSON:               {validate: loadErr(config.LoadTownConfig), critical: true},
+	"mayor/" + constants.FileRigsJSON:               {validate: loadErr(config.LoadRigsConfig), critical: true},
+	"mayor/" + constants.FileConfigJSON:             {validate: loadErr(config.LoadMayorConfig)},
+	"mayor/" + constants.FileAccountsJSON:           {validate: loadErr(config.LoadAccountsConfig)},
+	"mayor/" + config.DaemonPatrolConfigFileName:    {validate: loadErr(config.LoadDaemonPatrolConfig)},
+	"mayor/overseer.json":                           {validate: loadErr(config.LoadOverseerConfig)},
+	"settings/config.json":                          {validate: loadErr(config.LoadOrCreateTownSettings)},
+	"settings/escalation.json":                      {validate: loadErr(config.LoadEscalationConfig)},
+	"settings/prices.json":                          {validate: loadErr(prices.LoadTable)},
+	"config/messaging.json":                         {validate: loadErr(config.LoadMessagingConfig)},
+	constants.DirBeads + "/" + beads.RoutesFileName: {validate: validateRoutes, critical: true},
+}
+
+var rigSchemas = map[string]schema{
+	constants.FileConfigJSON: {validate: loadErr(config.LoadRigConfig), critical: true},
+	"settings/config.json":   {validate: loadErr(config.LoadRigSettings)},
+}
+
+// knownFiles lists the regular files expected directly in gt-managed
+// directories. Anything else there is reported as unknown.
+var knownFiles = map[string]map[string]bool{
+	constants.DirMayor: {
+		constants.FileTownJSON: true, constants.FileRigsJSON: true, constants.FileConfigJSON: true,
+		constants.FileAccountsJSON: true, constants.FileQuotaJSON: true, config.DaemonPatrolConfigFileName: true,
+		"overseer.json": true, "wasteland.json": true, "CLAUDE.md": true, "AGENTS.md": true,
+	},
+	constants.DirSettings: {
+		"config.json": true, "agents.json": true, "escalation.json": true, "experiments.json": true,
+		"prices.json": true,
+	},
+}
+
+func loadErr[T any](load func(string) (T, error)) func(string) error {
+	return func(path string) error {
+		_, err := load(path)
+		return err
+	}
+}
+
+func validateRoutes(path string) error {
+	bad, _, err := checkJSONL(path)
+	if err != nil {
+		return err
+	}
+	if bad > 0 {
+		return fmt.Errorf("%d unparseable route line(s)", bad)
+	}
+	return nil
+}
+
+// Scan walks the town and returns every problem it finds. now is used to age
+// temp files.
+func Scan(townRoot string, now time.Time) (*Report, error) {
+	// A broken rigs.json is reported by the walk; scan without rig schemas.
+	rigs, _ := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	registered := map[string]bool{}
+	if rigs != nil {
+		for name := range rigs.Rigs {
+			registered[name] = true
+		}
+	}
+
+	s := &scanner{townRoot: townRoot, registered: registered, now: now, report: &Report{TownRoot: townRoot}}
+	if err := filepath.WalkDir(townRoot, s.visit); err != nil {
+		return nil, fmt.Errorf("walking town: %w", err)
+	}
+	s.checkRegistry()
+	s.checkRoutes()
+
+	sort.SliceStable(s.report.Findings, func(i, j int) bool {
+		return s.report.Findings[i].Path < s.report.Findings[j].Path
+	})
+	return s.report, nil
+}
+
+type scanner struct {
+	townRoot   string
+	registered map[string]bool
+	now        time.Time
+	report     *Report
+}
+
+func (s *scanner) add(f *Finding) { s.report.Findings = append(s.report.Findings, f) }
+
+func (s *scanner) visit(path string, d fs.DirEntry, err error) error {
+	if err != nil {
+		if path == s.townRoot {
+			return err
+		}
+		s.add(&Finding{Path: s.rel(path), Kind: KindInvalid, Severity: SeverityWarning, Message: fmt.Sprintf("unreadable: %v", err)})
+		if d != nil && d.IsDir() {
+			return fs.SkipDir
+		}
+		return nil
+	}
+	rel := s.rel(path)
+	if d.IsDir() {
+		if path != s.townRoot && s.prune(path, rel, d.Name()) {
+			return fs.SkipDir
+		}
+		return nil
+	}
+	if !d.Type().IsRegular() {
+		return nil
+	}
+	s.report.Scanned++
+	s.checkFile(path, rel, d)
+	return nil
+}
+
+// prune skips trees fsck does not own: git clones and worktrees, the beads
+// database, and its own quarantine.
+func (s *scanner) prune(path, rel, name string) bool {
+	switch name {
+	case ".git", ".dolt", "node_modules":
+		return true
+	case constants.DirBeads:
+		return rel != constants.DirBeads // Town routes live here; rig beads are bd's business
+	}
+	if rel == QuarantineDir {
+		return true
+	}
+	if _, err := os.Lstat(filepath.Join(path, ".git")); err == nil {
+		return true
+	}
+	return false
+}
+
+func (s *scanner) checkFile(path, rel string, d fs.DirEntry) {
+	name := d.Name()
+
+	if isTempFile(name) {
+		if info, err := d.Info(); err == nil && s.now.Sub(info.ModTime()) >= LeftoverAge {
+			s.add(&Finding{Path: rel, Kind: KindLeftover, Severity: SeverityWarning,
+				Message: "leftover temp file from an interrupted write", Action: ActionQuarantine})
+		}
+		return
+	}
+
+	dir := filepath.ToSlash(filepath.Dir(rel))
+	if known, ok := knownFiles[dir]; ok && !known[name] && !strings.HasPrefix(name, ".") {
+		s.add(&Finding{Path: rel, Kind: KindUnknown, Severity: SeverityInfo,
+			Message: "not a file gt creates in " + dir + "/"})
+	}
+
+	if sc, ok := s.schemaFor(rel); ok {
+		if err := sc.validate(path); err != nil {
+			f := &Finding{Path: rel, Kind: KindInvalid, Severity: SeverityError, Message: err.Error()}
+			if sc.critical {
+				f.Message += " (critical file; repair by hand)"
+			} else {
+				f.Action = ActionQuarantine
+				f.Message += " (defaults apply once moved aside)"
+			}
+			s.add(f)
+		}
+		return
+	}
+
+	switch filepath.Ext(name) {
+	case ".json":
+		data, err := os.ReadFile(path) //nolint:gosec // G304: path comes from walking the town root
+		if err != nil || json.Valid(data) {
+			return
+		}
+		f := &Finding{Path: rel, Kind: KindInvalid, Severity: SeverityWarning, Message: "not valid JSON"}
+		if strings.HasPrefix(rel, constants.DirRuntime+"/") {
+			f.Action = ActionQuarantine
+		}
+		s.add(f)
+	case ".jsonl":
+		bad, total, err := checkJSONL(path)
+		if err != nil || bad == 0 {
+			return
+		}
+		s.add(&Finding{Path: rel, Kind: KindInvalid, Severity: SeverityWarning,
+			Message: fmt.Sprintf("%d of %d line(s) are not valid JSON", bad, total), Action: ActionTrim})
+	}
+}
+
+func (s *scanner) schemaFor(rel string) (schema, bool) {
+	if sc, ok := townSchemas[rel]; ok {
+		return sc, true
+	}
+	rig, rest, ok := strings.Cut(rel, "/")
+	if !ok || !s.registered[rig] {
+		return schema{}, false
+	}
+	sc, ok := rigSchemas[rest]
+	return sc, ok
+}
+
+// checkRegistry cross-checks rigs.json against the rig directories on disk.
+func (s *scanner) checkRegistry() {
+	for name := range s.registered {
+		if info, err := os.Stat(filepath.Join(s.townRoot, name)); err != nil || !info.IsDir() {
+			s.add(&Finding{Path: "mayor/" + constants.FileRigsJSON, Kind: KindDangling, Severity: SeverityError,
+				Message: fmt.Sprintf("rig %q is registered but %s/ does not exist (gt rig remove %s)", name, name, name)})
+		}
+	}
+
+	entries, err := os.ReadDir(s.townRoot)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if !e.IsDir() || s.registered[e.Name()] {
+			continue
+		}
+		cfg, err := config.LoadRigConfig(filepath.Join(s.townRoot, e.Name(), constants.FileConfigJSON))
+		if err != nil || cfg.Type != "rig" {
+			continue
+		}
+		s.add(&Finding{Path: e.Name() + "/" + constants.FileConfigJSON, Kind: KindUnregistered, Severity: SeverityWarning,
+			Message: fmt.Sprintf("rig %q has a config but is not in rigs.json (gt rig add %s --adopt)", e.Name(), e.Name())})
+	}
+}
+
+// checkRoutes verifies every route points at an existing beads directory.
+func (s *scanner) checkRoutes() {
+	routes, err := beads.LoadRoutes(filepath.Join(s.townRoot, constants.DirBeads))
+	if err != nil {
+		return // Reported as invalid by the walk
+	}
+	for _, r := range routes {
+		target := filepath.Join(s.townRoot, r.Path)
+		if _, err := os.Stat(target); err != nil {
+			s.add(&Finding{Path: constants.DirBeads + "/" + beads.RoutesFileName, Kind: KindDangling, Severity: SeverityWarning,
+				Message: fmt.Sprintf("route %s points at missing %s", r.Prefix, r.Path)})
+		}
+	}
+}
+
+func (s *scanner) rel(path string) string {
+	rel, err := filepath.Rel(s.townRoot, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// isTempFile reports whether name looks like an atomic-write temp file.
+func isTempFile(name string) bool {
+	return strings.HasSuffix(name, ".tmp") || strings.Contains(name, ".tmp.")
+}
+
+// checkJSONL counts unparseable non-blank lines.
+func checkJSONL(path string) (bad, total int, err error) {
+	f, err := os.Open(path) //nolint:gosec // G304: path comes from walking the town root
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		total++
+		if !json.Valid(line) {
+			bad++
+		}
+	}
+	return bad, total, sc.Err()
+}
@@ -0,0 +1,228 @@
+package fsck
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+func writeFile(t *testing.T, root, rel, content string) string {
+	t.Helper()
+	path := filepath.Join(root, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// newTown builds a minimal healthy town with one registered rig.
+func newTown(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	writeFile(t, root, "mayor/town.json", `{"type":"town","version":2,"name":"test"}`)
+	writeFile(t, root, "mayor/rigs.json", `{"version":1,"rigs":{"gastown":{"git_url":"https://example.com/g.git"}}}`)
+	writeFile(t, root, "gastown/config.json", `{"type":"rig","version":1,"name":"gastown"}`)
+	writeFile(t, root, ".beads/routes.jsonl", `{"prefix":"gt-","path":"gastown"}`+"\n")
+	return root
+}
+
+func findingsFor(r *Report, path string) []*Finding {
+	var out []*Finding
+	for _, f := range r.Findings {
+		if f.Path == path {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func TestScanHealthyTown(t *testing.T) {
+	root := newTown(t)
+	r, err := Scan(root, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Findings) != 0 {
+		for _, f := range r.Findings {
+			t.Errorf("unexpected finding: %+v", f)
+		}
+	}
+	if r.Scanned != 4 {
+		t.Errorf("Scanned = %d, want 4", r.Scanned)
+	}
+}
+
+func TestScanFindsProblems(t *testing.T) {
+	root := newTown(t)
+	now := time.Now()
+	writeFile(t, root, "mayor/daemon.json", `{not json`)
+	writeFile(t, root, "mayor/notes.txt", "hi")
+	writeFile(t, root, ".runtime/state.json", `{"a":`)
+	writeFile(t, root, ".runtime/log.jsonl", "{\"ok\":1}\n{\"torn\n")
+	tmp := writeFile(t, root, "settings/config.json.tmp.123", "{}")
+	old := now.Add(-2 * LeftoverAge)
+	if err := os.Chtimes(tmp, old, old); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, root, "settings/fresh.json.tmp.456", "{}")
+	writeFile(t, root, "orphan/config.json", `{"type":"rig","version":1,"name":"orphan"}`)
+	writeFile(t, root, "gastown/polecats/toast/.git", "gitdir: elsewhere")
+	writeFile(t, root, "gastown/polecats/toast/broken.json", "{")
+
+	r, err := Scan(root, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]struct {
+		kind   Kind
+		action Action
+	}{
+		"mayor/daemon.json":            {KindInvalid, ActionQuarantine},
+		"mayor/notes.txt":              {KindUnknown, ActionNone},
+		".runtime/state.json":          {KindInvalid, ActionQuarantine},
+		".runtime/log.jsonl":           {KindInvalid, ActionTrim},
+		"settings/config.json.tmp.123": {KindLeftover, ActionQuarantine},
+		"orphan/config.json":           {KindUnregistered, ActionNone},
+	}
+	for path, w := range want {
+		fs := findingsFor(r, path)
+		if len(fs) != 1 || fs[0].Kind != w.kind || fs[0].Action != w.action {
+			t.Errorf("%s: findings = %+v, want kind %s action %q", path, fs, w.kind, w.action)
+		}
+	}
+	if len(r.Findings) != len(want) {
+		for _, f := range r.Findings {
+			t.Logf("finding: %+v", f)
+		}
+		t.Errorf("got %d findings, want %d (fresh temp files and worktrees must be skipped)", len(r.Findings), len(want))
+	}
+}
+
+func TestScanReferentialIntegrity(t *testing.T) {
+	root := newTown(t)
+	writeFile(t, root, "mayor/rigs.json", `{"version":1,"rigs":{"gastown":{},"ghost":{}}}`)
+	writeFile(t, root, ".beads/routes.jsonl", `{"prefix":"gt-","path":"gastown"}`+"\n"+`{"prefix":"gh-","path":"ghost/mayor/rig"}`+"\n")
+
+	r, err := Scan(root, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dangling []string
+	for _, f := range r.Findings {
+		if f.Kind == KindDangling {
+			dangling = append(dangling, f.Message)
+		}
+	}
+	if len(dangling) != 2 || !strings.Contains(strings.Join(dangling, "|"), `rig "ghost"`) {
+		t.Errorf("dangling findings = %v", dangling)
+	}
+	if len(r.Unresolved(SeverityError)) != 1 {
+		t.Errorf("want the missing rig dir as the only error, got %+v", r.Unresolved(SeverityError))
+	}
+}
+
+func TestScanCriticalFileNotRepairable(t *testing.T) {
+	root := newTown(t)
+	writeFile(t, root, "mayor/town.json", `{"type":"town"}`) // missing name
+
+	r, err := Scan(root, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs := findingsFor(r, "mayor/town.json")
+	if len(fs) != 1 || fs[0].Severity != SeverityError || fs[0].Action != ActionNone {
+		t.Errorf("town.json findings = %+v", fs)
+	}
+}
+
+func TestRepair(t *testing.T) {
+	root := newTown(t)
+	now := time.Now()
+	writeFile(t, root, "mayor/daemon.json", `{not json`)
+	writeFile(t, root, ".runtime/log.jsonl", "{\"ok\":1}\n{\"torn\n{\"ok\":2}\n")
+	tmp := writeFile(t, root, "mayor/rigs.json.tmp.9", "{}")
+	old := now.Add(-2 * LeftoverAge)
+	if err := os.Chtimes(tmp, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := Scan(root, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := Repair(r, now); n != 3 {
+		t.Fatalf("Repair() = %d, want 3; findings %+v", n, r.Findings)
+	}
+
+	if _, err := os.Stat(tmp); !os.IsNotExist(err) {
+		t.Error("leftover temp file should be moved aside")
+	}
+	if _, err := os.Stat(filepath.Join(root, "mayor", "daemon.json")); !os.IsNotExist(err) {
+		t.Error("invalid daemon.json should be moved aside")
+	}
+	data, err := os.ReadFile(filepath.Join(root, ".runtime", "log.jsonl"))
+	if err != nil || string(data) != "{\"ok\":1}\n{\"ok\":2}\n" {
+		t.Errorf("trimmed log = %q, %v", data, err)
+	}
+	for _, f := range r.Findings {
+		if !strings.HasPrefix(f.MovedTo, QuarantineDir+"/") {
+			t.Errorf("%s moved to %q, want under %s", f.Path, f.MovedTo, QuarantineDir)
+		} else if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(f.MovedTo))); err != nil {
+			t.Errorf("quarantined copy of %s missing: %v", f.Path, err)
+		}
+	}
+
+	// A second scan is clean: quarantine is not rescanned.
+	again, err := Scan(root, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Findings) != 0 {
+		t.Errorf("findings after repair = %+v", again.Findings)
+	}
+}
+
+func TestTrimJSONLWaitsForWriterLock(t *testing.T) {
+	root := newTown(t)
+	path := writeFile(t, root, ".events.jsonl", "{\"ok\":1}\n{\"torn\n")
+	fl := flock.New(path + ".lock")
+	if err := fl.Lock(); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := trimJSONL(root, filepath.Join(root, QuarantineDir, "run"), ".events.jsonl")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("trim finished while a writer held the lock: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// An append made under the lock survives the trim.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString("{\"ok\":2}\n")
+	_ = f.Close()
+	_ = fl.Unlock()
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "{\"ok\":1}\n{\"ok\":2}\n" {
+		t.Errorf("trimmed file = %q, %v", data, err)
+	}
+}
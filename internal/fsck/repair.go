@@ -0,0 +1,104 @@
+package fsck
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+// Repair applies each finding's action and records the outcome on the
+// finding. Files that are replaced or moved aside go to a per-run directory
+// under QuarantineDir, keeping their town-relative path. Returns the number
+// of findings repaired.
+func Repair(r *Report, now time.Time) int {
+	runDir := filepath.Join(r.TownRoot, filepath.FromSlash(QuarantineDir), now.UTC().Format("20060102-150405"))
+	repaired := 0
+	for _, f := range r.Findings {
+		if f.Action == ActionNone {
+			continue
+		}
+		var err error
+		switch f.Action {
+		case ActionQuarantine:
+			f.MovedTo, err = quarantine(r.TownRoot, runDir, f.Path)
+		case ActionTrim:
+			f.MovedTo, err = trimJSONL(r.TownRoot, runDir, f.Path)
+		default:
+			err = fmt.Errorf("unknown action %q", f.Action)
+		}
+		if err != nil {
+			f.RepairErr = err.Error()
+			continue
+		}
+		f.Repaired = true
+		repaired++
+	}
+	return repaired
+}
+
+// quarantine moves rel into runDir and returns its new town-relative path.
+func quarantine(townRoot, runDir, rel string) (string, error) {
+	dst := filepath.Join(runDir, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return "", fmt.Errorf("creating quarantine: %w", err)
+	}
+	if err := os.Rename(filepath.Join(townRoot, filepath.FromSlash(rel)), dst); err != nil {
+		return "", fmt.Errorf("quarantining: %w", err)
+	}
+	moved, err := filepath.Rel(townRoot, dst)
+	if err != nil {
+		return dst, nil
+	}
+	return filepath.ToSlash(moved), nil
+}
+
+// trimJSONL rewrites rel without its unparseable lines, quarantining the
+// original first. It holds the file's "<path>.lock" flock, the lock every
+// JSONL appender takes, so no event written meanwhile is lost.
+func trimJSONL(townRoot, runDir, rel string) (string, error) {
+	path := filepath.Join(townRoot, filepath.FromSlash(rel))
+	fl := flock.New(path + ".lock")
+	if err := fl.Lock(); err != nil {
+		return "", fmt.Errorf("acquiring lock: %w", err)
+	}
+	defer fl.Unlock() //nolint:errcheck // best-effort unlock
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path comes from a scan of the town root
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	var kept bytes.Buffer
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 || !json.Valid(line) {
+			continue
+		}
+		kept.Write(line)
+		kept.WriteByte('\n')
+	}
+	if err := sc.Err(); err != nil {
+		return "", err
+	}
+
+	moved, err := quarantine(townRoot, runDir, rel)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, kept.Bytes(), info.Mode().Perm()); err != nil {
+		return moved, fmt.Errorf("rewriting: %w", err)
+	}
+	return moved, nil
+}
@@ -9,7 +9,9 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // GitError contains raw output from a git command for agent observation.
@@ -129,6 +131,25 @@ func (g *Git) runWithEnv(args []string, extraEnv []string) (_ string, _ error) {
 	return strings.TrimSpace(stdout.String()), nil
 }
 
+// runWithInput executes a git command with input on stdin.
+func (g *Git) runWithInput(input string, args ...string) (string, error) {
+	if g.gitDir != "" {
+		args = append([]string{"--git-dir=" + g.gitDir}, args...)
+	}
+	cmd := exec.Command("git", args...)
+	if g.workDir != "" {
+		cmd.Dir = g.workDir
+	}
+	cmd.Stdin = strings.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", g.wrapError(err, stdout.String(), stderr.String(), args)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
 // wrapError wraps git errors with context.
 // ZFC: Returns GitError with raw output for agent observation.
 // Does not detect or interpret error types - agents should observe and decide.
@@ -1577,6 +1598,116 @@ func (g *Git) PruneStaleBranches(pattern string, dryRun bool) ([]PrunedBranch, e
 	return pruned, nil
 }
 
+// ContentMerged reports whether base already contains branch's changes,
+// even if they landed as a squash or rebase rather than a merge: merging
+// branch into base would leave base's tree unchanged. Requires git 2.38+
+// (merge-tree --write-tree).
+func (g *Git) ContentMerged(branch, base string) (bool, error) {
+	if ancestor, err := g.IsAncestor(branch, base); err == nil && ancestor {
+		return true, nil
+	}
+	merged, err := g.run("merge-tree", "--write-tree", base, branch)
+	if err != nil {
+		// Exit status 1 is a conflicted merge: the changes aren't in base.
+		if strings.Contains(err.Error(), "exit status 1") {
+			return false, nil
+		}
+		return false, err
+	}
+	baseTree, err := g.run("rev-parse", base+"^{tree}")
+	if err != nil {
+		return false, err
+	}
+	return strings.SplitN(merged, "\n", 2)[0] == baseTree, nil
+}
+
+// FormatPatch returns the commits on branch that aren't on base as an
+// mbox patch series (git format-patch --stdout), or "" if there are none.
+func (g *Git) FormatPatch(base, branch string) (string, error) {
+	out, err := g.run("format-patch", "--stdout", base+".."+branch)
+	if err != nil || out == "" {
+		return "", err
+	}
+	return out + "\n", nil
+}
+
+// MergeBase returns the best common ancestor of a and b.
+func (g *Git) MergeBase(a, b string) (string, error) {
+	return g.run("merge-base", a, b)
+}
+
+// WorkingDiff returns everything in the worktree that differs from base,
+// committed or not and including untracked files, as a binary-safe unified
+// diff, or "" if nothing differs. The real index is left untouched.
+func (g *Git) WorkingDiff(base string) (string, error) {
+	tmp, err := os.CreateTemp("", "gt-index-*")
+	if err != nil {
+		return "", err
+	}
+	index := tmp.Name()
+	_ = tmp.Close()
+	defer os.Remove(index)
+
+	env := []string{"GIT_INDEX_FILE=" + index}
+	if _, err := g.runWithEnv([]string{"read-tree", "HEAD"}, env); err != nil {
+		return "", err
+	}
+	if _, err := g.runWithEnv([]string{"add", "-A"}, env); err != nil {
+		return "", err
+	}
+	out, err := g.runWithEnv([]string{"diff", "--cached", "--binary", base}, env)
+	if err != nil || out == "" {
+		return "", err
+	}
+	return out + "\n", nil
+}
+
+// Blob is a file version in a repository's history.
+type Blob struct {
+	Path string
+	Size int64
+}
+
+// NewBlobs returns the blobs reachable from branch but not from base: every
+// file version the branch's commits would add to the repo on merge,
+// including ones a later commit on the branch deleted again.
+func (g *Git) NewBlobs(base, branch string) ([]Blob, error) {
+	objects, err := g.run("rev-list", "--objects", base+".."+branch)
+	if err != nil || objects == "" {
+		return nil, err
+	}
+	out, err := g.runWithInput(objects+"\n", "cat-file", "--batch-check=%(objecttype) %(objectsize) %(rest)")
+	if err != nil {
+		return nil, err
+	}
+	var blobs []Blob
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) < 3 || fields[0] != "blob" {
+			continue
+		}
+		size, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		blobs = append(blobs, Blob{Path: fields[2], Size: size})
+	}
+	return blobs, nil
+}
+
+// CommitTime returns the committer time of a ref's commit.
+func (g *Git) CommitTime(ref string) (time.Time, error) {
+	out, err := g.run("log", "-1", "--format=%ct", ref)
+	if err != nil {
+		return time.Time{}, err
+	}
+	secs, err := strconv.ParseInt(out, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing commit time %q: %w", out, err)
+	}
+	return time.Unix(secs, 0), nil
+}
+
 // SubmoduleChange represents a changed submodule pointer between two refs.
 type SubmoduleChange struct {
 	Path   string // Submodule path relative to repo root
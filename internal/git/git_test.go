@@ -1587,3 +1587,89 @@ func TestClearPushURL(t *testing.T) {
 		t.Errorf("ClearPushURL (idempotent) should not error, got: %v", err)
 	}
 }
+
+func TestWorkingDiff(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	base, err := g.run("rev-parse", "HEAD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff, err := g.WorkingDiff(base); err != nil || diff != "" {
+		t.Fatalf("clean WorkingDiff = %q, %v; want empty", diff, err)
+	}
+
+	// One committed change, one unstaged edit, one untracked file
+	if err := os.WriteFile(filepath.Join(dir, "committed.txt"), []byte("c\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.run("add", "committed.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.run("commit", "-m", "wip"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Edited\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "untracked.txt"), []byte("u\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := g.WorkingDiff(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"committed.txt", "+# Edited", "untracked.txt"} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("WorkingDiff missing %q:\n%s", want, diff)
+		}
+	}
+
+	// The real index must be untouched: untracked.txt is still untracked
+	status, err := g.run("status", "--porcelain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(status, "?? untracked.txt") {
+		t.Errorf("WorkingDiff changed the index; status:\n%s", status)
+	}
+}
+
+func TestReadHead(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	want, err := g.Rev("HEAD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ReadHead(dir); got != want {
+		t.Errorf("ReadHead = %q, want %q", got, want)
+	}
+
+	sub := filepath.Join(dir, "sub")
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if got := ReadHead(sub); got != want {
+		t.Errorf("ReadHead from a subdirectory = %q, want %q", got, want)
+	}
+
+	// A linked worktree resolves its branch through the common git dir,
+	// including refs that were packed.
+	wt := filepath.Join(t.TempDir(), "wt")
+	if err := g.WorktreeAdd(wt, "feature"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.run("pack-refs", "--all"); err != nil {
+		t.Fatal(err)
+	}
+	if got := ReadHead(wt); got != want {
+		t.Errorf("ReadHead in a worktree = %q, want %q", got, want)
+	}
+
+	if got := ReadHead(t.TempDir()); got != "" {
+		t.Errorf("ReadHead outside a repository = %q, want empty", got)
+	}
+}
@@ -0,0 +1,81 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ReadHead returns the commit checked out in the repository containing dir,
+// read from .git directly instead of running git, for hot paths such as
+// cache keys. A symbolic ref whose target cannot be found is returned as the
+// ref name; "" means no repository was found.
+func ReadHead(dir string) string {
+	gitDir := findGitDir(dir)
+	if gitDir == "" {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(gitDir, "HEAD")) //nolint:gosec // G304: path is inside the repository's git dir
+	if err != nil {
+		return ""
+	}
+	head := strings.TrimSpace(string(data))
+	ref, ok := strings.CutPrefix(head, "ref: ")
+	if !ok {
+		return head // Detached
+	}
+
+	// Linked worktrees keep branch refs in the common git dir.
+	commonDir := gitDir
+	if data, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil { //nolint:gosec // G304: path is inside the repository's git dir
+		commonDir = resolveFrom(gitDir, strings.TrimSpace(string(data)))
+	}
+	for _, d := range []string{gitDir, commonDir} {
+		if data, err := os.ReadFile(filepath.Join(d, filepath.FromSlash(ref))); err == nil { //nolint:gosec // G304: path is inside the repository's git dir
+			return strings.TrimSpace(string(data))
+		}
+	}
+	if data, err := os.ReadFile(filepath.Join(commonDir, "packed-refs")); err == nil { //nolint:gosec // G304: path is inside the repository's git dir
+		for _, line := range strings.Split(string(data), "\n") {
+			if sha, name, ok := strings.Cut(line, " "); ok && name == ref {
+				return sha
+			}
+		}
+	}
+	return ref
+}
+
+// findGitDir returns the git dir of the repository containing dir: the
+// nearest .git directory, or the target of a .git file (linked worktrees
+// and submodules).
+func findGitDir(dir string) string {
+	for {
+		path := filepath.Join(dir, ".git")
+		if info, err := os.Stat(path); err == nil {
+			if info.IsDir() {
+				return path
+			}
+			data, err := os.ReadFile(path) //nolint:gosec // G304: path is a .git file
+			if err != nil {
+				return ""
+			}
+			target, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+			if !ok {
+				return ""
+			}
+			return resolveFrom(dir, strings.TrimSpace(target))
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+func resolveFrom(base, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(base, path)
+}
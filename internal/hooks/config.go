@@ -227,6 +227,8 @@ func DefaultOverrides() map[string]*HooksConfig {
 		// Blocks patrol formulas from using persistent molecules — must use wisps.
 		// Without this, witnesses could accidentally create permanent patrol molecules
 		// that survive session restarts and accumulate unbounded.
+		// All patrol roles also get the dry-run guard, which blocks mutating
+		// tool use while a patrol runs dry (gt patrol new --dry-run).
 		"witness": {
 			PreToolUse: []HookEntry{
 				{
@@ -257,6 +259,14 @@ func DefaultOverrides() map[string]*HooksConfig {
 						Command: "echo '❌ BLOCKED: Patrol formulas must use wisps, not persistent molecules.' && echo 'Use: bd mol wisp mol-*-patrol' && echo 'Not:  bd mol pour mol-*-patrol' && exit 2",
 					}},
 				},
+				{
+					// Dry-run guard: allows everything unless the patrol runs dry.
+					Matcher: dryRunGuardMatcher,
+					Hooks: []Hook{{
+						Type:    "command",
+						Command: fmt.Sprintf("%s && gt tap guard dry-run", pathSetup),
+					}},
+				},
 			},
 		},
 		// Deacon roles: patrol-formula-guard (same as witness).
@@ -291,6 +301,14 @@ func DefaultOverrides() map[string]*HooksConfig {
 						Command: "echo '❌ BLOCKED: Patrol formulas must use wisps, not persistent molecules.' && echo 'Use: bd mol wisp mol-*-patrol' && echo 'Not:  bd mol pour mol-*-patrol' && exit 2",
 					}},
 				},
+				{
+					// Dry-run guard: allows everything unless the patrol runs dry.
+					Matcher: dryRunGuardMatcher,
+					Hooks: []Hook{{
+						Type:    "command",
+						Command: fmt.Sprintf("%s && gt tap guard dry-run", pathSetup),
+					}},
+				},
 			},
 		},
 		// Refinery roles: patrol-formula-guard (same as witness).
@@ -325,11 +343,23 @@ func DefaultOverrides() map[string]*HooksConfig {
 						Command: "echo '❌ BLOCKED: Patrol formulas must use wisps, not persistent molecules.' && echo 'Use: bd mol wisp mol-*-patrol' && echo 'Not:  bd mol pour mol-*-patrol' && exit 2",
 					}},
 				},
+				{
+					// Dry-run guard: allows everything unless the patrol runs dry.
+					Matcher: dryRunGuardMatcher,
+					Hooks: []Hook{{
+						Type:    "command",
+						Command: fmt.Sprintf("%s && gt tap guard dry-run", pathSetup),
+					}},
+				},
 			},
 		},
 	}
 }
 
+// dryRunGuardMatcher limits the dry-run guard to the tools it can block, so
+// read-only tool calls do not spawn gt.
+const dryRunGuardMatcher = "Bash|Edit|MultiEdit|Write|NotebookEdit"
+
 // ComputeExpected computes the expected HooksConfig for a target by loading
 // the base config and applying all applicable overrides in order of specificity.
 // If no base config exists, uses DefaultBase().
@@ -0,0 +1,373 @@
+// Package inbox aggregates everything waiting on the overseer into one
+// queue: pending approval requests, unacknowledged escalations, review asks,
+// and critical doctor findings.
+//
+// Approvals and escalations are read from where they already live; review
+// asks and doctor findings are stored one file per item under
+// .runtime/inbox/items/. Snoozes and SLA escalations are tracked in
+// .runtime/inbox/state.json, so acting on an item in its own tool (gt
+// approval, gt escalate) removes it from the inbox too.
+package inbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/approval"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Item kinds.
+const (
+	KindApproval   = "approval"
+	KindEscalation = "escalation"
+	KindReview     = "review"
+	KindDoctor     = "doctor"
+)
+
+// DefaultSLA is how long an item may wait before it escalates.
+const DefaultSLA = 4 * time.Hour
+
+// DefaultNotify are the addresses told about overdue items.
+var DefaultNotify = []string{"overseer", "mayor/"}
+
+// ErrNotFound is returned when an item is not in the inbox.
+var ErrNotFound = errors.New("inbox item not found")
+
+// Item is one thing waiting on the overseer.
+type Item struct {
+	ID           string     `json:"id"`
+	Kind         string     `json:"kind"`
+	Title        string     `json:"title"`
+	Detail       string     `json:"detail,omitempty"`
+	From         string     `json:"from,omitempty"`
+	Ref          string     `json:"ref,omitempty"` // Approval ID, bead ID, or check name
+	CreatedAt    time.Time  `json:"created_at"`
+	SnoozedUntil *time.Time `json:"snoozed_until,omitempty"`
+	EscalatedAt  *time.Time `json:"escalated_at,omitempty"`
+}
+
+// Snoozed reports whether the item is hidden until later.
+func (it *Item) Snoozed(now time.Time) bool {
+	return it.SnoozedUntil != nil && now.Before(*it.SnoozedUntil)
+}
+
+// Source lists items from one place.
+type Source func() ([]*Item, error)
+
+// Sources returns the town's item sources: stored items, pending approvals,
+// and unacknowledged escalations.
+func Sources(townRoot string) []Source {
+	return []Source{
+		func() ([]*Item, error) { return listStored(townRoot) },
+		func() ([]*Item, error) { return listApprovals(townRoot) },
+		func() ([]*Item, error) { return listEscalations(townRoot) },
+	}
+}
+
+// Collect gathers items from sources, applies snoozes and escalation marks,
+// and returns them oldest first. A failing source is skipped and reported
+// in the returned error; the other sources' items are still returned.
+func Collect(townRoot string, sources []Source) ([]*Item, error) {
+	state, err := loadState(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	var items []*Item
+	var errs []error
+	for _, src := range sources {
+		found, err := src()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, found...)
+	}
+	for _, it := range items {
+		if t, ok := state.Snoozed[it.ID]; ok {
+			t := t
+			it.SnoozedUntil = &t
+		}
+		if t, ok := state.Escalated[it.ID]; ok {
+			t := t
+			it.EscalatedAt = &t
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].CreatedAt.Before(items[j].CreatedAt) })
+	return items, errors.Join(errs...)
+}
+
+// Find returns the item with the given ID.
+func Find(items []*Item, id string) (*Item, error) {
+	for _, it := range items {
+		if it.ID == id {
+			return it, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+}
+
+// Add stores a review ask or doctor finding. Adding an item whose ID is
+// already stored updates it but keeps its original CreatedAt, so a finding
+// reported by every doctor run still ages toward the SLA.
+func Add(townRoot string, it *Item) error {
+	if it.Kind != KindReview && it.Kind != KindDoctor {
+		return fmt.Errorf("cannot add %q items (only %s and %s)", it.Kind, KindReview, KindDoctor)
+	}
+	if strings.TrimSpace(it.Title) == "" {
+		return fmt.Errorf("inbox item needs a title")
+	}
+	now := time.Now().UTC()
+	if it.ID == "" {
+		it.ID = "ib-" + strconv.FormatInt(now.UnixNano(), 36)
+	}
+	if existing, err := loadStored(townRoot, it.ID); err == nil {
+		it.CreatedAt = existing.CreatedAt
+	} else if it.CreatedAt.IsZero() {
+		it.CreatedAt = now
+	}
+	return util.EnsureDirAndWriteJSON(itemPath(townRoot, it.ID), it)
+}
+
+// Resolve removes a stored item and its snooze/escalation state.
+func Resolve(townRoot, id string) error {
+	if err := os.Remove(itemPath(townRoot, id)); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%w: %s", ErrNotFound, id)
+		}
+		return fmt.Errorf("removing inbox item %s: %w", id, err)
+	}
+	return Forget(townRoot, id)
+}
+
+// Forget drops an item's snooze and escalation state, e.g. after it was
+// acted on in its own tool.
+func Forget(townRoot, id string) error {
+	state, err := loadState(townRoot)
+	if err != nil {
+		return err
+	}
+	delete(state.Snoozed, id)
+	delete(state.Escalated, id)
+	return saveState(townRoot, state)
+}
+
+// Snooze hides an item until the given time. Snoozing also restarts its SLA
+// escalation: if it is still waiting when the snooze ends, it may escalate again.
+func Snooze(townRoot, id string, until time.Time) error {
+	state, err := loadState(townRoot)
+	if err != nil {
+		return err
+	}
+	state.Snoozed[id] = until.UTC()
+	delete(state.Escalated, id)
+	return saveState(townRoot, state)
+}
+
+// Policy holds the inbox SLA settings.
+type Policy struct {
+	SLA    time.Duration // 0 disables escalation
+	Notify []string
+}
+
+// NewPolicy builds a policy from town settings; nil uses the defaults.
+func NewPolicy(cfg *config.InboxConfig) *Policy {
+	p := &Policy{SLA: DefaultSLA, Notify: DefaultNotify}
+	if cfg == nil {
+		return p
+	}
+	p.SLA = config.ParseDurationOrDefault(cfg.SLA, DefaultSLA)
+	if len(cfg.Notify) > 0 {
+		p.Notify = cfg.Notify
+	}
+	return p
+}
+
+// LoadPolicy reads the inbox policy from town settings.
+func LoadPolicy(townRoot string) (*Policy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return NewPolicy(settings.Inbox), nil
+}
+
+// Overdue returns the items that have waited longer than the SLA, are not
+// snoozed, and have not escalated yet. A snoozed item's wait counts from
+// the end of its snooze.
+func (p *Policy) Overdue(items []*Item, now time.Time) []*Item {
+	if p.SLA <= 0 {
+		return nil
+	}
+	var out []*Item
+	for _, it := range items {
+		if it.EscalatedAt != nil || it.Snoozed(now) {
+			continue
+		}
+		since := it.CreatedAt
+		if it.SnoozedUntil != nil && it.SnoozedUntil.After(since) {
+			since = *it.SnoozedUntil
+		}
+		if now.Sub(since) >= p.SLA {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+// MarkEscalated records that items escalated at now, so each escalates once.
+func MarkEscalated(townRoot string, items []*Item, now time.Time) error {
+	if len(items) == 0 {
+		return nil
+	}
+	state, err := loadState(townRoot)
+	if err != nil {
+		return err
+	}
+	for _, it := range items {
+		state.Escalated[it.ID] = now.UTC()
+	}
+	return saveState(townRoot, state)
+}
+
+// Dir returns the inbox's runtime directory.
+func Dir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "inbox")
+}
+
+func itemPath(townRoot, id string) string {
+	return filepath.Join(Dir(townRoot), "items", id+".json")
+}
+
+func loadStored(townRoot, id string) (*Item, error) {
+	data, err := os.ReadFile(itemPath(townRoot, id)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil, err
+	}
+	var it Item
+	if err := json.Unmarshal(data, &it); err != nil {
+		return nil, fmt.Errorf("parsing inbox item %s: %w", id, err)
+	}
+	return &it, nil
+}
+
+func listStored(townRoot string) ([]*Item, error) {
+	entries, err := os.ReadDir(filepath.Join(Dir(townRoot), "items"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading inbox items: %w", err)
+	}
+	var out []*Item
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		it, err := loadStored(townRoot, strings.TrimSuffix(e.Name(), ".json"))
+		if err != nil {
+			continue
+		}
+		out = append(out, it)
+	}
+	return out, nil
+}
+
+func listApprovals(townRoot string) ([]*Item, error) {
+	reqs, err := approval.List(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Item
+	for _, r := range reqs {
+		if !r.IsPending() {
+			continue
+		}
+		out = append(out, &Item{
+			ID:        r.ID,
+			Kind:      KindApproval,
+			Title:     fmt.Sprintf("Approve %s %s?", r.Action, r.Target),
+			Detail:    r.Reason,
+			From:      r.RequestedBy,
+			Ref:       r.ID,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	return out, nil
+}
+
+func listEscalations(townRoot string) ([]*Item, error) {
+	issues, err := beads.New(townRoot).ListEscalations()
+	if err != nil {
+		return nil, fmt.Errorf("listing escalations: %w", err)
+	}
+	var out []*Item
+	for _, issue := range issues {
+		fields := beads.ParseEscalationFields(issue.Description)
+		if fields != nil && fields.AckedBy != "" {
+			continue
+		}
+		it := &Item{
+			ID:    issue.ID,
+			Kind:  KindEscalation,
+			Title: issue.Title,
+			Ref:   issue.ID,
+		}
+		if fields != nil {
+			it.From = fields.EscalatedBy
+			it.Detail = fields.Reason
+			if fields.Severity != "" {
+				it.Title = fmt.Sprintf("[%s] %s", fields.Severity, issue.Title)
+			}
+		}
+		if t, err := time.Parse(time.RFC3339, issue.CreatedAt); err == nil {
+			it.CreatedAt = t
+		}
+		out = append(out, it)
+	}
+	return out, nil
+}
+
+// state tracks snoozes and SLA escalations by item ID.
+type state struct {
+	Snoozed   map[string]time.Time `json:"snoozed"`
+	Escalated map[string]time.Time `json:"escalated"`
+}
+
+func statePath(townRoot string) string {
+	return filepath.Join(Dir(townRoot), "state.json")
+}
+
+func loadState(townRoot string) (*state, error) {
+	s := &state{}
+	data, err := os.ReadFile(statePath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading inbox state: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, s); err != nil {
+			return nil, fmt.Errorf("parsing inbox state: %w", err)
+		}
+	}
+	if s.Snoozed == nil {
+		s.Snoozed = map[string]time.Time{}
+	}
+	if s.Escalated == nil {
+		s.Escalated = map[string]time.Time{}
+	}
+	return s, nil
+}
+
+func saveState(townRoot string, s *state) error {
+	return util.EnsureDirAndWriteJSON(statePath(townRoot), s)
+}
@@ -0,0 +1,100 @@
+package inbox
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestAddResolveAndCollect(t *testing.T) {
+	townRoot := t.TempDir()
+	first := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	if err := Add(townRoot, &Item{ID: "dr-dolt", Kind: KindDoctor, Title: "dolt down", CreatedAt: first}); err != nil {
+		t.Fatal(err)
+	}
+	// Re-adding (the next doctor run) keeps the original CreatedAt.
+	if err := Add(townRoot, &Item{ID: "dr-dolt", Kind: KindDoctor, Title: "dolt still down"}); err != nil {
+		t.Fatal(err)
+	}
+	review := &Item{Kind: KindReview, Title: "look at gt-4f2", CreatedAt: first.Add(time.Hour)}
+	if err := Add(townRoot, review); err != nil {
+		t.Fatal(err)
+	}
+	if err := Add(townRoot, &Item{Kind: KindApproval, Title: "x"}); err == nil {
+		t.Error("Add accepted an approval item")
+	}
+
+	failing := func() ([]*Item, error) { return nil, errors.New("beads down") }
+	older := func() ([]*Item, error) {
+		return []*Item{{ID: "ap-1", Kind: KindApproval, Title: "deploy?", CreatedAt: first.Add(-time.Hour)}}, nil
+	}
+	items, err := Collect(townRoot, []Source{func() ([]*Item, error) { return listStored(townRoot) }, failing, older})
+	if err == nil {
+		t.Error("Collect hid the failing source")
+	}
+	if len(items) != 3 || items[0].ID != "ap-1" || items[1].ID != "dr-dolt" || items[2].ID != review.ID {
+		t.Fatalf("items = %+v", items)
+	}
+	if !items[1].CreatedAt.Equal(first) || items[1].Title != "dolt still down" {
+		t.Errorf("re-added item = %+v", items[1])
+	}
+
+	if err := Resolve(townRoot, "dr-dolt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Resolve(townRoot, "dr-dolt"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("second Resolve = %v, want ErrNotFound", err)
+	}
+}
+
+func TestOverdueAndSnooze(t *testing.T) {
+	townRoot := t.TempDir()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	src := func() ([]*Item, error) {
+		return []*Item{
+			{ID: "old", Kind: KindReview, CreatedAt: now.Add(-5 * time.Hour)},
+			{ID: "new", Kind: KindReview, CreatedAt: now.Add(-time.Hour)},
+			{ID: "napping", Kind: KindReview, CreatedAt: now.Add(-6 * time.Hour)},
+		}, nil
+	}
+	if err := Snooze(townRoot, "napping", now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPolicy(&config.InboxConfig{SLA: "4h"})
+	items, err := Collect(townRoot, []Source{src})
+	if err != nil {
+		t.Fatal(err)
+	}
+	overdue := p.Overdue(items, now)
+	if len(overdue) != 1 || overdue[0].ID != "old" {
+		t.Fatalf("overdue = %+v, want [old]", overdue)
+	}
+
+	// Escalates once.
+	if err := MarkEscalated(townRoot, overdue, now); err != nil {
+		t.Fatal(err)
+	}
+	items, _ = Collect(townRoot, []Source{src})
+	if got := p.Overdue(items, now.Add(time.Hour)); len(got) != 0 {
+		t.Errorf("escalated item escalated again: %+v", got)
+	}
+
+	// A snoozed item's SLA runs from the end of the snooze.
+	later := now.Add(4*time.Hour + time.Minute)
+	items, _ = Collect(townRoot, []Source{src})
+	var ids []string
+	for _, it := range p.Overdue(items, later) {
+		ids = append(ids, it.ID)
+	}
+	if len(ids) != 1 || ids[0] != "new" {
+		t.Errorf("overdue after snooze = %v, want [new]", ids)
+	}
+
+	if got := NewPolicy(&config.InboxConfig{SLA: "0"}).Overdue(items, later); got != nil {
+		t.Errorf("SLA 0 escalated %+v", got)
+	}
+}
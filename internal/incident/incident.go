@@ -0,0 +1,302 @@
+// Package incident implements incident mode: freezing the town so its state
+// can be captured, and collecting that state into a diagnostics bundle.
+//
+// While an incident is active the scheduler is paused and the daemon skips
+// its recovery heartbeat and patrols, so agents are neither restarted nor
+// dispatched and the captured state stays consistent.
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/decision"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/telemetry"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// ErrActive is returned when starting an incident while one is in progress.
+var ErrActive = errors.New("an incident is already in progress")
+
+// ErrNotActive is returned when ending an incident and none is in progress.
+var ErrNotActive = errors.New("no incident in progress")
+
+// How much of each log the bundle keeps.
+const (
+	logTailLines   = 2000
+	eventTailLines = 1000
+	paneLines      = 200
+)
+
+// Incident is an active or finished incident. It is written to the state
+// file while active and to manifest.json in its bundle.
+type Incident struct {
+	ID        string    `json:"id"`
+	Reason    string    `json:"reason,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+	StartedBy string    `json:"started_by,omitempty"`
+	EndedAt   time.Time `json:"ended_at,omitzero"`
+	EndedBy   string    `json:"ended_by,omitempty"`
+	Bundle    string    `json:"bundle"`
+
+	// PausedScheduler records whether starting the incident paused the
+	// scheduler, so ending it only resumes a pause it caused.
+	PausedScheduler bool `json:"paused_scheduler"`
+
+	Artifacts []string `json:"artifacts"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+// StartOptions configures Start.
+type StartOptions struct {
+	Reason string
+	Actor  string
+
+	// Doctor, if set, writes a doctor report to w. The doctor lives in the
+	// cmd package, so callers supply it.
+	Doctor func(w io.Writer) error
+}
+
+// StatePath returns the path of the active incident's state file.
+func StatePath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "incident.json")
+}
+
+// BundlesDir returns the directory holding incident bundles.
+func BundlesDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "incidents")
+}
+
+// Active returns the incident in progress, or nil if there is none.
+func Active(townRoot string) (*Incident, error) {
+	data, err := os.ReadFile(StatePath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var inc Incident
+	if err := json.Unmarshal(data, &inc); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", StatePath(townRoot), err)
+	}
+	return &inc, nil
+}
+
+// IsActive reports whether an incident is in progress. Cheap enough to call
+// on every daemon tick.
+func IsActive(townRoot string) bool {
+	_, err := os.Stat(StatePath(townRoot))
+	return err == nil
+}
+
+// Start freezes the town and collects a diagnostics bundle. Collection
+// failures are recorded in the incident's Errors rather than aborting: a
+// partial capture is better than none.
+func Start(townRoot string, opts StartOptions) (*Incident, error) {
+	if IsActive(townRoot) {
+		return nil, ErrActive
+	}
+
+	now := time.Now()
+	inc := &Incident{
+		ID:        "inc-" + now.UTC().Format("20060102-150405"),
+		Reason:    opts.Reason,
+		StartedAt: now,
+		StartedBy: opts.Actor,
+	}
+	inc.Bundle = filepath.Join(BundlesDir(townRoot), inc.ID)
+	if err := os.MkdirAll(inc.Bundle, 0755); err != nil {
+		return nil, fmt.Errorf("creating bundle dir: %w", err)
+	}
+
+	// Freeze first, so nothing moves while we capture.
+	state, err := capacity.LoadState(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("loading scheduler state: %w", err)
+	}
+	if !state.Paused {
+		state.SetPaused("incident " + inc.ID)
+		if err := capacity.SaveState(townRoot, state); err != nil {
+			return nil, fmt.Errorf("pausing scheduler: %w", err)
+		}
+		inc.PausedScheduler = true
+	}
+	if err := writeJSON(StatePath(townRoot), inc); err != nil {
+		return nil, fmt.Errorf("writing incident state: %w", err)
+	}
+
+	collect(townRoot, inc, opts)
+
+	if err := writeJSON(StatePath(townRoot), inc); err != nil {
+		return inc, fmt.Errorf("writing incident state: %w", err)
+	}
+	return inc, writeJSON(filepath.Join(inc.Bundle, "manifest.json"), inc)
+}
+
+// End lifts the freeze and finalizes the bundle's manifest.
+func End(townRoot, actor string) (*Incident, error) {
+	inc, err := Active(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	if inc == nil {
+		return nil, ErrNotActive
+	}
+
+	if inc.PausedScheduler {
+		state, err := capacity.LoadState(townRoot)
+		if err != nil {
+			return nil, fmt.Errorf("loading scheduler state: %w", err)
+		}
+		// Someone may have resumed and re-paused by hand; leave their pause.
+		if state.Paused && state.PausedBy == "incident "+inc.ID {
+			state.SetResumed()
+			if err := capacity.SaveState(townRoot, state); err != nil {
+				return nil, fmt.Errorf("resuming scheduler: %w", err)
+			}
+		}
+	}
+
+	inc.EndedAt = time.Now()
+	inc.EndedBy = actor
+	if err := writeJSON(filepath.Join(inc.Bundle, "manifest.json"), inc); err != nil {
+		return nil, fmt.Errorf("writing manifest: %w", err)
+	}
+	if err := os.Remove(StatePath(townRoot)); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return inc, nil
+}
+
+// collect writes each artifact into the bundle.
+func collect(townRoot string, inc *Incident, opts StartOptions) {
+	add := func(name string, err error) {
+		if err != nil {
+			inc.Errors = append(inc.Errors, fmt.Sprintf("%s: %v", name, err))
+			return
+		}
+		inc.Artifacts = append(inc.Artifacts, name)
+	}
+	bundleFile := func(name string) string { return filepath.Join(inc.Bundle, name) }
+	// Logs that don't exist yet are simply absent from the bundle.
+	addLog := func(name, src string, lines int) {
+		if err := tailFile(src, bundleFile(name), lines); !os.IsNotExist(err) {
+			add(name, err)
+		}
+	}
+
+	if opts.Doctor != nil {
+		add("doctor.txt", withFile(bundleFile("doctor.txt"), opts.Doctor))
+	}
+	addLog("daemon.log", filepath.Join(townRoot, "daemon", "daemon.log"), logTailLines)
+	addLog("events.jsonl", filepath.Join(townRoot, events.EventsFile), eventTailLines)
+	addLog("decisions.jsonl", filepath.Join(townRoot, decision.LogFile), eventTailLines)
+	if violations, err := telemetry.ReadCardinalityReport(townRoot); err == nil && len(violations) > 0 {
+		add("telemetry-cardinality.json", writeJSON(bundleFile("telemetry-cardinality.json"), violations))
+	}
+	if state, err := capacity.LoadState(townRoot); err == nil {
+		add("scheduler.json", writeJSON(bundleFile("scheduler.json"), state))
+	}
+	add("wisps.json", withFile(bundleFile("wisps.json"), func(w io.Writer) error {
+		out, err := listWisps(townRoot)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	}))
+
+	panes, err := capturePanes()
+	if err != nil {
+		add("panes", err)
+		return
+	}
+	if len(panes) > 0 {
+		if err := os.MkdirAll(bundleFile("panes"), 0755); err != nil {
+			add("panes", err)
+			return
+		}
+	}
+	names := make([]string, 0, len(panes))
+	for name := range panes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		rel := filepath.Join("panes", name+".txt")
+		add(rel, os.WriteFile(bundleFile(rel), []byte(panes[name]), 0644)) //nolint:gosec // G306: diagnostics bundle
+	}
+}
+
+// listWisps returns the open wisps as bd reports them. A variable so tests
+// can run without bd.
+var listWisps = func(townRoot string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "bd", "mol", "wisp", "list", "--json")
+	cmd.Dir = townRoot
+	return cmd.Output()
+}
+
+// capturePanes returns the recent output of every Gas Town tmux session,
+// keyed by session name. A variable so tests can run without tmux.
+var capturePanes = func() (map[string]string, error) {
+	t := tmux.NewTmux()
+	sessions, err := t.ListSessions()
+	if err != nil {
+		return nil, err
+	}
+	panes := make(map[string]string)
+	for _, s := range sessions {
+		if !session.IsKnownSession(s) {
+			continue
+		}
+		if content, err := t.CapturePane(s, paneLines); err == nil {
+			panes[s] = content
+		}
+	}
+	return panes, nil
+}
+
+// tailFile copies the last n lines of src to dst.
+func tailFile(src, dst string, n int) error {
+	data, err := util.TailLines(src, n)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, 0644) //nolint:gosec // G306: diagnostics bundle
+}
+
+func withFile(path string, fn func(io.Writer) error) error {
+	f, err := os.Create(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return err
+	}
+	if err := fn(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644) //nolint:gosec // G306: diagnostics bundle
+}
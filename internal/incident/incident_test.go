@@ -0,0 +1,109 @@
+package incident
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func stubCollectors(t *testing.T) {
+	t.Helper()
+	origWisps, origPanes := listWisps, capturePanes
+	t.Cleanup(func() { listWisps, capturePanes = origWisps, origPanes })
+	listWisps = func(string) ([]byte, error) { return []byte(`[{"id":"gt-wisp-1"}]`), nil }
+	capturePanes = func() (map[string]string, error) {
+		return map[string]string{"gt-witness": "patrolling\n"}, nil
+	}
+}
+
+func TestStartAndEnd(t *testing.T) {
+	town := t.TempDir()
+	stubCollectors(t)
+	if err := os.MkdirAll(filepath.Join(town, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	var log strings.Builder
+	for i := 0; i < logTailLines+5; i++ {
+		fmt.Fprintf(&log, "line %d\n", i)
+	}
+	if err := os.WriteFile(filepath.Join(town, "daemon", "daemon.log"), []byte(log.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	inc, err := Start(town, StartOptions{Reason: "stuck", Actor: "mayor", Doctor: func(w io.Writer) error {
+		_, err := io.WriteString(w, "all good\n")
+		return err
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsActive(town) || !inc.PausedScheduler {
+		t.Fatalf("incident = %+v, want active with scheduler paused", inc)
+	}
+	if state, _ := capacity.LoadState(town); !state.Paused {
+		t.Error("scheduler not paused")
+	}
+	for _, name := range []string{"manifest.json", "doctor.txt", "daemon.log", "wisps.json", "scheduler.json", "panes/gt-witness.txt"} {
+		if _, err := os.Stat(filepath.Join(inc.Bundle, name)); err != nil {
+			t.Errorf("bundle missing %s: %v", name, err)
+		}
+	}
+	tail, _ := os.ReadFile(filepath.Join(inc.Bundle, "daemon.log"))
+	if lines := strings.Count(string(tail), "\n"); lines != logTailLines || !strings.HasPrefix(string(tail), "line 5\n") {
+		t.Errorf("daemon.log tail has %d lines starting %q", lines, strings.SplitN(string(tail), "\n", 2)[0])
+	}
+	if len(inc.Errors) != 0 {
+		t.Errorf("errors = %v", inc.Errors)
+	}
+	if _, err := os.Stat(filepath.Join(inc.Bundle, "events.jsonl")); !os.IsNotExist(err) {
+		t.Error("missing events log should be left out of the bundle")
+	}
+
+	if _, err := Start(town, StartOptions{}); !errors.Is(err, ErrActive) {
+		t.Errorf("second Start = %v, want ErrActive", err)
+	}
+
+	ended, err := End(town, "mayor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if IsActive(town) || ended.EndedAt.IsZero() {
+		t.Errorf("after End: active=%v, ended=%+v", IsActive(town), ended)
+	}
+	if state, _ := capacity.LoadState(town); state.Paused {
+		t.Error("scheduler still paused after End")
+	}
+	if _, err := End(town, "mayor"); !errors.Is(err, ErrNotActive) {
+		t.Errorf("second End = %v, want ErrNotActive", err)
+	}
+}
+
+func TestEndKeepsExistingPause(t *testing.T) {
+	town := t.TempDir()
+	stubCollectors(t)
+	state := &capacity.SchedulerState{}
+	state.SetPaused("overseer")
+	if err := capacity.SaveState(town, state); err != nil {
+		t.Fatal(err)
+	}
+
+	inc, err := Start(town, StartOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inc.PausedScheduler {
+		t.Error("incident claims a pause it did not make")
+	}
+	if _, err := End(town, ""); err != nil {
+		t.Fatal(err)
+	}
+	if state, _ := capacity.LoadState(town); !state.Paused || state.PausedBy != "overseer" {
+		t.Errorf("state = %+v, want the overseer's pause kept", state)
+	}
+}
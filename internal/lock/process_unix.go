@@ -23,3 +23,8 @@ func processExists(pid int) bool {
 	err = process.Signal(syscall.Signal(0))
 	return err == nil
 }
+
+// ProcessExists reports whether a process with the given PID is alive.
+func ProcessExists(pid int) bool {
+	return processExists(pid)
+}
@@ -24,3 +24,8 @@ func processExists(pid int) bool {
 	_ = windows.CloseHandle(handle)
 	return true
 }
+
+// ProcessExists reports whether a process with the given PID is alive.
+func ProcessExists(pid int) bool {
+	return processExists(pid)
+}
@@ -0,0 +1,268 @@
+// Package locks provides named, town-wide leases for mutual exclusion across
+// gt processes: doctor fixes, bead slinging, and anything else that must not
+// run twice at once.
+//
+// A lease is an advisory flock on .runtime/locks/<name>.lock plus a JSON
+// record beside it naming the holder and when its lease expires. The flock is
+// what excludes: the kernel releases it when the holder exits, so a crash
+// never wedges a lock. The record is what makes locks observable (gt doctor
+// reports leases held past their expiry, which usually means a hung holder)
+// and lets a blocked caller say who it is waiting on.
+package locks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/lock"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// ErrHeld is returned when a lock stays held by another process for the whole
+// wait. It is wrapped with the holder, when known.
+var ErrHeld = errors.New("lock is held by another process")
+
+// DefaultTTL is the lease length when Options.TTL is zero.
+const DefaultTTL = 10 * time.Minute
+
+// pollInterval is the retry interval while waiting for a lock.
+const pollInterval = 100 * time.Millisecond
+
+// Lease records who holds a lock and until when.
+type Lease struct {
+	Name       string    `json:"name"`
+	PID        int       `json:"pid"`
+	Command    string    `json:"command,omitempty"`
+	Purpose    string    `json:"purpose,omitempty"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// Expired reports whether the lease ran past its expiry at now.
+func (l *Lease) Expired(now time.Time) bool {
+	return !l.ExpiresAt.IsZero() && now.After(l.ExpiresAt)
+}
+
+// String describes the holder for error messages.
+func (l *Lease) String() string {
+	s := fmt.Sprintf("pid %d", l.PID)
+	if l.Purpose != "" {
+		s += " " + l.Purpose
+	}
+	return s + " since " + l.AcquiredAt.Local().Format("15:04:05")
+}
+
+// Options tune Acquire.
+type Options struct {
+	// Purpose describes what the holder is doing (e.g. the doctor check
+	// being fixed). Shown to blocked callers and in gt doctor.
+	Purpose string
+
+	// TTL is how long the holder expects to need the lock. A lease past its
+	// TTL is still honored, but reported as stale. Default: DefaultTTL.
+	TTL time.Duration
+
+	// Wait is how long to retry while the lock is held. Zero tries once.
+	Wait time.Duration
+}
+
+// Handle is a held lock. Release it when done.
+type Handle struct {
+	townRoot string
+	lease    Lease
+	unlock   func()
+	once     sync.Once
+}
+
+// Lease returns the handle's current lease record.
+func (h *Handle) Lease() Lease {
+	return h.lease
+}
+
+// Renew extends the lease by ttl from now, for holders that run longer than
+// their original TTL.
+func (h *Handle) Renew(ttl time.Duration) error {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	h.lease.ExpiresAt = time.Now().UTC().Add(ttl)
+	return util.AtomicWriteJSON(leasePath(h.townRoot, h.lease.Name), h.lease)
+}
+
+// Release removes the lease record and drops the lock. Safe to call more
+// than once.
+func (h *Handle) Release() {
+	h.once.Do(func() {
+		_ = os.Remove(leasePath(h.townRoot, h.lease.Name))
+		h.unlock()
+	})
+}
+
+// Dir returns the directory holding a town's lock and lease files.
+func Dir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "locks")
+}
+
+// fileBase maps a lock name to a flat file name; names may use "/" to group
+// related locks (e.g. "sling/gt-abc").
+var fileBase = strings.NewReplacer("/", "_", "\\", "_", ":", "_")
+
+func lockPath(townRoot, name string) string {
+	return filepath.Join(Dir(townRoot), fileBase.Replace(name)+".lock")
+}
+
+func leasePath(townRoot, name string) string {
+	return filepath.Join(Dir(townRoot), fileBase.Replace(name)+".json")
+}
+
+// Acquire takes the named lock, retrying for up to opts.Wait while another
+// process holds it. Returns ErrHeld (wrapped with the holder) if it stays
+// held.
+func Acquire(townRoot, name string, opts Options) (*Handle, error) {
+	if name == "" {
+		return nil, errors.New("lock name is required")
+	}
+	if err := os.MkdirAll(Dir(townRoot), 0755); err != nil {
+		return nil, fmt.Errorf("creating locks dir: %w", err)
+	}
+	ttl := opts.TTL
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	deadline := time.Now().Add(opts.Wait)
+	for {
+		unlock, ok, err := lock.FlockTryAcquire(lockPath(townRoot, name))
+		if err != nil {
+			return nil, fmt.Errorf("acquiring lock %s: %w", name, err)
+		}
+		if ok {
+			now := time.Now().UTC()
+			h := &Handle{
+				townRoot: townRoot,
+				unlock:   unlock,
+				lease: Lease{
+					Name:       name,
+					PID:        os.Getpid(),
+					Purpose:    opts.Purpose,
+					AcquiredAt: now,
+					ExpiresAt:  now.Add(ttl),
+				},
+			}
+			if len(os.Args) > 0 {
+				h.lease.Command = filepath.Base(os.Args[0])
+			}
+			if err := util.AtomicWriteJSON(leasePath(townRoot, name), h.lease); err != nil {
+				unlock()
+				return nil, fmt.Errorf("writing lease for %s: %w", name, err)
+			}
+			return h, nil
+		}
+		if !time.Now().Before(deadline) {
+			break
+		}
+		time.Sleep(pollInterval)
+	}
+
+	if l := Read(townRoot, name); l != nil {
+		if l.Expired(time.Now()) {
+			return nil, fmt.Errorf("%s: %w (%s; lease expired %s, holder may be hung)",
+				name, ErrHeld, l, l.ExpiresAt.Local().Format("15:04:05"))
+		}
+		return nil, fmt.Errorf("%s: %w (%s)", name, ErrHeld, l)
+	}
+	return nil, fmt.Errorf("%s: %w", name, ErrHeld)
+}
+
+// Read returns the named lock's lease record, or nil if none is recorded.
+// A record alone does not mean the lock is held; see Inspect.
+func Read(townRoot, name string) *Lease {
+	return readLease(leasePath(townRoot, name))
+}
+
+func readLease(path string) *Lease {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil
+	}
+	var l Lease
+	if json.Unmarshal(data, &l) != nil || l.Name == "" || l.PID == 0 {
+		return nil
+	}
+	return &l
+}
+
+// State classifies a recorded lease.
+type State string
+
+const (
+	// StateHeld is a live lease within its TTL.
+	StateHeld State = "held"
+	// StateExpired is a lease still held past its TTL: the holder is slow
+	// or hung.
+	StateExpired State = "expired"
+	// StateOrphaned is a record whose holder process is gone, left
+	// behind by a process that exited without releasing.
+	StateOrphaned State = "orphaned"
+)
+
+// Status is a lease record with its current state.
+type Status struct {
+	Lease
+	State State `json:"state"`
+}
+
+// List returns the state of every recorded lease in the town, sorted by name.
+func List(townRoot string, now time.Time) ([]Status, error) {
+	paths, err := filepath.Glob(filepath.Join(Dir(townRoot), "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var out []Status
+	for _, p := range paths {
+		l := readLease(p)
+		if l == nil {
+			continue
+		}
+		out = append(out, Status{Lease: *l, State: state(l, now)})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// state classifies l by its holder's PID rather than by trying the flock:
+// taking the flock to look would make a concurrent Acquire fail.
+func state(l *Lease, now time.Time) State {
+	if !lock.ProcessExists(l.PID) {
+		return StateOrphaned
+	}
+	if l.Expired(now) {
+		return StateExpired
+	}
+	return StateHeld
+}
+
+// ClearOrphaned removes the named lock's lease record if the process that
+// recorded it is gone. It reports whether a record was removed; records of
+// live holders are left alone.
+func ClearOrphaned(townRoot, name string) (bool, error) {
+	l := Read(townRoot, name)
+	if l == nil || lock.ProcessExists(l.PID) {
+		return false, nil
+	}
+	if err := os.Remove(leasePath(townRoot, name)); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
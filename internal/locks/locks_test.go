@@ -0,0 +1,96 @@
+package locks
+
+import (
+	"errors"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+func TestAcquireContentionAndRelease(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("advisory flock is a no-op on Windows")
+	}
+	town := t.TempDir()
+
+	h, err := Acquire(town, "sling/gt-abc", Options{Purpose: "slinging gt-abc"})
+	if err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	if l := Read(town, "sling/gt-abc"); l == nil || l.PID != os.Getpid() || l.Purpose != "slinging gt-abc" {
+		t.Fatalf("lease record = %+v", l)
+	}
+
+	_, err = Acquire(town, "sling/gt-abc", Options{Wait: 150 * time.Millisecond})
+	if !errors.Is(err, ErrHeld) {
+		t.Fatalf("second Acquire err = %v, want ErrHeld", err)
+	}
+	if !strings.Contains(err.Error(), "slinging gt-abc") {
+		t.Errorf("error should name the holder: %v", err)
+	}
+
+	h.Release()
+	h.Release() // idempotent
+	if Read(town, "sling/gt-abc") != nil {
+		t.Error("Release should remove the lease record")
+	}
+	h2, err := Acquire(town, "sling/gt-abc", Options{})
+	if err != nil {
+		t.Fatalf("Acquire after release: %v", err)
+	}
+	h2.Release()
+}
+
+func TestListStates(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("advisory flock is a no-op on Windows")
+	}
+	town := t.TempDir()
+	now := time.Now()
+
+	held, err := Acquire(town, "held", Options{TTL: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer held.Release()
+	expired, err := Acquire(town, "expired", Options{TTL: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer expired.Release()
+
+	// A record left by a process that exited without releasing.
+	orphan := Lease{Name: "orphan", PID: 999999, AcquiredAt: now.Add(-time.Hour), ExpiresAt: now.Add(-30 * time.Minute)}
+	if err := util.AtomicWriteJSON(leasePath(town, "orphan"), orphan); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses, err := List(town, now.Add(5*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]State{}
+	for _, s := range statuses {
+		got[s.Name] = s.State
+	}
+	want := map[string]State{"held": StateHeld, "expired": StateExpired, "orphan": StateOrphaned}
+	for name, st := range want {
+		if got[name] != st {
+			t.Errorf("%s state = %q, want %q", name, got[name], st)
+		}
+	}
+
+	if removed, err := ClearOrphaned(town, "held"); err != nil || removed {
+		t.Errorf("ClearOrphaned(held) = %v, %v; want false, nil", removed, err)
+	}
+	if removed, err := ClearOrphaned(town, "orphan"); err != nil || !removed {
+		t.Errorf("ClearOrphaned(orphan) = %v, %v; want true, nil", removed, err)
+	}
+	if Read(town, "orphan") != nil {
+		t.Error("orphaned record should be gone")
+	}
+}
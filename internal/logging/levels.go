@@ -0,0 +1,216 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// DefaultKey names the default level in override files and level tables.
+const DefaultKey = "*"
+
+// overrideRefresh is how often a running process re-reads the override file.
+const overrideRefresh = 5 * time.Second
+
+// ParseLevel parses debug, info, warn (or warning), and error.
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return slog.LevelInfo, fmt.Errorf("unknown log level %q (want debug, info, warn, or error)", s)
+}
+
+// LevelName returns the lower-case name ParseLevel accepts.
+func LevelName(l slog.Level) string {
+	return strings.ToLower(l.String())
+}
+
+// Levels resolves the level for each subsystem from town settings plus the
+// runtime override file. Overrides win over settings.
+type Levels struct {
+	mu           sync.Mutex
+	base         map[string]slog.Level
+	overrides    map[string]slog.Level
+	overridePath string
+	overrideMod  time.Time
+	lastCheck    time.Time
+	now          func() time.Time
+}
+
+// NewLevels builds a level table from settings. overridePath may be empty.
+// Unparseable levels in settings fall back to info.
+func NewLevels(cfg *config.LoggingConfig, overridePath string) *Levels {
+	l := &Levels{base: map[string]slog.Level{DefaultKey: slog.LevelInfo}, overridePath: overridePath, now: time.Now}
+	if cfg != nil {
+		if lvl, err := ParseLevel(cfg.Level); err == nil {
+			l.base[DefaultKey] = lvl
+		}
+		for sub, s := range cfg.Levels {
+			if lvl, err := ParseLevel(s); err == nil {
+				l.base[sub] = lvl
+			}
+		}
+	}
+	return l
+}
+
+// Level returns the effective level for subsystem.
+func (l *Levels) Level(subsystem string) slog.Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refreshLocked()
+	for _, table := range []map[string]slog.Level{l.overrides, l.base} {
+		if lvl, ok := table[subsystem]; ok {
+			return lvl
+		}
+	}
+	if lvl, ok := l.overrides[DefaultKey]; ok {
+		return lvl
+	}
+	return l.base[DefaultKey]
+}
+
+// Effective returns the effective level of every subsystem that has one
+// configured, plus the default under DefaultKey.
+func (l *Levels) Effective() map[string]slog.Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refreshLocked()
+	out := make(map[string]slog.Level, len(l.base)+len(l.overrides))
+	for k, v := range l.base {
+		out[k] = v
+	}
+	for k, v := range l.overrides {
+		out[k] = v
+	}
+	return out
+}
+
+// Refresh re-reads the override file without waiting for the refresh
+// interval.
+func (l *Levels) Refresh() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastCheck, l.overrideMod = time.Time{}, time.Time{}
+	l.refreshLocked()
+}
+
+// refreshLocked re-reads the override file when it may have changed.
+func (l *Levels) refreshLocked() {
+	if l.overridePath == "" {
+		return
+	}
+	now := l.now()
+	if !l.lastCheck.IsZero() && now.Sub(l.lastCheck) < overrideRefresh {
+		return
+	}
+	l.lastCheck = now
+
+	info, err := os.Stat(l.overridePath)
+	if err != nil {
+		l.overrides, l.overrideMod = nil, time.Time{}
+		return
+	}
+	if info.ModTime().Equal(l.overrideMod) && l.overrides != nil {
+		return
+	}
+	raw, err := readOverrides(l.overridePath)
+	if err != nil {
+		return // Keep the previous overrides rather than flapping
+	}
+	parsed := make(map[string]slog.Level, len(raw))
+	for sub, s := range raw {
+		if lvl, err := ParseLevel(s); err == nil {
+			parsed[sub] = lvl
+		}
+	}
+	l.overrides, l.overrideMod = parsed, info.ModTime()
+}
+
+// OverridesPath returns the runtime override file for a town, or "" without one.
+func OverridesPath(townRoot string) string {
+	if townRoot == "" {
+		return ""
+	}
+	return filepath.Join(townRoot, constants.DirRuntime, "log-levels.json")
+}
+
+// LoadOverrides reads the runtime overrides (subsystem → level name).
+func LoadOverrides(townRoot string) (map[string]string, error) {
+	return readOverrides(OverridesPath(townRoot))
+}
+
+func readOverrides(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	out := map[string]string{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return out, nil
+}
+
+// SetOverride sets (or, with level "", clears) a runtime override. Use
+// DefaultKey as the subsystem to override the default level.
+func SetOverride(townRoot, subsystem, level string) error {
+	if level != "" {
+		lvl, err := ParseLevel(level)
+		if err != nil {
+			return err
+		}
+		level = LevelName(lvl)
+	}
+	overrides, err := LoadOverrides(townRoot)
+	if err != nil {
+		return err
+	}
+	if level == "" {
+		delete(overrides, subsystem)
+	} else {
+		overrides[subsystem] = level
+	}
+	if len(overrides) == 0 {
+		if err := os.Remove(OverridesPath(townRoot)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return util.EnsureDirAndWriteJSON(OverridesPath(townRoot), overrides)
+}
+
+// SortedSubsystems returns the keys of a level table with DefaultKey first.
+func SortedSubsystems(levels map[string]slog.Level) []string {
+	keys := make([]string, 0, len(levels))
+	for k := range levels {
+		if k != DefaultKey {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	if _, ok := levels[DefaultKey]; ok {
+		keys = append([]string{DefaultKey}, keys...)
+	}
+	return keys
+}
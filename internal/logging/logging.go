@@ -0,0 +1,262 @@
+// Package logging is gt's shared structured logger.
+//
+// Every subsystem gets its logger from For, which tags records with the
+// subsystem name and filters them by that subsystem's level. Levels come
+// from the town settings (logging.level and logging.levels) and can be
+// changed at runtime with gt log level, which writes an override file that
+// running processes pick up within a few seconds (the daemon applies it at
+// once via its control API, see Refresh).
+//
+// Records go to a text sink in the daemon's historical line format
+// ("2006/01/02 15:04:05 message key=value") and, when telemetry is enabled,
+// to OpenTelemetry as log records. Use the Key constants for the attributes
+// that tie records to town entities so queries work across subsystems.
+package logging
+
+import (
+	"context"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Standard attribute keys.
+const (
+	KeySubsystem = "subsystem"
+	KeyRig       = "rig"
+	KeyAgent     = "agent"
+	KeyWisp      = "wisp"
+	KeyError     = "error"
+)
+
+// Subsystem names. Any string works; these are the ones gt itself uses.
+const (
+	SubsystemCLI    = "cli"
+	SubsystemDaemon = "daemon"
+	SubsystemDoctor = "doctor"
+)
+
+// Options configure Setup.
+type Options struct {
+	// TownRoot locates settings and the runtime override file. Empty means
+	// no town: default levels, no overrides.
+	TownRoot string
+
+	// Writer receives text output. When nil, the settings' logging.file is
+	// used if set; otherwise there is no text sink.
+	Writer io.Writer
+
+	// Config overrides the settings loaded from TownRoot (used in tests).
+	Config *config.LoggingConfig
+}
+
+type state struct {
+	levels *Levels
+	text   slog.Handler // May be nil
+	otel   bool
+	closer io.Closer
+}
+
+var (
+	mu      sync.RWMutex
+	current = &state{levels: NewLevels(nil, ""), otel: true}
+)
+
+// Setup configures the process-wide logger. It may be called again (for
+// example after a config change); loggers obtained earlier from For keep
+// working and pick up the new configuration.
+func Setup(opts Options) error {
+	cfg := opts.Config
+	if cfg == nil && opts.TownRoot != "" {
+		if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(opts.TownRoot)); err == nil {
+			cfg = settings.Logging
+		}
+	}
+	if cfg == nil {
+		cfg = &config.LoggingConfig{}
+	}
+
+	s := &state{
+		levels: NewLevels(cfg, OverridesPath(opts.TownRoot)),
+		otel:   cfg.OTel == nil || *cfg.OTel,
+	}
+	w := opts.Writer
+	if w == nil && cfg.File != "" {
+		path := cfg.File
+		if !filepath.IsAbs(path) && opts.TownRoot != "" {
+			path = filepath.Join(opts.TownRoot, path)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G304: path is from town settings
+		if err != nil {
+			return err
+		}
+		w, s.closer = f, f
+	}
+	if w != nil {
+		s.text = NewTextHandler(w)
+	}
+
+	mu.Lock()
+	old := current
+	current = s
+	mu.Unlock()
+	if old.closer != nil {
+		_ = old.closer.Close()
+	}
+	return nil
+}
+
+func load() *state {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// For returns the logger for a subsystem.
+func For(subsystem string) *slog.Logger {
+	return slog.New(&handler{subsystem: subsystem})
+}
+
+// StdLogger adapts a subsystem logger to *log.Logger for call sites that
+// still use Printf. Each line's level comes from its wording (see
+// LineLevel), so "Error ..." lines still show when the subsystem logs at warn.
+func StdLogger(subsystem string) *log.Logger {
+	return log.New(&lineWriter{h: &handler{subsystem: subsystem}}, "", 0)
+}
+
+// LineLevel classifies a legacy Printf log line: lines with a warning
+// marker ("Warning: ...", or after a "component: " prefix) are warnings,
+// lines that report an error or failure are errors, and everything else is
+// info. Warnings win, since "Warning: failed to ..." is the usual idiom for
+// a non-fatal failure.
+func LineLevel(line string) slog.Level {
+	lower := strings.ToLower(line)
+	switch {
+	case strings.HasPrefix(lower, "warning") || strings.Contains(lower, ": warning"):
+		return slog.LevelWarn
+	case strings.HasPrefix(lower, "error") || strings.Contains(lower, ": error") ||
+		strings.Contains(lower, "error:") || strings.Contains(lower, "failed"):
+		return slog.LevelError
+	}
+	return slog.LevelInfo
+}
+
+// lineWriter turns each *log.Logger line into a record at its LineLevel.
+type lineWriter struct {
+	h *handler
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	msg := strings.TrimSuffix(string(p), "\n")
+	level := LineLevel(msg)
+	ctx := context.Background()
+	if !w.h.Enabled(ctx, level) {
+		return len(p), nil
+	}
+	if err := w.h.Handle(ctx, slog.NewRecord(time.Now(), level, msg, 0)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Refresh re-reads the runtime override file now instead of within a few
+// seconds. The daemon's control API calls it after changing a level.
+func Refresh() {
+	load().levels.Refresh()
+}
+
+// EffectiveLevels returns the process's current level table (see
+// Levels.Effective).
+func EffectiveLevels() map[string]slog.Level {
+	return load().levels.Effective()
+}
+
+// Enabled reports whether a subsystem logs at level.
+func Enabled(subsystem string, level slog.Level) bool {
+	return level >= load().levels.Level(subsystem)
+}
+
+// handler filters by subsystem level and fans out to the current sinks.
+// It resolves the sinks on every record so Setup takes effect immediately.
+type handler struct {
+	subsystem string
+	attrs     []slog.Attr
+	groups    []string
+}
+
+func (h *handler) Enabled(_ context.Context, level slog.Level) bool {
+	return Enabled(h.subsystem, level)
+}
+
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
+	s := load()
+	r = r.Clone()
+	r.AddAttrs(slog.String(KeySubsystem, h.subsystem))
+
+	var firstErr error
+	for _, sink := range h.sinks(s) {
+		if err := sink.Handle(ctx, r); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (h *handler) sinks(s *state) []slog.Handler {
+	var out []slog.Handler
+	if s.text != nil {
+		out = append(out, h.derive(s.text))
+	}
+	if s.otel {
+		out = append(out, h.derive(otelHandler{}))
+	}
+	return out
+}
+
+// derive applies this handler's groups and attrs to a sink.
+func (h *handler) derive(sink slog.Handler) slog.Handler {
+	if len(h.attrs) > 0 {
+		sink = sink.WithAttrs(h.attrs)
+	}
+	for _, g := range h.groups {
+		sink = sink.WithGroup(g)
+	}
+	return sink
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(h.groups) > 0 {
+		// Attrs added after a group belong to it; qualify them now.
+		attrs = qualify(h.groups, attrs)
+	}
+	return &handler{subsystem: h.subsystem, attrs: append(append([]slog.Attr{}, h.attrs...), attrs...), groups: h.groups}
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &handler{subsystem: h.subsystem, attrs: h.attrs, groups: append(append([]string{}, h.groups...), name)}
+}
+
+func qualify(groups []string, attrs []slog.Attr) []slog.Attr {
+	prefix := ""
+	for _, g := range groups {
+		prefix += g + "."
+	}
+	out := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		out[i] = slog.Attr{Key: prefix + a.Key, Value: a.Value}
+	}
+	return out
+}
@@ -0,0 +1,127 @@
+package logging
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := map[string]slog.Level{
+		"":        slog.LevelInfo,
+		"debug":   slog.LevelDebug,
+		"INFO":    slog.LevelInfo,
+		"warning": slog.LevelWarn,
+		" error ": slog.LevelError,
+	}
+	for in, want := range tests {
+		got, err := ParseLevel(in)
+		if err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseLevel("loud"); err == nil {
+		t.Error("ParseLevel(loud) succeeded, want error")
+	}
+}
+
+func TestSubsystemLevels(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := &config.LoggingConfig{Level: "warn", Levels: map[string]string{SubsystemDaemon: "debug"}}
+	if err := Setup(Options{Writer: &buf, Config: cfg}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = Setup(Options{}) })
+
+	For(SubsystemDaemon).Debug("daemon debug", KeyRig, "gastown")
+	For(SubsystemDoctor).Info("doctor info")
+	For(SubsystemDoctor).Warn("doctor warn")
+	StdLogger(SubsystemCLI).Printf("cli printf")
+	StdLogger(SubsystemCLI).Printf("Error starting witness for %s: %v", "gastown", "boom")
+
+	out := buf.String()
+	if !strings.Contains(out, "DEBUG daemon debug rig=gastown subsystem=daemon") {
+		t.Errorf("missing daemon debug line:\n%s", out)
+	}
+	if strings.Contains(out, "doctor info") || strings.Contains(out, "cli printf") {
+		t.Errorf("records below the default level were written:\n%s", out)
+	}
+	if !strings.Contains(out, "WARN doctor warn subsystem=doctor") {
+		t.Errorf("missing doctor warn line:\n%s", out)
+	}
+	if !strings.Contains(out, "ERROR Error starting witness for gastown: boom subsystem=cli") {
+		t.Errorf("legacy error line should pass a warn level:\n%s", out)
+	}
+}
+
+func TestLineLevel(t *testing.T) {
+	tests := map[string]slog.Level{
+		"Heartbeat starting":                            slog.LevelInfo,
+		"Warning: failed to start feed curator: boom":   slog.LevelWarn,
+		"Error starting refinery for gastown: boom":     slog.LevelError,
+		"Handler: failed to spawn dog: boom":            slog.LevelError,
+		"wisp_reaper: gastown: query error: boom":       slog.LevelError,
+		"compactor_dog: gastown: warning: slow compact": slog.LevelWarn,
+		"Deacon started (no errors since last restart)": slog.LevelInfo,
+	}
+	for line, want := range tests {
+		if got := LineLevel(line); got != want {
+			t.Errorf("LineLevel(%q) = %v, want %v", line, got, want)
+		}
+	}
+}
+
+func TestLevelsOverrideRefresh(t *testing.T) {
+	townRoot := t.TempDir()
+	now := time.Now()
+	l := NewLevels(&config.LoggingConfig{Levels: map[string]string{SubsystemDaemon: "info"}}, OverridesPath(townRoot))
+	l.now = func() time.Time { return now }
+
+	if got := l.Level(SubsystemDaemon); got != slog.LevelInfo {
+		t.Fatalf("initial level = %v, want info", got)
+	}
+	if err := SetOverride(townRoot, SubsystemDaemon, "debug"); err != nil {
+		t.Fatal(err)
+	}
+	if got := l.Level(SubsystemDaemon); got != slog.LevelInfo {
+		t.Errorf("level changed before refresh interval: %v", got)
+	}
+
+	now = now.Add(overrideRefresh)
+	if got := l.Level(SubsystemDaemon); got != slog.LevelDebug {
+		t.Errorf("level after refresh = %v, want debug", got)
+	}
+
+	if err := SetOverride(townRoot, SubsystemDaemon, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(OverridesPath(townRoot)); !os.IsNotExist(err) {
+		t.Errorf("override file should be removed when empty, stat err = %v", err)
+	}
+	now = now.Add(overrideRefresh)
+	if got := l.Level(SubsystemDaemon); got != slog.LevelInfo {
+		t.Errorf("level after clearing = %v, want info", got)
+	}
+}
+
+func TestTextHandlerFormat(t *testing.T) {
+	var buf bytes.Buffer
+	at := time.Date(2026, 3, 2, 9, 4, 5, 0, time.Local)
+	logger := slog.New(NewTextHandler(&buf)).With(KeyRig, "gastown").WithGroup("patrol")
+
+	r := slog.NewRecord(at, slog.LevelInfo, "tick done", 0)
+	r.AddAttrs(slog.String("name", "dolt backup"), slog.Int("n", 3))
+	if err := logger.Handler().Handle(t.Context(), r); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `2026/03/02 09:04:05 tick done rig=gastown patrol.name="dolt backup" patrol.n=3` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got  %q\nwant %q", got, want)
+	}
+}
@@ -0,0 +1,202 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// TextTimeLayout is the timestamp prefix of text output. It matches
+// log.LstdFlags so existing log readers (gt daemon logs, the doctor log
+// scan) keep working.
+const TextTimeLayout = "2006/01/02 15:04:05"
+
+// otelLoggerName is the instrumentation scope for exported records.
+const otelLoggerName = "gastown"
+
+// TextHandler writes one line per record:
+//
+//	2006/01/02 15:04:05 [LEVEL ]message key=value ...
+//
+// The level tag is omitted for info so Printf-style lines look as before.
+type TextHandler struct {
+	mu     *sync.Mutex
+	w      io.Writer
+	prefix string // Preformatted attrs from WithAttrs
+	group  string
+}
+
+// NewTextHandler returns a text handler writing to w.
+func NewTextHandler(w io.Writer) *TextHandler {
+	return &TextHandler{mu: &sync.Mutex{}, w: w}
+}
+
+// Enabled accepts everything; level filtering happens per subsystem upstream.
+func (h *TextHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+// Handle writes the record.
+func (h *TextHandler) Handle(_ context.Context, r slog.Record) error {
+	var b strings.Builder
+	t := r.Time
+	if t.IsZero() {
+		t = time.Now()
+	}
+	b.WriteString(t.Format(TextTimeLayout))
+	b.WriteByte(' ')
+	if r.Level != slog.LevelInfo {
+		b.WriteString(r.Level.String())
+		b.WriteByte(' ')
+	}
+	b.WriteString(strings.TrimRight(r.Message, "\n"))
+	b.WriteString(h.prefix)
+	r.Attrs(func(a slog.Attr) bool {
+		appendAttr(&b, h.group, a)
+		return true
+	})
+	b.WriteByte('\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := io.WriteString(h.w, b.String())
+	return err
+}
+
+// WithAttrs returns a handler that writes attrs on every line.
+func (h *TextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var b strings.Builder
+	b.WriteString(h.prefix)
+	for _, a := range attrs {
+		appendAttr(&b, h.group, a)
+	}
+	return &TextHandler{mu: h.mu, w: h.w, prefix: b.String(), group: h.group}
+}
+
+// WithGroup returns a handler that qualifies later keys with name.
+func (h *TextHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &TextHandler{mu: h.mu, w: h.w, prefix: h.prefix, group: h.group + name + "."}
+}
+
+func appendAttr(b *strings.Builder, group string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		g := group
+		if a.Key != "" {
+			g += a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			appendAttr(b, g, ga)
+		}
+		return
+	}
+	b.WriteByte(' ')
+	b.WriteString(group)
+	b.WriteString(a.Key)
+	b.WriteByte('=')
+	s := a.Value.String()
+	if s == "" || strings.ContainsAny(s, " =\"\n\t") {
+		s = strconv.Quote(s)
+	}
+	b.WriteString(s)
+}
+
+// otelHandler emits records through the global OTel LoggerProvider. Before
+// telemetry.Init (or with telemetry off) the provider is a no-op.
+type otelHandler struct {
+	attrs []otellog.KeyValue
+	group string
+}
+
+func (otelHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h otelHandler) Handle(ctx context.Context, r slog.Record) error {
+	var rec otellog.Record
+	rec.SetTimestamp(r.Time)
+	rec.SetBody(otellog.StringValue(r.Message))
+	rec.SetSeverity(otelSeverity(r.Level))
+	rec.SetSeverityText(r.Level.String())
+	rec.AddAttributes(h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		rec.AddAttributes(otelAttrs(h.group, a)...)
+		return true
+	})
+	global.GetLoggerProvider().Logger(otelLoggerName).Emit(ctx, rec)
+	return nil
+}
+
+func (h otelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	kvs := append([]otellog.KeyValue{}, h.attrs...)
+	for _, a := range attrs {
+		kvs = append(kvs, otelAttrs(h.group, a)...)
+	}
+	return otelHandler{attrs: kvs, group: h.group}
+}
+
+func (h otelHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return otelHandler{attrs: h.attrs, group: h.group + name + "."}
+}
+
+func otelAttrs(group string, a slog.Attr) []otellog.KeyValue {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return nil
+	}
+	key := group + a.Key
+	switch a.Value.Kind() {
+	case slog.KindGroup:
+		g := group
+		if a.Key != "" {
+			g += a.Key + "."
+		}
+		var out []otellog.KeyValue
+		for _, ga := range a.Value.Group() {
+			out = append(out, otelAttrs(g, ga)...)
+		}
+		return out
+	case slog.KindString:
+		return []otellog.KeyValue{otellog.String(key, a.Value.String())}
+	case slog.KindInt64:
+		return []otellog.KeyValue{otellog.Int64(key, a.Value.Int64())}
+	case slog.KindUint64:
+		return []otellog.KeyValue{otellog.Int64(key, int64(a.Value.Uint64()))} //nolint:gosec // G115: counters fit
+	case slog.KindFloat64:
+		return []otellog.KeyValue{otellog.Float64(key, a.Value.Float64())}
+	case slog.KindBool:
+		return []otellog.KeyValue{otellog.Bool(key, a.Value.Bool())}
+	case slog.KindDuration:
+		return []otellog.KeyValue{otellog.String(key, a.Value.Duration().String())}
+	case slog.KindTime:
+		return []otellog.KeyValue{otellog.String(key, a.Value.Time().Format(time.RFC3339Nano))}
+	default:
+		return []otellog.KeyValue{otellog.String(key, fmt.Sprint(a.Value.Any()))}
+	}
+}
+
+func otelSeverity(l slog.Level) otellog.Severity {
+	switch {
+	case l >= slog.LevelError:
+		return otellog.SeverityError
+	case l >= slog.LevelWarn:
+		return otellog.SeverityWarn
+	case l >= slog.LevelInfo:
+		return otellog.SeverityInfo
+	default:
+		return otellog.SeverityDebug
+	}
+}
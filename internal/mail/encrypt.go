@@ -0,0 +1,193 @@
+package mail
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Sensitive threads can keep their message bodies encrypted at rest. The
+// sender names a key from the town secrets store (mayor/secrets.env); the
+// body is sealed with AES-256-GCM before it is written to beads, and replies
+// in the thread are sealed with the same key. Subjects and routing labels
+// stay readable so mail can still be listed and routed.
+//
+// A sealed body is stored as "gtenc:v1:<key name>:<base64 nonce+ciphertext>".
+// Mailboxes open it for the sender and recipients (To and CC) when the key
+// is in the store; anyone else sees SealedPlaceholder.
+
+// DefaultMailKey is the secret used when --encrypt names no key.
+const DefaultMailKey = "MAIL_KEY"
+
+// sealedPrefix marks an encrypted body.
+const sealedPrefix = "gtenc:v1:"
+
+// SealedPlaceholder replaces a body the reader may not or cannot decrypt.
+const SealedPlaceholder = "[encrypted]"
+
+// ErrMailKeyMissing is returned when a thread's key is not in the secrets store.
+var ErrMailKeyMissing = errors.New("mail key not in secrets store")
+
+// SealedKey returns the key name of an encrypted body, or "" if body is
+// plaintext.
+func SealedKey(body string) string {
+	rest, ok := strings.CutPrefix(body, sealedPrefix)
+	if !ok {
+		return ""
+	}
+	name, _, ok := strings.Cut(rest, ":")
+	if !ok {
+		return ""
+	}
+	return name
+}
+
+// SealBody encrypts body with the named key.
+func SealBody(key []byte, keyName, body string) (string, error) {
+	gcm, err := newMailCipher(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generating nonce: %w", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(body), []byte(keyName))
+	return sealedPrefix + keyName + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// OpenBody decrypts a body sealed by SealBody.
+func OpenBody(key []byte, body string) (string, error) {
+	keyName := SealedKey(body)
+	if keyName == "" {
+		return "", fmt.Errorf("body is not encrypted")
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(body, sealedPrefix+keyName+":"))
+	if err != nil {
+		return "", fmt.Errorf("decoding encrypted body: %w", err)
+	}
+	gcm, err := newMailCipher(key)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", fmt.Errorf("encrypted body is truncated")
+	}
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], []byte(keyName))
+	if err != nil {
+		return "", fmt.Errorf("decrypting with %s: %w", keyName, err)
+	}
+	return string(plain), nil
+}
+
+// GenerateMailKey returns a new key in the form stored in the secrets store.
+func GenerateMailKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("generating key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// LoadMailKey reads a mail key from the town secrets store. Keys are 32
+// bytes, stored as base64 or hex.
+func LoadMailKey(townRoot, name string) ([]byte, error) {
+	secrets, err := config.LoadSecrets(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := secrets[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMailKeyMissing, name)
+	}
+	if key, err := base64.StdEncoding.DecodeString(value); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	if key, err := hex.DecodeString(value); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	return nil, fmt.Errorf("mail key %s must be 32 bytes, base64 or hex encoded", name)
+}
+
+// seal encrypts msg's body in place with msg.Encrypted, unless it already
+// is sealed (fan-out sends the same message more than once).
+func (msg *Message) seal(townRoot string) error {
+	if msg.Encrypted == "" || SealedKey(msg.Body) != "" {
+		return nil
+	}
+	key, err := LoadMailKey(townRoot, msg.Encrypted)
+	if err != nil {
+		return err
+	}
+	sealed, err := SealBody(key, msg.Encrypted, msg.Body)
+	if err != nil {
+		return err
+	}
+	msg.Body = sealed
+	return nil
+}
+
+// open decrypts msg's body for reader, a beads identity. Readers outside
+// the thread's participants, or without the key, get SealedPlaceholder.
+func (msg *Message) open(townRoot, reader string) {
+	if SealedKey(msg.Body) == "" {
+		return
+	}
+	if !msg.isParticipant(reader) {
+		msg.Body = SealedPlaceholder
+		return
+	}
+	key, err := LoadMailKey(townRoot, msg.Encrypted)
+	if err == nil {
+		var plain string
+		if plain, err = OpenBody(key, msg.Body); err == nil {
+			msg.Body = plain
+			return
+		}
+	}
+	msg.Body = fmt.Sprintf("%s (%v)", SealedPlaceholder, err)
+}
+
+// openSealed decrypts sealed bodies for the mailbox owner. The town root
+// is only looked up once a sealed message turns up.
+func (m *Mailbox) openSealed(messages ...*Message) {
+	townRoot := ""
+	for _, msg := range messages {
+		if msg.Encrypted == "" {
+			continue
+		}
+		if townRoot == "" {
+			townRoot = detectTownRoot(m.workDir)
+		}
+		msg.open(townRoot, m.identity)
+	}
+}
+
+// isParticipant reports whether identity sent or received msg.
+func (msg *Message) isParticipant(identity string) bool {
+	identity = AddressToIdentity(identity)
+	if identity == "" {
+		return false
+	}
+	for _, addr := range append([]string{msg.From, msg.To}, msg.CC...) {
+		if addr != "" && AddressToIdentity(addr) == identity {
+			return true
+		}
+	}
+	return false
+}
+
+func newMailCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("mail key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,143 @@
+package mail
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestSealAndOpen(t *testing.T) {
+	townRoot := t.TempDir()
+	key, err := GenerateMailKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.SaveSecrets(townRoot, map[string]string{DefaultMailKey: key}); err != nil {
+		t.Fatal(err)
+	}
+
+	msg := &Message{
+		From:      "mayor/",
+		To:        "gastown/Toast",
+		CC:        []string{"gastown/witness"},
+		Body:      "rotate the staging credentials",
+		Encrypted: DefaultMailKey,
+	}
+	if err := msg.seal(townRoot); err != nil {
+		t.Fatal(err)
+	}
+	sealed := msg.Body
+	if SealedKey(sealed) != DefaultMailKey || strings.Contains(sealed, "staging") {
+		t.Fatalf("body not sealed: %q", sealed)
+	}
+	// Fan-out sends the same message again; it must not be sealed twice.
+	if err := msg.seal(townRoot); err != nil || msg.Body != sealed {
+		t.Fatalf("second seal changed body: %v", err)
+	}
+
+	for _, tc := range []struct {
+		reader string
+		want   string
+	}{
+		{"gastown/Toast", "rotate the staging credentials"},
+		{"gastown/witness", "rotate the staging credentials"},
+		{"mayor/", "rotate the staging credentials"},
+		{"gastown/Nux", SealedPlaceholder},
+	} {
+		m := *msg
+		m.open(townRoot, tc.reader)
+		if m.Body != tc.want {
+			t.Errorf("open for %s = %q, want %q", tc.reader, m.Body, tc.want)
+		}
+	}
+}
+
+func TestOpen_MissingKey(t *testing.T) {
+	key, err := GenerateMailKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	townRoot := t.TempDir()
+	if err := config.SaveSecrets(townRoot, map[string]string{"OTHER": key}); err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := LoadMailKey(townRoot, "OTHER")
+	sealed, err := SealBody(raw, DefaultMailKey, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadMailKey(townRoot, DefaultMailKey); !errors.Is(err, ErrMailKeyMissing) {
+		t.Errorf("LoadMailKey error = %v, want ErrMailKeyMissing", err)
+	}
+	msg := &Message{From: "mayor/", To: "gastown/Toast", Body: sealed, Encrypted: DefaultMailKey}
+	msg.open(townRoot, "gastown/Toast")
+	if !strings.HasPrefix(msg.Body, SealedPlaceholder) {
+		t.Errorf("body = %q, want %s marker", msg.Body, SealedPlaceholder)
+	}
+
+	// The key name is bound into the ciphertext.
+	tampered := strings.Replace(sealed, DefaultMailKey, "OTHER", 1)
+	if _, err := OpenBody(raw, tampered); err == nil {
+		t.Error("OpenBody accepted a body relabeled with another key")
+	}
+}
+
+func TestArchiveKeepsBodySealed(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script bd stub")
+	}
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(townRoot, "mayor", "town.json"), []byte(`{"name":"test"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	key, err := GenerateMailKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.SaveSecrets(townRoot, map[string]string{DefaultMailKey: key}); err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := LoadMailKey(townRoot, DefaultMailKey)
+	sealed, err := SealBody(raw, DefaultMailKey, "rotate the staging credentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// bd stub: show returns the sealed bead, close succeeds.
+	bead, _ := json.Marshal([]BeadsMessage{{
+		ID: "hq-1", Title: "creds", Description: sealed, Assignee: "gastown/Toast",
+		Status: "open", Labels: []string{"from:mayor/"},
+	}})
+	binDir := t.TempDir()
+	script := fmt.Sprintf("#!/bin/sh\ncase \"$1\" in\n  show) echo '%s' ;;\nesac\nexit 0\n", bead)
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	m := NewMailboxWithBeadsDir("gastown/Toast", townRoot, filepath.Join(townRoot, ".beads"))
+	if msg, err := m.Get("hq-1"); err != nil || msg.Body != "rotate the staging credentials" {
+		t.Fatalf("Get = %+v, %v; want the opened body", msg, err)
+	}
+	if err := m.Archive("hq-1"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(m.ArchivePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "staging") || !strings.Contains(string(data), sealed) {
+		t.Errorf("archive should hold the sealed body, got %s", data)
+	}
+}
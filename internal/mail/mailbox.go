@@ -112,6 +112,7 @@ func (m *Mailbox) listBeads() ([]*Message, error) {
 	if err != nil {
 		return nil, err
 	}
+	m.openSealed(messages...)
 
 	// Sort by priority (higher first), then timestamp (newest first).
 	sort.Slice(messages, func(i, j int) bool {
@@ -307,7 +308,12 @@ func (m *Mailbox) Get(id string) (*Message, error) {
 
 func (m *Mailbox) getBeads(id string) (*Message, error) {
 	// Single DB query - wisps and persistent messages in same store
-	return m.getFromDir(id, m.beadsDir)
+	msg, err := m.getFromDir(id, m.beadsDir)
+	if err != nil {
+		return nil, err
+	}
+	m.openSealed(msg)
+	return msg, nil
 }
 
 // getFromDir retrieves a message from a beads directory.
@@ -563,8 +569,9 @@ func (m *Mailbox) Archive(id string) error {
 	if m.legacy {
 		return m.archiveLegacy(id)
 	}
-	// Beads mode: append to archive then close
-	msg, err := m.Get(id)
+	// Beads mode: append to archive then close. Read the raw bead, not
+	// m.Get: an encrypted body must reach the archive still sealed.
+	msg, err := m.getFromDir(id, m.beadsDir)
 	if err != nil {
 		return err
 	}
@@ -1029,6 +1036,7 @@ func (m *Mailbox) listByThreadBeads(threadID string) ([]*Message, error) {
 	for _, bm := range beadsMsgs {
 		messages = append(messages, bm.ToMessage())
 	}
+	m.openSealed(messages...)
 
 	// Sort by timestamp (oldest first for thread view)
 	sort.Slice(messages, func(i, j int) bool {
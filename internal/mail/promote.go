@@ -0,0 +1,74 @@
+package mail
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PromotedLabelPrefix marks a message that was promoted to a bead (see
+// DraftPromotion). The rest of the label is the bead's ID.
+const PromotedLabelPrefix = "promoted:"
+
+// FromMailLabel marks a bead that was promoted from mail.
+const FromMailLabel = "from-mail"
+
+// Promotion is a bead drafted from a mail thread.
+type Promotion struct {
+	Title       string
+	Description string
+	Priority    int // beads priority (see PriorityToBeads)
+}
+
+// DraftPromotion drafts a bead for actionable work that arrived as msg. The
+// title is the thread's subject and the description carries the whole
+// thread over as initial context, with a link back to the message. thread
+// may be empty or omit msg; it is ordered oldest first.
+func DraftPromotion(msg *Message, thread []*Message) Promotion {
+	msgs := append([]*Message(nil), thread...)
+	found := false
+	for _, m := range msgs {
+		if m.ID == msg.ID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		msgs = append(msgs, msg)
+	}
+	sort.SliceStable(msgs, func(i, j int) bool { return msgs[i].Timestamp.Before(msgs[j].Timestamp) })
+
+	title := stripReplyPrefixes(msg.Subject)
+	if title == "" {
+		title = "Mail from " + msg.From
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Promoted from mail %s (from %s", msg.ID, msg.From)
+	if msg.ThreadID != "" {
+		fmt.Fprintf(&b, ", thread %s", msg.ThreadID)
+	}
+	b.WriteString(").\n\n## Thread\n")
+	for _, m := range msgs {
+		fmt.Fprintf(&b, "\n### %s\n", m.Subject)
+		fmt.Fprintf(&b, "From %s to %s, %s (%s)\n", m.From, m.To, m.Timestamp.Format("2006-01-02 15:04"), m.ID)
+		if body := strings.TrimSpace(m.Body); body != "" {
+			fmt.Fprintf(&b, "\n%s\n", body)
+		}
+	}
+
+	return Promotion{
+		Title:       title,
+		Description: b.String(),
+		Priority:    PriorityToBeads(msg.Priority),
+	}
+}
+
+// stripReplyPrefixes removes any leading "Re:" markers from a subject.
+func stripReplyPrefixes(subject string) string {
+	s := strings.TrimSpace(subject)
+	for len(s) >= 3 && strings.EqualFold(s[:3], "re:") {
+		s = strings.TrimSpace(s[3:])
+	}
+	return s
+}
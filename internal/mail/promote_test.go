@@ -0,0 +1,50 @@
+package mail
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDraftPromotion(t *testing.T) {
+	t0 := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	first := &Message{
+		ID: "hq-1", From: "mayor/", To: "gastown/crew/max", ThreadID: "thread-1",
+		Subject: "Flaky login test", Body: "TestLogin fails about 1 in 5 runs.", Timestamp: t0,
+	}
+	reply := &Message{
+		ID: "hq-2", From: "gastown/crew/max", To: "mayor/", ThreadID: "thread-1",
+		Subject: "Re: Re: Flaky login test", Body: "Looks like a race in the session cache.",
+		Timestamp: t0.Add(time.Hour), Priority: PriorityHigh,
+	}
+
+	p := DraftPromotion(reply, []*Message{reply, first})
+	if p.Title != "Flaky login test" {
+		t.Errorf("Title = %q, want reply prefixes stripped", p.Title)
+	}
+	if p.Priority != 1 {
+		t.Errorf("Priority = %d, want 1 (high)", p.Priority)
+	}
+	if !strings.HasPrefix(p.Description, "Promoted from mail hq-2 (from gastown/crew/max, thread thread-1).") {
+		t.Errorf("Description should link back to the message:\n%s", p.Description)
+	}
+	i1 := strings.Index(p.Description, "1 in 5 runs")
+	i2 := strings.Index(p.Description, "race in the session cache")
+	if i1 < 0 || i2 < 0 || i1 > i2 {
+		t.Errorf("Description should carry the thread oldest first:\n%s", p.Description)
+	}
+}
+
+func TestDraftPromotion_NoThread(t *testing.T) {
+	msg := &Message{ID: "hq-9", From: "deacon/", To: "mayor/", Body: "Disk is 95% full."}
+	p := DraftPromotion(msg, nil)
+	if p.Title != "Mail from deacon/" {
+		t.Errorf("Title = %q", p.Title)
+	}
+	if !strings.Contains(p.Description, "Disk is 95% full.") {
+		t.Errorf("Description missing the message body:\n%s", p.Description)
+	}
+	if p.Priority != 2 {
+		t.Errorf("Priority = %d, want 2 (normal)", p.Priority)
+	}
+}
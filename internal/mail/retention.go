@@ -0,0 +1,355 @@
+package mail
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/archive"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+const defaultRetentionWarnPercent = 80
+
+// Retention reasons, recorded on each RetentionAction.
+const (
+	RetainReasonAge   = "age"   // Thread went quiet longer than MaxAge
+	RetainReasonCount = "count" // Mailbox held more than MaxMessages
+	RetainReasonSize  = "size"  // Mailbox exceeded MaxBytes
+)
+
+// RetentionPolicy bounds mailbox growth. See config.MailRetentionConfig.
+// Zero values disable the corresponding limit.
+type RetentionPolicy struct {
+	MaxAge          time.Duration
+	MaxMessages     int
+	MaxBytes        int64
+	ArchiveMaxAge   time.Duration
+	ArchiveMaxBytes int64
+	WarnPercent     int
+}
+
+// NewRetentionPolicy builds a policy from town settings. A nil config
+// yields a policy with every limit disabled.
+func NewRetentionPolicy(cfg *config.MailRetentionConfig) (*RetentionPolicy, error) {
+	p := &RetentionPolicy{WarnPercent: defaultRetentionWarnPercent}
+	if cfg == nil {
+		return p, nil
+	}
+	var err error
+	if cfg.MaxAge != "" {
+		if p.MaxAge, err = archive.ParseAge(cfg.MaxAge); err != nil {
+			return nil, fmt.Errorf("mail_retention.max_age: %w", err)
+		}
+	}
+	if cfg.ArchiveMaxAge != "" {
+		if p.ArchiveMaxAge, err = archive.ParseAge(cfg.ArchiveMaxAge); err != nil {
+			return nil, fmt.Errorf("mail_retention.archive_max_age: %w", err)
+		}
+	}
+	p.MaxMessages = max(cfg.MaxMessages, 0)
+	p.MaxBytes = max(cfg.MaxBytes, 0)
+	p.ArchiveMaxBytes = max(cfg.ArchiveMaxBytes, 0)
+	if cfg.WarnPercent > 0 && cfg.WarnPercent <= 100 {
+		p.WarnPercent = cfg.WarnPercent
+	}
+	return p, nil
+}
+
+// LoadRetentionPolicy reads the mail retention policy from town settings.
+func LoadRetentionPolicy(townRoot string) (*RetentionPolicy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return NewRetentionPolicy(settings.MailRetention)
+}
+
+// Enabled reports whether any limit is set.
+func (p *RetentionPolicy) Enabled() bool {
+	return p.MaxAge > 0 || p.MaxMessages > 0 || p.MaxBytes > 0 || p.ArchiveMaxAge > 0 || p.ArchiveMaxBytes > 0
+}
+
+// MessageSize is a message's contribution to its mailbox's byte cap.
+func MessageSize(msg *Message) int64 {
+	return int64(len(msg.Subject) + len(msg.Body))
+}
+
+// RetentionAction is a message the policy moves to the archive.
+type RetentionAction struct {
+	Mailbox string   `json:"mailbox"`
+	Message *Message `json:"message"`
+	Reason  string   `json:"reason"`
+}
+
+// Plan returns the messages in one mailbox that the policy archives, oldest
+// first. Whole threads are archived once their newest message is older than
+// MaxAge; then the oldest remaining messages are evicted until the mailbox
+// is within MaxMessages and MaxBytes. Pinned messages are never archived.
+func (p *RetentionPolicy) Plan(messages []*Message, now time.Time) []RetentionAction {
+	var candidates []*Message
+	for _, msg := range messages {
+		if !msg.Pinned {
+			candidates = append(candidates, msg)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Timestamp.Before(candidates[j].Timestamp)
+	})
+
+	var actions []RetentionAction
+	archived := map[string]bool{}
+	take := func(msg *Message, reason string) {
+		archived[msg.ID] = true
+		actions = append(actions, RetentionAction{Mailbox: msg.To, Message: msg, Reason: reason})
+	}
+
+	if p.MaxAge > 0 {
+		newest := map[string]time.Time{}
+		for _, msg := range candidates {
+			if key := threadKey(msg); msg.Timestamp.After(newest[key]) {
+				newest[key] = msg.Timestamp
+			}
+		}
+		cutoff := now.Add(-p.MaxAge)
+		for _, msg := range candidates {
+			if newest[threadKey(msg)].Before(cutoff) {
+				take(msg, RetainReasonAge)
+			}
+		}
+	}
+
+	count, size := 0, int64(0)
+	for _, msg := range messages {
+		if !archived[msg.ID] {
+			count++
+			size += MessageSize(msg)
+		}
+	}
+	for _, msg := range candidates {
+		if archived[msg.ID] {
+			continue
+		}
+		switch {
+		case p.MaxMessages > 0 && count > p.MaxMessages:
+			take(msg, RetainReasonCount)
+		case p.MaxBytes > 0 && size > p.MaxBytes:
+			take(msg, RetainReasonSize)
+		default:
+			continue
+		}
+		count--
+		size -= MessageSize(msg)
+	}
+	return actions
+}
+
+func threadKey(msg *Message) string {
+	if msg.ThreadID != "" {
+		return msg.ThreadID
+	}
+	return msg.ID
+}
+
+// MailboxUsage is how full a mailbox is relative to the policy's caps.
+type MailboxUsage struct {
+	Mailbox  string `json:"mailbox"`
+	Messages int    `json:"messages"`
+	Bytes    int64  `json:"bytes"`
+
+	// Percent is the fuller of the two caps, as a percentage (0 if uncapped).
+	Percent int `json:"percent"`
+}
+
+// Usage measures a mailbox against MaxMessages and MaxBytes.
+func (p *RetentionPolicy) Usage(mailbox string, messages []*Message) MailboxUsage {
+	u := MailboxUsage{Mailbox: mailbox, Messages: len(messages)}
+	for _, msg := range messages {
+		u.Bytes += MessageSize(msg)
+	}
+	if p.MaxMessages > 0 {
+		u.Percent = u.Messages * 100 / p.MaxMessages
+	}
+	if p.MaxBytes > 0 {
+		u.Percent = max(u.Percent, int(u.Bytes*100/p.MaxBytes))
+	}
+	return u
+}
+
+// NearCap reports whether a mailbox has reached the warning threshold.
+func (p *RetentionPolicy) NearCap(u MailboxUsage) bool {
+	return u.Percent >= p.WarnPercent
+}
+
+// ListMailboxes returns the open mail in the town, keyed by recipient
+// address, with a single query. CC copies are counted only against the
+// primary recipient. A variable so tests can run without bd.
+var ListMailboxes = func(townRoot string) (map[string][]*Message, error) {
+	beadsDir := filepath.Join(townRoot, ".beads")
+	ctx, cancel := bdReadCtx()
+	defer cancel()
+	stdout, err := runBdCommand(ctx, []string{"list", "--label", "gt:message", "--json", "--limit", "0"}, townRoot, beadsDir)
+	if err != nil {
+		return nil, err
+	}
+	var msgs []BeadsMessage
+	if len(stdout) > 0 && string(stdout) != "null" {
+		if err := json.Unmarshal(stdout, &msgs); err != nil {
+			return nil, fmt.Errorf("parsing mail list: %w", err)
+		}
+	}
+	boxes := map[string][]*Message{}
+	for i := range msgs {
+		if msgs[i].Status != "open" && msgs[i].Status != "hooked" {
+			continue
+		}
+		msg := msgs[i].ToMessage()
+		if msg.To == "" {
+			continue
+		}
+		boxes[msg.To] = append(boxes[msg.To], msg)
+	}
+	return boxes, nil
+}
+
+// archiveRetained moves one planned message to the archive.
+var archiveRetained = func(townRoot string, msg *Message) error {
+	mb := NewMailboxWithBeadsDir(msg.To, townRoot, filepath.Join(townRoot, ".beads"))
+	if err := mb.appendToArchive(msg); err != nil {
+		return err
+	}
+	return mb.Delete(msg.ID)
+}
+
+// RetentionReport summarizes a retention sweep.
+type RetentionReport struct {
+	Mailboxes []MailboxUsage    `json:"mailboxes"` // Usage after the sweep
+	Archived  []RetentionAction `json:"archived,omitempty"`
+	Purged    int               `json:"purged"` // Archived messages deleted
+	Errors    []string          `json:"errors,omitempty"`
+	DryRun    bool              `json:"dry_run,omitempty"`
+}
+
+// SweepRetention applies the policy to every mailbox in the town, then
+// prunes the town's mail archive. With dryRun, nothing is changed.
+func SweepRetention(townRoot string, p *RetentionPolicy, now time.Time, dryRun bool) (*RetentionReport, error) {
+	boxes, err := ListMailboxes(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("listing mail: %w", err)
+	}
+	report := &RetentionReport{DryRun: dryRun}
+	names := make([]string, 0, len(boxes))
+	for name := range boxes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		messages := boxes[name]
+		gone := map[string]bool{}
+		for _, a := range p.Plan(messages, now) {
+			if !dryRun {
+				if err := archiveRetained(townRoot, a.Message); err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("%s %s: %v", name, a.Message.ID, err))
+					continue
+				}
+			}
+			gone[a.Message.ID] = true
+			report.Archived = append(report.Archived, a)
+		}
+		var kept []*Message
+		for _, msg := range messages {
+			if !gone[msg.ID] {
+				kept = append(kept, msg)
+			}
+		}
+		report.Mailboxes = append(report.Mailboxes, p.Usage(name, kept))
+	}
+
+	if p.ArchiveMaxAge > 0 || p.ArchiveMaxBytes > 0 {
+		mb := NewMailboxWithBeadsDir("", townRoot, filepath.Join(townRoot, ".beads"))
+		purged, err := mb.PruneArchive(p.ArchiveMaxAge, p.ArchiveMaxBytes, now, dryRun)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("archive: %v", err))
+		}
+		report.Purged = purged
+	}
+	return report, nil
+}
+
+// PruneArchive deletes archived messages older than maxAge, then the oldest
+// remaining ones until the archive fits in maxBytes. Zero disables a limit.
+// Returns how many messages were (or, with dryRun, would be) deleted.
+func (m *Mailbox) PruneArchive(maxAge time.Duration, maxBytes int64, now time.Time, dryRun bool) (int, error) {
+	if m.legacy {
+		fl, err := m.lockLegacy()
+		if err != nil {
+			return 0, err
+		}
+		defer func() { _ = fl.Unlock() }()
+	}
+
+	messages, err := m.ListArchived()
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].Timestamp.Before(messages[j].Timestamp)
+	})
+
+	keep := messages
+	if maxAge > 0 {
+		cutoff := now.Add(-maxAge)
+		keep = keep[:0:0]
+		for _, msg := range messages {
+			if !msg.Timestamp.Before(cutoff) {
+				keep = append(keep, msg)
+			}
+		}
+	}
+	if maxBytes > 0 {
+		var total int64
+		sizes := make([]int64, len(keep))
+		for i, msg := range keep {
+			data, err := json.Marshal(msg)
+			if err != nil {
+				return 0, err
+			}
+			sizes[i] = int64(len(data)) + 1 // newline
+			total += sizes[i]
+		}
+		drop := 0
+		for drop < len(keep) && total > maxBytes {
+			total -= sizes[drop]
+			drop++
+		}
+		keep = keep[drop:]
+	}
+
+	purged := len(messages) - len(keep)
+	if purged == 0 || dryRun {
+		return purged, nil
+	}
+	if len(keep) == 0 {
+		if err := os.Remove(m.ArchivePath()); err != nil && !os.IsNotExist(err) {
+			return 0, err
+		}
+		return purged, nil
+	}
+	if err := m.rewriteArchive(keep); err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// ArchiveSize returns the size of the mailbox's archive file in bytes.
+func (m *Mailbox) ArchiveSize() int64 {
+	info, err := os.Stat(m.ArchivePath())
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
@@ -0,0 +1,160 @@
+package mail
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func retentionMsg(id, thread string, age time.Duration, body string, now time.Time) *Message {
+	return &Message{ID: id, To: "gastown/witness", ThreadID: thread, Subject: "s", Body: body, Timestamp: now.Add(-age)}
+}
+
+func actionIDs(actions []RetentionAction) string {
+	var ids []string
+	for _, a := range actions {
+		ids = append(ids, a.Message.ID+":"+a.Reason)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestNewRetentionPolicy(t *testing.T) {
+	p, err := NewRetentionPolicy(&config.MailRetentionConfig{MaxAge: "14d", ArchiveMaxAge: "2160h", MaxMessages: 50, WarnPercent: 90})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.MaxAge != 14*24*time.Hour || p.ArchiveMaxAge != 90*24*time.Hour || p.MaxMessages != 50 || p.WarnPercent != 90 || !p.Enabled() {
+		t.Errorf("policy = %+v", p)
+	}
+	if p, _ := NewRetentionPolicy(nil); p.Enabled() || p.WarnPercent != defaultRetentionWarnPercent {
+		t.Errorf("nil config policy = %+v, want disabled with default warn percent", p)
+	}
+	if _, err := NewRetentionPolicy(&config.MailRetentionConfig{MaxAge: "soon"}); err == nil {
+		t.Error("invalid max_age accepted")
+	}
+}
+
+func TestRetentionPlan(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	messages := []*Message{
+		retentionMsg("old1", "t1", 20*day, "x", now),
+		retentionMsg("old2", "t1", 16*day, "x", now),
+		retentionMsg("live1", "t2", 30*day, "x", now), // old, but its thread is active
+		retentionMsg("live2", "t2", time.Hour, "x", now),
+		retentionMsg("mid", "", 5*day, "x", now),
+		retentionMsg("new", "", time.Minute, "x", now),
+	}
+	pinned := retentionMsg("pin", "", 60*day, "x", now)
+	pinned.Pinned = true
+	messages = append(messages, pinned)
+
+	p := &RetentionPolicy{MaxAge: 14 * day}
+	if got := actionIDs(p.Plan(messages, now)); got != "old1:age,old2:age" {
+		t.Errorf("age plan = %s", got)
+	}
+
+	p.MaxMessages = 3
+	if got := actionIDs(p.Plan(messages, now)); got != "old1:age,old2:age,live1:count,mid:count" {
+		t.Errorf("age+count plan = %s", got)
+	}
+
+	p = &RetentionPolicy{MaxBytes: int64(len("s")+len("x")) * 2}
+	if got := actionIDs(p.Plan(messages, now)); got != "live1:size,old1:size,old2:size,mid:size,live2:size" {
+		t.Errorf("size plan = %s, want oldest evicted first, pinned kept", got)
+	}
+}
+
+func TestRetentionUsage(t *testing.T) {
+	now := time.Now()
+	p := &RetentionPolicy{MaxMessages: 10, MaxBytes: 100, WarnPercent: 80}
+	msgs := []*Message{retentionMsg("a", "", 0, strings.Repeat("x", 84), now)}
+	u := p.Usage("mayor/", msgs)
+	if u.Messages != 1 || u.Bytes != 85 || u.Percent != 85 || !p.NearCap(u) {
+		t.Errorf("usage = %+v, want 85%% by bytes and near cap", u)
+	}
+	if p.NearCap(p.Usage("mayor/", nil)) {
+		t.Error("empty mailbox reported near cap")
+	}
+}
+
+func TestPruneArchive(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	mb := NewMailbox(t.TempDir())
+	for i, age := range []time.Duration{100 * 24 * time.Hour, 10 * 24 * time.Hour, 5 * 24 * time.Hour, time.Hour} {
+		msg := retentionMsg(string(rune('a'+i)), "", age, strings.Repeat("x", 100), now)
+		if err := mb.appendToArchive(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	n, err := mb.PruneArchive(90*24*time.Hour, 0, now, true)
+	if err != nil || n != 1 {
+		t.Fatalf("dry run = %d, %v; want 1", n, err)
+	}
+	if all, _ := mb.ListArchived(); len(all) != 4 {
+		t.Fatalf("dry run modified archive: %d left", len(all))
+	}
+
+	if n, err := mb.PruneArchive(90*24*time.Hour, 0, now, false); err != nil || n != 1 {
+		t.Fatalf("age prune = %d, %v; want 1", n, err)
+	}
+	size := mb.ArchiveSize()
+	if n, err := mb.PruneArchive(0, size-1, now, false); err != nil || n != 1 {
+		t.Fatalf("size prune = %d, %v; want 1", n, err)
+	}
+	all, _ := mb.ListArchived()
+	if len(all) != 2 || all[0].ID != "c" || all[1].ID != "d" {
+		t.Errorf("archive after prune = %v, want newest two kept", all)
+	}
+}
+
+func TestSweepRetention(t *testing.T) {
+	townRoot := t.TempDir()
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	origList, origArchive := ListMailboxes, archiveRetained
+	t.Cleanup(func() { ListMailboxes, archiveRetained = origList, origArchive })
+
+	ListMailboxes = func(string) (map[string][]*Message, error) {
+		return map[string][]*Message{
+			"gastown/witness": {
+				retentionMsg("w1", "", 3*time.Hour, "x", now),
+				retentionMsg("w2", "", 2*time.Hour, "x", now),
+				retentionMsg("w3", "", time.Hour, "x", now),
+			},
+			"mayor/": {retentionMsg("m1", "", time.Hour, "x", now)},
+		}, nil
+	}
+	var archived []string
+	archiveRetained = func(_ string, msg *Message) error {
+		archived = append(archived, msg.ID)
+		return nil
+	}
+
+	p := &RetentionPolicy{MaxMessages: 2, WarnPercent: 80}
+	report, err := SweepRetention(townRoot, p, now, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Archived) != 1 || len(archived) != 0 {
+		t.Fatalf("dry run: report %+v, archived %v", report.Archived, archived)
+	}
+
+	report, err = SweepRetention(townRoot, p, now, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(archived, ",") != "w1" {
+		t.Errorf("archived = %v, want oldest witness message", archived)
+	}
+	if len(report.Mailboxes) != 2 || report.Mailboxes[0].Mailbox != "gastown/witness" || report.Mailboxes[0].Messages != 2 {
+		t.Errorf("usage = %+v", report.Mailboxes)
+	}
+	if _, err := os.Stat(filepath.Join(townRoot, ".beads", "archive.jsonl")); !os.IsNotExist(err) {
+		t.Error("archive pruning ran without archive limits")
+	}
+}
@@ -74,6 +74,10 @@ type Message struct {
 	// Body is the full message content.
 	Body string `json:"body"`
 
+	// Encrypted names the secrets-store key that seals Body at rest.
+	// Empty for plaintext mail.
+	Encrypted string `json:"encrypted,omitempty"`
+
 	// Timestamp is when the message was sent.
 	Timestamp time.Time `json:"timestamp"`
 
@@ -417,6 +421,7 @@ func (bm *BeadsMessage) ToMessage() *Message {
 		To:              identityToAddress(bm.Assignee),
 		Subject:         bm.Title,
 		Body:            bm.Description,
+		Encrypted:       SealedKey(bm.Description),
 		Timestamp:       bm.CreatedAt,
 		Read:            bm.Status == "closed" || bm.HasLabel("read"),
 		Priority:        priority,
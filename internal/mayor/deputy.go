@@ -0,0 +1,327 @@
+package mayor
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/checkpoint"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// ErrNoDeputy is returned when promoting a deputy that isn't standing by.
+var ErrNoDeputy = errors.New("mayor deputy not running")
+
+// Heartbeat records when the Mayor was last seen alive. The daemon writes
+// it on each heartbeat that finds the Mayor's agent running; a deputy is
+// promoted once it lapses.
+type Heartbeat struct {
+	// Timestamp is when the Mayor was last seen alive.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Session is the tmux session the Mayor was seen in.
+	Session string `json:"session,omitempty"`
+}
+
+// HeartbeatFile returns the path to the Mayor heartbeat file.
+func HeartbeatFile(townRoot string) string {
+	return filepath.Join(townRoot, "mayor", "heartbeat.json")
+}
+
+// WriteHeartbeat writes the Mayor heartbeat to disk.
+func WriteHeartbeat(townRoot string, hb *Heartbeat) error {
+	hbFile := HeartbeatFile(townRoot)
+	if err := os.MkdirAll(filepath.Dir(hbFile), 0755); err != nil {
+		return err
+	}
+	if hb.Timestamp.IsZero() {
+		hb.Timestamp = time.Now().UTC()
+	}
+	data, err := json.MarshalIndent(hb, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(hbFile, data, 0600)
+}
+
+// ReadHeartbeat reads the Mayor heartbeat from disk.
+// Returns nil if the file doesn't exist or can't be read.
+func ReadHeartbeat(townRoot string) *Heartbeat {
+	data, err := os.ReadFile(HeartbeatFile(townRoot)) //nolint:gosec // G304: path is constructed from trusted townRoot
+	if err != nil {
+		return nil
+	}
+	var hb Heartbeat
+	if err := json.Unmarshal(data, &hb); err != nil {
+		return nil
+	}
+	return &hb
+}
+
+// Failover records one promotion of the deputy to Mayor.
+type Failover struct {
+	// At is when the deputy was promoted.
+	At time.Time `json:"at"`
+
+	// LastSeen is the Mayor heartbeat that lapsed (zero if there was none).
+	LastSeen time.Time `json:"last_seen,omitzero"`
+
+	// Checkpoint summarizes the Mayor checkpoint handed to the deputy.
+	Checkpoint string `json:"checkpoint,omitempty"`
+
+	// Error is set when the promotion failed.
+	Error string `json:"error,omitempty"`
+}
+
+// FailoverFile returns the path to the Mayor failover log.
+func FailoverFile(townRoot string) string {
+	return filepath.Join(townRoot, "mayor", "failovers.jsonl")
+}
+
+// RecordFailover appends a failover to the log.
+func RecordFailover(townRoot string, f Failover) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(FailoverFile(townRoot), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: not sensitive
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// ReadFailovers returns the failover log, oldest first.
+// Returns nil if there have been no failovers.
+func ReadFailovers(townRoot string) ([]Failover, error) {
+	file, err := os.Open(FailoverFile(townRoot)) //nolint:gosec // G304: path is constructed from trusted townRoot
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	var out []Failover
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var f Failover
+		if err := json.Unmarshal(scanner.Bytes(), &f); err != nil {
+			continue // Skip torn lines
+		}
+		out = append(out, f)
+	}
+	return out, scanner.Err()
+}
+
+// Failovers returns the town's failover log, oldest first.
+func (m *Manager) Failovers() ([]Failover, error) {
+	return ReadFailovers(m.townRoot)
+}
+
+// DeputySessionName returns the tmux session name for the Mayor's deputy.
+func DeputySessionName() string {
+	return session.DeputySessionName()
+}
+
+// Checkpoint returns the Mayor's latest checkpoint, or nil if it has none.
+func (m *Manager) Checkpoint() *checkpoint.Checkpoint {
+	cp, err := checkpoint.Read(m.mayorDir())
+	if err != nil {
+		return nil
+	}
+	return cp
+}
+
+// DeputyRunning reports whether the deputy is standing by: its session
+// exists and its agent is alive.
+func (m *Manager) DeputyRunning() bool {
+	t := tmux.NewTmux()
+	exists, err := t.HasSession(DeputySessionName())
+	return err == nil && exists && t.IsAgentAlive(DeputySessionName())
+}
+
+// deputyDir returns the deputy's working directory. It keeps the deputy's
+// settings and .runtime apart from the Mayor's.
+func (m *Manager) deputyDir() string {
+	return filepath.Join(m.mayorDir(), "deputy")
+}
+
+// deputyEnv is the identity the deputy runs under until it is promoted, so
+// its hooks and commands never read the Mayor's mail or act as the Mayor.
+func deputyEnv() map[string]string {
+	return map[string]string{
+		"GT_ROLE":         constants.MayorDeputy,
+		"BD_ACTOR":        constants.MayorDeputy,
+		"GIT_AUTHOR_NAME": "mayor-deputy",
+	}
+}
+
+// startupCommand builds an agent startup command with the given env.
+// Without an override the Mayor's role agent is used, since the deputy's
+// GT_ROLE does not name a role of its own.
+func (m *Manager) startupCommand(env map[string]string, prompt, agentOverride string) (string, error) {
+	if agentOverride == "" {
+		if name, ok := config.ResolveRoleAgentName(constants.RoleMayor, m.townRoot, ""); ok {
+			agentOverride = name
+		}
+	}
+	return config.BuildStartupCommandWithAgentOverride(env, "", prompt, agentOverride)
+}
+
+// StartDeputy starts the deputy: a standby Mayor session, primed with the
+// Mayor's checkpoint, that waits to be promoted. The deputy runs in its own
+// directory as mayor/deputy, with its own mailbox.
+// agentOverride optionally specifies a different agent alias to use.
+func (m *Manager) StartDeputy(agentOverride string) error {
+	t := tmux.NewTmux()
+	sessionID := DeputySessionName()
+
+	if _, err := session.KillExistingSession(t, sessionID, true); err != nil {
+		return ErrAlreadyRunning
+	}
+
+	deputyDir := m.deputyDir()
+	if err := os.MkdirAll(deputyDir, 0755); err != nil {
+		return fmt.Errorf("creating deputy directory: %w", err)
+	}
+
+	beacon := session.BeaconConfig{
+		Recipient: "mayor deputy",
+		Sender:    "daemon",
+		Topic:     "standby",
+	}
+	env := config.AgentEnv(config.AgentEnvConfig{
+		Role:     constants.RoleMayor,
+		TownRoot: m.townRoot,
+		Agent:    agentOverride,
+	})
+	for k, v := range deputyEnv() {
+		env[k] = v
+	}
+	command, err := m.startupCommand(env, session.BuildStartupPrompt(beacon, DeputyInstructions(m.Checkpoint())), agentOverride)
+	if err != nil {
+		return fmt.Errorf("building deputy command: %w", err)
+	}
+
+	theme := tmux.MayorTheme()
+	_, err = session.StartSession(t, session.SessionConfig{
+		SessionID:     sessionID,
+		WorkDir:       deputyDir,
+		Role:          constants.RoleMayor,
+		TownRoot:      m.townRoot,
+		AgentName:     "Deputy",
+		Command:       command,
+		AgentOverride: agentOverride,
+		ExtraEnv:      deputyEnv(),
+		Theme:         &theme,
+		WaitForAgent:  true,
+		WaitFatal:     true,
+		AcceptBypass:  true,
+	})
+	return err
+}
+
+// StopDeputy stops the deputy session.
+func (m *Manager) StopDeputy() error {
+	t := tmux.NewTmux()
+	running, err := t.HasSession(DeputySessionName())
+	if err != nil {
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return ErrNoDeputy
+	}
+	return t.KillSessionWithProcesses(DeputySessionName())
+}
+
+// PromoteDeputy makes the deputy the Mayor: any dead Mayor session is
+// cleared away, the deputy's session takes the Mayor's name, and its agent
+// is restarted in the Mayor's directory under the Mayor's identity, told to
+// take over from the Mayor's latest checkpoint, which is returned.
+func (m *Manager) PromoteDeputy() (*checkpoint.Checkpoint, error) {
+	if !m.DeputyRunning() {
+		return nil, ErrNoDeputy
+	}
+	t := tmux.NewTmux()
+	mayorSession := m.SessionName()
+
+	if _, err := session.KillExistingSession(t, mayorSession, true); err != nil {
+		return nil, ErrAlreadyRunning
+	}
+	agentOverride, _ := t.GetEnvironment(DeputySessionName(), "GT_AGENT")
+	if err := t.RenameSession(DeputySessionName(), mayorSession); err != nil {
+		return nil, fmt.Errorf("renaming deputy session: %w", err)
+	}
+
+	cp := m.Checkpoint()
+	beacon := session.BeaconConfig{
+		Recipient: "mayor",
+		Sender:    "daemon",
+		Topic:     "failover",
+	}
+	env := config.AgentEnv(config.AgentEnvConfig{
+		Role:     constants.RoleMayor,
+		TownRoot: m.townRoot,
+		Agent:    agentOverride,
+	})
+	command, err := m.startupCommand(env, session.BuildStartupPrompt(beacon, PromotionMessage(cp)), agentOverride)
+	if err != nil {
+		return cp, fmt.Errorf("building mayor command: %w", err)
+	}
+	for k, v := range env {
+		_ = t.SetEnvironment(mayorSession, k, v)
+	}
+	pane, err := t.GetPaneID(mayorSession)
+	if err != nil {
+		return cp, fmt.Errorf("finding promoted deputy pane: %w", err)
+	}
+	if err := t.RespawnPaneWithWorkDir(pane, m.mayorDir(), command); err != nil {
+		return cp, fmt.Errorf("restarting deputy as mayor: %w", err)
+	}
+
+	_ = t.ConfigureGasTownSession(mayorSession, tmux.MayorTheme(), "", "Mayor", "mayor")
+	if err := t.SetAutoRespawnHook(mayorSession); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to set auto-respawn hook for %s: %v\n", mayorSession, err)
+	}
+	_ = t.AcceptStartupDialogs(mayorSession)
+	return cp, nil
+}
+
+// DeputyInstructions tells a standby deputy to hold off until promoted,
+// and what the Mayor was doing when it started.
+func DeputyInstructions(cp *checkpoint.Checkpoint) string {
+	var b strings.Builder
+	b.WriteString("You are the Mayor's deputy, on standby, running as mayor/deputy with your own mailbox. ")
+	b.WriteString("The Mayor is running in another session. ")
+	b.WriteString("Do NOT act as the Mayor: do not read or send mail, sling work, or nudge agents. ")
+	b.WriteString("Run `gt prime` to load the Mayor's context, then wait. ")
+	b.WriteString("If the Mayor dies, the daemon will restart you as the Mayor and tell you to take over.")
+	if cp != nil {
+		fmt.Fprintf(&b, "\n\nMayor checkpoint (%s ago): %s", cp.Age().Round(time.Minute), cp.Summary())
+	}
+	return b.String()
+}
+
+// PromotionMessage tells a promoted deputy that it is now the Mayor.
+func PromotionMessage(cp *checkpoint.Checkpoint) string {
+	msg := "[FAILOVER] The Mayor's heartbeat lapsed and you have been promoted: you are now the Mayor. " +
+		"Check your mail and hook (`gt mail inbox`, `gt hook`) and resume coordinating the town."
+	if cp != nil {
+		msg += fmt.Sprintf(" Last Mayor checkpoint (%s ago): %s", cp.Age().Round(time.Minute), cp.Summary())
+	}
+	return msg
+}
@@ -0,0 +1,76 @@
+package mayor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/checkpoint"
+)
+
+func TestHeartbeatRoundTrip(t *testing.T) {
+	townRoot := t.TempDir()
+	if ReadHeartbeat(townRoot) != nil {
+		t.Fatal("expected no heartbeat before the first write")
+	}
+	ts := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	if err := WriteHeartbeat(townRoot, &Heartbeat{Timestamp: ts, Session: "hq-mayor"}); err != nil {
+		t.Fatal(err)
+	}
+	hb := ReadHeartbeat(townRoot)
+	if hb == nil || !hb.Timestamp.Equal(ts) || hb.Session != "hq-mayor" {
+		t.Errorf("ReadHeartbeat() = %+v", hb)
+	}
+}
+
+func TestRecordFailover(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ReadFailovers(townRoot); err != nil || got != nil {
+		t.Fatalf("ReadFailovers() on empty town = %v, %v", got, err)
+	}
+	at := time.Date(2026, 3, 10, 9, 5, 0, 0, time.UTC)
+	for _, f := range []Failover{
+		{At: at, LastSeen: at.Add(-6 * time.Minute), Checkpoint: "hooked: hq-1"},
+		{At: at.Add(time.Hour), Error: "mayor deputy not running"},
+	} {
+		if err := RecordFailover(townRoot, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := ReadFailovers(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Checkpoint != "hooked: hq-1" || got[1].Error == "" || !got[1].LastSeen.IsZero() {
+		t.Errorf("ReadFailovers() = %+v", got)
+	}
+}
+
+func TestDeputyMessagesIncludeCheckpoint(t *testing.T) {
+	cp := (&checkpoint.Checkpoint{Timestamp: time.Now()}).WithHookedBead("hq-42")
+	if msg := DeputyInstructions(cp); !strings.Contains(msg, "standby") || !strings.Contains(msg, "hq-42") {
+		t.Errorf("DeputyInstructions() = %q", msg)
+	}
+	if msg := PromotionMessage(nil); !strings.Contains(msg, "you are now the Mayor") || strings.Contains(msg, "checkpoint") {
+		t.Errorf("PromotionMessage(nil) = %q", msg)
+	}
+	if msg := PromotionMessage(cp); !strings.Contains(msg, "hq-42") {
+		t.Errorf("PromotionMessage() = %q", msg)
+	}
+}
+
+func TestDeputyIdentitySeparateFromMayor(t *testing.T) {
+	m := NewManager("/town")
+	if m.deputyDir() == m.mayorDir() {
+		t.Errorf("deputy shares the Mayor's directory %s", m.mayorDir())
+	}
+	env := deputyEnv()
+	if env["GT_ROLE"] == "mayor" || env["BD_ACTOR"] == "mayor" {
+		t.Errorf("deputyEnv() = %v, want an identity other than the Mayor's", env)
+	}
+}
@@ -0,0 +1,193 @@
+// Package memory stores per-agent long-term notes: distilled learnings an
+// agent wants its future sessions to start with (conventions discovered,
+// recurring failure causes). Each agent has its own append-only JSONL file
+// under <town>/.runtime/memory/. gt prime includes the newest notes that fit
+// a size budget; gt find searches every agent's notes.
+//
+// Town-wide memories shared by all agents live in the beads key-value store
+// (gt remember); this package holds what one agent learned for itself.
+package memory
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// DefaultPrimeBudget is how many bytes of notes gt prime includes.
+const DefaultPrimeBudget = 4096
+
+// maxNoteBytes caps a single note so one entry can't eat the prime budget.
+const maxNoteBytes = 1024
+
+// Note is one remembered learning.
+type Note struct {
+	Time time.Time `json:"ts"`
+	Text string    `json:"text"`
+}
+
+// Dir returns the directory holding the town's agent memory files.
+func Dir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "memory")
+}
+
+// Path returns the memory file for an agent ("gastown/crew/max" becomes
+// gastown.crew.max.jsonl).
+func Path(townRoot, agent string) string {
+	return filepath.Join(Dir(townRoot), fileName(agent))
+}
+
+func fileName(agent string) string {
+	return strings.ReplaceAll(strings.Trim(agent, "/"), "/", ".") + ".jsonl"
+}
+
+// agentName reverses fileName.
+func agentName(file string) string {
+	return strings.ReplaceAll(strings.TrimSuffix(file, ".jsonl"), ".", "/")
+}
+
+// Append adds a note to the agent's memory.
+func Append(townRoot, agent, text string) error {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return errors.New("note is empty")
+	}
+	if len(text) > maxNoteBytes {
+		return fmt.Errorf("note is %d bytes; keep it under %d (distill it)", len(text), maxNoteBytes)
+	}
+	if strings.Trim(agent, "/") == "" {
+		return errors.New("agent is required")
+	}
+
+	data, err := json.Marshal(Note{Time: time.Now().UTC(), Text: text})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(Dir(townRoot), 0755); err != nil {
+		return fmt.Errorf("creating memory dir: %w", err)
+	}
+	f, err := os.OpenFile(Path(townRoot, agent), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return fmt.Errorf("opening memory: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing memory: %w", err)
+	}
+	return nil
+}
+
+// Load returns the agent's notes, oldest first. A missing file yields none.
+func Load(townRoot, agent string) ([]Note, error) {
+	return loadFile(Path(townRoot, agent))
+}
+
+func loadFile(path string) ([]Note, error) {
+	f, err := os.Open(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var notes []Note
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var n Note
+		if json.Unmarshal(scanner.Bytes(), &n) == nil && n.Text != "" {
+			notes = append(notes, n)
+		}
+	}
+	return notes, scanner.Err()
+}
+
+// Budget returns the newest notes whose text fits in budget bytes, oldest
+// first, and how many older notes were left out.
+func Budget(notes []Note, budget int) (kept []Note, omitted int) {
+	used := 0
+	i := len(notes)
+	for i > 0 && used+len(notes[i-1].Text) <= budget {
+		i--
+		used += len(notes[i].Text)
+	}
+	return notes[i:], i
+}
+
+// Forget removes the agent's notes containing query (case-insensitive) and
+// returns how many were removed.
+func Forget(townRoot, agent, query string) (int, error) {
+	notes, err := Load(townRoot, agent)
+	if err != nil {
+		return 0, err
+	}
+	var keep []Note
+	for _, n := range notes {
+		if !matches(n, query) {
+			keep = append(keep, n)
+		}
+	}
+	removed := len(notes) - len(keep)
+	if removed == 0 {
+		return 0, nil
+	}
+
+	var b strings.Builder
+	for _, n := range keep {
+		data, err := json.Marshal(n)
+		if err != nil {
+			return 0, err
+		}
+		b.Write(data)
+		b.WriteByte('\n')
+	}
+	path := Path(townRoot, agent)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(b.String()), 0644); err != nil { //nolint:gosec // G306: notes are not secret
+		return 0, err
+	}
+	return removed, os.Rename(tmp, path)
+}
+
+// Match is a note found by Search.
+type Match struct {
+	Agent string `json:"agent"`
+	Note
+}
+
+// Search returns every agent's notes containing query (case-insensitive),
+// newest first.
+func Search(townRoot, query string) ([]Match, error) {
+	files, err := filepath.Glob(filepath.Join(Dir(townRoot), "*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	var out []Match
+	for _, file := range files {
+		notes, err := loadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		agent := agentName(filepath.Base(file))
+		for _, n := range notes {
+			if matches(n, query) {
+				out = append(out, Match{Agent: agent, Note: n})
+			}
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Time.After(out[j].Time) })
+	return out, nil
+}
+
+func matches(n Note, query string) bool {
+	return strings.Contains(strings.ToLower(n.Text), strings.ToLower(query))
+}
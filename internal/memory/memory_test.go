@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAppendLoadSearch(t *testing.T) {
+	town := t.TempDir()
+	for _, n := range []struct{ agent, text string }{
+		{"gastown/crew/max", "Refinery rebases before merging; push a clean branch"},
+		{"gastown/crew/max", "make test needs DOLT_PORT set"},
+		{"gastown/witness", "Polecats stall when the Dolt server restarts"},
+	} {
+		if err := Append(town, n.agent, n.text); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	notes, err := Load(town, "gastown/crew/max")
+	if err != nil || len(notes) != 2 || !strings.HasPrefix(notes[0].Text, "Refinery") {
+		t.Fatalf("Load = %+v, %v; want two notes oldest first", notes, err)
+	}
+
+	matches, err := Search(town, "dolt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	agents := map[string]bool{}
+	for _, m := range matches {
+		agents[m.Agent] = true
+	}
+	if len(matches) != 2 || !agents["gastown/crew/max"] || !agents["gastown/witness"] {
+		t.Errorf("Search(dolt) = %+v, want one note from each agent", matches)
+	}
+
+	if n, err := Forget(town, "gastown/crew/max", "DOLT_PORT"); err != nil || n != 1 {
+		t.Fatalf("Forget = %d, %v; want 1", n, err)
+	}
+	if notes, _ := Load(town, "gastown/crew/max"); len(notes) != 1 {
+		t.Errorf("after Forget: %+v, want one note", notes)
+	}
+
+	if err := Append(town, "mayor", "  "); err == nil {
+		t.Error("Append accepted an empty note")
+	}
+	if err := Append(town, "mayor", strings.Repeat("x", maxNoteBytes+1)); err == nil {
+		t.Error("Append accepted an oversized note")
+	}
+}
+
+func TestBudget(t *testing.T) {
+	notes := []Note{{Text: "aaaa"}, {Text: "bbbb"}, {Text: "cccc"}}
+	kept, omitted := Budget(notes, 9)
+	if len(kept) != 2 || kept[0].Text != "bbbb" || omitted != 1 {
+		t.Errorf("Budget(9) = %+v, %d; want the two newest and one omitted", kept, omitted)
+	}
+	if kept, omitted := Budget(notes, 2); len(kept) != 0 || omitted != 3 {
+		t.Errorf("Budget(2) = %+v, %d; want none", kept, omitted)
+	}
+}
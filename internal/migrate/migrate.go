@@ -0,0 +1,163 @@
+// Package migrate moves a town's on-disk layout between gastown versions.
+//
+// Each Step takes the town from layout Version-1 to Version. The town's
+// current layout version is recorded in mayor/town.json (layout_version);
+// towns created before layout versioning are at version 0. Run applies the
+// pending steps in order, backing up the town's config first and recording
+// the new version after every step, so an interrupted migration resumes
+// where it stopped.
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/backup"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Step is one layout migration.
+type Step struct {
+	// Version is the layout version the town is at after this step.
+	Version int
+
+	// Description says what the step does, for previews.
+	Description string
+
+	// Plan lists the changes the step would make, without making them.
+	Plan func(townRoot string) ([]string, error)
+
+	// Apply makes the changes. It must be idempotent: a step interrupted
+	// before its version was recorded runs again.
+	Apply func(townRoot string) error
+}
+
+// Latest is the layout version this binary expects.
+func Latest() int {
+	return Steps[len(Steps)-1].Version
+}
+
+// LayoutVersion returns the town's recorded layout version.
+func LayoutVersion(townRoot string) (int, error) {
+	cfg, err := config.LoadTownConfig(constants.MayorTownPath(townRoot))
+	if err != nil {
+		return 0, err
+	}
+	return cfg.LayoutVersion, nil
+}
+
+// setLayoutVersion records the town's layout version in town.json.
+func setLayoutVersion(townRoot string, version int) error {
+	path := constants.MayorTownPath(townRoot)
+	cfg, err := config.LoadTownConfig(path)
+	if err != nil {
+		return err
+	}
+	cfg.LayoutVersion = version
+	return config.SaveTownConfig(path, cfg)
+}
+
+// Pending returns the steps a town at layout version current still needs.
+func Pending(current int) []Step {
+	var out []Step
+	for _, s := range Steps {
+		if s.Version > current {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// PlannedStep is a pending step with the changes it would make.
+type PlannedStep struct {
+	Version     int      `json:"version"`
+	Description string   `json:"description"`
+	Changes     []string `json:"changes"`
+}
+
+// Options configures Run.
+type Options struct {
+	// DryRun plans the migration without changing anything.
+	DryRun bool
+
+	// NoBackup skips the config backup taken before the first step.
+	NoBackup bool
+}
+
+// Result reports what Run did (or, for a dry run, would do).
+type Result struct {
+	From   int           `json:"from"`
+	To     int           `json:"to"`
+	Steps  []PlannedStep `json:"steps"`
+	Backup string        `json:"backup,omitempty"`
+}
+
+// Run migrates the town to the latest layout version.
+func Run(townRoot string, opts Options) (*Result, error) {
+	from, err := LayoutVersion(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("reading layout version: %w", err)
+	}
+	if from > Latest() {
+		return nil, fmt.Errorf("town layout version %d is newer than this gt supports (%d); upgrade gt", from, Latest())
+	}
+
+	res := &Result{From: from, To: from}
+	pending := Pending(from)
+	for _, s := range pending {
+		changes, err := s.Plan(townRoot)
+		if err != nil {
+			return res, fmt.Errorf("planning layout v%d: %w", s.Version, err)
+		}
+		res.Steps = append(res.Steps, PlannedStep{Version: s.Version, Description: s.Description, Changes: changes})
+	}
+	if opts.DryRun || len(pending) == 0 {
+		return res, nil
+	}
+
+	if !opts.NoBackup {
+		dir, err := backupConfig(townRoot, from)
+		if err != nil {
+			return res, fmt.Errorf("backing up config: %w", err)
+		}
+		res.Backup = dir
+	}
+
+	for _, s := range pending {
+		if err := s.Apply(townRoot); err != nil {
+			return res, fmt.Errorf("migrating to layout v%d (%s): %w", s.Version, s.Description, err)
+		}
+		if err := setLayoutVersion(townRoot, s.Version); err != nil {
+			return res, fmt.Errorf("recording layout v%d: %w", s.Version, err)
+		}
+		res.To = s.Version
+	}
+	return res, nil
+}
+
+// BackupsDir is where Run keeps pre-migration config backups.
+func BackupsDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "migrate-backups")
+}
+
+// backupConfig copies the town's config files into a new backup directory.
+func backupConfig(townRoot string, from int) (string, error) {
+	dir := filepath.Join(BackupsDir(townRoot), fmt.Sprintf("v%d-%s", from, time.Now().UTC().Format("20060102-150405")))
+	for _, rel := range backup.ConfigFiles(townRoot) {
+		data, err := os.ReadFile(filepath.Join(townRoot, filepath.FromSlash(rel))) //nolint:gosec // G304: path from town config listing
+		if err != nil {
+			return "", err
+		}
+		dst := filepath.Join(dir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(dst, data, 0600); err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
@@ -0,0 +1,67 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+func newTown(t *testing.T, layout int) string {
+	t.Helper()
+	town := t.TempDir()
+	cfg := &config.TownConfig{Type: "town", Version: config.CurrentTownVersion, Name: "test", CreatedAt: time.Now(), LayoutVersion: layout}
+	if err := config.SaveTownConfig(constants.MayorTownPath(town), cfg); err != nil {
+		t.Fatal(err)
+	}
+	return town
+}
+
+func TestRun(t *testing.T) {
+	town := newTown(t, 0)
+
+	res, err := Run(town, Options{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Steps) != len(Steps) || res.To != 0 || len(res.Steps[0].Changes) == 0 {
+		t.Fatalf("dry run = %+v, want every step planned and nothing applied", res)
+	}
+	if _, err := os.Stat(filepath.Join(town, "plugins")); !os.IsNotExist(err) {
+		t.Fatal("dry run created plugins/")
+	}
+
+	res, err = Run(town, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.To != Latest() || res.Backup == "" {
+		t.Fatalf("result = %+v, want migrated to v%d with a backup", res, Latest())
+	}
+	if _, err := os.Stat(filepath.Join(res.Backup, "mayor", "town.json")); err != nil {
+		t.Errorf("backup missing town.json: %v", err)
+	}
+	for _, path := range []string{"deacon/dogs/boot", "plugins", "settings/escalation.json"} {
+		if _, err := os.Stat(filepath.Join(town, path)); err != nil {
+			t.Errorf("%s not created: %v", path, err)
+		}
+	}
+	if v, err := LayoutVersion(town); err != nil || v != Latest() {
+		t.Errorf("LayoutVersion = %d, %v; want %d", v, err, Latest())
+	}
+
+	// Up to date: nothing pending.
+	if res, err := Run(town, Options{}); err != nil || len(res.Steps) != 0 {
+		t.Errorf("second run = %+v, %v; want no steps", res, err)
+	}
+}
+
+func TestRunNewerLayout(t *testing.T) {
+	town := newTown(t, Latest()+1)
+	if _, err := Run(town, Options{}); err == nil {
+		t.Error("Run succeeded on a town newer than the binary")
+	}
+}
@@ -0,0 +1,69 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Steps are the layout migrations, in version order. Append new steps; never
+// renumber or remove shipped ones.
+var Steps = []Step{
+	{
+		Version:     1,
+		Description: "Create town directories added since the original layout",
+		Plan:        missingDirs.plan,
+		Apply:       missingDirs.apply,
+	},
+	{
+		Version:     2,
+		Description: "Create the default escalation config",
+		Plan:        planEscalationConfig,
+		Apply:       applyEscalationConfig,
+	},
+}
+
+// dirSet is a set of town-relative directories a step ensures exist.
+type dirSet []string
+
+var missingDirs = dirSet{
+	filepath.Join("deacon", "dogs", "boot"),
+	"plugins",
+	"settings",
+}
+
+func (d dirSet) plan(townRoot string) ([]string, error) {
+	var changes []string
+	for _, rel := range d {
+		if _, err := os.Stat(filepath.Join(townRoot, rel)); os.IsNotExist(err) {
+			changes = append(changes, fmt.Sprintf("create %s/", filepath.ToSlash(rel)))
+		}
+	}
+	return changes, nil
+}
+
+func (d dirSet) apply(townRoot string) error {
+	for _, rel := range d {
+		if err := os.MkdirAll(filepath.Join(townRoot, rel), 0755); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func planEscalationConfig(townRoot string) ([]string, error) {
+	if _, err := os.Stat(config.EscalationConfigPath(townRoot)); os.IsNotExist(err) {
+		return []string{"create settings/escalation.json with defaults"}, nil
+	}
+	return nil, nil
+}
+
+func applyEscalationConfig(townRoot string) error {
+	path := config.EscalationConfigPath(townRoot)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	return config.SaveEscalationConfig(path, config.NewEscalationConfig())
+}
@@ -0,0 +1,133 @@
+// Package observer implements read-only access to a town.
+//
+// Observers are stakeholders who may watch status, history, and logs but
+// not change anything. A CLI runs as an observer when GT_OBSERVER is set;
+// the dashboard serves observers with gt dashboard --observer, which admits
+// holders of a token issued by gt observer add. Only SHA-256 hashes of
+// tokens are stored, in mayor/observers.json.
+package observer
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// EnvVar marks a process as a read-only observer when set to a non-empty,
+// non-"0" value.
+const EnvVar = "GT_OBSERVER"
+
+// tokenPrefix makes observer tokens recognizable in logs and URLs.
+const tokenPrefix = "gto_"
+
+// Enabled reports whether this process runs as a read-only observer.
+func Enabled() bool {
+	v := strings.TrimSpace(os.Getenv(EnvVar))
+	return v != "" && v != "0" && !strings.EqualFold(v, "false")
+}
+
+// Observer is a named token holder. The token itself is never stored.
+type Observer struct {
+	Name      string    `json:"name"`
+	TokenHash string    `json:"token_hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Registry is the set of observer tokens (mayor/observers.json).
+type Registry struct {
+	Observers []Observer `json:"observers"`
+}
+
+// RegistryPath returns the observer token file.
+func RegistryPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirMayor, "observers.json")
+}
+
+// Load reads the observer registry; a missing file is an empty registry.
+func Load(townRoot string) (*Registry, error) {
+	r := &Registry{}
+	data, err := os.ReadFile(RegistryPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading observers: %w", err)
+	}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("parsing observers: %w", err)
+	}
+	return r, nil
+}
+
+// Save writes the registry readable only by its owner.
+func (r *Registry) Save(townRoot string) error {
+	return util.EnsureDirAndWriteJSONWithPerm(RegistryPath(townRoot), r, 0600)
+}
+
+// Add issues a token for a new observer and returns it. The token is shown
+// once; only its hash is kept.
+func (r *Registry) Add(name string, now time.Time) (string, error) {
+	if name == "" || strings.ContainsAny(name, " \t\n") {
+		return "", fmt.Errorf("invalid observer name %q", name)
+	}
+	if r.find(name) >= 0 {
+		return "", fmt.Errorf("observer %q already exists", name)
+	}
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generating token: %w", err)
+	}
+	token := tokenPrefix + hex.EncodeToString(buf)
+	r.Observers = append(r.Observers, Observer{Name: name, TokenHash: hashToken(token), CreatedAt: now.UTC()})
+	sort.Slice(r.Observers, func(i, j int) bool { return r.Observers[i].Name < r.Observers[j].Name })
+	return token, nil
+}
+
+// Revoke removes an observer. It reports whether one was removed.
+func (r *Registry) Revoke(name string) bool {
+	i := r.find(name)
+	if i < 0 {
+		return false
+	}
+	r.Observers = append(r.Observers[:i], r.Observers[i+1:]...)
+	return true
+}
+
+// Verify returns the observer holding token.
+func (r *Registry) Verify(token string) (string, bool) {
+	if !strings.HasPrefix(token, tokenPrefix) {
+		return "", false
+	}
+	h := []byte(hashToken(token))
+	for _, o := range r.Observers {
+		if subtle.ConstantTimeCompare(h, []byte(o.TokenHash)) == 1 {
+			return o.Name, true
+		}
+	}
+	return "", false
+}
+
+func (r *Registry) find(name string) int {
+	for i, o := range r.Observers {
+		if o.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
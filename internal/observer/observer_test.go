@@ -0,0 +1,70 @@
+package observer
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegistry_AddVerifyRevoke(t *testing.T) {
+	townRoot := t.TempDir()
+	r, err := Load(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := r.Add("alice", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Add("alice", time.Now()); err == nil {
+		t.Error("Add accepted a duplicate name")
+	}
+	if err := r.Save(townRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(RegistryPath(townRoot))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("registry perm = %o, want 600", perm)
+	}
+	data, _ := os.ReadFile(RegistryPath(townRoot))
+	if strings.Contains(string(data), token) {
+		t.Error("registry stores the raw token")
+	}
+
+	r, err = Load(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, ok := r.Verify(token); !ok || name != "alice" {
+		t.Errorf("Verify(token) = %q, %v; want alice, true", name, ok)
+	}
+	for _, bad := range []string{"", "gto_", token + "x", token[len(tokenPrefix):]} {
+		if _, ok := r.Verify(bad); ok {
+			t.Errorf("Verify(%q) accepted a bad token", bad)
+		}
+	}
+
+	if !r.Revoke("alice") {
+		t.Error("Revoke(alice) = false")
+	}
+	if _, ok := r.Verify(token); ok {
+		t.Error("revoked token still verifies")
+	}
+	if r.Revoke("alice") {
+		t.Error("second Revoke(alice) = true")
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	for v, want := range map[string]bool{"": false, "0": false, "false": false, "1": true, "alice": true} {
+		t.Setenv(EnvVar, v)
+		if got := Enabled(); got != want {
+			t.Errorf("Enabled() with %s=%q = %v, want %v", EnvVar, v, got, want)
+		}
+	}
+}
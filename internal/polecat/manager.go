@@ -15,12 +15,11 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/gofrs/flock"
-
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/locks"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/session"
@@ -182,37 +181,37 @@ func (m *Manager) GetNamePool() *NamePool {
 	return m.namePool
 }
 
-// lockPolecat acquires an exclusive file lock for a specific polecat.
+// polecatLockWait bounds how long a polecat or pool lock is waited for.
+const polecatLockWait = 5 * time.Minute
+
+// lockPolecat acquires the town lock for a specific polecat.
 // This prevents concurrent gt processes from racing on the same polecat's
 // filesystem operations (Add, Remove, RepairWorktree).
-// Caller must defer fl.Unlock().
-func (m *Manager) lockPolecat(name string) (*flock.Flock, error) {
-	lockDir := filepath.Join(m.rig.Path, ".runtime", "locks")
-	if err := os.MkdirAll(lockDir, 0755); err != nil {
-		return nil, fmt.Errorf("creating lock dir: %w", err)
-	}
-	lockPath := filepath.Join(lockDir, fmt.Sprintf("polecat-%s.lock", name))
-	fl := flock.New(lockPath)
-	if err := fl.Lock(); err != nil {
+// Caller must defer h.Release().
+func (m *Manager) lockPolecat(name string) (*locks.Handle, error) {
+	h, err := locks.Acquire(filepath.Dir(m.rig.Path), "polecat/"+m.rig.Name+"/"+name, locks.Options{
+		Purpose: "polecat " + m.rig.Name + "/" + name,
+		Wait:    polecatLockWait,
+	})
+	if err != nil {
 		return nil, fmt.Errorf("acquiring polecat lock for %s: %w", name, err)
 	}
-	return fl, nil
+	return h, nil
 }
 
-// lockPool acquires an exclusive file lock for name pool operations.
-// This prevents concurrent gt processes from racing on AllocateName/ReconcilePool.
-// Caller must defer fl.Unlock().
-func (m *Manager) lockPool() (*flock.Flock, error) {
-	lockDir := filepath.Join(m.rig.Path, ".runtime", "locks")
-	if err := os.MkdirAll(lockDir, 0755); err != nil {
-		return nil, fmt.Errorf("creating lock dir: %w", err)
-	}
-	lockPath := filepath.Join(lockDir, "polecat-pool.lock")
-	fl := flock.New(lockPath)
-	if err := fl.Lock(); err != nil {
+// lockPool acquires the town lock for the rig's name pool (the warm pool of
+// idle polecats). This prevents concurrent gt processes from racing on
+// AllocateName/ReconcilePool.
+// Caller must defer h.Release().
+func (m *Manager) lockPool() (*locks.Handle, error) {
+	h, err := locks.Acquire(filepath.Dir(m.rig.Path), "polecat-pool/"+m.rig.Name, locks.Options{
+		Purpose: "polecat pool " + m.rig.Name,
+		Wait:    polecatLockWait,
+	})
+	if err != nil {
 		return nil, fmt.Errorf("acquiring pool lock: %w", err)
 	}
-	return fl, nil
+	return h, nil
 }
 
 // CheckDoltHealth verifies that the Dolt database is reachable before spawning.
@@ -521,6 +520,9 @@ type AddOptions struct {
 // - polecat/{name}-{timestamp} otherwise
 func (m *Manager) buildBranchName(name, issue string) string {
 	template := m.rig.GetStringConfig("polecat_branch_template")
+	if template == "" {
+		template = rig.LoadBranchPolicy(m.rig.Path).BranchTemplate
+	}
 
 	// No template configured - use default behavior for backward compatibility
 	if template == "" {
@@ -611,6 +613,17 @@ func (m *Manager) buildBranchName(name, issue string) string {
 	return result
 }
 
+// policyBranchName builds a polecat branch name and checks it against the
+// rig's branch policy, so a template that can't satisfy the policy fails
+// before any worktree is created.
+func (m *Manager) policyBranchName(name, issue string) (string, error) {
+	branch := m.buildBranchName(name, issue)
+	if err := rig.LoadBranchPolicy(m.rig.Path).CheckBranchName(branch); err != nil {
+		return "", fmt.Errorf("%w (check polecat_branch_template in %s/config.json)", err, m.rig.Path)
+	}
+	return branch, nil
+}
+
 // Polecat state is derived from beads assignee field, not state.json.
 //
 // Branch naming: Each polecat run gets a unique branch (polecat/<name>-<timestamp>).
@@ -637,27 +650,27 @@ func (m *Manager) AllocateAndAdd(opts AddOptions) (string, *Polecat, error) {
 
 	name, err := m.namePool.Allocate()
 	if err != nil {
-		_ = poolLock.Unlock()
+		poolLock.Release()
 		return "", nil, err
 	}
 
 	if err := m.namePool.Save(); err != nil {
-		_ = poolLock.Unlock()
+		poolLock.Release()
 		return "", nil, fmt.Errorf("saving pool state: %w", err)
 	}
 
 	// Acquire per-polecat lock while still holding pool lock
 	polecatLock, err := m.lockPolecat(name)
 	if err != nil {
-		_ = poolLock.Unlock()
+		poolLock.Release()
 		return "", nil, err
 	}
 
 	// Create polecat directory while holding both locks
 	polecatDir := m.polecatDir(name)
 	if err := os.MkdirAll(polecatDir, 0755); err != nil {
-		_ = polecatLock.Unlock()
-		_ = poolLock.Unlock()
+		polecatLock.Release()
+		poolLock.Release()
 		return "", nil, fmt.Errorf("creating polecat dir: %w", err)
 	}
 
@@ -671,13 +684,13 @@ func (m *Manager) AllocateAndAdd(opts AddOptions) (string, *Polecat, error) {
 
 	// Directory exists — pool lock can be released. No concurrent AllocateName
 	// can reallocate this name because reconcilePoolInternal will see the directory.
-	_ = poolLock.Unlock()
+	poolLock.Release()
 
 	// Continue with the rest of AddWithOptions under the polecat lock only.
 	// addWithOptionsLocked expects the polecat directory to already exist
 	// and the polecat lock to be held by the caller.
 	p, err := m.addWithOptionsLocked(name, opts, polecatDir)
-	_ = polecatLock.Unlock()
+	polecatLock.Release()
 	if err != nil {
 		return "", nil, err
 	}
@@ -691,7 +704,10 @@ func (m *Manager) addWithOptionsLocked(name string, opts AddOptions, polecatDir
 	defer func() { telemetry.RecordPolecatSpawn(context.Background(), name, retErr) }()
 
 	clonePath := filepath.Join(polecatDir, m.rig.Name)
-	branchName := m.buildBranchName(name, opts.HookBead)
+	branchName, err := m.policyBranchName(name, opts.HookBead)
+	if err != nil {
+		return nil, err
+	}
 
 	// Track resources created for rollback on error.
 	var worktreeCreated bool
@@ -779,6 +795,8 @@ func (m *Manager) addWithOptionsLocked(name string, opts AddOptions, polecatDir
 		style.PrintWarning("could not run setup hooks: %v", err)
 	}
 
+	m.prewarm(name, clonePath, true)
+
 	agentID := m.agentBeadID(name)
 	if err = m.createAgentBeadWithRetry(agentID, &beads.AgentFields{
 		RoleType:   "polecat",
@@ -814,7 +832,7 @@ func (m *Manager) AddWithOptions(name string, opts AddOptions) (_ *Polecat, retE
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = fl.Unlock() }()
+	defer fl.Release()
 
 	if m.exists(name) {
 		return nil, ErrPolecatExists
@@ -826,7 +844,10 @@ func (m *Manager) AddWithOptions(name string, opts AddOptions) (_ *Polecat, retE
 	clonePath := filepath.Join(polecatDir, m.rig.Name)
 
 	// Build branch name using configured template or default format
-	branchName := m.buildBranchName(name, opts.HookBead)
+	branchName, err := m.policyBranchName(name, opts.HookBead)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create polecat directory (polecats/<name>/)
 	if err := os.MkdirAll(polecatDir, 0755); err != nil {
@@ -965,6 +986,10 @@ func (m *Manager) AddWithOptions(name string, opts AddOptions) (_ *Polecat, retE
 		style.PrintWarning("could not run setup hooks: %v", err)
 	}
 
+	// Pre-warm dependencies (go mod download, npm ci, ...) so the agent's
+	// first build doesn't pay for them. Non-fatal.
+	m.prewarm(name, clonePath, true)
+
 	// NOTE: Slash commands (.claude/commands/) are provisioned at town level by gt install.
 	// All agents inherit them via Claude's directory traversal - no per-workspace copies needed.
 
@@ -1022,7 +1047,7 @@ func (m *Manager) RemoveWithOptions(name string, force, nuclear, selfNuke bool)
 	if err != nil {
 		return err
 	}
-	defer func() { _ = fl.Unlock() }()
+	defer fl.Release()
 
 	if !m.exists(name) {
 		return ErrPolecatNotFound
@@ -1246,7 +1271,7 @@ func (m *Manager) AllocateName() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	defer func() { _ = fl.Unlock() }()
+	defer fl.Release()
 
 	// Reconcile without re-acquiring the pool lock
 	m.reconcilePoolInternal()
@@ -1322,7 +1347,7 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = fl.Unlock() }()
+	defer fl.Release()
 
 	if !m.exists(name) {
 		return nil, ErrPolecatNotFound
@@ -1385,7 +1410,10 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 
 	// Create fresh worktree to a temporary path first, so we can roll back if it fails.
 	// This prevents destroying the old worktree before the new one is confirmed working.
-	branchName := m.buildBranchName(name, opts.HookBead)
+	branchName, err := m.policyBranchName(name, opts.HookBead)
+	if err != nil {
+		return nil, err
+	}
 	tmpClonePath := newClonePath + ".repair-tmp"
 	_ = os.RemoveAll(tmpClonePath) // clean up any leftover temp dir
 	if err := repoGit.WorktreeAddFromRef(tmpClonePath, branchName, startPoint); err != nil {
@@ -1442,6 +1470,9 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 		style.PrintWarning("could not update .gitignore: %v", err)
 	}
 
+	// The repaired worktree is new, so its previous warm state is gone.
+	m.prewarm(name, newClonePath, true)
+
 	// NOTE: Slash commands inherited from town level - no per-workspace copies needed.
 
 	// Create or reopen agent bead for ZFC compliance
@@ -1485,15 +1516,16 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 //  1. Verify polecat exists and worktree is accessible
 //  2. Fetch latest from origin
 //  3. Create fresh branch: git checkout -b <branch> <startPoint>
-//  4. Reset agent bead and set hook_bead atomically
-//  5. Return polecat in working state
+//  4. Re-run pre-warm steps whose inputs changed (see prewarm)
+//  5. Reset agent bead and set hook_bead atomically
+//  6. Return polecat in working state
 func (m *Manager) ReuseIdlePolecat(name string, opts AddOptions) (*Polecat, error) {
 	// Acquire per-polecat file lock to prevent concurrent reuse/remove races
 	fl, err := m.lockPolecat(name)
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = fl.Unlock() }()
+	defer fl.Release()
 
 	if !m.exists(name) {
 		return nil, ErrPolecatNotFound
@@ -1535,11 +1567,17 @@ func (m *Manager) ReuseIdlePolecat(name string, opts AddOptions) (*Polecat, erro
 	}
 
 	// Create fresh branch from start point (branch-only, no worktree add/remove)
-	branchName := m.buildBranchName(name, opts.HookBead)
+	branchName, err := m.policyBranchName(name, opts.HookBead)
+	if err != nil {
+		return nil, err
+	}
 	if err := polecatGit.CheckoutNewBranch(branchName, startPoint); err != nil {
 		return nil, fmt.Errorf("creating branch %s from %s: %w", branchName, startPoint, err)
 	}
 
+	// Re-run only the pre-warm steps whose inputs changed with the new base.
+	m.prewarm(name, clonePath, false)
+
 	// Reset agent bead for reuse
 	agentID := m.agentBeadID(name)
 	if err := m.beads.ResetAgentBeadForReuse(agentID, "idle polecat reuse"); err != nil {
@@ -1581,7 +1619,7 @@ func (m *Manager) ReconcilePool() {
 	if err != nil {
 		return
 	}
-	defer func() { _ = fl.Unlock() }()
+	defer fl.Release()
 
 	m.reconcilePoolInternal()
 }
@@ -1837,6 +1875,33 @@ func (m *Manager) FindIdlePolecat() (*Polecat, error) {
 	return nil, nil
 }
 
+// FindBestIdlePolecat returns the idle polecat with the highest score, or nil
+// if none are idle. Ties keep List order, so with a constant score this
+// behaves like FindIdlePolecat. Used by sling to prefer polecats with better
+// track records (see stats.Scorecard). Polecats scoring below zero are
+// vetoed (see RoutingResponse).
+func (m *Manager) FindBestIdlePolecat(score func(name string) float64) (*Polecat, error) {
+	polecats, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+	var best *Polecat
+	var bestScore float64
+	for _, p := range polecats {
+		if p.State != StateIdle {
+			continue
+		}
+		s := score(p.Name)
+		if s < 0 {
+			continue
+		}
+		if best == nil || s > bestScore {
+			best, bestScore = p, s
+		}
+	}
+	return best, nil
+}
+
 // Get returns a specific polecat by name.
 // State is derived from beads assignee field + tmux session state:
 // - If an issue is assigned to this polecat: StateWorking
@@ -1,6 +1,7 @@
 package polecat
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -804,6 +805,28 @@ func TestBuildBranchName(t *testing.T) {
 	}
 }
 
+func TestPolicyBranchName(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := `{"type":"rig","name":"test-rig","branch_policy":{"polecat_branch_template":"wisp/{issue}","allowed_prefixes":["wisp/"]}}`
+	if err := os.WriteFile(filepath.Join(tmpDir, "config.json"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m := NewManager(&rig.Rig{Name: "test-rig", Path: tmpDir}, git.NewGit(tmpDir), nil)
+
+	got, err := m.policyBranchName("alpha", "gt-7")
+	if err != nil || got != "wisp/7" {
+		t.Fatalf("policyBranchName() = %q, %v; want the policy template's branch", got, err)
+	}
+
+	// A layered template that escapes the allowed prefixes is refused.
+	origDefault := rig.SystemDefaults["polecat_branch_template"]
+	rig.SystemDefaults["polecat_branch_template"] = "feature/{name}"
+	defer func() { rig.SystemDefaults["polecat_branch_template"] = origDefault }()
+	if _, err := m.policyBranchName("alpha", "gt-7"); !errors.Is(err, rig.ErrBranchPolicy) {
+		t.Errorf("policyBranchName() error = %v, want ErrBranchPolicy", err)
+	}
+}
+
 func TestAddWithOptions_NoPrimeMDCreatedLocally(t *testing.T) {
 	// This test verifies that ProvisionPrimeMDForWorktree does NOT create
 	// a local .beads/PRIME.md in the worktree when there's no tracked one.
@@ -0,0 +1,193 @@
+package polecat
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/telemetry"
+)
+
+// PrewarmStampFile records, per step, the input hash of the last successful
+// pre-warm. It lives in the polecat dir (outside the worktree) so git never
+// sees it and it disappears with the polecat.
+const PrewarmStampFile = ".prewarm.json"
+
+// PrewarmStepResult is the outcome of one pre-warm step.
+type PrewarmStepResult struct {
+	Name     string
+	Skipped  bool
+	Duration time.Duration
+	Err      error
+}
+
+type prewarmStamp struct {
+	Steps map[string]string `json:"steps"` // step name -> input hash
+}
+
+// PrewarmCacheDir returns the rig-wide package manager cache shared by
+// pre-warm steps.
+func PrewarmCacheDir(rigPath string) string {
+	return filepath.Join(rigPath, constants.DirRuntime, "prewarm-cache")
+}
+
+func (m *Manager) prewarmStampPath(name string) string {
+	return filepath.Join(m.polecatDir(name), PrewarmStampFile)
+}
+
+// prewarm runs the rig's configured pre-warm steps in a polecat worktree.
+// Steps whose inputs are unchanged since their last successful run are
+// skipped, which is what makes reused idle polecats fast; fresh forces every
+// step (a repaired worktree has no node_modules even if go.sum matches).
+// Failures are warnings: a cold worktree still works, just slower.
+func (m *Manager) prewarm(name, clonePath string, fresh bool) []PrewarmStepResult {
+	settings, err := config.LoadRigSettings(config.RigSettingsPath(m.rig.Path))
+	if err != nil || settings == nil || settings.Prewarm == nil || len(settings.Prewarm.Steps) == 0 {
+		return nil
+	}
+	cfg := settings.Prewarm
+
+	stamp := prewarmStamp{Steps: make(map[string]string)}
+	if !fresh {
+		if data, err := os.ReadFile(m.prewarmStampPath(name)); err == nil {
+			_ = json.Unmarshal(data, &stamp)
+			if stamp.Steps == nil {
+				stamp.Steps = make(map[string]string)
+			}
+		}
+	}
+
+	env := m.prewarmEnv(cfg)
+	var results []PrewarmStepResult
+	for _, step := range cfg.Steps {
+		if step.Command == "" {
+			continue
+		}
+		if step.When != "" {
+			if _, err := os.Stat(filepath.Join(clonePath, step.When)); err != nil {
+				continue
+			}
+		}
+		hash := prewarmInputHash(clonePath, step)
+		res := PrewarmStepResult{Name: step.Name}
+		if hash != "" && stamp.Steps[step.Name] == hash {
+			res.Skipped = true
+		} else {
+			start := time.Now()
+			res.Err = runPrewarmStep(clonePath, step.Command, env, cfg.StepTimeout())
+			res.Duration = time.Since(start)
+			if res.Err != nil {
+				delete(stamp.Steps, step.Name)
+				style.PrintWarning("pre-warm step %s failed after %s: %v", step.Name, res.Duration.Round(time.Second), res.Err)
+			} else if hash != "" {
+				stamp.Steps[step.Name] = hash
+			}
+		}
+		telemetry.RecordPolecatPrewarm(context.Background(), m.rig.Name, step.Name, res.Skipped, res.Duration, res.Err)
+		results = append(results, res)
+	}
+
+	if data, err := json.Marshal(stamp); err == nil {
+		_ = os.WriteFile(m.prewarmStampPath(name), data, 0644) //nolint:gosec // G306: not sensitive
+	}
+	return results
+}
+
+// IsWarm reports whether an idle polecat's worktree has completed every
+// configured pre-warm step with its current inputs. Spawn prefers warm
+// polecats when choosing which idle polecat to reuse.
+func (m *Manager) IsWarm(name string) bool {
+	settings, err := config.LoadRigSettings(config.RigSettingsPath(m.rig.Path))
+	if err != nil || settings == nil || settings.Prewarm == nil {
+		return false
+	}
+	data, err := os.ReadFile(m.prewarmStampPath(name))
+	if err != nil {
+		return false
+	}
+	var stamp prewarmStamp
+	if json.Unmarshal(data, &stamp) != nil {
+		return false
+	}
+	clonePath := m.clonePath(name)
+	for _, step := range settings.Prewarm.Steps {
+		if step.When != "" {
+			if _, err := os.Stat(filepath.Join(clonePath, step.When)); err != nil {
+				continue
+			}
+		}
+		hash := prewarmInputHash(clonePath, step)
+		if hash == "" || stamp.Steps[step.Name] != hash {
+			return false
+		}
+	}
+	return true
+}
+
+// prewarmEnv builds the environment for pre-warm steps: the parent
+// environment, the rig's env profile, and shared package caches.
+func (m *Manager) prewarmEnv(cfg *config.PrewarmConfig) []string {
+	env := os.Environ()
+	if profile := config.LoadRigEnvProfile(m.rig.Path); profile != nil {
+		for k, v := range profile.Resolve(m.rig.Path, os.LookupEnv).Vars {
+			env = append(env, k+"="+v)
+		}
+	}
+	if cfg.IsSharedCache() {
+		cache := PrewarmCacheDir(m.rig.Path)
+		if err := os.MkdirAll(cache, 0755); err == nil {
+			env = append(env,
+				"npm_config_cache="+filepath.Join(cache, "npm"),
+				"YARN_CACHE_FOLDER="+filepath.Join(cache, "yarn"),
+				"PIP_CACHE_DIR="+filepath.Join(cache, "pip"),
+			)
+		}
+	}
+	return env
+}
+
+// prewarmInputHash hashes a step's command and input files. Returns "" when
+// the step declares no inputs, so it always runs.
+func prewarmInputHash(clonePath string, step config.PrewarmStep) string {
+	if len(step.Inputs) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	h.Write([]byte(step.Command))
+	for _, input := range step.Inputs {
+		h.Write([]byte{0})
+		h.Write([]byte(input))
+		if data, err := os.ReadFile(filepath.Join(clonePath, input)); err == nil { //nolint:gosec // G304: path from rig settings
+			h.Write(data)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func runPrewarmStep(dir, command string, env []string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "sh", "-c", command) //nolint:gosec // G204: command from rig settings
+	cmd.Dir = dir
+	cmd.Env = env
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("timed out after %s", timeout)
+	}
+	if err != nil {
+		if len(out) > 500 {
+			out = out[len(out)-500:]
+		}
+		return fmt.Errorf("%w: %s", err, out)
+	}
+	return nil
+}
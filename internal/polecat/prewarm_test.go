@@ -0,0 +1,66 @@
+package polecat
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestPrewarm_SkipsUnchangedInputsOnReuse(t *testing.T) {
+	root := t.TempDir()
+	r := &rig.Rig{Name: "test-rig", Path: root}
+	m := NewManager(r, git.NewGit(root), nil)
+
+	clonePath := m.clonePath("Toast")
+	if err := os.MkdirAll(clonePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(clonePath, "go.sum"), []byte("v1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	settings := config.NewRigSettings()
+	settings.Prewarm = &config.PrewarmConfig{Steps: []config.PrewarmStep{
+		{Name: "deps", Command: "echo run >> prewarm.log; echo $npm_config_cache > cache.txt", Inputs: []string{"go.sum"}},
+		{Name: "node", Command: "exit 1", When: "package.json"},
+	}}
+	if err := config.SaveRigSettings(config.RigSettingsPath(root), settings); err != nil {
+		t.Fatal(err)
+	}
+
+	runs := func() int {
+		data, _ := os.ReadFile(filepath.Join(clonePath, "prewarm.log"))
+		return strings.Count(string(data), "run")
+	}
+
+	res := m.prewarm("Toast", clonePath, true)
+	if len(res) != 1 || res[0].Skipped || res[0].Err != nil {
+		t.Fatalf("first prewarm = %+v, want one step run (node skipped by When)", res)
+	}
+	if !m.IsWarm("Toast") {
+		t.Error("IsWarm = false after a successful prewarm")
+	}
+	cache, _ := os.ReadFile(filepath.Join(clonePath, "cache.txt"))
+	if want := filepath.Join(PrewarmCacheDir(root), "npm"); strings.TrimSpace(string(cache)) != want {
+		t.Errorf("npm_config_cache = %q, want %q", cache, want)
+	}
+
+	if res := m.prewarm("Toast", clonePath, false); !res[0].Skipped || runs() != 1 {
+		t.Errorf("reuse with unchanged inputs should skip, got %+v (runs=%d)", res, runs())
+	}
+
+	if err := os.WriteFile(filepath.Join(clonePath, "go.sum"), []byte("v2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if m.IsWarm("Toast") {
+		t.Error("IsWarm = true after inputs changed")
+	}
+	if res := m.prewarm("Toast", clonePath, false); res[0].Skipped || runs() != 2 {
+		t.Errorf("reuse with changed inputs should rerun, got %+v (runs=%d)", res, runs())
+	}
+}
@@ -0,0 +1,166 @@
+package polecat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// routingHookTimeout bounds a single routing hook. Routing sits on the sling
+// hot path, so a slow hook is abandoned and its adjustments ignored.
+var routingHookTimeout = 5 * time.Second
+
+// routingHookMaxOutput caps how much a routing hook may print to stdout.
+const routingHookMaxOutput = 1 << 20
+
+// RoutingCandidate is an idle polecat offered to routing hooks.
+type RoutingCandidate struct {
+	Name  string  `json:"name"`
+	Agent string  `json:"agent"` // e.g. "gastown/polecats/Toast"
+	Score float64 `json:"score"` // Score so far; higher wins
+	Warm  bool    `json:"warm"`  // Pre-warm steps are current
+}
+
+// RoutingRequest is written as JSON to each routing hook's stdin.
+type RoutingRequest struct {
+	Rig        string             `json:"rig"`
+	Bead       string             `json:"bead,omitempty"`
+	Candidates []RoutingCandidate `json:"candidates"`
+}
+
+// RoutingResponse is read as JSON from a routing hook's stdout. Scores
+// replaces the scores of the named candidates; candidates it omits keep
+// theirs. A negative score vetoes the candidate.
+type RoutingResponse struct {
+	Scores map[string]float64 `json:"scores"`
+}
+
+// RoutingHookDirs returns the directories searched for routing hooks: town
+// hooks in <town>/settings/routing-hooks/ run first, then the rig's own in
+// <rig>/.runtime/routing-hooks/.
+func RoutingHookDirs(townRoot, rigPath string) []string {
+	return []string{
+		filepath.Join(townRoot, "settings", "routing-hooks"),
+		filepath.Join(rigPath, constants.DirRuntime, "routing-hooks"),
+	}
+}
+
+// RoutingHooks returns the executable routing hooks in dirs, each directory
+// in alphabetical order. Missing directories and non-executable files are
+// skipped.
+func RoutingHooks(dirs ...string) []string {
+	var hooks []string
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil || info.Mode().Perm()&0111 == 0 {
+				continue
+			}
+			hooks = append(hooks, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return hooks
+}
+
+// ApplyRoutingHooks passes req through each hook in turn, letting it adjust
+// candidate scores, and returns the final score per candidate name.
+//
+// Hooks are untrusted extensions: a hook that fails, times out, or prints
+// anything but a valid RoutingResponse is skipped with a warning and routing
+// continues with the scores it was given.
+func ApplyRoutingHooks(hooks []string, req RoutingRequest) map[string]float64 {
+	for _, hook := range hooks {
+		resp, err := runRoutingHook(hook, req)
+		if err != nil {
+			style.PrintWarning("routing hook %s ignored: %v", filepath.Base(hook), err)
+			continue
+		}
+		for i, c := range req.Candidates {
+			if s, ok := resp.Scores[c.Name]; ok {
+				req.Candidates[i].Score = s
+			}
+		}
+	}
+
+	scores := make(map[string]float64, len(req.Candidates))
+	for _, c := range req.Candidates {
+		scores[c.Name] = c.Score
+	}
+	return scores
+}
+
+func runRoutingHook(hook string, req RoutingRequest) (*RoutingResponse, error) {
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), routingHookTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, hook) //nolint:gosec // G204: hook installed by the town operator
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &limitedWriter{w: &stdout, n: routingHookMaxOutput}
+	cmd.Stderr = &limitedWriter{w: &stderr, n: 500, truncate: true}
+	cmd.Env = append(os.Environ(), "GT_ROUTING_RIG="+req.Rig, "GT_ROUTING_BEAD="+req.Bead)
+	// Don't wait on grandchildren that inherited stdout after the hook is killed.
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("timed out after %s", routingHookTimeout)
+		}
+		if msg := bytes.TrimSpace(stderr.Bytes()); len(msg) > 0 {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+
+	var resp RoutingResponse
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("invalid output: %w", err)
+	}
+	return &resp, nil
+}
+
+// limitedWriter writes at most n bytes to w, so a runaway hook cannot
+// exhaust memory. Past the limit it fails, or with truncate drops the rest.
+type limitedWriter struct {
+	w        io.Writer
+	n        int
+	truncate bool
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > l.n {
+		if !l.truncate {
+			return 0, fmt.Errorf("output too large")
+		}
+		if _, err := l.w.Write(p[:l.n]); err != nil {
+			return 0, err
+		}
+		l.n = 0
+		return len(p), nil
+	}
+	l.n -= len(p)
+	return l.w.Write(p)
+}
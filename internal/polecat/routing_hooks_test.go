@@ -0,0 +1,98 @@
+package polecat
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func writeRoutingHook(t *testing.T, dir, name, script string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script+"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func routingRequest() RoutingRequest {
+	return RoutingRequest{
+		Rig:  "gastown",
+		Bead: "gt-abc",
+		Candidates: []RoutingCandidate{
+			{Name: "Toast", Agent: "gastown/polecats/Toast", Score: 0.5},
+			{Name: "Nux", Agent: "gastown/polecats/Nux", Score: 0.9},
+		},
+	}
+}
+
+func TestRoutingHooks_Order(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("routing hooks are shell scripts")
+	}
+	town, rigDir := t.TempDir(), t.TempDir()
+	dirs := RoutingHookDirs(town, rigDir)
+	writeRoutingHook(t, dirs[1], "01-rig", "cat >/dev/null")
+	writeRoutingHook(t, dirs[0], "20-town", "cat >/dev/null")
+	writeRoutingHook(t, dirs[0], "10-town", "cat >/dev/null")
+	if err := os.WriteFile(filepath.Join(dirs[0], "README"), []byte("docs"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got := RoutingHooks(dirs...)
+	want := []string{
+		filepath.Join(dirs[0], "10-town"),
+		filepath.Join(dirs[0], "20-town"),
+		filepath.Join(dirs[1], "01-rig"),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RoutingHooks() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("RoutingHooks()[%d] = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestApplyRoutingHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("routing hooks are shell scripts")
+	}
+	dir := t.TempDir()
+	// The first hook sees the request; the second vetoes Nux and leaves
+	// Toast's score as the first hook set it.
+	writeRoutingHook(t, dir, "10-prefer-toast", `grep -q '"bead":"gt-abc"' && echo '{"scores":{"Toast":2}}'`)
+	writeRoutingHook(t, dir, "20-veto-nux", `cat >/dev/null; echo '{"scores":{"Nux":-1}}'`)
+
+	scores := ApplyRoutingHooks(RoutingHooks(dir), routingRequest())
+	if scores["Toast"] != 2 || scores["Nux"] != -1 {
+		t.Errorf("scores = %v, want Toast=2 Nux=-1", scores)
+	}
+}
+
+func TestApplyRoutingHooks_MisbehavingHooksIgnored(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("routing hooks are shell scripts")
+	}
+	old := routingHookTimeout
+	routingHookTimeout = 200 * time.Millisecond
+	t.Cleanup(func() { routingHookTimeout = old })
+
+	dir := t.TempDir()
+	writeRoutingHook(t, dir, "10-fails", `echo '{"scores":{"Toast":5}}'; exit 1`)
+	writeRoutingHook(t, dir, "20-garbage", `echo not json`)
+	writeRoutingHook(t, dir, "30-hangs", `sleep 10`)
+
+	start := time.Now()
+	scores := ApplyRoutingHooks(RoutingHooks(dir), routingRequest())
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("hanging hook held routing for %s", elapsed)
+	}
+	if scores["Toast"] != 0.5 || scores["Nux"] != 0.9 {
+		t.Errorf("scores = %v, want the original scores", scores)
+	}
+}
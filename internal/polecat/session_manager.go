@@ -18,6 +18,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
+	"github.com/steveyegge/gastown/internal/sandbox"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
@@ -74,6 +75,10 @@ type SessionStartOptions struct {
 	// If set, GT_AGENT is written to the tmux session environment table so that
 	// IsAgentAlive and waitForPolecatReady read the correct process names.
 	Agent string
+
+	// Experiment is the A/B experiment variant tag ("<experiment>/<variant>")
+	// for the work this session runs. Sets GT_EXPERIMENT in the session.
+	Experiment string
 }
 
 // SessionInfo contains information about a running polecat session.
@@ -172,6 +177,11 @@ func (m *SessionManager) clonePath(polecat string) string {
 	return newPath
 }
 
+// ClonePath returns the path of the polecat's git worktree.
+func (m *SessionManager) ClonePath(polecat string) string {
+	return m.clonePath(polecat)
+}
+
 // hasPolecat checks if the polecat exists in this rig.
 func (m *SessionManager) hasPolecat(polecat string) bool {
 	polecatPath := m.polecatDir(polecat)
@@ -277,6 +287,7 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 			Issue:       opts.Issue,
 			Topic:       "assigned",
 			SessionName: sessionID,
+			Experiment:  opts.Experiment,
 		}, m.rig.Path, beacon, "")
 		if err != nil {
 			return fmt.Errorf("building startup command: %w", err)
@@ -316,12 +327,52 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	}
 	command = config.PrependEnv(command, envVarsToInject)
 
+	// Run the agent inside a container when its runtime config asks for
+	// isolation. The env exports above execute inside the container shell.
+	if runtimeConfig.Sandbox != nil && runtimeConfig.Kubernetes != nil {
+		return fmt.Errorf("runtime config sets both sandbox and kubernetes; pick one")
+	}
+	if runtimeConfig.Sandbox != nil {
+		if err := sandbox.CheckEngine(runtimeConfig.Sandbox); err != nil {
+			return err
+		}
+		command, err = sandbox.PrepareSession(runtimeConfig.Sandbox, sandbox.Options{
+			Name:     sessionID,
+			TownRoot: townRoot,
+			RigPath:  m.rig.Path,
+			WorkDir:  workDir,
+		}, command)
+		if err != nil {
+			return fmt.Errorf("wrapping sandbox command: %w", err)
+		}
+	}
+	// Or submit it as a Kubernetes Job and attach the pane to the pod.
+	if runtimeConfig.Kubernetes != nil {
+		if err := sandbox.CheckKubectl(); err != nil {
+			return err
+		}
+		command, err = sandbox.PrepareKubernetesSession(runtimeConfig.Kubernetes, sandbox.KubernetesOptions{
+			Name:    sessionID,
+			RigPath: m.rig.Path,
+			WorkDir: workDir,
+		}, command)
+		if err != nil {
+			return fmt.Errorf("preparing kubernetes job: %w", err)
+		}
+	}
+
 	// Create session with command directly to avoid send-keys race condition.
 	// See: https://github.com/anthropics/gastown/issues/280
 	if err := m.tmux.NewSessionWithCommand(sessionID, workDir, command); err != nil {
 		return fmt.Errorf("creating session: %w", err)
 	}
 
+	// Record the pane for gt grep-sessions. A Kubernetes session's pod logs
+	// are already streamed into its session log.
+	if runtimeConfig.Kubernetes == nil {
+		debugSession("RecordOutput", session.RecordOutput(m.tmux, sessionID, m.rig.Path))
+	}
+
 	// Set environment (non-fatal: session works without these)
 	// Use centralized AgentEnv for consistency across all role startup paths
 	// Note: townRoot already defined above for ResolveRoleAgentConfig
@@ -332,6 +383,7 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 		TownRoot:         townRoot,
 		RuntimeConfigDir: opts.RuntimeConfigDir,
 		Agent:            opts.Agent,
+		Experiment:       opts.Experiment,
 	})
 	for k, v := range envVars {
 		debugSession("SetEnvironment "+k, m.tmux.SetEnvironment(sessionID, k, v))
@@ -364,6 +416,14 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	// shadow built-in preset names (e.g., custom "codex" running "opencode"),
 	// so we resolve process names from both agent name and actual command.
 	processNames := config.ResolveProcessNames(runtimeConfig.ResolvedAgent, runtimeConfig.Command)
+	if runtimeConfig.Sandbox != nil {
+		// The pane process of a sandboxed session is the container CLI.
+		processNames = append(processNames, sandbox.Engine(runtimeConfig.Sandbox))
+	}
+	if runtimeConfig.Kubernetes != nil {
+		// The pane stays attached to the pod through kubectl.
+		processNames = append(processNames, "kubectl")
+	}
 	debugSession("SetEnvironment GT_PROCESS_NAMES", m.tmux.SetEnvironment(sessionID, "GT_PROCESS_NAMES", strings.Join(processNames, ",")))
 	// Hook the issue to the polecat if provided via --issue flag
 	if opts.Issue != "" {
@@ -476,6 +536,8 @@ func (m *SessionManager) Stop(polecat string, force bool) error {
 		return fmt.Errorf("checking session: %w", err)
 	}
 	if !running {
+		// The container may outlive a pane that died on its own.
+		m.stopContainer(sessionID)
 		return ErrSessionNotFound
 	}
 
@@ -484,6 +546,7 @@ func (m *SessionManager) Stop(polecat string, force bool) error {
 		_ = m.tmux.SendKeysRaw(sessionID, "C-c")
 		session.WaitForSessionExit(m.tmux, sessionID, constants.GracefulShutdownTimeout)
 	}
+	m.stopContainer(sessionID)
 
 	// Use KillSessionWithProcesses to ensure all descendant processes are killed.
 	// This prevents orphan bash processes from Claude's Bash tool surviving session termination.
@@ -494,6 +557,18 @@ func (m *SessionManager) Stop(polecat string, force bool) error {
 	return nil
 }
 
+// stopContainer removes the sandbox container or deletes the Kubernetes Job
+// behind a session, either of which keeps running the agent after its pane
+// is killed. Failures are warnings: the session is stopped either way.
+func (m *SessionManager) stopContainer(sessionID string) {
+	if err := sandbox.StopContainerRun(m.rig.Path, sessionID); err != nil {
+		style.PrintWarning("could not remove sandbox container for %s: %v", sessionID, err)
+	}
+	if err := sandbox.StopJobRun(m.rig.Path, sessionID); err != nil {
+		style.PrintWarning("could not delete Kubernetes job for %s: %v", sessionID, err)
+	}
+}
+
 // IsRunning checks if a polecat session is active and healthy.
 // Checks both tmux session existence AND agent process liveness to avoid
 // reporting zombie sessions (tmux alive but Claude dead) as "running".
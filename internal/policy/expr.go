@@ -0,0 +1,545 @@
+package policy
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Conditions are small boolean expressions over a decision's input:
+//
+//	rig == "beads" && role != "mayor"
+//	patrol in ["compactor_dog", "wisp_reaper"] && (hour < 6 || weekday == "sat")
+//	glob(check, "patrol-*") && !unattended
+//
+// Identifiers name input fields (dots reach into nested maps); a missing
+// field is null. Literals are strings ("..." or '...'), numbers, true,
+// false, null, and lists. Operators: ! && || == != < <= > >= in. Functions:
+// glob(s, pattern), startsWith(s, prefix), endsWith(s, suffix),
+// contains(s, sub), size(x).
+
+// expr is a compiled condition.
+type expr interface {
+	eval(in Input) (any, error)
+}
+
+// compile parses a condition. An empty condition always holds.
+func compile(src string) (expr, error) {
+	if strings.TrimSpace(src) == "" {
+		return literal{true}, nil
+	}
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at offset %d", t.text, t.pos)
+	}
+	return e, nil
+}
+
+// evalBool evaluates e and requires a boolean result.
+func evalBool(e expr, in Input) (bool, error) {
+	v, err := e.eval(in)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("condition is %s, not a boolean", typeName(v))
+	}
+	return b, nil
+}
+
+// Lexer
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokOp
+)
+
+type token struct {
+	kind tokKind
+	text string
+	pos  int
+}
+
+func lex(src string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(src); {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"' || c == '\'':
+			j := i + 1
+			var b strings.Builder
+			for ; j < len(src) && rune(src[j]) != c; j++ {
+				if src[j] == '\\' && j+1 < len(src) {
+					j++
+				}
+				b.WriteByte(src[j])
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			toks = append(toks, token{tokString, b.String(), i})
+			i = j + 1
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(src) && (unicode.IsDigit(rune(src[j])) || src[j] == '.') {
+				j++
+			}
+			toks = append(toks, token{tokNumber, src[i:j], i})
+			i = j
+		case unicode.IsLetter(c) || c == '_':
+			j := i
+			for j < len(src) && (unicode.IsLetter(rune(src[j])) || unicode.IsDigit(rune(src[j])) || src[j] == '_' || src[j] == '.') {
+				j++
+			}
+			toks = append(toks, token{tokIdent, src[i:j], i})
+			i = j
+		default:
+			op := ""
+			for _, candidate := range []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "[", "]", ","} {
+				if strings.HasPrefix(src[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q at offset %d", c, i)
+			}
+			toks = append(toks, token{tokOp, op, i})
+			i += len(op)
+		}
+	}
+	return append(toks, token{tokEOF, "end of condition", len(src)}), nil
+}
+
+// Parser (precedence, low to high: ||, &&, comparison/in, unary !)
+
+// comparisons are the binary operators that bind tighter than && and ||.
+var comparisons = map[string]bool{"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true, "in": true}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(kind tokKind, text string) bool {
+	if t := p.peek(); t.kind == kind && t.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(tokOp, text) {
+		t := p.peek()
+		return fmt.Errorf("expected %q, got %q at offset %d", text, t.text, t.pos)
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept(tokOp, "||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logical{or: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseCompare()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept(tokOp, "&&") {
+		right, err := p.parseCompare()
+		if err != nil {
+			return nil, err
+		}
+		left = logical{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseCompare() (expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	if !comparisons[t.text] || t.kind == tokString {
+		return left, nil
+	}
+	p.next()
+	right, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return compare{op: t.text, left: left, right: right}, nil
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if p.accept(tokOp, "!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return not{operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokString:
+		return literal{t.text}, nil
+	case tokNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at offset %d", t.text, t.pos)
+		}
+		return literal{n}, nil
+	case tokIdent:
+		switch t.text {
+		case "true":
+			return literal{true}, nil
+		case "false":
+			return literal{false}, nil
+		case "null":
+			return literal{nil}, nil
+		}
+		if p.accept(tokOp, "(") {
+			fn, ok := functions[t.text]
+			if !ok {
+				return nil, fmt.Errorf("unknown function %q at offset %d", t.text, t.pos)
+			}
+			args, err := p.parseList(")")
+			if err != nil {
+				return nil, err
+			}
+			if len(args) != fn.arity {
+				return nil, fmt.Errorf("%s takes %d argument(s), got %d", t.text, fn.arity, len(args))
+			}
+			return call{name: t.text, fn: fn.impl, args: args}, nil
+		}
+		return field(strings.Split(t.text, ".")), nil
+	case tokOp:
+		switch t.text {
+		case "(":
+			e, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return e, p.expect(")")
+		case "[":
+			items, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return list(items), nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q at offset %d", t.text, t.pos)
+}
+
+// parseList parses comma-separated expressions up to the closing token.
+func (p *parser) parseList(closing string) ([]expr, error) {
+	var items []expr
+	if p.accept(tokOp, closing) {
+		return items, nil
+	}
+	for {
+		item, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if p.accept(tokOp, closing) {
+			return items, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Nodes
+
+type literal struct{ v any }
+
+func (l literal) eval(Input) (any, error) { return l.v, nil }
+
+type field []string
+
+func (f field) eval(in Input) (any, error) {
+	var cur any = map[string]any(in)
+	for _, part := range f {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, nil
+		}
+		cur = normalize(m[part])
+	}
+	return cur, nil
+}
+
+type list []expr
+
+func (l list) eval(in Input) (any, error) {
+	out := make([]any, 0, len(l))
+	for _, e := range l {
+		v, err := e.eval(in)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+type not struct{ operand expr }
+
+func (n not) eval(in Input) (any, error) {
+	b, err := evalBool(n.operand, in)
+	return !b, err
+}
+
+type logical struct {
+	or          bool
+	left, right expr
+}
+
+func (l logical) eval(in Input) (any, error) {
+	left, err := evalBool(l.left, in)
+	if err != nil {
+		return nil, err
+	}
+	if left == l.or {
+		return left, nil // short-circuit
+	}
+	return evalBool(l.right, in)
+}
+
+type compare struct {
+	op          string
+	left, right expr
+}
+
+func (c compare) eval(in Input) (any, error) {
+	left, err := c.left.eval(in)
+	if err != nil {
+		return nil, err
+	}
+	right, err := c.right.eval(in)
+	if err != nil {
+		return nil, err
+	}
+	switch c.op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "in":
+		items, ok := right.([]any)
+		if !ok {
+			return nil, fmt.Errorf("right side of in is %s, not a list", typeName(right))
+		}
+		for _, item := range items {
+			if equal(left, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	var cmp int
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			return nil, fmt.Errorf("cannot compare number with %s", typeName(right))
+		}
+		cmp = compareOrdered(l, r)
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot compare string with %s", typeName(right))
+		}
+		cmp = strings.Compare(l, r)
+	default:
+		return nil, fmt.Errorf("cannot order %s", typeName(left))
+	}
+	switch c.op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	default: // ">="
+		return cmp >= 0, nil
+	}
+}
+
+type call struct {
+	name string
+	fn   func(args []any) (any, error)
+	args []expr
+}
+
+func (c call) eval(in Input) (any, error) {
+	args := make([]any, 0, len(c.args))
+	for _, a := range c.args {
+		v, err := a.eval(in)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+	}
+	v, err := c.fn(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", c.name, err)
+	}
+	return v, nil
+}
+
+// Functions
+
+var functions = map[string]struct {
+	arity int
+	impl  func(args []any) (any, error)
+}{
+	"glob": {2, stringFunc(func(s, pattern string) (any, error) {
+		ok, err := path.Match(pattern, s)
+		if err != nil {
+			return nil, fmt.Errorf("bad pattern %q", pattern)
+		}
+		return ok, nil
+	})},
+	"startsWith": {2, stringFunc(func(s, prefix string) (any, error) { return strings.HasPrefix(s, prefix), nil })},
+	"endsWith":   {2, stringFunc(func(s, suffix string) (any, error) { return strings.HasSuffix(s, suffix), nil })},
+	"contains":   {2, stringFunc(func(s, sub string) (any, error) { return strings.Contains(s, sub), nil })},
+	"size": {1, func(args []any) (any, error) {
+		switch v := args[0].(type) {
+		case string:
+			return float64(len(v)), nil
+		case []any:
+			return float64(len(v)), nil
+		case map[string]any:
+			return float64(len(v)), nil
+		case nil:
+			return float64(0), nil
+		}
+		return nil, fmt.Errorf("no size for %s", typeName(args[0]))
+	}},
+}
+
+// stringFunc adapts a two-string function. A null first argument (a
+// missing field) is treated as "".
+func stringFunc(fn func(a, b string) (any, error)) func(args []any) (any, error) {
+	return func(args []any) (any, error) {
+		if args[0] == nil {
+			args[0] = ""
+		}
+		a, ok1 := args[0].(string)
+		b, ok2 := args[1].(string)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("want strings, got %s and %s", typeName(args[0]), typeName(args[1]))
+		}
+		return fn(a, b)
+	}
+}
+
+// Values
+
+// normalize maps input values onto the types conditions work with: string,
+// float64, bool, nil, []any, and map[string]any.
+func normalize(v any) any {
+	switch x := v.(type) {
+	case int:
+		return float64(x)
+	case int64:
+		return float64(x)
+	case Input:
+		return map[string]any(x)
+	case []string:
+		out := make([]any, len(x))
+		for i, s := range x {
+			out[i] = s
+		}
+		return out
+	}
+	return v
+}
+
+func equal(a, b any) bool {
+	switch x := a.(type) {
+	case []any, map[string]any:
+		return false
+	default:
+		return x == b
+	}
+}
+
+func compareOrdered(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "map"
+	}
+	return fmt.Sprintf("%T", v)
+}
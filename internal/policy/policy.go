@@ -0,0 +1,250 @@
+// Package policy evaluates the town's governance rules at decision points:
+// may this agent sling to that rig, may this patrol run now, may this doctor
+// fix be applied.
+//
+// Rules live in settings/config.json under "policy". Each names a decision
+// point, a condition over the decision's input (such as
+// `rig == "beads" && role == "polecat"`), and an effect.
+// Rules are checked in order and the first whose condition holds decides;
+// when none matches the action is allowed. Decisions made by a rule are
+// appended to the decision log (gt policy log), and example decisions in
+// the config are checked by gt policy test.
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/decision"
+)
+
+// Decision points.
+const (
+	PointSling  = "sling"  // Dispatching a bead to a rig
+	PointPatrol = "patrol" // A daemon patrol tick
+	PointFix    = "fix"    // Applying a doctor fix
+)
+
+// Points lists the decision points with the input fields each provides.
+// Every point also gets actor and role (who is acting, when known), hour
+// (0-23), and weekday ("mon".."sun").
+var Points = map[string][]string{
+	PointSling:  {"bead", "rig", "formula", "force"},
+	PointPatrol: {"patrol"},
+	PointFix:    {"check", "category", "status", "safety", "unattended"},
+}
+
+// Rule effects.
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+)
+
+// Input is a decision's input fields, referenced by rule conditions.
+type Input map[string]any
+
+// Rule is a compiled policy rule.
+type Rule struct {
+	config.PolicyRule
+	cond expr
+}
+
+// Engine decides actions by the town's rules.
+type Engine struct {
+	rules []*Rule
+	tests []config.PolicyTest
+}
+
+// Decision is the outcome of evaluating the rules for one action.
+type Decision struct {
+	Point   string `json:"point"`
+	Allowed bool   `json:"allowed"`
+
+	// Rule is the rule that decided; empty when none matched.
+	Rule   string `json:"rule,omitempty"`
+	Reason string `json:"reason,omitempty"`
+
+	// Errors are conditions that failed to evaluate; those rules are
+	// treated as not matching.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Denied returns an error describing a denial, or nil if allowed.
+func (d Decision) Denied() error {
+	if d.Allowed {
+		return nil
+	}
+	msg := fmt.Sprintf("denied by policy %q", d.Rule)
+	if d.Reason != "" {
+		msg += ": " + d.Reason
+	}
+	return errors.New(msg)
+}
+
+// New compiles the rules in cfg. nil means no rules: everything is allowed.
+func New(cfg *config.PolicyConfig) (*Engine, error) {
+	e := &Engine{}
+	if cfg == nil {
+		return e, nil
+	}
+	names := map[string]bool{}
+	for i, r := range cfg.Rules {
+		if r.Name == "" {
+			return nil, fmt.Errorf("policy rule %d: missing name", i+1)
+		}
+		if names[r.Name] {
+			return nil, fmt.Errorf("policy rule %q: duplicate name", r.Name)
+		}
+		names[r.Name] = true
+		if _, ok := Points[r.On]; !ok {
+			return nil, fmt.Errorf("policy rule %q: unknown decision point %q (want %s)", r.Name, r.On, pointList())
+		}
+		if r.Effect != EffectAllow && r.Effect != EffectDeny {
+			return nil, fmt.Errorf("policy rule %q: effect must be %q or %q, got %q", r.Name, EffectAllow, EffectDeny, r.Effect)
+		}
+		cond, err := compile(r.When)
+		if err != nil {
+			return nil, fmt.Errorf("policy rule %q: %w", r.Name, err)
+		}
+		e.rules = append(e.rules, &Rule{PolicyRule: r, cond: cond})
+	}
+	e.tests = cfg.Tests
+	return e, nil
+}
+
+// Load reads the town's rules from settings/config.json.
+func Load(townRoot string) (*Engine, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return New(settings.Policy)
+}
+
+// Rules returns the compiled rules in evaluation order.
+func (e *Engine) Rules() []*Rule { return e.rules }
+
+// Tests returns the configured example decisions.
+func (e *Engine) Tests() []config.PolicyTest { return e.tests }
+
+// Decide evaluates the rules for point against in.
+func (e *Engine) Decide(point string, in Input) Decision {
+	d := Decision{Point: point, Allowed: true}
+	for _, r := range e.rules {
+		if r.On != point {
+			continue
+		}
+		ok, err := evalBool(r.cond, in)
+		if err != nil {
+			d.Errors = append(d.Errors, fmt.Sprintf("%s: %v", r.Name, err))
+			continue
+		}
+		if ok {
+			d.Allowed = r.Effect == EffectAllow
+			d.Rule = r.Name
+			d.Reason = r.Reason
+			return d
+		}
+	}
+	return d
+}
+
+// Evaluate decides an action at point in the town at townRoot, adding the
+// common input fields, and records the decision in the decision log when a
+// rule made it. subject names the action in the log (e.g. "gt-abc -> beads").
+// If the rules can't be loaded the action is allowed and the error returned
+// in Errors, so a broken config does not stop the town.
+func Evaluate(townRoot, point, subject string, in Input) Decision {
+	e, err := Load(townRoot)
+	if err != nil {
+		return Decision{Point: point, Allowed: true, Errors: []string{err.Error()}}
+	}
+	d := e.Decide(point, WithDefaults(in, time.Now()))
+	if d.Rule != "" {
+		_ = Record(townRoot, subject, d)
+	}
+	return d
+}
+
+// WithDefaults fills the common input fields (hour, weekday) for now,
+// keeping any already set.
+func WithDefaults(in Input, now time.Time) Input {
+	out := Input{
+		"hour":    now.Hour(),
+		"weekday": strings.ToLower(now.Weekday().String()[:3]),
+	}
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+// Record appends a decision to the town's decision log.
+func Record(townRoot, subject string, d Decision) error {
+	outcome := decision.OutcomeAllowed
+	if !d.Allowed {
+		outcome = decision.OutcomeDenied
+	}
+	detail := subject
+	if d.Reason != "" {
+		detail += ": " + d.Reason
+	}
+	return decision.Record(townRoot, decision.Decision{
+		Kind:    decision.KindPolicy,
+		Subject: d.Point,
+		Outcome: outcome,
+		Reason:  d.Rule,
+		Detail:  detail,
+	})
+}
+
+// TestResult is the outcome of one configured example decision.
+type TestResult struct {
+	Test     config.PolicyTest
+	Decision Decision
+	Failure  string // Empty when the test passed
+}
+
+// RunTests checks every configured example decision.
+func (e *Engine) RunTests() []TestResult {
+	results := make([]TestResult, 0, len(e.tests))
+	for _, t := range e.tests {
+		d := e.Decide(t.On, Input(t.Input))
+		res := TestResult{Test: t, Decision: d}
+		got := EffectAllow
+		if !d.Allowed {
+			got = EffectDeny
+		}
+		switch {
+		case t.Expect != EffectAllow && t.Expect != EffectDeny:
+			res.Failure = fmt.Sprintf("expect must be %q or %q, got %q", EffectAllow, EffectDeny, t.Expect)
+		case got != t.Expect:
+			res.Failure = fmt.Sprintf("expected %s, got %s", t.Expect, describe(d))
+		case t.Rule != "" && d.Rule != t.Rule:
+			res.Failure = fmt.Sprintf("expected rule %q to decide, got %s", t.Rule, describe(d))
+		case len(d.Errors) > 0:
+			res.Failure = "condition errors: " + strings.Join(d.Errors, "; ")
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+// describe summarizes a decision as "deny (rule x)" or "allow (no rule)".
+func describe(d Decision) string {
+	effect := EffectAllow
+	if !d.Allowed {
+		effect = EffectDeny
+	}
+	if d.Rule == "" {
+		return effect + " (no rule matched)"
+	}
+	return fmt.Sprintf("%s (rule %q)", effect, d.Rule)
+}
+
+func pointList() string {
+	return strings.Join([]string{PointSling, PointPatrol, PointFix}, ", ")
+}
@@ -0,0 +1,165 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/decision"
+)
+
+func TestConditions(t *testing.T) {
+	in := Input{
+		"rig":     "beads",
+		"role":    "polecat",
+		"hour":    3,
+		"force":   false,
+		"tags":    []string{"urgent"},
+		"agent":   map[string]any{"name": "nux"},
+		"patrols": []any{"a", "b"},
+	}
+	tests := []struct {
+		cond string
+		want bool
+	}{
+		{``, true},
+		{`rig == "beads" && role != "mayor"`, true},
+		{`rig == 'gastown' || hour < 6`, true},
+		{`!(hour >= 6 && hour < 22)`, true},
+		{`role in ["crew", "mayor"]`, false},
+		{`"urgent" in tags`, true},
+		{`agent.name == "nux" && agent.missing == null`, true},
+		{`glob(rig, "be*") && startsWith(role, "pole") && !force`, true},
+		{`size(patrols) == 2 && contains(rig, "ead") && endsWith(rig, "s")`, true},
+		{`missing == "x"`, false},
+		{`glob(missing, "*")`, true},
+	}
+	for _, tt := range tests {
+		e, err := compile(tt.cond)
+		if err != nil {
+			t.Errorf("compile(%q): %v", tt.cond, err)
+			continue
+		}
+		got, err := evalBool(e, in)
+		if err != nil {
+			t.Errorf("eval(%q): %v", tt.cond, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("eval(%q) = %v, want %v", tt.cond, got, tt.want)
+		}
+	}
+}
+
+func TestConditions_Errors(t *testing.T) {
+	for _, src := range []string{`rig ==`, `rig = "x"`, `"open`, `nope(rig)`, `glob(rig)`, `(rig == "x"`, `rig == "x" "y"`} {
+		if _, err := compile(src); err == nil {
+			t.Errorf("compile(%q) should fail", src)
+		}
+	}
+	for _, src := range []string{`rig`, `hour < "6"`, `rig in "beads"`} {
+		e, err := compile(src)
+		if err != nil {
+			t.Fatalf("compile(%q): %v", src, err)
+		}
+		if _, err := evalBool(e, Input{"rig": "beads", "hour": 3}); err == nil {
+			t.Errorf("eval(%q) should fail", src)
+		}
+	}
+}
+
+func TestEngine_DecideAndTests(t *testing.T) {
+	cfg := &config.PolicyConfig{
+		Rules: []config.PolicyRule{
+			{Name: "mayor-anywhere", On: PointSling, When: `role == "mayor"`, Effect: EffectAllow},
+			{Name: "broken", On: PointSling, When: `hour < "x"`, Effect: EffectDeny},
+			{Name: "beads-crew-only", On: PointSling, When: `rig == "beads"`, Effect: EffectDeny, Reason: "crew only"},
+			{Name: "no-compaction-daytime", On: PointPatrol, When: `patrol == "compactor_dog" && hour >= 7`, Effect: EffectDeny},
+		},
+		Tests: []config.PolicyTest{
+			{Name: "mayor", On: PointSling, Input: map[string]any{"rig": "beads", "role": "mayor"}, Expect: EffectAllow, Rule: "mayor-anywhere"},
+			{Name: "night", On: PointPatrol, Input: map[string]any{"patrol": "compactor_dog", "hour": 2.0}, Expect: EffectAllow},
+			{Name: "wrong", On: PointPatrol, Input: map[string]any{"patrol": "compactor_dog", "hour": 9.0}, Expect: EffectAllow},
+		},
+	}
+	e, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := e.Decide(PointSling, Input{"rig": "beads", "role": "polecat", "hour": 3})
+	if d.Allowed || d.Rule != "beads-crew-only" || len(d.Errors) != 1 {
+		t.Errorf("decision = %+v, want denied by beads-crew-only with one error", d)
+	}
+	if err := d.Denied(); err == nil || !strings.Contains(err.Error(), "crew only") {
+		t.Errorf("Denied() = %v", err)
+	}
+	if d := e.Decide(PointSling, Input{"rig": "gastown", "hour": 3}); !d.Allowed || d.Rule != "" {
+		t.Errorf("unmatched decision = %+v, want default allow", d)
+	}
+
+	results := e.RunTests()
+	if results[0].Failure != "" || results[1].Failure != "" {
+		t.Errorf("passing tests failed: %+v", results[:2])
+	}
+	if !strings.Contains(results[2].Failure, "no-compaction-daytime") {
+		t.Errorf("failing test = %+v", results[2])
+	}
+}
+
+func TestNew_RejectsInvalidRules(t *testing.T) {
+	for _, r := range []config.PolicyRule{
+		{On: PointSling, Effect: EffectDeny},
+		{Name: "x", On: "deploy", Effect: EffectDeny},
+		{Name: "x", On: PointSling, Effect: "maybe"},
+		{Name: "x", On: PointSling, Effect: EffectDeny, When: "rig =="},
+	} {
+		if _, err := New(&config.PolicyConfig{Rules: []config.PolicyRule{r}}); err == nil {
+			t.Errorf("New(%+v) should fail", r)
+		}
+	}
+}
+
+func TestEvaluate_LogsRuleDecisions(t *testing.T) {
+	townRoot := t.TempDir()
+	settings := config.NewTownSettings()
+	settings.Policy = &config.PolicyConfig{Rules: []config.PolicyRule{
+		{Name: "no-beads", On: PointSling, When: `rig == "beads"`, Effect: EffectDeny},
+	}}
+	if err := config.SaveTownSettings(config.TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatal(err)
+	}
+
+	if d := Evaluate(townRoot, PointSling, "gt-abc -> beads", Input{"rig": "beads"}); d.Allowed {
+		t.Error("sling to beads should be denied")
+	}
+	if d := Evaluate(townRoot, PointSling, "gt-def -> gastown", Input{"rig": "gastown"}); !d.Allowed {
+		t.Error("sling to gastown should be allowed")
+	}
+	logged, err := decision.Query(townRoot, decision.KindPolicy, PointSling, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logged) != 1 || logged[0].Outcome != decision.OutcomeDenied || logged[0].Reason != "no-beads" {
+		t.Errorf("logged = %+v, want one denial by no-beads", logged)
+	}
+
+	// A broken config allows the action and reports why.
+	if err := os.WriteFile(filepath.Join(townRoot, "settings", "config.json"), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if d := Evaluate(townRoot, PointSling, "gt-abc -> beads", Input{"rig": "beads"}); !d.Allowed || len(d.Errors) == 0 {
+		t.Errorf("broken config decision = %+v", d)
+	}
+}
+
+func TestWithDefaults(t *testing.T) {
+	now := time.Date(2026, 3, 7, 14, 30, 0, 0, time.Local) // A Saturday
+	in := WithDefaults(Input{"hour": 2}, now)
+	if in["hour"] != 2 || in["weekday"] != "sat" {
+		t.Errorf("WithDefaults = %v", in)
+	}
+}
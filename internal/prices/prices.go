@@ -0,0 +1,281 @@
+// Package prices holds per-model token prices for cost accounting.
+//
+// Prices come in three layers, highest first: town overrides in
+// settings/prices.json, the published price table fetched by gt prices
+// update (cached in .runtime/prices/published.json), and the built-in
+// table compiled from prices.json. Each model keeps a history of rates by
+// effective date, so usage is costed at the rates in effect when it
+// happened.
+package prices
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+//go:embed prices.json
+var builtinJSON []byte
+
+// DefaultModel is the table key used for models no other key matches.
+const DefaultModel = "default"
+
+// Layer names, as reported by Set.Rate.
+const (
+	LayerTown      = "town"
+	LayerPublished = "published"
+	LayerBuiltin   = "builtin"
+)
+
+const dateLayout = "2006-01-02"
+
+// Usage is a token count to be priced.
+type Usage struct {
+	Input      int
+	Output     int
+	CacheRead  int
+	CacheWrite int
+}
+
+// Rate is a model's price per million tokens from an effective date.
+type Rate struct {
+	From       string  `json:"from,omitempty"` // YYYY-MM-DD; empty means since forever
+	Input      float64 `json:"input"`
+	Output     float64 `json:"output"`
+	CacheRead  float64 `json:"cache_read"`
+	CacheWrite float64 `json:"cache_write"`
+}
+
+// Cost prices usage at this rate, in USD.
+func (r Rate) Cost(u Usage) float64 {
+	return (float64(u.Input)*r.Input +
+		float64(u.Output)*r.Output +
+		float64(u.CacheRead)*r.CacheRead +
+		float64(u.CacheWrite)*r.CacheWrite) / 1_000_000
+}
+
+// sameValues reports whether two rates charge the same, ignoring dates.
+func (r Rate) sameValues(o Rate) bool {
+	return r.Input == o.Input && r.Output == o.Output && r.CacheRead == o.CacheRead && r.CacheWrite == o.CacheWrite
+}
+
+// Table is a price table: the built-in and published files, and the town's
+// overrides. Model keys are exact model IDs, prefixes ending in "*"
+// ("claude-opus-*"), or DefaultModel.
+type Table struct {
+	Version string            `json:"version,omitempty"`
+	Models  map[string][]Rate `json:"models"`
+
+	// URL and AutoUpdate are only read from the town overrides: where gt
+	// prices update fetches from, and whether the daemon does so daily.
+	// There is no default URL; without one only the built-in table and
+	// town overrides apply.
+	URL        string `json:"url,omitempty"`
+	AutoUpdate bool   `json:"auto_update,omitempty"`
+}
+
+// Parse decodes and validates a price table, sorting each model's rates
+// by effective date.
+func Parse(data []byte) (*Table, error) {
+	var t Table
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("parsing price table: %w", err)
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Validate checks rates are non-negative with valid, distinct dates, and
+// sorts them by date.
+func (t *Table) Validate() error {
+	var errs []error
+	for model, rates := range t.Models {
+		seen := map[string]bool{}
+		for _, r := range rates {
+			if r.From != "" {
+				if _, err := time.Parse(dateLayout, r.From); err != nil {
+					errs = append(errs, fmt.Errorf("%s: from %q is not YYYY-MM-DD", model, r.From))
+				}
+			}
+			if seen[r.From] {
+				errs = append(errs, fmt.Errorf("%s: two rates from %q", model, r.From))
+			}
+			seen[r.From] = true
+			if r.Input < 0 || r.Output < 0 || r.CacheRead < 0 || r.CacheWrite < 0 {
+				errs = append(errs, fmt.Errorf("%s: negative price", model))
+			}
+		}
+		sortRates(rates)
+	}
+	return errors.Join(errs...)
+}
+
+// History returns a model's rates, oldest first: from the exact key, else
+// the longest matching prefix key. It does not fall back to DefaultModel.
+func (t *Table) History(model string) []Rate {
+	if rates, ok := t.Models[model]; ok {
+		return rates
+	}
+	best := ""
+	var rates []Rate
+	for key := range t.Models {
+		prefix, wild := strings.CutSuffix(key, "*")
+		if wild && strings.HasPrefix(model, prefix) && len(prefix) >= len(best) {
+			best, rates = prefix, t.Models[key]
+		}
+	}
+	return rates
+}
+
+// Rate returns the rate for model at a time, from its History.
+func (t *Table) Rate(model string, at time.Time) (Rate, bool) {
+	return rateAt(t.History(model), at)
+}
+
+// rateAt picks the latest rate in effect at a time. Usage older than every
+// dated rate gets the oldest one.
+func rateAt(rates []Rate, at time.Time) (Rate, bool) {
+	if len(rates) == 0 {
+		return Rate{}, false
+	}
+	day := at.Format(dateLayout)
+	best := rates[0]
+	for _, r := range rates {
+		if r.From <= day {
+			best = r
+		}
+	}
+	return best, true
+}
+
+func sortRates(rates []Rate) {
+	sort.SliceStable(rates, func(i, j int) bool { return rates[i].From < rates[j].From })
+}
+
+// Builtin returns the built-in price table.
+func Builtin() *Table {
+	t, err := Parse(builtinJSON)
+	if err != nil {
+		panic(fmt.Sprintf("built-in price table: %v", err)) // Caught by tests
+	}
+	return t
+}
+
+// TownPath returns the town's price overrides file.
+func TownPath(townRoot string) string {
+	return filepath.Join(townRoot, "settings", "prices.json")
+}
+
+// PublishedPath returns the cached published price table.
+func PublishedPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "prices", "published.json")
+}
+
+// LoadTable reads a price table file.
+func LoadTable(path string) (*Table, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil, err
+	}
+	t, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return t, nil
+}
+
+// Set is the layered price tables of a town.
+type Set struct {
+	Layers []NamedTable
+}
+
+// NamedTable is one layer of a Set.
+type NamedTable struct {
+	Name string
+	*Table
+}
+
+// Load returns a town's prices. Missing override or published files are
+// skipped; invalid ones are errors.
+func Load(townRoot string) (*Set, error) {
+	s := &Set{}
+	for _, l := range []struct{ name, path string }{
+		{LayerTown, TownPath(townRoot)},
+		{LayerPublished, PublishedPath(townRoot)},
+	} {
+		t, err := LoadTable(l.path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		s.Layers = append(s.Layers, NamedTable{l.name, t})
+	}
+	s.Layers = append(s.Layers, NamedTable{LayerBuiltin, Builtin()})
+	return s, nil
+}
+
+// BuiltinSet returns prices from the built-in table only.
+func BuiltinSet() *Set {
+	return &Set{Layers: []NamedTable{{LayerBuiltin, Builtin()}}}
+}
+
+// Rate returns the rate for model at a time and the layer it came from.
+// The highest layer that prices the model wins; a model no layer knows
+// gets the highest DefaultModel rate.
+func (s *Set) Rate(model string, at time.Time) (Rate, string) {
+	for _, l := range s.Layers {
+		if r, ok := l.Rate(model, at); ok {
+			return r, l.Name
+		}
+	}
+	for _, l := range s.Layers {
+		if r, ok := rateAt(l.Models[DefaultModel], at); ok {
+			return r, l.Name
+		}
+	}
+	return Rate{}, ""
+}
+
+// History returns the rate history that prices model and its layer, with
+// the same precedence as Rate.
+func (s *Set) History(model string) ([]Rate, string) {
+	for _, l := range s.Layers {
+		if rates := l.History(model); len(rates) > 0 {
+			return rates, l.Name
+		}
+	}
+	for _, l := range s.Layers {
+		if rates := l.Models[DefaultModel]; len(rates) > 0 {
+			return rates, l.Name
+		}
+	}
+	return nil, ""
+}
+
+// Cost prices usage of model at a time, in USD.
+func (s *Set) Cost(model string, at time.Time, u Usage) float64 {
+	r, _ := s.Rate(model, at)
+	return r.Cost(u)
+}
+
+// Town returns the town overrides layer, or nil.
+func (s *Set) Town() *Table {
+	for _, l := range s.Layers {
+		if l.Name == LayerTown {
+			return l.Table
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package prices
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestBuiltin(t *testing.T) {
+	b := Builtin()
+	if _, ok := b.Models[DefaultModel]; !ok {
+		t.Fatal("built-in table has no default rate")
+	}
+	r, ok := b.Rate("claude-opus-4-5-20251101", time.Now())
+	if !ok || r.Input != 5 || r.Output != 25 {
+		t.Errorf("opus 4.5 rate = %+v, %v", r, ok)
+	}
+	if r, ok := b.Rate("claude-opus-4-1-20250805", time.Now()); !ok || r.Input != 15 || r.Output != 75 {
+		t.Errorf("opus 4.1 rate = %+v, %v", r, ok)
+	}
+	// Family prefixes price model IDs the table doesn't list.
+	if r, ok := b.Rate("claude-haiku-4-5-20251001", time.Now()); !ok || r.Input != 1 {
+		t.Errorf("haiku family rate = %+v, %v", r, ok)
+	}
+}
+
+func TestTableRateHistory(t *testing.T) {
+	table, err := Parse([]byte(`{"models": {
+		"claude-opus-*": [
+			{"from": "2026-01-01", "input": 5, "output": 25, "cache_read": 0.5, "cache_write": 6.25},
+			{"input": 15, "output": 75, "cache_read": 1.5, "cache_write": 18.75}
+		],
+		"claude-opus-4-1*": [{"input": 20, "output": 80, "cache_read": 2, "cache_write": 25}]
+	}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		model string
+		at    string
+		input float64
+	}{
+		{"claude-opus-4-5", "2025-12-31", 15},
+		{"claude-opus-4-5", "2026-01-01", 5},
+		{"claude-opus-4-1-20250805", "2026-03-01", 20}, // Longest prefix wins
+	}
+	for _, tt := range tests {
+		r, ok := table.Rate(tt.model, day(tt.at))
+		if !ok || r.Input != tt.input {
+			t.Errorf("Rate(%s, %s) = %+v, %v; want input %v", tt.model, tt.at, r, ok, tt.input)
+		}
+	}
+	if _, ok := table.Rate("gpt-5", time.Now()); ok {
+		t.Error("Rate() should not match an unknown model without a default")
+	}
+
+	cost := Rate{Input: 3, Output: 15, CacheRead: 0.3, CacheWrite: 3.75}.Cost(Usage{
+		Input: 1_000_000, Output: 100_000, CacheRead: 2_000_000, CacheWrite: 0,
+	})
+	if math.Abs(cost-5.1) > 1e-9 {
+		t.Errorf("Cost() = %v, want 5.1", cost)
+	}
+}
+
+func TestParseRejectsBadRates(t *testing.T) {
+	for name, input := range map[string]string{
+		"bad date":  `{"models": {"m": [{"from": "Jan 1", "input": 1}]}}`,
+		"duplicate": `{"models": {"m": [{"input": 1}, {"input": 2}]}}`,
+		"negative":  `{"models": {"m": [{"input": -1}]}}`,
+	} {
+		if _, err := Parse([]byte(input)); err == nil {
+			t.Errorf("%s: Parse() succeeded", name)
+		}
+	}
+}
+
+func TestLoadLayers(t *testing.T) {
+	town := t.TempDir()
+	writeFile(t, TownPath(town), `{"url": "https://example.com/p.json", "models": {"claude-sonnet-*": [{"input": 2, "output": 10}]}}`)
+	writeFile(t, PublishedPath(town), `{"models": {"claude-sonnet-*": [{"input": 9, "output": 9}], "gpt-5": [{"input": 1.25, "output": 10}], "default": [{"input": 7, "output": 7}]}}`)
+
+	set, err := Load(town)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	if r, layer := set.Rate("claude-sonnet-4-5", now); r.Input != 2 || layer != LayerTown {
+		t.Errorf("override rate = %+v from %s", r, layer)
+	}
+	if r, layer := set.Rate("gpt-5", now); r.Input != 1.25 || layer != LayerPublished {
+		t.Errorf("published rate = %+v from %s", r, layer)
+	}
+	if r, layer := set.Rate("claude-opus-4-5-20251101", now); r.Input != 5 || layer != LayerBuiltin {
+		t.Errorf("built-in rate = %+v from %s", r, layer)
+	}
+	if r, layer := set.Rate("mystery", now); r.Input != 7 || layer != LayerPublished {
+		t.Errorf("default rate = %+v from %s, want the highest default", r, layer)
+	}
+	if set.SourceURL() != "https://example.com/p.json" {
+		t.Errorf("SourceURL() = %q", set.SourceURL())
+	}
+	bare, err := Load(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bare.SourceURL(); got != "" {
+		t.Errorf("SourceURL() without town overrides = %q, want no default", got)
+	}
+	if _, _, err := Update(town, "", now); !errors.Is(err, ErrNoURL) {
+		t.Errorf("Update() without a URL = %v, want ErrNoURL", err)
+	}
+
+	writeFile(t, TownPath(town), `{"models": {"m": [{"input": -1}]}}`)
+	if _, err := Load(town); err == nil || !strings.Contains(err.Error(), "prices.json") {
+		t.Errorf("Load() with invalid overrides = %v", err)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	cached := &Table{Models: map[string][]Rate{
+		"a": {{Input: 1}},
+		"b": {{From: "2026-01-01", Input: 2}},
+		"c": {{Input: 3}},
+	}}
+	fetched := &Table{Version: "v2", Models: map[string][]Rate{
+		"a": {{Input: 1.5}},                     // Undated change: takes effect today
+		"b": {{From: "2026-01-01", Input: 2.5}}, // Dated change: correction
+		"d": {{Input: 4}},                       // New model
+	}}
+	now := day("2026-05-10")
+	merged, changes := Merge(cached, fetched, now)
+	if merged.Version != "v2" || len(changes) != 3 {
+		t.Fatalf("Merge() = %+v, changes %+v", merged, changes)
+	}
+	if a := merged.Models["a"]; len(a) != 2 || a[0].Input != 1 || a[1].From != "2026-05-10" || a[1].Input != 1.5 {
+		t.Errorf("a = %+v, want the old rate kept and the new one from today", a)
+	}
+	if b := merged.Models["b"]; len(b) != 1 || b[0].Input != 2.5 {
+		t.Errorf("b = %+v, want the correction", b)
+	}
+	if len(merged.Models["c"]) != 1 {
+		t.Error("c dropped from the published table should keep its history")
+	}
+	if r, _ := merged.Rate("a", day("2026-05-09")); r.Input != 1 {
+		t.Errorf("usage before the update costs %v, want the old rate", r.Input)
+	}
+
+	// Fetching the same table again changes nothing.
+	again, changes := Merge(merged, fetched, now.AddDate(0, 0, 1))
+	if len(changes) != 0 || len(again.Models["a"]) != 2 {
+		t.Errorf("second Merge() changes = %+v, a = %+v", changes, again.Models["a"])
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"version": "2026-05", "models": {"gpt-5": [{"input": 1.25, "output": 10}]}}`))
+	}))
+	defer srv.Close()
+	town := t.TempDir()
+
+	table, changes, err := Update(town, srv.URL+"/prices.json", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Version != "2026-05" || len(changes) != 1 || changes[0].Model != "gpt-5" {
+		t.Errorf("Update() = %+v, %+v", table, changes)
+	}
+	if _, err := LoadTable(PublishedPath(town)); err != nil {
+		t.Errorf("cached table: %v", err)
+	}
+	if _, _, err := Update(town, srv.URL+"/missing", time.Now()); err == nil {
+		t.Error("Update() from a missing URL should fail")
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
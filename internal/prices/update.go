@@ -0,0 +1,153 @@
+package prices
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// maxTableSize bounds a fetched price table.
+const maxTableSize = 1 << 20
+
+// ErrNoURL is returned by Update when no price table URL is configured.
+var ErrNoURL = errors.New(`no price table URL: set "url" in settings/prices.json or pass --url`)
+
+// Change is a rate added to the cached published table by an update.
+type Change struct {
+	Model string `json:"model"`
+	Rate  Rate   `json:"rate"`
+	Old   *Rate  `json:"old,omitempty"` // The rate it supersedes, if any
+}
+
+// Fetch downloads and validates a published price table.
+func Fetch(url string) (*Table, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(url) //nolint:gosec // G107: URL comes from town settings
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: HTTP %d", url, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxTableSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", url, err)
+	}
+	t, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", url, err)
+	}
+	if len(t.Models) == 0 {
+		return nil, fmt.Errorf("%s: price table has no models", url)
+	}
+	return t, nil
+}
+
+// Merge folds a newly fetched table into the cached one. Rates are kept by
+// model and effective date, so rates dropped from the published file still
+// cost old usage. A changed dated rate is a correction and replaces the
+// cached one; a changed undated rate takes effect from today, keeping the
+// old one for earlier usage.
+func Merge(cached, fetched *Table, now time.Time) (*Table, []Change) {
+	out := &Table{Version: fetched.Version, Models: map[string][]Rate{}}
+	if cached != nil {
+		for model, rates := range cached.Models {
+			out.Models[model] = append([]Rate(nil), rates...)
+		}
+	}
+
+	var changes []Change
+	today := now.Format(dateLayout)
+	for model, rates := range fetched.Models {
+		for _, r := range rates {
+			current := out.Models[model]
+			i := indexFrom(current, r.From)
+			switch {
+			case i < 0:
+				changes = append(changes, Change{Model: model, Rate: r, Old: latest(current)})
+				current = append(current, r)
+			case current[i].sameValues(r):
+				continue
+			case r.From == "" && latest(current).sameValues(r):
+				continue // Already took effect on an earlier update
+			case r.From == "":
+				r.From = today
+				old := *latest(current)
+				if j := indexFrom(current, today); j >= 0 {
+					current[j] = r
+				} else {
+					current = append(current, r)
+				}
+				changes = append(changes, Change{Model: model, Rate: r, Old: &old})
+			default:
+				old := current[i]
+				current[i] = r
+				changes = append(changes, Change{Model: model, Rate: r, Old: &old})
+			}
+			sortRates(current)
+			out.Models[model] = current
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Model != changes[j].Model {
+			return changes[i].Model < changes[j].Model
+		}
+		return changes[i].Rate.From < changes[j].Rate.From
+	})
+	return out, changes
+}
+
+func indexFrom(rates []Rate, from string) int {
+	for i, r := range rates {
+		if r.From == from {
+			return i
+		}
+	}
+	return -1
+}
+
+func latest(rates []Rate) *Rate {
+	if len(rates) == 0 {
+		return nil
+	}
+	r := rates[len(rates)-1]
+	return &r
+}
+
+// Update fetches the published table from url and merges it into the
+// town's cache, returning the rates that changed.
+func Update(townRoot, url string, now time.Time) (*Table, []Change, error) {
+	if url == "" {
+		return nil, nil, ErrNoURL
+	}
+	fetched, err := Fetch(url)
+	if err != nil {
+		return nil, nil, err
+	}
+	cached, err := LoadTable(PublishedPath(townRoot))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		// A corrupt cache is replaced; history in it is lost.
+		cached = nil
+	}
+	merged, changes := Merge(cached, fetched, now)
+	if err := util.EnsureDirAndWriteJSON(PublishedPath(townRoot), merged); err != nil {
+		return nil, nil, fmt.Errorf("saving price table: %w", err)
+	}
+	return merged, changes, nil
+}
+
+// SourceURL returns where to fetch the published table: the town's url
+// setting, or "" if it has none.
+func (s *Set) SourceURL() string {
+	if t := s.Town(); t != nil {
+		return t.URL
+	}
+	return ""
+}
@@ -0,0 +1,303 @@
+// Package primecache caches rendered prime packs so agents in the same rig
+// do not rebuild identical context on every gt prime.
+//
+// Entries are content-addressed: the file name is a digest of every input
+// that shapes the pack (section, role, rig, agent, template sources, git
+// HEAD, and stamps of the files the pack reads). Any input change yields a
+// new key, so stale packs are never served; they simply age out.
+//
+// Pack files are named <owner>-<digest>.pack, where the owner tag identifies
+// the rig, role, and agent, so one agent's entries can be cleared without
+// touching the rest of the town (see ClearAgent).
+//
+// Keys are built from cheap inputs only (embedded templates, HEAD read from
+// .git, file sizes and mtimes), so a hit costs a few stats and one read.
+package primecache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+const (
+	// DefaultMaxAge is how long an unused entry is kept.
+	DefaultMaxAge = 24 * time.Hour
+
+	// DefaultMaxEntries caps the number of cached packs.
+	DefaultMaxEntries = 64
+
+	// packExt is the file extension of cached packs.
+	packExt = ".pack"
+)
+
+// Prime sections cached as packs.
+const (
+	SectionRole  = "role"  // Role template, CONTEXT.md, and rig conventions
+	SectionBeads = "beads" // bd prime workflow context
+)
+
+// Key identifies a prime pack by its inputs.
+type Key struct {
+	Section      string
+	Role         string
+	Rig          string
+	Agent        string   // Agent name within the rig and role, for packs rendered per agent
+	WorkDir      string   // Agent's working directory, for packs rendered per agent
+	TemplateHash string   // Digest of the template sources (see HashSources)
+	Head         string   // git HEAD of the agent's worktree (see git.ReadHead)
+	Stamps       []string // Stamps of the files the pack reads (see Stamp), any order
+}
+
+// Digest returns the content address of the key.
+func (k Key) Digest() string {
+	stamps := append([]string(nil), k.Stamps...)
+	sort.Strings(stamps)
+	h := sha256.New()
+	for _, part := range []string{k.Section, k.Role, k.Rig, k.Agent, k.WorkDir, k.TemplateHash, k.Head, strings.Join(stamps, "\n")} {
+		// Length-prefix each field so adjacent fields cannot run together.
+		fmt.Fprintf(h, "%d:%s\n", len(part), part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// HashSources digests template sources. Missing sources (nil) still count,
+// so adding a file later changes the hash.
+func HashSources(sources ...[]byte) string {
+	h := sha256.New()
+	for _, s := range sources {
+		if s == nil {
+			h.Write([]byte{0})
+			continue
+		}
+		fmt.Fprintf(h, "%d:", len(s))
+		h.Write(s)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Stamp identifies a file's current version by its size and mtime, so a
+// key changes when the file does without reading it. A missing file has a
+// stamp too, so creating it later changes the key.
+func Stamp(path string) string {
+	info, err := os.Stat(path)
+	if err != nil {
+		return path + ":-"
+	}
+	return fmt.Sprintf("%s:%d:%d", path, info.Size(), info.ModTime().UnixNano())
+}
+
+// Cache is an on-disk prime pack cache.
+type Cache struct {
+	dir        string
+	maxAge     time.Duration
+	maxEntries int
+	now        func() time.Time
+}
+
+// Dir returns the cache directory for a town.
+func Dir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "prime-cache")
+}
+
+// New returns the town's prime cache.
+func New(townRoot string) *Cache {
+	return &Cache{dir: Dir(townRoot), maxAge: DefaultMaxAge, maxEntries: DefaultMaxEntries, now: time.Now}
+}
+
+// owner returns the file name tag for entries of one agent. Packs shared by
+// every agent of a role in a rig have an empty agent.
+func owner(rig, role, agent string) string {
+	sum := sha256.Sum256([]byte(rig + "\x00" + role + "\x00" + agent))
+	return hex.EncodeToString(sum[:6])
+}
+
+func (c *Cache) path(k Key) string {
+	return filepath.Join(c.dir, owner(k.Rig, k.Role, k.Agent)+"-"+k.Digest()+packExt)
+}
+
+// Get returns the cached pack for k. A hit refreshes the entry's age.
+func (c *Cache) Get(k Key) (string, bool) {
+	path := c.path(k)
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is a digest under the cache dir
+	if err != nil {
+		return "", false
+	}
+	now := c.now()
+	_ = os.Chtimes(path, now, now)
+	return string(data), true
+}
+
+// Put stores a pack for k and prunes old entries.
+func (c *Cache) Put(k Key, pack string) error {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return fmt.Errorf("creating prime cache: %w", err)
+	}
+	if err := util.AtomicWriteFile(c.path(k), []byte(pack), 0644); err != nil {
+		return fmt.Errorf("writing prime pack: %w", err)
+	}
+	_, err := c.Prune()
+	return err
+}
+
+// Prune removes entries unused for maxAge and, beyond maxEntries, the least
+// recently used. Returns the number removed.
+func (c *Cache) Prune() (int, error) {
+	entries, err := c.entries()
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].mod.After(entries[j].mod) })
+
+	removed := 0
+	cutoff := c.now().Add(-c.maxAge)
+	for i, e := range entries {
+		if i < c.maxEntries && e.mod.After(cutoff) {
+			continue
+		}
+		if err := os.Remove(e.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// ClearAgent removes an agent's cached packs, plus the packs it shares with
+// the other agents of its role in the rig, forcing its next prime to render
+// afresh. Other agents' packs are left alone. Returns the number removed.
+func (c *Cache) ClearAgent(rig, role, agent string) (int, error) {
+	entries, err := c.entries()
+	if err != nil {
+		return 0, err
+	}
+	own, shared := owner(rig, role, agent)+"-", owner(rig, role, "")+"-"
+	removed := 0
+	for _, e := range entries {
+		name := filepath.Base(e.path)
+		if !strings.HasPrefix(name, own) && !strings.HasPrefix(name, shared) {
+			continue
+		}
+		if err := os.Remove(e.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+type entry struct {
+	path string
+	mod  time.Time
+	size int64
+}
+
+func (c *Cache) entries() ([]entry, error) {
+	dirents, err := os.ReadDir(c.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var out []entry
+	for _, d := range dirents {
+		if d.IsDir() || filepath.Ext(d.Name()) != packExt {
+			continue
+		}
+		info, err := d.Info()
+		if err != nil {
+			continue
+		}
+		out = append(out, entry{path: filepath.Join(c.dir, d.Name()), mod: info.ModTime(), size: info.Size()})
+	}
+	return out, nil
+}
+
+// Health summarizes the cache's on-disk state for gt doctor.
+type Health struct {
+	Entries int   // Cached packs
+	Bytes   int64 // Total size of cached packs
+	Stale   int   // Packs the next Prune would remove
+	Corrupt int   // Empty packs and stray files (e.g., interrupted writes)
+}
+
+// Inspect reports the cache's health without modifying it.
+func (c *Cache) Inspect() (Health, error) {
+	var h Health
+	entries, err := c.entries()
+	if err != nil {
+		return h, err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].mod.After(entries[j].mod) })
+	cutoff := c.now().Add(-c.maxAge)
+	for i, e := range entries {
+		h.Entries++
+		h.Bytes += e.size
+		if e.size == 0 {
+			h.Corrupt++
+		} else if i >= c.maxEntries || !e.mod.After(cutoff) {
+			h.Stale++
+		}
+	}
+	strays, err := c.strays()
+	if err != nil {
+		return h, err
+	}
+	h.Corrupt += len(strays)
+	return h, nil
+}
+
+// Repair removes corrupt entries and stray files, then prunes stale packs.
+// Returns the number of files removed.
+func (c *Cache) Repair() (int, error) {
+	entries, err := c.entries()
+	if err != nil {
+		return 0, err
+	}
+	strays, err := c.strays()
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range entries {
+		if e.size == 0 {
+			strays = append(strays, e.path)
+		}
+	}
+	removed := 0
+	for _, path := range strays {
+		if err := os.RemoveAll(path); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	n, err := c.Prune()
+	return removed + n, err
+}
+
+// strays returns files in the cache dir that are not packs.
+func (c *Cache) strays() ([]string, error) {
+	dirents, err := os.ReadDir(c.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var out []string
+	for _, d := range dirents {
+		if d.IsDir() || filepath.Ext(d.Name()) != packExt {
+			out = append(out, filepath.Join(c.dir, d.Name()))
+		}
+	}
+	return out, nil
+}
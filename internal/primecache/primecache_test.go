@@ -0,0 +1,161 @@
+package primecache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestKeyDigest(t *testing.T) {
+	base := Key{Section: SectionBeads, Role: "polecat", Rig: "gastown", TemplateHash: HashSources([]byte("tmpl")), Head: "abc123", Stamps: []string{"b:1:2", "a:-"}}
+
+	reordered := base
+	reordered.Stamps = []string{"a:-", "b:1:2"}
+	if base.Digest() != reordered.Digest() {
+		t.Error("stamp order should not change the digest")
+	}
+
+	for name, k := range map[string]Key{
+		"section":  {Section: SectionRole, Role: base.Role, Rig: base.Rig, TemplateHash: base.TemplateHash, Head: base.Head, Stamps: base.Stamps},
+		"agent":    {Section: base.Section, Role: base.Role, Rig: base.Rig, Agent: "/town/gastown/polecats/toast", TemplateHash: base.TemplateHash, Head: base.Head, Stamps: base.Stamps},
+		"head":     {Section: base.Section, Role: base.Role, Rig: base.Rig, TemplateHash: base.TemplateHash, Head: "def456", Stamps: base.Stamps},
+		"template": {Section: base.Section, Role: base.Role, Rig: base.Rig, TemplateHash: HashSources([]byte("tmpl2")), Head: base.Head, Stamps: base.Stamps},
+		"stamps":   {Section: base.Section, Role: base.Role, Rig: base.Rig, TemplateHash: base.TemplateHash, Head: base.Head, Stamps: []string{"a:-"}},
+		"rig":      {Section: base.Section, Role: base.Role, Rig: "beads", TemplateHash: base.TemplateHash, Head: base.Head, Stamps: base.Stamps},
+	} {
+		if k.Digest() == base.Digest() {
+			t.Errorf("changing %s should change the digest", name)
+		}
+	}
+
+	if HashSources([]byte("a"), nil) == HashSources([]byte("a")) {
+		t.Error("a missing source should still count toward the hash")
+	}
+}
+
+func TestStamp(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "CONTEXT.md")
+	missing := Stamp(path)
+	if err := os.WriteFile(path, []byte("one"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	created := Stamp(path)
+	if created == missing {
+		t.Error("creating the file should change its stamp")
+	}
+	if err := os.WriteFile(path, []byte("one two"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if Stamp(path) == created {
+		t.Error("changing the file should change its stamp")
+	}
+}
+
+func TestCacheGetPutPrune(t *testing.T) {
+	townRoot := t.TempDir()
+	now := time.Now()
+	c := New(townRoot)
+	c.now = func() time.Time { return now }
+	c.maxEntries = 2
+
+	k1 := Key{Role: "polecat", Head: "1"}
+	if _, ok := c.Get(k1); ok {
+		t.Fatal("empty cache should miss")
+	}
+	if err := c.Put(k1, "pack one"); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := c.Get(k1); !ok || got != "pack one" {
+		t.Fatalf("Get = %q, %v", got, ok)
+	}
+
+	// Age k1 so it is the least recently used, then overflow the cache.
+	old := now.Add(-time.Hour)
+	if err := os.Chtimes(c.path(k1), old, old); err != nil {
+		t.Fatal(err)
+	}
+	_ = c.Put(Key{Role: "polecat", Head: "2"}, "two")
+	_ = c.Put(Key{Role: "polecat", Head: "3"}, "three")
+	if _, ok := c.Get(k1); ok {
+		t.Error("least recently used entry should be pruned past maxEntries")
+	}
+
+	// Entries unused past maxAge are pruned.
+	c.now = func() time.Time { return now.Add(DefaultMaxAge + time.Minute) }
+	if n, err := c.Prune(); err != nil || n != 2 {
+		t.Errorf("Prune = %d, %v; want 2 expired", n, err)
+	}
+
+}
+
+func TestCacheClearAgent(t *testing.T) {
+	c := New(t.TempDir())
+	mine := Key{Section: SectionRole, Role: "polecat", Rig: "gastown", Agent: "toast"}
+	shared := Key{Section: SectionBeads, Role: "polecat", Rig: "gastown"}
+	theirs := Key{Section: SectionRole, Role: "polecat", Rig: "gastown", Agent: "nux"}
+	otherRig := Key{Section: SectionBeads, Role: "polecat", Rig: "beads"}
+	for _, k := range []Key{mine, shared, theirs, otherRig} {
+		if err := c.Put(k, "pack"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n, err := c.ClearAgent("gastown", "polecat", "toast"); err != nil || n != 2 {
+		t.Errorf("ClearAgent = %d, %v; want 2", n, err)
+	}
+	for _, k := range []Key{mine, shared} {
+		if _, ok := c.Get(k); ok {
+			t.Errorf("ClearAgent should remove %+v", k)
+		}
+	}
+	for _, k := range []Key{theirs, otherRig} {
+		if _, ok := c.Get(k); !ok {
+			t.Errorf("ClearAgent should keep %+v", k)
+		}
+	}
+}
+
+func TestCacheInspectRepair(t *testing.T) {
+	townRoot := t.TempDir()
+	now := time.Now()
+	c := New(townRoot)
+	c.now = func() time.Time { return now }
+
+	good := Key{Role: "polecat", Head: "good"}
+	stale := Key{Role: "polecat", Head: "stale"}
+	if err := c.Put(good, "pack"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(c.path(stale), []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := now.Add(-2 * DefaultMaxAge)
+	if err := os.Chtimes(c.path(stale), old, old); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(c.path(Key{Role: "polecat", Head: "empty"}), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(Dir(townRoot), "x.pack.tmp123"), []byte("partial"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := c.Inspect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Entries != 3 || h.Stale != 1 || h.Corrupt != 2 {
+		t.Errorf("Inspect = %+v, want 3 entries, 1 stale, 2 corrupt", h)
+	}
+
+	if n, err := c.Repair(); err != nil || n != 3 {
+		t.Errorf("Repair = %d, %v; want 3 removed", n, err)
+	}
+	if h, _ := c.Inspect(); h.Entries != 1 || h.Stale != 0 || h.Corrupt != 0 {
+		t.Errorf("after Repair = %+v, want only the good entry", h)
+	}
+	if _, ok := c.Get(good); !ok {
+		t.Error("Repair must keep healthy entries")
+	}
+}
@@ -0,0 +1,162 @@
+package pubsub
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// MQTT 3.1.1 packet types (high nibble of the fixed header).
+const (
+	mqttConnect    = 0x10
+	mqttConnack    = 0x20
+	mqttPublish    = 0x30
+	mqttPuback     = 0x40
+	mqttDisconnect = 0xE0
+)
+
+// mqttClient is a minimal MQTT 3.1.1 publisher: CONNECT, PUBLISH at QoS 0
+// or 1, DISCONNECT. Keep-alive is disabled, so no PINGREQ loop is needed.
+type mqttClient struct {
+	conn   net.Conn
+	r      *bufio.Reader
+	qos    byte
+	nextID uint16
+}
+
+func dialMQTT(conn net.Conn, clientID, username, password string, qos int) (*mqttClient, error) {
+	var payload []byte
+	payload = appendMQTTString(payload, clientID)
+	flags := byte(0x02) // clean session
+	if username != "" {
+		flags |= 0x80
+		payload = appendMQTTString(payload, username)
+		if password != "" {
+			flags |= 0x40
+			payload = appendMQTTString(payload, password)
+		}
+	}
+	var vh []byte
+	vh = appendMQTTString(vh, "MQTT")
+	vh = append(vh, 4, flags, 0, 0) // level 4 (3.1.1), flags, keep-alive 0 (off)
+
+	c := &mqttClient{conn: conn, r: bufio.NewReader(conn), qos: byte(qos)}
+	if err := c.write(mqttConnect, append(vh, payload...)); err != nil {
+		return nil, err
+	}
+	typ, body, err := c.read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CONNACK: %w", err)
+	}
+	if typ != mqttConnack || len(body) != 2 {
+		return nil, fmt.Errorf("expected CONNACK, got packet type 0x%x", typ)
+	}
+	if body[1] != 0 {
+		return nil, fmt.Errorf("broker refused connection (return code %d)", body[1])
+	}
+	return c, nil
+}
+
+func (c *mqttClient) Publish(topic string, payload []byte) error {
+	var body []byte
+	body = appendMQTTString(body, topic)
+	var id uint16
+	if c.qos > 0 {
+		c.nextID++
+		if c.nextID == 0 {
+			c.nextID = 1
+		}
+		id = c.nextID
+		body = binary.BigEndian.AppendUint16(body, id)
+	}
+	body = append(body, payload...)
+	if err := c.write(mqttPublish|c.qos<<1, body); err != nil {
+		return err
+	}
+	if c.qos == 0 {
+		return nil
+	}
+
+	_ = c.conn.SetReadDeadline(time.Now().Add(ioTimeout))
+	defer func() { _ = c.conn.SetReadDeadline(time.Time{}) }()
+	for {
+		typ, ack, err := c.read()
+		if err != nil {
+			return fmt.Errorf("waiting for PUBACK: %w", err)
+		}
+		if typ == mqttPuback && len(ack) == 2 && binary.BigEndian.Uint16(ack) == id {
+			return nil
+		}
+	}
+}
+
+func (c *mqttClient) Close() error {
+	_ = c.write(mqttDisconnect, nil)
+	return c.conn.Close()
+}
+
+func (c *mqttClient) write(header byte, body []byte) error {
+	pkt := append([]byte{header}, encodeMQTTLength(len(body))...)
+	pkt = append(pkt, body...)
+	_ = c.conn.SetWriteDeadline(time.Now().Add(ioTimeout))
+	_, err := c.conn.Write(pkt)
+	return err
+}
+
+// read returns the next packet's type (high nibble) and body.
+func (c *mqttClient) read() (byte, []byte, error) {
+	header, err := c.r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	n, err := decodeMQTTLength(c.r)
+	if err != nil {
+		return 0, nil, err
+	}
+	body := make([]byte, n)
+	if _, err := io.ReadFull(c.r, body); err != nil {
+		return 0, nil, err
+	}
+	return header & 0xF0, body, nil
+}
+
+func appendMQTTString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s))) //nolint:gosec // G115: topics and ids are short
+	return append(b, s...)
+}
+
+// encodeMQTTLength encodes the fixed header's variable-length remaining length.
+func encodeMQTTLength(n int) []byte {
+	var out []byte
+	for {
+		b := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			b |= 0x80
+		}
+		out = append(out, b)
+		if n == 0 {
+			return out
+		}
+	}
+}
+
+func decodeMQTTLength(r io.ByteReader) (int, error) {
+	n, mult := 0, 1
+	for i := 0; i < 4; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		n += int(b&0x7F) * mult
+		if b&0x80 == 0 {
+			return n, nil
+		}
+		mult *= 128
+	}
+	return 0, errors.New("malformed remaining length")
+}
@@ -0,0 +1,112 @@
+package pubsub
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// natsClient is a minimal core-NATS publisher. A background reader answers
+// server PINGs so the connection isn't dropped as stale, and records -ERR
+// replies so the next Publish fails and the forwarder reconnects.
+type natsClient struct {
+	conn net.Conn
+	mu   sync.Mutex // serializes writes (Publish vs. PONG replies)
+
+	errMu sync.Mutex
+	err   error
+}
+
+func dialNATS(conn net.Conn, username, password string) (*natsClient, error) {
+	r := bufio.NewReader(conn)
+	_ = conn.SetReadDeadline(time.Now().Add(ioTimeout))
+	info, err := r.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("reading INFO: %w", err)
+	}
+	if !strings.HasPrefix(info, "INFO ") {
+		return nil, fmt.Errorf("expected INFO, got %q", strings.TrimSpace(info))
+	}
+
+	opts := map[string]any{"verbose": false, "pedantic": false, "name": "gastown", "lang": "go", "protocol": 0}
+	if username != "" {
+		opts["user"] = username
+		opts["pass"] = password
+	}
+	data, _ := json.Marshal(opts)
+	c := &natsClient{conn: conn}
+	// PING after CONNECT: the PONG confirms the server accepted us; a
+	// rejected CONNECT produces -ERR instead.
+	if err := c.send("CONNECT " + string(data) + "\r\nPING\r\n"); err != nil {
+		return nil, err
+	}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("waiting for PONG: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		if line == "PONG" {
+			break
+		}
+		if strings.HasPrefix(line, "-ERR") {
+			return nil, fmt.Errorf("server rejected connection: %s", line)
+		}
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	go c.readLoop(r)
+	return c, nil
+}
+
+func (c *natsClient) readLoop(r *bufio.Reader) {
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			c.setErr(fmt.Errorf("connection lost: %w", err))
+			return
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PING":
+			_ = c.send("PONG\r\n")
+		case strings.HasPrefix(line, "-ERR"):
+			c.setErr(errors.New(line))
+		}
+	}
+}
+
+func (c *natsClient) setErr(err error) {
+	c.errMu.Lock()
+	if c.err == nil {
+		c.err = err
+	}
+	c.errMu.Unlock()
+}
+
+func (c *natsClient) Publish(subject string, payload []byte) error {
+	c.errMu.Lock()
+	err := c.err
+	c.errMu.Unlock()
+	if err != nil {
+		return err
+	}
+	return c.send(fmt.Sprintf("PUB %s %d\r\n%s\r\n", subject, len(payload), payload))
+}
+
+func (c *natsClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *natsClient) send(s string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(ioTimeout))
+	_, err := c.conn.Write([]byte(s))
+	return err
+}
@@ -0,0 +1,217 @@
+// Package pubsub forwards town events to external message brokers (MQTT or
+// NATS), so home-lab and fleet tooling can react to Gas Town without
+// polling. The clients are deliberately minimal: publish only, no
+// subscriptions, MQTT QoS 0/1.
+package pubsub
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+const (
+	// ioTimeout bounds broker handshakes and writes.
+	ioTimeout = 10 * time.Second
+
+	// reconnectDelay is the initial wait before redialing a lost broker;
+	// it doubles up to maxReconnectDelay.
+	reconnectDelay    = 5 * time.Second
+	maxReconnectDelay = 5 * time.Minute
+
+	defaultTopicPrefix = "gastown"
+)
+
+// Publisher sends payloads to broker topics.
+type Publisher interface {
+	Publish(topic string, payload []byte) error
+	Close() error
+}
+
+// Dial connects to the broker described by cfg.
+func Dial(cfg *config.EventPublisherConfig, town string) (Publisher, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing broker URL: %w", err)
+	}
+	useTLS := false
+	switch u.Scheme {
+	case "tcp", "mqtt", "nats", "":
+	case "ssl", "tls", "mqtts":
+		useTLS = true
+	default:
+		return nil, fmt.Errorf("unsupported broker URL scheme %q", u.Scheme)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		port := map[string]string{"mqtt": "1883", "nats": "4222"}[cfg.Protocol]
+		if useTLS && cfg.Protocol == "mqtt" {
+			port = "8883"
+		}
+		host = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	username, password := cfg.Username, ""
+	if cfg.PasswordEnv != "" {
+		password = os.Getenv(cfg.PasswordEnv)
+	}
+	if u.User != nil {
+		username = u.User.Username()
+		if p, ok := u.User.Password(); ok {
+			password = p
+		}
+	}
+
+	dialer := &net.Dialer{Timeout: ioTimeout}
+	var conn net.Conn
+	if useTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", host, &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12})
+	} else {
+		conn, err = dialer.Dial("tcp", host)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", host, err)
+	}
+
+	var p Publisher
+	if cfg.Protocol == "mqtt" {
+		clientID := cfg.ClientID
+		if clientID == "" {
+			clientID = "gastown-" + town
+		}
+		p, err = dialMQTT(conn, clientID, username, password, cfg.QoS)
+	} else {
+		p, err = dialNATS(conn, username, password)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+// Topic returns the broker topic for an event: prefix/town/rig/type for MQTT
+// and prefix.town.rig.type for NATS.
+func Topic(cfg *config.EventPublisherConfig, town string, e events.Event) string {
+	prefix := cfg.TopicPrefix
+	if prefix == "" {
+		prefix = defaultTopicPrefix
+	}
+	sep := "/"
+	if cfg.Protocol == "nats" {
+		sep = "."
+	}
+	parts := []string{prefix, town, eventRig(e), e.Type}
+	for i, p := range parts {
+		parts[i] = topicSegment(p)
+	}
+	return strings.Join(parts, sep)
+}
+
+// eventRig returns the rig an event belongs to: the payload's "rig", else
+// the first segment of a rig-scoped actor ("gastown/witness"), else "town".
+func eventRig(e events.Event) string {
+	if rig := e.PayloadString("rig"); rig != "" {
+		return rig
+	}
+	if rig, _, ok := strings.Cut(e.Actor, "/"); ok && rig != "" {
+		return rig
+	}
+	return "town"
+}
+
+// topicSegment makes s safe as one topic level in both MQTT and NATS: no
+// separators, wildcards, or whitespace.
+func topicSegment(s string) string {
+	if s == "" {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '.', '+', '#', '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// Forwarder publishes every matching town event to a broker, reconnecting
+// with backoff when the broker goes away. Events that arrive while the
+// broker is unreachable are dropped: the events log stays the source of
+// truth, and replaying a backlog into a home-automation bus is rarely what
+// anyone wants.
+type Forwarder struct {
+	townRoot string
+	town     string
+	cfg      *config.EventPublisherConfig
+	logf     func(format string, args ...interface{})
+	dial     func() (Publisher, error)
+}
+
+// NewForwarder creates a forwarder for the town's events.
+func NewForwarder(townRoot, town string, cfg *config.EventPublisherConfig, logf func(format string, args ...interface{})) (*Forwarder, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	f := &Forwarder{townRoot: townRoot, town: town, cfg: cfg, logf: logf}
+	f.dial = func() (Publisher, error) { return Dial(cfg, town) }
+	return f, nil
+}
+
+// Run forwards events until ctx is done.
+func (f *Forwarder) Run(ctx context.Context) {
+	var (
+		pub       Publisher
+		nextDial  time.Time
+		delay     = reconnectDelay
+		announced bool
+	)
+	defer func() {
+		if pub != nil {
+			_ = pub.Close()
+		}
+	}()
+
+	_ = events.Follow(ctx, f.townRoot, events.Filter{Types: f.cfg.Types}, func(e events.Event) error {
+		if pub == nil {
+			if time.Now().Before(nextDial) {
+				return nil
+			}
+			p, err := f.dial()
+			if err != nil {
+				if !announced {
+					f.logf("Event publisher: %v (dropping events until the broker is back)", err)
+					announced = true
+				}
+				nextDial = time.Now().Add(delay)
+				delay = min(delay*2, maxReconnectDelay)
+				return nil
+			}
+			f.logf("Event publisher connected to %s broker %s", f.cfg.Protocol, f.cfg.URL)
+			pub, delay, announced = p, reconnectDelay, false
+		}
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil
+		}
+		if err := pub.Publish(Topic(f.cfg, f.town, e), data); err != nil {
+			f.logf("Event publisher: publish failed, reconnecting: %v", err)
+			_ = pub.Close()
+			pub = nil
+			nextDial = time.Now().Add(delay)
+		}
+		return nil
+	})
+}
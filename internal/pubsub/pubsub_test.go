@@ -0,0 +1,139 @@
+package pubsub
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestTopic(t *testing.T) {
+	mqtt := &config.EventPublisherConfig{Protocol: "mqtt"}
+	nats := &config.EventPublisherConfig{Protocol: "nats", TopicPrefix: "fleet"}
+
+	for _, tc := range []struct {
+		cfg  *config.EventPublisherConfig
+		e    events.Event
+		want string
+	}{
+		{mqtt, events.Event{Type: events.TypeMerged, Actor: "gastown/refinery"}, "gastown/hq/gastown/merged"},
+		{mqtt, events.Event{Type: events.TypeSpawn, Actor: "gt", Payload: map[string]interface{}{"rig": "beads"}}, "gastown/hq/beads/spawn"},
+		{nats, events.Event{Type: events.TypeBoot, Actor: "deacon"}, "fleet.hq.town.boot"},
+		{nats, events.Event{Type: "a.b", Actor: "my rig/witness"}, "fleet.hq.my_rig.a_b"},
+	} {
+		if got := Topic(tc.cfg, "hq", tc.e); got != tc.want {
+			t.Errorf("Topic(%s, %+v) = %q, want %q", tc.cfg.Protocol, tc.e, got, tc.want)
+		}
+	}
+}
+
+func TestDial_MQTTQoS1(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	type published struct{ topic, payload, user string }
+	got := make(chan published, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		readPkt := func() (byte, []byte) {
+			h, _ := r.ReadByte()
+			n, _ := decodeMQTTLength(r)
+			body := make([]byte, n)
+			_, _ = io.ReadFull(r, body)
+			return h, body
+		}
+		_, connect := readPkt()
+		// Variable header is 10 bytes; payload is client id, then username.
+		idLen := int(binary.BigEndian.Uint16(connect[10:]))
+		userOff := 12 + idLen
+		user := string(connect[userOff+2 : userOff+2+int(binary.BigEndian.Uint16(connect[userOff:]))])
+		_, _ = conn.Write([]byte{mqttConnack, 2, 0, 0})
+
+		h, body := readPkt()
+		topicLen := int(binary.BigEndian.Uint16(body))
+		topic := string(body[2 : 2+topicLen])
+		id := body[2+topicLen : 4+topicLen]
+		if h&0x06 != 0x02 {
+			t.Errorf("PUBLISH flags = %x, want QoS 1", h)
+		}
+		_, _ = conn.Write(append([]byte{mqttPuback, 2}, id...))
+		got <- published{topic, string(body[4+topicLen:]), user}
+	}()
+
+	cfg := &config.EventPublisherConfig{Protocol: "mqtt", URL: "tcp://bob:secret@" + ln.Addr().String(), QoS: 1}
+	p, err := Dial(cfg, "hq")
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer p.Close()
+	if err := p.Publish("gastown/hq/town/boot", []byte(`{"type":"boot"}`)); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	msg := <-got
+	if msg.topic != "gastown/hq/town/boot" || msg.payload != `{"type":"boot"}` || msg.user != "bob" {
+		t.Errorf("broker got %+v", msg)
+	}
+}
+
+func TestDial_NATS(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	got := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		_, _ = conn.Write([]byte("INFO {\"server_id\":\"test\"}\r\n"))
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch {
+			case strings.HasPrefix(line, "PING"):
+				_, _ = conn.Write([]byte("PONG\r\n"))
+			case strings.HasPrefix(line, "PUB "):
+				fields := strings.Fields(line)
+				n, _ := strconv.Atoi(fields[2])
+				payload := make([]byte, n+2)
+				_, _ = io.ReadFull(r, payload)
+				got <- fields[1] + " " + string(payload[:n])
+				return
+			}
+		}
+	}()
+
+	cfg := &config.EventPublisherConfig{Protocol: "nats", URL: "nats://" + ln.Addr().String()}
+	p, err := Dial(cfg, "hq")
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer p.Close()
+	if err := p.Publish("gastown.hq.gastown.merged", []byte("{}")); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if msg := <-got; msg != "gastown.hq.gastown.merged {}" {
+		t.Errorf("server got %q", msg)
+	}
+}
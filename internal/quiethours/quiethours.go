@@ -0,0 +1,230 @@
+// Package quiethours holds back non-critical notifications during a town's
+// quiet hours and batches them into a digest sent when the hours end.
+//
+// Each notification channel ("mail", "slack", "email", "sms") has a daily
+// window in the town's timezone. Critical notifications always go out;
+// others sent during the window are queued in .runtime/notify-digest.json
+// until Flush finds the window over.
+package quiethours
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/lock"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Notification channels.
+const (
+	ChannelMail  = "mail"
+	ChannelSlack = "slack"
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+)
+
+// window is a daily quiet period in minutes after local midnight.
+type window struct {
+	start, end int
+	set        bool
+}
+
+func (w window) contains(minute int) bool {
+	switch {
+	case !w.set || w.start == w.end:
+		return false
+	case w.start < w.end:
+		return minute >= w.start && minute < w.end
+	default: // crosses midnight
+		return minute >= w.start || minute < w.end
+	}
+}
+
+// Policy answers whether a channel is in quiet hours.
+type Policy struct {
+	loc      *time.Location
+	def      window
+	channels map[string]window
+}
+
+// NewPolicy builds a policy from town settings. nil means never quiet.
+func NewPolicy(cfg *config.QuietHoursConfig) (*Policy, error) {
+	p := &Policy{loc: time.Local, channels: map[string]window{}}
+	if cfg == nil {
+		return p, nil
+	}
+	if cfg.Timezone != "" {
+		loc, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("quiet_hours.timezone: %w", err)
+		}
+		p.loc = loc
+	}
+	def, err := parseWindow(cfg.Start, cfg.End)
+	if err != nil {
+		return nil, fmt.Errorf("quiet_hours: %w", err)
+	}
+	p.def = def
+	for name, w := range cfg.Channels {
+		cw, err := parseWindow(w.Start, w.End)
+		if err != nil {
+			return nil, fmt.Errorf("quiet_hours.channels[%q]: %w", name, err)
+		}
+		// An explicitly empty window opts the channel out of the default.
+		cw.set = true
+		p.channels[name] = cw
+	}
+	return p, nil
+}
+
+// LoadPolicy reads the quiet-hours policy from town settings.
+func LoadPolicy(townRoot string) (*Policy, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading town settings: %w", err)
+	}
+	return NewPolicy(settings.QuietHours)
+}
+
+func parseWindow(start, end string) (window, error) {
+	if start == "" && end == "" {
+		return window{}, nil
+	}
+	s, err := parseClock(start)
+	if err != nil {
+		return window{}, err
+	}
+	e, err := parseClock(end)
+	if err != nil {
+		return window{}, err
+	}
+	return window{start: s, end: e, set: true}, nil
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q (want HH:MM)", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Location returns the policy's timezone.
+func (p *Policy) Location() *time.Location { return p.loc }
+
+// Quiet reports whether channel is in quiet hours at now.
+func (p *Policy) Quiet(channel string, now time.Time) bool {
+	w, ok := p.channels[channel]
+	if !ok {
+		w = p.def
+	}
+	local := now.In(p.loc)
+	return w.contains(local.Hour()*60 + local.Minute())
+}
+
+// Hold reports whether a notification on channel should be deferred to the
+// digest rather than sent now.
+func (p *Policy) Hold(channel string, critical bool, now time.Time) bool {
+	return !critical && p.Quiet(channel, now)
+}
+
+// Held is a notification deferred to the digest.
+type Held struct {
+	Channel string    `json:"channel"`
+	To      string    `json:"to,omitempty"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body,omitempty"`
+	At      time.Time `json:"at"`
+}
+
+// DigestPath returns the path of the town's pending digest.
+func DigestPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "notify-digest.json")
+}
+
+func lockDigest(townRoot string) (func(), error) {
+	if err := os.MkdirAll(filepath.Join(townRoot, constants.DirRuntime), 0755); err != nil {
+		return nil, fmt.Errorf("creating runtime dir: %w", err)
+	}
+	return lock.FlockAcquire(DigestPath(townRoot) + ".lock")
+}
+
+// Pending returns the held notifications, oldest first.
+func Pending(townRoot string) ([]Held, error) {
+	data, err := os.ReadFile(DigestPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading notification digest: %w", err)
+	}
+	var held []Held
+	if err := json.Unmarshal(data, &held); err != nil {
+		return nil, fmt.Errorf("parsing notification digest: %w", err)
+	}
+	sort.SliceStable(held, func(i, j int) bool { return held[i].At.Before(held[j].At) })
+	return held, nil
+}
+
+// Hold appends a notification to the digest.
+func Hold(townRoot string, h Held) error {
+	unlock, err := lockDigest(townRoot)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	held, err := Pending(townRoot)
+	if err != nil {
+		return err
+	}
+	if h.At.IsZero() {
+		h.At = time.Now().UTC()
+	}
+	return util.EnsureDirAndWriteJSON(DigestPath(townRoot), append(held, h))
+}
+
+// TakeDue removes and returns the held notifications whose channel is out
+// of quiet hours at now (or all of them with force), grouped by channel and
+// recipient in hold order.
+func (p *Policy) TakeDue(townRoot string, now time.Time, force bool) ([]Held, error) {
+	unlock, err := lockDigest(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	held, err := Pending(townRoot)
+	if err != nil || len(held) == 0 {
+		return nil, err
+	}
+	var due, keep []Held
+	for _, h := range held {
+		if force || !p.Quiet(h.Channel, now) {
+			due = append(due, h)
+		} else {
+			keep = append(keep, h)
+		}
+	}
+	if len(due) == 0 {
+		return nil, nil
+	}
+	if len(keep) == 0 {
+		if err := os.Remove(DigestPath(townRoot)); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("clearing notification digest: %w", err)
+		}
+	} else if err := util.EnsureDirAndWriteJSON(DigestPath(townRoot), keep); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		if due[i].Channel != due[j].Channel {
+			return due[i].Channel < due[j].Channel
+		}
+		return due[i].To < due[j].To
+	})
+	return due, nil
+}
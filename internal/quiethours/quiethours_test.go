@@ -0,0 +1,104 @@
+package quiethours
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestPolicyQuiet(t *testing.T) {
+	p, err := NewPolicy(&config.QuietHoursConfig{
+		Timezone: "America/New_York",
+		Start:    "23:00",
+		End:      "08:00",
+		Channels: map[string]config.QuietWindow{
+			ChannelMail: {},                             // never quiet
+			ChannelSMS:  {Start: "21:00", End: "22:00"}, // same-day window
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ny, _ := time.LoadLocation("America/New_York")
+	at := func(h, m int) time.Time { return time.Date(2026, 3, 2, h, m, 0, 0, ny).UTC() }
+
+	tests := []struct {
+		channel string
+		when    time.Time
+		want    bool
+	}{
+		{ChannelSlack, at(23, 30), true},
+		{ChannelSlack, at(3, 0), true},
+		{ChannelSlack, at(8, 0), false}, // end is exclusive
+		{ChannelSlack, at(22, 59), false},
+		{ChannelMail, at(3, 0), false},
+		{ChannelSMS, at(21, 15), true},
+		{ChannelSMS, at(23, 30), false},
+	}
+	for _, tt := range tests {
+		if got := p.Quiet(tt.channel, tt.when); got != tt.want {
+			t.Errorf("Quiet(%s, %s NY) = %v, want %v", tt.channel, tt.when.In(ny).Format("15:04"), got, tt.want)
+		}
+	}
+	if p.Hold(ChannelSlack, true, at(3, 0)) {
+		t.Error("critical notification held")
+	}
+
+	if _, err := NewPolicy(&config.QuietHoursConfig{Start: "11pm", End: "08:00"}); err == nil {
+		t.Error("invalid clock accepted")
+	}
+	if _, err := NewPolicy(&config.QuietHoursConfig{Timezone: "Mars/Olympus"}); err == nil {
+		t.Error("invalid timezone accepted")
+	}
+	if p, _ := NewPolicy(nil); p.Quiet(ChannelSlack, at(3, 0)) {
+		t.Error("nil config is quiet")
+	}
+}
+
+func TestHoldAndTakeDue(t *testing.T) {
+	townRoot := t.TempDir()
+	p, err := NewPolicy(&config.QuietHoursConfig{
+		Timezone: "UTC",
+		Start:    "23:00",
+		End:      "08:00",
+		Channels: map[string]config.QuietWindow{ChannelSMS: {Start: "01:00", End: "10:00"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	night := time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC)
+	for _, h := range []Held{
+		{Channel: ChannelMail, To: "mayor/", Subject: "doctor", At: night},
+		{Channel: ChannelSMS, To: "human", Subject: "esc", At: night.Add(time.Minute)},
+		{Channel: ChannelMail, To: "mayor/", Subject: "inbox", At: night.Add(2 * time.Minute)},
+	} {
+		if err := Hold(townRoot, h); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if due, err := p.TakeDue(townRoot, night.Add(time.Hour), false); err != nil || len(due) != 0 {
+		t.Fatalf("TakeDue during quiet hours = %v, %v", due, err)
+	}
+
+	// 09:00: mail's window is over, sms's is not.
+	due, err := p.TakeDue(townRoot, night.Add(7*time.Hour), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 2 || due[0].Subject != "doctor" || due[1].Subject != "inbox" {
+		t.Fatalf("due = %+v, want the two mail notifications in order", due)
+	}
+	left, _ := Pending(townRoot)
+	if len(left) != 1 || left[0].Channel != ChannelSMS {
+		t.Fatalf("pending = %+v, want the sms notification", left)
+	}
+
+	if due, _ := p.TakeDue(townRoot, night.Add(7*time.Hour), true); len(due) != 1 {
+		t.Errorf("forced TakeDue = %+v", due)
+	}
+	if left, _ := Pending(townRoot); len(left) != 0 {
+		t.Errorf("pending after force = %+v", left)
+	}
+}
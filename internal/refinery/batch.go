@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
 )
 
 // BatchConfig holds configuration for the batch-then-bisect merge queue.
@@ -45,6 +47,10 @@ type BatchResult struct {
 	// Conflicts is the set of MRs that had merge conflicts during stack construction.
 	Conflicts []*MRInfo
 
+	// PolicyViolations is the set of MRs whose branch the rig's branch
+	// policy keeps out of the merge queue. They never join a stack.
+	PolicyViolations []*MRInfo
+
 	// MergeCommit is the final SHA pushed to the target branch (empty if nothing merged).
 	MergeCommit string
 
@@ -201,6 +207,9 @@ func (e *Engineer) ProcessBatch(ctx context.Context, batch []*MRInfo, target str
 
 	result := &BatchResult{}
 
+	// Branches outside the rig's branch policy never join a stack
+	batch, result.PolicyViolations = e.splitByBranchPolicy(batch)
+
 	if len(batch) == 0 {
 		return result
 	}
@@ -234,7 +243,7 @@ func (e *Engineer) ProcessBatch(ctx context.Context, batch []*MRInfo, target str
 
 	// Step 2: Run gates on the stack tip
 	_, _ = fmt.Fprintf(e.output, "[Batch] Running gates on stack tip (%d MRs)...\n", len(stacked))
-	gateResult := e.runBatchGates(ctx)
+	gateResult := e.runBatchGates(ctx, stacked)
 
 	// Step 3: Happy path — all green
 	if gateResult.Success {
@@ -251,7 +260,7 @@ func (e *Engineer) ProcessBatch(ctx context.Context, batch []*MRInfo, target str
 			return result
 		}
 
-		retryResult := e.runBatchGates(ctx)
+		retryResult := e.runBatchGates(ctx, stacked)
 		if retryResult.Success {
 			_, _ = fmt.Fprintln(e.output, "[Batch] Retry succeeded (was flaky)")
 			return e.fastForwardBatch(ctx, stacked, target, result)
@@ -273,7 +282,7 @@ func (e *Engineer) ProcessBatch(ctx context.Context, batch []*MRInfo, target str
 			return result
 		}
 		// Verify the good subset actually passes
-		verifyResult := e.runBatchGates(ctx)
+		verifyResult := e.runBatchGates(ctx, good)
 		if verifyResult.Success {
 			return e.fastForwardBatch(ctx, good, target, result)
 		}
@@ -296,16 +305,39 @@ func (e *Engineer) processSingleMR(ctx context.Context, mr *MRInfo, target strin
 		result.Conflicts = []*MRInfo{mr}
 	} else if processResult.TestsFailed {
 		result.Culprits = []*MRInfo{mr}
+	} else if processResult.PolicyViolation {
+		result.PolicyViolations = []*MRInfo{mr}
 	} else {
 		result.Error = fmt.Errorf("merge failed: %s", processResult.Error)
 	}
 	return result
 }
 
-// runBatchGates runs quality gates (or legacy tests) on the current working tree.
-func (e *Engineer) runBatchGates(ctx context.Context) ProcessResult {
+// splitByBranchPolicy separates the MRs whose branch the rig's branch policy
+// allows from those it rejects.
+func (e *Engineer) splitByBranchPolicy(batch []*MRInfo) (allowed, rejected []*MRInfo) {
+	policy := rig.LoadBranchPolicy(e.rig.Path)
+	for _, mr := range batch {
+		if err := policy.CheckBranchName(mr.Branch); err != nil {
+			_, _ = fmt.Fprintf(e.output, "[Batch] MR %s: %v, skipping\n", mr.ID, err)
+			rejected = append(rejected, mr)
+			continue
+		}
+		allowed = append(allowed, mr)
+	}
+	return allowed, rejected
+}
+
+// runBatchGates runs quality gates (or legacy tests) on the current working
+// tree, which holds the stacked MRs. Gates see the stacked MRs' wisps in
+// their environment, as with a single merge.
+func (e *Engineer) runBatchGates(ctx context.Context, stacked []*MRInfo) ProcessResult {
 	if len(e.config.Gates) > 0 {
-		return e.runGates(ctx)
+		issues := make([]string, 0, len(stacked))
+		for _, mr := range stacked {
+			issues = append(issues, mr.SourceIssue)
+		}
+		return e.runGateSet(ctx, e.config.Gates, e.criteriaEnv(issues...))
 	}
 	if e.config.RunTests && e.config.TestCommand != "" {
 		result := e.runTests(ctx)
@@ -326,7 +358,7 @@ func (e *Engineer) runBatchGates(ctx context.Context) ProcessResult {
 func (e *Engineer) verifyAndPush(ctx context.Context, stacked []*MRInfo, target string) *BatchResult {
 	result := &BatchResult{}
 
-	gateResult := e.runBatchGates(ctx)
+	gateResult := e.runBatchGates(ctx, stacked)
 	if !gateResult.Success {
 		if gateResult.TestsFailed {
 			result.Culprits = stacked
@@ -412,7 +444,7 @@ func (e *Engineer) bisectBatch(ctx context.Context, batch []*MRInfo, target stri
 		return nil, batch
 	}
 
-	leftResult := e.runBatchGates(ctx)
+	leftResult := e.runBatchGates(ctx, left)
 
 	if leftResult.Success {
 		// Left half is green — culprit is in right half
@@ -436,7 +468,7 @@ func (e *Engineer) bisectBatch(ctx context.Context, batch []*MRInfo, target stri
 			_, _ = fmt.Fprintf(e.output, "[Bisect] Error testing right with good left: %v\n", resetErr)
 			return leftGood, append(leftCulprits, right...)
 		}
-		combinedResult := e.runBatchGates(ctx)
+		combinedResult := e.runBatchGates(ctx, combined)
 		if combinedResult.Success {
 			return append(leftGood, right...), leftCulprits
 		}
@@ -449,7 +481,7 @@ func (e *Engineer) bisectBatch(ctx context.Context, batch []*MRInfo, target stri
 	if resetErr := e.resetAndRebuildStack(right, target); resetErr != nil {
 		return nil, batch
 	}
-	rightResult := e.runBatchGates(ctx)
+	rightResult := e.runBatchGates(ctx, right)
 	if rightResult.Success {
 		return right, leftCulprits
 	}
@@ -476,7 +508,7 @@ func (e *Engineer) bisectRight(ctx context.Context, knownGood []*MRInfo, right [
 		return nil, right
 	}
 
-	result := e.runBatchGates(ctx)
+	result := e.runBatchGates(ctx, testBatch)
 	if result.Success {
 		// rLeft is fine in context of knownGood — culprit is in rRight
 		_, _ = fmt.Fprintf(e.output, "[Bisect-R] knownGood+rLeft passed → culprit in rRight=%v\n", mrIDs(rRight))
@@ -496,7 +528,7 @@ func (e *Engineer) bisectRight(ctx context.Context, knownGood []*MRInfo, right [
 	if resetErr := e.resetAndRebuildStack(testBatch2, target); resetErr != nil {
 		return rLeftGood, append(rLeftCulprits, rRight...)
 	}
-	result2 := e.runBatchGates(ctx)
+	result2 := e.runBatchGates(ctx, testBatch2)
 	if result2.Success {
 		_, _ = fmt.Fprintf(e.output, "[Bisect-R] rRight passed → good=%v, culprits=%v\n", mrIDs(append(rLeftGood, rRight...)), mrIDs(rLeftCulprits))
 		return append(rLeftGood, rRight...), rLeftCulprits
@@ -355,6 +355,31 @@ func TestBuildRebaseStack_MissingBranch(t *testing.T) {
 
 // --- ProcessBatch tests ---
 
+func TestProcessBatch_PolicyViolationsNotConflicts(t *testing.T) {
+	rigPath := t.TempDir()
+	cfg := `{"type":"rig","name":"test-rig","branch_policy":{"allowed_prefixes":["wisp/"]}}`
+	if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	e := NewEngineer(&rig.Rig{Name: "test-rig", Path: rigPath})
+	e.output = &bytes.Buffer{}
+
+	batch := []*MRInfo{
+		makeMR("mr-a", "polecat/toast/gt-1", "main"),
+		makeMR("mr-b", "feature-b", "main"),
+	}
+	result := e.ProcessBatch(context.Background(), batch, "main", DefaultBatchConfig())
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.PolicyViolations) != 2 {
+		t.Errorf("expected 2 policy violations, got %v", stackedIDs(result.PolicyViolations))
+	}
+	if len(result.Conflicts) != 0 {
+		t.Errorf("policy violations must not be reported as conflicts, got %v", stackedIDs(result.Conflicts))
+	}
+}
+
 func TestProcessBatch_EmptyBatch(t *testing.T) {
 	r := &rig.Rig{Name: "test-rig", Path: t.TempDir()}
 	e := NewEngineer(r)
@@ -383,10 +383,17 @@ type ProcessResult struct {
 	Conflict    bool
 	TestsFailed bool
 	SlotTimeout bool // Merge slot contention timeout (distinct from build/test failure)
+
+	PolicyViolation bool // Branch not allowed by the rig's branch policy
 }
 
 // doMerge performs the actual git merge operation.
 func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue string) ProcessResult {
+	// Refuse branches the rig's branch policy keeps out of the queue.
+	if err := rig.LoadBranchPolicy(e.rig.Path).CheckBranchName(branch); err != nil {
+		return ProcessResult{Success: false, Error: err.Error(), PolicyViolation: true}
+	}
+
 	// Step 1: Verify source branch exists locally (shared .repo.git with polecats)
 	_, _ = fmt.Fprintf(e.output, "[Engineer] Checking local branch %s...\n", branch)
 	exists, err := e.git.BranchExists(branch)
@@ -466,14 +473,19 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 		_, _ = fmt.Fprintf(e.output, "[Engineer] Pushed %d submodule(s)\n", len(subChanges))
 	}
 
-	// Step 4: Run quality gates (or legacy tests) if configured
+	// Step 4: Run quality gates (or legacy tests) if configured, limited to
+	// those the source issue's wisp type requires
+	gates, noGates, err := e.gatesForIssue(sourceIssue)
+	if err != nil {
+		return ProcessResult{Success: false, Error: err.Error()}
+	}
 	if len(e.config.Gates) > 0 {
 		// New gates system: run configured quality gates
-		gateResult := e.runGates(ctx)
+		gateResult := e.runGateSet(ctx, gates, e.criteriaEnv(sourceIssue))
 		if !gateResult.Success {
 			return gateResult
 		}
-	} else if e.config.RunTests && e.config.TestCommand != "" {
+	} else if e.config.RunTests && e.config.TestCommand != "" && !noGates {
 		// Legacy test command path (backward compatible)
 		_, _ = fmt.Fprintf(e.output, "[Engineer] Running tests: %s\n", e.config.TestCommand)
 		result := e.runTests(ctx)
@@ -699,7 +711,8 @@ func (e *Engineer) runTests(ctx context.Context) ProcessResult {
 }
 
 // runGate executes a single quality gate command and returns the result.
-func (e *Engineer) runGate(ctx context.Context, name string, gate *GateConfig) GateResult {
+// env is added to the command's environment (see criteriaEnv).
+func (e *Engineer) runGate(ctx context.Context, name string, gate *GateConfig, env []string) GateResult {
 	start := time.Now()
 
 	if strings.TrimSpace(gate.Cmd) == "" {
@@ -721,6 +734,9 @@ func (e *Engineer) runGate(ctx context.Context, name string, gate *GateConfig) G
 
 	cmd := exec.CommandContext(gateCtx, "sh", "-c", gate.Cmd) //nolint:gosec // G204: Gate commands are from trusted rig config
 	cmd.Dir = e.workDir
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
@@ -760,7 +776,12 @@ func (e *Engineer) runGate(ctx context.Context, name string, gate *GateConfig) G
 // Gates run in parallel if GatesParallel is true; otherwise sequentially.
 // Any single gate failure means overall failure.
 func (e *Engineer) runGates(ctx context.Context) ProcessResult {
-	gates := e.config.Gates
+	return e.runGateSet(ctx, e.config.Gates, nil)
+}
+
+// runGateSet executes the given quality gates with env added to their
+// environment; see runGates.
+func (e *Engineer) runGateSet(ctx context.Context, gates map[string]*GateConfig, env []string) ProcessResult {
 	if len(gates) == 0 {
 		return ProcessResult{Success: true}
 	}
@@ -784,14 +805,14 @@ func (e *Engineer) runGates(ctx context.Context) ProcessResult {
 			go func(idx int, gateName string) {
 				defer wg.Done()
 				_, _ = fmt.Fprintf(e.output, "[Engineer] Gate %q: starting (%s)\n", gateName, gates[gateName].Cmd)
-				results[idx] = e.runGate(ctx, gateName, gates[gateName])
+				results[idx] = e.runGate(ctx, gateName, gates[gateName], env)
 			}(i, name)
 		}
 		wg.Wait()
 	} else {
 		for _, name := range names {
 			_, _ = fmt.Fprintf(e.output, "[Engineer] Gate %q: starting (%s)\n", name, gates[name].Cmd)
-			result := e.runGate(ctx, name, gates[name])
+			result := e.runGate(ctx, name, gates[name], env)
 			results = append(results, result)
 			if !result.Success {
 				// Sequential mode: stop on first failure
@@ -947,6 +968,17 @@ func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
 
 	// 4. Log success
 	_, _ = fmt.Fprintf(e.output, "[Engineer] ✓ Merged: %s (commit: %s)\n", mr.ID, result.MergeCommit)
+	_ = events.LogFeed(events.TypeMerged, holder, mergeEventPayload(mr, ""))
+}
+
+// mergeEventPayload builds a merged/merge_failed event payload, including the
+// source issue so outcomes can be attributed to the wisp that produced them.
+func mergeEventPayload(mr *MRInfo, reason string) map[string]interface{} {
+	p := events.MergePayload(mr.ID, mr.Worker, mr.Branch, reason)
+	if mr.SourceIssue != "" {
+		p["bead"] = mr.SourceIssue
+	}
+	return p
 }
 
 // HandleMRInfoFailure handles a failed merge from MRInfo.
@@ -972,7 +1004,13 @@ func (e *Engineer) HandleMRInfoFailure(mr *MRInfo, result ProcessResult) {
 		failureType = "conflict"
 	} else if result.TestsFailed {
 		failureType = "tests"
+	} else if result.PolicyViolation {
+		failureType = "policy"
 	}
+	// Record the outcome for agent scorecards; the reason is prefixed with the
+	// failure type so test-gate failures can be told apart from conflicts.
+	_ = events.LogFeed(events.TypeMergeFailed, e.rig.Name+"/refinery", mergeEventPayload(mr, failureType+": "+result.Error))
+
 	polecatName := strings.TrimPrefix(mr.Worker, "polecats/")
 	nudgeTarget := fmt.Sprintf("%s/%s", e.rig.Name, polecatName)
 	nudgeMsg := fmt.Sprintf("MERGE_FAILED: branch=%s issue=%s type=%s error=%s — fix and resubmit with 'gt done'",
@@ -1099,6 +1137,8 @@ func (e *Engineer) createConflictResolutionTaskForMR(mr *MRInfo, _ ProcessResult
 3. Resolve conflicts in your editor
 4. Complete the rebase: git add . && git rebase --continue
 5. Force-push the resolved branch: git push -f
+   (if the town gates force-push, the first push files an approval request;
+   push again once 'gt approval' shows it approved)
 6. Close this task: bd close <this-task-id>
 
 The Refinery will automatically retry the merge after you force-push.`,
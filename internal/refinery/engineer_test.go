@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -368,7 +369,7 @@ func TestRunGate_Success(t *testing.T) {
 
 	result := e.runGate(context.Background(), "echo-test", &GateConfig{
 		Cmd: "echo hello",
-	})
+	}, nil)
 
 	if !result.Success {
 		t.Errorf("expected success, got error: %s", result.Error)
@@ -378,6 +379,36 @@ func TestRunGate_Success(t *testing.T) {
 	}
 }
 
+func TestCriteriaEnv_Batch(t *testing.T) {
+	r := &rig.Rig{Name: "test-rig", Path: t.TempDir()}
+	e := NewEngineer(r)
+
+	env := strings.Join(e.criteriaEnv("gt-a", "", "gt-b"), "\n")
+	if !strings.Contains(env, "GT_WISP_IDS=gt-a gt-b") {
+		t.Errorf("batch env should list every wisp:\n%s", env)
+	}
+	if strings.Contains(env, "GT_WISP_ID=") {
+		t.Errorf("batch env should not name a single wisp:\n%s", env)
+	}
+	if env := e.criteriaEnv("gt-a"); !slices.Contains(env, "GT_WISP_ID=gt-a") {
+		t.Errorf("single-MR env = %v, want GT_WISP_ID", env)
+	}
+}
+
+func TestRunGate_CriteriaEnv(t *testing.T) {
+	r := &rig.Rig{Name: "test-rig", Path: t.TempDir()}
+	e := NewEngineer(r)
+	e.workDir = t.TempDir()
+	env := []string{"GT_WISP_ID=gt-abc", `GT_WISP_CRITERIA=[{"kind":"test","text":"x","done":false}]`}
+
+	result := e.runGate(context.Background(), "criteria", &GateConfig{
+		Cmd: `test "$GT_WISP_ID" = gt-abc && echo "$GT_WISP_CRITERIA" | grep -q '"done":false'`,
+	}, env)
+	if !result.Success {
+		t.Errorf("gate should see the wisp criteria: %s", result.Error)
+	}
+}
+
 func TestRunGate_Failure(t *testing.T) {
 	r := &rig.Rig{Name: "test-rig", Path: t.TempDir()}
 	e := NewEngineer(r)
@@ -385,7 +416,7 @@ func TestRunGate_Failure(t *testing.T) {
 
 	result := e.runGate(context.Background(), "fail-test", &GateConfig{
 		Cmd: "exit 1",
-	})
+	}, nil)
 
 	if result.Success {
 		t.Error("expected failure")
@@ -402,7 +433,7 @@ func TestRunGate_EmptyCmd(t *testing.T) {
 
 	result := e.runGate(context.Background(), "empty", &GateConfig{
 		Cmd: "",
-	})
+	}, nil)
 
 	if result.Success {
 		t.Error("expected failure for empty cmd")
@@ -417,7 +448,7 @@ func TestRunGate_Timeout(t *testing.T) {
 	result := e.runGate(context.Background(), "slow", &GateConfig{
 		Cmd:     "sleep 10",
 		Timeout: 100 * time.Millisecond,
-	})
+	}, nil)
 
 	if result.Success {
 		t.Error("expected timeout failure")
@@ -621,6 +652,9 @@ func TestNotifyDeaconConvoyFeeding_AttemptsWhenConvoyID(t *testing.T) {
 		t.Fatal(err)
 	}
 	defer os.RemoveAll(tmpDir)
+	// Sending mail logs a feed event to the town found from the cwd; keep
+	// it out of the source tree.
+	t.Chdir(tmpDir)
 
 	rigDir := filepath.Join(tmpDir, "testrig")
 	if err := os.MkdirAll(rigDir, 0755); err != nil {
@@ -0,0 +1,104 @@
+package refinery
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/artifact"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/wisp"
+)
+
+// gatesForIssue returns the configured quality gates that sourceIssue's
+// wisp type requires, and whether the town configured the type to skip
+// gates entirely (which also skips the legacy test command). Untyped or
+// unreadable issues, and types whose gates are not set in town settings,
+// get every gate. A required gate that is not configured is an error: the
+// MR cannot pass a gate that never runs.
+func (e *Engineer) gatesForIssue(sourceIssue string) (map[string]*GateConfig, bool, error) {
+	all := e.config.Gates
+	if sourceIssue == "" || e.rig == nil {
+		return all, false, nil
+	}
+	issue, err := e.beads.Show(sourceIssue)
+	if err != nil {
+		return all, false, nil
+	}
+	townRoot := filepath.Dir(e.rig.Path)
+	var settings *config.WispSettings
+	if ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil {
+		settings = ts.Wisps
+	}
+	t, ok := wisp.TypeOf(wisp.Types(settings), issue)
+	if !ok {
+		return all, false, nil
+	}
+
+	if len(all) == 0 {
+		// Legacy test command: only "no gates" applies.
+		return all, t.SkipsGates(), nil
+	}
+
+	configured := make([]string, 0, len(all))
+	for name := range all {
+		configured = append(configured, name)
+	}
+	run, missing := t.RequiredGates(configured)
+	if len(missing) > 0 {
+		return nil, false, fmt.Errorf("wisp type %q requires gate(s) not configured for this rig: %s",
+			t.Name, strings.Join(missing, ", "))
+	}
+	gates := make(map[string]*GateConfig, len(run))
+	for _, name := range run {
+		gates[name] = all[name]
+	}
+	if len(gates) < len(all) {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Wisp type %q: running %d of %d gate(s) (town settings)\n", t.Name, len(gates), len(all))
+	}
+	return gates, t.SkipsGates(), nil
+}
+
+// criteriaEnv returns the environment that tells gate commands which wisps
+// they are gating: GT_WISP_IDS with the wisp IDs, space-separated,
+// GT_WISP_CRITERIA with their acceptance criteria and test expectations as
+// a JSON list of wisp.Criterion, and GT_WISP_ARTIFACTS with their
+// registered artifacts as a JSON list of artifact.Artifact. GT_WISP_ID is
+// set when exactly one wisp is gated; a batch of stacked MRs gates several.
+func (e *Engineer) criteriaEnv(sourceIssues ...string) []string {
+	var ids []string
+	for _, id := range sourceIssues {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 || e.beads == nil {
+		return nil
+	}
+	env := []string{"GT_WISP_IDS=" + strings.Join(ids, " ")}
+	if len(ids) == 1 {
+		env = append(env, "GT_WISP_ID="+ids[0])
+	}
+	if e.rig != nil {
+		arts := []artifact.Artifact{}
+		for _, id := range ids {
+			if list, err := artifact.List(filepath.Dir(e.rig.Path), id); err == nil {
+				arts = append(arts, list...)
+			}
+		}
+		if data, err := json.Marshal(arts); err == nil {
+			env = append(env, "GT_WISP_ARTIFACTS="+string(data))
+		}
+	}
+	criteria := []wisp.Criterion{}
+	for _, id := range ids {
+		if issue, err := e.beads.Show(id); err == nil {
+			criteria = append(criteria, wisp.Criteria(issue)...)
+		}
+	}
+	if data, err := json.Marshal(criteria); err == nil {
+		env = append(env, "GT_WISP_CRITERIA="+string(data))
+	}
+	return env
+}
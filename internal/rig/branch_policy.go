@@ -0,0 +1,68 @@
+package rig
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrBranchPolicy is returned when a branch violates the rig's branch policy.
+var ErrBranchPolicy = errors.New("branch policy violation")
+
+// BranchPolicy governs the branches agents create and merge in a rig. It is
+// the "branch_policy" section of the rig's config.json; the base branch is
+// the sibling default_branch setting.
+//
+//	"branch_policy": {
+//	  "polecat_branch_template": "wisp/{issue}-{timestamp}",
+//	  "allowed_prefixes": ["wisp/", "integration/"],
+//	  "allow_direct_commits": false
+//	}
+type BranchPolicy struct {
+	// BranchTemplate names polecat work branches, with the variables of the
+	// polecat_branch_template property. A property set on the rig's wisp or
+	// bead layer still takes precedence.
+	BranchTemplate string `json:"polecat_branch_template,omitempty"`
+
+	// AllowedPrefixes restricts the names of polecat branches and of
+	// branches the merge queue accepts. Empty allows any name.
+	AllowedPrefixes []string `json:"allowed_prefixes,omitempty"`
+
+	// AllowDirectCommits controls whether gt done may land work on the
+	// default branch without going through the merge queue (the convoy
+	// "direct" merge strategy). Crew commits on the default branch are not
+	// blocked; gt doctor reports unpushed ones. Nil defaults to true.
+	AllowDirectCommits *bool `json:"allow_direct_commits,omitempty"`
+}
+
+// LoadBranchPolicy returns the rig's branch policy. A rig without one (or
+// without a readable config.json) gets the zero policy, which allows
+// everything.
+func LoadBranchPolicy(rigPath string) *BranchPolicy {
+	cfg, err := LoadRigConfig(rigPath)
+	if err != nil || cfg.BranchPolicy == nil {
+		return &BranchPolicy{}
+	}
+	return cfg.BranchPolicy
+}
+
+// DirectCommitsAllowed reports whether work may land on the default branch
+// without the merge queue.
+func (p *BranchPolicy) DirectCommitsAllowed() bool {
+	return p.AllowDirectCommits == nil || *p.AllowDirectCommits
+}
+
+// CheckBranchName returns an error wrapping ErrBranchPolicy if branch
+// doesn't start with one of the allowed prefixes.
+func (p *BranchPolicy) CheckBranchName(branch string) error {
+	if len(p.AllowedPrefixes) == 0 {
+		return nil
+	}
+	for _, prefix := range p.AllowedPrefixes {
+		if strings.HasPrefix(branch, prefix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: branch %q must start with one of %s",
+		ErrBranchPolicy, branch, strings.Join(p.AllowedPrefixes, ", "))
+}
@@ -0,0 +1,43 @@
+package rig
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadBranchPolicy(t *testing.T) {
+	rigPath := t.TempDir()
+
+	p := LoadBranchPolicy(rigPath)
+	if !p.DirectCommitsAllowed() || p.CheckBranchName("anything") != nil {
+		t.Errorf("missing config should allow everything, got %+v", p)
+	}
+
+	cfg := `{"type":"rig","name":"gastown","branch_policy":{"polecat_branch_template":"wisp/{issue}","allowed_prefixes":["wisp/","integration/"],"allow_direct_commits":false}}`
+	if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p = LoadBranchPolicy(rigPath)
+	if p.BranchTemplate != "wisp/{issue}" {
+		t.Errorf("BranchTemplate = %q", p.BranchTemplate)
+	}
+	if p.DirectCommitsAllowed() {
+		t.Error("DirectCommitsAllowed() = true, want false")
+	}
+	for branch, ok := range map[string]bool{
+		"wisp/gt-123":        true,
+		"integration/auth":   true,
+		"polecat/Toast/gt-1": false,
+		"wispy":              false,
+	} {
+		err := p.CheckBranchName(branch)
+		if ok != (err == nil) {
+			t.Errorf("CheckBranchName(%q) = %v, want ok=%v", branch, err, ok)
+		}
+		if err != nil && !errors.Is(err, ErrBranchPolicy) {
+			t.Errorf("CheckBranchName(%q) error %v does not wrap ErrBranchPolicy", branch, err)
+		}
+	}
+}
@@ -97,6 +97,9 @@ type RigConfig struct {
 	// PolecatNames optionally specifies fixed names (overrides theme-based naming).
 	PolecatPoolSize int      `json:"polecat_pool_size,omitempty"`
 	PolecatNames    []string `json:"polecat_names,omitempty"`
+
+	// BranchPolicy governs branch naming and direct commits. See BranchPolicy.
+	BranchPolicy *BranchPolicy `json:"branch_policy,omitempty"`
 }
 
 // BeadsConfig represents beads configuration for the rig.
@@ -0,0 +1,207 @@
+// Package roster declares which long-lived agents each rig should run:
+// its witness, its refinery, and its crew. The roster is desired state;
+// gt agents apply (and the daemon's roster patrol) reconcile the running
+// sessions against it, starting declared agents that are missing and
+// stopping undeclared ones.
+//
+// Only rigs listed in the roster are managed. Town-level agents (Mayor,
+// Deacon, Boot) stay with the daemon heartbeat, and polecats come and go
+// with their work, so neither is part of the roster.
+package roster
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// CurrentVersion is the roster file format version.
+const CurrentVersion = 1
+
+// Roster is the declared agents of each managed rig.
+type Roster struct {
+	Type    string               `json:"type"` // "roster"
+	Version int                  `json:"version"`
+	Rigs    map[string]RigRoster `json:"rigs"`
+}
+
+// RigRoster is the agents one rig should run.
+type RigRoster struct {
+	Witness  bool     `json:"witness,omitempty"`
+	Refinery bool     `json:"refinery,omitempty"`
+	Crew     []string `json:"crew,omitempty"`
+}
+
+// Path returns the roster file for a town.
+func Path(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirMayor, "roster.json")
+}
+
+// Load reads the town's roster. Returns nil, nil if the town has none.
+func Load(townRoot string) (*Roster, error) {
+	data, err := os.ReadFile(Path(townRoot)) //nolint:gosec // G304: path is constructed from trusted townRoot
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading roster: %w", err)
+	}
+	var r Roster
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", Path(townRoot), err)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", Path(townRoot), err)
+	}
+	return &r, nil
+}
+
+// Save writes the roster to the town.
+func Save(townRoot string, r *Roster) error {
+	if r.Type == "" {
+		r.Type = "roster"
+	}
+	if r.Version == 0 {
+		r.Version = CurrentVersion
+	}
+	return util.EnsureDirAndWriteJSON(Path(townRoot), r)
+}
+
+// Validate checks the roster's version and crew names.
+func (r *Roster) Validate() error {
+	if r.Version > CurrentVersion {
+		return fmt.Errorf("roster version %d is newer than supported (%d)", r.Version, CurrentVersion)
+	}
+	for rig, rr := range r.Rigs {
+		seen := map[string]bool{}
+		for _, name := range rr.Crew {
+			if name == "" || filepath.Base(name) != name {
+				return fmt.Errorf("rig %s: invalid crew name %q", rig, name)
+			}
+			if seen[name] {
+				return fmt.Errorf("rig %s: crew %s listed twice", rig, name)
+			}
+			seen[name] = true
+		}
+	}
+	return nil
+}
+
+// Manages reports whether the roster governs a rig.
+func (r *Roster) Manages(rig string) bool {
+	if r == nil {
+		return false
+	}
+	_, ok := r.Rigs[rig]
+	return ok
+}
+
+// Allows reports whether an agent may run: true for agents the roster
+// doesn't govern, otherwise whether it is declared.
+func (r *Roster) Allows(id *session.AgentIdentity) bool {
+	if !r.Manages(id.Rig) {
+		return true
+	}
+	rr := r.Rigs[id.Rig]
+	switch id.Role {
+	case session.RoleWitness:
+		return rr.Witness
+	case session.RoleRefinery:
+		return rr.Refinery
+	case session.RoleCrew:
+		for _, name := range rr.Crew {
+			if name == id.Name {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// Declared returns every declared agent, ordered by address.
+func (r *Roster) Declared() []*session.AgentIdentity {
+	if r == nil {
+		return nil
+	}
+	var out []*session.AgentIdentity
+	for rig, rr := range r.Rigs {
+		prefix := session.PrefixFor(rig)
+		if rr.Witness {
+			out = append(out, &session.AgentIdentity{Role: session.RoleWitness, Rig: rig, Prefix: prefix})
+		}
+		if rr.Refinery {
+			out = append(out, &session.AgentIdentity{Role: session.RoleRefinery, Rig: rig, Prefix: prefix})
+		}
+		for _, name := range rr.Crew {
+			out = append(out, &session.AgentIdentity{Role: session.RoleCrew, Rig: rig, Name: name, Prefix: prefix})
+		}
+	}
+	sortByAddress(out)
+	return out
+}
+
+// isRosterRole reports whether a role is one a roster declares.
+func isRosterRole(role session.Role) bool {
+	return role == session.RoleWitness || role == session.RoleRefinery || role == session.RoleCrew
+}
+
+// Agents parses tmux session names into the agents a roster can declare,
+// skipping town-level agents, polecats, and sessions that aren't Gas Town's.
+func Agents(sessionNames []string) []*session.AgentIdentity {
+	var out []*session.AgentIdentity
+	for _, name := range sessionNames {
+		id, err := session.ParseSessionName(name)
+		if err != nil || !isRosterRole(id.Role) {
+			continue
+		}
+		out = append(out, id)
+	}
+	sortByAddress(out)
+	return out
+}
+
+// Drift is the difference between the roster and the running agents.
+type Drift struct {
+	Missing []*session.AgentIdentity // Declared but not running
+	Extra   []*session.AgentIdentity // Running in a managed rig but not declared
+}
+
+// Clean reports whether the running agents match the roster.
+func (d Drift) Clean() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0
+}
+
+// Diff compares the roster with the running agents (see Agents). Agents in
+// rigs the roster doesn't manage are ignored.
+func (r *Roster) Diff(running []*session.AgentIdentity) Drift {
+	var d Drift
+	up := map[string]bool{}
+	for _, id := range running {
+		if !isRosterRole(id.Role) {
+			continue
+		}
+		up[id.Address()] = true
+		if !r.Allows(id) {
+			d.Extra = append(d.Extra, id)
+		}
+	}
+	for _, id := range r.Declared() {
+		if !up[id.Address()] {
+			d.Missing = append(d.Missing, id)
+		}
+	}
+	sortByAddress(d.Extra)
+	return d
+}
+
+func sortByAddress(ids []*session.AgentIdentity) {
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Address() < ids[j].Address() })
+}
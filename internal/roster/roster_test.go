@@ -0,0 +1,94 @@
+package roster
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func addresses(ids []*session.AgentIdentity) string {
+	var out []string
+	for _, id := range ids {
+		out = append(out, id.Address())
+	}
+	return strings.Join(out, " ")
+}
+
+func TestDiff(t *testing.T) {
+	r := &Roster{Rigs: map[string]RigRoster{
+		"gastown": {Witness: true, Refinery: true, Crew: []string{"max"}},
+		"beads":   {Witness: true},
+	}}
+	running := []*session.AgentIdentity{
+		{Role: session.RoleWitness, Rig: "gastown"},
+		{Role: session.RoleCrew, Rig: "gastown", Name: "joe"},      // Undeclared crew
+		{Role: session.RoleRefinery, Rig: "beads"},                 // Undeclared refinery
+		{Role: session.RoleWitness, Rig: "wyvern"},                 // Unmanaged rig
+		{Role: session.RolePolecat, Rig: "gastown", Name: "Toast"}, // Polecats aren't rostered
+		{Role: session.RoleMayor},
+	}
+	d := r.Diff(running)
+	if got, want := addresses(d.Missing), "beads/witness gastown/crew/max gastown/refinery"; got != want {
+		t.Errorf("Missing = %q, want %q", got, want)
+	}
+	if got, want := addresses(d.Extra), "beads/refinery gastown/crew/joe"; got != want {
+		t.Errorf("Extra = %q, want %q", got, want)
+	}
+	if d.Clean() {
+		t.Error("drift should not be clean")
+	}
+}
+
+func TestAgentsSkipsNonRosterSessions(t *testing.T) {
+	reg := session.NewPrefixRegistry()
+	reg.Register("gt", "gastown")
+	old := session.DefaultRegistry()
+	session.SetDefaultRegistry(reg)
+	t.Cleanup(func() { session.SetDefaultRegistry(old) })
+
+	got := Agents([]string{"hq-mayor", "hq-deputy", "not a session", "gt-witness", "gt-crew-max"})
+	for _, id := range got {
+		if id.Role != session.RoleWitness && id.Role != session.RoleCrew {
+			t.Errorf("unexpected agent %s", id.Address())
+		}
+	}
+	if len(got) != 2 {
+		t.Errorf("Agents() = %d agents, want 2", len(got))
+	}
+}
+
+func TestLoadSaveAndValidate(t *testing.T) {
+	townRoot := t.TempDir()
+	if r, err := Load(townRoot); err != nil || r != nil {
+		t.Fatalf("Load() without a roster = %v, %v", r, err)
+	}
+
+	r := &Roster{Rigs: map[string]RigRoster{
+		"gastown": {Witness: true, Crew: []string{"joe", "max"}},
+		"beads":   {},
+	}}
+	if err := Save(townRoot, r); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := addresses(loaded.Declared()), "gastown/crew/joe gastown/crew/max gastown/witness"; got != want {
+		t.Errorf("Declared() = %q, want %q", got, want)
+	}
+	if !loaded.Manages("beads") || loaded.Manages("wyvern") {
+		t.Error("a rig declaring no agents should still be managed")
+	}
+
+	bad := `{"type":"roster","version":1,"rigs":{"gastown":{"crew":["max","max"]}}}`
+	if err := os.WriteFile(filepath.Join(townRoot, "mayor", "roster.json"), []byte(bad), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(townRoot); err == nil || !strings.Contains(err.Error(), "listed twice") {
+		t.Errorf("Load() duplicate crew error = %v", err)
+	}
+}
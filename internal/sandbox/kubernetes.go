@@ -0,0 +1,508 @@
+package sandbox
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Kubernetes runner.
+//
+// The tmux pane for a Kubernetes-backed session submits a Job whose single
+// container runs the agent's startup command with a TTY, waits for the pod,
+// streams the pod log into the rig's session log directory, and attaches to
+// the pod so nudges sent with tmux send-keys still reach the agent. When the
+// pane exits for any reason, an EXIT trap runs gt job-finish, which records
+// the Job's final state, deletes the Job, and exits with a status that
+// reflects it, so the normal pane-died handling (crash log, witness
+// notification, restart tracking) sees whether the Job succeeded.
+
+// DefaultKubernetesNamespace is used when no namespace is configured.
+const DefaultKubernetesNamespace = "default"
+
+// defaultJobTTL is how long finished Jobs are kept when unconfigured.
+const defaultJobTTL = time.Hour
+
+// podReadyTimeout bounds how long the pane waits for the Job's pod.
+const podReadyTimeout = 5 * time.Minute
+
+// podPollInterval is how often the pane checks whether the Job controller
+// has created the pod yet.
+const podPollInterval = 2 * time.Second
+
+// ErrNoKubernetesImage is returned when a Kubernetes config does not name an image.
+var ErrNoKubernetesImage = errors.New("kubernetes: image is required")
+
+// KubernetesOptions describes the session being submitted as a Job.
+type KubernetesOptions struct {
+	// Name is the session name; the Job name is derived from it.
+	Name string
+
+	// RigPath is the rig directory (mounted via hostPath when configured).
+	RigPath string
+
+	// WorkDir is the working directory inside the container.
+	WorkDir string
+}
+
+// Job is the subset of the batch/v1 Job schema that gastown emits.
+// Defined locally so the runner can shell out to kubectl without pulling in
+// client-go.
+type Job struct {
+	APIVersion string     `json:"apiVersion"`
+	Kind       string     `json:"kind"`
+	Metadata   ObjectMeta `json:"metadata"`
+	Spec       JobSpec    `json:"spec"`
+}
+
+// ObjectMeta is the subset of Kubernetes object metadata used for Jobs.
+type ObjectMeta struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// JobSpec is the subset of the Job spec used by the runner.
+type JobSpec struct {
+	BackoffLimit            int             `json:"backoffLimit"`
+	ActiveDeadlineSeconds   *int64          `json:"activeDeadlineSeconds,omitempty"`
+	TTLSecondsAfterFinished *int32          `json:"ttlSecondsAfterFinished,omitempty"`
+	Template                PodTemplateSpec `json:"template"`
+}
+
+// PodTemplateSpec wraps the pod spec for a Job.
+type PodTemplateSpec struct {
+	Metadata ObjectMeta `json:"metadata"`
+	Spec     PodSpec    `json:"spec"`
+}
+
+// PodSpec is the subset of the pod spec used by the runner.
+type PodSpec struct {
+	RestartPolicy      string      `json:"restartPolicy"`
+	ServiceAccountName string      `json:"serviceAccountName,omitempty"`
+	Containers         []Container `json:"containers"`
+	Volumes            []Volume    `json:"volumes,omitempty"`
+}
+
+// Container is the subset of the container spec used by the runner.
+type Container struct {
+	Name         string                `json:"name"`
+	Image        string                `json:"image"`
+	Command      []string              `json:"command"`
+	WorkingDir   string                `json:"workingDir,omitempty"`
+	Stdin        bool                  `json:"stdin"`
+	TTY          bool                  `json:"tty"`
+	EnvFrom      []EnvFromSource       `json:"envFrom,omitempty"`
+	Resources    *ResourceRequirements `json:"resources,omitempty"`
+	VolumeMounts []VolumeMount         `json:"volumeMounts,omitempty"`
+}
+
+// EnvFromSource exposes all keys of a Secret as environment variables.
+type EnvFromSource struct {
+	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// LocalObjectReference names an object in the same namespace.
+type LocalObjectReference struct {
+	Name string `json:"name"`
+}
+
+// ResourceRequirements holds container resource requests and limits.
+type ResourceRequirements struct {
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
+}
+
+// Volume is a hostPath volume.
+type Volume struct {
+	Name     string          `json:"name"`
+	HostPath *HostPathSource `json:"hostPath,omitempty"`
+}
+
+// HostPathSource points a volume at a node directory.
+type HostPathSource struct {
+	Path string `json:"path"`
+	Type string `json:"type,omitempty"`
+}
+
+// VolumeMount mounts a volume into a container.
+type VolumeMount struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+}
+
+// JobState is the terminal (or pending) state of a submitted Job.
+type JobState string
+
+const (
+	JobPending   JobState = "pending"
+	JobSucceeded JobState = "succeeded"
+	JobFailed    JobState = "failed"
+)
+
+// JobRun records the Job behind one run of a Kubernetes session, so gt
+// job-finish and session stop can find it without the runtime config.
+type JobRun struct {
+	Job       string   `json:"job"`
+	Namespace string   `json:"namespace"`
+	Context   string   `json:"context,omitempty"`
+	State     JobState `json:"state,omitempty"` // Set once the run has finished
+}
+
+// Namespace returns the configured namespace, defaulting to "default".
+func Namespace(cfg *config.RuntimeKubernetesConfig) string {
+	if cfg == nil || cfg.Namespace == "" {
+		return DefaultKubernetesNamespace
+	}
+	return cfg.Namespace
+}
+
+// ValidateKubernetes checks that a Kubernetes runner config is well-formed.
+func ValidateKubernetes(cfg *config.RuntimeKubernetesConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if strings.TrimSpace(cfg.Image) == "" {
+		return ErrNoKubernetesImage
+	}
+	if cfg.ActiveDeadline != "" {
+		if d, err := time.ParseDuration(cfg.ActiveDeadline); err != nil || d <= 0 {
+			return fmt.Errorf("kubernetes: invalid active_deadline %q", cfg.ActiveDeadline)
+		}
+	}
+	if cfg.TTLAfterFinished != "" {
+		if d, err := time.ParseDuration(cfg.TTLAfterFinished); err != nil || d < 0 {
+			return fmt.Errorf("kubernetes: invalid ttl_after_finished %q", cfg.TTLAfterFinished)
+		}
+	}
+	for _, s := range cfg.Secrets {
+		if !dnsLabel.MatchString(s) {
+			return fmt.Errorf("kubernetes: invalid secret name %q", s)
+		}
+	}
+	return nil
+}
+
+// CheckKubectl verifies that kubectl is on PATH.
+func CheckKubectl() error {
+	if _, err := exec.LookPath("kubectl"); err != nil {
+		return fmt.Errorf("kubernetes: kubectl not found in PATH: %w", err)
+	}
+	return nil
+}
+
+var (
+	dnsLabel     = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+	nonDNSLabel  = regexp.MustCompile(`[^a-z0-9-]+`)
+	dashRuns     = regexp.MustCompile(`-{2,}`)
+	maxJobNameLn = 63
+)
+
+// JobName derives a DNS-1123 compliant Job name from a session name.
+func JobName(session string) string {
+	name := strings.ToLower(session)
+	name = nonDNSLabel.ReplaceAllString(name, "-")
+	name = dashRuns.ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-")
+	if len(name) > maxJobNameLn {
+		name = strings.TrimRight(name[:maxJobNameLn], "-")
+	}
+	if name == "" {
+		name = "gt-agent"
+	}
+	return name
+}
+
+// jobSuffixLen is the length of the random suffix that makes Job names unique.
+const jobSuffixLen = 6
+
+// uniqueJobName derives a Job name for one run of a session: JobName plus a
+// random suffix. A finished Job lingers for its TTL, so a restarted session
+// can't reuse the name of its last run.
+func uniqueJobName(session string) string {
+	base := JobName(session)
+	if max := maxJobNameLn - jobSuffixLen - 1; len(base) > max {
+		base = strings.TrimRight(base[:max], "-")
+	}
+	b := make([]byte, jobSuffixLen/2)
+	_, _ = rand.Read(b)
+	return base + "-" + hex.EncodeToString(b)
+}
+
+// BuildJob returns the Job manifest that runs shellCommand for a session.
+func BuildJob(cfg *config.RuntimeKubernetesConfig, opts KubernetesOptions, shellCommand string) (*Job, error) {
+	if cfg == nil {
+		return nil, ErrNoKubernetesImage
+	}
+	if err := ValidateKubernetes(cfg); err != nil {
+		return nil, err
+	}
+
+	name := uniqueJobName(opts.Name)
+	labels := map[string]string{
+		"app.kubernetes.io/managed-by": "gastown",
+		"gastown.io/session":           JobName(opts.Name),
+	}
+
+	container := Container{
+		Name:       "agent",
+		Image:      cfg.Image,
+		Command:    []string{"sh", "-c", shellCommand},
+		WorkingDir: opts.WorkDir,
+		Stdin:      true,
+		TTY:        true,
+	}
+	for _, s := range cfg.Secrets {
+		container.EnvFrom = append(container.EnvFrom, EnvFromSource{SecretRef: &LocalObjectReference{Name: s}})
+	}
+	if cfg.CPU != "" || cfg.Memory != "" {
+		res := map[string]string{}
+		if cfg.CPU != "" {
+			res["cpu"] = cfg.CPU
+		}
+		if cfg.Memory != "" {
+			res["memory"] = cfg.Memory
+		}
+		container.Resources = &ResourceRequirements{Requests: res, Limits: res}
+	}
+
+	pod := PodSpec{
+		RestartPolicy:      "Never",
+		ServiceAccountName: cfg.ServiceAccount,
+	}
+	if cfg.HostPathRig && opts.RigPath != "" {
+		pod.Volumes = []Volume{{Name: "rig", HostPath: &HostPathSource{Path: opts.RigPath, Type: "Directory"}}}
+		container.VolumeMounts = []VolumeMount{{Name: "rig", MountPath: opts.RigPath}}
+	}
+	pod.Containers = []Container{container}
+
+	ttl := config.ParseDurationOrDefault(cfg.TTLAfterFinished, defaultJobTTL)
+	ttlSeconds := int32(ttl / time.Second)
+	spec := JobSpec{
+		// Agent sessions are not idempotent; never let Kubernetes retry them.
+		BackoffLimit:            0,
+		TTLSecondsAfterFinished: &ttlSeconds,
+		Template: PodTemplateSpec{
+			Metadata: ObjectMeta{Labels: labels},
+			Spec:     pod,
+		},
+	}
+	if cfg.ActiveDeadline != "" {
+		d, _ := time.ParseDuration(cfg.ActiveDeadline)
+		secs := int64(d / time.Second)
+		spec.ActiveDeadlineSeconds = &secs
+	}
+
+	return &Job{
+		APIVersion: "batch/v1",
+		Kind:       "Job",
+		Metadata: ObjectMeta{
+			Name:      name,
+			Namespace: Namespace(cfg),
+			Labels:    labels,
+		},
+		Spec: spec,
+	}, nil
+}
+
+// JobManifestPath returns where the manifest for a session's Job is written.
+func JobManifestPath(rigPath, session string) string {
+	return filepath.Join(rigPath, constants.DirRuntime, "k8s", JobName(session)+".json")
+}
+
+// JobRunPath returns where the JobRun for a session's current Job is kept.
+func JobRunPath(rigPath, session string) string {
+	return filepath.Join(rigPath, constants.DirRuntime, "k8s", JobName(session)+".run.json")
+}
+
+// SaveJobRun writes a JobRun, creating parent directories.
+func SaveJobRun(path string, r *JobRun) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating job run directory: %w", err)
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding job run: %w", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// LoadJobRun reads a JobRun written by SaveJobRun.
+func LoadJobRun(path string) (*JobRun, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil, err
+	}
+	var r JobRun
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("parsing job run %s: %w", path, err)
+	}
+	return &r, nil
+}
+
+// config returns the runtime config that addresses the run's cluster.
+func (r *JobRun) config() *config.RuntimeKubernetesConfig {
+	return &config.RuntimeKubernetesConfig{Context: r.Context, Namespace: r.Namespace}
+}
+
+// SessionLogPath returns the session log file that pod logs are streamed into.
+func SessionLogPath(rigPath, session string) string {
+	return filepath.Join(rigPath, constants.DirRuntime, "session-logs", session+".log")
+}
+
+// WriteJobManifest writes a Job manifest as JSON, creating parent directories.
+func WriteJobManifest(path string, job *Job) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating manifest directory: %w", err)
+	}
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding job manifest: %w", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// kubectlArgs returns kubectl arguments with context and namespace flags.
+func kubectlArgs(cfg *config.RuntimeKubernetesConfig, args ...string) []string {
+	out := []string{}
+	if cfg.Context != "" {
+		out = append(out, "--context", cfg.Context)
+	}
+	return append(append(out, "-n", Namespace(cfg)), args...)
+}
+
+// QueryJobState asks the cluster whether a Job has finished.
+func QueryJobState(cfg *config.RuntimeKubernetesConfig, job string) (JobState, error) {
+	out, err := exec.Command("kubectl", kubectlArgs(cfg, "get", "job", job,
+		"-o", "jsonpath={.status.succeeded}/{.status.failed}")...).Output()
+	if err != nil {
+		return "", fmt.Errorf("kubectl get job: %w", err)
+	}
+	return parseJobState(string(out)), nil
+}
+
+// parseJobState interprets "<succeeded>/<failed>" counts from kubectl.
+func parseJobState(s string) JobState {
+	succeeded, failed, _ := strings.Cut(strings.TrimSpace(s), "/")
+	switch {
+	case succeeded != "" && succeeded != "0":
+		return JobSucceeded
+	case failed != "" && failed != "0":
+		return JobFailed
+	default:
+		return JobPending
+	}
+}
+
+// DeleteJob deletes a Job and, through it, its pod. It does not wait for
+// the pod to terminate, and a Job that is already gone is not an error.
+func DeleteJob(cfg *config.RuntimeKubernetesConfig, job string) error {
+	out, err := exec.Command("kubectl", kubectlArgs(cfg, "delete", "job", job,
+		"--wait=false", "--ignore-not-found")...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("kubectl delete job %s: %w: %s", job, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// FinishJobRun records the final state of the run at path and deletes its
+// Job. A Job still running (the pane was killed or the attach dropped) is
+// left pending and deleted all the same.
+func FinishJobRun(path string) (*JobRun, error) {
+	r, err := LoadJobRun(path)
+	if err != nil {
+		return nil, err
+	}
+	state, err := QueryJobState(r.config(), r.Job)
+	if err != nil {
+		state = JobPending
+	}
+	r.State = state
+	if err := SaveJobRun(path, r); err != nil {
+		return r, err
+	}
+	return r, DeleteJob(r.config(), r.Job)
+}
+
+// StopJobRun deletes the Job of the session's current run, if any.
+func StopJobRun(rigPath, session string) error {
+	r, err := LoadJobRun(JobRunPath(rigPath, session))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return DeleteJob(r.config(), r.Job)
+}
+
+// kubectlPrefix returns the kubectl invocation with context and namespace flags.
+func kubectlPrefix(cfg *config.RuntimeKubernetesConfig) string {
+	parts := []string{"kubectl"}
+	if cfg.Context != "" {
+		parts = append(parts, "--context", config.ShellQuote(cfg.Context))
+	}
+	parts = append(parts, "-n", config.ShellQuote(Namespace(cfg)))
+	return strings.Join(parts, " ")
+}
+
+// KubernetesPaneCommand returns the shell command a tmux pane runs to submit
+// the Job described by manifestPath, stream its log to logPath, and attach.
+// However the pane exits, gt job-finish records the run at runPath and
+// deletes the Job, and the pane exits with the Job's status.
+func KubernetesPaneCommand(cfg *config.RuntimeKubernetesConfig, jobName, manifestPath, runPath, logPath string) string {
+	k := kubectlPrefix(cfg)
+	job := config.ShellQuote("job/" + jobName)
+	selector := config.ShellQuote("job-name=" + jobName)
+	// kubectl wait fails at once with "no matching resources" until the Job
+	// controller has created the pod, so poll for the pod first.
+	polls := int(podReadyTimeout / podPollInterval)
+	waitForPod := fmt.Sprintf(`{ i=0; until [ -n "$(%s get pod -l %s -o name 2>/dev/null)" ]; do i=$((i+1)); if [ $i -ge %d ]; then echo "timed out waiting for the pod of %s" >&2; exit 1; fi; sleep %d; done; }`,
+		k, selector, polls, jobName, int(podPollInterval/time.Second))
+	steps := []string{
+		fmt.Sprintf("mkdir -p %s", config.ShellQuote(filepath.Dir(logPath))),
+		fmt.Sprintf("%s apply -f %s", k, config.ShellQuote(manifestPath)),
+		waitForPod,
+		fmt.Sprintf("%s wait --for=condition=Ready pod -l %s --timeout=%ds", k, selector, int(podReadyTimeout/time.Second)),
+		fmt.Sprintf("{ %s logs -f --timestamps %s >> %s 2>&1 & }", k, job, config.ShellQuote(logPath)),
+		fmt.Sprintf("%s attach -it %s", k, job),
+	}
+	// Killing the tmux session signals the pane shell; turning HUP and
+	// TERM into exits makes every shell run the EXIT trap.
+	traps := []string{
+		fmt.Sprintf("trap %s EXIT", config.ShellQuote(fmt.Sprintf("gt job-finish --exit-code $? %s; exit $?", config.ShellQuote(runPath)))),
+		"trap 'exit 129' HUP",
+		"trap 'exit 143' TERM",
+	}
+	return strings.Join(traps, "; ") + "; " + strings.Join(steps, " && ")
+}
+
+// PrepareKubernetesSession writes the Job manifest for a session and returns
+// the pane command that runs it.
+func PrepareKubernetesSession(cfg *config.RuntimeKubernetesConfig, opts KubernetesOptions, shellCommand string) (string, error) {
+	job, err := BuildJob(cfg, opts, shellCommand)
+	if err != nil {
+		return "", err
+	}
+	manifestPath := JobManifestPath(opts.RigPath, opts.Name)
+	if err := WriteJobManifest(manifestPath, job); err != nil {
+		return "", err
+	}
+	runPath := JobRunPath(opts.RigPath, opts.Name)
+	if err := SaveJobRun(runPath, &JobRun{Job: job.Metadata.Name, Namespace: Namespace(cfg), Context: cfg.Context}); err != nil {
+		return "", err
+	}
+	logPath := SessionLogPath(opts.RigPath, opts.Name)
+	return KubernetesPaneCommand(cfg, job.Metadata.Name, manifestPath, runPath, logPath), nil
+}
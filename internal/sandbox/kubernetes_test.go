@@ -0,0 +1,207 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestValidateKubernetes(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *config.RuntimeKubernetesConfig
+		wantErr bool
+	}{
+		{"nil config", nil, false},
+		{"minimal", &config.RuntimeKubernetesConfig{Image: "img"}, false},
+		{"missing image", &config.RuntimeKubernetesConfig{}, true},
+		{"bad deadline", &config.RuntimeKubernetesConfig{Image: "img", ActiveDeadline: "soon"}, true},
+		{"zero deadline", &config.RuntimeKubernetesConfig{Image: "img", ActiveDeadline: "0s"}, true},
+		{"bad ttl", &config.RuntimeKubernetesConfig{Image: "img", TTLAfterFinished: "x"}, true},
+		{"bad secret", &config.RuntimeKubernetesConfig{Image: "img", Secrets: []string{"Bad_Name"}}, true},
+		{"full", &config.RuntimeKubernetesConfig{Image: "img", ActiveDeadline: "4h", TTLAfterFinished: "10m", Secrets: []string{"anthropic-key"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKubernetes(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateKubernetes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJobName(t *testing.T) {
+	tests := map[string]string{
+		"gt-gastown-toast":             "gt-gastown-toast",
+		"GT_Rig.Polecat":               "gt-rig-polecat",
+		"--weird__name--":              "weird-name",
+		"":                             "gt-agent",
+		strings.Repeat("a", 70):        strings.Repeat("a", 63),
+		strings.Repeat("a", 62) + "-b": strings.Repeat("a", 62),
+	}
+	for in, want := range tests {
+		if got := JobName(in); got != want {
+			t.Errorf("JobName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestBuildJob(t *testing.T) {
+	cfg := &config.RuntimeKubernetesConfig{
+		Namespace:      "agents",
+		Image:          "gt-agent:latest",
+		CPU:            "2",
+		Memory:         "4Gi",
+		Secrets:        []string{"anthropic-key"},
+		ServiceAccount: "gt-agent",
+		HostPathRig:    true,
+		ActiveDeadline: "2h",
+	}
+	job, err := BuildJob(cfg, KubernetesOptions{Name: "gt-rig-toast", RigPath: "/town/rig", WorkDir: "/town/rig/polecats/toast"}, "exec claude")
+	if err != nil {
+		t.Fatalf("BuildJob: %v", err)
+	}
+	if !strings.HasPrefix(job.Metadata.Name, "gt-rig-toast-") || job.Metadata.Namespace != "agents" {
+		t.Errorf("metadata = %+v", job.Metadata)
+	}
+	if job.Metadata.Labels["gastown.io/session"] != "gt-rig-toast" {
+		t.Errorf("labels = %v", job.Metadata.Labels)
+	}
+	// A restarted session must not reuse a Job still within its TTL.
+	again, _ := BuildJob(cfg, KubernetesOptions{Name: "gt-rig-toast"}, "exec claude")
+	if again.Metadata.Name == job.Metadata.Name {
+		t.Errorf("two runs share Job name %q", job.Metadata.Name)
+	}
+	long, _ := BuildJob(cfg, KubernetesOptions{Name: strings.Repeat("a", 70)}, "true")
+	if len(long.Metadata.Name) > 63 {
+		t.Errorf("Job name %q is longer than 63", long.Metadata.Name)
+	}
+	if job.Spec.BackoffLimit != 0 {
+		t.Errorf("BackoffLimit = %d, want 0", job.Spec.BackoffLimit)
+	}
+	if job.Spec.ActiveDeadlineSeconds == nil || *job.Spec.ActiveDeadlineSeconds != 7200 {
+		t.Errorf("ActiveDeadlineSeconds = %v, want 7200", job.Spec.ActiveDeadlineSeconds)
+	}
+	if job.Spec.TTLSecondsAfterFinished == nil || *job.Spec.TTLSecondsAfterFinished != 3600 {
+		t.Errorf("TTLSecondsAfterFinished = %v, want default 3600", job.Spec.TTLSecondsAfterFinished)
+	}
+
+	pod := job.Spec.Template.Spec
+	if pod.RestartPolicy != "Never" || pod.ServiceAccountName != "gt-agent" {
+		t.Errorf("pod spec = %+v", pod)
+	}
+	if len(pod.Volumes) != 1 || pod.Volumes[0].HostPath.Path != "/town/rig" {
+		t.Errorf("volumes = %+v, want hostPath /town/rig", pod.Volumes)
+	}
+	c := pod.Containers[0]
+	if !c.Stdin || !c.TTY {
+		t.Error("container must have stdin and tty for attach")
+	}
+	if strings.Join(c.Command, " ") != "sh -c exec claude" {
+		t.Errorf("command = %v", c.Command)
+	}
+	if c.WorkingDir != "/town/rig/polecats/toast" {
+		t.Errorf("WorkingDir = %q", c.WorkingDir)
+	}
+	if len(c.EnvFrom) != 1 || c.EnvFrom[0].SecretRef.Name != "anthropic-key" {
+		t.Errorf("envFrom = %+v", c.EnvFrom)
+	}
+	if c.Resources == nil || c.Resources.Limits["memory"] != "4Gi" || c.Resources.Requests["cpu"] != "2" {
+		t.Errorf("resources = %+v", c.Resources)
+	}
+}
+
+func TestBuildJob_NoHostPath(t *testing.T) {
+	job, err := BuildJob(&config.RuntimeKubernetesConfig{Image: "img"}, KubernetesOptions{Name: "s", RigPath: "/town/rig"}, "true")
+	if err != nil {
+		t.Fatalf("BuildJob: %v", err)
+	}
+	if job.Metadata.Namespace != DefaultKubernetesNamespace {
+		t.Errorf("namespace = %q, want default", job.Metadata.Namespace)
+	}
+	if len(job.Spec.Template.Spec.Volumes) != 0 {
+		t.Error("expected no volumes without host_path_rig")
+	}
+	if job.Spec.ActiveDeadlineSeconds != nil {
+		t.Error("expected no active deadline")
+	}
+}
+
+func TestBuildJob_InvalidConfig(t *testing.T) {
+	_, err := BuildJob(&config.RuntimeKubernetesConfig{}, KubernetesOptions{}, "true")
+	if !errors.Is(err, ErrNoKubernetesImage) {
+		t.Errorf("BuildJob() error = %v, want ErrNoKubernetesImage", err)
+	}
+}
+
+func TestPrepareKubernetesSession(t *testing.T) {
+	rigPath := t.TempDir()
+	cfg := &config.RuntimeKubernetesConfig{Context: "kind-gt", Image: "img"}
+	cmd, err := PrepareKubernetesSession(cfg, KubernetesOptions{Name: "gt-rig-toast", RigPath: rigPath}, "exec claude")
+	if err != nil {
+		t.Fatalf("PrepareKubernetesSession: %v", err)
+	}
+
+	manifest := filepath.Join(rigPath, ".runtime", "k8s", "gt-rig-toast.json")
+	data, err := os.ReadFile(manifest)
+	if err != nil {
+		t.Fatalf("manifest not written: %v", err)
+	}
+	var job Job
+	if err := json.Unmarshal(data, &job); err != nil {
+		t.Fatalf("manifest is not valid JSON: %v", err)
+	}
+	if job.Kind != "Job" || job.APIVersion != "batch/v1" {
+		t.Errorf("manifest kind = %s/%s", job.APIVersion, job.Kind)
+	}
+
+	name := job.Metadata.Name
+	runPath := JobRunPath(rigPath, "gt-rig-toast")
+	run, err := LoadJobRun(runPath)
+	if err != nil {
+		t.Fatalf("job run not recorded: %v", err)
+	}
+	if run.Job != name || run.Namespace != "default" || run.Context != "kind-gt" || run.State != "" {
+		t.Errorf("job run = %+v", run)
+	}
+
+	for _, want := range []string{
+		"gt job-finish --exit-code $? " + runPath + "; exit $?",
+		"kubectl --context kind-gt -n default apply -f " + manifest,
+		"until [ -n \"$(kubectl --context kind-gt -n default get pod -l job-name=" + name + " -o name 2>/dev/null)\" ]",
+		"wait --for=condition=Ready pod -l job-name=" + name,
+		"logs -f --timestamps job/" + name + " >> " + SessionLogPath(rigPath, "gt-rig-toast"),
+		"attach -it job/" + name,
+	} {
+		if !strings.Contains(cmd, want) {
+			t.Errorf("pane command %q missing %q", cmd, want)
+		}
+	}
+}
+
+func TestParseJobState(t *testing.T) {
+	tests := map[string]JobState{
+		"/":     JobPending,
+		"0/0":   JobPending,
+		"1/":    JobSucceeded,
+		"/1":    JobFailed,
+		"0/1\n": JobFailed,
+	}
+	for in, want := range tests {
+		if got := parseJobState(in); got != want {
+			t.Errorf("parseJobState(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestStopJobRun_NoRun(t *testing.T) {
+	if err := StopJobRun(t.TempDir(), "gt-rig-toast"); err != nil {
+		t.Errorf("StopJobRun() without a recorded run = %v, want nil", err)
+	}
+}
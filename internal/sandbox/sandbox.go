@@ -0,0 +1,265 @@
+// Package sandbox runs agent sessions inside containers.
+//
+// A sandboxed session still lives in a tmux pane: the pane runs
+// "<engine> run ..." and the agent's normal startup command executes inside
+// the container. The town root is bind-mounted at its host path so GT_*
+// paths, worktrees, town config, and the beads directories resolve
+// identically on both sides, while the rest of the host filesystem stays out
+// of reach. The container uses the host network by default so gt and bd can
+// reach the town's Dolt server; the image must provide gt, bd, and the agent.
+//
+// The container is named after the session. It is removed before the
+// session starts, when the pane exits, and when the session is stopped, so
+// a killed pane never leaves it running or blocks the next start.
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Supported container engines.
+const (
+	EngineDocker = "docker"
+	EnginePodman = "podman"
+)
+
+// Supported network modes.
+const (
+	NetworkNone   = "none"
+	NetworkBridge = "bridge"
+	NetworkHost   = "host"
+)
+
+// ErrNoImage is returned when a sandbox config does not name an image.
+var ErrNoImage = errors.New("sandbox: image is required")
+
+// Options describes the session being wrapped.
+type Options struct {
+	// Name is the container name (usually the tmux session name).
+	Name string
+
+	// TownRoot is mounted read-write at the same path inside the container.
+	TownRoot string
+
+	// RigPath is mounted read-write at the same path inside the container
+	// when TownRoot is not set (the town mount already covers it).
+	RigPath string
+
+	// WorkDir is the working directory inside the container.
+	// Defaults to RigPath.
+	WorkDir string
+}
+
+// Engine returns the configured engine, defaulting to docker.
+func Engine(cfg *config.RuntimeSandboxConfig) string {
+	if cfg == nil || cfg.Engine == "" {
+		return EngineDocker
+	}
+	return cfg.Engine
+}
+
+// Network returns the configured network mode, defaulting to host.
+func Network(cfg *config.RuntimeSandboxConfig) string {
+	if cfg == nil || cfg.Network == "" {
+		return NetworkHost
+	}
+	return cfg.Network
+}
+
+// Validate checks that a sandbox config is well-formed.
+func Validate(cfg *config.RuntimeSandboxConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	switch Engine(cfg) {
+	case EngineDocker, EnginePodman:
+	default:
+		return fmt.Errorf("sandbox: unsupported engine %q (want %s or %s)", cfg.Engine, EngineDocker, EnginePodman)
+	}
+	if strings.TrimSpace(cfg.Image) == "" {
+		return ErrNoImage
+	}
+	switch Network(cfg) {
+	case NetworkNone, NetworkBridge, NetworkHost:
+	default:
+		return fmt.Errorf("sandbox: unsupported network mode %q", cfg.Network)
+	}
+	if cfg.PidsLimit < 0 {
+		return fmt.Errorf("sandbox: pids_limit must be >= 0, got %d", cfg.PidsLimit)
+	}
+	for _, m := range cfg.Mounts {
+		if err := validateMount(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateMount checks a "host:container[:ro]" bind mount spec.
+func validateMount(spec string) error {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("sandbox: invalid mount %q (want host:container[:ro])", spec)
+	}
+	if len(parts) == 3 && parts[2] != "ro" && parts[2] != "rw" {
+		return fmt.Errorf("sandbox: invalid mount mode %q in %q", parts[2], spec)
+	}
+	return nil
+}
+
+// CheckEngine verifies that the configured engine binary is on PATH.
+func CheckEngine(cfg *config.RuntimeSandboxConfig) error {
+	engine := Engine(cfg)
+	if _, err := exec.LookPath(engine); err != nil {
+		return fmt.Errorf("sandbox: %s not found in PATH: %w", engine, err)
+	}
+	return nil
+}
+
+// RunArgs returns the "<engine> run" argument vector (excluding the engine
+// itself) that executes shellCommand inside the container.
+func RunArgs(cfg *config.RuntimeSandboxConfig, opts Options, shellCommand string) ([]string, error) {
+	if err := Validate(cfg); err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, ErrNoImage
+	}
+
+	workDir := opts.WorkDir
+	if workDir == "" {
+		workDir = opts.RigPath
+	}
+
+	args := []string{"run", "--rm", "-it", "--init"}
+	if opts.Name != "" {
+		args = append(args, "--name", opts.Name)
+	}
+	args = append(args, "--network", Network(cfg))
+	if cfg.CPUs != "" {
+		args = append(args, "--cpus", cfg.CPUs)
+	}
+	if cfg.Memory != "" {
+		args = append(args, "--memory", cfg.Memory)
+	}
+	if cfg.PidsLimit > 0 {
+		args = append(args, "--pids-limit", fmt.Sprintf("%d", cfg.PidsLimit))
+	}
+	if cfg.ReadOnlyRoot {
+		// A read-only root still needs scratch space for tool caches.
+		args = append(args, "--read-only", "--tmpfs", "/tmp")
+	}
+	if opts.TownRoot != "" {
+		args = append(args, "-v", opts.TownRoot+":"+opts.TownRoot)
+	} else if opts.RigPath != "" {
+		args = append(args, "-v", opts.RigPath+":"+opts.RigPath)
+	}
+	for _, m := range cfg.Mounts {
+		args = append(args, "-v", m)
+	}
+	if workDir != "" {
+		args = append(args, "-w", workDir)
+	}
+	args = append(args, cfg.ExtraArgs...)
+	args = append(args, cfg.Image, "sh", "-c", shellCommand)
+	return args, nil
+}
+
+// WrapCommand returns a shell command line that runs shellCommand inside the
+// container described by cfg. The result is suitable for passing to
+// tmux.NewSessionWithCommand in place of the unwrapped startup command.
+//
+// A named container left over from an earlier run is removed first, and an
+// EXIT trap removes it again however the pane exits: "--rm" alone does not
+// help when the engine CLI is killed before the container stops.
+func WrapCommand(cfg *config.RuntimeSandboxConfig, opts Options, shellCommand string) (string, error) {
+	args, err := RunArgs(cfg, opts, shellCommand)
+	if err != nil {
+		return "", err
+	}
+	quoted := make([]string, 0, len(args)+1)
+	quoted = append(quoted, Engine(cfg))
+	for _, a := range args {
+		quoted = append(quoted, config.ShellQuote(a))
+	}
+	run := strings.Join(quoted, " ")
+	if opts.Name == "" {
+		return "exec " + run, nil
+	}
+	rm := fmt.Sprintf("%s rm -f %s >/dev/null 2>&1", Engine(cfg), config.ShellQuote(opts.Name))
+	return strings.Join([]string{
+		rm,
+		"trap " + config.ShellQuote(rm) + " EXIT",
+		// Killing the tmux session signals the pane shell; turning HUP and
+		// TERM into exits makes every shell run the EXIT trap.
+		"trap 'exit 129' HUP",
+		"trap 'exit 143' TERM",
+		run,
+	}, "; "), nil
+}
+
+// ContainerRun records the container behind a sandboxed session, so session
+// stop can remove it without the runtime config.
+type ContainerRun struct {
+	Engine string `json:"engine"`
+	Name   string `json:"name"`
+}
+
+// ContainerRunPath returns where the ContainerRun for a session is kept.
+func ContainerRunPath(rigPath, session string) string {
+	return filepath.Join(rigPath, constants.DirRuntime, "sandbox", session+".json")
+}
+
+// PrepareSession records the session's container and returns the pane
+// command that runs shellCommand inside it.
+func PrepareSession(cfg *config.RuntimeSandboxConfig, opts Options, shellCommand string) (string, error) {
+	command, err := WrapCommand(cfg, opts, shellCommand)
+	if err != nil {
+		return "", err
+	}
+	if opts.Name == "" || opts.RigPath == "" {
+		return command, nil
+	}
+	path := ContainerRunPath(opts.RigPath, opts.Name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("creating sandbox run directory: %w", err)
+	}
+	data, err := json.Marshal(ContainerRun{Engine: Engine(cfg), Name: opts.Name})
+	if err != nil {
+		return "", fmt.Errorf("encoding sandbox run: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("writing sandbox run: %w", err)
+	}
+	return command, nil
+}
+
+// StopContainerRun force-removes the container of a sandboxed session, if
+// the session has one. A container that is already gone is not an error.
+func StopContainerRun(rigPath, session string) error {
+	data, err := os.ReadFile(ContainerRunPath(rigPath, session)) //nolint:gosec // G304: path is constructed internally
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var r ContainerRun
+	if err := json.Unmarshal(data, &r); err != nil {
+		return fmt.Errorf("parsing sandbox run: %w", err)
+	}
+	out, err := exec.Command(r.Engine, "rm", "-f", r.Name).CombinedOutput() //nolint:gosec // G204: engine is docker or podman
+	if err != nil && !strings.Contains(strings.ToLower(string(out)), "no such container") {
+		return fmt.Errorf("%s rm -f %s: %w: %s", r.Engine, r.Name, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
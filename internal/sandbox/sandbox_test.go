@@ -0,0 +1,154 @@
+package sandbox
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *config.RuntimeSandboxConfig
+		wantErr bool
+	}{
+		{"nil config", nil, false},
+		{"minimal", &config.RuntimeSandboxConfig{Image: "gt-agent:latest"}, false},
+		{"podman", &config.RuntimeSandboxConfig{Engine: "podman", Image: "img"}, false},
+		{"missing image", &config.RuntimeSandboxConfig{}, true},
+		{"bad engine", &config.RuntimeSandboxConfig{Engine: "lxc", Image: "img"}, true},
+		{"bad network", &config.RuntimeSandboxConfig{Image: "img", Network: "overlay"}, true},
+		{"negative pids", &config.RuntimeSandboxConfig{Image: "img", PidsLimit: -1}, true},
+		{"bad mount", &config.RuntimeSandboxConfig{Image: "img", Mounts: []string{"/only-host"}}, true},
+		{"bad mount mode", &config.RuntimeSandboxConfig{Image: "img", Mounts: []string{"/a:/b:rx"}}, true},
+		{"ro mount", &config.RuntimeSandboxConfig{Image: "img", Mounts: []string{"/a:/b:ro"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRunArgs_Defaults(t *testing.T) {
+	cfg := &config.RuntimeSandboxConfig{Image: "gt-agent:latest"}
+	args, err := RunArgs(cfg, Options{Name: "gt-rig-toast", TownRoot: "/town", RigPath: "/town/rig"}, "exec claude")
+	if err != nil {
+		t.Fatalf("RunArgs: %v", err)
+	}
+	got := strings.Join(args, " ")
+	for _, want := range []string{
+		"run --rm -it --init",
+		"--name gt-rig-toast",
+		"--network host",
+		"-v /town:/town",
+		"-w /town/rig",
+		"gt-agent:latest sh -c exec claude",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("args %q missing %q", got, want)
+		}
+	}
+	for _, unwanted := range []string{"--cpus", "--memory", "--pids-limit", "--read-only", "-v /town/rig:"} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("args %q should not contain %q", got, unwanted)
+		}
+	}
+}
+
+func TestRunArgs_Limits(t *testing.T) {
+	cfg := &config.RuntimeSandboxConfig{
+		Image:        "img",
+		Network:      NetworkNone,
+		CPUs:         "2",
+		Memory:       "4g",
+		PidsLimit:    256,
+		ReadOnlyRoot: true,
+		Mounts:       []string{"/cache:/cache:ro"},
+		ExtraArgs:    []string{"--cap-drop=ALL"},
+	}
+	args, err := RunArgs(cfg, Options{RigPath: "/town/rig", WorkDir: "/town/rig/polecats/toast/rig"}, "true")
+	if err != nil {
+		t.Fatalf("RunArgs: %v", err)
+	}
+	got := strings.Join(args, " ")
+	for _, want := range []string{
+		"--network none",
+		"-v /town/rig:/town/rig",
+		"--cpus 2",
+		"--memory 4g",
+		"--pids-limit 256",
+		"--read-only --tmpfs /tmp",
+		"-v /cache:/cache:ro",
+		"-w /town/rig/polecats/toast/rig",
+		"--cap-drop=ALL img sh -c true",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("args %q missing %q", got, want)
+		}
+	}
+}
+
+func TestRunArgs_InvalidConfig(t *testing.T) {
+	_, err := RunArgs(&config.RuntimeSandboxConfig{}, Options{}, "true")
+	if !errors.Is(err, ErrNoImage) {
+		t.Errorf("RunArgs() error = %v, want ErrNoImage", err)
+	}
+}
+
+func TestWrapCommand_QuotesInnerCommand(t *testing.T) {
+	cfg := &config.RuntimeSandboxConfig{Engine: EnginePodman, Image: "img"}
+	inner := "export GT_RIG=rig && exec env GT_ROLE=polecat claude 'do the work'"
+	got, err := WrapCommand(cfg, Options{RigPath: "/town/rig"}, inner)
+	if err != nil {
+		t.Fatalf("WrapCommand: %v", err)
+	}
+	if !strings.HasPrefix(got, "exec podman run ") {
+		t.Errorf("WrapCommand() = %q, want exec podman run prefix", got)
+	}
+	if !strings.HasSuffix(got, "sh -c "+config.ShellQuote(inner)) {
+		t.Errorf("WrapCommand() = %q, want quoted inner command suffix", got)
+	}
+}
+
+func TestWrapCommand_RemovesNamedContainer(t *testing.T) {
+	cfg := &config.RuntimeSandboxConfig{Image: "img"}
+	got, err := WrapCommand(cfg, Options{Name: "gt-rig-toast", RigPath: "/town/rig"}, "true")
+	if err != nil {
+		t.Fatalf("WrapCommand: %v", err)
+	}
+	rm := "docker rm -f gt-rig-toast >/dev/null 2>&1"
+	if !strings.HasPrefix(got, rm+"; ") {
+		t.Errorf("WrapCommand() = %q, want leftover container removed first", got)
+	}
+	if !strings.Contains(got, "trap "+config.ShellQuote(rm)+" EXIT") {
+		t.Errorf("WrapCommand() = %q, want container removed on pane exit", got)
+	}
+	if strings.Contains(got, "exec docker") {
+		t.Errorf("WrapCommand() = %q: exec would drop the EXIT trap", got)
+	}
+}
+
+func TestPrepareSession_RecordsContainer(t *testing.T) {
+	rigPath := t.TempDir()
+	cfg := &config.RuntimeSandboxConfig{Engine: EnginePodman, Image: "img"}
+	if _, err := PrepareSession(cfg, Options{Name: "gt-rig-toast", RigPath: rigPath}, "true"); err != nil {
+		t.Fatalf("PrepareSession: %v", err)
+	}
+	data, err := os.ReadFile(ContainerRunPath(rigPath, "gt-rig-toast"))
+	if err != nil {
+		t.Fatalf("container run not recorded: %v", err)
+	}
+	if got := string(data); got != `{"engine":"podman","name":"gt-rig-toast"}` {
+		t.Errorf("container run = %s", got)
+	}
+	if err := StopContainerRun(t.TempDir(), "gt-rig-toast"); err != nil {
+		t.Errorf("StopContainerRun() without a recorded run = %v, want nil", err)
+	}
+}
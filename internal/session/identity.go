@@ -115,6 +115,8 @@ func ParseSessionNameWithRegistry(session string, registry *PrefixRegistry) (*Ag
 			return &AgentIdentity{Role: RoleMayor}, nil
 		case string(RoleDeacon):
 			return &AgentIdentity{Role: RoleDeacon}, nil
+		case "deputy":
+			return &AgentIdentity{Role: RoleMayor, Name: "deputy"}, nil
 		case "boot":
 			return &AgentIdentity{Role: RoleDeacon, Name: "boot"}, nil
 		case "overseer":
@@ -164,6 +166,9 @@ func ParseSessionNameWithRegistry(session string, registry *PrefixRegistry) (*Ag
 func (a *AgentIdentity) SessionName() string {
 	switch a.Role {
 	case RoleMayor:
+		if a.Name == "deputy" {
+			return DeputySessionName()
+		}
 		return MayorSessionName()
 	case RoleDeacon:
 		if a.Name == "boot" {
@@ -48,6 +48,12 @@ func TestParseSessionName(t *testing.T) {
 			wantRole: RoleDeacon,
 			wantName: "boot",
 		},
+		{
+			name:     "deputy",
+			session:  "hq-deputy",
+			wantRole: RoleMayor,
+			wantName: "deputy",
+		},
 
 		// Witness (new format: <prefix>-witness)
 		{
@@ -218,6 +224,11 @@ func TestAgentIdentity_SessionName(t *testing.T) {
 			identity: AgentIdentity{Role: RoleDeacon, Name: "boot"},
 			want:     "hq-boot",
 		},
+		{
+			name:     "deputy",
+			identity: AgentIdentity{Role: RoleMayor, Name: "deputy"},
+			want:     "hq-deputy",
+		},
 		{
 			name:     "witness",
 			identity: AgentIdentity{Role: RoleWitness, Rig: "gastown", Prefix: "gt"},
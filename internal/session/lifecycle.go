@@ -5,13 +5,16 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/cli"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/runtime"
+	"github.com/steveyegge/gastown/internal/sessionindex"
 	"github.com/steveyegge/gastown/internal/telemetry"
 	"github.com/steveyegge/gastown/internal/tmux"
 )
@@ -207,6 +210,11 @@ func StartSession(t *tmux.Tmux, cfg SessionConfig) (_ *StartResult, retErr error
 		_ = t.SetEnvironment(cfg.SessionID, k, cfg.ExtraEnv[k])
 	}
 
+	// Record rig sessions' output for gt grep-sessions.
+	if cfg.RigPath != "" {
+		_ = RecordOutput(t, cfg.SessionID, cfg.RigPath)
+	}
+
 	// 7. Apply theme.
 	if cfg.Theme != nil {
 		_ = t.ConfigureGasTownSession(cfg.SessionID, *cfg.Theme, cfg.RigName, cfg.AgentName, cfg.Role)
@@ -264,6 +272,17 @@ func StartSession(t *tmux.Tmux, cfg SessionConfig) (_ *StartResult, retErr error
 	return &StartResult{RuntimeConfig: runtimeConfig}, nil
 }
 
+// RecordOutput pipes a rig session's pane output into the rig's session
+// log, each line stamped with the time it was written.
+func RecordOutput(t *tmux.Tmux, sessionID, rigPath string) error {
+	dir := sessionindex.LogDir(rigPath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating session log dir: %w", err)
+	}
+	logPath := filepath.Join(dir, sessionID+".log")
+	return t.PipePane(sessionID, "exec "+cli.Name()+" record-session "+config.ShellQuote(logPath))
+}
+
 // StopSession stops a tmux session with optional graceful shutdown.
 //
 // If graceful is true, sends Ctrl-C first and waits for the session to exit
@@ -17,6 +17,13 @@ func MayorSessionName() string {
 	return HQPrefix + "mayor"
 }
 
+// DeputySessionName returns the session name for the Mayor's deputy, a
+// standby mayor that the daemon promotes when the Mayor's heartbeat lapses.
+// "hq-deputy" avoids tmux prefix-matching collisions with "hq-mayor".
+func DeputySessionName() string {
+	return HQPrefix + "deputy"
+}
+
 // DeaconSessionName returns the session name for the Deacon agent.
 // One deacon per machine - multi-town requires containers/VMs for isolation.
 func DeaconSessionName() string {
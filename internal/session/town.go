@@ -17,10 +17,12 @@ type TownSession struct {
 }
 
 // TownSessions returns the list of town-level sessions in shutdown order.
-// Order matters: Boot (Deacon's watchdog) must be stopped before Deacon,
-// otherwise Boot will try to restart Deacon.
+// Order matters: the Mayor's deputy must be stopped before the Mayor, and
+// Boot (Deacon's watchdog) before Deacon, otherwise each would take over
+// from or restart the session it watches.
 func TownSessions() []TownSession {
 	return []TownSession{
+		{"Deputy", DeputySessionName()},
 		{"Mayor", MayorSessionName()},
 		{"Boot", BootSessionName()},
 		{"Deacon", DeaconSessionName()},
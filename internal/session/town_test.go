@@ -7,12 +7,12 @@ import (
 func TestTownSessions(t *testing.T) {
 	sessions := TownSessions()
 
-	if len(sessions) != 3 {
-		t.Errorf("TownSessions() returned %d sessions, want 3", len(sessions))
+	if len(sessions) != 4 {
+		t.Errorf("TownSessions() returned %d sessions, want 4", len(sessions))
 	}
 
-	// Verify order is correct (Mayor, Boot, Deacon)
-	expectedOrder := []string{"Mayor", "Boot", "Deacon"}
+	// Verify order is correct (Deputy, Mayor, Boot, Deacon)
+	expectedOrder := []string{"Deputy", "Mayor", "Boot", "Deacon"}
 	for i, s := range sessions {
 		if s.Name != expectedOrder[i] {
 			t.Errorf("TownSessions()[%d].Name = %q, want %q", i, s.Name, expectedOrder[i])
@@ -77,17 +77,21 @@ func TestTownSession_CanBeCreated(t *testing.T) {
 }
 
 func TestTownSession_ShutdownOrder(t *testing.T) {
-	// Verify that shutdown order is Mayor -> Boot -> Deacon
-	// This is critical because Boot monitors Deacon
+	// Verify that shutdown order is Deputy -> Mayor -> Boot -> Deacon
+	// This is critical because the deputy takes over from the Mayor and
+	// Boot monitors Deacon
 	sessions := TownSessions()
 
-	if sessions[0].Name != "Mayor" {
-		t.Errorf("First session should be Mayor, got %q", sessions[0].Name)
+	if sessions[0].Name != "Deputy" {
+		t.Errorf("First session should be Deputy, got %q", sessions[0].Name)
 	}
-	if sessions[1].Name != "Boot" {
-		t.Errorf("Second session should be Boot, got %q", sessions[1].Name)
+	if sessions[1].Name != "Mayor" {
+		t.Errorf("Second session should be Mayor, got %q", sessions[1].Name)
 	}
-	if sessions[2].Name != "Deacon" {
-		t.Errorf("Third session should be Deacon, got %q", sessions[2].Name)
+	if sessions[2].Name != "Boot" {
+		t.Errorf("Third session should be Boot, got %q", sessions[2].Name)
+	}
+	if sessions[3].Name != "Deacon" {
+		t.Errorf("Fourth session should be Deacon, got %q", sessions[3].Name)
 	}
 }
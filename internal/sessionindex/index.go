@@ -0,0 +1,312 @@
+// Package sessionindex indexes recorded agent session output so it can be
+// searched without reading every byte of it.
+//
+// Session logs (<rig>/.runtime/session-logs/<session>.log) hold a rig's
+// tmux panes, recorded through pipe-pane (see Record), and the output of its
+// Kubernetes pods. Each line starts with the time it was written.
+//
+// Logs are split into chunks of about ChunkSize bytes, ending on line
+// boundaries. Each chunk gets a Bloom filter of the (ASCII case-folded)
+// trigrams it contains. A search only reads the chunks whose filters hold
+// every trigram of the pattern's literal text, so looking for an error
+// string touches a few chunks instead of gigabytes of logs.
+//
+// Indexes live in .runtime/session-index, one file per log, and are
+// brought up to date incrementally: appended output is indexed on the next
+// Update, and a log that shrank (rotated or truncated) is re-indexed.
+package sessionindex
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// ChunkSize is the size a chunk grows to before it ends at the next line.
+const ChunkSize = 64 << 10
+
+const (
+	bloomWords  = 512 // 32768 bits per chunk
+	bloomHashes = 4
+	indexExt    = ".idx"
+)
+
+// Recording is a session log on disk.
+type Recording struct {
+	Rig     string
+	Session string
+	Path    string
+}
+
+// LogDir returns a rig's session log directory.
+func LogDir(rigPath string) string {
+	return filepath.Join(rigPath, constants.DirRuntime, "session-logs")
+}
+
+// Dir returns the town's session index directory.
+func Dir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "session-index")
+}
+
+// Recordings lists the session logs of every rig in the town.
+func Recordings(townRoot string) ([]Recording, error) {
+	entries, err := os.ReadDir(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("reading town root: %w", err)
+	}
+	var out []Recording
+	for _, e := range entries {
+		if !e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		logs, _ := filepath.Glob(filepath.Join(LogDir(filepath.Join(townRoot, e.Name())), "*.log"))
+		for _, path := range logs {
+			out = append(out, Recording{
+				Rig:     e.Name(),
+				Session: strings.TrimSuffix(filepath.Base(path), ".log"),
+				Path:    path,
+			})
+		}
+	}
+	return out, nil
+}
+
+// fileIndex is the index of one session log.
+type fileIndex struct {
+	Path   string
+	Size   int64 // Bytes of the log covered by Chunks
+	Chunks []chunk
+}
+
+type chunk struct {
+	Offset int64
+	Length int
+	Line   int       // Number of the chunk's first line, from 1
+	Time   time.Time // When the chunk's first line was written; zero if unknown
+	Bloom  []uint64
+}
+
+// UpdateStats reports what Update did.
+type UpdateStats struct {
+	Logs    int   // Session logs in the index
+	Indexed int64 // Bytes read to bring the index up to date
+	Removed int   // Indexes dropped because their log is gone
+}
+
+// Update brings the index of every session log up to date.
+func Update(townRoot string) (UpdateStats, error) {
+	var stats UpdateStats
+	recs, err := Recordings(townRoot)
+	if err != nil {
+		return stats, err
+	}
+	live := map[string]bool{}
+	for _, rec := range recs {
+		path := indexPath(townRoot, rec)
+		live[path] = true
+		n, err := updateFile(path, rec.Path)
+		if err != nil {
+			return stats, fmt.Errorf("indexing %s: %w", rec.Path, err)
+		}
+		stats.Logs++
+		stats.Indexed += n
+	}
+
+	stale, _ := filepath.Glob(filepath.Join(Dir(townRoot), "*", "*"+indexExt))
+	for _, path := range stale {
+		if !live[path] && os.Remove(path) == nil {
+			stats.Removed++
+		}
+	}
+	return stats, nil
+}
+
+func indexPath(townRoot string, rec Recording) string {
+	return filepath.Join(Dir(townRoot), rec.Rig, rec.Session+indexExt)
+}
+
+// updateFile extends the index at idxPath to cover the log, returning the
+// number of bytes read. The last chunk is always re-indexed, since output
+// may have been appended to it.
+func updateFile(idxPath, logPath string) (int64, error) {
+	info, err := os.Stat(logPath)
+	if err != nil {
+		return 0, err
+	}
+	idx, err := loadIndex(idxPath)
+	if err != nil || idx.Path != logPath || info.Size() < idx.Size {
+		idx = &fileIndex{Path: logPath}
+	}
+	// Output past what an earlier Update saw was appended since then, so
+	// an unstamped chunk there is dated now. Unstamped output that was on
+	// disk before the first Update has no known time.
+	appendedFrom := int64(-1)
+	if len(idx.Chunks) > 0 {
+		appendedFrom = idx.Size
+	}
+	if info.Size() == idx.Size && len(idx.Chunks) > 0 {
+		return 0, nil
+	}
+
+	offset, line := int64(0), 1
+	var lastTime time.Time
+	if n := len(idx.Chunks); n > 0 {
+		last := idx.Chunks[n-1]
+		idx.Chunks = idx.Chunks[:n-1]
+		offset, line, lastTime = last.Offset, last.Line, last.Time
+	}
+
+	f, err := os.Open(logPath) //nolint:gosec // G304: path is from the town's session log dir
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	r := bufio.NewReaderSize(f, ChunkSize)
+	start := offset
+	now := time.Now()
+	for {
+		data, err := readChunk(r)
+		if len(data) > 0 {
+			written, ok := firstLineTime(data)
+			switch {
+			case ok:
+			case offset == start && !lastTime.IsZero():
+				written = lastTime
+			case appendedFrom >= 0 && offset >= appendedFrom:
+				written = now
+			}
+			idx.Chunks = append(idx.Chunks, chunk{
+				Offset: offset,
+				Length: len(data),
+				Line:   line,
+				Time:   written,
+				Bloom:  bloomOf(data),
+			})
+			offset += int64(len(data))
+			line += bytes.Count(data, []byte("\n"))
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	idx.Size = offset
+	if err := saveIndex(idxPath, idx); err != nil {
+		return 0, err
+	}
+	return offset - start, nil
+}
+
+// readChunk reads about ChunkSize bytes, through the end of a line.
+func readChunk(r *bufio.Reader) ([]byte, error) {
+	buf := make([]byte, ChunkSize)
+	n, err := io.ReadFull(r, buf)
+	buf = buf[:n]
+	if errors.Is(err, io.ErrUnexpectedEOF) || err == io.EOF {
+		return buf, io.EOF
+	}
+	if err != nil {
+		return buf, err
+	}
+	if buf[n-1] == '\n' {
+		return buf, nil
+	}
+	rest, err := r.ReadBytes('\n')
+	return append(buf, rest...), err
+}
+
+func loadIndex(path string) (*fileIndex, error) {
+	f, err := os.Open(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var idx fileIndex
+	if err := gob.NewDecoder(bufio.NewReader(f)).Decode(&idx); err != nil {
+		return nil, err
+	}
+	return &idx, nil
+}
+
+func saveIndex(path string, idx *fileIndex) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(idx); err != nil {
+		return err
+	}
+	return util.AtomicWriteFile(path, buf.Bytes(), 0644)
+}
+
+// Bloom filters over case-folded trigrams.
+
+func fold(b byte) byte {
+	if 'A' <= b && b <= 'Z' {
+		return b + 'a' - 'A'
+	}
+	return b
+}
+
+func trigram(a, b, c byte) uint32 {
+	return uint32(fold(a))<<16 | uint32(fold(b))<<8 | uint32(fold(c))
+}
+
+func bloomAdd(bloom []uint64, t uint32) {
+	h1, h2 := t*2654435761, (t^0x5bd1e995)*40503|1
+	for i := uint32(0); i < bloomHashes; i++ {
+		bit := (h1 + i*h2) % (bloomWords * 64)
+		bloom[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+func bloomHas(bloom []uint64, t uint32) bool {
+	h1, h2 := t*2654435761, (t^0x5bd1e995)*40503|1
+	for i := uint32(0); i < bloomHashes; i++ {
+		bit := (h1 + i*h2) % (bloomWords * 64)
+		if bloom[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func bloomOf(data []byte) []uint64 {
+	bloom := make([]uint64, bloomWords)
+	for i := 0; i+2 < len(data); i++ {
+		bloomAdd(bloom, trigram(data[i], data[i+1], data[i+2]))
+	}
+	return bloom
+}
+
+// trigramsOf returns the distinct trigrams of the literal strings, sorted.
+func trigramsOf(literals []string) []uint32 {
+	seen := map[uint32]bool{}
+	for _, s := range literals {
+		for i := 0; i+2 < len(s); i++ {
+			seen[trigram(s[i], s[i+1], s[i+2])] = true
+		}
+	}
+	out := make([]uint32, 0, len(seen))
+	for t := range seen {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
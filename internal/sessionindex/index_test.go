@@ -0,0 +1,145 @@
+package sessionindex
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeLog(t *testing.T, path, content string, appendTo bool) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if appendTo {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+	f, err := os.OpenFile(path, flags, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateAndSearch(t *testing.T) {
+	town := t.TempDir()
+	toast := filepath.Join(LogDir(filepath.Join(town, "gastown")), "gt-gastown-Toast.log")
+
+	// Several chunks of noise, with one error line in the middle.
+	var b strings.Builder
+	for i := 1; i <= 5000; i++ {
+		if i == 2500 {
+			b.WriteString("panic: connection refused by dolt\n")
+			continue
+		}
+		fmt.Fprintf(&b, "line %d: building package %d ok\n", i, i%97)
+	}
+	writeLog(t, toast, b.String(), false)
+
+	stats, err := Update(town)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Logs != 1 || stats.Indexed != int64(b.Len()) {
+		t.Fatalf("Update() = %+v, want one log fully indexed", stats)
+	}
+
+	matches, ss, err := Search(town, regexp.MustCompile(regexp.QuoteMeta("connection refused")), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || matches[0].Line != 2500 || matches[0].Session != "gt-gastown-Toast" || matches[0].Rig != "gastown" {
+		t.Fatalf("Search() = %+v", matches)
+	}
+	if ss.Chunks < 3 || ss.Scanned != 1 {
+		t.Errorf("stats = %+v, want only the matching chunk read", ss)
+	}
+
+	// Appended output is indexed incrementally, without re-reading the log.
+	writeLog(t, toast, "Error: Connection Refused again\n", true)
+	stats, err = Update(town)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Indexed >= int64(b.Len()) {
+		t.Errorf("second Update() read %d bytes, want only the tail", stats.Indexed)
+	}
+	matches, _, _ = Search(town, regexp.MustCompile("(?i)connection refused"), 0)
+	if len(matches) != 2 || matches[1].Line != 5001 {
+		t.Errorf("case-insensitive Search() = %+v", matches)
+	}
+	if matches, _, _ := Search(town, regexp.MustCompile(`panic: \w+ refused`), 1); len(matches) != 1 {
+		t.Errorf("regexp Search() = %+v", matches)
+	}
+
+	// A truncated log is re-indexed; a removed one drops its index.
+	writeLog(t, toast, "fresh start\n", false)
+	if _, err := Update(town); err != nil {
+		t.Fatal(err)
+	}
+	if matches, _, _ := Search(town, regexp.MustCompile("refused"), 0); len(matches) != 0 {
+		t.Errorf("after truncation: %+v", matches)
+	}
+	if err := os.Remove(toast); err != nil {
+		t.Fatal(err)
+	}
+	if stats, _ := Update(town); stats.Removed != 1 {
+		t.Errorf("Update() after removal = %+v", stats)
+	}
+}
+
+func TestRequiredLiterals(t *testing.T) {
+	tests := map[string]string{
+		`connection refused`:    `["connection refused"]`,
+		`panic: \w+ (refused)+`: `["panic: " " " "refused"]`,
+		`a|b`:                   `[]`,
+		`(?i)disk full`:         `["DI" "" " FULL"]`, // Split around S and K
+	}
+	for expr, want := range tests {
+		if got := fmt.Sprintf("%q", requiredLiterals(regexp.MustCompile(expr))); got != want {
+			t.Errorf("requiredLiterals(%q) = %s, want %s", expr, got, want)
+		}
+	}
+}
+
+func TestRecordStampsLineTimes(t *testing.T) {
+	town := t.TempDir()
+	log := filepath.Join(LogDir(filepath.Join(town, "gastown")), "gt-gastown-Toast.log")
+	writeLog(t, log, "unstamped output from before recording\n", false)
+	if _, err := Update(town); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.OpenFile(log, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	written := time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC)
+	err = record(f, strings.NewReader("building...\nError: disk full"), func() time.Time { return written })
+	_ = f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Update(town); err != nil {
+		t.Fatal(err)
+	}
+
+	matches, _, _ := Search(town, regexp.MustCompile("^Error: disk full$"), 0)
+	if len(matches) != 1 || !matches[0].Time.Equal(written) || matches[0].Text != "Error: disk full" {
+		t.Fatalf("Search() = %+v, want the line's own stamp and text", matches)
+	}
+	// Output on disk before the first index has no known time, rather than
+	// the log's modification time.
+	matches, _, _ = Search(town, regexp.MustCompile("before recording"), 0)
+	if len(matches) != 1 || !matches[0].Time.IsZero() {
+		t.Errorf("Search() = %+v, want an unknown time for unstamped output", matches)
+	}
+}
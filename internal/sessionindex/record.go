@@ -0,0 +1,69 @@
+package sessionindex
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"time"
+)
+
+// stampLayout is the time stamp that starts each recorded line. It matches
+// the stamps `kubectl logs --timestamps` writes, so pod logs and recorded
+// tmux panes read the same way.
+const stampLayout = time.RFC3339Nano
+
+// maxStampLen bounds where the stamp's separating space can be.
+const maxStampLen = len("2006-01-02T15:04:05.999999999-07:00")
+
+// Record copies session output from r to w a line at a time, prefixing each
+// line with the time it was read. Each line is written as soon as it is
+// complete, so w should be unbuffered (a session log opened for append).
+func Record(w io.Writer, r io.Reader) error {
+	return record(w, r, time.Now)
+}
+
+func record(w io.Writer, r io.Reader, now func() time.Time) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			if line[len(line)-1] != '\n' {
+				line = append(line, '\n')
+			}
+			stamped := append([]byte(now().UTC().Format(stampLayout)+" "), line...)
+			if _, werr := w.Write(stamped); werr != nil {
+				return werr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// lineTime splits a recorded line into its time stamp and text. ok is false
+// for a line without a stamp, which is returned whole.
+func lineTime(line []byte) (t time.Time, text []byte, ok bool) {
+	end := bytes.IndexByte(line, ' ')
+	if end < len("2006-01-02T15:04:05Z") || end > maxStampLen {
+		return time.Time{}, line, false
+	}
+	t, err := time.Parse(stampLayout, string(line[:end]))
+	if err != nil {
+		return time.Time{}, line, false
+	}
+	return t, line[end+1:], true
+}
+
+// firstLineTime returns the stamp of data's first line.
+func firstLineTime(data []byte) (time.Time, bool) {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		data = data[:i]
+	}
+	t, _, ok := lineTime(data)
+	return t, ok
+}
@@ -0,0 +1,154 @@
+package sessionindex
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"time"
+	"unicode/utf8"
+)
+
+// Match is a line of recorded session output that matched a search.
+type Match struct {
+	Rig     string    `json:"rig"`
+	Session string    `json:"session"`
+	Path    string    `json:"path"`
+	Line    int       `json:"line"`
+	Time    time.Time `json:"time"` // When the line was written; zero if unknown
+	Text    string    `json:"text"`
+}
+
+// SearchStats reports how much of the recorded output a search read.
+type SearchStats struct {
+	Chunks  int `json:"chunks"`  // Chunks in the index
+	Scanned int `json:"scanned"` // Chunks read because their filter matched
+}
+
+// Search returns the indexed lines matching re, newest recording first, up
+// to limit matches (0 for no limit). Output appended since the last Update
+// is not searched.
+func Search(townRoot string, re *regexp.Regexp, limit int) ([]Match, SearchStats, error) {
+	var stats SearchStats
+	recs, err := Recordings(townRoot)
+	if err != nil {
+		return nil, stats, err
+	}
+	grams := trigramsOf(requiredLiterals(re))
+
+	type indexed struct {
+		rec Recording
+		idx *fileIndex
+	}
+	var all []indexed
+	for _, rec := range recs {
+		idx, err := loadIndex(indexPath(townRoot, rec))
+		if err != nil || idx.Path != rec.Path || len(idx.Chunks) == 0 {
+			continue
+		}
+		all = append(all, indexed{rec, idx})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return lastTime(all[i].idx).After(lastTime(all[j].idx))
+	})
+
+	var matches []Match
+	for _, it := range all {
+		f, err := os.Open(it.rec.Path)
+		if err != nil {
+			continue
+		}
+		for _, c := range it.idx.Chunks {
+			stats.Chunks++
+			if !chunkMayMatch(c, grams) || (limit > 0 && len(matches) >= limit) {
+				continue
+			}
+			stats.Scanned++
+			data := make([]byte, c.Length)
+			n, _ := f.ReadAt(data, c.Offset)
+			for i, line := range bytes.Split(data[:n], []byte("\n")) {
+				written, text, ok := lineTime(line)
+				if !ok {
+					written = c.Time
+				}
+				if !re.Match(text) {
+					continue
+				}
+				matches = append(matches, Match{
+					Rig:     it.rec.Rig,
+					Session: it.rec.Session,
+					Path:    it.rec.Path,
+					Line:    c.Line + i,
+					Time:    written,
+					Text:    string(text),
+				})
+				if limit > 0 && len(matches) >= limit {
+					break
+				}
+			}
+		}
+		_ = f.Close()
+	}
+	return matches, stats, nil
+}
+
+func lastTime(idx *fileIndex) time.Time {
+	return idx.Chunks[len(idx.Chunks)-1].Time
+}
+
+func chunkMayMatch(c chunk, grams []uint32) bool {
+	if len(c.Bloom) != bloomWords {
+		return true
+	}
+	for _, t := range grams {
+		if !bloomHas(c.Bloom, t) {
+			return false
+		}
+	}
+	return true
+}
+
+// requiredLiterals returns strings that every match of re contains. Only
+// the top-level concatenation is analyzed; a pattern with no required
+// literal of three or more bytes yields none, and every chunk is read.
+func requiredLiterals(re *regexp.Regexp) []string {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	var out []string
+	var walk func(*syntax.Regexp)
+	walk = func(r *syntax.Regexp) {
+		switch r.Op {
+		case syntax.OpLiteral:
+			if r.Flags&syntax.FoldCase == 0 {
+				out = append(out, string(r.Rune))
+				return
+			}
+			// The index only folds ASCII case, so split case-insensitive
+			// literals at runes with other case variants (K and the Kelvin
+			// sign, é and É).
+			start := 0
+			for i, c := range r.Rune {
+				if c >= utf8.RuneSelf || c == 'k' || c == 'K' || c == 's' || c == 'S' {
+					out = append(out, string(r.Rune[start:i]))
+					start = i + 1
+				}
+			}
+			out = append(out, string(r.Rune[start:]))
+		case syntax.OpConcat:
+			for _, sub := range r.Sub {
+				walk(sub)
+			}
+		case syntax.OpCapture, syntax.OpPlus:
+			walk(r.Sub[0])
+		case syntax.OpRepeat:
+			if r.Min >= 1 {
+				walk(r.Sub[0])
+			}
+		}
+	}
+	walk(parsed.Simplify())
+	return out
+}
@@ -0,0 +1,72 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/wisp"
+)
+
+// ByReason groups abandons by their reason code.
+const ByReason = "reason"
+
+// AbandonStats counts the wisps abandoned in one group.
+type AbandonStats struct {
+	Key      string         `json:"key"`
+	Total    int            `json:"total"`
+	Requeued int            `json:"requeued"`
+	Closed   int            `json:"closed"`
+	Reasons  map[string]int `json:"reasons"`
+}
+
+// SummarizeAbandons groups the abandons logged at or after since by rig,
+// agent, week, or reason. Groups are sorted by total, most first.
+func SummarizeAbandons(evs []events.Event, by string, since time.Time) ([]AbandonStats, error) {
+	var key func(e events.Event) string
+	switch by {
+	case ByRig:
+		key = func(e events.Event) string { return orNone(RigFromAgent(e.PayloadString("agent"))) }
+	case ByAgent:
+		key = func(e events.Event) string { return orNone(e.PayloadString("agent")) }
+	case ByWeek:
+		key = func(e events.Event) string { return WeekOf(e.Time()) }
+	case ByReason:
+		key = func(e events.Event) string { return orNone(e.PayloadString("reason")) }
+	default:
+		return nil, fmt.Errorf("unknown grouping %q for abandons (want %s, %s, %s, or %s)", by, ByRig, ByAgent, ByWeek, ByReason)
+	}
+
+	groups := make(map[string]*AbandonStats)
+	for _, e := range evs {
+		if e.Type != events.TypeWispAbandon || e.Time().Before(since) {
+			continue
+		}
+		k := key(e)
+		g, ok := groups[k]
+		if !ok {
+			g = &AbandonStats{Key: k, Reasons: map[string]int{}}
+			groups[k] = g
+		}
+		g.Total++
+		if e.PayloadString("outcome") == wisp.AbandonClose {
+			g.Closed++
+		} else {
+			g.Requeued++
+		}
+		g.Reasons[orNone(e.PayloadString("reason"))]++
+	}
+
+	out := make([]AbandonStats, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, *g)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Total != out[j].Total {
+			return out[i].Total > out[j].Total
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out, nil
+}
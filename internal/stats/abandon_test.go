@@ -0,0 +1,87 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/wisp"
+)
+
+func TestBuildTimelines_Abandon(t *testing.T) {
+	toast := "gastown/polecats/Toast"
+	nux := "gastown/polecats/Nux"
+	evs := []events.Event{
+		ev(0, events.TypeSling, "mayor", events.SlingPayload("gt-1", toast)),
+		ev(time.Minute, events.TypeHook, toast, events.HookPayload("gt-1")),
+		ev(10*time.Minute, events.TypeWispAbandon, "mayor", events.WispAbandonPayload("gt-1", toast, "unclear", wisp.AbandonRequeue, "")),
+		ev(20*time.Minute, events.TypeHook, nux, events.HookPayload("gt-1")),
+		ev(50*time.Minute, events.TypeDone, nux, events.DonePayload("gt-1", "polecat/Nux")),
+		// Second wisp: abandoned and closed
+		ev(0, events.TypeSling, "mayor", events.SlingPayload("gt-2", toast)),
+		ev(time.Minute, events.TypeHook, toast, events.HookPayload("gt-2")),
+		ev(5*time.Minute, events.TypeWispAbandon, toast, events.WispAbandonPayload("gt-2", toast, "duplicate", wisp.AbandonClose, "")),
+	}
+
+	got := BuildTimelines(evs)
+	if len(got) != 2 {
+		t.Fatalf("BuildTimelines() returned %d timelines, want 2", len(got))
+	}
+	a := got[0]
+	if a.Agent != nux || a.Abandons != 1 {
+		t.Errorf("gt-1 agent, abandons = %q, %d; want %q, 1", a.Agent, a.Abandons, nux)
+	}
+	if d, ok := a.CycleTime(); !ok || d != 30*time.Minute {
+		t.Errorf("gt-1 CycleTime = %v, %v; want the second claim's 30m", d, ok)
+	}
+	if d, ok := a.QueueWait(); !ok || d != 20*time.Minute {
+		t.Errorf("gt-1 QueueWait = %v, %v; want 20m", d, ok)
+	}
+
+	b := got[1]
+	if !b.IsDropped() || b.IsComplete() {
+		t.Errorf("gt-2 = %+v, want dropped", b)
+	}
+	groups := Summarize(got, func(*Timeline) string { return "all" }, t0, t0.Add(time.Hour))
+	if len(groups) != 1 || groups[0].InFlight != 0 {
+		t.Errorf("Summarize = %+v, want no wisps in flight", groups)
+	}
+}
+
+func TestSummarizeAbandons(t *testing.T) {
+	toast := "gastown/polecats/Toast"
+	evs := []events.Event{
+		ev(0, events.TypeWispAbandon, toast, events.WispAbandonPayload("gt-1", toast, "stuck", wisp.AbandonRequeue, "")),
+		ev(time.Minute, events.TypeWispAbandon, toast, events.WispAbandonPayload("gt-2", toast, "duplicate", wisp.AbandonClose, "")),
+		ev(2*time.Minute, events.TypeWispAbandon, "mayor", events.WispAbandonPayload("gt-3", "beads/crew/max", "stuck", wisp.AbandonRequeue, "")),
+		ev(3*time.Minute, events.TypeDone, toast, events.DonePayload("gt-4", "")),
+	}
+
+	byReason, err := SummarizeAbandons(evs, ByReason, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byReason) != 2 || byReason[0].Key != "stuck" || byReason[0].Total != 2 || byReason[0].Requeued != 2 {
+		t.Errorf("by reason = %+v, want stuck (2 requeued) first", byReason)
+	}
+
+	byRig, err := SummarizeAbandons(evs, ByRig, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byRig) != 2 || byRig[0].Key != "gastown" || byRig[0].Closed != 1 || byRig[0].Reasons["duplicate"] != 1 {
+		t.Errorf("by rig = %+v, want gastown with one closed duplicate first", byRig)
+	}
+
+	recent, err := SummarizeAbandons(evs, ByAgent, t0.Add(90*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recent) != 1 || recent[0].Key != "beads/crew/max" {
+		t.Errorf("since filter = %+v, want only beads/crew/max", recent)
+	}
+
+	if _, err := SummarizeAbandons(evs, ByType, time.Time{}); err == nil {
+		t.Error("SummarizeAbandons accepted --by type")
+	}
+}
@@ -0,0 +1,152 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Grouping keys for Summarize.
+const (
+	ByRig   = "rig"
+	ByAgent = "agent"
+	ByWeek  = "week"
+	ByType  = "type"
+)
+
+// GroupKey returns the key function for a grouping name.
+func GroupKey(by string) (func(*Timeline) string, error) {
+	switch by {
+	case ByRig:
+		return func(t *Timeline) string { return orNone(t.Rig) }, nil
+	case ByAgent:
+		return func(t *Timeline) string { return orNone(t.Agent) }, nil
+	case ByWeek:
+		return func(t *Timeline) string { return WeekOf(t.Completed) }, nil
+	case ByType:
+		return func(t *Timeline) string { return orNone(t.Type) }, nil
+	default:
+		return nil, fmt.Errorf("unknown grouping %q (want %s, %s, %s, or %s)", by, ByRig, ByAgent, ByWeek, ByType)
+	}
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
+
+// WeekOf returns the ISO week label (e.g., "2026-W07") for a time.
+func WeekOf(t time.Time) string {
+	if t.IsZero() {
+		return "(open)"
+	}
+	y, w := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", y, w)
+}
+
+// Percentiles summarizes a distribution of durations.
+type Percentiles struct {
+	P50 time.Duration `json:"p50"`
+	P90 time.Duration `json:"p90"`
+	P99 time.Duration `json:"p99"`
+}
+
+// Percentile returns the p-th percentile (0-100) of ds using nearest rank.
+// ds need not be sorted. Returns 0 for an empty slice.
+func Percentile(ds []time.Duration, p float64) time.Duration {
+	if len(ds) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+func percentilesOf(ds []time.Duration) Percentiles {
+	return Percentiles{
+		P50: Percentile(ds, 50),
+		P90: Percentile(ds, 90),
+		P99: Percentile(ds, 99),
+	}
+}
+
+// GroupStats is the cycle-time and throughput summary for one group.
+type GroupStats struct {
+	Key            string      `json:"key"`
+	Completed      int         `json:"completed"`
+	InFlight       int         `json:"in_flight"`
+	PerWeek        float64     `json:"per_week"`
+	CycleTime      Percentiles `json:"cycle_time"`
+	QueueWait      Percentiles `json:"queue_wait"`
+	StartupLatency Percentiles `json:"startup_latency"`
+}
+
+// Summarize groups timelines by key and computes per-group percentiles and
+// throughput. Only wisps completed at or after since count toward completed
+// stats; in-flight wisps are counted regardless. Groups are sorted by key.
+func Summarize(timelines []*Timeline, key func(*Timeline) string, since, now time.Time) []GroupStats {
+	type acc struct {
+		stats                 GroupStats
+		cycle, queue, startup []time.Duration
+	}
+	groups := make(map[string]*acc)
+
+	for _, t := range timelines {
+		if t.IsComplete() && t.Completed.Before(since) {
+			continue
+		}
+		k := key(t)
+		a, ok := groups[k]
+		if !ok {
+			a = &acc{stats: GroupStats{Key: k}}
+			groups[k] = a
+		}
+		if !t.IsComplete() {
+			if !t.IsDropped() && (!t.Claimed.IsZero() || !t.Queued.IsZero()) {
+				a.stats.InFlight++
+			}
+			continue
+		}
+		a.stats.Completed++
+		if d, ok := t.CycleTime(); ok {
+			a.cycle = append(a.cycle, d)
+		}
+		if d, ok := t.QueueWait(); ok {
+			a.queue = append(a.queue, d)
+		}
+		if d, ok := t.StartupLatency(); ok {
+			a.startup = append(a.startup, d)
+		}
+	}
+
+	window := now.Sub(since)
+	if since.IsZero() || window <= 0 {
+		window = 0
+	}
+
+	out := make([]GroupStats, 0, len(groups))
+	for _, a := range groups {
+		a.stats.CycleTime = percentilesOf(a.cycle)
+		a.stats.QueueWait = percentilesOf(a.queue)
+		a.stats.StartupLatency = percentilesOf(a.startup)
+		if window > 0 {
+			weeks := window.Hours() / (24 * 7)
+			if weeks > 0 {
+				a.stats.PerWeek = float64(a.stats.Completed) / weeks
+			}
+		}
+		out = append(out, a.stats)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
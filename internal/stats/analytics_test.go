@@ -0,0 +1,87 @@
+package stats
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	var ds []time.Duration
+	for i := 1; i <= 100; i++ {
+		ds = append(ds, time.Duration(i)*time.Second)
+	}
+	tests := map[float64]time.Duration{
+		50: 50 * time.Second,
+		90: 90 * time.Second,
+		99: 99 * time.Second,
+		0:  time.Second,
+	}
+	for p, want := range tests {
+		if got := Percentile(ds, p); got != want {
+			t.Errorf("Percentile(%v) = %v, want %v", p, got, want)
+		}
+	}
+	if got := Percentile(nil, 50); got != 0 {
+		t.Errorf("Percentile(nil) = %v, want 0", got)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	now := t0.Add(14 * 24 * time.Hour)
+	done := func(bead, agent string, claimedAgo, cycle time.Duration) *Timeline {
+		claimed := now.Add(-claimedAgo)
+		return &Timeline{
+			Bead: bead, Agent: agent, Rig: RigFromAgent(agent),
+			Queued: claimed.Add(-time.Minute), Claimed: claimed,
+			FirstActivity: claimed.Add(30 * time.Second), Completed: claimed.Add(cycle),
+		}
+	}
+	timelines := []*Timeline{
+		done("gt-1", "gastown/polecats/a", 48*time.Hour, 10*time.Minute),
+		done("gt-2", "gastown/polecats/b", 24*time.Hour, 20*time.Minute),
+		done("bd-1", "beads/polecats/c", 24*time.Hour, time.Hour),
+		done("old", "gastown/polecats/a", 30*24*time.Hour, time.Minute),
+		{Bead: "gt-open", Agent: "gastown/polecats/a", Rig: "gastown", Claimed: now.Add(-time.Hour)},
+	}
+
+	key, err := GroupKey(ByRig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := Summarize(timelines, key, now.Add(-7*24*time.Hour), now)
+	if len(groups) != 2 {
+		t.Fatalf("Summarize() = %+v, want 2 groups", groups)
+	}
+	b, g := groups[0], groups[1]
+	if b.Key != "beads" || b.Completed != 1 || b.CycleTime.P50 != time.Hour {
+		t.Errorf("beads group = %+v", b)
+	}
+	if g.Key != "gastown" || g.Completed != 2 || g.InFlight != 1 {
+		t.Errorf("gastown group = %+v (old wisp should be excluded)", g)
+	}
+	if g.CycleTime.P50 != 10*time.Minute || g.CycleTime.P99 != 20*time.Minute {
+		t.Errorf("gastown cycle = %+v", g.CycleTime)
+	}
+	if g.QueueWait.P50 != time.Minute || g.StartupLatency.P50 != 30*time.Second {
+		t.Errorf("gastown wait/startup = %+v / %+v", g.QueueWait, g.StartupLatency)
+	}
+	if g.PerWeek != 2 {
+		t.Errorf("gastown per week = %v, want 2", g.PerWeek)
+	}
+}
+
+func TestGroupKey(t *testing.T) {
+	tl := &Timeline{Agent: "gastown/polecats/a", Completed: time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC)}
+	for by, want := range map[string]string{ByRig: "(none)", ByAgent: "gastown/polecats/a", ByWeek: "2026-W07"} {
+		key, err := GroupKey(by)
+		if err != nil {
+			t.Fatalf("GroupKey(%s): %v", by, err)
+		}
+		if got := key(tl); got != want {
+			t.Errorf("GroupKey(%s) = %q, want %q", by, got, want)
+		}
+	}
+	if _, err := GroupKey("month"); err == nil {
+		t.Error("GroupKey(month) should fail")
+	}
+}
@@ -0,0 +1,113 @@
+package stats
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// CalibrationBand is how far actual effort may stray from the estimate, as a
+// fraction of it, and still count as on target.
+const CalibrationBand = 0.25
+
+// MinCalibrationSamples is how many estimated wisps a group needs before its
+// ratio is trusted to adjust new estimates.
+const MinCalibrationSamples = 3
+
+// Calibration compares estimated effort with actual effort (see
+// Timeline.Actual) for one group's completed wisps.
+//
+// Ratios are actual/estimate: 1.0 is a perfect estimate, 2.0 means the work
+// took twice as long as estimated.
+type Calibration struct {
+	Key         string  `json:"key"`
+	Estimated   int     `json:"estimated"`   // Completed wisps with an estimate
+	Unestimated int     `json:"unestimated"` // Completed wisps without one
+	RatioP50    float64 `json:"ratio_p50"`
+	RatioP90    float64 `json:"ratio_p90"`
+	OnTarget    float64 `json:"on_target"` // Fraction within CalibrationBand of the estimate
+}
+
+// Bias describes the group's typical misestimate: "under" when work runs
+// longer than estimated, "over" when shorter, "" when on target or unknown.
+func (c Calibration) Bias() string {
+	switch {
+	case c.Estimated == 0:
+		return ""
+	case c.RatioP50 > 1+CalibrationBand:
+		return "under"
+	case c.RatioP50 < 1-CalibrationBand:
+		return "over"
+	}
+	return ""
+}
+
+// Adjust scales a new estimate by the group's median ratio, once the group
+// has MinCalibrationSamples estimated wisps. Returns the estimate unchanged
+// and false otherwise.
+func (c Calibration) Adjust(estimate time.Duration) (time.Duration, bool) {
+	if c.Estimated < MinCalibrationSamples || c.RatioP50 <= 0 {
+		return estimate, false
+	}
+	return time.Duration(float64(estimate) * c.RatioP50).Round(time.Minute), true
+}
+
+// Calibrate groups the wisps completed at or after since by key and compares
+// their estimates with their actual effort. Groups are sorted by key.
+func Calibrate(timelines []*Timeline, key func(*Timeline) string, since time.Time) []Calibration {
+	type acc struct {
+		cal    Calibration
+		ratios []float64
+	}
+	groups := make(map[string]*acc)
+
+	for _, t := range timelines {
+		if !t.IsComplete() || t.Completed.Before(since) {
+			continue
+		}
+		k := key(t)
+		a, ok := groups[k]
+		if !ok {
+			a = &acc{cal: Calibration{Key: k}}
+			groups[k] = a
+		}
+		actual, ok := t.Actual()
+		if t.Estimate <= 0 || !ok {
+			a.cal.Unestimated++
+			continue
+		}
+		a.ratios = append(a.ratios, float64(actual)/float64(t.Estimate))
+	}
+
+	out := make([]Calibration, 0, len(groups))
+	for _, a := range groups {
+		a.cal.Estimated = len(a.ratios)
+		if len(a.ratios) > 0 {
+			sort.Float64s(a.ratios)
+			a.cal.RatioP50 = ratioPercentile(a.ratios, 50)
+			a.cal.RatioP90 = ratioPercentile(a.ratios, 90)
+			within := 0
+			for _, r := range a.ratios {
+				if math.Abs(r-1) <= CalibrationBand {
+					within++
+				}
+			}
+			a.cal.OnTarget = float64(within) / float64(len(a.ratios))
+		}
+		out = append(out, a.cal)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// ratioPercentile returns the p-th percentile of sorted using nearest rank.
+func ratioPercentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,85 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestBuildTimelines_Estimate(t *testing.T) {
+	toast := "gastown/polecats/Toast"
+	evs := []events.Event{
+		ev(0, events.TypeSling, "mayor", events.SlingPayload("gt-1", toast)),
+		ev(time.Minute, events.TypeEstimate, "mayor", events.EstimatePayload("gt-1", time.Hour)),
+		ev(2*time.Minute, events.TypeHook, toast, events.HookPayload("gt-1")),
+		ev(3*time.Minute, events.TypeEstimate, toast, events.EstimatePayload("gt-1", 2*time.Hour)),
+		ev(62*time.Minute, events.TypeDone, toast, events.DonePayload("gt-1", "polecat/Toast")),
+		ev(70*time.Minute, events.TypeEstimate, toast, events.EstimatePayload("gt-1", 5*time.Hour)),
+	}
+	got := BuildTimelines(evs)
+	if len(got) != 1 {
+		t.Fatalf("BuildTimelines() returned %d timelines, want 1", len(got))
+	}
+	if got[0].Estimate != 2*time.Hour {
+		t.Errorf("Estimate = %v, want the last estimate before completion (2h)", got[0].Estimate)
+	}
+	if d, ok := got[0].Actual(); !ok || d != time.Hour {
+		t.Errorf("Actual = %v, %v; want 1h", d, ok)
+	}
+}
+
+func TestCalibrate(t *testing.T) {
+	done := func(agent, typ string, estimate, actual time.Duration) *Timeline {
+		return &Timeline{
+			Agent: agent, Type: typ, Estimate: estimate,
+			Claimed: t0, Completed: t0.Add(actual),
+		}
+	}
+	timelines := []*Timeline{
+		done("a", "bug", time.Hour, time.Hour),         // 1.0
+		done("a", "bug", time.Hour, 2*time.Hour),       // 2.0
+		done("a", "bug", time.Hour, 90*time.Minute),    // 1.5
+		done("a", "feature", 0, 3*time.Hour),           // unestimated
+		done("b", "bug", 2*time.Hour, time.Hour),       // 0.5
+		{Agent: "b", Estimate: time.Hour, Claimed: t0}, // in flight
+		done("b", "chore", time.Hour, 70*time.Minute),  // 1.17
+		{Agent: "c", Estimate: time.Hour, Completed: t0.Add(-48 * time.Hour), Claimed: t0.Add(-49 * time.Hour)}, // before since
+	}
+	key, _ := GroupKey(ByAgent)
+	got := Calibrate(timelines, key, t0.Add(-time.Hour))
+	if len(got) != 2 {
+		t.Fatalf("Calibrate() returned %d groups, want 2: %+v", len(got), got)
+	}
+
+	a := got[0]
+	if a.Key != "a" || a.Estimated != 3 || a.Unestimated != 1 {
+		t.Errorf("a = %+v", a)
+	}
+	if a.RatioP50 != 1.5 || a.RatioP90 != 2.0 {
+		t.Errorf("a ratios = %.2f / %.2f, want 1.5 / 2.0", a.RatioP50, a.RatioP90)
+	}
+	if a.OnTarget < 0.33 || a.OnTarget > 0.34 {
+		t.Errorf("a on target = %.2f, want 1/3", a.OnTarget)
+	}
+	if a.Bias() != "under" {
+		t.Errorf("a bias = %q, want under", a.Bias())
+	}
+	if adj, ok := a.Adjust(2 * time.Hour); !ok || adj != 3*time.Hour {
+		t.Errorf("Adjust(2h) = %v, %v; want 3h", adj, ok)
+	}
+
+	b := got[1]
+	if b.Estimated != 2 {
+		t.Errorf("b = %+v", b)
+	}
+	if _, ok := b.Adjust(time.Hour); ok {
+		t.Error("Adjust should not apply below MinCalibrationSamples")
+	}
+
+	typeKey, _ := GroupKey(ByType)
+	byType := Calibrate(timelines, typeKey, t0.Add(-time.Hour))
+	if byType[0].Key != "bug" || byType[0].Estimated != 4 {
+		t.Errorf("by type = %+v", byType)
+	}
+}
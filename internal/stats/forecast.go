@@ -0,0 +1,182 @@
+package stats
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+)
+
+const week = 7 * 24 * time.Hour
+
+// Forecast defaults.
+const (
+	DefaultForecastWeeks       = 8
+	DefaultForecastInflowWeeks = 3
+	DefaultForecastTrials      = 2000
+
+	// forecastHorizon caps a single simulated run, so a group whose history
+	// is mostly empty weeks doesn't simulate forever.
+	forecastHorizon = 520 // weeks
+)
+
+// ForecastOptions controls BuildForecasts.
+type ForecastOptions struct {
+	Weeks       int       // Weeks of history to sample throughput from
+	InflowWeeks int       // Recent weeks checked for inflow outpacing throughput
+	Trials      int       // Monte Carlo trials per group
+	Seed        int64     // Random seed (0 = time-based)
+	Now         time.Time // Anchor; zero means time.Now()
+
+	// Ready is open work not yet slung (Bead and Rig set), which joins the
+	// backlog. Closed holds beads closed without a completion event, such
+	// as wisps abandoned or closed by hand, which leave it.
+	Ready  []*Timeline
+	Closed map[string]bool
+}
+
+func (o ForecastOptions) withDefaults() ForecastOptions {
+	if o.Weeks <= 0 {
+		o.Weeks = DefaultForecastWeeks
+	}
+	if o.InflowWeeks <= 0 {
+		o.InflowWeeks = DefaultForecastInflowWeeks
+	}
+	if o.InflowWeeks > o.Weeks {
+		o.InflowWeeks = o.Weeks
+	}
+	if o.Trials <= 0 {
+		o.Trials = DefaultForecastTrials
+	}
+	if o.Now.IsZero() {
+		o.Now = time.Now()
+	}
+	if o.Seed == 0 {
+		o.Seed = o.Now.UnixNano()
+	}
+	return o
+}
+
+// Forecast estimates when one group's open backlog will clear.
+//
+// Weekly throughput and inflow cover the last Weeks weeks (oldest first),
+// counted in 7-day buckets ending now. The P50/P85/P95 dates come from a
+// Monte Carlo simulation that replays randomly sampled historical weeks
+// against the backlog; they are zero when the backlog is empty or there
+// was no throughput to sample (Stalled).
+type Forecast struct {
+	Key        string    `json:"key"`
+	Backlog    int       `json:"backlog"`
+	Throughput []int     `json:"weekly_throughput"`
+	Inflow     []int     `json:"weekly_inflow"`
+	PerWeek    float64   `json:"per_week"`
+	P50        time.Time `json:"p50,omitempty"`
+	P85        time.Time `json:"p85,omitempty"`
+	P95        time.Time `json:"p95,omitempty"`
+	Stalled    bool      `json:"stalled,omitempty"`
+	Growing    bool      `json:"growing,omitempty"` // Inflow exceeded throughput every one of the last InflowWeeks weeks
+}
+
+// BuildForecasts forecasts backlog completion per group. The backlog is
+// every queued or claimed wisp that has not completed, been dropped, or been
+// closed, plus the ready work in opts.Ready. Groups with neither backlog nor
+// history in the window are omitted. Results are sorted by key.
+func BuildForecasts(timelines []*Timeline, key func(*Timeline) string, opts ForecastOptions) []Forecast {
+	opts = opts.withDefaults()
+	start := opts.Now.Add(-time.Duration(opts.Weeks) * week)
+	bucket := func(t time.Time) int {
+		if t.IsZero() || t.Before(start) || !t.Before(opts.Now) {
+			return -1
+		}
+		return int(t.Sub(start) / week)
+	}
+
+	groups := make(map[string]*Forecast)
+	get := func(k string) *Forecast {
+		f, ok := groups[k]
+		if !ok {
+			f = &Forecast{Key: k, Throughput: make([]int, opts.Weeks), Inflow: make([]int, opts.Weeks)}
+			groups[k] = f
+		}
+		return f
+	}
+
+	inBacklog := make(map[string]bool)
+	for _, t := range timelines {
+		k := key(t)
+		if !t.IsComplete() {
+			if (!t.Queued.IsZero() || !t.Claimed.IsZero()) && !t.IsDropped() && !opts.Closed[t.Bead] {
+				get(k).Backlog++
+				inBacklog[t.Bead] = true
+			}
+		} else if b := bucket(t.Completed); b >= 0 {
+			get(k).Throughput[b]++
+		}
+		queued := t.Queued
+		if queued.IsZero() {
+			queued = t.Claimed
+		}
+		if b := bucket(queued); b >= 0 {
+			get(k).Inflow[b]++
+		}
+	}
+	for _, t := range opts.Ready {
+		if !inBacklog[t.Bead] {
+			get(key(t)).Backlog++
+		}
+	}
+
+	rng := rand.New(rand.NewSource(opts.Seed)) //nolint:gosec // G404: simulation, not security
+	out := make([]Forecast, 0, len(groups))
+	for _, f := range groups {
+		total := 0
+		for _, n := range f.Throughput {
+			total += n
+		}
+		f.PerWeek = float64(total) / float64(opts.Weeks)
+
+		f.Growing = true
+		for i := opts.Weeks - opts.InflowWeeks; i < opts.Weeks; i++ {
+			if f.Inflow[i] <= f.Throughput[i] {
+				f.Growing = false
+				break
+			}
+		}
+
+		if f.Backlog > 0 {
+			if total == 0 {
+				f.Stalled = true
+			} else {
+				ds := simulateClear(rng, f.Backlog, f.Throughput, opts.Trials)
+				f.P50 = opts.Now.Add(Percentile(ds, 50))
+				f.P85 = opts.Now.Add(Percentile(ds, 85))
+				f.P95 = opts.Now.Add(Percentile(ds, 95))
+			}
+		}
+		out = append(out, *f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// simulateClear returns, for each trial, how long it takes to finish
+// backlog wisps when every week's throughput is drawn at random from
+// history. The final week is prorated.
+func simulateClear(rng *rand.Rand, backlog int, history []int, trials int) []time.Duration {
+	out := make([]time.Duration, 0, trials)
+	for i := 0; i < trials; i++ {
+		remaining := float64(backlog)
+		var d time.Duration
+		for w := 0; w < forecastHorizon && remaining > 0; w++ {
+			n := float64(history[rng.Intn(len(history))])
+			if n >= remaining {
+				d += time.Duration(float64(week) * remaining / n)
+				remaining = 0
+				break
+			}
+			remaining -= n
+			d += week
+		}
+		out = append(out, d)
+	}
+	return out
+}
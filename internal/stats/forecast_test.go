@@ -0,0 +1,113 @@
+package stats
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBuildForecasts(t *testing.T) {
+	now := t0.Add(8 * week)
+	var timelines []*Timeline
+	n := 0
+	add := func(rig string, queued, completed time.Time) {
+		n++
+		timelines = append(timelines, &Timeline{
+			Bead: fmt.Sprintf("gt-%d", n), Rig: rig,
+			Queued: queued, Claimed: queued, Completed: completed,
+		})
+	}
+
+	// steady: 4 done per week for 8 weeks, 4 queued per week, 8 open.
+	for w := 0; w < 8; w++ {
+		for i := 0; i < 4; i++ {
+			at := t0.Add(time.Duration(w)*week + time.Duration(i)*time.Hour)
+			add("steady", at, at.Add(time.Hour))
+		}
+	}
+	for i := 0; i < 8; i++ {
+		add("steady", now.Add(-time.Hour), time.Time{})
+	}
+
+	// flooded: 1 done per week, but 3 queued per week in the last 3 weeks.
+	for w := 0; w < 8; w++ {
+		at := t0.Add(time.Duration(w) * week)
+		add("flooded", at, at.Add(time.Hour))
+	}
+	for w := 5; w < 8; w++ {
+		for i := 0; i < 3; i++ {
+			add("flooded", t0.Add(time.Duration(w)*week+time.Duration(i+2)*time.Hour), time.Time{})
+		}
+	}
+
+	// stalled: open work, no completions.
+	add("stalled", now.Add(-time.Hour), time.Time{})
+
+	key, _ := GroupKey(ByRig)
+	fs := BuildForecasts(timelines, key, ForecastOptions{Now: now, Seed: 1})
+	if len(fs) != 3 {
+		t.Fatalf("got %d forecasts, want 3: %+v", len(fs), fs)
+	}
+	byKey := map[string]Forecast{}
+	for _, f := range fs {
+		byKey[f.Key] = f
+	}
+
+	steady := byKey["steady"]
+	if steady.Backlog != 8 || steady.PerWeek != 4 || steady.Growing || steady.Stalled {
+		t.Errorf("steady = %+v", steady)
+	}
+	// Constant throughput makes every trial identical: 8 wisps at 4/week.
+	if want := now.Add(2 * week); !steady.P50.Equal(want) || !steady.P95.Equal(want) {
+		t.Errorf("steady P50/P95 = %v/%v, want %v", steady.P50, steady.P95, want)
+	}
+
+	flooded := byKey["flooded"]
+	if flooded.Backlog != 9 || !flooded.Growing {
+		t.Errorf("flooded = %+v, want backlog 9 and growing", flooded)
+	}
+	if flooded.P50.Before(now.Add(8*week)) || flooded.P95.Before(flooded.P50) {
+		t.Errorf("flooded P50/P95 = %v/%v", flooded.P50, flooded.P95)
+	}
+
+	stalled := byKey["stalled"]
+	if !stalled.Stalled || !stalled.P50.IsZero() {
+		t.Errorf("stalled = %+v", stalled)
+	}
+}
+
+func TestSimulateClear_ProratesFinalWeek(t *testing.T) {
+	fs := BuildForecasts([]*Timeline{
+		{Bead: "a", Rig: "r", Queued: t0, Completed: t0.Add(time.Hour)},
+		{Bead: "b", Rig: "r", Queued: t0, Completed: t0.Add(2 * time.Hour)},
+		{Bead: "c", Rig: "r", Queued: t0.Add(3 * time.Hour)},
+	}, func(t *Timeline) string { return t.Rig }, ForecastOptions{Now: t0.Add(week), Weeks: 1, Seed: 1})
+	if len(fs) != 1 {
+		t.Fatalf("got %d forecasts", len(fs))
+	}
+	if want := t0.Add(week + week/2); !fs[0].P50.Equal(want) {
+		t.Errorf("P50 = %v, want %v (half a week for 1 wisp at 2/week)", fs[0].P50, want)
+	}
+}
+
+func TestBuildForecasts_Backlog(t *testing.T) {
+	now := t0.Add(week)
+	fs := BuildForecasts([]*Timeline{
+		{Bead: "done", Rig: "r", Queued: t0, Completed: t0.Add(time.Hour)},
+		{Bead: "open", Rig: "r", Queued: t0},
+		{Bead: "dropped", Rig: "r", Queued: t0, Abandons: 1, Dropped: t0.Add(time.Hour)},
+		{Bead: "closed", Rig: "r", Queued: t0},
+	}, func(t *Timeline) string { return t.Rig }, ForecastOptions{
+		Now: now, Weeks: 1, Seed: 1,
+		Ready:  []*Timeline{{Bead: "ready", Rig: "r"}, {Bead: "open", Rig: "r"}},
+		Closed: map[string]bool{"closed": true},
+	})
+	if len(fs) != 1 {
+		t.Fatalf("got %d forecasts", len(fs))
+	}
+	// "open" and "ready"; dropped and closed wisps are not backlog, and a
+	// ready bead already open as a wisp is not counted twice.
+	if fs[0].Backlog != 2 {
+		t.Errorf("Backlog = %d, want 2", fs[0].Backlog)
+	}
+}
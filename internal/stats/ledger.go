@@ -0,0 +1,141 @@
+package stats
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/telemetry"
+	"github.com/steveyegge/gastown/internal/wisp"
+)
+
+// LedgerPath returns the path of the durable completed-wisp ledger.
+func LedgerPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "stats", "wisps.jsonl")
+}
+
+// AppendLedger appends a completed timeline to the town ledger.
+func AppendLedger(townRoot string, t *Timeline) error {
+	path := LedgerPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating stats directory: %w", err)
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("encoding timeline: %w", err)
+	}
+	data = append(data, '\n')
+
+	fl := flock.New(path + ".lock")
+	if err := fl.Lock(); err != nil {
+		return fmt.Errorf("acquiring ledger lock: %w", err)
+	}
+	defer fl.Unlock() //nolint:errcheck // best-effort unlock
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: stats are non-sensitive
+	if err != nil {
+		return fmt.Errorf("opening ledger: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing ledger: %w", err)
+	}
+	return f.Close()
+}
+
+// LoadLedger reads all completed timelines from the ledger.
+// When a bead appears more than once, the latest entry wins.
+func LoadLedger(townRoot string) ([]*Timeline, error) {
+	f, err := os.Open(LedgerPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening ledger: %w", err)
+	}
+	defer f.Close()
+
+	index := make(map[string]int)
+	var out []*Timeline
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var t Timeline
+		if err := json.Unmarshal(scanner.Bytes(), &t); err != nil || t.Bead == "" {
+			continue
+		}
+		if i, ok := index[t.Bead]; ok {
+			out[i] = &t
+			continue
+		}
+		index[t.Bead] = len(out)
+		out = append(out, &t)
+	}
+	return out, scanner.Err()
+}
+
+// Load returns every known timeline: ledger entries plus any timelines still
+// reconstructable from the event log. Ledger entries win for completed wisps.
+func Load(townRoot string) ([]*Timeline, error) {
+	ledger, err := LoadLedger(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	evs, err := events.ReadAll(townRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(ledger))
+	out := append([]*Timeline(nil), ledger...)
+	for _, t := range ledger {
+		seen[t.Bead] = true
+	}
+	for _, t := range BuildTimelines(evs) {
+		if !seen[t.Bead] {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+
+// RecordCompletion reconstructs the timeline of a just-completed wisp from the
+// event log, appends it to the ledger, and emits cycle-time metrics.
+// labels are the bead's labels; the metrics carry one of them, chosen by
+// wisp.MetricLabel, as a bounded "label" dimension. wispType is the wisp's
+// type, if it has one, for per-type estimate calibration.
+// Call after the done event has been logged.
+func RecordCompletion(townRoot, bead string, labels []string, wispType string) error {
+	evs, err := events.ReadAll(townRoot)
+	if err != nil {
+		return err
+	}
+	var t *Timeline
+	for _, c := range BuildTimelines(evs) {
+		if c.Bead == bead {
+			t = c
+			break
+		}
+	}
+	if t == nil || !t.IsComplete() {
+		return fmt.Errorf("no completion recorded for %s", bead)
+	}
+	t.Type = wispType
+
+	queueWait, _ := t.QueueWait()
+	cycle, _ := t.CycleTime()
+	var wispSettings *config.WispSettings
+	if ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil {
+		wispSettings = ts.Wisps
+	}
+	label := wisp.MetricLabel(wispSettings, labels)
+	telemetry.RecordWispCycle(context.Background(), t.Bead, t.Rig, t.Agent, label, queueWait, cycle)
+
+	return AppendLedger(townRoot, t)
+}
@@ -0,0 +1,170 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// Failure-type prefixes the refinery puts on merge_failed reasons.
+const (
+	failureTests    = "tests:"
+	failureConflict = "conflict:"
+)
+
+// CostSample is one session's cost attributed to an agent.
+type CostSample struct {
+	Agent   string
+	CostUSD float64
+}
+
+// Scorecard aggregates an agent's track record.
+type Scorecard struct {
+	Agent          string  `json:"agent"`
+	Completed      int     `json:"completed"`
+	Merged         int     `json:"merged"`
+	MergeFailed    int     `json:"merge_failed"`
+	GateFailures   int     `json:"gate_failures"`
+	Conflicts      int     `json:"conflicts"`
+	ReviewPassRate float64 `json:"review_pass_rate"`
+	CostUSD        float64 `json:"cost_usd"`
+	CostPerWisp    float64 `json:"cost_per_wisp"`
+	Nudges         int     `json:"nudges"`
+	NudgesPerWisp  float64 `json:"nudges_per_wisp"`
+	Score          float64 `json:"score"`
+}
+
+// NeutralScore is the score of an agent with no track record, so new agents
+// are neither preferred nor avoided.
+const NeutralScore = 0.5
+
+// AgentKey normalizes the different ways events name an agent to the
+// "<rig>/polecats/<name>" form used by hook events. Two-part targets such as
+// "gastown/Toast" (nudges) are treated as polecats; other forms are kept.
+func AgentKey(s string) string {
+	s = strings.TrimSuffix(s, "/")
+	parts := strings.Split(s, "/")
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		switch parts[1] {
+		case "witness", "refinery", "crew", "polecats":
+			return s
+		}
+		return parts[0] + "/polecats/" + parts[1]
+	}
+	return s
+}
+
+// mergeWorkerKey builds an agent key from a merge event, whose actor is
+// "<rig>/refinery" and whose worker is a bare or "polecats/"-prefixed name.
+func mergeWorkerKey(e events.Event) string {
+	worker := strings.TrimPrefix(e.PayloadString("worker"), "polecats/")
+	rig := RigFromAgent(e.Actor)
+	if worker == "" || rig == "" {
+		return ""
+	}
+	return rig + "/polecats/" + worker
+}
+
+// BuildScorecards aggregates completed wisps, merge outcomes, nudges, and
+// costs into one scorecard per agent, sorted by descending score.
+func BuildScorecards(timelines []*Timeline, evs []events.Event, costs []CostSample) []*Scorecard {
+	cards := make(map[string]*Scorecard)
+	card := func(agent string) *Scorecard {
+		c, ok := cards[agent]
+		if !ok {
+			c = &Scorecard{Agent: agent}
+			cards[agent] = c
+		}
+		return c
+	}
+
+	for _, t := range timelines {
+		if t.Agent != "" && t.IsComplete() {
+			card(t.Agent).Completed++
+		}
+	}
+	for _, e := range evs {
+		switch e.Type {
+		case events.TypeMerged:
+			if k := mergeWorkerKey(e); k != "" {
+				card(k).Merged++
+			}
+		case events.TypeMergeFailed:
+			k := mergeWorkerKey(e)
+			if k == "" {
+				continue
+			}
+			c := card(k)
+			c.MergeFailed++
+			reason := e.PayloadString("reason")
+			switch {
+			case strings.HasPrefix(reason, failureTests):
+				c.GateFailures++
+			case strings.HasPrefix(reason, failureConflict):
+				c.Conflicts++
+			}
+		case events.TypeNudge:
+			target := e.PayloadString("target")
+			if rig := e.PayloadString("rig"); rig != "" && !strings.Contains(target, "/") {
+				target = rig + "/" + target
+			}
+			if k := AgentKey(target); strings.Contains(k, "/polecats/") {
+				card(k).Nudges++
+			}
+		}
+	}
+	for _, s := range costs {
+		if s.Agent != "" {
+			card(s.Agent).CostUSD += s.CostUSD
+		}
+	}
+
+	out := make([]*Scorecard, 0, len(cards))
+	for _, c := range cards {
+		c.finish()
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		return out[i].Agent < out[j].Agent
+	})
+	return out
+}
+
+// finish derives rates and the composite score from the raw counts.
+//
+// The score is in [0, 1]: 60% review pass rate, 25% test-gate health, and 15%
+// nudge independence. Pass and gate rates are Laplace-smoothed so a single
+// outcome cannot swing an agent to either extreme. Cost is reported but not
+// scored, since it mostly tracks the size of the work rather than the agent.
+func (c *Scorecard) finish() {
+	reviewed := c.Merged + c.MergeFailed
+	if reviewed > 0 {
+		c.ReviewPassRate = float64(c.Merged) / float64(reviewed)
+	}
+	if c.Completed > 0 {
+		c.CostPerWisp = c.CostUSD / float64(c.Completed)
+		c.NudgesPerWisp = float64(c.Nudges) / float64(c.Completed)
+	}
+	if reviewed == 0 && c.Completed == 0 {
+		c.Score = NeutralScore
+		return
+	}
+	pass := (float64(c.Merged) + 1) / (float64(reviewed) + 2)
+	gates := 1 - (float64(c.GateFailures)+1)/(float64(reviewed)+2)
+	independence := 1 / (1 + c.NudgesPerWisp)
+	c.Score = 0.6*pass + 0.25*gates + 0.15*independence
+}
+
+// ScoreOf returns the score for agent, or NeutralScore when it has no card.
+func ScoreOf(cards []*Scorecard, agent string) float64 {
+	for _, c := range cards {
+		if c.Agent == agent {
+			return c.Score
+		}
+	}
+	return NeutralScore
+}
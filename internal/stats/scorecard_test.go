@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestAgentKey(t *testing.T) {
+	tests := map[string]string{
+		"gastown/Toast":          "gastown/polecats/Toast",
+		"gastown/polecats/Toast": "gastown/polecats/Toast",
+		"gastown/witness":        "gastown/witness",
+		"gastown/crew/joe":       "gastown/crew/joe",
+		"deacon":                 "deacon",
+	}
+	for in, want := range tests {
+		if got := AgentKey(in); got != want {
+			t.Errorf("AgentKey(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestBuildScorecards(t *testing.T) {
+	toast, nux := "gastown/polecats/Toast", "gastown/polecats/Nux"
+	done := func(bead, agent string) *Timeline {
+		return &Timeline{Bead: bead, Agent: agent, Claimed: t0, Completed: t0.Add(time.Hour)}
+	}
+	timelines := []*Timeline{done("gt-1", toast), done("gt-2", toast), done("gt-3", nux)}
+	evs := []events.Event{
+		ev(0, events.TypeMerged, "gastown/refinery", events.MergePayload("mr-1", "Toast", "polecat/Toast", "")),
+		ev(0, events.TypeMerged, "gastown/refinery", events.MergePayload("mr-2", "polecats/Toast", "polecat/Toast", "")),
+		ev(0, events.TypeMergeFailed, "gastown/refinery", events.MergePayload("mr-3", "Nux", "polecat/Nux", "tests: quality gates failed: go test")),
+		ev(0, events.TypeMergeFailed, "gastown/refinery", events.MergePayload("mr-4", "Nux", "polecat/Nux", "conflict: rebase failed")),
+		ev(0, events.TypeNudge, "gastown/witness", events.NudgePayload("gastown", "gastown/Nux", "wake up")),
+		ev(0, events.TypeNudge, "gastown/witness", events.NudgePayload("", "deacon", "ping")),
+	}
+	costs := []CostSample{{Agent: toast, CostUSD: 1.5}, {Agent: toast, CostUSD: 0.5}, {Agent: nux, CostUSD: 3}}
+
+	cards := BuildScorecards(timelines, evs, costs)
+	if len(cards) != 2 {
+		t.Fatalf("BuildScorecards() = %d cards, want 2", len(cards))
+	}
+	best, worst := cards[0], cards[1]
+	if best.Agent != toast || worst.Agent != nux {
+		t.Fatalf("order = %s, %s; want Toast first", best.Agent, worst.Agent)
+	}
+	if best.Completed != 2 || best.Merged != 2 || best.ReviewPassRate != 1 || best.CostPerWisp != 1 {
+		t.Errorf("Toast card = %+v", best)
+	}
+	if worst.MergeFailed != 2 || worst.GateFailures != 1 || worst.Conflicts != 1 || worst.Nudges != 1 || worst.NudgesPerWisp != 1 {
+		t.Errorf("Nux card = %+v", worst)
+	}
+	if best.Score <= worst.Score {
+		t.Errorf("scores = %v, %v; want Toast higher", best.Score, worst.Score)
+	}
+	if got := ScoreOf(cards, "gastown/polecats/New"); got != NeutralScore {
+		t.Errorf("ScoreOf(unknown) = %v, want %v", got, NeutralScore)
+	}
+}
@@ -0,0 +1,196 @@
+// Package stats derives wisp cycle-time and throughput analytics from the
+// town event log.
+//
+// Each wisp moves through four stages: queued (slung or scheduled), claimed
+// (hooked by an agent), first activity (the claiming agent's first event
+// after the hook), and completed (gt done). Timelines are reconstructed from
+// .events.jsonl, and completed timelines are appended to a durable ledger so
+// analytics survive event-log pruning.
+package stats
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/wisp"
+)
+
+// Timeline records when a wisp reached each lifecycle stage.
+// Zero times mean the stage was not observed.
+type Timeline struct {
+	Bead          string    `json:"bead"`
+	Rig           string    `json:"rig,omitempty"`
+	Agent         string    `json:"agent,omitempty"`
+	Queued        time.Time `json:"queued,omitempty"`
+	Claimed       time.Time `json:"claimed,omitempty"`
+	FirstActivity time.Time `json:"first_activity,omitempty"`
+	Completed     time.Time `json:"completed,omitempty"`
+
+	// Type is the wisp type (see wisp.Types), recorded at completion.
+	Type string `json:"type,omitempty"`
+	// Estimate is the effort estimated for the wisp before it completed.
+	Estimate time.Duration `json:"estimate,omitempty"`
+
+	// Abandons counts the times the wisp was handed back (gt wisp abandon).
+	// A requeued wisp's claim restarts with its next hook; Dropped is set
+	// when an abandon closed it instead.
+	Abandons int       `json:"abandons,omitempty"`
+	Dropped  time.Time `json:"dropped,omitempty"`
+}
+
+// between returns b-a when both are set and ordered.
+func between(a, b time.Time) (time.Duration, bool) {
+	if a.IsZero() || b.IsZero() || b.Before(a) {
+		return 0, false
+	}
+	return b.Sub(a), true
+}
+
+// QueueWait is the time from queued to claimed.
+func (t *Timeline) QueueWait() (time.Duration, bool) { return between(t.Queued, t.Claimed) }
+
+// StartupLatency is the time from claimed to the agent's first activity.
+func (t *Timeline) StartupLatency() (time.Duration, bool) {
+	return between(t.Claimed, t.FirstActivity)
+}
+
+// CycleTime is the time from claimed to completed.
+func (t *Timeline) CycleTime() (time.Duration, bool) { return between(t.Claimed, t.Completed) }
+
+// LeadTime is the time from queued to completed.
+func (t *Timeline) LeadTime() (time.Duration, bool) { return between(t.Queued, t.Completed) }
+
+// Actual is the effort the wisp took, for comparison with its estimate: its
+// cycle time, or its lead time if the claim was not observed.
+func (t *Timeline) Actual() (time.Duration, bool) {
+	if d, ok := t.CycleTime(); ok {
+		return d, true
+	}
+	return t.LeadTime()
+}
+
+// IsComplete reports whether the wisp has been completed.
+func (t *Timeline) IsComplete() bool { return !t.Completed.IsZero() }
+
+// IsDropped reports whether the wisp was abandoned and closed.
+func (t *Timeline) IsDropped() bool { return !t.Dropped.IsZero() }
+
+// RigFromAgent extracts the rig from an agent address like
+// "gastown/polecats/Toast". Town-level agents (mayor, deacon) have no rig.
+func RigFromAgent(agent string) string {
+	agent = strings.TrimSuffix(agent, "/")
+	parts := strings.Split(agent, "/")
+	if len(parts) < 2 || parts[0] == "mayor" || parts[0] == "deacon" {
+		return ""
+	}
+	return parts[0]
+}
+
+// BuildTimelines reconstructs wisp timelines from events (in log order).
+// Returned timelines are sorted by bead ID.
+func BuildTimelines(evs []events.Event) []*Timeline {
+	byBead := make(map[string]*Timeline)
+	get := func(bead string) *Timeline {
+		t, ok := byBead[bead]
+		if !ok {
+			t = &Timeline{Bead: bead}
+			byBead[bead] = t
+		}
+		return t
+	}
+	// awaiting maps a claiming agent to the beads whose first activity is
+	// still pending.
+	awaiting := make(map[string][]*Timeline)
+
+	for _, e := range evs {
+		ts := e.Time()
+		if ts.IsZero() {
+			continue
+		}
+
+		// Any event by an agent with a pending claim counts as first activity.
+		if e.Type != events.TypeHook && e.Actor != "" {
+			if pending := awaiting[e.Actor]; len(pending) > 0 {
+				for _, t := range pending {
+					if t.FirstActivity.IsZero() {
+						t.FirstActivity = ts
+					}
+				}
+				delete(awaiting, e.Actor)
+			}
+		}
+
+		bead := e.PayloadString("bead")
+		if bead == "" {
+			continue
+		}
+
+		switch e.Type {
+		case events.TypeSling:
+			t := get(bead)
+			if t.Queued.IsZero() {
+				t.Queued = ts
+			}
+			if target := e.PayloadString("target"); target != "" && t.Agent == "" {
+				t.Agent = target
+			}
+		case events.TypeSchedulerEnqueue:
+			t := get(bead)
+			if t.Queued.IsZero() || ts.Before(t.Queued) {
+				t.Queued = ts
+			}
+			if rig := e.PayloadString("rig"); rig != "" {
+				t.Rig = rig
+			}
+		case events.TypeHook:
+			t := get(bead)
+			if t.Claimed.IsZero() {
+				t.Claimed = ts
+				if e.Actor != "" {
+					t.Agent = e.Actor
+					awaiting[e.Actor] = append(awaiting[e.Actor], t)
+				}
+			}
+		case events.TypeEstimate:
+			// The latest estimate made before completion counts.
+			t := get(bead)
+			if d, err := time.ParseDuration(e.PayloadString("estimate")); err == nil && d > 0 && t.Completed.IsZero() {
+				t.Estimate = d
+			}
+		case events.TypeWispAbandon:
+			t := get(bead)
+			t.Abandons++
+			if e.PayloadString("outcome") == wisp.AbandonClose {
+				t.Dropped = ts
+				break
+			}
+			// Requeued: the next hook starts a fresh claim
+			if t.Agent != "" {
+				awaiting[t.Agent] = slices.DeleteFunc(awaiting[t.Agent], func(p *Timeline) bool { return p == t })
+			}
+			t.Agent, t.Claimed, t.FirstActivity = "", time.Time{}, time.Time{}
+		case events.TypeDone:
+			t := get(bead)
+			t.Completed = ts
+			if t.Agent == "" {
+				t.Agent = e.Actor
+			}
+			if t.FirstActivity.IsZero() && !t.Claimed.IsZero() {
+				t.FirstActivity = ts
+			}
+		}
+	}
+
+	out := make([]*Timeline, 0, len(byBead))
+	for _, t := range byBead {
+		if t.Rig == "" {
+			t.Rig = RigFromAgent(t.Agent)
+		}
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Bead < out[j].Bead })
+	return out
+}
@@ -0,0 +1,132 @@
+package stats
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+var t0 = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+func ev(offset time.Duration, typ, actor string, payload map[string]interface{}) events.Event {
+	return events.Event{
+		Timestamp: t0.Add(offset).Format(time.RFC3339),
+		Type:      typ,
+		Actor:     actor,
+		Payload:   payload,
+	}
+}
+
+func TestBuildTimelines(t *testing.T) {
+	toast := "gastown/polecats/Toast"
+	evs := []events.Event{
+		ev(0, events.TypeSling, "mayor", events.SlingPayload("gt-1", toast)),
+		ev(2*time.Minute, events.TypeHook, toast, events.HookPayload("gt-1")),
+		ev(3*time.Minute, events.TypeSessionStart, toast, nil),
+		ev(4*time.Minute, events.TypeMail, toast, events.MailPayload("witness", "hi")),
+		ev(30*time.Minute, events.TypeDone, toast, events.DonePayload("gt-1", "polecat/Toast")),
+		// Second wisp: hooked but never completed.
+		ev(time.Hour, events.TypeSchedulerEnqueue, "mayor", events.SchedulerEnqueuePayload("gt-2", "beads")),
+		ev(61*time.Minute, events.TypeHook, "beads/polecats/Nux", events.HookPayload("gt-2")),
+		{Timestamp: "garbage", Type: events.TypeDone, Payload: events.DonePayload("gt-3", "")},
+	}
+
+	got := BuildTimelines(evs)
+	if len(got) != 2 {
+		t.Fatalf("BuildTimelines() returned %d timelines, want 2", len(got))
+	}
+
+	a := got[0]
+	if a.Bead != "gt-1" || a.Agent != toast || a.Rig != "gastown" {
+		t.Errorf("gt-1 identity = %+v", a)
+	}
+	if d, ok := a.QueueWait(); !ok || d != 2*time.Minute {
+		t.Errorf("QueueWait = %v, %v", d, ok)
+	}
+	if d, ok := a.StartupLatency(); !ok || d != time.Minute {
+		t.Errorf("StartupLatency = %v, %v (first activity is session start)", d, ok)
+	}
+	if d, ok := a.CycleTime(); !ok || d != 28*time.Minute {
+		t.Errorf("CycleTime = %v, %v", d, ok)
+	}
+	if d, ok := a.LeadTime(); !ok || d != 30*time.Minute {
+		t.Errorf("LeadTime = %v, %v", d, ok)
+	}
+
+	b := got[1]
+	if b.IsComplete() || b.Rig != "beads" || b.Claimed.IsZero() {
+		t.Errorf("gt-2 = %+v, want open and claimed in rig beads", b)
+	}
+	if _, ok := b.CycleTime(); ok {
+		t.Error("open wisp should have no cycle time")
+	}
+}
+
+func TestRigFromAgent(t *testing.T) {
+	tests := map[string]string{
+		"gastown/polecats/Toast": "gastown",
+		"gastown/witness":        "gastown",
+		"mayor/":                 "",
+		"deacon":                 "",
+		"":                       "",
+	}
+	for in, want := range tests {
+		if got := RigFromAgent(in); got != want {
+			t.Errorf("RigFromAgent(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRecordCompletionAndLoad(t *testing.T) {
+	townRoot := t.TempDir()
+	toast := "gastown/polecats/Toast"
+	writeEvents(t, townRoot,
+		ev(0, events.TypeSling, "mayor", events.SlingPayload("gt-1", toast)),
+		ev(time.Minute, events.TypeHook, toast, events.HookPayload("gt-1")),
+		ev(10*time.Minute, events.TypeDone, toast, events.DonePayload("gt-1", "")),
+		ev(11*time.Minute, events.TypeSling, "mayor", events.SlingPayload("gt-2", toast)),
+	)
+
+	if err := RecordCompletion(townRoot, "gt-1", nil, ""); err != nil {
+		t.Fatalf("RecordCompletion: %v", err)
+	}
+	if err := RecordCompletion(townRoot, "gt-2", nil, ""); err == nil {
+		t.Error("RecordCompletion of an open wisp should fail")
+	}
+
+	ledger, err := LoadLedger(townRoot)
+	if err != nil || len(ledger) != 1 || ledger[0].Bead != "gt-1" {
+		t.Fatalf("LoadLedger() = %+v, %v", ledger, err)
+	}
+
+	// Simulate the event log being pruned: the ledger still has gt-1.
+	writeEvents(t, townRoot,
+		ev(11*time.Minute, events.TypeSling, "mayor", events.SlingPayload("gt-2", toast)),
+	)
+	all, err := Load(townRoot)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(all) != 2 || all[0].Bead != "gt-1" || !all[0].IsComplete() || all[1].Bead != "gt-2" {
+		t.Errorf("Load() = %+v", all)
+	}
+}
+
+func writeEvents(t *testing.T, townRoot string, evs ...events.Event) {
+	t.Helper()
+	f, err := os.Create(filepath.Join(townRoot, events.EventsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	for _, e := range evs {
+		if err := enc.Encode(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package stats
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// Activity kinds of agent transcript entries.
+const (
+	EntryPrompt     = "prompt"      // Input to the agent: a user turn or nudge
+	EntryToolResult = "tool_result" // A tool finished and returned its output
+	EntryToolUse    = "tool_use"    // The model asked for a tool
+	EntryReply      = "reply"       // The model produced text
+)
+
+// maxGenerating caps a single model response. Longer gaps before an
+// assistant entry mean the session was suspended or stalled, and the excess
+// counts as idle.
+const maxGenerating = 10 * time.Minute
+
+// ActivityEntry is one timestamped transcript entry.
+type ActivityEntry struct {
+	At   time.Time
+	Kind string
+}
+
+// ParseTranscriptActivity reads the timestamped user and assistant entries
+// of a Claude Code transcript (JSONL). Unparseable lines are skipped.
+func ParseTranscriptActivity(r io.Reader) ([]ActivityEntry, error) {
+	var entries []ActivityEntry
+	br := bufio.NewReaderSize(r, 256*1024)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			if e, ok := parseActivityLine(line); ok {
+				entries = append(entries, e)
+			}
+		}
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return entries, err
+		}
+	}
+}
+
+func parseActivityLine(line []byte) (ActivityEntry, bool) {
+	var msg struct {
+		Type      string `json:"type"`
+		Timestamp string `json:"timestamp"`
+		Message   *struct {
+			Content json.RawMessage `json:"content"`
+		} `json:"message"`
+	}
+	if json.Unmarshal(line, &msg) != nil || msg.Message == nil {
+		return ActivityEntry{}, false
+	}
+	at, err := time.Parse(time.RFC3339Nano, msg.Timestamp)
+	if err != nil {
+		return ActivityEntry{}, false
+	}
+	// Content is a plain string or a list of typed blocks.
+	var blocks []struct {
+		Type string `json:"type"`
+	}
+	_ = json.Unmarshal(msg.Message.Content, &blocks)
+	has := func(t string) bool {
+		for _, b := range blocks {
+			if b.Type == t {
+				return true
+			}
+		}
+		return false
+	}
+	switch msg.Type {
+	case "user":
+		if has("tool_result") {
+			return ActivityEntry{At: at, Kind: EntryToolResult}, true
+		}
+		return ActivityEntry{At: at, Kind: EntryPrompt}, true
+	case "assistant":
+		if has("tool_use") {
+			return ActivityEntry{At: at, Kind: EntryToolUse}, true
+		}
+		return ActivityEntry{At: at, Kind: EntryReply}, true
+	}
+	return ActivityEntry{}, false
+}
+
+// Utilization splits an agent's wall-clock time in a window:
+//
+//	generating  waiting on the model (prompt or tool result → assistant)
+//	waiting     waiting on tools the model ran (tool use → tool result)
+//	idle        waiting for input, or no session at all
+//
+// Wall clock runs from the window start, or from the agent's first entry if
+// it started later, to the window end.
+type Utilization struct {
+	Agent      string        `json:"agent"`
+	Wall       time.Duration `json:"wall"`
+	Generating time.Duration `json:"generating"`
+	Waiting    time.Duration `json:"waiting"`
+	Idle       time.Duration `json:"idle"`
+	Turns      int           `json:"turns"` // Model responses in the window
+}
+
+// Share returns d as a fraction of the wall clock.
+func (u Utilization) Share(d time.Duration) float64 {
+	if u.Wall <= 0 {
+		return 0
+	}
+	return float64(d) / float64(u.Wall)
+}
+
+// AnalyzeActivity computes an agent's utilization over [since, until) from
+// its transcript entries, which may come from several transcripts and need
+// not be sorted. Each gap between consecutive entries is classified by the
+// entry that ends it.
+func AnalyzeActivity(agent string, entries []ActivityEntry, since, until time.Time) Utilization {
+	u := Utilization{Agent: agent}
+	sorted := make([]ActivityEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.At.Before(until) {
+			sorted = append(sorted, e)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].At.Before(sorted[j].At) })
+
+	if len(sorted) == 0 {
+		return u
+	}
+	start := since
+	if first := sorted[0].At; first.After(since) {
+		start = first
+	}
+	if !start.Before(until) {
+		return u
+	}
+	u.Wall = until.Sub(start)
+
+	for i := 1; i < len(sorted); i++ {
+		from, to := sorted[i-1].At, sorted[i].At
+		if from.Before(start) {
+			from = start
+		}
+		if !to.After(from) {
+			continue
+		}
+		gap := to.Sub(from)
+		switch sorted[i].Kind {
+		case EntryToolUse, EntryReply:
+			if sorted[i-1].Kind == EntryPrompt || sorted[i-1].Kind == EntryToolResult {
+				u.Turns++
+			}
+			u.Generating += min(gap, maxGenerating)
+		case EntryToolResult:
+			u.Waiting += gap
+		}
+	}
+	u.Idle = max(u.Wall-u.Generating-u.Waiting, 0)
+	return u
+}
+
+// HostUtilization is a snapshot of the machine the agents run on.
+type HostUtilization struct {
+	CPUs           int     `json:"cpus"`
+	Load1          float64 `json:"load1"`
+	Load5          float64 `json:"load5"`
+	Load15         float64 `json:"load15"`
+	MemTotalKB     int64   `json:"mem_total_kb,omitempty"`
+	MemAvailableKB int64   `json:"mem_available_kb,omitempty"`
+}
+
+// LoadPerCPU returns the 15-minute load average per CPU.
+func (h HostUtilization) LoadPerCPU() float64 {
+	if h.CPUs <= 0 {
+		return 0
+	}
+	return h.Load15 / float64(h.CPUs)
+}
+
+// MemAvailableShare returns the fraction of memory available, or 1 when
+// memory is unknown.
+func (h HostUtilization) MemAvailableShare() float64 {
+	if h.MemTotalKB <= 0 {
+		return 1
+	}
+	return float64(h.MemAvailableKB) / float64(h.MemTotalKB)
+}
+
+// Saturated reports whether the host is short of CPU or memory.
+func (h HostUtilization) Saturated() bool {
+	return h.LoadPerCPU() >= 0.9 || h.MemAvailableShare() < 0.1
+}
+
+// Bottleneck kinds.
+const (
+	BottleneckNone     = "none"     // No agent activity to judge
+	BottleneckModel    = "model"    // Agents mostly wait on model responses
+	BottleneckHost     = "host"     // Tool runs dominate, or the host is saturated
+	BottleneckWork     = "work"     // Agents sit idle with nothing queued
+	BottleneckDispatch = "dispatch" // Agents sit idle while work is queued
+)
+
+// Bottleneck is the verdict of a utilization report.
+type Bottleneck struct {
+	Kind   string `json:"kind"`
+	Reason string `json:"reason"`
+}
+
+// idleBound is the idle share above which agents are starved of work.
+const idleBound = 0.5
+
+// Diagnose names what limits throughput, given per-agent utilization, the
+// host, and the number of wisps waiting to be claimed.
+func Diagnose(agents []Utilization, host HostUtilization, queued int) Bottleneck {
+	var t Utilization
+	for _, u := range agents {
+		t.Wall += u.Wall
+		t.Generating += u.Generating
+		t.Waiting += u.Waiting
+		t.Idle += u.Idle
+	}
+	if t.Wall <= 0 {
+		return Bottleneck{Kind: BottleneckNone, Reason: "no agent activity in the window"}
+	}
+	pct := func(d time.Duration) int { return int(t.Share(d)*100 + 0.5) }
+
+	if t.Share(t.Idle) >= idleBound {
+		if queued > 0 {
+			return Bottleneck{Kind: BottleneckDispatch, Reason: fmt.Sprintf(
+				"agents idle %d%% of the time while %d wisps wait to be claimed", pct(t.Idle), queued)}
+		}
+		return Bottleneck{Kind: BottleneckWork, Reason: fmt.Sprintf(
+			"agents idle %d%% of the time with nothing queued", pct(t.Idle))}
+	}
+	if host.Saturated() {
+		return Bottleneck{Kind: BottleneckHost, Reason: fmt.Sprintf(
+			"host saturated (load %.2f per CPU, %d%% memory free)", host.LoadPerCPU(), int(host.MemAvailableShare()*100))}
+	}
+	if t.Waiting > t.Generating {
+		return Bottleneck{Kind: BottleneckHost, Reason: fmt.Sprintf(
+			"agents spend %d%% of the time waiting on tool runs (builds, tests) vs %d%% on the model", pct(t.Waiting), pct(t.Generating))}
+	}
+	return Bottleneck{Kind: BottleneckModel, Reason: fmt.Sprintf(
+		"agents spend %d%% of the time waiting on model responses vs %d%% on tools", pct(t.Generating), pct(t.Waiting))}
+}
@@ -0,0 +1,94 @@
+package stats
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTranscriptActivity(t *testing.T) {
+	transcript := strings.Join([]string{
+		`{"type":"user","timestamp":"2026-02-10T10:00:00Z","message":{"role":"user","content":"fix the bug"}}`,
+		`{"type":"assistant","timestamp":"2026-02-10T10:00:20Z","message":{"content":[{"type":"tool_use","name":"Bash"}]}}`,
+		`{"type":"user","timestamp":"2026-02-10T10:01:00Z","message":{"content":[{"type":"tool_result"}]}}`,
+		`{"type":"assistant","timestamp":"2026-02-10T10:01:10Z","message":{"content":[{"type":"text","text":"done"}]}}`,
+		`{"type":"summary","summary":"x"}`,
+		`not json`,
+	}, "\n")
+	got, err := ParseTranscriptActivity(strings.NewReader(transcript))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{EntryPrompt, EntryToolUse, EntryToolResult, EntryReply}
+	if len(got) != len(want) {
+		t.Fatalf("got %d entries, want %d: %+v", len(got), len(want), got)
+	}
+	for i, k := range want {
+		if got[i].Kind != k {
+			t.Errorf("entry %d kind = %s, want %s", i, got[i].Kind, k)
+		}
+	}
+}
+
+func TestAnalyzeActivity(t *testing.T) {
+	at := func(d time.Duration, kind string) ActivityEntry { return ActivityEntry{At: t0.Add(d), Kind: kind} }
+	entries := []ActivityEntry{
+		at(10*time.Minute, EntryPrompt),
+		at(11*time.Minute, EntryToolUse),    // 1m generating
+		at(16*time.Minute, EntryToolResult), // 5m waiting
+		at(18*time.Minute, EntryReply),      // 2m generating
+		at(50*time.Minute, EntryPrompt),     // 32m idle
+		at(80*time.Minute, EntryReply),      // 30m gap, capped at 10m generating
+	}
+	u := AnalyzeActivity("gastown/polecats/Toast", entries, t0, t0.Add(2*time.Hour))
+
+	if u.Wall != 110*time.Minute {
+		t.Errorf("Wall = %v, want 110m (from first entry)", u.Wall)
+	}
+	if u.Generating != 13*time.Minute {
+		t.Errorf("Generating = %v, want 13m", u.Generating)
+	}
+	if u.Waiting != 5*time.Minute {
+		t.Errorf("Waiting = %v, want 5m", u.Waiting)
+	}
+	if u.Idle != 92*time.Minute {
+		t.Errorf("Idle = %v, want 92m", u.Idle)
+	}
+	if u.Turns != 3 {
+		t.Errorf("Turns = %d, want 3", u.Turns)
+	}
+
+	// Entries before the window only contribute the part of a gap inside it.
+	u = AnalyzeActivity("a", entries, t0.Add(15*time.Minute), t0.Add(20*time.Minute))
+	if u.Wall != 5*time.Minute || u.Waiting != time.Minute || u.Generating != 2*time.Minute {
+		t.Errorf("clipped = %+v, want wall 5m, waiting 1m, generating 2m", u)
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	util := func(gen, wait, idle time.Duration) []Utilization {
+		return []Utilization{{Wall: gen + wait + idle, Generating: gen, Waiting: wait, Idle: idle}}
+	}
+	calm := HostUtilization{CPUs: 8, Load15: 2, MemTotalKB: 100, MemAvailableKB: 50}
+	busy := HostUtilization{CPUs: 8, Load15: 12, MemTotalKB: 100, MemAvailableKB: 50}
+
+	tests := []struct {
+		name   string
+		agents []Utilization
+		host   HostUtilization
+		queued int
+		want   string
+	}{
+		{"no activity", nil, calm, 0, BottleneckNone},
+		{"idle, empty queue", util(time.Hour, time.Hour, 5*time.Hour), calm, 0, BottleneckWork},
+		{"idle, work queued", util(time.Hour, time.Hour, 5*time.Hour), calm, 3, BottleneckDispatch},
+		{"model bound", util(5*time.Hour, time.Hour, time.Hour), calm, 0, BottleneckModel},
+		{"tool bound", util(time.Hour, 5*time.Hour, time.Hour), calm, 0, BottleneckHost},
+		{"host saturated", util(5*time.Hour, time.Hour, time.Hour), busy, 0, BottleneckHost},
+	}
+	for _, tt := range tests {
+		if got := Diagnose(tt.agents, tt.host, tt.queued); got.Kind != tt.want {
+			t.Errorf("%s: Diagnose() = %+v, want %s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -51,10 +51,19 @@ var (
 	ArrowPrefix = Info.Render("→")
 )
 
+// ApplyTheme rebuilds the prefixes for the current ui theme mode, so they
+// are spelled out in screen-reader mode. Call it after ui.InitTheme.
+func ApplyTheme() {
+	SuccessPrefix = Success.Render(ui.Glyph(ui.IconPass))
+	WarningPrefix = Warning.Render(ui.Glyph(ui.IconWarn))
+	ErrorPrefix = Error.Render(ui.Glyph(ui.IconFail))
+	ArrowPrefix = Info.Render(ui.Glyph("→"))
+}
+
 // PrintWarning prints a warning message to stderr with consistent formatting.
 // The format and args work like fmt.Printf.
 // Writes to stderr so warnings never contaminate structured (JSON) output on stdout.
 func PrintWarning(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	fmt.Fprintf(os.Stderr, "%s %s\n", Warning.Render(ui.IconWarn+" Warning:"), msg)
+	fmt.Fprintf(os.Stderr, "%s %s\n", Warning.Render(ui.Glyph(ui.IconWarn)+" Warning:"), msg)
 }
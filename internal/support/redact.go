@@ -0,0 +1,111 @@
+package support
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces every secret in a support bundle.
+const Redacted = "[REDACTED]"
+
+// secretKey matches config keys and environment variable names whose values
+// are credentials.
+var secretKey = regexp.MustCompile(`(?i)(token|secret|passw(or)?d|api[_-]?key|access[_-]?key|private[_-]?key|credential|auth|webhook|cookie)`)
+
+// secretValue matches credential-shaped strings wherever they appear.
+var secretValue = regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{20,}|github_pat_[A-Za-z0-9_]{20,}|sk-[A-Za-z0-9_-]{20,}|xox[abpr]-[A-Za-z0-9-]{10,}|AKIA[0-9A-Z]{16})\b`)
+
+// urlCredentials matches the user-info part of a URL.
+var urlCredentials = regexp.MustCompile(`://[^/\s:@"']+(:[^/\s@"']*)?@`)
+
+// secretAssignment matches "key: value" and "key=value" lines with a secret key.
+var secretAssignment = regexp.MustCompile(`(?i)((?:token|secret|passw(?:or)?d|api[_-]?key|access[_-]?key|private[_-]?key|credential|auth\w*|webhook\w*)["']?\s*[:=]\s*)("[^"]*"|'[^']*'|\S+)`)
+
+// Redactor scrubs secrets from files and values, counting what it removed.
+type Redactor struct {
+	Count int
+}
+
+// File redacts a file's contents. JSON is redacted structurally, by key;
+// anything else (or JSON that doesn't parse) line by line.
+func (r *Redactor) File(name string, data []byte) []byte {
+	if filepath.Ext(name) == ".json" {
+		if out, ok := r.json(data); ok {
+			return out
+		}
+	}
+	return r.Text(data)
+}
+
+// Text redacts secret assignments, credential-shaped tokens, and URL
+// credentials from free text.
+func (r *Redactor) Text(data []byte) []byte {
+	data = secretAssignment.ReplaceAllFunc(data, func(m []byte) []byte {
+		r.Count++
+		sub := secretAssignment.FindSubmatch(m)
+		return append(append([]byte(nil), sub[1]...), Redacted...)
+	})
+	return []byte(r.String(string(data)))
+}
+
+// String redacts credential-shaped tokens and URL credentials from s,
+// counting s once however many it held.
+func (r *Redactor) String(s string) string {
+	out := secretValue.ReplaceAllString(s, Redacted)
+	out = urlCredentials.ReplaceAllString(out, "://"+Redacted+"@")
+	if out != s {
+		r.Count++
+	}
+	return out
+}
+
+// Env redacts an environment variable's value if its name or value looks
+// like a credential.
+func (r *Redactor) Env(name, value string) string {
+	if secretKey.MatchString(name) && value != "" {
+		r.Count++
+		return Redacted
+	}
+	return r.String(value)
+}
+
+func (r *Redactor) json(data []byte) ([]byte, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	out, err := json.MarshalIndent(r.value("", v), "", "  ")
+	if err != nil {
+		return nil, false
+	}
+	return append(out, '\n'), true
+}
+
+func (r *Redactor) value(key string, v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			t[k] = r.value(k, child)
+		}
+		return t
+	case []interface{}:
+		for i, child := range t {
+			t[i] = r.value(key, child)
+		}
+		return t
+	case string:
+		// References to environment variables ("$GH_TOKEN") are not secrets.
+		if t != "" && secretKey.MatchString(key) && !strings.HasPrefix(t, "$") {
+			r.Count++
+			return Redacted
+		}
+		return r.String(t)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,200 @@
+// Package support builds support bundles: shareable tarballs holding what
+// maintainers need to diagnose a bug report against gastown itself.
+//
+// A bundle holds version and environment details, a doctor report, the
+// town and rig configuration, and the tails of the daemon and town logs.
+// Everything passes through a Redactor on the way in, so credentials in
+// configs, logs, and the environment never leave the machine.
+package support
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/backup"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// logTailLines is how much of each log the bundle keeps.
+const logTailLines = 1000
+
+// envPrefixes selects the environment variables worth including.
+var envPrefixes = []string{"GT_", "BD_", "BEADS_", "DOLT_", "CLAUDE_", "OTEL_", "TMUX", "SHELL", "TERM", "LANG", "PATH"}
+
+// tools whose versions go into environment.txt.
+var tools = [][]string{
+	{"git", "--version"},
+	{"tmux", "-V"},
+	{"bd", "version"},
+	{"dolt", "version"},
+}
+
+// Options configures Create.
+type Options struct {
+	// Path is where the tarball is written.
+	Path string
+
+	// Version describes the gt binary (the cmd package owns build info).
+	Version string
+
+	// Doctor, if set, writes a doctor report to w.
+	Doctor func(w io.Writer) error
+}
+
+// Manifest describes a bundle. It is written into the bundle as
+// manifest.json.
+type Manifest struct {
+	CreatedAt  time.Time `json:"created_at"`
+	Version    string    `json:"version"`
+	Files      []string  `json:"files"`
+	Redactions int       `json:"redactions"`
+	Errors     []string  `json:"errors,omitempty"`
+}
+
+// Create writes a support bundle for the town to opts.Path.
+func Create(townRoot string, opts Options) (*Manifest, error) {
+	m := &Manifest{CreatedAt: time.Now().UTC(), Version: opts.Version}
+	r := &Redactor{}
+
+	tmp := opts.Path + ".partial"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600) //nolint:gosec // G304: path is user-chosen output
+	if err != nil {
+		return nil, fmt.Errorf("creating bundle: %w", err)
+	}
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	add := func(name string, data []byte) {
+		hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: m.CreatedAt}
+		if err := tw.WriteHeader(hdr); err != nil {
+			m.Errors = append(m.Errors, fmt.Sprintf("%s: %v", name, err))
+			return
+		}
+		if _, err := tw.Write(data); err != nil {
+			m.Errors = append(m.Errors, fmt.Sprintf("%s: %v", name, err))
+			return
+		}
+		m.Files = append(m.Files, name)
+	}
+
+	add("version.txt", []byte(opts.Version+"\n"))
+	add("environment.txt", environment(r))
+
+	if opts.Doctor != nil {
+		var buf strings.Builder
+		if err := opts.Doctor(&buf); err != nil {
+			m.Errors = append(m.Errors, fmt.Sprintf("doctor: %v", err))
+		}
+		add("doctor.txt", r.Text([]byte(buf.String())))
+	}
+
+	for _, rel := range backup.ConfigFiles(townRoot) {
+		data, err := os.ReadFile(filepath.Join(townRoot, filepath.FromSlash(rel))) //nolint:gosec // G304: path from town config listing
+		if err != nil {
+			m.Errors = append(m.Errors, fmt.Sprintf("%s: %v", rel, err))
+			continue
+		}
+		add("config/"+rel, r.File(rel, data))
+	}
+
+	for _, rel := range logFiles(townRoot) {
+		data, err := util.TailLines(filepath.Join(townRoot, rel), logTailLines)
+		if err != nil {
+			m.Errors = append(m.Errors, fmt.Sprintf("%s: %v", rel, err))
+			continue
+		}
+		add("logs/"+filepath.ToSlash(rel), r.Text(data))
+	}
+
+	// Manifest goes last so it reflects everything above.
+	m.Redactions = r.Count
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err == nil {
+		add("manifest.json", append(data, '\n'))
+	}
+
+	if cerr := tw.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := gz.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return nil, fmt.Errorf("writing bundle: %w", err)
+	}
+	if err := os.Rename(tmp, opts.Path); err != nil {
+		_ = os.Remove(tmp)
+		return nil, fmt.Errorf("finalizing bundle: %w", err)
+	}
+	return m, nil
+}
+
+// logFiles returns the town-relative paths of the daemon and town logs.
+func logFiles(townRoot string) []string {
+	var out []string
+	for _, pattern := range []string{"daemon/*.log", "logs/*.log"} {
+		matches, _ := filepath.Glob(filepath.Join(townRoot, pattern))
+		for _, p := range matches {
+			if rel, err := filepath.Rel(townRoot, p); err == nil {
+				out = append(out, rel)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// environment describes the host, tool versions, and the relevant
+// environment variables, with secret-looking variables redacted.
+func environment(r *Redactor) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "os: %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(&b, "go: %s\n", runtime.Version())
+	fmt.Fprintf(&b, "cpus: %d\n", runtime.NumCPU())
+
+	b.WriteString("\n# tools\n")
+	for _, tool := range tools {
+		fmt.Fprintf(&b, "%s: %s\n", tool[0], toolVersion(tool[0], tool[1:]...))
+	}
+
+	b.WriteString("\n# environment\n")
+	env := os.Environ()
+	sort.Strings(env)
+	for _, kv := range env {
+		name, value, _ := strings.Cut(kv, "=")
+		for _, prefix := range envPrefixes {
+			if strings.HasPrefix(name, prefix) {
+				fmt.Fprintf(&b, "%s=%s\n", name, r.Env(name, value))
+				break
+			}
+		}
+	}
+	return []byte(b.String())
+}
+
+// toolVersion runs a tool's version command. A variable so tests can run
+// without the tools installed.
+var toolVersion = func(name string, args ...string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, name, args...).Output()
+	if err != nil {
+		return "not available (" + err.Error() + ")"
+	}
+	return strings.TrimSpace(strings.SplitN(string(out), "\n", 2)[0])
+}
@@ -0,0 +1,92 @@
+package support
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readBundle(t *testing.T, path string) map[string]string {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(tr)
+		files[hdr.Name] = string(data)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	town := t.TempDir()
+	orig := toolVersion
+	t.Cleanup(func() { toolVersion = orig })
+	toolVersion = func(name string, _ ...string) string { return name + " 1.0" }
+	t.Setenv("GT_TEST_SECRET", "hunter2")
+
+	for rel, content := range map[string]string{
+		"mayor/town.json":     `{"name":"test","webhook_url":"https://hooks.example.com/abc"}`,
+		"daemon/daemon.log":   "started\nauth=letmein\n",
+		"logs/town.log":       "spawned polecat\n",
+		"settings/notes.txt":  "nothing secret\n",
+		"unrelated/file.json": `{"token":"x"}`,
+	} {
+		path := filepath.Join(town, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	m, err := Create(town, Options{Path: out, Version: "gt version test", Doctor: func(w io.Writer) error {
+		_, err := io.WriteString(w, "✓ all checks passed\n")
+		return err
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := readBundle(t, out)
+
+	for _, name := range []string{"version.txt", "environment.txt", "doctor.txt", "config/mayor/town.json", "config/settings/notes.txt", "logs/daemon/daemon.log", "logs/logs/town.log", "manifest.json"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("bundle missing %s (have %v)", name, m.Files)
+		}
+	}
+	if _, ok := files["config/unrelated/file.json"]; ok {
+		t.Error("bundle includes files outside the town config")
+	}
+	all := strings.Join([]string{files["config/mayor/town.json"], files["logs/daemon/daemon.log"], files["environment.txt"]}, "\n")
+	for _, leaked := range []string{"hooks.example.com", "letmein", "hunter2"} {
+		if strings.Contains(all, leaked) {
+			t.Errorf("bundle leaks %q", leaked)
+		}
+	}
+	if !strings.Contains(files["environment.txt"], "tmux: tmux 1.0") {
+		t.Errorf("environment.txt missing tool versions:\n%s", files["environment.txt"])
+	}
+	if m.Redactions < 3 { // The test's own environment may add more
+		t.Errorf("Redactions = %d, want at least 3", m.Redactions)
+	}
+}
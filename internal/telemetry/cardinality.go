@@ -0,0 +1,196 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/constants"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// metricAttrAllowlist is the set of attribute keys allowed on metrics. Every
+// key here must draw its values from a small, bounded set: a metric series is
+// created per distinct attribute combination, so one unbounded value (a
+// session ID, a bead ID) turns a counter into millions of series.
+//
+// Identifiers belong on log events, which have no such limit. Extend this
+// list only with keys whose values are bounded.
+var metricAttrAllowlist = map[string]bool{
+	"status":     true, // ok | error
+	"subcommand": true, // bd subcommand
+	"role":       true, // agent role
+	"hook_mode":  true,
+	"new_state":  true, // agent state
+	"operation":  true, // mail operation
+	"exit_type":  true, // COMPLETED | ESCALATED | DEFERRED
+	"agent_type": true, // deacon, witness-<rig>, ...
+	"formula":    true, // formula names
+	"rig":        true, // rig names
+	"agent":      true, // agent names within a rig
+	"label":      true, // wisp metric label (bounded by wisp.MetricLabel)
+	"step":       true, // prewarm step
+	"key":        true, // offending key on the violations counter itself
+	"rule":       true, // input guard rule name (bounded by configured rules)
+	"action":     true, // input guard action: block | confirm
+	"outcome":    true, // input guard outcome: blocked | confirmed
+	"command":    true, // tmux subcommand
+	"class":      true, // tmux error class
+}
+
+// CardinalityViolation records a metric attribute the guard stripped.
+type CardinalityViolation struct {
+	Key      string    `json:"key"`
+	Count    int64     `json:"count"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+var (
+	violationsMu sync.Mutex
+	violations   = map[string]*CardinalityViolation{}
+
+	violationCounterOnce sync.Once
+	violationCounter     metric.Int64Counter
+)
+
+// guardMetricAttrs strips attributes whose key is not on the allowlist,
+// counting each stripped key so the offending call site can be found.
+func guardMetricAttrs(attrs []attribute.KeyValue) []attribute.KeyValue {
+	var bad []string
+	for _, kv := range attrs {
+		if !metricAttrAllowlist[string(kv.Key)] {
+			bad = append(bad, string(kv.Key))
+		}
+	}
+	if len(bad) == 0 {
+		return attrs
+	}
+
+	out := make([]attribute.KeyValue, 0, len(attrs)-len(bad))
+	for _, kv := range attrs {
+		if metricAttrAllowlist[string(kv.Key)] {
+			out = append(out, kv)
+		}
+	}
+	for _, key := range bad {
+		recordViolation(key)
+	}
+	return out
+}
+
+func recordViolation(key string) {
+	violationsMu.Lock()
+	v := violations[key]
+	if v == nil {
+		v = &CardinalityViolation{Key: key}
+		violations[key] = v
+	}
+	v.Count++
+	v.LastSeen = time.Now().UTC()
+	violationsMu.Unlock()
+
+	violationCounterOnce.Do(func() {
+		violationCounter, _ = otel.GetMeterProvider().Meter(meterRecorderName).Int64Counter("gastown.telemetry.cardinality_violations.total",
+			metric.WithDescription("Metric attributes stripped by the cardinality guard"),
+		)
+	})
+	if violationCounter != nil {
+		violationCounter.Add(context.Background(), 1, metric.WithAttributes(attribute.String("key", key)))
+	}
+}
+
+// CardinalityViolations returns the violations seen by this process.
+func CardinalityViolations() []CardinalityViolation {
+	violationsMu.Lock()
+	defer violationsMu.Unlock()
+	out := make([]CardinalityViolation, 0, len(violations))
+	for _, v := range violations {
+		out = append(out, *v)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// CardinalityReportPath returns where violations are persisted for doctor.
+func CardinalityReportPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "telemetry-cardinality.json")
+}
+
+// ReadCardinalityReport returns the violations recorded in townRoot, or nil
+// if none have been.
+func ReadCardinalityReport(townRoot string) ([]CardinalityViolation, error) {
+	data, err := os.ReadFile(CardinalityReportPath(townRoot)) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading cardinality report: %w", err)
+	}
+	var report []CardinalityViolation
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("parsing cardinality report: %w", err)
+	}
+	return report, nil
+}
+
+// SaveCardinalityReport merges this process's violations into the town's
+// report. A no-op when there are none, so clean processes never touch disk.
+func SaveCardinalityReport(townRoot string) error {
+	seen := CardinalityViolations()
+	if len(seen) == 0 || townRoot == "" {
+		return nil
+	}
+	path := CardinalityReportPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating runtime dir: %w", err)
+	}
+	// gt processes exit concurrently; serialize the read-merge-write.
+	fl := flock.New(path + ".lock")
+	if err := fl.Lock(); err != nil {
+		return fmt.Errorf("locking cardinality report: %w", err)
+	}
+	defer func() { _ = fl.Unlock() }()
+
+	report, err := ReadCardinalityReport(townRoot)
+	if err != nil {
+		report = nil // Corrupt report: start over rather than lose new data.
+	}
+	byKey := map[string]*CardinalityViolation{}
+	for i := range report {
+		byKey[report[i].Key] = &report[i]
+	}
+	var added []CardinalityViolation
+	for _, v := range seen {
+		if old := byKey[v.Key]; old != nil {
+			old.Count += v.Count
+			if v.LastSeen.After(old.LastSeen) {
+				old.LastSeen = v.LastSeen
+			}
+			continue
+		}
+		added = append(added, v)
+	}
+	report = append(report, added...)
+	sort.Slice(report, func(i, j int) bool { return report[i].Key < report[j].Key })
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil { //nolint:gosec // G306: report is not sensitive
+		return fmt.Errorf("writing cardinality report: %w", err)
+	}
+
+	violationsMu.Lock()
+	violations = map[string]*CardinalityViolation{}
+	violationsMu.Unlock()
+	return nil
+}
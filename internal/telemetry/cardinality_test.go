@@ -0,0 +1,80 @@
+package telemetry
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// resetViolations clears the process's recorded violations.
+func resetViolations(t *testing.T) {
+	t.Helper()
+	violationsMu.Lock()
+	violations = map[string]*CardinalityViolation{}
+	violationsMu.Unlock()
+	t.Cleanup(func() {
+		violationsMu.Lock()
+		violations = map[string]*CardinalityViolation{}
+		violationsMu.Unlock()
+	})
+}
+
+func TestGuardMetricAttrs(t *testing.T) {
+	resetViolations(t)
+
+	clean := []attribute.KeyValue{attribute.String("status", "ok"), attribute.String("rig", "gastown")}
+	if got := guardMetricAttrs(clean); len(got) != 2 {
+		t.Errorf("allowed attrs stripped: %v", got)
+	}
+	if len(CardinalityViolations()) != 0 {
+		t.Fatal("clean attrs recorded a violation")
+	}
+
+	got := guardMetricAttrs([]attribute.KeyValue{
+		attribute.String("status", "ok"),
+		attribute.String("session_id", "gt-toast-123"),
+		attribute.String("bead_id", "gt-4f2"),
+	})
+	if len(got) != 1 || got[0].Key != "status" {
+		t.Errorf("guarded attrs = %v, want only status", got)
+	}
+	guardMetricAttrs([]attribute.KeyValue{attribute.String("session_id", "gt-nux-9")})
+
+	v := CardinalityViolations()
+	if len(v) != 2 || v[0].Key != "bead_id" || v[1].Key != "session_id" || v[1].Count != 2 {
+		t.Errorf("violations = %+v", v)
+	}
+}
+
+func TestSaveCardinalityReport(t *testing.T) {
+	resetViolations(t)
+	town := t.TempDir()
+
+	if err := SaveCardinalityReport(town); err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := ReadCardinalityReport(town); r != nil {
+		t.Fatalf("clean process wrote a report: %+v", r)
+	}
+
+	recordViolation("session_id")
+	if err := SaveCardinalityReport(town); err != nil {
+		t.Fatal(err)
+	}
+	recordViolation("session_id")
+	recordViolation("bead")
+	if err := SaveCardinalityReport(town); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ReadCardinalityReport(town)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 2 || r[0].Key != "bead" || r[1].Key != "session_id" || r[1].Count != 2 {
+		t.Errorf("report = %+v, want merged counts", r)
+	}
+	if len(CardinalityViolations()) != 0 {
+		t.Error("saved violations not cleared from the process")
+	}
+}
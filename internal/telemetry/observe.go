@@ -0,0 +1,65 @@
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
+)
+
+// Observe runs fn as the named operation and records it the RED way: rate
+// (gastown.op.calls.total), errors (the same counter's status attribute),
+// and duration (gastown.op.duration_ms), plus an "op" log event. It returns
+// fn's error unchanged.
+//
+// New subsystems should wrap their operations with Observe instead of adding
+// a Record* function per operation:
+//
+//	err := telemetry.Observe(ctx, "archive.sweep", func() error {
+//		return sweep(townRoot)
+//	})
+//
+// operation names a code path ("subsystem.verb"), never data, since it is a
+// metric attribute. attrs are added to both the metrics and the log event;
+// keys outside the metric allowlist (see cardinality.go) are logged but
+// stripped from metrics, so identifiers belong there only on purpose.
+func Observe(ctx context.Context, operation string, fn func() error, attrs ...attribute.KeyValue) error {
+	start := time.Now()
+	err := fn()
+	recordOp(ctx, operation, time.Since(start), err, attrs)
+	return err
+}
+
+// ObserveValue is Observe for operations that return a value.
+func ObserveValue[T any](ctx context.Context, operation string, fn func() (T, error), attrs ...attribute.KeyValue) (T, error) {
+	start := time.Now()
+	v, err := fn()
+	recordOp(ctx, operation, time.Since(start), err, attrs)
+	return v, err
+}
+
+func recordOp(ctx context.Context, operation string, d time.Duration, err error, attrs []attribute.KeyValue) {
+	initInstruments()
+	status := statusStr(err)
+	durationMs := float64(d.Microseconds()) / 1000
+
+	metricKVs := append([]attribute.KeyValue{
+		attribute.String("operation", operation),
+		attribute.String("status", status),
+	}, attrs...)
+	opts := metricAttrs(metricKVs...)
+	inst.opTotal.Add(ctx, 1, opts)
+	inst.opDurationHist.Record(ctx, durationMs, opts)
+
+	kvs := []otellog.KeyValue{
+		otellog.String("operation", operation),
+		otellog.Float64("duration_ms", durationMs),
+		otellog.String("status", status),
+		errKV(err),
+	}
+	for _, a := range attrs {
+		kvs = append(kvs, otellog.String(string(a.Key), a.Value.Emit()))
+	}
+	emit(ctx, "op", severity(err), kvs...)
+}
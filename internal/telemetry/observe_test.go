@@ -0,0 +1,105 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// observeReader installs a meter provider backed by a manual reader for the
+// duration of the test.
+func observeReader(t *testing.T) *sdkmetric.ManualReader {
+	t.Helper()
+	reader := sdkmetric.NewManualReader()
+	prev := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(prev) })
+	resetInstruments(t)
+	return reader
+}
+
+// opCalls returns the gastown.op.calls.total data points keyed by status.
+func opCalls(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.DataPoint[int64] {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+	out := make(map[string]metricdata.DataPoint[int64])
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "gastown.op.calls.total" {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				status, _ := dp.Attributes.Value("status")
+				out[status.AsString()] = dp
+			}
+		}
+	}
+	return out
+}
+
+func TestObserve_RecordsCallsAndErrors(t *testing.T) {
+	reader := observeReader(t)
+	ctx := context.Background()
+	boom := errors.New("boom")
+
+	calls := 0
+	for i := 0; i < 2; i++ {
+		if err := Observe(ctx, "test.op", func() error { calls++; return nil }); err != nil {
+			t.Fatalf("Observe: %v", err)
+		}
+	}
+	if err := Observe(ctx, "test.op", func() error { return boom }); !errors.Is(err, boom) {
+		t.Fatalf("Observe error = %v, want %v", err, boom)
+	}
+	if calls != 2 {
+		t.Errorf("fn called %d times, want 2", calls)
+	}
+
+	points := opCalls(t, reader)
+	if got := points["ok"].Value; got != 2 {
+		t.Errorf("ok calls = %d, want 2", got)
+	}
+	if got := points["error"].Value; got != 1 {
+		t.Errorf("error calls = %d, want 1", got)
+	}
+	okPoint := points["ok"]
+	op, _ := okPoint.Attributes.Value("operation")
+	if op.AsString() != "test.op" {
+		t.Errorf("operation = %q, want test.op", op.AsString())
+	}
+}
+
+func TestObserve_DropsHighCardinalityAttrs(t *testing.T) {
+	reader := observeReader(t)
+	_ = Observe(context.Background(), "test.op", func() error { return nil },
+		attribute.String("rig", "gastown"),
+		attribute.String("session", "gt-gastown-polecat-nux"),
+	)
+
+	dp, ok := opCalls(t, reader)["ok"]
+	if !ok {
+		t.Fatal("no ok data point")
+	}
+	if _, ok := dp.Attributes.Value("rig"); !ok {
+		t.Error("allowlisted attribute rig missing")
+	}
+	if _, ok := dp.Attributes.Value("session"); ok {
+		t.Error("high-cardinality attribute session kept on metric")
+	}
+}
+
+func TestObserveValue(t *testing.T) {
+	resetInstruments(t)
+	n, err := ObserveValue(context.Background(), "test.value", func() (int, error) { return 42, nil })
+	if err != nil || n != 42 {
+		t.Errorf("ObserveValue = %d, %v; want 42, nil", n, err)
+	}
+}
@@ -0,0 +1,135 @@
+package telemetry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// EnvLogsDisabled turns off log export entirely ("true") while metrics
+	// keep flowing.
+	EnvLogsDisabled = "GT_OTEL_LOGS_DISABLED"
+
+	// EnvDropAttrs is a comma-separated list of attribute keys stripped from
+	// every log event and metric point (e.g. "content_len,keys_len,title").
+	EnvDropAttrs = "GT_OTEL_DROP_ATTRS"
+
+	// EnvHashIDs replaces identifier attributes (sessions, beads, agents)
+	// with a stable hash when "true", so events still correlate without
+	// exposing names.
+	EnvHashIDs = "GT_OTEL_HASH_IDS"
+)
+
+// identifierKeys are the attribute keys hashed under GT_OTEL_HASH_IDS.
+var identifierKeys = map[string]bool{
+	"session_id": true,
+	"session":    true,
+	"bead":       true,
+	"bead_id":    true,
+	"agent_id":   true,
+	"agent":      true,
+	"name":       true,
+	"target":     true,
+}
+
+// privacyPolicy is the process-wide telemetry privacy configuration, read
+// once from the environment. It is applied in emit and metricAttrs so that
+// Record* call sites never have to know about it.
+type privacyPolicy struct {
+	logsDisabled bool
+	hashIDs      bool
+	drop         map[string]bool
+}
+
+var (
+	privacyOnce sync.Once
+	privacy     privacyPolicy
+)
+
+// currentPrivacy returns the privacy policy, loading it on first use.
+func currentPrivacy() *privacyPolicy {
+	privacyOnce.Do(func() {
+		privacy = loadPrivacy()
+	})
+	return &privacy
+}
+
+func loadPrivacy() privacyPolicy {
+	p := privacyPolicy{
+		logsDisabled: os.Getenv(EnvLogsDisabled) == "true",
+		hashIDs:      os.Getenv(EnvHashIDs) == "true",
+	}
+	for _, key := range strings.Split(os.Getenv(EnvDropAttrs), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			if p.drop == nil {
+				p.drop = map[string]bool{}
+			}
+			p.drop[key] = true
+		}
+	}
+	return p
+}
+
+// LogsDisabled reports whether log export is turned off by GT_OTEL_LOGS_DISABLED.
+func LogsDisabled() bool {
+	return currentPrivacy().logsDisabled
+}
+
+// hashID returns a short, stable stand-in for an identifier.
+func hashID(s string) string {
+	if s == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(s))
+	return "h:" + hex.EncodeToString(sum[:6])
+}
+
+// filterLog applies the policy to log event attributes.
+func (p *privacyPolicy) filterLog(attrs []otellog.KeyValue) []otellog.KeyValue {
+	if len(p.drop) == 0 && !p.hashIDs {
+		return attrs
+	}
+	out := make([]otellog.KeyValue, 0, len(attrs))
+	for _, kv := range attrs {
+		if p.drop[kv.Key] {
+			continue
+		}
+		if p.hashIDs && identifierKeys[kv.Key] && kv.Value.Kind() == otellog.KindString {
+			kv = otellog.String(kv.Key, hashID(kv.Value.AsString()))
+		}
+		out = append(out, kv)
+	}
+	return out
+}
+
+// filterMetric applies the policy to metric attributes.
+func (p *privacyPolicy) filterMetric(attrs []attribute.KeyValue) []attribute.KeyValue {
+	if len(p.drop) == 0 && !p.hashIDs {
+		return attrs
+	}
+	out := make([]attribute.KeyValue, 0, len(attrs))
+	for _, kv := range attrs {
+		key := string(kv.Key)
+		if p.drop[key] {
+			continue
+		}
+		if p.hashIDs && identifierKeys[key] && kv.Value.Type() == attribute.STRING {
+			kv = attribute.String(key, hashID(kv.Value.AsString()))
+		}
+		out = append(out, kv)
+	}
+	return out
+}
+
+// metricAttrs is metric.WithAttributes with the privacy policy and the
+// cardinality guard (see cardinality.go) applied.
+func metricAttrs(attrs ...attribute.KeyValue) metric.MeasurementOption {
+	return metric.WithAttributes(guardMetricAttrs(currentPrivacy().filterMetric(attrs))...)
+}
@@ -0,0 +1,77 @@
+package telemetry
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
+)
+
+// resetPrivacy re-reads the privacy env vars on next use.
+func resetPrivacy(t *testing.T) {
+	t.Helper()
+	privacyOnce = sync.Once{}
+	t.Cleanup(func() { privacyOnce = sync.Once{} })
+}
+
+func TestPrivacy_DefaultPassesThrough(t *testing.T) {
+	t.Setenv(EnvDropAttrs, "")
+	t.Setenv(EnvHashIDs, "")
+	t.Setenv(EnvLogsDisabled, "")
+	resetPrivacy(t)
+
+	attrs := []otellog.KeyValue{otellog.String("session_id", "gt-mayor"), otellog.Int64("content_len", 42)}
+	got := currentPrivacy().filterLog(attrs)
+	if len(got) != 2 || got[0].Value.AsString() != "gt-mayor" {
+		t.Errorf("filterLog = %v, want unchanged", got)
+	}
+	if LogsDisabled() {
+		t.Error("logs disabled by default")
+	}
+}
+
+func TestPrivacy_DropAndHash(t *testing.T) {
+	t.Setenv(EnvDropAttrs, "content_len, title")
+	t.Setenv(EnvHashIDs, "true")
+	resetPrivacy(t)
+	p := currentPrivacy()
+
+	got := p.filterLog([]otellog.KeyValue{
+		otellog.String("session_id", "gt-mayor"),
+		otellog.Int64("content_len", 42),
+		otellog.String("title", "fix the thing"),
+		otellog.String("status", "ok"),
+	})
+	if len(got) != 2 {
+		t.Fatalf("filterLog = %v, want session_id and status", got)
+	}
+	if id := got[0].Value.AsString(); id == "gt-mayor" || !strings.HasPrefix(id, "h:") || id != hashID("gt-mayor") {
+		t.Errorf("session_id = %q, want stable hash", id)
+	}
+	if got[1].Value.AsString() != "ok" {
+		t.Errorf("status = %v, want untouched", got[1])
+	}
+
+	m := p.filterMetric([]attribute.KeyValue{
+		attribute.String("agent", "toast"),
+		attribute.Int("content_len", 1),
+		attribute.String("rig", "gastown"),
+	})
+	if len(m) != 2 || m[0].Value.AsString() != hashID("toast") || m[1].Value.AsString() != "gastown" {
+		t.Errorf("filterMetric = %v", m)
+	}
+}
+
+func TestPrivacy_LogsDisabledKeepsRecording(t *testing.T) {
+	t.Setenv(EnvLogsDisabled, "true")
+	resetPrivacy(t)
+	resetInstruments(t)
+	if !LogsDisabled() {
+		t.Fatal("LogsDisabled() = false")
+	}
+	// Must not panic; metrics are still recorded against the noop provider.
+	RecordSessionStart(context.Background(), "gt-mayor", "mayor", nil)
+}
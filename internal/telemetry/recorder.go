@@ -9,6 +9,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 	"unicode/utf8"
 
 	"go.opentelemetry.io/otel"
@@ -30,8 +31,12 @@ type recorderInstruments struct {
 	sessionTotal        metric.Int64Counter
 	sessionStopTotal    metric.Int64Counter
 	promptTotal         metric.Int64Counter
+	promptBlockedTotal  metric.Int64Counter
+	promptCoalesced     metric.Int64Counter
+	promptQueueDepth    metric.Int64UpDownCounter
 	paneReadTotal       metric.Int64Counter
 	paneOutputTotal     metric.Int64Counter
+	tmuxErrorTotal      metric.Int64Counter
 	primeTotal         metric.Int64Counter
 	agentStateTotal    metric.Int64Counter
 	polecatTotal       metric.Int64Counter
@@ -43,9 +48,14 @@ type recorderInstruments struct {
 	daemonRestartTotal metric.Int64Counter
 	formulaTotal       metric.Int64Counter
 	convoyTotal        metric.Int64Counter
+	opTotal            metric.Int64Counter
 
 	// Histograms
-	bdDurationHist metric.Float64Histogram
+	bdDurationHist    metric.Float64Histogram
+	wispCycleHist     metric.Float64Histogram
+	wispQueueWaitHist metric.Float64Histogram
+	prewarmHist       metric.Float64Histogram
+	opDurationHist    metric.Float64Histogram
 }
 
 var (
@@ -73,12 +83,24 @@ func initInstruments() {
 		inst.promptTotal, _ = m.Int64Counter("gastown.prompt.sends.total",
 			metric.WithDescription("Total tmux SendKeys prompt dispatches"),
 		)
+		inst.promptBlockedTotal, _ = m.Int64Counter("gastown.prompt.blocked.total",
+			metric.WithDescription("Total prompt sends stopped by the session input guard"),
+		)
+		inst.promptCoalesced, _ = m.Int64Counter("gastown.prompt.coalesced.total",
+			metric.WithDescription("Total prompt sends merged into an identical queued send"),
+		)
+		inst.promptQueueDepth, _ = m.Int64UpDownCounter("gastown.prompt.queue.depth",
+			metric.WithDescription("Prompt sends waiting in per-session send queues"),
+		)
 		inst.paneReadTotal, _ = m.Int64Counter("gastown.pane.reads.total",
 			metric.WithDescription("Total tmux CapturePane calls"),
 		)
 		inst.paneOutputTotal, _ = m.Int64Counter("gastown.pane.output.total",
 			metric.WithDescription("Total pane output chunks emitted to VictoriaLogs"),
 		)
+		inst.tmuxErrorTotal, _ = m.Int64Counter("gastown.tmux.errors.total",
+			metric.WithDescription("Total failed tmux commands, by command and error class"),
+		)
 		inst.primeTotal, _ = m.Int64Counter("gastown.prime.total",
 			metric.WithDescription("Total gt prime invocations"),
 		)
@@ -113,11 +135,31 @@ func initInstruments() {
 			metric.WithDescription("Total auto-convoy creations"),
 		)
 
+		inst.opTotal, _ = m.Int64Counter("gastown.op.calls.total",
+			metric.WithDescription("Total observed operations (see Observe)"),
+		)
+
 		// Histograms
 		inst.bdDurationHist, _ = m.Float64Histogram("gastown.bd.duration_ms",
 			metric.WithDescription("bd CLI call round-trip latency in milliseconds"),
 			metric.WithUnit("ms"),
 		)
+		inst.wispCycleHist, _ = m.Float64Histogram("gastown.wisp.cycle_time_s",
+			metric.WithDescription("Wisp cycle time from claim to completion in seconds"),
+			metric.WithUnit("s"),
+		)
+		inst.wispQueueWaitHist, _ = m.Float64Histogram("gastown.wisp.queue_wait_s",
+			metric.WithDescription("Wisp wait time from sling to claim in seconds"),
+			metric.WithUnit("s"),
+		)
+		inst.prewarmHist, _ = m.Float64Histogram("gastown.polecat.prewarm_s",
+			metric.WithDescription("Polecat worktree pre-warm step duration in seconds"),
+			metric.WithUnit("s"),
+		)
+		inst.opDurationHist, _ = m.Float64Histogram("gastown.op.duration_ms",
+			metric.WithDescription("Observed operation duration in milliseconds (see Observe)"),
+			metric.WithUnit("ms"),
+		)
 	})
 }
 
@@ -130,12 +172,17 @@ func statusStr(err error) string {
 }
 
 // emit sends an OTel log event with the given body and key-value attributes.
+// The privacy policy (see privacy.go) is enforced here for every event.
 func emit(ctx context.Context, body string, sev otellog.Severity, attrs ...otellog.KeyValue) {
+	p := currentPrivacy()
+	if p.logsDisabled {
+		return
+	}
 	logger := global.GetLoggerProvider().Logger(loggerName)
 	var r otellog.Record
 	r.SetBody(otellog.StringValue(body))
 	r.SetSeverity(sev)
-	r.AddAttributes(attrs...)
+	r.AddAttributes(p.filterLog(attrs)...)
 	logger.Emit(ctx, r)
 }
 
@@ -191,7 +238,7 @@ func RecordBDCall(ctx context.Context, args []string, durationMs float64, err er
 		subcommand = args[0]
 	}
 	status := statusStr(err)
-	attrs := metric.WithAttributes(
+	attrs := metricAttrs(
 		attribute.String("status", status),
 		attribute.String("subcommand", subcommand),
 	)
@@ -219,7 +266,7 @@ func RecordSessionStart(ctx context.Context, sessionID, role string, err error)
 	initInstruments()
 	status := statusStr(err)
 	inst.sessionTotal.Add(ctx, 1,
-		metric.WithAttributes(
+		metricAttrs(
 			attribute.String("status", status),
 			attribute.String("role", role),
 		),
@@ -237,7 +284,7 @@ func RecordSessionStop(ctx context.Context, sessionID string, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.sessionStopTotal.Add(ctx, 1,
-		metric.WithAttributes(attribute.String("status", status)),
+		metricAttrs(attribute.String("status", status)),
 	)
 	emit(ctx, "session.stop", severity(err),
 		otellog.String("session_id", sessionID),
@@ -251,7 +298,7 @@ func RecordPromptSend(ctx context.Context, session, keys string, debounceMs int,
 	initInstruments()
 	status := statusStr(err)
 	inst.promptTotal.Add(ctx, 1,
-		metric.WithAttributes(attribute.String("status", status)),
+		metricAttrs(attribute.String("status", status)),
 	)
 	emit(ctx, "prompt.send", severity(err),
 		otellog.String("session", session),
@@ -262,12 +309,47 @@ func RecordPromptSend(ctx context.Context, session, keys string, debounceMs int,
 	)
 }
 
+// RecordPromptBlocked records a prompt send stopped by the session input
+// guard (metrics + log event). action is the rule's action ("block" or
+// "confirm"); outcome is "blocked", or "confirmed" when a human let a
+// confirm-rule match through.
+func RecordPromptBlocked(ctx context.Context, session, rule, action, outcome string) {
+	initInstruments()
+	inst.promptBlockedTotal.Add(ctx, 1,
+		metricAttrs(
+			attribute.String("rule", rule),
+			attribute.String("action", action),
+			attribute.String("outcome", outcome),
+		),
+	)
+	emit(ctx, "prompt.blocked", otellog.SeverityWarn,
+		otellog.String("session", session),
+		otellog.String("rule", rule),
+		otellog.String("action", action),
+		otellog.String("outcome", outcome),
+	)
+}
+
+// RecordPromptQueueDepth adjusts the number of prompt sends waiting in the
+// tmux send queues by delta (metric only; sends are logged by RecordPromptSend).
+func RecordPromptQueueDepth(ctx context.Context, delta int) {
+	initInstruments()
+	inst.promptQueueDepth.Add(ctx, int64(delta))
+}
+
+// RecordPromptCoalesced records a prompt send merged into an identical one
+// still waiting in its session's send queue (metric only).
+func RecordPromptCoalesced(ctx context.Context) {
+	initInstruments()
+	inst.promptCoalesced.Add(ctx, 1)
+}
+
 // RecordPaneRead records a tmux CapturePane call (metrics + log event).
 func RecordPaneRead(ctx context.Context, session string, lines, contentLen int, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.paneReadTotal.Add(ctx, 1,
-		metric.WithAttributes(attribute.String("status", status)),
+		metricAttrs(attribute.String("status", status)),
 	)
 	emit(ctx, "pane.read", severity(err),
 		otellog.String("session", session),
@@ -278,12 +360,31 @@ func RecordPaneRead(ctx context.Context, session string, lines, contentLen int,
 	)
 }
 
+// RecordTmuxError records a failed tmux command (metrics + log event).
+// command is the tmux subcommand and class its error class (see
+// tmux.ErrorClass); attempt counts retries of transient failures from 1.
+func RecordTmuxError(ctx context.Context, command, class string, attempt int, err error) {
+	initInstruments()
+	inst.tmuxErrorTotal.Add(ctx, 1,
+		metricAttrs(
+			attribute.String("command", command),
+			attribute.String("class", class),
+		),
+	)
+	emit(ctx, "tmux.error", otellog.SeverityWarn,
+		otellog.String("command", command),
+		otellog.String("class", class),
+		otellog.Int64("attempt", int64(attempt)),
+		errKV(err),
+	)
+}
+
 // RecordPrime records a gt prime invocation (metrics + log event).
 func RecordPrime(ctx context.Context, role string, hookMode bool, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.primeTotal.Add(ctx, 1,
-		metric.WithAttributes(
+		metricAttrs(
 			attribute.String("status", status),
 			attribute.String("role", role),
 			attribute.Bool("hook_mode", hookMode),
@@ -320,7 +421,7 @@ func RecordAgentStateChange(ctx context.Context, agentID, newState string, hookB
 	status := statusStr(err)
 	hasHookBead := hookBead != nil && *hookBead != ""
 	inst.agentStateTotal.Add(ctx, 1,
-		metric.WithAttributes(
+		metricAttrs(
 			attribute.String("status", status),
 			attribute.String("new_state", newState),
 		),
@@ -339,7 +440,7 @@ func RecordPolecatSpawn(ctx context.Context, name string, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.polecatTotal.Add(ctx, 1,
-		metric.WithAttributes(attribute.String("status", status)),
+		metricAttrs(attribute.String("status", status)),
 	)
 	emit(ctx, "polecat.spawn", severity(err),
 		otellog.String("name", name),
@@ -353,7 +454,7 @@ func RecordPolecatRemove(ctx context.Context, name string, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.polecatRemoveTotal.Add(ctx, 1,
-		metric.WithAttributes(attribute.String("status", status)),
+		metricAttrs(attribute.String("status", status)),
 	)
 	emit(ctx, "polecat.remove", severity(err),
 		otellog.String("name", name),
@@ -367,7 +468,7 @@ func RecordSling(ctx context.Context, bead, target string, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.slingTotal.Add(ctx, 1,
-		metric.WithAttributes(attribute.String("status", status)),
+		metricAttrs(attribute.String("status", status)),
 	)
 	emit(ctx, "sling", severity(err),
 		otellog.String("bead", bead),
@@ -382,7 +483,7 @@ func RecordMail(ctx context.Context, operation string, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.mailTotal.Add(ctx, 1,
-		metric.WithAttributes(
+		metricAttrs(
 			attribute.String("status", status),
 			attribute.String("operation", operation),
 		),
@@ -399,7 +500,7 @@ func RecordNudge(ctx context.Context, target string, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.nudgeTotal.Add(ctx, 1,
-		metric.WithAttributes(attribute.String("status", status)),
+		metricAttrs(attribute.String("status", status)),
 	)
 	emit(ctx, "nudge", severity(err),
 		otellog.String("target", target),
@@ -414,7 +515,7 @@ func RecordDone(ctx context.Context, exitType string, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.doneTotal.Add(ctx, 1,
-		metric.WithAttributes(
+		metricAttrs(
 			attribute.String("status", status),
 			attribute.String("exit_type", exitType),
 		),
@@ -426,12 +527,62 @@ func RecordDone(ctx context.Context, exitType string, err error) {
 	)
 }
 
+// RecordWispCycle records the timing of a completed wisp (metrics + log event).
+// queueWait is sling→claim and cycle is claim→completion; zero values are
+// unknown and not recorded in the histograms. label is the wisp's metric
+// label; callers must draw it from a bounded set (see wisp.MetricLabel).
+func RecordWispCycle(ctx context.Context, bead, rig, agent, label string, queueWait, cycle time.Duration) {
+	initInstruments()
+	attrs := metricAttrs(
+		attribute.String("rig", rig),
+		attribute.String("agent", agent),
+		attribute.String("label", label),
+	)
+	if cycle > 0 {
+		inst.wispCycleHist.Record(ctx, cycle.Seconds(), attrs)
+	}
+	if queueWait > 0 {
+		inst.wispQueueWaitHist.Record(ctx, queueWait.Seconds(), attrs)
+	}
+	emit(ctx, "wisp.cycle", otellog.SeverityInfo,
+		otellog.String("bead_id", bead),
+		otellog.String("rig", rig),
+		otellog.String("agent", agent),
+		otellog.String("label", label),
+		otellog.Float64("queue_wait_s", queueWait.Seconds()),
+		otellog.Float64("cycle_time_s", cycle.Seconds()),
+	)
+}
+
+// RecordPolecatPrewarm records one worktree pre-warm step (metrics + log
+// event). Skipped steps (worktree still warm) are logged but not timed.
+func RecordPolecatPrewarm(ctx context.Context, rig, step string, skipped bool, d time.Duration, err error) {
+	initInstruments()
+	if !skipped {
+		inst.prewarmHist.Record(ctx, d.Seconds(),
+			metricAttrs(
+				attribute.String("rig", rig),
+				attribute.String("step", step),
+				attribute.String("status", statusStr(err)),
+			),
+		)
+	}
+	emit(ctx, "polecat.prewarm", severity(err),
+		otellog.String("rig", rig),
+		otellog.String("step", step),
+		otellog.Bool("skipped", skipped),
+		otellog.Float64("duration_s", d.Seconds()),
+		otellog.String("status", statusStr(err)),
+		errKV(err),
+	)
+}
+
 // RecordDaemonRestart records a daemon-initiated agent session restart (metrics + log event).
 // agentType is e.g. "deacon", "witness-myrig", "refinery-myrig".
 func RecordDaemonRestart(ctx context.Context, agentType string) {
 	initInstruments()
 	inst.daemonRestartTotal.Add(ctx, 1,
-		metric.WithAttributes(attribute.String("agent_type", agentType)),
+		metricAttrs(attribute.String("agent_type", agentType)),
 	)
 	emit(ctx, "daemon.restart", otellog.SeverityInfo,
 		otellog.String("agent_type", agentType),
@@ -443,7 +594,7 @@ func RecordFormulaInstantiate(ctx context.Context, formulaName, beadID string, e
 	initInstruments()
 	status := statusStr(err)
 	inst.formulaTotal.Add(ctx, 1,
-		metric.WithAttributes(
+		metricAttrs(
 			attribute.String("status", status),
 			attribute.String("formula", formulaName),
 		),
@@ -461,7 +612,7 @@ func RecordConvoyCreate(ctx context.Context, beadID string, err error) {
 	initInstruments()
 	status := statusStr(err)
 	inst.convoyTotal.Add(ctx, 1,
-		metric.WithAttributes(attribute.String("status", status)),
+		metricAttrs(attribute.String("status", status)),
 	)
 	emit(ctx, "convoy.create", severity(err),
 		otellog.String("bead_id", beadID),
@@ -476,9 +627,9 @@ const maxPaneOutputLog = 8192
 // Opt-in: only called when GT_LOG_PANE_OUTPUT=true.
 func RecordPaneOutput(ctx context.Context, sessionID, content string) {
 	initInstruments()
-	inst.paneOutputTotal.Add(ctx, 1, metric.WithAttributes(
-		attribute.String("session", sessionID),
-	))
+	// The session goes on the log event only: as a metric attribute it
+	// would create a series per session.
+	inst.paneOutputTotal.Add(ctx, 1)
 	emit(ctx, "pane.output", otellog.SeverityInfo,
 		otellog.String("session", sessionID),
 		otellog.String("content", truncateOutput(content, maxPaneOutputLog)),
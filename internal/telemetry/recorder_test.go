@@ -99,6 +99,23 @@ func TestRecordBDCall_TruncatesLongOutput(t *testing.T) {
 	RecordBDCall(ctx, []string{"cmd"}, 1.0, nil, bigStdout, bigStderr)
 }
 
+func TestRecordTmuxError(t *testing.T) {
+	resetInstruments(t)
+	ctx := context.Background()
+
+	RecordTmuxError(ctx, "has-session", "session_missing", 1, errors.New("tmux has-session: can't find session: x"))
+	RecordTmuxError(ctx, "send-keys", "transient", 3, errors.New("tmux send-keys: Resource temporarily unavailable"))
+}
+
+func TestRecordPromptQueue(t *testing.T) {
+	resetInstruments(t)
+	ctx := context.Background()
+
+	RecordPromptQueueDepth(ctx, 1)
+	RecordPromptCoalesced(ctx)
+	RecordPromptQueueDepth(ctx, -1)
+}
+
 func TestRecordSessionStart(t *testing.T) {
 	resetInstruments(t)
 	ctx := context.Background()
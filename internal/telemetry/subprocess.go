@@ -25,6 +25,9 @@ func buildGTResourceAttrs() string {
 	} else if v := os.Getenv("GT_CREW"); v != "" {
 		attrs = append(attrs, "gt.agent="+v)
 	}
+	if v := os.Getenv("GT_EXPERIMENT"); v != "" {
+		attrs = append(attrs, "gt.experiment="+v)
+	}
 	return strings.Join(attrs, ",")
 }
 
@@ -8,6 +8,12 @@
 //	GT_OTEL_METRICS_URL  (default: http://localhost:8428/opentelemetry/api/v1/push)
 //	GT_OTEL_LOGS_URL     (default: http://localhost:9428/insert/opentelemetry/v1/logs)
 //
+// Privacy controls (applied centrally to every event, see privacy.go):
+//
+//	GT_OTEL_LOGS_DISABLED=true   no log export; metrics still flow
+//	GT_OTEL_DROP_ATTRS=a,b       strip these attribute keys everywhere
+//	GT_OTEL_HASH_IDS=true        hash session/bead/agent identifiers
+//
 // Telemetry is best-effort: initialization errors are returned but do not
 // affect normal gt operation — callers should log and continue.
 //
@@ -22,6 +28,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/log/global"
@@ -124,11 +131,16 @@ func Init(ctx context.Context, serviceName, serviceVersion string) (*Provider, e
 		logsURL = DefaultLogsURL
 	}
 
+	resAttrs := []attribute.KeyValue{
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(serviceVersion),
+	}
+	// Tag gt's own telemetry with the A/B experiment variant, if any.
+	if v := os.Getenv("GT_EXPERIMENT"); v != "" {
+		resAttrs = append(resAttrs, attribute.String("gt.experiment", v))
+	}
 	res, err := resource.New(ctx,
-		resource.WithAttributes(
-			semconv.ServiceName(serviceName),
-			semconv.ServiceVersion(serviceVersion),
-		),
+		resource.WithAttributes(resAttrs...),
 		resource.WithHost(),
 		resource.WithOS(),
 	)
@@ -157,19 +169,21 @@ func Init(ctx context.Context, serviceName, serviceVersion string) (*Provider, e
 	p.shutdowns = append(p.shutdowns, mp.Shutdown)
 	initInstruments()
 
-	// Logs → VictoriaLogs
-	logExp, err := otlploghttp.New(ctx,
-		otlploghttp.WithEndpointURL(logsURL),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("creating OTLP log exporter: %w", err)
+	// Logs → VictoriaLogs, unless turned off for privacy (metrics still export).
+	if !LogsDisabled() {
+		logExp, err := otlploghttp.New(ctx,
+			otlploghttp.WithEndpointURL(logsURL),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("creating OTLP log exporter: %w", err)
+		}
+		lp := sdklog.NewLoggerProvider(
+			sdklog.WithResource(res),
+			sdklog.WithProcessor(sdklog.NewBatchProcessor(logExp)),
+		)
+		global.SetLoggerProvider(lp)
+		p.shutdowns = append(p.shutdowns, lp.Shutdown)
 	}
-	lp := sdklog.NewLoggerProvider(
-		sdklog.WithResource(res),
-		sdklog.WithProcessor(sdklog.NewBatchProcessor(logExp)),
-	)
-	global.SetLoggerProvider(lp)
-	p.shutdowns = append(p.shutdowns, lp.Shutdown)
 
 	initDone = true
 	globalProvider = p
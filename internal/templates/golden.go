@@ -0,0 +1,205 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Golden tests render role templates against fixture contexts and compare the
+// output to checked-in golden files, so a template or RoleData change shows
+// up as a reviewable diff. Each fixture also bounds the rendered size in
+// (estimated) tokens, since prime output is paid for on every session start.
+//
+// A fixture directory holds <name>.json fixtures and <name>.golden.md
+// goldens side by side.
+
+// Fixture is one golden test case.
+type Fixture struct {
+	Name      string   `json:"-"` // From the file name
+	Role      string   `json:"role"`
+	Data      RoleData `json:"data"` // Keys are RoleData field names
+	MinTokens int      `json:"min_tokens,omitempty"`
+	MaxTokens int      `json:"max_tokens,omitempty"`
+}
+
+// GoldenOptions tune RunGolden.
+type GoldenOptions struct {
+	// RolesDir renders <RolesDir>/<role>.md.tmpl instead of the embedded
+	// templates, to test edits in a source checkout without rebuilding.
+	RolesDir string
+
+	// Update rewrites golden files from the rendered output.
+	Update bool
+}
+
+// GoldenResult is the outcome of one fixture.
+type GoldenResult struct {
+	Fixture  string   `json:"fixture"`
+	Role     string   `json:"role"`
+	Tokens   int      `json:"tokens"`
+	Golden   string   `json:"golden"`
+	Updated  bool     `json:"updated,omitempty"`
+	Diff     []string `json:"diff,omitempty"`     // Changed lines, "-" golden / "+" rendered
+	Failures []string `json:"failures,omitempty"` // Render errors, missing golden, token bounds
+}
+
+// Passed reports whether the fixture matched its golden and token bounds.
+func (r GoldenResult) Passed() bool {
+	return len(r.Diff) == 0 && len(r.Failures) == 0
+}
+
+// EstimateTokens approximates the token count of rendered text at four bytes
+// per token, which tracks Claude's tokenizer closely enough on English
+// markdown to catch budget regressions.
+func EstimateTokens(s string) int {
+	return (len(s) + 3) / 4
+}
+
+// LoadFixtures reads the fixtures in dir, sorted by name.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	fixtures := make([]Fixture, 0, len(paths))
+	for _, p := range paths {
+		data, err := os.ReadFile(p) //nolint:gosec // G304: fixture paths come from the caller's directory
+		if err != nil {
+			return nil, err
+		}
+		var f Fixture
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", p, err)
+		}
+		f.Name = strings.TrimSuffix(filepath.Base(p), ".json")
+		if f.Role == "" {
+			return nil, fmt.Errorf("%s: role is required", p)
+		}
+		if f.Data.Role == "" {
+			f.Data.Role = f.Role
+		}
+		fixtures = append(fixtures, f)
+	}
+	return fixtures, nil
+}
+
+// GoldenPath returns where a fixture's golden output lives.
+func GoldenPath(dir, fixture string) string {
+	return filepath.Join(dir, fixture+".golden.md")
+}
+
+// RunGolden renders every fixture in dir and compares it to its golden.
+func RunGolden(dir string, opts GoldenOptions) ([]GoldenResult, error) {
+	fixtures, err := LoadFixtures(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(fixtures) == 0 {
+		return nil, fmt.Errorf("no fixtures in %s", dir)
+	}
+	tmpl, err := New()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]GoldenResult, 0, len(fixtures))
+	for _, f := range fixtures {
+		r := GoldenResult{Fixture: f.Name, Role: f.Role, Golden: GoldenPath(dir, f.Name)}
+
+		var out string
+		if opts.RolesDir != "" {
+			out, err = RenderRoleFile(filepath.Join(opts.RolesDir, f.Role+".md.tmpl"), f.Data)
+		} else {
+			out, err = tmpl.RenderRole(f.Role, f.Data)
+		}
+		if err != nil {
+			r.Failures = append(r.Failures, err.Error())
+			results = append(results, r)
+			continue
+		}
+
+		r.Tokens = EstimateTokens(out)
+		if f.MaxTokens > 0 && r.Tokens > f.MaxTokens {
+			r.Failures = append(r.Failures, fmt.Sprintf("~%d tokens exceeds max_tokens %d", r.Tokens, f.MaxTokens))
+		}
+		if f.MinTokens > 0 && r.Tokens < f.MinTokens {
+			r.Failures = append(r.Failures, fmt.Sprintf("~%d tokens is under min_tokens %d", r.Tokens, f.MinTokens))
+		}
+
+		if opts.Update {
+			if err := os.WriteFile(r.Golden, []byte(out), 0644); err != nil { //nolint:gosec // G306: goldens are checked-in text
+				return nil, fmt.Errorf("writing %s: %w", r.Golden, err)
+			}
+			r.Updated = true
+			results = append(results, r)
+			continue
+		}
+
+		want, err := os.ReadFile(r.Golden) //nolint:gosec // G304: golden path derived from the fixture dir
+		if err != nil {
+			r.Failures = append(r.Failures, "golden missing (run with update to create it)")
+		} else {
+			r.Diff = lineDiff(string(want), out)
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// maxDiffLines caps the diff reported per fixture.
+const maxDiffLines = 60
+
+// lineDiff returns the changed lines between want and got, "-" for lines
+// only in want and "+" for lines only in got, each prefixed with its line
+// number in the respective text. Nil when equal.
+func lineDiff(want, got string) []string {
+	if want == got {
+		return nil
+	}
+	a := strings.Split(want, "\n")
+	b := strings.Split(got, "\n")
+
+	// Longest common subsequence table, filled from the end.
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []string
+	add := func(s string) {
+		if len(out) < maxDiffLines {
+			out = append(out, s)
+		} else if len(out) == maxDiffLines {
+			out = append(out, "... (diff truncated)")
+		}
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			add(fmt.Sprintf("+%d: %s", j+1, b[j]))
+			j++
+		default:
+			add(fmt.Sprintf("-%d: %s", i+1, a[i]))
+			i++
+		}
+	}
+	return out
+}
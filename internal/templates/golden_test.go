@@ -0,0 +1,82 @@
+package templates
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite prime golden files from rendered output")
+
+// TestRoleGoldens renders every role against testdata/prime fixtures.
+// After an intended template change, regenerate with:
+//
+//	go test ./internal/templates -run TestRoleGoldens -update
+func TestRoleGoldens(t *testing.T) {
+	if c := os.Getenv("GT_COMMAND"); c != "" && c != "gt" {
+		t.Skip("goldens are rendered with the default gt command name")
+	}
+	results, err := RunGolden("testdata/prime", GoldenOptions{Update: *updateGolden})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, _ := New()
+	covered := map[string]bool{}
+	for _, r := range results {
+		covered[r.Role] = true
+		if !r.Passed() {
+			t.Errorf("%s (~%d tokens):\n%s\n%s", r.Fixture, r.Tokens,
+				strings.Join(r.Failures, "\n"), strings.Join(r.Diff, "\n"))
+		}
+	}
+	for _, role := range tmpl.RoleNames() {
+		if !covered[role] {
+			t.Errorf("role %s has no golden fixture", role)
+		}
+	}
+}
+
+func TestRunGolden_DiffAndTokenBounds(t *testing.T) {
+	dir := t.TempDir()
+	fixture := `{"role": "boot", "data": {"TownRoot": "/t"}, "max_tokens": 10}`
+	if err := os.WriteFile(filepath.Join(dir, "boot.json"), []byte(fixture), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := RunGolden(dir, GoldenOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results[0].Failures) != 2 {
+		t.Errorf("want missing-golden and max_tokens failures, got %v", results[0].Failures)
+	}
+
+	if _, err := RunGolden(dir, GoldenOptions{Update: true}); err != nil {
+		t.Fatal(err)
+	}
+	golden := GoldenPath(dir, "boot")
+	data, _ := os.ReadFile(golden)
+	edited := strings.Replace(string(data), "/t", "/elsewhere", 1)
+	if err := os.WriteFile(golden, []byte(edited), 0644); err != nil {
+		t.Fatal(err)
+	}
+	results, _ = RunGolden(dir, GoldenOptions{})
+	diff := strings.Join(results[0].Diff, "\n")
+	if !strings.Contains(diff, "/elsewhere") || !strings.Contains(diff, "+") {
+		t.Errorf("diff should show the changed line, got:\n%s", diff)
+	}
+}
+
+func TestLineDiff(t *testing.T) {
+	if d := lineDiff("a\nb\n", "a\nb\n"); d != nil {
+		t.Errorf("equal texts diff = %v", d)
+	}
+	got := lineDiff("a\nb\nc", "a\nB\nc\nd")
+	want := []string{"+2: B", "-2: b", "+4: d"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("lineDiff = %q, want %q", got, want)
+	}
+}
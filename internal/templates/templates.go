@@ -149,6 +149,28 @@ func (t *Templates) RenderRole(role string, data RoleData) (string, error) {
 	return buf.String(), nil
 }
 
+// RoleSource returns the embedded source of a role template. Callers use it
+// to detect template changes (for example, to key cached prime output).
+func RoleSource(role string) ([]byte, error) {
+	return templateFS.ReadFile("roles/" + role + ".md.tmpl")
+}
+
+// RenderRoleFile renders a role context from a template file on disk instead
+// of the embedded role template. Used for prompt experiment variants.
+func RenderRoleFile(path string, data RoleData) (string, error) {
+	tmpl, err := template.New(filepath.Base(path)).Funcs(templateFuncs).ParseFiles(path)
+	if err != nil {
+		return "", fmt.Errorf("parsing role template %s: %w", path, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("rendering role template %s: %w", path, err)
+	}
+
+	return buf.String(), nil
+}
+
 // RenderMessage renders a message template.
 func (t *Templates) RenderMessage(name string, data interface{}) (string, error) {
 	templateName := name + ".md.tmpl"
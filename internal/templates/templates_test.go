@@ -2,6 +2,8 @@ package templates
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -535,3 +537,20 @@ func TestRoleNames(t *testing.T) {
 		}
 	}
 }
+
+func TestRenderRoleFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "polecat-terse.md.tmpl")
+	if err := os.WriteFile(path, []byte("You are {{ .Polecat }} in {{ .RigName }}. Run `{{ cmd }} done` when finished."), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := RenderRoleFile(path, RoleData{Polecat: "Toast", RigName: "gastown"})
+	if err != nil {
+		t.Fatalf("RenderRoleFile() error = %v", err)
+	}
+	if want := "You are Toast in gastown. Run `" + CmdName() + " done` when finished."; got != want {
+		t.Errorf("RenderRoleFile() = %q, want %q", got, want)
+	}
+	if _, err := RenderRoleFile(filepath.Join(t.TempDir(), "missing.tmpl"), RoleData{}); err == nil {
+		t.Error("RenderRoleFile(missing) should fail")
+	}
+}
@@ -0,0 +1,149 @@
+package tmux
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// ErrorClass categorizes why a tmux command failed. Classes are stable,
+// low-cardinality strings: they are recorded as a telemetry attribute.
+type ErrorClass string
+
+const (
+	// ClassNoServer: the tmux server isn't running or couldn't be reached.
+	ClassNoServer ErrorClass = "no_server"
+	// ClassSessionExists: a session with the requested name already exists.
+	ClassSessionExists ErrorClass = "session_exists"
+	// ClassSessionMissing: the target session doesn't exist.
+	ClassSessionMissing ErrorClass = "session_missing"
+	// ClassPaneDead: the target pane is gone or its process has exited.
+	ClassPaneDead ErrorClass = "pane_dead"
+	// ClassPermissionDenied: the socket or server refused access.
+	ClassPermissionDenied ErrorClass = "permission_denied"
+	// ClassUsage: the command doesn't apply to the target's current state,
+	// e.g. a copy-mode command sent to a pane that is not in a mode.
+	ClassUsage ErrorClass = "usage"
+	// ClassTransient: a momentary failure that is safe to retry.
+	ClassTransient ErrorClass = "transient"
+	// ClassOther: any failure not covered above.
+	ClassOther ErrorClass = "other"
+)
+
+// Errors for the classes that had no sentinel before classification.
+var (
+	ErrPaneDead         = errors.New("tmux pane dead")
+	ErrPermissionDenied = errors.New("tmux permission denied")
+	ErrTransient        = errors.New("transient tmux failure")
+)
+
+// classSentinels maps classes to the sentinel errors.Is matches them with.
+var classSentinels = map[ErrorClass]error{
+	ClassNoServer:         ErrNoServer,
+	ClassSessionExists:    ErrSessionExists,
+	ClassSessionMissing:   ErrSessionNotFound,
+	ClassPaneDead:         ErrPaneDead,
+	ClassPermissionDenied: ErrPermissionDenied,
+	ClassTransient:        ErrTransient,
+}
+
+// CommandError is a failed tmux command. errors.Is matches it against its
+// class's sentinel (ErrNoServer, ErrSessionNotFound, ...), so callers that
+// only care about the class needn't unwrap it.
+type CommandError struct {
+	Command string     // tmux subcommand, e.g. "send-keys"
+	Class   ErrorClass // Why it failed
+	Stderr  string     // tmux's stderr, trimmed
+	Err     error      // Underlying exec error
+}
+
+func (e *CommandError) Error() string {
+	if e.Stderr != "" {
+		return fmt.Sprintf("tmux %s: %s", e.Command, e.Stderr)
+	}
+	return fmt.Sprintf("tmux %s: %v", e.Command, e.Err)
+}
+
+func (e *CommandError) Unwrap() error { return e.Err }
+
+// Is reports whether target is the sentinel for e's class.
+func (e *CommandError) Is(target error) bool {
+	sentinel, ok := classSentinels[e.Class]
+	return ok && target == sentinel
+}
+
+// Unexpected reports whether failures of class c point at a problem. The
+// other classes are answers callers ask for: HasSession probing a missing
+// session, ListSessions with no server running, a pane that already exited.
+// Only unexpected failures are recorded in telemetry.
+func (c ErrorClass) Unexpected() bool {
+	switch c {
+	case ClassOther, ClassTransient, ClassPermissionDenied:
+		return true
+	}
+	return false
+}
+
+// ClassOf returns the class of a tmux error: the class of a wrapped
+// *CommandError, or the class a bare sentinel stands for. Returns "" for nil.
+func ClassOf(err error) ErrorClass {
+	if err == nil {
+		return ""
+	}
+	var cmdErr *CommandError
+	if errors.As(err, &cmdErr) {
+		return cmdErr.Class
+	}
+	for class, sentinel := range classSentinels {
+		if errors.Is(err, sentinel) {
+			return class
+		}
+	}
+	return ClassOther
+}
+
+// classify maps a failed tmux invocation to an error class from its stderr,
+// falling back to the exec error for failures that never reached tmux.
+// Order matters: a refused socket reads "error connecting to ... (Permission
+// denied)", which must not be mistaken for a missing server.
+func classify(err error, stderr string) ErrorClass {
+	switch {
+	case strings.Contains(stderr, "Permission denied") ||
+		strings.Contains(stderr, "access not allowed") ||
+		errors.Is(err, os.ErrPermission):
+		return ClassPermissionDenied
+	case strings.Contains(stderr, "Resource temporarily unavailable") ||
+		strings.Contains(stderr, "Interrupted system call"):
+		return ClassTransient
+	case strings.Contains(stderr, "not in a mode"):
+		return ClassUsage
+	case strings.Contains(stderr, "no server running") ||
+		strings.Contains(stderr, "error connecting to") ||
+		strings.Contains(stderr, "no current target") ||
+		strings.Contains(stderr, "server exited unexpectedly"):
+		return ClassNoServer
+	case strings.Contains(stderr, "duplicate session"):
+		return ClassSessionExists
+	case strings.Contains(stderr, "session not found") ||
+		strings.Contains(stderr, "can't find session"):
+		return ClassSessionMissing
+	case strings.Contains(stderr, "can't find pane") ||
+		strings.Contains(stderr, "pane is dead") ||
+		strings.Contains(stderr, "target pane has exited"):
+		return ClassPaneDead
+	}
+	if errors.Is(err, exec.ErrNotFound) {
+		return ClassNoServer // No tmux binary, so no server either
+	}
+	return ClassOther
+}
+
+// Transient failures are retried by run before being returned: tmux didn't
+// act on the command, so running it again is safe.
+const (
+	transientAttempts = 3
+	transientBackoff  = 100 * time.Millisecond
+)
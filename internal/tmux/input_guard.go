@@ -0,0 +1,255 @@
+package tmux
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/telemetry"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// The session input guard screens text before it is typed into a session.
+// Agents and the daemon nudge each other constantly; a nudge that carries
+// "rm -rf ~/gt" or "git push --force origin main" is one Enter away from
+// running. Rules come from the input_guard section of town settings (see
+// config.DefaultInputGuardRules for the built-ins). Every stopped send is
+// written to the audit log and counted in telemetry.
+
+// ErrInputBlocked is returned when the input guard stops a send.
+var ErrInputBlocked = errors.New("input blocked by session input guard")
+
+// InputBlockedError describes a send stopped by the input guard.
+type InputBlockedError struct {
+	Session     string
+	Rule        string
+	Action      string // config.InputGuardBlock or config.InputGuardConfirm
+	Description string
+	Match       string
+}
+
+func (e *InputBlockedError) Error() string {
+	return fmt.Sprintf("input to %s blocked by rule %q (%s): %q", e.Session, e.Rule, e.Description, e.Match)
+}
+
+func (e *InputBlockedError) Unwrap() error { return ErrInputBlocked }
+
+// NeedsConfirmation reports whether a human may let the send through
+// (see ConfirmInput).
+func (e *InputBlockedError) NeedsConfirmation() bool {
+	return e.Action == config.InputGuardConfirm
+}
+
+// InputGuard matches outgoing text against compiled rules.
+type InputGuard struct {
+	rules []inputRule
+}
+
+type inputRule struct {
+	config.InputGuardRule
+	re *regexp.Regexp
+}
+
+// NewInputGuard compiles the effective rules of cfg (nil: built-in rules).
+func NewInputGuard(cfg *config.InputGuardConfig) (*InputGuard, error) {
+	rules, err := cfg.EffectiveRules()
+	if err != nil {
+		return nil, err
+	}
+	g := &InputGuard{}
+	for _, r := range rules {
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("input guard rule %q: %w", r.Name, err)
+		}
+		g.rules = append(g.rules, inputRule{InputGuardRule: r, re: re})
+	}
+	return g, nil
+}
+
+// Check returns the first rule text violates and the offending text.
+// workDir is called at most once, and only for rules limited to paths
+// outside the session's working directory; it may return "" if unknown.
+func (g *InputGuard) Check(text string, workDir func() string) (config.InputGuardRule, string, bool) {
+	if g == nil {
+		return config.InputGuardRule{}, "", false
+	}
+	var dir string
+	var dirLoaded bool
+	for _, r := range g.rules {
+		for _, loc := range r.re.FindAllStringIndex(text, -1) {
+			match := text[loc[0]:loc[1]]
+			if !r.OutsideWorkDir {
+				return r.InputGuardRule, match, true
+			}
+			if !dirLoaded {
+				dir, dirLoaded = workDir(), true
+			}
+			if p, ok := pathOutside(text[loc[1]:], dir); ok {
+				return r.InputGuardRule, match + " " + p, true
+			}
+		}
+	}
+	return config.InputGuardRule{}, "", false
+}
+
+// pathOutside returns the first path argument in args (up to the next shell
+// operator) that resolves outside workDir. The worktree root itself counts
+// as outside. Unresolvable paths ($VARS, relative paths with no known
+// working directory) are treated as outside.
+func pathOutside(args, workDir string) (string, bool) {
+	if i := strings.IndexAny(args, ";&|\n)`"); i >= 0 {
+		args = args[:i]
+	}
+	home, _ := os.UserHomeDir()
+	for _, arg := range strings.Fields(args) {
+		arg = strings.Trim(arg, `"'`)
+		if arg == "" || strings.HasPrefix(arg, "-") {
+			continue
+		}
+		p := arg
+		switch {
+		case p == "~" || strings.HasPrefix(p, "~/"):
+			p = home + p[1:]
+		case strings.HasPrefix(p, "$HOME"):
+			p = home + strings.TrimPrefix(p, "$HOME")
+		case strings.HasPrefix(p, "${HOME}"):
+			p = home + strings.TrimPrefix(p, "${HOME}")
+		}
+		if strings.HasPrefix(p, "$") {
+			return arg, true
+		}
+		if !filepath.IsAbs(p) {
+			if workDir == "" {
+				if strings.HasPrefix(filepath.Clean(p), "..") {
+					return arg, true
+				}
+				continue
+			}
+			p = filepath.Join(workDir, p)
+		}
+		if workDir == "" {
+			return arg, true
+		}
+		rel, err := filepath.Rel(filepath.Clean(workDir), filepath.Clean(p))
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return arg, true
+		}
+	}
+	return "", false
+}
+
+var (
+	inputGuardMu     sync.Mutex
+	inputGuard       *InputGuard
+	inputGuardLoaded bool
+	inputConfirmed   = map[string]bool{}
+)
+
+// SetInputGuard replaces the process-wide input guard; nil turns it off.
+func SetInputGuard(g *InputGuard) {
+	inputGuardMu.Lock()
+	defer inputGuardMu.Unlock()
+	inputGuard, inputGuardLoaded = g, true
+}
+
+// currentInputGuard returns the process-wide guard, loading it from the
+// town settings on first use. Outside a town, or when the settings cannot
+// be read, the built-in rules apply.
+func currentInputGuard() *InputGuard {
+	inputGuardMu.Lock()
+	defer inputGuardMu.Unlock()
+	if inputGuardLoaded {
+		return inputGuard
+	}
+	inputGuardLoaded = true
+
+	townRoot := os.Getenv("GT_ROOT")
+	if townRoot == "" {
+		townRoot, _ = workspace.FindFromCwd()
+	}
+	var cfg *config.InputGuardConfig
+	if townRoot != "" {
+		if c, err := config.LoadInputGuardConfig(townRoot); err == nil {
+			cfg = c
+		}
+	}
+	g, err := NewInputGuard(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: input guard settings invalid (%v), using built-in rules\n", err)
+		g, _ = NewInputGuard(nil)
+	}
+	inputGuard = g
+	return inputGuard
+}
+
+// ConfirmInput lets the next send of exactly keys to target through
+// confirm rules. Callers use it after a human has confirmed an
+// InputBlockedError with NeedsConfirmation.
+func ConfirmInput(target, keys string) {
+	inputGuardMu.Lock()
+	defer inputGuardMu.Unlock()
+	inputConfirmed[target+"\x00"+keys] = true
+}
+
+func consumeInputConfirmation(target, keys string) bool {
+	inputGuardMu.Lock()
+	defer inputGuardMu.Unlock()
+	key := target + "\x00" + keys
+	ok := inputConfirmed[key]
+	delete(inputConfirmed, key)
+	return ok
+}
+
+// guardInput checks keys bound for target against the input guard,
+// auditing and counting every stopped or confirmed send.
+func (t *Tmux) guardInput(target, keys string) error {
+	g := currentInputGuard()
+	rule, match, ok := g.Check(keys, func() string { return t.targetWorkDir(target) })
+	if !ok {
+		return nil
+	}
+
+	outcome := "blocked"
+	if rule.Action == config.InputGuardConfirm && consumeInputConfirmation(target, keys) {
+		outcome = "confirmed"
+	}
+	actor := os.Getenv("BD_ACTOR")
+	if actor == "" {
+		actor = "gt"
+	}
+	_ = events.LogAudit(events.TypeInputBlocked, actor,
+		events.InputBlockedPayload(target, rule.Name, rule.Action, outcome, match))
+	telemetry.RecordPromptBlocked(context.Background(), target, rule.Name, rule.Action, outcome)
+	if outcome == "confirmed" {
+		return nil
+	}
+	return &InputBlockedError{
+		Session:     target,
+		Rule:        rule.Name,
+		Action:      rule.Action,
+		Description: rule.Description,
+		Match:       match,
+	}
+}
+
+// targetWorkDir returns the working directory of a session or pane ("%N"),
+// or "" if it cannot be read.
+func (t *Tmux) targetWorkDir(target string) string {
+	if strings.HasPrefix(target, "%") {
+		out, err := t.run("display-message", "-t", target, "-p", "#{pane_current_path}")
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(out)
+	}
+	dir, _ := t.GetPaneWorkDir(target)
+	return dir
+}
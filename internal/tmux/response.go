@@ -0,0 +1,123 @@
+package tmux
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// DefaultResponseQuiet is how long a pane must stay unchanged before a
+// quiescence detector considers the agent finished responding.
+const DefaultResponseQuiet = 3 * time.Second
+
+// responsePollInterval is how often WaitForResponse captures the pane.
+const responsePollInterval = 200 * time.Millisecond
+
+// busyMarkers are status-line fragments agent TUIs show while a response is
+// in progress. Claude Code and Codex both render "esc to interrupt".
+var busyMarkers = []string{"esc to interrupt"}
+
+// ResponseDetector decides from pane captures whether an agent has finished
+// responding. Runtimes that draw a recognizable input prompt use a
+// PromptDetector; the rest fall back to a QuiescenceDetector.
+type ResponseDetector interface {
+	// Finished reports whether the agent is done, given the last lines of
+	// its pane and how long they have gone unchanged.
+	Finished(lines []string, unchanged time.Duration) bool
+}
+
+// PromptDetector reports an agent finished once its input prompt is on
+// screen and no busy marker is. Agents like Claude Code keep the prompt
+// drawn while working, so the busy check is what tells the two apart.
+type PromptDetector struct {
+	Prefix string // Ready-prompt prefix, e.g. "❯ "
+}
+
+// Finished implements ResponseDetector.
+func (p PromptDetector) Finished(lines []string, _ time.Duration) bool {
+	prefix := strings.TrimSpace(p.Prefix)
+	atPrompt := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		for _, marker := range busyMarkers {
+			if strings.Contains(trimmed, marker) {
+				return false
+			}
+		}
+		// Scan every line: Claude Code renders a status bar below the
+		// prompt, so the prompt may not be the last non-empty line.
+		if matchesPromptPrefix(trimmed, p.Prefix) || (prefix != "" && trimmed == prefix) {
+			atPrompt = true
+		}
+	}
+	return atPrompt
+}
+
+// QuiescenceDetector reports an agent finished once its pane has stopped
+// changing for Quiet, for runtimes without a recognizable prompt.
+type QuiescenceDetector struct {
+	Quiet time.Duration
+}
+
+// Finished implements ResponseDetector.
+func (q QuiescenceDetector) Finished(_ []string, unchanged time.Duration) bool {
+	return unchanged >= q.Quiet
+}
+
+// DetectorForAgent returns the response detector for an agent preset:
+// prompt-based when the preset declares a ready prompt, quiescence-based
+// otherwise. An empty name means the default agent (Claude).
+func DetectorForAgent(agentName string) ResponseDetector {
+	if agentName == "" {
+		agentName = string(config.DefaultAgentPreset())
+	}
+	preset := config.GetAgentPresetByName(agentName)
+	if preset != nil && preset.ReadyPromptPrefix != "" {
+		return PromptDetector{Prefix: preset.ReadyPromptPrefix}
+	}
+	return QuiescenceDetector{Quiet: DefaultResponseQuiet}
+}
+
+// ResponseDetectorFor returns the response detector for the agent running
+// in a session, resolved from the session's GT_AGENT.
+func (t *Tmux) ResponseDetectorFor(session string) ResponseDetector {
+	agentName, _ := t.GetEnvironment(session, "GT_AGENT")
+	return DetectorForAgent(agentName)
+}
+
+// WaitForResponse polls the session's pane until detector reports the agent
+// finished responding. Returns ErrIdleTimeout if the agent is still busy at
+// the deadline, or the capture error if the session or server is gone.
+func (t *Tmux) WaitForResponse(session string, detector ResponseDetector, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	var last []string
+	seen := false
+	changedAt := time.Now()
+	for time.Now().Before(deadline) {
+		lines, err := t.CapturePaneLines(session, 5)
+		if err != nil {
+			// Distinguish terminal errors from transient ones.
+			// Session not found or no server means the session is gone —
+			// no point in polling further.
+			if errors.Is(err, ErrSessionNotFound) || errors.Is(err, ErrNoServer) {
+				return err
+			}
+			time.Sleep(responsePollInterval)
+			continue
+		}
+		if !seen || !slices.Equal(lines, last) {
+			last, seen, changedAt = lines, true, time.Now()
+		}
+		if detector.Finished(lines, time.Since(changedAt)) {
+			return nil
+		}
+		time.Sleep(responsePollInterval)
+	}
+	return ErrIdleTimeout
+}
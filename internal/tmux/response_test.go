@@ -0,0 +1,53 @@
+package tmux
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestPromptDetector(t *testing.T) {
+	t.Parallel()
+	d := PromptDetector{Prefix: DefaultReadyPromptPrefix}
+	tests := []struct {
+		name  string
+		lines []string
+		want  bool
+	}{
+		{"idle prompt with status bar", []string{"❯ ", "", "⏵⏵ bypass permissions on (shift+tab to cycle)"}, true},
+		{"nbsp prompt", []string{"❯ "}, true},
+		{"prompt while busy", []string{"❯ ", "⏵⏵ bypass permissions on · esc to interrupt"}, false},
+		{"no prompt", []string{"Running tests..."}, false},
+		{"empty pane", nil, false},
+	}
+	for _, tt := range tests {
+		if got := d.Finished(tt.lines, 0); got != tt.want {
+			t.Errorf("%s: Finished = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestQuiescenceDetector(t *testing.T) {
+	t.Parallel()
+	d := QuiescenceDetector{Quiet: 2 * time.Second}
+	if d.Finished([]string{"> "}, time.Second) {
+		t.Error("Finished before the quiet period elapsed")
+	}
+	if !d.Finished([]string{"working"}, 2*time.Second) {
+		t.Error("not Finished after the quiet period")
+	}
+}
+
+func TestDetectorForAgent(t *testing.T) {
+	t.Parallel()
+	if d, ok := DetectorForAgent("").(PromptDetector); !ok || d.Prefix != DefaultReadyPromptPrefix {
+		t.Errorf("default agent: got %#v, want PromptDetector{%q}", DetectorForAgent(""), DefaultReadyPromptPrefix)
+	}
+	if _, ok := DetectorForAgent(string(config.AgentGemini)).(QuiescenceDetector); !ok {
+		t.Errorf("agent without a ready prompt: got %#v, want QuiescenceDetector", DetectorForAgent(string(config.AgentGemini)))
+	}
+	if _, ok := DetectorForAgent("no-such-agent").(QuiescenceDetector); !ok {
+		t.Error("unknown agent should fall back to quiescence")
+	}
+}
@@ -0,0 +1,184 @@
+package tmux
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/telemetry"
+)
+
+// sendQueues holds the outbound queue of each send target (session or pane).
+var sendQueues sync.Map // map[string]*sendQueue
+
+// sendQueue orders the prompt sends to one target. Nudges, patrols, and
+// mail notifications can all send to the same pane at once; interleaved
+// send-keys calls garble the prompt, so every send goes through the queue.
+//
+// The queue is drained by whichever caller holds the target's nudge lock:
+// it runs queued sends in arrival order until its own has run, so sends are
+// delivered first-come-first-served no matter which waiter wins the lock.
+// The queue is per process; the drainer also holds the target's send lock
+// file (see sendLockPath), so sends from other gt processes wait too.
+// A send identical to one still waiting is coalesced into it, and a
+// replaceable send (see SendKeysReplace) takes over a waiting one's text.
+type sendQueue struct {
+	mu      sync.Mutex
+	pending []*sendJob
+}
+
+// sendJob is one queued send. Coalesced callers share its result.
+type sendJob struct {
+	key  string // Coalescing key; sends with equal keys are redundant
+	run  func() error
+	done chan struct{}
+	err  error
+}
+
+func getSendQueue(target string) *sendQueue {
+	q, _ := sendQueues.LoadOrStore(target, &sendQueue{})
+	return q.(*sendQueue)
+}
+
+// sendLockPath returns the lock file that serializes sends to target on a
+// tmux socket across processes. It sits beside tmux's own sockets.
+func sendLockPath(socket, target string) string {
+	if socket == "" {
+		socket = "default"
+	}
+	clean := func(s string) string {
+		return unsafeLockChars.ReplaceAllString(s, "_")
+	}
+	return filepath.Join(SocketDir(), "gt-send-locks", clean(socket)+"-"+clean(target)+".lock")
+}
+
+// unsafeLockChars matches characters not kept in a send lock file name
+// (pane targets look like "%12").
+var unsafeLockChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// lockSendTarget takes the cross-process send lock for target, waiting
+// until deadline.
+func lockSendTarget(socket, target string, deadline time.Time) (*flock.Flock, error) {
+	path := sendLockPath(socket, target)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	fileLock := flock.New(path)
+	locked, err := fileLock.TryLockContext(ctx, 20*time.Millisecond)
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, fmt.Errorf("lock %s not acquired", path)
+	}
+	return fileLock, nil
+}
+
+// sendQueued runs fn as a send to target on socket, after the sends queued
+// before it. If an identical send (same key) is still waiting, fn is dropped
+// and the caller gets that send's result instead. replace marks a send whose
+// latest text is all that matters: it takes over a waiting send with the
+// same key, which then runs with fn. Returns an error if the queue doesn't
+// reach the send within nudgeLockTimeout.
+func sendQueued(socket, target, key string, replace bool, fn func() error) error {
+	ctx := context.Background()
+	deadline := time.Now().Add(nudgeLockTimeout)
+	q := getSendQueue(target)
+
+	q.mu.Lock()
+	for _, j := range q.pending {
+		if j.key != key {
+			continue
+		}
+		if replace {
+			j.run = fn
+		}
+		q.mu.Unlock()
+		telemetry.RecordPromptCoalesced(ctx)
+		return q.wait(j)
+	}
+	job := &sendJob{key: key, run: fn, done: make(chan struct{})}
+	q.pending = append(q.pending, job)
+	q.mu.Unlock()
+	telemetry.RecordPromptQueueDepth(ctx, 1)
+
+	if !acquireNudgeLock(target, nudgeLockTimeout) {
+		if q.remove(job) {
+			job.err = fmt.Errorf("send queue timeout for %q: previous send may be hung", target)
+			close(job.done)
+		}
+		return q.wait(job)
+	}
+	defer releaseNudgeLock(target)
+
+	fileLock, err := lockSendTarget(socket, target, deadline)
+	if err != nil {
+		if q.remove(job) {
+			job.err = fmt.Errorf("send queue timeout for %q: another process is still sending: %w", target, err)
+			close(job.done)
+		}
+		return q.wait(job)
+	}
+	defer func() { _ = fileLock.Unlock() }()
+
+	for {
+		select {
+		case <-job.done:
+			return job.err
+		default:
+		}
+		q.mu.Lock()
+		head := q.pending[0]
+		q.pending = q.pending[1:]
+		run := head.run
+		q.mu.Unlock()
+		telemetry.RecordPromptQueueDepth(ctx, -1)
+
+		head.err = run()
+		close(head.done)
+	}
+}
+
+// wait blocks until job has run, or gives up after nudgeLockTimeout.
+func (q *sendQueue) wait(job *sendJob) error {
+	select {
+	case <-job.done:
+		return job.err
+	case <-time.After(nudgeLockTimeout):
+		return fmt.Errorf("send queue timeout: queued send did not run within %s", nudgeLockTimeout)
+	}
+}
+
+// remove drops job from the queue if it hasn't started. Reports whether
+// it was still waiting.
+func (q *sendQueue) remove(job *sendJob) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, j := range q.pending {
+		if j == job {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			telemetry.RecordPromptQueueDepth(context.Background(), -1)
+			return true
+		}
+	}
+	return false
+}
+
+// SendQueueDepth returns the number of sends waiting for a target.
+func SendQueueDepth(target string) int {
+	q, ok := sendQueues.Load(target)
+	if !ok {
+		return 0
+	}
+	sq := q.(*sendQueue)
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+	return len(sq.pending)
+}
@@ -0,0 +1,172 @@
+package tmux
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// queueWhileLocked holds target's nudge lock while enqueue adds sends, so
+// they all wait, then releases it and waits for every send to return.
+func queueWhileLocked(t *testing.T, target string, enqueue func(wg *sync.WaitGroup)) {
+	t.Helper()
+	sendQueues.Delete(target)
+	sessionNudgeLocks.Delete(target)
+	if !acquireNudgeLock(target, time.Second) {
+		t.Fatal("acquireNudgeLock should succeed")
+	}
+	var wg sync.WaitGroup
+	enqueue(&wg)
+	releaseNudgeLock(target)
+	wg.Wait()
+	if d := SendQueueDepth(target); d != 0 {
+		t.Errorf("SendQueueDepth = %d after drain, want 0", d)
+	}
+}
+
+// waitForDepth waits until target's queue holds n sends.
+func waitForDepth(t *testing.T, target string, n int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for SendQueueDepth(target) != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("SendQueueDepth = %d, want %d", SendQueueDepth(target), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSendQueued_Order(t *testing.T) {
+	const target = "test-sendqueue-order"
+	var mu sync.Mutex
+	var got []string
+
+	queueWhileLocked(t, target, func(wg *sync.WaitGroup) {
+		for i, msg := range []string{"one", "two", "three", "four"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = sendQueued("", target, msg, false, func() error {
+					mu.Lock()
+					got = append(got, msg)
+					mu.Unlock()
+					return nil
+				})
+			}()
+			waitForDepth(t, target, i+1)
+		}
+	})
+
+	want := []string{"one", "two", "three", "four"}
+	if len(got) != len(want) {
+		t.Fatalf("sent %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sent %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSendQueued_CoalescesIdentical(t *testing.T) {
+	const target = "test-sendqueue-coalesce"
+	sendErr := errors.New("send failed")
+	var runs int
+	errs := make([]error, 3)
+
+	queueWhileLocked(t, target, func(wg *sync.WaitGroup) {
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = sendQueued("", target, "nudge\x00hello", false, func() error {
+					runs++
+					return sendErr
+				})
+			}()
+			waitForDepth(t, target, 1)
+		}
+		time.Sleep(20 * time.Millisecond) // Let the duplicates join
+	})
+
+	if runs != 1 {
+		t.Errorf("identical send ran %d times, want 1", runs)
+	}
+	for i, err := range errs {
+		if !errors.Is(err, sendErr) {
+			t.Errorf("caller %d got %v, want the shared result", i, err)
+		}
+	}
+}
+
+func TestSendQueued_ReplaceTakesLatest(t *testing.T) {
+	const target = "test-sendqueue-replace"
+	var sent []string
+
+	queueWhileLocked(t, target, func(wg *sync.WaitGroup) {
+		for _, msg := range []string{"stale", "latest"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = sendQueued("", target, "replace", true, func() error {
+					sent = append(sent, msg)
+					return nil
+				})
+			}()
+			waitForDepth(t, target, 1)
+		}
+		time.Sleep(20 * time.Millisecond)
+	})
+
+	if len(sent) != 1 || sent[0] != "latest" {
+		t.Errorf("sent %v, want [latest]", sent)
+	}
+}
+
+func TestSendQueued_DifferentTargetsIndependent(t *testing.T) {
+	const busy, idle = "test-sendqueue-busy", "test-sendqueue-idle"
+	sessionNudgeLocks.Delete(busy)
+	if !acquireNudgeLock(busy, time.Second) {
+		t.Fatal("acquireNudgeLock should succeed")
+	}
+	defer releaseNudgeLock(busy)
+
+	ran := false
+	if err := sendQueued("", idle, "x", false, func() error { ran = true; return nil }); err != nil {
+		t.Fatalf("sendQueued(%s) = %v", idle, err)
+	}
+	if !ran {
+		t.Error("send to an idle target should run while another target is busy")
+	}
+}
+
+func TestSendQueued_WaitsForOtherProcess(t *testing.T) {
+	const target = "test-sendqueue-xproc"
+	sendQueues.Delete(target)
+	sessionNudgeLocks.Delete(target)
+
+	// Another process's send is modelled by a second lock on the file.
+	other, err := lockSendTarget("", target, time.Now().Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Remove(sendLockPath("", target)) })
+
+	ran := make(chan struct{})
+	go func() {
+		_ = sendQueued("", target, "x", false, func() error { close(ran); return nil })
+	}()
+	select {
+	case <-ran:
+		t.Fatal("send ran while another process held the target's send lock")
+	case <-time.After(100 * time.Millisecond):
+	}
+	_ = other.Unlock()
+	select {
+	case <-ran:
+	case <-time.After(2 * time.Second):
+		t.Fatal("send did not run after the lock was released")
+	}
+}
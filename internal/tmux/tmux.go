@@ -157,6 +157,10 @@ func NewTmuxWithSocket(socket string) *Tmux {
 // run executes a tmux command and returns stdout.
 // All commands include -u flag for UTF-8 support regardless of locale settings.
 // See: https://github.com/steveyegge/gastown/issues/1219
+//
+// Failures are returned as a *CommandError (see errors.go). Transient ones
+// are retried with a short backoff first, and unexpected ones (see
+// ErrorClass.Unexpected) are recorded in telemetry by command and class.
 func (t *Tmux) run(args ...string) (string, error) {
 	// Prepend global flags: -u (UTF-8 mode, PATCH-004) and optionally -L (socket).
 	// The -L flag must come before the subcommand, so it goes in the prefix.
@@ -165,42 +169,41 @@ func (t *Tmux) run(args ...string) (string, error) {
 		allArgs = append(allArgs, "-L", t.socketName)
 	}
 	allArgs = append(allArgs, args...)
-	cmd := exec.Command("tmux", allArgs...)
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
 
-	err := cmd.Run()
-	if err != nil {
-		return "", t.wrapError(err, stderr.String(), args)
-	}
+	var err error
+	backoff := transientBackoff
+	for attempt := 1; ; attempt++ {
+		cmd := exec.Command("tmux", allArgs...)
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
 
-	return strings.TrimSpace(stdout.String()), nil
+		runErr := cmd.Run()
+		if runErr == nil {
+			return strings.TrimSpace(stdout.String()), nil
+		}
+		err = t.wrapError(runErr, stderr.String(), args)
+		class := ClassOf(err)
+		if class.Unexpected() {
+			telemetry.RecordTmuxError(context.Background(), args[0], string(class), attempt, err)
+		}
+		if class != ClassTransient || attempt == transientAttempts {
+			return "", err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
 }
 
-// wrapError wraps tmux errors with context.
+// wrapError classifies a failed tmux command into a *CommandError.
 func (t *Tmux) wrapError(err error, stderr string, args []string) error {
 	stderr = strings.TrimSpace(stderr)
-
-	// Detect specific error types
-	if strings.Contains(stderr, "no server running") ||
-		strings.Contains(stderr, "error connecting to") ||
-		strings.Contains(stderr, "no current target") ||
-		strings.Contains(stderr, "server exited unexpectedly") {
-		return ErrNoServer
-	}
-	if strings.Contains(stderr, "duplicate session") {
-		return ErrSessionExists
-	}
-	if strings.Contains(stderr, "session not found") ||
-		strings.Contains(stderr, "can't find session") {
-		return ErrSessionNotFound
+	return &CommandError{
+		Command: args[0],
+		Class:   classify(err, stderr),
+		Stderr:  stderr,
+		Err:     err,
 	}
-
-	if stderr != "" {
-		return fmt.Errorf("tmux %s: %s", args[0], stderr)
-	}
-	return fmt.Errorf("tmux %s: %w", args[0], err)
 }
 
 // NewSession creates a new detached tmux session.
@@ -405,7 +408,7 @@ func (t *Tmux) EnsureSessionFresh(name, workDir string) error {
 	if err == nil {
 		return nil // Created successfully
 	}
-	if err != ErrSessionExists {
+	if !errors.Is(err, ErrSessionExists) {
 		return fmt.Errorf("creating session: %w", err)
 	}
 
@@ -425,7 +428,7 @@ func (t *Tmux) EnsureSessionFresh(name, workDir string) error {
 	// Create fresh session (handle race: another agent may have created it
 	// between our kill and this create — that's fine, treat as success)
 	err = t.NewSession(name, workDir)
-	if err == ErrSessionExists {
+	if errors.Is(err, ErrSessionExists) {
 		return nil
 	}
 	return err
@@ -468,7 +471,7 @@ func (t *Tmux) EnsureSessionFreshWithCommand(name, workDir, command string) erro
 func (t *Tmux) KillSession(name string) (retErr error) {
 	defer func() { telemetry.RecordSessionStop(context.Background(), name, retErr) }()
 	_, retErr = t.run("kill-session", "-t", name)
-	if retErr == ErrSessionNotFound || retErr == ErrNoServer {
+	if errors.Is(retErr, ErrSessionNotFound) || errors.Is(retErr, ErrNoServer) {
 		retErr = nil
 	}
 	return retErr
@@ -502,7 +505,7 @@ func (t *Tmux) KillSessionWithProcesses(name string) error {
 	if err != nil {
 		// Session might not exist or server may have already gone away.
 		killErr := t.KillSession(name)
-		if killErr == nil || killErr == ErrSessionNotFound || killErr == ErrNoServer {
+		if killErr == nil || errors.Is(killErr, ErrSessionNotFound) || errors.Is(killErr, ErrNoServer) {
 			return nil
 		}
 		return killErr
@@ -554,7 +557,7 @@ func (t *Tmux) KillSessionWithProcesses(name string) error {
 	// Ignore missing/dead-server errors - killing the pane process may have
 	// already caused tmux to destroy the session automatically.
 	err = t.KillSession(name)
-	if err == ErrSessionNotFound || err == ErrNoServer {
+	if errors.Is(err, ErrSessionNotFound) || errors.Is(err, ErrNoServer) {
 		return nil
 	}
 	return err
@@ -576,7 +579,7 @@ func (t *Tmux) KillSessionWithProcessesExcluding(name string, excludePIDs []stri
 	if err != nil {
 		// Session might not exist or server may have already gone away.
 		killErr := t.KillSession(name)
-		if killErr == nil || killErr == ErrSessionNotFound || killErr == ErrNoServer {
+		if killErr == nil || errors.Is(killErr, ErrSessionNotFound) || errors.Is(killErr, ErrNoServer) {
 			return nil
 		}
 		return killErr
@@ -646,7 +649,7 @@ func (t *Tmux) KillSessionWithProcessesExcluding(name string, excludePIDs []stri
 	// Ignore missing/dead-server errors - if we killed all non-excluded
 	// processes, tmux may have already destroyed the session automatically.
 	err = t.KillSession(name)
-	if err == ErrSessionNotFound || err == ErrNoServer {
+	if errors.Is(err, ErrSessionNotFound) || errors.Is(err, ErrNoServer) {
 		return nil
 	}
 	return err
@@ -1012,8 +1015,21 @@ func (t *Tmux) SendKeys(session, keys string) error {
 // SendKeysDebounced sends keystrokes with a configurable delay before Enter.
 // The debounceMs parameter controls how long to wait after paste before sending Enter.
 // This prevents race conditions where Enter arrives before paste is processed.
+// Sends go through the session's send queue, like nudges: the debounce is
+// part of the send, so no other send lands between the paste and its Enter.
 func (t *Tmux) SendKeysDebounced(session, keys string, debounceMs int) (retErr error) {
 	defer func() { telemetry.RecordPromptSend(context.Background(), session, keys, debounceMs, retErr) }()
+	if err := t.guardInput(session, keys); err != nil {
+		return err
+	}
+	return sendQueued(t.socketName, session, "keys\x00"+keys, false, func() error {
+		return t.sendKeysDebounced(session, keys, debounceMs)
+	})
+}
+
+// sendKeysDebounced pastes keys and presses Enter; the caller holds the
+// session's send queue.
+func (t *Tmux) sendKeysDebounced(session, keys string, debounceMs int) error {
 	// Send text using literal mode (-l) to handle special chars
 	if _, err := t.run("send-keys", "-t", session, "-l", keys); err != nil {
 		return err
@@ -1023,8 +1039,8 @@ func (t *Tmux) SendKeysDebounced(session, keys string, debounceMs int) (retErr e
 		time.Sleep(time.Duration(debounceMs) * time.Millisecond)
 	}
 	// Send Enter separately - more reliable than appending to send-keys
-	_, retErr = t.run("send-keys", "-t", session, "Enter")
-	return retErr
+	_, err := t.run("send-keys", "-t", session, "Enter")
+	return err
 }
 
 // SendKeysRaw sends keystrokes without adding Enter.
@@ -1037,19 +1053,29 @@ func (t *Tmux) SendKeysRaw(session, keys string) error {
 // This is useful for "replaceable" notifications where only the latest matters.
 // Uses Ctrl-U to clear the input line before sending the new message.
 // The delay parameter controls how long to wait after clearing before sending (ms).
-func (t *Tmux) SendKeysReplace(session, keys string, clearDelayMs int) error {
-	// Send Ctrl-U to clear any pending input on the line
-	if _, err := t.run("send-keys", "-t", session, "C-u"); err != nil {
+// A replaceable send still waiting in the session's send queue is superseded:
+// it goes out once, with the latest keys.
+func (t *Tmux) SendKeysReplace(session, keys string, clearDelayMs int) (retErr error) {
+	defer func() {
+		telemetry.RecordPromptSend(context.Background(), session, keys, constants.DefaultDebounceMs, retErr)
+	}()
+	if err := t.guardInput(session, keys); err != nil {
 		return err
 	}
+	return sendQueued(t.socketName, session, "replace", true, func() error {
+		// Send Ctrl-U to clear any pending input on the line
+		if _, err := t.run("send-keys", "-t", session, "C-u"); err != nil {
+			return err
+		}
 
-	// Small delay to let the clear take effect
-	if clearDelayMs > 0 {
-		time.Sleep(time.Duration(clearDelayMs) * time.Millisecond)
-	}
+		// Small delay to let the clear take effect
+		if clearDelayMs > 0 {
+			time.Sleep(time.Duration(clearDelayMs) * time.Millisecond)
+		}
 
-	// Now send the actual message
-	return t.SendKeys(session, keys)
+		// Now send the actual message
+		return t.sendKeysDebounced(session, keys, constants.DefaultDebounceMs)
+	})
 }
 
 // SendKeysDelayed sends keystrokes after a delay (in milliseconds).
@@ -1167,6 +1193,9 @@ func isTransientSendKeysError(err error) bool {
 	if err == nil {
 		return false
 	}
+	if ClassOf(err) == ClassTransient {
+		return true
+	}
 	msg := err.Error()
 	return strings.Contains(msg, "not in a mode")
 }
@@ -1293,18 +1322,25 @@ func (t *Tmux) sendKeysLiteralWithRetry(target, text string, timeout time.Durati
 // If the agent TUI hasn't initialized yet (cold startup), retries with backoff
 // up to NudgeReadyTimeout before giving up. See sendKeysLiteralWithRetry.
 //
-// IMPORTANT: Nudges to the same session are serialized to prevent interleaving.
-// If multiple goroutines try to nudge the same session concurrently, they will
-// queue up and execute one at a time. This prevents garbled input when
-// SessionStart hooks and nudges arrive simultaneously.
+// The message is screened by the session input guard first; see
+// ErrInputBlocked.
+//
+// IMPORTANT: Nudges to the same session go through its send queue (see
+// sendQueued) to prevent interleaving: concurrent nudges are delivered one
+// at a time, in order, and a nudge identical to one still waiting is sent
+// once. This prevents garbled input when SessionStart hooks and nudges
+// arrive simultaneously.
 func (t *Tmux) NudgeSession(session, message string) error {
-	// Serialize nudges to this session to prevent interleaving.
-	// Use a timed lock to avoid permanent blocking if a previous nudge hung.
-	if !acquireNudgeLock(session, nudgeLockTimeout) {
-		return fmt.Errorf("nudge lock timeout for session %q: previous nudge may be hung", session)
+	if err := t.guardInput(session, message); err != nil {
+		return err
 	}
-	defer releaseNudgeLock(session)
+	return sendQueued(t.socketName, session, "nudge\x00"+message, false, func() error {
+		return t.nudgeSession(session, message)
+	})
+}
 
+// nudgeSession delivers a nudge; the caller holds the session's send queue.
+func (t *Tmux) nudgeSession(session, message string) error {
 	// Resolve the correct target: in multi-pane sessions, find the pane
 	// running the agent rather than sending to the focused pane.
 	target := session
@@ -1361,15 +1397,18 @@ func (t *Tmux) NudgeSession(session, message string) error {
 // NudgePane sends a message to a specific pane reliably.
 // Same pattern as NudgeSession but targets a pane ID (e.g., "%9") instead of session name.
 // After sending, triggers SIGWINCH to wake Claude in detached sessions.
-// Nudges to the same pane are serialized to prevent interleaving.
+// Nudges to the same pane go through its send queue to prevent interleaving.
 func (t *Tmux) NudgePane(pane, message string) error {
-	// Serialize nudges to this pane to prevent interleaving.
-	// Use a timed lock to avoid permanent blocking if a previous nudge hung.
-	if !acquireNudgeLock(pane, nudgeLockTimeout) {
-		return fmt.Errorf("nudge lock timeout for pane %q: previous nudge may be hung", pane)
+	if err := t.guardInput(pane, message); err != nil {
+		return err
 	}
-	defer releaseNudgeLock(pane)
+	return sendQueued(t.socketName, pane, "nudge\x00"+message, false, func() error {
+		return t.nudgePane(pane, message)
+	})
+}
 
+// nudgePane delivers a nudge; the caller holds the pane's send queue.
+func (t *Tmux) nudgePane(pane, message string) error {
 	// 1. Exit copy/scroll mode if active — copy mode intercepts input,
 	//    preventing delivery to the underlying process.
 	if inMode, _ := t.run("display-message", "-p", "-t", pane, "#{pane_in_mode}"); strings.TrimSpace(inMode) == "1" {
@@ -1907,6 +1946,13 @@ func (t *Tmux) CapturePane(session string, lines int) (string, error) {
 	return content, err
 }
 
+// PipePane pipes a pane's output to a shell command, replacing any pipe
+// already open for it.
+func (t *Tmux) PipePane(session, command string) error {
+	_, err := t.run("pipe-pane", "-t", session, command)
+	return err
+}
+
 // CapturePaneAll captures all scrollback history.
 func (t *Tmux) CapturePaneAll(session string) (string, error) {
 	return t.run("capture-pane", "-p", "-t", session, "-S", "-")
@@ -2312,44 +2358,30 @@ func (t *Tmux) WaitForRuntimeReady(session string, rc *config.RuntimeConfig, tim
 // Claude Code uses ❯ (U+276F) as the prompt character.
 const DefaultReadyPromptPrefix = "❯ "
 
-// WaitForIdle polls until the agent appears to be at an idle prompt.
-// Unlike WaitForRuntimeReady (which is for bootstrap), this is for steady-state
-// idle detection — used to avoid interrupting agents mid-work.
+// WaitForIdle polls until the agent has finished responding and is waiting
+// for input. Unlike WaitForRuntimeReady (which is for bootstrap), this is for
+// steady-state idle detection — used to avoid interrupting agents mid-work,
+// and in place of fixed sleeps when a caller needs an agent to settle.
+//
+// Detection follows the session's runtime (see ResponseDetectorFor): the
+// ready prompt with no busy marker for agents that draw one, otherwise a
+// pane that has stopped changing.
 //
 // Returns nil if the agent becomes idle within the timeout.
-// Returns an error if the timeout expires while the agent is still busy.
+// Returns ErrIdleTimeout if the timeout expires while the agent is still busy.
 func (t *Tmux) WaitForIdle(session string, timeout time.Duration) error {
-	promptPrefix := DefaultReadyPromptPrefix
-	prefix := strings.TrimSpace(promptPrefix)
+	return t.WaitForResponse(session, t.ResponseDetectorFor(session), timeout)
+}
 
-	deadline := time.Now().Add(timeout)
-	for time.Now().Before(deadline) {
-		lines, err := t.CapturePaneLines(session, 5)
-		if err != nil {
-			// Distinguish terminal errors from transient ones.
-			// Session not found or no server means the session is gone —
-			// no point in polling further.
-			if errors.Is(err, ErrSessionNotFound) || errors.Is(err, ErrNoServer) {
-				return err
-			}
-			time.Sleep(200 * time.Millisecond)
-			continue
-		}
-		// Scan all captured lines for the prompt prefix.
-		// Claude Code renders a status bar below the prompt line,
-		// so the prompt may not be the last non-empty line.
-		for _, line := range lines {
-			trimmed := strings.TrimSpace(line)
-			if trimmed == "" {
-				continue
-			}
-			if matchesPromptPrefix(trimmed, promptPrefix) || (prefix != "" && trimmed == prefix) {
-				return nil
-			}
-		}
-		time.Sleep(200 * time.Millisecond)
+// NudgeWhenIdle waits up to timeout for the agent to finish responding, then
+// nudges it, so the message arrives between turns instead of mid-response.
+// An agent still busy at the timeout is nudged anyway; the message waits in
+// its input until the next prompt.
+func (t *Tmux) NudgeWhenIdle(session, message string, timeout time.Duration) error {
+	if err := t.WaitForIdle(session, timeout); errors.Is(err, ErrSessionNotFound) || errors.Is(err, ErrNoServer) {
+		return err
 	}
-	return ErrIdleTimeout
+	return t.NudgeSession(session, message)
 }
 
 // IsAtPrompt checks if the agent is currently at an idle prompt (non-blocking).
@@ -120,7 +120,7 @@ func TestDuplicateSession(t *testing.T) {
 
 	// Try to create duplicate
 	err := tm.NewSession(sessionName, "")
-	if err != ErrSessionExists {
+	if !errors.Is(err, ErrSessionExists) {
 		t.Errorf("expected ErrSessionExists, got %v", err)
 	}
 }
@@ -189,20 +189,77 @@ func TestWrapError(t *testing.T) {
 	tests := []struct {
 		stderr string
 		want   error
+		class  ErrorClass
 	}{
-		{"no server running on /tmp/tmux-...", ErrNoServer},
-		{"error connecting to /tmp/tmux-...", ErrNoServer},
-		{"no current target", ErrNoServer},
-		{"duplicate session: test", ErrSessionExists},
-		{"session not found: test", ErrSessionNotFound},
-		{"can't find session: test", ErrSessionNotFound},
+		{"no server running on /tmp/tmux-...", ErrNoServer, ClassNoServer},
+		{"error connecting to /tmp/tmux-...", ErrNoServer, ClassNoServer},
+		{"no current target", ErrNoServer, ClassNoServer},
+		{"duplicate session: test", ErrSessionExists, ClassSessionExists},
+		{"session not found: test", ErrSessionNotFound, ClassSessionMissing},
+		{"can't find session: test", ErrSessionNotFound, ClassSessionMissing},
+		{"can't find pane: %3", ErrPaneDead, ClassPaneDead},
+		{"error connecting to /tmp/tmux-0/gt (Permission denied)", ErrPermissionDenied, ClassPermissionDenied},
+		{"Resource temporarily unavailable", ErrTransient, ClassTransient},
+		{"not in a mode", nil, ClassUsage},
 	}
 
 	for _, tt := range tests {
 		err := tm.wrapError(nil, tt.stderr, []string{"test"})
-		if err != tt.want {
+		if tt.want != nil && !errors.Is(err, tt.want) {
 			t.Errorf("wrapError(%q) = %v, want %v", tt.stderr, err, tt.want)
 		}
+		if got := ClassOf(err); got != tt.class {
+			t.Errorf("ClassOf(wrapError(%q)) = %q, want %q", tt.stderr, got, tt.class)
+		}
+	}
+}
+
+func TestCommandError(t *testing.T) {
+	t.Parallel()
+	err := fmt.Errorf("killing: %w", &CommandError{Command: "kill-session", Class: ClassSessionMissing, Stderr: "can't find session: x"})
+	if !errors.Is(err, ErrSessionNotFound) || errors.Is(err, ErrNoServer) {
+		t.Errorf("errors.Is matched the wrong sentinel for %v", err)
+	}
+	if got, want := err.Error(), "killing: tmux kill-session: can't find session: x"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	other := &CommandError{Command: "send-keys", Class: ClassOther, Err: errors.New("exit status 1")}
+	for _, sentinel := range []error{ErrNoServer, ErrSessionNotFound, ErrSessionExists, ErrPaneDead, ErrPermissionDenied, ErrTransient} {
+		if errors.Is(other, sentinel) {
+			t.Errorf("ClassOther error matched %v", sentinel)
+		}
+	}
+	if got := other.Error(); got != "tmux send-keys: exit status 1" {
+		t.Errorf("Error() = %q", got)
+	}
+
+	if got := ClassOf(ErrNoServer); got != ClassNoServer {
+		t.Errorf("ClassOf(ErrNoServer) = %q", got)
+	}
+	if got := ClassOf(errors.New("boom")); got != ClassOther {
+		t.Errorf("ClassOf(other) = %q", got)
+	}
+	if got := ClassOf(nil); got != "" {
+		t.Errorf("ClassOf(nil) = %q", got)
+	}
+}
+
+func TestErrorClassUnexpected(t *testing.T) {
+	t.Parallel()
+	for class, want := range map[ErrorClass]bool{
+		ClassNoServer:         false,
+		ClassSessionExists:    false,
+		ClassSessionMissing:   false,
+		ClassPaneDead:         false,
+		ClassUsage:            false,
+		ClassPermissionDenied: true,
+		ClassTransient:        true,
+		ClassOther:            true,
+	} {
+		if got := class.Unexpected(); got != want {
+			t.Errorf("%s.Unexpected() = %v, want %v", class, got, want)
+		}
 	}
 }
 
@@ -0,0 +1,447 @@
+// Package trace reconstructs the end-to-end timeline of a single wisp.
+//
+// A wisp's history is spread across several stores: the town event log
+// (.events.jsonl) records slings, hooks, nudges, merges, and completion; the
+// town activity log (logs/town.log) records agent lifecycle; the stats ledger
+// keeps stage times after the event log is pruned; the experiment ledger
+// records prompt variants; the artifact registry holds the branches, patches,
+// and reports produced; and the bead itself carries creation, closure, and
+// comments. Build merges them into one ordered list of entries.
+//
+// Events that name the wisp's bead are always included. Events about the
+// assigned agent (spawn, prime, prompts, deaths) are included only while the
+// agent held the wisp, so a polecat's earlier and later work stays out.
+package trace
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/steveyegge/gastown/internal/artifact"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/experiment"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/townlog"
+)
+
+// Kind classifies a timeline entry.
+type Kind string
+
+// Entry kinds, roughly in lifecycle order.
+const (
+	KindCreated  Kind = "created"  // Bead created
+	KindSling    Kind = "sling"    // Sling or scheduler decision
+	KindClaim    Kind = "claim"    // Hooked by an agent
+	KindSpawn    Kind = "spawn"    // Agent session spawned or resumed
+	KindPrime    Kind = "prime"    // Agent primed (session start)
+	KindPrompt   Kind = "prompt"   // Nudge or mail sent to the agent
+	KindState    Kind = "state"    // Handoff, unhook, crash, kill, patrol check
+	KindGate     Kind = "gate"     // Approval gate
+	KindReview   Kind = "review"   // Merge queue activity
+	KindComment  Kind = "comment"  // Structured wisp comment
+	KindArtifact Kind = "artifact" // Artifact registered
+	KindDone     Kind = "done"     // gt done
+	KindClosed   Kind = "closed"   // Bead closed
+	KindVariant  Kind = "variant"  // Experiment assignment
+	KindOther    Kind = "other"    // Any other event naming the bead
+)
+
+// Sources of timeline entries.
+const (
+	SourceEvents      = "events"
+	SourceTownLog     = "townlog"
+	SourceBeads       = "beads"
+	SourceStats       = "stats"
+	SourceExperiments = "experiments"
+	SourceArtifacts   = "artifacts"
+)
+
+// Entry is one point on a wisp's timeline.
+type Entry struct {
+	Time    time.Time `json:"time"`
+	Kind    Kind      `json:"kind"`
+	Source  string    `json:"source"`
+	Actor   string    `json:"actor,omitempty"`
+	Summary string    `json:"summary"`
+	Type    string    `json:"type,omitempty"` // Raw event type in the source store
+}
+
+// Trace is a wisp's reconstructed timeline.
+type Trace struct {
+	Bead   string          `json:"bead"`
+	Title  string          `json:"title,omitempty"`
+	Status string          `json:"status,omitempty"`
+	Rig    string          `json:"rig,omitempty"`
+	Agent  string          `json:"agent,omitempty"`
+	Stages *stats.Timeline `json:"stages,omitempty"`
+
+	// Artifacts are the wisp's registered outputs, oldest first.
+	Artifacts []artifact.Artifact `json:"artifacts,omitempty"`
+
+	Entries  []Entry  `json:"entries"`
+	Warnings []string `json:"warnings,omitempty"` // Stores that could not be read
+}
+
+// Inputs are the raw records Build merges. Any field may be empty.
+type Inputs struct {
+	Events      []events.Event
+	TownLog     []townlog.Event
+	Ledger      *stats.Timeline
+	Issue       *beads.Issue
+	Comments    []*beads.Comment
+	Assignments []experiment.Assignment
+	Artifacts   []artifact.Artifact
+	Now         time.Time // End of the agent window for unfinished wisps
+}
+
+// Load reads every store for bead and builds its trace. Stores that fail to
+// load are reported in Warnings rather than failing the trace.
+func Load(townRoot, bead string) (*Trace, error) {
+	in := Inputs{Now: time.Now()}
+	var warnings []string
+	warn := func(store string, err error) {
+		warnings = append(warnings, fmt.Sprintf("%s: %v", store, err))
+	}
+
+	var err error
+	if in.Events, err = events.ReadAll(townRoot); err != nil {
+		warn(SourceEvents, err)
+	}
+	if in.TownLog, err = townlog.ReadEvents(townRoot); err != nil {
+		warn(SourceTownLog, err)
+	}
+	if ledger, err := stats.LoadLedger(townRoot); err != nil {
+		warn(SourceStats, err)
+	} else {
+		for _, t := range ledger {
+			if t.Bead == bead {
+				in.Ledger = t
+			}
+		}
+	}
+	if in.Assignments, err = experiment.AssignmentsForBead(townRoot, bead); err != nil {
+		warn(SourceExperiments, err)
+	}
+	if in.Artifacts, err = artifact.List(townRoot, bead); err != nil {
+		warn(SourceArtifacts, err)
+	}
+	bd := beads.New(townRoot)
+	if in.Issue, err = bd.Show(bead); err != nil {
+		warn(SourceBeads, err)
+	} else if in.Comments, err = bd.Comments(bead); err != nil {
+		warn(SourceBeads+" comments", err)
+	}
+
+	t := Build(bead, in)
+	t.Warnings = append(warnings, t.Warnings...)
+	if len(t.Entries) == 0 && in.Issue == nil {
+		return t, fmt.Errorf("no record of %s in any store", bead)
+	}
+	return t, nil
+}
+
+// Build merges inputs into a trace for bead, ordered by time.
+func Build(bead string, in Inputs) *Trace {
+	t := &Trace{Bead: bead, Entries: []Entry{}}
+
+	// Stage times: prefer the event log, fall back to the ledger.
+	for _, tl := range stats.BuildTimelines(in.Events) {
+		if tl.Bead == bead {
+			t.Stages = tl
+		}
+	}
+	if t.Stages == nil && in.Ledger != nil {
+		t.Stages = in.Ledger
+	}
+	if t.Stages != nil {
+		t.Agent, t.Rig = t.Stages.Agent, t.Stages.Rig
+	}
+	if in.Issue != nil {
+		t.Title, t.Status = in.Issue.Title, in.Issue.Status
+		if t.Agent == "" {
+			t.Agent = in.Issue.Assignee
+		}
+	}
+	if t.Rig == "" {
+		t.Rig = stats.RigFromAgent(t.Agent)
+	}
+
+	start, end := agentWindow(t.Stages, in.Now)
+	inWindow := func(ts time.Time) bool {
+		return t.Agent != "" && !start.IsZero() && !ts.Before(start) && (end.IsZero() || !ts.After(end))
+	}
+
+	seenTypes := make(map[string]bool)
+	for _, e := range in.Events {
+		ts := e.Time()
+		if ts.IsZero() {
+			continue
+		}
+		if mentionsBead(e, bead) {
+			t.add(Entry{Time: ts, Kind: eventKind(e.Type), Source: SourceEvents, Actor: e.Actor, Type: e.Type, Summary: eventSummary(e)})
+			seenTypes[e.Type] = true
+			continue
+		}
+		if !inWindow(ts) {
+			continue
+		}
+		if kind, ok := agentEventKind(e, t.Agent); ok {
+			t.add(Entry{Time: ts, Kind: kind, Source: SourceEvents, Actor: e.Actor, Type: e.Type, Summary: eventSummary(e)})
+		}
+	}
+
+	// The stats ledger outlives the event log; add stages the log no longer has.
+	if in.Ledger != nil {
+		for _, st := range []struct {
+			at    time.Time
+			kind  Kind
+			types []string
+			text  string
+		}{
+			{in.Ledger.Queued, KindSling, []string{events.TypeSling, events.TypeSchedulerEnqueue}, "queued"},
+			{in.Ledger.Claimed, KindClaim, []string{events.TypeHook}, "claimed by " + in.Ledger.Agent},
+			{in.Ledger.Completed, KindDone, []string{events.TypeDone}, "completed by " + in.Ledger.Agent},
+		} {
+			if st.at.IsZero() || anySeen(seenTypes, st.types) {
+				continue
+			}
+			t.add(Entry{Time: st.at, Kind: st.kind, Source: SourceStats, Summary: st.text})
+		}
+	}
+
+	for _, le := range in.TownLog {
+		ts := wallClock(le.Timestamp)
+		if !containsID(le.Context, bead) && !(inWindow(ts) && SameAgent(le.Agent, t.Agent)) {
+			continue
+		}
+		summary := string(le.Type)
+		if le.Context != "" {
+			summary += ": " + le.Context
+		}
+		t.add(Entry{Time: ts, Kind: townlogKind(le.Type), Source: SourceTownLog, Actor: le.Agent, Type: string(le.Type), Summary: summary})
+	}
+
+	for _, a := range in.Assignments {
+		t.add(Entry{Time: a.At, Kind: KindVariant, Source: SourceExperiments, Actor: a.Agent,
+			Summary: fmt.Sprintf("experiment %s: variant %s", a.Experiment, a.Variant)})
+	}
+
+	t.Artifacts = in.Artifacts
+	for _, a := range in.Artifacts {
+		summary := fmt.Sprintf("%s %s (%s)", a.Kind, a.Ref, a.ID)
+		if a.Description != "" {
+			summary += ": " + a.Description
+		}
+		t.add(Entry{Time: a.CreatedAt, Kind: KindArtifact, Source: SourceArtifacts, Actor: a.CreatedBy, Summary: summary})
+	}
+
+	if in.Issue != nil {
+		if ts := parseBeadTime(in.Issue.CreatedAt); !ts.IsZero() {
+			t.add(Entry{Time: ts, Kind: KindCreated, Source: SourceBeads, Actor: in.Issue.CreatedBy, Summary: "created: " + in.Issue.Title})
+		}
+		if ts := parseBeadTime(in.Issue.ClosedAt); !ts.IsZero() {
+			t.add(Entry{Time: ts, Kind: KindClosed, Source: SourceBeads, Summary: "closed (" + in.Issue.Status + ")"})
+		}
+	}
+	for _, c := range in.Comments {
+		summary := firstLine(c.Body)
+		if c.Artifact != "" {
+			summary += " [" + c.Artifact + "]"
+		}
+		actor := c.Author
+		if c.Role != "" && c.Role != c.Author {
+			actor += " (" + c.Role + ")"
+		}
+		t.add(Entry{Time: c.CreatedAt, Kind: KindComment, Source: SourceBeads, Actor: actor, Summary: summary})
+	}
+
+	sort.SliceStable(t.Entries, func(i, j int) bool { return t.Entries[i].Time.Before(t.Entries[j].Time) })
+	return t
+}
+
+func (t *Trace) add(e Entry) {
+	t.Entries = append(t.Entries, e)
+}
+
+// Duration returns the span from the first to the last entry.
+func (t *Trace) Duration() time.Duration {
+	if len(t.Entries) < 2 {
+		return 0
+	}
+	return t.Entries[len(t.Entries)-1].Time.Sub(t.Entries[0].Time)
+}
+
+// agentWindow returns when the assigned agent held the wisp. An open window
+// (unfinished wisp) ends at now.
+func agentWindow(st *stats.Timeline, now time.Time) (start, end time.Time) {
+	if st == nil {
+		return time.Time{}, time.Time{}
+	}
+	start = st.Claimed
+	if start.IsZero() {
+		start = st.Queued
+	}
+	end = st.Completed
+	if end.IsZero() {
+		end = now
+	}
+	return start, end
+}
+
+// mentionsBead reports whether any payload field names the bead.
+func mentionsBead(e events.Event, bead string) bool {
+	for _, key := range []string{"bead", "issue", "target", "source_issue"} {
+		if e.PayloadString(key) == bead {
+			return true
+		}
+	}
+	return false
+}
+
+// eventKind maps an event naming the bead to a timeline kind.
+func eventKind(typ string) Kind {
+	switch typ {
+	case events.TypeSling, events.TypeSchedulerEnqueue, events.TypeSchedulerDispatch, events.TypeSchedulerDispatchFailed:
+		return KindSling
+	case events.TypeHook:
+		return KindClaim
+	case events.TypeUnhook, events.TypePolecatChecked, events.TypeSchedulerCloseRetry:
+		return KindState
+	case events.TypeMergeStarted, events.TypeMerged, events.TypeMergeFailed, events.TypeMergeSkipped:
+		return KindReview
+	case events.TypeApprovalRequested, events.TypeApprovalDecided:
+		return KindGate
+	case events.TypeDone:
+		return KindDone
+	}
+	return KindOther
+}
+
+// agentEventKind classifies an event about the assigned agent. ok is false
+// for events that are not about the agent.
+func agentEventKind(e events.Event, agent string) (Kind, bool) {
+	switch e.Type {
+	case events.TypeSpawn:
+		rig, polecat := e.PayloadString("rig"), e.PayloadString("polecat")
+		return KindSpawn, polecat != "" && SameAgent(rig+"/"+polecat, agent)
+	case events.TypeSessionStart:
+		return KindPrime, SameAgent(e.Actor, agent)
+	case events.TypeNudge, events.TypePolecatNudged:
+		return KindPrompt, SameAgent(e.PayloadString("target"), agent)
+	case events.TypeMail:
+		return KindPrompt, SameAgent(e.PayloadString("to"), agent)
+	case events.TypeHandoff, events.TypeSessionEnd, events.TypeSessionDeath, events.TypeKill:
+		target := e.PayloadString("target")
+		if target == "" {
+			target = e.PayloadString("agent")
+		}
+		return KindState, SameAgent(e.Actor, agent) || SameAgent(target, agent)
+	case events.TypeEscalationSent:
+		return KindState, SameAgent(e.PayloadString("target"), agent)
+	}
+	return "", false
+}
+
+func townlogKind(typ townlog.EventType) Kind {
+	switch typ {
+	case townlog.EventSpawn, townlog.EventWake:
+		return KindSpawn
+	case townlog.EventNudge, townlog.EventPolecatNudged:
+		return KindPrompt
+	case townlog.EventDone:
+		return KindDone
+	}
+	return KindState
+}
+
+// eventSummary renders an event as a short line.
+func eventSummary(e events.Event) string {
+	var parts []string
+	for _, key := range []string{"target", "to", "branch", "mr", "status", "action", "reason", "subject", "error"} {
+		if v := e.PayloadString(key); v != "" {
+			parts = append(parts, key+"="+clip(firstLine(v), summaryValueMax))
+		}
+	}
+	if len(parts) == 0 {
+		return e.Type
+	}
+	return e.Type + " " + strings.Join(parts, " ")
+}
+
+// SameAgent reports whether two agent addresses name the same agent. Stores
+// disagree on the form ("gastown/polecats/Toast" vs "gastown/Toast"), so
+// addresses match when their rig and final name agree.
+func SameAgent(a, b string) bool {
+	a, b = strings.Trim(a, "/"), strings.Trim(b, "/")
+	if a == "" || b == "" {
+		return false
+	}
+	if a == b {
+		return true
+	}
+	pa, pb := strings.Split(a, "/"), strings.Split(b, "/")
+	if len(pa) < 2 || len(pb) < 2 {
+		return false
+	}
+	return pa[0] == pb[0] && pa[len(pa)-1] == pb[len(pb)-1]
+}
+
+// containsID reports whether s mentions id as a whole word, so gt-ab does
+// not match gt-abc.
+func containsID(s, id string) bool {
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool {
+		return r != '-' && r != '.' && r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if f == id {
+			return true
+		}
+	}
+	return false
+}
+
+func anySeen(seen map[string]bool, types []string) bool {
+	for _, typ := range types {
+		if seen[typ] {
+			return true
+		}
+	}
+	return false
+}
+
+// wallClock reinterprets a town log time. The log stores local wall-clock
+// times without a zone and townlog parses them as UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.Local)
+}
+
+// parseBeadTime parses a bd timestamp, returning the zero time if malformed.
+func parseBeadTime(s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// summaryValueMax caps payload values in summaries (nudge text can be long).
+const summaryValueMax = 80
+
+func clip(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-3] + "..."
+}
+
+func firstLine(s string) string {
+	s, _, _ = strings.Cut(strings.TrimSpace(s), "\n")
+	return s
+}
@@ -0,0 +1,120 @@
+package trace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/artifact"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/experiment"
+	"github.com/steveyegge/gastown/internal/stats"
+	"github.com/steveyegge/gastown/internal/townlog"
+)
+
+func ev(at time.Time, typ, actor string, payload map[string]interface{}) events.Event {
+	return events.Event{Timestamp: at.Format(time.RFC3339), Type: typ, Actor: actor, Payload: payload}
+}
+
+func TestBuild(t *testing.T) {
+	base := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	at := func(m int) time.Time { return base.Add(time.Duration(m) * time.Minute) }
+	agent := "gastown/polecats/Toast"
+
+	in := Inputs{
+		Events: []events.Event{
+			// Before the claim: the same polecat's previous wisp.
+			ev(at(-30), events.TypeNudge, "mayor", events.NudgePayload("gastown", "gastown/Toast", "old work")),
+			ev(at(0), events.TypeSling, "mayor", events.SlingPayload("gt-abc", agent)),
+			ev(at(1), events.TypeSpawn, "gt", events.SpawnPayload("gastown", "Toast")),
+			ev(at(1), events.TypeHook, agent, events.HookPayload("gt-abc")),
+			ev(at(2), events.TypeSessionStart, agent, events.SessionPayload("s1", agent, "", "")),
+			ev(at(5), events.TypeNudge, "gastown/witness", events.NudgePayload("gastown", "gastown/Toast", "status?")),
+			ev(at(6), events.TypeNudge, "gastown/witness", events.NudgePayload("gastown", "gastown/Nux", "someone else")),
+			ev(at(9), events.TypeSling, "mayor", events.SlingPayload("gt-abcd", agent)),
+			ev(at(10), events.TypeDone, agent, events.DonePayload("gt-abc", "polecat/Toast")),
+			ev(at(12), events.TypeMerged, "gastown/refinery", map[string]interface{}{"mr": "gt-mr1", "bead": "gt-abc", "branch": "polecat/Toast"}),
+			// After completion: not part of this wisp.
+			ev(at(20), events.TypeNudge, "mayor", events.NudgePayload("gastown", "gastown/Toast", "next")),
+		},
+		TownLog: []townlog.Event{
+			{Timestamp: wallUTC(at(1)), Type: townlog.EventSpawn, Agent: "gastown/Toast", Context: "gt-abc"},
+			{Timestamp: wallUTC(at(30)), Type: townlog.EventKill, Agent: "gastown/Toast"},
+		},
+		Issue: &beads.Issue{ID: "gt-abc", Title: "Fix it", Status: "closed", Assignee: agent,
+			CreatedAt: at(-60).Format(time.RFC3339), ClosedAt: at(12).Format(time.RFC3339)},
+		Comments:    []*beads.Comment{{Author: "overseer", Role: "human", Body: "smaller change\nplease", CreatedAt: at(4)}},
+		Assignments: []experiment.Assignment{{Experiment: "prompt-v2", Variant: "b", Bead: "gt-abc", Agent: agent, At: at(1)}},
+		Artifacts:   []artifact.Artifact{{ID: "art-1", Wisp: "gt-abc", Kind: artifact.KindBranch, Ref: "polecat/Toast", CreatedBy: agent, CreatedAt: at(10)}},
+		Now:         at(60),
+	}
+
+	tr := Build("gt-abc", in)
+	if tr.Agent != agent || tr.Rig != "gastown" || tr.Title != "Fix it" {
+		t.Fatalf("header = %q %q %q", tr.Agent, tr.Rig, tr.Title)
+	}
+
+	var kinds []Kind
+	for _, e := range tr.Entries {
+		kinds = append(kinds, e.Kind)
+	}
+	want := []Kind{KindCreated, KindSling, KindSpawn, KindClaim, KindSpawn, KindVariant, KindPrime, KindComment, KindPrompt, KindDone, KindArtifact, KindReview, KindClosed}
+	if len(kinds) != len(want) {
+		t.Fatalf("kinds = %v\nwant    %v", kinds, want)
+	}
+	for i := range want {
+		if kinds[i] != want[i] {
+			t.Fatalf("kinds = %v\nwant    %v", kinds, want)
+		}
+	}
+	if len(tr.Artifacts) != 1 || tr.Artifacts[0].ID != "art-1" {
+		t.Errorf("Artifacts = %+v, want art-1", tr.Artifacts)
+	}
+	if got := tr.Duration(); got != 72*time.Minute {
+		t.Errorf("Duration = %v, want 72m", got)
+	}
+}
+
+func TestBuildFallsBackToLedger(t *testing.T) {
+	base := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	ledger := &stats.Timeline{Bead: "gt-old", Agent: "gastown/polecats/Nux", Rig: "gastown",
+		Queued: base, Claimed: base.Add(time.Minute), Completed: base.Add(time.Hour)}
+
+	tr := Build("gt-old", Inputs{Ledger: ledger, Now: base.Add(48 * time.Hour)})
+	if len(tr.Entries) != 3 {
+		t.Fatalf("entries = %+v, want 3 ledger stages", tr.Entries)
+	}
+	for _, e := range tr.Entries {
+		if e.Source != SourceStats {
+			t.Errorf("entry %+v: source = %q, want stats", e, e.Source)
+		}
+	}
+	if tr.Stages != ledger {
+		t.Error("Stages should come from the ledger when the event log has none")
+	}
+}
+
+func TestSameAgent(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"gastown/polecats/Toast", "gastown/Toast", true},
+		{"gastown/polecats/Toast", "gastown/polecats/Toast/", true},
+		{"gastown/polecats/Toast", "beads/polecats/Toast", false},
+		{"gastown/polecats/Toast", "gastown/polecats/Nux", false},
+		{"mayor", "mayor", true},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		if got := SameAgent(tt.a, tt.b); got != tt.want {
+			t.Errorf("SameAgent(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// wallUTC mimics townlog parsing: local wall-clock time labelled UTC.
+func wallUTC(t time.Time) time.Time {
+	l := t.Local()
+	return time.Date(l.Year(), l.Month(), l.Day(), l.Hour(), l.Minute(), l.Second(), 0, time.UTC)
+}
@@ -1,6 +1,9 @@
 package feed
 
-import "github.com/charmbracelet/bubbles/key"
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/steveyegge/gastown/internal/observer"
+)
 
 // KeyMap defines the key bindings for the feed TUI.
 type KeyMap struct {
@@ -41,7 +44,7 @@ type KeyMap struct {
 
 // DefaultKeyMap returns the default key bindings.
 func DefaultKeyMap() KeyMap {
-	return KeyMap{
+	km := KeyMap{
 		Up: key.NewBinding(
 			key.WithKeys("up", "k"),
 			key.WithHelp("↑/k", "up"),
@@ -131,6 +134,12 @@ func DefaultKeyMap() KeyMap {
 			key.WithHelp("q", "quit"),
 		),
 	}
+	// Read-only observers can watch agents but not poke them
+	if observer.Enabled() {
+		km.Nudge.SetEnabled(false)
+		km.Handoff.SetEnabled(false)
+	}
+	return km
 }
 
 // ShortHelp returns key bindings for the short help view.
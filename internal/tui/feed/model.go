@@ -94,6 +94,10 @@ type Model struct {
 	showHelp bool
 	filter   string
 
+	// viewBeads restricts the event feed to these beads (gt feed --view).
+	// nil shows everything.
+	viewBeads map[string]bool
+
 	// View mode
 	viewMode ViewMode
 
@@ -825,6 +829,12 @@ func (m *Model) addEventLocked(e Event) bool {
 		}
 	}
 
+	// Restrict the feed to a saved wisp view's beads (gt feed --view).
+	// The agent tree above still tracks everything.
+	if m.viewBeads != nil && !m.viewBeads[e.Target] {
+		return true
+	}
+
 	// Add to event feed
 	m.events = append(m.events, e)
 
@@ -836,6 +846,19 @@ func (m *Model) addEventLocked(e Event) bool {
 	return true
 }
 
+// SetWispView restricts the event feed to events about the given beads and
+// labels the header with the view name.
+// Safe to call concurrently with the Bubble Tea event loop.
+func (m *Model) SetWispView(name string, beadIDs []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.filter = "view " + name
+	m.viewBeads = make(map[string]bool, len(beadIDs))
+	for _, id := range beadIDs {
+		m.viewBeads[id] = true
+	}
+}
+
 // SetEventChannel sets the channel to receive events from.
 // Safe to call concurrently with the Bubble Tea event loop.
 func (m *Model) SetEventChannel(ch <-chan Event) {
@@ -26,6 +26,7 @@ func init() {
 // This should be called after InitTheme() has been called.
 func ApplyThemeMode() {
 	if !ShouldUseColor() {
+		lipgloss.SetColorProfile(termenv.Ascii)
 		return
 	}
 	// Set lipgloss dark background flag based on theme mode
@@ -264,8 +265,12 @@ func RenderCategory(s string) string {
 	return CategoryStyle.Render(strings.ToUpper(s))
 }
 
-// RenderSeparator renders the light separator line in muted color
+// RenderSeparator renders the light separator line in muted color.
+// Screen-reader mode gets an empty line instead of a row of box drawing.
 func RenderSeparator() string {
+	if IsAccessible() {
+		return ""
+	}
 	return MutedStyle.Render(SeparatorLight)
 }
 
@@ -281,34 +286,78 @@ func RenderCommand(s string) string {
 
 // === Icon Render Functions ===
 
+// accessibleGlyphs spells out glyphs for screen-reader mode.
+var accessibleGlyphs = map[string]string{
+	IconPass:  "[ok]",
+	IconWarn:  "[warning]",
+	IconFail:  "[failed]",
+	IconSkip:  "[skipped]",
+	IconInfo:  "[info]",
+	IconFix:   "[fixed]",
+	TreeChild: "- ",
+	TreeLast:  "- ",
+	"→":       "->",
+	"✗":       "[failed]",
+	"⚠️":      "[warning]",
+	"○":       "-",
+	"◌":       "-",
+	"●":       "*",
+	"•":       "-",
+	"★":       "*",
+	"▶":       ">",
+	"▸":       ">",
+	"⏳":       "[waiting]",
+	"⧖":       "[waiting]",
+	"⏱":       "[time]",
+	"⏸️":      "[paused]",
+	"⚡":       "[!]",
+	"↻":       "[retry]",
+}
+
+// Glyph returns how an icon or line-drawing glyph is shown in the current
+// theme mode: the glyph itself, or words in screen-reader mode.
+func Glyph(icon string) string {
+	if IsAccessible() {
+		if text, ok := accessibleGlyphs[icon]; ok {
+			return text
+		}
+	}
+	return icon
+}
+
 // RenderPassIcon renders the pass icon with styling
 func RenderPassIcon() string {
-	return PassStyle.Render(IconPass)
+	return PassStyle.Render(Glyph(IconPass))
 }
 
 // RenderWarnIcon renders the warning icon with styling
 func RenderWarnIcon() string {
-	return WarnStyle.Render(IconWarn)
+	return WarnStyle.Render(Glyph(IconWarn))
 }
 
 // RenderFailIcon renders the fail icon with styling
 func RenderFailIcon() string {
-	return FailStyle.Render(IconFail)
+	return FailStyle.Render(Glyph(IconFail))
 }
 
 // RenderSkipIcon renders the skip icon with styling
 func RenderSkipIcon() string {
-	return MutedStyle.Render(IconSkip)
+	return MutedStyle.Render(Glyph(IconSkip))
 }
 
 // RenderInfoIcon renders the info icon with styling
 func RenderInfoIcon() string {
-	return AccentStyle.Render(IconInfo)
+	return AccentStyle.Render(Glyph(IconInfo))
 }
 
 // RenderFixIcon renders the fix icon (wrench emoji, double-width)
 func RenderFixIcon() string {
-	return IconFix
+	return Glyph(IconFix)
+}
+
+// RenderTreeLast renders the detail-line connector in muted color
+func RenderTreeLast() string {
+	return MutedStyle.Render(Glyph(TreeLast))
 }
 
 // === Issue Component Renderers ===
@@ -340,6 +389,12 @@ func RenderStatus(status string) string {
 // RenderStatusIcon returns the appropriate icon for a status with semantic coloring
 // This is the canonical source for status icon rendering - use this everywhere
 func RenderStatusIcon(status string) string {
+	if IsAccessible() {
+		switch status {
+		case "open", "in_progress", "blocked", "closed", "deferred", "pinned":
+			return "[" + strings.ReplaceAll(status, "_", " ") + "]"
+		}
+	}
 	switch status {
 	case "open":
 		return StatusIconOpen // no color - available but not urgent
@@ -364,6 +419,9 @@ func RenderStatusIcon(status string) string {
 // P0/P1/P2 get color; P3/P4 use standard text
 func RenderPriority(priority int) string {
 	label := fmt.Sprintf("%s P%d", PriorityIcon, priority)
+	if IsAccessible() {
+		label = fmt.Sprintf("P%d", priority)
+	}
 	switch priority {
 	case 0:
 		return PriorityP0Style.Render(label)
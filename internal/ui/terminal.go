@@ -18,8 +18,16 @@ const (
 	ThemeModeDark ThemeMode = "dark"
 	// ThemeModeLight forces light mode colors (dark text on light background).
 	ThemeModeLight ThemeMode = "light"
+	// ThemeModeNone disables color but keeps glyphs and line drawing.
+	ThemeModeNone ThemeMode = "none"
+	// ThemeModeAccessible is for screen readers: no color, status glyphs
+	// spelled out as words, and no decorative line drawing.
+	ThemeModeAccessible ThemeMode = "accessible"
 )
 
+// ThemeModes lists the valid theme modes.
+var ThemeModes = []ThemeMode{ThemeModeAuto, ThemeModeDark, ThemeModeLight, ThemeModeNone, ThemeModeAccessible}
+
 // themeMode is the cached theme mode, set during init.
 var themeMode ThemeMode
 
@@ -35,7 +43,7 @@ func InitTheme(configTheme string) {
 
 // GetThemeMode returns the current CLI color scheme mode.
 // Priority order:
-//  1. GT_THEME environment variable ("dark", "light", "auto")
+//  1. GT_THEME environment variable ("dark", "light", "auto", "none", "accessible")
 //  2. Configured value from settings (passed to InitTheme)
 //  3. Default: "auto"
 func GetThemeMode() ThemeMode {
@@ -48,31 +56,32 @@ func HasDarkBackground() bool {
 	return hasDarkBackground
 }
 
+// IsAccessible returns true in screen-reader mode.
+func IsAccessible() bool {
+	return themeMode == ThemeModeAccessible
+}
+
+// ParseThemeMode returns the theme mode named by s (case-insensitive).
+func ParseThemeMode(s string) (ThemeMode, bool) {
+	for _, m := range ThemeModes {
+		if strings.EqualFold(s, string(m)) {
+			return m, true
+		}
+	}
+	return "", false
+}
+
 // resolveThemeMode determines the theme mode from env and config.
 func resolveThemeMode(configTheme string) ThemeMode {
 	// Priority 1: GT_THEME environment variable
-	if envTheme := os.Getenv("GT_THEME"); envTheme != "" {
-		switch strings.ToLower(envTheme) {
-		case "dark":
-			return ThemeModeDark
-		case "light":
-			return ThemeModeLight
-		case "auto":
-			return ThemeModeAuto
-		}
-		// Invalid value - fall through to config
+	if mode, ok := ParseThemeMode(os.Getenv("GT_THEME")); ok {
+		return mode
 	}
+	// Invalid value - fall through to config
 
 	// Priority 2: Config value
-	if configTheme != "" {
-		switch strings.ToLower(configTheme) {
-		case "dark":
-			return ThemeModeDark
-		case "light":
-			return ThemeModeLight
-		case "auto":
-			return ThemeModeAuto
-		}
+	if mode, ok := ParseThemeMode(configTheme); ok {
+		return mode
 	}
 
 	// Default: auto
@@ -86,6 +95,8 @@ func detectDarkBackground(mode ThemeMode) bool {
 		return true
 	case ThemeModeLight:
 		return false
+	case ThemeModeNone, ThemeModeAccessible:
+		return true // No colors are drawn; skip querying the terminal
 	default:
 		// Auto mode - use termenv detection
 		return termenv.HasDarkBackground()
@@ -98,13 +109,18 @@ func IsTerminal() bool {
 }
 
 // ShouldUseColor determines if ANSI color codes should be used.
-// Respects NO_COLOR (https://no-color.org/), CLICOLOR, and CLICOLOR_FORCE conventions.
+// Respects NO_COLOR (https://no-color.org/), CLICOLOR, and CLICOLOR_FORCE conventions,
+// and the none and accessible theme modes.
 func ShouldUseColor() bool {
 	// NO_COLOR takes precedence - any value disables color
 	if _, exists := os.LookupEnv("NO_COLOR"); exists {
 		return false
 	}
 
+	if themeMode == ThemeModeNone || themeMode == ThemeModeAccessible {
+		return false
+	}
+
 	// CLICOLOR=0 disables color
 	if os.Getenv("CLICOLOR") == "0" {
 		return false
@@ -127,6 +143,11 @@ func ShouldUseEmoji() bool {
 		return false
 	}
 
+	// Screen readers announce emoji by name; spell things out instead
+	if IsAccessible() {
+		return false
+	}
+
 	// default: use emoji only if stdout is a TTY
 	return IsTerminal()
 }
@@ -332,3 +332,27 @@ func TestHasDarkBackground_ForcedModes(t *testing.T) {
 		t.Error("Expected HasDarkBackground() to return false when mode is light")
 	}
 }
+
+func TestAccessibleMode(t *testing.T) {
+	t.Cleanup(func() { InitTheme("") }) // Runs after GT_THEME is restored
+	t.Setenv("GT_THEME", "accessible")
+	InitTheme("")
+
+	if !IsAccessible() || ShouldUseColor() || ShouldUseEmoji() {
+		t.Fatal("accessible mode should disable color and emoji")
+	}
+	if got := RenderPassIcon(); got != "[ok]" {
+		t.Errorf("RenderPassIcon() = %q, want [ok]", got)
+	}
+	if got := RenderStatusIcon("in_progress"); got != "[in progress]" {
+		t.Errorf("RenderStatusIcon(in_progress) = %q", got)
+	}
+	if got := RenderSeparator(); got != "" {
+		t.Errorf("RenderSeparator() = %q, want empty", got)
+	}
+	for glyph, want := range map[string]string{IconWarn: "[warning]", "⚠️": "[warning]", "●": "*", "⏳": "[waiting]"} {
+		if got := Glyph(glyph); got != want {
+			t.Errorf("Glyph(%q) = %q, want %q", glyph, got, want)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Locale holds the date and clock layouts for formatting times.
+type Locale struct {
+	Name       string // e.g. "en_US", or "C" for the ISO default
+	DateLayout string // Go layout for a date, e.g. "01/02/2006"
+	TimeLayout string // Go layout for a time of day, e.g. "3:04 PM"
+}
+
+// isoLocale is used when no locale is configured: ISO dates, 24-hour clock.
+var isoLocale = Locale{Name: "C", DateLayout: "2006-01-02", TimeLayout: "15:04"}
+
+// localeLayouts maps a language or language_REGION to its layouts. A full
+// tag is tried before its language.
+var localeLayouts = map[string][2]string{
+	"en_US": {"01/02/2006", "3:04 PM"},
+	"en_CA": {"2006-01-02", "3:04 PM"},
+	"en_PH": {"01/02/2006", "3:04 PM"},
+	"en":    {"02/01/2006", "15:04"},
+	"fr":    {"02/01/2006", "15:04"},
+	"es":    {"02/01/2006", "15:04"},
+	"it":    {"02/01/2006", "15:04"},
+	"pt":    {"02/01/2006", "15:04"},
+	"nl":    {"02-01-2006", "15:04"},
+	"de":    {"02.01.2006", "15:04"},
+	"ru":    {"02.01.2006", "15:04"},
+	"pl":    {"02.01.2006", "15:04"},
+	"cs":    {"02.01.2006", "15:04"},
+	"fi":    {"02.01.2006", "15:04"},
+	"nb":    {"02.01.2006", "15:04"},
+	"da":    {"02.01.2006", "15:04"},
+	"tr":    {"02.01.2006", "15:04"},
+	"sv":    {"2006-01-02", "15:04"},
+	"ja":    {"2006/01/02", "15:04"},
+	"zh":    {"2006/01/02", "15:04"},
+	"ko":    {"2006.01.02", "15:04"},
+}
+
+// locale is the active locale, set by InitLocale.
+var locale = isoLocale
+
+// InitLocale sets the locale used to format times. Call this early in main.
+// configLocale is the value from TownSettings.CLILocale (may be empty).
+// Priority order:
+//  1. GT_LOCALE environment variable
+//  2. Configured value from settings
+//  3. LC_ALL, LC_TIME, then LANG
+//  4. Default: ISO dates and a 24-hour clock
+func InitLocale(configLocale string) {
+	for _, name := range []string{os.Getenv("GT_LOCALE"), configLocale, os.Getenv("LC_ALL"), os.Getenv("LC_TIME"), os.Getenv("LANG")} {
+		if name != "" {
+			locale = ParseLocale(name)
+			return
+		}
+	}
+	locale = isoLocale
+}
+
+// GetLocale returns the active locale.
+func GetLocale() Locale {
+	return locale
+}
+
+// ParseLocale returns the layouts for a POSIX or BCP 47 locale name such as
+// "en_US.UTF-8" or "de-DE". Unknown languages get the ISO default.
+func ParseLocale(name string) Locale {
+	tag, _, _ := strings.Cut(name, ".")
+	tag, _, _ = strings.Cut(tag, "@")
+	tag = strings.ReplaceAll(tag, "-", "_")
+	lang, region, _ := strings.Cut(tag, "_")
+	lang = strings.ToLower(lang)
+	if region != "" {
+		tag = lang + "_" + strings.ToUpper(region)
+	} else {
+		tag = lang
+	}
+	for _, key := range []string{tag, lang} {
+		if l, ok := localeLayouts[key]; ok {
+			return Locale{Name: tag, DateLayout: l[0], TimeLayout: l[1]}
+		}
+	}
+	return Locale{Name: tag, DateLayout: isoLocale.DateLayout, TimeLayout: isoLocale.TimeLayout}
+}
+
+// FormatDate formats the date of t in local time for the active locale.
+func FormatDate(t time.Time) string {
+	return t.Local().Format(locale.DateLayout)
+}
+
+// FormatClock formats the time of day of t in local time for the active locale.
+func FormatClock(t time.Time) string {
+	return t.Local().Format(locale.TimeLayout)
+}
+
+// FormatDateTime formats t in local time as a date and time of day.
+func FormatDateTime(t time.Time) string {
+	return t.Local().Format(locale.DateLayout + " " + locale.TimeLayout)
+}
+
+// FormatRelative formats t relative to now, e.g. "5 minutes ago". Times
+// more than a week away are shown as a date.
+func FormatRelative(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return formatRelative(time.Since(t), t)
+}
+
+func formatRelative(diff time.Duration, t time.Time) string {
+	suffix := "ago"
+	if diff < 0 {
+		diff, suffix = -diff, "from now"
+	}
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s %s", unit, suffix)
+		}
+		return fmt.Sprintf("%d %ss %s", n, unit, suffix)
+	}
+	switch {
+	case diff < time.Minute:
+		return "just now"
+	case diff < time.Hour:
+		return plural(int(diff.Minutes()), "minute")
+	case diff < 24*time.Hour:
+		return plural(int(diff.Hours()), "hour")
+	case diff < 7*24*time.Hour:
+		return plural(int(diff.Hours()/24), "day")
+	default:
+		return FormatDate(t)
+	}
+}
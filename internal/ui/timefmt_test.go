@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		name, wantName, wantDate, wantTime string
+	}{
+		{"en_US.UTF-8", "en_US", "01/02/2006", "3:04 PM"},
+		{"en-GB", "en_GB", "02/01/2006", "15:04"},
+		{"de_DE@euro", "de_DE", "02.01.2006", "15:04"},
+		{"ja_JP.UTF-8", "ja_JP", "2006/01/02", "15:04"},
+		{"C", "c", "2006-01-02", "15:04"},
+	}
+	for _, tt := range tests {
+		l := ParseLocale(tt.name)
+		if l.Name != tt.wantName || l.DateLayout != tt.wantDate || l.TimeLayout != tt.wantTime {
+			t.Errorf("ParseLocale(%q) = %+v", tt.name, l)
+		}
+	}
+}
+
+func TestInitLocale_Priority(t *testing.T) {
+	t.Cleanup(func() { InitLocale("") }) // Runs after the environment is restored
+	t.Setenv("LANG", "de_DE.UTF-8")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_TIME", "")
+	t.Setenv("GT_LOCALE", "")
+
+	InitLocale("")
+	if got := GetLocale().Name; got != "de_DE" {
+		t.Errorf("from LANG: locale = %q", got)
+	}
+	InitLocale("en_US")
+	if got := GetLocale().Name; got != "en_US" {
+		t.Errorf("config should beat LANG: locale = %q", got)
+	}
+	t.Setenv("GT_LOCALE", "ja_JP")
+	InitLocale("en_US")
+	if got := GetLocale().Name; got != "ja_JP" {
+		t.Errorf("GT_LOCALE should beat config: locale = %q", got)
+	}
+}
+
+func TestFormatRelative(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		diff time.Duration
+		want string
+	}{
+		{10 * time.Second, "just now"},
+		{time.Minute, "1 minute ago"},
+		{5 * time.Hour, "5 hours ago"},
+		{-2 * 24 * time.Hour, "2 days from now"},
+	}
+	for _, tt := range tests {
+		if got := formatRelative(tt.diff, now.Add(-tt.diff)); got != tt.want {
+			t.Errorf("formatRelative(%v) = %q, want %q", tt.diff, got, tt.want)
+		}
+	}
+	old := now.Add(-30 * 24 * time.Hour)
+	if got := formatRelative(30*24*time.Hour, old); got != FormatDate(old) {
+		t.Errorf("a month ago = %q, want the date", got)
+	}
+}
@@ -0,0 +1,36 @@
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+)
+
+// TailLines returns the last n lines of the file at path, each ending in a
+// newline. Lines longer than 1MB fail the read.
+func TailLines(path string, n int) ([]byte, error) {
+	f, err := os.Open(path) //nolint:gosec // G304: callers pass internal paths
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ring := make([][]byte, 0, n)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(ring) == n {
+			ring = ring[1:]
+		}
+		ring = append(ring, append([]byte(nil), scanner.Bytes()...))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	for _, line := range ring {
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,24 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTailLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log")
+	if err := os.WriteFile(path, []byte("a\nb\nc\nd"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := TailLines(path, 2)
+	if err != nil || string(got) != "c\nd\n" {
+		t.Errorf("TailLines(2) = %q, %v", got, err)
+	}
+	if got, _ := TailLines(path, 10); string(got) != "a\nb\nc\nd\n" {
+		t.Errorf("TailLines(10) = %q", got)
+	}
+	if _, err := TailLines(filepath.Join(t.TempDir(), "missing"), 2); !os.IsNotExist(err) {
+		t.Errorf("missing file error = %v", err)
+	}
+}
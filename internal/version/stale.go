@@ -42,6 +42,12 @@ func resolveCommitHash() string {
 	return ""
 }
 
+// BinaryCommit returns the commit the running binary was built from, or ""
+// if unknown.
+func BinaryCommit() string {
+	return resolveCommitHash()
+}
+
 // ShortCommit returns first 12 characters of a hash.
 func ShortCommit(hash string) string {
 	if len(hash) > 12 {
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/observer"
 	"github.com/steveyegge/gastown/internal/session"
 )
 
@@ -60,6 +61,8 @@ type APIHandler struct {
 	cmdSem chan struct{}
 	// csrfToken is validated on POST requests to prevent cross-site request forgery.
 	csrfToken string
+	// readOnly refuses every mutating request (observer dashboards).
+	readOnly bool
 }
 
 const optionsCacheTTL = 30 * time.Second
@@ -105,6 +108,13 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	path := strings.TrimPrefix(r.URL.Path, "/api")
+
+	// Observers may only run safe commands; every other POST mutates.
+	if h.readOnly && r.Method == http.MethodPost && path != "/run" {
+		h.sendError(w, "Read-only observer: this action is disabled", http.StatusForbidden)
+		return
+	}
+
 	switch {
 	case path == "/run" && r.Method == http.MethodPost:
 		h.handleRun(w, r)
@@ -134,6 +144,8 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.handleCrew(w, r)
 	case path == "/ready" && r.Method == http.MethodGet:
 		h.handleReady(w, r)
+	case path == "/wisps" && r.Method == http.MethodGet:
+		h.handleWisps(w, r)
 	case path == "/events" && r.Method == http.MethodGet:
 		h.handleSSE(w, r)
 	case path == "/session/preview" && r.Method == http.MethodGet:
@@ -157,6 +169,10 @@ func (h *APIHandler) handleRun(w http.ResponseWriter, r *http.Request) {
 		h.sendError(w, fmt.Sprintf("Command blocked: %v", err), http.StatusForbidden)
 		return
 	}
+	if h.readOnly && !meta.Safe {
+		h.sendError(w, "Read-only observer: only read-only commands may run", http.StatusForbidden)
+		return
+	}
 
 	// Enforce server-side confirmation for dangerous commands
 	if meta.Confirm && !req.Confirmed {
@@ -214,8 +230,18 @@ func (h *APIHandler) handleRun(w http.ResponseWriter, r *http.Request) {
 
 // handleCommands returns the list of available commands for the palette.
 func (h *APIHandler) handleCommands(w http.ResponseWriter, _ *http.Request) {
+	commands := GetCommandList()
+	if h.readOnly {
+		safe := commands[:0]
+		for _, c := range commands {
+			if c.Safe {
+				safe = append(safe, c)
+			}
+		}
+		commands = safe
+	}
 	resp := CommandListResponse{
-		Commands: GetCommandList(),
+		Commands: commands,
 	}
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(resp)
@@ -241,6 +267,10 @@ func (h *APIHandler) runGtCommand(ctx context.Context, timeout time.Duration, ar
 	}
 	// Ensure the command doesn't wait for stdin
 	cmd.Stdin = nil
+	if h.readOnly {
+		// Keeps read commands from marking mail read and the like
+		cmd.Env = append(os.Environ(), observer.EnvVar+"=1")
+	}
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -1966,6 +1996,53 @@ func (h *APIHandler) handleReady(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// WispsResponse is the response for /api/wisps.
+type WispsResponse struct {
+	View  string          `json:"view,omitempty"`
+	Wisps json.RawMessage `json:"wisps"`
+}
+
+// handleWisps returns in-flight wisps, filtered by the optional view, label,
+// and rig query parameters. Views are the saved views from town settings, so
+// the dashboard shows the same slices as gt wisp list --view.
+func (h *APIHandler) handleWisps(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	args := []string{"wisp", "list", "--json"}
+	if view := q.Get("view"); view != "" {
+		if !isValidID(view) {
+			h.sendError(w, "Invalid view name", http.StatusBadRequest)
+			return
+		}
+		args = append(args, "--view="+view)
+	}
+	if label := q.Get("label"); label != "" {
+		if len(label) > 200 || strings.ContainsAny(label, " \t\r\n") {
+			h.sendError(w, "Invalid label", http.StatusBadRequest)
+			return
+		}
+		args = append(args, "--label="+label)
+	}
+	if rig := q.Get("rig"); rig != "" {
+		if !isValidRigName(rig) {
+			h.sendError(w, "Invalid rig name", http.StatusBadRequest)
+			return
+		}
+		args = append(args, "--rig="+rig)
+	}
+
+	output, err := h.runGtCommand(r.Context(), 15*time.Second, args)
+	if err != nil {
+		h.sendError(w, "Failed to list wisps: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !json.Valid([]byte(output)) {
+		h.sendError(w, "Unexpected gt wisp list output", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(WispsResponse{View: q.Get("view"), Wisps: json.RawMessage(output)})
+}
+
 // SessionPreviewResponse is the response for /api/session/preview.
 type SessionPreviewResponse struct {
 	Session   string `json:"session"`
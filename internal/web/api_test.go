@@ -487,6 +487,26 @@ func TestAPIHandler_Crew(t *testing.T) {
 	}
 }
 
+func TestAPIHandler_WispsRejectsBadParams(t *testing.T) {
+	handler := &APIHandler{
+		gtPath:            "false",
+		workDir:           t.TempDir(),
+		defaultRunTimeout: 5 * time.Second,
+		maxRunTimeout:     10 * time.Second,
+		cmdSem:            make(chan struct{}, maxConcurrentCommands),
+		csrfToken:         "test-token",
+	}
+
+	for _, q := range []string{"view=--json", "rig=my-rig", "label=a%20b"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/wisps?"+q, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("GET /api/wisps?%s status = %d, want %d", q, w.Code, http.StatusBadRequest)
+		}
+	}
+}
+
 func TestAPIHandler_Ready(t *testing.T) {
 	handler := &APIHandler{
 		gtPath:            "false", // fast-failing stub — ready handler gracefully returns empty on error
@@ -1158,3 +1178,41 @@ func TestHandleSessionPreviewPrefixValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIHandler_ReadOnly(t *testing.T) {
+	handler := NewAPIHandler(30*time.Second, 60*time.Second, "test-token")
+	handler.readOnly = true
+
+	post := func(path, body string) int {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Dashboard-Token", "test-token")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+	for _, path := range []string{"/api/mail/send", "/api/issues/create", "/api/issues/close", "/api/issues/update"} {
+		if code := post(path, `{}`); code != http.StatusForbidden {
+			t.Errorf("read-only POST %s status = %d, want %d", path, code, http.StatusForbidden)
+		}
+	}
+	if code := post("/api/run", `{"command": "mail send", "confirmed": true}`); code != http.StatusForbidden {
+		t.Errorf("read-only run of unsafe command status = %d, want %d", code, http.StatusForbidden)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/commands", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	var resp CommandListResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(resp.Commands) == 0 {
+		t.Error("Expected safe commands in read-only command list")
+	}
+	for _, cmd := range resp.Commands {
+		if !cmd.Safe {
+			t.Errorf("read-only command list includes unsafe %q", cmd.Name)
+		}
+	}
+}
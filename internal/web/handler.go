@@ -10,6 +10,7 @@ import (
 	"io/fs"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -43,6 +44,7 @@ type ConvoyHandler struct {
 	template     *template.Template
 	fetchTimeout time.Duration
 	csrfToken    string
+	readOnly     bool
 }
 
 // NewConvoyHandler creates a new convoy handler with the given fetcher, fetch timeout, and CSRF token.
@@ -241,6 +243,7 @@ func (h *ConvoyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Summary:     summary,
 		Expand:      expandPanel,
 		CSRFToken:   h.csrfToken,
+		ReadOnly:    h.readOnly,
 	}
 
 	var buf bytes.Buffer
@@ -345,6 +348,26 @@ func generateCSRFToken() string {
 // NewDashboardMux creates an HTTP handler that serves both the dashboard and API.
 // webCfg may be nil, in which case defaults are used.
 func NewDashboardMux(fetcher ConvoyFetcher, webCfg *config.WebTimeoutsConfig) (http.Handler, error) {
+	return newDashboardMux(fetcher, webCfg, false)
+}
+
+// NewObserverDashboardMux creates a read-only dashboard for observers. Every
+// request must carry a token that verify accepts, either as a bearer token
+// or once as ?token=, which is then kept in a cookie. Mutating API calls are
+// refused and only safe commands may run. A nil verify admits everyone, for
+// observers running the dashboard locally.
+func NewObserverDashboardMux(fetcher ConvoyFetcher, webCfg *config.WebTimeoutsConfig, verify func(token string) bool) (http.Handler, error) {
+	mux, err := newDashboardMux(fetcher, webCfg, true)
+	if err != nil {
+		return nil, err
+	}
+	if verify == nil {
+		return mux, nil
+	}
+	return requireObserverToken(mux, verify), nil
+}
+
+func newDashboardMux(fetcher ConvoyFetcher, webCfg *config.WebTimeoutsConfig, readOnly bool) (http.Handler, error) {
 	if webCfg == nil {
 		webCfg = config.DefaultWebTimeoutsConfig()
 	}
@@ -356,10 +379,12 @@ func NewDashboardMux(fetcher ConvoyFetcher, webCfg *config.WebTimeoutsConfig) (h
 	if err != nil {
 		return nil, err
 	}
+	convoyHandler.readOnly = readOnly
 
 	defaultRunTimeout := config.ParseDurationOrDefault(webCfg.DefaultRunTimeout, 30*time.Second)
 	maxRunTimeout := config.ParseDurationOrDefault(webCfg.MaxRunTimeout, 60*time.Second)
 	apiHandler := NewAPIHandler(defaultRunTimeout, maxRunTimeout, csrfToken)
+	apiHandler.readOnly = readOnly
 
 	// Create static file server from embedded files
 	staticFS, err := fs.Sub(staticFiles, "static")
@@ -375,3 +400,44 @@ func NewDashboardMux(fetcher ConvoyFetcher, webCfg *config.WebTimeoutsConfig) (h
 
 	return mux, nil
 }
+
+// observerCookie holds an observer's token once it has been presented.
+const observerCookie = "gt_observer"
+
+// requireObserverToken admits only requests carrying a token verify accepts.
+func requireObserverToken(next http.Handler, verify func(string) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.URL.Query().Get("token"); token != "" {
+			if !verify(token) {
+				http.Error(w, "Invalid observer token", http.StatusUnauthorized)
+				return
+			}
+			// Keep the token out of history and logs: cookie, then redirect.
+			http.SetCookie(w, &http.Cookie{
+				Name:     observerCookie,
+				Value:    token,
+				Path:     "/",
+				HttpOnly: true,
+				SameSite: http.SameSiteStrictMode,
+			})
+			q := r.URL.Query()
+			q.Del("token")
+			u := *r.URL
+			u.RawQuery = q.Encode()
+			http.Redirect(w, r, u.RequestURI(), http.StatusSeeOther)
+			return
+		}
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" {
+			if c, err := r.Cookie(observerCookie); err == nil {
+				token = c.Value
+			}
+		}
+		if token == "" || !verify(token) {
+			http.Error(w, "Observer token required", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
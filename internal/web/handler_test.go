@@ -1136,3 +1136,47 @@ func TestConvoyHandler_NonFatalErrors(t *testing.T) {
 		t.Error("Response should contain convoy data even when other fetches fail")
 	}
 }
+
+func TestObserverDashboardMux_RequiresToken(t *testing.T) {
+	verify := func(token string) bool { return token == "gto_good" }
+	mux, err := NewObserverDashboardMux(&MockConvoyFetcher{}, nil, verify)
+	if err != nil {
+		t.Fatalf("NewObserverDashboardMux: %v", err)
+	}
+
+	get := func(target string, setup func(*http.Request)) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if setup != nil {
+			setup(req)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := get("/api/commands", nil); w.Code != http.StatusUnauthorized {
+		t.Errorf("no token: status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+	if w := get("/api/commands?token=gto_bad", nil); w.Code != http.StatusUnauthorized {
+		t.Errorf("bad token: status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+	if w := get("/api/commands", func(r *http.Request) { r.Header.Set("Authorization", "Bearer gto_good") }); w.Code != http.StatusOK {
+		t.Errorf("bearer token: status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	// A query token is swapped for a cookie and stripped from the URL
+	w := get("/?token=gto_good&expand=mail", nil)
+	if w.Code != http.StatusSeeOther {
+		t.Fatalf("query token: status = %d, want %d", w.Code, http.StatusSeeOther)
+	}
+	if loc := w.Header().Get("Location"); loc != "/?expand=mail" {
+		t.Errorf("query token: Location = %q, want /?expand=mail", loc)
+	}
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 || !cookies[0].HttpOnly {
+		t.Fatalf("query token: cookies = %v, want one HttpOnly cookie", cookies)
+	}
+	if w := get("/api/commands", func(r *http.Request) { r.AddCookie(cookies[0]) }); w.Code != http.StatusOK {
+		t.Errorf("cookie token: status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
@@ -32,6 +32,7 @@ type ConvoyData struct {
 	Summary     *DashboardSummary
 	Expand      string // Panel to show fullscreen (from ?expand=name)
 	CSRFToken   string // Token for CSRF protection on POST requests
+	ReadOnly    bool   // Served to a read-only observer; mutating actions are refused
 }
 
 // RigRow represents a registered rig in the dashboard.
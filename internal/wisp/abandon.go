@@ -0,0 +1,65 @@
+package wisp
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Abandoning a wisp hands it back explicitly instead of letting it sit
+// hooked until a patrol finds it stale. Every abandon records a reason code
+// so gt stats --abandons can show why work gets dropped.
+
+// AbandonLabelPrefix labels a wisp with each reason it was abandoned for,
+// so saved views can find wisps that keep bouncing.
+const AbandonLabelPrefix = "gt:abandoned:"
+
+// Abandon outcomes.
+const (
+	AbandonRequeue = "requeued" // Back to open and unassigned
+	AbandonClose   = "closed"   // Closed; the work won't be done
+)
+
+// AbandonReason is a reason code for abandoning a wisp.
+type AbandonReason struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	// Close reports whether wisps abandoned for this reason are closed
+	// rather than requeued by default.
+	Close bool `json:"close,omitempty"`
+}
+
+// AbandonReasons are the reason codes gt wisp abandon accepts.
+var AbandonReasons = []AbandonReason{
+	{Code: "blocked", Description: "Waiting on other work, a person, or an outside system"},
+	{Code: "unclear", Description: "The spec is ambiguous, contradictory, or missing"},
+	{Code: "too-large", Description: "Too big for one wisp; needs splitting"},
+	{Code: "env-broken", Description: "Build, test, or tooling environment is broken"},
+	{Code: "stuck", Description: "No progress after repeated attempts"},
+	{Code: "duplicate", Description: "Already done or tracked elsewhere", Close: true},
+	{Code: "obsolete", Description: "No longer needed", Close: true},
+	{Code: "other", Description: "Anything else (explain with --note)"},
+}
+
+// LookupAbandonReason returns the reason with the given code.
+func LookupAbandonReason(code string) (AbandonReason, error) {
+	for _, r := range AbandonReasons {
+		if r.Code == code {
+			return r, nil
+		}
+	}
+	codes := make([]string, len(AbandonReasons))
+	for i, r := range AbandonReasons {
+		codes[i] = r.Code
+	}
+	return AbandonReason{}, fmt.Errorf("unknown abandon reason %q (want one of: %s)", code, strings.Join(codes, ", "))
+}
+
+// AbandonPatchPath returns where the partial work of a wisp abandoned at t
+// is kept.
+func AbandonPatchPath(townRoot, beadID string, t time.Time) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "abandoned", fmt.Sprintf("%s-%s.patch", beadID, t.UTC().Format("20060102T150405Z")))
+}
@@ -0,0 +1,17 @@
+package wisp
+
+import "testing"
+
+func TestLookupAbandonReason(t *testing.T) {
+	r, err := LookupAbandonReason("duplicate")
+	if err != nil || !r.Close {
+		t.Errorf("LookupAbandonReason(duplicate) = %+v, %v; want a closing reason", r, err)
+	}
+	r, err = LookupAbandonReason("stuck")
+	if err != nil || r.Close {
+		t.Errorf("LookupAbandonReason(stuck) = %+v, %v; want a requeueing reason", r, err)
+	}
+	if _, err := LookupAbandonReason("bored"); err == nil {
+		t.Error("LookupAbandonReason accepted an unknown code")
+	}
+}
@@ -0,0 +1,247 @@
+package wisp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Wisp templates scaffold the description of a new wisp: headings for the
+// author to fill in, a checklist of acceptance criteria, and a checklist of
+// test expectations. The acceptance criteria go in the bead's
+// acceptance_criteria field, which gt done already refuses to close with
+// unchecked items; the test expectations go in a description section.
+// Criteria reads both back, so gates and reviewers can check a wisp's
+// definition of done.
+
+// TestsHeading is the description section holding a wisp's test
+// expectations. CriteriaHeading holds acceptance criteria for beads whose
+// acceptance_criteria field is empty.
+const (
+	CriteriaHeading = "Acceptance Criteria"
+	TestsHeading    = "Test Expectations"
+)
+
+// Criterion kinds.
+const (
+	CriterionAcceptance = "acceptance"
+	CriterionTest       = "test"
+)
+
+// Template is a resolved wisp template.
+type Template struct {
+	Name string `json:"name"`
+	config.WispTemplateConfig
+}
+
+// DefaultTemplates returns the built-in wisp templates.
+func DefaultTemplates() map[string]Template {
+	return map[string]Template{
+		"bugfix": {Name: "bugfix", WispTemplateConfig: config.WispTemplateConfig{
+			Description: "Fix a defect, proven by a regression test",
+			Type:        "bug",
+			Sections:    []string{"Problem", "Steps to Reproduce", "Expected Behavior"},
+			Criteria: []string{
+				"The reported failure no longer reproduces",
+				"Related behavior is unchanged",
+			},
+			Tests: []string{
+				"A regression test fails before the fix and passes after it",
+				"The existing test suite passes",
+			},
+		}},
+		"feature": {Name: "feature", WispTemplateConfig: config.WispTemplateConfig{
+			Description: "Add functionality, with tests and docs",
+			Type:        "feature",
+			Sections:    []string{"Motivation", "Proposed Behavior", "Out of Scope"},
+			Criteria: []string{
+				"The proposed behavior works as described",
+				"User-facing documentation is updated",
+			},
+			Tests: []string{
+				"New tests cover the proposed behavior, including failure cases",
+				"The existing test suite passes",
+			},
+		}},
+		"spike": {Name: "spike", WispTemplateConfig: config.WispTemplateConfig{
+			Description: "Time-boxed investigation that answers a question",
+			Type:        "spike",
+			Sections:    []string{"Question", "Time Box", "Approach"},
+			Criteria: []string{
+				"The question is answered, or why it can't be is written down",
+				"Findings and a recommended next step are recorded on the wisp",
+			},
+			Tests: []string{
+				"Any prototype code stays off the default branch",
+			},
+		}},
+	}
+}
+
+// Templates returns the town's wisp templates: the built-ins with the
+// configured overrides applied field by field, plus any custom templates.
+func Templates(s *config.WispSettings) map[string]Template {
+	templates := DefaultTemplates()
+	if s == nil {
+		return templates
+	}
+	for name, c := range s.Templates {
+		t := templates[name]
+		t.Name = name
+		if c.Description != "" {
+			t.Description = c.Description
+		}
+		if c.Type != "" {
+			t.Type = c.Type
+		}
+		if c.Sections != nil {
+			t.Sections = c.Sections
+		}
+		if c.Criteria != nil {
+			t.Criteria = c.Criteria
+		}
+		if c.Tests != nil {
+			t.Tests = c.Tests
+		}
+		templates[name] = t
+	}
+	return templates
+}
+
+// TemplateNames returns the template names, sorted.
+func TemplateNames(templates map[string]Template) []string {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks that t's wisp type exists, if it names one.
+func (t Template) Validate(types map[string]Type) error {
+	if t.Type == "" {
+		return nil
+	}
+	if _, ok := types[t.Type]; !ok {
+		return fmt.Errorf("wisp template %q: unknown wisp type %q", t.Name, t.Type)
+	}
+	return nil
+}
+
+// Scaffold returns the description and acceptance criteria of a new wisp.
+// The summary leads the description; criteria and tests replace the
+// template's defaults when given.
+func (t Template) Scaffold(summary string, criteria, tests []string) (description, acceptance string) {
+	if len(criteria) == 0 {
+		criteria = t.Criteria
+	}
+	if len(tests) == 0 {
+		tests = t.Tests
+	}
+
+	var b strings.Builder
+	if summary = strings.TrimSpace(summary); summary != "" {
+		b.WriteString(summary + "\n\n")
+	}
+	for _, heading := range t.Sections {
+		fmt.Fprintf(&b, "## %s\n\n<!-- fill in -->\n\n", heading)
+	}
+	if len(tests) > 0 {
+		fmt.Fprintf(&b, "## %s\n\n%s\n", TestsHeading, checklist(tests))
+	}
+	return strings.TrimRight(b.String(), "\n"), checklist(criteria)
+}
+
+func checklist(items []string) string {
+	lines := make([]string, len(items))
+	for i, item := range items {
+		lines[i] = "- [ ] " + item
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Criterion is one checklist item of a wisp's definition of done.
+type Criterion struct {
+	Kind string `json:"kind"` // CriterionAcceptance or CriterionTest
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+// Criteria returns issue's acceptance criteria, from its
+// acceptance_criteria field or else its CriteriaHeading section, followed
+// by the test expectations in its TestsHeading section. Only checklist
+// items ("- [ ]" and "- [x]") count.
+func Criteria(issue *beads.Issue) []Criterion {
+	acceptance := issue.AcceptanceCriteria
+	if strings.TrimSpace(acceptance) == "" {
+		acceptance = section(issue.Description, CriteriaHeading)
+	}
+	out := parseChecklist(acceptance, CriterionAcceptance)
+	return append(out, parseChecklist(section(issue.Description, TestsHeading), CriterionTest)...)
+}
+
+// Unchecked returns how many criteria are not done.
+func Unchecked(criteria []Criterion) int {
+	n := 0
+	for _, c := range criteria {
+		if !c.Done {
+			n++
+		}
+	}
+	return n
+}
+
+// section returns the body of a "## heading" section of markdown, up to
+// the next heading of the same or a higher level.
+func section(markdown, heading string) string {
+	var body []string
+	in := false
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if level, title, ok := parseHeading(trimmed); ok {
+			if in && level <= 2 {
+				break
+			}
+			if level == 2 && strings.EqualFold(title, heading) {
+				in = true
+				continue
+			}
+		}
+		if in {
+			body = append(body, line)
+		}
+	}
+	return strings.Join(body, "\n")
+}
+
+func parseHeading(line string) (int, string, bool) {
+	level := len(line) - len(strings.TrimLeft(line, "#"))
+	if level == 0 || level > 6 || !strings.HasPrefix(line[level:], " ") {
+		return 0, "", false
+	}
+	return level, strings.TrimSpace(line[level:]), true
+}
+
+func parseChecklist(text, kind string) []Criterion {
+	var out []Criterion
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		item, ok := strings.CutPrefix(trimmed, "- ")
+		if !ok {
+			item, ok = strings.CutPrefix(trimmed, "* ")
+		}
+		if !ok || len(item) < 4 || item[0] != '[' || item[2] != ']' {
+			continue
+		}
+		mark := item[1]
+		if mark != ' ' && mark != 'x' && mark != 'X' {
+			continue
+		}
+		out = append(out, Criterion{Kind: kind, Text: strings.TrimSpace(item[3:]), Done: mark != ' '})
+	}
+	return out
+}
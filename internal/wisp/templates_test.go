@@ -0,0 +1,66 @@
+package wisp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestTemplates_OverridesAndCustom(t *testing.T) {
+	templates := Templates(&config.WispSettings{Templates: map[string]config.WispTemplateConfig{
+		"bugfix": {Tests: []string{"go test ./... passes"}},
+		"docs":   {Type: "docs", Sections: []string{"Audience"}},
+	}})
+	types := DefaultTypes()
+
+	bugfix := templates["bugfix"]
+	if bugfix.Type != "bug" || len(bugfix.Criteria) != 2 || fmt.Sprint(bugfix.Tests) != "[go test ./... passes]" {
+		t.Errorf("override should only change tests: %+v", bugfix)
+	}
+	if err := templates["docs"].Validate(types); err == nil {
+		t.Error("a template of an unknown wisp type should not validate")
+	}
+	for _, name := range []string{"bugfix", "feature", "spike"} {
+		if err := templates[name].Validate(types); err != nil {
+			t.Errorf("built-in: %v", err)
+		}
+	}
+}
+
+func TestScaffoldAndCriteria(t *testing.T) {
+	tmpl := DefaultTemplates()["bugfix"]
+	description, acceptance := tmpl.Scaffold("Login fails on Safari.", []string{"Safari users can log in"}, nil)
+
+	for _, want := range []string{"Login fails on Safari.\n\n## Problem\n", "## Steps to Reproduce", "## Test Expectations\n\n- [ ] A regression test"} {
+		if !strings.Contains(description, want) {
+			t.Errorf("description missing %q:\n%s", want, description)
+		}
+	}
+	if acceptance != "- [ ] Safari users can log in" {
+		t.Errorf("acceptance = %q, want the given criteria", acceptance)
+	}
+
+	issue := &beads.Issue{
+		AcceptanceCriteria: "- [x] Safari users can log in\n- [ ] Error is logged",
+		Description:        description + "\n\n## Notes\n\n- [ ] not a criterion",
+	}
+	criteria := Criteria(issue)
+	if len(criteria) != 4 || Unchecked(criteria) != 3 {
+		t.Fatalf("Criteria() = %+v", criteria)
+	}
+	if c := criteria[0]; c.Kind != CriterionAcceptance || !c.Done || c.Text != "Safari users can log in" {
+		t.Errorf("first criterion = %+v", c)
+	}
+	if c := criteria[2]; c.Kind != CriterionTest {
+		t.Errorf("test expectation = %+v", c)
+	}
+
+	// Without the field, acceptance criteria come from the description.
+	legacy := &beads.Issue{Description: "## Acceptance Criteria\n\n* [X] Done\n### Detail\n- [ ] Nested item\n\n## Other\n- [ ] no"}
+	if got := Criteria(legacy); len(got) != 2 || !got[0].Done || got[1].Text != "Nested item" {
+		t.Errorf("Criteria(legacy) = %+v", got)
+	}
+}
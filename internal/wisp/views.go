@@ -0,0 +1,142 @@
+package wisp
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// ReservedLabelPrefix marks labels Gas Town manages itself (gt:agent,
+// gt:merge-request, ...). Users may not add or remove them as wisp labels.
+const ReservedLabelPrefix = "gt:"
+
+// Metric label values used when a wisp carries no tracked label.
+const (
+	MetricLabelNone  = "none"
+	MetricLabelOther = "other"
+)
+
+// ValidateLabel checks that l can be used as a user wisp label.
+func ValidateLabel(l string) error {
+	switch {
+	case l == "":
+		return fmt.Errorf("empty label")
+	case strings.HasPrefix(l, ReservedLabelPrefix):
+		return fmt.Errorf("label %q uses the reserved %q prefix", l, ReservedLabelPrefix)
+	case strings.ContainsAny(l, " \t\n,"):
+		return fmt.Errorf("label %q must not contain whitespace or commas", l)
+	}
+	return nil
+}
+
+// ParseLabelArgs splits label arguments into additions and removals:
+// "+x" or "x" adds x, "-x" removes it.
+func ParseLabelArgs(args []string) (add, remove []string, err error) {
+	for _, a := range args {
+		target := &add
+		switch {
+		case strings.HasPrefix(a, "+"):
+			a = a[1:]
+		case strings.HasPrefix(a, "-"):
+			a = a[1:]
+			target = &remove
+		}
+		if err := ValidateLabel(a); err != nil {
+			return nil, nil, err
+		}
+		*target = append(*target, a)
+	}
+	return add, remove, nil
+}
+
+// UserLabels returns the labels on a bead that are not Gas Town-managed.
+func UserLabels(labels []string) []string {
+	var out []string
+	for _, l := range labels {
+		if !strings.HasPrefix(l, ReservedLabelPrefix) {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// ViewNames returns the configured view names, sorted.
+func ViewNames(s *config.WispSettings) []string {
+	if s == nil {
+		return nil
+	}
+	names := make([]string, 0, len(s.Views))
+	for name := range s.Views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CompileView looks up a saved view and compiles its query.
+func CompileView(s *config.WispSettings, name string) (*beads.Predicate, config.WispView, error) {
+	var v config.WispView
+	ok := false
+	if s != nil {
+		v, ok = s.Views[name]
+	}
+	if !ok {
+		if names := ViewNames(s); len(names) > 0 {
+			return nil, v, fmt.Errorf("unknown wisp view %q (have: %s)", name, strings.Join(names, ", "))
+		}
+		return nil, v, fmt.Errorf("unknown wisp view %q (no views configured in settings/config.json)", name)
+	}
+	p, err := beads.Query(v.Query)
+	if err != nil {
+		return nil, v, fmt.Errorf("wisp view %q: %w", name, err)
+	}
+	return p, v, nil
+}
+
+// RouteTarget returns the sling target of the first view (by name) that
+// has a target and matches issue. It returns "" when no view applies.
+// Views with invalid queries are skipped; gt wisp views reports them.
+func RouteTarget(s *config.WispSettings, issue *beads.Issue, env beads.QueryEnv) (target, view string) {
+	for _, name := range ViewNames(s) {
+		if s.Views[name].Target == "" {
+			continue
+		}
+		p, v, err := CompileView(s, name)
+		if err != nil {
+			continue
+		}
+		if p.Match(issue, env) {
+			return v.Target, name
+		}
+	}
+	return "", ""
+}
+
+// MetricLabel picks the value of the "label" metric dimension for a wisp:
+// the first tracked label it carries, MetricLabelOther if it only has
+// untracked labels, or MetricLabelNone. Only the first MaxWispMetricLabels
+// tracked labels count, which bounds the dimension's cardinality no matter
+// how labels are used.
+func MetricLabel(s *config.WispSettings, labels []string) string {
+	user := UserLabels(labels)
+	if len(user) == 0 {
+		return MetricLabelNone
+	}
+	if s == nil {
+		return MetricLabelOther
+	}
+	tracked := s.MetricLabels
+	if len(tracked) > config.MaxWispMetricLabels {
+		tracked = tracked[:config.MaxWispMetricLabels]
+	}
+	for _, t := range tracked {
+		if slices.Contains(user, t) {
+			return t
+		}
+	}
+	return MetricLabelOther
+}
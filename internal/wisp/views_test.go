@@ -0,0 +1,83 @@
+package wisp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestParseLabelArgs(t *testing.T) {
+	add, remove, err := ParseLabelArgs([]string{"+frontend", "urgent", "-backend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(add) != "[frontend urgent]" || fmt.Sprint(remove) != "[backend]" {
+		t.Errorf("add=%v remove=%v", add, remove)
+	}
+
+	for _, bad := range []string{"+gt:agent", "-gt:merge-request", "+", "a b", "x,y"} {
+		if _, _, err := ParseLabelArgs([]string{bad}); err == nil {
+			t.Errorf("ParseLabelArgs(%q) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestCompileViewAndRoute(t *testing.T) {
+	s := &config.WispSettings{Views: map[string]config.WispView{
+		"urgent-frontend": {Query: "label = frontend AND priority <= 1", Target: "webapp"},
+		"docs":            {Query: "label = docs"},
+		"broken":          {Query: "label =", Target: "nowhere"},
+	}}
+
+	p, _, err := CompileView(s, "urgent-frontend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	urgent := &beads.Issue{ID: "gt-1", Priority: 0, Labels: []string{"frontend", "gt:task"}}
+	calm := &beads.Issue{ID: "gt-2", Priority: 3, Labels: []string{"frontend"}}
+	if !p.Match(urgent, beads.QueryEnv{}) || p.Match(calm, beads.QueryEnv{}) {
+		t.Error("urgent-frontend view matched the wrong beads")
+	}
+
+	if _, _, err := CompileView(s, "missing"); err == nil {
+		t.Error("CompileView(missing) succeeded")
+	}
+	if _, _, err := CompileView(nil, "any"); err == nil {
+		t.Error("CompileView with no settings succeeded")
+	}
+
+	if target, view := RouteTarget(s, urgent, beads.QueryEnv{}); target != "webapp" || view != "urgent-frontend" {
+		t.Errorf("RouteTarget(urgent) = %q, %q", target, view)
+	}
+	if target, _ := RouteTarget(s, calm, beads.QueryEnv{}); target != "" {
+		t.Errorf("RouteTarget(calm) = %q, want no route", target)
+	}
+}
+
+func TestMetricLabel_Capped(t *testing.T) {
+	s := &config.WispSettings{}
+	for i := 0; i < config.MaxWispMetricLabels+5; i++ {
+		s.MetricLabels = append(s.MetricLabels, fmt.Sprintf("l%d", i))
+	}
+
+	tests := []struct {
+		labels []string
+		want   string
+	}{
+		{nil, MetricLabelNone},
+		{[]string{"gt:task"}, MetricLabelNone},
+		{[]string{"l3", "l1"}, "l1"},
+		{[]string{"unlisted"}, MetricLabelOther},
+		{[]string{fmt.Sprintf("l%d", config.MaxWispMetricLabels)}, MetricLabelOther}, // past the cap
+	}
+	for _, tt := range tests {
+		if got := MetricLabel(s, tt.labels); got != tt.want {
+			t.Errorf("MetricLabel(%v) = %q, want %q", tt.labels, got, tt.want)
+		}
+	}
+	if got := MetricLabel(nil, []string{"x"}); got != MetricLabelOther {
+		t.Errorf("MetricLabel(nil settings) = %q", got)
+	}
+}
@@ -0,0 +1,246 @@
+package wisp
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Wisp types give different kinds of work their own workflow: lifecycle
+// states, the refinery gates they must pass, a default priority, and extra
+// prime context. A patrol finding is triaged and closed without the merge
+// gates a feature goes through.
+//
+// A wisp's type is its TypeLabelPrefix label, or else its bead's issue type
+// when a wisp type of that name exists. Its lifecycle state is its
+// StateLabelPrefix label; a typed wisp without one is in its first state.
+
+// Labels recording a wisp's type and lifecycle state.
+const (
+	TypeLabelPrefix  = "gt:wisp-type:"
+	StateLabelPrefix = "gt:wisp-state:"
+)
+
+// AllGates in a type's gate list requires every configured refinery gate.
+const AllGates = "*"
+
+// Type is a resolved wisp type.
+type Type struct {
+	Name string `json:"name"`
+	config.WispTypeConfig
+
+	// TownGates reports that Gates comes from town settings. A wisp's type
+	// is a label any agent can set, so only a gate list the town chose may
+	// narrow or skip the rig's gates; see RequiredGates.
+	TownGates bool `json:"town_gates,omitempty"`
+}
+
+func intPtr(n int) *int { return &n }
+
+// DefaultTypes returns the built-in wisp types.
+func DefaultTypes() map[string]Type {
+	return map[string]Type{
+		"bug": {Name: "bug", WispTypeConfig: config.WispTypeConfig{
+			Description: "Defect fix; full review and every merge gate",
+			States:      []string{"open", "in_progress", "in_review", "closed"},
+			Gates:       []string{AllGates},
+			Priority:    intPtr(1),
+		}},
+		"feature": {Name: "feature", WispTypeConfig: config.WispTypeConfig{
+			Description: "New functionality; design, review, and every merge gate",
+			States:      []string{"open", "design", "in_progress", "in_review", "closed"},
+			Gates:       []string{AllGates},
+			Priority:    intPtr(2),
+		}},
+		"chore": {Name: "chore", WispTypeConfig: config.WispTypeConfig{
+			Description: "Maintenance; every merge gate, no review stage",
+			States:      []string{"open", "in_progress", "closed"},
+			Gates:       []string{AllGates},
+			Priority:    intPtr(3),
+		}},
+		"spike": {Name: "spike", WispTypeConfig: config.WispTypeConfig{
+			Description: "Time-boxed investigation; findings are the output, every merge gate if merged",
+			States:      []string{"open", "in_progress", "closed"},
+			Gates:       []string{AllGates},
+			Priority:    intPtr(2),
+		}},
+		"patrol-finding": {Name: "patrol-finding", WispTypeConfig: config.WispTypeConfig{
+			Description: "Observation filed by a patrol; triaged, every merge gate if merged",
+			States:      []string{"open", "triaged", "closed"},
+			Gates:       []string{AllGates},
+			Priority:    intPtr(3),
+		}},
+	}
+}
+
+// Types returns the town's wisp types: the built-ins with the configured
+// overrides applied field by field, plus any custom types.
+func Types(s *config.WispSettings) map[string]Type {
+	types := DefaultTypes()
+	if s == nil {
+		return types
+	}
+	for name, c := range s.Types {
+		t, ok := types[name]
+		if !ok {
+			t = Type{Name: name, WispTypeConfig: config.WispTypeConfig{
+				States: []string{"open", "in_progress", "closed"},
+				Gates:  []string{AllGates},
+			}}
+		}
+		if c.Description != "" {
+			t.Description = c.Description
+		}
+		if c.States != nil {
+			t.States = c.States
+		}
+		if c.Gates != nil {
+			t.Gates = c.Gates
+			t.TownGates = true
+		}
+		if c.Priority != nil {
+			t.Priority = c.Priority
+		}
+		if c.PrimeTemplate != "" {
+			t.PrimeTemplate = c.PrimeTemplate
+		}
+		types[name] = t
+	}
+	return types
+}
+
+// TypeNames returns the type names, sorted.
+func TypeNames(types map[string]Type) []string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks that t's workflow is usable.
+func (t Type) Validate() error {
+	if len(t.States) < 2 {
+		return fmt.Errorf("wisp type %q: needs at least two states", t.Name)
+	}
+	seen := make(map[string]bool, len(t.States))
+	for _, s := range t.States {
+		if s == "" || strings.ContainsAny(s, " \t,") {
+			return fmt.Errorf("wisp type %q: invalid state %q", t.Name, s)
+		}
+		if seen[s] {
+			return fmt.Errorf("wisp type %q: duplicate state %q", t.Name, s)
+		}
+		seen[s] = true
+	}
+	if t.Priority != nil && (*t.Priority < 0 || *t.Priority > 4) {
+		return fmt.Errorf("wisp type %q: priority %d out of range 0-4", t.Name, *t.Priority)
+	}
+	return nil
+}
+
+// TypeOf returns the wisp type of issue, if it has one.
+func TypeOf(types map[string]Type, issue *beads.Issue) (Type, bool) {
+	for _, l := range issue.Labels {
+		if name, ok := strings.CutPrefix(l, TypeLabelPrefix); ok {
+			t, ok := types[name]
+			return t, ok
+		}
+	}
+	t, ok := types[issue.Type]
+	return t, ok
+}
+
+// StateOf returns issue's lifecycle state under t.
+func (t Type) StateOf(issue *beads.Issue) string {
+	for _, l := range issue.Labels {
+		if s, ok := strings.CutPrefix(l, StateLabelPrefix); ok {
+			return s
+		}
+	}
+	if issue.Status == "closed" {
+		return t.States[len(t.States)-1]
+	}
+	return t.States[0]
+}
+
+// NextState returns the state after cur, or false if cur is final.
+func (t Type) NextState(cur string) (string, bool) {
+	i := slices.Index(t.States, cur)
+	if i < 0 || i == len(t.States)-1 {
+		return "", false
+	}
+	return t.States[i+1], true
+}
+
+// SkipsGates reports whether the town configured t to merge without any
+// refinery gate or test command.
+func (t Type) SkipsGates() bool {
+	return t.TownGates && len(t.Gates) == 0
+}
+
+// IsFinal reports whether state is the last of t's lifecycle.
+func (t Type) IsFinal(state string) bool {
+	return len(t.States) > 0 && t.States[len(t.States)-1] == state
+}
+
+// Transition returns the bead update that moves issue to state to, and
+// whether the bead should then be closed.
+func (t Type) Transition(issue *beads.Issue, to string) (beads.UpdateOptions, bool, error) {
+	if !slices.Contains(t.States, to) {
+		return beads.UpdateOptions{}, false, fmt.Errorf("wisp type %q has no state %q (states: %s)",
+			t.Name, to, strings.Join(t.States, " → "))
+	}
+	var opts beads.UpdateOptions
+	for _, l := range issue.Labels {
+		if strings.HasPrefix(l, StateLabelPrefix) && l != StateLabelPrefix+to {
+			opts.RemoveLabels = append(opts.RemoveLabels, l)
+		}
+	}
+	if !beads.HasLabel(issue, StateLabelPrefix+to) {
+		opts.AddLabels = []string{StateLabelPrefix + to}
+	}
+	return opts, t.IsFinal(to), nil
+}
+
+// Assign returns the bead update that gives issue type t: the type label,
+// the initial state, and (unless keepPriority) t's default priority.
+func (t Type) Assign(issue *beads.Issue, keepPriority bool) beads.UpdateOptions {
+	var opts beads.UpdateOptions
+	for _, l := range issue.Labels {
+		if strings.HasPrefix(l, TypeLabelPrefix) || strings.HasPrefix(l, StateLabelPrefix) {
+			opts.RemoveLabels = append(opts.RemoveLabels, l)
+		}
+	}
+	opts.AddLabels = []string{TypeLabelPrefix + t.Name, StateLabelPrefix + t.States[0]}
+	if t.Priority != nil && !keepPriority {
+		opts.Priority = intPtr(*t.Priority)
+	}
+	return opts
+}
+
+// RequiredGates splits the configured refinery gates into those t requires
+// (sorted) and the required gate names that are not configured. Unless the
+// gate list comes from town settings, every configured gate is required:
+// built-in types are the baseline and cannot opt out of gates.
+func (t Type) RequiredGates(configured []string) (run, missing []string) {
+	if !t.TownGates || slices.Contains(t.Gates, AllGates) {
+		run = append(run, configured...)
+		sort.Strings(run)
+		return run, nil
+	}
+	for _, g := range t.Gates {
+		if slices.Contains(configured, g) {
+			run = append(run, g)
+		} else {
+			missing = append(missing, g)
+		}
+	}
+	sort.Strings(run)
+	return run, missing
+}
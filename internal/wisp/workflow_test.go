@@ -0,0 +1,108 @@
+package wisp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestTypes_OverridesAndCustom(t *testing.T) {
+	two := 2
+	types := Types(&config.WispSettings{Types: map[string]config.WispTypeConfig{
+		"patrol-finding": {Priority: &two},
+		"docs":           {States: []string{"open", "drafted", "closed"}, Gates: []string{"lint"}},
+	}})
+
+	pf := types["patrol-finding"]
+	if *pf.Priority != 2 || fmt.Sprint(pf.Gates) != "[*]" || pf.TownGates || fmt.Sprint(pf.States) != "[open triaged closed]" {
+		t.Errorf("override should only change priority: %+v", pf)
+	}
+	docs := types["docs"]
+	if err := docs.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(docs.Gates) != "[lint]" || docs.Priority != nil {
+		t.Errorf("custom type = %+v", docs)
+	}
+	for _, name := range TypeNames(types) {
+		if err := types[name].Validate(); err != nil {
+			t.Errorf("built-in: %v", err)
+		}
+	}
+
+	bad := Type{Name: "x", WispTypeConfig: config.WispTypeConfig{States: []string{"open", "open"}}}
+	if bad.Validate() == nil {
+		t.Error("duplicate states should not validate")
+	}
+}
+
+func TestTypeOfAndLifecycle(t *testing.T) {
+	types := DefaultTypes()
+
+	if typ, ok := TypeOf(types, &beads.Issue{Type: "bug"}); !ok || typ.Name != "bug" {
+		t.Errorf("issue type should select the wisp type, got %q %v", typ.Name, ok)
+	}
+	if _, ok := TypeOf(types, &beads.Issue{Type: "task"}); ok {
+		t.Error("task is not a wisp type")
+	}
+
+	issue := &beads.Issue{ID: "gt-1", Type: "task", Priority: 0, Labels: []string{"frontend"}}
+	pf := types["patrol-finding"]
+	opts := pf.Assign(issue, false)
+	if fmt.Sprint(opts.AddLabels) != "[gt:wisp-type:patrol-finding gt:wisp-state:open]" || *opts.Priority != 3 {
+		t.Errorf("Assign = %+v", opts)
+	}
+	if pf.Assign(issue, true).Priority != nil {
+		t.Error("keepPriority should leave priority alone")
+	}
+
+	issue.Labels = append(issue.Labels, opts.AddLabels...)
+	typ, ok := TypeOf(types, issue)
+	if !ok || typ.Name != "patrol-finding" {
+		t.Fatalf("type label should win over issue type, got %q", typ.Name)
+	}
+	if s := typ.StateOf(issue); s != "open" {
+		t.Errorf("StateOf = %q", s)
+	}
+	next, _ := typ.NextState("open")
+	tr, closeBead, err := typ.Transition(issue, next)
+	if err != nil || closeBead || fmt.Sprint(tr.RemoveLabels) != "[gt:wisp-state:open]" || fmt.Sprint(tr.AddLabels) != "[gt:wisp-state:triaged]" {
+		t.Errorf("Transition(triaged) = %+v %v %v", tr, closeBead, err)
+	}
+	if _, closeBead, _ := typ.Transition(issue, "closed"); !closeBead {
+		t.Error("final state should close the bead")
+	}
+	if _, _, err := typ.Transition(issue, "in_review"); err == nil {
+		t.Error("patrol findings have no review state")
+	}
+	if _, ok := typ.NextState("closed"); ok {
+		t.Error("final state has no successor")
+	}
+}
+
+func TestRequiredGates(t *testing.T) {
+	configured := []string{"test", "lint", "build"}
+	types := DefaultTypes()
+
+	if run, _ := types["feature"].RequiredGates(configured); fmt.Sprint(run) != "[build lint test]" {
+		t.Errorf("feature gates = %v", run)
+	}
+	// Built-in gate lists can't opt out: the type is an agent-settable label.
+	if run, _ := types["patrol-finding"].RequiredGates(configured); fmt.Sprint(run) != "[build lint test]" {
+		t.Errorf("built-in patrol-finding gates = %v, want every gate", run)
+	}
+	if types["patrol-finding"].SkipsGates() {
+		t.Error("built-in type skips gates")
+	}
+	town := Types(&config.WispSettings{Types: map[string]config.WispTypeConfig{"patrol-finding": {Gates: []string{}}}})
+	if run, _ := town["patrol-finding"].RequiredGates(configured); len(run) != 0 || !town["patrol-finding"].SkipsGates() {
+		t.Errorf("town-configured empty gate list = %v, want none", run)
+	}
+	docs := Type{Name: "docs", TownGates: true, WispTypeConfig: config.WispTypeConfig{Gates: []string{"lint", "spellcheck"}}}
+	run, missing := docs.RequiredGates(configured)
+	if fmt.Sprint(run) != "[lint]" || fmt.Sprint(missing) != "[spellcheck]" {
+		t.Errorf("docs gates = %v missing %v", run, missing)
+	}
+}
@@ -412,7 +412,7 @@ func HandleMergeFailed(workDir, rigName string, msg *mail.Message, router *mail.
 	nudgeMsg := fmt.Sprintf("MERGE_FAILED: branch=%s issue=%s type=%s error=%s — fix and resubmit with 'gt done'",
 		payload.Branch, payload.IssueID, payload.FailureType, payload.Error)
 	t := tmux.NewTmux()
-	if err := t.NudgeSession(sessionName, nudgeMsg); err != nil {
+	if err := t.NudgeWhenIdle(sessionName, nudgeMsg, constants.NudgeIdleTimeout); err != nil {
 		result.Error = fmt.Errorf("nudging polecat about failure: %w", err)
 		return result
 	}
@@ -643,11 +643,11 @@ func nudgeRefinery(townRoot, rigName string) error {
 		return nil
 	}
 
-	// Immediate delivery: send directly to tmux pane.
-	// No cooperative queue — idle agents never call Drain(), so queued
-	// nudges would be stuck forever. Direct delivery is safe: if the
-	// agent is busy, text buffers in tmux and is processed at next prompt.
-	return t.NudgeSession(sessionName, "New MR available - check merge queue for pending work")
+	// Direct delivery to the tmux pane, once the refinery finishes its
+	// current response. No cooperative queue — idle agents never call
+	// Drain(), so queued nudges would be stuck forever. If the refinery is
+	// still busy at the timeout, text buffers in tmux until the next prompt.
+	return t.NudgeWhenIdle(sessionName, "New MR available - check merge queue for pending work", constants.NudgeIdleTimeout)
 }
 
 // RecoveryPayload contains data for RECOVERY_NEEDED escalation.
@@ -671,7 +671,7 @@ func EscalateRecoveryNeeded(workDir, rigName string, payload *RecoveryPayload) (
 	nudgeMsg := fmt.Sprintf("RECOVERY_NEEDED: %s/%s cleanup_status=%s branch=%s issue=%s detected=%s — coordinate recovery before authorizing cleanup",
 		rigName, payload.PolecatName, payload.CleanupStatus, payload.Branch, payload.IssueID, payload.DetectedAt.Format(time.RFC3339))
 	t := tmux.NewTmux()
-	if err := t.NudgeSession(sessionName, nudgeMsg); err != nil {
+	if err := t.NudgeWhenIdle(sessionName, nudgeMsg, constants.NudgeIdleTimeout); err != nil {
 		return "", fmt.Errorf("nudging deacon about recovery: %w", err)
 	}
 	return "nudge", nil
@@ -1720,7 +1720,7 @@ then either close the bead or reset the respawn counter.`,
 				t := tmux.NewTmux()
 				nudgeMsg := fmt.Sprintf("SPAWN_BLOCKED %s (respawn limit reached) from %s/%s — mail send failed, investigate spawn storm",
 					hookBead, rigName, polecatName)
-				if nudgeErr := t.NudgeSession(session.MayorSessionName(), nudgeMsg); nudgeErr != nil {
+				if nudgeErr := t.NudgeWhenIdle(session.MayorSessionName(), nudgeMsg, constants.NudgeIdleTimeout); nudgeErr != nil {
 					fmt.Fprintf(os.Stderr, "witness: nudge fallback to mayor also failed for %s: %v\n", hookBead, nudgeErr)
 				}
 			}
@@ -1771,7 +1771,7 @@ Please re-dispatch to an available polecat.`,
 			t := tmux.NewTmux()
 			nudgeMsg := fmt.Sprintf("RECOVERED_BEAD %s from %s/%s (status=%s, respawns=%d) — mail send failed, please re-dispatch",
 				hookBead, rigName, polecatName, status, respawnCount)
-			if nudgeErr := t.NudgeSession(session.DeaconSessionName(), nudgeMsg); nudgeErr != nil {
+			if nudgeErr := t.NudgeWhenIdle(session.DeaconSessionName(), nudgeMsg, constants.NudgeIdleTimeout); nudgeErr != nil {
 				fmt.Fprintf(os.Stderr, "witness: nudge fallback to deacon also failed for %s: %v\n", hookBead, nudgeErr)
 			}
 		}